@@ -7,6 +7,7 @@ var services = mapOf(
         "appconfiguration" to "App Configuration",
         "appservice" to "AppService",
         "applicationinsights" to "Application Insights",
+        "arcresourcebridge" to "Arc Resource Bridge",
         "attestation" to "Attestation",
         "authorization" to "Authorization",
         "automation" to "Automation",
@@ -16,11 +17,15 @@ var services = mapOf(
         "blueprints" to "Blueprints",
         "bot" to "Bot",
         "cdn" to "CDN",
+        "chaosstudio" to "Chaos Studio",
         "cognitive" to "Cognitive Services",
         "communication" to "Communication",
         "compute" to "Compute",
+        "computefleet" to "Compute Fleet",
         "consumption" to "Consumption",
+        "containerapps" to "Container Apps",
         "containers" to "Container Services",
+        "containerstorage" to "Container Storage",
         "cosmos" to "CosmosDB",
         "costmanagement" to "Cost Management",
         "customproviders" to "Custom Providers",
@@ -33,23 +38,30 @@ var services = mapOf(
         "databasemigration" to "Database Migration",
         "databoxedge" to "Databox Edge",
         "desktopvirtualization" to "Desktop Virtualization",
+        "devcenter" to "Dev Center",
         "devtestlabs" to "Dev Test",
         "digitaltwins" to "Digital Twins",
         "domainservices" to "DomainServices",
+        "durabletask" to "Durable Task",
+        "elasticsan" to "Elastic SAN",
         "eventgrid" to "EventGrid",
         "eventhub" to "EventHub",
+        "extendedlocation" to "Extended Location",
         "firewall" to "Firewall",
         "frontdoor" to "FrontDoor",
         "hdinsight" to "HDInsight",
         "hpccache" to "HPC Cache",
         "hsm" to "Hardware Security Module",
         "healthcare" to "Health Care",
+        "hybridcompute" to "Hybrid Compute",
         "iotcentral" to "IoT Central",
         "iothub" to "IoT Hub",
         "keyvault" to "KeyVault",
         "kusto" to "Kusto",
+        "labservice" to "Lab Service",
         "lighthouse" to "Lighthouse",
         "loadbalancer" to "Load Balancer",
+        "loadtestservice" to "Load Test",
         "loganalytics" to "Log Analytics",
         "logic" to "Logic",
         "logz" to "Logz",
@@ -60,14 +72,17 @@ var services = mapOf(
         "managementgroup" to "Management Group",
         "maps" to "Maps",
         "mariadb" to "MariaDB",
+        "marketplace" to "Marketplace",
         "media" to "Media",
         "mssql" to "Microsoft SQL Server / Azure SQL",
         "mixedreality" to "Mixed Reality",
+        "mobilenetwork" to "Mobile Network",
         "monitor" to "Monitor",
         "mysql" to "MySQL",
         "netapp" to "NetApp",
         "network" to "Network",
         "notificationhub" to "Notification Hub",
+        "orbital" to "Orbital",
         "policy" to "Policy",
         "portal" to "Portal",
         "postgres" to "PostgreSQL",
@@ -78,6 +93,7 @@ var services = mapOf(
         "redis" to "Redis",
         "redisenterprise" to "Redis Enterprise",
         "relay" to "Relay",
+        "reservations" to "Reservations",
         "resource" to "Resources",
         "sql" to "SQL",
         "search" to "Search",
@@ -89,6 +105,7 @@ var services = mapOf(
         "signalr" to "SignalR",
         "springcloud" to "Spring Cloud",
         "storage" to "Storage",
+        "storagemover" to "Storage Mover",
         "streamanalytics" to "Stream Analytics",
         "subscription" to "Subscription",
         "synapse" to "Synapse",
@@ -96,5 +113,6 @@ var services = mapOf(
         "trafficmanager" to "Traffic Manager",
         "vmware" to "VMware",
         "videoanalyzer" to "Video Analyzer",
+        "videoindexer" to "Video Indexer",
         "web" to "Web"
 )
\ No newline at end of file
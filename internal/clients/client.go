@@ -13,6 +13,7 @@ import (
 	appConfiguration "github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/client"
 	applicationInsights "github.com/hashicorp/terraform-provider-azurerm/internal/services/applicationinsights/client"
 	appService "github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/client"
+	arcResourceBridge "github.com/hashicorp/terraform-provider-azurerm/internal/services/arcresourcebridge/client"
 	attestation "github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/client"
 	authorization "github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/client"
 	automation "github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/client"
@@ -21,11 +22,15 @@ import (
 	blueprints "github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/client"
 	bot "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/client"
 	cdn "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
+	chaosstudio "github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/client"
 	cognitiveServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/client"
 	communication "github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/client"
 	compute "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
+	computeFleet "github.com/hashicorp/terraform-provider-azurerm/internal/services/computefleet/client"
 	consumption "github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/client"
+	containerApps "github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/client"
 	containerServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/client"
+	containerStorage "github.com/hashicorp/terraform-provider-azurerm/internal/services/containerstorage/client"
 	cosmosdb "github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/client"
 	costmanagement "github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/client"
 	customproviders "github.com/hashicorp/terraform-provider-azurerm/internal/services/customproviders/client"
@@ -37,26 +42,33 @@ import (
 	dataprotection "github.com/hashicorp/terraform-provider-azurerm/internal/services/dataprotection/client"
 	datashare "github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/client"
 	desktopvirtualization "github.com/hashicorp/terraform-provider-azurerm/internal/services/desktopvirtualization/client"
+	devcenter "github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/client"
 	devspace "github.com/hashicorp/terraform-provider-azurerm/internal/services/devspace/client"
 	devtestlabs "github.com/hashicorp/terraform-provider-azurerm/internal/services/devtestlabs/client"
 	digitaltwins "github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/client"
 	dns "github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/client"
 	domainservices "github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/client"
+	durabletask "github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/client"
+	elasticsan "github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/client"
 	eventgrid "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/client"
 	eventhub "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/client"
+	extendedLocation "github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/client"
 	firewall "github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/client"
 	frontdoor "github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/client"
 	hdinsight "github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/client"
 	healthcare "github.com/hashicorp/terraform-provider-azurerm/internal/services/healthcare/client"
 	hpccache "github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/client"
 	hsm "github.com/hashicorp/terraform-provider-azurerm/internal/services/hsm/client"
+	hybridcompute "github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/client"
 	iotcentral "github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/client"
 	iothub "github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/client"
 	timeseriesinsights "github.com/hashicorp/terraform-provider-azurerm/internal/services/iottimeseriesinsights/client"
 	keyvault "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	kusto "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/client"
+	labservice "github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/client"
 	lighthouse "github.com/hashicorp/terraform-provider-azurerm/internal/services/lighthouse/client"
 	loadbalancers "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/client"
+	loadtestservice "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/client"
 	loganalytics "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/client"
 	logic "github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/client"
 	logz "github.com/hashicorp/terraform-provider-azurerm/internal/services/logz/client"
@@ -66,8 +78,10 @@ import (
 	managementgroup "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/client"
 	maps "github.com/hashicorp/terraform-provider-azurerm/internal/services/maps/client"
 	mariadb "github.com/hashicorp/terraform-provider-azurerm/internal/services/mariadb/client"
+	marketplace "github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/client"
 	media "github.com/hashicorp/terraform-provider-azurerm/internal/services/media/client"
 	mixedreality "github.com/hashicorp/terraform-provider-azurerm/internal/services/mixedreality/client"
+	mobilenetwork "github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/client"
 	monitor "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/client"
 	msi "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/client"
 	mssql "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/client"
@@ -75,6 +89,7 @@ import (
 	netapp "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/client"
 	network "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/client"
 	notificationhub "github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/client"
+	orbital "github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/client"
 	policy "github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/client"
 	portal "github.com/hashicorp/terraform-provider-azurerm/internal/services/portal/client"
 	postgres "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/client"
@@ -85,6 +100,7 @@ import (
 	redis "github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/client"
 	redisenterprise "github.com/hashicorp/terraform-provider-azurerm/internal/services/redisenterprise/client"
 	relay "github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/client"
+	reservations "github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/client"
 	resource "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	search "github.com/hashicorp/terraform-provider-azurerm/internal/services/search/client"
 	securityCenter "github.com/hashicorp/terraform-provider-azurerm/internal/services/securitycenter/client"
@@ -97,11 +113,13 @@ import (
 	appPlatform "github.com/hashicorp/terraform-provider-azurerm/internal/services/springcloud/client"
 	sql "github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/client"
 	storage "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	storageMover "github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/client"
 	streamAnalytics "github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/client"
 	subscription "github.com/hashicorp/terraform-provider-azurerm/internal/services/subscription/client"
 	synapse "github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/client"
 	trafficManager "github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/client"
 	videoAnalyzer "github.com/hashicorp/terraform-provider-azurerm/internal/services/videoanalyzer/client"
+	videoIndexer "github.com/hashicorp/terraform-provider-azurerm/internal/services/videoindexer/client"
 	vmware "github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/client"
 	web "github.com/hashicorp/terraform-provider-azurerm/internal/services/web/client"
 )
@@ -120,6 +138,7 @@ type Client struct {
 	AppInsights           *applicationInsights.Client
 	AppPlatform           *appPlatform.Client
 	AppService            *appService.Client
+	ArcResourceBridge     *arcResourceBridge.Client
 	Attestation           *attestation.Client
 	Authorization         *authorization.Client
 	Automation            *automation.Client
@@ -128,11 +147,15 @@ type Client struct {
 	Blueprints            *blueprints.Client
 	Bot                   *bot.Client
 	Cdn                   *cdn.Client
+	ChaosStudio           *chaosstudio.Client
 	Cognitive             *cognitiveServices.Client
 	Communication         *communication.Client
 	Compute               *compute.Client
+	ComputeFleet          *computeFleet.Client
 	Consumption           *consumption.Client
+	ContainerApps         *containerApps.Client
 	Containers            *containerServices.Client
+	ContainerStorage      *containerStorage.Client
 	Cosmos                *cosmosdb.Client
 	CostManagement        *costmanagement.Client
 	CustomProviders       *customproviders.Client
@@ -144,26 +167,33 @@ type Client struct {
 	DataProtection        *dataprotection.Client
 	DataShare             *datashare.Client
 	DesktopVirtualization *desktopvirtualization.Client
+	DevCenter             *devcenter.Client
 	DevSpace              *devspace.Client
 	DevTestLabs           *devtestlabs.Client
 	DigitalTwins          *digitaltwins.Client
 	Dns                   *dns.Client
 	DomainServices        *domainservices.Client
+	DurableTask           *durabletask.Client
+	ElasticSan            *elasticsan.Client
 	EventGrid             *eventgrid.Client
 	Eventhub              *eventhub.Client
+	ExtendedLocation      *extendedLocation.Client
 	Firewall              *firewall.Client
 	Frontdoor             *frontdoor.Client
 	HPCCache              *hpccache.Client
 	HSM                   *hsm.Client
 	HDInsight             *hdinsight.Client
 	HealthCare            *healthcare.Client
+	HybridCompute         *hybridcompute.Client
 	IoTCentral            *iotcentral.Client
 	IoTHub                *iothub.Client
 	IoTTimeSeriesInsights *timeseriesinsights.Client
 	KeyVault              *keyvault.Client
 	Kusto                 *kusto.Client
+	LabService            *labservice.Client
 	Lighthouse            *lighthouse.Client
 	LoadBalancers         *loadbalancers.Client
+	LoadTestService       *loadtestservice.Client
 	LogAnalytics          *loganalytics.Client
 	Logic                 *logic.Client
 	Logz                  *logz.Client
@@ -173,8 +203,10 @@ type Client struct {
 	ManagementGroups      *managementgroup.Client
 	Maps                  *maps.Client
 	MariaDB               *mariadb.Client
+	Marketplace           *marketplace.Client
 	Media                 *media.Client
 	MixedReality          *mixedreality.Client
+	MobileNetwork         *mobilenetwork.Client
 	Monitor               *monitor.Client
 	MSI                   *msi.Client
 	MSSQL                 *mssql.Client
@@ -182,6 +214,7 @@ type Client struct {
 	NetApp                *netapp.Client
 	Network               *network.Client
 	NotificationHubs      *notificationhub.Client
+	Orbital               *orbital.Client
 	Policy                *policy.Client
 	Portal                *portal.Client
 	Postgres              *postgres.Client
@@ -192,6 +225,7 @@ type Client struct {
 	Redis                 *redis.Client
 	RedisEnterprise       *redisenterprise.Client
 	Relay                 *relay.Client
+	Reservations          *reservations.Client
 	Resource              *resource.Client
 	Search                *search.Client
 	SecurityCenter        *securityCenter.Client
@@ -202,12 +236,14 @@ type Client struct {
 	ServiceFabricManaged  *serviceFabricManaged.Client
 	SignalR               *signalr.Client
 	Storage               *storage.Client
+	StorageMover          *storageMover.Client
 	StreamAnalytics       *streamAnalytics.Client
 	Subscription          *subscription.Client
 	Sql                   *sql.Client
 	Synapse               *synapse.Client
 	TrafficManager        *trafficManager.Client
 	VideoAnalyzer         *videoAnalyzer.Client
+	VideoIndexer          *videoIndexer.Client
 	Vmware                *vmware.Client
 	Web                   *web.Client
 }
@@ -229,6 +265,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.AppInsights = applicationInsights.NewClient(o)
 	client.AppPlatform = appPlatform.NewClient(o)
 	client.AppService = appService.NewClient(o)
+	client.ArcResourceBridge = arcResourceBridge.NewClient(o)
 	client.Attestation = attestation.NewClient(o)
 	client.Authorization = authorization.NewClient(o)
 	client.Automation = automation.NewClient(o)
@@ -237,11 +274,15 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Blueprints = blueprints.NewClient(o)
 	client.Bot = bot.NewClient(o)
 	client.Cdn = cdn.NewClient(o)
+	client.ChaosStudio = chaosstudio.NewClient(o)
 	client.Cognitive = cognitiveServices.NewClient(o)
 	client.Communication = communication.NewClient(o)
 	client.Compute = compute.NewClient(o)
+	client.ComputeFleet = computeFleet.NewClient(o)
 	client.Consumption = consumption.NewClient(o)
+	client.ContainerApps = containerApps.NewClient(o)
 	client.Containers = containerServices.NewClient(o)
+	client.ContainerStorage = containerStorage.NewClient(o)
 	client.Cosmos = cosmosdb.NewClient(o)
 	client.CostManagement = costmanagement.NewClient(o)
 	client.CustomProviders = customproviders.NewClient(o)
@@ -253,27 +294,34 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.DataProtection = dataprotection.NewClient(o)
 	client.DataShare = datashare.NewClient(o)
 	client.DesktopVirtualization = desktopvirtualization.NewClient(o)
+	client.DevCenter = devcenter.NewClient(o)
 	client.DevSpace = devspace.NewClient(o)
 	client.DevTestLabs = devtestlabs.NewClient(o)
 	client.DigitalTwins = digitaltwins.NewClient(o)
 	client.Dns = dns.NewClient(o)
 	client.DomainServices = domainservices.NewClient(o)
+	client.DurableTask = durabletask.NewClient(o)
+	client.ElasticSan = elasticsan.NewClient(o)
 	client.EventGrid = eventgrid.NewClient(o)
 	client.Eventhub = eventhub.NewClient(o)
+	client.ExtendedLocation = extendedLocation.NewClient(o)
 	client.Firewall = firewall.NewClient(o)
 	client.Frontdoor = frontdoor.NewClient(o)
 	client.HPCCache = hpccache.NewClient(o)
 	client.HSM = hsm.NewClient(o)
 	client.HDInsight = hdinsight.NewClient(o)
 	client.HealthCare = healthcare.NewClient(o)
+	client.HybridCompute = hybridcompute.NewClient(o)
 	client.IoTCentral = iotcentral.NewClient(o)
 	client.IoTHub = iothub.NewClient(o)
 	client.IoTTimeSeriesInsights = timeseriesinsights.NewClient(o)
 	client.KeyVault = keyvault.NewClient(o)
 	client.Kusto = kusto.NewClient(o)
+	client.LabService = labservice.NewClient(o)
 	client.Lighthouse = lighthouse.NewClient(o)
 	client.LogAnalytics = loganalytics.NewClient(o)
 	client.LoadBalancers = loadbalancers.NewClient(o)
+	client.LoadTestService = loadtestservice.NewClient(o)
 	client.Logic = logic.NewClient(o)
 	client.Logz = logz.NewClient(o)
 	client.MachineLearning = machinelearning.NewClient(o)
@@ -282,8 +330,10 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.ManagementGroups = managementgroup.NewClient(o)
 	client.Maps = maps.NewClient(o)
 	client.MariaDB = mariadb.NewClient(o)
+	client.Marketplace = marketplace.NewClient(o)
 	client.Media = media.NewClient(o)
 	client.MixedReality = mixedreality.NewClient(o)
+	client.MobileNetwork = mobilenetwork.NewClient(o)
 	client.Monitor = monitor.NewClient(o)
 	client.MSI = msi.NewClient(o)
 	client.MSSQL = mssql.NewClient(o)
@@ -291,6 +341,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.NetApp = netapp.NewClient(o)
 	client.Network = network.NewClient(o)
 	client.NotificationHubs = notificationhub.NewClient(o)
+	client.Orbital = orbital.NewClient(o)
 	client.Policy = policy.NewClient(o)
 	client.Portal = portal.NewClient(o)
 	client.Postgres = postgres.NewClient(o)
@@ -301,6 +352,7 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Redis = redis.NewClient(o)
 	client.RedisEnterprise = redisenterprise.NewClient(o)
 	client.Relay = relay.NewClient(o)
+	client.Reservations = reservations.NewClient(o)
 	client.Resource = resource.NewClient(o)
 	client.Search = search.NewClient(o)
 	client.SecurityCenter = securityCenter.NewClient(o)
@@ -312,11 +364,13 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.SignalR = signalr.NewClient(o)
 	client.Sql = sql.NewClient(o)
 	client.Storage = storage.NewClient(o)
+	client.StorageMover = storageMover.NewClient(o)
 	client.StreamAnalytics = streamAnalytics.NewClient(o)
 	client.Subscription = subscription.NewClient(o)
 	client.Synapse = synapse.NewClient(o)
 	client.TrafficManager = trafficManager.NewClient(o)
 	client.VideoAnalyzer = videoAnalyzer.NewClient(o)
+	client.VideoIndexer = videoIndexer.NewClient(o)
 	client.Vmware = vmware.NewClient(o)
 	client.Web = web.NewClient(o)
 
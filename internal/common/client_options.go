@@ -45,6 +45,9 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	if o.Features.ManagementLock.ReleaseDuringDependentUpdates {
+		c.Sender = autorest.DecorateSender(c.Sender, withManagementLockRelease(newManagementLockReleaseClient(o)))
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -55,6 +58,9 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	}
 }
 
+// TerraformPartnerId is Microsoft’s Terraform Partner ID
+const TerraformPartnerId = "222c6c49-1b0a-5959-a213-6608f9eb8820"
+
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
 	tfUserAgent := fmt.Sprintf("HashiCorp Terraform/%s (+https://www.terraform.io) Terraform Plugin SDK/%s", tfVersion, meta.SDKVersionString())
 
@@ -70,8 +76,7 @@ func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableT
 	// hence, send partner ID if present, otherwise send Terraform GUID
 	// unless users have opted out
 	if partnerID == "" && !disableTerraformPartnerID {
-		// Microsoft’s Terraform Partner ID is this specific GUID
-		partnerID = "222c6c49-1b0a-5959-a213-6608f9eb8820"
+		partnerID = TerraformPartnerId
 	}
 
 	if partnerID != "" {
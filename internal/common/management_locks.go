@@ -0,0 +1,308 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/sender"
+)
+
+var (
+	// managementLockMutexes ensures that each Management Lock is only released (and re-acquired) by a single request
+	// at once, such that requests which are blocked by different Management Locks don't wait on one another
+	managementLockMutexes     = map[string]*sync.Mutex{}
+	managementLockMutexesLock = &sync.Mutex{}
+)
+
+// withManagementLockRelease returns a SendDecorator which, when a request is rejected since it's Scope is locked,
+// temporarily releases the releasable Management Locks which apply to the Scope, retries the request and then
+// re-acquires the Management Locks once the (long-running) operation has completed - regardless of whether the
+// retried request succeeded
+//
+// Management Locks are only released for PUT, PATCH and POST requests - and never for DELETE requests, since preventing
+// the deletion of a Resource is the purpose of a `CanNotDelete` Management Lock
+func withManagementLockRelease(client locks.ManagementLocksClient) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if r.Method != http.MethodPut && r.Method != http.MethodPatch && r.Method != http.MethodPost {
+				return s.Do(r)
+			}
+
+			rr := autorest.NewRetriableRequest(r)
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(rr.Request())
+			if err != nil || !responseWasScopeLocked(resp) {
+				return resp, err
+			}
+
+			scope := managementLockScopeFromPath(r.URL.Path)
+			if scope == "" {
+				return resp, nil
+			}
+
+			ctx := r.Context()
+			releasable, err := findReleasableManagementLocks(ctx, client, scope)
+			if err != nil {
+				return resp, err
+			}
+			if len(releasable) == 0 {
+				return resp, nil
+			}
+
+			// the Management Locks are sorted by ID, so they're always acquired in the same order to avoid deadlocks
+			for _, lock := range releasable {
+				mutex := managementLockMutex(*lock.ID)
+				mutex.Lock()
+				defer mutex.Unlock()
+			}
+
+			released, err := releaseManagementLocks(ctx, client, releasable)
+			if err != nil {
+				return resp, err
+			}
+
+			if err := rr.Prepare(); err != nil {
+				if reacquireErr := reacquireManagementLocks(client, released); reacquireErr != nil {
+					return resp, fmt.Errorf("%+v - additionally %+v", err, reacquireErr)
+				}
+				return resp, err
+			}
+
+			log.Printf("[DEBUG] Retrying %s %q now that %d Management Lock(s) have been released", r.Method, r.URL.Path, len(released))
+			resp, err = s.Do(rr.Request())
+			if err == nil {
+				// the caller polls the operation again once the Management Locks have been re-acquired, which
+				// surfaces any error - as such this only needs to wait for the operation to complete
+				if pollErr := waitForManagementLockedOperation(ctx, client.Client, resp); pollErr != nil {
+					log.Printf("[DEBUG] Polling %s %q whilst the Management Lock(s) are released: %+v", r.Method, r.URL.Path, pollErr)
+				}
+			}
+
+			if reacquireErr := reacquireManagementLocks(client, released); reacquireErr != nil {
+				return resp, reacquireErr
+			}
+
+			return resp, err
+		})
+	}
+}
+
+func newManagementLockReleaseClient(o ClientOptions) locks.ManagementLocksClient {
+	client := locks.NewManagementLocksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	setUserAgent(&client.Client, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
+	client.Authorizer = o.ResourceManagerAuthorizer
+	client.Sender = sender.BuildSender("AzureRM")
+	return client
+}
+
+func managementLockMutex(id string) *sync.Mutex {
+	managementLockMutexesLock.Lock()
+	defer managementLockMutexesLock.Unlock()
+
+	key := strings.ToLower(id)
+	mutex, ok := managementLockMutexes[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		managementLockMutexes[key] = mutex
+	}
+
+	return mutex
+}
+
+// responseWasScopeLocked determines whether the request was rejected since the Scope is locked, the body of the
+// response is buffered such that it can still be read by the caller
+func responseWasScopeLocked(resp *http.Response) bool {
+	if resp == nil || resp.StatusCode != http.StatusConflict || resp.Body == nil {
+		return false
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var payload struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return false
+	}
+
+	return strings.EqualFold(payload.Error.Code, "ScopeLocked")
+}
+
+// managementLockScopeFromPath returns the ID of the Resource which the request path refers to - omitting any trailing
+// segment which isn't part of a Resource ID (for example an action such as `listKeys`), or an empty string when the
+// request path isn't within a Subscription
+func managementLockScopeFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") || segments[1] == "" {
+		return ""
+	}
+
+	scope := make([]string, 0)
+	for i := 0; i < len(segments); {
+		if strings.EqualFold(segments[i], "providers") {
+			// `providers/{namespace}` is followed by at least one `{type}/{name}` pair when it's part of a Resource ID
+			if i+3 >= len(segments) {
+				break
+			}
+			scope = append(scope, segments[i:i+4]...)
+			i += 4
+			continue
+		}
+
+		if i+1 >= len(segments) {
+			break
+		}
+		scope = append(scope, segments[i:i+2]...)
+		i += 2
+	}
+
+	return "/" + strings.Join(scope, "/")
+}
+
+// managementLockAppliesToScope determines whether a Management Lock at the lockScope applies to the scope - which is
+// the case when it's at the same scope or a parent scope (since Management Locks are inherited). Management Locks at
+// a child scope are never released, since these only block the scope being deleted
+func managementLockAppliesToScope(lockScope, scope string) bool {
+	lockScope = strings.ToLower(strings.TrimSuffix(lockScope, "/"))
+	scope = strings.ToLower(strings.TrimSuffix(scope, "/"))
+
+	return lockScope == scope || strings.HasPrefix(scope, lockScope+"/")
+}
+
+// findReleasableManagementLocks returns the releasable Management Locks which apply to the Scope (sorted by ID)
+func findReleasableManagementLocks(ctx context.Context, client locks.ManagementLocksClient, scope string) ([]locks.ManagementLockObject, error) {
+	// Management Locks can be inherited from a parent scope, so these are listed for the Subscription and then filtered
+	segments := strings.Split(strings.Trim(scope, "/"), "/")
+	subscriptionScope := fmt.Sprintf("/%s/%s", segments[0], segments[1])
+
+	releasable := make([]locks.ManagementLockObject, 0)
+	iterator, err := client.ListByScopeComplete(ctx, subscriptionScope, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing the Management Locks for %q: %+v", subscriptionScope, err)
+	}
+	for iterator.NotDone() {
+		lock := iterator.Value()
+		if managementLockIsReleasable(lock) {
+			if lockScope, _ := splitManagementLockId(*lock.ID); managementLockAppliesToScope(lockScope, scope) {
+				releasable = append(releasable, lock)
+			}
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing the Management Locks for %q: %+v", subscriptionScope, err)
+		}
+	}
+
+	sort.Slice(releasable, func(i, j int) bool {
+		return strings.ToLower(*releasable[i].ID) < strings.ToLower(*releasable[j].ID)
+	})
+
+	return releasable, nil
+}
+
+// releaseManagementLocks deletes the Management Locks, returning the Management Locks which were released
+func releaseManagementLocks(ctx context.Context, client locks.ManagementLocksClient, input []locks.ManagementLockObject) ([]locks.ManagementLockObject, error) {
+	released := make([]locks.ManagementLockObject, 0)
+	for _, lock := range input {
+		lockScope, lockName := splitManagementLockId(*lock.ID)
+		log.Printf("[DEBUG] Temporarily releasing Management Lock %q", *lock.ID)
+		if _, err := client.DeleteByScope(ctx, lockScope, lockName); err != nil {
+			if reacquireErr := reacquireManagementLocks(client, released); reacquireErr != nil {
+				return nil, fmt.Errorf("releasing Management Lock %q: %+v - additionally %+v", *lock.ID, err, reacquireErr)
+			}
+			return nil, fmt.Errorf("releasing Management Lock %q: %+v", *lock.ID, err)
+		}
+		released = append(released, lock)
+	}
+
+	return released, nil
+}
+
+// reacquireManagementLocks re-creates the released Management Locks using a context which is separate from the request,
+// such that the Management Locks are re-acquired even when the request has timed out or been cancelled
+func reacquireManagementLocks(client locks.ManagementLocksClient, input []locks.ManagementLockObject) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	for _, lock := range input {
+		lockScope, lockName := splitManagementLockId(*lock.ID)
+		log.Printf("[DEBUG] Re-acquiring Management Lock %q", *lock.ID)
+		payload := locks.ManagementLockObject{
+			ManagementLockProperties: lock.ManagementLockProperties,
+		}
+		if _, err := client.CreateOrUpdateByScope(ctx, lockScope, lockName, payload); err != nil {
+			return fmt.Errorf("re-acquiring the temporarily released Management Lock %q: %+v", *lock.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForManagementLockedOperation waits for the operation to complete when the response is for a long-running
+// operation, such that the Management Locks are only re-acquired once the operation has completed
+func waitForManagementLockedOperation(ctx context.Context, client autorest.Client, resp *http.Response) error {
+	if resp == nil || resp.Request == nil {
+		return nil
+	}
+	asyncOperation := resp.Header.Get("Azure-AsyncOperation") != ""
+	accepted := (resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusAccepted) && resp.Header.Get("Location") != ""
+	if !asyncOperation && !accepted {
+		return nil
+	}
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	return future.WaitForCompletionRef(ctx, client)
+}
+
+// managementLockIsReleasable determines whether the Management Lock can be temporarily released, which is the case when
+// it has an Owner whose `applicationId` is the TerraformPartnerId - the Owners of a Management Lock are free-form
+// metadata, so this is used as a marker rather than being the ID of an Application
+func managementLockIsReleasable(input locks.ManagementLockObject) bool {
+	if input.ID == nil || input.ManagementLockProperties == nil || input.ManagementLockProperties.Owners == nil {
+		return false
+	}
+
+	for _, owner := range *input.ManagementLockProperties.Owners {
+		if owner.ApplicationID != nil && strings.EqualFold(*owner.ApplicationID, TerraformPartnerId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitManagementLockId splits the ID of a Management Lock into the Scope and Name of the Management Lock
+func splitManagementLockId(input string) (scope, name string) {
+	const separator = "/providers/microsoft.authorization/locks/"
+	index := strings.LastIndex(strings.ToLower(input), separator)
+	if index == -1 {
+		return input, ""
+	}
+
+	return input[:index], input[index+len(separator):]
+}
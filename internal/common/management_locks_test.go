@@ -0,0 +1,222 @@
+package common
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestResponseWasScopeLocked(t *testing.T) {
+	testData := []struct {
+		Name       string
+		StatusCode int
+		Body       string
+		Expected   bool
+	}{
+		{
+			Name:       "Scope Locked",
+			StatusCode: http.StatusConflict,
+			Body:       `{"error":{"code":"ScopeLocked","message":"The scope cannot perform delete operation because following scope(s) are locked"}}`,
+			Expected:   true,
+		},
+		{
+			Name:       "Different Conflict",
+			StatusCode: http.StatusConflict,
+			Body:       `{"error":{"code":"AnotherOperationInProgress","message":"Another operation is in progress"}}`,
+			Expected:   false,
+		},
+		{
+			Name:       "Not a Conflict",
+			StatusCode: http.StatusBadRequest,
+			Body:       `{"error":{"code":"ScopeLocked"}}`,
+			Expected:   false,
+		},
+		{
+			Name:       "Invalid Body",
+			StatusCode: http.StatusConflict,
+			Body:       `ScopeLocked`,
+			Expected:   false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		resp := &http.Response{
+			StatusCode: v.StatusCode,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(v.Body)),
+		}
+		if actual := responseWasScopeLocked(resp); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+
+		// the body must still be readable by the caller
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("reading body: %+v", err)
+		}
+		if string(body) != v.Body {
+			t.Fatalf("Expected the body %q but got %q", v.Body, string(body))
+		}
+	}
+}
+
+func TestSplitManagementLockId(t *testing.T) {
+	testData := []struct {
+		Input         string
+		ExpectedScope string
+		ExpectedName  string
+	}{
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Authorization/locks/lock1",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			ExpectedName:  "lock1",
+		},
+		{
+			Input:         "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1/providers/microsoft.authorization/locks/lock1",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1",
+			ExpectedName:  "lock1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		scope, name := splitManagementLockId(v.Input)
+		if scope != v.ExpectedScope {
+			t.Fatalf("Expected the scope %q but got %q", v.ExpectedScope, scope)
+		}
+		if name != v.ExpectedName {
+			t.Fatalf("Expected the name %q but got %q", v.ExpectedName, name)
+		}
+	}
+}
+
+func TestManagementLockScopeFromPath(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+		},
+		{
+			// action
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/listKeys",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+		},
+		{
+			// nested child resource
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/blobServices/default/containers/container1",
+		},
+		{
+			// extension resource
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+		},
+		{
+			// subscription-level action
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Storage/register",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := managementLockScopeFromPath(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestManagementLockAppliesToScope(t *testing.T) {
+	testData := []struct {
+		LockScope string
+		Scope     string
+		Expected  bool
+	}{
+		{
+			// same scope
+			LockScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Scope:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/GROUP1",
+			Expected:  true,
+		},
+		{
+			// inherited from the parent scope
+			LockScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Scope:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected:  true,
+		},
+		{
+			// child scope
+			LockScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Scope:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Expected:  false,
+		},
+		{
+			// sibling scope with a common prefix
+			LockScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Scope:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group10",
+			Expected:  false,
+		},
+		{
+			LockScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2",
+			Scope:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected:  false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q for %q", v.LockScope, v.Scope)
+
+		if actual := managementLockAppliesToScope(v.LockScope, v.Scope); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestWithManagementLockReleaseIgnoresDelete(t *testing.T) {
+	requests := 0
+	sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{
+			StatusCode: http.StatusConflict,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"error": {"code": "ScopeLocked"}}`)),
+			Request:    r,
+		}, nil
+	})
+
+	// the Management Locks client isn't configured, so any attempt to release a Management Lock would fail
+	decorated := autorest.DecorateSender(sender, withManagementLockRelease(locks.ManagementLocksClient{}))
+
+	req, err := http.NewRequest(http.MethodDelete, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := decorated.Do(req)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected the response to be returned as-is but got status %d", resp.StatusCode)
+	}
+	if requests != 1 {
+		t.Fatalf("expected the request to be sent once but it was sent %d times", requests)
+	}
+}
@@ -6,6 +6,10 @@ func Default() UserFeatures {
 		ApiManagement: ApiManagementFeatures{
 			PurgeSoftDeleteOnDestroy: false,
 		},
+		AppConfiguration: AppConfigurationFeatures{
+			PurgeSoftDeleteOnDestroy: true,
+			RecoverSoftDeleted:       true,
+		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
 		},
@@ -22,6 +26,9 @@ func Default() UserFeatures {
 		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
 			PermanentlyDeleteOnDestroy: false,
 		},
+		ManagementLock: ManagementLockFeatures{
+			ReleaseDuringDependentUpdates: false,
+		},
 		Network: NetworkFeatures{
 			RelaxedLocking: false,
 		},
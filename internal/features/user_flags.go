@@ -2,6 +2,7 @@ package features
 
 type UserFeatures struct {
 	ApiManagement          ApiManagementFeatures
+	AppConfiguration       AppConfigurationFeatures
 	CognitiveAccount       CognitiveAccountFeatures
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
@@ -9,6 +10,7 @@ type UserFeatures struct {
 	Network                NetworkFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
+	ManagementLock         ManagementLockFeatures
 	ResourceGroup          ResourceGroupFeatures
 }
 
@@ -51,6 +53,10 @@ type LogAnalyticsWorkspaceFeatures struct {
 	PermanentlyDeleteOnDestroy bool
 }
 
+type ManagementLockFeatures struct {
+	ReleaseDuringDependentUpdates bool
+}
+
 type ResourceGroupFeatures struct {
 	PreventDeletionIfContainsResources bool
 }
@@ -58,3 +64,8 @@ type ResourceGroupFeatures struct {
 type ApiManagementFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
 }
+
+type AppConfigurationFeatures struct {
+	PurgeSoftDeleteOnDestroy bool
+	RecoverSoftDeleted       bool
+}
@@ -25,6 +25,24 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"app_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"purge_soft_delete_on_destroy": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+					"recover_soft_deleted": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
 		"cognitive_account": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -145,6 +163,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"management_lock": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*schema.Schema{
+					"release_during_dependent_updates": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
 		"resource_group": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -254,6 +286,19 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["app_configuration"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			appConfRaw := items[0].(map[string]interface{})
+			if v, ok := appConfRaw["purge_soft_delete_on_destroy"]; ok {
+				featuresMap.AppConfiguration.PurgeSoftDeleteOnDestroy = v.(bool)
+			}
+			if v, ok := appConfRaw["recover_soft_deleted"]; ok {
+				featuresMap.AppConfiguration.RecoverSoftDeleted = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["cognitive_account"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 && items[0] != nil {
@@ -370,6 +415,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["management_lock"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			managementLockRaw := items[0].(map[string]interface{})
+			if v, ok := managementLockRaw["release_during_dependent_updates"]; ok {
+				featuresMap.ManagementLock.ReleaseDuringDependentUpdates = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["resource_group"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
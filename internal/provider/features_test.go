@@ -21,6 +21,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -37,6 +41,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				ManagementLock: features.ManagementLockFeatures{
+					ReleaseDuringDependentUpdates: false,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
@@ -67,6 +74,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": true,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
@@ -89,6 +102,11 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": true,
 						},
 					},
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"release_during_dependent_updates": true,
+						},
+					},
 					"network": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": true,
@@ -124,6 +142,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
 				},
@@ -140,6 +162,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
+				ManagementLock: features.ManagementLockFeatures{
+					ReleaseDuringDependentUpdates: true,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: true,
 				},
@@ -170,6 +195,12 @@ func TestExpandFeatures(t *testing.T) {
 							"purge_soft_delete_on_destroy": false,
 						},
 					},
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
@@ -192,6 +223,11 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": false,
 						},
 					},
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"release_during_dependent_updates": false,
+						},
+					},
 					"network_locking": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": false,
@@ -227,6 +263,10 @@ func TestExpandFeatures(t *testing.T) {
 				ApiManagement: features.ApiManagementFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
 				},
@@ -243,6 +283,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				ManagementLock: features.ManagementLockFeatures{
+					ReleaseDuringDependentUpdates: false,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
@@ -340,6 +383,76 @@ func TestExpandFeaturesApiManagement(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesAppConfiguration(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Purge and Recover Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
+				},
+			},
+		},
+		{
+			Name: "Recover Only",
+			Input: []interface{}{
+				map[string]interface{}{
+					"app_configuration": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				AppConfiguration: features.AppConfigurationFeatures{
+					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.AppConfiguration, testCase.Expected.AppConfiguration) {
+			t.Fatalf("Expected %+v but got %+v", result.AppConfiguration, testCase.Expected.AppConfiguration)
+		}
+	}
+}
+
 func TestExpandFeaturesCognitiveServices(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -995,3 +1108,68 @@ func TestExpandFeaturesResourceGroup(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesManagementLock(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"management_lock": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					ReleaseDuringDependentUpdates: false,
+				},
+			},
+		},
+		{
+			Name: "Release During Dependent Updates Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"release_during_dependent_updates": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					ReleaseDuringDependentUpdates: true,
+				},
+			},
+		},
+		{
+			Name: "Release During Dependent Updates Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"release_during_dependent_updates": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					ReleaseDuringDependentUpdates: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ManagementLock, testCase.Expected.ManagementLock) {
+			t.Fatalf("Expected %+v but got %+v", result.ManagementLock, testCase.Expected.ManagementLock)
+		}
+	}
+}
@@ -69,11 +69,13 @@ func resourceApiManagementApiReleaseCreateUpdate(d *pluginsdk.ResourceData, meta
 	if err != nil {
 		return err
 	}
-	id := parse.NewApiReleaseID(subscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, name)
+	// releases belong to the API, but the `api_id` can refer to a specific revision which is made current by the release
+	apiName := apiManagementApiNameWithoutRevision(apiId.Name)
+	id := parse.NewApiReleaseID(subscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiName, name)
 	ifMatch := "*"
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, name)
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.ReleaseName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for existing %s: %+v", id, err)
@@ -92,7 +94,7 @@ func resourceApiManagementApiReleaseCreateUpdate(d *pluginsdk.ResourceData, meta
 		},
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, name, parameters, ifMatch); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.ReleaseName, parameters, ifMatch); err != nil {
 		return fmt.Errorf("creating/ updating %s: %+v", id, err)
 	}
 
@@ -122,7 +124,12 @@ func resourceApiManagementApiReleaseRead(d *pluginsdk.ResourceData, meta interfa
 	}
 	d.Set("name", id.ReleaseName)
 	if props := resp.APIReleaseContractProperties; props != nil {
-		d.Set("api_id", parse.NewApiID(subscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName).ID())
+		// the revision which was released isn't consistently returned, so a revision specified in the config is retained
+		apiId := parse.NewApiID(subscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName).ID()
+		if existing, err := parse.ApiID(d.Get("api_id").(string)); err == nil && apiManagementApiNameWithoutRevision(existing.Name) == id.ApiName {
+			apiId = parse.NewApiID(subscriptionId, id.ResourceGroup, id.ServiceName, existing.Name).ID()
+		}
+		d.Set("api_id", apiId)
 		d.Set("notes", props.Notes)
 	}
 	return nil
@@ -1,12 +1,14 @@
 package apimanagement
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -99,7 +101,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 						"content_value": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+							ValidateFunc: validate.ApiManagementApiImportContent,
 						},
 
 						"content_format": {
@@ -360,11 +362,11 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 
 		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, apiParams, "")
 		if err != nil {
-			return fmt.Errorf("creating/updating %s: %+v", id, err)
+			return fmt.Errorf("importing %s: %+v%s", id, err, apiManagementApiImportErrorDetails(err))
 		}
 
 		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
+			return fmt.Errorf("waiting on import of %s: %+v%s", id, err, apiManagementApiImportErrorDetails(err))
 		}
 	}
 
@@ -444,7 +446,15 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 		revision = strings.Split(id.Name, "=")[1]
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	// the ID refers to the current revision of the API, which changes when another revision is released - so once the
+	// revision is known we read that specific revision to avoid the new current revision forcing this one to be recreated
+	apiId := id.Name
+	if v := d.Get("revision").(string); revision == "" && v != "" {
+		revision = v
+		apiId = fmt.Sprintf("%s;rev=%s", name, revision)
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, apiId)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] API %q Revision %q (API Management Service %q / Resource Group %q) does not exist - removing from state!", name, revision, id.ServiceName, id.ResourceGroup)
@@ -659,3 +669,35 @@ func flattenApiManagementOpenIDAuthentication(input *apimanagement.OpenIDAuthent
 
 	return []interface{}{result}
 }
+
+// apiManagementApiImportErrorDetails returns the individual validation errors reported by the service when an import
+// is rejected, since these are nested within the error details and otherwise difficult to find in the error message
+func apiManagementApiImportErrorDetails(err error) string {
+	var serviceError *autorestAzure.ServiceError
+	var requestError *autorestAzure.RequestError
+	switch {
+	case errors.As(err, &serviceError):
+	case errors.As(err, &requestError) && requestError.ServiceError != nil:
+		serviceError = requestError.ServiceError
+	default:
+		return ""
+	}
+
+	details := make([]string, 0)
+	for _, detail := range serviceError.Details {
+		message, ok := detail["message"].(string)
+		if !ok || message == "" {
+			continue
+		}
+		if target, ok := detail["target"].(string); ok && target != "" {
+			message = fmt.Sprintf("%s: %s", target, message)
+		}
+		details = append(details, fmt.Sprintf("  * %s", message))
+	}
+
+	if len(details) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("\n\nThe import was rejected with the following validation errors:\n%s", strings.Join(details, "\n"))
+}
@@ -0,0 +1,244 @@
+package apimanagement
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceApiManagementApiRevision() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApiManagementApiRevisionCreate,
+		Read:   resourceApiManagementApiRevisionRead,
+		Update: resourceApiManagementApiRevisionUpdate,
+		Delete: resourceApiManagementApiRevisionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			apiId, err := parse.ApiID(id)
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(apiId.Name, ";rev=") {
+				return fmt.Errorf("expected the API name in %q to include the revision in the format `{apiName};rev={revision}`", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"api_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiID,
+			},
+
+			"revision": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"revision_description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_api_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiID,
+			},
+
+			"is_current": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"is_online": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceApiManagementApiRevisionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.ApiClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	apiId, err := parse.ApiID(d.Get("api_id").(string))
+	if err != nil {
+		return err
+	}
+
+	apiName := apiManagementApiNameWithoutRevision(apiId.Name)
+	id := parse.NewApiID(apiId.SubscriptionId, apiId.ResourceGroup, apiId.ServiceName, fmt.Sprintf("%s;rev=%s", apiName, d.Get("revision").(string)))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_api_management_api_revision", id.ID())
+	}
+
+	// when no source is specified the revision is cloned from the current revision of the API
+	sourceApiId := parse.NewApiID(apiId.SubscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiName)
+	if v := d.Get("source_api_id").(string); v != "" {
+		source, err := parse.ApiID(v)
+		if err != nil {
+			return err
+		}
+		sourceApiId = *source
+	}
+
+	source, err := client.Get(ctx, sourceApiId.ResourceGroup, sourceApiId.ServiceName, sourceApiId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving source %s: %+v", sourceApiId, err)
+	}
+	if source.APIContractProperties == nil {
+		return fmt.Errorf("retrieving source %s: `properties` was nil", sourceApiId)
+	}
+
+	params := apimanagement.APICreateOrUpdateParameter{
+		APICreateOrUpdateProperties: &apimanagement.APICreateOrUpdateProperties{
+			Path:        source.APIContractProperties.Path,
+			SourceAPIID: utils.String(sourceApiId.ID()),
+		},
+	}
+
+	if v := d.Get("revision_description").(string); v != "" {
+		params.APICreateOrUpdateProperties.APIRevisionDescription = utils.String(v)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.Name, params, "")
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceApiManagementApiRevisionRead(d, meta)
+}
+
+func resourceApiManagementApiRevisionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.ApiClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApiID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("api_id", parse.NewApiID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, apiManagementApiNameWithoutRevision(id.Name)).ID())
+
+	if props := resp.APIContractProperties; props != nil {
+		d.Set("revision", props.APIRevision)
+		d.Set("revision_description", props.APIRevisionDescription)
+		d.Set("is_current", props.IsCurrent)
+		d.Set("is_online", props.IsOnline)
+	}
+
+	return nil
+}
+
+func resourceApiManagementApiRevisionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.ApiClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApiID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("revision_description") {
+		params := apimanagement.APIUpdateContract{
+			APIContractUpdateProperties: &apimanagement.APIContractUpdateProperties{
+				APIRevisionDescription: utils.String(d.Get("revision_description").(string)),
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.ServiceName, id.Name, params, "*"); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceApiManagementApiRevisionRead(d, meta)
+}
+
+func resourceApiManagementApiRevisionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.ApiClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApiID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the current revision of an API can't be deleted, it's removed along with the API itself
+	existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if props := existing.APIContractProperties; props != nil && props.IsCurrent != nil && *props.IsCurrent {
+		log.Printf("[DEBUG] %s is the current revision of the API - it will be removed when the API is deleted", *id)
+		return nil
+	}
+
+	if resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, "*", nil); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+// apiManagementApiNameWithoutRevision returns the name of the API from an API ID which may refer to a specific revision
+func apiManagementApiNameWithoutRevision(name string) string {
+	return strings.Split(name, ";")[0]
+}
@@ -0,0 +1,192 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementApiRevisionResource struct{}
+
+func TestAccApiManagementApiRevision_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_revision", "test")
+	r := ApiManagementApiRevisionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("revision").HasValue("2"),
+				check.That(data.ResourceName).Key("is_current").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementApiRevision_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_revision", "test")
+	r := ApiManagementApiRevisionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementApiRevision_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_revision", "test")
+	r := ApiManagementApiRevisionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("revision_description").HasValue("Adding the v2 operations"),
+			),
+		},
+		data.ImportStep("source_api_id"),
+	})
+}
+
+func TestAccApiManagementApiRevision_fromRevision(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_revision", "test")
+	r := ApiManagementApiRevisionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fromRevision(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("revision").HasValue("3"),
+			),
+		},
+		data.ImportStep("source_api_id"),
+	})
+}
+
+func TestAccApiManagementApiRevision_release(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_revision", "test")
+	r := ApiManagementApiRevisionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.release(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// the revision is made current by the release, which is created after the revision
+			Config: r.release(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("is_current").HasValue("true"),
+				check.That("azurerm_api_management_api.test").Key("revision").HasValue("1"),
+				check.That("azurerm_api_management_api.test").Key("is_current").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementApiRevisionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApiID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.ApiClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ApiManagementApiRevisionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_revision" "test" {
+  api_id   = azurerm_api_management_api.test.id
+  revision = "2"
+}
+`, ApiManagementApiResource{}.basic(data))
+}
+
+func (r ApiManagementApiRevisionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_revision" "import" {
+  api_id   = azurerm_api_management_api_revision.test.api_id
+  revision = azurerm_api_management_api_revision.test.revision
+}
+`, r.basic(data))
+}
+
+func (r ApiManagementApiRevisionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_revision" "test" {
+  api_id               = azurerm_api_management_api.test.id
+  revision             = "2"
+  source_api_id        = "${azurerm_api_management_api.test.id};rev=1"
+  revision_description = "Adding the v2 operations"
+}
+`, ApiManagementApiResource{}.basic(data))
+}
+
+func (r ApiManagementApiRevisionResource) fromRevision(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_revision" "source" {
+  api_id   = azurerm_api_management_api.test.id
+  revision = "2"
+}
+
+resource "azurerm_api_management_api_revision" "test" {
+  api_id        = azurerm_api_management_api.test.id
+  revision      = "3"
+  source_api_id = azurerm_api_management_api_revision.source.id
+}
+`, ApiManagementApiResource{}.basic(data))
+}
+
+func (r ApiManagementApiRevisionResource) release(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_release" "test" {
+  name   = "acctest-ApiRelease-%d"
+  api_id = azurerm_api_management_api_revision.test.id
+  notes  = "Making revision 2 current"
+}
+`, r.basic(data), data.RandomInteger)
+}
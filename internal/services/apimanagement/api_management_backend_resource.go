@@ -1,17 +1,20 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/backend"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -115,14 +118,143 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 			},
 
 			"protocol": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"pool"},
 				ValidateFunc: validation.StringInSlice([]string{
-					string(apimanagement.BackendProtocolHTTP),
-					string(apimanagement.BackendProtocolSoap),
+					string(backend.BackendProtocolHttp),
+					string(backend.BackendProtocolSoap),
 				}, false),
 			},
 
+			"pool": {
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"circuit_breaker_rule", "credentials", "proxy", "service_fabric_cluster", "tls"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"service": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 30,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"id": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validate.BackendID,
+									},
+
+									"priority": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+
+									"weight": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"circuit_breaker_rule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				// the API only supports a single rule at this time
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"trip_duration": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: helpersValidate.ISO8601Duration,
+						},
+
+						"accept_retry_after_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"failure_condition": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"interval_duration": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: helpersValidate.ISO8601Duration,
+									},
+
+									"count": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										ExactlyOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.count", "circuit_breaker_rule.0.failure_condition.0.percentage"},
+									},
+
+									"percentage": {
+										Type:         pluginsdk.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+										ExactlyOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.count", "circuit_breaker_rule.0.failure_condition.0.percentage"},
+									},
+
+									"error_reasons": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 200),
+										},
+										AtLeastOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.error_reasons", "circuit_breaker_rule.0.failure_condition.0.status_code_range"},
+									},
+
+									"status_code_range": {
+										Type:     pluginsdk.TypeList,
+										Optional: true,
+										MaxItems: 10,
+										Elem: &pluginsdk.Resource{
+											Schema: map[string]*pluginsdk.Schema{
+												"min": {
+													Type:         pluginsdk.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(200, 599),
+												},
+
+												"max": {
+													Type:         pluginsdk.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(200, 599),
+												},
+											},
+										},
+										AtLeastOneOf: []string{"circuit_breaker_rule.0.failure_condition.0.error_reasons", "circuit_breaker_rule.0.failure_condition.0.status_code_range"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"proxy": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -245,11 +377,24 @@ func resourceApiManagementBackend() *pluginsdk.Resource {
 			},
 
 			"url": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"pool"},
+				ValidateFunc:  validation.StringIsNotEmpty,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				// a pool distributes the requests across other backends, so only a single backend has a protocol and url
+				if len(d.Get("pool").([]interface{})) == 0 {
+					if (d.NewValueKnown("protocol") && d.Get("protocol").(string) == "") || (d.NewValueKnown("url") && d.Get("url").(string) == "") {
+						return fmt.Errorf("`protocol` and `url` must be specified when `pool` isn't specified")
+					}
+				}
+				return nil
+			}),
+		),
 	}
 }
 
@@ -260,46 +405,50 @@ func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta in
 	defer cancel()
 
 	id := parse.NewBackendID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), d.Get("name").(string))
+	backendId := backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+		existing, err := client.Get(ctx, backendId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %s", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_api_management_backend", *existing.ID)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_api_management_backend", id.ID())
 		}
 	}
 
-	credentialsRaw := d.Get("credentials").([]interface{})
-	credentials := expandApiManagementBackendCredentials(credentialsRaw)
-	protocol := d.Get("protocol").(string)
-	proxyRaw := d.Get("proxy").([]interface{})
-	proxy := expandApiManagementBackendProxy(proxyRaw)
-	tlsRaw := d.Get("tls").([]interface{})
-	tls := expandApiManagementBackendTls(tlsRaw)
-	url := d.Get("url").(string)
-
-	backendContract := apimanagement.BackendContract{
-		BackendContractProperties: &apimanagement.BackendContractProperties{
-			Credentials: credentials,
-			Protocol:    apimanagement.BackendProtocol(protocol),
-			Proxy:       proxy,
-			TLS:         tls,
-			URL:         utils.String(url),
-		},
+	backendContract := backend.BackendContract{
+		Properties: &backend.BackendContractProperties{},
+	}
+	props := backendContract.Properties
+
+	if poolRaw := d.Get("pool").([]interface{}); len(poolRaw) > 0 {
+		backendType := backend.BackendTypePool
+		props.Type = &backendType
+		props.Pool = expandApiManagementBackendPool(poolRaw)
+	} else {
+		protocol := backend.BackendProtocol(d.Get("protocol").(string))
+		backendType := backend.BackendTypeSingle
+		props.Type = &backendType
+		props.Protocol = &protocol
+		props.Url = utils.String(d.Get("url").(string))
+		props.Credentials = expandApiManagementBackendCredentials(d.Get("credentials").([]interface{}))
+		props.Proxy = expandApiManagementBackendProxy(d.Get("proxy").([]interface{}))
+		props.Tls = expandApiManagementBackendTls(d.Get("tls").([]interface{}))
+		props.CircuitBreaker = expandApiManagementBackendCircuitBreaker(d.Get("circuit_breaker_rule").([]interface{}))
 	}
+
 	if description, ok := d.GetOk("description"); ok {
-		backendContract.BackendContractProperties.Description = utils.String(description.(string))
+		props.Description = utils.String(description.(string))
 	}
 	if resourceID, ok := d.GetOk("resource_id"); ok {
-		backendContract.BackendContractProperties.ResourceID = utils.String(resourceID.(string))
+		props.ResourceId = utils.String(resourceID.(string))
 	}
 	if title, ok := d.GetOk("title"); ok {
-		backendContract.BackendContractProperties.Title = utils.String(title.(string))
+		props.Title = utils.String(title.(string))
 	}
 
 	if serviceFabricClusterRaw, ok := d.GetOk("service_fabric_cluster"); ok {
@@ -307,12 +456,12 @@ func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta in
 		if err != nil {
 			return err
 		}
-		backendContract.BackendContractProperties.Properties = &apimanagement.BackendProperties{
+		props.Properties = &backend.BackendProperties{
 			ServiceFabricCluster: serviceFabricCluster,
 		}
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, id.Name, backendContract, ""); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, backendId, backendContract); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
@@ -329,9 +478,9 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := client.Get(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s does not exist - removing from state!", *id)
 			d.SetId("")
 			return nil
@@ -344,12 +493,17 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("api_management_name", id.ServiceName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	if props := resp.BackendContractProperties; props != nil {
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
 		d.Set("description", props.Description)
-		d.Set("protocol", string(props.Protocol))
-		d.Set("resource_id", props.ResourceID)
+		protocol := ""
+		if props.Protocol != nil {
+			protocol = string(*props.Protocol)
+		}
+		d.Set("protocol", protocol)
+		d.Set("resource_id", props.ResourceId)
 		d.Set("title", props.Title)
-		d.Set("url", props.URL)
+		d.Set("url", props.Url)
 		if err := d.Set("credentials", flattenApiManagementBackendCredentials(props.Credentials)); err != nil {
 			return fmt.Errorf("setting `credentials`: %s", err)
 		}
@@ -361,9 +515,15 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 				return fmt.Errorf("setting `service_fabric_cluster`: %s", err)
 			}
 		}
-		if err := d.Set("tls", flattenApiManagementBackendTls(props.TLS)); err != nil {
+		if err := d.Set("tls", flattenApiManagementBackendTls(props.Tls)); err != nil {
 			return fmt.Errorf("setting `tls`: %s", err)
 		}
+		if err := d.Set("pool", flattenApiManagementBackendPool(props.Pool)); err != nil {
+			return fmt.Errorf("setting `pool`: %s", err)
+		}
+		if err := d.Set("circuit_breaker_rule", flattenApiManagementBackendCircuitBreaker(props.CircuitBreaker)); err != nil {
+			return fmt.Errorf("setting `circuit_breaker_rule`: %s", err)
+		}
 	}
 
 	return nil
@@ -379,8 +539,8 @@ func resourceApiManagementBackendDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	if resp, err := client.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, ""); err != nil {
-		if !utils.ResponseWasNotFound(resp) {
+	if resp, err := client.Delete(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting %s: %s", *id, err)
 		}
 	}
@@ -388,12 +548,12 @@ func resourceApiManagementBackendDelete(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
-func expandApiManagementBackendCredentials(input []interface{}) *apimanagement.BackendCredentialsContract {
+func expandApiManagementBackendCredentials(input []interface{}) *backend.BackendCredentialsContract {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	contract := apimanagement.BackendCredentialsContract{}
+	contract := backend.BackendCredentialsContract{}
 	if authorizationRaw := v["authorization"]; authorizationRaw != nil {
 		authorization := expandApiManagementBackendCredentialsAuthorization(authorizationRaw.([]interface{}))
 		contract.Authorization = authorization
@@ -405,22 +565,20 @@ func expandApiManagementBackendCredentials(input []interface{}) *apimanagement.B
 		}
 	}
 	if headerRaw := v["header"]; headerRaw != nil {
-		header := expandApiManagementBackendCredentialsObject(headerRaw.(map[string]interface{}))
-		contract.Header = *header
+		contract.Header = expandApiManagementBackendCredentialsObject(headerRaw.(map[string]interface{}))
 	}
 	if queryRaw := v["query"]; queryRaw != nil {
-		query := expandApiManagementBackendCredentialsObject(queryRaw.(map[string]interface{}))
-		contract.Query = *query
+		contract.Query = expandApiManagementBackendCredentialsObject(queryRaw.(map[string]interface{}))
 	}
 	return &contract
 }
 
-func expandApiManagementBackendCredentialsAuthorization(input []interface{}) *apimanagement.BackendAuthorizationHeaderCredentials {
+func expandApiManagementBackendCredentialsAuthorization(input []interface{}) *backend.BackendAuthorizationHeaderCredentials {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	credentials := apimanagement.BackendAuthorizationHeaderCredentials{}
+	credentials := backend.BackendAuthorizationHeaderCredentials{}
 	if parameter := v["parameter"]; parameter != nil {
 		credentials.Parameter = utils.String(parameter.(string))
 	}
@@ -438,17 +596,17 @@ func expandApiManagementBackendCredentialsObject(input map[string]interface{}) *
 	return &output
 }
 
-func expandApiManagementBackendProxy(input []interface{}) *apimanagement.BackendProxyContract {
+func expandApiManagementBackendProxy(input []interface{}) *backend.BackendProxyContract {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	contract := apimanagement.BackendProxyContract{}
+	contract := backend.BackendProxyContract{}
 	if password := v["password"]; password != nil {
 		contract.Password = utils.String(password.(string))
 	}
 	if url := v["url"]; url != nil {
-		contract.URL = utils.String(url.(string))
+		contract.Url = utils.String(url.(string))
 	}
 	if username := v["username"]; username != nil {
 		contract.Username = utils.String(username.(string))
@@ -456,16 +614,16 @@ func expandApiManagementBackendProxy(input []interface{}) *apimanagement.Backend
 	return &contract
 }
 
-func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error, *apimanagement.BackendServiceFabricClusterProperties) {
+func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error, *backend.BackendServiceFabricClusterProperties) {
 	if len(input) == 0 {
 		return nil, nil
 	}
 	v := input[0].(map[string]interface{})
 	managementEndpoints := v["management_endpoints"].(*pluginsdk.Set).List()
-	maxPartitionResolutionRetries := int32(v["max_partition_resolution_retries"].(int))
-	properties := apimanagement.BackendServiceFabricClusterProperties{
-		ManagementEndpoints:           utils.ExpandStringSlice(managementEndpoints),
-		MaxPartitionResolutionRetries: utils.Int32(maxPartitionResolutionRetries),
+	maxPartitionResolutionRetries := int64(v["max_partition_resolution_retries"].(int))
+	properties := backend.BackendServiceFabricClusterProperties{
+		ManagementEndpoints:           *utils.ExpandStringSlice(managementEndpoints),
+		MaxPartitionResolutionRetries: utils.Int64(maxPartitionResolutionRetries),
 	}
 
 	if v2, ok := v["client_certificate_thumbprint"].(string); ok && v2 != "" {
@@ -473,10 +631,10 @@ func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error,
 	}
 
 	if v2, ok := v["client_certificate_id"].(string); ok && v2 != "" {
-		properties.ClientCertificateID = utils.String(v2)
+		properties.ClientCertificateId = utils.String(v2)
 	}
 
-	if properties.ClientCertificateID == nil && properties.ClientCertificatethumbprint == nil {
+	if properties.ClientCertificateId == nil && properties.ClientCertificatethumbprint == nil {
 		return fmt.Errorf("at least one of `client_certificate_thumbprint` and `client_certificate_id` must be set"), nil
 	}
 
@@ -496,11 +654,11 @@ func expandApiManagementBackendServiceFabricCluster(input []interface{}) (error,
 	return nil, &properties
 }
 
-func expandApiManagementBackendServiceFabricClusterServerX509Names(input []interface{}) *[]apimanagement.X509CertificateName {
-	results := make([]apimanagement.X509CertificateName, 0)
+func expandApiManagementBackendServiceFabricClusterServerX509Names(input []interface{}) *[]backend.X509CertificateName {
+	results := make([]backend.X509CertificateName, 0)
 	for _, certificateName := range input {
 		v := certificateName.(map[string]interface{})
-		result := apimanagement.X509CertificateName{
+		result := backend.X509CertificateName{
 			IssuerCertificateThumbprint: utils.String(v["issuer_certificate_thumbprint"].(string)),
 			Name:                        utils.String(v["name"].(string)),
 		}
@@ -509,12 +667,12 @@ func expandApiManagementBackendServiceFabricClusterServerX509Names(input []inter
 	return &results
 }
 
-func expandApiManagementBackendTls(input []interface{}) *apimanagement.BackendTLSProperties {
+func expandApiManagementBackendTls(input []interface{}) *backend.BackendTlsProperties {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	properties := apimanagement.BackendTLSProperties{}
+	properties := backend.BackendTlsProperties{}
 	if validateCertificateChain := v["validate_certificate_chain"]; validateCertificateChain != nil {
 		properties.ValidateCertificateChain = utils.Bool(validateCertificateChain.(bool))
 	}
@@ -524,7 +682,7 @@ func expandApiManagementBackendTls(input []interface{}) *apimanagement.BackendTL
 	return &properties
 }
 
-func flattenApiManagementBackendCredentials(input *apimanagement.BackendCredentialsContract) []interface{} {
+func flattenApiManagementBackendCredentials(input *backend.BackendCredentialsContract) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -539,18 +697,18 @@ func flattenApiManagementBackendCredentials(input *apimanagement.BackendCredenti
 	return append(results, result)
 }
 
-func flattenApiManagementBackendCredentialsObject(input map[string][]string) map[string]interface{} {
+func flattenApiManagementBackendCredentialsObject(input *map[string][]string) map[string]interface{} {
 	results := make(map[string]interface{})
 	if input == nil {
 		return results
 	}
-	for k, v := range input {
+	for k, v := range *input {
 		results[k] = strings.Join(v, ",")
 	}
 	return results
 }
 
-func flattenApiManagementBackendCredentialsAuthorization(input *apimanagement.BackendAuthorizationHeaderCredentials) []interface{} {
+func flattenApiManagementBackendCredentialsAuthorization(input *backend.BackendAuthorizationHeaderCredentials) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -565,7 +723,7 @@ func flattenApiManagementBackendCredentialsAuthorization(input *apimanagement.Ba
 	return append(results, result)
 }
 
-func flattenApiManagementBackendProxy(input *apimanagement.BackendProxyContract) []interface{} {
+func flattenApiManagementBackendProxy(input *backend.BackendProxyContract) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -574,7 +732,7 @@ func flattenApiManagementBackendProxy(input *apimanagement.BackendProxyContract)
 	if password := input.Password; password != nil {
 		result["password"] = *password
 	}
-	if url := input.URL; url != nil {
+	if url := input.Url; url != nil {
 		result["url"] = *url
 	}
 	if username := input.Username; username != nil {
@@ -583,7 +741,7 @@ func flattenApiManagementBackendProxy(input *apimanagement.BackendProxyContract)
 	return append(results, result)
 }
 
-func flattenApiManagementBackendServiceFabricCluster(input *apimanagement.BackendServiceFabricClusterProperties) []interface{} {
+func flattenApiManagementBackendServiceFabricCluster(input *backend.BackendServiceFabricClusterProperties) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -593,13 +751,11 @@ func flattenApiManagementBackendServiceFabricCluster(input *apimanagement.Backen
 		result["client_certificate_thumbprint"] = *clientCertificatethumbprint
 	}
 
-	if input.ClientCertificateID != nil {
-		result["client_certificate_id"] = *input.ClientCertificateID
+	if input.ClientCertificateId != nil {
+		result["client_certificate_id"] = *input.ClientCertificateId
 	}
 
-	if managementEndpoints := input.ManagementEndpoints; managementEndpoints != nil {
-		result["management_endpoints"] = *managementEndpoints
-	}
+	result["management_endpoints"] = input.ManagementEndpoints
 	if maxPartitionResolutionRetries := input.MaxPartitionResolutionRetries; maxPartitionResolutionRetries != nil {
 		result["max_partition_resolution_retries"] = int(*maxPartitionResolutionRetries)
 	}
@@ -610,7 +766,7 @@ func flattenApiManagementBackendServiceFabricCluster(input *apimanagement.Backen
 	return append(results, result)
 }
 
-func flattenApiManagementBackendServiceFabricClusterServerX509Names(input *[]apimanagement.X509CertificateName) []interface{} {
+func flattenApiManagementBackendServiceFabricClusterServerX509Names(input *[]backend.X509CertificateName) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -628,7 +784,7 @@ func flattenApiManagementBackendServiceFabricClusterServerX509Names(input *[]api
 	return results
 }
 
-func flattenApiManagementBackendTls(input *apimanagement.BackendTLSProperties) []interface{} {
+func flattenApiManagementBackendTls(input *backend.BackendTlsProperties) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -642,3 +798,170 @@ func flattenApiManagementBackendTls(input *apimanagement.BackendTLSProperties) [
 	}
 	return append(results, result)
 }
+
+func expandApiManagementBackendPool(input []interface{}) *backend.BackendPool {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	services := make([]backend.BackendPoolItem, 0)
+	for _, raw := range v["service"].([]interface{}) {
+		service := raw.(map[string]interface{})
+		item := backend.BackendPoolItem{
+			Id: service["id"].(string),
+		}
+		if priority := service["priority"].(int); priority != 0 {
+			item.Priority = utils.Int64(int64(priority))
+		}
+		if weight := service["weight"].(int); weight != 0 {
+			item.Weight = utils.Int64(int64(weight))
+		}
+		services = append(services, item)
+	}
+
+	return &backend.BackendPool{
+		Services: &services,
+	}
+}
+
+func expandApiManagementBackendCircuitBreaker(input []interface{}) *backend.BackendCircuitBreaker {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	rules := make([]backend.CircuitBreakerRule, 0)
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		rules = append(rules, backend.CircuitBreakerRule{
+			AcceptRetryAfter: utils.Bool(v["accept_retry_after_enabled"].(bool)),
+			FailureCondition: expandApiManagementBackendCircuitBreakerFailureCondition(v["failure_condition"].([]interface{})),
+			Name:             utils.String(v["name"].(string)),
+			TripDuration:     utils.String(v["trip_duration"].(string)),
+		})
+	}
+
+	return &backend.BackendCircuitBreaker{
+		Rules: &rules,
+	}
+}
+
+func expandApiManagementBackendCircuitBreakerFailureCondition(input []interface{}) *backend.CircuitBreakerFailureCondition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	condition := backend.CircuitBreakerFailureCondition{
+		Interval: utils.String(v["interval_duration"].(string)),
+	}
+	if count := v["count"].(int); count != 0 {
+		condition.Count = utils.Int64(int64(count))
+	}
+	if percentage := v["percentage"].(int); percentage != 0 {
+		condition.Percentage = utils.Int64(int64(percentage))
+	}
+	if errorReasons := v["error_reasons"].([]interface{}); len(errorReasons) > 0 {
+		condition.ErrorReasons = utils.ExpandStringSlice(errorReasons)
+	}
+	if statusCodeRangesRaw := v["status_code_range"].([]interface{}); len(statusCodeRangesRaw) > 0 {
+		statusCodeRanges := make([]backend.FailureStatusCodeRange, 0)
+		for _, item := range statusCodeRangesRaw {
+			statusCodeRange := item.(map[string]interface{})
+			statusCodeRanges = append(statusCodeRanges, backend.FailureStatusCodeRange{
+				Min: utils.Int64(int64(statusCodeRange["min"].(int))),
+				Max: utils.Int64(int64(statusCodeRange["max"].(int))),
+			})
+		}
+		condition.StatusCodeRanges = &statusCodeRanges
+	}
+
+	return &condition
+}
+
+func flattenApiManagementBackendPool(input *backend.BackendPool) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Services == nil {
+		return results
+	}
+
+	services := make([]interface{}, 0)
+	for _, item := range *input.Services {
+		priority := 0
+		if item.Priority != nil {
+			priority = int(*item.Priority)
+		}
+		weight := 0
+		if item.Weight != nil {
+			weight = int(*item.Weight)
+		}
+		services = append(services, map[string]interface{}{
+			"id":       item.Id,
+			"priority": priority,
+			"weight":   weight,
+		})
+	}
+
+	return append(results, map[string]interface{}{
+		"service": services,
+	})
+}
+
+func flattenApiManagementBackendCircuitBreaker(input *backend.BackendCircuitBreaker) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.Rules == nil {
+		return results
+	}
+
+	for _, rule := range *input.Rules {
+		results = append(results, map[string]interface{}{
+			"name":                       utils.NormalizeNilableString(rule.Name),
+			"trip_duration":              utils.NormalizeNilableString(rule.TripDuration),
+			"accept_retry_after_enabled": rule.AcceptRetryAfter != nil && *rule.AcceptRetryAfter,
+			"failure_condition":          flattenApiManagementBackendCircuitBreakerFailureCondition(rule.FailureCondition),
+		})
+	}
+	return results
+}
+
+func flattenApiManagementBackendCircuitBreakerFailureCondition(input *backend.CircuitBreakerFailureCondition) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	count := 0
+	if input.Count != nil {
+		count = int(*input.Count)
+	}
+	percentage := 0
+	if input.Percentage != nil {
+		percentage = int(*input.Percentage)
+	}
+
+	statusCodeRanges := make([]interface{}, 0)
+	if input.StatusCodeRanges != nil {
+		for _, item := range *input.StatusCodeRanges {
+			min := 0
+			if item.Min != nil {
+				min = int(*item.Min)
+			}
+			max := 0
+			if item.Max != nil {
+				max = int(*item.Max)
+			}
+			statusCodeRanges = append(statusCodeRanges, map[string]interface{}{
+				"min": min,
+				"max": max,
+			})
+		}
+	}
+
+	return append(results, map[string]interface{}{
+		"interval_duration": utils.NormalizeNilableString(input.Interval),
+		"count":             count,
+		"percentage":        percentage,
+		"error_reasons":     utils.FlattenStringSlice(input.ErrorReasons),
+		"status_code_range": statusCodeRanges,
+	})
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2024-05-01/backend"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -188,18 +190,70 @@ func TestAccApiManagementBackend_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementBackend_circuitBreaker(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
+	r := ApiManagementAuthorizationBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.circuitBreaker(data, "count = 3", 500),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.circuitBreaker(data, "percentage = 50", 429),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "circuitbreaker"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementBackend_pool(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_backend", "test")
+	r := ApiManagementAuthorizationBackendResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pool(data, 1, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pool.0.service.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.pool(data, 1, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (ApiManagementAuthorizationBackendResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.BackendID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := clients.ApiManagement.BackendClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	resp, err := clients.ApiManagement.BackendClient.Get(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
 }
 
 func (r ApiManagementAuthorizationBackendResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
@@ -208,9 +262,9 @@ func (r ApiManagementAuthorizationBackendResource) Destroy(ctx context.Context,
 		return nil, err
 	}
 
-	resp, err := client.ApiManagement.BackendClient.Delete(ctx, id.ResourceGroup, id.ServiceName, id.Name, "")
+	resp, err := client.ApiManagement.BackendClient.Delete(ctx, backend.NewBackendID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(true), nil
 		}
 		return nil, fmt.Errorf("deleting Backend: %+v", err)
@@ -450,3 +504,77 @@ resource "azurerm_api_management_backend" "test" {
 }
 `, r.template(data, "all"), data.RandomInteger)
 }
+
+func (r ApiManagementAuthorizationBackendResource) circuitBreaker(data acceptance.TestData, threshold string, minStatusCode int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://acctest"
+
+  circuit_breaker_rule {
+    name                       = "acctest-rule"
+    trip_duration              = "PT1M"
+    accept_retry_after_enabled = true
+
+    failure_condition {
+      %s
+      interval_duration = "PT5M"
+      error_reasons     = ["BackendConnectionFailure", "Timeout"]
+
+      status_code_range {
+        min = %d
+        max = 599
+      }
+    }
+  }
+}
+`, r.template(data, "circuitbreaker"), data.RandomInteger, threshold, minStatusCode)
+}
+
+func (r ApiManagementAuthorizationBackendResource) pool(data acceptance.TestData, firstPriority, secondPriority int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_backend" "first" {
+  name                = "acctestapi-first-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://acctest-first"
+}
+
+resource "azurerm_api_management_backend" "second" {
+  name                = "acctestapi-second-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  protocol            = "http"
+  url                 = "https://acctest-second"
+}
+
+resource "azurerm_api_management_backend" "test" {
+  name                = "acctestapi-pool-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  description         = "load-balanced pool"
+
+  pool {
+    service {
+      id       = azurerm_api_management_backend.first.id
+      priority = %[3]d
+      weight   = 50
+    }
+
+    service {
+      id       = azurerm_api_management_backend.second.id
+      priority = %[4]d
+      weight   = 50
+    }
+  }
+}
+`, r.template(data, "pool"), data.RandomInteger, firstPriority, secondPriority)
+}
@@ -43,6 +43,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_api_management_api_operation_policy":        resourceApiManagementApiOperationPolicy(),
 		"azurerm_api_management_api_policy":                  resourceApiManagementApiPolicy(),
 		"azurerm_api_management_api_release":                 resourceApiManagementApiRelease(),
+		"azurerm_api_management_api_revision":                resourceApiManagementApiRevision(),
 		"azurerm_api_management_api_schema":                  resourceApiManagementApiSchema(),
 		"azurerm_api_management_api_version_set":             resourceApiManagementApiVersionSet(),
 		"azurerm_api_management_authorization_server":        resourceApiManagementAuthorizationServer(),
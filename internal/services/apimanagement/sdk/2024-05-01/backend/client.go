@@ -0,0 +1,15 @@
+package backend
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BackendClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBackendClientWithBaseURI(endpoint string) BackendClient {
+	return BackendClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
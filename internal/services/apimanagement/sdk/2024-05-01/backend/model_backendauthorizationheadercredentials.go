@@ -0,0 +1,6 @@
+package backend
+
+type BackendAuthorizationHeaderCredentials struct {
+	Parameter *string `json:"parameter,omitempty"`
+	Scheme    *string `json:"scheme,omitempty"`
+}
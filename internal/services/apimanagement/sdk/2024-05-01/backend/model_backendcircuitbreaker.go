@@ -0,0 +1,5 @@
+package backend
+
+type BackendCircuitBreaker struct {
+	Rules *[]CircuitBreakerRule `json:"rules,omitempty"`
+}
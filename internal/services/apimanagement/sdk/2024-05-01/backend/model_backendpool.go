@@ -0,0 +1,5 @@
+package backend
+
+type BackendPool struct {
+	Services *[]BackendPoolItem `json:"services,omitempty"`
+}
@@ -0,0 +1,7 @@
+package backend
+
+type BackendPoolItem struct {
+	Id       string `json:"id"`
+	Priority *int64 `json:"priority,omitempty"`
+	Weight   *int64 `json:"weight,omitempty"`
+}
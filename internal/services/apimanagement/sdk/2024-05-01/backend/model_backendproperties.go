@@ -0,0 +1,5 @@
+package backend
+
+type BackendProperties struct {
+	ServiceFabricCluster *BackendServiceFabricClusterProperties `json:"serviceFabricCluster,omitempty"`
+}
@@ -0,0 +1,7 @@
+package backend
+
+type BackendProxyContract struct {
+	Password *string `json:"password,omitempty"`
+	Url      *string `json:"url,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
@@ -0,0 +1,10 @@
+package backend
+
+type BackendServiceFabricClusterProperties struct {
+	ClientCertificateId           *string                `json:"clientCertificateId,omitempty"`
+	ClientCertificatethumbprint   *string                `json:"clientCertificatethumbprint,omitempty"`
+	ManagementEndpoints           []string               `json:"managementEndpoints,omitempty"`
+	MaxPartitionResolutionRetries *int64                 `json:"maxPartitionResolutionRetries,omitempty"`
+	ServerCertificateThumbprints  *[]string              `json:"serverCertificateThumbprints,omitempty"`
+	ServerX509Names               *[]X509CertificateName `json:"serverX509Names,omitempty"`
+}
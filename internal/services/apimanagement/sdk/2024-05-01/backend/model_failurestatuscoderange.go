@@ -0,0 +1,6 @@
+package backend
+
+type FailureStatusCodeRange struct {
+	Max *int64 `json:"max,omitempty"`
+	Min *int64 `json:"min,omitempty"`
+}
@@ -0,0 +1,121 @@
+package validate
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ApiManagementApiImportContent validates the content of an API import, returning warnings for an inline OpenAPI
+// or Swagger document which API Management would either reject or import differently than may be expected.
+// Links, WSDL and WADL content are passed through to the service as-is.
+func ApiManagementApiImportContent(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return warnings, errors
+	}
+
+	if strings.HasPrefix(value, "<") || strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return warnings, errors
+	}
+
+	var document map[string]interface{}
+	if err := yaml.Unmarshal([]byte(value), &document); err != nil || document == nil {
+		warnings = append(warnings, fmt.Sprintf("%q could not be parsed as a JSON or YAML document, API Management may reject the import", k))
+		return warnings, errors
+	}
+
+	_, isOpenApi := document["openapi"]
+	_, isSwagger := document["swagger"]
+	if !isOpenApi && !isSwagger {
+		return warnings, errors
+	}
+
+	info := importContentMap(document["info"])
+	if title, _ := info["title"].(string); title == "" {
+		warnings = append(warnings, fmt.Sprintf("%q does not define `info.title`, which API Management uses as the default Display Name of the API", k))
+	}
+
+	if isOpenApi {
+		if servers, _ := document["servers"].([]interface{}); len(servers) == 0 {
+			warnings = append(warnings, fmt.Sprintf("%q does not define any `servers`, `service_url` should be set so API Management can route requests to the backend", k))
+		}
+	} else if host, _ := document["host"].(string); host == "" {
+		warnings = append(warnings, fmt.Sprintf("%q does not define a `host`, `service_url` should be set so API Management can route requests to the backend", k))
+	}
+
+	paths := importContentMap(document["paths"])
+	if len(paths) == 0 {
+		warnings = append(warnings, fmt.Sprintf("%q does not define any `paths`, no operations will be imported", k))
+		return warnings, errors
+	}
+
+	operationIds := make(map[string]int)
+	missingOperationIds := make([]string, 0)
+	for path, v := range paths {
+		for method, op := range importContentMap(v) {
+			if !importContentIsOperation(method) {
+				continue
+			}
+
+			operationId, _ := importContentMap(op)["operationId"].(string)
+			if operationId == "" {
+				missingOperationIds = append(missingOperationIds, fmt.Sprintf("%s %s", strings.ToUpper(method), path))
+				continue
+			}
+			operationIds[operationId]++
+		}
+	}
+
+	if len(missingOperationIds) > 0 {
+		sort.Strings(missingOperationIds)
+		warnings = append(warnings, fmt.Sprintf("%q contains operations without an `operationId` (%s), API Management will generate the operation names which may change between imports", k, strings.Join(missingOperationIds, ", ")))
+	}
+
+	duplicateOperationIds := make([]string, 0)
+	for operationId, count := range operationIds {
+		if count > 1 {
+			duplicateOperationIds = append(duplicateOperationIds, operationId)
+		}
+	}
+	if len(duplicateOperationIds) > 0 {
+		sort.Strings(duplicateOperationIds)
+		warnings = append(warnings, fmt.Sprintf("%q contains duplicate `operationId` values (%s), API Management requires operation names to be unique within an API", k, strings.Join(duplicateOperationIds, ", ")))
+	}
+
+	return warnings, errors
+}
+
+// importContentMap returns the input as a map with string keys, since YAML documents decode nested objects with interface keys
+func importContentMap(input interface{}) map[string]interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		return v
+	case map[interface{}]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			output[fmt.Sprintf("%v", key)] = value
+		}
+		return output
+	}
+
+	return nil
+}
+
+func importContentIsOperation(method string) bool {
+	switch strings.ToLower(method) {
+	case "get", "put", "post", "delete", "options", "head", "patch", "trace":
+		return true
+	}
+
+	return false
+}
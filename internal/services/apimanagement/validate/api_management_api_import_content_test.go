@@ -0,0 +1,102 @@
+package validate
+
+import (
+	"testing"
+)
+
+func TestApiManagementApiImportContent(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Value     string
+		WarnCount int
+		ErrCount  int
+	}{
+		{
+			Name:     "empty",
+			Value:    "  ",
+			ErrCount: 1,
+		},
+		{
+			Name:  "link",
+			Value: "https://example.com/openapi.json",
+		},
+		{
+			Name:  "wsdl",
+			Value: `<?xml version="1.0" encoding="utf-8"?><wsdl:definitions></wsdl:definitions>`,
+		},
+		{
+			Name:      "not a document",
+			Value:     "{ this is : not [ valid",
+			WarnCount: 1,
+		},
+		{
+			Name:  "not an openapi document",
+			Value: `{"hello": "world"}`,
+		},
+		{
+			Name: "valid openapi json",
+			Value: `{
+  "openapi": "3.0.1",
+  "info": { "title": "Echo API", "version": "1.0" },
+  "servers": [ { "url": "https://echo.example.com" } ],
+  "paths": {
+    "/echo": {
+      "parameters": [],
+      "get": { "operationId": "echo-get" },
+      "post": { "operationId": "echo-post" }
+    }
+  }
+}`,
+		},
+		{
+			Name: "valid swagger yaml",
+			Value: `swagger: "2.0"
+info:
+  title: Echo API
+  version: "1.0"
+host: echo.example.com
+paths:
+  /echo:
+    get:
+      operationId: echo-get
+`,
+		},
+		{
+			Name: "openapi yaml with problems",
+			Value: `openapi: 3.0.1
+info:
+  version: "1.0"
+paths:
+  /echo:
+    get:
+      operationId: echo
+    post:
+      operationId: echo
+    put:
+      summary: no operation id
+`,
+			WarnCount: 4,
+		},
+		{
+			Name: "openapi without paths",
+			Value: `{
+  "openapi": "3.0.1",
+  "info": { "title": "Echo API", "version": "1.0" },
+  "servers": [ { "url": "https://echo.example.com" } ]
+}`,
+			WarnCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			warnings, errors := ApiManagementApiImportContent(tc.Value, "content_value")
+			if len(warnings) != tc.WarnCount {
+				t.Fatalf("expected %d warnings but got %d: %+v", tc.WarnCount, len(warnings), warnings)
+			}
+			if len(errors) != tc.ErrCount {
+				t.Fatalf("expected %d errors but got %d: %+v", tc.ErrCount, len(errors), errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,466 @@
+package appconfiguration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"gopkg.in/yaml.v2"
+)
+
+// keyValuesParallelism is the number of concurrent data-plane requests made when reconciling Key-Values
+const keyValuesParallelism = 10
+
+type KeyValuesResource struct{}
+
+var _ sdk.ResourceWithUpdate = KeyValuesResource{}
+
+type KeyValuesResourceModel struct {
+	ConfigurationStoreId string            `tfschema:"configuration_store_id"`
+	KeyPrefix            string            `tfschema:"key_prefix"`
+	Label                string            `tfschema:"label"`
+	ContentType          string            `tfschema:"content_type"`
+	Values               map[string]string `tfschema:"values"`
+	Content              string            `tfschema:"content"`
+	Separator            string            `tfschema:"separator"`
+}
+
+func (r KeyValuesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"key_prefix": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"label": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"content_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+		},
+
+		"values": {
+			Type:         pluginsdk.TypeMap,
+			Optional:     true,
+			ExactlyOneOf: []string{"values", "content"},
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ExactlyOneOf:     []string{"values", "content"},
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: appConfigurationKeyValuesContentDiffSuppress,
+		},
+
+		"separator": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      ":",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r KeyValuesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KeyValuesResource) ModelObject() interface{} {
+	return &KeyValuesResourceModel{}
+}
+
+func (r KeyValuesResource) ResourceType() string {
+	return "azurerm_app_configuration_key_values"
+}
+
+func (r KeyValuesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.AppConfigurationKeyValuesID
+}
+
+func (r KeyValuesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KeyValuesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.AppConfigurationKeyValuesId{
+				ConfigurationStoreId: model.ConfigurationStoreId,
+				KeyPrefix:            model.KeyPrefix,
+				Label:                model.Label,
+			}
+
+			desired, err := expandAppConfigurationKeyValues(model)
+			if err != nil {
+				return err
+			}
+
+			existing, err := listAppConfigurationKeyValues(ctx, client, id)
+			if err != nil {
+				return err
+			}
+			if len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := reconcileAppConfigurationKeyValues(ctx, client, id, model.ContentType, existing, desired); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KeyValuesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.KeyValuesId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, id.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			var state KeyValuesResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := listAppConfigurationKeyValues(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			actual := make(map[string]string)
+			for key, kv := range existing {
+				actual[key] = utils.NormalizeNilableString(kv.Value)
+			}
+
+			state.ConfigurationStoreId = id.ConfigurationStoreId
+			state.KeyPrefix = id.KeyPrefix
+			state.Label = id.Label
+			if state.Separator == "" {
+				state.Separator = ":"
+			}
+
+			if state.Content == "" {
+				state.Values = actual
+			} else {
+				// when the Key-Values are sourced from a document, only replace it when it's drifted - the
+				// flat JSON representation of the actual values will then surface the drift in the plan
+				expected, err := flattenAppConfigurationKeyValuesContent(state.Content, state.Separator)
+				if err != nil || !reflect.DeepEqual(expected, actual) {
+					content, err := json.Marshal(actual)
+					if err != nil {
+						return fmt.Errorf("encoding `content`: %+v", err)
+					}
+					state.Content = string(content)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KeyValuesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.KeyValuesId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KeyValuesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, id.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			desired, err := expandAppConfigurationKeyValues(model)
+			if err != nil {
+				return err
+			}
+
+			existing, err := listAppConfigurationKeyValues(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			return reconcileAppConfigurationKeyValues(ctx, client, *id, model.ContentType, existing, desired)
+		},
+	}
+}
+
+func (r KeyValuesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.KeyValuesId(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.AppConfiguration.DataPlaneClient(ctx, id.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := listAppConfigurationKeyValues(ctx, client, *id)
+			if err != nil {
+				return err
+			}
+
+			return reconcileAppConfigurationKeyValues(ctx, client, *id, "", existing, map[string]string{})
+		},
+	}
+}
+
+// listAppConfigurationKeyValues returns the Key-Values under the key prefix and label, keyed by their name without the prefix
+func listAppConfigurationKeyValues(ctx context.Context, client *appconfiguration.BaseClient, id parse.AppConfigurationKeyValuesId) (map[string]appconfiguration.KeyValue, error) {
+	// a null label has to be filtered for explicitly, otherwise all labels are returned
+	label := id.Label
+	if label == "" {
+		label = "\x00"
+	}
+
+	results := make(map[string]appconfiguration.KeyValue)
+	iterator, err := client.GetKeyValuesComplete(ctx, id.KeyPrefix+"*", label, "", "", []string{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Key-Values with the prefix %q and label %q: %+v", id.KeyPrefix, id.Label, err)
+	}
+	for iterator.NotDone() {
+		kv := iterator.Value()
+		if kv.Key != nil {
+			results[strings.TrimPrefix(*kv.Key, id.KeyPrefix)] = kv
+		}
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing Key-Values with the prefix %q and label %q: %+v", id.KeyPrefix, id.Label, err)
+		}
+	}
+
+	return results, nil
+}
+
+// reconcileAppConfigurationKeyValues creates/updates the Key-Values which differ from those desired and
+// removes those which are no longer desired, issuing the data-plane requests concurrently
+func reconcileAppConfigurationKeyValues(ctx context.Context, client *appconfiguration.BaseClient, id parse.AppConfigurationKeyValuesId, contentType string, existing map[string]appconfiguration.KeyValue, desired map[string]string) error {
+	operations := make([]func() error, 0)
+
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		value := desired[key]
+		if kv, ok := existing[key]; ok && utils.NormalizeNilableString(kv.Value) == value && utils.NormalizeNilableString(kv.ContentType) == contentType {
+			continue
+		}
+
+		fullKey := id.KeyPrefix + key
+		entity := appconfiguration.KeyValue{
+			Key:         utils.String(fullKey),
+			Label:       utils.String(id.Label),
+			ContentType: utils.String(contentType),
+			Value:       utils.String(value),
+		}
+		operations = append(operations, func() error {
+			if _, err := client.PutKeyValue(ctx, fullKey, id.Label, &entity, "", ""); err != nil {
+				return fmt.Errorf("setting key/label pair %q/%q: %+v", fullKey, id.Label, err)
+			}
+			return nil
+		})
+	}
+
+	for key := range existing {
+		if _, ok := desired[key]; ok {
+			continue
+		}
+
+		fullKey := id.KeyPrefix + key
+		operations = append(operations, func() error {
+			if _, err := client.DeleteKeyValue(ctx, fullKey, id.Label, ""); err != nil {
+				if v, ok := err.(autorest.DetailedError); ok && utils.ResponseWasNotFound(autorest.Response{Response: v.Response}) {
+					return nil
+				}
+				return fmt.Errorf("deleting key/label pair %q/%q: %+v", fullKey, id.Label, err)
+			}
+			return nil
+		})
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := make([]string, 0)
+	semaphore := make(chan struct{}, keyValuesParallelism)
+	for _, operation := range operations {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(operation func() error) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := operation(); err != nil {
+				mu.Lock()
+				errs = append(errs, err.Error())
+				mu.Unlock()
+			}
+		}(operation)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("reconciling Key-Values for %s:\n%s", id.ID(), strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+func expandAppConfigurationKeyValues(model KeyValuesResourceModel) (map[string]string, error) {
+	if model.Content == "" {
+		if model.Values == nil {
+			return map[string]string{}, nil
+		}
+		return model.Values, nil
+	}
+
+	values, err := flattenAppConfigurationKeyValuesContent(model.Content, model.Separator)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `content`: %+v", err)
+	}
+	return values, nil
+}
+
+// flattenAppConfigurationKeyValuesContent parses a JSON or YAML document into a flat map of Key-Values,
+// joining the keys of nested objects using the separator
+func flattenAppConfigurationKeyValuesContent(content string, separator string) (map[string]string, error) {
+	// YAML is a superset of JSON, so this handles both
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(content), &raw); err != nil {
+		return nil, err
+	}
+
+	normalized := normalizeAppConfigurationKeyValuesContent(raw)
+	if _, ok := normalized.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("expected the document to be an object but got %T", raw)
+	}
+
+	results := make(map[string]string)
+	if err := flattenAppConfigurationKeyValuesNode(results, "", separator, normalized); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func flattenAppConfigurationKeyValuesNode(results map[string]string, path string, separator string, node interface{}) error {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + separator + key
+			}
+			if err := flattenAppConfigurationKeyValuesNode(results, childPath, separator, value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("encoding the value of %q: %+v", path, err)
+		}
+		results[path] = string(encoded)
+	case nil:
+		results[path] = ""
+	default:
+		results[path] = fmt.Sprint(v)
+	}
+
+	return nil
+}
+
+// normalizeAppConfigurationKeyValuesContent converts the map[interface{}]interface{} values returned by the YAML parser
+// into map[string]interface{} so they can be processed (and encoded to JSON) consistently
+func normalizeAppConfigurationKeyValuesContent(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			out[fmt.Sprint(key)] = normalizeAppConfigurationKeyValuesContent(value)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, value := range v {
+			out[i] = normalizeAppConfigurationKeyValuesContent(value)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func appConfigurationKeyValuesContentDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	separator := d.Get("separator").(string)
+	oldValues, err := flattenAppConfigurationKeyValuesContent(old, separator)
+	if err != nil {
+		return false
+	}
+	newValues, err := flattenAppConfigurationKeyValuesContent(new, separator)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValues, newValues)
+}
@@ -0,0 +1,213 @@
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationKeyValuesResource struct{}
+
+func TestAccAppConfigurationKeyValues_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.%").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeyValues_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationKeyValues_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.%").HasValue("2"),
+				check.That(data.ResourceName).Key("values.second").HasValue("updated"),
+				check.That(data.ResourceName).Key("values.third").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationKeyValues_content(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_key_values", "test")
+	r := AppConfigurationKeyValuesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.content(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("content", "values", "separator"),
+	})
+}
+
+func (AppConfigurationKeyValuesResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.KeyValuesId(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.AppConfiguration.DataPlaneClient(ctx, id.ConfigurationStoreId)
+	if err != nil {
+		return nil, err
+	}
+
+	label := id.Label
+	if label == "" {
+		label = "\x00"
+	}
+
+	iterator, err := client.GetKeyValuesComplete(ctx, id.KeyPrefix+"*", label, "", "", []string{})
+	if err != nil {
+		return nil, fmt.Errorf("listing Key-Values for %s: %+v", id.ID(), err)
+	}
+
+	return utils.Bool(iterator.NotDone()), nil
+}
+
+func (r AppConfigurationKeyValuesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key_prefix             = "acctest/"
+  label                  = "acctest"
+
+  values = {
+    first  = "1"
+    second = "2"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data))
+}
+
+func (r AppConfigurationKeyValuesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "import" {
+  configuration_store_id = azurerm_app_configuration_key_values.test.configuration_store_id
+  key_prefix             = azurerm_app_configuration_key_values.test.key_prefix
+  label                  = azurerm_app_configuration_key_values.test.label
+  values                 = azurerm_app_configuration_key_values.test.values
+}
+`, r.basic(data))
+}
+
+func (r AppConfigurationKeyValuesResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key_prefix             = "acctest/"
+  label                  = "acctest"
+  content_type           = "text/plain"
+
+  values = {
+    second = "updated"
+    third  = "3"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data))
+}
+
+func (r AppConfigurationKeyValuesResource) content(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_key_values" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key_prefix             = "acctest:"
+
+  content = <<CONTENT
+Logging:
+  LogLevel:
+    Default: Warning
+Hosts:
+  - a.example.com
+  - b.example.com
+CONTENT
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data))
+}
+
+func (AppConfigurationKeyValuesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testaccappconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "App Configuration Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -1,11 +1,13 @@
 package appconfiguration
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -13,12 +15,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	configurationStoresV20200601 "github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2024-05-01/configurationstores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type appConfigurationIdentityType = identity.SystemAssignedUserAssigned
@@ -42,6 +46,13 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// purge protection can be enabled but not disabled once set
+			pluginsdk.ForceNewIfChange("purge_protection_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				return old.(bool) && !new.(bool)
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -68,6 +79,20 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"purge_protection_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"soft_delete_retention_days": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      7,
+				ValidateFunc: validation.IntBetween(1, 7),
+			},
+
 			"endpoint": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -175,7 +200,7 @@ func resourceAppConfiguration() *pluginsdk.Resource {
 }
 
 func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresClient
+	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresV20240501Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -195,12 +220,48 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 		return tf.ImportAsExistsError("azurerm_app_configuration", resourceId.ID())
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if the configuration store exists in the soft delete state
+	deletedId := configurationstores.NewDeletedConfigurationStoreID(subscriptionId, location, name)
+	deleted, err := client.GetDeleted(ctx, deletedId)
+	if err != nil {
+		// if Terraform lacks permission to read at the Subscription scope we'll get a 403 rather than a 404
+		if !response.WasNotFound(deleted.HttpResponse) && !utils.ResponseWasForbidden(autorest.Response{Response: deleted.HttpResponse}) {
+			return fmt.Errorf("checking for presence of an existing %s: %+v", deletedId, err)
+		}
+	}
+
+	recoverSoftDeleted := false
+	if !response.WasNotFound(deleted.HttpResponse) && !utils.ResponseWasForbidden(autorest.Response{Response: deleted.HttpResponse}) {
+		if !meta.(*clients.Client).Features.AppConfiguration.RecoverSoftDeleted {
+			return fmt.Errorf(optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location))
+		}
+
+		recoverSoftDeleted = true
+	}
+
+	sku := d.Get("sku").(string)
 	parameters := configurationstores.ConfigurationStore{
-		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Location: location,
 		Sku: configurationstores.Sku{
-			Name: d.Get("sku").(string),
+			Name: sku,
 		},
-		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+		Properties: &configurationstores.ConfigurationStoreProperties{},
+		Tags:       tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	// soft delete and purge protection are only available on the `standard` sku
+	if sku == "standard" {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(d.Get("purge_protection_enabled").(bool))
+		parameters.Properties.SoftDeleteRetentionInDays = utils.Int64(int64(d.Get("soft_delete_retention_days").(int)))
+	} else if d.Get("purge_protection_enabled").(bool) {
+		return fmt.Errorf("`purge_protection_enabled` can only be set when `sku` is `standard`")
+	}
+
+	if recoverSoftDeleted {
+		createMode := configurationstores.CreateModeRecover
+		parameters.Properties.CreateMode = &createMode
 	}
 
 	identity, err := expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
@@ -218,7 +279,7 @@ func resourceAppConfigurationCreate(d *pluginsdk.ResourceData, meta interface{})
 }
 
 func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresClient
+	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresV20240501Client
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -243,6 +304,16 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 		parameters.Identity = identity
 	}
 
+	if d.HasChange("purge_protection_enabled") {
+		if d.Get("sku").(string) != "standard" {
+			return fmt.Errorf("`purge_protection_enabled` can only be set when `sku` is `standard`")
+		}
+
+		parameters.Properties = &configurationstores.ConfigurationStorePropertiesUpdateParameters{
+			EnablePurgeProtection: utils.Bool(d.Get("purge_protection_enabled").(bool)),
+		}
+	}
+
 	if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
@@ -251,7 +322,8 @@ func resourceAppConfigurationUpdate(d *pluginsdk.ResourceData, meta interface{})
 }
 
 func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresClient
+	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresV20240501Client
+	keysClient := meta.(*clients.Client).AppConfiguration.ConfigurationStoresClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -270,7 +342,7 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	resultPage, err := client.ListKeysComplete(ctx, *id)
+	resultPage, err := keysClient.ListKeysComplete(ctx, configurationStoresV20200601.NewConfigurationStoreID(id.SubscriptionId, id.ResourceGroupName, id.ConfigStoreName))
 	if err != nil {
 		return fmt.Errorf("retrieving access keys for %s: %+v", *id, err)
 	}
@@ -282,9 +354,20 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("location", location.Normalize(model.Location))
 		d.Set("sku", model.Sku.Name)
 
+		purgeProtectionEnabled := false
+		softDeleteRetentionDays := 7
 		if props := model.Properties; props != nil {
 			d.Set("endpoint", props.Endpoint)
+
+			if props.EnablePurgeProtection != nil {
+				purgeProtectionEnabled = *props.EnablePurgeProtection
+			}
+			if props.SoftDeleteRetentionInDays != nil {
+				softDeleteRetentionDays = int(*props.SoftDeleteRetentionInDays)
+			}
 		}
+		d.Set("purge_protection_enabled", purgeProtectionEnabled)
+		d.Set("soft_delete_retention_days", softDeleteRetentionDays)
 
 		accessKeys := flattenAppConfigurationAccessKeys(resultPage.Items)
 		d.Set("primary_read_key", accessKeys.primaryReadKey)
@@ -303,7 +386,7 @@ func resourceAppConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) e
 }
 
 func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresClient
+	client := meta.(*clients.Client).AppConfiguration.ConfigurationStoresV20240501Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -312,13 +395,55 @@ func resourceAppConfigurationDelete(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
 	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
+	// only the `standard` sku retains deleted configuration stores
+	if !strings.EqualFold(existing.Model.Sku.Name, "standard") || !meta.(*clients.Client).Features.AppConfiguration.PurgeSoftDeleteOnDestroy {
+		return nil
+	}
+
+	// configuration stores with Purge Protection Enabled cannot be purged until the retention period has passed
+	if props := existing.Model.Properties; props != nil && props.EnablePurgeProtection != nil && *props.EnablePurgeProtection {
+		log.Printf("[DEBUG] %s has Purge Protection Enabled and will be purged automatically by Azure", *id)
+		return nil
+	}
+
+	deletedId := configurationstores.NewDeletedConfigurationStoreID(id.SubscriptionId, location.Normalize(existing.Model.Location), id.ConfigStoreName)
+	log.Printf("[DEBUG] Purging %s..", deletedId)
+	if err := client.PurgeDeletedThenPoll(ctx, deletedId); err != nil {
+		return fmt.Errorf("purging %s: %+v", deletedId, err)
+	}
+	log.Printf("[DEBUG] Purged %s.", deletedId)
+
 	return nil
 }
 
+func optedOutOfRecoveringSoftDeletedAppConfigurationErrorFmt(name, location string) string {
+	return fmt.Sprintf(`
+An existing soft-deleted App Configuration exists with the Name %q in the location %q, however
+automatically recovering this App Configuration has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted App Configuration when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs#features
+
+Alternatively you can manually recover or purge this (e.g. using the Azure CLI) and then
+import it into Terraform via "terraform import", or pick a different name/location.
+`, name, location)
+}
+
 type flattenedAccessKeys struct {
 	primaryReadKey    []interface{}
 	primaryWriteKey   []interface{}
@@ -326,7 +451,7 @@ type flattenedAccessKeys struct {
 	secondaryWriteKey []interface{}
 }
 
-func flattenAppConfigurationAccessKeys(values []configurationstores.ApiKey) flattenedAccessKeys {
+func flattenAppConfigurationAccessKeys(values []configurationStoresV20200601.ApiKey) flattenedAccessKeys {
 	result := flattenedAccessKeys{
 		primaryReadKey:    make([]interface{}, 0),
 		primaryWriteKey:   make([]interface{}, 0),
@@ -363,7 +488,7 @@ func flattenAppConfigurationAccessKeys(values []configurationstores.ApiKey) flat
 	return result
 }
 
-func flattenAppConfigurationAccessKey(input configurationstores.ApiKey) []interface{} {
+func flattenAppConfigurationAccessKey(input configurationStoresV20200601.ApiKey) []interface{} {
 	connectionString := ""
 
 	if input.ConnectionString != nil {
@@ -0,0 +1,277 @@
+package appconfiguration
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2024-05-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SnapshotResource struct{}
+
+var _ sdk.Resource = SnapshotResource{}
+
+type SnapshotResourceModel struct {
+	Name                     string                `tfschema:"name"`
+	ConfigurationStoreId     string                `tfschema:"configuration_store_id"`
+	CompositionType          string                `tfschema:"composition_type"`
+	Filter                   []SnapshotFilterModel `tfschema:"filter"`
+	RetentionPeriodInSeconds int                   `tfschema:"retention_period_in_seconds"`
+	Tags                     map[string]string     `tfschema:"tags"`
+	ItemsCount               int                   `tfschema:"items_count"`
+	SizeInBytes              int                   `tfschema:"size_in_bytes"`
+	Status                   string                `tfschema:"status"`
+}
+
+type SnapshotFilterModel struct {
+	Key   string `tfschema:"key"`
+	Label string `tfschema:"label"`
+}
+
+func (r SnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"configuration_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: snapshots.ValidateConfigurationStoreID,
+		},
+
+		"filter": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 3,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"label": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"composition_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(snapshots.CompositionTypeKey),
+			ValidateFunc: validation.StringInSlice(snapshots.PossibleValuesForCompositionType(), false),
+		},
+
+		"retention_period_in_seconds": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			ForceNew: true,
+			// 30 days
+			Default: 2592000,
+			// between 1 hour and 90 days
+			ValidateFunc: validation.IntBetween(3600, 7776000),
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r SnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"items_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"size_in_bytes": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SnapshotResource) ModelObject() interface{} {
+	return &SnapshotResourceModel{}
+}
+
+func (r SnapshotResource) ResourceType() string {
+	return "azurerm_app_configuration_snapshot"
+}
+
+func (r SnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return snapshots.ValidateSnapshotID
+}
+
+func (r SnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.SnapshotsClient
+
+			var model SnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storeId, err := snapshots.ParseConfigurationStoreID(model.ConfigurationStoreId)
+			if err != nil {
+				return err
+			}
+
+			id := snapshots.NewSnapshotID(storeId.SubscriptionId, storeId.ResourceGroupName, storeId.ConfigStoreName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			compositionType := snapshots.CompositionType(model.CompositionType)
+			payload := snapshots.Snapshot{
+				Properties: &snapshots.SnapshotProperties{
+					CompositionType: &compositionType,
+					Filters:         expandAppConfigurationSnapshotFilters(model.Filter),
+					RetentionPeriod: utils.Int64(int64(model.RetentionPeriodInSeconds)),
+					Tags:            &model.Tags,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppConfiguration.SnapshotsClient
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SnapshotResourceModel{
+				Name:                 id.SnapshotName,
+				ConfigurationStoreId: snapshots.NewConfigurationStoreID(id.SubscriptionId, id.ResourceGroupName, id.ConfigStoreName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.CompositionType != nil {
+						state.CompositionType = string(*props.CompositionType)
+					}
+					state.Filter = flattenAppConfigurationSnapshotFilters(props.Filters)
+					if props.RetentionPeriod != nil {
+						state.RetentionPeriodInSeconds = int(*props.RetentionPeriod)
+					}
+					if props.Tags != nil {
+						state.Tags = *props.Tags
+					}
+					if props.ItemsCount != nil {
+						state.ItemsCount = int(*props.ItemsCount)
+					}
+					if props.Size != nil {
+						state.SizeInBytes = int(*props.Size)
+					}
+					if props.Status != nil {
+						state.Status = string(*props.Status)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// Snapshots are immutable and the Resource Manager API offers no way to delete them - they're
+			// retained in the Configuration Store until archived, after which they expire once the
+			// retention period has elapsed, so all we can do here is remove it from the state.
+			log.Printf("[DEBUG] %s cannot be deleted - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+func expandAppConfigurationSnapshotFilters(input []SnapshotFilterModel) []snapshots.KeyValueFilter {
+	results := make([]snapshots.KeyValueFilter, 0)
+	for _, v := range input {
+		filter := snapshots.KeyValueFilter{
+			Key: v.Key,
+		}
+		if v.Label != "" {
+			filter.Label = utils.String(v.Label)
+		}
+		results = append(results, filter)
+	}
+	return results
+}
+
+func flattenAppConfigurationSnapshotFilters(input []snapshots.KeyValueFilter) []SnapshotFilterModel {
+	results := make([]SnapshotFilterModel, 0)
+	for _, v := range input {
+		results = append(results, SnapshotFilterModel{
+			Key:   v.Key,
+			Label: utils.NormalizeNilableString(v.Label),
+		})
+	}
+	return results
+}
@@ -0,0 +1,186 @@
+package appconfiguration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2024-05-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppConfigurationSnapshotResource struct{}
+
+func TestAccAppConfigurationSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("ready"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppConfigurationSnapshot_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_configuration_snapshot", "test")
+	r := AppConfigurationSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("items_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AppConfigurationSnapshotResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := snapshots.ParseSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AppConfiguration.SnapshotsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r AppConfigurationSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  name                   = "acctest-snapshot-%d"
+  configuration_store_id = azurerm_app_configuration.test.id
+
+  filter {
+    key = "acctest/*"
+  }
+
+  depends_on = [azurerm_app_configuration_key.test, azurerm_app_configuration_key.test2]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AppConfigurationSnapshotResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "import" {
+  name                   = azurerm_app_configuration_snapshot.test.name
+  configuration_store_id = azurerm_app_configuration_snapshot.test.configuration_store_id
+
+  filter {
+    key = "acctest/*"
+  }
+}
+`, r.basic(data))
+}
+
+func (r AppConfigurationSnapshotResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_configuration_snapshot" "test" {
+  name                        = "acctest-snapshot-%d"
+  configuration_store_id      = azurerm_app_configuration.test.id
+  composition_type            = "key_label"
+  retention_period_in_seconds = 3600
+
+  filter {
+    key   = "acctest/*"
+    label = "production"
+  }
+
+  filter {
+    key = "acctest/*"
+  }
+
+  tags = {
+    environment = "test"
+  }
+
+  depends_on = [azurerm_app_configuration_key.test, azurerm_app_configuration_key.test2]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (AppConfigurationSnapshotResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-appconfig-%d"
+  location = "%s"
+}
+
+resource "azurerm_app_configuration" "test" {
+  name                = "testaccappconf%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "App Configuration Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_app_configuration_key" "test" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest/one"
+  label                  = "production"
+  value                  = "a"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+resource "azurerm_app_configuration_key" "test2" {
+  configuration_store_id = azurerm_app_configuration.test.id
+  key                    = "acctest/two"
+  value                  = "b"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
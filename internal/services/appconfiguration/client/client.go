@@ -9,12 +9,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/1.0/appconfiguration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2020-06-01/configurationstores"
+	configurationStoresV20240501 "github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2024-05-01/configurationstores"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appconfiguration/sdk/2024-05-01/snapshots"
 )
 
 type Client struct {
-	ConfigurationStoresClient *configurationstores.ConfigurationStoresClient
-	tokenFunc                 func(endpoint string) (autorest.Authorizer, error)
-	configureClientFunc       func(c *autorest.Client, authorizer autorest.Authorizer)
+	ConfigurationStoresClient          *configurationstores.ConfigurationStoresClient
+	ConfigurationStoresV20240501Client *configurationStoresV20240501.ConfigurationStoresClient
+	SnapshotsClient                    *snapshots.SnapshotsClient
+	tokenFunc                          func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc                func(c *autorest.Client, authorizer autorest.Authorizer)
 }
 
 func (c Client) DataPlaneClient(ctx context.Context, configurationStoreId string) (*appconfiguration.BaseClient, error) {
@@ -49,9 +53,17 @@ func NewClient(o *common.ClientOptions) *Client {
 	configurationStores := configurationstores.NewConfigurationStoresClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&configurationStores.Client, o.ResourceManagerAuthorizer)
 
+	configurationStoresV20240501Client := configurationStoresV20240501.NewConfigurationStoresClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&configurationStoresV20240501Client.Client, o.ResourceManagerAuthorizer)
+
+	snapshotsClient := snapshots.NewSnapshotsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&snapshotsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ConfigurationStoresClient: &configurationStores,
-		tokenFunc:                 o.TokenFunc,
-		configureClientFunc:       o.ConfigureClient,
+		ConfigurationStoresClient:          &configurationStores,
+		ConfigurationStoresV20240501Client: &configurationStoresV20240501Client,
+		SnapshotsClient:                    &snapshotsClient,
+		tokenFunc:                          o.TokenFunc,
+		configureClientFunc:                o.ConfigureClient,
 	}
 }
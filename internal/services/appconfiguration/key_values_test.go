@@ -0,0 +1,76 @@
+package appconfiguration
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenAppConfigurationKeyValuesContent(t *testing.T) {
+	testData := []struct {
+		name      string
+		content   string
+		separator string
+		expected  map[string]string
+		error     bool
+	}{
+		{
+			name:      "flat json",
+			content:   `{"a": "1", "b": true, "c": 3}`,
+			separator: ":",
+			expected: map[string]string{
+				"a": "1",
+				"b": "true",
+				"c": "3",
+			},
+		},
+		{
+			name:      "nested json",
+			content:   `{"Logging": {"LogLevel": {"Default": "Warning"}}, "Hosts": ["a", "b"]}`,
+			separator: ":",
+			expected: map[string]string{
+				"Logging:LogLevel:Default": "Warning",
+				"Hosts":                    `["a","b"]`,
+			},
+		},
+		{
+			name:      "nested yaml with custom separator",
+			content:   "database:\n  host: example.com\n  port: 5432\nempty:\n",
+			separator: "/",
+			expected: map[string]string{
+				"database/host": "example.com",
+				"database/port": "5432",
+				"empty":         "",
+			},
+		},
+		{
+			name:      "not an object",
+			content:   `["a", "b"]`,
+			separator: ":",
+			error:     true,
+		},
+		{
+			name:      "invalid document",
+			content:   `{"a": `,
+			separator: ":",
+			error:     true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			actual, err := flattenAppConfigurationKeyValuesContent(v.content, v.separator)
+			if err != nil {
+				if v.error {
+					return
+				}
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if v.error {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if !reflect.DeepEqual(actual, v.expected) {
+				t.Fatalf("expected %+v but got %+v", v.expected, actual)
+			}
+		})
+	}
+}
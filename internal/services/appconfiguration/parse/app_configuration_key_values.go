@@ -0,0 +1,59 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type AppConfigurationKeyValuesId struct {
+	ConfigurationStoreId string
+	KeyPrefix            string
+	Label                string
+}
+
+func (k AppConfigurationKeyValuesId) ID() string {
+	// empty segments are represented as "%00" since the ID parser doesn't allow empty values
+	keyPrefix := "%00"
+	if k.KeyPrefix != "" {
+		keyPrefix = url.QueryEscape(k.KeyPrefix)
+	}
+	label := "%00"
+	if k.Label != "" {
+		label = url.QueryEscape(k.Label)
+	}
+	return fmt.Sprintf("%s/AppConfigurationKeyValues/%s/Label/%s", k.ConfigurationStoreId, keyPrefix, label)
+}
+
+func KeyValuesId(input string) (*AppConfigurationKeyValuesId, error) {
+	resourceID, err := parseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("while parsing resource ID: %+v", err)
+	}
+
+	rawKeyPrefix, ok := resourceID.Path["AppConfigurationKeyValues"]
+	if !ok {
+		return nil, fmt.Errorf("the segment 'AppConfigurationKeyValues' was not found in the resource id %q", input)
+	}
+	rawLabel, ok := resourceID.Path["Label"]
+	if !ok {
+		return nil, fmt.Errorf("the segment 'Label' was not found in the resource id %q", input)
+	}
+
+	id := AppConfigurationKeyValuesId{
+		ConfigurationStoreId: strings.TrimSuffix(input, fmt.Sprintf("/AppConfigurationKeyValues/%s/Label/%s", rawKeyPrefix, rawLabel)),
+	}
+
+	if rawKeyPrefix != "%00" {
+		if id.KeyPrefix, err = url.QueryUnescape(rawKeyPrefix); err != nil {
+			return nil, fmt.Errorf("decoding key prefix %q: %+v", rawKeyPrefix, err)
+		}
+	}
+	if rawLabel != "%00" {
+		if id.Label, err = url.QueryUnescape(rawLabel); err != nil {
+			return nil, fmt.Errorf("decoding label %q: %+v", rawLabel, err)
+		}
+	}
+
+	return &id, nil
+}
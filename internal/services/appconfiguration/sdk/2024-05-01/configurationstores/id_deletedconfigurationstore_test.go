@@ -0,0 +1,254 @@
+package configurationstores
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = DeletedConfigurationStoreId{}
+
+func TestNewDeletedConfigurationStoreID(t *testing.T) {
+	id := NewDeletedConfigurationStoreID("12345678-1234-9876-4563-123456789012", "locationValue", "configStoreValue")
+
+	if id.SubscriptionId != "12345678-1234-9876-4563-123456789012" {
+		t.Fatalf("Expected %q but got %q for Segment 'SubscriptionId'", id.SubscriptionId, "12345678-1234-9876-4563-123456789012")
+	}
+
+	if id.Location != "locationValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'Location'", id.Location, "locationValue")
+	}
+
+	if id.ConfigStoreName != "configStoreValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'ConfigStoreName'", id.ConfigStoreName, "configStoreValue")
+	}
+}
+
+func TestFormatDeletedConfigurationStoreID(t *testing.T) {
+	actual := NewDeletedConfigurationStoreID("12345678-1234-9876-4563-123456789012", "locationValue", "configStoreValue").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue/deletedConfigurationStores/configStoreValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseDeletedConfigurationStoreID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DeletedConfigurationStoreId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue/deletedConfigurationStores",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue/deletedConfigurationStores/configStoreValue",
+			Expected: &DeletedConfigurationStoreId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				Location:        "locationValue",
+				ConfigStoreName: "configStoreValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue/deletedConfigurationStores/configStoreValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseDeletedConfigurationStoreID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.Location != v.Expected.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expected.Location, actual.Location)
+		}
+
+		if actual.ConfigStoreName != v.Expected.ConfigStoreName {
+			t.Fatalf("Expected %q but got %q for ConfigStoreName", v.Expected.ConfigStoreName, actual.ConfigStoreName)
+		}
+
+	}
+}
+
+func TestParseDeletedConfigurationStoreIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DeletedConfigurationStoreId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/SuBsCrIpTiOnS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/PrOvIdErS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/PrOvIdErS/MiCrOsOfT.ApPcOnFiGuRaTiOn",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/PrOvIdErS/MiCrOsOfT.ApPcOnFiGuRaTiOn/LoCaTiOnS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue/deletedConfigurationStores",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/PrOvIdErS/MiCrOsOfT.ApPcOnFiGuRaTiOn/LoCaTiOnS/LoCaTiOnVaLuE/DeLeTeDcOnFiGuRaTiOnStOrEs",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue/deletedConfigurationStores/configStoreValue",
+			Expected: &DeletedConfigurationStoreId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				Location:        "locationValue",
+				ConfigStoreName: "configStoreValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.AppConfiguration/locations/locationValue/deletedConfigurationStores/configStoreValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/PrOvIdErS/MiCrOsOfT.ApPcOnFiGuRaTiOn/LoCaTiOnS/LoCaTiOnVaLuE/DeLeTeDcOnFiGuRaTiOnStOrEs/CoNfIgStOrEvAlUe",
+			Expected: &DeletedConfigurationStoreId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				Location:        "LoCaTiOnVaLuE",
+				ConfigStoreName: "CoNfIgStOrEvAlUe",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/PrOvIdErS/MiCrOsOfT.ApPcOnFiGuRaTiOn/LoCaTiOnS/LoCaTiOnVaLuE/DeLeTeDcOnFiGuRaTiOnStOrEs/CoNfIgStOrEvAlUe/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseDeletedConfigurationStoreIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+
+		if actual.Location != v.Expected.Location {
+			t.Fatalf("Expected %q but got %q for Location", v.Expected.Location, actual.Location)
+		}
+
+		if actual.ConfigStoreName != v.Expected.ConfigStoreName {
+			t.Fatalf("Expected %q but got %q for ConfigStoreName", v.Expected.ConfigStoreName, actual.ConfigStoreName)
+		}
+
+	}
+}
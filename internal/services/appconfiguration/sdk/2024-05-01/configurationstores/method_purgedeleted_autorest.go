@@ -0,0 +1,74 @@
+package configurationstores
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type PurgeDeletedResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// PurgeDeleted ...
+func (c ConfigurationStoresClient) PurgeDeleted(ctx context.Context, id DeletedConfigurationStoreId) (result PurgeDeletedResponse, err error) {
+	req, err := c.preparerForPurgeDeleted(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "configurationstores.ConfigurationStoresClient", "PurgeDeleted", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPurgeDeleted(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "configurationstores.ConfigurationStoresClient", "PurgeDeleted", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PurgeDeletedThenPoll performs PurgeDeleted then polls until it's completed
+func (c ConfigurationStoresClient) PurgeDeletedThenPoll(ctx context.Context, id DeletedConfigurationStoreId) error {
+	result, err := c.PurgeDeleted(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing PurgeDeleted: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after PurgeDeleted: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPurgeDeleted prepares the PurgeDeleted request.
+func (c ConfigurationStoresClient) preparerForPurgeDeleted(ctx context.Context, id DeletedConfigurationStoreId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/purge", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPurgeDeleted sends the PurgeDeleted request. The method will close the
+// http.Response Body if it receives an error.
+func (c ConfigurationStoresClient) senderForPurgeDeleted(ctx context.Context, req *http.Request) (future PurgeDeletedResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
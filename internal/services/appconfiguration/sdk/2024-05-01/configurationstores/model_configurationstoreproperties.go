@@ -0,0 +1,12 @@
+package configurationstores
+
+type ConfigurationStoreProperties struct {
+	CreateMode                *CreateMode          `json:"createMode,omitempty"`
+	CreationDate              *string              `json:"creationDate,omitempty"`
+	DisableLocalAuth          *bool                `json:"disableLocalAuth,omitempty"`
+	EnablePurgeProtection     *bool                `json:"enablePurgeProtection,omitempty"`
+	Endpoint                  *string              `json:"endpoint,omitempty"`
+	ProvisioningState         *ProvisioningState   `json:"provisioningState,omitempty"`
+	PublicNetworkAccess       *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+	SoftDeleteRetentionInDays *int64               `json:"softDeleteRetentionInDays,omitempty"`
+}
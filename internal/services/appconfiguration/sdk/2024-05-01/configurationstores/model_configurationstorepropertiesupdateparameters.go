@@ -0,0 +1,7 @@
+package configurationstores
+
+type ConfigurationStorePropertiesUpdateParameters struct {
+	DisableLocalAuth      *bool                `json:"disableLocalAuth,omitempty"`
+	EnablePurgeProtection *bool                `json:"enablePurgeProtection,omitempty"`
+	PublicNetworkAccess   *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
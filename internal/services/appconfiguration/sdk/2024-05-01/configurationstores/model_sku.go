@@ -0,0 +1,5 @@
+package configurationstores
+
+type Sku struct {
+	Name string `json:"name"`
+}
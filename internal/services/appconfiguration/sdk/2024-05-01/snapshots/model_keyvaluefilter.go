@@ -0,0 +1,6 @@
+package snapshots
+
+type KeyValueFilter struct {
+	Key   string  `json:"key"`
+	Label *string `json:"label,omitempty"`
+}
@@ -0,0 +1,15 @@
+package snapshots
+
+type SnapshotProperties struct {
+	CompositionType   *CompositionType   `json:"compositionType,omitempty"`
+	Created           *string            `json:"created,omitempty"`
+	Etag              *string            `json:"etag,omitempty"`
+	Expires           *string            `json:"expires,omitempty"`
+	Filters           []KeyValueFilter   `json:"filters"`
+	ItemsCount        *int64             `json:"itemsCount,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	RetentionPeriod   *int64             `json:"retentionPeriod,omitempty"`
+	Size              *int64             `json:"size,omitempty"`
+	Status            *SnapshotStatus    `json:"status,omitempty"`
+	Tags              *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,243 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	relayParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/parse"
+	relayValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FunctionAppHybridConnectionResource struct{}
+
+type FunctionAppHybridConnectionModel struct {
+	FunctionAppId       string `tfschema:"function_app_id"`
+	RelayId             string `tfschema:"relay_id"`
+	HostName            string `tfschema:"hostname"`
+	Port                int    `tfschema:"port"`
+	SendKeyName         string `tfschema:"send_key_name"`
+	NamespaceName       string `tfschema:"namespace_name"`
+	RelayName           string `tfschema:"relay_name"`
+	ServiceBusNamespace string `tfschema:"service_bus_namespace"`
+	ServiceBusSuffix    string `tfschema:"service_bus_suffix"`
+	SendKeyValue        string `tfschema:"send_key_value"`
+}
+
+var _ sdk.ResourceWithUpdate = FunctionAppHybridConnectionResource{}
+
+func (r FunctionAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"function_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FunctionAppID,
+		},
+
+		"relay_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: relayValidate.HybridConnectionID,
+		},
+
+		"hostname": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: azValidate.PortNumberOrZero,
+		},
+
+		"send_key_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "RootManageSharedAccessKey",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r FunctionAppHybridConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return hybridConnectionComputedSchema()
+}
+
+func (r FunctionAppHybridConnectionResource) ModelObject() interface{} {
+	return &FunctionAppHybridConnectionModel{}
+}
+
+func (r FunctionAppHybridConnectionResource) ResourceType() string {
+	return "azurerm_function_app_hybrid_connection"
+}
+
+func (r FunctionAppHybridConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.FunctionAppHybridConnectionID
+}
+
+func (r FunctionAppHybridConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var appHybridConn FunctionAppHybridConnectionModel
+			if err := metadata.Decode(&appHybridConn); err != nil {
+				return err
+			}
+
+			appId, err := parse.FunctionAppID(appHybridConn.FunctionAppId)
+			if err != nil {
+				return err
+			}
+
+			relayId, err := relayParse.HybridConnectionID(appHybridConn.RelayId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewFunctionAppHybridConnectionID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, relayId.NamespaceName, relayId.HybridConnectionName)
+
+			existing, err := client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// Hybrid Connections are supported on Elastic Premium and Dedicated plans, but not on the Consumption plan
+			site, err := client.Get(ctx, appId.ResourceGroup, appId.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", appId, err)
+			}
+			if site.SiteProperties == nil || site.SiteProperties.ServerFarmID == nil {
+				return fmt.Errorf("determining Service Plan ID for %s", appId)
+			}
+			servicePlanId, err := parse.ServicePlanID(*site.SiteProperties.ServerFarmID)
+			if err != nil {
+				return err
+			}
+			servicePlan, err := metadata.Client.AppService.ServicePlanClient.Get(ctx, servicePlanId.ResourceGroup, servicePlanId.ServerfarmName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", servicePlanId, err)
+			}
+			if sku := servicePlan.Sku; sku != nil && sku.Tier != nil && strings.EqualFold(*sku.Tier, "dynamic") {
+				return fmt.Errorf("Hybrid Connections are not supported for Function Apps on a Consumption (Dynamic) Service Plan, %s must use an Elastic Premium or Dedicated Service Plan", appId)
+			}
+
+			envelope := expandHybridConnection(appHybridConn.RelayId, appHybridConn.HostName, appHybridConn.Port, appHybridConn.SendKeyName)
+			if _, err := client.CreateOrUpdateHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, envelope); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppHybridConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.FunctionAppHybridConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			appHybridConn := FunctionAppHybridConnectionModel{
+				FunctionAppId: parse.NewFunctionAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID(),
+				NamespaceName: id.HybridConnectionNamespaceName,
+				RelayName:     id.RelayName,
+			}
+
+			if props := existing.HybridConnectionProperties; props != nil {
+				appHybridConn.RelayId = utils.NormalizeNilableString(props.RelayArmURI)
+				appHybridConn.HostName = utils.NormalizeNilableString(props.Hostname)
+				appHybridConn.Port = int(utils.NormaliseNilableInt32(props.Port))
+				appHybridConn.SendKeyName = utils.NormalizeNilableString(props.SendKeyName)
+				appHybridConn.ServiceBusNamespace = utils.NormalizeNilableString(props.ServiceBusNamespace)
+				appHybridConn.ServiceBusSuffix = utils.NormalizeNilableString(props.ServiceBusSuffix)
+
+				sendKeyValue, err := hybridConnectionSendKeyValue(ctx, metadata.Client.Relay.NamespacesClient, props)
+				if err != nil {
+					return fmt.Errorf("retrieving Send Key Value for %s: %+v", id, err)
+				}
+				appHybridConn.SendKeyValue = sendKeyValue
+			}
+
+			return metadata.Encode(&appHybridConn)
+		},
+	}
+}
+
+func (r FunctionAppHybridConnectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.FunctionAppHybridConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var appHybridConn FunctionAppHybridConnectionModel
+			if err := metadata.Decode(&appHybridConn); err != nil {
+				return err
+			}
+
+			envelope := expandHybridConnection(appHybridConn.RelayId, appHybridConn.HostName, appHybridConn.Port, appHybridConn.SendKeyName)
+			if _, err := client.CreateOrUpdateHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, envelope); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r FunctionAppHybridConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.FunctionAppHybridConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DeleteHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FunctionAppHybridConnectionResource struct{}
+
+func TestAccFunctionAppHybridConnection_linuxElasticPremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_hybrid_connection", "test")
+	r := FunctionAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linux(data, SkuElasticPremiumPlan),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("send_key_value").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHybridConnection_linuxStandard(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_hybrid_connection", "test")
+	r := FunctionAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linux(data, SkuStandardPlan),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHybridConnection_windowsElasticPremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_hybrid_connection", "test")
+	r := FunctionAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.windows(data, SkuElasticPremiumPlan),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFunctionAppHybridConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_hybrid_connection", "test")
+	r := FunctionAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linux(data, SkuElasticPremiumPlan),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFunctionAppHybridConnection_consumptionPlanNotSupported(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_hybrid_connection", "test")
+	r := FunctionAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.linux(data, SkuConsumptionPlan),
+			ExpectError: regexp.MustCompile("Hybrid Connections are not supported for Function Apps on a Consumption"),
+		},
+	})
+}
+
+func (r FunctionAppHybridConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FunctionAppHybridConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.HybridConnectionProperties != nil), nil
+}
+
+func (r FunctionAppHybridConnectionResource) linux(data acceptance.TestData, planSku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_linux_function_app" "test" {
+  name                = "acctest-LFA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {}
+}
+
+resource "azurerm_function_app_hybrid_connection" "test" {
+  function_app_id = azurerm_linux_function_app.test.id
+  relay_id        = azurerm_relay_hybrid_connection.test.id
+  hostname        = "acctest%[3]s.hostname"
+  port            = 8080
+}
+`, r.template(data, planSku, "Linux"), data.RandomInteger, data.RandomString)
+}
+
+func (r FunctionAppHybridConnectionResource) windows(data acceptance.TestData, planSku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_windows_function_app" "test" {
+  name                = "acctest-WFA-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+
+  site_config {}
+}
+
+resource "azurerm_function_app_hybrid_connection" "test" {
+  function_app_id = azurerm_windows_function_app.test.id
+  relay_id        = azurerm_relay_hybrid_connection.test.id
+  hostname        = "acctest%[3]s.hostname"
+  port            = 8080
+}
+`, r.template(data, planSku, "Windows"), data.RandomInteger, data.RandomString)
+}
+
+func (r FunctionAppHybridConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_function_app_hybrid_connection" "import" {
+  function_app_id = azurerm_function_app_hybrid_connection.test.function_app_id
+  relay_id        = azurerm_function_app_hybrid_connection.test.relay_id
+  hostname        = azurerm_function_app_hybrid_connection.test.hostname
+  port            = azurerm_function_app_hybrid_connection.test.port
+}
+`, r.linux(data, SkuElasticPremiumPlan))
+}
+
+func (FunctionAppHybridConnectionResource) template(data acceptance.TestData, planSku string, osType string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-FAHC-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "%[5]s"
+  sku_name            = "%[4]s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctest-RN-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctest-RN-HC-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, planSku, osType)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	apimValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -1609,7 +1610,7 @@ func autoHealTriggerSchemaWindows() *pluginsdk.Schema {
 							"status_code_range": {
 								Type:         pluginsdk.TypeString,
 								Required:     true,
-								ValidateFunc: nil, // TODO - status code range validation
+								ValidateFunc: validate.StatusCodeRange,
 							},
 
 							"count": {
@@ -1817,7 +1818,7 @@ func autoHealTriggerSchemaLinux() *pluginsdk.Schema {
 							"status_code_range": {
 								Type:         pluginsdk.TypeString,
 								Required:     true,
-								ValidateFunc: nil, // TODO - status code range validation
+								ValidateFunc: validate.StatusCodeRange,
 							},
 
 							"count": {
@@ -1953,7 +1954,7 @@ func autoHealTriggerSchemaLinuxComputed() *pluginsdk.Schema {
 
 				"slow_request": {
 					Type:     pluginsdk.TypeList,
-					Optional: true,
+					Computed: true,
 					Elem: &pluginsdk.Resource{
 						Schema: map[string]*pluginsdk.Schema{
 							"time_taken": {
@@ -3797,6 +3798,10 @@ func expandAutoHealSettingsWindows(autoHealSettings []AutoHealSettingWindows) *w
 		result.Triggers.StatusCodesRange = &statusCodeRangeTriggers
 	}
 
+	if len(triggers.SlowRequests) > 0 {
+		result.Triggers.SlowRequests, result.Triggers.SlowRequestsWithPath = expandAutoHealSlowRequests(triggers.SlowRequests)
+	}
+
 	action := autoHeal.Actions[0]
 	result.Actions.ActionType = web.AutoHealActionType(action.ActionType)
 	result.Actions.MinProcessExecutionTime = utils.String(action.MinimumProcessTime)
@@ -3876,16 +3881,7 @@ func flattenAutoHealSettingsWindows(autoHealRules *web.AutoHealRules) []AutoHeal
 		}
 		resultTrigger.StatusCodes = statusCodeTriggers
 
-		slowRequestTriggers := make([]AutoHealSlowRequest, 0)
-		if triggers.SlowRequests != nil {
-			slowRequestTriggers = append(slowRequestTriggers, AutoHealSlowRequest{
-				TimeTaken: utils.NormalizeNilableString(triggers.SlowRequests.TimeTaken),
-				Interval:  utils.NormalizeNilableString(triggers.SlowRequests.TimeInterval),
-				Count:     int(utils.NormaliseNilableInt32(triggers.SlowRequests.Count)),
-				Path:      utils.NormalizeNilableString(triggers.SlowRequests.Path),
-			})
-		}
-		resultTrigger.SlowRequests = slowRequestTriggers
+		resultTrigger.SlowRequests = flattenAutoHealSlowRequests(triggers)
 		result.Triggers = []AutoHealTriggerWindows{resultTrigger}
 	}
 
@@ -3927,49 +3923,57 @@ func expandAutoHealSettingsLinux(autoHealSettings []AutoHealSettingLinux) *web.A
 
 	autoHeal := autoHealSettings[0]
 
-	triggers := autoHeal.Triggers[0]
-	if len(triggers.Requests) == 1 {
-		result.Triggers.Requests = &web.RequestsBasedTrigger{
-			Count:        utils.Int32(int32(triggers.Requests[0].Count)),
-			TimeInterval: utils.String(triggers.Requests[0].Interval),
+	if len(autoHeal.Triggers) == 1 {
+		triggers := autoHeal.Triggers[0]
+		if len(triggers.Requests) == 1 {
+			result.Triggers.Requests = &web.RequestsBasedTrigger{
+				Count:        utils.Int32(int32(triggers.Requests[0].Count)),
+				TimeInterval: utils.String(triggers.Requests[0].Interval),
+			}
 		}
-	}
 
-	if len(triggers.StatusCodes) > 0 {
-		statusCodeTriggers := make([]web.StatusCodesBasedTrigger, 0)
-		statusCodeRangeTriggers := make([]web.StatusCodesRangeBasedTrigger, 0)
-		for _, s := range triggers.StatusCodes {
-			statusCodeTrigger := web.StatusCodesBasedTrigger{}
-			statusCodeRangeTrigger := web.StatusCodesRangeBasedTrigger{}
-			parts := strings.Split(s.StatusCodeRange, "-")
-			if len(parts) == 2 {
-				statusCodeRangeTrigger.StatusCodes = utils.String(s.StatusCodeRange)
-				statusCodeRangeTrigger.Count = utils.Int32(int32(s.Count))
-				statusCodeRangeTrigger.TimeInterval = utils.String(s.Interval)
-				if s.Path != "" {
-					statusCodeRangeTrigger.Path = utils.String(s.Path)
-				}
-				statusCodeRangeTriggers = append(statusCodeRangeTriggers, statusCodeRangeTrigger)
-			} else {
-				statusCode, err := strconv.Atoi(s.StatusCodeRange)
-				if err == nil {
-					statusCodeTrigger.Status = utils.Int32(int32(statusCode))
+		if len(triggers.StatusCodes) > 0 {
+			statusCodeTriggers := make([]web.StatusCodesBasedTrigger, 0)
+			statusCodeRangeTriggers := make([]web.StatusCodesRangeBasedTrigger, 0)
+			for _, s := range triggers.StatusCodes {
+				statusCodeTrigger := web.StatusCodesBasedTrigger{}
+				statusCodeRangeTrigger := web.StatusCodesRangeBasedTrigger{}
+				parts := strings.Split(s.StatusCodeRange, "-")
+				if len(parts) == 2 {
+					statusCodeRangeTrigger.StatusCodes = utils.String(s.StatusCodeRange)
+					statusCodeRangeTrigger.Count = utils.Int32(int32(s.Count))
+					statusCodeRangeTrigger.TimeInterval = utils.String(s.Interval)
+					if s.Path != "" {
+						statusCodeRangeTrigger.Path = utils.String(s.Path)
+					}
+					statusCodeRangeTriggers = append(statusCodeRangeTriggers, statusCodeRangeTrigger)
+				} else {
+					statusCode, err := strconv.Atoi(s.StatusCodeRange)
+					if err == nil {
+						statusCodeTrigger.Status = utils.Int32(int32(statusCode))
+					}
+					statusCodeTrigger.Count = utils.Int32(int32(s.Count))
+					statusCodeTrigger.TimeInterval = utils.String(s.Interval)
+					if s.Path != "" {
+						statusCodeTrigger.Path = utils.String(s.Path)
+					}
+					statusCodeTriggers = append(statusCodeTriggers, statusCodeTrigger)
 				}
-				statusCodeTrigger.Count = utils.Int32(int32(s.Count))
-				statusCodeTrigger.TimeInterval = utils.String(s.Interval)
-				if s.Path != "" {
-					statusCodeTrigger.Path = utils.String(s.Path)
-				}
-				statusCodeTriggers = append(statusCodeTriggers, statusCodeTrigger)
 			}
+			result.Triggers.StatusCodes = &statusCodeTriggers
+			result.Triggers.StatusCodesRange = &statusCodeRangeTriggers
+		}
+
+		if len(triggers.SlowRequests) > 0 {
+			result.Triggers.SlowRequests, result.Triggers.SlowRequestsWithPath = expandAutoHealSlowRequests(triggers.SlowRequests)
 		}
-		result.Triggers.StatusCodes = &statusCodeTriggers
-		result.Triggers.StatusCodesRange = &statusCodeRangeTriggers
 	}
 
-	action := autoHeal.Actions[0]
-	result.Actions.ActionType = web.AutoHealActionType(action.ActionType)
-	result.Actions.MinProcessExecutionTime = utils.String(action.MinimumProcessTime)
+	if len(autoHeal.Actions) == 1 {
+		action := autoHeal.Actions[0]
+		result.Actions.ActionType = web.AutoHealActionType(action.ActionType)
+		result.Actions.MinProcessExecutionTime = utils.String(action.MinimumProcessTime)
+	}
 
 	return result
 }
@@ -4036,16 +4040,7 @@ func flattenAutoHealSettingsLinux(autoHealRules *web.AutoHealRules) []AutoHealSe
 		}
 		resultTrigger.StatusCodes = statusCodeTriggers
 
-		slowRequestTriggers := make([]AutoHealSlowRequest, 0)
-		if triggers.SlowRequests != nil {
-			slowRequestTriggers = append(slowRequestTriggers, AutoHealSlowRequest{
-				TimeTaken: utils.NormalizeNilableString(triggers.SlowRequests.TimeTaken),
-				Interval:  utils.NormalizeNilableString(triggers.SlowRequests.TimeInterval),
-				Count:     int(utils.NormaliseNilableInt32(triggers.SlowRequests.Count)),
-				Path:      utils.NormalizeNilableString(triggers.SlowRequests.Path),
-			})
-		}
-		resultTrigger.SlowRequests = slowRequestTriggers
+		resultTrigger.SlowRequests = flattenAutoHealSlowRequests(triggers)
 		result.Triggers = []AutoHealTriggerLinux{resultTrigger}
 	}
 
@@ -4066,6 +4061,59 @@ func flattenAutoHealSettingsLinux(autoHealRules *web.AutoHealRules) []AutoHealSe
 	return nil
 }
 
+// expandAutoHealSlowRequests splits the `slow_request` blocks into the single trigger which applies to all requests
+// and the triggers which are scoped to a request path, since the API models these as separate fields.
+func expandAutoHealSlowRequests(slowRequests []AutoHealSlowRequest) (*web.SlowRequestsBasedTrigger, *[]web.SlowRequestsBasedTrigger) {
+	var slowRequestTrigger *web.SlowRequestsBasedTrigger
+	slowRequestsWithPath := make([]web.SlowRequestsBasedTrigger, 0)
+
+	for _, v := range slowRequests {
+		trigger := web.SlowRequestsBasedTrigger{
+			TimeTaken:    utils.String(v.TimeTaken),
+			TimeInterval: utils.String(v.Interval),
+			Count:        utils.Int32(int32(v.Count)),
+		}
+
+		if v.Path != "" {
+			trigger.Path = utils.String(v.Path)
+			slowRequestsWithPath = append(slowRequestsWithPath, trigger)
+			continue
+		}
+
+		if slowRequestTrigger == nil {
+			slowRequestTrigger = &trigger
+		}
+	}
+
+	return slowRequestTrigger, &slowRequestsWithPath
+}
+
+func flattenAutoHealSlowRequests(triggers web.AutoHealTriggers) []AutoHealSlowRequest {
+	result := make([]AutoHealSlowRequest, 0)
+
+	if triggers.SlowRequests != nil {
+		result = append(result, AutoHealSlowRequest{
+			TimeTaken: utils.NormalizeNilableString(triggers.SlowRequests.TimeTaken),
+			Interval:  utils.NormalizeNilableString(triggers.SlowRequests.TimeInterval),
+			Count:     int(utils.NormaliseNilableInt32(triggers.SlowRequests.Count)),
+			Path:      utils.NormalizeNilableString(triggers.SlowRequests.Path),
+		})
+	}
+
+	if triggers.SlowRequestsWithPath != nil {
+		for _, v := range *triggers.SlowRequestsWithPath {
+			result = append(result, AutoHealSlowRequest{
+				TimeTaken: utils.NormalizeNilableString(v.TimeTaken),
+				Interval:  utils.NormalizeNilableString(v.TimeInterval),
+				Count:     int(utils.NormaliseNilableInt32(v.Count)),
+				Path:      utils.NormalizeNilableString(v.Path),
+			})
+		}
+	}
+
+	return result
+}
+
 func DisabledLogsConfig() *web.SiteLogsConfig {
 	return &web.SiteLogsConfig{
 		SiteLogsConfigProperties: &web.SiteLogsConfigProperties{
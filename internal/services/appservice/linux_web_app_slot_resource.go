@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
 	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -45,6 +46,7 @@ type LinuxWebAppSlotModel struct {
 	StorageAccounts               []helpers.StorageAccount            `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString          `tfschema:"connection_string"`
 	Tags                          map[string]string                   `tfschema:"tags"`
+	VirtualNetworkSubnetID        string                              `tfschema:"virtual_network_subnet_id"`
 	CustomDomainVerificationId    string                              `tfschema:"custom_domain_verification_id"`
 	DefaultHostname               string                              `tfschema:"default_hostname"`
 	Kind                          string                              `tfschema:"kind"`
@@ -160,6 +162,12 @@ func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 		"storage_account": helpers.StorageAccountSchema(),
 
 		"tags": tags.Schema(),
+
+		"virtual_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
 	}
 }
 
@@ -262,6 +270,10 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 				},
 			}
 
+			if webAppSlot.VirtualNetworkSubnetID != "" {
+				siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(webAppSlot.VirtualNetworkSubnetID)
+			}
+
 			if webAppSlot.KeyVaultReferenceIdentityID != "" {
 				siteEnvelope.SiteProperties.KeyVaultReferenceIdentity = utils.String(webAppSlot.KeyVaultReferenceIdentityID)
 			}
@@ -421,6 +433,7 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 				Enabled:                     utils.NormaliseNilableBool(props.Enabled),
 				HttpsOnly:                   utils.NormaliseNilableBool(props.HTTPSOnly),
 				Tags:                        tags.ToTypedObject(webApp.Tags),
+				VirtualNetworkSubnetID:      utils.NormalizeNilableString(props.VirtualNetworkSubnetID),
 			}
 
 			var healthCheckCount *int
@@ -531,6 +544,18 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				existing.KeyVaultReferenceIdentity = utils.String(state.KeyVaultReferenceIdentityID)
 			}
 
+			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
+				if state.VirtualNetworkSubnetID == "" {
+					if _, err := client.DeleteSwiftVirtualNetworkSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName); err != nil {
+						return fmt.Errorf("removing the Virtual Network Integration for Linux %s: %+v", id, err)
+					}
+					existing.SiteProperties.VirtualNetworkSubnetID = nil
+				} else {
+					// the integration is moved to the new subnet in-place, rather than being disconnected first
+					existing.SiteProperties.VirtualNetworkSubnetID = utils.String(state.VirtualNetworkSubnetID)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("tags") {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
@@ -38,10 +38,13 @@ func (r Registration) Resources() []sdk.Resource {
 		return []sdk.Resource{
 			AppServiceSourceControlResource{},
 			AppServiceSourceControlTokenResource{},
+			AppServiceStickySettingsResource{},
+			FunctionAppHybridConnectionResource{},
 			LinuxFunctionAppResource{},
 			LinuxWebAppResource{},
 			LinuxWebAppSlotResource{},
 			ServicePlanResource{},
+			WebAppHybridConnectionResource{},
 			WindowsWebAppResource{},
 			WindowsFunctionAppResource{},
 		}
@@ -0,0 +1,256 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppServiceStickySettingsResource struct{}
+
+type AppServiceStickySettingsModel struct {
+	AppID                 string   `tfschema:"app_id"`
+	AppSettingNames       []string `tfschema:"app_setting_names"`
+	ConnectionStringNames []string `tfschema:"connection_string_names"`
+}
+
+var _ sdk.ResourceWithUpdate = AppServiceStickySettingsResource{}
+
+// normalize ensures omitted lists are sent as empty lists, which clears them, rather than `null` which is ignored by the API
+func (m *AppServiceStickySettingsModel) normalize() {
+	if m.AppSettingNames == nil {
+		m.AppSettingNames = make([]string, 0)
+	}
+	if m.ConnectionStringNames == nil {
+		m.ConnectionStringNames = make([]string, 0)
+	}
+}
+
+func (r AppServiceStickySettingsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppID,
+		},
+
+		"app_setting_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			AtLeastOneOf: []string{
+				"app_setting_names",
+				"connection_string_names",
+			},
+		},
+
+		"connection_string_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			AtLeastOneOf: []string{
+				"app_setting_names",
+				"connection_string_names",
+			},
+		},
+	}
+}
+
+func (r AppServiceStickySettingsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AppServiceStickySettingsResource) ModelObject() interface{} {
+	return &AppServiceStickySettingsModel{}
+}
+
+func (r AppServiceStickySettingsResource) ResourceType() string {
+	return "azurerm_app_service_sticky_settings"
+}
+
+func (r AppServiceStickySettingsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	// This is a meta resource with a 1:1 relationship with the app it's pointed at so we use the same ID
+	return validate.WebAppID
+}
+
+func (r AppServiceStickySettingsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var stickySettings AppServiceStickySettingsModel
+			if err := metadata.Decode(&stickySettings); err != nil {
+				return err
+			}
+			stickySettings.normalize()
+
+			id, err := parse.WebAppID(stickySettings.AppID)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("checking for existing Sticky Settings on %s: %+v", id, err)
+			}
+			if names := existing.SlotConfigNames; names != nil {
+				if (names.AppSettingNames != nil && len(*names.AppSettingNames) > 0) || (names.ConnectionStringNames != nil && len(*names.ConnectionStringNames) > 0) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			payload := web.SlotConfigNamesResource{
+				SlotConfigNames: &web.SlotConfigNames{
+					AppSettingNames:       &stickySettings.AppSettingNames,
+					ConnectionStringNames: &stickySettings.ConnectionStringNames,
+				},
+			}
+			// the Azure Storage mounts aren't managed by this resource, so any existing values are retained
+			if existing.SlotConfigNames != nil {
+				payload.SlotConfigNames.AzureStorageConfigNames = existing.SlotConfigNames.AzureStorageConfigNames
+			}
+
+			if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, payload); err != nil {
+				return fmt.Errorf("creating Sticky Settings for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AppServiceStickySettingsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading Sticky Settings for %s: %+v", id, err)
+			}
+
+			state := AppServiceStickySettingsModel{
+				AppID:                 id.ID(),
+				AppSettingNames:       make([]string, 0),
+				ConnectionStringNames: make([]string, 0),
+			}
+
+			if names := resp.SlotConfigNames; names != nil {
+				if names.AppSettingNames != nil {
+					state.AppSettingNames = *names.AppSettingNames
+				}
+				if names.ConnectionStringNames != nil {
+					state.ConnectionStringNames = *names.ConnectionStringNames
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AppServiceStickySettingsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var stickySettings AppServiceStickySettingsModel
+			if err := metadata.Decode(&stickySettings); err != nil {
+				return err
+			}
+			stickySettings.normalize()
+
+			existing, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading Sticky Settings for %s: %+v", id, err)
+			}
+			if existing.SlotConfigNames == nil {
+				existing.SlotConfigNames = &web.SlotConfigNames{}
+			}
+
+			if metadata.ResourceData.HasChange("app_setting_names") {
+				existing.SlotConfigNames.AppSettingNames = &stickySettings.AppSettingNames
+			}
+
+			if metadata.ResourceData.HasChange("connection_string_names") {
+				existing.SlotConfigNames.ConnectionStringNames = &stickySettings.ConnectionStringNames
+			}
+
+			if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, existing); err != nil {
+				return fmt.Errorf("updating Sticky Settings for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AppServiceStickySettingsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+				return fmt.Errorf("reading Sticky Settings for %s: %+v", id, err)
+			}
+
+			payload := web.SlotConfigNamesResource{
+				SlotConfigNames: &web.SlotConfigNames{
+					AppSettingNames:       &[]string{},
+					ConnectionStringNames: &[]string{},
+				},
+			}
+			if existing.SlotConfigNames != nil {
+				payload.SlotConfigNames.AzureStorageConfigNames = existing.SlotConfigNames.AzureStorageConfigNames
+			}
+
+			if _, err := client.UpdateSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName, payload); err != nil {
+				return fmt.Errorf("deleting Sticky Settings for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AppServiceStickySettingsResource struct{}
+
+func TestAccAppServiceStickySettings_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_sticky_settings", "test")
+	r := AppServiceStickySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_setting_names.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAppServiceStickySettings_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_sticky_settings", "test")
+	r := AppServiceStickySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAppServiceStickySettings_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_sticky_settings", "test")
+	r := AppServiceStickySettingsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("app_setting_names.#").HasValue("2"),
+				check.That(data.ResourceName).Key("connection_string_names.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("connection_string_names.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AppServiceStickySettingsResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.ListSlotConfigurationNames(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Sticky Settings for %s: %v", id, err)
+	}
+
+	names := resp.SlotConfigNames
+	if names == nil {
+		return utils.Bool(false), nil
+	}
+
+	hasAppSettings := names.AppSettingNames != nil && len(*names.AppSettingNames) > 0
+	hasConnectionStrings := names.ConnectionStringNames != nil && len(*names.ConnectionStringNames) > 0
+	return utils.Bool(hasAppSettings || hasConnectionStrings), nil
+}
+
+func (r AppServiceStickySettingsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_app_service_sticky_settings" "test" {
+  app_id            = azurerm_linux_web_app.test.id
+  app_setting_names = ["SLOT_SPECIFIC_SETTING"]
+}
+`, baseLinuxAppTemplate(data))
+}
+
+func (r AppServiceStickySettingsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_app_service_sticky_settings" "import" {
+  app_id            = azurerm_app_service_sticky_settings.test.app_id
+  app_setting_names = azurerm_app_service_sticky_settings.test.app_setting_names
+}
+`, r.basic(data))
+}
+
+func (r AppServiceStickySettingsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_app_service_sticky_settings" "test" {
+  app_id                  = azurerm_linux_web_app.test.id
+  app_setting_names       = ["SLOT_SPECIFIC_SETTING", "ANOTHER_SLOT_SPECIFIC_SETTING"]
+  connection_string_names = ["SlotDatabase"]
+}
+`, baseLinuxAppTemplate(data))
+}
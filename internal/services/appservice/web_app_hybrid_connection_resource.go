@@ -0,0 +1,291 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	relayParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/namespaces"
+	relayValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppHybridConnectionResource struct{}
+
+type WebAppHybridConnectionModel struct {
+	WebAppId            string `tfschema:"web_app_id"`
+	RelayId             string `tfschema:"relay_id"`
+	HostName            string `tfschema:"hostname"`
+	Port                int    `tfschema:"port"`
+	SendKeyName         string `tfschema:"send_key_name"`
+	NamespaceName       string `tfschema:"namespace_name"`
+	RelayName           string `tfschema:"relay_name"`
+	ServiceBusNamespace string `tfschema:"service_bus_namespace"`
+	ServiceBusSuffix    string `tfschema:"service_bus_suffix"`
+	SendKeyValue        string `tfschema:"send_key_value"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppHybridConnectionResource{}
+
+func (r WebAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WebAppID,
+		},
+
+		"relay_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: relayValidate.HybridConnectionID,
+		},
+
+		"hostname": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: azValidate.PortNumberOrZero,
+		},
+
+		"send_key_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "RootManageSharedAccessKey",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r WebAppHybridConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return hybridConnectionComputedSchema()
+}
+
+func (r WebAppHybridConnectionResource) ModelObject() interface{} {
+	return &WebAppHybridConnectionModel{}
+}
+
+func (r WebAppHybridConnectionResource) ResourceType() string {
+	return "azurerm_web_app_hybrid_connection"
+}
+
+func (r WebAppHybridConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppHybridConnectionID
+}
+
+func (r WebAppHybridConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var appHybridConn WebAppHybridConnectionModel
+			if err := metadata.Decode(&appHybridConn); err != nil {
+				return err
+			}
+
+			appId, err := parse.WebAppID(appHybridConn.WebAppId)
+			if err != nil {
+				return err
+			}
+
+			relayId, err := relayParse.HybridConnectionID(appHybridConn.RelayId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWebAppHybridConnectionID(appId.SubscriptionId, appId.ResourceGroup, appId.SiteName, relayId.NamespaceName, relayId.HybridConnectionName)
+
+			existing, err := client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			envelope := expandHybridConnection(appHybridConn.RelayId, appHybridConn.HostName, appHybridConn.Port, appHybridConn.SendKeyName)
+			if _, err := client.CreateOrUpdateHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, envelope); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WebAppHybridConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppHybridConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			appHybridConn := WebAppHybridConnectionModel{
+				WebAppId:      parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName).ID(),
+				NamespaceName: id.HybridConnectionNamespaceName,
+				RelayName:     id.RelayName,
+			}
+
+			if props := existing.HybridConnectionProperties; props != nil {
+				appHybridConn.RelayId = utils.NormalizeNilableString(props.RelayArmURI)
+				appHybridConn.HostName = utils.NormalizeNilableString(props.Hostname)
+				appHybridConn.Port = int(utils.NormaliseNilableInt32(props.Port))
+				appHybridConn.SendKeyName = utils.NormalizeNilableString(props.SendKeyName)
+				appHybridConn.ServiceBusNamespace = utils.NormalizeNilableString(props.ServiceBusNamespace)
+				appHybridConn.ServiceBusSuffix = utils.NormalizeNilableString(props.ServiceBusSuffix)
+
+				sendKeyValue, err := hybridConnectionSendKeyValue(ctx, metadata.Client.Relay.NamespacesClient, props)
+				if err != nil {
+					return fmt.Errorf("retrieving Send Key Value for %s: %+v", id, err)
+				}
+				appHybridConn.SendKeyValue = sendKeyValue
+			}
+
+			return metadata.Encode(&appHybridConn)
+		},
+	}
+}
+
+func (r WebAppHybridConnectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppHybridConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var appHybridConn WebAppHybridConnectionModel
+			if err := metadata.Decode(&appHybridConn); err != nil {
+				return err
+			}
+
+			envelope := expandHybridConnection(appHybridConn.RelayId, appHybridConn.HostName, appHybridConn.Port, appHybridConn.SendKeyName)
+			if _, err := client.CreateOrUpdateHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName, envelope); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppHybridConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.WebAppHybridConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.DeleteHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName); err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func hybridConnectionComputedSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"namespace_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"relay_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"service_bus_namespace": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"service_bus_suffix": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"send_key_value": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func expandHybridConnection(relayId string, hostName string, port int, sendKeyName string) web.HybridConnection {
+	return web.HybridConnection{
+		HybridConnectionProperties: &web.HybridConnectionProperties{
+			RelayArmURI:  utils.String(relayId),
+			Hostname:     utils.String(hostName),
+			Port:         utils.Int32(int32(port)),
+			SendKeyName:  utils.String(sendKeyName),
+			SendKeyValue: utils.String(""), // The service creates this no matter what is sent, but the API requires the field to be set
+		},
+	}
+}
+
+// hybridConnectionSendKeyValue retrieves the primary key of the Send Key, since key values are not returned by the App Service API.
+// The Relay Namespace is looked up using the Relay ID rather than the Service Bus Namespace name, so that it can be in any Resource Group.
+func hybridConnectionSendKeyValue(ctx context.Context, client *namespaces.NamespacesClient, props *web.HybridConnectionProperties) (string, error) {
+	if props.RelayArmURI == nil || props.SendKeyName == nil {
+		return "", nil
+	}
+
+	relayId, err := relayParse.HybridConnectionID(*props.RelayArmURI)
+	if err != nil {
+		return "", err
+	}
+
+	authRuleId := namespaces.NewAuthorizationRuleID(relayId.SubscriptionId, relayId.ResourceGroupName, relayId.NamespaceName, *props.SendKeyName)
+	keys, err := client.ListKeys(ctx, authRuleId)
+	if err != nil {
+		return "", fmt.Errorf("listing keys for %s: %+v", authRuleId, err)
+	}
+
+	if model := keys.Model; model != nil && model.PrimaryKey != nil {
+		return *model.PrimaryKey, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,174 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppHybridConnectionResource struct{}
+
+func TestAccWebAppHybridConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_hybrid_connection", "test")
+	r := WebAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("send_key_value").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppHybridConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_hybrid_connection", "test")
+	r := WebAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccWebAppHybridConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_hybrid_connection", "test")
+	r := WebAppHybridConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("port").HasValue("8081"),
+				check.That(data.ResourceName).Key("send_key_name").HasValue("sendKey"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WebAppHybridConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WebAppHybridConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.WebAppsClient.GetHybridConnection(ctx, id.ResourceGroup, id.SiteName, id.HybridConnectionNamespaceName, id.RelayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.HybridConnectionProperties != nil), nil
+}
+
+func (r WebAppHybridConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_hybrid_connection" "test" {
+  web_app_id = azurerm_linux_web_app.test.id
+  relay_id   = azurerm_relay_hybrid_connection.test.id
+  hostname   = "acctest%[2]s.hostname"
+  port       = 8080
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r WebAppHybridConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_app_hybrid_connection" "import" {
+  web_app_id = azurerm_web_app_hybrid_connection.test.web_app_id
+  relay_id   = azurerm_web_app_hybrid_connection.test.relay_id
+  hostname   = azurerm_web_app_hybrid_connection.test.hostname
+  port       = azurerm_web_app_hybrid_connection.test.port
+}
+`, r.basic(data))
+}
+
+func (r WebAppHybridConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_relay_namespace_authorization_rule" "test" {
+  name                = "sendKey"
+  resource_group_name = azurerm_resource_group.test.name
+  namespace_name      = azurerm_relay_namespace.test.name
+
+  listen = true
+  send   = true
+  manage = false
+}
+
+resource "azurerm_web_app_hybrid_connection" "test" {
+  web_app_id    = azurerm_linux_web_app.test.id
+  relay_id      = azurerm_relay_hybrid_connection.test.id
+  hostname      = "acctest%[2]s.hostname"
+  port          = 8081
+  send_key_name = azurerm_relay_namespace_authorization_rule.test.name
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r WebAppHybridConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctest-RN-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctest-RN-HC-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+  user_metadata        = "metadatatest"
+}
+`, baseLinuxAppTemplate(data), data.RandomInteger)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -49,6 +50,7 @@ type WindowsFunctionAppModel struct {
 	Identity                  []helpers.Identity                     `tfschema:"identity"`
 	SiteConfig                []helpers.SiteConfigWindowsFunctionApp `tfschema:"site_config"`
 	Tags                      map[string]string                      `tfschema:"tags"`
+	VirtualNetworkSubnetID    string                                 `tfschema:"virtual_network_subnet_id"`
 
 	// Computed
 	CustomDomainVerificationId    string   `tfschema:"custom_domain_verification_id"`
@@ -213,6 +215,12 @@ func (r WindowsFunctionAppResource) Arguments() map[string]*pluginsdk.Schema {
 		"site_config": helpers.SiteConfigSchemaWindowsFunctionApp(),
 
 		"tags": tags.Schema(),
+
+		"virtual_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
 	}
 }
 
@@ -400,6 +408,10 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 				},
 			}
 
+			if functionApp.VirtualNetworkSubnetID != "" {
+				siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(functionApp.VirtualNetworkSubnetID)
+			}
+
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, siteEnvelope)
 			if err != nil {
 				return fmt.Errorf("creating Windows %s: %+v", id, err)
@@ -514,15 +526,16 @@ func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
 			}
 
 			state := WindowsFunctionAppModel{
-				Name:                 id.SiteName,
-				ResourceGroup:        id.ResourceGroup,
-				ServicePlanId:        utils.NormalizeNilableString(props.ServerFarmID),
-				Location:             location.NormalizeNilable(functionApp.Location),
-				Enabled:              utils.NormaliseNilableBool(functionApp.Enabled),
-				ClientCertMode:       string(functionApp.ClientCertMode),
-				DailyMemoryTimeQuota: int(utils.NormaliseNilableInt32(props.DailyMemoryTimeQuota)),
-				Tags:                 tags.ToTypedObject(functionApp.Tags),
-				Kind:                 utils.NormalizeNilableString(functionApp.Kind),
+				Name:                   id.SiteName,
+				ResourceGroup:          id.ResourceGroup,
+				ServicePlanId:          utils.NormalizeNilableString(props.ServerFarmID),
+				Location:               location.NormalizeNilable(functionApp.Location),
+				Enabled:                utils.NormaliseNilableBool(functionApp.Enabled),
+				ClientCertMode:         string(functionApp.ClientCertMode),
+				DailyMemoryTimeQuota:   int(utils.NormaliseNilableInt32(props.DailyMemoryTimeQuota)),
+				Tags:                   tags.ToTypedObject(functionApp.Tags),
+				VirtualNetworkSubnetID: utils.NormalizeNilableString(props.VirtualNetworkSubnetID),
+				Kind:                   utils.NormalizeNilableString(functionApp.Kind),
 			}
 
 			if identity := helpers.FlattenIdentity(functionApp.Identity); identity != nil {
@@ -628,6 +641,18 @@ func (r WindowsFunctionAppResource) Update() sdk.ResourceFunc {
 				existing.Identity = helpers.ExpandIdentity(state.Identity)
 			}
 
+			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
+				if state.VirtualNetworkSubnetID == "" {
+					if _, err := client.DeleteSwiftVirtualNetwork(ctx, id.ResourceGroup, id.SiteName); err != nil {
+						return fmt.Errorf("removing the Virtual Network Integration for Windows %s: %+v", id, err)
+					}
+					existing.SiteProperties.VirtualNetworkSubnetID = nil
+				} else {
+					// the integration is moved to the new subnet in-place, rather than being disconnected first
+					existing.SiteProperties.VirtualNetworkSubnetID = utils.String(state.VirtualNetworkSubnetID)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("tags") {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -48,6 +49,7 @@ type WindowsWebAppModel struct {
 	PossibleOutboundIPAddressList []string                    `tfschema:"possible_outbound_ip_address_list"`
 	SiteCredentials               []helpers.SiteCredential    `tfschema:"site_credential"`
 	Tags                          map[string]string           `tfschema:"tags"`
+	VirtualNetworkSubnetID        string                      `tfschema:"virtual_network_subnet_id"`
 }
 
 var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
@@ -130,6 +132,12 @@ func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 		"storage_account": helpers.StorageAccountSchemaWindows(),
 
 		"tags": tags.Schema(),
+
+		"virtual_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
 	}
 }
 
@@ -281,6 +289,10 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 				},
 			}
 
+			if webApp.VirtualNetworkSubnetID != "" {
+				siteEnvelope.SiteProperties.VirtualNetworkSubnetID = utils.String(webApp.VirtualNetworkSubnetID)
+			}
+
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.SiteName, siteEnvelope)
 			if err != nil {
 				return fmt.Errorf("creating Windows %s: %+v", id, err)
@@ -453,6 +465,8 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 				state.ClientCertEnabled = *v
 			}
 
+			state.VirtualNetworkSubnetID = utils.NormalizeNilableString(webAppProps.VirtualNetworkSubnetID)
+
 			if webAppProps.ClientCertMode != "" {
 				state.ClientCertMode = string(webAppProps.ClientCertMode)
 			}
@@ -586,6 +600,18 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				existing.Identity = helpers.ExpandIdentity(state.Identity)
 			}
 
+			if metadata.ResourceData.HasChange("virtual_network_subnet_id") {
+				if state.VirtualNetworkSubnetID == "" {
+					if _, err := client.DeleteSwiftVirtualNetwork(ctx, id.ResourceGroup, id.SiteName); err != nil {
+						return fmt.Errorf("removing the Virtual Network Integration for Windows %s: %+v", id, err)
+					}
+					existing.SiteProperties.VirtualNetworkSubnetID = nil
+				} else {
+					// the integration is moved to the new subnet in-place, rather than being disconnected first
+					existing.SiteProperties.VirtualNetworkSubnetID = utils.String(state.VirtualNetworkSubnetID)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("tags") {
 				existing.Tags = tags.FromTypedObject(state.Tags)
 			}
@@ -0,0 +1,271 @@
+package arcresourcebridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/arcresourcebridge/sdk/2022-10-27/appliances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcResourceBridgeApplianceResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcResourceBridgeApplianceResource{}
+
+type ArcResourceBridgeApplianceResourceModel struct {
+	Name                   string            `tfschema:"name"`
+	ResourceGroupName      string            `tfschema:"resource_group_name"`
+	Location               string            `tfschema:"location"`
+	Distro                 string            `tfschema:"distro"`
+	InfrastructureProvider string            `tfschema:"infrastructure_provider"`
+	PublicKeyBase64        string            `tfschema:"public_key_base64"`
+	Tags                   map[string]string `tfschema:"tags"`
+}
+
+func (r ArcResourceBridgeApplianceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"distro": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(appliances.DistroAKSEdge),
+			ValidateFunc: validation.StringInSlice(appliances.PossibleValuesForDistro(), false),
+		},
+
+		"infrastructure_provider": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(appliances.PossibleValuesForProvider(), false),
+		},
+
+		// the Appliance only supports a System Assigned Identity, which can't be changed once created
+		"identity": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(identity.TypeSystemAssigned),
+						}, false),
+					},
+
+					"principal_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tenant_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"public_key_base64": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsBase64,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcResourceBridgeApplianceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ArcResourceBridgeApplianceResource) ModelObject() interface{} {
+	return &ArcResourceBridgeApplianceResourceModel{}
+}
+
+func (r ArcResourceBridgeApplianceResource) ResourceType() string {
+	return "azurerm_arc_resource_bridge_appliance"
+}
+
+func (r ArcResourceBridgeApplianceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return appliances.ValidateApplianceID
+}
+
+func (r ArcResourceBridgeApplianceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ArcResourceBridge.AppliancesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ArcResourceBridgeApplianceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := appliances.NewApplianceID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAssigned(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			distro := appliances.Distro(model.Distro)
+			provider := appliances.Provider(model.InfrastructureProvider)
+			payload := appliances.Appliance{
+				Identity: identityValue,
+				Location: location.Normalize(model.Location),
+				Properties: &appliances.ApplianceProperties{
+					Distro: &distro,
+					InfrastructureConfig: &appliances.AppliancePropertiesInfrastructureConfig{
+						Provider: &provider,
+					},
+				},
+				Tags: &model.Tags,
+			}
+			if model.PublicKeyBase64 != "" {
+				payload.Properties.PublicKey = utils.String(model.PublicKeyBase64)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcResourceBridgeApplianceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ArcResourceBridge.AppliancesClient
+
+			id, err := appliances.ParseApplianceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcResourceBridgeApplianceResourceModel{
+				Name:              id.ApplianceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if err := metadata.ResourceData.Set("identity", identity.FlattenSystemAssigned(model.Identity)); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					if props.Distro != nil {
+						state.Distro = string(*props.Distro)
+					}
+					if config := props.InfrastructureConfig; config != nil && config.Provider != nil {
+						state.InfrastructureProvider = string(*config.Provider)
+					}
+					state.PublicKeyBase64 = utils.NormalizeNilableString(props.PublicKey)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcResourceBridgeApplianceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ArcResourceBridge.AppliancesClient
+
+			id, err := appliances.ParseApplianceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcResourceBridgeApplianceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// only the tags can be updated, everything else is ForceNew
+			if metadata.ResourceData.HasChange("tags") {
+				payload := appliances.PatchableAppliance{
+					Tags: &model.Tags,
+				}
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcResourceBridgeApplianceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ArcResourceBridge.AppliancesClient
+
+			id, err := appliances.ParseApplianceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,200 @@
+package arcresourcebridge_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/arcresourcebridge/sdk/2022-10-27/appliances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcResourceBridgeApplianceResource struct{}
+
+func TestAccArcResourceBridgeAppliance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_resource_bridge_appliance", "test")
+	r := ArcResourceBridgeApplianceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcResourceBridgeAppliance_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_resource_bridge_appliance", "test")
+	r := ArcResourceBridgeApplianceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcResourceBridgeAppliance_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_resource_bridge_appliance", "test")
+	r := ArcResourceBridgeApplianceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcResourceBridgeAppliance_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_resource_bridge_appliance", "test")
+	r := ArcResourceBridgeApplianceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updateTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ArcResourceBridgeApplianceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := appliances.ParseApplianceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ArcResourceBridge.AppliancesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ArcResourceBridgeApplianceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-arcbridge-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ArcResourceBridgeApplianceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_resource_bridge_appliance" "test" {
+  name                    = "acctest-arcbridge-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  infrastructure_provider = "VMWare"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ArcResourceBridgeApplianceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_resource_bridge_appliance" "import" {
+  name                    = azurerm_arc_resource_bridge_appliance.test.name
+  resource_group_name     = azurerm_arc_resource_bridge_appliance.test.resource_group_name
+  location                = azurerm_arc_resource_bridge_appliance.test.location
+  infrastructure_provider = azurerm_arc_resource_bridge_appliance.test.infrastructure_provider
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ArcResourceBridgeApplianceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_resource_bridge_appliance" "test" {
+  name                    = "acctest-arcbridge-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  distro                  = "AKSEdge"
+  infrastructure_provider = "HCI"
+  public_key_base64       = base64encode("acctest-public-key-%d")
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ArcResourceBridgeApplianceResource) updateTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_resource_bridge_appliance" "test" {
+  name                    = "acctest-arcbridge-%d"
+  resource_group_name     = azurerm_resource_group.test.name
+  location                = azurerm_resource_group.test.location
+  infrastructure_provider = "VMWare"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/arcresourcebridge/sdk/2022-10-27/appliances"
+)
+
+type Client struct {
+	AppliancesClient *appliances.AppliancesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	appliancesClient := appliances.NewAppliancesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&appliancesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AppliancesClient: &appliancesClient,
+	}
+}
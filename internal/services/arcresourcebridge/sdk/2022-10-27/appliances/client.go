@@ -0,0 +1,15 @@
+package appliances
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AppliancesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAppliancesClientWithBaseURI(endpoint string) AppliancesClient {
+	return AppliancesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
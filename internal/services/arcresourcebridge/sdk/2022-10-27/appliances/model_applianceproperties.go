@@ -0,0 +1,10 @@
+package appliances
+
+type ApplianceProperties struct {
+	Distro               *Distro                                  `json:"distro,omitempty"`
+	InfrastructureConfig *AppliancePropertiesInfrastructureConfig `json:"infrastructureConfig,omitempty"`
+	ProvisioningState    *string                                  `json:"provisioningState,omitempty"`
+	PublicKey            *string                                  `json:"publicKey,omitempty"`
+	Status               *Status                                  `json:"status,omitempty"`
+	Version              *string                                  `json:"version,omitempty"`
+}
@@ -0,0 +1,5 @@
+package appliances
+
+type AppliancePropertiesInfrastructureConfig struct {
+	Provider *Provider `json:"provider,omitempty"`
+}
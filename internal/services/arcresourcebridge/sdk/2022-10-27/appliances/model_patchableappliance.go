@@ -0,0 +1,5 @@
+package appliances
+
+type PatchableAppliance struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package appliances
+
+import "fmt"
+
+const defaultApiVersion = "2022-10-27"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/appliances/%s", defaultApiVersion)
+}
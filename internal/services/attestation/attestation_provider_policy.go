@@ -0,0 +1,191 @@
+package attestation
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/sdk/2020-10-01/attestation"
+)
+
+// unsignedAttestationPolicyResetToken is an unsigned JSON Web Token with an empty body, which is used
+// to revert an attestation type back to the default policy on providers without a signing certificate
+const unsignedAttestationPolicyResetToken = "eyJhbGciOiJub25lIn0.."
+
+type attestationPolicy struct {
+	environmentType attestation.Type
+	data            string
+}
+
+func expandAttestationProviderPolicies(input []interface{}) []attestationPolicy {
+	results := make([]attestationPolicy, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		results = append(results, attestationPolicy{
+			environmentType: attestation.Type(v["environment_type"].(string)),
+			data:            v["data"].(string),
+		})
+	}
+	return results
+}
+
+func setAttestationProviderPolicies(ctx context.Context, client *attestation.PolicyClient, attestUri string, policies []attestationPolicy) error {
+	for _, policy := range policies {
+		if _, err := client.Set(ctx, attestUri, policy.environmentType, policy.data); err != nil {
+			return fmt.Errorf("setting the %q policy: %+v", string(policy.environmentType), err)
+		}
+	}
+	return nil
+}
+
+func resetAttestationProviderPolicies(ctx context.Context, client *attestation.PolicyClient, attestUri string, environmentTypes []attestation.Type) error {
+	for _, environmentType := range environmentTypes {
+		if _, err := client.Reset(ctx, attestUri, environmentType, unsignedAttestationPolicyResetToken); err != nil {
+			return fmt.Errorf("resetting the %q policy: %+v", string(environmentType), err)
+		}
+	}
+	return nil
+}
+
+// removedAttestationProviderPolicyTypes returns the environment types which are present in `old` but not `new`
+func removedAttestationProviderPolicyTypes(old, new []attestationPolicy) []attestation.Type {
+	remaining := make(map[attestation.Type]struct{})
+	for _, policy := range new {
+		remaining[policy.environmentType] = struct{}{}
+	}
+
+	results := make([]attestation.Type, 0)
+	for _, policy := range old {
+		if _, ok := remaining[policy.environmentType]; !ok {
+			results = append(results, policy.environmentType)
+		}
+	}
+	return results
+}
+
+// validateAttestationProviderPolicies ensures each environment type is only specified once and that, when a policy
+// signing certificate is configured (making this an Isolated provider), each policy has been signed by that certificate
+// since the service would otherwise reject it
+func validateAttestationProviderPolicies(policies []attestationPolicy, signingCertificate string) error {
+	seen := make(map[attestation.Type]struct{})
+	for _, policy := range policies {
+		if _, ok := seen[policy.environmentType]; ok {
+			return fmt.Errorf("only one `policy` block can be specified for the environment type %q", string(policy.environmentType))
+		}
+		seen[policy.environmentType] = struct{}{}
+	}
+
+	if signingCertificate == "" {
+		return nil
+	}
+
+	block, _ := pem.Decode([]byte(signingCertificate))
+	if block == nil {
+		return fmt.Errorf("`policy_signing_certificate_data` is an invalid X.509 certificate, unable to decode")
+	}
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("parsing `policy_signing_certificate_data`: %+v", err)
+	}
+
+	for _, policy := range policies {
+		// the value may not be known yet (e.g. when it's interpolated from another resource)
+		if policy.data == "" {
+			continue
+		}
+		if err := verifyAttestationPolicySignature(policy.data, certificate); err != nil {
+			return fmt.Errorf("the %q policy must be signed by the `policy_signing_certificate_data`: %+v", string(policy.environmentType), err)
+		}
+	}
+
+	return nil
+}
+
+func verifyAttestationPolicySignature(token string, certificate *x509.Certificate) error {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return fmt.Errorf("expected a JSON Web Token containing 3 segments but got %d", len(segments))
+	}
+
+	rawHeader, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[0], "="))
+	if err != nil {
+		return fmt.Errorf("decoding header: %+v", err)
+	}
+	var header struct {
+		Algorithm string `json:"alg"`
+	}
+	if err := json.Unmarshal(rawHeader, &header); err != nil {
+		return fmt.Errorf("unmarshaling header: %+v", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[2], "="))
+	if err != nil {
+		return fmt.Errorf("decoding signature: %+v", err)
+	}
+	if len(signature) == 0 {
+		return fmt.Errorf("the policy is unsigned")
+	}
+
+	if len(header.Algorithm) != 5 {
+		return fmt.Errorf("unsupported signing algorithm %q", header.Algorithm)
+	}
+
+	var hash crypto.Hash
+	switch header.Algorithm[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	default:
+		return fmt.Errorf("unsupported signing algorithm %q", header.Algorithm)
+	}
+	hasher := hash.New()
+	hasher.Write([]byte(segments[0] + "." + segments[1]))
+	digest := hasher.Sum(nil)
+
+	switch {
+	case strings.HasPrefix(header.Algorithm, "RS"):
+		publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("the algorithm %q requires an RSA certificate", header.Algorithm)
+		}
+		return rsa.VerifyPKCS1v15(publicKey, hash, digest, signature)
+
+	case strings.HasPrefix(header.Algorithm, "PS"):
+		publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("the algorithm %q requires an RSA certificate", header.Algorithm)
+		}
+		return rsa.VerifyPSS(publicKey, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+
+	case strings.HasPrefix(header.Algorithm, "ES"):
+		publicKey, ok := certificate.PublicKey.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("the algorithm %q requires an ECDSA certificate", header.Algorithm)
+		}
+		if len(signature)%2 != 0 {
+			return fmt.Errorf("invalid ECDSA signature length %d", len(signature))
+		}
+		r := new(big.Int).SetBytes(signature[:len(signature)/2])
+		s := new(big.Int).SetBytes(signature[len(signature)/2:])
+		if !ecdsa.Verify(publicKey, digest, r, s) {
+			return fmt.Errorf("the signature does not match the certificate")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unsupported signing algorithm %q", header.Algorithm)
+}
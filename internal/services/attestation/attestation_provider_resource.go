@@ -1,6 +1,7 @@
 package attestation
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
@@ -14,10 +15,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/sdk/2020-10-01/attestation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/sdk/2020-10-01/attestationproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
@@ -40,6 +43,11 @@ func resourceAttestationProvider() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			policies := expandAttestationProviderPolicies(diff.Get("policy").([]interface{}))
+			return validateAttestationProviderPolicies(policies, diff.Get("policy_signing_certificate_data").(string))
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -59,6 +67,32 @@ func resourceAttestationProvider() *pluginsdk.Resource {
 				ValidateFunc: validate.IsCert,
 			},
 
+			"policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"environment_type": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(attestation.TypeOpenEnclave),
+								string(attestation.TypeSgxEnclave),
+								string(attestation.TypeTpm),
+							}, false),
+						},
+
+						// a JSON Web Token containing the policy, which must be signed by the
+						// `policy_signing_certificate_data` when one is specified
+						"data": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.AttestationPolicy,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"attestation_uri": {
@@ -117,11 +151,28 @@ func resourceAttestationProviderCreate(d *pluginsdk.ResourceData, meta interface
 		props.Properties.PolicySigningCertificates = expandArmAttestationProviderJSONWebKeySet(v)
 	}
 
-	if _, err := client.Create(ctx, id, props); err != nil {
+	resp, err := client.Create(ctx, id, props)
+	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
+
+	if policies := expandAttestationProviderPolicies(d.Get("policy").([]interface{})); len(policies) > 0 {
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.AttestUri == nil {
+			return fmt.Errorf("retrieving %s: `properties.attestUri` was nil", id)
+		}
+
+		policyClient, err := meta.(*clients.Client).Attestation.PolicyClient()
+		if err != nil {
+			return fmt.Errorf("building Policy client for %s: %+v", id, err)
+		}
+
+		if err := setAttestationProviderPolicies(ctx, policyClient, *resp.Model.Properties.AttestUri, policies); err != nil {
+			return fmt.Errorf("updating policies for %s: %+v", id, err)
+		}
+	}
+
 	return resourceAttestationProviderRead(d, meta)
 }
 
@@ -176,9 +227,41 @@ func resourceAttestationProviderUpdate(d *pluginsdk.ResourceData, meta interface
 		updateParams.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	if _, err := client.Update(ctx, *id, updateParams); err != nil {
+	resp, err := client.Update(ctx, *id, updateParams)
+	if err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
+
+	if d.HasChange("policy") {
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.AttestUri == nil {
+			return fmt.Errorf("retrieving %s: `properties.attestUri` was nil", *id)
+		}
+		attestUri := *resp.Model.Properties.AttestUri
+
+		policyClient, err := meta.(*clients.Client).Attestation.PolicyClient()
+		if err != nil {
+			return fmt.Errorf("building Policy client for %s: %+v", *id, err)
+		}
+
+		oldRaw, newRaw := d.GetChange("policy")
+		oldPolicies := expandAttestationProviderPolicies(oldRaw.([]interface{}))
+		newPolicies := expandAttestationProviderPolicies(newRaw.([]interface{}))
+
+		if removed := removedAttestationProviderPolicyTypes(oldPolicies, newPolicies); len(removed) > 0 {
+			// Isolated providers only accept a reset which is signed by the policy signing certificate, which
+			// Terraform doesn't have access to - so the existing policy is left in place
+			if d.Get("policy_signing_certificate_data").(string) != "" {
+				log.Printf("[DEBUG] Skipping resetting the removed policies for %s since the provider requires a signed reset", *id)
+			} else if err := resetAttestationProviderPolicies(ctx, policyClient, attestUri, removed); err != nil {
+				return fmt.Errorf("updating policies for %s: %+v", *id, err)
+			}
+		}
+
+		if err := setAttestationProviderPolicies(ctx, policyClient, attestUri, newPolicies); err != nil {
+			return fmt.Errorf("updating policies for %s: %+v", *id, err)
+		}
+	}
+
 	return resourceAttestationProviderRead(d, meta)
 }
 
@@ -6,8 +6,10 @@ import (
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"math/big"
@@ -126,6 +128,57 @@ func TestAccAttestationProvider_update(t *testing.T) {
 	})
 }
 
+func TestAccAttestationProvider_policy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_attestation_provider", "test")
+	r := AttestationProviderResource{}
+	randStr := strings.ToLower(acceptance.RandString(10))
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.policy(data, randStr),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the policies are write-only since the API returns the stored policy rather than the submitted token
+		data.ImportStep("policy"),
+		{
+			Config: r.policyUpdated(data, randStr),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("policy"),
+		{
+			Config: r.basic(data, randStr),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAttestationProvider_signedPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_attestation_provider", "test")
+	r := AttestationProviderResource{}
+	randStr := strings.ToLower(acceptance.RandString(10))
+	testCertificate, signedPolicy, err := testGenerateSignedAttestationPolicy("ENCOM", testAttestationPolicy)
+	if err != nil {
+		t.Fatalf("Test case failed: '%+v'", err)
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.signedPolicy(data, randStr, testCertificate, signedPolicy),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("policy", "policy_signing_certificate_data"),
+	})
+}
+
 func (t AttestationProviderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := attestationproviders.ParseAttestationProvidersID(state.ID)
 	if err != nil {
@@ -172,6 +225,60 @@ func testGenerateTestCertificate(organization string) (string, error) {
 	return encoded.String(), nil
 }
 
+const testAttestationPolicy = "version= 1.0;authorizationrules{=> permit();};issuancerules{};"
+
+func testUnsignedAttestationPolicy(policy string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	body := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"AttestationPolicy":%q}`, base64.RawURLEncoding.EncodeToString([]byte(policy)))))
+	return fmt.Sprintf("%s.%s.", header, body)
+}
+
+// testGenerateSignedAttestationPolicy generates a self-signed certificate and an ES256 JSON Web Token containing
+// the policy which is signed by the private key of that certificate
+func testGenerateSignedAttestationPolicy(organization string, policy string) (string, string, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	rawCert := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			Organization: []string{organization},
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour * 24 * 180),
+
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, &rawCert, &rawCert, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to create test certificate: %+v", err)
+	}
+
+	encoded := &bytes.Buffer{}
+	if err := pem.Encode(encoded, &pem.Block{Type: "CERTIFICATE", Bytes: certBytes}); err != nil {
+		return "", "", fmt.Errorf("unable to pem encode test certificate: %+v", err)
+	}
+
+	header := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"alg":"ES256","x5c":[%q]}`, base64.StdEncoding.EncodeToString(certBytes))))
+	body := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"AttestationPolicy":%q}`, base64.RawURLEncoding.EncodeToString([]byte(policy)))))
+	digest := sha256.Sum256([]byte(header + "." + body))
+
+	r, s, err := ecdsa.Sign(rand.Reader, privateKey, digest[:])
+	if err != nil {
+		return "", "", fmt.Errorf("unable to sign test policy: %+v", err)
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	token := fmt.Sprintf("%s.%s.%s", header, body, base64.RawURLEncoding.EncodeToString(signature))
+	return encoded.String(), token, nil
+}
+
 // currently only supported in "East US 2", "West Central US" & "UK South"
 func (AttestationProviderResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
@@ -271,3 +378,66 @@ resource "azurerm_attestation_provider" "test" {
 }
 `, template, randStr)
 }
+
+func (AttestationProviderResource) policy(data acceptance.TestData, randStr string) string {
+	template := AttestationProviderResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_attestation_provider" "test" {
+  name                = "acctestap%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy {
+    environment_type = "SgxEnclave"
+    data             = "%s"
+  }
+}
+`, template, randStr, testUnsignedAttestationPolicy(testAttestationPolicy))
+}
+
+func (AttestationProviderResource) policyUpdated(data acceptance.TestData, randStr string) string {
+	template := AttestationProviderResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_attestation_provider" "test" {
+  name                = "acctestap%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy {
+    environment_type = "OpenEnclave"
+    data             = "%s"
+  }
+
+  policy {
+    environment_type = "Tpm"
+    data             = "%s"
+  }
+}
+`, template, randStr, testUnsignedAttestationPolicy(testAttestationPolicy), testUnsignedAttestationPolicy(testAttestationPolicy))
+}
+
+func (AttestationProviderResource) signedPolicy(data acceptance.TestData, randStr string, testCertificate string, signedPolicy string) string {
+	template := AttestationProviderResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_attestation_provider" "test" {
+  name                = "acctestap%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy_signing_certificate_data = <<EOT
+%s
+EOT
+
+  policy {
+    environment_type = "SgxEnclave"
+    data             = "%s"
+  }
+}
+`, template, randStr, testCertificate, signedPolicy)
+}
@@ -1,12 +1,21 @@
 package client
 
 import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/sdk/2020-10-01/attestation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/attestation/sdk/2020-10-01/attestationproviders"
 )
 
+// attestationDataPlaneResource is the audience used for tokens issued to the Attestation data plane
+const attestationDataPlaneResource = "https://attest.azure.net"
+
 type Client struct {
-	ProviderClient *attestationproviders.AttestationProvidersClient
+	ProviderClient      *attestationproviders.AttestationProvidersClient
+	tokenFunc           func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc func(c *autorest.Client, authorizer autorest.Authorizer)
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -14,6 +23,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&providerClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ProviderClient: &providerClient,
+		ProviderClient:      &providerClient,
+		tokenFunc:           o.TokenFunc,
+		configureClientFunc: o.ConfigureClient,
+	}
+}
+
+func (c Client) PolicyClient() (*attestation.PolicyClient, error) {
+	authorizer, err := c.tokenFunc(attestationDataPlaneResource)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", attestationDataPlaneResource, err)
 	}
+
+	client := attestation.NewPolicyClient()
+	c.configureClientFunc(&client.Client, authorizer)
+	return &client, nil
 }
@@ -0,0 +1,24 @@
+package attestation
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+// Type enumerates the values for type.
+type Type string
+
+const (
+	// TypeOpenEnclave OpenEnclave's enclave
+	TypeOpenEnclave Type = "OpenEnclave"
+	// TypeSgxEnclave Intel Software Guard eXtensions
+	TypeSgxEnclave Type = "SgxEnclave"
+	// TypeTpm Edge TPM Virtualization Based Security
+	TypeTpm Type = "Tpm"
+)
+
+// PossibleTypeValues returns an array of possible values for the Type const type.
+func PossibleTypeValues() []Type {
+	return []Type{TypeOpenEnclave, TypeSgxEnclave, TypeTpm}
+}
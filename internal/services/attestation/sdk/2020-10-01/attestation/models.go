@@ -0,0 +1,21 @@
+package attestation
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// The package's fully qualified name.
+const fqdn = "github.com/Azure/azure-sdk-for-go/services/attestation/2020-10-01/attestation"
+
+// PolicyResponse the response to an attestation policy operation
+type PolicyResponse struct {
+	autorest.Response `json:"-"`
+	// Token - An RFC7519 JSON Web Token structure whose body is a PolicyResult object.
+	Token *string `json:"token,omitempty"`
+}
@@ -1,6 +1,4 @@
-package storage
-
-import "github.com/Azure/azure-sdk-for-go/version"
+package attestation
 
 // Copyright (c) Microsoft Corporation. All rights reserved.
 // Licensed under the MIT License. See License.txt in the project root for license information.
@@ -10,10 +8,10 @@ import "github.com/Azure/azure-sdk-for-go/version"
 
 // UserAgent returns the UserAgent string to use when sending http.Requests.
 func UserAgent() string {
-	return "Azure-SDK-For-Go/" + Version() + " storage/2021-01-01"
+	return "Azure-SDK-For-Go/" + Version() + " attestation/2020-10-01"
 }
 
 // Version returns the semantic version (see http://semver.org) of the client.
 func Version() string {
-	return version.Number
+	return "0.0.0"
 }
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// AttestationPolicy validates that the value is a JSON Web Token (either unsigned or signed) whose body
+// contains the base64url encoded Attestation Policy in the `AttestationPolicy` claim
+func AttestationPolicy(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	segments := strings.Split(v, ".")
+	if len(segments) != 3 {
+		errors = append(errors, fmt.Errorf("%s must be a JSON Web Token containing a header, body and signature separated by `.`", k))
+		return
+	}
+
+	header := make(map[string]interface{})
+	if err := decodeJWTSegment(segments[0], &header); err != nil {
+		errors = append(errors, fmt.Errorf("decoding the header of %s: %+v", k, err))
+		return
+	}
+	alg, ok := header["alg"].(string)
+	if !ok || alg == "" {
+		errors = append(errors, fmt.Errorf("the header of %s must specify an `alg`", k))
+		return
+	}
+	if strings.EqualFold(alg, "none") && segments[2] != "" {
+		errors = append(errors, fmt.Errorf("%s is an unsigned JSON Web Token but contains a signature", k))
+	}
+	if !strings.EqualFold(alg, "none") && segments[2] == "" {
+		errors = append(errors, fmt.Errorf("%s specifies the algorithm %q but does not contain a signature", k, alg))
+	}
+
+	body := make(map[string]interface{})
+	if err := decodeJWTSegment(segments[1], &body); err != nil {
+		errors = append(errors, fmt.Errorf("decoding the body of %s: %+v", k, err))
+		return
+	}
+	policy, ok := body["AttestationPolicy"].(string)
+	if !ok || policy == "" {
+		errors = append(errors, fmt.Errorf("the body of %s must contain an `AttestationPolicy` claim", k))
+		return
+	}
+	if _, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(policy, "=")); err != nil {
+		errors = append(errors, fmt.Errorf("the `AttestationPolicy` claim of %s must be base64url encoded: %+v", k, err))
+	}
+
+	return
+}
+
+func decodeJWTSegment(input string, target interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(input, "="))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decoded, target)
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestAttestationPolicy(t *testing.T) {
+	encode := func(input string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(input))
+	}
+	policy := encode("version= 1.0;authorizationrules{=> permit();};issuancerules{};")
+
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+		{
+			// not a jwt
+			Input: "version= 1.0;authorizationrules{=> permit();};issuancerules{};",
+			Valid: false,
+		},
+		{
+			// unsigned
+			Input: encode(`{"alg":"none"}`) + "." + encode(`{"AttestationPolicy":"`+policy+`"}`) + ".",
+			Valid: true,
+		},
+		{
+			// signed
+			Input: encode(`{"alg":"RS256","x5c":["MIIB"]}`) + "." + encode(`{"AttestationPolicy":"`+policy+`"}`) + ".c2lnbmF0dXJl",
+			Valid: true,
+		},
+		{
+			// signed algorithm without a signature
+			Input: encode(`{"alg":"RS256"}`) + "." + encode(`{"AttestationPolicy":"`+policy+`"}`) + ".",
+			Valid: false,
+		},
+		{
+			// unsigned with a signature
+			Input: encode(`{"alg":"none"}`) + "." + encode(`{"AttestationPolicy":"`+policy+`"}`) + ".c2lnbmF0dXJl",
+			Valid: false,
+		},
+		{
+			// missing alg
+			Input: encode(`{"typ":"JWT"}`) + "." + encode(`{"AttestationPolicy":"`+policy+`"}`) + ".",
+			Valid: false,
+		},
+		{
+			// missing policy claim
+			Input: encode(`{"alg":"none"}`) + "." + encode(`{"Policy":"`+policy+`"}`) + ".",
+			Valid: false,
+		},
+		{
+			// policy claim not base64url encoded
+			Input: encode(`{"alg":"none"}`) + "." + encode(`{"AttestationPolicy":"version= 1.0;"}`) + ".",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := AttestationPolicy(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
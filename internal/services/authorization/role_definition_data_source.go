@@ -1,7 +1,11 @@
 package authorization
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
@@ -119,31 +123,31 @@ func dataSourceArmRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("one of `name` or `role_definition_id` must be specified")
 	}
 
-	// search by name
-	var role authorization.RoleDefinition
-	if name != "" {
-		roleDefinitions, err := client.List(ctx, scope, fmt.Sprintf("roleName eq '%s'", name))
-		if err != nil {
-			return fmt.Errorf("loading Role Definition List: %+v", err)
-		}
-		if len(roleDefinitions.Values()) != 1 {
-			return fmt.Errorf("loading Role Definition List: could not find role '%s'", name)
-		}
-		if roleDefinitions.Values()[0].ID == nil {
-			return fmt.Errorf("loading Role Definition List: values[0].ID is nil '%s'", name)
+	cacheKey := strings.ToLower(fmt.Sprintf("%s|%s|%s", scope, name, defId))
+	role, cached := builtInRoleDefinitionsCache.get(cacheKey)
+	if !cached {
+		var err error
+		if name != "" {
+			role, err = findRoleDefinitionByName(ctx, client, scope, name)
+			if err != nil {
+				return err
+			}
+			if role.ID != nil {
+				defId = *role.ID
+			}
+		} else {
+			role, err = client.Get(ctx, scope, defId)
+			if err != nil {
+				return fmt.Errorf("loading Role Definition: %+v", err)
+			}
 		}
 
-		defId = *roleDefinitions.Values()[0].ID
-		role, err = client.GetByID(ctx, defId)
-		if err != nil {
-			return fmt.Errorf("Getting Role Definition by ID %s: %+v", defId, err)
-		}
-	} else {
-		var err error
-		role, err = client.Get(ctx, scope, defId)
-		if err != nil {
-			return fmt.Errorf("loading Role Definition: %+v", err)
+		// Built-In Roles don't change, so these can be shared by every lookup
+		if props := role.RoleDefinitionProperties; props != nil && props.RoleType != nil && strings.EqualFold(*props.RoleType, "BuiltInRole") {
+			builtInRoleDefinitionsCache.set(cacheKey, role)
 		}
+	} else if name != "" && role.ID != nil {
+		defId = *role.ID
 	}
 
 	if role.ID == nil {
@@ -157,7 +161,8 @@ func dataSourceArmRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("description", props.Description)
 		d.Set("type", props.RoleType)
 
-		permissions := flattenRoleDefinitionPermissions(props.Permissions)
+		// the ordering of the actions isn't guaranteed by the API, so these are sorted to avoid perpetual diffs
+		permissions := flattenRoleDefinitionPermissions(sortRoleDefinitionPermissions(props.Permissions))
 		if err := d.Set("permissions", permissions); err != nil {
 			return err
 		}
@@ -170,3 +175,106 @@ func dataSourceArmRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{}
 
 	return nil
 }
+
+// findRoleDefinitionByName searches the specified scope (which can be a Management Group, Subscription,
+// Resource Group or Resource) for the Role Definition with the specified name. Role Definitions are inherited
+// from parent scopes, so where more than one match is found the one defined at this scope is used.
+func findRoleDefinitionByName(ctx context.Context, client *authorization.RoleDefinitionsClient, scope, name string) (authorization.RoleDefinition, error) {
+	var role authorization.RoleDefinition
+
+	iterator, err := client.ListComplete(ctx, scope, fmt.Sprintf("roleName eq '%s'", name))
+	if err != nil {
+		return role, fmt.Errorf("loading Role Definition List: %+v", err)
+	}
+
+	matches := make([]authorization.RoleDefinition, 0)
+	for iterator.NotDone() {
+		matches = append(matches, iterator.Value())
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return role, fmt.Errorf("loading Role Definition List: %+v", err)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return role, fmt.Errorf("loading Role Definition List: could not find role '%s'", name)
+	case 1:
+		role = matches[0]
+	default:
+		found := false
+		for _, match := range matches {
+			if match.ID != nil && scope != "" && strings.HasPrefix(strings.ToLower(*match.ID), strings.ToLower(strings.TrimSuffix(scope, "/"))+"/providers/microsoft.authorization/roledefinitions/") {
+				role = match
+				found = true
+				break
+			}
+		}
+		if !found {
+			return role, fmt.Errorf("loading Role Definition List: found %d roles named '%s' - please specify the `scope` at which the role is defined", len(matches), name)
+		}
+	}
+
+	if role.ID == nil {
+		return role, fmt.Errorf("loading Role Definition List: values[0].ID is nil '%s'", name)
+	}
+
+	role, err = client.GetByID(ctx, *role.ID)
+	if err != nil {
+		return role, fmt.Errorf("Getting Role Definition by ID %s: %+v", *role.ID, err)
+	}
+
+	return role, nil
+}
+
+func sortRoleDefinitionPermissions(input *[]authorization.Permission) *[]authorization.Permission {
+	if input == nil {
+		return nil
+	}
+
+	output := make([]authorization.Permission, 0)
+	for _, permission := range *input {
+		permission.Actions = sortRoleDefinitionActions(permission.Actions)
+		permission.NotActions = sortRoleDefinitionActions(permission.NotActions)
+		output = append(output, permission)
+	}
+
+	return &output
+}
+
+func sortRoleDefinitionActions(input *[]string) *[]string {
+	if input == nil {
+		return nil
+	}
+
+	output := make([]string, len(*input))
+	copy(output, *input)
+	sort.SliceStable(output, func(i, j int) bool {
+		return strings.ToLower(output[i]) < strings.ToLower(output[j])
+	})
+
+	return &output
+}
+
+type roleDefinitionsCache struct {
+	lock  sync.RWMutex
+	items map[string]authorization.RoleDefinition
+}
+
+var builtInRoleDefinitionsCache = &roleDefinitionsCache{
+	items: make(map[string]authorization.RoleDefinition),
+}
+
+func (c *roleDefinitionsCache) get(key string) (authorization.RoleDefinition, bool) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	v, ok := c.items[key]
+	return v, ok
+}
+
+func (c *roleDefinitionsCache) set(key string, value authorization.RoleDefinition) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.items[key] = value
+}
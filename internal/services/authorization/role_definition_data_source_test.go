@@ -57,6 +57,25 @@ func TestAccRoleDefinitionDataSource_basicByName(t *testing.T) {
 	})
 }
 
+func TestAccRoleDefinitionDataSource_managementGroupByName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_role_definition", "test")
+	id := uuid.New().String()
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: RoleDefinitionDataSource{}.managementGroupByName(id, data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("type").HasValue("CustomRole"),
+				check.That(data.ResourceName).Key("permissions.#").HasValue("1"),
+				check.That(data.ResourceName).Key("permissions.0.actions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("permissions.0.actions.0").HasValue("Microsoft.Compute/virtualMachines/read"),
+				check.That(data.ResourceName).Key("permissions.0.actions.1").HasValue("Microsoft.Resources/subscriptions/resourceGroups/read"),
+			),
+		},
+	})
+}
+
 func TestAccRoleDefinitionDataSource_builtIn_contributor(t *testing.T) {
 	data := acceptance.BuildTestData(t, "data.azurerm_role_definition", "test")
 
@@ -74,8 +93,8 @@ func TestAccRoleDefinitionDataSource_builtIn_contributor(t *testing.T) {
 				check.That(data.ResourceName).Key("permissions.0.not_actions.0").HasValue("Microsoft.Authorization/*/Delete"),
 				check.That(data.ResourceName).Key("permissions.0.not_actions.1").HasValue("Microsoft.Authorization/*/Write"),
 				check.That(data.ResourceName).Key("permissions.0.not_actions.2").HasValue("Microsoft.Authorization/elevateAccess/Action"),
-				check.That(data.ResourceName).Key("permissions.0.not_actions.3").HasValue("Microsoft.Blueprint/blueprintAssignments/write"),
-				check.That(data.ResourceName).Key("permissions.0.not_actions.4").HasValue("Microsoft.Blueprint/blueprintAssignments/delete"),
+				check.That(data.ResourceName).Key("permissions.0.not_actions.3").HasValue("Microsoft.Blueprint/blueprintAssignments/delete"),
+				check.That(data.ResourceName).Key("permissions.0.not_actions.4").HasValue("Microsoft.Blueprint/blueprintAssignments/write"),
 				check.That(data.ResourceName).Key("permissions.0.not_actions.5").HasValue("Microsoft.Compute/galleries/share/action"),
 			),
 		},
@@ -196,3 +215,37 @@ data "azurerm_role_definition" "byName" {
 }
 `, d.basic(id, data))
 }
+
+func (d RoleDefinitionDataSource) managementGroupByName(id string, data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = azurerm_management_group.test.id
+
+  permissions {
+    actions = [
+      "Microsoft.Resources/subscriptions/resourceGroups/read",
+      "Microsoft.Compute/virtualMachines/read",
+    ]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    azurerm_management_group.test.id,
+  ]
+}
+
+data "azurerm_role_definition" "test" {
+  name  = azurerm_role_definition.test.name
+  scope = azurerm_management_group.test.id
+}
+`, id, data.RandomInteger)
+}
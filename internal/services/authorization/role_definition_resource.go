@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization"
@@ -171,7 +172,17 @@ func resourceArmRoleDefinitionCreate(d *pluginsdk.ResourceData, meta interface{}
 		},
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, scope, roleDefinitionId, properties); err != nil {
+	err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+		resp, err := client.CreateOrUpdate(ctx, scope, roleDefinitionId, properties)
+		if err != nil {
+			if utils.ResponseWasConflict(resp.Response) {
+				return pluginsdk.RetryableError(err)
+			}
+			return pluginsdk.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -240,9 +251,21 @@ func resourceArmRoleDefinitionUpdate(d *pluginsdk.ResourceData, meta interface{}
 		},
 	}
 
-	resp, err := client.CreateOrUpdate(ctx, roleDefinitionId.Scope, roleDefinitionId.RoleID, properties)
+	// updating the permissions of a Custom Role which is in use can transiently fail with a Conflict
+	var resp azuresdkhacks.RoleDefinitionUpdateResponse
+	err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutUpdate), func() *pluginsdk.RetryError {
+		resp, err = client.CreateOrUpdate(ctx, roleDefinitionId.Scope, roleDefinitionId.RoleID, properties)
+		if err != nil {
+			if utils.ResponseWasConflict(resp.Response) {
+				log.Printf("[DEBUG] Role Definition %q (Scope %q) is in use - retrying..", roleDefinitionId.RoleID, roleDefinitionId.Scope)
+				return pluginsdk.RetryableError(fmt.Errorf("updating Role Definition %q (Scope %q): %+v", roleDefinitionId.RoleID, roleDefinitionId.Scope, err))
+			}
+			return pluginsdk.NonRetryableError(fmt.Errorf("updating Role Definition %q (Scope %q): %+v", roleDefinitionId.RoleID, roleDefinitionId.Scope, err))
+		}
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("updating Role Definition %q (Scope %q): %+v", roleDefinitionId.RoleID, roleDefinitionId.Scope, err)
+		return err
 	}
 	if resp.RoleDefinitionProperties == nil {
 		return fmt.Errorf("updating Role Definition %q (Scope %q): `properties` was nil", roleDefinitionId.RoleID, roleDefinitionId.Scope)
@@ -303,6 +326,7 @@ func resourceArmRoleDefinitionRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("description", props.Description)
 
 		permissions := flattenRoleDefinitionPermissions(props.Permissions)
+		permissions = normalizeRoleDefinitionPermissionsOrdering(d.Get("permissions").([]interface{}), permissions)
 		if err := d.Set("permissions", permissions); err != nil {
 			return err
 		}
@@ -323,12 +347,26 @@ func resourceArmRoleDefinitionDelete(d *pluginsdk.ResourceData, meta interface{}
 
 	id, _ := parse.RoleDefinitionId(d.Id())
 
-	resp, err := client.Delete(ctx, id.Scope, id.RoleID)
-	if err != nil {
-		if !utils.ResponseWasNotFound(resp.Response) {
-			return fmt.Errorf("deleting Role Definition %q at Scope %q: %+v", id.RoleID, id.Scope, err)
+	// the Role Assignments using this Role Definition can take a while to be removed, during which time the
+	// deletion is rejected with a Conflict
+	err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutDelete), func() *pluginsdk.RetryError {
+		resp, err := client.Delete(ctx, id.Scope, id.RoleID)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			if utils.ResponseWasConflict(resp.Response) {
+				log.Printf("[DEBUG] Role Definition %q (Scope %q) is in use - retrying..", id.RoleID, id.Scope)
+				return pluginsdk.RetryableError(fmt.Errorf("deleting Role Definition %q at Scope %q: %+v", id.RoleID, id.Scope, err))
+			}
+			return pluginsdk.NonRetryableError(fmt.Errorf("deleting Role Definition %q at Scope %q: %+v", id.RoleID, id.Scope, err))
 		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+
 	// Deletes are not instant and can take time to propagate
 	stateConf := &pluginsdk.StateChangeConf{
 		Pending: []string{
@@ -529,3 +567,55 @@ func roleDefinitionDeleteStateRefreshFunc(ctx context.Context, client *authoriza
 		return "Pending", "Pending", nil
 	}
 }
+
+// normalizeRoleDefinitionPermissionsOrdering retains the ordering of the `actions` and `not_actions` from the
+// existing permissions when they contain the same items, since the API doesn't guarantee the ordering
+func normalizeRoleDefinitionPermissionsOrdering(existing []interface{}, permissions []interface{}) []interface{} {
+	if len(existing) != len(permissions) {
+		return permissions
+	}
+
+	for i, raw := range permissions {
+		if existing[i] == nil || raw == nil {
+			continue
+		}
+
+		current := existing[i].(map[string]interface{})
+		permission := raw.(map[string]interface{})
+		for _, key := range []string{"actions", "not_actions"} {
+			currentItems, ok := current[key].([]interface{})
+			if !ok {
+				continue
+			}
+			actualItems, ok := permission[key].([]interface{})
+			if !ok {
+				continue
+			}
+			if roleDefinitionActionsMatch(currentItems, actualItems) {
+				permission[key] = currentItems
+			}
+		}
+	}
+
+	return permissions
+}
+
+func roleDefinitionActionsMatch(existing []interface{}, actions []interface{}) bool {
+	if len(existing) != len(actions) {
+		return false
+	}
+
+	counts := make(map[string]int)
+	for _, v := range actions {
+		counts[strings.ToLower(v.(string))]++
+	}
+	for _, v := range existing {
+		key := strings.ToLower(v.(string))
+		if counts[key] == 0 {
+			return false
+		}
+		counts[key]--
+	}
+
+	return true
+}
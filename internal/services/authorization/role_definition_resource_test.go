@@ -87,6 +87,31 @@ func TestAccRoleDefinition_update(t *testing.T) {
 	})
 }
 
+func TestAccRoleDefinition_updateWhilstAssigned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
+	r := RoleDefinitionResource{}
+	id := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.assigned(id, data, `"Microsoft.Resources/subscriptions/resourceGroups/read", "Microsoft.Compute/virtualMachines/read"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.assigned(id, data, `"Microsoft.Resources/subscriptions/resourceGroups/read", "Microsoft.Compute/virtualMachines/read", "Microsoft.Compute/disks/read"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permissions.0.actions.#").HasValue("3"),
+				check.That(data.ResourceName).Key("permissions.0.actions.2").HasValue("Microsoft.Compute/disks/read"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccRoleDefinition_updateEmptyId(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_definition", "test")
 
@@ -305,6 +330,41 @@ resource "azurerm_role_definition" "test" {
 `, id, data.RandomInteger)
 }
 
+func (RoleDefinitionResource) assigned(id string, data acceptance.TestData, actions string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = data.azurerm_subscription.primary.id
+
+  permissions {
+    actions     = [%s]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    data.azurerm_subscription.primary.id,
+  ]
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope              = data.azurerm_subscription.primary.id
+  role_definition_id = azurerm_role_definition.test.role_definition_resource_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, id, data.RandomInteger, actions)
+}
+
 func (RoleDefinitionResource) emptyId(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
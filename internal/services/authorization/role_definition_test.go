@@ -0,0 +1,77 @@
+package authorization
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeRoleDefinitionPermissionsOrdering(t *testing.T) {
+	testData := []struct {
+		name     string
+		existing []interface{}
+		actual   []interface{}
+		expected []interface{}
+	}{
+		{
+			name:     "same ordering",
+			existing: []interface{}{"a/read", "b/read"},
+			actual:   []interface{}{"a/read", "b/read"},
+			expected: []interface{}{"a/read", "b/read"},
+		},
+		{
+			name:     "different ordering",
+			existing: []interface{}{"b/read", "a/read"},
+			actual:   []interface{}{"a/read", "b/read"},
+			expected: []interface{}{"b/read", "a/read"},
+		},
+		{
+			name:     "different casing",
+			existing: []interface{}{"B/Read", "a/read"},
+			actual:   []interface{}{"a/read", "b/read"},
+			expected: []interface{}{"B/Read", "a/read"},
+		},
+		{
+			name:     "different items",
+			existing: []interface{}{"b/read", "c/read"},
+			actual:   []interface{}{"a/read", "b/read"},
+			expected: nil,
+		},
+		{
+			name:     "additional items",
+			existing: []interface{}{"a/read"},
+			actual:   []interface{}{"a/read", "b/read"},
+			expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		existing := []interface{}{
+			map[string]interface{}{
+				"actions":     v.existing,
+				"not_actions": []interface{}{},
+			},
+		}
+		permissions := []interface{}{
+			map[string]interface{}{
+				"actions":     v.actual,
+				"not_actions": []interface{}{},
+			},
+		}
+
+		result := normalizeRoleDefinitionPermissionsOrdering(existing, permissions)
+		actions := result[0].(map[string]interface{})["actions"]
+
+		if v.expected == nil {
+			if !reflect.DeepEqual(actions, v.actual) {
+				t.Fatalf("expected %+v but got %+v", v.actual, actions)
+			}
+			continue
+		}
+
+		if !reflect.DeepEqual(actions, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actions)
+		}
+	}
+}
@@ -3,17 +3,47 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/azurestackhci/mgmt/2020-10-01/azurestackhci"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/galleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/marketplacegalleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
 )
 
 type Client struct {
-	ClusterClient *azurestackhci.ClustersClient
+	ClusterClient                  *azurestackhci.ClustersClient
+	GalleryImagesClient            *galleryimages.GalleryImagesClient
+	LogicalNetworksClient          *logicalnetworks.LogicalNetworksClient
+	MarketplaceGalleryImagesClient *marketplacegalleryimages.MarketplaceGalleryImagesClient
+	NetworkInterfacesClient        *networkinterfaces.NetworkInterfacesClient
+	VirtualMachineInstancesClient  *virtualmachineinstances.VirtualMachineInstancesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	clusterClient := azurestackhci.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&clusterClient.Client, o.ResourceManagerAuthorizer)
 
+	galleryImagesClient := galleryimages.NewGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&galleryImagesClient.Client, o.ResourceManagerAuthorizer)
+
+	logicalNetworksClient := logicalnetworks.NewLogicalNetworksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&logicalNetworksClient.Client, o.ResourceManagerAuthorizer)
+
+	marketplaceGalleryImagesClient := marketplacegalleryimages.NewMarketplaceGalleryImagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&marketplaceGalleryImagesClient.Client, o.ResourceManagerAuthorizer)
+
+	networkInterfacesClient := networkinterfaces.NewNetworkInterfacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&networkInterfacesClient.Client, o.ResourceManagerAuthorizer)
+
+	virtualMachineInstancesClient := virtualmachineinstances.NewVirtualMachineInstancesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualMachineInstancesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ClusterClient: &clusterClient,
+		ClusterClient:                  &clusterClient,
+		GalleryImagesClient:            &galleryImagesClient,
+		LogicalNetworksClient:          &logicalNetworksClient,
+		MarketplaceGalleryImagesClient: &marketplaceGalleryImagesClient,
+		NetworkInterfacesClient:        &networkInterfacesClient,
+		VirtualMachineInstancesClient:  &virtualMachineInstancesClient,
 	}
 }
@@ -0,0 +1,15 @@
+package galleryimages
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GalleryImagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGalleryImagesClientWithBaseURI(endpoint string) GalleryImagesClient {
+	return GalleryImagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,12 @@
+package galleryimages
+
+type GalleryImageProperties struct {
+	CloudInitDataSource *CloudInitDataSource    `json:"cloudInitDataSource,omitempty"`
+	ContainerId         *string                 `json:"containerId,omitempty"`
+	HyperVGeneration    *HyperVGeneration       `json:"hyperVGeneration,omitempty"`
+	Identifier          *GalleryImageIdentifier `json:"identifier,omitempty"`
+	ImagePath           *string                 `json:"imagePath,omitempty"`
+	OsType              OperatingSystemTypes    `json:"osType"`
+	ProvisioningState   *ProvisioningStateEnum  `json:"provisioningState,omitempty"`
+	Version             *GalleryImageVersion    `json:"version,omitempty"`
+}
@@ -0,0 +1,5 @@
+package galleryimages
+
+type GalleryImagesUpdateRequest struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
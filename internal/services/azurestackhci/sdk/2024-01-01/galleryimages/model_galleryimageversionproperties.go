@@ -0,0 +1,5 @@
+package galleryimages
+
+type GalleryImageVersionProperties struct {
+	StorageProfile GalleryImageVersionStorageProfile `json:"storageProfile"`
+}
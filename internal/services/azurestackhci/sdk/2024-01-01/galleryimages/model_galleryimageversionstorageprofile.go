@@ -0,0 +1,5 @@
+package galleryimages
+
+type GalleryImageVersionStorageProfile struct {
+	OsDiskImage *GalleryOSDiskImage `json:"osDiskImage,omitempty"`
+}
@@ -0,0 +1,5 @@
+package galleryimages
+
+type GalleryOSDiskImage struct {
+	SizeInMB *int64 `json:"sizeInMB,omitempty"`
+}
@@ -0,0 +1,9 @@
+package galleryimages
+
+import "fmt"
+
+const defaultApiVersion = "2024-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/galleryimages/%s", defaultApiVersion)
+}
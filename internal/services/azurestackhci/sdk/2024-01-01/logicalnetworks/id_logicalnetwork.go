@@ -0,0 +1,124 @@
+package logicalnetworks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = LogicalNetworkId{}
+
+// LogicalNetworkId is a struct representing the Resource ID for a Logical Network
+type LogicalNetworkId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	LogicalNetworkName string
+}
+
+// NewLogicalNetworkID returns a new LogicalNetworkId struct
+func NewLogicalNetworkID(subscriptionId string, resourceGroupName string, logicalNetworkName string) LogicalNetworkId {
+	return LogicalNetworkId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		LogicalNetworkName: logicalNetworkName,
+	}
+}
+
+// ParseLogicalNetworkID parses 'input' into a LogicalNetworkId
+func ParseLogicalNetworkID(input string) (*LogicalNetworkId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LogicalNetworkId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LogicalNetworkId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LogicalNetworkName, ok = parsed.Parsed["logicalNetworkName"]; !ok {
+		return nil, fmt.Errorf("the segment 'logicalNetworkName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseLogicalNetworkIDInsensitively parses 'input' case-insensitively into a LogicalNetworkId
+// note: this method should only be used for API response data and not user input
+func ParseLogicalNetworkIDInsensitively(input string) (*LogicalNetworkId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LogicalNetworkId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LogicalNetworkId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LogicalNetworkName, ok = parsed.Parsed["logicalNetworkName"]; !ok {
+		return nil, fmt.Errorf("the segment 'logicalNetworkName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateLogicalNetworkID checks that 'input' can be parsed as a Logical Network ID
+func ValidateLogicalNetworkID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseLogicalNetworkID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Logical Network ID
+func (id LogicalNetworkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AzureStackHCI/logicalNetworks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.LogicalNetworkName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Logical Network ID
+func (id LogicalNetworkId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftAzureStackHCI", "Microsoft.AzureStackHCI", "Microsoft.AzureStackHCI"),
+		resourceids.StaticSegment("staticLogicalNetworks", "logicalNetworks", "logicalNetworks"),
+		resourceids.UserSpecifiedSegment("logicalNetworkName", "logicalNetworkValue"),
+	}
+}
+
+// String returns a human-readable description of this Logical Network ID
+func (id LogicalNetworkId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Logical Network Name: %q", id.LogicalNetworkName),
+	}
+	return fmt.Sprintf("Logical Network (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package logicalnetworks
+
+type LogicalNetworkProperties struct {
+	DhcpOptions       *LogicalNetworkPropertiesDhcpOptions `json:"dhcpOptions,omitempty"`
+	ProvisioningState *ProvisioningStateEnum               `json:"provisioningState,omitempty"`
+	Subnets           *[]Subnet                            `json:"subnets,omitempty"`
+	VMSwitchName      *string                              `json:"vmSwitchName,omitempty"`
+}
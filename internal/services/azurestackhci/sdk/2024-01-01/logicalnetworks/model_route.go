@@ -0,0 +1,6 @@
+package logicalnetworks
+
+type Route struct {
+	Name       *string                `json:"name,omitempty"`
+	Properties *RoutePropertiesFormat `json:"properties,omitempty"`
+}
@@ -0,0 +1,5 @@
+package logicalnetworks
+
+type RouteTablePropertiesFormat struct {
+	Routes *[]Route `json:"routes,omitempty"`
+}
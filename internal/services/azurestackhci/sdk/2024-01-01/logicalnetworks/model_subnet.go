@@ -0,0 +1,6 @@
+package logicalnetworks
+
+type Subnet struct {
+	Name       *string                 `json:"name,omitempty"`
+	Properties *SubnetPropertiesFormat `json:"properties,omitempty"`
+}
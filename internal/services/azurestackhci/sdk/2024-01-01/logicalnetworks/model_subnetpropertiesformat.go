@@ -0,0 +1,10 @@
+package logicalnetworks
+
+type SubnetPropertiesFormat struct {
+	AddressPrefix      *string                 `json:"addressPrefix,omitempty"`
+	AddressPrefixes    *[]string               `json:"addressPrefixes,omitempty"`
+	IPAllocationMethod *IPAllocationMethodEnum `json:"ipAllocationMethod,omitempty"`
+	IPPools            *[]IPPool               `json:"ipPools,omitempty"`
+	RouteTable         *RouteTable             `json:"routeTable,omitempty"`
+	Vlan               *int64                  `json:"vlan,omitempty"`
+}
@@ -0,0 +1,15 @@
+package marketplacegalleryimages
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MarketplaceGalleryImagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMarketplaceGalleryImagesClientWithBaseURI(endpoint string) MarketplaceGalleryImagesClient {
+	return MarketplaceGalleryImagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,149 @@
+package marketplacegalleryimages
+
+import "strings"
+
+type CloudInitDataSource string
+
+const (
+	CloudInitDataSourceAzure   CloudInitDataSource = "Azure"
+	CloudInitDataSourceNoCloud CloudInitDataSource = "NoCloud"
+)
+
+func PossibleValuesForCloudInitDataSource() []string {
+	return []string{
+		string(CloudInitDataSourceAzure),
+		string(CloudInitDataSourceNoCloud),
+	}
+}
+
+func parseCloudInitDataSource(input string) (*CloudInitDataSource, error) {
+	vals := map[string]CloudInitDataSource{
+		"azure":   CloudInitDataSourceAzure,
+		"nocloud": CloudInitDataSourceNoCloud,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CloudInitDataSource(input)
+	return &out, nil
+}
+
+type ExtendedLocationTypes string
+
+const (
+	ExtendedLocationTypesCustomLocation ExtendedLocationTypes = "CustomLocation"
+)
+
+func PossibleValuesForExtendedLocationTypes() []string {
+	return []string{
+		string(ExtendedLocationTypesCustomLocation),
+	}
+}
+
+func parseExtendedLocationTypes(input string) (*ExtendedLocationTypes, error) {
+	vals := map[string]ExtendedLocationTypes{
+		"customlocation": ExtendedLocationTypesCustomLocation,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExtendedLocationTypes(input)
+	return &out, nil
+}
+
+type HyperVGeneration string
+
+const (
+	HyperVGenerationV1 HyperVGeneration = "V1"
+	HyperVGenerationV2 HyperVGeneration = "V2"
+)
+
+func PossibleValuesForHyperVGeneration() []string {
+	return []string{
+		string(HyperVGenerationV1),
+		string(HyperVGenerationV2),
+	}
+}
+
+func parseHyperVGeneration(input string) (*HyperVGeneration, error) {
+	vals := map[string]HyperVGeneration{
+		"v1": HyperVGenerationV1,
+		"v2": HyperVGenerationV2,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HyperVGeneration(input)
+	return &out, nil
+}
+
+type OperatingSystemTypes string
+
+const (
+	OperatingSystemTypesLinux   OperatingSystemTypes = "Linux"
+	OperatingSystemTypesWindows OperatingSystemTypes = "Windows"
+)
+
+func PossibleValuesForOperatingSystemTypes() []string {
+	return []string{
+		string(OperatingSystemTypesLinux),
+		string(OperatingSystemTypesWindows),
+	}
+}
+
+func parseOperatingSystemTypes(input string) (*OperatingSystemTypes, error) {
+	vals := map[string]OperatingSystemTypes{
+		"linux":   OperatingSystemTypesLinux,
+		"windows": OperatingSystemTypesWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperatingSystemTypes(input)
+	return &out, nil
+}
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumAccepted   ProvisioningStateEnum = "Accepted"
+	ProvisioningStateEnumCanceled   ProvisioningStateEnum = "Canceled"
+	ProvisioningStateEnumFailed     ProvisioningStateEnum = "Failed"
+	ProvisioningStateEnumInProgress ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumSucceeded  ProvisioningStateEnum = "Succeeded"
+)
+
+func PossibleValuesForProvisioningStateEnum() []string {
+	return []string{
+		string(ProvisioningStateEnumAccepted),
+		string(ProvisioningStateEnumCanceled),
+		string(ProvisioningStateEnumFailed),
+		string(ProvisioningStateEnumInProgress),
+		string(ProvisioningStateEnumSucceeded),
+	}
+}
+
+func parseProvisioningStateEnum(input string) (*ProvisioningStateEnum, error) {
+	vals := map[string]ProvisioningStateEnum{
+		"accepted":   ProvisioningStateEnumAccepted,
+		"canceled":   ProvisioningStateEnumCanceled,
+		"failed":     ProvisioningStateEnumFailed,
+		"inprogress": ProvisioningStateEnumInProgress,
+		"succeeded":  ProvisioningStateEnumSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStateEnum(input)
+	return &out, nil
+}
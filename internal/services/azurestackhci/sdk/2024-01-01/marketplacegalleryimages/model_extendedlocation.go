@@ -0,0 +1,6 @@
+package marketplacegalleryimages
+
+type ExtendedLocation struct {
+	Name *string                `json:"name,omitempty"`
+	Type *ExtendedLocationTypes `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package marketplacegalleryimages
+
+type GalleryImageVersion struct {
+	Name       *string                        `json:"name,omitempty"`
+	Properties *GalleryImageVersionProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,5 @@
+package marketplacegalleryimages
+
+type GalleryImageVersionProperties struct {
+	StorageProfile GalleryImageVersionStorageProfile `json:"storageProfile"`
+}
@@ -0,0 +1,5 @@
+package marketplacegalleryimages
+
+type GalleryImageVersionStorageProfile struct {
+	OsDiskImage *GalleryOSDiskImage `json:"osDiskImage,omitempty"`
+}
@@ -0,0 +1,5 @@
+package marketplacegalleryimages
+
+type GalleryOSDiskImage struct {
+	SizeInMB *int64 `json:"sizeInMB,omitempty"`
+}
@@ -0,0 +1,11 @@
+package marketplacegalleryimages
+
+type MarketplaceGalleryImageProperties struct {
+	CloudInitDataSource *CloudInitDataSource    `json:"cloudInitDataSource,omitempty"`
+	ContainerId         *string                 `json:"containerId,omitempty"`
+	HyperVGeneration    *HyperVGeneration       `json:"hyperVGeneration,omitempty"`
+	Identifier          *GalleryImageIdentifier `json:"identifier,omitempty"`
+	OsType              OperatingSystemTypes    `json:"osType"`
+	ProvisioningState   *ProvisioningStateEnum  `json:"provisioningState,omitempty"`
+	Version             *GalleryImageVersion    `json:"version,omitempty"`
+}
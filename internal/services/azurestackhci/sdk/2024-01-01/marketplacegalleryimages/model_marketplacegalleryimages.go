@@ -0,0 +1,11 @@
+package marketplacegalleryimages
+
+type MarketplaceGalleryImages struct {
+	ExtendedLocation *ExtendedLocation                  `json:"extendedLocation,omitempty"`
+	Id               *string                            `json:"id,omitempty"`
+	Location         string                             `json:"location"`
+	Name             *string                            `json:"name,omitempty"`
+	Properties       *MarketplaceGalleryImageProperties `json:"properties,omitempty"`
+	Tags             *map[string]string                 `json:"tags,omitempty"`
+	Type             *string                            `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package marketplacegalleryimages
+
+type MarketplaceGalleryImagesUpdateRequest struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,15 @@
+package networkinterfaces
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkInterfacesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkInterfacesClientWithBaseURI(endpoint string) NetworkInterfacesClient {
+	return NetworkInterfacesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
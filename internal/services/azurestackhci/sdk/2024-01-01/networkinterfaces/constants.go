@@ -0,0 +1,65 @@
+package networkinterfaces
+
+import "strings"
+
+type ExtendedLocationTypes string
+
+const (
+	ExtendedLocationTypesCustomLocation ExtendedLocationTypes = "CustomLocation"
+)
+
+func PossibleValuesForExtendedLocationTypes() []string {
+	return []string{
+		string(ExtendedLocationTypesCustomLocation),
+	}
+}
+
+func parseExtendedLocationTypes(input string) (*ExtendedLocationTypes, error) {
+	vals := map[string]ExtendedLocationTypes{
+		"customlocation": ExtendedLocationTypesCustomLocation,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExtendedLocationTypes(input)
+	return &out, nil
+}
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumAccepted   ProvisioningStateEnum = "Accepted"
+	ProvisioningStateEnumCanceled   ProvisioningStateEnum = "Canceled"
+	ProvisioningStateEnumFailed     ProvisioningStateEnum = "Failed"
+	ProvisioningStateEnumInProgress ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumSucceeded  ProvisioningStateEnum = "Succeeded"
+)
+
+func PossibleValuesForProvisioningStateEnum() []string {
+	return []string{
+		string(ProvisioningStateEnumAccepted),
+		string(ProvisioningStateEnumCanceled),
+		string(ProvisioningStateEnumFailed),
+		string(ProvisioningStateEnumInProgress),
+		string(ProvisioningStateEnumSucceeded),
+	}
+}
+
+func parseProvisioningStateEnum(input string) (*ProvisioningStateEnum, error) {
+	vals := map[string]ProvisioningStateEnum{
+		"accepted":   ProvisioningStateEnumAccepted,
+		"canceled":   ProvisioningStateEnumCanceled,
+		"failed":     ProvisioningStateEnumFailed,
+		"inprogress": ProvisioningStateEnumInProgress,
+		"succeeded":  ProvisioningStateEnumSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStateEnum(input)
+	return &out, nil
+}
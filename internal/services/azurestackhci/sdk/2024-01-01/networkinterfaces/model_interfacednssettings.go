@@ -0,0 +1,5 @@
+package networkinterfaces
+
+type InterfaceDNSSettings struct {
+	DnsServers *[]string `json:"dnsServers,omitempty"`
+}
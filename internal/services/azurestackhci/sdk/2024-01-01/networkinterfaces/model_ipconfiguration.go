@@ -0,0 +1,6 @@
+package networkinterfaces
+
+type IPConfiguration struct {
+	Name       *string                    `json:"name,omitempty"`
+	Properties *IPConfigurationProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,8 @@
+package networkinterfaces
+
+type IPConfigurationProperties struct {
+	Gateway          *string                          `json:"gateway,omitempty"`
+	PrefixLength     *string                          `json:"prefixLength,omitempty"`
+	PrivateIPAddress *string                          `json:"privateIPAddress,omitempty"`
+	Subnet           *IPConfigurationPropertiesSubnet `json:"subnet,omitempty"`
+}
@@ -0,0 +1,11 @@
+package networkinterfaces
+
+type NetworkInterfaces struct {
+	ExtendedLocation *ExtendedLocation           `json:"extendedLocation,omitempty"`
+	Id               *string                     `json:"id,omitempty"`
+	Location         string                      `json:"location"`
+	Name             *string                     `json:"name,omitempty"`
+	Properties       *NetworkInterfaceProperties `json:"properties,omitempty"`
+	Tags             *map[string]string          `json:"tags,omitempty"`
+	Type             *string                     `json:"type,omitempty"`
+}
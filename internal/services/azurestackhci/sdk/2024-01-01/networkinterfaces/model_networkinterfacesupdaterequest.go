@@ -0,0 +1,5 @@
+package networkinterfaces
+
+type NetworkInterfacesUpdateRequest struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
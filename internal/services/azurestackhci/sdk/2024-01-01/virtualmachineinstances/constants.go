@@ -0,0 +1,212 @@
+package virtualmachineinstances
+
+import "strings"
+
+type ExtendedLocationTypes string
+
+const (
+	ExtendedLocationTypesCustomLocation ExtendedLocationTypes = "CustomLocation"
+)
+
+func PossibleValuesForExtendedLocationTypes() []string {
+	return []string{
+		string(ExtendedLocationTypesCustomLocation),
+	}
+}
+
+func parseExtendedLocationTypes(input string) (*ExtendedLocationTypes, error) {
+	vals := map[string]ExtendedLocationTypes{
+		"customlocation": ExtendedLocationTypesCustomLocation,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExtendedLocationTypes(input)
+	return &out, nil
+}
+
+type OperatingSystemTypes string
+
+const (
+	OperatingSystemTypesLinux   OperatingSystemTypes = "Linux"
+	OperatingSystemTypesWindows OperatingSystemTypes = "Windows"
+)
+
+func PossibleValuesForOperatingSystemTypes() []string {
+	return []string{
+		string(OperatingSystemTypesLinux),
+		string(OperatingSystemTypesWindows),
+	}
+}
+
+func parseOperatingSystemTypes(input string) (*OperatingSystemTypes, error) {
+	vals := map[string]OperatingSystemTypes{
+		"linux":   OperatingSystemTypesLinux,
+		"windows": OperatingSystemTypesWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperatingSystemTypes(input)
+	return &out, nil
+}
+
+type ProvisioningStateEnum string
+
+const (
+	ProvisioningStateEnumAccepted   ProvisioningStateEnum = "Accepted"
+	ProvisioningStateEnumCanceled   ProvisioningStateEnum = "Canceled"
+	ProvisioningStateEnumFailed     ProvisioningStateEnum = "Failed"
+	ProvisioningStateEnumInProgress ProvisioningStateEnum = "InProgress"
+	ProvisioningStateEnumSucceeded  ProvisioningStateEnum = "Succeeded"
+)
+
+func PossibleValuesForProvisioningStateEnum() []string {
+	return []string{
+		string(ProvisioningStateEnumAccepted),
+		string(ProvisioningStateEnumCanceled),
+		string(ProvisioningStateEnumFailed),
+		string(ProvisioningStateEnumInProgress),
+		string(ProvisioningStateEnumSucceeded),
+	}
+}
+
+func parseProvisioningStateEnum(input string) (*ProvisioningStateEnum, error) {
+	vals := map[string]ProvisioningStateEnum{
+		"accepted":   ProvisioningStateEnumAccepted,
+		"canceled":   ProvisioningStateEnumCanceled,
+		"failed":     ProvisioningStateEnumFailed,
+		"inprogress": ProvisioningStateEnumInProgress,
+		"succeeded":  ProvisioningStateEnumSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStateEnum(input)
+	return &out, nil
+}
+
+type SecurityTypes string
+
+const (
+	SecurityTypesConfidentialVM SecurityTypes = "ConfidentialVM"
+	SecurityTypesTrustedLaunch  SecurityTypes = "TrustedLaunch"
+)
+
+func PossibleValuesForSecurityTypes() []string {
+	return []string{
+		string(SecurityTypesConfidentialVM),
+		string(SecurityTypesTrustedLaunch),
+	}
+}
+
+func parseSecurityTypes(input string) (*SecurityTypes, error) {
+	vals := map[string]SecurityTypes{
+		"confidentialvm": SecurityTypesConfidentialVM,
+		"trustedlaunch":  SecurityTypesTrustedLaunch,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SecurityTypes(input)
+	return &out, nil
+}
+
+type VMSizeEnum string
+
+const (
+	VMSizeEnumCustom         VMSizeEnum = "Custom"
+	VMSizeEnumDefault        VMSizeEnum = "Default"
+	VMSizeEnumStandardA2v2   VMSizeEnum = "Standard_A2_v2"
+	VMSizeEnumStandardA4v2   VMSizeEnum = "Standard_A4_v2"
+	VMSizeEnumStandardD16sv3 VMSizeEnum = "Standard_D16s_v3"
+	VMSizeEnumStandardD2sv3  VMSizeEnum = "Standard_D2s_v3"
+	VMSizeEnumStandardD32sv3 VMSizeEnum = "Standard_D32s_v3"
+	VMSizeEnumStandardD4sv3  VMSizeEnum = "Standard_D4s_v3"
+	VMSizeEnumStandardD8sv3  VMSizeEnum = "Standard_D8s_v3"
+	VMSizeEnumStandardDS13v2 VMSizeEnum = "Standard_DS13_v2"
+	VMSizeEnumStandardDS2v2  VMSizeEnum = "Standard_DS2_v2"
+	VMSizeEnumStandardDS3v2  VMSizeEnum = "Standard_DS3_v2"
+	VMSizeEnumStandardDS4v2  VMSizeEnum = "Standard_DS4_v2"
+	VMSizeEnumStandardDS5v2  VMSizeEnum = "Standard_DS5_v2"
+	VMSizeEnumStandardK8S2v1 VMSizeEnum = "Standard_K8S2_v1"
+	VMSizeEnumStandardK8S3v1 VMSizeEnum = "Standard_K8S3_v1"
+	VMSizeEnumStandardK8S4v1 VMSizeEnum = "Standard_K8S4_v1"
+	VMSizeEnumStandardK8S5v1 VMSizeEnum = "Standard_K8S5_v1"
+	VMSizeEnumStandardK8Sv1  VMSizeEnum = "Standard_K8S_v1"
+	VMSizeEnumStandardNK12   VMSizeEnum = "Standard_NK12"
+	VMSizeEnumStandardNK6    VMSizeEnum = "Standard_NK6"
+	VMSizeEnumStandardNV12   VMSizeEnum = "Standard_NV12"
+	VMSizeEnumStandardNV6    VMSizeEnum = "Standard_NV6"
+)
+
+func PossibleValuesForVMSizeEnum() []string {
+	return []string{
+		string(VMSizeEnumCustom),
+		string(VMSizeEnumDefault),
+		string(VMSizeEnumStandardA2v2),
+		string(VMSizeEnumStandardA4v2),
+		string(VMSizeEnumStandardD16sv3),
+		string(VMSizeEnumStandardD2sv3),
+		string(VMSizeEnumStandardD32sv3),
+		string(VMSizeEnumStandardD4sv3),
+		string(VMSizeEnumStandardD8sv3),
+		string(VMSizeEnumStandardDS13v2),
+		string(VMSizeEnumStandardDS2v2),
+		string(VMSizeEnumStandardDS3v2),
+		string(VMSizeEnumStandardDS4v2),
+		string(VMSizeEnumStandardDS5v2),
+		string(VMSizeEnumStandardK8S2v1),
+		string(VMSizeEnumStandardK8S3v1),
+		string(VMSizeEnumStandardK8S4v1),
+		string(VMSizeEnumStandardK8S5v1),
+		string(VMSizeEnumStandardK8Sv1),
+		string(VMSizeEnumStandardNK12),
+		string(VMSizeEnumStandardNK6),
+		string(VMSizeEnumStandardNV12),
+		string(VMSizeEnumStandardNV6),
+	}
+}
+
+func parseVMSizeEnum(input string) (*VMSizeEnum, error) {
+	vals := map[string]VMSizeEnum{
+		"custom":           VMSizeEnumCustom,
+		"default":          VMSizeEnumDefault,
+		"standard_a2_v2":   VMSizeEnumStandardA2v2,
+		"standard_a4_v2":   VMSizeEnumStandardA4v2,
+		"standard_d16s_v3": VMSizeEnumStandardD16sv3,
+		"standard_d2s_v3":  VMSizeEnumStandardD2sv3,
+		"standard_d32s_v3": VMSizeEnumStandardD32sv3,
+		"standard_d4s_v3":  VMSizeEnumStandardD4sv3,
+		"standard_d8s_v3":  VMSizeEnumStandardD8sv3,
+		"standard_ds13_v2": VMSizeEnumStandardDS13v2,
+		"standard_ds2_v2":  VMSizeEnumStandardDS2v2,
+		"standard_ds3_v2":  VMSizeEnumStandardDS3v2,
+		"standard_ds4_v2":  VMSizeEnumStandardDS4v2,
+		"standard_ds5_v2":  VMSizeEnumStandardDS5v2,
+		"standard_k8s2_v1": VMSizeEnumStandardK8S2v1,
+		"standard_k8s3_v1": VMSizeEnumStandardK8S3v1,
+		"standard_k8s4_v1": VMSizeEnumStandardK8S4v1,
+		"standard_k8s5_v1": VMSizeEnumStandardK8S5v1,
+		"standard_k8s_v1":  VMSizeEnumStandardK8Sv1,
+		"standard_nk12":    VMSizeEnumStandardNK12,
+		"standard_nk6":     VMSizeEnumStandardNK6,
+		"standard_nv12":    VMSizeEnumStandardNV12,
+		"standard_nv6":     VMSizeEnumStandardNV6,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VMSizeEnum(input)
+	return &out, nil
+}
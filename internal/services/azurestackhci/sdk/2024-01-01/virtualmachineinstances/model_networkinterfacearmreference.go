@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type NetworkInterfaceArmReference struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type NetworkProfileUpdate struct {
+	NetworkInterfaces *[]NetworkInterfaceArmReference `json:"networkInterfaces,omitempty"`
+}
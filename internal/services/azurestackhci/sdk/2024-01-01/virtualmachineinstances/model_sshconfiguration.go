@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type SshConfiguration struct {
+	PublicKeys *[]SshPublicKey `json:"publicKeys,omitempty"`
+}
@@ -0,0 +1,6 @@
+package virtualmachineinstances
+
+type SshPublicKey struct {
+	KeyData *string `json:"keyData,omitempty"`
+	Path    *string `json:"path,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type StorageProfileUpdate struct {
+	DataDisks *[]VirtualHardDiskArmReference `json:"dataDisks,omitempty"`
+}
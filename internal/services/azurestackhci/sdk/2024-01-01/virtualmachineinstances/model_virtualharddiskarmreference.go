@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualHardDiskArmReference struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,14 @@
+package virtualmachineinstances
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type VirtualMachineInstance struct {
+	ExtendedLocation *ExtendedLocation                 `json:"extendedLocation,omitempty"`
+	Id               *string                           `json:"id,omitempty"`
+	Identity         *identity.SystemAssigned          `json:"identity,omitempty"`
+	Name             *string                           `json:"name,omitempty"`
+	Properties       *VirtualMachineInstanceProperties `json:"properties,omitempty"`
+	Type             *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesHardwareProfile struct {
+	DynamicMemoryConfig *VirtualMachineInstancePropertiesHardwareProfileDynamicMemoryConfig `json:"dynamicMemoryConfig,omitempty"`
+	MemoryMB            *int64                                                              `json:"memoryMB,omitempty"`
+	Processors          *int64                                                              `json:"processors,omitempty"`
+	VMSize              *VMSizeEnum                                                         `json:"vmSize,omitempty"`
+}
@@ -0,0 +1,5 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesNetworkProfile struct {
+	NetworkInterfaces *[]NetworkInterfaceArmReference `json:"networkInterfaces,omitempty"`
+}
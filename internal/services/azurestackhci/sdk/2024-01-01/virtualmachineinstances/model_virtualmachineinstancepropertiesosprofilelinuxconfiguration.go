@@ -0,0 +1,8 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesOsProfileLinuxConfiguration struct {
+	DisablePasswordAuthentication *bool             `json:"disablePasswordAuthentication,omitempty"`
+	ProvisionVMAgent              *bool             `json:"provisionVMAgent,omitempty"`
+	ProvisionVMConfigAgent        *bool             `json:"provisionVMConfigAgent,omitempty"`
+	Ssh                           *SshConfiguration `json:"ssh,omitempty"`
+}
@@ -0,0 +1,9 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesOsProfileWindowsConfiguration struct {
+	EnableAutomaticUpdates *bool             `json:"enableAutomaticUpdates,omitempty"`
+	ProvisionVMAgent       *bool             `json:"provisionVMAgent,omitempty"`
+	ProvisionVMConfigAgent *bool             `json:"provisionVMConfigAgent,omitempty"`
+	Ssh                    *SshConfiguration `json:"ssh,omitempty"`
+	TimeZone               *string           `json:"timeZone,omitempty"`
+}
@@ -0,0 +1,6 @@
+package virtualmachineinstances
+
+type VirtualMachineInstancePropertiesStorageProfileOsDisk struct {
+	Id     *string               `json:"id,omitempty"`
+	OsType *OperatingSystemTypes `json:"osType,omitempty"`
+}
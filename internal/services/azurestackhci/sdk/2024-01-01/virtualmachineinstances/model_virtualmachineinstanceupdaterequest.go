@@ -0,0 +1,10 @@
+package virtualmachineinstances
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type VirtualMachineInstanceUpdateRequest struct {
+	Identity   *identity.SystemAssigned                `json:"identity,omitempty"`
+	Properties *VirtualMachineInstanceUpdateProperties `json:"properties,omitempty"`
+}
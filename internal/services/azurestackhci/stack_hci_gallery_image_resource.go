@@ -0,0 +1,258 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/galleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCIGalleryImageResource struct{}
+
+var _ sdk.ResourceWithUpdate = StackHCIGalleryImageResource{}
+
+type StackHCIGalleryImageResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	CustomLocationId  string            `tfschema:"custom_location_id"`
+	HypervGeneration  string            `tfschema:"hyperv_generation"`
+	ImagePath         string            `tfschema:"image_path"`
+	OsType            string            `tfschema:"os_type"`
+	StoragePathId     string            `tfschema:"storage_path_id"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r StackHCIGalleryImageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"hyperv_generation": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(galleryimages.PossibleValuesForHyperVGeneration(), false),
+		},
+
+		"image_path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(galleryimages.PossibleValuesForOperatingSystemTypes(), false),
+		},
+
+		"storage_path_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCIGalleryImageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIGalleryImageResource) ModelObject() interface{} {
+	return &StackHCIGalleryImageResourceModel{}
+}
+
+func (r StackHCIGalleryImageResource) ResourceType() string {
+	return "azurerm_stack_hci_gallery_image"
+}
+
+func (r StackHCIGalleryImageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return galleryimages.ValidateGalleryImageID
+}
+
+func (r StackHCIGalleryImageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// copying the image onto the cluster can take a long time
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StackHCIGalleryImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := galleryimages.NewGalleryImageID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := galleryimages.ExtendedLocationTypesCustomLocation
+			payload := galleryimages.GalleryImages{
+				ExtendedLocation: &galleryimages.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &galleryimages.GalleryImageProperties{
+					ImagePath: utils.String(model.ImagePath),
+					OsType:    galleryimages.OperatingSystemTypes(model.OsType),
+				},
+				Tags: &model.Tags,
+			}
+			if model.HypervGeneration != "" {
+				hypervGeneration := galleryimages.HyperVGeneration(model.HypervGeneration)
+				payload.Properties.HyperVGeneration = &hypervGeneration
+			}
+			if model.StoragePathId != "" {
+				payload.Properties.ContainerId = utils.String(model.StoragePathId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+
+			id, err := galleryimages.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCIGalleryImageResourceModel{
+				Name:              id.GalleryImageName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = utils.NormalizeNilableString(model.ExtendedLocation.Name)
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.OsType = string(props.OsType)
+					state.StoragePathId = utils.NormalizeNilableString(props.ContainerId)
+					if props.HyperVGeneration != nil {
+						state.HypervGeneration = string(*props.HyperVGeneration)
+					}
+					// the API doesn't always return the `image_path` once the image has been copied, so fall back to the config
+					state.ImagePath = metadata.ResourceData.Get("image_path").(string)
+					if props.ImagePath != nil {
+						state.ImagePath = *props.ImagePath
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+
+			id, err := galleryimages.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIGalleryImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := galleryimages.GalleryImagesUpdateRequest{
+					Tags: &model.Tags,
+				}
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIGalleryImageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.GalleryImagesClient
+
+			id, err := galleryimages.ParseGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
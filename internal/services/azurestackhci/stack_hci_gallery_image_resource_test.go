@@ -0,0 +1,119 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/galleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCIGalleryImageResource struct {
+	customLocationId string
+	imagePath        string
+}
+
+func newStackHCIGalleryImageResource(t *testing.T) StackHCIGalleryImageResource {
+	r := StackHCIGalleryImageResource{
+		customLocationId: os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"),
+		imagePath:        os.Getenv("ARM_TEST_STACK_HCI_IMAGE_PATH"),
+	}
+	if r.customLocationId == "" || r.imagePath == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID and/or ARM_TEST_STACK_HCI_IMAGE_PATH are not specified")
+	}
+
+	return r
+}
+
+func TestAccStackHCIGalleryImage_basic(t *testing.T) {
+	r := newStackHCIGalleryImageResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCIGalleryImage_requiresImport(t *testing.T) {
+	r := newStackHCIGalleryImageResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_gallery_image", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (StackHCIGalleryImageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := galleryimages.ParseGalleryImageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AzureStackHCI.GalleryImagesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCIGalleryImageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hci-gi-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_stack_hci_gallery_image" "test" {
+  name                = "acctest-gi-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%[3]s"
+  hyperv_generation   = "V2"
+  image_path          = "%[4]s"
+  os_type             = "Linux"
+}
+`, data.RandomInteger, data.Locations.Primary, r.customLocationId, r.imagePath)
+}
+
+func (r StackHCIGalleryImageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_gallery_image" "import" {
+  name                = azurerm_stack_hci_gallery_image.test.name
+  resource_group_name = azurerm_stack_hci_gallery_image.test.resource_group_name
+  location            = azurerm_stack_hci_gallery_image.test.location
+  custom_location_id  = azurerm_stack_hci_gallery_image.test.custom_location_id
+  hyperv_generation   = azurerm_stack_hci_gallery_image.test.hyperv_generation
+  image_path          = azurerm_stack_hci_gallery_image.test.image_path
+  os_type             = azurerm_stack_hci_gallery_image.test.os_type
+}
+`, r.basic(data))
+}
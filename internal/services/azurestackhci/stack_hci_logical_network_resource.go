@@ -0,0 +1,485 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCILogicalNetworkResource struct{}
+
+var _ sdk.ResourceWithUpdate = StackHCILogicalNetworkResource{}
+var _ sdk.ResourceWithCustomizeDiff = StackHCILogicalNetworkResource{}
+
+type StackHCILogicalNetworkResourceModel struct {
+	Name              string                         `tfschema:"name"`
+	ResourceGroupName string                         `tfschema:"resource_group_name"`
+	Location          string                         `tfschema:"location"`
+	CustomLocationId  string                         `tfschema:"custom_location_id"`
+	VirtualSwitchName string                         `tfschema:"virtual_switch_name"`
+	DnsServers        []string                       `tfschema:"dns_servers"`
+	Subnet            []StackHCILogicalNetworkSubnet `tfschema:"subnet"`
+	Tags              map[string]string              `tfschema:"tags"`
+}
+
+type StackHCILogicalNetworkSubnet struct {
+	AddressPrefix      string                         `tfschema:"address_prefix"`
+	IPAllocationMethod string                         `tfschema:"ip_allocation_method"`
+	IPPool             []StackHCILogicalNetworkIPPool `tfschema:"ip_pool"`
+	Route              []StackHCILogicalNetworkRoute  `tfschema:"route"`
+	VlanId             int64                          `tfschema:"vlan_id"`
+}
+
+type StackHCILogicalNetworkIPPool struct {
+	Start string `tfschema:"start"`
+	End   string `tfschema:"end"`
+}
+
+type StackHCILogicalNetworkRoute struct {
+	Name             string `tfschema:"name"`
+	AddressPrefix    string `tfschema:"address_prefix"`
+	NextHopIPAddress string `tfschema:"next_hop_ip_address"`
+}
+
+func (r StackHCILogicalNetworkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"virtual_switch_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+
+		"subnet": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_allocation_method": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(logicalnetworks.PossibleValuesForIPAllocationMethodEnum(), false),
+					},
+
+					"address_prefix": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsCIDR,
+					},
+
+					"ip_pool": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"start": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+
+								"end": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+							},
+						},
+					},
+
+					"route": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"address_prefix": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsCIDR,
+								},
+
+								"next_hop_ip_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPv4Address,
+								},
+							},
+						},
+					},
+
+					"vlan_id": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(0, 4094),
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCILogicalNetworkResource) ModelObject() interface{} {
+	return &StackHCILogicalNetworkResourceModel{}
+}
+
+func (r StackHCILogicalNetworkResource) ResourceType() string {
+	return "azurerm_stack_hci_logical_network"
+}
+
+func (r StackHCILogicalNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return logicalnetworks.ValidateLogicalNetworkID
+}
+
+func (r StackHCILogicalNetworkResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StackHCILogicalNetworkResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a Static allocation needs an address space to allocate from, which the Dynamic (DHCP) allocation takes from the network
+			for _, subnet := range model.Subnet {
+				if subnet.IPAllocationMethod == string(logicalnetworks.IPAllocationMethodEnumStatic) && subnet.AddressPrefix == "" {
+					return fmt.Errorf("`address_prefix` must be specified when `ip_allocation_method` is `Static`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StackHCILogicalNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := logicalnetworks.NewLogicalNetworkID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := logicalnetworks.ExtendedLocationTypesCustomLocation
+			payload := logicalnetworks.LogicalNetworks{
+				ExtendedLocation: &logicalnetworks.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &logicalnetworks.LogicalNetworkProperties{
+					Subnets:      expandStackHCILogicalNetworkSubnets(model.Subnet),
+					VMSwitchName: utils.String(model.VirtualSwitchName),
+				},
+				Tags: &model.Tags,
+			}
+			if len(model.DnsServers) > 0 {
+				payload.Properties.DhcpOptions = &logicalnetworks.LogicalNetworkPropertiesDhcpOptions{
+					DnsServers: &model.DnsServers,
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+
+			id, err := logicalnetworks.ParseLogicalNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCILogicalNetworkResourceModel{
+				Name:              id.LogicalNetworkName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = utils.NormalizeNilableString(model.ExtendedLocation.Name)
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.Subnet = flattenStackHCILogicalNetworkSubnets(props.Subnets)
+					state.VirtualSwitchName = utils.NormalizeNilableString(props.VMSwitchName)
+
+					dnsServers := make([]string, 0)
+					if props.DhcpOptions != nil && props.DhcpOptions.DnsServers != nil {
+						dnsServers = *props.DhcpOptions.DnsServers
+					}
+					state.DnsServers = dnsServers
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+
+			id, err := logicalnetworks.ParseLogicalNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCILogicalNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := logicalnetworks.LogicalNetworksUpdateRequest{
+					Tags: &model.Tags,
+				}
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCILogicalNetworkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.LogicalNetworksClient
+
+			id, err := logicalnetworks.ParseLogicalNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCILogicalNetworkSubnets(input []StackHCILogicalNetworkSubnet) *[]logicalnetworks.Subnet {
+	output := make([]logicalnetworks.Subnet, 0)
+	for _, v := range input {
+		ipAllocationMethod := logicalnetworks.IPAllocationMethodEnum(v.IPAllocationMethod)
+		props := logicalnetworks.SubnetPropertiesFormat{
+			IPAllocationMethod: &ipAllocationMethod,
+			IPPools:            expandStackHCILogicalNetworkIPPools(v.IPPool),
+			Vlan:               utils.Int64(v.VlanId),
+		}
+		if v.AddressPrefix != "" {
+			props.AddressPrefix = utils.String(v.AddressPrefix)
+		}
+		if len(v.Route) > 0 {
+			props.RouteTable = &logicalnetworks.RouteTable{
+				Properties: &logicalnetworks.RouteTablePropertiesFormat{
+					Routes: expandStackHCILogicalNetworkRoutes(v.Route),
+				},
+			}
+		}
+
+		output = append(output, logicalnetworks.Subnet{
+			Properties: &props,
+		})
+	}
+	return &output
+}
+
+func expandStackHCILogicalNetworkIPPools(input []StackHCILogicalNetworkIPPool) *[]logicalnetworks.IPPool {
+	output := make([]logicalnetworks.IPPool, 0)
+	for _, v := range input {
+		output = append(output, logicalnetworks.IPPool{
+			End:   utils.String(v.End),
+			Start: utils.String(v.Start),
+		})
+	}
+	return &output
+}
+
+func expandStackHCILogicalNetworkRoutes(input []StackHCILogicalNetworkRoute) *[]logicalnetworks.Route {
+	output := make([]logicalnetworks.Route, 0)
+	for _, v := range input {
+		route := logicalnetworks.Route{
+			Properties: &logicalnetworks.RoutePropertiesFormat{
+				AddressPrefix:    utils.String(v.AddressPrefix),
+				NextHopIPAddress: utils.String(v.NextHopIPAddress),
+			},
+		}
+		if v.Name != "" {
+			route.Name = utils.String(v.Name)
+		}
+		output = append(output, route)
+	}
+	return &output
+}
+
+func flattenStackHCILogicalNetworkSubnets(input *[]logicalnetworks.Subnet) []StackHCILogicalNetworkSubnet {
+	output := make([]StackHCILogicalNetworkSubnet, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		props := v.Properties
+		if props == nil {
+			continue
+		}
+
+		subnet := StackHCILogicalNetworkSubnet{
+			AddressPrefix: utils.NormalizeNilableString(props.AddressPrefix),
+			IPPool:        flattenStackHCILogicalNetworkIPPools(props.IPPools),
+			Route:         make([]StackHCILogicalNetworkRoute, 0),
+		}
+		if props.IPAllocationMethod != nil {
+			subnet.IPAllocationMethod = string(*props.IPAllocationMethod)
+		}
+		if props.Vlan != nil {
+			subnet.VlanId = *props.Vlan
+		}
+		if props.RouteTable != nil && props.RouteTable.Properties != nil {
+			subnet.Route = flattenStackHCILogicalNetworkRoutes(props.RouteTable.Properties.Routes)
+		}
+
+		output = append(output, subnet)
+	}
+	return output
+}
+
+func flattenStackHCILogicalNetworkIPPools(input *[]logicalnetworks.IPPool) []StackHCILogicalNetworkIPPool {
+	output := make([]StackHCILogicalNetworkIPPool, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, StackHCILogicalNetworkIPPool{
+			End:   utils.NormalizeNilableString(v.End),
+			Start: utils.NormalizeNilableString(v.Start),
+		})
+	}
+	return output
+}
+
+func flattenStackHCILogicalNetworkRoutes(input *[]logicalnetworks.Route) []StackHCILogicalNetworkRoute {
+	output := make([]StackHCILogicalNetworkRoute, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		route := StackHCILogicalNetworkRoute{
+			Name: utils.NormalizeNilableString(v.Name),
+		}
+		if props := v.Properties; props != nil {
+			route.AddressPrefix = utils.NormalizeNilableString(props.AddressPrefix)
+			route.NextHopIPAddress = utils.NormalizeNilableString(props.NextHopIPAddress)
+		}
+		output = append(output, route)
+	}
+	return output
+}
@@ -0,0 +1,204 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCILogicalNetworkResource struct {
+	customLocationId  string
+	virtualSwitchName string
+}
+
+// the Logical Network has to be created on a Custom Location which is backed by an Azure Stack HCI cluster, which
+// can't be provisioned as a part of the test - as such these need to be specified as environment variables
+func newStackHCILogicalNetworkResource(t *testing.T) StackHCILogicalNetworkResource {
+	r := StackHCILogicalNetworkResource{
+		customLocationId:  os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"),
+		virtualSwitchName: os.Getenv("ARM_TEST_STACK_HCI_VIRTUAL_SWITCH_NAME"),
+	}
+	if r.customLocationId == "" || r.virtualSwitchName == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID and/or ARM_TEST_STACK_HCI_VIRTUAL_SWITCH_NAME are not specified")
+	}
+
+	return r
+}
+
+func TestAccStackHCILogicalNetwork_basic(t *testing.T) {
+	r := newStackHCILogicalNetworkResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCILogicalNetwork_requiresImport(t *testing.T) {
+	r := newStackHCILogicalNetworkResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCILogicalNetwork_complete(t *testing.T) {
+	r := newStackHCILogicalNetworkResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "one"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCILogicalNetwork_update(t *testing.T) {
+	r := newStackHCILogicalNetworkResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_logical_network", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "one"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "two"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (StackHCILogicalNetworkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := logicalnetworks.ParseLogicalNetworkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AzureStackHCI.LogicalNetworksClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCILogicalNetworkResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hci-ln-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StackHCILogicalNetworkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%s"
+  virtual_switch_name = "%s"
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+`, r.template(data), data.RandomInteger, r.customLocationId, r.virtualSwitchName)
+}
+
+func (r StackHCILogicalNetworkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_logical_network" "import" {
+  name                = azurerm_stack_hci_logical_network.test.name
+  resource_group_name = azurerm_stack_hci_logical_network.test.resource_group_name
+  location            = azurerm_stack_hci_logical_network.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+  virtual_switch_name = azurerm_stack_hci_logical_network.test.virtual_switch_name
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+`, r.basic(data))
+}
+
+func (r StackHCILogicalNetworkResource) complete(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%[3]s"
+  virtual_switch_name = "%[4]s"
+  dns_servers         = ["10.0.0.7", "10.0.0.8"]
+
+  subnet {
+    ip_allocation_method = "Static"
+    address_prefix       = "10.0.0.0/24"
+    vlan_id              = 123
+
+    ip_pool {
+      start = "10.0.0.218"
+      end   = "10.0.0.230"
+    }
+
+    route {
+      name                = "test-route"
+      address_prefix      = "0.0.0.0/0"
+      next_hop_ip_address = "10.0.0.1"
+    }
+  }
+
+  tags = {
+    environment = "%[5]s"
+  }
+}
+`, r.template(data), data.RandomInteger, r.customLocationId, r.virtualSwitchName, tag)
+}
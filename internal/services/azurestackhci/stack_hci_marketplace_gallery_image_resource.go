@@ -0,0 +1,307 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/marketplacegalleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCIMarketplaceGalleryImageResource struct{}
+
+var _ sdk.ResourceWithUpdate = StackHCIMarketplaceGalleryImageResource{}
+
+type StackHCIMarketplaceGalleryImageResourceModel struct {
+	Name              string                               `tfschema:"name"`
+	ResourceGroupName string                               `tfschema:"resource_group_name"`
+	Location          string                               `tfschema:"location"`
+	CustomLocationId  string                               `tfschema:"custom_location_id"`
+	HypervGeneration  string                               `tfschema:"hyperv_generation"`
+	Identifier        []StackHCIMarketplaceImageIdentifier `tfschema:"identifier"`
+	OsType            string                               `tfschema:"os_type"`
+	Version           string                               `tfschema:"version"`
+	StoragePathId     string                               `tfschema:"storage_path_id"`
+	Tags              map[string]string                    `tfschema:"tags"`
+}
+
+type StackHCIMarketplaceImageIdentifier struct {
+	Publisher string `tfschema:"publisher"`
+	Offer     string `tfschema:"offer"`
+	Sku       string `tfschema:"sku"`
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"hyperv_generation": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(marketplacegalleryimages.PossibleValuesForHyperVGeneration(), false),
+		},
+
+		"identifier": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"os_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(marketplacegalleryimages.PossibleValuesForOperatingSystemTypes(), false),
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_path_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) ModelObject() interface{} {
+	return &StackHCIMarketplaceGalleryImageResourceModel{}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) ResourceType() string {
+	return "azurerm_stack_hci_marketplace_gallery_image"
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return marketplacegalleryimages.ValidateMarketplaceGalleryImageID
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// downloading the image from the Marketplace onto the cluster can take a long time
+		Timeout: 2 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StackHCIMarketplaceGalleryImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := marketplacegalleryimages.NewMarketplaceGalleryImageID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := marketplacegalleryimages.ExtendedLocationTypesCustomLocation
+			hypervGeneration := marketplacegalleryimages.HyperVGeneration(model.HypervGeneration)
+			payload := marketplacegalleryimages.MarketplaceGalleryImages{
+				ExtendedLocation: &marketplacegalleryimages.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &marketplacegalleryimages.MarketplaceGalleryImageProperties{
+					HyperVGeneration: &hypervGeneration,
+					Identifier: &marketplacegalleryimages.GalleryImageIdentifier{
+						Offer:     model.Identifier[0].Offer,
+						Publisher: model.Identifier[0].Publisher,
+						Sku:       model.Identifier[0].Sku,
+					},
+					OsType: marketplacegalleryimages.OperatingSystemTypes(model.OsType),
+					Version: &marketplacegalleryimages.GalleryImageVersion{
+						Name: utils.String(model.Version),
+					},
+				},
+				Tags: &model.Tags,
+			}
+			if model.StoragePathId != "" {
+				payload.Properties.ContainerId = utils.String(model.StoragePathId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+
+			id, err := marketplacegalleryimages.ParseMarketplaceGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCIMarketplaceGalleryImageResourceModel{
+				Name:              id.MarketplaceGalleryImageName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = utils.NormalizeNilableString(model.ExtendedLocation.Name)
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.OsType = string(props.OsType)
+					state.StoragePathId = utils.NormalizeNilableString(props.ContainerId)
+					if props.HyperVGeneration != nil {
+						state.HypervGeneration = string(*props.HyperVGeneration)
+					}
+					if identifier := props.Identifier; identifier != nil {
+						state.Identifier = []StackHCIMarketplaceImageIdentifier{
+							{
+								Offer:     identifier.Offer,
+								Publisher: identifier.Publisher,
+								Sku:       identifier.Sku,
+							},
+						}
+					}
+					if props.Version != nil {
+						state.Version = utils.NormalizeNilableString(props.Version.Name)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+
+			id, err := marketplacegalleryimages.ParseMarketplaceGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIMarketplaceGalleryImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := marketplacegalleryimages.MarketplaceGalleryImagesUpdateRequest{
+					Tags: &model.Tags,
+				}
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.MarketplaceGalleryImagesClient
+
+			id, err := marketplacegalleryimages.ParseMarketplaceGalleryImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
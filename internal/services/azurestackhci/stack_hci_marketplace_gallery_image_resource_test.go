@@ -0,0 +1,183 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/marketplacegalleryimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCIMarketplaceGalleryImageResource struct {
+	customLocationId string
+}
+
+func newStackHCIMarketplaceGalleryImageResource(t *testing.T) StackHCIMarketplaceGalleryImageResource {
+	r := StackHCIMarketplaceGalleryImageResource{
+		customLocationId: os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"),
+	}
+	if r.customLocationId == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID is not specified")
+	}
+
+	return r
+}
+
+func TestAccStackHCIMarketplaceGalleryImage_basic(t *testing.T) {
+	r := newStackHCIMarketplaceGalleryImageResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_marketplace_gallery_image", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCIMarketplaceGalleryImage_requiresImport(t *testing.T) {
+	r := newStackHCIMarketplaceGalleryImageResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_marketplace_gallery_image", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCIMarketplaceGalleryImage_update(t *testing.T) {
+	r := newStackHCIMarketplaceGalleryImageResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_marketplace_gallery_image", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (StackHCIMarketplaceGalleryImageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := marketplacegalleryimages.ParseMarketplaceGalleryImageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AzureStackHCI.MarketplaceGalleryImagesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hci-mgi-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_marketplace_gallery_image" "test" {
+  name                = "acctest-mgi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%s"
+  hyperv_generation   = "V2"
+  os_type             = "Windows"
+  version             = "20348.2227.240104"
+
+  identifier {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2022-datacenter-azure-edition-core"
+  }
+}
+`, r.template(data), data.RandomInteger, r.customLocationId)
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_marketplace_gallery_image" "import" {
+  name                = azurerm_stack_hci_marketplace_gallery_image.test.name
+  resource_group_name = azurerm_stack_hci_marketplace_gallery_image.test.resource_group_name
+  location            = azurerm_stack_hci_marketplace_gallery_image.test.location
+  custom_location_id  = azurerm_stack_hci_marketplace_gallery_image.test.custom_location_id
+  hyperv_generation   = azurerm_stack_hci_marketplace_gallery_image.test.hyperv_generation
+  os_type             = azurerm_stack_hci_marketplace_gallery_image.test.os_type
+  version             = azurerm_stack_hci_marketplace_gallery_image.test.version
+
+  identifier {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2022-datacenter-azure-edition-core"
+  }
+}
+`, r.basic(data))
+}
+
+func (r StackHCIMarketplaceGalleryImageResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_marketplace_gallery_image" "test" {
+  name                = "acctest-mgi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%s"
+  hyperv_generation   = "V2"
+  os_type             = "Windows"
+  version             = "20348.2227.240104"
+
+  identifier {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2022-datacenter-azure-edition-core"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, r.customLocationId)
+}
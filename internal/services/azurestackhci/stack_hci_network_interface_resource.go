@@ -0,0 +1,333 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/logicalnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCINetworkInterfaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = StackHCINetworkInterfaceResource{}
+
+type StackHCINetworkInterfaceResourceModel struct {
+	Name              string                                    `tfschema:"name"`
+	ResourceGroupName string                                    `tfschema:"resource_group_name"`
+	Location          string                                    `tfschema:"location"`
+	CustomLocationId  string                                    `tfschema:"custom_location_id"`
+	DnsServers        []string                                  `tfschema:"dns_servers"`
+	IPConfiguration   []StackHCINetworkInterfaceIPConfiguration `tfschema:"ip_configuration"`
+	MacAddress        string                                    `tfschema:"mac_address"`
+	Tags              map[string]string                         `tfschema:"tags"`
+}
+
+type StackHCINetworkInterfaceIPConfiguration struct {
+	SubnetId         string `tfschema:"subnet_id"`
+	PrivateIPAddress string `tfschema:"private_ip_address"`
+	Gateway          string `tfschema:"gateway"`
+	PrefixLength     string `tfschema:"prefix_length"`
+}
+
+func (r StackHCINetworkInterfaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"ip_configuration": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: logicalnetworks.ValidateLogicalNetworkID,
+					},
+
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPv4Address,
+					},
+
+					"gateway": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"prefix_length": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"dns_servers": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+
+		"mac_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCINetworkInterfaceResource) ModelObject() interface{} {
+	return &StackHCINetworkInterfaceResourceModel{}
+}
+
+func (r StackHCINetworkInterfaceResource) ResourceType() string {
+	return "azurerm_stack_hci_network_interface"
+}
+
+func (r StackHCINetworkInterfaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkinterfaces.ValidateNetworkInterfaceID
+}
+
+func (r StackHCINetworkInterfaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model StackHCINetworkInterfaceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := networkinterfaces.NewNetworkInterfaceID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := networkinterfaces.ExtendedLocationTypesCustomLocation
+			payload := networkinterfaces.NetworkInterfaces{
+				ExtendedLocation: &networkinterfaces.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Location: location.Normalize(model.Location),
+				Properties: &networkinterfaces.NetworkInterfaceProperties{
+					IPConfigurations: expandStackHCINetworkInterfaceIPConfigurations(model.IPConfiguration),
+				},
+				Tags: &model.Tags,
+			}
+			if len(model.DnsServers) > 0 {
+				payload.Properties.DnsSettings = &networkinterfaces.InterfaceDNSSettings{
+					DnsServers: &model.DnsServers,
+				}
+			}
+			if model.MacAddress != "" {
+				payload.Properties.MacAddress = utils.String(model.MacAddress)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+
+			id, err := networkinterfaces.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCINetworkInterfaceResourceModel{
+				Name:              id.NetworkInterfaceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = utils.NormalizeNilableString(model.ExtendedLocation.Name)
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.IPConfiguration = flattenStackHCINetworkInterfaceIPConfigurations(props.IPConfigurations)
+					state.MacAddress = utils.NormalizeNilableString(props.MacAddress)
+
+					dnsServers := make([]string, 0)
+					if props.DnsSettings != nil && props.DnsSettings.DnsServers != nil {
+						dnsServers = *props.DnsSettings.DnsServers
+					}
+					state.DnsServers = dnsServers
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+
+			id, err := networkinterfaces.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCINetworkInterfaceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := networkinterfaces.NetworkInterfacesUpdateRequest{
+					Tags: &model.Tags,
+				}
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCINetworkInterfaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.NetworkInterfacesClient
+
+			id, err := networkinterfaces.ParseNetworkInterfaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCINetworkInterfaceIPConfigurations(input []StackHCINetworkInterfaceIPConfiguration) *[]networkinterfaces.IPConfiguration {
+	output := make([]networkinterfaces.IPConfiguration, 0)
+	for _, v := range input {
+		props := networkinterfaces.IPConfigurationProperties{
+			Subnet: &networkinterfaces.IPConfigurationPropertiesSubnet{
+				Id: utils.String(v.SubnetId),
+			},
+		}
+		if v.PrivateIPAddress != "" {
+			props.PrivateIPAddress = utils.String(v.PrivateIPAddress)
+		}
+
+		output = append(output, networkinterfaces.IPConfiguration{
+			Properties: &props,
+		})
+	}
+	return &output
+}
+
+func flattenStackHCINetworkInterfaceIPConfigurations(input *[]networkinterfaces.IPConfiguration) []StackHCINetworkInterfaceIPConfiguration {
+	output := make([]StackHCINetworkInterfaceIPConfiguration, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		props := v.Properties
+		if props == nil {
+			continue
+		}
+
+		ipConfiguration := StackHCINetworkInterfaceIPConfiguration{
+			Gateway:          utils.NormalizeNilableString(props.Gateway),
+			PrefixLength:     utils.NormalizeNilableString(props.PrefixLength),
+			PrivateIPAddress: utils.NormalizeNilableString(props.PrivateIPAddress),
+		}
+		if props.Subnet != nil && props.Subnet.Id != nil {
+			subnetId, err := logicalnetworks.ParseLogicalNetworkIDInsensitively(*props.Subnet.Id)
+			if err == nil {
+				ipConfiguration.SubnetId = subnetId.ID()
+			}
+		}
+
+		output = append(output, ipConfiguration)
+	}
+	return output
+}
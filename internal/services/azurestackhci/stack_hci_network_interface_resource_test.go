@@ -0,0 +1,184 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCINetworkInterfaceResource struct {
+	customLocationId  string
+	virtualSwitchName string
+}
+
+func newStackHCINetworkInterfaceResource(t *testing.T) StackHCINetworkInterfaceResource {
+	r := StackHCINetworkInterfaceResource{
+		customLocationId:  os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"),
+		virtualSwitchName: os.Getenv("ARM_TEST_STACK_HCI_VIRTUAL_SWITCH_NAME"),
+	}
+	if r.customLocationId == "" || r.virtualSwitchName == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID and/or ARM_TEST_STACK_HCI_VIRTUAL_SWITCH_NAME are not specified")
+	}
+
+	return r
+}
+
+func TestAccStackHCINetworkInterface_basic(t *testing.T) {
+	r := newStackHCINetworkInterfaceResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStackHCINetworkInterface_requiresImport(t *testing.T) {
+	r := newStackHCINetworkInterfaceResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCINetworkInterface_complete(t *testing.T) {
+	r := newStackHCINetworkInterfaceResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_network_interface", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (StackHCINetworkInterfaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkinterfaces.ParseNetworkInterfaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AzureStackHCI.NetworkInterfacesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCINetworkInterfaceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hci-nic-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%[3]s"
+  virtual_switch_name = "%[4]s"
+
+  subnet {
+    ip_allocation_method = "Static"
+    address_prefix       = "10.0.0.0/24"
+
+    ip_pool {
+      start = "10.0.0.218"
+      end   = "10.0.0.230"
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, r.customLocationId, r.virtualSwitchName)
+}
+
+func (r StackHCINetworkInterfaceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-nic-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_logical_network.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StackHCINetworkInterfaceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_network_interface" "import" {
+  name                = azurerm_stack_hci_network_interface.test.name
+  resource_group_name = azurerm_stack_hci_network_interface.test.resource_group_name
+  location            = azurerm_stack_hci_network_interface.test.location
+  custom_location_id  = azurerm_stack_hci_network_interface.test.custom_location_id
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_logical_network.test.id
+  }
+}
+`, r.basic(data))
+}
+
+func (r StackHCINetworkInterfaceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-nic-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = azurerm_stack_hci_logical_network.test.custom_location_id
+  dns_servers         = ["10.0.0.7", "10.0.0.8"]
+  mac_address         = "02:ec:01:0c:00:08"
+
+  ip_configuration {
+    subnet_id          = azurerm_stack_hci_logical_network.test.id
+    private_ip_address = "10.0.0.220"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,772 @@
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// StackHCIVirtualMachineResource manages the Virtual Machine Instance which is attached to an Arc Machine of kind `HCI`,
+// the Arc Machine itself is managed using the `azurerm_arc_machine` resource.
+type StackHCIVirtualMachineResource struct{}
+
+var _ sdk.ResourceWithUpdate = StackHCIVirtualMachineResource{}
+
+type StackHCIVirtualMachineResourceModel struct {
+	ArcMachineId        string                                  `tfschema:"arc_machine_id"`
+	CustomLocationId    string                                  `tfschema:"custom_location_id"`
+	HardwareProfile     []StackHCIVirtualMachineHardwareProfile `tfschema:"hardware_profile"`
+	NetworkInterfaceIds []string                                `tfschema:"network_interface_ids"`
+	OsProfile           []StackHCIVirtualMachineOsProfile       `tfschema:"os_profile"`
+	StorageProfile      []StackHCIVirtualMachineStorageProfile  `tfschema:"storage_profile"`
+	SecureBootEnabled   bool                                    `tfschema:"secure_boot_enabled"`
+	SecurityType        string                                  `tfschema:"security_type"`
+	TpmEnabled          bool                                    `tfschema:"tpm_enabled"`
+}
+
+type StackHCIVirtualMachineHardwareProfile struct {
+	VmSize         string                                `tfschema:"vm_size"`
+	ProcessorCount int64                                 `tfschema:"processor_count"`
+	MemoryInMb     int64                                 `tfschema:"memory_in_mb"`
+	DynamicMemory  []StackHCIVirtualMachineDynamicMemory `tfschema:"dynamic_memory"`
+}
+
+type StackHCIVirtualMachineDynamicMemory struct {
+	MaximumMemoryInMb            int64 `tfschema:"maximum_memory_in_mb"`
+	MinimumMemoryInMb            int64 `tfschema:"minimum_memory_in_mb"`
+	TargetMemoryBufferPercentage int64 `tfschema:"target_memory_buffer_percentage"`
+}
+
+type StackHCIVirtualMachineOsProfile struct {
+	AdminUsername        string                                       `tfschema:"admin_username"`
+	AdminPassword        string                                       `tfschema:"admin_password"`
+	ComputerName         string                                       `tfschema:"computer_name"`
+	LinuxConfiguration   []StackHCIVirtualMachineLinuxConfiguration   `tfschema:"linux_configuration"`
+	WindowsConfiguration []StackHCIVirtualMachineWindowsConfiguration `tfschema:"windows_configuration"`
+	VmAgentEnabled       bool                                         `tfschema:"vm_agent_enabled"`
+}
+
+type StackHCIVirtualMachineLinuxConfiguration struct {
+	PasswordAuthenticationEnabled bool                                 `tfschema:"password_authentication_enabled"`
+	SshPublicKey                  []StackHCIVirtualMachineSshPublicKey `tfschema:"ssh_public_key"`
+}
+
+type StackHCIVirtualMachineWindowsConfiguration struct {
+	AutomaticUpdatesEnabled bool   `tfschema:"automatic_updates_enabled"`
+	TimeZone                string `tfschema:"time_zone"`
+}
+
+type StackHCIVirtualMachineSshPublicKey struct {
+	Path    string `tfschema:"path"`
+	KeyData string `tfschema:"key_data"`
+}
+
+type StackHCIVirtualMachineStorageProfile struct {
+	ImageId               string   `tfschema:"image_id"`
+	DataDiskIds           []string `tfschema:"data_disk_ids"`
+	VmConfigStoragePathId string   `tfschema:"vm_config_storage_path_id"`
+}
+
+func (r StackHCIVirtualMachineResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+
+		"hardware_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vm_size": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(virtualmachineinstances.VMSizeEnumCustom),
+						ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForVMSizeEnum(), false),
+					},
+
+					"processor_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"memory_in_mb": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"dynamic_memory": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"maximum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"minimum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"target_memory_buffer_percentage": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntBetween(5, 2000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"network_interface_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkinterfaces.ValidateNetworkInterfaceID,
+			},
+		},
+
+		"os_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"admin_password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"computer_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(1, 15),
+					},
+
+					"linux_configuration": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						ForceNew:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"password_authentication_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"ssh_public_key": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									ForceNew: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"path": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"key_data": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+
+					"windows_configuration": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						ForceNew:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"os_profile.0.linux_configuration", "os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"automatic_updates_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"time_zone": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"vm_agent_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"storage_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"data_disk_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+					},
+
+					"vm_config_storage_path_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+				},
+			},
+		},
+
+		"secure_boot_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"security_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForSecurityTypes(), false),
+		},
+
+		"tpm_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIVirtualMachineResource) ModelObject() interface{} {
+	return &StackHCIVirtualMachineResourceModel{}
+}
+
+func (r StackHCIVirtualMachineResource) ResourceType() string {
+	return "azurerm_stack_hci_virtual_machine"
+}
+
+func (r StackHCIVirtualMachineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualmachineinstances.ValidateVirtualMachineInstanceID
+}
+
+func (r StackHCIVirtualMachineResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			var model StackHCIVirtualMachineResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := virtualmachineinstances.NewVirtualMachineInstanceID(model.ArcMachineId)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			extendedLocationType := virtualmachineinstances.ExtendedLocationTypesCustomLocation
+			payload := virtualmachineinstances.VirtualMachineInstance{
+				ExtendedLocation: &virtualmachineinstances.ExtendedLocation{
+					Name: utils.String(model.CustomLocationId),
+					Type: &extendedLocationType,
+				},
+				Properties: &virtualmachineinstances.VirtualMachineInstanceProperties{
+					HardwareProfile: expandStackHCIVirtualMachineHardwareProfile(model.HardwareProfile),
+					NetworkProfile: &virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile{
+						NetworkInterfaces: expandStackHCIVirtualMachineNetworkInterfaces(model.NetworkInterfaceIds),
+					},
+					OsProfile:       expandStackHCIVirtualMachineOsProfile(model.OsProfile),
+					SecurityProfile: expandStackHCIVirtualMachineSecurityProfile(model),
+					StorageProfile:  expandStackHCIVirtualMachineStorageProfile(model.StorageProfile),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StackHCIVirtualMachineResourceModel{
+				ArcMachineId: id.Scope,
+			}
+
+			// the API doesn't return the `admin_password`, so this is pulled from the config
+			var config StackHCIVirtualMachineResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model := resp.Model; model != nil {
+				if model.ExtendedLocation != nil {
+					state.CustomLocationId = utils.NormalizeNilableString(model.ExtendedLocation.Name)
+				}
+
+				if props := model.Properties; props != nil {
+					state.HardwareProfile = flattenStackHCIVirtualMachineHardwareProfile(props.HardwareProfile)
+					state.OsProfile = flattenStackHCIVirtualMachineOsProfile(props.OsProfile, config.OsProfile)
+					state.StorageProfile = flattenStackHCIVirtualMachineStorageProfile(props.StorageProfile)
+
+					networkInterfaceIds := make([]string, 0)
+					if props.NetworkProfile != nil && props.NetworkProfile.NetworkInterfaces != nil {
+						for _, v := range *props.NetworkProfile.NetworkInterfaces {
+							if v.Id == nil {
+								continue
+							}
+							networkInterfaceId, err := networkinterfaces.ParseNetworkInterfaceIDInsensitively(*v.Id)
+							if err != nil {
+								return err
+							}
+							networkInterfaceIds = append(networkInterfaceIds, networkInterfaceId.ID())
+						}
+					}
+					state.NetworkInterfaceIds = networkInterfaceIds
+
+					if securityProfile := props.SecurityProfile; securityProfile != nil {
+						state.TpmEnabled = utils.NormaliseNilableBool(securityProfile.EnableTPM)
+						if securityProfile.SecurityType != nil {
+							state.SecurityType = string(*securityProfile.SecurityType)
+						}
+						if securityProfile.UefiSettings != nil {
+							state.SecureBootEnabled = utils.NormaliseNilableBool(securityProfile.UefiSettings.SecureBootEnabled)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIVirtualMachineResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := virtualmachineinstances.VirtualMachineInstanceUpdateProperties{}
+			payload := virtualmachineinstances.VirtualMachineInstanceUpdateRequest{
+				Properties: &props,
+			}
+
+			if metadata.ResourceData.HasChange("hardware_profile") {
+				hardwareProfile := model.HardwareProfile[0]
+				vmSize := virtualmachineinstances.VMSizeEnum(hardwareProfile.VmSize)
+				props.HardwareProfile = &virtualmachineinstances.HardwareProfileUpdate{
+					VMSize: &vmSize,
+				}
+				if hardwareProfile.ProcessorCount != 0 {
+					props.HardwareProfile.Processors = utils.Int64(hardwareProfile.ProcessorCount)
+				}
+				if hardwareProfile.MemoryInMb != 0 {
+					props.HardwareProfile.MemoryMB = utils.Int64(hardwareProfile.MemoryInMb)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("network_interface_ids") {
+				props.NetworkProfile = &virtualmachineinstances.NetworkProfileUpdate{
+					NetworkInterfaces: expandStackHCIVirtualMachineNetworkInterfaces(model.NetworkInterfaceIds),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("storage_profile.0.data_disk_ids") {
+				props.StorageProfile = &virtualmachineinstances.StorageProfileUpdate{
+					DataDisks: expandStackHCIVirtualMachineDataDisks(model.StorageProfile[0].DataDiskIds),
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstancesClient
+
+			id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineHardwareProfile(input []StackHCIVirtualMachineHardwareProfile) *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	vmSize := virtualmachineinstances.VMSizeEnum(v.VmSize)
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile{
+		VMSize: &vmSize,
+	}
+	if v.ProcessorCount != 0 {
+		output.Processors = utils.Int64(v.ProcessorCount)
+	}
+	if v.MemoryInMb != 0 {
+		output.MemoryMB = utils.Int64(v.MemoryInMb)
+	}
+	if len(v.DynamicMemory) > 0 {
+		output.DynamicMemoryConfig = &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfileDynamicMemoryConfig{
+			MaximumMemoryMB:    utils.Int64(v.DynamicMemory[0].MaximumMemoryInMb),
+			MinimumMemoryMB:    utils.Int64(v.DynamicMemory[0].MinimumMemoryInMb),
+			TargetMemoryBuffer: utils.Int64(v.DynamicMemory[0].TargetMemoryBufferPercentage),
+		}
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineHardwareProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile) []StackHCIVirtualMachineHardwareProfile {
+	if input == nil {
+		return make([]StackHCIVirtualMachineHardwareProfile, 0)
+	}
+
+	output := StackHCIVirtualMachineHardwareProfile{
+		DynamicMemory: make([]StackHCIVirtualMachineDynamicMemory, 0),
+	}
+	if input.VMSize != nil {
+		output.VmSize = string(*input.VMSize)
+	}
+	if input.Processors != nil {
+		output.ProcessorCount = *input.Processors
+	}
+	if input.MemoryMB != nil {
+		output.MemoryInMb = *input.MemoryMB
+	}
+	if v := input.DynamicMemoryConfig; v != nil && v.MaximumMemoryMB != nil {
+		dynamicMemory := StackHCIVirtualMachineDynamicMemory{
+			MaximumMemoryInMb: *v.MaximumMemoryMB,
+		}
+		if v.MinimumMemoryMB != nil {
+			dynamicMemory.MinimumMemoryInMb = *v.MinimumMemoryMB
+		}
+		if v.TargetMemoryBuffer != nil {
+			dynamicMemory.TargetMemoryBufferPercentage = *v.TargetMemoryBuffer
+		}
+		output.DynamicMemory = []StackHCIVirtualMachineDynamicMemory{dynamicMemory}
+	}
+
+	return []StackHCIVirtualMachineHardwareProfile{output}
+}
+
+func expandStackHCIVirtualMachineNetworkInterfaces(input []string) *[]virtualmachineinstances.NetworkInterfaceArmReference {
+	output := make([]virtualmachineinstances.NetworkInterfaceArmReference, 0)
+	for _, v := range input {
+		output = append(output, virtualmachineinstances.NetworkInterfaceArmReference{
+			Id: utils.String(v),
+		})
+	}
+	return &output
+}
+
+func expandStackHCIVirtualMachineDataDisks(input []string) *[]virtualmachineinstances.VirtualHardDiskArmReference {
+	output := make([]virtualmachineinstances.VirtualHardDiskArmReference, 0)
+	for _, v := range input {
+		output = append(output, virtualmachineinstances.VirtualHardDiskArmReference{
+			Id: utils.String(v),
+		})
+	}
+	return &output
+}
+
+func expandStackHCIVirtualMachineOsProfile(input []StackHCIVirtualMachineOsProfile) *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile{
+		AdminUsername: utils.String(v.AdminUsername),
+		ComputerName:  utils.String(v.ComputerName),
+	}
+	if v.AdminPassword != "" {
+		output.AdminPassword = utils.String(v.AdminPassword)
+	}
+
+	if len(v.LinuxConfiguration) > 0 {
+		linux := v.LinuxConfiguration[0]
+		output.LinuxConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileLinuxConfiguration{
+			DisablePasswordAuthentication: utils.Bool(!linux.PasswordAuthenticationEnabled),
+			ProvisionVMAgent:              utils.Bool(v.VmAgentEnabled),
+			ProvisionVMConfigAgent:        utils.Bool(v.VmAgentEnabled),
+		}
+		if len(linux.SshPublicKey) > 0 {
+			publicKeys := make([]virtualmachineinstances.SshPublicKey, 0)
+			for _, key := range linux.SshPublicKey {
+				publicKeys = append(publicKeys, virtualmachineinstances.SshPublicKey{
+					KeyData: utils.String(key.KeyData),
+					Path:    utils.String(key.Path),
+				})
+			}
+			output.LinuxConfiguration.Ssh = &virtualmachineinstances.SshConfiguration{
+				PublicKeys: &publicKeys,
+			}
+		}
+	}
+
+	if len(v.WindowsConfiguration) > 0 {
+		windows := v.WindowsConfiguration[0]
+		output.WindowsConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileWindowsConfiguration{
+			EnableAutomaticUpdates: utils.Bool(windows.AutomaticUpdatesEnabled),
+			ProvisionVMAgent:       utils.Bool(v.VmAgentEnabled),
+			ProvisionVMConfigAgent: utils.Bool(v.VmAgentEnabled),
+		}
+		if windows.TimeZone != "" {
+			output.WindowsConfiguration.TimeZone = utils.String(windows.TimeZone)
+		}
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineOsProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile, config []StackHCIVirtualMachineOsProfile) []StackHCIVirtualMachineOsProfile {
+	if input == nil {
+		return make([]StackHCIVirtualMachineOsProfile, 0)
+	}
+
+	output := StackHCIVirtualMachineOsProfile{
+		AdminUsername:        utils.NormalizeNilableString(input.AdminUsername),
+		ComputerName:         utils.NormalizeNilableString(input.ComputerName),
+		LinuxConfiguration:   make([]StackHCIVirtualMachineLinuxConfiguration, 0),
+		WindowsConfiguration: make([]StackHCIVirtualMachineWindowsConfiguration, 0),
+	}
+	if len(config) > 0 {
+		output.AdminPassword = config[0].AdminPassword
+	}
+
+	if linux := input.LinuxConfiguration; linux != nil {
+		sshPublicKeys := make([]StackHCIVirtualMachineSshPublicKey, 0)
+		if linux.Ssh != nil && linux.Ssh.PublicKeys != nil {
+			for _, key := range *linux.Ssh.PublicKeys {
+				sshPublicKeys = append(sshPublicKeys, StackHCIVirtualMachineSshPublicKey{
+					KeyData: utils.NormalizeNilableString(key.KeyData),
+					Path:    utils.NormalizeNilableString(key.Path),
+				})
+			}
+		}
+
+		output.LinuxConfiguration = []StackHCIVirtualMachineLinuxConfiguration{
+			{
+				PasswordAuthenticationEnabled: !utils.NormaliseNilableBool(linux.DisablePasswordAuthentication),
+				SshPublicKey:                  sshPublicKeys,
+			},
+		}
+		output.VmAgentEnabled = utils.NormaliseNilableBool(linux.ProvisionVMAgent)
+	}
+
+	if windows := input.WindowsConfiguration; windows != nil {
+		output.WindowsConfiguration = []StackHCIVirtualMachineWindowsConfiguration{
+			{
+				AutomaticUpdatesEnabled: utils.NormaliseNilableBool(windows.EnableAutomaticUpdates),
+				TimeZone:                utils.NormalizeNilableString(windows.TimeZone),
+			},
+		}
+		output.VmAgentEnabled = utils.NormaliseNilableBool(windows.ProvisionVMAgent)
+	}
+
+	return []StackHCIVirtualMachineOsProfile{output}
+}
+
+func expandStackHCIVirtualMachineSecurityProfile(input StackHCIVirtualMachineResourceModel) *virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile {
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile{
+		EnableTPM: utils.Bool(input.TpmEnabled),
+		UefiSettings: &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfileUefiSettings{
+			SecureBootEnabled: utils.Bool(input.SecureBootEnabled),
+		},
+	}
+	if input.SecurityType != "" {
+		securityType := virtualmachineinstances.SecurityTypes(input.SecurityType)
+		output.SecurityType = &securityType
+	}
+
+	return &output
+}
+
+func expandStackHCIVirtualMachineStorageProfile(input []StackHCIVirtualMachineStorageProfile) *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile{
+		DataDisks: expandStackHCIVirtualMachineDataDisks(v.DataDiskIds),
+		ImageReference: &virtualmachineinstances.ImageArmReference{
+			Id: utils.String(v.ImageId),
+		},
+	}
+	if v.VmConfigStoragePathId != "" {
+		output.VMConfigStoragePathId = utils.String(v.VmConfigStoragePathId)
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineStorageProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile) []StackHCIVirtualMachineStorageProfile {
+	if input == nil {
+		return make([]StackHCIVirtualMachineStorageProfile, 0)
+	}
+
+	output := StackHCIVirtualMachineStorageProfile{
+		DataDiskIds:           make([]string, 0),
+		VmConfigStoragePathId: utils.NormalizeNilableString(input.VMConfigStoragePathId),
+	}
+	if input.ImageReference != nil {
+		output.ImageId = utils.NormalizeNilableString(input.ImageReference.Id)
+	}
+	if input.DataDisks != nil {
+		for _, v := range *input.DataDisks {
+			if v.Id != nil {
+				output.DataDiskIds = append(output.DataDiskIds, *v.Id)
+			}
+		}
+	}
+
+	return []StackHCIVirtualMachineStorageProfile{output}
+}
@@ -0,0 +1,219 @@
+package azurestackhci_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/sdk/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StackHCIVirtualMachineResource struct {
+	customLocationId  string
+	virtualSwitchName string
+	imageId           string
+}
+
+// in addition to the Custom Location, an image needs to be available on the cluster - since downloading this
+// as a part of each test takes a considerable amount of time, an existing (Marketplace) Gallery Image is used
+func newStackHCIVirtualMachineResource(t *testing.T) StackHCIVirtualMachineResource {
+	r := StackHCIVirtualMachineResource{
+		customLocationId:  os.Getenv("ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID"),
+		virtualSwitchName: os.Getenv("ARM_TEST_STACK_HCI_VIRTUAL_SWITCH_NAME"),
+		imageId:           os.Getenv("ARM_TEST_STACK_HCI_IMAGE_ID"),
+	}
+	if r.customLocationId == "" || r.virtualSwitchName == "" || r.imageId == "" {
+		t.Skip("Skipping as ARM_TEST_STACK_HCI_CUSTOM_LOCATION_ID, ARM_TEST_STACK_HCI_VIRTUAL_SWITCH_NAME and/or ARM_TEST_STACK_HCI_IMAGE_ID are not specified")
+	}
+
+	return r
+}
+
+func TestAccStackHCIVirtualMachine_basic(t *testing.T) {
+	r := newStackHCIVirtualMachineResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, 4096),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func TestAccStackHCIVirtualMachine_requiresImport(t *testing.T) {
+	r := newStackHCIVirtualMachineResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, 4096),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStackHCIVirtualMachine_update(t *testing.T) {
+	r := newStackHCIVirtualMachineResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_virtual_machine", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, 4096),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+		{
+			Config: r.basic(data, 4, 8192),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("os_profile.0.admin_password"),
+	})
+}
+
+func (StackHCIVirtualMachineResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := virtualmachineinstances.ParseVirtualMachineInstanceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.AzureStackHCI.VirtualMachineInstancesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StackHCIVirtualMachineResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hci-vm-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_arc_machine" "test" {
+  name                = "acctest-vm-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  kind                = "HCI"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_stack_hci_logical_network" "test" {
+  name                = "acctest-ln-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%[3]s"
+  virtual_switch_name = "%[4]s"
+
+  subnet {
+    ip_allocation_method = "Dynamic"
+  }
+}
+
+resource "azurerm_stack_hci_network_interface" "test" {
+  name                = "acctest-nic-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  custom_location_id  = "%[3]s"
+
+  ip_configuration {
+    subnet_id = azurerm_stack_hci_logical_network.test.id
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, r.customLocationId, r.virtualSwitchName)
+}
+
+func (r StackHCIVirtualMachineResource) basic(data acceptance.TestData, processorCount, memoryInMb int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_stack_hci_virtual_machine" "test" {
+  arc_machine_id        = azurerm_arc_machine.test.id
+  custom_location_id    = "%[2]s"
+  network_interface_ids = [azurerm_stack_hci_network_interface.test.id]
+
+  hardware_profile {
+    vm_size         = "Custom"
+    processor_count = %[3]d
+    memory_in_mb    = %[4]d
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "P@ssw0rd1234!"
+    computer_name  = "acctest%[5]s"
+
+    windows_configuration {
+      automatic_updates_enabled = true
+      time_zone                 = "UTC"
+    }
+  }
+
+  storage_profile {
+    image_id = "%[6]s"
+  }
+}
+`, r.template(data), r.customLocationId, processorCount, memoryInMb, data.RandomString, r.imageId)
+}
+
+func (r StackHCIVirtualMachineResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stack_hci_virtual_machine" "import" {
+  arc_machine_id        = azurerm_stack_hci_virtual_machine.test.arc_machine_id
+  custom_location_id    = azurerm_stack_hci_virtual_machine.test.custom_location_id
+  network_interface_ids = azurerm_stack_hci_virtual_machine.test.network_interface_ids
+
+  hardware_profile {
+    vm_size         = "Custom"
+    processor_count = 2
+    memory_in_mb    = 4096
+  }
+
+  os_profile {
+    admin_username = "adminuser"
+    admin_password = "P@ssw0rd1234!"
+    computer_name  = "acctest%s"
+
+    windows_configuration {
+      automatic_updates_enabled = true
+      time_zone                 = "UTC"
+    }
+  }
+
+  storage_profile {
+    image_id = "%s"
+  }
+}
+`, r.basic(data, 2, 4096), data.RandomString, r.imageId)
+}
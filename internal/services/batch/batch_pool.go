@@ -159,6 +159,9 @@ func flattenBatchPoolStartTask(startTask *batch.StartTask) []interface{} {
 			if armResourceFile.FileMode != nil {
 				resourceFile["file_mode"] = *armResourceFile.FileMode
 			}
+			if armResourceFile.IdentityReference != nil && armResourceFile.IdentityReference.ResourceID != nil {
+				resourceFile["user_assigned_identity_id"] = *armResourceFile.IdentityReference.ResourceID
+			}
 			resourceFiles = append(resourceFiles, resourceFile)
 		}
 	}
@@ -253,15 +256,16 @@ func flattenBatchPoolContainerRegistry(d *pluginsdk.ResourceData, armContainerRe
 	if registryServer := armContainerRegistry.RegistryServer; registryServer != nil {
 		result["registry_server"] = *registryServer
 	}
-	if userName := armContainerRegistry.UserName; userName != nil {
-		result["user_name"] = *userName
+	if identityReference := armContainerRegistry.IdentityReference; identityReference != nil && identityReference.ResourceID != nil {
+		result["user_assigned_identity_id"] = *identityReference.ResourceID
 	}
 
 	// If we didn't specify a registry server and user name, just return what we have now rather than trying to locate the password
-	if len(result) != 2 {
+	if armContainerRegistry.RegistryServer == nil || armContainerRegistry.UserName == nil {
 		return result
 	}
 
+	result["user_name"] = *armContainerRegistry.UserName
 	result["password"] = findBatchPoolContainerRegistryPassword(d, result["registry_server"].(string), result["user_name"].(string))
 
 	return result
@@ -368,9 +372,27 @@ func expandBatchPoolContainerRegistry(ref map[string]interface{}) (*batch.Contai
 
 	containerRegistry := batch.ContainerRegistry{
 		RegistryServer: utils.String(ref["registry_server"].(string)),
-		UserName:       utils.String(ref["user_name"].(string)),
-		Password:       utils.String(ref["password"].(string)),
 	}
+
+	userName := ref["user_name"].(string)
+	password := ref["password"].(string)
+	identityId := ref["user_assigned_identity_id"].(string)
+	if identityId != "" {
+		if userName != "" || password != "" {
+			return nil, fmt.Errorf("`user_name` and `password` cannot be specified when using `user_assigned_identity_id` for container registry %q", *containerRegistry.RegistryServer)
+		}
+		containerRegistry.IdentityReference = &batch.ComputeNodeIdentityReference{
+			ResourceID: utils.String(identityId),
+		}
+		return &containerRegistry, nil
+	}
+
+	if userName == "" || password == "" {
+		return nil, fmt.Errorf("either `user_name` and `password` or `user_assigned_identity_id` must be specified for container registry %q", *containerRegistry.RegistryServer)
+	}
+	containerRegistry.UserName = utils.String(userName)
+	containerRegistry.Password = utils.String(password)
+
 	return &containerRegistry, nil
 }
 
@@ -504,6 +526,14 @@ func ExpandBatchPoolStartTask(list []interface{}) (*batch.StartTask, error) {
 				resourceFile.FileMode = &fileMode
 			}
 		}
+		if v, ok := resourceFileValue["user_assigned_identity_id"]; ok {
+			identityId := v.(string)
+			if identityId != "" {
+				resourceFile.IdentityReference = &batch.ComputeNodeIdentityReference{
+					ResourceID: &identityId,
+				}
+			}
+		}
 		resourceFiles = append(resourceFiles, resourceFile)
 	}
 
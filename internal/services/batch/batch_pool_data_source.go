@@ -153,6 +153,10 @@ func dataSourceBatchPool() *pluginsdk.Resource {
 										Computed:  true,
 										Sensitive: true,
 									},
+									"user_assigned_identity_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
 								},
 							},
 						},
@@ -289,6 +293,10 @@ func dataSourceBatchPool() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Computed: true,
 									},
+									"user_assigned_identity_id": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
 								},
 							},
 						},
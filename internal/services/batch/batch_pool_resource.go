@@ -15,8 +15,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/identity"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/sdk/2024-02-01/pool"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/validate"
 	msiparse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
+	msivalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -163,17 +165,23 @@ func resourceBatchPool() *pluginsdk.Resource {
 									},
 									"user_name": {
 										Type:         pluginsdk.TypeString,
-										Required:     true,
+										Optional:     true,
 										ForceNew:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
 									"password": {
 										Type:         pluginsdk.TypeString,
-										Required:     true,
+										Optional:     true,
 										ForceNew:     true,
 										Sensitive:    true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
+									"user_assigned_identity_id": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: msivalidate.UserAssignedIdentityID,
+									},
 								},
 							},
 							AtLeastOneOf: []string{"container_configuration.0.type", "container_configuration.0.container_image_names", "container_configuration.0.container_registries"},
@@ -242,8 +250,9 @@ func resourceBatchPool() *pluginsdk.Resource {
 				Default:  false,
 			},
 			"certificate": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
+				Type:       pluginsdk.TypeList,
+				Optional:   true,
+				Deprecated: "Batch Certificates have been retired - Key Vault secrets should be accessed from the pool nodes using a User Assigned Identity specified in the `identity` block instead",
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"id": {
@@ -287,6 +296,66 @@ func resourceBatchPool() *pluginsdk.Resource {
 
 			"identity": batchPoolIdentity{}.Schema(),
 
+			"target_node_communication_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(pool.PossibleValuesForNodeCommunicationMode(), false),
+			},
+
+			"current_node_communication_mode": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"upgrade_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(pool.PossibleValuesForUpgradeMode(), false),
+						},
+
+						"automatic_os_upgrade_policy": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"automatic_os_upgrade_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"automatic_rollback_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+
+									"os_rolling_upgrade_deferral_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+
+									"rolling_upgrade_policy_enabled": {
+										Type:     pluginsdk.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"start_task": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -425,6 +494,11 @@ func resourceBatchPool() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Optional: true,
 									},
+									"user_assigned_identity_id": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: msivalidate.UserAssignedIdentityID,
+									},
 								},
 							},
 						},
@@ -687,6 +761,15 @@ func resourceBatchPoolCreate(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	// the node communication mode and upgrade policy are only available in a newer API version, so are patched separately
+	_, hasCommunicationMode := d.GetOk("target_node_communication_mode")
+	_, hasUpgradePolicy := d.GetOk("upgrade_policy")
+	if hasCommunicationMode || hasUpgradePolicy {
+		if err := updateBatchPoolNodeSettings(ctx, d, meta, id); err != nil {
+			return err
+		}
+	}
+
 	return resourceBatchPoolRead(d, meta)
 }
 
@@ -786,6 +869,12 @@ func resourceBatchPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChanges("target_node_communication_mode", "upgrade_policy") {
+		if err := updateBatchPoolNodeSettings(ctx, d, meta, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceBatchPoolRead(d, meta)
 }
 
@@ -819,6 +908,29 @@ func resourceBatchPoolRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
+	settings, err := meta.(*clients.Client).Batch.PoolSettingsClient.Get(ctx, pool.NewPoolID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving node settings for %s: %+v", *id, err)
+	}
+
+	targetNodeCommunicationMode := ""
+	currentNodeCommunicationMode := ""
+	var upgradePolicy *pool.UpgradePolicy
+	if model := settings.Model; model != nil && model.Properties != nil {
+		if v := model.Properties.TargetNodeCommunicationMode; v != nil {
+			targetNodeCommunicationMode = string(*v)
+		}
+		if v := model.Properties.CurrentNodeCommunicationMode; v != nil {
+			currentNodeCommunicationMode = string(*v)
+		}
+		upgradePolicy = model.Properties.UpgradePolicy
+	}
+	d.Set("target_node_communication_mode", targetNodeCommunicationMode)
+	d.Set("current_node_communication_mode", currentNodeCommunicationMode)
+	if err := d.Set("upgrade_policy", flattenBatchPoolUpgradePolicy(upgradePolicy)); err != nil {
+		return fmt.Errorf("setting `upgrade_policy`: %+v", err)
+	}
+
 	if props := resp.PoolProperties; props != nil {
 		d.Set("display_name", props.DisplayName)
 		d.Set("vm_size", props.VMSize)
@@ -1056,3 +1168,70 @@ func flattenBatchPoolIdentity(input *batch.PoolIdentity) ([]interface{}, error)
 	}
 	return batchPoolIdentity{}.Flatten(config), nil
 }
+
+func updateBatchPoolNodeSettings(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.PoolId) error {
+	client := meta.(*clients.Client).Batch.PoolSettingsClient
+
+	payload := pool.Pool{
+		Properties: &pool.PoolProperties{
+			UpgradePolicy: expandBatchPoolUpgradePolicy(d.Get("upgrade_policy").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("target_node_communication_mode"); ok {
+		mode := pool.NodeCommunicationMode(v.(string))
+		payload.Properties.TargetNodeCommunicationMode = &mode
+	}
+
+	if _, err := client.Update(ctx, pool.NewPoolID(id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name), payload); err != nil {
+		return fmt.Errorf("updating node settings for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandBatchPoolUpgradePolicy(input []interface{}) *pool.UpgradePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	output := &pool.UpgradePolicy{
+		Mode: pool.UpgradeMode(raw["mode"].(string)),
+	}
+
+	if v := raw["automatic_os_upgrade_policy"].([]interface{}); len(v) > 0 && v[0] != nil {
+		policy := v[0].(map[string]interface{})
+		output.AutomaticOSUpgradePolicy = &pool.AutomaticOSUpgradePolicy{
+			DisableAutomaticRollback: utils.Bool(!policy["automatic_rollback_enabled"].(bool)),
+			EnableAutomaticOSUpgrade: utils.Bool(policy["automatic_os_upgrade_enabled"].(bool)),
+			OsRollingUpgradeDeferral: utils.Bool(policy["os_rolling_upgrade_deferral_enabled"].(bool)),
+			UseRollingUpgradePolicy:  utils.Bool(policy["rolling_upgrade_policy_enabled"].(bool)),
+		}
+	}
+
+	return output
+}
+
+func flattenBatchPoolUpgradePolicy(input *pool.UpgradePolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	automaticOSUpgradePolicy := make([]interface{}, 0)
+	if policy := input.AutomaticOSUpgradePolicy; policy != nil {
+		automaticOSUpgradePolicy = append(automaticOSUpgradePolicy, map[string]interface{}{
+			"automatic_os_upgrade_enabled":        policy.EnableAutomaticOSUpgrade != nil && *policy.EnableAutomaticOSUpgrade,
+			"automatic_rollback_enabled":          policy.DisableAutomaticRollback == nil || !*policy.DisableAutomaticRollback,
+			"os_rolling_upgrade_deferral_enabled": policy.OsRollingUpgradeDeferral != nil && *policy.OsRollingUpgradeDeferral,
+			"rolling_upgrade_policy_enabled":      policy.UseRollingUpgradePolicy != nil && *policy.UseRollingUpgradePolicy,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                        string(input.Mode),
+			"automatic_os_upgrade_policy": automaticOSUpgradePolicy,
+		},
+	}
+}
@@ -9,13 +9,15 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/sdk/2024-02-01/pool"
 )
 
 type Client struct {
-	AccountClient     *batch.AccountClient
-	ApplicationClient *batch.ApplicationClient
-	CertificateClient *batch.CertificateClient
-	PoolClient        *batch.PoolClient
+	AccountClient      *batch.AccountClient
+	ApplicationClient  *batch.ApplicationClient
+	CertificateClient  *batch.CertificateClient
+	PoolClient         *batch.PoolClient
+	PoolSettingsClient *pool.PoolClient
 
 	BatchManagementAuthorizer autorest.Authorizer
 }
@@ -33,11 +35,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	poolClient := batch.NewPoolClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&poolClient.Client, o.ResourceManagerAuthorizer)
 
+	poolSettingsClient := pool.NewPoolClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&poolSettingsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AccountClient:             &accountClient,
 		ApplicationClient:         &applicationClient,
 		CertificateClient:         &certificateClient,
 		PoolClient:                &poolClient,
+		PoolSettingsClient:        &poolSettingsClient,
 		BatchManagementAuthorizer: o.BatchManagementAuthorizer,
 	}
 }
@@ -0,0 +1,15 @@
+package pool
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PoolClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPoolClientWithBaseURI(endpoint string) PoolClient {
+	return PoolClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,65 @@
+package pool
+
+import "strings"
+
+type NodeCommunicationMode string
+
+const (
+	NodeCommunicationModeClassic    NodeCommunicationMode = "Classic"
+	NodeCommunicationModeDefault    NodeCommunicationMode = "Default"
+	NodeCommunicationModeSimplified NodeCommunicationMode = "Simplified"
+)
+
+func PossibleValuesForNodeCommunicationMode() []string {
+	return []string{
+		string(NodeCommunicationModeClassic),
+		string(NodeCommunicationModeDefault),
+		string(NodeCommunicationModeSimplified),
+	}
+}
+
+func parseNodeCommunicationMode(input string) (*NodeCommunicationMode, error) {
+	vals := map[string]NodeCommunicationMode{
+		"classic":    NodeCommunicationModeClassic,
+		"default":    NodeCommunicationModeDefault,
+		"simplified": NodeCommunicationModeSimplified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NodeCommunicationMode(input)
+	return &out, nil
+}
+
+type UpgradeMode string
+
+const (
+	UpgradeModeAutomatic UpgradeMode = "automatic"
+	UpgradeModeManual    UpgradeMode = "manual"
+	UpgradeModeRolling   UpgradeMode = "rolling"
+)
+
+func PossibleValuesForUpgradeMode() []string {
+	return []string{
+		string(UpgradeModeAutomatic),
+		string(UpgradeModeManual),
+		string(UpgradeModeRolling),
+	}
+}
+
+func parseUpgradeMode(input string) (*UpgradeMode, error) {
+	vals := map[string]UpgradeMode{
+		"automatic": UpgradeModeAutomatic,
+		"manual":    UpgradeModeManual,
+		"rolling":   UpgradeModeRolling,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := UpgradeMode(input)
+	return &out, nil
+}
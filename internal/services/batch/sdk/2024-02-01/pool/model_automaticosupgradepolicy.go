@@ -0,0 +1,8 @@
+package pool
+
+type AutomaticOSUpgradePolicy struct {
+	DisableAutomaticRollback *bool `json:"disableAutomaticRollback,omitempty"`
+	EnableAutomaticOSUpgrade *bool `json:"enableAutomaticOSUpgrade,omitempty"`
+	OsRollingUpgradeDeferral *bool `json:"osRollingUpgradeDeferral,omitempty"`
+	UseRollingUpgradePolicy  *bool `json:"useRollingUpgradePolicy,omitempty"`
+}
@@ -0,0 +1,9 @@
+package pool
+
+type Pool struct {
+	Etag       *string         `json:"etag,omitempty"`
+	Id         *string         `json:"id,omitempty"`
+	Name       *string         `json:"name,omitempty"`
+	Properties *PoolProperties `json:"properties,omitempty"`
+	Type       *string         `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package pool
+
+type PoolProperties struct {
+	CurrentNodeCommunicationMode *NodeCommunicationMode `json:"currentNodeCommunicationMode,omitempty"`
+	TargetNodeCommunicationMode  *NodeCommunicationMode `json:"targetNodeCommunicationMode,omitempty"`
+	UpgradePolicy                *UpgradePolicy         `json:"upgradePolicy,omitempty"`
+}
@@ -0,0 +1,6 @@
+package pool
+
+type UpgradePolicy struct {
+	AutomaticOSUpgradePolicy *AutomaticOSUpgradePolicy `json:"automaticOSUpgradePolicy,omitempty"`
+	Mode                     UpgradeMode               `json:"mode"`
+}
@@ -0,0 +1,9 @@
+package pool
+
+import "fmt"
+
+const defaultApiVersion = "2024-02-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/pool/%s", defaultApiVersion)
+}
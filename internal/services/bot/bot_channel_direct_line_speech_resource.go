@@ -5,7 +5,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/botservice/mgmt/2021-03-01/botservice"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -13,7 +12,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/channel"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/validate"
+	cognitiveValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -60,6 +61,12 @@ func resourceBotChannelDirectLineSpeech() *pluginsdk.Resource {
 
 			"cognitive_service_location": location.SchemaWithoutForceNew(),
 
+			"cognitive_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: cognitiveValidate.AccountID,
+			},
+
 			"custom_speech_model_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -76,49 +83,26 @@ func resourceBotChannelDirectLineSpeech() *pluginsdk.Resource {
 }
 
 func resourceBotChannelDirectLineSpeechCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewBotChannelID(subscriptionId, d.Get("resource_group_name").(string), d.Get("bot_name").(string), string(botservice.ChannelNameDirectLineSpeechChannel))
+	id := parse.NewBotChannelID(subscriptionId, d.Get("resource_group_name").(string), d.Get("bot_name").(string), string(channel.ChannelNameDirectLineSpeechChannel))
+	channelId := channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, id.ChannelName)
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.BotServiceName, id.ChannelName)
+		existing, err := client.Get(ctx, channelId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of %s: %+v", id, err)
 			}
 		}
-		if existing.ID != nil && *existing.ID != "" {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_bot_channel_direct_line_speech", id.ID())
 		}
 	}
 
-	channel := botservice.BotChannel{
-		Properties: botservice.DirectLineSpeechChannel{
-			Properties: &botservice.DirectLineSpeechChannelProperties{
-				CognitiveServiceRegion:          utils.String(d.Get("cognitive_service_location").(string)),
-				CognitiveServiceSubscriptionKey: utils.String(d.Get("cognitive_service_access_key").(string)),
-				IsDefaultBotForCogSvcAccount:    utils.Bool(false),
-				IsEnabled:                       utils.Bool(true),
-			},
-			ChannelName: botservice.ChannelNameBasicChannelChannelNameDirectLineSpeechChannel,
-		},
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Kind:     botservice.KindBot,
-	}
-
-	if v, ok := d.GetOk("custom_speech_model_id"); ok {
-		channel, _ := channel.Properties.AsDirectLineSpeechChannel()
-		channel.Properties.CustomSpeechModelID = utils.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("custom_voice_deployment_id"); ok {
-		channel, _ := channel.Properties.AsDirectLineSpeechChannel()
-		channel.Properties.CustomVoiceDeploymentID = utils.String(v.(string))
-	}
-
-	if _, err := client.Create(ctx, id.ResourceGroup, id.BotServiceName, botservice.ChannelNameDirectLineSpeechChannel, channel); err != nil {
+	if _, err := client.Create(ctx, channelId, expandBotChannelDirectLineSpeech(d)); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -127,7 +111,7 @@ func resourceBotChannelDirectLineSpeechCreate(d *pluginsdk.ResourceData, meta in
 }
 
 func resourceBotChannelDirectLineSpeechRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -136,9 +120,9 @@ func resourceBotChannelDirectLineSpeechRead(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.BotServiceName, string(botservice.ChannelNameDirectLineSpeechChannel))
+	resp, err := client.Get(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameDirectLineSpeechChannel)))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] %s was not found - removing from state", id)
 			d.SetId("")
 			return nil
@@ -149,13 +133,15 @@ func resourceBotChannelDirectLineSpeechRead(d *pluginsdk.ResourceData, meta inte
 
 	d.Set("bot_name", id.BotServiceName)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if props := resp.Properties; props != nil {
-		if channel, ok := props.AsDirectLineSpeechChannel(); ok {
-			if channelProps := channel.Properties; channelProps != nil {
-				d.Set("custom_speech_model_id", channelProps.CustomSpeechModelID)
-				d.Set("custom_voice_deployment_id", channelProps.CustomVoiceDeploymentID)
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if props, ok := model.Properties.(channel.DirectLineSpeechChannel); ok {
+			if channelProps := props.Properties; channelProps != nil {
+				d.Set("cognitive_account_id", channelProps.CognitiveServiceResourceId)
+				d.Set("custom_speech_model_id", channelProps.CustomSpeechModelId)
+				d.Set("custom_voice_deployment_id", channelProps.CustomVoiceDeploymentId)
 			}
 		}
 	}
@@ -164,7 +150,7 @@ func resourceBotChannelDirectLineSpeechRead(d *pluginsdk.ResourceData, meta inte
 }
 
 func resourceBotChannelDirectLineSpeechUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -173,31 +159,7 @@ func resourceBotChannelDirectLineSpeechUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	channel := botservice.BotChannel{
-		Properties: botservice.DirectLineSpeechChannel{
-			Properties: &botservice.DirectLineSpeechChannelProperties{
-				CognitiveServiceRegion:          utils.String(d.Get("cognitive_service_location").(string)),
-				CognitiveServiceSubscriptionKey: utils.String(d.Get("cognitive_service_access_key").(string)),
-				IsDefaultBotForCogSvcAccount:    utils.Bool(false),
-				IsEnabled:                       utils.Bool(true),
-			},
-			ChannelName: botservice.ChannelNameBasicChannelChannelNameDirectLineSpeechChannel,
-		},
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Kind:     botservice.KindBot,
-	}
-
-	if v, ok := d.GetOk("custom_speech_model_id"); ok {
-		channel, _ := channel.Properties.AsDirectLineSpeechChannel()
-		channel.Properties.CustomSpeechModelID = utils.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("custom_voice_deployment_id"); ok {
-		channel, _ := channel.Properties.AsDirectLineSpeechChannel()
-		channel.Properties.CustomVoiceDeploymentID = utils.String(v.(string))
-	}
-
-	if _, err := client.Update(ctx, id.ResourceGroup, id.BotServiceName, botservice.ChannelNameDirectLineSpeechChannel, channel); err != nil {
+	if _, err := client.Update(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameDirectLineSpeechChannel)), expandBotChannelDirectLineSpeech(d)); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
@@ -205,7 +167,7 @@ func resourceBotChannelDirectLineSpeechUpdate(d *pluginsdk.ResourceData, meta in
 }
 
 func resourceBotChannelDirectLineSpeechDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -214,12 +176,42 @@ func resourceBotChannelDirectLineSpeechDelete(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.BotServiceName, string(botservice.ChannelNameDirectLineSpeechChannel))
+	resp, err := client.Delete(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameDirectLineSpeechChannel)))
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting %s: %+v", id, err)
 		}
 	}
 
 	return nil
 }
+
+func expandBotChannelDirectLineSpeech(d *pluginsdk.ResourceData) channel.BotChannel {
+	props := &channel.DirectLineSpeechChannelProperties{
+		CognitiveServiceRegion:          utils.String(d.Get("cognitive_service_location").(string)),
+		CognitiveServiceSubscriptionKey: utils.String(d.Get("cognitive_service_access_key").(string)),
+		IsDefaultBotForCogSvcAccount:    utils.Bool(false),
+		IsEnabled:                       utils.Bool(true),
+	}
+
+	if v, ok := d.GetOk("cognitive_account_id"); ok {
+		props.CognitiveServiceResourceId = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_speech_model_id"); ok {
+		props.CustomSpeechModelId = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("custom_voice_deployment_id"); ok {
+		props.CustomVoiceDeploymentId = utils.String(v.(string))
+	}
+
+	kind := channel.KindBot
+	return channel.BotChannel{
+		Properties: channel.DirectLineSpeechChannel{
+			Properties: props,
+		},
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Kind:     &kind,
+	}
+}
@@ -152,6 +152,7 @@ resource "azurerm_bot_channel_direct_line_speech" "test" {
   resource_group_name          = azurerm_resource_group.test.name
   cognitive_service_location   = azurerm_cognitive_account.test.location
   cognitive_service_access_key = azurerm_cognitive_account.test.primary_access_key
+  cognitive_account_id         = azurerm_cognitive_account.test.id
   custom_speech_model_id       = "0830f48d-f592-4709-b408-d723c0973fb1"
   custom_voice_deployment_id   = "4fc2752c-7e8e-4852-85a9-0f28fffa3edd"
 }
@@ -189,6 +190,7 @@ resource "azurerm_bot_channel_direct_line_speech" "test" {
   resource_group_name          = azurerm_resource_group.test.name
   cognitive_service_location   = azurerm_cognitive_account.test2.location
   cognitive_service_access_key = azurerm_cognitive_account.test2.primary_access_key
+  cognitive_account_id         = azurerm_cognitive_account.test2.id
   custom_speech_model_id       = "4560f48d-f592-4709-b408-d723c0973fb1"
   custom_voice_deployment_id   = "8up2752c-7e8e-4852-85a9-0f28fffa3edd"
 }
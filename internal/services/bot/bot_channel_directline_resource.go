@@ -5,13 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/botservice/mgmt/2021-03-01/botservice"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/channel"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -84,6 +84,24 @@ func resourceBotChannelDirectline() *pluginsdk.Resource {
 							Optional: true,
 						},
 
+						"endpoint_parameters_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"storage_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"user_upload_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
 						"trusted_origins": {
 							Type:     pluginsdk.TypeSet,
 							Optional: true,
@@ -112,27 +130,41 @@ func resourceBotChannelDirectline() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			// the extension keys are used to connect the Direct Line App Service Extension to this Channel
+			"extension_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"extension_key2": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
 
 func resourceBotChannelDirectlineCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	resourceId := parse.NewBotChannelID(subscriptionId, d.Get("resource_group_name").(string), d.Get("bot_name").(string), string(botservice.ChannelNameBasicChannelChannelNameDirectLineChannel))
-	existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.BotServiceName, resourceId.ChannelName)
+	resourceId := parse.NewBotChannelID(subscriptionId, d.Get("resource_group_name").(string), d.Get("bot_name").(string), string(channel.ChannelNameDirectLineChannel))
+	channelId := channel.NewChannelID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.BotServiceName, resourceId.ChannelName)
+	existing, err := client.Get(ctx, channelId)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return fmt.Errorf("checking for presence of existing Directline Channel for Bot %q (Resource Group %q): %+v", resourceId.BotServiceName, resourceId.ResourceGroup, err)
 		}
 	}
-	if !utils.ResponseWasNotFound(existing.Response) {
+	if !response.WasNotFound(existing.HttpResponse) {
 		// a "Default Site" site gets created and returned.. so let's check it's not just that
-		if props := existing.Properties; props != nil {
-			directLineChannel, ok := props.AsDirectLineChannel()
+		if model := existing.Model; model != nil {
+			directLineChannel, ok := model.Properties.(channel.DirectLineChannel)
 			if ok && directLineChannel.Properties != nil {
 				sites := filterSites(directLineChannel.Properties.Sites)
 				if len(sites) != 0 {
@@ -142,24 +174,14 @@ func resourceBotChannelDirectlineCreate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	channel := botservice.BotChannel{
-		Properties: botservice.DirectLineChannel{
-			Properties: &botservice.DirectLineChannelProperties{
-				Sites: expandDirectlineSites(d.Get("site").(*pluginsdk.Set).List()),
-			},
-			ChannelName: botservice.ChannelNameBasicChannelChannelNameDirectLineChannel,
-		},
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Kind:     botservice.KindBot,
-	}
-
-	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.BotServiceName, botservice.ChannelNameDirectLineChannel, channel); err != nil {
+	payload := expandBotChannelDirectline(d)
+	if _, err := client.Create(ctx, channelId, payload); err != nil {
 		return fmt.Errorf("creating Directline Channel for Bot %q (Resource Group %q): %+v", resourceId.BotServiceName, resourceId.ResourceGroup, err)
 	}
 	d.SetId(resourceId.ID())
 
 	// Unable to create a new site with enhanced_authentication_enabled in the same operation, so we need to make two calls
-	if _, err := client.Update(ctx, resourceId.ResourceGroup, resourceId.BotServiceName, botservice.ChannelNameDirectLineChannel, channel); err != nil {
+	if _, err := client.Update(ctx, channelId, payload); err != nil {
 		return fmt.Errorf("updating Directline Channel for Bot %q (Resource Group %q): %+v", resourceId.BotServiceName, resourceId.ResourceGroup, err)
 	}
 
@@ -167,7 +189,7 @@ func resourceBotChannelDirectlineCreate(d *pluginsdk.ResourceData, meta interfac
 }
 
 func resourceBotChannelDirectlineRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -176,9 +198,10 @@ func resourceBotChannelDirectlineRead(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.BotServiceName, string(botservice.ChannelNameBasicChannelChannelNameDirectLineChannel))
+	channelId := channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameDirectLineChannel))
+	resp, err := client.Get(ctx, channelId)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Directline Channel for Bot %q (Resource Group %q) was not found - removing from state!", id.ResourceGroup, id.BotServiceName)
 			d.SetId("")
 			return nil
@@ -187,19 +210,24 @@ func resourceBotChannelDirectlineRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("retrieving Channel Directline for Bot %q (Resource Group %q): %+v", id.ResourceGroup, id.BotServiceName, err)
 	}
 
-	channelsResp, err := client.ListWithKeys(ctx, id.ResourceGroup, id.BotServiceName, botservice.ChannelNameDirectLineChannel)
+	channelsResp, err := client.ListWithKeys(ctx, channelId)
 	if err != nil {
 		return fmt.Errorf("listing Keys for Directline Channel for Bot %q (Resource Group %q): %+v", id.ResourceGroup, id.BotServiceName, err)
 	}
 
 	d.Set("bot_name", id.BotServiceName)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if props := channelsResp.Properties; props != nil {
-		if channel, ok := props.AsDirectLineChannel(); ok {
-			if channelProps := channel.Properties; channelProps != nil {
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+	}
+
+	if model := channelsResp.Model; model != nil {
+		if props, ok := model.Properties.(channel.DirectLineChannel); ok {
+			if channelProps := props.Properties; channelProps != nil {
 				d.Set("site", flattenDirectlineSites(filterSites(channelProps.Sites)))
+				d.Set("extension_key", utils.NormalizeNilableString(channelProps.ExtensionKey1))
+				d.Set("extension_key2", utils.NormalizeNilableString(channelProps.ExtensionKey2))
 			}
 		}
 	}
@@ -208,7 +236,7 @@ func resourceBotChannelDirectlineRead(d *pluginsdk.ResourceData, meta interface{
 }
 
 func resourceBotChannelDirectlineUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -217,18 +245,7 @@ func resourceBotChannelDirectlineUpdate(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	channel := botservice.BotChannel{
-		Properties: botservice.DirectLineChannel{
-			Properties: &botservice.DirectLineChannelProperties{
-				Sites: expandDirectlineSites(d.Get("site").(*pluginsdk.Set).List()),
-			},
-			ChannelName: botservice.ChannelNameBasicChannelChannelNameDirectLineChannel,
-		},
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Kind:     botservice.KindBot,
-	}
-
-	if _, err := client.Update(ctx, id.ResourceGroup, id.BotServiceName, botservice.ChannelNameDirectLineChannel, channel); err != nil {
+	if _, err := client.Update(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameDirectLineChannel)), expandBotChannelDirectline(d)); err != nil {
 		return fmt.Errorf("updating Directline Channel for Bot %q (Resource Group %q): %+v", id.BotServiceName, id.ResourceGroup, err)
 	}
 
@@ -236,7 +253,7 @@ func resourceBotChannelDirectlineUpdate(d *pluginsdk.ResourceData, meta interfac
 }
 
 func resourceBotChannelDirectlineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -245,9 +262,9 @@ func resourceBotChannelDirectlineDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.BotServiceName, string(botservice.ChannelNameBasicChannelChannelNameDirectLineChannel))
+	resp, err := client.Delete(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameDirectLineChannel)))
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting Directline Channel for Bot %q (Resource Group %q): %+v", id.BotServiceName, id.ResourceGroup, err)
 		}
 	}
@@ -255,8 +272,21 @@ func resourceBotChannelDirectlineDelete(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
-func expandDirectlineSites(input []interface{}) *[]botservice.DirectLineSite {
-	sites := make([]botservice.DirectLineSite, len(input))
+func expandBotChannelDirectline(d *pluginsdk.ResourceData) channel.BotChannel {
+	kind := channel.KindBot
+	return channel.BotChannel{
+		Properties: channel.DirectLineChannel{
+			Properties: &channel.DirectLineChannelProperties{
+				Sites: expandDirectlineSites(d.Get("site").(*pluginsdk.Set).List()),
+			},
+		},
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Kind:     &kind,
+	}
+}
+
+func expandDirectlineSites(input []interface{}) *[]channel.DirectLineSite {
+	sites := make([]channel.DirectLineSite, 0)
 
 	for _, element := range input {
 		if element == nil {
@@ -264,22 +294,31 @@ func expandDirectlineSites(input []interface{}) *[]botservice.DirectLineSite {
 		}
 
 		site := element.(map[string]interface{})
-		expanded := botservice.DirectLineSite{}
+		expanded := channel.DirectLineSite{}
 
 		if v, ok := site["name"].(string); ok {
-			expanded.SiteName = &v
+			expanded.SiteName = v
 		}
 		if v, ok := site["enabled"].(bool); ok {
-			expanded.IsEnabled = &v
+			expanded.IsEnabled = v
 		}
 		if v, ok := site["v1_allowed"].(bool); ok {
-			expanded.IsV1Enabled = &v
+			expanded.IsV1Enabled = utils.Bool(v)
 		}
 		if v, ok := site["v3_allowed"].(bool); ok {
-			expanded.IsV3Enabled = &v
+			expanded.IsV3Enabled = utils.Bool(v)
 		}
 		if v, ok := site["enhanced_authentication_enabled"].(bool); ok {
-			expanded.IsSecureSiteEnabled = &v
+			expanded.IsSecureSiteEnabled = utils.Bool(v)
+		}
+		if v, ok := site["endpoint_parameters_enabled"].(bool); ok {
+			expanded.IsEndpointParametersEnabled = utils.Bool(v)
+		}
+		if v, ok := site["storage_enabled"].(bool); ok {
+			expanded.IsNoStorageEnabled = utils.Bool(!v)
+		}
+		if v, ok := site["user_upload_enabled"].(bool); ok {
+			expanded.IsBlockUserUploadEnabled = utils.Bool(!v)
 		}
 		if v, ok := site["trusted_origins"].(*pluginsdk.Set); ok {
 			origins := v.List()
@@ -296,15 +335,14 @@ func expandDirectlineSites(input []interface{}) *[]botservice.DirectLineSite {
 	return &sites
 }
 
-func flattenDirectlineSites(input []botservice.DirectLineSite) []interface{} {
+func flattenDirectlineSites(input []channel.DirectLineSite) []interface{} {
 	sites := make([]interface{}, len(input))
 
 	for i, element := range input {
 		site := make(map[string]interface{})
 
-		if v := element.SiteName; v != nil {
-			site["name"] = *v
-		}
+		site["name"] = element.SiteName
+		site["enabled"] = element.IsEnabled
 
 		if element.Key != nil {
 			site["key"] = *element.Key
@@ -314,8 +352,8 @@ func flattenDirectlineSites(input []botservice.DirectLineSite) []interface{} {
 			site["key2"] = *element.Key2
 		}
 
-		if element.IsEnabled != nil {
-			site["enabled"] = *element.IsEnabled
+		if element.SiteId != nil {
+			site["id"] = *element.SiteId
 		}
 
 		if element.IsV1Enabled != nil {
@@ -330,6 +368,24 @@ func flattenDirectlineSites(input []botservice.DirectLineSite) []interface{} {
 			site["enhanced_authentication_enabled"] = *element.IsSecureSiteEnabled
 		}
 
+		endpointParametersEnabled := false
+		if element.IsEndpointParametersEnabled != nil {
+			endpointParametersEnabled = *element.IsEndpointParametersEnabled
+		}
+		site["endpoint_parameters_enabled"] = endpointParametersEnabled
+
+		storageEnabled := true
+		if element.IsNoStorageEnabled != nil {
+			storageEnabled = !*element.IsNoStorageEnabled
+		}
+		site["storage_enabled"] = storageEnabled
+
+		userUploadEnabled := true
+		if element.IsBlockUserUploadEnabled != nil {
+			userUploadEnabled = !*element.IsBlockUserUploadEnabled
+		}
+		site["user_upload_enabled"] = userUploadEnabled
+
 		if element.TrustedOrigins != nil {
 			site["trusted_origins"] = *element.TrustedOrigins
 		}
@@ -342,10 +398,14 @@ func flattenDirectlineSites(input []botservice.DirectLineSite) []interface{} {
 
 // When creating a new directline channel, a Default Site is created
 // There is a race condition where this site is not removed before the create request is completed
-func filterSites(sites *[]botservice.DirectLineSite) []botservice.DirectLineSite {
-	filtered := make([]botservice.DirectLineSite, 0)
+func filterSites(sites *[]channel.DirectLineSite) []channel.DirectLineSite {
+	filtered := make([]channel.DirectLineSite, 0)
+	if sites == nil {
+		return filtered
+	}
+
 	for _, site := range *sites {
-		if *site.SiteName == "Default Site" {
+		if site.SiteName == "Default Site" {
 			continue
 		}
 		filtered = append(filtered, site)
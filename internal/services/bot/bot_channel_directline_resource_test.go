@@ -41,6 +41,7 @@ func testAccBotChannelDirectline_complete(t *testing.T) {
 			Config: r.completeConfig(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_key").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -120,6 +121,9 @@ resource "azurerm_bot_channel_directline" "test" {
     v1_allowed                      = true
     v3_allowed                      = true
     enhanced_authentication_enabled = true
+    endpoint_parameters_enabled     = true
+    storage_enabled                 = false
+    user_upload_enabled             = false
     trusted_origins                 = ["https://example.com"]
   }
 }
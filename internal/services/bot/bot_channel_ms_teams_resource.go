@@ -5,13 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/botservice/mgmt/2021-03-01/botservice"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/channel"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -64,47 +64,41 @@ func resourceBotChannelMsTeams() *pluginsdk.Resource {
 				Optional: true,
 				Default:  false,
 			},
+
+			"deployment_environment": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  "CommercialDeployment",
+				ValidateFunc: validation.StringInSlice([]string{
+					"CommercialDeployment",
+					"GCCModerateDeployment",
+				}, false),
+			},
 		},
 	}
 }
 
 func resourceBotChannelMsTeamsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	resourceId := parse.NewBotChannelID(subscriptionId, d.Get("resource_group_name").(string), d.Get("bot_name").(string), string(botservice.ChannelNameMsTeamsChannel))
+	resourceId := parse.NewBotChannelID(subscriptionId, d.Get("resource_group_name").(string), d.Get("bot_name").(string), string(channel.ChannelNameMsTeamsChannel))
+	channelId := channel.NewChannelID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.BotServiceName, resourceId.ChannelName)
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.BotServiceName, resourceId.ChannelName)
+		existing, err := client.Get(ctx, channelId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for the presence of existing MS Teams Channel for Bot %q (Resource Group %q): %+v", resourceId.BotServiceName, resourceId.ResourceGroup, err)
 			}
 		}
-		if existing.ID != nil && *existing.ID != "" {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_bot_channel_ms_teams", resourceId.ID())
 		}
 	}
 
-	channel := botservice.BotChannel{
-		Properties: botservice.MsTeamsChannel{
-			Properties: &botservice.MsTeamsChannelProperties{
-				EnableCalling: utils.Bool(d.Get("enable_calling").(bool)),
-				IsEnabled:     utils.Bool(true),
-			},
-			ChannelName: botservice.ChannelNameBasicChannelChannelNameMsTeamsChannel,
-		},
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Kind:     botservice.KindBot,
-	}
-
-	if v, ok := d.GetOk("calling_web_hook"); ok {
-		channel, _ := channel.Properties.AsMsTeamsChannel()
-		channel.Properties.CallingWebHook = utils.String(v.(string))
-	}
-
-	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.BotServiceName, botservice.ChannelNameMsTeamsChannel, channel); err != nil {
+	if _, err := client.Create(ctx, channelId, expandBotChannelMsTeams(d)); err != nil {
 		return fmt.Errorf("creating MS Teams Channel for Bot %q (Resource Group %q): %+v", resourceId.BotServiceName, resourceId.ResourceGroup, err)
 	}
 
@@ -113,7 +107,7 @@ func resourceBotChannelMsTeamsCreate(d *pluginsdk.ResourceData, meta interface{}
 }
 
 func resourceBotChannelMsTeamsRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -122,9 +116,9 @@ func resourceBotChannelMsTeamsRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.BotServiceName, string(botservice.ChannelNameMsTeamsChannel))
+	resp, err := client.Get(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameMsTeamsChannel)))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] MS Teams Channel for Bot %q (Resource Group %q) was not found - removing from state!", id.BotServiceName, id.ResourceGroup)
 			d.SetId("")
 			return nil
@@ -135,13 +129,20 @@ func resourceBotChannelMsTeamsRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("bot_name", id.BotServiceName)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if props := resp.Properties; props != nil {
-		if channel, ok := props.AsMsTeamsChannel(); ok {
-			if channelProps := channel.Properties; channelProps != nil {
-				d.Set("calling_web_hook", channelProps.CallingWebHook)
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if props, ok := model.Properties.(channel.MsTeamsChannel); ok {
+			if channelProps := props.Properties; channelProps != nil {
+				d.Set("calling_web_hook", channelProps.CallingWebhook)
 				d.Set("enable_calling", channelProps.EnableCalling)
+
+				deploymentEnvironment := "CommercialDeployment"
+				if v := channelProps.DeploymentEnvironment; v != nil && *v != "" {
+					deploymentEnvironment = *v
+				}
+				d.Set("deployment_environment", deploymentEnvironment)
 			}
 		}
 	}
@@ -150,7 +151,7 @@ func resourceBotChannelMsTeamsRead(d *pluginsdk.ResourceData, meta interface{})
 }
 
 func resourceBotChannelMsTeamsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -159,20 +160,7 @@ func resourceBotChannelMsTeamsUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
-	channel := botservice.BotChannel{
-		Properties: botservice.MsTeamsChannel{
-			Properties: &botservice.MsTeamsChannelProperties{
-				EnableCalling:  utils.Bool(d.Get("enable_calling").(bool)),
-				CallingWebHook: utils.String(d.Get("calling_web_hook").(string)),
-				IsEnabled:      utils.Bool(true),
-			},
-			ChannelName: botservice.ChannelNameBasicChannelChannelNameMsTeamsChannel,
-		},
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
-		Kind:     botservice.KindBot,
-	}
-
-	if _, err := client.Update(ctx, id.ResourceGroup, id.BotServiceName, botservice.ChannelNameMsTeamsChannel, channel); err != nil {
+	if _, err := client.Update(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameMsTeamsChannel)), expandBotChannelMsTeams(d)); err != nil {
 		return fmt.Errorf("updating MS Teams Channel for Bot %q (Resource Group %q): %+v", id.BotServiceName, id.ResourceGroup, err)
 	}
 
@@ -180,7 +168,7 @@ func resourceBotChannelMsTeamsUpdate(d *pluginsdk.ResourceData, meta interface{}
 }
 
 func resourceBotChannelMsTeamsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.ChannelClient
+	client := meta.(*clients.Client).Bot.ChannelV20220915Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -189,12 +177,33 @@ func resourceBotChannelMsTeamsDelete(d *pluginsdk.ResourceData, meta interface{}
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.BotServiceName, string(botservice.ChannelNameMsTeamsChannel))
+	resp, err := client.Delete(ctx, channel.NewChannelID(id.SubscriptionId, id.ResourceGroup, id.BotServiceName, string(channel.ChannelNameMsTeamsChannel)))
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting MS Teams Channel for Bot %q (Resource Group %q): %+v", id.BotServiceName, id.ResourceGroup, err)
 		}
 	}
 
 	return nil
 }
+
+func expandBotChannelMsTeams(d *pluginsdk.ResourceData) channel.BotChannel {
+	props := &channel.MsTeamsChannelProperties{
+		DeploymentEnvironment: utils.String(d.Get("deployment_environment").(string)),
+		EnableCalling:         utils.Bool(d.Get("enable_calling").(bool)),
+		IsEnabled:             true,
+	}
+
+	if v, ok := d.GetOk("calling_web_hook"); ok {
+		props.CallingWebhook = utils.String(v.(string))
+	}
+
+	kind := channel.KindBot
+	return channel.BotChannel{
+		Properties: channel.MsTeamsChannel{
+			Properties: props,
+		},
+		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Kind:     &kind,
+	}
+}
@@ -95,8 +95,9 @@ resource "azurerm_bot_channel_ms_teams" "test" {
   bot_name            = azurerm_bot_channels_registration.test.name
   location            = azurerm_bot_channels_registration.test.location
   resource_group_name = azurerm_resource_group.test.name
-  calling_web_hook    = "https://example.com/"
-  enable_calling      = true
+  calling_web_hook       = "https://example.com/"
+  enable_calling         = true
+  deployment_environment = "CommercialDeployment"
 }
 `, BotChannelsRegistrationResource{}.basicConfig(data))
 }
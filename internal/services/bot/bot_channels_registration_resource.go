@@ -6,13 +6,14 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/botservice/mgmt/2021-03-01/botservice"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/bot"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/validate"
 	kvValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -40,23 +41,27 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 			_, err := parse.BotServiceID(id)
 			return err
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
-			client := meta.(*clients.Client).Bot.BotClient
+			client := meta.(*clients.Client).Bot.BotV20220915Client
 
 			id, err := parse.BotServiceID(d.Id())
 			if err != nil {
 				return nil, err
 			}
 
-			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			resp, err := client.Get(ctx, bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 			if err != nil {
-				if utils.ResponseWasNotFound(resp.Response) {
+				if response.WasNotFound(resp.HttpResponse) {
 					return nil, fmt.Errorf("Bot Channels Registration %q was not found in Resource Group %q", id.Name, id.ResourceGroup)
 				}
 
 				return nil, fmt.Errorf("retrieving Bot Channels Registration %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 			}
-			if resp.Kind != botservice.KindBot {
-				return nil, fmt.Errorf("Bot %q (Resource Group %q) was not a Channel Registration - got %q", id.Name, id.ResourceGroup, string(resp.Kind))
+			kind := ""
+			if resp.Model != nil && resp.Model.Kind != nil {
+				kind = string(*resp.Model.Kind)
+			}
+			if kind != string(bot.KindBot) {
+				return nil, fmt.Errorf("Bot %q (Resource Group %q) was not a Channel Registration - got %q", id.Name, id.ResourceGroup, kind)
 			}
 
 			return []*pluginsdk.ResourceData{d}, nil
@@ -79,8 +84,8 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(botservice.SkuNameF0),
-					string(botservice.SkuNameS1),
+					string(bot.SkuNameF0),
+					string(bot.SkuNameS1),
 				}, false),
 			},
 
@@ -146,8 +151,24 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 			},
 
 			"isolated_network_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"public_network_access_enabled"},
+				Deprecated:    "`isolated_network_enabled` has been superseded by `public_network_access_enabled` and will be removed in the next major version of the provider",
+			},
+
+			"public_network_access_enabled": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"isolated_network_enabled"},
+			},
+
+			"streaming_endpoint_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
+				Default:  false,
 			},
 
 			"tags": tags.Schema(),
@@ -156,69 +177,36 @@ func resourceBotChannelsRegistration() *pluginsdk.Resource {
 }
 
 func resourceBotChannelsRegistrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot.BotV20220915Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	resourceId := parse.NewBotServiceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	botId := bot.NewBotServiceID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.Name)
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.Name)
+		existing, err := client.Get(ctx, botId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing Bot Channels Registration %q (Resource Group %q): %+v", resourceId.Name, resourceId.ResourceGroup, err)
 			}
 		}
-		if existing.ID != nil && *existing.ID != "" {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_bot_channels_registration", resourceId.ID())
 		}
 	}
 
-	displayName := d.Get("display_name").(string)
-	if displayName == "" {
-		displayName = resourceId.Name
-	}
-
-	bot := botservice.Bot{
-		Properties: &botservice.BotProperties{
-			DisplayName:                       utils.String(displayName),
-			Endpoint:                          utils.String(d.Get("endpoint").(string)),
-			MsaAppID:                          utils.String(d.Get("microsoft_app_id").(string)),
-			CmekKeyVaultURL:                   utils.String(d.Get("cmk_key_vault_url").(string)),
-			Description:                       utils.String(d.Get("description").(string)),
-			DeveloperAppInsightKey:            utils.String(d.Get("developer_app_insights_key").(string)),
-			DeveloperAppInsightsAPIKey:        utils.String(d.Get("developer_app_insights_api_key").(string)),
-			DeveloperAppInsightsApplicationID: utils.String(d.Get("developer_app_insights_application_id").(string)),
-			IconURL:                           utils.String(d.Get("icon_url").(string)),
-			IsCmekEnabled:                     utils.Bool(false),
-		},
-		Location: utils.String(d.Get("location").(string)),
-		Sku: &botservice.Sku{
-			Name: botservice.SkuName(d.Get("sku").(string)),
-		},
-		Kind: botservice.KindBot,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
-	}
-
-	if _, ok := d.GetOk("cmk_key_vault_url"); ok {
-		bot.Properties.IsCmekEnabled = utils.Bool(true)
-	}
-
-	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.Name, bot); err != nil {
+	if _, err := client.Create(ctx, botId, expandBotChannelsRegistration(d, resourceId)); err != nil {
 		return fmt.Errorf("creating Bot Channels Registration %q (Resource Group %q): %+v", resourceId.Name, resourceId.ResourceGroup, err)
 	}
 
 	d.SetId(resourceId.ID())
 
-	if v, ok := d.GetOk("isolated_network_enabled"); ok && v.(bool) {
-		return resourceBotChannelsRegistrationUpdate(d, meta)
-	} else {
-		return resourceBotChannelsRegistrationRead(d, meta)
-	}
+	return resourceBotChannelsRegistrationRead(d, meta)
 }
 
 func resourceBotChannelsRegistrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot.BotV20220915Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -227,9 +215,9 @@ func resourceBotChannelsRegistrationRead(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Bot Channels Registration %q (Resource Group %q) was not found - removing from state", id.Name, id.ResourceGroup)
 			d.SetId("")
 			return nil
@@ -240,29 +228,43 @@ func resourceBotChannelsRegistrationRead(d *pluginsdk.ResourceData, meta interfa
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", string(sku.Name))
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		if sku := model.Sku; sku != nil {
+			d.Set("sku", string(sku.Name))
+		}
 
-	if props := resp.Properties; props != nil {
-		d.Set("cmk_key_vault_url", props.CmekKeyVaultURL)
-		d.Set("microsoft_app_id", props.MsaAppID)
-		d.Set("endpoint", props.Endpoint)
-		d.Set("description", props.Description)
-		d.Set("display_name", props.DisplayName)
-		d.Set("developer_app_insights_key", props.DeveloperAppInsightKey)
-		d.Set("developer_app_insights_application_id", props.DeveloperAppInsightsApplicationID)
-		d.Set("icon_url", props.IconURL)
-		d.Set("isolated_network_enabled", props.IsIsolated)
+		if props := model.Properties; props != nil {
+			d.Set("cmk_key_vault_url", props.CmekKeyVaultUrl)
+			d.Set("microsoft_app_id", props.MsaAppId)
+			d.Set("endpoint", props.Endpoint)
+			d.Set("description", props.Description)
+			d.Set("display_name", props.DisplayName)
+			d.Set("developer_app_insights_key", props.DeveloperAppInsightKey)
+			d.Set("developer_app_insights_application_id", props.DeveloperAppInsightsApplicationId)
+			d.Set("icon_url", props.IconUrl)
+
+			publicNetworkAccessEnabled := flattenBotPublicNetworkAccess(props.PublicNetworkAccess)
+			d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+			d.Set("isolated_network_enabled", !publicNetworkAccessEnabled)
+
+			streamingEndpointEnabled := false
+			if v := props.IsStreamingSupported; v != nil {
+				streamingEndpointEnabled = *v
+			}
+			d.Set("streaming_endpoint_enabled", streamingEndpointEnabled)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot.BotV20220915Client
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -271,39 +273,7 @@ func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	t := d.Get("tags").(map[string]interface{})
-	displayName := d.Get("display_name").(string)
-	if displayName == "" {
-		displayName = id.Name
-	}
-
-	bot := botservice.Bot{
-		Properties: &botservice.BotProperties{
-			DisplayName:                       utils.String(displayName),
-			Endpoint:                          utils.String(d.Get("endpoint").(string)),
-			MsaAppID:                          utils.String(d.Get("microsoft_app_id").(string)),
-			CmekKeyVaultURL:                   utils.String(d.Get("cmk_key_vault_url").(string)),
-			Description:                       utils.String(d.Get("description").(string)),
-			DeveloperAppInsightKey:            utils.String(d.Get("developer_app_insights_key").(string)),
-			DeveloperAppInsightsAPIKey:        utils.String(d.Get("developer_app_insights_api_key").(string)),
-			DeveloperAppInsightsApplicationID: utils.String(d.Get("developer_app_insights_application_id").(string)),
-			IconURL:                           utils.String(d.Get("icon_url").(string)),
-			IsCmekEnabled:                     utils.Bool(false),
-			IsIsolated:                        utils.Bool(d.Get("isolated_network_enabled").(bool)),
-		},
-		Location: utils.String(d.Get("location").(string)),
-		Sku: &botservice.Sku{
-			Name: botservice.SkuName(d.Get("sku").(string)),
-		},
-		Kind: botservice.KindBot,
-		Tags: tags.Expand(t),
-	}
-
-	if _, ok := d.GetOk("cmk_key_vault_url"); ok {
-		bot.Properties.IsCmekEnabled = utils.Bool(true)
-	}
-
-	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, bot); err != nil {
+	if _, err := client.Update(ctx, bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name), expandBotChannelsRegistration(d, *id)); err != nil {
 		return fmt.Errorf("updating Bot Channels Registration %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
@@ -311,7 +281,7 @@ func resourceBotChannelsRegistrationUpdate(d *pluginsdk.ResourceData, meta inter
 }
 
 func resourceBotChannelsRegistrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Bot.BotClient
+	client := meta.(*clients.Client).Bot.BotV20220915Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -320,12 +290,58 @@ func resourceBotChannelsRegistrationDelete(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Delete(ctx, bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting Bot Channels Registration %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 		}
 	}
 
 	return nil
 }
+
+func expandBotChannelsRegistration(d *pluginsdk.ResourceData, id parse.BotServiceId) bot.Bot {
+	displayName := d.Get("display_name").(string)
+	if displayName == "" {
+		displayName = id.Name
+	}
+
+	// `isolated_network_enabled` is superseded by `public_network_access_enabled`, but both map onto the same property
+	publicNetworkAccessEnabled := true
+	if v, ok := d.GetOkExists("public_network_access_enabled"); ok {
+		publicNetworkAccessEnabled = v.(bool)
+	}
+	if d.HasChange("isolated_network_enabled") && !d.HasChange("public_network_access_enabled") {
+		publicNetworkAccessEnabled = !d.Get("isolated_network_enabled").(bool)
+	}
+
+	kind := bot.KindBot
+	output := bot.Bot{
+		Properties: &bot.BotProperties{
+			DisplayName:                       displayName,
+			Endpoint:                          d.Get("endpoint").(string),
+			MsaAppId:                          d.Get("microsoft_app_id").(string),
+			CmekKeyVaultUrl:                   utils.String(d.Get("cmk_key_vault_url").(string)),
+			Description:                       utils.String(d.Get("description").(string)),
+			DeveloperAppInsightKey:            utils.String(d.Get("developer_app_insights_key").(string)),
+			DeveloperAppInsightsApiKey:        utils.String(d.Get("developer_app_insights_api_key").(string)),
+			DeveloperAppInsightsApplicationId: utils.String(d.Get("developer_app_insights_application_id").(string)),
+			IconUrl:                           utils.String(d.Get("icon_url").(string)),
+			IsCmekEnabled:                     utils.Bool(false),
+			IsStreamingSupported:              utils.Bool(d.Get("streaming_endpoint_enabled").(bool)),
+			PublicNetworkAccess:               expandBotPublicNetworkAccess(publicNetworkAccessEnabled),
+		},
+		Location: utils.String(d.Get("location").(string)),
+		Sku: &bot.Sku{
+			Name: bot.SkuName(d.Get("sku").(string)),
+		},
+		Kind: &kind,
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, ok := d.GetOk("cmk_key_vault_url"); ok {
+		output.Properties.IsCmekEnabled = utils.Bool(true)
+	}
+
+	return output
+}
@@ -248,10 +248,11 @@ resource "azurerm_bot_channels_registration" "test" {
   developer_app_insights_api_key        = azurerm_application_insights_api_key.test2.api_key
   developer_app_insights_application_id = azurerm_application_insights.test2.app_id
 
-  description              = "TestDescription2"
-  isolated_network_enabled = false
-  icon_url                 = "http://myprofile/myicon2.png"
-  cmk_key_vault_url        = azurerm_key_vault_key.test2.id
+  description                   = "TestDescription2"
+  public_network_access_enabled = true
+  streaming_endpoint_enabled    = true
+  icon_url                      = "http://myprofile/myicon2.png"
+  cmk_key_vault_url             = azurerm_key_vault_key.test2.id
 
   tags = {
     environment = "production2"
@@ -347,10 +348,11 @@ resource "azurerm_bot_channels_registration" "test" {
   developer_app_insights_api_key        = azurerm_application_insights_api_key.test.api_key
   developer_app_insights_application_id = azurerm_application_insights.test.app_id
 
-  description              = "TestDescription"
-  isolated_network_enabled = true
-  icon_url                 = "http://myprofile/myicon.png"
-  cmk_key_vault_url        = azurerm_key_vault_key.test.id
+  description                   = "TestDescription"
+  public_network_access_enabled = false
+  streaming_endpoint_enabled    = true
+  icon_url                      = "http://myprofile/myicon.png"
+  cmk_key_vault_url             = azurerm_key_vault_key.test.id
 
   tags = {
     environment = "production"
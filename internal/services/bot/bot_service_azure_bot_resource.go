@@ -1,8 +1,8 @@
 package bot
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/botservice/mgmt/2021-03-01/botservice"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/bot"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -45,7 +45,7 @@ func (r AzureBotServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 }
 
 func (r AzureBotServiceResource) Create() sdk.ResourceFunc {
-	return r.base.createFunc(r.ResourceType(), string(botservice.KindAzurebot))
+	return r.base.createFunc(r.ResourceType(), bot.KindAzurebot)
 }
 
 func (r AzureBotServiceResource) Read() sdk.ResourceFunc {
@@ -61,5 +61,5 @@ func (r AzureBotServiceResource) Update() sdk.ResourceFunc {
 }
 
 func (r AzureBotServiceResource) CustomImporter() sdk.ResourceRunFunc {
-	return r.base.importerFunc(string(botservice.KindAzurebot))
+	return r.base.importerFunc(bot.KindAzurebot)
 }
@@ -154,6 +154,9 @@ resource "azurerm_bot_service_azure_bot" "test" {
   developer_app_insights_api_key        = azurerm_application_insights_api_key.test.api_key
   developer_app_insights_application_id = azurerm_application_insights.test.app_id
 
+  public_network_access_enabled = false
+  streaming_endpoint_enabled    = true
+
   tags = {
     environment = "test"
   }
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/botservice/mgmt/2021-03-01/botservice"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/bot"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -30,8 +32,8 @@ func (br botBaseResource) arguments(fields map[string]*pluginsdk.Schema) map[str
 			Required: true,
 			ForceNew: true,
 			ValidateFunc: validation.StringInSlice([]string{
-				string(botservice.SkuNameF0),
-				string(botservice.SkuNameS1),
+				string(bot.SkuNameF0),
+				string(bot.SkuNameS1),
 			}, false),
 		},
 
@@ -94,6 +96,18 @@ func (br botBaseResource) arguments(fields map[string]*pluginsdk.Schema) map[str
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
 
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"streaming_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"tags": tags.Schema(),
 	}
 
@@ -108,22 +122,23 @@ func (br botBaseResource) attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
 
-func (br botBaseResource) createFunc(resourceName, botKind string) sdk.ResourceFunc {
+func (br botBaseResource) createFunc(resourceName string, botKind bot.Kind) sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Bot.BotClient
+			client := metadata.Client.Bot.BotV20220915Client
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
 			id := parse.NewBotServiceID(subscriptionId, metadata.ResourceData.Get("resource_group_name").(string), metadata.ResourceData.Get("name").(string))
+			botId := bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name)
 
-			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			existing, err := client.Get(ctx, botId)
 			if err != nil {
-				if !utils.ResponseWasNotFound(existing.Response) {
+				if !response.WasNotFound(existing.HttpResponse) {
 					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 				}
 			}
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return tf.ImportAsExistsError(resourceName, id.ID())
 			}
 
@@ -132,26 +147,28 @@ func (br botBaseResource) createFunc(resourceName, botKind string) sdk.ResourceF
 				displayName = id.Name
 			}
 
-			props := botservice.Bot{
+			props := bot.Bot{
 				Location: utils.String(metadata.ResourceData.Get("location").(string)),
-				Sku: &botservice.Sku{
-					Name: botservice.SkuName(metadata.ResourceData.Get("sku").(string)),
+				Sku: &bot.Sku{
+					Name: bot.SkuName(metadata.ResourceData.Get("sku").(string)),
 				},
-				Kind: botservice.Kind(botKind),
-				Properties: &botservice.BotProperties{
-					DisplayName:                       utils.String(displayName),
-					Endpoint:                          utils.String(metadata.ResourceData.Get("endpoint").(string)),
-					MsaAppID:                          utils.String(metadata.ResourceData.Get("microsoft_app_id").(string)),
+				Kind: &botKind,
+				Properties: &bot.BotProperties{
+					DisplayName:                       displayName,
+					Endpoint:                          metadata.ResourceData.Get("endpoint").(string),
+					MsaAppId:                          metadata.ResourceData.Get("microsoft_app_id").(string),
 					DeveloperAppInsightKey:            utils.String(metadata.ResourceData.Get("developer_app_insights_key").(string)),
-					DeveloperAppInsightsAPIKey:        utils.String(metadata.ResourceData.Get("developer_app_insights_api_key").(string)),
-					DeveloperAppInsightsApplicationID: utils.String(metadata.ResourceData.Get("developer_app_insights_application_id").(string)),
+					DeveloperAppInsightsApiKey:        utils.String(metadata.ResourceData.Get("developer_app_insights_api_key").(string)),
+					DeveloperAppInsightsApplicationId: utils.String(metadata.ResourceData.Get("developer_app_insights_application_id").(string)),
 					LuisAppIds:                        utils.ExpandStringSlice(metadata.ResourceData.Get("luis_app_ids").([]interface{})),
 					LuisKey:                           utils.String(metadata.ResourceData.Get("luis_key").(string)),
+					IsStreamingSupported:              utils.Bool(metadata.ResourceData.Get("streaming_endpoint_enabled").(bool)),
+					PublicNetworkAccess:               expandBotPublicNetworkAccess(metadata.ResourceData.Get("public_network_access_enabled").(bool)),
 				},
-				Tags: tags.Expand(metadata.ResourceData.Get("tags").(map[string]interface{})),
+				Tags: tagsHelper.Expand(metadata.ResourceData.Get("tags").(map[string]interface{})),
 			}
 
-			if _, err := client.Create(ctx, id.ResourceGroup, id.Name, props); err != nil {
+			if _, err := client.Create(ctx, botId, props); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -165,16 +182,16 @@ func (br botBaseResource) readFunc() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Bot.BotClient
+			client := metadata.Client.Bot.BotV20220915Client
 
 			id, err := parse.BotServiceID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			resp, err := client.Get(ctx, bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 			if err != nil {
-				if !utils.ResponseWasNotFound(resp.Response) {
+				if response.WasNotFound(resp.HttpResponse) {
 					return metadata.MarkAsGone(id)
 				}
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -182,58 +199,39 @@ func (br botBaseResource) readFunc() sdk.ResourceFunc {
 
 			metadata.ResourceData.Set("name", id.Name)
 			metadata.ResourceData.Set("resource_group_name", id.ResourceGroup)
-			metadata.ResourceData.Set("location", location.NormalizeNilable(resp.Location))
-
-			sku := ""
-			if v := resp.Sku; v != nil {
-				sku = string(v.Name)
-			}
-			metadata.ResourceData.Set("sku", sku)
 
-			metadata.ResourceData.Set("tags", tags.FlattenAndSet(metadata.ResourceData, resp.Tags))
+			if model := resp.Model; model != nil {
+				metadata.ResourceData.Set("location", location.NormalizeNilable(model.Location))
 
-			if props := resp.Properties; props != nil {
-				msAppId := ""
-				if v := props.MsaAppID; v != nil {
-					msAppId = *v
+				sku := ""
+				if v := model.Sku; v != nil {
+					sku = string(v.Name)
 				}
-				metadata.ResourceData.Set("microsoft_app_id", msAppId)
-
-				displayName := ""
-				if v := props.DisplayName; v != nil {
-					displayName = *v
-				}
-				metadata.ResourceData.Set("display_name", displayName)
-
-				endpoint := ""
-				if v := props.Endpoint; v != nil {
-					endpoint = *v
-				}
-				metadata.ResourceData.Set("endpoint", endpoint)
-
-				key := ""
-				if v := props.DeveloperAppInsightKey; v != nil {
-					key = *v
-				}
-				metadata.ResourceData.Set("developer_app_insights_key", key)
-
-				apiKey := ""
-				if v := props.DeveloperAppInsightsAPIKey; v != nil {
-					apiKey = *v
-				}
-				metadata.ResourceData.Set("developer_app_insights_api_key", apiKey)
-
-				appInsightsId := ""
-				if v := props.DeveloperAppInsightsApplicationID; v != nil {
-					appInsightsId = *v
+				metadata.ResourceData.Set("sku", sku)
+
+				metadata.ResourceData.Set("tags", tags.Flatten(tagsHelper.Flatten(model.Tags)))
+
+				if props := model.Properties; props != nil {
+					metadata.ResourceData.Set("microsoft_app_id", props.MsaAppId)
+					metadata.ResourceData.Set("display_name", props.DisplayName)
+					metadata.ResourceData.Set("endpoint", props.Endpoint)
+					metadata.ResourceData.Set("developer_app_insights_key", utils.NormalizeNilableString(props.DeveloperAppInsightKey))
+					metadata.ResourceData.Set("developer_app_insights_api_key", utils.NormalizeNilableString(props.DeveloperAppInsightsApiKey))
+					metadata.ResourceData.Set("developer_app_insights_application_id", utils.NormalizeNilableString(props.DeveloperAppInsightsApplicationId))
+
+					var luisAppIds []string
+					if v := props.LuisAppIds; v != nil {
+						luisAppIds = *v
+					}
+					metadata.ResourceData.Set("luis_app_ids", utils.FlattenStringSlice(&luisAppIds))
+
+					streamingEndpointEnabled := false
+					if v := props.IsStreamingSupported; v != nil {
+						streamingEndpointEnabled = *v
+					}
+					metadata.ResourceData.Set("streaming_endpoint_enabled", streamingEndpointEnabled)
+					metadata.ResourceData.Set("public_network_access_enabled", flattenBotPublicNetworkAccess(props.PublicNetworkAccess))
 				}
-				metadata.ResourceData.Set("developer_app_insights_application_id", appInsightsId)
-
-				var luisAppIds []string
-				if v := props.LuisAppIds; v != nil {
-					luisAppIds = *v
-				}
-				metadata.ResourceData.Set("luis_app_ids", utils.FlattenStringSlice(&luisAppIds))
 			}
 
 			return nil
@@ -245,13 +243,13 @@ func (br botBaseResource) deleteFunc() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Bot.BotClient
+			client := metadata.Client.Bot.BotV20220915Client
 			id, err := parse.BotServiceID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			if _, err = client.Delete(ctx, id.ResourceGroup, id.Name); err != nil {
+			if _, err = client.Delete(ctx, bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name)); err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
 
@@ -264,46 +262,64 @@ func (br botBaseResource) updateFunc() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Bot.BotClient
+			client := metadata.Client.Bot.BotV20220915Client
 			id, err := parse.BotServiceID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			botId := bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+			existing, err := client.Get(ctx, botId)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
 
 			if metadata.ResourceData.HasChange("display_name") {
-				existing.Properties.DisplayName = utils.String(metadata.ResourceData.Get("display_name").(string))
+				payload.Properties.DisplayName = metadata.ResourceData.Get("display_name").(string)
 			}
 
 			if metadata.ResourceData.HasChange("endpoint") {
-				existing.Properties.Endpoint = utils.String(metadata.ResourceData.Get("endpoint").(string))
+				payload.Properties.Endpoint = metadata.ResourceData.Get("endpoint").(string)
 			}
 
 			if metadata.ResourceData.HasChange("developer_app_insights_key") {
-				existing.Properties.DeveloperAppInsightKey = utils.String(metadata.ResourceData.Get("developer_app_insights_key").(string))
+				payload.Properties.DeveloperAppInsightKey = utils.String(metadata.ResourceData.Get("developer_app_insights_key").(string))
 			}
 
 			if metadata.ResourceData.HasChange("developer_app_insights_api_key") {
-				existing.Properties.DeveloperAppInsightsAPIKey = utils.String(metadata.ResourceData.Get("developer_app_insights_api_key").(string))
+				payload.Properties.DeveloperAppInsightsApiKey = utils.String(metadata.ResourceData.Get("developer_app_insights_api_key").(string))
 			}
 
 			if metadata.ResourceData.HasChange("developer_app_insights_application_id") {
-				existing.Properties.DeveloperAppInsightsApplicationID = utils.String(metadata.ResourceData.Get("developer_app_insights_application_id").(string))
+				payload.Properties.DeveloperAppInsightsApplicationId = utils.String(metadata.ResourceData.Get("developer_app_insights_application_id").(string))
 			}
 
 			if metadata.ResourceData.HasChange("luis_app_ids") {
-				existing.Properties.LuisAppIds = utils.ExpandStringSlice(metadata.ResourceData.Get("luis_app_ids").([]interface{}))
+				payload.Properties.LuisAppIds = utils.ExpandStringSlice(metadata.ResourceData.Get("luis_app_ids").([]interface{}))
 			}
 
 			if metadata.ResourceData.HasChange("luis_key") {
-				existing.Properties.LuisKey = utils.String(metadata.ResourceData.Get("luis_key").(string))
+				payload.Properties.LuisKey = utils.String(metadata.ResourceData.Get("luis_key").(string))
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				payload.Properties.PublicNetworkAccess = expandBotPublicNetworkAccess(metadata.ResourceData.Get("public_network_access_enabled").(bool))
+			}
+
+			if metadata.ResourceData.HasChange("streaming_endpoint_enabled") {
+				payload.Properties.IsStreamingSupported = utils.Bool(metadata.ResourceData.Get("streaming_endpoint_enabled").(bool))
 			}
 
-			if _, err := client.Update(ctx, id.ResourceGroup, id.Name, existing); err != nil {
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = tagsHelper.Expand(metadata.ResourceData.Get("tags").(map[string]interface{}))
+			}
+
+			if _, err := client.Update(ctx, botId, payload); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
@@ -312,24 +328,41 @@ func (br botBaseResource) updateFunc() sdk.ResourceFunc {
 	}
 }
 
-func (br botBaseResource) importerFunc(expectKind string) sdk.ResourceRunFunc {
+func (br botBaseResource) importerFunc(expectKind bot.Kind) sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-		client := metadata.Client.Bot.BotClient
+		client := metadata.Client.Bot.BotV20220915Client
 
 		id, err := parse.BotServiceID(metadata.ResourceData.Id())
 		if err != nil {
 			return err
 		}
 
-		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		resp, err := client.Get(ctx, bot.NewBotServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 		if err != nil {
 			return fmt.Errorf("retrieving %s: %+v", *id, err)
 		}
 
-		if actualKind := string(resp.Kind); actualKind != expectKind {
-			return fmt.Errorf("bot has mismatched type, expected: %q, got %q", expectKind, actualKind)
+		actualKind := ""
+		if resp.Model != nil && resp.Model.Kind != nil {
+			actualKind = string(*resp.Model.Kind)
+		}
+		if actualKind != string(expectKind) {
+			return fmt.Errorf("bot has mismatched type, expected: %q, got %q", string(expectKind), actualKind)
 		}
 
 		return nil
 	}
 }
+
+func expandBotPublicNetworkAccess(input bool) *bot.PublicNetworkAccess {
+	publicNetworkAccess := bot.PublicNetworkAccessDisabled
+	if input {
+		publicNetworkAccess = bot.PublicNetworkAccessEnabled
+	}
+	return &publicNetworkAccess
+}
+
+func flattenBotPublicNetworkAccess(input *bot.PublicNetworkAccess) bool {
+	// the API defaults to Enabled when this isn't returned
+	return input == nil || *input != bot.PublicNetworkAccessDisabled
+}
@@ -4,13 +4,17 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/botservice/mgmt/2021-03-01/botservice"
 	"github.com/Azure/azure-sdk-for-go/services/healthbot/mgmt/2020-12-08/healthbot"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/bot"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/sdk/2022-09-15/channel"
 )
 
 type Client struct {
-	BotClient        *botservice.BotsClient
-	ConnectionClient *botservice.BotConnectionClient
-	ChannelClient    *botservice.ChannelsClient
-	HealthbotClient  *healthbot.BotsClient
+	BotClient              *botservice.BotsClient
+	BotV20220915Client     *bot.BotClient
+	ConnectionClient       *botservice.BotConnectionClient
+	ChannelClient          *botservice.ChannelsClient
+	ChannelV20220915Client *channel.ChannelClient
+	HealthbotClient        *healthbot.BotsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -23,13 +27,21 @@ func NewClient(o *common.ClientOptions) *Client {
 	channelClient := botservice.NewChannelsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&channelClient.Client, o.ResourceManagerAuthorizer)
 
+	botV20220915Client := bot.NewBotClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&botV20220915Client.Client, o.ResourceManagerAuthorizer)
+
+	channelV20220915Client := channel.NewChannelClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&channelV20220915Client.Client, o.ResourceManagerAuthorizer)
+
 	healthBotClient := healthbot.NewBotsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&healthBotClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		BotClient:        &botClient,
-		ChannelClient:    &channelClient,
-		ConnectionClient: &connectionClient,
-		HealthbotClient:  &healthBotClient,
+		BotClient:              &botClient,
+		BotV20220915Client:     &botV20220915Client,
+		ChannelClient:          &channelClient,
+		ChannelV20220915Client: &channelV20220915Client,
+		ConnectionClient:       &connectionClient,
+		HealthbotClient:        &healthBotClient,
 	}
 }
@@ -0,0 +1,15 @@
+package bot
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BotClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBotClientWithBaseURI(endpoint string) BotClient {
+	return BotClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
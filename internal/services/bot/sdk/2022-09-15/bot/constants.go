@@ -0,0 +1,155 @@
+package bot
+
+import "strings"
+
+type Kind string
+
+const (
+	KindAzurebot Kind = "azurebot"
+	KindBot      Kind = "bot"
+	KindDesigner Kind = "designer"
+	KindFunction Kind = "function"
+	KindSdk      Kind = "sdk"
+)
+
+func PossibleValuesForKind() []string {
+	return []string{
+		string(KindAzurebot),
+		string(KindBot),
+		string(KindDesigner),
+		string(KindFunction),
+		string(KindSdk),
+	}
+}
+
+func parseKind(input string) (*Kind, error) {
+	vals := map[string]Kind{
+		"azurebot": KindAzurebot,
+		"bot":      KindBot,
+		"designer": KindDesigner,
+		"function": KindFunction,
+		"sdk":      KindSdk,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Kind(input)
+	return &out, nil
+}
+
+type MsaAppType string
+
+const (
+	MsaAppTypeMultiTenant     MsaAppType = "MultiTenant"
+	MsaAppTypeSingleTenant    MsaAppType = "SingleTenant"
+	MsaAppTypeUserAssignedMSI MsaAppType = "UserAssignedMSI"
+)
+
+func PossibleValuesForMsaAppType() []string {
+	return []string{
+		string(MsaAppTypeMultiTenant),
+		string(MsaAppTypeSingleTenant),
+		string(MsaAppTypeUserAssignedMSI),
+	}
+}
+
+func parseMsaAppType(input string) (*MsaAppType, error) {
+	vals := map[string]MsaAppType{
+		"multitenant":     MsaAppTypeMultiTenant,
+		"singletenant":    MsaAppTypeSingleTenant,
+		"userassignedmsi": MsaAppTypeUserAssignedMSI,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := MsaAppType(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameF0 SkuName = "F0"
+	SkuNameS1 SkuName = "S1"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameF0),
+		string(SkuNameS1),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"f0": SkuNameF0,
+		"s1": SkuNameS1,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
+type SkuTier string
+
+const (
+	SkuTierFree     SkuTier = "Free"
+	SkuTierStandard SkuTier = "Standard"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierFree),
+		string(SkuTierStandard),
+	}
+}
+
+func parseSkuTier(input string) (*SkuTier, error) {
+	vals := map[string]SkuTier{
+		"free":     SkuTierFree,
+		"standard": SkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuTier(input)
+	return &out, nil
+}
@@ -0,0 +1,14 @@
+package bot
+
+type Bot struct {
+	Etag       *string            `json:"etag,omitempty"`
+	Id         *string            `json:"id,omitempty"`
+	Kind       *Kind              `json:"kind,omitempty"`
+	Location   *string            `json:"location,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *BotProperties     `json:"properties,omitempty"`
+	Sku        *Sku               `json:"sku,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+	Zones      *[]string          `json:"zones,omitempty"`
+}
@@ -0,0 +1,6 @@
+package bot
+
+type Sku struct {
+	Name SkuName  `json:"name"`
+	Tier *SkuTier `json:"tier,omitempty"`
+}
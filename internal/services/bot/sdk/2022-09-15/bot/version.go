@@ -0,0 +1,9 @@
+package bot
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/bot/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package channel
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ChannelClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewChannelClientWithBaseURI(endpoint string) ChannelClient {
+	return ChannelClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
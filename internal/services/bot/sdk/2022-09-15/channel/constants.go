@@ -0,0 +1,110 @@
+package channel
+
+import "strings"
+
+type ChannelName string
+
+const (
+	ChannelNameAlexaChannel            ChannelName = "AlexaChannel"
+	ChannelNameDirectLineChannel       ChannelName = "DirectLineChannel"
+	ChannelNameDirectLineSpeechChannel ChannelName = "DirectLineSpeechChannel"
+	ChannelNameEmailChannel            ChannelName = "EmailChannel"
+	ChannelNameFacebookChannel         ChannelName = "FacebookChannel"
+	ChannelNameKikChannel              ChannelName = "KikChannel"
+	ChannelNameLineChannel             ChannelName = "LineChannel"
+	ChannelNameMsTeamsChannel          ChannelName = "MsTeamsChannel"
+	ChannelNameOmnichannel             ChannelName = "Omnichannel"
+	ChannelNameOutlookChannel          ChannelName = "OutlookChannel"
+	ChannelNameSkypeChannel            ChannelName = "SkypeChannel"
+	ChannelNameSlackChannel            ChannelName = "SlackChannel"
+	ChannelNameSmsChannel              ChannelName = "SmsChannel"
+	ChannelNameTelegramChannel         ChannelName = "TelegramChannel"
+	ChannelNameTelephonyChannel        ChannelName = "TelephonyChannel"
+	ChannelNameWebChatChannel          ChannelName = "WebChatChannel"
+)
+
+func PossibleValuesForChannelName() []string {
+	return []string{
+		string(ChannelNameAlexaChannel),
+		string(ChannelNameDirectLineChannel),
+		string(ChannelNameDirectLineSpeechChannel),
+		string(ChannelNameEmailChannel),
+		string(ChannelNameFacebookChannel),
+		string(ChannelNameKikChannel),
+		string(ChannelNameLineChannel),
+		string(ChannelNameMsTeamsChannel),
+		string(ChannelNameOmnichannel),
+		string(ChannelNameOutlookChannel),
+		string(ChannelNameSkypeChannel),
+		string(ChannelNameSlackChannel),
+		string(ChannelNameSmsChannel),
+		string(ChannelNameTelegramChannel),
+		string(ChannelNameTelephonyChannel),
+		string(ChannelNameWebChatChannel),
+	}
+}
+
+func parseChannelName(input string) (*ChannelName, error) {
+	vals := map[string]ChannelName{
+		"alexachannel":            ChannelNameAlexaChannel,
+		"directlinechannel":       ChannelNameDirectLineChannel,
+		"directlinespeechchannel": ChannelNameDirectLineSpeechChannel,
+		"emailchannel":            ChannelNameEmailChannel,
+		"facebookchannel":         ChannelNameFacebookChannel,
+		"kikchannel":              ChannelNameKikChannel,
+		"linechannel":             ChannelNameLineChannel,
+		"msteamschannel":          ChannelNameMsTeamsChannel,
+		"omnichannel":             ChannelNameOmnichannel,
+		"outlookchannel":          ChannelNameOutlookChannel,
+		"skypechannel":            ChannelNameSkypeChannel,
+		"slackchannel":            ChannelNameSlackChannel,
+		"smschannel":              ChannelNameSmsChannel,
+		"telegramchannel":         ChannelNameTelegramChannel,
+		"telephonychannel":        ChannelNameTelephonyChannel,
+		"webchatchannel":          ChannelNameWebChatChannel,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ChannelName(input)
+	return &out, nil
+}
+
+type Kind string
+
+const (
+	KindAzurebot Kind = "azurebot"
+	KindBot      Kind = "bot"
+	KindDesigner Kind = "designer"
+	KindFunction Kind = "function"
+	KindSdk      Kind = "sdk"
+)
+
+func PossibleValuesForKind() []string {
+	return []string{
+		string(KindAzurebot),
+		string(KindBot),
+		string(KindDesigner),
+		string(KindFunction),
+		string(KindSdk),
+	}
+}
+
+func parseKind(input string) (*Kind, error) {
+	vals := map[string]Kind{
+		"azurebot": KindAzurebot,
+		"bot":      KindBot,
+		"designer": KindDesigner,
+		"function": KindFunction,
+		"sdk":      KindSdk,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Kind(input)
+	return &out, nil
+}
@@ -0,0 +1,65 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListWithKeysResponse struct {
+	HttpResponse *http.Response
+	Model        *ListChannelWithKeysResponse
+}
+
+// ListWithKeys ...
+func (c ChannelClient) ListWithKeys(ctx context.Context, id ChannelId) (result ListWithKeysResponse, err error) {
+	req, err := c.preparerForListWithKeys(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "channel.ChannelClient", "ListWithKeys", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "channel.ChannelClient", "ListWithKeys", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListWithKeys(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "channel.ChannelClient", "ListWithKeys", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListWithKeys prepares the ListWithKeys request.
+func (c ChannelClient) preparerForListWithKeys(ctx context.Context, id ChannelId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listChannelWithKeys", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListWithKeys handles the response to the ListWithKeys request. The method always
+// closes the http.Response Body.
+func (c ChannelClient) responderForListWithKeys(resp *http.Response) (result ListWithKeysResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
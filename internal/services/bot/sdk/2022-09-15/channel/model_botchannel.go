@@ -0,0 +1,51 @@
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type BotChannel struct {
+	Etag       *string            `json:"etag,omitempty"`
+	Id         *string            `json:"id,omitempty"`
+	Kind       *Kind              `json:"kind,omitempty"`
+	Location   *string            `json:"location,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties Channel            `json:"properties"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+	Zones      *[]string          `json:"zones,omitempty"`
+}
+
+var _ json.Unmarshaler = &BotChannel{}
+
+func (s *BotChannel) UnmarshalJSON(bytes []byte) error {
+	type alias BotChannel
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into BotChannel: %+v", err)
+	}
+
+	s.Etag = decoded.Etag
+	s.Id = decoded.Id
+	s.Kind = decoded.Kind
+	s.Location = decoded.Location
+	s.Name = decoded.Name
+	s.Tags = decoded.Tags
+	s.Type = decoded.Type
+	s.Zones = decoded.Zones
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling BotChannel into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalChannelImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'BotChannel': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
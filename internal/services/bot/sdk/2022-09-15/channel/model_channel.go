@@ -0,0 +1,61 @@
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type Channel interface {
+}
+
+func unmarshalChannelImplementation(input []byte) (Channel, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling Channel into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["channelName"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "DirectLineChannel") {
+		var out DirectLineChannel
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into DirectLineChannel: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "DirectLineSpeechChannel") {
+		var out DirectLineSpeechChannel
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into DirectLineSpeechChannel: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "MsTeamsChannel") {
+		var out MsTeamsChannel
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into MsTeamsChannel: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawChannelImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawChannelImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
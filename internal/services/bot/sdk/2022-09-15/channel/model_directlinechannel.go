@@ -0,0 +1,41 @@
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Channel = DirectLineChannel{}
+
+type DirectLineChannel struct {
+	Etag              *string                      `json:"etag,omitempty"`
+	Location          *string                      `json:"location,omitempty"`
+	Properties        *DirectLineChannelProperties `json:"properties,omitempty"`
+	ProvisioningState *string                      `json:"provisioningState,omitempty"`
+
+	// Fields inherited from Channel
+}
+
+var _ json.Marshaler = DirectLineChannel{}
+
+func (s DirectLineChannel) MarshalJSON() ([]byte, error) {
+	type wrapper DirectLineChannel
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling DirectLineChannel: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling DirectLineChannel: %+v", err)
+	}
+	decoded["channelName"] = "DirectLineChannel"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling DirectLineChannel: %+v", err)
+	}
+
+	return encoded, nil
+}
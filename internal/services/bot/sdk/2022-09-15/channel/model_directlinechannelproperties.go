@@ -0,0 +1,8 @@
+package channel
+
+type DirectLineChannelProperties struct {
+	DirectLineEmbedCode *string           `json:"DirectLineEmbedCode,omitempty"`
+	ExtensionKey1       *string           `json:"extensionKey1,omitempty"`
+	ExtensionKey2       *string           `json:"extensionKey2,omitempty"`
+	Sites               *[]DirectLineSite `json:"sites,omitempty"`
+}
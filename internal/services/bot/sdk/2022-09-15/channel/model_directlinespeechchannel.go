@@ -0,0 +1,41 @@
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Channel = DirectLineSpeechChannel{}
+
+type DirectLineSpeechChannel struct {
+	Etag              *string                            `json:"etag,omitempty"`
+	Location          *string                            `json:"location,omitempty"`
+	Properties        *DirectLineSpeechChannelProperties `json:"properties,omitempty"`
+	ProvisioningState *string                            `json:"provisioningState,omitempty"`
+
+	// Fields inherited from Channel
+}
+
+var _ json.Marshaler = DirectLineSpeechChannel{}
+
+func (s DirectLineSpeechChannel) MarshalJSON() ([]byte, error) {
+	type wrapper DirectLineSpeechChannel
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling DirectLineSpeechChannel: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling DirectLineSpeechChannel: %+v", err)
+	}
+	decoded["channelName"] = "DirectLineSpeechChannel"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling DirectLineSpeechChannel: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,11 @@
+package channel
+
+type DirectLineSpeechChannelProperties struct {
+	CognitiveServiceRegion          *string `json:"cognitiveServiceRegion,omitempty"`
+	CognitiveServiceResourceId      *string `json:"cognitiveServiceResourceId,omitempty"`
+	CognitiveServiceSubscriptionKey *string `json:"cognitiveServiceSubscriptionKey,omitempty"`
+	CustomSpeechModelId             *string `json:"customSpeechModelId,omitempty"`
+	CustomVoiceDeploymentId         *string `json:"customVoiceDeploymentId,omitempty"`
+	IsDefaultBotForCogSvcAccount    *bool   `json:"isDefaultBotForCogSvcAccount,omitempty"`
+	IsEnabled                       *bool   `json:"isEnabled,omitempty"`
+}
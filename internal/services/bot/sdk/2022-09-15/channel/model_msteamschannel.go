@@ -0,0 +1,41 @@
+package channel
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Channel = MsTeamsChannel{}
+
+type MsTeamsChannel struct {
+	Etag              *string                   `json:"etag,omitempty"`
+	Location          *string                   `json:"location,omitempty"`
+	Properties        *MsTeamsChannelProperties `json:"properties,omitempty"`
+	ProvisioningState *string                   `json:"provisioningState,omitempty"`
+
+	// Fields inherited from Channel
+}
+
+var _ json.Marshaler = MsTeamsChannel{}
+
+func (s MsTeamsChannel) MarshalJSON() ([]byte, error) {
+	type wrapper MsTeamsChannel
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling MsTeamsChannel: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling MsTeamsChannel: %+v", err)
+	}
+	decoded["channelName"] = "MsTeamsChannel"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling MsTeamsChannel: %+v", err)
+	}
+
+	return encoded, nil
+}
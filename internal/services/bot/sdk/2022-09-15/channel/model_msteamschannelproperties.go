@@ -0,0 +1,10 @@
+package channel
+
+type MsTeamsChannelProperties struct {
+	AcceptedTerms         *bool   `json:"acceptedTerms,omitempty"`
+	CallingWebhook        *string `json:"callingWebhook,omitempty"`
+	DeploymentEnvironment *string `json:"deploymentEnvironment,omitempty"`
+	EnableCalling         *bool   `json:"enableCalling,omitempty"`
+	IncomingCallRoute     *string `json:"incomingCallRoute,omitempty"`
+	IsEnabled             bool    `json:"isEnabled"`
+}
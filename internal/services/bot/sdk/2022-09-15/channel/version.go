@@ -0,0 +1,9 @@
+package channel
+
+import "fmt"
+
+const defaultApiVersion = "2022-09-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/channel/%s", defaultApiVersion)
+}
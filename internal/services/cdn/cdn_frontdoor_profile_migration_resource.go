@@ -0,0 +1,270 @@
+package cdn
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/sdk/2024-09-01/profiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCdnFrontDoorProfileMigration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCdnFrontDoorProfileMigrationCreate,
+		Read:   resourceCdnFrontDoorProfileMigrationRead,
+		Update: resourceCdnFrontDoorProfileMigrationUpdate,
+		Delete: resourceCdnFrontDoorProfileMigrationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := profiles.ParseProfileID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// once committed the Classic CDN Profile is gone, so there's no way back
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if diff.Id() == "" || !diff.HasChange("commit") {
+					return nil
+				}
+				old, new := diff.GetChange("commit")
+				if old.(bool) && !new.(bool) {
+					return fmt.Errorf("`commit` cannot be set to `false` once the migration has been committed")
+				}
+				return nil
+			}),
+		),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"cdn_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: profiles.ValidateProfileID,
+			},
+
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(profiles.SkuNameStandardAzureFrontDoor),
+					string(profiles.SkuNamePremiumAzureFrontDoor),
+				}, false),
+			},
+
+			"endpoint_mapping": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"migrated_from": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"migrated_to": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"commit": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"resource_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdnFrontDoorProfileMigrationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Get("cdn_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if model := existing.Model; model != nil && model.Properties != nil && model.Properties.ResourceState != nil {
+		switch *model.Properties.ResourceState {
+		case profiles.ProfileResourceStateMigrating, profiles.ProfileResourceStatePendingMigrationCommit, profiles.ProfileResourceStateCommittingMigration, profiles.ProfileResourceStateMigrated:
+			return tf.ImportAsExistsError("azurerm_cdn_frontdoor_profile_migration", id.ID())
+		}
+	}
+
+	skuName := profiles.SkuName(d.Get("sku_name").(string))
+	payload := profiles.CdnMigrationToAfdParameters{
+		Sku: profiles.Sku{
+			Name: &skuName,
+		},
+		MigrationEndpointMappings: expandCdnFrontDoorProfileMigrationEndpointMappings(d.Get("endpoint_mapping").([]interface{})),
+	}
+
+	if err := client.CdnMigrateToAfdThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("migrating %s to Front Door: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if d.Get("commit").(bool) {
+		if err := client.MigrationCommitThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("committing the migration of %s: %+v", *id, err)
+		}
+	}
+
+	return resourceCdnFrontDoorProfileMigrationRead(d, meta)
+}
+
+func resourceCdnFrontDoorProfileMigrationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("cdn_profile_id", id.ID())
+
+	if model := resp.Model; model != nil {
+		resourceState := ""
+		if props := model.Properties; props != nil && props.ResourceState != nil {
+			resourceState = string(*props.ResourceState)
+		}
+		d.Set("resource_state", resourceState)
+
+		// the migration was aborted (or never happened) outside of Terraform, so this needs to be re-created
+		if model.Sku.Name != nil && *model.Sku.Name != profiles.SkuNameStandardAzureFrontDoor && *model.Sku.Name != profiles.SkuNamePremiumAzureFrontDoor && resourceState == string(profiles.ProfileResourceStateActive) {
+			log.Printf("[DEBUG] %s is no longer being migrated - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		if model.Sku.Name != nil && (*model.Sku.Name == profiles.SkuNameStandardAzureFrontDoor || *model.Sku.Name == profiles.SkuNamePremiumAzureFrontDoor) {
+			d.Set("sku_name", string(*model.Sku.Name))
+		}
+
+		// until the migration's committed the profile sits in one of the interim migration states
+		committed := true
+		switch profiles.ProfileResourceState(resourceState) {
+		case profiles.ProfileResourceStateMigrating, profiles.ProfileResourceStatePendingMigrationCommit, profiles.ProfileResourceStateAbortingMigration:
+			committed = false
+		}
+		d.Set("commit", committed)
+	}
+
+	return nil
+}
+
+func resourceCdnFrontDoorProfileMigrationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("commit") && d.Get("commit").(bool) {
+		if err := client.MigrationCommitThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("committing the migration of %s: %+v", *id, err)
+		}
+	}
+
+	return resourceCdnFrontDoorProfileMigrationRead(d, meta)
+}
+
+func resourceCdnFrontDoorProfileMigrationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cdn.FrontDoorProfilesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := profiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// a migration which hasn't been committed yet can be rolled back, once committed the
+	// Front Door Profile is managed separately and all we can do is remove this from the state
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.ResourceState != nil {
+		if *model.Properties.ResourceState == profiles.ProfileResourceStatePendingMigrationCommit {
+			if err := client.MigrationAbortThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("aborting the migration of %s: %+v", *id, err)
+			}
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] the migration of %s has been committed - removing from state", *id)
+	return nil
+}
+
+func expandCdnFrontDoorProfileMigrationEndpointMappings(input []interface{}) *[]profiles.MigrationEndpointMapping {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]profiles.MigrationEndpointMapping, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, profiles.MigrationEndpointMapping{
+			MigratedFrom: utils.String(v["migrated_from"].(string)),
+			MigratedTo:   utils.String(v["migrated_to"].(string)),
+		})
+	}
+
+	return &results
+}
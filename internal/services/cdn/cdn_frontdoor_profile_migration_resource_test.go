@@ -0,0 +1,175 @@
+package cdn_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/sdk/2024-09-01/profiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CdnFrontDoorProfileMigrationResource struct{}
+
+func TestAccCdnFrontDoorProfileMigration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_profile_migration", "test")
+	r := CdnFrontDoorProfileMigrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_state").HasValue("PendingMigrationCommit"),
+			),
+		},
+		data.ImportStep("endpoint_mapping"),
+	})
+}
+
+func TestAccCdnFrontDoorProfileMigration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_profile_migration", "test")
+	r := CdnFrontDoorProfileMigrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCdnFrontDoorProfileMigration_commit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_profile_migration", "test")
+	r := CdnFrontDoorProfileMigrationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("endpoint_mapping"),
+		{
+			Config: r.committed(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_state").HasValue("Active"),
+			),
+		},
+		data.ImportStep("endpoint_mapping"),
+	})
+}
+
+func (r CdnFrontDoorProfileMigrationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := profiles.ParseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Cdn.FrontDoorProfilesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Sku.Name == nil {
+		return utils.Bool(false), nil
+	}
+
+	skuName := *resp.Model.Sku.Name
+	return utils.Bool(skuName == profiles.SkuNameStandardAzureFrontDoor || skuName == profiles.SkuNamePremiumAzureFrontDoor), nil
+}
+
+func (r CdnFrontDoorProfileMigrationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = azurerm_cdn_profile.test.name
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.contoso.com"
+    https_port = 443
+    http_port  = 80
+  }
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r CdnFrontDoorProfileMigrationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_profile_migration" "test" {
+  cdn_profile_id = azurerm_cdn_profile.test.id
+  sku_name       = "Standard_AzureFrontDoor"
+
+  endpoint_mapping {
+    migrated_from = azurerm_cdn_endpoint.test.name
+    migrated_to   = "acctestafdend%d"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CdnFrontDoorProfileMigrationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_profile_migration" "import" {
+  cdn_profile_id = azurerm_cdn_frontdoor_profile_migration.test.cdn_profile_id
+  sku_name       = azurerm_cdn_frontdoor_profile_migration.test.sku_name
+}
+`, r.basic(data))
+}
+
+func (r CdnFrontDoorProfileMigrationResource) committed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_profile_migration" "test" {
+  cdn_profile_id = azurerm_cdn_profile.test.id
+  sku_name       = "Standard_AzureFrontDoor"
+  commit         = true
+
+  endpoint_mapping {
+    migrated_from = azurerm_cdn_endpoint.test.name
+    migrated_to   = "acctestafdend%d"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
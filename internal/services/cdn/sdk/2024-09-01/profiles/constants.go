@@ -0,0 +1,150 @@
+package profiles
+
+import "strings"
+
+type ProfileProvisioningState string
+
+const (
+	ProfileProvisioningStateCreating  ProfileProvisioningState = "Creating"
+	ProfileProvisioningStateDeleting  ProfileProvisioningState = "Deleting"
+	ProfileProvisioningStateFailed    ProfileProvisioningState = "Failed"
+	ProfileProvisioningStateSucceeded ProfileProvisioningState = "Succeeded"
+	ProfileProvisioningStateUpdating  ProfileProvisioningState = "Updating"
+)
+
+func PossibleValuesForProfileProvisioningState() []string {
+	return []string{
+		string(ProfileProvisioningStateCreating),
+		string(ProfileProvisioningStateDeleting),
+		string(ProfileProvisioningStateFailed),
+		string(ProfileProvisioningStateSucceeded),
+		string(ProfileProvisioningStateUpdating),
+	}
+}
+
+func parseProfileProvisioningState(input string) (*ProfileProvisioningState, error) {
+	vals := map[string]ProfileProvisioningState{
+		"creating":  ProfileProvisioningStateCreating,
+		"deleting":  ProfileProvisioningStateDeleting,
+		"failed":    ProfileProvisioningStateFailed,
+		"succeeded": ProfileProvisioningStateSucceeded,
+		"updating":  ProfileProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProfileProvisioningState(input)
+	return &out, nil
+}
+
+type ProfileResourceState string
+
+const (
+	ProfileResourceStateAbortingMigration      ProfileResourceState = "AbortingMigration"
+	ProfileResourceStateActive                 ProfileResourceState = "Active"
+	ProfileResourceStateCommittingMigration    ProfileResourceState = "CommittingMigration"
+	ProfileResourceStateCreating               ProfileResourceState = "Creating"
+	ProfileResourceStateDeleting               ProfileResourceState = "Deleting"
+	ProfileResourceStateDisabled               ProfileResourceState = "Disabled"
+	ProfileResourceStateMigrated               ProfileResourceState = "Migrated"
+	ProfileResourceStateMigrating              ProfileResourceState = "Migrating"
+	ProfileResourceStatePendingMigrationCommit ProfileResourceState = "PendingMigrationCommit"
+)
+
+func PossibleValuesForProfileResourceState() []string {
+	return []string{
+		string(ProfileResourceStateAbortingMigration),
+		string(ProfileResourceStateActive),
+		string(ProfileResourceStateCommittingMigration),
+		string(ProfileResourceStateCreating),
+		string(ProfileResourceStateDeleting),
+		string(ProfileResourceStateDisabled),
+		string(ProfileResourceStateMigrated),
+		string(ProfileResourceStateMigrating),
+		string(ProfileResourceStatePendingMigrationCommit),
+	}
+}
+
+func parseProfileResourceState(input string) (*ProfileResourceState, error) {
+	vals := map[string]ProfileResourceState{
+		"abortingmigration":      ProfileResourceStateAbortingMigration,
+		"active":                 ProfileResourceStateActive,
+		"committingmigration":    ProfileResourceStateCommittingMigration,
+		"creating":               ProfileResourceStateCreating,
+		"deleting":               ProfileResourceStateDeleting,
+		"disabled":               ProfileResourceStateDisabled,
+		"migrated":               ProfileResourceStateMigrated,
+		"migrating":              ProfileResourceStateMigrating,
+		"pendingmigrationcommit": ProfileResourceStatePendingMigrationCommit,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProfileResourceState(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameCustomVerizon                    SkuName = "Custom_Verizon"
+	SkuNamePremiumAzureFrontDoor            SkuName = "Premium_AzureFrontDoor"
+	SkuNamePremiumVerizon                   SkuName = "Premium_Verizon"
+	SkuNameStandardPlus955BandWidthChinaCdn SkuName = "StandardPlus_955BandWidth_ChinaCdn"
+	SkuNameStandardPlusAvgBandWidthChinaCdn SkuName = "StandardPlus_AvgBandWidth_ChinaCdn"
+	SkuNameStandardPlusChinaCdn             SkuName = "StandardPlus_ChinaCdn"
+	SkuNameStandard955BandWidthChinaCdn     SkuName = "Standard_955BandWidth_ChinaCdn"
+	SkuNameStandardAkamai                   SkuName = "Standard_Akamai"
+	SkuNameStandardAvgBandWidthChinaCdn     SkuName = "Standard_AvgBandWidth_ChinaCdn"
+	SkuNameStandardAzureFrontDoor           SkuName = "Standard_AzureFrontDoor"
+	SkuNameStandardChinaCdn                 SkuName = "Standard_ChinaCdn"
+	SkuNameStandardMicrosoft                SkuName = "Standard_Microsoft"
+	SkuNameStandardVerizon                  SkuName = "Standard_Verizon"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameCustomVerizon),
+		string(SkuNamePremiumAzureFrontDoor),
+		string(SkuNamePremiumVerizon),
+		string(SkuNameStandardPlus955BandWidthChinaCdn),
+		string(SkuNameStandardPlusAvgBandWidthChinaCdn),
+		string(SkuNameStandardPlusChinaCdn),
+		string(SkuNameStandard955BandWidthChinaCdn),
+		string(SkuNameStandardAkamai),
+		string(SkuNameStandardAvgBandWidthChinaCdn),
+		string(SkuNameStandardAzureFrontDoor),
+		string(SkuNameStandardChinaCdn),
+		string(SkuNameStandardMicrosoft),
+		string(SkuNameStandardVerizon),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"custom_verizon":                     SkuNameCustomVerizon,
+		"premium_azurefrontdoor":             SkuNamePremiumAzureFrontDoor,
+		"premium_verizon":                    SkuNamePremiumVerizon,
+		"standardplus_955bandwidth_chinacdn": SkuNameStandardPlus955BandWidthChinaCdn,
+		"standardplus_avgbandwidth_chinacdn": SkuNameStandardPlusAvgBandWidthChinaCdn,
+		"standardplus_chinacdn":              SkuNameStandardPlusChinaCdn,
+		"standard_955bandwidth_chinacdn":     SkuNameStandard955BandWidthChinaCdn,
+		"standard_akamai":                    SkuNameStandardAkamai,
+		"standard_avgbandwidth_chinacdn":     SkuNameStandardAvgBandWidthChinaCdn,
+		"standard_azurefrontdoor":            SkuNameStandardAzureFrontDoor,
+		"standard_chinacdn":                  SkuNameStandardChinaCdn,
+		"standard_microsoft":                 SkuNameStandardMicrosoft,
+		"standard_verizon":                   SkuNameStandardVerizon,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
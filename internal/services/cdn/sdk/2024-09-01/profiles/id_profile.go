@@ -0,0 +1,124 @@
+package profiles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ProfileId{}
+
+// ProfileId is a struct representing the Resource ID for a Profile
+type ProfileId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	ProfileName       string
+}
+
+// NewProfileID returns a new ProfileId struct
+func NewProfileID(subscriptionId string, resourceGroupName string, profileName string) ProfileId {
+	return ProfileId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		ProfileName:       profileName,
+	}
+}
+
+// ParseProfileID parses 'input' into a ProfileId
+func ParseProfileID(input string) (*ProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ProfileId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ProfileName, ok = parsed.Parsed["profileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'profileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseProfileIDInsensitively parses 'input' case-insensitively into a ProfileId
+// note: this method should only be used for API response data and not user input
+func ParseProfileIDInsensitively(input string) (*ProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ProfileId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ProfileName, ok = parsed.Parsed["profileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'profileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateProfileID checks that 'input' can be parsed as a Profile ID
+func ValidateProfileID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseProfileID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Profile ID
+func (id ProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ProfileName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Profile ID
+func (id ProfileId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn", "Microsoft.Cdn"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profileValue"),
+	}
+}
+
+// String returns a human-readable description of this Profile ID
+func (id ProfileId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Profile Name: %q", id.ProfileName),
+	}
+	return fmt.Sprintf("Profile (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,75 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CdnMigrateToAfdResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CdnMigrateToAfd ...
+func (c ProfilesClient) CdnMigrateToAfd(ctx context.Context, id ProfileId, input CdnMigrationToAfdParameters) (result CdnMigrateToAfdResponse, err error) {
+	req, err := c.preparerForCdnMigrateToAfd(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "profiles.ProfilesClient", "CdnMigrateToAfd", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCdnMigrateToAfd(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "profiles.ProfilesClient", "CdnMigrateToAfd", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CdnMigrateToAfdThenPoll performs CdnMigrateToAfd then polls until it's completed
+func (c ProfilesClient) CdnMigrateToAfdThenPoll(ctx context.Context, id ProfileId, input CdnMigrationToAfdParameters) error {
+	result, err := c.CdnMigrateToAfd(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CdnMigrateToAfd: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CdnMigrateToAfd: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCdnMigrateToAfd prepares the CdnMigrateToAfd request.
+func (c ProfilesClient) preparerForCdnMigrateToAfd(ctx context.Context, id ProfileId, input CdnMigrationToAfdParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/cdnMigrateToAfd", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCdnMigrateToAfd sends the CdnMigrateToAfd request. The method will close the
+// http.Response Body if it receives an error.
+func (c ProfilesClient) senderForCdnMigrateToAfd(ctx context.Context, req *http.Request) (future CdnMigrateToAfdResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
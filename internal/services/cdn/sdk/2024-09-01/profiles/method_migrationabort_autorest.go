@@ -0,0 +1,74 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type MigrationAbortResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// MigrationAbort ...
+func (c ProfilesClient) MigrationAbort(ctx context.Context, id ProfileId) (result MigrationAbortResponse, err error) {
+	req, err := c.preparerForMigrationAbort(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "profiles.ProfilesClient", "MigrationAbort", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForMigrationAbort(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "profiles.ProfilesClient", "MigrationAbort", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// MigrationAbortThenPoll performs MigrationAbort then polls until it's completed
+func (c ProfilesClient) MigrationAbortThenPoll(ctx context.Context, id ProfileId) error {
+	result, err := c.MigrationAbort(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing MigrationAbort: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after MigrationAbort: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForMigrationAbort prepares the MigrationAbort request.
+func (c ProfilesClient) preparerForMigrationAbort(ctx context.Context, id ProfileId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/migrationAbort", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForMigrationAbort sends the MigrationAbort request. The method will close the
+// http.Response Body if it receives an error.
+func (c ProfilesClient) senderForMigrationAbort(ctx context.Context, req *http.Request) (future MigrationAbortResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
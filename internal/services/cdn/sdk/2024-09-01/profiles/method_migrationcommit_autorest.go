@@ -0,0 +1,74 @@
+package profiles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type MigrationCommitResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// MigrationCommit ...
+func (c ProfilesClient) MigrationCommit(ctx context.Context, id ProfileId) (result MigrationCommitResponse, err error) {
+	req, err := c.preparerForMigrationCommit(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "profiles.ProfilesClient", "MigrationCommit", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForMigrationCommit(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "profiles.ProfilesClient", "MigrationCommit", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// MigrationCommitThenPoll performs MigrationCommit then polls until it's completed
+func (c ProfilesClient) MigrationCommitThenPoll(ctx context.Context, id ProfileId) error {
+	result, err := c.MigrationCommit(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing MigrationCommit: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after MigrationCommit: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForMigrationCommit prepares the MigrationCommit request.
+func (c ProfilesClient) preparerForMigrationCommit(ctx context.Context, id ProfileId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/migrationCommit", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForMigrationCommit sends the MigrationCommit request. The method will close the
+// http.Response Body if it receives an error.
+func (c ProfilesClient) senderForMigrationCommit(ctx context.Context, req *http.Request) (future MigrationCommitResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,6 @@
+package profiles
+
+type CdnMigrationToAfdParameters struct {
+	MigrationEndpointMappings *[]MigrationEndpointMapping `json:"migrationEndpointMappings,omitempty"`
+	Sku                       Sku                         `json:"sku"`
+}
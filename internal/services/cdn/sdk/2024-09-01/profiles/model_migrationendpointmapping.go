@@ -0,0 +1,6 @@
+package profiles
+
+type MigrationEndpointMapping struct {
+	MigratedFrom *string `json:"migratedFrom,omitempty"`
+	MigratedTo   *string `json:"migratedTo,omitempty"`
+}
@@ -0,0 +1,9 @@
+package profiles
+
+type ProfileProperties struct {
+	ExtendedProperties           *map[string]string        `json:"extendedProperties,omitempty"`
+	FrontDoorId                  *string                   `json:"frontDoorId,omitempty"`
+	OriginResponseTimeoutSeconds *int64                    `json:"originResponseTimeoutSeconds,omitempty"`
+	ProvisioningState            *ProfileProvisioningState `json:"provisioningState,omitempty"`
+	ResourceState                *ProfileResourceState     `json:"resourceState,omitempty"`
+}
@@ -0,0 +1,9 @@
+package profiles
+
+import "fmt"
+
+const defaultApiVersion = "2024-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/profiles/%s", defaultApiVersion)
+}
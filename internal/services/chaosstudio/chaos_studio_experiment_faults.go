@@ -0,0 +1,330 @@
+package chaosstudio
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// The typed fault blocks below are a convenience over the generic `urn` and `parameters` fields of an action,
+// each maps to a single capability URN and a known set of parameters.
+
+const (
+	chaosStudioFaultUrnCpuPressure    = "urn:csci:microsoft:agent:cpuPressure/1.0"
+	chaosStudioFaultUrnNetworkLatency = "urn:csci:microsoft:agent:networkLatency/1.1"
+
+	chaosStudioAksChaosMeshUrnFormat = "urn:csci:microsoft:azureKubernetesServiceChaosMesh:%s/2.1"
+)
+
+var chaosStudioAksChaosMeshUrnRegex = regexp.MustCompile(`^urn:csci:microsoft:azureKubernetesServiceChaosMesh:([A-Za-z]+)/2\.1$`)
+
+type ChaosStudioCpuPressureFault struct {
+	PressureLevel int `tfschema:"pressure_level"`
+}
+
+type ChaosStudioNetworkLatencyFault struct {
+	LatencyInMilliseconds int                                   `tfschema:"latency_in_milliseconds"`
+	DestinationFilter     []ChaosStudioNetworkDestinationFilter `tfschema:"destination_filter"`
+}
+
+type ChaosStudioNetworkDestinationFilter struct {
+	Address    string `tfschema:"address"`
+	SubnetMask int    `tfschema:"subnet_mask"`
+	PortLow    int    `tfschema:"port_low"`
+	PortHigh   int    `tfschema:"port_high"`
+}
+
+type ChaosStudioAksChaosMeshFault struct {
+	FaultType string `tfschema:"fault_type"`
+	SpecJson  string `tfschema:"spec_json"`
+}
+
+// chaosStudioNetworkDestinationFilter is the JSON representation of a destination filter within the
+// `destinationFilters` parameter of the network fault
+type chaosStudioNetworkDestinationFilter struct {
+	Address    string `json:"address"`
+	SubnetMask int    `json:"subnetMask"`
+	PortLow    *int   `json:"portLow,omitempty"`
+	PortHigh   *int   `json:"portHigh,omitempty"`
+}
+
+func chaosStudioAksChaosMeshFaultTypes() []string {
+	return []string{
+		"dnsChaos",
+		"httpChaos",
+		"ioChaos",
+		"kernelChaos",
+		"networkChaos",
+		"podChaos",
+		"stressChaos",
+		"timeChaos",
+	}
+}
+
+func schemaChaosStudioCpuPressureFault() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"pressure_level": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 99),
+				},
+			},
+		},
+	}
+}
+
+func schemaChaosStudioNetworkLatencyFault() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"latency_in_milliseconds": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+
+				"destination_filter": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"address": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+
+							"subnet_mask": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(0, 32),
+							},
+
+							"port_low": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+
+							"port_high": {
+								Type:         pluginsdk.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaChaosStudioAksChaosMeshFault() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"fault_type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(chaosStudioAksChaosMeshFaultTypes(), false),
+				},
+
+				"spec_json": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsJSON,
+					StateFunc:    utils.NormalizeJson,
+				},
+			},
+		},
+	}
+}
+
+// expandChaosStudioActionFault returns the URN and parameters for an action, either from one of the typed fault
+// blocks or from the `urn` and `parameters` fields
+func expandChaosStudioActionFault(input ChaosStudioExperimentAction) (string, []experiments.KeyValuePair, error) {
+	parameters := make([]experiments.KeyValuePair, 0)
+
+	typedFaults := 0
+	urn := ""
+	if len(input.CpuPressure) > 0 {
+		typedFaults++
+		urn = chaosStudioFaultUrnCpuPressure
+		parameters = append(parameters, experiments.KeyValuePair{
+			Key:   "pressureLevel",
+			Value: strconv.Itoa(input.CpuPressure[0].PressureLevel),
+		})
+	}
+
+	if len(input.NetworkLatency) > 0 {
+		typedFaults++
+		urn = chaosStudioFaultUrnNetworkLatency
+
+		fault := input.NetworkLatency[0]
+		filters := make([]chaosStudioNetworkDestinationFilter, 0)
+		for _, v := range fault.DestinationFilter {
+			filter := chaosStudioNetworkDestinationFilter{
+				Address:    v.Address,
+				SubnetMask: v.SubnetMask,
+			}
+			if v.PortLow != 0 {
+				filter.PortLow = utils.Int(v.PortLow)
+			}
+			if v.PortHigh != 0 {
+				filter.PortHigh = utils.Int(v.PortHigh)
+			}
+			filters = append(filters, filter)
+		}
+		encoded, err := json.Marshal(filters)
+		if err != nil {
+			return "", nil, fmt.Errorf("marshaling `destination_filter`: %+v", err)
+		}
+
+		parameters = append(parameters, experiments.KeyValuePair{
+			Key:   "latencyInMilliseconds",
+			Value: strconv.Itoa(fault.LatencyInMilliseconds),
+		}, experiments.KeyValuePair{
+			Key:   "destinationFilters",
+			Value: string(encoded),
+		})
+	}
+
+	if len(input.AksChaosMesh) > 0 {
+		typedFaults++
+		fault := input.AksChaosMesh[0]
+		urn = fmt.Sprintf(chaosStudioAksChaosMeshUrnFormat, fault.FaultType)
+		parameters = append(parameters, experiments.KeyValuePair{
+			Key:   "jsonSpec",
+			Value: fault.SpecJson,
+		})
+	}
+
+	if typedFaults > 1 {
+		return "", nil, fmt.Errorf("only one of `cpu_pressure`, `network_latency` and `aks_chaos_mesh` can be specified")
+	}
+
+	if typedFaults == 1 {
+		if input.Urn != "" {
+			return "", nil, fmt.Errorf("`urn` cannot be specified alongside a fault block")
+		}
+	} else {
+		if input.Urn == "" {
+			return "", nil, fmt.Errorf("one of `urn`, `cpu_pressure`, `network_latency` or `aks_chaos_mesh` must be specified")
+		}
+		if chaosStudioIsTypedFaultUrn(input.Urn) {
+			return "", nil, fmt.Errorf("the fault %q must be configured using its fault block rather than `urn`", input.Urn)
+		}
+		urn = input.Urn
+	}
+
+	keys := make([]string, 0)
+	for key := range input.Parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, existing := range parameters {
+			if existing.Key == key {
+				return "", nil, fmt.Errorf("the parameter %q is managed by the fault block and cannot be specified in `parameters`", key)
+			}
+		}
+		parameters = append(parameters, experiments.KeyValuePair{
+			Key:   key,
+			Value: input.Parameters[key],
+		})
+	}
+
+	return urn, parameters, nil
+}
+
+// flattenChaosStudioActionFault maps the URN and parameters of an action back onto the typed fault blocks where
+// possible, any parameters which aren't part of a fault block are returned in `parameters`
+func flattenChaosStudioActionFault(urn string, input []experiments.KeyValuePair, output *ChaosStudioExperimentAction) error {
+	parameters := make(map[string]string)
+	for _, v := range input {
+		parameters[v.Key] = v.Value
+	}
+
+	switch {
+	case urn == chaosStudioFaultUrnCpuPressure:
+		fault := ChaosStudioCpuPressureFault{}
+		if v, ok := parameters["pressureLevel"]; ok {
+			level, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("parsing `pressureLevel` %q: %+v", v, err)
+			}
+			fault.PressureLevel = level
+			delete(parameters, "pressureLevel")
+		}
+		output.CpuPressure = []ChaosStudioCpuPressureFault{fault}
+
+	case urn == chaosStudioFaultUrnNetworkLatency:
+		fault := ChaosStudioNetworkLatencyFault{
+			DestinationFilter: make([]ChaosStudioNetworkDestinationFilter, 0),
+		}
+		if v, ok := parameters["latencyInMilliseconds"]; ok {
+			latency, err := strconv.Atoi(v)
+			if err != nil {
+				return fmt.Errorf("parsing `latencyInMilliseconds` %q: %+v", v, err)
+			}
+			fault.LatencyInMilliseconds = latency
+			delete(parameters, "latencyInMilliseconds")
+		}
+		if v, ok := parameters["destinationFilters"]; ok {
+			var filters []chaosStudioNetworkDestinationFilter
+			if err := json.Unmarshal([]byte(v), &filters); err != nil {
+				return fmt.Errorf("unmarshaling `destinationFilters`: %+v", err)
+			}
+			for _, filter := range filters {
+				fault.DestinationFilter = append(fault.DestinationFilter, ChaosStudioNetworkDestinationFilter{
+					Address:    filter.Address,
+					SubnetMask: filter.SubnetMask,
+					PortLow:    utils.NormaliseNilableInt(filter.PortLow),
+					PortHigh:   utils.NormaliseNilableInt(filter.PortHigh),
+				})
+			}
+			delete(parameters, "destinationFilters")
+		}
+		output.NetworkLatency = []ChaosStudioNetworkLatencyFault{fault}
+
+	case chaosStudioAksChaosMeshUrnRegex.MatchString(urn):
+		fault := ChaosStudioAksChaosMeshFault{
+			FaultType: chaosStudioAksChaosMeshUrnRegex.FindStringSubmatch(urn)[1],
+		}
+		if v, ok := parameters["jsonSpec"]; ok {
+			fault.SpecJson = utils.NormalizeJson(v)
+			delete(parameters, "jsonSpec")
+		}
+		output.AksChaosMesh = []ChaosStudioAksChaosMeshFault{fault}
+
+	default:
+		output.Urn = urn
+	}
+
+	output.Parameters = parameters
+	return nil
+}
+
+func chaosStudioIsTypedFaultUrn(urn string) bool {
+	return urn == chaosStudioFaultUrnCpuPressure || urn == chaosStudioFaultUrnNetworkLatency || chaosStudioAksChaosMeshUrnRegex.MatchString(urn)
+}
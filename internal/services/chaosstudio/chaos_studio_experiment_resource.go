@@ -0,0 +1,533 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ChaosStudioExperimentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ChaosStudioExperimentResource{}
+
+// chaosStudioDelayUrn is the URN used for all delay actions, which don't target a capability
+const chaosStudioDelayUrn = "urn:csci:microsoft:chaosStudio:timedDelay/1.0"
+
+type ChaosStudioExperimentResourceModel struct {
+	Name              string                          `tfschema:"name"`
+	ResourceGroupName string                          `tfschema:"resource_group_name"`
+	Location          string                          `tfschema:"location"`
+	Selector          []ChaosStudioExperimentSelector `tfschema:"selector"`
+	Step              []ChaosStudioExperimentStep     `tfschema:"step"`
+	Tags              map[string]string               `tfschema:"tags"`
+}
+
+type ChaosStudioExperimentSelector struct {
+	Name                 string   `tfschema:"name"`
+	ChaosStudioTargetIds []string `tfschema:"chaos_studio_target_ids"`
+}
+
+type ChaosStudioExperimentStep struct {
+	Name   string                        `tfschema:"name"`
+	Branch []ChaosStudioExperimentBranch `tfschema:"branch"`
+}
+
+type ChaosStudioExperimentBranch struct {
+	Name   string                        `tfschema:"name"`
+	Action []ChaosStudioExperimentAction `tfschema:"action"`
+}
+
+type ChaosStudioExperimentAction struct {
+	ActionType     string                           `tfschema:"action_type"`
+	Duration       string                           `tfschema:"duration"`
+	SelectorName   string                           `tfschema:"selector_name"`
+	Urn            string                           `tfschema:"urn"`
+	Parameters     map[string]string                `tfschema:"parameters"`
+	CpuPressure    []ChaosStudioCpuPressureFault    `tfschema:"cpu_pressure"`
+	NetworkLatency []ChaosStudioNetworkLatencyFault `tfschema:"network_latency"`
+	AksChaosMesh   []ChaosStudioAksChaosMeshFault   `tfschema:"aks_chaos_mesh"`
+}
+
+func (r ChaosStudioExperimentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^<>%&:?#/\\]{1,90}$`),
+				"`name` must be between 1 and 90 characters and cannot contain the characters `<>%&:?#/\\`",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemOrUserAssignedIdentity(),
+
+		"selector": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"chaos_studio_target_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: targets.ValidateScopedTargetID,
+						},
+					},
+				},
+			},
+		},
+
+		"step": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"branch": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"action": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"action_type": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringInSlice(experiments.PossibleValuesForActionType(), false),
+											},
+
+											"duration": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validate.ISO8601Duration,
+											},
+
+											"selector_name": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"urn": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"parameters": {
+												Type:     pluginsdk.TypeMap,
+												Optional: true,
+												Elem: &pluginsdk.Schema{
+													Type: pluginsdk.TypeString,
+												},
+											},
+
+											"cpu_pressure": schemaChaosStudioCpuPressureFault(),
+
+											"network_latency": schemaChaosStudioNetworkLatencyFault(),
+
+											"aks_chaos_mesh": schemaChaosStudioAksChaosMeshFault(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ChaosStudioExperimentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioExperimentResource) ModelObject() interface{} {
+	return &ChaosStudioExperimentResourceModel{}
+}
+
+func (r ChaosStudioExperimentResource) ResourceType() string {
+	return "azurerm_chaos_studio_experiment"
+}
+
+func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return experiments.ValidateExperimentID
+}
+
+func (r ChaosStudioExperimentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ChaosStudioExperimentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := experiments.NewExperimentID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandChaosStudioExperiment(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioExperimentResourceModel{
+				Name:              id.ExperimentName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				flattenedIdentity, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				state.Selector = flattenChaosStudioExperimentSelectors(model.Properties.Selectors)
+
+				steps, err := flattenChaosStudioExperimentSteps(model.Properties.Steps)
+				if err != nil {
+					return err
+				}
+				state.Step = steps
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ChaosStudioExperimentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the selectors and steps are validated as a whole, so the full definition is sent on each update
+			payload, err := expandChaosStudioExperiment(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandChaosStudioExperiment(metadata sdk.ResourceMetaData, model ChaosStudioExperimentResourceModel) (*experiments.Experiment, error) {
+	expandedIdentity, err := identity.ExpandSystemOrUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	selectorNames := make(map[string]struct{})
+	selectors := make([]experiments.Selector, 0)
+	for _, v := range model.Selector {
+		if _, exists := selectorNames[v.Name]; exists {
+			return nil, fmt.Errorf("the selector name %q is specified more than once", v.Name)
+		}
+		selectorNames[v.Name] = struct{}{}
+
+		references := make([]experiments.TargetReference, 0)
+		for _, targetId := range v.ChaosStudioTargetIds {
+			references = append(references, experiments.TargetReference{
+				Id:   targetId,
+				Type: experiments.TargetReferenceTypeChaosTarget,
+			})
+		}
+
+		selectors = append(selectors, experiments.ListSelector{
+			Id:      v.Name,
+			Targets: references,
+		})
+	}
+
+	steps := make([]experiments.Step, 0)
+	for _, step := range model.Step {
+		branches := make([]experiments.Branch, 0)
+		for _, branch := range step.Branch {
+			actions := make([]experiments.Action, 0)
+			for i, action := range branch.Action {
+				expanded, err := expandChaosStudioExperimentAction(action, selectorNames)
+				if err != nil {
+					return nil, fmt.Errorf("expanding action %d of the branch %q in the step %q: %+v", i, branch.Name, step.Name, err)
+				}
+				actions = append(actions, expanded)
+			}
+
+			branches = append(branches, experiments.Branch{
+				Name:    branch.Name,
+				Actions: actions,
+			})
+		}
+
+		steps = append(steps, experiments.Step{
+			Name:     step.Name,
+			Branches: branches,
+		})
+	}
+
+	tags := model.Tags
+	return &experiments.Experiment{
+		Identity: expandedIdentity,
+		Location: location.Normalize(model.Location),
+		Properties: experiments.ExperimentProperties{
+			Selectors: selectors,
+			Steps:     steps,
+		},
+		Tags: &tags,
+	}, nil
+}
+
+func expandChaosStudioExperimentAction(input ChaosStudioExperimentAction, selectorNames map[string]struct{}) (experiments.Action, error) {
+	actionType := experiments.ActionType(input.ActionType)
+
+	if actionType == experiments.ActionTypeDelay {
+		if input.Duration == "" {
+			return nil, fmt.Errorf("`duration` must be specified for a `delay` action")
+		}
+		if input.SelectorName != "" || input.Urn != "" || len(input.Parameters) > 0 || len(input.CpuPressure) > 0 || len(input.NetworkLatency) > 0 || len(input.AksChaosMesh) > 0 {
+			return nil, fmt.Errorf("only `duration` can be specified for a `delay` action")
+		}
+
+		return experiments.DelayAction{
+			Name:     chaosStudioDelayUrn,
+			Duration: input.Duration,
+		}, nil
+	}
+
+	if input.SelectorName == "" {
+		return nil, fmt.Errorf("`selector_name` must be specified for a %q action", input.ActionType)
+	}
+	if _, ok := selectorNames[input.SelectorName]; !ok {
+		return nil, fmt.Errorf("the selector %q was not found", input.SelectorName)
+	}
+
+	urn, parameters, err := expandChaosStudioActionFault(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if actionType == experiments.ActionTypeContinuous {
+		if input.Duration == "" {
+			return nil, fmt.Errorf("`duration` must be specified for a `continuous` action")
+		}
+
+		return experiments.ContinuousAction{
+			Name:       urn,
+			Duration:   input.Duration,
+			Parameters: parameters,
+			SelectorId: input.SelectorName,
+		}, nil
+	}
+
+	if input.Duration != "" {
+		return nil, fmt.Errorf("`duration` cannot be specified for a `discrete` action")
+	}
+
+	return experiments.DiscreteAction{
+		Name:       urn,
+		Parameters: parameters,
+		SelectorId: input.SelectorName,
+	}, nil
+}
+
+func flattenChaosStudioExperimentSelectors(input []experiments.Selector) []ChaosStudioExperimentSelector {
+	output := make([]ChaosStudioExperimentSelector, 0)
+	for _, raw := range input {
+		// only List selectors can be managed by this resource
+		v, ok := raw.(experiments.ListSelector)
+		if !ok {
+			continue
+		}
+
+		targetIds := make([]string, 0)
+		for _, target := range v.Targets {
+			targetIds = append(targetIds, target.Id)
+		}
+
+		output = append(output, ChaosStudioExperimentSelector{
+			Name:                 v.Id,
+			ChaosStudioTargetIds: targetIds,
+		})
+	}
+
+	return output
+}
+
+func flattenChaosStudioExperimentSteps(input []experiments.Step) ([]ChaosStudioExperimentStep, error) {
+	output := make([]ChaosStudioExperimentStep, 0)
+	for _, step := range input {
+		branches := make([]ChaosStudioExperimentBranch, 0)
+		for _, branch := range step.Branches {
+			actions := make([]ChaosStudioExperimentAction, 0)
+			for _, raw := range branch.Actions {
+				action := ChaosStudioExperimentAction{}
+
+				switch v := raw.(type) {
+				case experiments.ContinuousAction:
+					action.ActionType = string(experiments.ActionTypeContinuous)
+					action.Duration = v.Duration
+					action.SelectorName = v.SelectorId
+					if err := flattenChaosStudioActionFault(v.Name, v.Parameters, &action); err != nil {
+						return nil, fmt.Errorf("flattening the action %q in the branch %q: %+v", v.Name, branch.Name, err)
+					}
+
+				case experiments.DelayAction:
+					action.ActionType = string(experiments.ActionTypeDelay)
+					action.Duration = v.Duration
+
+				case experiments.DiscreteAction:
+					action.ActionType = string(experiments.ActionTypeDiscrete)
+					action.SelectorName = v.SelectorId
+					if err := flattenChaosStudioActionFault(v.Name, v.Parameters, &action); err != nil {
+						return nil, fmt.Errorf("flattening the action %q in the branch %q: %+v", v.Name, branch.Name, err)
+					}
+
+				default:
+					continue
+				}
+
+				actions = append(actions, action)
+			}
+
+			branches = append(branches, ChaosStudioExperimentBranch{
+				Name:   branch.Name,
+				Action: actions,
+			})
+		}
+
+		output = append(output, ChaosStudioExperimentStep{
+			Name:   step.Name,
+			Branch: branches,
+		})
+	}
+
+	return output, nil
+}
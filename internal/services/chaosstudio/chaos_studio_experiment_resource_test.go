@@ -0,0 +1,303 @@
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioExperimentResource struct{}
+
+func TestAccChaosStudioExperiment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("step.0.branch.0.action.0.aks_chaos_mesh.0.fault_type").HasValue("podChaos"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioExperiment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccChaosStudioExperiment_agentFaults(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.agentFaults(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("step.0.branch.0.action.0.cpu_pressure.0.pressure_level").HasValue("95"),
+				check.That(data.ResourceName).Key("step.0.branch.1.action.0.network_latency.0.destination_filter.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.agentFaultsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("step.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ChaosStudioExperimentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := experiments.ParseExperimentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ChaosStudio.ExperimentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ChaosStudioExperimentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-experiment-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+
+    branch {
+      name = "Branch1"
+
+      action {
+        action_type   = "continuous"
+        duration      = "PT5M"
+        selector_name = "Selector1"
+
+        aks_chaos_mesh {
+          fault_type = "podChaos"
+          spec_json = jsonencode({
+            action = "pod-failure"
+            mode   = "all"
+            selector = {
+              namespaces = ["default"]
+            }
+          })
+        }
+      }
+    }
+  }
+}
+`, ChaosStudioTargetResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ChaosStudioExperimentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "import" {
+  name                = azurerm_chaos_studio_experiment.test.name
+  resource_group_name = azurerm_chaos_studio_experiment.test.resource_group_name
+  location            = azurerm_chaos_studio_experiment.test.location
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+
+    branch {
+      name = "Branch1"
+
+      action {
+        action_type = "delay"
+        duration    = "PT1M"
+      }
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ChaosStudioExperimentResource) agentFaults(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-experiment-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+
+    branch {
+      name = "CPU"
+
+      action {
+        action_type   = "continuous"
+        duration      = "PT10M"
+        selector_name = "Selector1"
+
+        cpu_pressure {
+          pressure_level = 95
+        }
+      }
+    }
+
+    branch {
+      name = "Network"
+
+      action {
+        action_type   = "continuous"
+        duration      = "PT10M"
+        selector_name = "Selector1"
+
+        network_latency {
+          latency_in_milliseconds = 200
+
+          destination_filter {
+            address     = "10.0.2.0"
+            subnet_mask = 24
+            port_low    = 5000
+            port_high   = 5200
+          }
+        }
+      }
+    }
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.agentTemplate(data), data.RandomInteger)
+}
+
+func (r ChaosStudioExperimentResource) agentFaultsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-experiment-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+
+    branch {
+      name = "CPU"
+
+      action {
+        action_type   = "continuous"
+        duration      = "PT5M"
+        selector_name = "Selector1"
+
+        cpu_pressure {
+          pressure_level = 50
+        }
+      }
+
+      action {
+        action_type = "delay"
+        duration    = "PT1M"
+      }
+    }
+  }
+
+  step {
+    name = "Step2"
+
+    branch {
+      name = "Network"
+
+      action {
+        action_type   = "continuous"
+        duration      = "PT5M"
+        selector_name = "Selector1"
+
+        network_latency {
+          latency_in_milliseconds = 100
+
+          destination_filter {
+            address     = "10.0.2.0"
+            subnet_mask = 24
+          }
+        }
+      }
+    }
+  }
+}
+`, r.agentTemplate(data), data.RandomInteger)
+}
+
+func (ChaosStudioExperimentResource) agentTemplate(data acceptance.TestData) string {
+	return ChaosStudioTargetResource{}.agent(data, `["CPUPressure-1.0", "NetworkLatency-1.1"]`)
+}
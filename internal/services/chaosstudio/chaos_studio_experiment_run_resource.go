@@ -0,0 +1,435 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// ChaosStudioExperimentRunResource starts a run of an Experiment and surfaces the results of that run. A run can't be
+// updated, so changing any of the arguments (e.g. `triggers`) starts a new run.
+type ChaosStudioExperimentRunResource struct{}
+
+var _ sdk.Resource = ChaosStudioExperimentRunResource{}
+
+type ChaosStudioExperimentRunResourceModel struct {
+	ExperimentId      string                         `tfschema:"experiment_id"`
+	Triggers          map[string]string              `tfschema:"triggers"`
+	WaitForCompletion bool                           `tfschema:"wait_for_completion"`
+	ExecutionId       string                         `tfschema:"execution_id"`
+	Status            string                         `tfschema:"status"`
+	StartedAt         string                         `tfschema:"started_at"`
+	StoppedAt         string                         `tfschema:"stopped_at"`
+	FailureReason     string                         `tfschema:"failure_reason"`
+	Step              []ChaosStudioExperimentRunStep `tfschema:"step"`
+}
+
+type ChaosStudioExperimentRunStep struct {
+	Name   string                           `tfschema:"name"`
+	Status string                           `tfschema:"status"`
+	Branch []ChaosStudioExperimentRunBranch `tfschema:"branch"`
+}
+
+type ChaosStudioExperimentRunBranch struct {
+	Name   string                           `tfschema:"name"`
+	Status string                           `tfschema:"status"`
+	Action []ChaosStudioExperimentRunAction `tfschema:"action"`
+}
+
+type ChaosStudioExperimentRunAction struct {
+	Name      string `tfschema:"name"`
+	Status    string `tfschema:"status"`
+	StartTime string `tfschema:"start_time"`
+	EndTime   string `tfschema:"end_time"`
+}
+
+const (
+	chaosStudioExecutionStatusCancelled = "Cancelled"
+	chaosStudioExecutionStatusFailed    = "Failed"
+	chaosStudioExecutionStatusSuccess   = "Success"
+)
+
+func (r ChaosStudioExperimentRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"experiment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: experiments.ValidateExperimentID,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"wait_for_completion": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"execution_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"started_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"stopped_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"failure_reason": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"step": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"status": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"branch": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"status": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"action": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+
+											"status": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+
+											"start_time": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+
+											"end_time": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunResource) ModelObject() interface{} {
+	return &ChaosStudioExperimentRunResourceModel{}
+}
+
+func (r ChaosStudioExperimentRunResource) ResourceType() string {
+	return "azurerm_chaos_studio_experiment_run"
+}
+
+func (r ChaosStudioExperimentRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return experiments.ValidateExecutionID
+}
+
+func (r ChaosStudioExperimentRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 6 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			var model ChaosStudioExperimentRunResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			experimentId, err := experiments.ParseExperimentID(model.ExperimentId)
+			if err != nil {
+				return err
+			}
+
+			// the Start API doesn't return the ID of the execution, so it's identified by comparing the executions
+			// which exist before and after the Experiment is started
+			existing, err := listChaosStudioExecutionNames(ctx, client, *experimentId)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Start(ctx, *experimentId); err != nil {
+				return fmt.Errorf("starting %s: %+v", *experimentId, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			var executionName string
+			startConf := &pluginsdk.StateChangeConf{
+				Pending: []string{"Pending"},
+				Target:  []string{"Started"},
+				Refresh: func() (interface{}, string, error) {
+					names, err := listChaosStudioExecutionNames(ctx, client, *experimentId)
+					if err != nil {
+						return nil, "", err
+					}
+					for name := range names {
+						if _, ok := existing[name]; !ok {
+							executionName = name
+							return name, "Started", nil
+						}
+					}
+					return "", "Pending", nil
+				},
+				MinTimeout: 10 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			if _, err := startConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for the execution of %s to start: %+v", *experimentId, err)
+			}
+
+			id := experiments.NewExecutionID(experimentId.SubscriptionId, experimentId.ResourceGroupName, experimentId.ExperimentName, executionName)
+			metadata.SetID(id)
+
+			if model.WaitForCompletion {
+				log.Printf("[DEBUG] waiting for %s to complete", id)
+				completeConf := &pluginsdk.StateChangeConf{
+					Pending: []string{"Running"},
+					Target:  []string{"Completed"},
+					Refresh: func() (interface{}, string, error) {
+						resp, err := client.GetExecution(ctx, id)
+						if err != nil {
+							return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+						}
+
+						status := ""
+						if model := resp.Model; model != nil && model.Properties != nil {
+							status = utils.NormalizeNilableString(model.Properties.Status)
+						}
+						if chaosStudioExecutionIsComplete(status) {
+							return resp, "Completed", nil
+						}
+						return resp, "Running", nil
+					},
+					MinTimeout: 30 * time.Second,
+					Timeout:    time.Until(deadline),
+				}
+				if _, err := completeConf.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExecutionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ExecutionDetails(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving details of %s: %+v", *id, err)
+			}
+
+			var state ChaosStudioExperimentRunResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.ExperimentId = experiments.NewExperimentID(id.SubscriptionId, id.ResourceGroupName, id.ExperimentName).ID()
+			state.ExecutionId = id.ExecutionId
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Status = utils.NormalizeNilableString(props.Status)
+					state.StartedAt = utils.NormalizeNilableString(props.StartedAt)
+					state.StoppedAt = utils.NormalizeNilableString(props.StoppedAt)
+					state.FailureReason = utils.NormalizeNilableString(props.FailureReason)
+
+					steps := make([]ChaosStudioExperimentRunStep, 0)
+					if props.RunInformation != nil {
+						steps = flattenChaosStudioExperimentRunSteps(props.RunInformation.Steps)
+					}
+					state.Step = steps
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioExperimentRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExecutionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetExecution(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			status := ""
+			if model := resp.Model; model != nil && model.Properties != nil {
+				status = utils.NormalizeNilableString(model.Properties.Status)
+			}
+
+			// the history of an execution can't be removed, so a run which is still in progress is cancelled and
+			// a completed run is only removed from the state
+			if chaosStudioExecutionIsComplete(status) {
+				log.Printf("[DEBUG] %s has completed with the status %q - removing from state", *id, status)
+				return nil
+			}
+
+			experimentId := experiments.NewExperimentID(id.SubscriptionId, id.ResourceGroupName, id.ExperimentName)
+			if err := client.CancelThenPoll(ctx, experimentId); err != nil {
+				return fmt.Errorf("cancelling %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func chaosStudioExecutionIsComplete(status string) bool {
+	for _, v := range []string{chaosStudioExecutionStatusCancelled, chaosStudioExecutionStatusFailed, chaosStudioExecutionStatusSuccess} {
+		if strings.EqualFold(status, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func listChaosStudioExecutionNames(ctx context.Context, client *experiments.ExperimentsClient, id experiments.ExperimentId) (map[string]struct{}, error) {
+	resp, err := client.ListAllExecutionsComplete(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("listing executions of %s: %+v", id, err)
+	}
+
+	names := make(map[string]struct{})
+	for _, item := range resp.Items {
+		if item.Name != nil {
+			names[*item.Name] = struct{}{}
+		}
+	}
+
+	return names, nil
+}
+
+func flattenChaosStudioExperimentRunSteps(input *[]experiments.StepStatus) []ChaosStudioExperimentRunStep {
+	output := make([]ChaosStudioExperimentRunStep, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, step := range *input {
+		branches := make([]ChaosStudioExperimentRunBranch, 0)
+		if step.Branches != nil {
+			for _, branch := range *step.Branches {
+				actions := make([]ChaosStudioExperimentRunAction, 0)
+				if branch.Actions != nil {
+					for _, action := range *branch.Actions {
+						actions = append(actions, ChaosStudioExperimentRunAction{
+							Name:      utils.NormalizeNilableString(action.ActionName),
+							Status:    utils.NormalizeNilableString(action.Status),
+							StartTime: utils.NormalizeNilableString(action.StartTime),
+							EndTime:   utils.NormalizeNilableString(action.EndTime),
+						})
+					}
+				}
+
+				branches = append(branches, ChaosStudioExperimentRunBranch{
+					Name:   utils.NormalizeNilableString(branch.BranchName),
+					Status: utils.NormalizeNilableString(branch.Status),
+					Action: actions,
+				})
+			}
+		}
+
+		output = append(output, ChaosStudioExperimentRunStep{
+			Name:   utils.NormalizeNilableString(step.StepName),
+			Status: utils.NormalizeNilableString(step.Status),
+			Branch: branches,
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,95 @@
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioExperimentRunResource struct{}
+
+func TestAccChaosStudioExperimentRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment_run", "test")
+	r := ChaosStudioExperimentRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+				check.That(data.ResourceName).Key("step.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("triggers", "wait_for_completion"),
+	})
+}
+
+func (ChaosStudioExperimentRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := experiments.ParseExecutionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ChaosStudio.ExperimentsClient.GetExecution(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// basic runs an Experiment containing only a delay, since the identity of the Experiment would otherwise need to be
+// granted access to the target resources before the run starts
+func (ChaosStudioExperimentRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-experiment-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selector {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "Step1"
+
+    branch {
+      name = "Branch1"
+
+      action {
+        action_type = "delay"
+        duration    = "PT1M"
+      }
+    }
+  }
+}
+
+resource "azurerm_chaos_studio_experiment_run" "test" {
+  experiment_id = azurerm_chaos_studio_experiment.test.id
+
+  triggers = {
+    run = "1"
+  }
+}
+`, ChaosStudioTargetResource{}.basic(data), data.RandomInteger)
+}
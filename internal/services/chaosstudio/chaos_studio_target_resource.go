@@ -0,0 +1,383 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/capabilities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/capabilitytypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// ChaosStudioTargetResource onboards a resource to Chaos Studio and enables a set of capabilities (faults) on it,
+// when no capabilities are specified all of the capabilities supported by the Target Type are enabled.
+type ChaosStudioTargetResource struct{}
+
+var _ sdk.ResourceWithUpdate = ChaosStudioTargetResource{}
+
+type ChaosStudioTargetResourceModel struct {
+	Location         string                           `tfschema:"location"`
+	TargetResourceId string                           `tfschema:"target_resource_id"`
+	TargetType       string                           `tfschema:"target_type"`
+	Capabilities     []string                         `tfschema:"capabilities"`
+	AgentIdentity    []ChaosStudioTargetAgentIdentity `tfschema:"agent_identity"`
+	AgentProfileId   string                           `tfschema:"agent_profile_id"`
+}
+
+type ChaosStudioTargetAgentIdentity struct {
+	ClientId string `tfschema:"client_id"`
+	TenantId string `tfschema:"tenant_id"`
+}
+
+const chaosStudioTargetTypeAgent = "Microsoft-Agent"
+
+func (r ChaosStudioTargetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"target_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^Microsoft-[A-Za-z]+$`),
+				"`target_type` must be in the format `Microsoft-{Type}`, for example `Microsoft-Agent` or `Microsoft-AzureKubernetesServiceChaosMesh`",
+			),
+		},
+
+		"capabilities": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"agent_identity": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"tenant_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"agent_profile_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) ModelObject() interface{} {
+	return &ChaosStudioTargetResourceModel{}
+}
+
+func (r ChaosStudioTargetResource) ResourceType() string {
+	return "azurerm_chaos_studio_target"
+}
+
+func (r ChaosStudioTargetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return targets.ValidateScopedTargetID
+}
+
+func (r ChaosStudioTargetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ChaosStudioTargetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := targets.NewScopedTargetID(model.TargetResourceId, model.TargetType)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties, err := expandChaosStudioTargetProperties(model.TargetType, model.AgentIdentity)
+			if err != nil {
+				return err
+			}
+
+			payload := targets.Target{
+				Location:   utils.String(location.Normalize(model.Location)),
+				Properties: properties,
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			capabilityNames := model.Capabilities
+			if len(capabilityNames) == 0 {
+				capabilityNames, err = listChaosStudioCapabilityTypes(ctx, metadata.Client.ChaosStudio.CapabilityTypesClient, subscriptionId, model.Location, model.TargetType)
+				if err != nil {
+					return err
+				}
+			}
+
+			metadata.SetID(id)
+
+			if err := enableChaosStudioCapabilities(ctx, metadata.Client.ChaosStudio.CapabilitiesClient, id, capabilityNames); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseScopedTargetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioTargetResourceModel{
+				TargetResourceId: id.Scope,
+				TargetType:       id.TargetName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				state.AgentIdentity = flattenChaosStudioTargetAgentIdentity(model.Properties)
+				if v, ok := model.Properties["agentProfileId"].(string); ok {
+					state.AgentProfileId = v
+				}
+			}
+
+			capabilityId := capabilities.NewScopedTargetID(id.Scope, id.TargetName)
+			capabilitiesResp, err := metadata.Client.ChaosStudio.CapabilitiesClient.ListComplete(ctx, capabilityId)
+			if err != nil {
+				return fmt.Errorf("listing capabilities for %s: %+v", *id, err)
+			}
+			capabilityNames := make([]string, 0)
+			for _, item := range capabilitiesResp.Items {
+				if item.Name != nil {
+					capabilityNames = append(capabilityNames, *item.Name)
+				}
+			}
+			sort.Strings(capabilityNames)
+			state.Capabilities = capabilityNames
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+			capabilitiesClient := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := targets.ParseScopedTargetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ChaosStudioTargetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("agent_identity") {
+				properties, err := expandChaosStudioTargetProperties(model.TargetType, model.AgentIdentity)
+				if err != nil {
+					return err
+				}
+
+				payload := targets.Target{
+					Location:   utils.String(location.Normalize(model.Location)),
+					Properties: properties,
+				}
+				if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("capabilities") {
+				oldRaw, newRaw := metadata.ResourceData.GetChange("capabilities")
+				oldSet := oldRaw.(*pluginsdk.Set)
+				newSet := newRaw.(*pluginsdk.Set)
+
+				for _, v := range oldSet.Difference(newSet).List() {
+					capabilityId := capabilities.NewScopedCapabilityID(id.Scope, id.TargetName, v.(string))
+					if _, err := capabilitiesClient.Delete(ctx, capabilityId); err != nil {
+						return fmt.Errorf("disabling %s: %+v", capabilityId, err)
+					}
+				}
+
+				if err := enableChaosStudioCapabilities(ctx, capabilitiesClient, *id, *utils.ExpandStringSlice(newSet.Difference(oldSet).List())); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseScopedTargetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// deleting the Target also removes any capabilities enabled on it
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandChaosStudioTargetProperties(targetType string, input []ChaosStudioTargetAgentIdentity) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+	if len(input) == 0 {
+		if targetType == chaosStudioTargetTypeAgent {
+			return nil, fmt.Errorf("an `agent_identity` block must be specified when `target_type` is %q", chaosStudioTargetTypeAgent)
+		}
+		return properties, nil
+	}
+
+	if targetType != chaosStudioTargetTypeAgent {
+		return nil, fmt.Errorf("an `agent_identity` block can only be specified when `target_type` is %q", chaosStudioTargetTypeAgent)
+	}
+
+	properties["identities"] = []interface{}{
+		map[string]interface{}{
+			"type":     "AzureManagedIdentity",
+			"clientId": input[0].ClientId,
+			"tenantId": input[0].TenantId,
+		},
+	}
+
+	return properties, nil
+}
+
+func flattenChaosStudioTargetAgentIdentity(input map[string]interface{}) []ChaosStudioTargetAgentIdentity {
+	identities, ok := input["identities"].([]interface{})
+	if !ok {
+		return []ChaosStudioTargetAgentIdentity{}
+	}
+
+	for _, raw := range identities {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		result := ChaosStudioTargetAgentIdentity{}
+		if clientId, ok := v["clientId"].(string); ok {
+			result.ClientId = clientId
+		}
+		if tenantId, ok := v["tenantId"].(string); ok {
+			result.TenantId = tenantId
+		}
+		return []ChaosStudioTargetAgentIdentity{result}
+	}
+
+	return []ChaosStudioTargetAgentIdentity{}
+}
+
+func listChaosStudioCapabilityTypes(ctx context.Context, client *capabilitytypes.CapabilityTypesClient, subscriptionId, locationName, targetType string) ([]string, error) {
+	id := capabilitytypes.NewTargetTypeID(subscriptionId, location.Normalize(locationName), targetType)
+	resp, err := client.ListComplete(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("listing capability types for %s: %+v", id, err)
+	}
+
+	names := make([]string, 0)
+	for _, item := range resp.Items {
+		if item.Name != nil {
+			names = append(names, *item.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no capability types were found for %s", id)
+	}
+
+	return names, nil
+}
+
+func enableChaosStudioCapabilities(ctx context.Context, client *capabilities.CapabilitiesClient, targetId targets.ScopedTargetId, names []string) error {
+	for _, name := range names {
+		capabilityId := capabilities.NewScopedCapabilityID(targetId.Scope, targetId.TargetName, name)
+		log.Printf("[DEBUG] enabling %s", capabilityId)
+
+		payload := capabilities.Capability{
+			Properties: &capabilities.CapabilityProperties{},
+		}
+		if _, err := client.CreateOrUpdate(ctx, capabilityId, payload); err != nil {
+			return fmt.Errorf("enabling %s: %+v", capabilityId, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioTargetResource struct{}
+
+func TestAccChaosStudioTarget_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_target", "test")
+	r := ChaosStudioTargetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				// all of the capabilities for the Target Type are enabled when none are specified
+				check.That(data.ResourceName).Key("capabilities.#").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioTarget_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_target", "test")
+	r := ChaosStudioTargetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccChaosStudioTarget_agent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_target", "test")
+	r := ChaosStudioTargetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.agent(data, `["CPUPressure-1.0"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("capabilities.#").HasValue("1"),
+				check.That(data.ResourceName).Key("agent_profile_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.agent(data, `["CPUPressure-1.0", "NetworkLatency-1.1"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("capabilities.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.agent(data, `["NetworkLatency-1.1"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("capabilities.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ChaosStudioTargetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := targets.ParseScopedTargetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ChaosStudio.TargetsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ChaosStudioTargetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_target" "test" {
+  location           = azurerm_resource_group.test.location
+  target_resource_id = azurerm_kubernetes_cluster.test.id
+  target_type        = "Microsoft-AzureKubernetesServiceChaosMesh"
+}
+`, r.kubernetesTemplate(data))
+}
+
+func (r ChaosStudioTargetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_target" "import" {
+  location           = azurerm_chaos_studio_target.test.location
+  target_resource_id = azurerm_chaos_studio_target.test.target_resource_id
+  target_type        = azurerm_chaos_studio_target.test.target_type
+}
+`, r.basic(data))
+}
+
+func (r ChaosStudioTargetResource) agent(data acceptance.TestData, capabilities string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_target" "test" {
+  location           = azurerm_resource_group.test.location
+  target_resource_id = azurerm_linux_virtual_machine.test.id
+  target_type        = "Microsoft-Agent"
+  capabilities       = %s
+
+  agent_identity {
+    client_id = azurerm_user_assigned_identity.test.client_id
+    tenant_id = azurerm_user_assigned_identity.test.tenant_id
+  }
+}
+`, r.virtualMachineTemplate(data), capabilities)
+}
+
+func (ChaosStudioTargetResource) kubernetesTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-chaos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 1
+    vm_size    = "Standard_DS2_v2"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (ChaosStudioTargetResource) virtualMachineTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-chaos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestnw-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  identity {
+    type = "UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/capabilities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/capabilitytypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2023-11-01/targets"
+)
+
+type Client struct {
+	CapabilitiesClient    *capabilities.CapabilitiesClient
+	CapabilityTypesClient *capabilitytypes.CapabilityTypesClient
+	ExperimentsClient     *experiments.ExperimentsClient
+	TargetsClient         *targets.TargetsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	capabilitiesClient := capabilities.NewCapabilitiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&capabilitiesClient.Client, o.ResourceManagerAuthorizer)
+
+	capabilityTypesClient := capabilitytypes.NewCapabilityTypesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&capabilityTypesClient.Client, o.ResourceManagerAuthorizer)
+
+	experimentsClient := experiments.NewExperimentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&experimentsClient.Client, o.ResourceManagerAuthorizer)
+
+	targetsClient := targets.NewTargetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&targetsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CapabilitiesClient:    &capabilitiesClient,
+		CapabilityTypesClient: &capabilityTypesClient,
+		ExperimentsClient:     &experimentsClient,
+		TargetsClient:         &targetsClient,
+	}
+}
@@ -0,0 +1,123 @@
+package capabilities
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScopedCapabilityId{}
+
+// ScopedCapabilityId is a struct representing the Resource ID for a Scoped Capability
+type ScopedCapabilityId struct {
+	Scope          string
+	TargetName     string
+	CapabilityName string
+}
+
+// NewScopedCapabilityID returns a new ScopedCapabilityId struct
+func NewScopedCapabilityID(scope string, targetName string, capabilityName string) ScopedCapabilityId {
+	return ScopedCapabilityId{
+		Scope:          scope,
+		TargetName:     targetName,
+		CapabilityName: capabilityName,
+	}
+}
+
+// ParseScopedCapabilityID parses 'input' into a ScopedCapabilityId
+func ParseScopedCapabilityID(input string) (*ScopedCapabilityId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedCapabilityId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedCapabilityId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.TargetName, ok = parsed.Parsed["targetName"]; !ok {
+		return nil, fmt.Errorf("the segment 'targetName' was not found in the resource id %q", input)
+	}
+
+	if id.CapabilityName, ok = parsed.Parsed["capabilityName"]; !ok {
+		return nil, fmt.Errorf("the segment 'capabilityName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseScopedCapabilityIDInsensitively parses 'input' case-insensitively into a ScopedCapabilityId
+// note: this method should only be used for API response data and not user input
+func ParseScopedCapabilityIDInsensitively(input string) (*ScopedCapabilityId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScopedCapabilityId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScopedCapabilityId{}
+
+	if id.Scope, ok = parsed.Parsed["scope"]; !ok {
+		return nil, fmt.Errorf("the segment 'scope' was not found in the resource id %q", input)
+	}
+
+	if id.TargetName, ok = parsed.Parsed["targetName"]; !ok {
+		return nil, fmt.Errorf("the segment 'targetName' was not found in the resource id %q", input)
+	}
+
+	if id.CapabilityName, ok = parsed.Parsed["capabilityName"]; !ok {
+		return nil, fmt.Errorf("the segment 'capabilityName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateScopedCapabilityID checks that 'input' can be parsed as a Scoped Capability ID
+func ValidateScopedCapabilityID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseScopedCapabilityID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scoped Capability ID
+func (id ScopedCapabilityId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Chaos/targets/%s/capabilities/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.TargetName, id.CapabilityName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scoped Capability ID
+func (id ScopedCapabilityId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/some-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftChaos", "Microsoft.Chaos", "Microsoft.Chaos"),
+		resourceids.StaticSegment("staticTargets", "targets", "targets"),
+		resourceids.UserSpecifiedSegment("targetName", "targetValue"),
+		resourceids.StaticSegment("staticCapabilities", "capabilities", "capabilities"),
+		resourceids.UserSpecifiedSegment("capabilityName", "capabilityValue"),
+	}
+}
+
+// String returns a human-readable description of this Scoped Capability ID
+func (id ScopedCapabilityId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope: %q", id.Scope),
+		fmt.Sprintf("Target Name: %q", id.TargetName),
+		fmt.Sprintf("Capability Name: %q", id.CapabilityName),
+	}
+	return fmt.Sprintf("Scoped Capability (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package capabilities
+
+type Capability struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *CapabilityProperties `json:"properties,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package capabilitytypes
+
+type CapabilityType struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *CapabilityTypeProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
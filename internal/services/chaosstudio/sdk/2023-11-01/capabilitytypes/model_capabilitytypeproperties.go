@@ -0,0 +1,13 @@
+package capabilitytypes
+
+type CapabilityTypeProperties struct {
+	AzureRbacActions     *[]string `json:"azureRbacActions,omitempty"`
+	AzureRbacDataActions *[]string `json:"azureRbacDataActions,omitempty"`
+	Description          *string   `json:"description,omitempty"`
+	DisplayName          *string   `json:"displayName,omitempty"`
+	Kind                 *string   `json:"kind,omitempty"`
+	ParametersSchema     *string   `json:"parametersSchema,omitempty"`
+	Publisher            *string   `json:"publisher,omitempty"`
+	TargetType           *string   `json:"targetType,omitempty"`
+	Urn                  *string   `json:"urn,omitempty"`
+}
@@ -0,0 +1,87 @@
+package experiments
+
+import "strings"
+
+type ActionType string
+
+const (
+	ActionTypeContinuous ActionType = "continuous"
+	ActionTypeDelay      ActionType = "delay"
+	ActionTypeDiscrete   ActionType = "discrete"
+)
+
+func PossibleValuesForActionType() []string {
+	return []string{
+		string(ActionTypeContinuous),
+		string(ActionTypeDelay),
+		string(ActionTypeDiscrete),
+	}
+}
+
+func parseActionType(input string) (*ActionType, error) {
+	vals := map[string]ActionType{
+		"continuous": ActionTypeContinuous,
+		"delay":      ActionTypeDelay,
+		"discrete":   ActionTypeDiscrete,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ActionType(input)
+	return &out, nil
+}
+
+type SelectorType string
+
+const (
+	SelectorTypeList  SelectorType = "List"
+	SelectorTypeQuery SelectorType = "Query"
+)
+
+func PossibleValuesForSelectorType() []string {
+	return []string{
+		string(SelectorTypeList),
+		string(SelectorTypeQuery),
+	}
+}
+
+func parseSelectorType(input string) (*SelectorType, error) {
+	vals := map[string]SelectorType{
+		"list":  SelectorTypeList,
+		"query": SelectorTypeQuery,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SelectorType(input)
+	return &out, nil
+}
+
+type TargetReferenceType string
+
+const (
+	TargetReferenceTypeChaosTarget TargetReferenceType = "ChaosTarget"
+)
+
+func PossibleValuesForTargetReferenceType() []string {
+	return []string{
+		string(TargetReferenceTypeChaosTarget),
+	}
+}
+
+func parseTargetReferenceType(input string) (*TargetReferenceType, error) {
+	vals := map[string]TargetReferenceType{
+		"chaostarget": TargetReferenceTypeChaosTarget,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TargetReferenceType(input)
+	return &out, nil
+}
@@ -0,0 +1,65 @@
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ExecutionDetailsResponse struct {
+	HttpResponse *http.Response
+	Model        *ExperimentExecutionDetails
+}
+
+// ExecutionDetails ...
+func (c ExperimentsClient) ExecutionDetails(ctx context.Context, id ExecutionId) (result ExecutionDetailsResponse, err error) {
+	req, err := c.preparerForExecutionDetails(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ExecutionDetails", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ExecutionDetails", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForExecutionDetails(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ExecutionDetails", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForExecutionDetails prepares the ExecutionDetails request.
+func (c ExperimentsClient) preparerForExecutionDetails(ctx context.Context, id ExecutionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/getExecutionDetails", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForExecutionDetails handles the response to the ExecutionDetails request. The method always
+// closes the http.Response Body.
+func (c ExperimentsClient) responderForExecutionDetails(resp *http.Response) (result ExecutionDetailsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package experiments
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetExecutionResponse struct {
+	HttpResponse *http.Response
+	Model        *ExperimentExecution
+}
+
+// GetExecution ...
+func (c ExperimentsClient) GetExecution(ctx context.Context, id ExecutionId) (result GetExecutionResponse, err error) {
+	req, err := c.preparerForGetExecution(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "GetExecution", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "GetExecution", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetExecution(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "GetExecution", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetExecution prepares the GetExecution request.
+func (c ExperimentsClient) preparerForGetExecution(ctx context.Context, id ExecutionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetExecution handles the response to the GetExecution request. The method always
+// closes the http.Response Body.
+func (c ExperimentsClient) responderForGetExecution(resp *http.Response) (result GetExecutionResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,183 @@
+package experiments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListAllExecutionsResponse struct {
+	HttpResponse *http.Response
+	Model        *[]ExperimentExecution
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ListAllExecutionsResponse, error)
+}
+
+type ListAllExecutionsCompleteResult struct {
+	Items []ExperimentExecution
+}
+
+func (r ListAllExecutionsResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ListAllExecutionsResponse) LoadMore(ctx context.Context) (resp ListAllExecutionsResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// ListAllExecutions ...
+func (c ExperimentsClient) ListAllExecutions(ctx context.Context, id ExperimentId) (resp ListAllExecutionsResponse, err error) {
+	req, err := c.preparerForListAllExecutions(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ListAllExecutions", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ListAllExecutions", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForListAllExecutions(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ListAllExecutions", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ListAllExecutionsComplete retrieves all of the results into a single object
+func (c ExperimentsClient) ListAllExecutionsComplete(ctx context.Context, id ExperimentId) (ListAllExecutionsCompleteResult, error) {
+	return c.ListAllExecutionsCompleteMatchingPredicate(ctx, id, ExperimentExecutionPredicate{})
+}
+
+// ListAllExecutionsCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c ExperimentsClient) ListAllExecutionsCompleteMatchingPredicate(ctx context.Context, id ExperimentId, predicate ExperimentExecutionPredicate) (resp ListAllExecutionsCompleteResult, err error) {
+	items := make([]ExperimentExecution, 0)
+
+	page, err := c.ListAllExecutions(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ListAllExecutionsCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForListAllExecutions prepares the ListAllExecutions request.
+func (c ExperimentsClient) preparerForListAllExecutions(ctx context.Context, id ExperimentId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/executions", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListAllExecutionsWithNextLink prepares the ListAllExecutions request with the given nextLink token.
+func (c ExperimentsClient) preparerForListAllExecutionsWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListAllExecutions handles the response to the ListAllExecutions request. The method always
+// closes the http.Response Body.
+func (c ExperimentsClient) responderForListAllExecutions(resp *http.Response) (result ListAllExecutionsResponse, err error) {
+	type page struct {
+		Values   []ExperimentExecution `json:"value"`
+		NextLink *string               `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ListAllExecutionsResponse, err error) {
+			req, err := c.preparerForListAllExecutionsWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ListAllExecutions", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ListAllExecutions", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForListAllExecutions(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "experiments.ExperimentsClient", "ListAllExecutions", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
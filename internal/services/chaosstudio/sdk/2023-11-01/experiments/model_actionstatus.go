@@ -0,0 +1,9 @@
+package experiments
+
+type ActionStatus struct {
+	ActionId   *string `json:"actionId,omitempty"`
+	ActionName *string `json:"actionName,omitempty"`
+	EndTime    *string `json:"endTime,omitempty"`
+	StartTime  *string `json:"startTime,omitempty"`
+	Status     *string `json:"status,omitempty"`
+}
@@ -0,0 +1,8 @@
+package experiments
+
+type BranchStatus struct {
+	Actions    *[]ActionStatus `json:"actions,omitempty"`
+	BranchId   *string         `json:"branchId,omitempty"`
+	BranchName *string         `json:"branchName,omitempty"`
+	Status     *string         `json:"status,omitempty"`
+}
@@ -0,0 +1,15 @@
+package experiments
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type Experiment struct {
+	Id         *string                           `json:"id,omitempty"`
+	Identity   *identity.SystemOrUserAssignedMap `json:"identity,omitempty"`
+	Location   string                            `json:"location"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties ExperimentProperties              `json:"properties"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package experiments
+
+type ExperimentExecution struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *ExperimentExecutionProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
@@ -0,0 +1,10 @@
+package experiments
+
+type ExperimentExecutionDetailsProperties struct {
+	FailureReason  *string                                             `json:"failureReason,omitempty"`
+	LastActionAt   *string                                             `json:"lastActionAt,omitempty"`
+	RunInformation *ExperimentExecutionDetailsPropertiesRunInformation `json:"runInformation,omitempty"`
+	StartedAt      *string                                             `json:"startedAt,omitempty"`
+	Status         *string                                             `json:"status,omitempty"`
+	StoppedAt      *string                                             `json:"stoppedAt,omitempty"`
+}
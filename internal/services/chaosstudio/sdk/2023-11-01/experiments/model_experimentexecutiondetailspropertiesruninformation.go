@@ -0,0 +1,5 @@
+package experiments
+
+type ExperimentExecutionDetailsPropertiesRunInformation struct {
+	Steps *[]StepStatus `json:"steps,omitempty"`
+}
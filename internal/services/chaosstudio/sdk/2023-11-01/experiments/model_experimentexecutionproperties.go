@@ -0,0 +1,7 @@
+package experiments
+
+type ExperimentExecutionProperties struct {
+	StartedAt *string `json:"startedAt,omitempty"`
+	Status    *string `json:"status,omitempty"`
+	StoppedAt *string `json:"stoppedAt,omitempty"`
+}
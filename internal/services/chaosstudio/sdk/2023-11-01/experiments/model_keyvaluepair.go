@@ -0,0 +1,6 @@
+package experiments
+
+type KeyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
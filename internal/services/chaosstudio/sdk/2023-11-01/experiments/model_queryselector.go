@@ -0,0 +1,40 @@
+package experiments
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Selector = QuerySelector{}
+
+type QuerySelector struct {
+	Id              string   `json:"id"`
+	QueryString     string   `json:"queryString"`
+	SubscriptionIds []string `json:"subscriptionIds"`
+
+	// Fields inherited from Selector
+}
+
+var _ json.Marshaler = QuerySelector{}
+
+func (s QuerySelector) MarshalJSON() ([]byte, error) {
+	type wrapper QuerySelector
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling QuerySelector: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling QuerySelector: %+v", err)
+	}
+	decoded["type"] = "Query"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling QuerySelector: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,6 @@
+package experiments
+
+type Step struct {
+	Branches []Branch `json:"branches"`
+	Name     string   `json:"name"`
+}
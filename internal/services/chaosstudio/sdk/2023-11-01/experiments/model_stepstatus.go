@@ -0,0 +1,8 @@
+package experiments
+
+type StepStatus struct {
+	Branches *[]BranchStatus `json:"branches,omitempty"`
+	Status   *string         `json:"status,omitempty"`
+	StepId   *string         `json:"stepId,omitempty"`
+	StepName *string         `json:"stepName,omitempty"`
+}
@@ -0,0 +1,9 @@
+package targets
+
+type Target struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   *string                `json:"location,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+	Type       *string                `json:"type,omitempty"`
+}
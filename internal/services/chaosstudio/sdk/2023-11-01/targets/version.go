@@ -0,0 +1,9 @@
+package targets
+
+import "fmt"
+
+const defaultApiVersion = "2023-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/targets/%s", defaultApiVersion)
+}
@@ -0,0 +1,291 @@
+package cognitive
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2021-04-30/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2024-10-01/raipolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCognitiveAccountRaiPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCognitiveAccountRaiPolicyCreate,
+		Read:   resourceCognitiveAccountRaiPolicyRead,
+		Update: resourceCognitiveAccountRaiPolicyUpdate,
+		Delete: resourceCognitiveAccountRaiPolicyDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := raipolicies.ParseRaiPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cognitive_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"base_policy_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"content_filter": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"source": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(raipolicies.RaiPolicyContentSourceCompletion),
+								string(raipolicies.RaiPolicyContentSourcePrompt),
+							}, false),
+						},
+
+						"filter_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"block_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Required: true,
+						},
+
+						"severity_threshold": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(raipolicies.ContentLevelHigh),
+								string(raipolicies.ContentLevelLow),
+								string(raipolicies.ContentLevelMedium),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(raipolicies.RaiPolicyModeAsynchronousFilter),
+					string(raipolicies.RaiPolicyModeBlocking),
+					string(raipolicies.RaiPolicyModeDefault),
+					string(raipolicies.RaiPolicyModeDeferred),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceCognitiveAccountRaiPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := cognitiveservicesaccounts.ParseAccountID(d.Get("cognitive_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := raipolicies.NewRaiPolicyID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cognitive_account_rai_policy", id.ID())
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, expandCognitiveAccountRaiPolicy(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCognitiveAccountRaiPolicyRead(d, meta)
+}
+
+func resourceCognitiveAccountRaiPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := raipolicies.ParseRaiPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RaiPolicyName)
+	d.Set("cognitive_account_id", cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("base_policy_name", props.BasePolicyName)
+
+			mode := ""
+			if props.Mode != nil {
+				mode = string(*props.Mode)
+			}
+			d.Set("mode", mode)
+
+			if err := d.Set("content_filter", flattenCognitiveAccountRaiPolicyContentFilters(props.ContentFilters)); err != nil {
+				return fmt.Errorf("setting `content_filter`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceCognitiveAccountRaiPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := raipolicies.ParseRaiPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, *id, expandCognitiveAccountRaiPolicy(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCognitiveAccountRaiPolicyRead(d, meta)
+}
+
+func resourceCognitiveAccountRaiPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.RaiPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := raipolicies.ParseRaiPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCognitiveAccountRaiPolicy(d *pluginsdk.ResourceData) raipolicies.RaiPolicy {
+	contentFilters := make([]raipolicies.RaiPolicyContentFilter, 0)
+	for _, item := range d.Get("content_filter").([]interface{}) {
+		v := item.(map[string]interface{})
+
+		source := raipolicies.RaiPolicyContentSource(v["source"].(string))
+		filter := raipolicies.RaiPolicyContentFilter{
+			Blocking: utils.Bool(v["block_enabled"].(bool)),
+			Enabled:  utils.Bool(v["filter_enabled"].(bool)),
+			Name:     utils.String(v["name"].(string)),
+			Source:   &source,
+		}
+
+		if severityThreshold := v["severity_threshold"].(string); severityThreshold != "" {
+			level := raipolicies.ContentLevel(severityThreshold)
+			filter.SeverityThreshold = &level
+		}
+
+		contentFilters = append(contentFilters, filter)
+	}
+
+	policy := raipolicies.RaiPolicy{
+		Properties: &raipolicies.RaiPolicyProperties{
+			BasePolicyName: utils.String(d.Get("base_policy_name").(string)),
+			ContentFilters: &contentFilters,
+		},
+	}
+
+	if v, ok := d.GetOk("mode"); ok {
+		mode := raipolicies.RaiPolicyMode(v.(string))
+		policy.Properties.Mode = &mode
+	}
+
+	return policy
+}
+
+func flattenCognitiveAccountRaiPolicyContentFilters(input *[]raipolicies.RaiPolicyContentFilter) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, filter := range *input {
+		name := ""
+		if filter.Name != nil {
+			name = *filter.Name
+		}
+
+		source := ""
+		if filter.Source != nil {
+			source = string(*filter.Source)
+		}
+
+		severityThreshold := ""
+		if filter.SeverityThreshold != nil {
+			severityThreshold = string(*filter.SeverityThreshold)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":               name,
+			"source":             source,
+			"filter_enabled":     filter.Enabled != nil && *filter.Enabled,
+			"block_enabled":      filter.Blocking != nil && *filter.Blocking,
+			"severity_threshold": severityThreshold,
+		})
+	}
+
+	return results
+}
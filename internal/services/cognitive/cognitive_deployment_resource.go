@@ -0,0 +1,385 @@
+package cognitive
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2021-04-30/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/sdk/2024-10-01/deployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCognitiveDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCognitiveDeploymentCreate,
+		Read:   resourceCognitiveDeploymentRead,
+		Update: resourceCognitiveDeploymentUpdate,
+		Delete: resourceCognitiveDeploymentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := deployments.ParseDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cognitive_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"model": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"format": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"version": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Standard",
+								"DataZoneStandard",
+								"GlobalBatch",
+								"GlobalStandard",
+								"DataZoneProvisionedManaged",
+								"GlobalProvisionedManaged",
+								"ProvisionedManaged",
+							}, false),
+						},
+
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"dynamic_throttling_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"rai_policy_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"version_upgrade_option": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(deployments.DeploymentModelVersionUpgradeOptionOnceNewDefaultVersionAvailable),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(deployments.DeploymentModelVersionUpgradeOptionNoAutoUpgrade),
+					string(deployments.DeploymentModelVersionUpgradeOptionOnceCurrentVersionExpired),
+					string(deployments.DeploymentModelVersionUpgradeOptionOnceNewDefaultVersionAvailable),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceCognitiveDeploymentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := cognitiveservicesaccounts.ParseAccountID(d.Get("cognitive_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := deployments.NewDeploymentID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.AccountName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cognitive_deployment", id.ID())
+	}
+
+	// deployments within the same account can't be modified concurrently
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	versionUpgradeOption := deployments.DeploymentModelVersionUpgradeOption(d.Get("version_upgrade_option").(string))
+	payload := deployments.Deployment{
+		Properties: &deployments.DeploymentProperties{
+			DynamicThrottlingEnabled: utils.Bool(d.Get("dynamic_throttling_enabled").(bool)),
+			Model:                    expandCognitiveDeploymentModel(d.Get("model").([]interface{})),
+			VersionUpgradeOption:     &versionUpgradeOption,
+		},
+		Sku: expandCognitiveDeploymentSku(d.Get("sku").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("rai_policy_name"); ok {
+		payload.Properties.RaiPolicyName = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceCognitiveDeploymentRead(d, meta)
+}
+
+func resourceCognitiveDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deployments.ParseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DeploymentName)
+	d.Set("cognitive_account_id", cognitiveservicesaccounts.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if err := d.Set("sku", flattenCognitiveDeploymentSku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			if err := d.Set("model", flattenCognitiveDeploymentModel(props.Model)); err != nil {
+				return fmt.Errorf("setting `model`: %+v", err)
+			}
+
+			dynamicThrottlingEnabled := false
+			if props.DynamicThrottlingEnabled != nil {
+				dynamicThrottlingEnabled = *props.DynamicThrottlingEnabled
+			}
+			d.Set("dynamic_throttling_enabled", dynamicThrottlingEnabled)
+			d.Set("rai_policy_name", props.RaiPolicyName)
+
+			versionUpgradeOption := ""
+			if props.VersionUpgradeOption != nil {
+				versionUpgradeOption = string(*props.VersionUpgradeOption)
+			}
+			d.Set("version_upgrade_option", versionUpgradeOption)
+		}
+	}
+
+	return nil
+}
+
+func resourceCognitiveDeploymentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deployments.ParseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+	payload := *existing.Model
+
+	if d.HasChange("model") {
+		payload.Properties.Model = expandCognitiveDeploymentModel(d.Get("model").([]interface{}))
+	}
+
+	if d.HasChange("sku") {
+		payload.Sku = expandCognitiveDeploymentSku(d.Get("sku").([]interface{}))
+	}
+
+	if d.HasChange("dynamic_throttling_enabled") {
+		payload.Properties.DynamicThrottlingEnabled = utils.Bool(d.Get("dynamic_throttling_enabled").(bool))
+	}
+
+	if d.HasChange("rai_policy_name") {
+		payload.Properties.RaiPolicyName = utils.String(d.Get("rai_policy_name").(string))
+	}
+
+	if d.HasChange("version_upgrade_option") {
+		versionUpgradeOption := deployments.DeploymentModelVersionUpgradeOption(d.Get("version_upgrade_option").(string))
+		payload.Properties.VersionUpgradeOption = &versionUpgradeOption
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceCognitiveDeploymentRead(d, meta)
+}
+
+func resourceCognitiveDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cognitive.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := deployments.ParseDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.AccountName, "azurerm_cognitive_account")
+	defer locks.UnlockByName(id.AccountName, "azurerm_cognitive_account")
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandCognitiveDeploymentModel(input []interface{}) *deployments.DeploymentModel {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	model := &deployments.DeploymentModel{
+		Format: utils.String(v["format"].(string)),
+		Name:   utils.String(v["name"].(string)),
+	}
+
+	if version := v["version"].(string); version != "" {
+		model.Version = utils.String(version)
+	}
+
+	return model
+}
+
+func flattenCognitiveDeploymentModel(input *deployments.DeploymentModel) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	format := ""
+	if input.Format != nil {
+		format = *input.Format
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"format":  format,
+			"name":    name,
+			"version": version,
+		},
+	}
+}
+
+func expandCognitiveDeploymentSku(input []interface{}) *deployments.Sku {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &deployments.Sku{
+		Name:     v["name"].(string),
+		Capacity: utils.Int64(int64(v["capacity"].(int))),
+	}
+}
+
+func flattenCognitiveDeploymentSku(input *deployments.Sku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     input.Name,
+			"capacity": capacity,
+		},
+	}
+}
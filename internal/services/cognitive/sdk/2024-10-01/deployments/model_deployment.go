@@ -0,0 +1,9 @@
+package deployments
+
+type Deployment struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *DeploymentProperties `json:"properties,omitempty"`
+	Sku        *Sku                  `json:"sku,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
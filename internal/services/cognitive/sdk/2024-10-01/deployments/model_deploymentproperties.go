@@ -0,0 +1,10 @@
+package deployments
+
+type DeploymentProperties struct {
+	CurrentCapacity          *int64                               `json:"currentCapacity,omitempty"`
+	DynamicThrottlingEnabled *bool                                `json:"dynamicThrottlingEnabled,omitempty"`
+	Model                    *DeploymentModel                     `json:"model,omitempty"`
+	ProvisioningState        *DeploymentProvisioningState         `json:"provisioningState,omitempty"`
+	RaiPolicyName            *string                              `json:"raiPolicyName,omitempty"`
+	VersionUpgradeOption     *DeploymentModelVersionUpgradeOption `json:"versionUpgradeOption,omitempty"`
+}
@@ -0,0 +1,8 @@
+package raipolicies
+
+type RaiPolicy struct {
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *RaiPolicyProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package raipolicies
+
+type RaiPolicyContentFilter struct {
+	Blocking          *bool                   `json:"blocking,omitempty"`
+	Enabled           *bool                   `json:"enabled,omitempty"`
+	Name              *string                 `json:"name,omitempty"`
+	SeverityThreshold *ContentLevel           `json:"severityThreshold,omitempty"`
+	Source            *RaiPolicyContentSource `json:"source,omitempty"`
+}
@@ -3,17 +3,47 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/communication/mgmt/2020-08-20/communication"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/communicationservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/emailservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/senderusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2024-09-01-preview/smtpusernames"
 )
 
 type Client struct {
-	ServiceClient *communication.ServiceClient
+	ServiceClient               *communication.ServiceClient
+	CommunicationServicesClient *communicationservices.CommunicationServicesClient
+	DomainsClient               *domains.DomainsClient
+	EmailServicesClient         *emailservices.EmailServicesClient
+	SenderUsernamesClient       *senderusernames.SenderUsernamesClient
+	SmtpUsernamesClient         *smtpusernames.SmtpUsernamesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	serviceClient := communication.NewServiceClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&serviceClient.Client, o.ResourceManagerAuthorizer)
 
+	communicationServicesClient := communicationservices.NewCommunicationServicesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&communicationServicesClient.Client, o.ResourceManagerAuthorizer)
+
+	domainsClient := domains.NewDomainsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&domainsClient.Client, o.ResourceManagerAuthorizer)
+
+	emailServicesClient := emailservices.NewEmailServicesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&emailServicesClient.Client, o.ResourceManagerAuthorizer)
+
+	senderUsernamesClient := senderusernames.NewSenderUsernamesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&senderUsernamesClient.Client, o.ResourceManagerAuthorizer)
+
+	smtpUsernamesClient := smtpusernames.NewSmtpUsernamesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&smtpUsernamesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ServiceClient: &serviceClient,
+		ServiceClient:               &serviceClient,
+		CommunicationServicesClient: &communicationServicesClient,
+		DomainsClient:               &domainsClient,
+		EmailServicesClient:         &emailServicesClient,
+		SenderUsernamesClient:       &senderUsernamesClient,
+		SmtpUsernamesClient:         &smtpUsernamesClient,
 	}
 }
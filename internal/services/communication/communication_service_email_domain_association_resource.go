@@ -0,0 +1,213 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/communicationservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const communicationServiceResourceName = "azurerm_communication_service"
+
+type CommunicationServiceEmailDomainAssociationResource struct{}
+
+var _ sdk.Resource = CommunicationServiceEmailDomainAssociationResource{}
+
+type CommunicationServiceEmailDomainAssociationResourceModel struct {
+	CommunicationServiceId string `tfschema:"communication_service_id"`
+	EmailServiceDomainId   string `tfschema:"email_service_domain_id"`
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"communication_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CommunicationServiceID,
+		},
+
+		"email_service_domain_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: domains.ValidateDomainID,
+		},
+	}
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) ModelObject() interface{} {
+	return &CommunicationServiceEmailDomainAssociationResourceModel{}
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) ResourceType() string {
+	return "azurerm_communication_service_email_domain_association"
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.CommunicationServiceEmailDomainAssociationID
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.CommunicationServicesClient
+
+			var model CommunicationServiceEmailDomainAssociationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			communicationServiceId, err := parse.CommunicationServiceID(model.CommunicationServiceId)
+			if err != nil {
+				return err
+			}
+
+			domainId, err := domains.ParseDomainID(model.EmailServiceDomainId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(communicationServiceId.Name, communicationServiceResourceName)
+			defer locks.UnlockByName(communicationServiceId.Name, communicationServiceResourceName)
+
+			id := parse.NewCommunicationServiceEmailDomainAssociationId(*communicationServiceId, *domainId)
+			serviceId := communicationservices.NewCommunicationServiceID(communicationServiceId.SubscriptionId, communicationServiceId.ResourceGroup, communicationServiceId.Name)
+
+			existing, err := client.Get(ctx, serviceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", serviceId, err)
+			}
+
+			linkedDomains := communicationServiceLinkedDomains(existing.Model)
+			if communicationServiceHasLinkedDomain(linkedDomains, *domainId) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			linkedDomains = append(linkedDomains, domainId.ID())
+			payload := communicationservices.CommunicationServiceResourceUpdate{
+				Properties: &communicationservices.CommunicationServiceUpdateProperties{
+					LinkedDomains: &linkedDomains,
+				},
+			}
+
+			if _, err := client.Update(ctx, serviceId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.CommunicationServicesClient
+
+			id, err := parse.CommunicationServiceEmailDomainAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			serviceId := communicationservices.NewCommunicationServiceID(id.CommunicationService.SubscriptionId, id.CommunicationService.ResourceGroup, id.CommunicationService.Name)
+			resp, err := client.Get(ctx, serviceId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", serviceId, err)
+			}
+
+			if !communicationServiceHasLinkedDomain(communicationServiceLinkedDomains(resp.Model), id.EmailServiceDomain) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := CommunicationServiceEmailDomainAssociationResourceModel{
+				CommunicationServiceId: id.CommunicationService.ID(),
+				EmailServiceDomainId:   id.EmailServiceDomain.ID(),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.CommunicationServicesClient
+
+			id, err := parse.CommunicationServiceEmailDomainAssociationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.CommunicationService.Name, communicationServiceResourceName)
+			defer locks.UnlockByName(id.CommunicationService.Name, communicationServiceResourceName)
+
+			serviceId := communicationservices.NewCommunicationServiceID(id.CommunicationService.SubscriptionId, id.CommunicationService.ResourceGroup, id.CommunicationService.Name)
+			existing, err := client.Get(ctx, serviceId)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", serviceId, err)
+			}
+
+			linkedDomains := make([]string, 0)
+			for _, v := range communicationServiceLinkedDomains(existing.Model) {
+				if !strings.EqualFold(v, id.EmailServiceDomain.ID()) {
+					linkedDomains = append(linkedDomains, v)
+				}
+			}
+
+			payload := communicationservices.CommunicationServiceResourceUpdate{
+				Properties: &communicationservices.CommunicationServiceUpdateProperties{
+					LinkedDomains: &linkedDomains,
+				},
+			}
+
+			if _, err := client.Update(ctx, serviceId, payload); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func communicationServiceLinkedDomains(input *communicationservices.CommunicationServiceResource) []string {
+	if input == nil || input.Properties == nil || input.Properties.LinkedDomains == nil {
+		return []string{}
+	}
+
+	return *input.Properties.LinkedDomains
+}
+
+func communicationServiceHasLinkedDomain(linkedDomains []string, domainId domains.DomainId) bool {
+	for _, v := range linkedDomains {
+		if strings.EqualFold(v, domainId.ID()) {
+			return true
+		}
+	}
+
+	return false
+}
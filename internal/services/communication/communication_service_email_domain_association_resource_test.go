@@ -0,0 +1,99 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/communicationservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CommunicationServiceEmailDomainAssociationResource struct{}
+
+func TestAccCommunicationServiceEmailDomainAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_email_domain_association", "test")
+	r := CommunicationServiceEmailDomainAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCommunicationServiceEmailDomainAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_email_domain_association", "test")
+	r := CommunicationServiceEmailDomainAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CommunicationServiceEmailDomainAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceId := communicationservices.NewCommunicationServiceID(id.CommunicationService.SubscriptionId, id.CommunicationService.ResourceGroup, id.CommunicationService.Name)
+	resp, err := client.Communication.CommunicationServicesClient.Get(ctx, serviceId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", serviceId, err)
+	}
+
+	if resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.LinkedDomains != nil {
+		for _, v := range *resp.Model.Properties.LinkedDomains {
+			if strings.EqualFold(v, id.EmailServiceDomain.ID()) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_communication_service" "test" {
+  name                = "acctest-CommunicationService-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  data_location       = "United States"
+}
+
+resource "azurerm_communication_service_email_domain_association" "test" {
+  communication_service_id = azurerm_communication_service.test.id
+  email_service_domain_id  = azurerm_email_communication_service_domain.test.id
+}
+`, EmailCommunicationServiceDomainResource{}.azureManaged(data), data.RandomInteger)
+}
+
+func (r CommunicationServiceEmailDomainAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_communication_service_email_domain_association" "import" {
+  communication_service_id = azurerm_communication_service_email_domain_association.test.communication_service_id
+  email_service_domain_id  = azurerm_communication_service_email_domain_association.test.email_service_domain_id
+}
+`, r.basic(data))
+}
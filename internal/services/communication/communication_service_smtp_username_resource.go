@@ -0,0 +1,209 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2024-09-01-preview/smtpusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CommunicationServiceSmtpUsernameResource struct{}
+
+var _ sdk.ResourceWithUpdate = CommunicationServiceSmtpUsernameResource{}
+
+type CommunicationServiceSmtpUsernameResourceModel struct {
+	Name                   string `tfschema:"name"`
+	CommunicationServiceId string `tfschema:"communication_service_id"`
+	Username               string `tfschema:"username"`
+	EntraApplicationId     string `tfschema:"entra_application_id"`
+	TenantId               string `tfschema:"tenant_id"`
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,62}[a-zA-Z0-9])?$`),
+				"`name` must be between 1 and 64 characters, start and end with a letter or number and may only contain letters, numbers and hyphens",
+			),
+		},
+
+		"communication_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CommunicationServiceID,
+		},
+
+		// this can either be a plain username or an email address on one of the Domains linked to the Communication Service
+		"username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"entra_application_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CommunicationServiceSmtpUsernameResource) ModelObject() interface{} {
+	return &CommunicationServiceSmtpUsernameResourceModel{}
+}
+
+func (r CommunicationServiceSmtpUsernameResource) ResourceType() string {
+	return "azurerm_communication_service_smtp_username"
+}
+
+func (r CommunicationServiceSmtpUsernameResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return smtpusernames.ValidateSmtpUsernameID
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SmtpUsernamesClient
+
+			var model CommunicationServiceSmtpUsernameResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			communicationServiceId, err := parse.CommunicationServiceID(model.CommunicationServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := smtpusernames.NewSmtpUsernameID(communicationServiceId.SubscriptionId, communicationServiceId.ResourceGroup, communicationServiceId.Name, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandCommunicationServiceSmtpUsername(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SmtpUsernamesClient
+
+			id, err := smtpusernames.ParseSmtpUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CommunicationServiceSmtpUsernameResourceModel{
+				Name:                   id.SmtpUsername,
+				CommunicationServiceId: parse.NewCommunicationServiceID(id.SubscriptionId, id.ResourceGroupName, id.CommunicationServiceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.EntraApplicationId = model.Properties.EntraApplicationId
+				state.TenantId = model.Properties.TenantId
+				state.Username = model.Properties.Username
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SmtpUsernamesClient
+
+			id, err := smtpusernames.ParseSmtpUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CommunicationServiceSmtpUsernameResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, expandCommunicationServiceSmtpUsername(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SmtpUsernamesClient
+
+			id, err := smtpusernames.ParseSmtpUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCommunicationServiceSmtpUsername(input CommunicationServiceSmtpUsernameResourceModel) smtpusernames.SmtpUsernameResource {
+	return smtpusernames.SmtpUsernameResource{
+		Properties: smtpusernames.SmtpUsernameProperties{
+			EntraApplicationId: input.EntraApplicationId,
+			TenantId:           input.TenantId,
+			Username:           input.Username,
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2024-09-01-preview/smtpusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CommunicationServiceSmtpUsernameResource struct{}
+
+func TestAccCommunicationServiceSmtpUsername_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_smtp_username", "test")
+	r := CommunicationServiceSmtpUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "acctest-smtp"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCommunicationServiceSmtpUsername_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_smtp_username", "test")
+	r := CommunicationServiceSmtpUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "acctest-smtp"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCommunicationServiceSmtpUsername_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_communication_service_smtp_username", "test")
+	r := CommunicationServiceSmtpUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "acctest-smtp"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "acctest-smtp-updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CommunicationServiceSmtpUsernameResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := smtpusernames.ParseSmtpUsernameID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.SmtpUsernamesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CommunicationServiceSmtpUsernameResource) basic(data acceptance.TestData, username string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_communication_service_smtp_username" "test" {
+  name                     = "acctest-smtp-%d"
+  communication_service_id = azurerm_communication_service.test.id
+  username                 = "%s"
+  entra_application_id     = azuread_application.test.application_id
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger, username)
+}
+
+func (r CommunicationServiceSmtpUsernameResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_communication_service_smtp_username" "import" {
+  name                     = azurerm_communication_service_smtp_username.test.name
+  communication_service_id = azurerm_communication_service_smtp_username.test.communication_service_id
+  username                 = azurerm_communication_service_smtp_username.test.username
+  entra_application_id     = azurerm_communication_service_smtp_username.test.entra_application_id
+  tenant_id                = azurerm_communication_service_smtp_username.test.tenant_id
+}
+`, r.basic(data, "acctest-smtp"))
+}
+
+func (r CommunicationServiceSmtpUsernameResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-smtp-%d"
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = azuread_application.test.application_id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_communication_service.test.id
+  role_definition_name = "Communication and Email Service Owner"
+  principal_id         = azuread_service_principal.test.object_id
+}
+`, CommunicationServiceEmailDomainAssociationResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,368 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/emailservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// azureManagedDomainName is the only name which can be used for a Domain managed by Azure
+const azureManagedDomainName = "AzureManagedDomain"
+
+type EmailCommunicationServiceDomainResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = EmailCommunicationServiceDomainResource{}
+	_ sdk.ResourceWithCustomizeDiff = EmailCommunicationServiceDomainResource{}
+)
+
+type EmailCommunicationServiceDomainResourceModel struct {
+	Name                          string                                `tfschema:"name"`
+	EmailServiceId                string                                `tfschema:"email_service_id"`
+	DomainManagement              string                                `tfschema:"domain_management"`
+	UserEngagementTrackingEnabled bool                                  `tfschema:"user_engagement_tracking_enabled"`
+	Tags                          map[string]string                     `tfschema:"tags"`
+	FromSenderDomain              string                                `tfschema:"from_sender_domain"`
+	MailFromSenderDomain          string                                `tfschema:"mail_from_sender_domain"`
+	VerificationRecords           []EmailDomainVerificationRecordsModel `tfschema:"verification_records"`
+}
+
+type EmailDomainVerificationRecordsModel struct {
+	Domain []EmailDomainVerificationRecordModel `tfschema:"domain"`
+	SPF    []EmailDomainVerificationRecordModel `tfschema:"spf"`
+	DKIM   []EmailDomainVerificationRecordModel `tfschema:"dkim"`
+	DKIM2  []EmailDomainVerificationRecordModel `tfschema:"dkim2"`
+	DMARC  []EmailDomainVerificationRecordModel `tfschema:"dmarc"`
+}
+
+type EmailDomainVerificationRecordModel struct {
+	Name   string `tfschema:"name"`
+	Type   string `tfschema:"type"`
+	Value  string `tfschema:"value"`
+	TTL    int    `tfschema:"ttl"`
+	Status string `tfschema:"status"`
+}
+
+func (r EmailCommunicationServiceDomainResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"email_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: emailservices.ValidateEmailServiceID,
+		},
+
+		"domain_management": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(domains.PossibleValuesForDomainManagement(), false),
+		},
+
+		"user_engagement_tracking_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r EmailCommunicationServiceDomainResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"from_sender_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mail_from_sender_domain": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"verification_records": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"domain": emailDomainVerificationRecordSchema(),
+					"spf":    emailDomainVerificationRecordSchema(),
+					"dkim":   emailDomainVerificationRecordSchema(),
+					"dkim2":  emailDomainVerificationRecordSchema(),
+					"dmarc":  emailDomainVerificationRecordSchema(),
+				},
+			},
+		},
+	}
+}
+
+func emailDomainVerificationRecordSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"value": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"ttl": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"status": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainResource) ModelObject() interface{} {
+	return &EmailCommunicationServiceDomainResourceModel{}
+}
+
+func (r EmailCommunicationServiceDomainResource) ResourceType() string {
+	return "azurerm_email_communication_service_domain"
+}
+
+func (r EmailCommunicationServiceDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return domains.ValidateDomainID
+}
+
+func (r EmailCommunicationServiceDomainResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			name := metadata.ResourceDiff.Get("name").(string)
+			domainManagement := metadata.ResourceDiff.Get("domain_management").(string)
+
+			if domainManagement == string(domains.DomainManagementAzureManaged) && name != azureManagedDomainName {
+				return fmt.Errorf("`name` must be %q when `domain_management` is %q", azureManagedDomainName, domainManagement)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainsClient
+
+			var model EmailCommunicationServiceDomainResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			emailServiceId, err := emailservices.ParseEmailServiceID(model.EmailServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := domains.NewDomainID(emailServiceId.SubscriptionId, emailServiceId.ResourceGroupName, emailServiceId.EmailServiceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := domains.DomainResource{
+				Location: "global",
+				Properties: &domains.DomainProperties{
+					DomainManagement:       domains.DomainManagement(model.DomainManagement),
+					UserEngagementTracking: expandEmailDomainUserEngagementTracking(model.UserEngagementTrackingEnabled),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainsClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EmailCommunicationServiceDomainResourceModel{
+				Name:           id.DomainName,
+				EmailServiceId: emailservices.NewEmailServiceID(id.SubscriptionId, id.ResourceGroupName, id.EmailServiceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DomainManagement = string(props.DomainManagement)
+					state.FromSenderDomain = utils.NormalizeNilableString(props.FromSenderDomain)
+					state.MailFromSenderDomain = utils.NormalizeNilableString(props.MailFromSenderDomain)
+					state.UserEngagementTrackingEnabled = props.UserEngagementTracking != nil && *props.UserEngagementTracking == domains.UserEngagementTrackingEnabled
+					state.VerificationRecords = flattenEmailDomainVerificationRecords(props.VerificationRecords, props.VerificationStates)
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainsClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EmailCommunicationServiceDomainResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := domains.UpdateDomainRequestParameters{
+				Properties: &domains.UpdateDomainProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("user_engagement_tracking_enabled") {
+				payload.Properties.UserEngagementTracking = expandEmailDomainUserEngagementTracking(model.UserEngagementTrackingEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainsClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEmailDomainUserEngagementTracking(input bool) *domains.UserEngagementTracking {
+	userEngagementTracking := domains.UserEngagementTrackingDisabled
+	if input {
+		userEngagementTracking = domains.UserEngagementTrackingEnabled
+	}
+	return &userEngagementTracking
+}
+
+func flattenEmailDomainVerificationRecords(records *domains.DomainPropertiesVerificationRecords, states *domains.DomainPropertiesVerificationStates) []EmailDomainVerificationRecordsModel {
+	if records == nil {
+		return []EmailDomainVerificationRecordsModel{}
+	}
+
+	if states == nil {
+		states = &domains.DomainPropertiesVerificationStates{}
+	}
+
+	return []EmailDomainVerificationRecordsModel{
+		{
+			Domain: flattenEmailDomainVerificationRecord(records.Domain, states.Domain),
+			SPF:    flattenEmailDomainVerificationRecord(records.SPF, states.SPF),
+			DKIM:   flattenEmailDomainVerificationRecord(records.DKIM, states.DKIM),
+			DKIM2:  flattenEmailDomainVerificationRecord(records.DKIMTwo, states.DKIMTwo),
+			DMARC:  flattenEmailDomainVerificationRecord(records.DMARC, states.DMARC),
+		},
+	}
+}
+
+func flattenEmailDomainVerificationRecord(record *domains.DnsRecord, state *domains.VerificationStatusRecord) []EmailDomainVerificationRecordModel {
+	if record == nil {
+		return []EmailDomainVerificationRecordModel{}
+	}
+
+	result := EmailDomainVerificationRecordModel{
+		Name:  utils.NormalizeNilableString(record.Name),
+		Type:  utils.NormalizeNilableString(record.Type),
+		Value: utils.NormalizeNilableString(record.Value),
+	}
+	if record.TTL != nil {
+		result.TTL = int(*record.TTL)
+	}
+	if state != nil && state.Status != nil {
+		result.Status = string(*state.Status)
+	}
+
+	return []EmailDomainVerificationRecordModel{result}
+}
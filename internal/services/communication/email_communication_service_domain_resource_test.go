@@ -0,0 +1,132 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EmailCommunicationServiceDomainResource struct{}
+
+func TestAccEmailCommunicationServiceDomain_azureManaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailCommunicationServiceDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("from_sender_domain").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailCommunicationServiceDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureManaged(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomain_customerManaged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain", "test")
+	r := EmailCommunicationServiceDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customerManaged(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("verification_records.0.domain.0.value").Exists(),
+				check.That(data.ResourceName).Key("verification_records.0.spf.0.value").Exists(),
+				check.That(data.ResourceName).Key("verification_records.0.dkim.0.value").Exists(),
+				check.That(data.ResourceName).Key("verification_records.0.dkim2.0.value").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.customerManaged(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EmailCommunicationServiceDomainResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := domains.ParseDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.DomainsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EmailCommunicationServiceDomainResource) azureManaged(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = "AzureManagedDomain"
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "AzureManaged"
+}
+`, r.template(data))
+}
+
+func (r EmailCommunicationServiceDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "import" {
+  name              = azurerm_email_communication_service_domain.test.name
+  email_service_id  = azurerm_email_communication_service_domain.test.email_service_id
+  domain_management = azurerm_email_communication_service_domain.test.domain_management
+}
+`, r.azureManaged(data))
+}
+
+func (r EmailCommunicationServiceDomainResource) customerManaged(data acceptance.TestData, trackingEnabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name                             = "acctest%d.com"
+  email_service_id                 = azurerm_email_communication_service.test.id
+  domain_management                = "CustomerManaged"
+  user_engagement_tracking_enabled = %t
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, trackingEnabled)
+}
+
+func (r EmailCommunicationServiceDomainResource) template(data acceptance.TestData) string {
+	return EmailCommunicationServiceResource{}.basic(data)
+}
@@ -0,0 +1,209 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/senderusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EmailCommunicationServiceDomainSenderUsernameResource struct{}
+
+var _ sdk.ResourceWithUpdate = EmailCommunicationServiceDomainSenderUsernameResource{}
+
+type EmailCommunicationServiceDomainSenderUsernameResourceModel struct {
+	Name                 string `tfschema:"name"`
+	EmailServiceDomainId string `tfschema:"email_service_domain_id"`
+	DisplayName          string `tfschema:"display_name"`
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]{0,62}[a-zA-Z0-9])?$`),
+				"`name` must be between 1 and 64 characters, start and end with a letter or number and may only contain letters, numbers, periods, hyphens and underscores",
+			),
+		},
+
+		"email_service_domain_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: domains.ValidateDomainID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) ModelObject() interface{} {
+	return &EmailCommunicationServiceDomainSenderUsernameResourceModel{}
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) ResourceType() string {
+	return "azurerm_email_communication_service_domain_sender_username"
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return senderusernames.ValidateSenderUsernameID
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SenderUsernamesClient
+
+			var model EmailCommunicationServiceDomainSenderUsernameResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			domainId, err := domains.ParseDomainID(model.EmailServiceDomainId)
+			if err != nil {
+				return err
+			}
+
+			id := senderusernames.NewSenderUsernameID(domainId.SubscriptionId, domainId.ResourceGroupName, domainId.EmailServiceName, domainId.DomainName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := senderusernames.SenderUsernameResource{
+				Properties: &senderusernames.SenderUsernameProperties{
+					Username: model.Name,
+				},
+			}
+			if model.DisplayName != "" {
+				payload.Properties.DisplayName = utils.String(model.DisplayName)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SenderUsernamesClient
+
+			id, err := senderusernames.ParseSenderUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EmailCommunicationServiceDomainSenderUsernameResourceModel{
+				Name:                 id.SenderUsernameName,
+				EmailServiceDomainId: domains.NewDomainID(id.SubscriptionId, id.ResourceGroupName, id.EmailServiceName, id.DomainName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SenderUsernamesClient
+
+			id, err := senderusernames.ParseSenderUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EmailCommunicationServiceDomainSenderUsernameResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("display_name") {
+				payload.Properties.DisplayName = nil
+				if model.DisplayName != "" {
+					payload.Properties.DisplayName = utils.String(model.DisplayName)
+				}
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.SenderUsernamesClient
+
+			id, err := senderusernames.ParseSenderUsernameID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
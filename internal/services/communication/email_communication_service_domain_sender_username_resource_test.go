@@ -0,0 +1,123 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/senderusernames"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EmailCommunicationServiceDomainSenderUsernameResource struct{}
+
+func TestAccEmailCommunicationServiceDomainSenderUsername_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailCommunicationServiceDomainSenderUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomainSenderUsername_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailCommunicationServiceDomainSenderUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEmailCommunicationServiceDomainSenderUsername_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_sender_username", "test")
+	r := EmailCommunicationServiceDomainSenderUsernameResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := senderusernames.ParseSenderUsernameID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.SenderUsernamesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "test" {
+  name                    = "acctest-sender-%d"
+  email_service_domain_id = azurerm_email_communication_service_domain.test.id
+}
+`, EmailCommunicationServiceDomainResource{}.azureManaged(data), data.RandomInteger)
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "import" {
+  name                    = azurerm_email_communication_service_domain_sender_username.test.name
+  email_service_domain_id = azurerm_email_communication_service_domain_sender_username.test.email_service_domain_id
+}
+`, r.basic(data))
+}
+
+func (r EmailCommunicationServiceDomainSenderUsernameResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_email_communication_service_domain_sender_username" "test" {
+  name                    = "acctest-sender-%d"
+  email_service_domain_id = azurerm_email_communication_service_domain.test.id
+  display_name            = "Acceptance Test"
+}
+`, EmailCommunicationServiceDomainResource{}.azureManaged(data), data.RandomInteger)
+}
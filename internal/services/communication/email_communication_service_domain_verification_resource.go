@@ -0,0 +1,240 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// EmailCommunicationServiceDomainVerificationResource initiates verification of a Customer Managed Domain. This is
+// split out from the Domain itself since the DNS records exported by the Domain must exist before verification starts.
+type EmailCommunicationServiceDomainVerificationResource struct{}
+
+var _ sdk.Resource = EmailCommunicationServiceDomainVerificationResource{}
+
+type EmailCommunicationServiceDomainVerificationResourceModel struct {
+	EmailServiceDomainId string   `tfschema:"email_service_domain_id"`
+	VerificationTypes    []string `tfschema:"verification_types"`
+	WaitForVerification  bool     `tfschema:"wait_for_verification"`
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"email_service_domain_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: domains.ValidateDomainID,
+		},
+
+		"verification_types": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(domains.PossibleValuesForVerificationType(), false),
+			},
+		},
+
+		"wait_for_verification": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) ModelObject() interface{} {
+	return &EmailCommunicationServiceDomainVerificationResourceModel{}
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) ResourceType() string {
+	return "azurerm_email_communication_service_domain_verification"
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return domains.ValidateDomainID
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainsClient
+
+			var model EmailCommunicationServiceDomainVerificationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := domains.ParseDomainID(model.EmailServiceDomainId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			states := emailDomainVerificationStatuses(resp.Model)
+			for _, verificationType := range model.VerificationTypes {
+				switch states[verificationType] {
+				case domains.VerificationStatusVerified, domains.VerificationStatusVerificationInProgress, domains.VerificationStatusVerificationRequested:
+					continue
+				}
+
+				payload := domains.VerificationParameter{
+					VerificationType: domains.VerificationType(verificationType),
+				}
+				if err := client.InitiateVerificationThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("initiating %s verification for %s: %+v", verificationType, *id, err)
+				}
+			}
+
+			if model.WaitForVerification {
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return fmt.Errorf("internal-error: context had no deadline")
+				}
+
+				stateConf := &pluginsdk.StateChangeConf{
+					Pending:    []string{"Pending"},
+					Target:     []string{"Verified"},
+					Refresh:    emailDomainVerificationRefreshFunc(ctx, client, *id, model.VerificationTypes),
+					MinTimeout: 30 * time.Second,
+					Timeout:    time.Until(deadline),
+				}
+
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for verification of %s: %+v", *id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.DomainsClient
+
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state EmailCommunicationServiceDomainVerificationResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.EmailServiceDomainId = id.ID()
+
+			// when a record has failed verification (or was never verified) it's removed from the state,
+			// so that verification is initiated again on the next apply
+			statuses := emailDomainVerificationStatuses(resp.Model)
+			for _, verificationType := range state.VerificationTypes {
+				switch statuses[verificationType] {
+				case "", domains.VerificationStatusNotStarted, domains.VerificationStatusVerificationFailed, domains.VerificationStatusCancellationRequested:
+					log.Printf("[DEBUG] %s verification for %s has status %q - removing from state", verificationType, *id, statuses[verificationType])
+					return metadata.MarkAsGone(id)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := domains.ParseDomainID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// verification can't be revoked, so this is only removed from the state
+			log.Printf("[DEBUG] removing the verification of %s from the state", *id)
+			return nil
+		},
+	}
+}
+
+func emailDomainVerificationStatuses(input *domains.DomainResource) map[string]domains.VerificationStatus {
+	output := make(map[string]domains.VerificationStatus)
+	if input == nil || input.Properties == nil || input.Properties.VerificationStates == nil {
+		return output
+	}
+
+	states := input.Properties.VerificationStates
+	records := map[domains.VerificationType]*domains.VerificationStatusRecord{
+		domains.VerificationTypeDKIM:   states.DKIM,
+		domains.VerificationTypeDKIM2:  states.DKIMTwo,
+		domains.VerificationTypeDMARC:  states.DMARC,
+		domains.VerificationTypeDomain: states.Domain,
+		domains.VerificationTypeSPF:    states.SPF,
+	}
+	for verificationType, record := range records {
+		if record != nil && record.Status != nil {
+			output[string(verificationType)] = *record.Status
+		}
+	}
+
+	return output
+}
+
+func emailDomainVerificationRefreshFunc(ctx context.Context, client *domains.DomainsClient, id domains.DomainId, verificationTypes []string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		statuses := emailDomainVerificationStatuses(resp.Model)
+		pending := make([]string, 0)
+		for _, verificationType := range verificationTypes {
+			switch statuses[verificationType] {
+			case domains.VerificationStatusVerified:
+				continue
+			case domains.VerificationStatusVerificationFailed:
+				return resp, "Failed", fmt.Errorf("%s verification failed - please check the DNS records exported by the Domain have been created", verificationType)
+			}
+			pending = append(pending, verificationType)
+		}
+
+		if len(pending) > 0 {
+			log.Printf("[DEBUG] waiting for %s verification of %s", strings.Join(pending, ", "), id)
+			return resp, "Pending", nil
+		}
+
+		return resp, "Verified", nil
+	}
+}
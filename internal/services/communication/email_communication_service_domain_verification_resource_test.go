@@ -0,0 +1,107 @@
+package communication_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EmailCommunicationServiceDomainVerificationResource struct{}
+
+func TestAccEmailCommunicationServiceDomainVerification_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE_NAME") == "" || os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as `ARM_TEST_DNS_ZONE_NAME` and `ARM_TEST_DNS_ZONE_RESOURCE_GROUP` are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_email_communication_service_domain_verification", "test")
+	r := EmailCommunicationServiceDomainVerificationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		// the verification status is only known once the DNS lookup has completed
+		data.ImportStep("verification_types", "wait_for_verification"),
+	})
+}
+
+func (r EmailCommunicationServiceDomainVerificationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := domains.ParseDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Communication.DomainsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.VerificationStates == nil {
+		return utils.Bool(false), nil
+	}
+
+	states := resp.Model.Properties.VerificationStates
+	for _, record := range []*domains.VerificationStatusRecord{states.Domain, states.SPF} {
+		if record == nil || record.Status == nil || *record.Status == domains.VerificationStatusNotStarted {
+			return utils.Bool(false), nil
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+// basic requires the `ARM_TEST_DNS_ZONE_NAME` / `ARM_TEST_DNS_ZONE_RESOURCE_GROUP` zone to be delegated publicly,
+// since verification performs a DNS lookup against the records exported by the Domain
+func (r EmailCommunicationServiceDomainVerificationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dns_zone" "test" {
+  name                = "%s"
+  resource_group_name = "%s"
+}
+
+resource "azurerm_email_communication_service_domain" "test" {
+  name              = data.azurerm_dns_zone.test.name
+  email_service_id  = azurerm_email_communication_service.test.id
+  domain_management = "CustomerManaged"
+}
+
+locals {
+  records = azurerm_email_communication_service_domain.test.verification_records.0
+}
+
+resource "azurerm_dns_txt_record" "test" {
+  name                = "@"
+  zone_name           = data.azurerm_dns_zone.test.name
+  resource_group_name = data.azurerm_dns_zone.test.resource_group_name
+  ttl                 = 3600
+
+  record {
+    value = local.records.domain.0.value
+  }
+
+  record {
+    value = local.records.spf.0.value
+  }
+}
+
+resource "azurerm_email_communication_service_domain_verification" "test" {
+  email_service_domain_id = azurerm_email_communication_service_domain.test.id
+  verification_types      = ["Domain", "SPF"]
+
+  depends_on = [azurerm_dns_txt_record.test]
+}
+`, EmailCommunicationServiceResource{}.basic(data), os.Getenv("ARM_TEST_DNS_ZONE_NAME"), os.Getenv("ARM_TEST_DNS_ZONE_RESOURCE_GROUP"))
+}
@@ -0,0 +1,210 @@
+package communication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/emailservices"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EmailCommunicationServiceResource struct{}
+
+var _ sdk.ResourceWithUpdate = EmailCommunicationServiceResource{}
+
+type EmailCommunicationServiceResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	DataLocation      string            `tfschema:"data_location"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r EmailCommunicationServiceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.CommunicationServiceName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"data_location": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"Africa",
+				"Asia Pacific",
+				"Australia",
+				"Brazil",
+				"Canada",
+				"Europe",
+				"France",
+				"Germany",
+				"India",
+				"Japan",
+				"Korea",
+				"Norway",
+				"Switzerland",
+				"UAE",
+				"UK",
+				"United States",
+			}, false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r EmailCommunicationServiceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EmailCommunicationServiceResource) ModelObject() interface{} {
+	return &EmailCommunicationServiceResourceModel{}
+}
+
+func (r EmailCommunicationServiceResource) ResourceType() string {
+	return "azurerm_email_communication_service"
+}
+
+func (r EmailCommunicationServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return emailservices.ValidateEmailServiceID
+}
+
+func (r EmailCommunicationServiceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.EmailServicesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EmailCommunicationServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := emailservices.NewEmailServiceID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := emailservices.EmailServiceResource{
+				// The location is always `global` from the Azure Portal
+				Location: "global",
+				Properties: &emailservices.EmailServiceProperties{
+					DataLocation: model.DataLocation,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.EmailServicesClient
+
+			id, err := emailservices.ParseEmailServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EmailCommunicationServiceResourceModel{
+				Name:              id.EmailServiceName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DataLocation = props.DataLocation
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EmailCommunicationServiceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.EmailServicesClient
+
+			id, err := emailservices.ParseEmailServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EmailCommunicationServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := emailservices.EmailServiceResourceUpdate{
+					Tags: &model.Tags,
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EmailCommunicationServiceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Communication.EmailServicesClient
+
+			id, err := emailservices.ParseEmailServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,55 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/sdk/2023-03-31/domains"
+)
+
+var _ resourceid.Formatter = CommunicationServiceEmailDomainAssociationId{}
+
+type CommunicationServiceEmailDomainAssociationId struct {
+	CommunicationService CommunicationServiceId
+	EmailServiceDomain   domains.DomainId
+}
+
+func (id CommunicationServiceEmailDomainAssociationId) ID() string {
+	communicationServiceId := id.CommunicationService.ID()
+	emailServiceDomainId := id.EmailServiceDomain.ID()
+	return fmt.Sprintf("%s|%s", communicationServiceId, emailServiceDomainId)
+}
+
+func (id CommunicationServiceEmailDomainAssociationId) String() string {
+	return fmt.Sprintf("Communication Service / Email Domain Association (%s / %s)", id.CommunicationService.String(), id.EmailServiceDomain.String())
+}
+
+func NewCommunicationServiceEmailDomainAssociationId(communicationService CommunicationServiceId, emailServiceDomain domains.DomainId) CommunicationServiceEmailDomainAssociationId {
+	return CommunicationServiceEmailDomainAssociationId{
+		CommunicationService: communicationService,
+		EmailServiceDomain:   emailServiceDomain,
+	}
+}
+
+func CommunicationServiceEmailDomainAssociationID(input string) (*CommunicationServiceEmailDomainAssociationId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("expected an ID in the format {communicationServiceID}|{emailServiceDomainID} but got %q", input)
+	}
+
+	communicationServiceId, err := CommunicationServiceID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Communication Service ID for Communication Service/Email Domain Association %q: %+v", segments[0], err)
+	}
+
+	emailServiceDomainId, err := domains.ParseDomainID(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("parsing Email Service Domain ID for Communication Service/Email Domain Association %q: %+v", segments[1], err)
+	}
+
+	return &CommunicationServiceEmailDomainAssociationId{
+		CommunicationService: *communicationServiceId,
+		EmailServiceDomain:   *emailServiceDomainId,
+	}, nil
+}
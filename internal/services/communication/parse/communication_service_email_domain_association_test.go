@@ -0,0 +1,65 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestCommunicationServiceEmailDomainAssociationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *CommunicationServiceEmailDomainAssociationId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			// missing the email service domain
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Communication/CommunicationServices/communicationService1",
+			Expected: nil,
+		},
+		{
+			// missing the communication service
+			Input:    "|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Communication/emailServices/emailService1/domains/example.com",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Communication/CommunicationServices/communicationService1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Communication/emailServices/emailService1/domains/example.com",
+			Expected: &CommunicationServiceEmailDomainAssociationId{
+				CommunicationService: CommunicationServiceId{
+					SubscriptionId: "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:  "resGroup1",
+					Name:           "communicationService1",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := CommunicationServiceEmailDomainAssociationID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatal("Expected an error but didn't get one")
+		}
+
+		if actual.CommunicationService.Name != v.Expected.CommunicationService.Name {
+			t.Fatalf("Expected %q but got %q for CommunicationService.Name", v.Expected.CommunicationService.Name, actual.CommunicationService.Name)
+		}
+
+		if actual.EmailServiceDomain.DomainName != "example.com" {
+			t.Fatalf("Expected %q but got %q for EmailServiceDomain.DomainName", "example.com", actual.EmailServiceDomain.DomainName)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("Expected %q but got %q for ID", v.Input, actual.ID())
+		}
+	}
+}
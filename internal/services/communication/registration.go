@@ -1,6 +1,9 @@
 package communication
 
-import "github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
 
 type Registration struct{}
 
@@ -27,3 +30,18 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_communication_service": resourceArmCommunicationService(),
 	}
 }
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		CommunicationServiceEmailDomainAssociationResource{},
+		CommunicationServiceSmtpUsernameResource{},
+		EmailCommunicationServiceResource{},
+		EmailCommunicationServiceDomainResource{},
+		EmailCommunicationServiceDomainSenderUsernameResource{},
+		EmailCommunicationServiceDomainVerificationResource{},
+	}
+}
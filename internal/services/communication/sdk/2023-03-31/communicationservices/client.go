@@ -0,0 +1,15 @@
+package communicationservices
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CommunicationServicesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCommunicationServicesClientWithBaseURI(endpoint string) CommunicationServicesClient {
+	return CommunicationServicesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,52 @@
+package communicationservices
+
+import "strings"
+
+type CommunicationServicesProvisioningState string
+
+const (
+	CommunicationServicesProvisioningStateCanceled  CommunicationServicesProvisioningState = "Canceled"
+	CommunicationServicesProvisioningStateCreating  CommunicationServicesProvisioningState = "Creating"
+	CommunicationServicesProvisioningStateDeleting  CommunicationServicesProvisioningState = "Deleting"
+	CommunicationServicesProvisioningStateFailed    CommunicationServicesProvisioningState = "Failed"
+	CommunicationServicesProvisioningStateMoving    CommunicationServicesProvisioningState = "Moving"
+	CommunicationServicesProvisioningStateRunning   CommunicationServicesProvisioningState = "Running"
+	CommunicationServicesProvisioningStateSucceeded CommunicationServicesProvisioningState = "Succeeded"
+	CommunicationServicesProvisioningStateUnknown   CommunicationServicesProvisioningState = "Unknown"
+	CommunicationServicesProvisioningStateUpdating  CommunicationServicesProvisioningState = "Updating"
+)
+
+func PossibleValuesForCommunicationServicesProvisioningState() []string {
+	return []string{
+		string(CommunicationServicesProvisioningStateCanceled),
+		string(CommunicationServicesProvisioningStateCreating),
+		string(CommunicationServicesProvisioningStateDeleting),
+		string(CommunicationServicesProvisioningStateFailed),
+		string(CommunicationServicesProvisioningStateMoving),
+		string(CommunicationServicesProvisioningStateRunning),
+		string(CommunicationServicesProvisioningStateSucceeded),
+		string(CommunicationServicesProvisioningStateUnknown),
+		string(CommunicationServicesProvisioningStateUpdating),
+	}
+}
+
+func parseCommunicationServicesProvisioningState(input string) (*CommunicationServicesProvisioningState, error) {
+	vals := map[string]CommunicationServicesProvisioningState{
+		"canceled":  CommunicationServicesProvisioningStateCanceled,
+		"creating":  CommunicationServicesProvisioningStateCreating,
+		"deleting":  CommunicationServicesProvisioningStateDeleting,
+		"failed":    CommunicationServicesProvisioningStateFailed,
+		"moving":    CommunicationServicesProvisioningStateMoving,
+		"running":   CommunicationServicesProvisioningStateRunning,
+		"succeeded": CommunicationServicesProvisioningStateSucceeded,
+		"unknown":   CommunicationServicesProvisioningStateUnknown,
+		"updating":  CommunicationServicesProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CommunicationServicesProvisioningState(input)
+	return &out, nil
+}
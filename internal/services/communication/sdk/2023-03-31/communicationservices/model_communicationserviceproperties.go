@@ -0,0 +1,10 @@
+package communicationservices
+
+type CommunicationServiceProperties struct {
+	DataLocation        string                                  `json:"dataLocation"`
+	HostName            *string                                 `json:"hostName,omitempty"`
+	ImmutableResourceId *string                                 `json:"immutableResourceId,omitempty"`
+	LinkedDomains       *[]string                               `json:"linkedDomains,omitempty"`
+	ProvisioningState   *CommunicationServicesProvisioningState `json:"provisioningState,omitempty"`
+	Version             *string                                 `json:"version,omitempty"`
+}
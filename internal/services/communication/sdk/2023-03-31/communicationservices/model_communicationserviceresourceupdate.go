@@ -0,0 +1,6 @@
+package communicationservices
+
+type CommunicationServiceResourceUpdate struct {
+	Properties *CommunicationServiceUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                    `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package communicationservices
+
+type CommunicationServiceUpdateProperties struct {
+	LinkedDomains *[]string `json:"linkedDomains,omitempty"`
+}
@@ -0,0 +1,188 @@
+package domains
+
+import "strings"
+
+type DomainManagement string
+
+const (
+	DomainManagementAzureManaged                    DomainManagement = "AzureManaged"
+	DomainManagementCustomerManaged                 DomainManagement = "CustomerManaged"
+	DomainManagementCustomerManagedInExchangeOnline DomainManagement = "CustomerManagedInExchangeOnline"
+)
+
+func PossibleValuesForDomainManagement() []string {
+	return []string{
+		string(DomainManagementAzureManaged),
+		string(DomainManagementCustomerManaged),
+		string(DomainManagementCustomerManagedInExchangeOnline),
+	}
+}
+
+func parseDomainManagement(input string) (*DomainManagement, error) {
+	vals := map[string]DomainManagement{
+		"azuremanaged":                    DomainManagementAzureManaged,
+		"customermanaged":                 DomainManagementCustomerManaged,
+		"customermanagedinexchangeonline": DomainManagementCustomerManagedInExchangeOnline,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DomainManagement(input)
+	return &out, nil
+}
+
+type DomainsProvisioningState string
+
+const (
+	DomainsProvisioningStateCanceled  DomainsProvisioningState = "Canceled"
+	DomainsProvisioningStateCreating  DomainsProvisioningState = "Creating"
+	DomainsProvisioningStateDeleting  DomainsProvisioningState = "Deleting"
+	DomainsProvisioningStateFailed    DomainsProvisioningState = "Failed"
+	DomainsProvisioningStateMoving    DomainsProvisioningState = "Moving"
+	DomainsProvisioningStateRunning   DomainsProvisioningState = "Running"
+	DomainsProvisioningStateSucceeded DomainsProvisioningState = "Succeeded"
+	DomainsProvisioningStateUnknown   DomainsProvisioningState = "Unknown"
+	DomainsProvisioningStateUpdating  DomainsProvisioningState = "Updating"
+)
+
+func PossibleValuesForDomainsProvisioningState() []string {
+	return []string{
+		string(DomainsProvisioningStateCanceled),
+		string(DomainsProvisioningStateCreating),
+		string(DomainsProvisioningStateDeleting),
+		string(DomainsProvisioningStateFailed),
+		string(DomainsProvisioningStateMoving),
+		string(DomainsProvisioningStateRunning),
+		string(DomainsProvisioningStateSucceeded),
+		string(DomainsProvisioningStateUnknown),
+		string(DomainsProvisioningStateUpdating),
+	}
+}
+
+func parseDomainsProvisioningState(input string) (*DomainsProvisioningState, error) {
+	vals := map[string]DomainsProvisioningState{
+		"canceled":  DomainsProvisioningStateCanceled,
+		"creating":  DomainsProvisioningStateCreating,
+		"deleting":  DomainsProvisioningStateDeleting,
+		"failed":    DomainsProvisioningStateFailed,
+		"moving":    DomainsProvisioningStateMoving,
+		"running":   DomainsProvisioningStateRunning,
+		"succeeded": DomainsProvisioningStateSucceeded,
+		"unknown":   DomainsProvisioningStateUnknown,
+		"updating":  DomainsProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DomainsProvisioningState(input)
+	return &out, nil
+}
+
+type UserEngagementTracking string
+
+const (
+	UserEngagementTrackingDisabled UserEngagementTracking = "Disabled"
+	UserEngagementTrackingEnabled  UserEngagementTracking = "Enabled"
+)
+
+func PossibleValuesForUserEngagementTracking() []string {
+	return []string{
+		string(UserEngagementTrackingDisabled),
+		string(UserEngagementTrackingEnabled),
+	}
+}
+
+func parseUserEngagementTracking(input string) (*UserEngagementTracking, error) {
+	vals := map[string]UserEngagementTracking{
+		"disabled": UserEngagementTrackingDisabled,
+		"enabled":  UserEngagementTrackingEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := UserEngagementTracking(input)
+	return &out, nil
+}
+
+type VerificationStatus string
+
+const (
+	VerificationStatusCancellationRequested  VerificationStatus = "CancellationRequested"
+	VerificationStatusNotStarted             VerificationStatus = "NotStarted"
+	VerificationStatusVerificationFailed     VerificationStatus = "VerificationFailed"
+	VerificationStatusVerificationInProgress VerificationStatus = "VerificationInProgress"
+	VerificationStatusVerificationRequested  VerificationStatus = "VerificationRequested"
+	VerificationStatusVerified               VerificationStatus = "Verified"
+)
+
+func PossibleValuesForVerificationStatus() []string {
+	return []string{
+		string(VerificationStatusCancellationRequested),
+		string(VerificationStatusNotStarted),
+		string(VerificationStatusVerificationFailed),
+		string(VerificationStatusVerificationInProgress),
+		string(VerificationStatusVerificationRequested),
+		string(VerificationStatusVerified),
+	}
+}
+
+func parseVerificationStatus(input string) (*VerificationStatus, error) {
+	vals := map[string]VerificationStatus{
+		"cancellationrequested":  VerificationStatusCancellationRequested,
+		"notstarted":             VerificationStatusNotStarted,
+		"verificationfailed":     VerificationStatusVerificationFailed,
+		"verificationinprogress": VerificationStatusVerificationInProgress,
+		"verificationrequested":  VerificationStatusVerificationRequested,
+		"verified":               VerificationStatusVerified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VerificationStatus(input)
+	return &out, nil
+}
+
+type VerificationType string
+
+const (
+	VerificationTypeDKIM   VerificationType = "DKIM"
+	VerificationTypeDKIM2  VerificationType = "DKIM2"
+	VerificationTypeDMARC  VerificationType = "DMARC"
+	VerificationTypeDomain VerificationType = "Domain"
+	VerificationTypeSPF    VerificationType = "SPF"
+)
+
+func PossibleValuesForVerificationType() []string {
+	return []string{
+		string(VerificationTypeDKIM),
+		string(VerificationTypeDKIM2),
+		string(VerificationTypeDMARC),
+		string(VerificationTypeDomain),
+		string(VerificationTypeSPF),
+	}
+}
+
+func parseVerificationType(input string) (*VerificationType, error) {
+	vals := map[string]VerificationType{
+		"dkim":   VerificationTypeDKIM,
+		"dkim2":  VerificationTypeDKIM2,
+		"dmarc":  VerificationTypeDMARC,
+		"domain": VerificationTypeDomain,
+		"spf":    VerificationTypeSPF,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VerificationType(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package domains
+
+type DnsRecord struct {
+	Name  *string `json:"name,omitempty"`
+	TTL   *int64  `json:"ttl,omitempty"`
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
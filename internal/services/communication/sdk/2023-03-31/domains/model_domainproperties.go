@@ -0,0 +1,12 @@
+package domains
+
+type DomainProperties struct {
+	DataLocation           *string                              `json:"dataLocation,omitempty"`
+	DomainManagement       DomainManagement                     `json:"domainManagement"`
+	FromSenderDomain       *string                              `json:"fromSenderDomain,omitempty"`
+	MailFromSenderDomain   *string                              `json:"mailFromSenderDomain,omitempty"`
+	ProvisioningState      *DomainsProvisioningState            `json:"provisioningState,omitempty"`
+	UserEngagementTracking *UserEngagementTracking              `json:"userEngagementTracking,omitempty"`
+	VerificationRecords    *DomainPropertiesVerificationRecords `json:"verificationRecords,omitempty"`
+	VerificationStates     *DomainPropertiesVerificationStates  `json:"verificationStates,omitempty"`
+}
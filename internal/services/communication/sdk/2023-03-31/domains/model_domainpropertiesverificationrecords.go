@@ -0,0 +1,9 @@
+package domains
+
+type DomainPropertiesVerificationRecords struct {
+	DKIM    *DnsRecord `json:"DKIM,omitempty"`
+	DKIMTwo *DnsRecord `json:"DKIM2,omitempty"`
+	DMARC   *DnsRecord `json:"DMARC,omitempty"`
+	Domain  *DnsRecord `json:"Domain,omitempty"`
+	SPF     *DnsRecord `json:"SPF,omitempty"`
+}
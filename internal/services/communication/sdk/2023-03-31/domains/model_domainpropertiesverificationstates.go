@@ -0,0 +1,9 @@
+package domains
+
+type DomainPropertiesVerificationStates struct {
+	DKIM    *VerificationStatusRecord `json:"DKIM,omitempty"`
+	DKIMTwo *VerificationStatusRecord `json:"DKIM2,omitempty"`
+	DMARC   *VerificationStatusRecord `json:"DMARC,omitempty"`
+	Domain  *VerificationStatusRecord `json:"Domain,omitempty"`
+	SPF     *VerificationStatusRecord `json:"SPF,omitempty"`
+}
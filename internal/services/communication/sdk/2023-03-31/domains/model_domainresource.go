@@ -0,0 +1,10 @@
+package domains
+
+type DomainResource struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *DomainProperties  `json:"properties,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
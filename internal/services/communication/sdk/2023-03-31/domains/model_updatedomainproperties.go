@@ -0,0 +1,5 @@
+package domains
+
+type UpdateDomainProperties struct {
+	UserEngagementTracking *UserEngagementTracking `json:"userEngagementTracking,omitempty"`
+}
@@ -0,0 +1,6 @@
+package domains
+
+type UpdateDomainRequestParameters struct {
+	Properties *UpdateDomainProperties `json:"properties,omitempty"`
+	Tags       *map[string]string      `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package domains
+
+type VerificationParameter struct {
+	VerificationType VerificationType `json:"verificationType"`
+}
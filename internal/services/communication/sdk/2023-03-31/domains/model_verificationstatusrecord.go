@@ -0,0 +1,6 @@
+package domains
+
+type VerificationStatusRecord struct {
+	ErrorCode *string             `json:"errorCode,omitempty"`
+	Status    *VerificationStatus `json:"status,omitempty"`
+}
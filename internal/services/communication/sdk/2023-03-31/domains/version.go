@@ -0,0 +1,9 @@
+package domains
+
+import "fmt"
+
+const defaultApiVersion = "2023-03-31"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/domains/%s", defaultApiVersion)
+}
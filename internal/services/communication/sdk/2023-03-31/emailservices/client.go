@@ -0,0 +1,15 @@
+package emailservices
+
+import "github.com/Azure/go-autorest/autorest"
+
+type EmailServicesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewEmailServicesClientWithBaseURI(endpoint string) EmailServicesClient {
+	return EmailServicesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package emailservices
+
+type EmailServiceProperties struct {
+	DataLocation      string                          `json:"dataLocation"`
+	ProvisioningState *EmailServicesProvisioningState `json:"provisioningState,omitempty"`
+}
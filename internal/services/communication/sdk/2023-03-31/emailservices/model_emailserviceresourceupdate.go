@@ -0,0 +1,5 @@
+package emailservices
+
+type EmailServiceResourceUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
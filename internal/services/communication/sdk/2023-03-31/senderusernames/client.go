@@ -0,0 +1,15 @@
+package senderusernames
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SenderUsernamesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSenderUsernamesClientWithBaseURI(endpoint string) SenderUsernamesClient {
+	return SenderUsernamesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
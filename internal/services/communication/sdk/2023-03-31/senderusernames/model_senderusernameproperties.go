@@ -0,0 +1,8 @@
+package senderusernames
+
+type SenderUsernameProperties struct {
+	DataLocation      *string            `json:"dataLocation,omitempty"`
+	DisplayName       *string            `json:"displayName,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Username          string             `json:"username"`
+}
@@ -0,0 +1,8 @@
+package senderusernames
+
+type SenderUsernameResource struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *SenderUsernameProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
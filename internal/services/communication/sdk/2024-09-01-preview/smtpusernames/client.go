@@ -0,0 +1,15 @@
+package smtpusernames
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SmtpUsernamesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSmtpUsernamesClientWithBaseURI(endpoint string) SmtpUsernamesClient {
+	return SmtpUsernamesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,137 @@
+package smtpusernames
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SmtpUsernameId{}
+
+// SmtpUsernameId is a struct representing the Resource ID for a Smtp Username
+type SmtpUsernameId struct {
+	SubscriptionId           string
+	ResourceGroupName        string
+	CommunicationServiceName string
+	SmtpUsername             string
+}
+
+// NewSmtpUsernameID returns a new SmtpUsernameId struct
+func NewSmtpUsernameID(subscriptionId string, resourceGroupName string, communicationServiceName string, smtpUsername string) SmtpUsernameId {
+	return SmtpUsernameId{
+		SubscriptionId:           subscriptionId,
+		ResourceGroupName:        resourceGroupName,
+		CommunicationServiceName: communicationServiceName,
+		SmtpUsername:             smtpUsername,
+	}
+}
+
+// ParseSmtpUsernameID parses 'input' into a SmtpUsernameId
+func ParseSmtpUsernameID(input string) (*SmtpUsernameId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SmtpUsernameId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SmtpUsernameId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CommunicationServiceName, ok = parsed.Parsed["communicationServiceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'communicationServiceName' was not found in the resource id %q", input)
+	}
+
+	if id.SmtpUsername, ok = parsed.Parsed["smtpUsername"]; !ok {
+		return nil, fmt.Errorf("the segment 'smtpUsername' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseSmtpUsernameIDInsensitively parses 'input' case-insensitively into a SmtpUsernameId
+// note: this method should only be used for API response data and not user input
+func ParseSmtpUsernameIDInsensitively(input string) (*SmtpUsernameId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SmtpUsernameId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SmtpUsernameId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.CommunicationServiceName, ok = parsed.Parsed["communicationServiceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'communicationServiceName' was not found in the resource id %q", input)
+	}
+
+	if id.SmtpUsername, ok = parsed.Parsed["smtpUsername"]; !ok {
+		return nil, fmt.Errorf("the segment 'smtpUsername' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateSmtpUsernameID checks that 'input' can be parsed as a Smtp Username ID
+func ValidateSmtpUsernameID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSmtpUsernameID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Smtp Username ID
+func (id SmtpUsernameId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Communication/communicationServices/%s/smtpUsernames/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.CommunicationServiceName, id.SmtpUsername)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Smtp Username ID
+func (id SmtpUsernameId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCommunication", "Microsoft.Communication", "Microsoft.Communication"),
+		resourceids.StaticSegment("staticCommunicationServices", "communicationServices", "communicationServices"),
+		resourceids.UserSpecifiedSegment("communicationServiceName", "communicationServiceValue"),
+		resourceids.StaticSegment("staticSmtpUsernames", "smtpUsernames", "smtpUsernames"),
+		resourceids.UserSpecifiedSegment("smtpUsername", "smtpUsernameValue"),
+	}
+}
+
+// String returns a human-readable description of this Smtp Username ID
+func (id SmtpUsernameId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Communication Service Name: %q", id.CommunicationServiceName),
+		fmt.Sprintf("Smtp Username: %q", id.SmtpUsername),
+	}
+	return fmt.Sprintf("Smtp Username (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,7 @@
+package smtpusernames
+
+type SmtpUsernameProperties struct {
+	EntraApplicationId string `json:"entraApplicationId"`
+	TenantId           string `json:"tenantId"`
+	Username           string `json:"username"`
+}
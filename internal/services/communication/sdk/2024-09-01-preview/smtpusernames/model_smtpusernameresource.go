@@ -0,0 +1,8 @@
+package smtpusernames
+
+type SmtpUsernameResource struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties SmtpUsernameProperties `json:"properties"`
+	Type       *string                `json:"type,omitempty"`
+}
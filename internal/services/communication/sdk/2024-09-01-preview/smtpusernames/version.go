@@ -0,0 +1,9 @@
+package smtpusernames
+
+import "fmt"
+
+const defaultApiVersion = "2024-09-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/smtpusernames/%s", defaultApiVersion)
+}
@@ -4,32 +4,34 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-02/diskencryptionsets"
 )
 
 type Client struct {
-	AvailabilitySetsClient          *compute.AvailabilitySetsClient
-	DedicatedHostsClient            *compute.DedicatedHostsClient
-	DedicatedHostGroupsClient       *compute.DedicatedHostGroupsClient
-	DisksClient                     *compute.DisksClient
-	DiskAccessClient                *compute.DiskAccessesClient
-	DiskEncryptionSetsClient        *compute.DiskEncryptionSetsClient
-	GalleriesClient                 *compute.GalleriesClient
-	GalleryImagesClient             *compute.GalleryImagesClient
-	GalleryImageVersionsClient      *compute.GalleryImageVersionsClient
-	ProximityPlacementGroupsClient  *compute.ProximityPlacementGroupsClient
-	MarketplaceAgreementsClient     *marketplaceordering.MarketplaceAgreementsClient
-	ImagesClient                    *compute.ImagesClient
-	SnapshotsClient                 *compute.SnapshotsClient
-	UsageClient                     *compute.UsageClient
-	VMExtensionImageClient          *compute.VirtualMachineExtensionImagesClient
-	VMExtensionClient               *compute.VirtualMachineExtensionsClient
-	VMScaleSetClient                *compute.VirtualMachineScaleSetsClient
-	VMScaleSetExtensionsClient      *compute.VirtualMachineScaleSetExtensionsClient
-	VMScaleSetRollingUpgradesClient *compute.VirtualMachineScaleSetRollingUpgradesClient
-	VMScaleSetVMsClient             *compute.VirtualMachineScaleSetVMsClient
-	VMClient                        *compute.VirtualMachinesClient
-	VMImageClient                   *compute.VirtualMachineImagesClient
-	SSHPublicKeysClient             *compute.SSHPublicKeysClient
+	AvailabilitySetsClient            *compute.AvailabilitySetsClient
+	DedicatedHostsClient              *compute.DedicatedHostsClient
+	DedicatedHostGroupsClient         *compute.DedicatedHostGroupsClient
+	DisksClient                       *compute.DisksClient
+	DiskAccessClient                  *compute.DiskAccessesClient
+	DiskEncryptionSetsClient          *compute.DiskEncryptionSetsClient
+	DiskEncryptionSetsV20220302Client *diskencryptionsets.DiskEncryptionSetsClient
+	GalleriesClient                   *compute.GalleriesClient
+	GalleryImagesClient               *compute.GalleryImagesClient
+	GalleryImageVersionsClient        *compute.GalleryImageVersionsClient
+	ProximityPlacementGroupsClient    *compute.ProximityPlacementGroupsClient
+	MarketplaceAgreementsClient       *marketplaceordering.MarketplaceAgreementsClient
+	ImagesClient                      *compute.ImagesClient
+	SnapshotsClient                   *compute.SnapshotsClient
+	UsageClient                       *compute.UsageClient
+	VMExtensionImageClient            *compute.VirtualMachineExtensionImagesClient
+	VMExtensionClient                 *compute.VirtualMachineExtensionsClient
+	VMScaleSetClient                  *compute.VirtualMachineScaleSetsClient
+	VMScaleSetExtensionsClient        *compute.VirtualMachineScaleSetExtensionsClient
+	VMScaleSetRollingUpgradesClient   *compute.VirtualMachineScaleSetRollingUpgradesClient
+	VMScaleSetVMsClient               *compute.VirtualMachineScaleSetVMsClient
+	VMClient                          *compute.VirtualMachinesClient
+	VMImageClient                     *compute.VirtualMachineImagesClient
+	SSHPublicKeysClient               *compute.SSHPublicKeysClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -51,6 +53,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	diskEncryptionSetsClient := compute.NewDiskEncryptionSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&diskEncryptionSetsClient.Client, o.ResourceManagerAuthorizer)
 
+	diskEncryptionSetsV20220302Client := diskencryptionsets.NewDiskEncryptionSetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&diskEncryptionSetsV20220302Client.Client, o.ResourceManagerAuthorizer)
+
 	galleriesClient := compute.NewGalleriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleriesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -103,28 +108,29 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&sshPublicKeysClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		AvailabilitySetsClient:          &availabilitySetsClient,
-		DedicatedHostsClient:            &dedicatedHostsClient,
-		DedicatedHostGroupsClient:       &dedicatedHostGroupsClient,
-		DisksClient:                     &disksClient,
-		DiskAccessClient:                &diskAccessClient,
-		DiskEncryptionSetsClient:        &diskEncryptionSetsClient,
-		GalleriesClient:                 &galleriesClient,
-		GalleryImagesClient:             &galleryImagesClient,
-		GalleryImageVersionsClient:      &galleryImageVersionsClient,
-		ImagesClient:                    &imagesClient,
-		MarketplaceAgreementsClient:     &marketplaceAgreementsClient,
-		ProximityPlacementGroupsClient:  &proximityPlacementGroupsClient,
-		SnapshotsClient:                 &snapshotsClient,
-		UsageClient:                     &usageClient,
-		VMExtensionImageClient:          &vmExtensionImageClient,
-		VMExtensionClient:               &vmExtensionClient,
-		VMScaleSetClient:                &vmScaleSetClient,
-		VMScaleSetExtensionsClient:      &vmScaleSetExtensionsClient,
-		VMScaleSetRollingUpgradesClient: &vmScaleSetRollingUpgradesClient,
-		VMScaleSetVMsClient:             &vmScaleSetVMsClient,
-		VMClient:                        &vmClient,
-		VMImageClient:                   &vmImageClient,
-		SSHPublicKeysClient:             &sshPublicKeysClient,
+		AvailabilitySetsClient:            &availabilitySetsClient,
+		DedicatedHostsClient:              &dedicatedHostsClient,
+		DedicatedHostGroupsClient:         &dedicatedHostGroupsClient,
+		DisksClient:                       &disksClient,
+		DiskAccessClient:                  &diskAccessClient,
+		DiskEncryptionSetsClient:          &diskEncryptionSetsClient,
+		DiskEncryptionSetsV20220302Client: &diskEncryptionSetsV20220302Client,
+		GalleriesClient:                   &galleriesClient,
+		GalleryImagesClient:               &galleryImagesClient,
+		GalleryImageVersionsClient:        &galleryImageVersionsClient,
+		ImagesClient:                      &imagesClient,
+		MarketplaceAgreementsClient:       &marketplaceAgreementsClient,
+		ProximityPlacementGroupsClient:    &proximityPlacementGroupsClient,
+		SnapshotsClient:                   &snapshotsClient,
+		UsageClient:                       &usageClient,
+		VMExtensionImageClient:            &vmExtensionImageClient,
+		VMExtensionClient:                 &vmExtensionClient,
+		VMScaleSetClient:                  &vmScaleSetClient,
+		VMScaleSetExtensionsClient:        &vmScaleSetExtensionsClient,
+		VMScaleSetRollingUpgradesClient:   &vmScaleSetRollingUpgradesClient,
+		VMScaleSetVMsClient:               &vmScaleSetVMsClient,
+		VMClient:                          &vmClient,
+		VMImageClient:                     &vmImageClient,
+		SSHPublicKeysClient:               &sshPublicKeysClient,
 	}
 }
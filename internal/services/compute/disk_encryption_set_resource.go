@@ -2,18 +2,26 @@ package compute
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/sdk/2022-03-02/diskencryptionsets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultV73 "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.3/keyvault"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	resourcesClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -38,7 +46,7 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 		},
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DiskEncryptionSetID(id)
+			_, err := diskencryptionsets.ParseDiskEncryptionSetID(id)
 			return err
 		}),
 
@@ -55,9 +63,10 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"key_vault_key_id": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: keyVaultValidate.NestedItemId,
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     keyVaultValidate.NestedItemIdWithOptionalVersion,
+				DiffSuppressFunc: diskEncryptionSetKeyVaultKeyIdDiffSuppress,
 			},
 
 			"auto_key_rotation_enabled": {
@@ -65,14 +74,21 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"federated_client_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"encryption_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				Default:  string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
+				Default:  string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
 				ValidateFunc: validation.StringInSlice([]string{
-					string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
-					string(compute.DiskEncryptionSetTypeEncryptionAtRestWithPlatformAndCustomerKeys),
+					string(diskencryptionsets.DiskEncryptionSetTypeConfidentialVmEncryptedWithCustomerKey),
+					string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey),
+					string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithPlatformAndCustomerKeys),
 				}, false),
 			},
 
@@ -89,9 +105,19 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(compute.DiskEncryptionSetIdentityTypeSystemAssigned),
+								string(identity.TypeSystemAssigned),
+								string(identity.TypeUserAssigned),
+								string(identity.TypeSystemAssignedUserAssigned),
 							}, false),
 						},
+						"identity_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+							},
+						},
 						"principal_id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -110,26 +136,30 @@ func resourceDiskEncryptionSet() *pluginsdk.Resource {
 }
 
 func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
+	client := meta.(*clients.Client).Compute.DiskEncryptionSetsV20220302Client
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	resourcesClient := meta.(*clients.Client).Resource
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
-
-	existing, err := client.Get(ctx, resourceGroup, name)
+	id := diskencryptionsets.NewDiskEncryptionSetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for present of existing Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
-	if existing.ID != nil && *existing.ID != "" {
-		return tf.ImportAsExistsError("azurerm_disk_encryption_set", *existing.ID)
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_disk_encryption_set", id.ID())
 	}
 
 	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+	rotationToLatestKeyVersionEnabled := d.Get("auto_key_rotation_enabled").(bool)
+	if err := diskEncryptionSetValidateKeyVaultKeyVersion(keyVaultKeyId, rotationToLatestKeyVersionEnabled); err != nil {
+		return err
+	}
+
 	keyVaultDetails, err := diskEncryptionSetRetrieveKeyVault(ctx, keyVaultsClient, resourcesClient, keyVaultKeyId)
 	if err != nil {
 		return fmt.Errorf("validating Key Vault Key %q for Disk Encryption Set: %+v", keyVaultKeyId, err)
@@ -141,111 +171,150 @@ func resourceDiskEncryptionSetCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("validating Key Vault %q (Resource Group %q) for Disk Encryption Set: Purge Protection must be enabled but it isn't!", keyVaultDetails.keyVaultName, keyVaultDetails.resourceGroupName)
 	}
 
-	location := azure.NormalizeLocation(d.Get("location").(string))
-	rotationToLatestKeyVersionEnabled := d.Get("auto_key_rotation_enabled").(bool)
-	encryptionType := d.Get("encryption_type").(string)
-	identityRaw := d.Get("identity").([]interface{})
-	t := d.Get("tags").(map[string]interface{})
-
-	params := compute.DiskEncryptionSet{
-		Location: utils.String(location),
-		EncryptionSetProperties: &compute.EncryptionSetProperties{
-			ActiveKey: &compute.KeyForDiskEncryptionSet{
-				KeyURL: utils.String(keyVaultKeyId),
-				SourceVault: &compute.SourceVault{
-					ID: utils.String(keyVaultDetails.keyVaultId),
+	encryptionType := diskencryptionsets.DiskEncryptionSetType(d.Get("encryption_type").(string))
+	if encryptionType == diskencryptionsets.DiskEncryptionSetTypeConfidentialVmEncryptedWithCustomerKey {
+		if err := diskEncryptionSetValidateKeyReleasePolicy(ctx, keyVaultsClient, keyVaultKeyId); err != nil {
+			return fmt.Errorf("validating Key Vault Key %q for Confidential VM encryption: %+v", keyVaultKeyId, err)
+		}
+	}
+
+	identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	params := diskencryptionsets.DiskEncryptionSet{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &diskencryptionsets.EncryptionSetProperties{
+			ActiveKey: &diskencryptionsets.KeyForDiskEncryptionSet{
+				KeyUrl: keyVaultKeyId,
+				SourceVault: &diskencryptionsets.SourceVault{
+					Id: utils.String(keyVaultDetails.keyVaultId),
 				},
 			},
 			RotationToLatestKeyVersionEnabled: utils.Bool(rotationToLatestKeyVersionEnabled),
-			EncryptionType:                    compute.DiskEncryptionSetType(encryptionType),
+			EncryptionType:                    &encryptionType,
 		},
-		Identity: expandDiskEncryptionSetIdentity(identityRaw),
-		Tags:     tags.Expand(t),
+		Identity: identityValue,
+		Tags:     tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, params)
-	if err != nil {
-		return fmt.Errorf("creating Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	// a Federated Client ID allows the Key to be accessed in another tenant through a User Assigned Identity
+	if federatedClientId := d.Get("federated_client_id").(string); federatedClientId != "" {
+		params.Properties.FederatedClientId = utils.String(federatedClientId)
 	}
 
-	resp, err := client.Get(ctx, resourceGroup, name)
-	if err != nil {
-		return fmt.Errorf("retrieving Disk Encryption Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if err := client.CreateOrUpdateThenPoll(ctx, id, params); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
-	if resp.ID == nil {
-		return fmt.Errorf("Cannot read Disk Encryption Set %q (Resource Group %q) ID", name, resourceGroup)
-	}
-	d.SetId(*resp.ID)
+
+	d.SetId(id.ID())
 
 	return resourceDiskEncryptionSetRead(d, meta)
 }
 
 func resourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
+	client := meta.(*clients.Client).Compute.DiskEncryptionSetsV20220302Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[INFO] Disk Encryption Set %q does not exist - removing from state", d.Id())
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("reading Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-
-	if props := resp.EncryptionSetProperties; props != nil {
-		keyVaultKeyId := ""
-		if props.ActiveKey != nil && props.ActiveKey.KeyURL != nil {
-			keyVaultKeyId = *props.ActiveKey.KeyURL
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DiskEncryptionSetName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if props := model.Properties; props != nil {
+			rotationToLatestKeyVersionEnabled := props.RotationToLatestKeyVersionEnabled != nil && *props.RotationToLatestKeyVersionEnabled
+
+			keyVaultKeyId := ""
+			if props.ActiveKey != nil {
+				keyVaultKeyId = props.ActiveKey.KeyUrl
+			}
+
+			// when the Key is rotated automatically the Active Key is updated to the latest version, so a versionless
+			// Key ID in the config is kept as-is to avoid a diff
+			if rotationToLatestKeyVersionEnabled && keyVaultKeyId != "" {
+				if configKeyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("key_vault_key_id").(string)); err == nil && configKeyId.Version == "" {
+					activeKeyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyVaultKeyId)
+					if err != nil {
+						return err
+					}
+					keyVaultKeyId = activeKeyId.VersionlessID()
+				}
+			}
+			d.Set("key_vault_key_id", keyVaultKeyId)
+			d.Set("auto_key_rotation_enabled", rotationToLatestKeyVersionEnabled)
+
+			federatedClientId := ""
+			if props.FederatedClientId != nil && *props.FederatedClientId != "None" {
+				federatedClientId = *props.FederatedClientId
+			}
+			d.Set("federated_client_id", federatedClientId)
+
+			encryptionType := string(diskencryptionsets.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey)
+			if props.EncryptionType != nil {
+				encryptionType = string(*props.EncryptionType)
+			}
+			d.Set("encryption_type", encryptionType)
 		}
-		d.Set("key_vault_key_id", keyVaultKeyId)
-		d.Set("auto_key_rotation_enabled", props.RotationToLatestKeyVersionEnabled)
 
-		encryptionType := string(compute.DiskEncryptionSetTypeEncryptionAtRestWithCustomerKey)
-		if props.EncryptionType != "" {
-			encryptionType = string(props.EncryptionType)
+		flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
 		}
-		d.Set("encryption_type", encryptionType)
-	}
 
-	if err := d.Set("identity", flattenDiskEncryptionSetIdentity(resp.Identity)); err != nil {
-		return fmt.Errorf("setting `identity`: %+v", err)
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
+	client := meta.(*clients.Client).Compute.DiskEncryptionSetsV20220302Client
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	update := compute.DiskEncryptionSetUpdate{}
+	update := diskencryptionsets.DiskEncryptionSetUpdate{}
 	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		update.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if d.HasChange("identity") {
+		identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		update.Identity = identityValue
+	}
+
+	if err := diskEncryptionSetValidateKeyVaultKeyVersion(d.Get("key_vault_key_id").(string), d.Get("auto_key_rotation_enabled").(bool)); err != nil {
+		return err
 	}
 
 	if d.HasChange("key_vault_key_id") {
@@ -260,86 +329,95 @@ func resourceDiskEncryptionSetUpdate(d *pluginsdk.ResourceData, meta interface{}
 		if !keyVaultDetails.purgeProtectionEnabled {
 			return fmt.Errorf("validating Key Vault %q (Resource Group %q) for Disk Encryption Set: Purge Protection must be enabled but it isn't!", keyVaultDetails.keyVaultName, keyVaultDetails.resourceGroupName)
 		}
-		update.DiskEncryptionSetUpdateProperties = &compute.DiskEncryptionSetUpdateProperties{
-			ActiveKey: &compute.KeyForDiskEncryptionSet{
-				KeyURL: utils.String(keyVaultKeyId),
-				SourceVault: &compute.SourceVault{
-					ID: utils.String(keyVaultDetails.keyVaultId),
+		if d.Get("encryption_type").(string) == string(diskencryptionsets.DiskEncryptionSetTypeConfidentialVmEncryptedWithCustomerKey) {
+			if err := diskEncryptionSetValidateKeyReleasePolicy(ctx, keyVaultsClient, keyVaultKeyId); err != nil {
+				return fmt.Errorf("validating Key Vault Key %q for Confidential VM encryption: %+v", keyVaultKeyId, err)
+			}
+		}
+		update.Properties = &diskencryptionsets.DiskEncryptionSetUpdateProperties{
+			ActiveKey: &diskencryptionsets.KeyForDiskEncryptionSet{
+				KeyUrl: keyVaultKeyId,
+				SourceVault: &diskencryptionsets.SourceVault{
+					Id: utils.String(keyVaultDetails.keyVaultId),
 				},
 			},
 		}
 	}
 
 	if d.HasChange("auto_key_rotation_enabled") {
-		if update.DiskEncryptionSetUpdateProperties == nil {
-			update.DiskEncryptionSetUpdateProperties = &compute.DiskEncryptionSetUpdateProperties{}
+		if update.Properties == nil {
+			update.Properties = &diskencryptionsets.DiskEncryptionSetUpdateProperties{}
 		}
 
-		update.DiskEncryptionSetUpdateProperties.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("auto_key_rotation_enabled").(bool))
+		update.Properties.RotationToLatestKeyVersionEnabled = utils.Bool(d.Get("auto_key_rotation_enabled").(bool))
 	}
 
-	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
-	if err != nil {
-		return fmt.Errorf("updating Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if d.HasChange("federated_client_id") {
+		if update.Properties == nil {
+			update.Properties = &diskencryptionsets.DiskEncryptionSetUpdateProperties{}
+		}
+
+		// the API requires `None` to be sent to remove the Federated Client ID
+		federatedClientId := "None"
+		if v := d.Get("federated_client_id").(string); v != "" {
+			federatedClientId = v
+		}
+		update.Properties.FederatedClientId = utils.String(federatedClientId)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+
+	if err := client.UpdateThenPoll(ctx, *id, update); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
 	return resourceDiskEncryptionSetRead(d, meta)
 }
 
 func resourceDiskEncryptionSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Compute.DiskEncryptionSetsClient
+	client := meta.(*clients.Client).Compute.DiskEncryptionSetsV20220302Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DiskEncryptionSetID(d.Id())
+	id, err := diskencryptionsets.ParseDiskEncryptionSetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deleting Disk Encryption Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
 	return nil
 }
 
-func expandDiskEncryptionSetIdentity(input []interface{}) *compute.EncryptionSetIdentity {
-	val := input[0].(map[string]interface{})
-	return &compute.EncryptionSetIdentity{
-		Type: compute.DiskEncryptionSetIdentityType(val["type"].(string)),
+// diskEncryptionSetKeyVaultKeyIdDiffSuppress ignores changes to the version of the Key when it's rotated automatically
+func diskEncryptionSetKeyVaultKeyIdDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	if !d.Get("auto_key_rotation_enabled").(bool) || old == "" || new == "" {
+		return false
 	}
-}
 
-func flattenDiskEncryptionSetIdentity(input *compute.EncryptionSetIdentity) []interface{} {
-	if input == nil {
-		return make([]interface{}, 0)
+	oldKeyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(old)
+	if err != nil {
+		return false
 	}
-
-	identityType := string(input.Type)
-	principalId := ""
-	if input.PrincipalID != nil {
-		principalId = *input.PrincipalID
+	newKeyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(new)
+	if err != nil {
+		return false
 	}
-	tenantId := ""
-	if input.TenantID != nil {
-		tenantId = *input.TenantID
+
+	return strings.EqualFold(oldKeyId.VersionlessID(), newKeyId.VersionlessID())
+}
+
+func diskEncryptionSetValidateKeyVaultKeyVersion(keyVaultKeyId string, rotationToLatestKeyVersionEnabled bool) error {
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyVaultKeyId)
+	if err != nil {
+		return err
 	}
 
-	return []interface{}{
-		map[string]interface{}{
-			"type":         identityType,
-			"principal_id": principalId,
-			"tenant_id":    tenantId,
-		},
+	if keyId.Version == "" && !rotationToLatestKeyVersionEnabled {
+		return fmt.Errorf("a versionless `key_vault_key_id` can only be used when `auto_key_rotation_enabled` is set to `true`")
 	}
+
+	return nil
 }
 
 type diskEncryptionSetKeyVault struct {
@@ -351,7 +429,7 @@ type diskEncryptionSetKeyVault struct {
 }
 
 func diskEncryptionSetRetrieveKeyVault(ctx context.Context, keyVaultsClient *client.Client, resourcesClient *resourcesClient.Client, id string) (*diskEncryptionSetKeyVault, error) {
-	keyVaultKeyId, err := keyVaultParse.ParseNestedItemID(id)
+	keyVaultKeyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(id)
 	if err != nil {
 		return nil, err
 	}
@@ -394,3 +472,49 @@ func diskEncryptionSetRetrieveKeyVault(ctx context.Context, keyVaultsClient *cli
 		softDeleteEnabled:      softDeleteEnabled,
 	}, nil
 }
+
+// diskEncryptionSetValidateKeyReleasePolicy ensures that the Key Vault Key can be released to a Confidential VM, which
+// requires an exportable HSM-backed RSA Key with a Secure Key Release policy - otherwise provisioning the Disk fails
+func diskEncryptionSetValidateKeyReleasePolicy(ctx context.Context, keyVaultsClient *client.Client, id string) error {
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(id)
+	if err != nil {
+		return err
+	}
+
+	resp, err := keyVaultsClient.ManagementV73Client.GetKey(ctx, keyId.KeyVaultBaseUrl, keyId.Name, keyId.Version)
+	if err != nil {
+		return fmt.Errorf("retrieving Key %q (Key Vault %q): %+v", keyId.Name, keyId.KeyVaultBaseUrl, err)
+	}
+
+	if resp.Key == nil || resp.Key.Kty != keyvaultV73.RSAHSM {
+		return fmt.Errorf("the Key must be of type %q", string(keyvaultV73.RSAHSM))
+	}
+	if resp.Attributes == nil || resp.Attributes.Exportable == nil || !*resp.Attributes.Exportable {
+		return fmt.Errorf("the Key must be exportable")
+	}
+	if resp.ReleasePolicy == nil || resp.ReleasePolicy.EncodedPolicy == nil || *resp.ReleasePolicy.EncodedPolicy == "" {
+		return fmt.Errorf("the Key must have a release policy")
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*resp.ReleasePolicy.EncodedPolicy, "="))
+	if err != nil {
+		return fmt.Errorf("decoding the release policy: %+v", err)
+	}
+
+	var policy struct {
+		Version string        `json:"version"`
+		AnyOf   []interface{} `json:"anyOf"`
+		AllOf   []interface{} `json:"allOf"`
+	}
+	if err := json.Unmarshal(decoded, &policy); err != nil {
+		return fmt.Errorf("unmarshaling the release policy: %+v", err)
+	}
+	if policy.Version == "" {
+		return fmt.Errorf("the release policy must specify a `version`")
+	}
+	if len(policy.AnyOf) == 0 && len(policy.AllOf) == 0 {
+		return fmt.Errorf("the release policy must contain at least one `anyOf` or `allOf` rule")
+	}
+
+	return nil
+}
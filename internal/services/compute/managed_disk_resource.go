@@ -500,6 +500,11 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("max_shares") {
+		// unlike the performance settings, the number of shares can only be changed whilst the disk is detached
+		if disk.ManagedBy != nil || (disk.ManagedByExtended != nil && len(*disk.ManagedByExtended) > 0) {
+			return fmt.Errorf("`max_shares` can only be changed when Managed Disk %q (Resource Group %q) is not attached to any Virtual Machines", name, resourceGroup)
+		}
+
 		diskUpdate.MaxShares = utils.Int32(int32(maxShares))
 		var skuName compute.DiskStorageAccountTypes
 		for _, v := range compute.PossibleDiskStorageAccountTypesValues() {
@@ -539,6 +544,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// the performance of an UltraSSD disk can be changed whilst it's attached, so the Virtual Machine isn't shut down
 	if strings.EqualFold(storageAccountType, string(compute.StorageAccountTypesUltraSSDLRS)) {
 		if d.HasChange("disk_iops_read_write") {
 			v := d.Get("disk_iops_read_write")
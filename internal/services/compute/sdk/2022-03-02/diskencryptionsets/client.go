@@ -0,0 +1,15 @@
+package diskencryptionsets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DiskEncryptionSetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDiskEncryptionSetsClientWithBaseURI(endpoint string) DiskEncryptionSetsClient {
+	return DiskEncryptionSetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -27,8 +27,9 @@ import (
 )
 
 // NOTE: the `azurerm_virtual_machine_scale_set` resource has been superseded by the
-//       `azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set` resources
-//       and as such this resource is feature-frozen and new functionality will be added to these new resources instead.
+//
+//	`azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set` resources
+//	and as such this resource is feature-frozen and new functionality will be added to these new resources instead.
 func resourceVirtualMachineScaleSet() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVirtualMachineScaleSetCreateUpdate,
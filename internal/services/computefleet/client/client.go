@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/computefleet/sdk/2024-11-01/fleets"
+)
+
+type Client struct {
+	FleetsClient *fleets.FleetsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	fleetsClient := fleets.NewFleetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fleetsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		FleetsClient: &fleetsClient,
+	}
+}
@@ -0,0 +1,1177 @@
+package computefleet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/computefleet/sdk/2024-11-01/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/computefleet/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ComputeFleetResource struct{}
+
+var _ sdk.ResourceWithUpdate = ComputeFleetResource{}
+
+type ComputeFleetResourceModel struct {
+	Name                     string                               `tfschema:"name"`
+	ResourceGroupName        string                               `tfschema:"resource_group_name"`
+	Location                 string                               `tfschema:"location"`
+	SpotPriorityProfile      []ComputeFleetSpotPriorityProfile    `tfschema:"spot_priority_profile"`
+	RegularPriorityProfile   []ComputeFleetRegularPriorityProfile `tfschema:"regular_priority_profile"`
+	VMSizesProfile           []ComputeFleetVMSizeProfile          `tfschema:"vm_sizes_profile"`
+	VirtualMachineProfile    []ComputeFleetVirtualMachineProfile  `tfschema:"virtual_machine_profile"`
+	ComputeApiVersion        string                               `tfschema:"compute_api_version"`
+	PlatformFaultDomainCount int64                                `tfschema:"platform_fault_domain_count"`
+	Zones                    []string                             `tfschema:"zones"`
+	Tags                     map[string]string                    `tfschema:"tags"`
+	UniqueId                 string                               `tfschema:"unique_id"`
+}
+
+type ComputeFleetSpotPriorityProfile struct {
+	AllocationStrategy  string  `tfschema:"allocation_strategy"`
+	Capacity            int64   `tfschema:"capacity"`
+	EvictionPolicy      string  `tfschema:"eviction_policy"`
+	MaintainEnabled     bool    `tfschema:"maintain_enabled"`
+	MaxHourlyPricePerVM float64 `tfschema:"max_hourly_price_per_vm"`
+	MinCapacity         int64   `tfschema:"min_capacity"`
+}
+
+type ComputeFleetRegularPriorityProfile struct {
+	AllocationStrategy string `tfschema:"allocation_strategy"`
+	Capacity           int64  `tfschema:"capacity"`
+	MinCapacity        int64  `tfschema:"min_capacity"`
+}
+
+type ComputeFleetVMSizeProfile struct {
+	Name string `tfschema:"name"`
+	Rank int64  `tfschema:"rank"`
+}
+
+type ComputeFleetVirtualMachineProfile struct {
+	NetworkApiVersion    string                             `tfschema:"network_api_version"`
+	NetworkInterface     []ComputeFleetNetworkInterface     `tfschema:"network_interface"`
+	OsDisk               []ComputeFleetOsDisk               `tfschema:"os_disk"`
+	OsProfile            []ComputeFleetOsProfile            `tfschema:"os_profile"`
+	SourceImageId        string                             `tfschema:"source_image_id"`
+	SourceImageReference []ComputeFleetSourceImageReference `tfschema:"source_image_reference"`
+}
+
+type ComputeFleetNetworkInterface struct {
+	Name                         string                        `tfschema:"name"`
+	AcceleratedNetworkingEnabled bool                          `tfschema:"accelerated_networking_enabled"`
+	IPForwardingEnabled          bool                          `tfschema:"ip_forwarding_enabled"`
+	IPConfiguration              []ComputeFleetIPConfiguration `tfschema:"ip_configuration"`
+	Primary                      bool                          `tfschema:"primary"`
+}
+
+type ComputeFleetIPConfiguration struct {
+	Name     string `tfschema:"name"`
+	Primary  bool   `tfschema:"primary"`
+	SubnetId string `tfschema:"subnet_id"`
+}
+
+type ComputeFleetOsDisk struct {
+	Caching            string `tfschema:"caching"`
+	DiskSizeInGB       int64  `tfschema:"disk_size_in_gb"`
+	StorageAccountType string `tfschema:"storage_account_type"`
+}
+
+type ComputeFleetOsProfile struct {
+	CustomData           string                             `tfschema:"custom_data"`
+	LinuxConfiguration   []ComputeFleetLinuxConfiguration   `tfschema:"linux_configuration"`
+	WindowsConfiguration []ComputeFleetWindowsConfiguration `tfschema:"windows_configuration"`
+}
+
+type ComputeFleetLinuxConfiguration struct {
+	AdminPassword                 string   `tfschema:"admin_password"`
+	AdminSshKeys                  []string `tfschema:"admin_ssh_keys"`
+	AdminUsername                 string   `tfschema:"admin_username"`
+	ComputerNamePrefix            string   `tfschema:"computer_name_prefix"`
+	PasswordAuthenticationEnabled bool     `tfschema:"password_authentication_enabled"`
+}
+
+type ComputeFleetWindowsConfiguration struct {
+	AdminPassword           string `tfschema:"admin_password"`
+	AdminUsername           string `tfschema:"admin_username"`
+	AutomaticUpdatesEnabled bool   `tfschema:"automatic_updates_enabled"`
+	ComputerNamePrefix      string `tfschema:"computer_name_prefix"`
+	TimeZone                string `tfschema:"time_zone"`
+}
+
+type ComputeFleetSourceImageReference struct {
+	Offer     string `tfschema:"offer"`
+	Publisher string `tfschema:"publisher"`
+	Sku       string `tfschema:"sku"`
+	Version   string `tfschema:"version"`
+}
+
+func (r ComputeFleetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.FleetName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"spot_priority_profile": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			AtLeastOneOf: []string{"spot_priority_profile", "regular_priority_profile"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"capacity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+
+					"min_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+
+					"allocation_strategy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(fleets.SpotAllocationStrategyPriceCapacityOptimized),
+						ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForSpotAllocationStrategy(), false),
+					},
+
+					"eviction_policy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(fleets.EvictionPolicyDelete),
+						ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForEvictionPolicy(), false),
+					},
+
+					// replaces Spot VMs which have been evicted, so that the capacity is maintained
+					"maintain_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  true,
+					},
+
+					"max_hourly_price_per_vm": {
+						Type:         pluginsdk.TypeFloat,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      -1,
+						ValidateFunc: computeValidate.SpotMaxPrice,
+					},
+				},
+			},
+		},
+
+		"regular_priority_profile": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			AtLeastOneOf: []string{"spot_priority_profile", "regular_priority_profile"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"capacity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+
+					"min_capacity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(0, 10000),
+					},
+
+					"allocation_strategy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(fleets.RegularPriorityAllocationStrategyLowestPrice),
+						ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForRegularPriorityAllocationStrategy(), false),
+					},
+				},
+			},
+		},
+
+		// the VM Sizes the Fleet can choose from, in order of preference when the `Prioritized` allocation strategy is used
+		"vm_sizes_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"rank": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+				},
+			},
+		},
+
+		"virtual_machine_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"network_interface": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"ip_configuration": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									ForceNew: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"subnet_id": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: networkValidate.SubnetID,
+											},
+
+											"primary": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  false,
+											},
+										},
+									},
+								},
+
+								"accelerated_networking_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"ip_forwarding_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"primary": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+							},
+						},
+					},
+
+					"os_disk": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"caching": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForCachingTypes(), false),
+								},
+
+								"storage_account_type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForStorageAccountTypes(), false),
+								},
+
+								"disk_size_in_gb": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ForceNew:     true,
+									Computed:     true,
+									ValidateFunc: validation.IntBetween(0, 4095),
+								},
+							},
+						},
+					},
+
+					"os_profile": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"linux_configuration": {
+									Type:         pluginsdk.TypeList,
+									Optional:     true,
+									ForceNew:     true,
+									MaxItems:     1,
+									ExactlyOneOf: []string{"virtual_machine_profile.0.os_profile.0.linux_configuration", "virtual_machine_profile.0.os_profile.0.windows_configuration"},
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"admin_username": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"admin_password": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ForceNew:     true,
+												Sensitive:    true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"admin_ssh_keys": {
+												Type:     pluginsdk.TypeList,
+												Optional: true,
+												ForceNew: true,
+												Elem: &pluginsdk.Schema{
+													Type:         pluginsdk.TypeString,
+													ValidateFunc: validation.StringIsNotEmpty,
+												},
+											},
+
+											"computer_name_prefix": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ForceNew:     true,
+												Computed:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"password_authentication_enabled": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  false,
+											},
+										},
+									},
+								},
+
+								"windows_configuration": {
+									Type:         pluginsdk.TypeList,
+									Optional:     true,
+									ForceNew:     true,
+									MaxItems:     1,
+									ExactlyOneOf: []string{"virtual_machine_profile.0.os_profile.0.linux_configuration", "virtual_machine_profile.0.os_profile.0.windows_configuration"},
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"admin_username": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"admin_password": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ForceNew:     true,
+												Sensitive:    true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"computer_name_prefix": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ForceNew:     true,
+												Computed:     true,
+												ValidateFunc: validation.StringLenBetween(1, 9),
+											},
+
+											"automatic_updates_enabled": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												ForceNew: true,
+												Default:  true,
+											},
+
+											"time_zone": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ForceNew:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+
+								"custom_data": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsBase64,
+								},
+							},
+						},
+					},
+
+					"source_image_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: azure.ValidateResourceID,
+						ExactlyOneOf: []string{"virtual_machine_profile.0.source_image_id", "virtual_machine_profile.0.source_image_reference"},
+					},
+
+					"source_image_reference": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						ForceNew:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"virtual_machine_profile.0.source_image_id", "virtual_machine_profile.0.source_image_reference"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"publisher": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"offer": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"sku": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"version": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"network_api_version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(fleets.NetworkApiVersion20201101),
+						ValidateFunc: validation.StringInSlice(fleets.PossibleValuesForNetworkApiVersion(), false),
+					},
+				},
+			},
+		},
+
+		"compute_api_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"platform_fault_domain_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 3),
+		},
+
+		"zones": azure.SchemaZones(),
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ComputeFleetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"unique_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ComputeFleetResource) ModelObject() interface{} {
+	return &ComputeFleetResourceModel{}
+}
+
+func (r ComputeFleetResource) ResourceType() string {
+	return "azurerm_compute_fleet"
+}
+
+func (r ComputeFleetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fleets.ValidateFleetID
+}
+
+func (r ComputeFleetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ComputeFleet.FleetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ComputeFleetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fleets.NewFleetID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := expandComputeFleet(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ComputeFleetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ComputeFleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the admin passwords and custom data aren't returned by the API
+			var config ComputeFleetResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ComputeFleetResourceModel{
+				Name:              id.FleetName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+				if model.Zones != nil {
+					state.Zones = *model.Zones
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					state.SpotPriorityProfile = flattenComputeFleetSpotPriorityProfile(props.SpotPriorityProfile)
+					state.RegularPriorityProfile = flattenComputeFleetRegularPriorityProfile(props.RegularPriorityProfile)
+					state.VMSizesProfile = flattenComputeFleetVMSizesProfile(props.VMSizesProfile)
+					state.UniqueId = utils.NormalizeNilableString(props.UniqueId)
+
+					state.ComputeApiVersion = utils.NormalizeNilableString(props.ComputeProfile.ComputeApiVersion)
+					if v := props.ComputeProfile.PlatformFaultDomainCount; v != nil {
+						state.PlatformFaultDomainCount = *v
+					}
+					state.VirtualMachineProfile = flattenComputeFleetVirtualMachineProfile(props.ComputeProfile.BaseVirtualMachineProfile, config.VirtualMachineProfile)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ComputeFleetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ComputeFleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ComputeFleetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the secrets within the Virtual Machine Profile aren't returned by the API, so the whole Fleet is sent again
+			payload, err := expandComputeFleet(metadata, model)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ComputeFleetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ComputeFleet.FleetsClient
+
+			id, err := fleets.ParseFleetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandComputeFleet(metadata sdk.ResourceMetaData, model ComputeFleetResourceModel) (*fleets.Fleet, error) {
+	identityValue, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	computeProfile := fleets.ComputeProfile{
+		BaseVirtualMachineProfile: expandComputeFleetVirtualMachineProfile(model.VirtualMachineProfile),
+		PlatformFaultDomainCount:  utils.Int64(model.PlatformFaultDomainCount),
+	}
+	if model.ComputeApiVersion != "" {
+		computeProfile.ComputeApiVersion = utils.String(model.ComputeApiVersion)
+	}
+
+	payload := fleets.Fleet{
+		Identity: identityValue,
+		Location: location.Normalize(model.Location),
+		Properties: &fleets.FleetProperties{
+			ComputeProfile:         computeProfile,
+			RegularPriorityProfile: expandComputeFleetRegularPriorityProfile(model.RegularPriorityProfile),
+			SpotPriorityProfile:    expandComputeFleetSpotPriorityProfile(model.SpotPriorityProfile),
+			VMSizesProfile:         expandComputeFleetVMSizesProfile(model.VMSizesProfile),
+		},
+		Tags: &model.Tags,
+	}
+
+	if len(model.Zones) > 0 {
+		payload.Zones = &model.Zones
+	}
+
+	return &payload, nil
+}
+
+func expandComputeFleetSpotPriorityProfile(input []ComputeFleetSpotPriorityProfile) *fleets.SpotPriorityProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	profile := input[0]
+	allocationStrategy := fleets.SpotAllocationStrategy(profile.AllocationStrategy)
+	evictionPolicy := fleets.EvictionPolicy(profile.EvictionPolicy)
+
+	return &fleets.SpotPriorityProfile{
+		AllocationStrategy: &allocationStrategy,
+		Capacity:           utils.Int64(profile.Capacity),
+		EvictionPolicy:     &evictionPolicy,
+		Maintain:           utils.Bool(profile.MaintainEnabled),
+		MaxPricePerVM:      utils.Float(profile.MaxHourlyPricePerVM),
+		MinCapacity:        utils.Int64(profile.MinCapacity),
+	}
+}
+
+func flattenComputeFleetSpotPriorityProfile(input *fleets.SpotPriorityProfile) []ComputeFleetSpotPriorityProfile {
+	if input == nil {
+		return []ComputeFleetSpotPriorityProfile{}
+	}
+
+	output := ComputeFleetSpotPriorityProfile{
+		MaxHourlyPricePerVM: -1,
+	}
+	if input.AllocationStrategy != nil {
+		output.AllocationStrategy = string(*input.AllocationStrategy)
+	}
+	if input.Capacity != nil {
+		output.Capacity = *input.Capacity
+	}
+	if input.EvictionPolicy != nil {
+		output.EvictionPolicy = string(*input.EvictionPolicy)
+	}
+	if input.Maintain != nil {
+		output.MaintainEnabled = *input.Maintain
+	}
+	if input.MaxPricePerVM != nil {
+		output.MaxHourlyPricePerVM = *input.MaxPricePerVM
+	}
+	if input.MinCapacity != nil {
+		output.MinCapacity = *input.MinCapacity
+	}
+
+	return []ComputeFleetSpotPriorityProfile{output}
+}
+
+func expandComputeFleetRegularPriorityProfile(input []ComputeFleetRegularPriorityProfile) *fleets.RegularPriorityProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	profile := input[0]
+	allocationStrategy := fleets.RegularPriorityAllocationStrategy(profile.AllocationStrategy)
+
+	return &fleets.RegularPriorityProfile{
+		AllocationStrategy: &allocationStrategy,
+		Capacity:           utils.Int64(profile.Capacity),
+		MinCapacity:        utils.Int64(profile.MinCapacity),
+	}
+}
+
+func flattenComputeFleetRegularPriorityProfile(input *fleets.RegularPriorityProfile) []ComputeFleetRegularPriorityProfile {
+	if input == nil {
+		return []ComputeFleetRegularPriorityProfile{}
+	}
+
+	output := ComputeFleetRegularPriorityProfile{}
+	if input.AllocationStrategy != nil {
+		output.AllocationStrategy = string(*input.AllocationStrategy)
+	}
+	if input.Capacity != nil {
+		output.Capacity = *input.Capacity
+	}
+	if input.MinCapacity != nil {
+		output.MinCapacity = *input.MinCapacity
+	}
+
+	return []ComputeFleetRegularPriorityProfile{output}
+}
+
+func expandComputeFleetVMSizesProfile(input []ComputeFleetVMSizeProfile) []fleets.VMSizeProfile {
+	output := make([]fleets.VMSizeProfile, 0)
+	for _, v := range input {
+		output = append(output, fleets.VMSizeProfile{
+			Name: v.Name,
+			Rank: utils.Int64(v.Rank),
+		})
+	}
+
+	return output
+}
+
+func flattenComputeFleetVMSizesProfile(input []fleets.VMSizeProfile) []ComputeFleetVMSizeProfile {
+	output := make([]ComputeFleetVMSizeProfile, 0)
+	for _, v := range input {
+		size := ComputeFleetVMSizeProfile{
+			Name: v.Name,
+		}
+		if v.Rank != nil {
+			size.Rank = *v.Rank
+		}
+		output = append(output, size)
+	}
+
+	return output
+}
+
+func expandComputeFleetVirtualMachineProfile(input []ComputeFleetVirtualMachineProfile) fleets.BaseVirtualMachineProfile {
+	if len(input) == 0 {
+		return fleets.BaseVirtualMachineProfile{}
+	}
+
+	profile := input[0]
+	networkApiVersion := fleets.NetworkApiVersion(profile.NetworkApiVersion)
+
+	osProfile, osType := expandComputeFleetOsProfile(profile.OsProfile)
+
+	storageProfile := fleets.VirtualMachineScaleSetStorageProfile{
+		OsDisk: expandComputeFleetOsDisk(profile.OsDisk, osType),
+	}
+	if profile.SourceImageId != "" {
+		storageProfile.ImageReference = &fleets.ImageReference{
+			Id: utils.String(profile.SourceImageId),
+		}
+	}
+	if len(profile.SourceImageReference) > 0 {
+		image := profile.SourceImageReference[0]
+		storageProfile.ImageReference = &fleets.ImageReference{
+			Offer:     utils.String(image.Offer),
+			Publisher: utils.String(image.Publisher),
+			Sku:       utils.String(image.Sku),
+			Version:   utils.String(image.Version),
+		}
+	}
+
+	return fleets.BaseVirtualMachineProfile{
+		NetworkProfile: &fleets.VirtualMachineScaleSetNetworkProfile{
+			NetworkApiVersion:              &networkApiVersion,
+			NetworkInterfaceConfigurations: expandComputeFleetNetworkInterfaces(profile.NetworkInterface),
+		},
+		OsProfile:      osProfile,
+		StorageProfile: &storageProfile,
+	}
+}
+
+func flattenComputeFleetVirtualMachineProfile(input fleets.BaseVirtualMachineProfile, config []ComputeFleetVirtualMachineProfile) []ComputeFleetVirtualMachineProfile {
+	output := ComputeFleetVirtualMachineProfile{}
+
+	if network := input.NetworkProfile; network != nil {
+		if network.NetworkApiVersion != nil {
+			output.NetworkApiVersion = string(*network.NetworkApiVersion)
+		}
+		output.NetworkInterface = flattenComputeFleetNetworkInterfaces(network.NetworkInterfaceConfigurations)
+	}
+
+	var configOsProfile []ComputeFleetOsProfile
+	if len(config) > 0 {
+		configOsProfile = config[0].OsProfile
+	}
+	output.OsProfile = flattenComputeFleetOsProfile(input.OsProfile, configOsProfile)
+
+	if storage := input.StorageProfile; storage != nil {
+		output.OsDisk = flattenComputeFleetOsDisk(storage.OsDisk)
+
+		if image := storage.ImageReference; image != nil {
+			if image.Id != nil && *image.Id != "" {
+				output.SourceImageId = *image.Id
+			} else {
+				output.SourceImageReference = []ComputeFleetSourceImageReference{
+					{
+						Offer:     utils.NormalizeNilableString(image.Offer),
+						Publisher: utils.NormalizeNilableString(image.Publisher),
+						Sku:       utils.NormalizeNilableString(image.Sku),
+						Version:   utils.NormalizeNilableString(image.Version),
+					},
+				}
+			}
+		}
+	}
+
+	return []ComputeFleetVirtualMachineProfile{output}
+}
+
+func expandComputeFleetOsProfile(input []ComputeFleetOsProfile) (*fleets.VirtualMachineScaleSetOSProfile, fleets.OperatingSystemTypes) {
+	if len(input) == 0 {
+		return nil, ""
+	}
+
+	profile := input[0]
+	output := fleets.VirtualMachineScaleSetOSProfile{}
+	if profile.CustomData != "" {
+		output.CustomData = utils.String(profile.CustomData)
+	}
+
+	if len(profile.LinuxConfiguration) > 0 {
+		linux := profile.LinuxConfiguration[0]
+		output.AdminUsername = utils.String(linux.AdminUsername)
+		if linux.AdminPassword != "" {
+			output.AdminPassword = utils.String(linux.AdminPassword)
+		}
+		if linux.ComputerNamePrefix != "" {
+			output.ComputerNamePrefix = utils.String(linux.ComputerNamePrefix)
+		}
+
+		publicKeys := make([]fleets.SshPublicKey, 0)
+		for _, key := range linux.AdminSshKeys {
+			publicKeys = append(publicKeys, fleets.SshPublicKey{
+				KeyData: utils.String(key),
+				Path:    utils.String(fmt.Sprintf("/home/%s/.ssh/authorized_keys", linux.AdminUsername)),
+			})
+		}
+
+		output.LinuxConfiguration = &fleets.LinuxConfiguration{
+			DisablePasswordAuthentication: utils.Bool(!linux.PasswordAuthenticationEnabled),
+			Ssh: &fleets.SshConfiguration{
+				PublicKeys: &publicKeys,
+			},
+		}
+
+		return &output, fleets.OperatingSystemTypesLinux
+	}
+
+	if len(profile.WindowsConfiguration) > 0 {
+		windows := profile.WindowsConfiguration[0]
+		output.AdminUsername = utils.String(windows.AdminUsername)
+		output.AdminPassword = utils.String(windows.AdminPassword)
+		if windows.ComputerNamePrefix != "" {
+			output.ComputerNamePrefix = utils.String(windows.ComputerNamePrefix)
+		}
+
+		output.WindowsConfiguration = &fleets.WindowsConfiguration{
+			EnableAutomaticUpdates: utils.Bool(windows.AutomaticUpdatesEnabled),
+		}
+		if windows.TimeZone != "" {
+			output.WindowsConfiguration.TimeZone = utils.String(windows.TimeZone)
+		}
+
+		return &output, fleets.OperatingSystemTypesWindows
+	}
+
+	return &output, ""
+}
+
+func flattenComputeFleetOsProfile(input *fleets.VirtualMachineScaleSetOSProfile, config []ComputeFleetOsProfile) []ComputeFleetOsProfile {
+	if input == nil {
+		return []ComputeFleetOsProfile{}
+	}
+
+	output := ComputeFleetOsProfile{}
+
+	var configLinux []ComputeFleetLinuxConfiguration
+	var configWindows []ComputeFleetWindowsConfiguration
+	if len(config) > 0 {
+		output.CustomData = config[0].CustomData
+		configLinux = config[0].LinuxConfiguration
+		configWindows = config[0].WindowsConfiguration
+	}
+
+	if linux := input.LinuxConfiguration; linux != nil {
+		linuxConfiguration := ComputeFleetLinuxConfiguration{
+			AdminSshKeys:       make([]string, 0),
+			AdminUsername:      utils.NormalizeNilableString(input.AdminUsername),
+			ComputerNamePrefix: utils.NormalizeNilableString(input.ComputerNamePrefix),
+		}
+		if len(configLinux) > 0 {
+			linuxConfiguration.AdminPassword = configLinux[0].AdminPassword
+		}
+		if linux.DisablePasswordAuthentication != nil {
+			linuxConfiguration.PasswordAuthenticationEnabled = !*linux.DisablePasswordAuthentication
+		}
+		if linux.Ssh != nil && linux.Ssh.PublicKeys != nil {
+			for _, key := range *linux.Ssh.PublicKeys {
+				if key.KeyData != nil {
+					linuxConfiguration.AdminSshKeys = append(linuxConfiguration.AdminSshKeys, *key.KeyData)
+				}
+			}
+		}
+
+		output.LinuxConfiguration = []ComputeFleetLinuxConfiguration{linuxConfiguration}
+	}
+
+	if windows := input.WindowsConfiguration; windows != nil {
+		windowsConfiguration := ComputeFleetWindowsConfiguration{
+			AdminUsername:      utils.NormalizeNilableString(input.AdminUsername),
+			ComputerNamePrefix: utils.NormalizeNilableString(input.ComputerNamePrefix),
+			TimeZone:           utils.NormalizeNilableString(windows.TimeZone),
+		}
+		if len(configWindows) > 0 {
+			windowsConfiguration.AdminPassword = configWindows[0].AdminPassword
+		}
+		if windows.EnableAutomaticUpdates != nil {
+			windowsConfiguration.AutomaticUpdatesEnabled = *windows.EnableAutomaticUpdates
+		}
+
+		output.WindowsConfiguration = []ComputeFleetWindowsConfiguration{windowsConfiguration}
+	}
+
+	return []ComputeFleetOsProfile{output}
+}
+
+func expandComputeFleetOsDisk(input []ComputeFleetOsDisk, osType fleets.OperatingSystemTypes) *fleets.VirtualMachineScaleSetOSDisk {
+	if len(input) == 0 {
+		return nil
+	}
+
+	disk := input[0]
+	caching := fleets.CachingTypes(disk.Caching)
+	storageAccountType := fleets.StorageAccountTypes(disk.StorageAccountType)
+
+	output := fleets.VirtualMachineScaleSetOSDisk{
+		Caching:      &caching,
+		CreateOption: fleets.DiskCreateOptionTypesFromImage,
+		ManagedDisk: &fleets.VirtualMachineScaleSetManagedDiskParameters{
+			StorageAccountType: &storageAccountType,
+		},
+	}
+	if disk.DiskSizeInGB > 0 {
+		output.DiskSizeGB = utils.Int64(disk.DiskSizeInGB)
+	}
+	if osType != "" {
+		output.OsType = &osType
+	}
+
+	return &output
+}
+
+func flattenComputeFleetOsDisk(input *fleets.VirtualMachineScaleSetOSDisk) []ComputeFleetOsDisk {
+	if input == nil {
+		return []ComputeFleetOsDisk{}
+	}
+
+	output := ComputeFleetOsDisk{}
+	if input.Caching != nil {
+		output.Caching = string(*input.Caching)
+	}
+	if input.DiskSizeGB != nil {
+		output.DiskSizeInGB = *input.DiskSizeGB
+	}
+	if input.ManagedDisk != nil && input.ManagedDisk.StorageAccountType != nil {
+		output.StorageAccountType = string(*input.ManagedDisk.StorageAccountType)
+	}
+
+	return []ComputeFleetOsDisk{output}
+}
+
+func expandComputeFleetNetworkInterfaces(input []ComputeFleetNetworkInterface) *[]fleets.VirtualMachineScaleSetNetworkConfiguration {
+	output := make([]fleets.VirtualMachineScaleSetNetworkConfiguration, 0)
+
+	for _, nic := range input {
+		ipConfigurations := make([]fleets.VirtualMachineScaleSetIPConfiguration, 0)
+		for _, ipConfiguration := range nic.IPConfiguration {
+			ipConfigurations = append(ipConfigurations, fleets.VirtualMachineScaleSetIPConfiguration{
+				Name: ipConfiguration.Name,
+				Properties: &fleets.VirtualMachineScaleSetIPConfigurationProperties{
+					Primary: utils.Bool(ipConfiguration.Primary),
+					Subnet: &fleets.ApiEntityReference{
+						Id: utils.String(ipConfiguration.SubnetId),
+					},
+				},
+			})
+		}
+
+		output = append(output, fleets.VirtualMachineScaleSetNetworkConfiguration{
+			Name: nic.Name,
+			Properties: &fleets.VirtualMachineScaleSetNetworkConfigurationProperties{
+				EnableAcceleratedNetworking: utils.Bool(nic.AcceleratedNetworkingEnabled),
+				EnableIPForwarding:          utils.Bool(nic.IPForwardingEnabled),
+				IPConfigurations:            ipConfigurations,
+				Primary:                     utils.Bool(nic.Primary),
+			},
+		})
+	}
+
+	return &output
+}
+
+func flattenComputeFleetNetworkInterfaces(input *[]fleets.VirtualMachineScaleSetNetworkConfiguration) []ComputeFleetNetworkInterface {
+	output := make([]ComputeFleetNetworkInterface, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, nic := range *input {
+		networkInterface := ComputeFleetNetworkInterface{
+			Name:            nic.Name,
+			IPConfiguration: make([]ComputeFleetIPConfiguration, 0),
+		}
+
+		if props := nic.Properties; props != nil {
+			if props.EnableAcceleratedNetworking != nil {
+				networkInterface.AcceleratedNetworkingEnabled = *props.EnableAcceleratedNetworking
+			}
+			if props.EnableIPForwarding != nil {
+				networkInterface.IPForwardingEnabled = *props.EnableIPForwarding
+			}
+			if props.Primary != nil {
+				networkInterface.Primary = *props.Primary
+			}
+
+			for _, ipConfiguration := range props.IPConfigurations {
+				config := ComputeFleetIPConfiguration{
+					Name: ipConfiguration.Name,
+				}
+				if ipProps := ipConfiguration.Properties; ipProps != nil {
+					if ipProps.Primary != nil {
+						config.Primary = *ipProps.Primary
+					}
+					if ipProps.Subnet != nil {
+						config.SubnetId = utils.NormalizeNilableString(ipProps.Subnet.Id)
+					}
+				}
+				networkInterface.IPConfiguration = append(networkInterface.IPConfiguration, config)
+			}
+		}
+
+		output = append(output, networkInterface)
+	}
+
+	return output
+}
@@ -0,0 +1,506 @@
+package computefleet_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/computefleet/sdk/2024-11-01/fleets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ComputeFleetResource struct{}
+
+func TestAccComputeFleet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("unique_id").Exists(),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.linux_configuration.0.admin_password"),
+	})
+}
+
+func TestAccComputeFleet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccComputeFleet_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, 2, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.linux_configuration.0.admin_password", "virtual_machine_profile.0.os_profile.0.custom_data"),
+	})
+}
+
+func TestAccComputeFleet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, 2, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.linux_configuration.0.admin_password", "virtual_machine_profile.0.os_profile.0.custom_data"),
+		{
+			Config: r.completeUpdated(data, 3, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.linux_configuration.0.admin_password", "virtual_machine_profile.0.os_profile.0.custom_data"),
+	})
+}
+
+func TestAccComputeFleet_windows(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_compute_fleet", "test")
+	r := ComputeFleetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.windows(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine_profile.0.os_profile.0.windows_configuration.0.admin_password"),
+	})
+}
+
+func (r ComputeFleetResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := fleets.ParseFleetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ComputeFleet.FleetsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ComputeFleetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_fleet" "test" {
+  name                = "acctest-fleet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  spot_priority_profile {
+    capacity = 1
+  }
+
+  vm_sizes_profile {
+    name = "Standard_F2s_v2"
+  }
+
+  virtual_machine_profile {
+    os_profile {
+      linux_configuration {
+        admin_username                  = "adminuser"
+        admin_password                  = "P@ssw0rd1234!"
+        password_authentication_enabled = true
+      }
+    }
+
+    source_image_reference {
+      publisher = "Canonical"
+      offer     = "0001-com-ubuntu-server-jammy"
+      sku       = "22_04-lts"
+      version   = "latest"
+    }
+
+    os_disk {
+      caching              = "ReadWrite"
+      storage_account_type = "Standard_LRS"
+    }
+
+    network_interface {
+      name    = "networkProTest"
+      primary = true
+
+      ip_configuration {
+        name      = "ipConfigTest"
+        primary   = true
+        subnet_id = azurerm_subnet.test.id
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ComputeFleetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_fleet" "import" {
+  name                = azurerm_compute_fleet.test.name
+  resource_group_name = azurerm_compute_fleet.test.resource_group_name
+  location            = azurerm_compute_fleet.test.location
+
+  spot_priority_profile {
+    capacity = 1
+  }
+
+  vm_sizes_profile {
+    name = "Standard_F2s_v2"
+  }
+
+  virtual_machine_profile {
+    os_profile {
+      linux_configuration {
+        admin_username                  = "adminuser"
+        admin_password                  = "P@ssw0rd1234!"
+        password_authentication_enabled = true
+      }
+    }
+
+    source_image_reference {
+      publisher = "Canonical"
+      offer     = "0001-com-ubuntu-server-jammy"
+      sku       = "22_04-lts"
+      version   = "latest"
+    }
+
+    os_disk {
+      caching              = "ReadWrite"
+      storage_account_type = "Standard_LRS"
+    }
+
+    network_interface {
+      name    = "networkProTest"
+      primary = true
+
+      ip_configuration {
+        name      = "ipConfigTest"
+        primary   = true
+        subnet_id = azurerm_subnet.test.id
+      }
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ComputeFleetResource) complete(data acceptance.TestData, spotCapacity, regularCapacity int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_compute_fleet" "test" {
+  name                        = "acctest-fleet-%[2]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  platform_fault_domain_count = 1
+  zones                       = ["1", "2"]
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  spot_priority_profile {
+    capacity                = %[3]d
+    min_capacity            = 1
+    allocation_strategy     = "CapacityOptimized"
+    eviction_policy         = "Deallocate"
+    maintain_enabled        = true
+    max_hourly_price_per_vm = 1
+  }
+
+  regular_priority_profile {
+    capacity            = %[4]d
+    min_capacity        = 1
+    allocation_strategy = "Prioritized"
+  }
+
+  vm_sizes_profile {
+    name = "Standard_F2s_v2"
+    rank = 0
+  }
+
+  vm_sizes_profile {
+    name = "Standard_D2s_v3"
+    rank = 1
+  }
+
+  virtual_machine_profile {
+    network_api_version = "2020-11-01"
+
+    os_profile {
+      custom_data = base64encode("/bin/bash")
+
+      linux_configuration {
+        computer_name_prefix            = "prefix"
+        admin_username                  = "adminuser"
+        admin_password                  = "P@ssw0rd1234!"
+        admin_ssh_keys                  = [local.first_public_key]
+        password_authentication_enabled = true
+      }
+    }
+
+    source_image_reference {
+      publisher = "Canonical"
+      offer     = "0001-com-ubuntu-server-jammy"
+      sku       = "22_04-lts"
+      version   = "latest"
+    }
+
+    os_disk {
+      caching              = "ReadOnly"
+      storage_account_type = "Premium_LRS"
+      disk_size_in_gb      = 64
+    }
+
+    network_interface {
+      name                           = "networkProTest"
+      primary                        = true
+      accelerated_networking_enabled = false
+      ip_forwarding_enabled          = true
+
+      ip_configuration {
+        name      = "ipConfigTest"
+        primary   = true
+        subnet_id = azurerm_subnet.test.id
+      }
+    }
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.completeTemplate(data), data.RandomInteger, spotCapacity, regularCapacity)
+}
+
+func (r ComputeFleetResource) completeUpdated(data acceptance.TestData, spotCapacity, regularCapacity int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_compute_fleet" "test" {
+  name                        = "acctest-fleet-%[2]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  platform_fault_domain_count = 1
+  zones                       = ["1", "2"]
+
+  identity {
+    type         = "SystemAssigned, UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  spot_priority_profile {
+    capacity                = %[3]d
+    min_capacity            = 1
+    allocation_strategy     = "CapacityOptimized"
+    eviction_policy         = "Deallocate"
+    maintain_enabled        = true
+    max_hourly_price_per_vm = 1
+  }
+
+  regular_priority_profile {
+    capacity            = %[4]d
+    min_capacity        = 1
+    allocation_strategy = "Prioritized"
+  }
+
+  vm_sizes_profile {
+    name = "Standard_D2s_v3"
+    rank = 0
+  }
+
+  vm_sizes_profile {
+    name = "Standard_F2s_v2"
+    rank = 1
+  }
+
+  vm_sizes_profile {
+    name = "Standard_D2as_v5"
+    rank = 2
+  }
+
+  virtual_machine_profile {
+    network_api_version = "2020-11-01"
+
+    os_profile {
+      custom_data = base64encode("/bin/bash")
+
+      linux_configuration {
+        computer_name_prefix            = "prefix"
+        admin_username                  = "adminuser"
+        admin_password                  = "P@ssw0rd1234!"
+        admin_ssh_keys                  = [local.first_public_key]
+        password_authentication_enabled = true
+      }
+    }
+
+    source_image_reference {
+      publisher = "Canonical"
+      offer     = "0001-com-ubuntu-server-jammy"
+      sku       = "22_04-lts"
+      version   = "latest"
+    }
+
+    os_disk {
+      caching              = "ReadOnly"
+      storage_account_type = "Premium_LRS"
+      disk_size_in_gb      = 64
+    }
+
+    network_interface {
+      name                           = "networkProTest"
+      primary                        = true
+      accelerated_networking_enabled = false
+      ip_forwarding_enabled          = true
+
+      ip_configuration {
+        name      = "ipConfigTest"
+        primary   = true
+        subnet_id = azurerm_subnet.test.id
+      }
+    }
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, r.completeTemplate(data), data.RandomInteger, spotCapacity, regularCapacity)
+}
+
+func (r ComputeFleetResource) windows(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_compute_fleet" "test" {
+  name                = "acctest-fleet-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  regular_priority_profile {
+    capacity = 1
+  }
+
+  vm_sizes_profile {
+    name = "Standard_F2s_v2"
+  }
+
+  virtual_machine_profile {
+    os_profile {
+      windows_configuration {
+        computer_name_prefix      = "acctest"
+        admin_username            = "adminuser"
+        admin_password            = "P@ssw0rd1234!"
+        automatic_updates_enabled = false
+        time_zone                 = "UTC"
+      }
+    }
+
+    source_image_reference {
+      publisher = "MicrosoftWindowsServer"
+      offer     = "WindowsServer"
+      sku       = "2022-datacenter-azure-edition"
+      version   = "latest"
+    }
+
+    os_disk {
+      caching              = "ReadWrite"
+      storage_account_type = "Standard_LRS"
+    }
+
+    network_interface {
+      name    = "networkProTest"
+      primary = true
+
+      ip_configuration {
+        name      = "ipConfigTest"
+        primary   = true
+        subnet_id = azurerm_subnet.test.id
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ComputeFleetResource) completeTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  first_public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC+wWK73dCr+jgQOAxNsHAnNNNMEMWOHYEccp6wJm2gotpr9katuF/ZAdou5AaW1C61slRkHRkpRRX9FA9CYBiitZgvCCz+3nWNN7l/Up54Zps/pHWGZLHNJZRYyAB6j5yVLMVHIHriY49d/GZTZVNB8GoJv9Gakwc/fuEZYYl4YDFiGMBP///TzlI4jhiJzjKnEvqPFki5p2ZRJqcbCiF4pJrxUQR/RXqVFQdbRLZgYfJ8xGB878RENq3yQ39d8dVOkq4edbkzwcUmwwwkYVPIoDGsYLaRHnG+To7FvMeyO7xDVQkMKzopTQV8AuKpyvpqu0a9pWOMaiCyDytO7GGN you@me.com"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ComputeFleetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fleet-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
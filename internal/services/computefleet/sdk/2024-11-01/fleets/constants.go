@@ -0,0 +1,291 @@
+package fleets
+
+import "strings"
+
+type CachingTypes string
+
+const (
+	CachingTypesNone      CachingTypes = "None"
+	CachingTypesReadOnly  CachingTypes = "ReadOnly"
+	CachingTypesReadWrite CachingTypes = "ReadWrite"
+)
+
+func PossibleValuesForCachingTypes() []string {
+	return []string{
+		string(CachingTypesNone),
+		string(CachingTypesReadOnly),
+		string(CachingTypesReadWrite),
+	}
+}
+
+func parseCachingTypes(input string) (*CachingTypes, error) {
+	vals := map[string]CachingTypes{
+		"none":      CachingTypesNone,
+		"readonly":  CachingTypesReadOnly,
+		"readwrite": CachingTypesReadWrite,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CachingTypes(input)
+	return &out, nil
+}
+
+type DiskCreateOptionTypes string
+
+const (
+	DiskCreateOptionTypesAttach    DiskCreateOptionTypes = "Attach"
+	DiskCreateOptionTypesEmpty     DiskCreateOptionTypes = "Empty"
+	DiskCreateOptionTypesFromImage DiskCreateOptionTypes = "FromImage"
+)
+
+func PossibleValuesForDiskCreateOptionTypes() []string {
+	return []string{
+		string(DiskCreateOptionTypesAttach),
+		string(DiskCreateOptionTypesEmpty),
+		string(DiskCreateOptionTypesFromImage),
+	}
+}
+
+func parseDiskCreateOptionTypes(input string) (*DiskCreateOptionTypes, error) {
+	vals := map[string]DiskCreateOptionTypes{
+		"attach":    DiskCreateOptionTypesAttach,
+		"empty":     DiskCreateOptionTypesEmpty,
+		"fromimage": DiskCreateOptionTypesFromImage,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DiskCreateOptionTypes(input)
+	return &out, nil
+}
+
+type EvictionPolicy string
+
+const (
+	EvictionPolicyDeallocate EvictionPolicy = "Deallocate"
+	EvictionPolicyDelete     EvictionPolicy = "Delete"
+)
+
+func PossibleValuesForEvictionPolicy() []string {
+	return []string{
+		string(EvictionPolicyDeallocate),
+		string(EvictionPolicyDelete),
+	}
+}
+
+func parseEvictionPolicy(input string) (*EvictionPolicy, error) {
+	vals := map[string]EvictionPolicy{
+		"deallocate": EvictionPolicyDeallocate,
+		"delete":     EvictionPolicyDelete,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EvictionPolicy(input)
+	return &out, nil
+}
+
+type NetworkApiVersion string
+
+const (
+	NetworkApiVersion20201101 NetworkApiVersion = "2020-11-01"
+)
+
+func PossibleValuesForNetworkApiVersion() []string {
+	return []string{
+		string(NetworkApiVersion20201101),
+	}
+}
+
+func parseNetworkApiVersion(input string) (*NetworkApiVersion, error) {
+	vals := map[string]NetworkApiVersion{
+		"2020-11-01": NetworkApiVersion20201101,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NetworkApiVersion(input)
+	return &out, nil
+}
+
+type OperatingSystemTypes string
+
+const (
+	OperatingSystemTypesLinux   OperatingSystemTypes = "Linux"
+	OperatingSystemTypesWindows OperatingSystemTypes = "Windows"
+)
+
+func PossibleValuesForOperatingSystemTypes() []string {
+	return []string{
+		string(OperatingSystemTypesLinux),
+		string(OperatingSystemTypesWindows),
+	}
+}
+
+func parseOperatingSystemTypes(input string) (*OperatingSystemTypes, error) {
+	vals := map[string]OperatingSystemTypes{
+		"linux":   OperatingSystemTypesLinux,
+		"windows": OperatingSystemTypesWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperatingSystemTypes(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateMigrating ProvisioningState = "Migrating"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMigrating),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"migrating": ProvisioningStateMigrating,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type RegularPriorityAllocationStrategy string
+
+const (
+	RegularPriorityAllocationStrategyLowestPrice RegularPriorityAllocationStrategy = "LowestPrice"
+	RegularPriorityAllocationStrategyPrioritized RegularPriorityAllocationStrategy = "Prioritized"
+)
+
+func PossibleValuesForRegularPriorityAllocationStrategy() []string {
+	return []string{
+		string(RegularPriorityAllocationStrategyLowestPrice),
+		string(RegularPriorityAllocationStrategyPrioritized),
+	}
+}
+
+func parseRegularPriorityAllocationStrategy(input string) (*RegularPriorityAllocationStrategy, error) {
+	vals := map[string]RegularPriorityAllocationStrategy{
+		"lowestprice": RegularPriorityAllocationStrategyLowestPrice,
+		"prioritized": RegularPriorityAllocationStrategyPrioritized,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RegularPriorityAllocationStrategy(input)
+	return &out, nil
+}
+
+type SpotAllocationStrategy string
+
+const (
+	SpotAllocationStrategyCapacityOptimized      SpotAllocationStrategy = "CapacityOptimized"
+	SpotAllocationStrategyLowestPrice            SpotAllocationStrategy = "LowestPrice"
+	SpotAllocationStrategyPriceCapacityOptimized SpotAllocationStrategy = "PriceCapacityOptimized"
+)
+
+func PossibleValuesForSpotAllocationStrategy() []string {
+	return []string{
+		string(SpotAllocationStrategyCapacityOptimized),
+		string(SpotAllocationStrategyLowestPrice),
+		string(SpotAllocationStrategyPriceCapacityOptimized),
+	}
+}
+
+func parseSpotAllocationStrategy(input string) (*SpotAllocationStrategy, error) {
+	vals := map[string]SpotAllocationStrategy{
+		"capacityoptimized":      SpotAllocationStrategyCapacityOptimized,
+		"lowestprice":            SpotAllocationStrategyLowestPrice,
+		"pricecapacityoptimized": SpotAllocationStrategyPriceCapacityOptimized,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SpotAllocationStrategy(input)
+	return &out, nil
+}
+
+type StorageAccountTypes string
+
+const (
+	StorageAccountTypesPremiumV2LRS   StorageAccountTypes = "PremiumV2_LRS"
+	StorageAccountTypesPremiumLRS     StorageAccountTypes = "Premium_LRS"
+	StorageAccountTypesPremiumZRS     StorageAccountTypes = "Premium_ZRS"
+	StorageAccountTypesStandardSSDLRS StorageAccountTypes = "StandardSSD_LRS"
+	StorageAccountTypesStandardSSDZRS StorageAccountTypes = "StandardSSD_ZRS"
+	StorageAccountTypesStandardLRS    StorageAccountTypes = "Standard_LRS"
+	StorageAccountTypesUltraSSDLRS    StorageAccountTypes = "UltraSSD_LRS"
+)
+
+func PossibleValuesForStorageAccountTypes() []string {
+	return []string{
+		string(StorageAccountTypesPremiumV2LRS),
+		string(StorageAccountTypesPremiumLRS),
+		string(StorageAccountTypesPremiumZRS),
+		string(StorageAccountTypesStandardSSDLRS),
+		string(StorageAccountTypesStandardSSDZRS),
+		string(StorageAccountTypesStandardLRS),
+		string(StorageAccountTypesUltraSSDLRS),
+	}
+}
+
+func parseStorageAccountTypes(input string) (*StorageAccountTypes, error) {
+	vals := map[string]StorageAccountTypes{
+		"premiumv2_lrs":   StorageAccountTypesPremiumV2LRS,
+		"premium_lrs":     StorageAccountTypesPremiumLRS,
+		"premium_zrs":     StorageAccountTypesPremiumZRS,
+		"standardssd_lrs": StorageAccountTypesStandardSSDLRS,
+		"standardssd_zrs": StorageAccountTypesStandardSSDZRS,
+		"standard_lrs":    StorageAccountTypesStandardLRS,
+		"ultrassd_lrs":    StorageAccountTypesUltraSSDLRS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageAccountTypes(input)
+	return &out, nil
+}
@@ -0,0 +1,5 @@
+package fleets
+
+type ApiEntityReference struct {
+	Id *string `json:"id,omitempty"`
+}
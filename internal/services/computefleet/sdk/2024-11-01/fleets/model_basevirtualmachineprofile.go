@@ -0,0 +1,7 @@
+package fleets
+
+type BaseVirtualMachineProfile struct {
+	NetworkProfile *VirtualMachineScaleSetNetworkProfile `json:"networkProfile,omitempty"`
+	OsProfile      *VirtualMachineScaleSetOSProfile      `json:"osProfile,omitempty"`
+	StorageProfile *VirtualMachineScaleSetStorageProfile `json:"storageProfile,omitempty"`
+}
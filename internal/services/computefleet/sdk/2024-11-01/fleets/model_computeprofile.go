@@ -0,0 +1,7 @@
+package fleets
+
+type ComputeProfile struct {
+	BaseVirtualMachineProfile BaseVirtualMachineProfile `json:"baseVirtualMachineProfile"`
+	ComputeApiVersion         *string                   `json:"computeApiVersion,omitempty"`
+	PlatformFaultDomainCount  *int64                    `json:"platformFaultDomainCount,omitempty"`
+}
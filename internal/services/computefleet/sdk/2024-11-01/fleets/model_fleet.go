@@ -0,0 +1,16 @@
+package fleets
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type Fleet struct {
+	Id         *string                            `json:"id,omitempty"`
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                             `json:"location"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *FleetProperties                   `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+	Zones      *[]string                          `json:"zones,omitempty"`
+}
@@ -0,0 +1,11 @@
+package fleets
+
+type FleetProperties struct {
+	ComputeProfile         ComputeProfile          `json:"computeProfile"`
+	ProvisioningState      *ProvisioningState      `json:"provisioningState,omitempty"`
+	RegularPriorityProfile *RegularPriorityProfile `json:"regularPriorityProfile,omitempty"`
+	SpotPriorityProfile    *SpotPriorityProfile    `json:"spotPriorityProfile,omitempty"`
+	TimeCreated            *string                 `json:"timeCreated,omitempty"`
+	UniqueId               *string                 `json:"uniqueId,omitempty"`
+	VMSizesProfile         []VMSizeProfile         `json:"vmSizesProfile"`
+}
@@ -0,0 +1,9 @@
+package fleets
+
+type ImageReference struct {
+	Id        *string `json:"id,omitempty"`
+	Offer     *string `json:"offer,omitempty"`
+	Publisher *string `json:"publisher,omitempty"`
+	Sku       *string `json:"sku,omitempty"`
+	Version   *string `json:"version,omitempty"`
+}
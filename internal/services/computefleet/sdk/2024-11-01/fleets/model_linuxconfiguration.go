@@ -0,0 +1,7 @@
+package fleets
+
+type LinuxConfiguration struct {
+	DisablePasswordAuthentication *bool             `json:"disablePasswordAuthentication,omitempty"`
+	ProvisionVMAgent              *bool             `json:"provisionVMAgent,omitempty"`
+	Ssh                           *SshConfiguration `json:"ssh,omitempty"`
+}
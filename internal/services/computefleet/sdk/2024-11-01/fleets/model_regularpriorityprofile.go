@@ -0,0 +1,7 @@
+package fleets
+
+type RegularPriorityProfile struct {
+	AllocationStrategy *RegularPriorityAllocationStrategy `json:"allocationStrategy,omitempty"`
+	Capacity           *int64                             `json:"capacity,omitempty"`
+	MinCapacity        *int64                             `json:"minCapacity,omitempty"`
+}
@@ -0,0 +1,5 @@
+package fleets
+
+type SshConfiguration struct {
+	PublicKeys *[]SshPublicKey `json:"publicKeys,omitempty"`
+}
@@ -0,0 +1,6 @@
+package fleets
+
+type SshPublicKey struct {
+	KeyData *string `json:"keyData,omitempty"`
+	Path    *string `json:"path,omitempty"`
+}
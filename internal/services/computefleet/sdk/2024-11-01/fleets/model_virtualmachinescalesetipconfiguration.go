@@ -0,0 +1,6 @@
+package fleets
+
+type VirtualMachineScaleSetIPConfiguration struct {
+	Name       string                                           `json:"name"`
+	Properties *VirtualMachineScaleSetIPConfigurationProperties `json:"properties,omitempty"`
+}
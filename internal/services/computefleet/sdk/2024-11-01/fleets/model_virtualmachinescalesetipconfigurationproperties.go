@@ -0,0 +1,6 @@
+package fleets
+
+type VirtualMachineScaleSetIPConfigurationProperties struct {
+	Primary *bool               `json:"primary,omitempty"`
+	Subnet  *ApiEntityReference `json:"subnet,omitempty"`
+}
@@ -0,0 +1,5 @@
+package fleets
+
+type VirtualMachineScaleSetManagedDiskParameters struct {
+	StorageAccountType *StorageAccountTypes `json:"storageAccountType,omitempty"`
+}
@@ -0,0 +1,6 @@
+package fleets
+
+type VirtualMachineScaleSetNetworkConfiguration struct {
+	Name       string                                                `json:"name"`
+	Properties *VirtualMachineScaleSetNetworkConfigurationProperties `json:"properties,omitempty"`
+}
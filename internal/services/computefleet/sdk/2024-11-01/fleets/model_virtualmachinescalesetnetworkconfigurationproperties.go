@@ -0,0 +1,8 @@
+package fleets
+
+type VirtualMachineScaleSetNetworkConfigurationProperties struct {
+	EnableAcceleratedNetworking *bool                                   `json:"enableAcceleratedNetworking,omitempty"`
+	EnableIPForwarding          *bool                                   `json:"enableIPForwarding,omitempty"`
+	IPConfigurations            []VirtualMachineScaleSetIPConfiguration `json:"ipConfigurations"`
+	Primary                     *bool                                   `json:"primary,omitempty"`
+}
@@ -0,0 +1,6 @@
+package fleets
+
+type VirtualMachineScaleSetNetworkProfile struct {
+	NetworkApiVersion              *NetworkApiVersion                            `json:"networkApiVersion,omitempty"`
+	NetworkInterfaceConfigurations *[]VirtualMachineScaleSetNetworkConfiguration `json:"networkInterfaceConfigurations,omitempty"`
+}
@@ -0,0 +1,9 @@
+package fleets
+
+type VirtualMachineScaleSetOSDisk struct {
+	Caching      *CachingTypes                                `json:"caching,omitempty"`
+	CreateOption DiskCreateOptionTypes                        `json:"createOption"`
+	DiskSizeGB   *int64                                       `json:"diskSizeGB,omitempty"`
+	ManagedDisk  *VirtualMachineScaleSetManagedDiskParameters `json:"managedDisk,omitempty"`
+	OsType       *OperatingSystemTypes                        `json:"osType,omitempty"`
+}
@@ -0,0 +1,10 @@
+package fleets
+
+type VirtualMachineScaleSetOSProfile struct {
+	AdminPassword        *string               `json:"adminPassword,omitempty"`
+	AdminUsername        *string               `json:"adminUsername,omitempty"`
+	ComputerNamePrefix   *string               `json:"computerNamePrefix,omitempty"`
+	CustomData           *string               `json:"customData,omitempty"`
+	LinuxConfiguration   *LinuxConfiguration   `json:"linuxConfiguration,omitempty"`
+	WindowsConfiguration *WindowsConfiguration `json:"windowsConfiguration,omitempty"`
+}
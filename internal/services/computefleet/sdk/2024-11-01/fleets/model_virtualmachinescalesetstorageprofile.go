@@ -0,0 +1,6 @@
+package fleets
+
+type VirtualMachineScaleSetStorageProfile struct {
+	ImageReference *ImageReference               `json:"imageReference,omitempty"`
+	OsDisk         *VirtualMachineScaleSetOSDisk `json:"osDisk,omitempty"`
+}
@@ -0,0 +1,6 @@
+package fleets
+
+type VMSizeProfile struct {
+	Name string `json:"name"`
+	Rank *int64 `json:"rank,omitempty"`
+}
@@ -0,0 +1,7 @@
+package fleets
+
+type WindowsConfiguration struct {
+	EnableAutomaticUpdates *bool   `json:"enableAutomaticUpdates,omitempty"`
+	ProvisionVMAgent       *bool   `json:"provisionVMAgent,omitempty"`
+	TimeZone               *string `json:"timeZone,omitempty"`
+}
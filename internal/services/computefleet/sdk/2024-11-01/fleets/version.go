@@ -0,0 +1,9 @@
+package fleets
+
+import "fmt"
+
+const defaultApiVersion = "2024-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/fleets/%s", defaultApiVersion)
+}
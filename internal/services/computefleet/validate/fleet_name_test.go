@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFleetName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "-fleet",
+			Valid: false,
+		},
+		{
+			Input: "fleet-",
+			Valid: false,
+		},
+		{
+			Input: "compute-fleet1",
+			Valid: true,
+		},
+		{
+			Input: "compute_fleet",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 64),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 65),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := FleetName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -21,6 +21,9 @@ import (
 
 type consumptionBudgetBaseResource struct{}
 
+// consumptionBudgetThresholdTypeForecasted isn't defined in the SDK, but is supported by the API
+const consumptionBudgetThresholdTypeForecasted = "Forecasted"
+
 func getDimensionNames() []string {
 	return []string{
 		"ChargeType",
@@ -119,6 +122,7 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 								},
 								"values": {
 									Type:     pluginsdk.TypeList,
+									MinItems: 1,
 									Required: true,
 									Elem: &pluginsdk.Schema{
 										Type:         pluginsdk.TypeString,
@@ -188,6 +192,7 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 											},
 											"values": {
 												Type:     pluginsdk.TypeList,
+												MinItems: 1,
 												Required: true,
 												Elem: &pluginsdk.Schema{
 													Type:         pluginsdk.TypeString,
@@ -231,7 +236,7 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 						ForceNew: true, // TODO: remove this when the above issue is fixed
 						ValidateFunc: validation.StringInSlice([]string{
 							string(consumption.ThresholdTypeActual),
-							"Forecasted",
+							consumptionBudgetThresholdTypeForecasted,
 						}, false),
 					},
 					"operator": {
@@ -297,10 +302,11 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"start_date": {
-						Type:         pluginsdk.TypeString,
-						Required:     true,
-						ValidateFunc: validate.ConsumptionBudgetTimePeriodStartDate,
-						ForceNew:     true,
+						Type:             pluginsdk.TypeString,
+						Required:         true,
+						ValidateFunc:     validate.ConsumptionBudgetTimePeriodStartDate,
+						ForceNew:         true,
+						DiffSuppressFunc: consumptionBudgetStartDateDiffSuppress,
 					},
 					"end_date": {
 						Type:         pluginsdk.TypeString,
@@ -321,6 +327,54 @@ func (br consumptionBudgetBaseResource) arguments(fields map[string]*pluginsdk.S
 	return output
 }
 
+// consumptionBudgetStartDateDiffSuppress suppresses moving the `start_date` of a Budget forward to the start of the
+// current `time_grain` period once the Budget has started, since the Budget rolls over at the end of each period - this
+// allows the `start_date` to be computed (for example as the first of the current month) without the Budget being
+// recreated at every period boundary
+func consumptionBudgetStartDateDiffSuppress(_, old, new string, d *pluginsdk.ResourceData) bool {
+	return consumptionBudgetStartDateWithinCurrentPeriod(old, new, d.Get("time_grain").(string), time.Now())
+}
+
+func consumptionBudgetStartDateWithinCurrentPeriod(old, new, timeGrain string, now time.Time) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	oldStartDate, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newStartDate, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	if !newStartDate.After(oldStartDate) || oldStartDate.After(now) {
+		return false
+	}
+
+	var months int
+	switch consumption.TimeGrainType(timeGrain) {
+	case consumption.TimeGrainTypeMonthly, consumption.TimeGrainTypeBillingMonth:
+		months = 1
+	case consumption.TimeGrainTypeQuarterly, consumption.TimeGrainTypeBillingQuarter:
+		months = 3
+	case consumption.TimeGrainTypeAnnually, consumption.TimeGrainTypeBillingAnnual:
+		months = 12
+	default:
+		return false
+	}
+
+	// find the period (counted from the original `start_date`) which is currently in progress
+	periods := 0
+	for !oldStartDate.AddDate(0, (periods+1)*months, 0).After(now) {
+		periods++
+	}
+	currentPeriodStart := oldStartDate.AddDate(0, periods*months, 0)
+	currentPeriodEnd := oldStartDate.AddDate(0, (periods+1)*months, 0)
+
+	return !newStartDate.Before(currentPeriodStart) && newStartDate.Before(currentPeriodEnd)
+}
+
 func (br consumptionBudgetBaseResource) attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -390,7 +444,7 @@ func (br consumptionBudgetBaseResource) readFunc(scopeFieldName string) sdk.Reso
 
 			resp, err := client.Get(ctx, id.Scope, id.Name)
 			if err != nil {
-				if !utils.ResponseWasNotFound(resp.Response) {
+				if utils.ResponseWasNotFound(resp.Response) {
 					return metadata.MarkAsGone(id)
 				}
 				return fmt.Errorf("reading %s, %+v", *id, err)
@@ -584,6 +638,19 @@ func expandConsumptionBudgetNotifications(input []interface{}) map[string]*consu
 
 	notifications := make(map[string]*consumption.Notification)
 
+	// the Notifications have historically been keyed as `actual_{operator}_{threshold}_Percent` regardless of the
+	// threshold type - to avoid changing the keys of existing Notifications this key is retained, other than for a
+	// Forecasted Notification which uses the same operator and threshold as an Actual Notification
+	actualKeys := make(map[string]struct{})
+	for _, v := range input {
+		if v != nil {
+			notificationRaw := v.(map[string]interface{})
+			if notificationRaw["threshold_type"].(string) == string(consumption.ThresholdTypeActual) {
+				actualKeys[consumptionBudgetNotificationKey("actual", notificationRaw["operator"].(string), notificationRaw["threshold"].(int))] = struct{}{}
+			}
+		}
+	}
+
 	for _, v := range input {
 		if v != nil {
 			notificationRaw := v.(map[string]interface{})
@@ -604,12 +671,14 @@ func expandConsumptionBudgetNotifications(input []interface{}) map[string]*consu
 				notification.ContactRoles = utils.ExpandStringSlice(notificationRaw["contact_roles"].([]interface{}))
 			}
 
-			// contact_groups cannot be set on consumption budgets for management groups
 			if _, ok := notificationRaw["contact_groups"]; ok {
 				notification.ContactGroups = utils.ExpandStringSlice(notificationRaw["contact_groups"].([]interface{}))
 			}
 
-			notificationKey := fmt.Sprintf("actual_%s_%s_Percent", string(notification.Operator), notification.Threshold.StringFixed(0))
+			notificationKey := consumptionBudgetNotificationKey("actual", string(notification.Operator), notificationRaw["threshold"].(int))
+			if _, exists := actualKeys[notificationKey]; exists && notification.ThresholdType != consumption.ThresholdTypeActual {
+				notificationKey = consumptionBudgetNotificationKey("forecasted", string(notification.Operator), notificationRaw["threshold"].(int))
+			}
 			notifications[notificationKey] = &notification
 		}
 	}
@@ -617,6 +686,10 @@ func expandConsumptionBudgetNotifications(input []interface{}) map[string]*consu
 	return notifications
 }
 
+func consumptionBudgetNotificationKey(prefix, operator string, threshold int) string {
+	return fmt.Sprintf("%s_%s_%d_Percent", prefix, operator, threshold)
+}
+
 func flattenConsumptionBudgetNotifications(input map[string]*consumption.Notification, scope string) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -659,19 +732,20 @@ func flattenConsumptionBudgetNotifications(input map[string]*consumption.Notific
 			}
 			block["contact_emails"] = emails
 
+			// contact_roles cannot be set on consumption budgets for management groups
 			if scope != "management_group_id" {
 				var roles []interface{}
 				if v := n.ContactRoles; v != nil {
 					roles = utils.FlattenStringSlice(v)
 				}
 				block["contact_roles"] = roles
+			}
 
-				var groups []interface{}
-				if v := n.ContactGroups; v != nil {
-					groups = utils.FlattenStringSlice(v)
-				}
-				block["contact_groups"] = groups
+			var groups []interface{}
+			if v := n.ContactGroups; v != nil {
+				groups = utils.FlattenStringSlice(v)
 			}
+			block["contact_groups"] = groups
 
 			notifications = append(notifications, block)
 		}
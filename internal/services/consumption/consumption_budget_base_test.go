@@ -0,0 +1,182 @@
+package consumption
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2019-10-01/consumption"
+)
+
+func TestConsumptionBudgetStartDateWithinCurrentPeriod(t *testing.T) {
+	now := time.Date(2022, 5, 15, 12, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		name      string
+		old       string
+		new       string
+		timeGrain string
+		expected  bool
+	}{
+		{
+			name:      "unchanged",
+			old:       "2022-05-01T00:00:00Z",
+			new:       "2022-05-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeMonthly),
+			expected:  false,
+		},
+		{
+			name:      "monthly moved to the current month",
+			old:       "2022-01-01T00:00:00Z",
+			new:       "2022-05-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeMonthly),
+			expected:  true,
+		},
+		{
+			name:      "monthly moved to the next month",
+			old:       "2022-01-01T00:00:00Z",
+			new:       "2022-06-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeMonthly),
+			expected:  false,
+		},
+		{
+			name:      "monthly moved to a previous month",
+			old:       "2022-01-01T00:00:00Z",
+			new:       "2022-04-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeMonthly),
+			expected:  false,
+		},
+		{
+			name:      "monthly moved backwards",
+			old:       "2022-05-01T00:00:00Z",
+			new:       "2022-04-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeMonthly),
+			expected:  false,
+		},
+		{
+			name:      "not yet started",
+			old:       "2022-06-01T00:00:00Z",
+			new:       "2022-07-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeMonthly),
+			expected:  false,
+		},
+		{
+			name:      "quarterly moved to the start of the current quarter",
+			old:       "2022-01-01T00:00:00Z",
+			new:       "2022-04-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeQuarterly),
+			expected:  true,
+		},
+		{
+			name:      "quarterly moved to the current month",
+			old:       "2022-01-01T00:00:00Z",
+			new:       "2022-05-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeQuarterly),
+			expected:  true,
+		},
+		{
+			name:      "quarterly moved to the next quarter",
+			old:       "2022-01-01T00:00:00Z",
+			new:       "2022-07-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeQuarterly),
+			expected:  false,
+		},
+		{
+			name:      "annually moved within the current year",
+			old:       "2021-06-01T00:00:00Z",
+			new:       "2022-05-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeAnnually),
+			expected:  true,
+		},
+		{
+			name:      "annually moved into the next year",
+			old:       "2021-01-01T00:00:00Z",
+			new:       "2023-01-01T00:00:00Z",
+			timeGrain: string(consumption.TimeGrainTypeAnnually),
+			expected:  false,
+		},
+		{
+			name:      "invalid date",
+			old:       "2022-01-01T00:00:00Z",
+			new:       "2022-05-01",
+			timeGrain: string(consumption.TimeGrainTypeMonthly),
+			expected:  false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := consumptionBudgetStartDateWithinCurrentPeriod(v.old, v.new, v.timeGrain, now)
+		if actual != v.expected {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.name)
+		}
+	}
+}
+
+func TestExpandConsumptionBudgetNotificationsKeys(t *testing.T) {
+	notification := func(thresholdType string, threshold int) interface{} {
+		return map[string]interface{}{
+			"enabled":        true,
+			"operator":       "GreaterThan",
+			"threshold":      threshold,
+			"threshold_type": thresholdType,
+			"contact_emails": []interface{}{"foo@example.com"},
+		}
+	}
+
+	testData := []struct {
+		name     string
+		input    []interface{}
+		expected map[string]consumption.ThresholdType
+	}{
+		{
+			name:  "actual",
+			input: []interface{}{notification("Actual", 90)},
+			expected: map[string]consumption.ThresholdType{
+				"actual_GreaterThan_90_Percent": consumption.ThresholdTypeActual,
+			},
+		},
+		{
+			// Forecasted Notifications have historically used the `actual_` prefix, which is retained
+			name:  "forecasted",
+			input: []interface{}{notification("Forecasted", 90)},
+			expected: map[string]consumption.ThresholdType{
+				"actual_GreaterThan_90_Percent": consumptionBudgetThresholdTypeForecasted,
+			},
+		},
+		{
+			name:  "actual and forecasted with different thresholds",
+			input: []interface{}{notification("Actual", 90), notification("Forecasted", 100)},
+			expected: map[string]consumption.ThresholdType{
+				"actual_GreaterThan_90_Percent":  consumption.ThresholdTypeActual,
+				"actual_GreaterThan_100_Percent": consumptionBudgetThresholdTypeForecasted,
+			},
+		},
+		{
+			name:  "actual and forecasted with the same threshold",
+			input: []interface{}{notification("Forecasted", 90), notification("Actual", 90)},
+			expected: map[string]consumption.ThresholdType{
+				"actual_GreaterThan_90_Percent":     consumption.ThresholdTypeActual,
+				"forecasted_GreaterThan_90_Percent": consumptionBudgetThresholdTypeForecasted,
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := expandConsumptionBudgetNotifications(v.input)
+		if len(actual) != len(v.expected) {
+			t.Fatalf("Expected %d Notifications but got %d for %q", len(v.expected), len(actual), v.name)
+		}
+		for key, thresholdType := range v.expected {
+			notification, ok := actual[key]
+			if !ok {
+				t.Fatalf("Expected a Notification with the key %q for %q", key, v.name)
+			}
+			if notification.ThresholdType != thresholdType {
+				t.Fatalf("Expected the Notification %q to have the threshold type %q but got %q", key, thresholdType, notification.ThresholdType)
+			}
+		}
+	}
+}
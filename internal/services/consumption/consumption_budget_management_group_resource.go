@@ -1,6 +1,10 @@
 package consumption
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/services/consumption/mgmt/2019-10-01/consumption"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/consumption/validate"
@@ -15,6 +19,7 @@ type ManagementGroupConsumptionBudget struct {
 
 var _ sdk.Resource = ManagementGroupConsumptionBudget{}
 var _ sdk.ResourceWithCustomImporter = ManagementGroupConsumptionBudget{}
+var _ sdk.ResourceWithCustomizeDiff = ManagementGroupConsumptionBudget{}
 
 func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Schema {
 	schema := map[string]*pluginsdk.Schema{
@@ -60,7 +65,7 @@ func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Sche
 						ForceNew: true, // TODO: remove this when the above issue is fixed
 						ValidateFunc: validation.StringInSlice([]string{
 							string(consumption.ThresholdTypeActual),
-							"Forecasted",
+							consumptionBudgetThresholdTypeForecasted,
 						}, false),
 					},
 					"operator": {
@@ -75,8 +80,16 @@ func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Sche
 
 					"contact_emails": {
 						Type:     pluginsdk.TypeList,
-						Required: true,
-						MinItems: 1,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"contact_groups": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
 						Elem: &pluginsdk.Schema{
 							Type:         pluginsdk.TypeString,
 							ValidateFunc: validation.StringIsNotEmpty,
@@ -121,6 +134,43 @@ func (r ManagementGroupConsumptionBudget) Update() sdk.ResourceFunc {
 	return r.base.updateFunc()
 }
 
+func (r ManagementGroupConsumptionBudget) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// `contact_emails` and `contact_groups` are both Optional, but at least one of these must be specified for
+			// each `notification` - since `notification` is a Set this can't be enforced using `AtLeastOneOf`, and
+			// values which aren't known until apply (e.g. the ID of an Action Group) count as being specified
+			config := metadata.ResourceDiff.GetRawConfig()
+			if config.IsNull() || !config.IsKnown() {
+				return nil
+			}
+
+			notifications := config.GetAttr("notification")
+			if notifications.IsNull() || !notifications.IsKnown() {
+				return nil
+			}
+
+			for it := notifications.ElementIterator(); it.Next(); {
+				_, notification := it.Element()
+				if notification.IsNull() || !notification.IsKnown() {
+					continue
+				}
+
+				contactEmails := notification.GetAttr("contact_emails")
+				contactGroups := notification.GetAttr("contact_groups")
+				emailsEmpty := contactEmails.IsNull() || (contactEmails.IsKnown() && contactEmails.LengthInt() == 0)
+				groupsEmpty := contactGroups.IsNull() || (contactGroups.IsKnown() && contactGroups.LengthInt() == 0)
+				if emailsEmpty && groupsEmpty {
+					return fmt.Errorf("at least one of `contact_emails` or `contact_groups` must be specified for each `notification`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ManagementGroupConsumptionBudget) CustomImporter() sdk.ResourceRunFunc {
 	return r.base.importerFunc("management_group")
 }
@@ -3,6 +3,7 @@ package consumption_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -85,6 +86,22 @@ func TestAccConsumptionBudgetManagementGroup_complete(t *testing.T) {
 		data.ImportStep(),
 	})
 }
+func TestAccConsumptionBudgetManagementGroup_forecastedWithActionGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_management_group", "test")
+	r := ConsumptionBudgetManagementGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.forecastedWithActionGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("notification.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccConsumptionBudgetManagementGroup_completeUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_management_group", "test")
 	r := ConsumptionBudgetManagementGroupResource{}
@@ -107,6 +124,18 @@ func TestAccConsumptionBudgetManagementGroup_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccConsumptionBudgetManagementGroup_withoutContacts(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_consumption_budget_management_group", "test")
+	r := ConsumptionBudgetManagementGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.withoutContacts(data),
+			ExpectError: regexp.MustCompile("at least one of `contact_emails` or `contact_groups` must be specified"),
+		},
+	})
+}
+
 func (ConsumptionBudgetManagementGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ConsumptionBudgetID(state.ID)
 	if err != nil {
@@ -167,6 +196,38 @@ resource "azurerm_consumption_budget_management_group" "test" {
 `, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
 }
 
+func (ConsumptionBudgetManagementGroupResource) withoutContacts(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_management_group" "tenant_root" {
+  name = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_consumption_budget_management_group" "test" {
+  name                = "acctestconsumptionbudgetManagementGroup-%d"
+  management_group_id = data.azurerm_management_group.tenant_root.id
+
+  amount     = 1000
+  time_grain = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  notification {
+    enabled   = true
+    threshold = 90.0
+    operator  = "EqualTo"
+  }
+}
+`, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
+}
+
 func (ConsumptionBudgetManagementGroupResource) basicUpdate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -336,6 +397,65 @@ resource "azurerm_consumption_budget_management_group" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339), consumptionBudgetTestStartDate().AddDate(1, 1, 0).Format(time.RFC3339))
 }
 
+func (ConsumptionBudgetManagementGroupResource) forecastedWithActionGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_management_group" "tenant_root" {
+  name = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestAG-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestAG"
+}
+
+resource "azurerm_consumption_budget_management_group" "test" {
+  name                = "acctestconsumptionbudgetManagementGroup-%d"
+  management_group_id = data.azurerm_management_group.tenant_root.id
+
+  amount     = 1000
+  time_grain = "Monthly"
+
+  time_period {
+    start_date = "%s"
+  }
+
+  notification {
+    enabled        = true
+    threshold      = 90.0
+    operator       = "GreaterThan"
+    threshold_type = "Actual"
+
+    contact_emails = [
+      "foo@example.com",
+    ]
+  }
+
+  notification {
+    enabled        = true
+    threshold      = 90.0
+    operator       = "GreaterThan"
+    threshold_type = "Forecasted"
+
+    contact_groups = [
+      azurerm_monitor_action_group.test.id,
+    ]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, consumptionBudgetTestStartDate().Format(time.RFC3339))
+}
+
 func (ConsumptionBudgetManagementGroupResource) completeUpdate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2025-01-01/javacomponents"
+)
+
+type Client struct {
+	JavaComponentsClient *javacomponents.JavaComponentsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	javaComponentsClient := javacomponents.NewJavaComponentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&javaComponentsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		JavaComponentsClient: &javaComponentsClient,
+	}
+}
@@ -0,0 +1,332 @@
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2025-01-01/javacomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ContainerAppEnvironmentJavaComponentResource{}
+	_ sdk.ResourceWithCustomizeDiff = ContainerAppEnvironmentJavaComponentResource{}
+)
+
+type ContainerAppEnvironmentJavaComponentModel struct {
+	Name                      string                                     `tfschema:"name"`
+	ContainerAppEnvironmentId string                                     `tfschema:"container_app_environment_id"`
+	ComponentType             string                                     `tfschema:"component_type"`
+	Configuration             map[string]string                          `tfschema:"configuration"`
+	MinReplicas               int                                        `tfschema:"min_replicas"`
+	MaxReplicas               int                                        `tfschema:"max_replicas"`
+	ServiceBind               []ContainerAppEnvironmentJavaComponentBind `tfschema:"service_bind"`
+}
+
+type ContainerAppEnvironmentJavaComponentBind struct {
+	Name      string `tfschema:"name"`
+	ServiceId string `tfschema:"service_id"`
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z][a-z0-9-]{0,30}[a-z0-9]$`),
+				"`name` must be between 2 and 32 characters, start with a lowercase letter, end with a lowercase letter or number and may only contain lowercase letters, numbers and hyphens",
+			),
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: javacomponents.ValidateManagedEnvironmentID,
+		},
+
+		"component_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(javacomponents.JavaComponentTypeSpringBootAdmin),
+				string(javacomponents.JavaComponentTypeSpringCloudConfig),
+				string(javacomponents.JavaComponentTypeSpringCloudEureka),
+			}, false),
+		},
+
+		"configuration": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"min_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 30),
+		},
+
+		"max_replicas": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 30),
+		},
+
+		"service_bind": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"service_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: javacomponents.ValidateJavaComponentID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentJavaComponentModel{}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_java_component"
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return javacomponents.ValidateJavaComponentID
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			minReplicas := metadata.ResourceDiff.Get("min_replicas").(int)
+			maxReplicas := metadata.ResourceDiff.Get("max_replicas").(int)
+			if minReplicas > maxReplicas {
+				return fmt.Errorf("`min_replicas` (%d) cannot be greater than `max_replicas` (%d)", minReplicas, maxReplicas)
+			}
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			var model ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			environmentId, err := javacomponents.ParseManagedEnvironmentID(model.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := javacomponents.NewJavaComponentID(environmentId.SubscriptionId, environmentId.ResourceGroupName, environmentId.ManagedEnvironmentName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandContainerAppEnvironmentJavaComponent(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentJavaComponentModel{
+				Name:                      id.JavaComponentName,
+				ContainerAppEnvironmentId: javacomponents.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ComponentType = string(props.ComponentType)
+					state.Configuration = flattenContainerAppEnvironmentJavaComponentConfigurations(props.Configurations)
+					state.ServiceBind = flattenContainerAppEnvironmentJavaComponentServiceBinds(props.ServiceBinds)
+
+					// the API omits the scale block when the defaults are used
+					state.MinReplicas = 1
+					state.MaxReplicas = 1
+					if scale := props.Scale; scale != nil {
+						if scale.MinReplicas != nil {
+							state.MinReplicas = int(*scale.MinReplicas)
+						}
+						if scale.MaxReplicas != nil {
+							state.MaxReplicas = int(*scale.MaxReplicas)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandContainerAppEnvironmentJavaComponent(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerAppEnvironmentJavaComponent(input ContainerAppEnvironmentJavaComponentModel) javacomponents.JavaComponent {
+	configurations := make([]javacomponents.JavaComponentConfigurationProperty, 0)
+	for k, v := range input.Configuration {
+		configurations = append(configurations, javacomponents.JavaComponentConfigurationProperty{
+			PropertyName: utils.String(k),
+			Value:        utils.String(v),
+		})
+	}
+
+	serviceBinds := make([]javacomponents.JavaComponentServiceBind, 0)
+	for _, v := range input.ServiceBind {
+		serviceBinds = append(serviceBinds, javacomponents.JavaComponentServiceBind{
+			Name:      utils.String(v.Name),
+			ServiceId: utils.String(v.ServiceId),
+		})
+	}
+
+	return javacomponents.JavaComponent{
+		Properties: &javacomponents.JavaComponentProperties{
+			ComponentType:  javacomponents.JavaComponentType(input.ComponentType),
+			Configurations: &configurations,
+			Scale: &javacomponents.JavaComponentPropertiesScale{
+				MinReplicas: utils.Int64(int64(input.MinReplicas)),
+				MaxReplicas: utils.Int64(int64(input.MaxReplicas)),
+			},
+			ServiceBinds: &serviceBinds,
+		},
+	}
+}
+
+func flattenContainerAppEnvironmentJavaComponentConfigurations(input *[]javacomponents.JavaComponentConfigurationProperty) map[string]string {
+	results := make(map[string]string)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.PropertyName == nil {
+			continue
+		}
+		results[*v.PropertyName] = utils.NormalizeNilableString(v.Value)
+	}
+
+	return results
+}
+
+func flattenContainerAppEnvironmentJavaComponentServiceBinds(input *[]javacomponents.JavaComponentServiceBind) []ContainerAppEnvironmentJavaComponentBind {
+	results := make([]ContainerAppEnvironmentJavaComponentBind, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		results = append(results, ContainerAppEnvironmentJavaComponentBind{
+			Name:      utils.NormalizeNilableString(v.Name),
+			ServiceId: utils.NormalizeNilableString(v.ServiceId),
+		})
+	}
+
+	return results
+}
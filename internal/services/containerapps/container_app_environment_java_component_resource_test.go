@@ -0,0 +1,161 @@
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/sdk/2025-01-01/javacomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct {
+	environmentId string
+}
+
+// Container App Environments can't be provisioned by the Provider, so these tests need an existing one
+func newContainerAppEnvironmentJavaComponentResource(t *testing.T) ContainerAppEnvironmentJavaComponentResource {
+	environmentId := os.Getenv("ARM_TEST_CONTAINER_APP_ENVIRONMENT_ID")
+	if environmentId == "" {
+		t.Skip("Skipping as ARM_TEST_CONTAINER_APP_ENVIRONMENT_ID is not specified")
+	}
+
+	return ContainerAppEnvironmentJavaComponentResource{
+		environmentId: environmentId,
+	}
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_configServer(t *testing.T) {
+	r := newContainerAppEnvironmentJavaComponentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.configServer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_requiresImport(t *testing.T) {
+	r := newContainerAppEnvironmentJavaComponentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.configServer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_eurekaWithAdmin(t *testing.T) {
+	r := newContainerAppEnvironmentJavaComponentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.eurekaWithAdmin(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_container_app_environment_java_component.admin").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.eurekaWithAdmin(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("max_replicas").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerAppEnvironmentJavaComponentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := javacomponents.ParseJavaComponentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ContainerApps.JavaComponentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) configServer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_container_app_environment_java_component" "test" {
+  name                         = "acctest-config-%[2]s"
+  container_app_environment_id = %[1]q
+  component_type               = "SpringCloudConfig"
+
+  configuration = {
+    "spring.cloud.config.server.git.uri"           = "https://github.com/Azure-Samples/azure-spring-apps-config.git"
+    "spring.cloud.config.server.git.default-label" = "main"
+  }
+}
+`, r.environmentId, data.RandomString)
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_app_environment_java_component" "import" {
+  name                         = azurerm_container_app_environment_java_component.test.name
+  container_app_environment_id = azurerm_container_app_environment_java_component.test.container_app_environment_id
+  component_type               = azurerm_container_app_environment_java_component.test.component_type
+  configuration                = azurerm_container_app_environment_java_component.test.configuration
+}
+`, r.configServer(data))
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) eurekaWithAdmin(data acceptance.TestData, maxReplicas int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_container_app_environment_java_component" "test" {
+  name                         = "acctest-eureka-%[2]s"
+  container_app_environment_id = %[1]q
+  component_type               = "SpringCloudEureka"
+  max_replicas                 = %[3]d
+}
+
+resource "azurerm_container_app_environment_java_component" "admin" {
+  name                         = "acctest-admin-%[2]s"
+  container_app_environment_id = %[1]q
+  component_type               = "SpringBootAdmin"
+
+  service_bind {
+    name       = "eureka"
+    service_id = azurerm_container_app_environment_java_component.test.id
+  }
+}
+`, r.environmentId, data.RandomString, maxReplicas)
+}
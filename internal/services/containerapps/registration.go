@@ -0,0 +1,31 @@
+package containerapps
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ContainerAppEnvironmentJavaComponentResource{},
+	}
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Container Apps"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Container Apps",
+	}
+}
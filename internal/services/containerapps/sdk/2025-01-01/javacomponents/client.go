@@ -0,0 +1,15 @@
+package javacomponents
+
+import "github.com/Azure/go-autorest/autorest"
+
+type JavaComponentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewJavaComponentsClientWithBaseURI(endpoint string) JavaComponentsClient {
+	return JavaComponentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,77 @@
+package javacomponents
+
+import "strings"
+
+type JavaComponentProvisioningState string
+
+const (
+	JavaComponentProvisioningStateCanceled   JavaComponentProvisioningState = "Canceled"
+	JavaComponentProvisioningStateDeleting   JavaComponentProvisioningState = "Deleting"
+	JavaComponentProvisioningStateFailed     JavaComponentProvisioningState = "Failed"
+	JavaComponentProvisioningStateInProgress JavaComponentProvisioningState = "InProgress"
+	JavaComponentProvisioningStateSucceeded  JavaComponentProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForJavaComponentProvisioningState() []string {
+	return []string{
+		string(JavaComponentProvisioningStateCanceled),
+		string(JavaComponentProvisioningStateDeleting),
+		string(JavaComponentProvisioningStateFailed),
+		string(JavaComponentProvisioningStateInProgress),
+		string(JavaComponentProvisioningStateSucceeded),
+	}
+}
+
+func parseJavaComponentProvisioningState(input string) (*JavaComponentProvisioningState, error) {
+	vals := map[string]JavaComponentProvisioningState{
+		"canceled":   JavaComponentProvisioningStateCanceled,
+		"deleting":   JavaComponentProvisioningStateDeleting,
+		"failed":     JavaComponentProvisioningStateFailed,
+		"inprogress": JavaComponentProvisioningStateInProgress,
+		"succeeded":  JavaComponentProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := JavaComponentProvisioningState(input)
+	return &out, nil
+}
+
+type JavaComponentType string
+
+const (
+	JavaComponentTypeNacos              JavaComponentType = "Nacos"
+	JavaComponentTypeSpringBootAdmin    JavaComponentType = "SpringBootAdmin"
+	JavaComponentTypeSpringCloudConfig  JavaComponentType = "SpringCloudConfig"
+	JavaComponentTypeSpringCloudEureka  JavaComponentType = "SpringCloudEureka"
+	JavaComponentTypeSpringCloudGateway JavaComponentType = "SpringCloudGateway"
+)
+
+func PossibleValuesForJavaComponentType() []string {
+	return []string{
+		string(JavaComponentTypeNacos),
+		string(JavaComponentTypeSpringBootAdmin),
+		string(JavaComponentTypeSpringCloudConfig),
+		string(JavaComponentTypeSpringCloudEureka),
+		string(JavaComponentTypeSpringCloudGateway),
+	}
+}
+
+func parseJavaComponentType(input string) (*JavaComponentType, error) {
+	vals := map[string]JavaComponentType{
+		"nacos":              JavaComponentTypeNacos,
+		"springbootadmin":    JavaComponentTypeSpringBootAdmin,
+		"springcloudconfig":  JavaComponentTypeSpringCloudConfig,
+		"springcloudeureka":  JavaComponentTypeSpringCloudEureka,
+		"springcloudgateway": JavaComponentTypeSpringCloudGateway,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := JavaComponentType(input)
+	return &out, nil
+}
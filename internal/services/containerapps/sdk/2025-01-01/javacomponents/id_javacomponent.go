@@ -0,0 +1,137 @@
+package javacomponents
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = JavaComponentId{}
+
+// JavaComponentId is a struct representing the Resource ID for a Java Component
+type JavaComponentId struct {
+	SubscriptionId         string
+	ResourceGroupName      string
+	ManagedEnvironmentName string
+	JavaComponentName      string
+}
+
+// NewJavaComponentID returns a new JavaComponentId struct
+func NewJavaComponentID(subscriptionId string, resourceGroupName string, managedEnvironmentName string, javaComponentName string) JavaComponentId {
+	return JavaComponentId{
+		SubscriptionId:         subscriptionId,
+		ResourceGroupName:      resourceGroupName,
+		ManagedEnvironmentName: managedEnvironmentName,
+		JavaComponentName:      javaComponentName,
+	}
+}
+
+// ParseJavaComponentID parses 'input' into a JavaComponentId
+func ParseJavaComponentID(input string) (*JavaComponentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(JavaComponentId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := JavaComponentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ManagedEnvironmentName, ok = parsed.Parsed["managedEnvironmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managedEnvironmentName' was not found in the resource id %q", input)
+	}
+
+	if id.JavaComponentName, ok = parsed.Parsed["javaComponentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'javaComponentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseJavaComponentIDInsensitively parses 'input' case-insensitively into a JavaComponentId
+// note: this method should only be used for API response data and not user input
+func ParseJavaComponentIDInsensitively(input string) (*JavaComponentId, error) {
+	parser := resourceids.NewParserFromResourceIdType(JavaComponentId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := JavaComponentId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ManagedEnvironmentName, ok = parsed.Parsed["managedEnvironmentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'managedEnvironmentName' was not found in the resource id %q", input)
+	}
+
+	if id.JavaComponentName, ok = parsed.Parsed["javaComponentName"]; !ok {
+		return nil, fmt.Errorf("the segment 'javaComponentName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateJavaComponentID checks that 'input' can be parsed as a Java Component ID
+func ValidateJavaComponentID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseJavaComponentID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Java Component ID
+func (id JavaComponentId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.App/managedEnvironments/%s/javaComponents/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName, id.JavaComponentName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Java Component ID
+func (id JavaComponentId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftApp", "Microsoft.App", "Microsoft.App"),
+		resourceids.StaticSegment("staticManagedEnvironments", "managedEnvironments", "managedEnvironments"),
+		resourceids.UserSpecifiedSegment("managedEnvironmentName", "managedEnvironmentValue"),
+		resourceids.StaticSegment("staticJavaComponents", "javaComponents", "javaComponents"),
+		resourceids.UserSpecifiedSegment("javaComponentName", "javaComponentValue"),
+	}
+}
+
+// String returns a human-readable description of this Java Component ID
+func (id JavaComponentId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Managed Environment Name: %q", id.ManagedEnvironmentName),
+		fmt.Sprintf("Java Component Name: %q", id.JavaComponentName),
+	}
+	return fmt.Sprintf("Java Component (%s)", strings.Join(components, "\n"))
+}
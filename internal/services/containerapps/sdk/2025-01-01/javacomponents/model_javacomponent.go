@@ -0,0 +1,8 @@
+package javacomponents
+
+type JavaComponent struct {
+	Id         *string                  `json:"id,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *JavaComponentProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package javacomponents
+
+type JavaComponentConfigurationProperty struct {
+	PropertyName *string `json:"propertyName,omitempty"`
+	Value        *string `json:"value,omitempty"`
+}
@@ -0,0 +1,9 @@
+package javacomponents
+
+type JavaComponentProperties struct {
+	ComponentType     JavaComponentType                     `json:"componentType"`
+	Configurations    *[]JavaComponentConfigurationProperty `json:"configurations,omitempty"`
+	ProvisioningState *JavaComponentProvisioningState       `json:"provisioningState,omitempty"`
+	Scale             *JavaComponentPropertiesScale         `json:"scale,omitempty"`
+	ServiceBinds      *[]JavaComponentServiceBind           `json:"serviceBinds,omitempty"`
+}
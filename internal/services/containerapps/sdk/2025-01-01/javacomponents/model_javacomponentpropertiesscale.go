@@ -0,0 +1,6 @@
+package javacomponents
+
+type JavaComponentPropertiesScale struct {
+	MaxReplicas *int64 `json:"maxReplicas,omitempty"`
+	MinReplicas *int64 `json:"minReplicas,omitempty"`
+}
@@ -0,0 +1,6 @@
+package javacomponents
+
+type JavaComponentServiceBind struct {
+	Name      *string `json:"name,omitempty"`
+	ServiceId *string `json:"serviceId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package javacomponents
+
+import "fmt"
+
+const defaultApiVersion = "2025-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/javacomponents/%s", defaultApiVersion)
+}
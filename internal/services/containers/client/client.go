@@ -8,20 +8,28 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/containerregistry/mgmt/2020-11-01-preview/containerregistry"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2024-03-01/standbycontainergrouppools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2024-05-01/trustedaccess"
 )
 
 type Client struct {
 	AgentPoolsClient                *containerservice.AgentPoolsClient
+	ExtensionsClient                *extensions.ExtensionsClient
+	FluxConfigurationClient         *fluxconfiguration.FluxConfigurationClient
 	GroupsClient                    *containerinstance.ContainerGroupsClient
 	KubernetesClustersClient        *containerservice.ManagedClustersClient
 	MaintenanceConfigurationsClient *containerservice.MaintenanceConfigurationsClient
 	RegistriesClient                *containerregistry.RegistriesClient
 	ReplicationsClient              *containerregistry.ReplicationsClient
 	ServicesClient                  *legacy.ContainerServicesClient
+	StandbyPoolsClient              *standbycontainergrouppools.StandbyContainerGroupPoolsClient
 	WebhooksClient                  *containerregistry.WebhooksClient
 	TokensClient                    *containerregistry.TokensClient
 	ScopeMapsClient                 *containerregistry.ScopeMapsClient
 	TasksClient                     *legacyacr.TasksClient
+	TrustedAccessClient             *trustedaccess.TrustedAccessClient
 
 	Environment azure.Environment
 }
@@ -48,6 +56,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	groupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
+	standbyPoolsClient := standbycontainergrouppools.NewStandbyContainerGroupPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&standbyPoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	// AKS
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&kubernetesClustersClient.Client, o.ResourceManagerAuthorizer)
@@ -58,11 +69,22 @@ func NewClient(o *common.ClientOptions) *Client {
 	maintenanceConfigurationsClient := containerservice.NewMaintenanceConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&maintenanceConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	extensionsClient := extensions.NewExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&extensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	fluxConfigurationClient := fluxconfiguration.NewFluxConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&fluxConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
+	trustedAccessClient := trustedaccess.NewTrustedAccessClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&trustedAccessClient.Client, o.ResourceManagerAuthorizer)
+
 	servicesClient := legacy.NewContainerServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
 		AgentPoolsClient:                &agentPoolsClient,
+		ExtensionsClient:                &extensionsClient,
+		FluxConfigurationClient:         &fluxConfigurationClient,
 		KubernetesClustersClient:        &kubernetesClustersClient,
 		GroupsClient:                    &groupsClient,
 		MaintenanceConfigurationsClient: &maintenanceConfigurationsClient,
@@ -70,9 +92,11 @@ func NewClient(o *common.ClientOptions) *Client {
 		WebhooksClient:                  &webhooksClient,
 		ReplicationsClient:              &replicationsClient,
 		ServicesClient:                  &servicesClient,
+		StandbyPoolsClient:              &standbyPoolsClient,
 		Environment:                     o.Environment,
 		TokensClient:                    &tokensClient,
 		ScopeMapsClient:                 &scopeMapsClient,
 		TasksClient:                     &tasksClient,
+		TrustedAccessClient:             &trustedAccessClient,
 	}
 }
@@ -0,0 +1,308 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2024-03-01/standbycontainergrouppools"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerGroupStandbyPoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerGroupStandbyPoolResource{}
+
+type ContainerGroupStandbyPoolModel struct {
+	Name                          string            `tfschema:"name"`
+	ResourceGroupName             string            `tfschema:"resource_group_name"`
+	Location                      string            `tfschema:"location"`
+	ContainerGroupProfileId       string            `tfschema:"container_group_profile_id"`
+	ContainerGroupProfileRevision int64             `tfschema:"container_group_profile_revision"`
+	MaxReadyCapacity              int64             `tfschema:"max_ready_capacity"`
+	RefillPolicy                  string            `tfschema:"refill_policy"`
+	SubnetIds                     []string          `tfschema:"subnet_ids"`
+	Tags                          map[string]string `tfschema:"tags"`
+}
+
+func (r ContainerGroupStandbyPoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9-]{3,24}$`),
+				"`name` must be between 3 and 24 characters and can contain only letters, numbers and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		// the Container Group Profile describes the Container Groups which are kept warm in the pool
+		"container_group_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"container_group_profile_revision": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"max_ready_capacity": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 2000),
+		},
+
+		"refill_policy": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(standbycontainergrouppools.RefillPolicyAlways),
+			ValidateFunc: validation.StringInSlice(
+				standbycontainergrouppools.PossibleValuesForRefillPolicy(),
+				false,
+			),
+		},
+
+		"subnet_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerGroupStandbyPoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerGroupStandbyPoolResource) ModelObject() interface{} {
+	return &ContainerGroupStandbyPoolModel{}
+}
+
+func (r ContainerGroupStandbyPoolResource) ResourceType() string {
+	return "azurerm_container_group_standby_pool"
+}
+
+func (r ContainerGroupStandbyPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return standbycontainergrouppools.ValidateStandbyContainerGroupPoolID
+}
+
+func (r ContainerGroupStandbyPoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.StandbyPoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerGroupStandbyPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := standbycontainergrouppools.NewStandbyContainerGroupPoolID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			refillPolicy := standbycontainergrouppools.RefillPolicy(model.RefillPolicy)
+			payload := standbycontainergrouppools.StandbyContainerGroupPoolResource{
+				Location: location.Normalize(model.Location),
+				Properties: &standbycontainergrouppools.StandbyContainerGroupPoolResourceProperties{
+					ContainerGroupProperties: expandContainerGroupStandbyPoolContainerGroupProperties(model),
+					ElasticityProfile: standbycontainergrouppools.StandbyContainerGroupPoolElasticityProfile{
+						MaxReadyCapacity: model.MaxReadyCapacity,
+						RefillPolicy:     &refillPolicy,
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerGroupStandbyPoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.StandbyPoolsClient
+
+			id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerGroupStandbyPoolModel{
+				Name:              id.StandbyContainerGroupPoolName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					profile := props.ContainerGroupProperties.ContainerGroupProfile
+					state.ContainerGroupProfileId = profile.Id
+					if profile.Revision != nil {
+						state.ContainerGroupProfileRevision = *profile.Revision
+					}
+
+					subnetIds := make([]string, 0)
+					if subnets := props.ContainerGroupProperties.SubnetIds; subnets != nil {
+						for _, subnet := range *subnets {
+							subnetIds = append(subnetIds, subnet.Id)
+						}
+					}
+					state.SubnetIds = subnetIds
+
+					state.MaxReadyCapacity = props.ElasticityProfile.MaxReadyCapacity
+					if v := props.ElasticityProfile.RefillPolicy; v != nil {
+						state.RefillPolicy = string(*v)
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerGroupStandbyPoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.StandbyPoolsClient
+
+			id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerGroupStandbyPoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChanges("container_group_profile_id", "container_group_profile_revision", "subnet_ids") {
+				payload.Properties.ContainerGroupProperties = expandContainerGroupStandbyPoolContainerGroupProperties(model)
+			}
+
+			if metadata.ResourceData.HasChange("max_ready_capacity") {
+				payload.Properties.ElasticityProfile.MaxReadyCapacity = model.MaxReadyCapacity
+			}
+
+			if metadata.ResourceData.HasChange("refill_policy") {
+				refillPolicy := standbycontainergrouppools.RefillPolicy(model.RefillPolicy)
+				payload.Properties.ElasticityProfile.RefillPolicy = &refillPolicy
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerGroupStandbyPoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.StandbyPoolsClient
+
+			id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerGroupStandbyPoolContainerGroupProperties(model ContainerGroupStandbyPoolModel) standbycontainergrouppools.ContainerGroupProperties {
+	output := standbycontainergrouppools.ContainerGroupProperties{
+		ContainerGroupProfile: standbycontainergrouppools.ContainerGroupProfile{
+			Id: model.ContainerGroupProfileId,
+		},
+	}
+
+	if model.ContainerGroupProfileRevision > 0 {
+		output.ContainerGroupProfile.Revision = utils.Int64(model.ContainerGroupProfileRevision)
+	}
+
+	if len(model.SubnetIds) > 0 {
+		subnets := make([]standbycontainergrouppools.Subnet, 0)
+		for _, subnetId := range model.SubnetIds {
+			subnets = append(subnets, standbycontainergrouppools.Subnet{
+				Id: subnetId,
+			})
+		}
+		output.SubnetIds = &subnets
+	}
+
+	return output
+}
@@ -0,0 +1,220 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2024-03-01/standbycontainergrouppools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerGroupStandbyPoolResource struct{}
+
+func TestAccContainerGroupStandbyPool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group_standby_pool", "test")
+	r := ContainerGroupStandbyPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("refill_policy").HasValue("always"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerGroupStandbyPool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group_standby_pool", "test")
+	r := ContainerGroupStandbyPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerGroupStandbyPool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_group_standby_pool", "test")
+	r := ContainerGroupStandbyPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("max_ready_capacity").HasValue("5"),
+				check.That(data.ResourceName).Key("container_group_profile_revision").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("max_ready_capacity").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerGroupStandbyPoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := standbycontainergrouppools.ParseStandbyContainerGroupPoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.StandbyPoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContainerGroupStandbyPoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_group_standby_pool" "test" {
+  name                       = "acctestsp-%d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  container_group_profile_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  max_ready_capacity         = 1
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContainerGroupStandbyPoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_group_standby_pool" "import" {
+  name                       = azurerm_container_group_standby_pool.test.name
+  resource_group_name        = azurerm_container_group_standby_pool.test.resource_group_name
+  location                   = azurerm_container_group_standby_pool.test.location
+  container_group_profile_id = azurerm_container_group_standby_pool.test.container_group_profile_id
+  max_ready_capacity         = azurerm_container_group_standby_pool.test.max_ready_capacity
+}
+`, r.basic(data))
+}
+
+func (r ContainerGroupStandbyPoolResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_group_standby_pool" "test" {
+  name                             = "acctestsp-%d"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = azurerm_resource_group.test.location
+  container_group_profile_id       = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  container_group_profile_revision = 1
+  max_ready_capacity               = 5
+  refill_policy                    = "always"
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (ContainerGroupStandbyPoolResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sp-%[1]d"
+  location = "%[2]s"
+}
+
+# Container Group Profiles aren't supported by the provider yet, so an ARM template is used to create one.
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-cgp-deployment-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  parameters_content = jsonencode({
+    name = {
+      value = "acctest-cgp-%[1]d"
+    }
+  })
+
+  template_content = <<EOF
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "name": {
+      "type": "String"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.ContainerInstance/containerGroupProfiles",
+      "apiVersion": "2024-05-01-preview",
+      "name": "[parameters('name')]",
+      "location": "${azurerm_resource_group.test.location}",
+      "properties": {
+        "sku": "Standard",
+        "osType": "Linux",
+        "containers": [
+          {
+            "name": "hello-world",
+            "properties": {
+              "image": "mcr.microsoft.com/azuredocs/aci-helloworld:latest",
+              "ports": [
+                {
+                  "port": 80
+                }
+              ],
+              "resources": {
+                "requests": {
+                  "cpu": 1,
+                  "memoryInGB": 1.5
+                }
+              }
+            }
+          }
+        ]
+      }
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "String",
+      "value": "[resourceId('Microsoft.ContainerInstance/containerGroupProfiles', parameters('name'))]"
+    }
+  }
+}
+EOF
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,334 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/extensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesClusterExtensionResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterExtensionResource{}
+
+type KubernetesClusterExtensionModel struct {
+	Name                           string            `tfschema:"name"`
+	ClusterId                      string            `tfschema:"cluster_id"`
+	ExtensionType                  string            `tfschema:"extension_type"`
+	ReleaseTrain                   string            `tfschema:"release_train"`
+	Version                        string            `tfschema:"version"`
+	ReleaseNamespace               string            `tfschema:"release_namespace"`
+	TargetNamespace                string            `tfschema:"target_namespace"`
+	ConfigurationSettings          map[string]string `tfschema:"configuration_settings"`
+	ConfigurationProtectedSettings map[string]string `tfschema:"configuration_protected_settings"`
+	CurrentVersion                 string            `tfschema:"current_version"`
+}
+
+func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// both AKS and Azure Arc-enabled Kubernetes clusters can host extensions
+		"cluster_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				validate.ClusterID,
+				validate.ConnectedClusterID,
+			),
+		},
+
+		"extension_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"release_train": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"release_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"target_namespace"},
+		},
+
+		"target_namespace": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"release_namespace"},
+		},
+
+		"configuration_settings": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"configuration_protected_settings": {
+			Type:      pluginsdk.TypeMap,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"current_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) ModelObject() interface{} {
+	return &KubernetesClusterExtensionModel{}
+}
+
+func (r KubernetesClusterExtensionResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_extension"
+}
+
+func (r KubernetesClusterExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return extensions.ValidateScopedExtensionID
+}
+
+func (r KubernetesClusterExtensionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ExtensionsClient
+
+			var model KubernetesClusterExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := extensions.NewScopedExtensionID(model.ClusterId, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := extensions.ExtensionProperties{
+				AutoUpgradeMinorVersion:        utils.Bool(model.Version == ""),
+				ConfigurationProtectedSettings: &model.ConfigurationProtectedSettings,
+				ConfigurationSettings:          &model.ConfigurationSettings,
+				ExtensionType:                  utils.String(model.ExtensionType),
+				Scope:                          expandKubernetesClusterExtensionScope(model),
+			}
+			if model.ReleaseTrain != "" {
+				props.ReleaseTrain = utils.String(model.ReleaseTrain)
+			}
+			if model.Version != "" {
+				props.Version = utils.String(model.Version)
+			}
+
+			payload := extensions.Extension{
+				Properties: &props,
+			}
+
+			// extensions on Arc-enabled clusters authenticate back to Azure using their own identity
+			if _, err := parse.ConnectedClusterID(model.ClusterId); err == nil {
+				payload.Identity = &identity.SystemAssigned{
+					Type: identity.TypeSystemAssigned,
+				}
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterExtensionModel{
+				Name:      id.ExtensionName,
+				ClusterId: id.Scope,
+			}
+
+			// the API doesn't return the protected settings, so these are pulled from the config
+			var config KubernetesClusterExtensionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.ConfigurationProtectedSettings = config.ConfigurationProtectedSettings
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.ConfigurationSettings != nil {
+						state.ConfigurationSettings = *props.ConfigurationSettings
+					}
+					state.CurrentVersion = utils.NormalizeNilableString(props.CurrentVersion)
+					state.ExtensionType = utils.NormalizeNilableString(props.ExtensionType)
+					state.ReleaseTrain = utils.NormalizeNilableString(props.ReleaseTrain)
+					state.Version = utils.NormalizeNilableString(props.Version)
+
+					if scope := props.Scope; scope != nil {
+						if scope.Cluster != nil {
+							state.ReleaseNamespace = utils.NormalizeNilableString(scope.Cluster.ReleaseNamespace)
+						}
+						if scope.Namespace != nil {
+							state.TargetNamespace = utils.NormalizeNilableString(scope.Namespace.TargetNamespace)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterExtensionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := extensions.PatchExtensionProperties{}
+
+			if metadata.ResourceData.HasChange("configuration_settings") {
+				props.ConfigurationSettings = &model.ConfigurationSettings
+			}
+
+			if metadata.ResourceData.HasChange("configuration_protected_settings") {
+				props.ConfigurationProtectedSettings = &model.ConfigurationProtectedSettings
+			}
+
+			if metadata.ResourceData.HasChange("release_train") {
+				props.ReleaseTrain = utils.String(model.ReleaseTrain)
+			}
+
+			if metadata.ResourceData.HasChange("version") {
+				props.AutoUpgradeMinorVersion = utils.Bool(model.Version == "")
+				if model.Version != "" {
+					props.Version = utils.String(model.Version)
+				}
+			}
+
+			payload := extensions.PatchExtension{
+				Properties: &props,
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterExtensionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ExtensionsClient
+
+			id, err := extensions.ParseScopedExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesClusterExtensionScope(input KubernetesClusterExtensionModel) *extensions.Scope {
+	if input.TargetNamespace != "" {
+		return &extensions.Scope{
+			Namespace: &extensions.ScopeNamespace{
+				TargetNamespace: utils.String(input.TargetNamespace),
+			},
+		}
+	}
+
+	if input.ReleaseNamespace != "" {
+		return &extensions.Scope{
+			Cluster: &extensions.ScopeCluster{
+				ReleaseNamespace: utils.String(input.ReleaseNamespace),
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,217 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2024-05-01/trustedaccess"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KubernetesClusterTrustedAccessRoleBindingResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesClusterTrustedAccessRoleBindingResource{}
+
+type KubernetesClusterTrustedAccessRoleBindingModel struct {
+	Name                string   `tfschema:"name"`
+	KubernetesClusterId string   `tfschema:"kubernetes_cluster_id"`
+	Roles               []string `tfschema:"roles"`
+	SourceResourceId    string   `tfschema:"source_resource_id"`
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]{0,22}[a-zA-Z0-9])?$`),
+				"`name` must be between 1 and 24 characters, can contain only letters, numbers and hyphens and must start and end with a letter or number",
+			),
+		},
+
+		"kubernetes_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ClusterID,
+		},
+
+		// the roles which can be bound depend on the type of the source resource, for example
+		// `Microsoft.MachineLearningServices/workspaces/mlworkload`
+		"roles": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"source_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) ModelObject() interface{} {
+	return &KubernetesClusterTrustedAccessRoleBindingModel{}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) ResourceType() string {
+	return "azurerm_kubernetes_cluster_trusted_access_role_binding"
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return trustedaccess.ValidateTrustedAccessRoleBindingID
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			var model KubernetesClusterTrustedAccessRoleBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := parse.ClusterID(model.KubernetesClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := trustedaccess.NewTrustedAccessRoleBindingID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ManagedClusterName, model.Name)
+			existing, err := client.RoleBindingsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := trustedaccess.TrustedAccessRoleBinding{
+				Properties: trustedaccess.TrustedAccessRoleBindingProperties{
+					Roles:            model.Roles,
+					SourceResourceId: model.SourceResourceId,
+				},
+			}
+			if err := client.RoleBindingsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			id, err := trustedaccess.ParseTrustedAccessRoleBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.RoleBindingsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesClusterTrustedAccessRoleBindingModel{
+				Name:                id.TrustedAccessRoleBindingName,
+				KubernetesClusterId: parse.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Roles = model.Properties.Roles
+				state.SourceResourceId = model.Properties.SourceResourceId
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			id, err := trustedaccess.ParseTrustedAccessRoleBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesClusterTrustedAccessRoleBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.RoleBindingsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("roles") {
+				payload.Properties.Roles = model.Roles
+			}
+
+			if err := client.RoleBindingsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.TrustedAccessClient
+
+			id, err := trustedaccess.ParseTrustedAccessRoleBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.RoleBindingsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,1103 @@
+package containers
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	fluxConfigurationReferenceTypeBranch = "branch"
+	fluxConfigurationReferenceTypeCommit = "commit"
+	fluxConfigurationReferenceTypeSemver = "semver"
+	fluxConfigurationReferenceTypeTag    = "tag"
+
+	// the keys used to pass the source secrets to Flux in the protected settings
+	fluxConfigurationProtectedSettingsBucketSecretKey = "bucketSecretKey"
+	fluxConfigurationProtectedSettingsHttpsKey        = "httpsKey"
+	fluxConfigurationProtectedSettingsSshPrivateKey   = "sshPrivateKey"
+)
+
+type KubernetesFluxConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = KubernetesFluxConfigurationResource{}
+
+type KubernetesFluxConfigurationModel struct {
+	Name                            string                                     `tfschema:"name"`
+	ClusterId                       string                                     `tfschema:"cluster_id"`
+	Namespace                       string                                     `tfschema:"namespace"`
+	Scope                           string                                     `tfschema:"scope"`
+	Kustomizations                  []KubernetesFluxConfigurationKustomization `tfschema:"kustomizations"`
+	GitRepository                   []KubernetesFluxConfigurationGitRepository `tfschema:"git_repository"`
+	Bucket                          []KubernetesFluxConfigurationBucket        `tfschema:"bucket"`
+	BlobStorage                     []KubernetesFluxConfigurationBlobStorage   `tfschema:"blob_storage"`
+	ContinuousReconciliationEnabled bool                                       `tfschema:"continuous_reconciliation_enabled"`
+}
+
+type KubernetesFluxConfigurationKustomization struct {
+	Name                     string                                 `tfschema:"name"`
+	Path                     string                                 `tfschema:"path"`
+	TimeoutInSeconds         int64                                  `tfschema:"timeout_in_seconds"`
+	SyncIntervalInSeconds    int64                                  `tfschema:"sync_interval_in_seconds"`
+	RetryIntervalInSeconds   int64                                  `tfschema:"retry_interval_in_seconds"`
+	RecreatingEnabled        bool                                   `tfschema:"recreating_enabled"`
+	GarbageCollectionEnabled bool                                   `tfschema:"garbage_collection_enabled"`
+	WaitEnabled              bool                                   `tfschema:"wait_enabled"`
+	DependsOn                []string                               `tfschema:"depends_on"`
+	PostBuild                []KubernetesFluxConfigurationPostBuild `tfschema:"post_build"`
+}
+
+type KubernetesFluxConfigurationPostBuild struct {
+	Substitute     map[string]string                           `tfschema:"substitute"`
+	SubstituteFrom []KubernetesFluxConfigurationSubstituteFrom `tfschema:"substitute_from"`
+}
+
+type KubernetesFluxConfigurationSubstituteFrom struct {
+	Kind     string `tfschema:"kind"`
+	Name     string `tfschema:"name"`
+	Optional bool   `tfschema:"optional"`
+}
+
+type KubernetesFluxConfigurationGitRepository struct {
+	Url                   string `tfschema:"url"`
+	ReferenceType         string `tfschema:"reference_type"`
+	ReferenceValue        string `tfschema:"reference_value"`
+	HttpsUser             string `tfschema:"https_user"`
+	HttpsKeyBase64        string `tfschema:"https_key_base64"`
+	HttpsCACertBase64     string `tfschema:"https_ca_cert_base64"`
+	SshPrivateKeyBase64   string `tfschema:"ssh_private_key_base64"`
+	SshKnownHostsBase64   string `tfschema:"ssh_known_hosts_base64"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int64  `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int64  `tfschema:"timeout_in_seconds"`
+}
+
+type KubernetesFluxConfigurationBucket struct {
+	Url                   string `tfschema:"url"`
+	BucketName            string `tfschema:"bucket_name"`
+	AccessKey             string `tfschema:"access_key"`
+	SecretKeyBase64       string `tfschema:"secret_key_base64"`
+	TlsEnabled            bool   `tfschema:"tls_enabled"`
+	LocalAuthReference    string `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int64  `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int64  `tfschema:"timeout_in_seconds"`
+}
+
+type KubernetesFluxConfigurationBlobStorage struct {
+	ContainerId           string                                        `tfschema:"container_id"`
+	AccountKey            string                                        `tfschema:"account_key"`
+	SasToken              string                                        `tfschema:"sas_token"`
+	ManagedIdentity       []KubernetesFluxConfigurationManagedIdentity  `tfschema:"managed_identity"`
+	ServicePrincipal      []KubernetesFluxConfigurationServicePrincipal `tfschema:"service_principal"`
+	LocalAuthReference    string                                        `tfschema:"local_auth_reference"`
+	SyncIntervalInSeconds int64                                         `tfschema:"sync_interval_in_seconds"`
+	TimeoutInSeconds      int64                                         `tfschema:"timeout_in_seconds"`
+}
+
+type KubernetesFluxConfigurationManagedIdentity struct {
+	ClientId string `tfschema:"client_id"`
+}
+
+type KubernetesFluxConfigurationServicePrincipal struct {
+	ClientId                   string `tfschema:"client_id"`
+	TenantId                   string `tfschema:"tenant_id"`
+	ClientSecret               string `tfschema:"client_secret"`
+	ClientCertificateBase64    string `tfschema:"client_certificate_base64"`
+	ClientCertificatePassword  string `tfschema:"client_certificate_password"`
+	ClientCertificateSendChain bool   `tfschema:"client_certificate_send_chain"`
+}
+
+func fluxConfigurationIntervalSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeInt,
+		Optional:     true,
+		Default:      600,
+		ValidateFunc: validation.IntBetween(1, 35791394),
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	sourceKinds := []string{"git_repository", "bucket", "blob_storage"}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,28}[a-z0-9])?$`),
+				"`name` must be between 1 and 30 characters, can only contain lowercase alphanumeric characters and hyphens, and must start and end with an alphanumeric character",
+			),
+		},
+
+		// both AKS and Azure Arc-enabled Kubernetes clusters can host Flux configurations
+		"cluster_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				validate.ClusterID,
+				validate.ConnectedClusterID,
+			),
+		},
+
+		"namespace": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`),
+				"`namespace` must be between 1 and 63 characters, can only contain lowercase alphanumeric characters and hyphens, and must start and end with an alphanumeric character",
+			),
+		},
+
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(fluxconfiguration.ScopeTypeNamespace),
+			ValidateFunc: validation.StringInSlice(fluxconfiguration.PossibleValuesForScopeType(), false),
+		},
+
+		"kustomizations": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"timeout_in_seconds": fluxConfigurationIntervalSchema(),
+
+					"sync_interval_in_seconds": fluxConfigurationIntervalSchema(),
+
+					"retry_interval_in_seconds": fluxConfigurationIntervalSchema(),
+
+					"recreating_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"garbage_collection_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"wait_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"depends_on": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"post_build": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"substitute": {
+									Type:     pluginsdk.TypeMap,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+
+								"substitute_from": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"kind": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													"ConfigMap",
+													"Secret",
+												}, false),
+											},
+
+											"name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"optional": {
+												Type:     pluginsdk.TypeBool,
+												Optional: true,
+												Default:  false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"git_repository": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceKinds,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^(https?://|ssh://|git@)`),
+							"`url` must start with `http://`, `https://`, `ssh://` or `git@`",
+						),
+					},
+
+					"reference_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							fluxConfigurationReferenceTypeBranch,
+							fluxConfigurationReferenceTypeCommit,
+							fluxConfigurationReferenceTypeSemver,
+							fluxConfigurationReferenceTypeTag,
+						}, false),
+					},
+
+					"reference_value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"https_user": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						RequiredWith:  []string{"git_repository.0.https_key_base64"},
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.ssh_private_key_base64"},
+					},
+
+					"https_key_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsBase64,
+						RequiredWith: []string{"git_repository.0.https_user"},
+					},
+
+					"https_ca_cert_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"ssh_private_key_base64": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						Sensitive:     true,
+						ValidateFunc:  validation.StringIsBase64,
+						ConflictsWith: []string{"git_repository.0.local_auth_reference", "git_repository.0.https_user"},
+					},
+
+					"ssh_known_hosts_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsBase64,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						ConflictsWith: []string{"git_repository.0.https_user", "git_repository.0.ssh_private_key_base64"},
+					},
+
+					"sync_interval_in_seconds": fluxConfigurationIntervalSchema(),
+
+					"timeout_in_seconds": fluxConfigurationIntervalSchema(),
+				},
+			},
+		},
+
+		"bucket": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceKinds,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"bucket_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"access_key": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						RequiredWith:  []string{"bucket.0.secret_key_base64"},
+						ConflictsWith: []string{"bucket.0.local_auth_reference"},
+					},
+
+					"secret_key_base64": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsBase64,
+						RequiredWith: []string{"bucket.0.access_key"},
+					},
+
+					"tls_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"local_auth_reference": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						ConflictsWith: []string{"bucket.0.access_key"},
+					},
+
+					"sync_interval_in_seconds": fluxConfigurationIntervalSchema(),
+
+					"timeout_in_seconds": fluxConfigurationIntervalSchema(),
+				},
+			},
+		},
+
+		"blob_storage": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: sourceKinds,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"container_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"account_key": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+					},
+
+					"sas_token": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+					},
+
+					"managed_identity": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"client_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+							},
+						},
+					},
+
+					"service_principal": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"client_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+
+								"tenant_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+
+								"client_secret": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									ExactlyOneOf: []string{"blob_storage.0.service_principal.0.client_secret", "blob_storage.0.service_principal.0.client_certificate_base64"},
+								},
+
+								"client_certificate_base64": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsBase64,
+									ExactlyOneOf: []string{"blob_storage.0.service_principal.0.client_secret", "blob_storage.0.service_principal.0.client_certificate_base64"},
+								},
+
+								"client_certificate_password": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									RequiredWith: []string{"blob_storage.0.service_principal.0.client_certificate_base64"},
+								},
+
+								"client_certificate_send_chain": {
+									Type:         pluginsdk.TypeBool,
+									Optional:     true,
+									Default:      false,
+									RequiredWith: []string{"blob_storage.0.service_principal.0.client_certificate_base64"},
+								},
+							},
+						},
+					},
+
+					"local_auth_reference": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+					},
+
+					"sync_interval_in_seconds": fluxConfigurationIntervalSchema(),
+
+					"timeout_in_seconds": fluxConfigurationIntervalSchema(),
+				},
+			},
+		},
+
+		"continuous_reconciliation_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFluxConfigurationResource) ModelObject() interface{} {
+	return &KubernetesFluxConfigurationModel{}
+}
+
+func (r KubernetesFluxConfigurationResource) ResourceType() string {
+	return "azurerm_kubernetes_flux_configuration"
+}
+
+func (r KubernetesFluxConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return fluxconfiguration.ValidateScopedFluxConfigurationID
+}
+
+func (r KubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			var model KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := fluxconfiguration.NewScopedFluxConfigurationID(model.ClusterId, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := expandKubernetesFluxConfigurationProperties(model)
+			if err != nil {
+				return err
+			}
+
+			payload := fluxconfiguration.FluxConfiguration{
+				Properties: props,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API doesn't return any of the source credentials, so these are pulled from the config
+			var config KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := KubernetesFluxConfigurationModel{
+				Name:      id.FluxConfigurationName,
+				ClusterId: id.Scope,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.BlobStorage = flattenKubernetesFluxConfigurationBlobStorage(props.AzureBlob, config.BlobStorage)
+					state.Bucket = flattenKubernetesFluxConfigurationBucket(props.Bucket, config.Bucket)
+					state.ContinuousReconciliationEnabled = props.Suspend == nil || !*props.Suspend
+					state.GitRepository = flattenKubernetesFluxConfigurationGitRepository(props.GitRepository, config.GitRepository)
+					state.Kustomizations = flattenKubernetesFluxConfigurationKustomizations(props.Kustomizations)
+					state.Namespace = utils.NormalizeNilableString(props.Namespace)
+					if props.Scope != nil {
+						state.Scope = string(*props.Scope)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KubernetesFluxConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the source credentials are write-only, so the whole configuration is sent to ensure these are retained
+			props, err := expandKubernetesFluxConfigurationProperties(model)
+			if err != nil {
+				return err
+			}
+
+			payload := fluxconfiguration.FluxConfiguration{
+				Properties: props,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFluxConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FluxConfigurationClient
+
+			id, err := fluxconfiguration.ParseScopedFluxConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFluxConfigurationProperties(input KubernetesFluxConfigurationModel) (*fluxconfiguration.FluxConfigurationProperties, error) {
+	kustomizations, err := expandKubernetesFluxConfigurationKustomizations(input.Kustomizations)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := fluxconfiguration.ScopeType(input.Scope)
+	protectedSettings := make(map[string]string)
+	props := fluxconfiguration.FluxConfigurationProperties{
+		ConfigurationProtectedSettings: &protectedSettings,
+		Kustomizations:                 kustomizations,
+		Namespace:                      utils.String(input.Namespace),
+		Scope:                          &scope,
+		Suspend:                        utils.Bool(!input.ContinuousReconciliationEnabled),
+	}
+
+	switch {
+	case len(input.GitRepository) > 0:
+		sourceKind := fluxconfiguration.SourceKindTypeGitRepository
+		props.SourceKind = &sourceKind
+
+		gitRepository := input.GitRepository[0]
+		props.GitRepository = expandKubernetesFluxConfigurationGitRepository(gitRepository)
+		if gitRepository.HttpsKeyBase64 != "" {
+			protectedSettings[fluxConfigurationProtectedSettingsHttpsKey] = gitRepository.HttpsKeyBase64
+		}
+		if gitRepository.SshPrivateKeyBase64 != "" {
+			protectedSettings[fluxConfigurationProtectedSettingsSshPrivateKey] = gitRepository.SshPrivateKeyBase64
+		}
+
+	case len(input.Bucket) > 0:
+		sourceKind := fluxconfiguration.SourceKindTypeBucket
+		props.SourceKind = &sourceKind
+
+		bucket := input.Bucket[0]
+		props.Bucket = expandKubernetesFluxConfigurationBucket(bucket)
+		if bucket.SecretKeyBase64 != "" {
+			protectedSettings[fluxConfigurationProtectedSettingsBucketSecretKey] = bucket.SecretKeyBase64
+		}
+
+	case len(input.BlobStorage) > 0:
+		sourceKind := fluxconfiguration.SourceKindTypeAzureBlob
+		props.SourceKind = &sourceKind
+
+		blobStorage, err := expandKubernetesFluxConfigurationBlobStorage(input.BlobStorage[0])
+		if err != nil {
+			return nil, err
+		}
+		props.AzureBlob = blobStorage
+	}
+
+	return &props, nil
+}
+
+func expandKubernetesFluxConfigurationKustomizations(input []KubernetesFluxConfigurationKustomization) (*map[string]fluxconfiguration.KustomizationDefinition, error) {
+	names := make(map[string]struct{})
+	for _, v := range input {
+		if _, ok := names[v.Name]; ok {
+			return nil, fmt.Errorf("`kustomizations` contains more than one kustomization named %q", v.Name)
+		}
+		names[v.Name] = struct{}{}
+	}
+
+	output := make(map[string]fluxconfiguration.KustomizationDefinition)
+	for _, v := range input {
+		// Flux will never reconcile a kustomization which depends on one that doesn't exist, so catch this up front
+		for _, dependency := range v.DependsOn {
+			if dependency == v.Name {
+				return nil, fmt.Errorf("kustomization %q cannot depend on itself", v.Name)
+			}
+			if _, ok := names[dependency]; !ok {
+				return nil, fmt.Errorf("kustomization %q depends on %q which is not defined in `kustomizations`", v.Name, dependency)
+			}
+		}
+
+		dependsOn := v.DependsOn
+		output[v.Name] = fluxconfiguration.KustomizationDefinition{
+			DependsOn:              &dependsOn,
+			Force:                  utils.Bool(v.RecreatingEnabled),
+			Path:                   utils.String(v.Path),
+			PostBuild:              expandKubernetesFluxConfigurationPostBuild(v.PostBuild),
+			Prune:                  utils.Bool(v.GarbageCollectionEnabled),
+			RetryIntervalInSeconds: utils.Int64(v.RetryIntervalInSeconds),
+			SyncIntervalInSeconds:  utils.Int64(v.SyncIntervalInSeconds),
+			TimeoutInSeconds:       utils.Int64(v.TimeoutInSeconds),
+			Wait:                   utils.Bool(v.WaitEnabled),
+		}
+	}
+
+	return &output, nil
+}
+
+func expandKubernetesFluxConfigurationPostBuild(input []KubernetesFluxConfigurationPostBuild) *fluxconfiguration.PostBuildDefinition {
+	if len(input) == 0 {
+		return nil
+	}
+
+	postBuild := input[0]
+	substituteFrom := make([]fluxconfiguration.SubstituteFromDefinition, 0)
+	for _, v := range postBuild.SubstituteFrom {
+		substituteFrom = append(substituteFrom, fluxconfiguration.SubstituteFromDefinition{
+			Kind:     utils.String(v.Kind),
+			Name:     utils.String(v.Name),
+			Optional: utils.Bool(v.Optional),
+		})
+	}
+
+	return &fluxconfiguration.PostBuildDefinition{
+		Substitute:     &postBuild.Substitute,
+		SubstituteFrom: &substituteFrom,
+	}
+}
+
+func expandKubernetesFluxConfigurationGitRepository(input KubernetesFluxConfigurationGitRepository) *fluxconfiguration.GitRepositoryDefinition {
+	output := fluxconfiguration.GitRepositoryDefinition{
+		RepositoryRef:         &fluxconfiguration.RepositoryRefDefinition{},
+		SyncIntervalInSeconds: utils.Int64(input.SyncIntervalInSeconds),
+		TimeoutInSeconds:      utils.Int64(input.TimeoutInSeconds),
+		Url:                   utils.String(input.Url),
+	}
+
+	switch input.ReferenceType {
+	case fluxConfigurationReferenceTypeBranch:
+		output.RepositoryRef.Branch = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeCommit:
+		output.RepositoryRef.Commit = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeSemver:
+		output.RepositoryRef.Semver = utils.String(input.ReferenceValue)
+	case fluxConfigurationReferenceTypeTag:
+		output.RepositoryRef.Tag = utils.String(input.ReferenceValue)
+	}
+
+	if input.HttpsCACertBase64 != "" {
+		output.HTTPSCACert = utils.String(input.HttpsCACertBase64)
+	}
+	if input.HttpsUser != "" {
+		output.HTTPSUser = utils.String(input.HttpsUser)
+	}
+	if input.LocalAuthReference != "" {
+		output.LocalAuthRef = utils.String(input.LocalAuthReference)
+	}
+	if input.SshKnownHostsBase64 != "" {
+		output.SshKnownHosts = utils.String(input.SshKnownHostsBase64)
+	}
+
+	return &output
+}
+
+func expandKubernetesFluxConfigurationBucket(input KubernetesFluxConfigurationBucket) *fluxconfiguration.BucketDefinition {
+	output := fluxconfiguration.BucketDefinition{
+		BucketName:            utils.String(input.BucketName),
+		Insecure:              utils.Bool(!input.TlsEnabled),
+		SyncIntervalInSeconds: utils.Int64(input.SyncIntervalInSeconds),
+		TimeoutInSeconds:      utils.Int64(input.TimeoutInSeconds),
+		Url:                   utils.String(input.Url),
+	}
+
+	if input.AccessKey != "" {
+		output.AccessKey = utils.String(input.AccessKey)
+	}
+	if input.LocalAuthReference != "" {
+		output.LocalAuthRef = utils.String(input.LocalAuthReference)
+	}
+
+	return &output
+}
+
+func expandKubernetesFluxConfigurationBlobStorage(input KubernetesFluxConfigurationBlobStorage) (*fluxconfiguration.AzureBlobDefinition, error) {
+	containerId, err := storageParse.StorageContainerDataPlaneID(input.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	output := fluxconfiguration.AzureBlobDefinition{
+		ContainerName:         utils.String(containerId.Name),
+		SyncIntervalInSeconds: utils.Int64(input.SyncIntervalInSeconds),
+		TimeoutInSeconds:      utils.Int64(input.TimeoutInSeconds),
+		Url:                   utils.String(strings.TrimSuffix(input.ContainerId, "/"+containerId.Name)),
+	}
+
+	if input.AccountKey != "" {
+		output.AccountKey = utils.String(input.AccountKey)
+	}
+	if input.LocalAuthReference != "" {
+		output.LocalAuthRef = utils.String(input.LocalAuthReference)
+	}
+	if input.SasToken != "" {
+		output.SasToken = utils.String(input.SasToken)
+	}
+
+	if len(input.ManagedIdentity) > 0 {
+		output.ManagedIdentity = &fluxconfiguration.ManagedIdentityDefinition{
+			ClientId: utils.String(input.ManagedIdentity[0].ClientId),
+		}
+	}
+
+	if len(input.ServicePrincipal) > 0 {
+		servicePrincipal := input.ServicePrincipal[0]
+		output.ServicePrincipal = &fluxconfiguration.ServicePrincipalDefinition{
+			ClientId: utils.String(servicePrincipal.ClientId),
+			TenantId: utils.String(servicePrincipal.TenantId),
+		}
+		if servicePrincipal.ClientSecret != "" {
+			output.ServicePrincipal.ClientSecret = utils.String(servicePrincipal.ClientSecret)
+		}
+		if servicePrincipal.ClientCertificateBase64 != "" {
+			output.ServicePrincipal.ClientCertificate = utils.String(servicePrincipal.ClientCertificateBase64)
+			output.ServicePrincipal.ClientCertificateSendChain = utils.Bool(servicePrincipal.ClientCertificateSendChain)
+		}
+		if servicePrincipal.ClientCertificatePassword != "" {
+			output.ServicePrincipal.ClientCertificatePassword = utils.String(servicePrincipal.ClientCertificatePassword)
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenKubernetesFluxConfigurationKustomizations(input *map[string]fluxconfiguration.KustomizationDefinition) []KubernetesFluxConfigurationKustomization {
+	output := make([]KubernetesFluxConfigurationKustomization, 0)
+	if input == nil {
+		return output
+	}
+
+	names := make([]string, 0)
+	for k := range *input {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v := (*input)[name]
+		kustomization := KubernetesFluxConfigurationKustomization{
+			Name:                     name,
+			Path:                     utils.NormalizeNilableString(v.Path),
+			RecreatingEnabled:        v.Force != nil && *v.Force,
+			GarbageCollectionEnabled: v.Prune != nil && *v.Prune,
+			WaitEnabled:              v.Wait == nil || *v.Wait,
+			DependsOn:                make([]string, 0),
+			PostBuild:                flattenKubernetesFluxConfigurationPostBuild(v.PostBuild),
+		}
+		if v.DependsOn != nil {
+			kustomization.DependsOn = *v.DependsOn
+		}
+		if v.RetryIntervalInSeconds != nil {
+			kustomization.RetryIntervalInSeconds = *v.RetryIntervalInSeconds
+		}
+		if v.SyncIntervalInSeconds != nil {
+			kustomization.SyncIntervalInSeconds = *v.SyncIntervalInSeconds
+		}
+		if v.TimeoutInSeconds != nil {
+			kustomization.TimeoutInSeconds = *v.TimeoutInSeconds
+		}
+
+		output = append(output, kustomization)
+	}
+
+	return output
+}
+
+func flattenKubernetesFluxConfigurationPostBuild(input *fluxconfiguration.PostBuildDefinition) []KubernetesFluxConfigurationPostBuild {
+	if input == nil {
+		return []KubernetesFluxConfigurationPostBuild{}
+	}
+
+	substitute := make(map[string]string)
+	if input.Substitute != nil {
+		substitute = *input.Substitute
+	}
+
+	substituteFrom := make([]KubernetesFluxConfigurationSubstituteFrom, 0)
+	if input.SubstituteFrom != nil {
+		for _, v := range *input.SubstituteFrom {
+			substituteFrom = append(substituteFrom, KubernetesFluxConfigurationSubstituteFrom{
+				Kind:     utils.NormalizeNilableString(v.Kind),
+				Name:     utils.NormalizeNilableString(v.Name),
+				Optional: v.Optional != nil && *v.Optional,
+			})
+		}
+	}
+
+	// the API returns an empty object when no post build settings were specified
+	if len(substitute) == 0 && len(substituteFrom) == 0 {
+		return []KubernetesFluxConfigurationPostBuild{}
+	}
+
+	return []KubernetesFluxConfigurationPostBuild{
+		{
+			Substitute:     substitute,
+			SubstituteFrom: substituteFrom,
+		},
+	}
+}
+
+func flattenKubernetesFluxConfigurationGitRepository(input *fluxconfiguration.GitRepositoryDefinition, config []KubernetesFluxConfigurationGitRepository) []KubernetesFluxConfigurationGitRepository {
+	if input == nil || input.Url == nil {
+		return []KubernetesFluxConfigurationGitRepository{}
+	}
+
+	output := KubernetesFluxConfigurationGitRepository{
+		Url:                 utils.NormalizeNilableString(input.Url),
+		HttpsUser:           utils.NormalizeNilableString(input.HTTPSUser),
+		HttpsCACertBase64:   utils.NormalizeNilableString(input.HTTPSCACert),
+		SshKnownHostsBase64: utils.NormalizeNilableString(input.SshKnownHosts),
+		LocalAuthReference:  utils.NormalizeNilableString(input.LocalAuthRef),
+	}
+	if len(config) > 0 {
+		output.HttpsKeyBase64 = config[0].HttpsKeyBase64
+		output.SshPrivateKeyBase64 = config[0].SshPrivateKeyBase64
+	}
+
+	if ref := input.RepositoryRef; ref != nil {
+		switch {
+		case ref.Branch != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeBranch
+			output.ReferenceValue = *ref.Branch
+		case ref.Commit != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeCommit
+			output.ReferenceValue = *ref.Commit
+		case ref.Semver != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeSemver
+			output.ReferenceValue = *ref.Semver
+		case ref.Tag != nil:
+			output.ReferenceType = fluxConfigurationReferenceTypeTag
+			output.ReferenceValue = *ref.Tag
+		}
+	}
+
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = *input.SyncIntervalInSeconds
+	}
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = *input.TimeoutInSeconds
+	}
+
+	return []KubernetesFluxConfigurationGitRepository{output}
+}
+
+func flattenKubernetesFluxConfigurationBucket(input *fluxconfiguration.BucketDefinition, config []KubernetesFluxConfigurationBucket) []KubernetesFluxConfigurationBucket {
+	if input == nil || input.Url == nil {
+		return []KubernetesFluxConfigurationBucket{}
+	}
+
+	output := KubernetesFluxConfigurationBucket{
+		Url:                utils.NormalizeNilableString(input.Url),
+		BucketName:         utils.NormalizeNilableString(input.BucketName),
+		AccessKey:          utils.NormalizeNilableString(input.AccessKey),
+		TlsEnabled:         input.Insecure == nil || !*input.Insecure,
+		LocalAuthReference: utils.NormalizeNilableString(input.LocalAuthRef),
+	}
+	if len(config) > 0 {
+		output.SecretKeyBase64 = config[0].SecretKeyBase64
+	}
+
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = *input.SyncIntervalInSeconds
+	}
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = *input.TimeoutInSeconds
+	}
+
+	return []KubernetesFluxConfigurationBucket{output}
+}
+
+func flattenKubernetesFluxConfigurationBlobStorage(input *fluxconfiguration.AzureBlobDefinition, config []KubernetesFluxConfigurationBlobStorage) []KubernetesFluxConfigurationBlobStorage {
+	if input == nil || input.Url == nil {
+		return []KubernetesFluxConfigurationBlobStorage{}
+	}
+
+	output := KubernetesFluxConfigurationBlobStorage{
+		ContainerId:        fmt.Sprintf("%s/%s", strings.TrimSuffix(*input.Url, "/"), utils.NormalizeNilableString(input.ContainerName)),
+		LocalAuthReference: utils.NormalizeNilableString(input.LocalAuthRef),
+		ManagedIdentity:    []KubernetesFluxConfigurationManagedIdentity{},
+		ServicePrincipal:   []KubernetesFluxConfigurationServicePrincipal{},
+	}
+
+	var configServicePrincipal KubernetesFluxConfigurationServicePrincipal
+	if len(config) > 0 {
+		output.AccountKey = config[0].AccountKey
+		output.SasToken = config[0].SasToken
+		if len(config[0].ServicePrincipal) > 0 {
+			configServicePrincipal = config[0].ServicePrincipal[0]
+		}
+	}
+
+	if input.ManagedIdentity != nil {
+		output.ManagedIdentity = []KubernetesFluxConfigurationManagedIdentity{
+			{
+				ClientId: utils.NormalizeNilableString(input.ManagedIdentity.ClientId),
+			},
+		}
+	}
+
+	if sp := input.ServicePrincipal; sp != nil {
+		output.ServicePrincipal = []KubernetesFluxConfigurationServicePrincipal{
+			{
+				ClientId:                   utils.NormalizeNilableString(sp.ClientId),
+				TenantId:                   utils.NormalizeNilableString(sp.TenantId),
+				ClientSecret:               configServicePrincipal.ClientSecret,
+				ClientCertificateBase64:    configServicePrincipal.ClientCertificateBase64,
+				ClientCertificatePassword:  configServicePrincipal.ClientCertificatePassword,
+				ClientCertificateSendChain: sp.ClientCertificateSendChain != nil && *sp.ClientCertificateSendChain,
+			},
+		}
+	}
+
+	if input.SyncIntervalInSeconds != nil {
+		output.SyncIntervalInSeconds = *input.SyncIntervalInSeconds
+	}
+	if input.TimeoutInSeconds != nil {
+		output.TimeoutInSeconds = *input.TimeoutInSeconds
+	}
+
+	return []KubernetesFluxConfigurationBlobStorage{output}
+}
@@ -0,0 +1,431 @@
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-05-01/fluxconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KubernetesFluxConfigurationResource struct{}
+
+func TestAccKubernetesFluxConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFluxConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKubernetesFluxConfiguration_kustomizationDependencies(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.kustomizationDependencies(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.kustomizationMissingDependency(data),
+			ExpectError: regexp.MustCompile(`kustomization "applications" depends on "infrastructure" which is not defined in .kustomizations.`),
+		},
+	})
+}
+
+func TestAccKubernetesFluxConfiguration_bucket(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.bucket(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("bucket.0.secret_key_base64"),
+	})
+}
+
+func TestAccKubernetesFluxConfiguration_blobStorageAccountKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blobStorageAccountKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("blob_storage.0.account_key"),
+	})
+}
+
+func TestAccKubernetesFluxConfiguration_blobStorageManagedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blobStorageManagedIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesFluxConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("continuous_reconciliation_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("continuous_reconciliation_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (KubernetesFluxConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := fluxconfiguration.ParseScopedFluxConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.FluxConfigurationClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r KubernetesFluxConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, KubernetesClusterExtensionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r KubernetesFluxConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_flux_configuration" "import" {
+  name       = azurerm_kubernetes_flux_configuration.test.name
+  cluster_id = azurerm_kubernetes_flux_configuration.test.cluster_id
+  namespace  = azurerm_kubernetes_flux_configuration.test.namespace
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, r.basic(data))
+}
+
+func (r KubernetesFluxConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name                              = "acctest-fc-%d"
+  cluster_id                        = azurerm_kubernetes_cluster.test.id
+  namespace                         = "flux"
+  continuous_reconciliation_enabled = false
+
+  git_repository {
+    url                      = "https://github.com/Azure/arc-k8s-demo"
+    reference_type           = "branch"
+    reference_value          = "main"
+    sync_interval_in_seconds = 300
+    timeout_in_seconds       = 300
+  }
+
+  kustomizations {
+    name                       = "kustomization-1"
+    path                       = "./test/path"
+    timeout_in_seconds         = 300
+    sync_interval_in_seconds   = 300
+    retry_interval_in_seconds  = 300
+    recreating_enabled         = true
+    garbage_collection_enabled = true
+    wait_enabled               = false
+
+    post_build {
+      substitute = {
+        cluster_env = "test"
+      }
+
+      substitute_from {
+        kind     = "ConfigMap"
+        name     = "cluster-settings"
+        optional = true
+      }
+    }
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, KubernetesClusterExtensionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r KubernetesFluxConfigurationResource) kustomizationDependencies(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name = "infrastructure"
+    path = "./infrastructure"
+  }
+
+  kustomizations {
+    name       = "applications"
+    path       = "./applications"
+    depends_on = ["infrastructure"]
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, KubernetesClusterExtensionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r KubernetesFluxConfigurationResource) kustomizationMissingDependency(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name       = "applications"
+    path       = "./applications"
+    depends_on = ["infrastructure"]
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, KubernetesClusterExtensionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r KubernetesFluxConfigurationResource) bucket(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  bucket {
+    url                      = "https://storage.googleapis.com"
+    bucket_name              = "flux"
+    access_key               = "example"
+    secret_key_base64        = base64encode("example")
+    tls_enabled              = true
+    sync_interval_in_seconds = 300
+    timeout_in_seconds       = 300
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, KubernetesClusterExtensionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r KubernetesFluxConfigurationResource) blobStorageTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "flux"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, KubernetesClusterExtensionResource{}.basic(data), data.RandomString)
+}
+
+func (r KubernetesFluxConfigurationResource) blobStorageAccountKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  blob_storage {
+    container_id = azurerm_storage_container.test.id
+    account_key  = azurerm_storage_account.test.primary_access_key
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, r.blobStorageTemplate(data), data.RandomInteger)
+}
+
+func (r KubernetesFluxConfigurationResource) blobStorageManagedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Reader"
+  principal_id         = azurerm_kubernetes_cluster.test.kubelet_identity[0].object_id
+}
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  blob_storage {
+    container_id = azurerm_storage_container.test.id
+
+    managed_identity {
+      client_id = azurerm_kubernetes_cluster.test.kubelet_identity[0].client_id
+    }
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test,
+    azurerm_role_assignment.test,
+  ]
+}
+`, r.blobStorageTemplate(data), data.RandomInteger)
+}
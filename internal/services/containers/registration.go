@@ -50,6 +50,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ContainerGroupStandbyPoolResource{},
 		ContainerRegistryTaskResource{},
+		KubernetesClusterExtensionResource{},
+		KubernetesClusterTrustedAccessRoleBindingResource{},
+		KubernetesFluxConfigurationResource{},
 	}
 }
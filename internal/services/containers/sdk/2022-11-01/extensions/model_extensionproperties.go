@@ -0,0 +1,16 @@
+package extensions
+
+type ExtensionProperties struct {
+	AutoUpgradeMinorVersion        *bool              `json:"autoUpgradeMinorVersion,omitempty"`
+	ConfigurationProtectedSettings *map[string]string `json:"configurationProtectedSettings,omitempty"`
+	ConfigurationSettings          *map[string]string `json:"configurationSettings,omitempty"`
+	CurrentVersion                 *string            `json:"currentVersion,omitempty"`
+	CustomLocationSettings         *map[string]string `json:"customLocationSettings,omitempty"`
+	ExtensionType                  *string            `json:"extensionType,omitempty"`
+	IsSystemExtension              *bool              `json:"isSystemExtension,omitempty"`
+	PackageUri                     *string            `json:"packageUri,omitempty"`
+	ProvisioningState              *ProvisioningState `json:"provisioningState,omitempty"`
+	ReleaseTrain                   *string            `json:"releaseTrain,omitempty"`
+	Scope                          *Scope             `json:"scope,omitempty"`
+	Version                        *string            `json:"version,omitempty"`
+}
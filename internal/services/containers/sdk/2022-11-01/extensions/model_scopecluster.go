@@ -0,0 +1,5 @@
+package extensions
+
+type ScopeCluster struct {
+	ReleaseNamespace *string `json:"releaseNamespace,omitempty"`
+}
@@ -0,0 +1,13 @@
+package fluxconfiguration
+
+type AzureBlobDefinition struct {
+	AccountKey            *string                     `json:"accountKey,omitempty"`
+	ContainerName         *string                     `json:"containerName,omitempty"`
+	LocalAuthRef          *string                     `json:"localAuthRef,omitempty"`
+	ManagedIdentity       *ManagedIdentityDefinition  `json:"managedIdentity,omitempty"`
+	SasToken              *string                     `json:"sasToken,omitempty"`
+	ServicePrincipal      *ServicePrincipalDefinition `json:"servicePrincipal,omitempty"`
+	SyncIntervalInSeconds *int64                      `json:"syncIntervalInSeconds,omitempty"`
+	TimeoutInSeconds      *int64                      `json:"timeoutInSeconds,omitempty"`
+	Url                   *string                     `json:"url,omitempty"`
+}
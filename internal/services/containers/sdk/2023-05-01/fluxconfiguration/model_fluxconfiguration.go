@@ -0,0 +1,8 @@
+package fluxconfiguration
+
+type FluxConfiguration struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *FluxConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package fluxconfiguration
+
+type ManagedIdentityDefinition struct {
+	ClientId *string `json:"clientId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package fluxconfiguration
+
+type PostBuildDefinition struct {
+	Substitute     *map[string]string          `json:"substitute,omitempty"`
+	SubstituteFrom *[]SubstituteFromDefinition `json:"substituteFrom,omitempty"`
+}
@@ -0,0 +1,8 @@
+package fluxconfiguration
+
+type RepositoryRefDefinition struct {
+	Branch *string `json:"branch,omitempty"`
+	Commit *string `json:"commit,omitempty"`
+	Semver *string `json:"semver,omitempty"`
+	Tag    *string `json:"tag,omitempty"`
+}
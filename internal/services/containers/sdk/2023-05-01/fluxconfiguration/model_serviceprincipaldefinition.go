@@ -0,0 +1,10 @@
+package fluxconfiguration
+
+type ServicePrincipalDefinition struct {
+	ClientCertificate          *string `json:"clientCertificate,omitempty"`
+	ClientCertificatePassword  *string `json:"clientCertificatePassword,omitempty"`
+	ClientCertificateSendChain *bool   `json:"clientCertificateSendChain,omitempty"`
+	ClientId                   *string `json:"clientId,omitempty"`
+	ClientSecret               *string `json:"clientSecret,omitempty"`
+	TenantId                   *string `json:"tenantId,omitempty"`
+}
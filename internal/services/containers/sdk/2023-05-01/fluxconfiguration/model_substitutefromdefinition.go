@@ -0,0 +1,7 @@
+package fluxconfiguration
+
+type SubstituteFromDefinition struct {
+	Kind     *string `json:"kind,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Optional *bool   `json:"optional,omitempty"`
+}
@@ -0,0 +1,9 @@
+package fluxconfiguration
+
+import "fmt"
+
+const defaultApiVersion = "2023-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/fluxconfiguration/%s", defaultApiVersion)
+}
@@ -0,0 +1,6 @@
+package standbycontainergrouppools
+
+type ContainerGroupProperties struct {
+	ContainerGroupProfile ContainerGroupProfile `json:"containerGroupProfile"`
+	SubnetIds             *[]Subnet             `json:"subnetIds,omitempty"`
+}
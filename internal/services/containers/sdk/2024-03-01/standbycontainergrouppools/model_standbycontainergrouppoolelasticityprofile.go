@@ -0,0 +1,6 @@
+package standbycontainergrouppools
+
+type StandbyContainerGroupPoolElasticityProfile struct {
+	MaxReadyCapacity int64         `json:"maxReadyCapacity"`
+	RefillPolicy     *RefillPolicy `json:"refillPolicy,omitempty"`
+}
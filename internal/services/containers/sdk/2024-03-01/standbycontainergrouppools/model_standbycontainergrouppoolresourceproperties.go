@@ -0,0 +1,7 @@
+package standbycontainergrouppools
+
+type StandbyContainerGroupPoolResourceProperties struct {
+	ContainerGroupProperties ContainerGroupProperties                   `json:"containerGroupProperties"`
+	ElasticityProfile        StandbyContainerGroupPoolElasticityProfile `json:"elasticityProfile"`
+	ProvisioningState        *ProvisioningState                         `json:"provisioningState,omitempty"`
+}
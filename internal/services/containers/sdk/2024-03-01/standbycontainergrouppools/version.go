@@ -0,0 +1,9 @@
+package standbycontainergrouppools
+
+import "fmt"
+
+const defaultApiVersion = "2024-03-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/standbycontainergrouppools/%s", defaultApiVersion)
+}
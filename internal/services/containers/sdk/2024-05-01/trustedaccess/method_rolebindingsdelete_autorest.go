@@ -0,0 +1,73 @@
+package trustedaccess
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type RoleBindingsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// RoleBindingsDelete ...
+func (c TrustedAccessClient) RoleBindingsDelete(ctx context.Context, id TrustedAccessRoleBindingId) (result RoleBindingsDeleteResponse, err error) {
+	req, err := c.preparerForRoleBindingsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trustedaccess.TrustedAccessClient", "RoleBindingsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForRoleBindingsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "trustedaccess.TrustedAccessClient", "RoleBindingsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// RoleBindingsDeleteThenPoll performs RoleBindingsDelete then polls until it's completed
+func (c TrustedAccessClient) RoleBindingsDeleteThenPoll(ctx context.Context, id TrustedAccessRoleBindingId) error {
+	result, err := c.RoleBindingsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing RoleBindingsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after RoleBindingsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForRoleBindingsDelete prepares the RoleBindingsDelete request.
+func (c TrustedAccessClient) preparerForRoleBindingsDelete(ctx context.Context, id TrustedAccessRoleBindingId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForRoleBindingsDelete sends the RoleBindingsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c TrustedAccessClient) senderForRoleBindingsDelete(ctx context.Context, req *http.Request) (future RoleBindingsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,8 @@
+package trustedaccess
+
+type TrustedAccessRoleBinding struct {
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties TrustedAccessRoleBindingProperties `json:"properties"`
+	Type       *string                            `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package trustedaccess
+
+type TrustedAccessRoleBindingProperties struct {
+	ProvisioningState *string  `json:"provisioningState,omitempty"`
+	Roles             []string `json:"roles,omitempty"`
+	SourceResourceId  string   `json:"sourceResourceId"`
+}
@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerstorage/sdk/2023-07-01-preview/pools"
+)
+
+type Client struct {
+	PoolsClient *pools.PoolsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	poolsClient := pools.NewPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&poolsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		PoolsClient: &poolsClient,
+	}
+}
@@ -0,0 +1,570 @@
+package containerstorage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	containerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/parse"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerstorage/sdk/2023-07-01-preview/pools"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerStoragePoolResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContainerStoragePoolResource{}
+
+type ContainerStoragePoolModel struct {
+	Name                 string                              `tfschema:"name"`
+	ResourceGroupName    string                              `tfschema:"resource_group_name"`
+	Location             string                              `tfschema:"location"`
+	KubernetesClusterIds []string                            `tfschema:"kubernetes_cluster_ids"`
+	AzureDisk            []ContainerStoragePoolDiskModel     `tfschema:"azure_disk"`
+	ElasticSan           []ContainerStoragePoolDiskModel     `tfschema:"elastic_san"`
+	EphemeralDisk        []ContainerStoragePoolEphemeralDisk `tfschema:"ephemeral_disk"`
+	StorageInGiB         int                                 `tfschema:"storage_in_gib"`
+	ReclaimPolicy        string                              `tfschema:"reclaim_policy"`
+	Zones                []string                            `tfschema:"zones"`
+	Tags                 map[string]string                   `tfschema:"tags"`
+	Status               string                              `tfschema:"status"`
+}
+
+type ContainerStoragePoolDiskModel struct {
+	SkuName    string                                `tfschema:"sku_name"`
+	Encryption []ContainerStoragePoolEncryptionModel `tfschema:"encryption"`
+}
+
+type ContainerStoragePoolEncryptionModel struct {
+	KeyVaultKeyId          string `tfschema:"key_vault_key_id"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+}
+
+type ContainerStoragePoolEphemeralDisk struct {
+	Replicas int `tfschema:"replicas"`
+}
+
+var containerStoragePoolTypes = []string{"azure_disk", "elastic_san", "ephemeral_disk"}
+
+func containerStoragePoolEncryptionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"key_vault_key_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: keyVaultValidate.VersionlessNestedItemId,
+				},
+
+				"user_assigned_identity_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerStoragePoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`),
+				"`name` must be between 3 and 63 characters, start and end with a lowercase letter or number and may only contain lowercase letters, numbers and hyphens",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"kubernetes_cluster_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: containerValidate.ClusterID,
+			},
+		},
+
+		"azure_disk": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: containerStoragePoolTypes,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"sku_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(pools.AzureDiskSkuNamePremiumLRS),
+						ValidateFunc: validation.StringInSlice(pools.PossibleValuesForAzureDiskSkuName(), false),
+					},
+
+					"encryption": containerStoragePoolEncryptionSchema(),
+				},
+			},
+		},
+
+		"elastic_san": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: containerStoragePoolTypes,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"sku_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(pools.ElasticSanSkuNamePremiumLRS),
+						ValidateFunc: validation.StringInSlice(pools.PossibleValuesForElasticSanSkuName(), false),
+					},
+
+					"encryption": containerStoragePoolEncryptionSchema(),
+				},
+			},
+		},
+
+		"ephemeral_disk": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: containerStoragePoolTypes,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"replicas": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      3,
+						ValidateFunc: validation.IntBetween(1, 3),
+					},
+				},
+			},
+		},
+
+		"storage_in_gib": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"reclaim_policy": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(pools.ReclaimPolicyDelete),
+			ValidateFunc: validation.StringInSlice(pools.PossibleValuesForReclaimPolicy(), false),
+		},
+
+		"zones": azure.SchemaZones(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContainerStoragePoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContainerStoragePoolResource) ModelObject() interface{} {
+	return &ContainerStoragePoolModel{}
+}
+
+func (r ContainerStoragePoolResource) ResourceType() string {
+	return "azurerm_container_storage_pool"
+}
+
+func (r ContainerStoragePoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return pools.ValidatePoolID
+}
+
+func (r ContainerStoragePoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerStorage.PoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContainerStoragePoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := pools.NewPoolID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			poolType, err := expandContainerStoragePoolType(model)
+			if err != nil {
+				return err
+			}
+
+			reclaimPolicy := pools.ReclaimPolicy(model.ReclaimPolicy)
+			props := pools.PoolProperties{
+				Assignments:   expandContainerStoragePoolAssignments(model.KubernetesClusterIds),
+				PoolType:      *poolType,
+				ReclaimPolicy: &reclaimPolicy,
+				Resources:     expandContainerStoragePoolResources(model.StorageInGiB),
+			}
+			if len(model.Zones) > 0 {
+				props.Zones = &model.Zones
+			}
+
+			payload := pools.Pool{
+				Location:   location.Normalize(model.Location),
+				Properties: &props,
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerStoragePoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerStorage.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerStoragePoolModel{
+				Name:              id.PoolName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					clusterIds, err := flattenContainerStoragePoolAssignments(props.Assignments)
+					if err != nil {
+						return err
+					}
+					state.KubernetesClusterIds = clusterIds
+
+					if err := flattenContainerStoragePoolType(props.PoolType, &state); err != nil {
+						return err
+					}
+
+					if props.ReclaimPolicy != nil {
+						state.ReclaimPolicy = string(*props.ReclaimPolicy)
+					}
+					if props.Resources != nil && props.Resources.Requests != nil && props.Resources.Requests.Storage != nil {
+						state.StorageInGiB = int(*props.Resources.Requests.Storage)
+					}
+					if props.Status != nil && props.Status.State != nil {
+						state.Status = string(*props.Status.State)
+					}
+					if props.Zones != nil {
+						state.Zones = *props.Zones
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerStoragePoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerStorage.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContainerStoragePoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := pools.PoolUpdate{
+				Properties: &pools.PoolUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("kubernetes_cluster_ids") {
+				payload.Properties.Assignments = expandContainerStoragePoolAssignments(model.KubernetesClusterIds)
+			}
+
+			if metadata.ResourceData.HasChange("storage_in_gib") {
+				payload.Properties.Resources = expandContainerStoragePoolResources(model.StorageInGiB)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerStoragePoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerStorage.PoolsClient
+
+			id, err := pools.ParsePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerStoragePoolAssignments(input []string) *[]pools.Assignment {
+	results := make([]pools.Assignment, 0)
+	for _, v := range input {
+		results = append(results, pools.Assignment{
+			Id: v,
+		})
+	}
+	return &results
+}
+
+func flattenContainerStoragePoolAssignments(input *[]pools.Assignment) ([]string, error) {
+	results := make([]string, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, v := range *input {
+		clusterId, err := containerParse.ClusterID(v.Id)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, clusterId.ID())
+	}
+	return results, nil
+}
+
+func expandContainerStoragePoolResources(input int) *pools.Resources {
+	if input == 0 {
+		return nil
+	}
+
+	return &pools.Resources{
+		Requests: &pools.Requests{
+			Storage: utils.Int64(int64(input)),
+		},
+	}
+}
+
+func expandContainerStoragePoolType(input ContainerStoragePoolModel) (*pools.PoolType, error) {
+	if len(input.AzureDisk) > 0 {
+		encryption, err := expandContainerStoragePoolEncryption(input.AzureDisk[0].Encryption)
+		if err != nil {
+			return nil, err
+		}
+
+		skuName := pools.AzureDiskSkuName(input.AzureDisk[0].SkuName)
+		return &pools.PoolType{
+			AzureDisk: &pools.AzureDisk{
+				Encryption: encryption,
+				SkuName:    &skuName,
+			},
+		}, nil
+	}
+
+	if len(input.ElasticSan) > 0 {
+		encryption, err := expandContainerStoragePoolEncryption(input.ElasticSan[0].Encryption)
+		if err != nil {
+			return nil, err
+		}
+
+		skuName := pools.ElasticSanSkuName(input.ElasticSan[0].SkuName)
+		return &pools.PoolType{
+			ElasticSan: &pools.ElasticSan{
+				Encryption: encryption,
+				SkuName:    &skuName,
+			},
+		}, nil
+	}
+
+	if len(input.EphemeralDisk) > 0 {
+		return &pools.PoolType{
+			EphemeralDisk: &pools.EphemeralDisk{
+				Replicas: utils.Int64(int64(input.EphemeralDisk[0].Replicas)),
+			},
+		}, nil
+	}
+
+	return nil, fmt.Errorf("one of `azure_disk`, `elastic_san` or `ephemeral_disk` must be specified")
+}
+
+func flattenContainerStoragePoolType(input pools.PoolType, state *ContainerStoragePoolModel) error {
+	if disk := input.AzureDisk; disk != nil {
+		encryption, err := flattenContainerStoragePoolEncryption(disk.Encryption)
+		if err != nil {
+			return err
+		}
+
+		skuName := ""
+		if disk.SkuName != nil {
+			skuName = string(*disk.SkuName)
+		}
+		state.AzureDisk = []ContainerStoragePoolDiskModel{
+			{
+				SkuName:    skuName,
+				Encryption: encryption,
+			},
+		}
+	}
+
+	if san := input.ElasticSan; san != nil {
+		encryption, err := flattenContainerStoragePoolEncryption(san.Encryption)
+		if err != nil {
+			return err
+		}
+
+		skuName := ""
+		if san.SkuName != nil {
+			skuName = string(*san.SkuName)
+		}
+		state.ElasticSan = []ContainerStoragePoolDiskModel{
+			{
+				SkuName:    skuName,
+				Encryption: encryption,
+			},
+		}
+	}
+
+	if ephemeral := input.EphemeralDisk; ephemeral != nil {
+		replicas := 0
+		if ephemeral.Replicas != nil {
+			replicas = int(*ephemeral.Replicas)
+		}
+		state.EphemeralDisk = []ContainerStoragePoolEphemeralDisk{
+			{
+				Replicas: replicas,
+			},
+		}
+	}
+
+	return nil
+}
+
+func expandContainerStoragePoolEncryption(input []ContainerStoragePoolEncryptionModel) (*pools.Encryption, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(input[0].KeyVaultKeyId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+	}
+
+	return &pools.Encryption{
+		Identity: &identity.UserAssignedMap{
+			Type: identity.TypeUserAssigned,
+			IdentityIds: map[string]identity.UserAssignedIdentityDetails{
+				input[0].UserAssignedIdentityId: {},
+			},
+		},
+		KeyName:     keyId.Name,
+		KeyVaultUri: keyId.KeyVaultBaseUrl,
+	}, nil
+}
+
+func flattenContainerStoragePoolEncryption(input *pools.Encryption) ([]ContainerStoragePoolEncryptionModel, error) {
+	if input == nil {
+		return []ContainerStoragePoolEncryptionModel{}, nil
+	}
+
+	keyId, err := keyVaultParse.NewNestedItemID(input.KeyVaultUri, "keys", input.KeyName, "")
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Key Vault Key ID: %+v", err)
+	}
+
+	result := ContainerStoragePoolEncryptionModel{
+		KeyVaultKeyId: keyId.ID(),
+	}
+
+	if input.Identity != nil {
+		for raw := range input.Identity.IdentityIds {
+			identityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+			if err != nil {
+				return nil, err
+			}
+			result.UserAssignedIdentityId = identityId.ID()
+		}
+	}
+
+	return []ContainerStoragePoolEncryptionModel{result}, nil
+}
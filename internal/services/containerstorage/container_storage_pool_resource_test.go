@@ -0,0 +1,244 @@
+package containerstorage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerstorage/sdk/2023-07-01-preview/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContainerStoragePoolResource struct{}
+
+func TestAccContainerStoragePool_azureDisk(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_storage_pool", "test")
+	r := ContainerStoragePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureDisk(data, 1024),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerStoragePool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_storage_pool", "test")
+	r := ContainerStoragePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureDisk(data, 1024),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerStoragePool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_storage_pool", "test")
+	r := ContainerStoragePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureDisk(data, 1024),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.azureDisk(data, 2048),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage_in_gib").HasValue("2048"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerStoragePool_elasticSan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_storage_pool", "test")
+	r := ContainerStoragePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.elasticSan(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerStoragePool_ephemeralDisk(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_storage_pool", "test")
+	r := ContainerStoragePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ephemeralDisk(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerStoragePoolResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := pools.ParsePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ContainerStorage.PoolsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ContainerStoragePoolResource) template(data acceptance.TestData, vmSize string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acstor-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%[1]d"
+
+  default_node_pool {
+    name       = "default"
+    node_count = 3
+    vm_size    = "%[3]s"
+    node_labels = {
+      "acstor.azure.com/io-engine" = "acstor"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_kubernetes_cluster_extension" "test" {
+  name              = "acstor"
+  cluster_id        = azurerm_kubernetes_cluster.test.id
+  extension_type    = "microsoft.azurecontainerstorage"
+  release_namespace = "acstor"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_kubernetes_cluster.test.kubelet_identity.0.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, vmSize)
+}
+
+func (r ContainerStoragePoolResource) azureDisk(data acceptance.TestData, storage int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_storage_pool" "test" {
+  name                   = "acctest-pool-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  kubernetes_cluster_ids = [azurerm_kubernetes_cluster.test.id]
+  storage_in_gib         = %d
+
+  azure_disk {
+    sku_name = "Premium_LRS"
+  }
+
+  depends_on = [azurerm_kubernetes_cluster_extension.test, azurerm_role_assignment.test]
+}
+`, r.template(data, "Standard_D4s_v5"), data.RandomInteger, storage)
+}
+
+func (r ContainerStoragePoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_storage_pool" "import" {
+  name                   = azurerm_container_storage_pool.test.name
+  resource_group_name    = azurerm_container_storage_pool.test.resource_group_name
+  location               = azurerm_container_storage_pool.test.location
+  kubernetes_cluster_ids = azurerm_container_storage_pool.test.kubernetes_cluster_ids
+  storage_in_gib         = azurerm_container_storage_pool.test.storage_in_gib
+
+  azure_disk {
+    sku_name = "Premium_LRS"
+  }
+}
+`, r.azureDisk(data, 1024))
+}
+
+func (r ContainerStoragePoolResource) elasticSan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_storage_pool" "test" {
+  name                   = "acctest-pool-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  kubernetes_cluster_ids = [azurerm_kubernetes_cluster.test.id]
+  storage_in_gib         = 1024
+
+  elastic_san {
+    sku_name = "Premium_LRS"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_kubernetes_cluster_extension.test, azurerm_role_assignment.test]
+}
+`, r.template(data, "Standard_D4s_v5"), data.RandomInteger)
+}
+
+func (r ContainerStoragePoolResource) ephemeralDisk(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_storage_pool" "test" {
+  name                   = "acctest-pool-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  kubernetes_cluster_ids = [azurerm_kubernetes_cluster.test.id]
+
+  ephemeral_disk {
+    replicas = 3
+  }
+
+  depends_on = [azurerm_kubernetes_cluster_extension.test, azurerm_role_assignment.test]
+}
+`, r.template(data, "Standard_L8s_v3"), data.RandomInteger)
+}
@@ -0,0 +1,15 @@
+package pools
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PoolsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPoolsClientWithBaseURI(endpoint string) PoolsClient {
+	return PoolsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
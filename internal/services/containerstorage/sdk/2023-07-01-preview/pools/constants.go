@@ -0,0 +1,222 @@
+package pools
+
+import "strings"
+
+type AssignmentStatusState string
+
+const (
+	AssignmentStatusStateAssigned    AssignmentStatusState = "Assigned"
+	AssignmentStatusStateAssigning   AssignmentStatusState = "Assigning"
+	AssignmentStatusStateFailed      AssignmentStatusState = "Failed"
+	AssignmentStatusStateUnassigning AssignmentStatusState = "Unassigning"
+)
+
+func PossibleValuesForAssignmentStatusState() []string {
+	return []string{
+		string(AssignmentStatusStateAssigned),
+		string(AssignmentStatusStateAssigning),
+		string(AssignmentStatusStateFailed),
+		string(AssignmentStatusStateUnassigning),
+	}
+}
+
+func parseAssignmentStatusState(input string) (*AssignmentStatusState, error) {
+	vals := map[string]AssignmentStatusState{
+		"assigned":    AssignmentStatusStateAssigned,
+		"assigning":   AssignmentStatusStateAssigning,
+		"failed":      AssignmentStatusStateFailed,
+		"unassigning": AssignmentStatusStateUnassigning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AssignmentStatusState(input)
+	return &out, nil
+}
+
+type AzureDiskSkuName string
+
+const (
+	AzureDiskSkuNamePremiumV2LRS   AzureDiskSkuName = "PremiumV2_LRS"
+	AzureDiskSkuNamePremiumLRS     AzureDiskSkuName = "Premium_LRS"
+	AzureDiskSkuNamePremiumZRS     AzureDiskSkuName = "Premium_ZRS"
+	AzureDiskSkuNameStandardSSDLRS AzureDiskSkuName = "StandardSSD_LRS"
+	AzureDiskSkuNameStandardSSDZRS AzureDiskSkuName = "StandardSSD_ZRS"
+	AzureDiskSkuNameStandardLRS    AzureDiskSkuName = "Standard_LRS"
+	AzureDiskSkuNameUltraSSDLRS    AzureDiskSkuName = "UltraSSD_LRS"
+)
+
+func PossibleValuesForAzureDiskSkuName() []string {
+	return []string{
+		string(AzureDiskSkuNamePremiumV2LRS),
+		string(AzureDiskSkuNamePremiumLRS),
+		string(AzureDiskSkuNamePremiumZRS),
+		string(AzureDiskSkuNameStandardSSDLRS),
+		string(AzureDiskSkuNameStandardSSDZRS),
+		string(AzureDiskSkuNameStandardLRS),
+		string(AzureDiskSkuNameUltraSSDLRS),
+	}
+}
+
+func parseAzureDiskSkuName(input string) (*AzureDiskSkuName, error) {
+	vals := map[string]AzureDiskSkuName{
+		"premiumv2_lrs":   AzureDiskSkuNamePremiumV2LRS,
+		"premium_lrs":     AzureDiskSkuNamePremiumLRS,
+		"premium_zrs":     AzureDiskSkuNamePremiumZRS,
+		"standardssd_lrs": AzureDiskSkuNameStandardSSDLRS,
+		"standardssd_zrs": AzureDiskSkuNameStandardSSDZRS,
+		"standard_lrs":    AzureDiskSkuNameStandardLRS,
+		"ultrassd_lrs":    AzureDiskSkuNameUltraSSDLRS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AzureDiskSkuName(input)
+	return &out, nil
+}
+
+type ElasticSanSkuName string
+
+const (
+	ElasticSanSkuNamePremiumLRS ElasticSanSkuName = "Premium_LRS"
+	ElasticSanSkuNamePremiumZRS ElasticSanSkuName = "Premium_ZRS"
+)
+
+func PossibleValuesForElasticSanSkuName() []string {
+	return []string{
+		string(ElasticSanSkuNamePremiumLRS),
+		string(ElasticSanSkuNamePremiumZRS),
+	}
+}
+
+func parseElasticSanSkuName(input string) (*ElasticSanSkuName, error) {
+	vals := map[string]ElasticSanSkuName{
+		"premium_lrs": ElasticSanSkuNamePremiumLRS,
+		"premium_zrs": ElasticSanSkuNamePremiumZRS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ElasticSanSkuName(input)
+	return &out, nil
+}
+
+type OperationalState string
+
+const (
+	OperationalStateAvailable    OperationalState = "Available"
+	OperationalStateDeleting     OperationalState = "Deleting"
+	OperationalStatePending      OperationalState = "Pending"
+	OperationalStateProvisioning OperationalState = "Provisioning"
+	OperationalStateUnavailable  OperationalState = "Unavailable"
+	OperationalStateUnknown      OperationalState = "Unknown"
+	OperationalStateUpdating     OperationalState = "Updating"
+)
+
+func PossibleValuesForOperationalState() []string {
+	return []string{
+		string(OperationalStateAvailable),
+		string(OperationalStateDeleting),
+		string(OperationalStatePending),
+		string(OperationalStateProvisioning),
+		string(OperationalStateUnavailable),
+		string(OperationalStateUnknown),
+		string(OperationalStateUpdating),
+	}
+}
+
+func parseOperationalState(input string) (*OperationalState, error) {
+	vals := map[string]OperationalState{
+		"available":    OperationalStateAvailable,
+		"deleting":     OperationalStateDeleting,
+		"pending":      OperationalStatePending,
+		"provisioning": OperationalStateProvisioning,
+		"unavailable":  OperationalStateUnavailable,
+		"unknown":      OperationalStateUnknown,
+		"updating":     OperationalStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperationalState(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted     ProvisioningState = "Accepted"
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":     ProvisioningStateAccepted,
+		"canceled":     ProvisioningStateCanceled,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+		"updating":     ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type ReclaimPolicy string
+
+const (
+	ReclaimPolicyDelete ReclaimPolicy = "Delete"
+	ReclaimPolicyRetain ReclaimPolicy = "Retain"
+)
+
+func PossibleValuesForReclaimPolicy() []string {
+	return []string{
+		string(ReclaimPolicyDelete),
+		string(ReclaimPolicyRetain),
+	}
+}
+
+func parseReclaimPolicy(input string) (*ReclaimPolicy, error) {
+	vals := map[string]ReclaimPolicy{
+		"delete": ReclaimPolicyDelete,
+		"retain": ReclaimPolicyRetain,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReclaimPolicy(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package pools
+
+type Assignment struct {
+	Id     string            `json:"id"`
+	Status *AssignmentStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,6 @@
+package pools
+
+type AssignmentStatus struct {
+	Message *string               `json:"message,omitempty"`
+	State   AssignmentStatusState `json:"state"`
+}
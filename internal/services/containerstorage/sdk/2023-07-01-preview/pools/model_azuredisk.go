@@ -0,0 +1,6 @@
+package pools
+
+type AzureDisk struct {
+	Encryption *Encryption       `json:"encryption,omitempty"`
+	SkuName    *AzureDiskSkuName `json:"skuName,omitempty"`
+}
@@ -0,0 +1,6 @@
+package pools
+
+type ElasticSan struct {
+	Encryption *Encryption        `json:"encryption,omitempty"`
+	SkuName    *ElasticSanSkuName `json:"skuName,omitempty"`
+}
@@ -0,0 +1,11 @@
+package pools
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type Encryption struct {
+	Identity    *identity.UserAssignedMap `json:"identity,omitempty"`
+	KeyName     string                    `json:"keyName"`
+	KeyVaultUri string                    `json:"keyVaultUri"`
+}
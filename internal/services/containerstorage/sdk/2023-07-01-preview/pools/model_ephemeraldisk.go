@@ -0,0 +1,5 @@
+package pools
+
+type EphemeralDisk struct {
+	Replicas *int64 `json:"replicas,omitempty"`
+}
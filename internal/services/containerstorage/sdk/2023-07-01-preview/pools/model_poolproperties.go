@@ -0,0 +1,11 @@
+package pools
+
+type PoolProperties struct {
+	Assignments       *[]Assignment              `json:"assignments,omitempty"`
+	PoolType          PoolType                   `json:"poolType"`
+	ProvisioningState *ProvisioningState         `json:"provisioningState,omitempty"`
+	ReclaimPolicy     *ReclaimPolicy             `json:"reclaimPolicy,omitempty"`
+	Resources         *Resources                 `json:"resources,omitempty"`
+	Status            *ResourceOperationalStatus `json:"status,omitempty"`
+	Zones             *[]string                  `json:"zones,omitempty"`
+}
@@ -0,0 +1,7 @@
+package pools
+
+type PoolType struct {
+	AzureDisk     *AzureDisk     `json:"azureDisk,omitempty"`
+	ElasticSan    *ElasticSan    `json:"elasticSan,omitempty"`
+	EphemeralDisk *EphemeralDisk `json:"ephemeralDisk,omitempty"`
+}
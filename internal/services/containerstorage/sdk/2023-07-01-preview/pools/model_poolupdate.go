@@ -0,0 +1,6 @@
+package pools
+
+type PoolUpdate struct {
+	Properties *PoolUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string    `json:"tags,omitempty"`
+}
@@ -0,0 +1,7 @@
+package pools
+
+type PoolUpdateProperties struct {
+	Assignments *[]Assignment `json:"assignments,omitempty"`
+	PoolType    *PoolType     `json:"poolType,omitempty"`
+	Resources   *Resources    `json:"resources,omitempty"`
+}
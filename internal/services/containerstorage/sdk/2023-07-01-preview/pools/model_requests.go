@@ -0,0 +1,5 @@
+package pools
+
+type Requests struct {
+	Storage *int64 `json:"storage,omitempty"`
+}
@@ -0,0 +1,6 @@
+package pools
+
+type ResourceOperationalStatus struct {
+	Message *string           `json:"message,omitempty"`
+	State   *OperationalState `json:"state,omitempty"`
+}
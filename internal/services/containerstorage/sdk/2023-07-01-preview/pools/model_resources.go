@@ -0,0 +1,5 @@
+package pools
+
+type Resources struct {
+	Requests *Requests `json:"requests,omitempty"`
+}
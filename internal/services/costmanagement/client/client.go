@@ -3,17 +3,23 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2020-06-01/costmanagement"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/sdk/2023-07-01-preview/exports"
 )
 
 type Client struct {
-	ExportClient *costmanagement.ExportsClient
+	ExportClient  *costmanagement.ExportsClient
+	ExportsClient *exports.ExportsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	ExportClient := costmanagement.NewExportsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ExportClient.Client, o.ResourceManagerAuthorizer)
 
+	exportsClient := exports.NewExportsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&exportsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ExportClient: &ExportClient,
+		ExportClient:  &ExportClient,
+		ExportsClient: &exportsClient,
 	}
 }
@@ -329,3 +329,35 @@ func flattenExportDeliveryInfo(input *costmanagement.ExportDeliveryInfo) []inter
 		},
 	}
 }
+
+func expandExportDefinition(input []interface{}) *costmanagement.ExportDefinition {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	attrs := input[0].(map[string]interface{})
+	definitionInfo := &costmanagement.ExportDefinition{
+		Type:      costmanagement.ExportType(attrs["type"].(string)),
+		Timeframe: costmanagement.TimeframeType(attrs["time_frame"].(string)),
+	}
+
+	return definitionInfo
+}
+
+func flattenExportDefinition(input *costmanagement.ExportDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	queryType := ""
+	if v := input.Type; v != "" {
+		queryType = string(input.Type)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"time_frame": string(input.Timeframe),
+			"type":       queryType,
+		},
+	}
+}
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/costmanagement/mgmt/2020-06-01/costmanagement"
-	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/sdk/2023-07-01-preview/exports"
 	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -31,10 +34,10 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 			Type:     pluginsdk.TypeString,
 			Required: true,
 			ValidateFunc: validation.StringInSlice([]string{
-				string(costmanagement.RecurrenceTypeDaily),
-				string(costmanagement.RecurrenceTypeWeekly),
-				string(costmanagement.RecurrenceTypeMonthly),
-				string(costmanagement.RecurrenceTypeAnnually),
+				string(exports.RecurrenceTypeDaily),
+				string(exports.RecurrenceTypeWeekly),
+				string(exports.RecurrenceTypeMonthly),
+				string(exports.RecurrenceTypeAnnually),
 			}, false),
 		},
 
@@ -82,9 +85,10 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 						Type:     pluginsdk.TypeString,
 						Required: true,
 						ValidateFunc: validation.StringInSlice([]string{
-							string(costmanagement.ExportTypeActualCost),
-							string(costmanagement.ExportTypeAmortizedCost),
-							string(costmanagement.ExportTypeUsage),
+							string(exports.ExportTypeActualCost),
+							string(exports.ExportTypeAmortizedCost),
+							string(exports.ExportTypeFocusCost),
+							string(exports.ExportTypeUsage),
 						}, false),
 					},
 
@@ -92,17 +96,72 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 						Type:     pluginsdk.TypeString,
 						Required: true,
 						ValidateFunc: validation.StringInSlice([]string{
-							string(costmanagement.Custom),
-							string(costmanagement.BillingMonthToDate),
-							string(costmanagement.TheLastBillingMonth),
-							string(costmanagement.TheLastMonth),
-							string(costmanagement.WeekToDate),
-							string(costmanagement.MonthToDate),
+							string(exports.TimeframeTypeCustom),
+							string(exports.TimeframeTypeBillingMonthToDate),
+							string(exports.TimeframeTypeTheLastBillingMonth),
+							string(exports.TimeframeTypeTheLastMonth),
+							string(exports.TimeframeTypeWeekToDate),
+							string(exports.TimeframeTypeMonthToDate),
 						}, false),
 					},
+
+					"data_version": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
 				},
 			},
 		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"file_format": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(exports.FormatTypeCsv),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(exports.FormatTypeCsv),
+				string(exports.FormatTypeParquet),
+			}, false),
+		},
+
+		"compression_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(exports.CompressionModeTypeNone),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(exports.CompressionModeTypeGzip),
+				string(exports.CompressionModeTypeNone),
+				string(exports.CompressionModeTypeSnappy),
+			}, false),
+		},
+
+		"file_partition_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"data_overwrite_behavior": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(exports.DataOverwriteBehaviorTypeCreateNewReport),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(exports.DataOverwriteBehaviorTypeCreateNewReport),
+				string(exports.DataOverwriteBehaviorTypeOverwritePreviousReport),
+			}, false),
+		},
+
+		"identity": commonschema.SystemAssignedIdentity(),
+
+		// the location is only used by the managed identity of the Export
+		"location": commonschema.LocationOptional(),
 	}
 
 	for k, v := range fields {
@@ -120,20 +179,22 @@ func (br costManagementExportBaseResource) createFunc(resourceName, scopeFieldNa
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.CostManagement.ExportClient
+			client := metadata.Client.CostManagement.ExportsClient
 			id := parse.NewCostManagementExportId(metadata.ResourceData.Get(scopeFieldName).(string), metadata.ResourceData.Get("name").(string))
-			existing, err := client.Get(ctx, id.Scope, id.Name, "")
+			exportId := exports.NewScopedExportID(id.Scope, id.Name)
+
+			existing, err := client.Get(ctx, exportId)
 			if err != nil {
-				if !utils.ResponseWasNotFound(existing.Response) {
+				if !response.WasNotFound(existing.HttpResponse) {
 					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 				}
 			}
 
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return tf.ImportAsExistsError(resourceName, id.ID())
 			}
 
-			if err := createOrUpdateCostManagementExport(ctx, client, metadata, id); err != nil {
+			if err := createOrUpdateCostManagementExport(ctx, client, metadata, exportId, nil); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -147,16 +208,16 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.CostManagement.ExportClient
+			client := metadata.Client.CostManagement.ExportsClient
 
 			id, err := parse.CostManagementExportID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			resp, err := client.Get(ctx, id.Scope, id.Name, "")
+			resp, err := client.Get(ctx, exports.NewScopedExportID(id.Scope, id.Name))
 			if err != nil {
-				if !utils.ResponseWasNotFound(resp.Response) {
+				if response.WasNotFound(resp.HttpResponse) {
 					return metadata.MarkAsGone(id)
 				}
 				return fmt.Errorf("reading %s: %+v", *id, err)
@@ -166,19 +227,39 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 			// lintignore:R001
 			metadata.ResourceData.Set(scopeFieldName, id.Scope)
 
-			if schedule := resp.Schedule; schedule != nil {
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("reading %s: `model` was nil", *id)
+			}
+
+			metadata.ResourceData.Set("location", location.NormalizeNilable(model.Location))
+
+			if err := metadata.ResourceData.Set("identity", identity.FlattenSystemAssigned(model.Identity)); err != nil {
+				return fmt.Errorf("setting `identity`: %+v", err)
+			}
+
+			props := model.Properties
+			if props == nil {
+				return fmt.Errorf("reading %s: `properties` was nil", *id)
+			}
+
+			if schedule := props.Schedule; schedule != nil {
 				if recurrencePeriod := schedule.RecurrencePeriod; recurrencePeriod != nil {
-					metadata.ResourceData.Set("recurrence_period_start_date", recurrencePeriod.From.Format(time.RFC3339))
-					metadata.ResourceData.Set("recurrence_period_end_date", recurrencePeriod.To.Format(time.RFC3339))
+					metadata.ResourceData.Set("recurrence_period_start_date", formatCostManagementExportTime(recurrencePeriod.From))
+					metadata.ResourceData.Set("recurrence_period_end_date", formatCostManagementExportTime(utils.NormalizeNilableString(recurrencePeriod.To)))
 				}
 
-				status := schedule.Status == costmanagement.Active
-
+				status := schedule.Status != nil && *schedule.Status == exports.StatusTypeActive
 				metadata.ResourceData.Set("active", status)
-				metadata.ResourceData.Set("recurrence_type", schedule.Recurrence)
+
+				recurrence := ""
+				if schedule.Recurrence != nil {
+					recurrence = string(*schedule.Recurrence)
+				}
+				metadata.ResourceData.Set("recurrence_type", recurrence)
 			}
 
-			exportDeliveryInfo, err := flattenExportDataStorageLocation(resp.DeliveryInfo)
+			exportDeliveryInfo, err := flattenExportDataStorageLocation(props.DeliveryInfo)
 			if err != nil {
 				return fmt.Errorf("flattening `export_data_storage_location`: %+v", err)
 			}
@@ -187,10 +268,32 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 				return fmt.Errorf("setting `export_data_storage_location`: %+v", err)
 			}
 
-			if err := metadata.ResourceData.Set("export_data_options", flattenExportDefinition(resp.Definition)); err != nil {
+			if err := metadata.ResourceData.Set("export_data_options", flattenCostManagementExportDefinition(props.Definition)); err != nil {
 				return fmt.Errorf("setting `export_data_options`: %+v", err)
 			}
 
+			metadata.ResourceData.Set("description", utils.NormalizeNilableString(props.ExportDescription))
+
+			format := string(exports.FormatTypeCsv)
+			if props.Format != nil {
+				format = string(*props.Format)
+			}
+			metadata.ResourceData.Set("file_format", format)
+
+			compressionMode := string(exports.CompressionModeTypeNone)
+			if props.CompressionMode != nil {
+				compressionMode = string(*props.CompressionMode)
+			}
+			metadata.ResourceData.Set("compression_mode", compressionMode)
+
+			metadata.ResourceData.Set("file_partition_enabled", props.PartitionData != nil && *props.PartitionData)
+
+			dataOverwriteBehavior := string(exports.DataOverwriteBehaviorTypeCreateNewReport)
+			if props.DataOverwriteBehavior != nil {
+				dataOverwriteBehavior = string(*props.DataOverwriteBehavior)
+			}
+			metadata.ResourceData.Set("data_overwrite_behavior", dataOverwriteBehavior)
+
 			return nil
 		},
 	}
@@ -200,14 +303,14 @@ func (br costManagementExportBaseResource) deleteFunc() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.CostManagement.ExportClient
+			client := metadata.Client.CostManagement.ExportsClient
 
 			id, err := parse.CostManagementExportID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			if _, err = client.Delete(ctx, id.Scope, id.Name); err != nil {
+			if _, err = client.Delete(ctx, exports.NewScopedExportID(id.Scope, id.Name)); err != nil {
 				return fmt.Errorf("deleting %s: %+v", *id, err)
 			}
 
@@ -220,14 +323,24 @@ func (br costManagementExportBaseResource) updateFunc() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.CostManagement.ExportClient
+			client := metadata.Client.CostManagement.ExportsClient
 
 			id, err := parse.CostManagementExportID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			if err := createOrUpdateCostManagementExport(ctx, client, metadata, *id); err != nil {
+			exportId := exports.NewScopedExportID(id.Scope, id.Name)
+			existing, err := client.Get(ctx, exportId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the eTag of the existing Export must be specified when updating it
+			if err := createOrUpdateCostManagementExport(ctx, client, metadata, exportId, existing.Model.ETag); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 
@@ -236,13 +349,13 @@ func (br costManagementExportBaseResource) updateFunc() sdk.ResourceFunc {
 	}
 }
 
-func createOrUpdateCostManagementExport(ctx context.Context, client *costmanagement.ExportsClient, metadata sdk.ResourceMetaData, id parse.CostManagementExportId) error {
+func createOrUpdateCostManagementExport(ctx context.Context, client *exports.ExportsClient, metadata sdk.ResourceMetaData, id exports.ScopedExportId, eTag *string) error {
 	from, _ := time.Parse(time.RFC3339, metadata.ResourceData.Get("recurrence_period_start_date").(string))
 	to, _ := time.Parse(time.RFC3339, metadata.ResourceData.Get("recurrence_period_end_date").(string))
 
-	status := costmanagement.Active
+	status := exports.StatusTypeActive
 	if v := metadata.ResourceData.Get("active"); !v.(bool) {
-		status = costmanagement.Inactive
+		status = exports.StatusTypeInactive
 	}
 
 	deliveryInfo, err := expandExportDataStorageLocation(metadata.ResourceData.Get("export_data_storage_location").([]interface{}))
@@ -250,30 +363,73 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *costmanagem
 		return fmt.Errorf("expanding `export_data_storage_location`: %+v", err)
 	}
 
-	props := costmanagement.Export{
-		ExportProperties: &costmanagement.ExportProperties{
-			Schedule: &costmanagement.ExportSchedule{
-				Recurrence: costmanagement.RecurrenceType(metadata.ResourceData.Get("recurrence_type").(string)),
-				RecurrencePeriod: &costmanagement.ExportRecurrencePeriod{
-					From: &date.Time{Time: from},
-					To:   &date.Time{Time: to},
+	format := exports.FormatType(metadata.ResourceData.Get("file_format").(string))
+	compressionMode := exports.CompressionModeType(metadata.ResourceData.Get("compression_mode").(string))
+	switch {
+	case compressionMode == exports.CompressionModeTypeGzip && format != exports.FormatTypeCsv:
+		return fmt.Errorf("`compression_mode` can only be set to `gzip` when `file_format` is `Csv`")
+	case compressionMode == exports.CompressionModeTypeSnappy && format != exports.FormatTypeParquet:
+		return fmt.Errorf("`compression_mode` can only be set to `snappy` when `file_format` is `Parquet`")
+	}
+
+	expandedIdentity, err := identity.ExpandSystemAssigned(metadata.ResourceData.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	recurrence := exports.RecurrenceType(metadata.ResourceData.Get("recurrence_type").(string))
+	dataOverwriteBehavior := exports.DataOverwriteBehaviorType(metadata.ResourceData.Get("data_overwrite_behavior").(string))
+	props := exports.Export{
+		ETag:     eTag,
+		Identity: expandedIdentity,
+		Properties: &exports.ExportProperties{
+			Schedule: &exports.ExportSchedule{
+				Recurrence: &recurrence,
+				RecurrencePeriod: &exports.ExportRecurrencePeriod{
+					From: from.Format(time.RFC3339),
+					To:   utils.String(to.Format(time.RFC3339)),
 				},
-				Status: status,
+				Status: &status,
 			},
-			DeliveryInfo: deliveryInfo,
-			Format:       costmanagement.Csv,
-			Definition:   expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			CompressionMode:       &compressionMode,
+			DataOverwriteBehavior: &dataOverwriteBehavior,
+			DeliveryInfo:          *deliveryInfo,
+			Format:                &format,
+			Definition:            expandCostManagementExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			PartitionData:         utils.Bool(metadata.ResourceData.Get("file_partition_enabled").(bool)),
 		},
 	}
 
-	_, err = client.CreateOrUpdate(ctx, id.Scope, id.Name, props)
+	if v := metadata.ResourceData.Get("description").(string); v != "" {
+		props.Properties.ExportDescription = utils.String(v)
+	}
+
+	// the managed identity of the Export is used to write to the storage account, and it's created in this location
+	if v := metadata.ResourceData.Get("location").(string); v != "" {
+		props.Location = utils.String(location.Normalize(v))
+	} else if expandedIdentity != nil && expandedIdentity.Type == identity.TypeSystemAssigned {
+		return fmt.Errorf("`location` must be specified when `identity` is specified")
+	}
+
+	_, err = client.CreateOrUpdate(ctx, id, props)
 
 	return err
 }
 
-func expandExportDataStorageLocation(input []interface{}) (*costmanagement.ExportDeliveryInfo, error) {
+// formatCostManagementExportTime returns the times in the format they're specified in, since the API returns them
+// without a timezone
+func formatCostManagementExportTime(input string) string {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05"} {
+		if v, err := time.Parse(layout, input); err == nil {
+			return v.Format(time.RFC3339)
+		}
+	}
+	return input
+}
+
+func expandExportDataStorageLocation(input []interface{}) (*exports.ExportDeliveryInfo, error) {
 	if len(input) == 0 || input[0] == nil {
-		return nil, nil
+		return &exports.ExportDeliveryInfo{}, nil
 	}
 	attrs := input[0].(map[string]interface{})
 
@@ -284,10 +440,10 @@ func expandExportDataStorageLocation(input []interface{}) (*costmanagement.Expor
 
 	storageId := storageParse.NewStorageAccountID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName)
 
-	deliveryInfo := &costmanagement.ExportDeliveryInfo{
-		Destination: &costmanagement.ExportDeliveryDestination{
-			ResourceID:     utils.String(storageId.ID()),
-			Container:      utils.String(containerId.ContainerName),
+	deliveryInfo := &exports.ExportDeliveryInfo{
+		Destination: exports.ExportDeliveryDestination{
+			ResourceId:     utils.String(storageId.ID()),
+			Container:      containerId.ContainerName,
 			RootFolderPath: utils.String(attrs["root_folder_path"].(string)),
 		},
 	}
@@ -295,30 +451,34 @@ func expandExportDataStorageLocation(input []interface{}) (*costmanagement.Expor
 	return deliveryInfo, nil
 }
 
-func expandExportDefinition(input []interface{}) *costmanagement.ExportDefinition {
+func expandCostManagementExportDefinition(input []interface{}) exports.ExportDefinition {
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return exports.ExportDefinition{}
 	}
 
 	attrs := input[0].(map[string]interface{})
-	definitionInfo := &costmanagement.ExportDefinition{
-		Type:      costmanagement.ExportType(attrs["type"].(string)),
-		Timeframe: costmanagement.TimeframeType(attrs["time_frame"].(string)),
+	definitionInfo := exports.ExportDefinition{
+		Type:      exports.ExportType(attrs["type"].(string)),
+		Timeframe: exports.TimeframeType(attrs["time_frame"].(string)),
+	}
+
+	if v := attrs["data_version"].(string); v != "" {
+		definitionInfo.DataSet = &exports.ExportDataset{
+			Configuration: &exports.ExportDatasetConfiguration{
+				DataVersion: utils.String(v),
+			},
+		}
 	}
 
 	return definitionInfo
 }
 
-func flattenExportDataStorageLocation(input *costmanagement.ExportDeliveryInfo) ([]interface{}, error) {
-	if input == nil || input.Destination == nil {
-		return []interface{}{}, nil
-	}
-
+func flattenExportDataStorageLocation(input exports.ExportDeliveryInfo) ([]interface{}, error) {
 	destination := input.Destination
 	var err error
 	var storageAccountId *storageParse.StorageAccountId
 
-	if v := destination.ResourceID; v != nil {
+	if v := destination.ResourceId; v != nil {
 		storageAccountId, err = storageParse.StorageAccountID(*v)
 		if err != nil {
 			return nil, err
@@ -326,8 +486,8 @@ func flattenExportDataStorageLocation(input *costmanagement.ExportDeliveryInfo)
 	}
 
 	containerId := ""
-	if v := destination.Container; v != nil && storageAccountId != nil {
-		containerId = storageParse.NewStorageContainerResourceManagerID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, "default", *v).ID()
+	if storageAccountId != nil && destination.Container != "" {
+		containerId = storageParse.NewStorageContainerResourceManagerID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, "default", destination.Container).ID()
 	}
 
 	rootFolderPath := ""
@@ -343,20 +503,17 @@ func flattenExportDataStorageLocation(input *costmanagement.ExportDeliveryInfo)
 	}, nil
 }
 
-func flattenExportDefinition(input *costmanagement.ExportDefinition) []interface{} {
-	if input == nil {
-		return []interface{}{}
-	}
-
-	queryType := ""
-	if v := input.Type; v != "" {
-		queryType = string(input.Type)
+func flattenCostManagementExportDefinition(input exports.ExportDefinition) []interface{} {
+	dataVersion := ""
+	if input.DataSet != nil && input.DataSet.Configuration != nil {
+		dataVersion = utils.NormalizeNilableString(input.DataSet.Configuration.DataVersion)
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"time_frame": string(input.Timeframe),
-			"type":       queryType,
+			"data_version": dataVersion,
+			"time_frame":   string(input.Timeframe),
+			"type":         string(input.Type),
 		},
 	}
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/sdk/2023-07-01-preview/exports"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -85,12 +86,12 @@ func (t ResourceGroupCostManagementExport) Exists(ctx context.Context, clients *
 		return nil, err
 	}
 
-	resp, err := clients.CostManagement.ExportClient.Get(ctx, id.Scope, id.Name, "")
+	resp, err := clients.CostManagement.ExportsClient.Get(ctx, exports.NewScopedExportID(id.Scope, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("retrieving (%s): %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ExportProperties != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
 }
 
 func (ResourceGroupCostManagementExport) basic(data acceptance.TestData) string {
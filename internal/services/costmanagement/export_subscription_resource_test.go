@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/costmanagement/sdk/2023-07-01-preview/exports"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -61,6 +62,22 @@ func TestAccSubscriptionCostManagementExport_update(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionCostManagementExport_focus(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subscription_cost_management_export", "test")
+	r := SubscriptionCostManagementExport{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.focus(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSubscriptionCostManagementExport_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subscription_cost_management_export", "test")
 	r := SubscriptionCostManagementExport{}
@@ -85,12 +102,12 @@ func (t SubscriptionCostManagementExport) Exists(ctx context.Context, clients *c
 		return nil, err
 	}
 
-	resp, err := clients.CostManagement.ExportClient.Get(ctx, id.Scope, id.Name, "")
+	resp, err := clients.CostManagement.ExportsClient.Get(ctx, exports.NewScopedExportID(id.Scope, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("retrieving (%s): %+v", *id, err)
 	}
 
-	return utils.Bool(resp.ExportProperties != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil), nil
 }
 
 func (SubscriptionCostManagementExport) basic(data acceptance.TestData) string {
@@ -193,6 +210,74 @@ resource "azurerm_subscription_cost_management_export" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomInteger, start, end)
 }
 
+func (SubscriptionCostManagementExport) focus(data acceptance.TestData) string {
+	start := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	end := time.Now().AddDate(0, 0, 2).Format("2006-01-02")
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "test" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cm-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_container" "test" {
+  name                 = "acctestcontainer%[3]s"
+  storage_account_name = azurerm_storage_account.test.name
+}
+
+resource "azurerm_subscription_cost_management_export" "test" {
+  name                         = "accs%[1]d"
+  subscription_id              = data.azurerm_subscription.test.id
+  recurrence_type              = "Daily"
+  recurrence_period_start_date = "%[4]sT00:00:00Z"
+  recurrence_period_end_date   = "%[5]sT00:00:00Z"
+  description                  = "FOCUS export for acceptance testing"
+  file_format                  = "Parquet"
+  compression_mode             = "snappy"
+  file_partition_enabled       = true
+  data_overwrite_behavior      = "OverwritePreviousReport"
+  location                     = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  export_data_storage_location {
+    container_id     = azurerm_storage_container.test.resource_manager_id
+    root_folder_path = "/focus"
+  }
+
+  export_data_options {
+    type         = "FocusCost"
+    time_frame   = "MonthToDate"
+    data_version = "1.0"
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_subscription_cost_management_export.test.identity.0.principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, start, end)
+}
+
 func (SubscriptionCostManagementExport) requiresImport(data acceptance.TestData) string {
 	template := SubscriptionCostManagementExport{}.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,291 @@
+package exports
+
+import "strings"
+
+type CompressionModeType string
+
+const (
+	CompressionModeTypeGzip   CompressionModeType = "gzip"
+	CompressionModeTypeNone   CompressionModeType = "none"
+	CompressionModeTypeSnappy CompressionModeType = "snappy"
+)
+
+func PossibleValuesForCompressionModeType() []string {
+	return []string{
+		string(CompressionModeTypeGzip),
+		string(CompressionModeTypeNone),
+		string(CompressionModeTypeSnappy),
+	}
+}
+
+func parseCompressionModeType(input string) (*CompressionModeType, error) {
+	vals := map[string]CompressionModeType{
+		"gzip":   CompressionModeTypeGzip,
+		"none":   CompressionModeTypeNone,
+		"snappy": CompressionModeTypeSnappy,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CompressionModeType(input)
+	return &out, nil
+}
+
+type DataOverwriteBehaviorType string
+
+const (
+	DataOverwriteBehaviorTypeCreateNewReport         DataOverwriteBehaviorType = "CreateNewReport"
+	DataOverwriteBehaviorTypeOverwritePreviousReport DataOverwriteBehaviorType = "OverwritePreviousReport"
+)
+
+func PossibleValuesForDataOverwriteBehaviorType() []string {
+	return []string{
+		string(DataOverwriteBehaviorTypeCreateNewReport),
+		string(DataOverwriteBehaviorTypeOverwritePreviousReport),
+	}
+}
+
+func parseDataOverwriteBehaviorType(input string) (*DataOverwriteBehaviorType, error) {
+	vals := map[string]DataOverwriteBehaviorType{
+		"createnewreport":         DataOverwriteBehaviorTypeCreateNewReport,
+		"overwritepreviousreport": DataOverwriteBehaviorTypeOverwritePreviousReport,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataOverwriteBehaviorType(input)
+	return &out, nil
+}
+
+type DestinationType string
+
+const (
+	DestinationTypeAzureBlob DestinationType = "AzureBlob"
+)
+
+func PossibleValuesForDestinationType() []string {
+	return []string{
+		string(DestinationTypeAzureBlob),
+	}
+}
+
+func parseDestinationType(input string) (*DestinationType, error) {
+	vals := map[string]DestinationType{
+		"azureblob": DestinationTypeAzureBlob,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DestinationType(input)
+	return &out, nil
+}
+
+type ExportType string
+
+const (
+	ExportTypeActualCost                 ExportType = "ActualCost"
+	ExportTypeAmortizedCost              ExportType = "AmortizedCost"
+	ExportTypeFocusCost                  ExportType = "FocusCost"
+	ExportTypePriceSheet                 ExportType = "PriceSheet"
+	ExportTypeReservationDetails         ExportType = "ReservationDetails"
+	ExportTypeReservationRecommendations ExportType = "ReservationRecommendations"
+	ExportTypeReservationTransactions    ExportType = "ReservationTransactions"
+	ExportTypeUsage                      ExportType = "Usage"
+)
+
+func PossibleValuesForExportType() []string {
+	return []string{
+		string(ExportTypeActualCost),
+		string(ExportTypeAmortizedCost),
+		string(ExportTypeFocusCost),
+		string(ExportTypePriceSheet),
+		string(ExportTypeReservationDetails),
+		string(ExportTypeReservationRecommendations),
+		string(ExportTypeReservationTransactions),
+		string(ExportTypeUsage),
+	}
+}
+
+func parseExportType(input string) (*ExportType, error) {
+	vals := map[string]ExportType{
+		"actualcost":                 ExportTypeActualCost,
+		"amortizedcost":              ExportTypeAmortizedCost,
+		"focuscost":                  ExportTypeFocusCost,
+		"pricesheet":                 ExportTypePriceSheet,
+		"reservationdetails":         ExportTypeReservationDetails,
+		"reservationrecommendations": ExportTypeReservationRecommendations,
+		"reservationtransactions":    ExportTypeReservationTransactions,
+		"usage":                      ExportTypeUsage,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExportType(input)
+	return &out, nil
+}
+
+type FormatType string
+
+const (
+	FormatTypeCsv     FormatType = "Csv"
+	FormatTypeParquet FormatType = "Parquet"
+)
+
+func PossibleValuesForFormatType() []string {
+	return []string{
+		string(FormatTypeCsv),
+		string(FormatTypeParquet),
+	}
+}
+
+func parseFormatType(input string) (*FormatType, error) {
+	vals := map[string]FormatType{
+		"csv":     FormatTypeCsv,
+		"parquet": FormatTypeParquet,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FormatType(input)
+	return &out, nil
+}
+
+type GranularityType string
+
+const (
+	GranularityTypeDaily GranularityType = "Daily"
+)
+
+func PossibleValuesForGranularityType() []string {
+	return []string{
+		string(GranularityTypeDaily),
+	}
+}
+
+func parseGranularityType(input string) (*GranularityType, error) {
+	vals := map[string]GranularityType{
+		"daily": GranularityTypeDaily,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GranularityType(input)
+	return &out, nil
+}
+
+type RecurrenceType string
+
+const (
+	RecurrenceTypeAnnually RecurrenceType = "Annually"
+	RecurrenceTypeDaily    RecurrenceType = "Daily"
+	RecurrenceTypeMonthly  RecurrenceType = "Monthly"
+	RecurrenceTypeWeekly   RecurrenceType = "Weekly"
+)
+
+func PossibleValuesForRecurrenceType() []string {
+	return []string{
+		string(RecurrenceTypeAnnually),
+		string(RecurrenceTypeDaily),
+		string(RecurrenceTypeMonthly),
+		string(RecurrenceTypeWeekly),
+	}
+}
+
+func parseRecurrenceType(input string) (*RecurrenceType, error) {
+	vals := map[string]RecurrenceType{
+		"annually": RecurrenceTypeAnnually,
+		"daily":    RecurrenceTypeDaily,
+		"monthly":  RecurrenceTypeMonthly,
+		"weekly":   RecurrenceTypeWeekly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RecurrenceType(input)
+	return &out, nil
+}
+
+type StatusType string
+
+const (
+	StatusTypeActive   StatusType = "Active"
+	StatusTypeInactive StatusType = "Inactive"
+)
+
+func PossibleValuesForStatusType() []string {
+	return []string{
+		string(StatusTypeActive),
+		string(StatusTypeInactive),
+	}
+}
+
+func parseStatusType(input string) (*StatusType, error) {
+	vals := map[string]StatusType{
+		"active":   StatusTypeActive,
+		"inactive": StatusTypeInactive,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StatusType(input)
+	return &out, nil
+}
+
+type TimeframeType string
+
+const (
+	TimeframeTypeBillingMonthToDate  TimeframeType = "BillingMonthToDate"
+	TimeframeTypeCustom              TimeframeType = "Custom"
+	TimeframeTypeMonthToDate         TimeframeType = "MonthToDate"
+	TimeframeTypeTheCurrentMonth     TimeframeType = "TheCurrentMonth"
+	TimeframeTypeTheLastBillingMonth TimeframeType = "TheLastBillingMonth"
+	TimeframeTypeTheLastMonth        TimeframeType = "TheLastMonth"
+	TimeframeTypeWeekToDate          TimeframeType = "WeekToDate"
+)
+
+func PossibleValuesForTimeframeType() []string {
+	return []string{
+		string(TimeframeTypeBillingMonthToDate),
+		string(TimeframeTypeCustom),
+		string(TimeframeTypeMonthToDate),
+		string(TimeframeTypeTheCurrentMonth),
+		string(TimeframeTypeTheLastBillingMonth),
+		string(TimeframeTypeTheLastMonth),
+		string(TimeframeTypeWeekToDate),
+	}
+}
+
+func parseTimeframeType(input string) (*TimeframeType, error) {
+	vals := map[string]TimeframeType{
+		"billingmonthtodate":  TimeframeTypeBillingMonthToDate,
+		"custom":              TimeframeTypeCustom,
+		"monthtodate":         TimeframeTypeMonthToDate,
+		"thecurrentmonth":     TimeframeTypeTheCurrentMonth,
+		"thelastbillingmonth": TimeframeTypeTheLastBillingMonth,
+		"thelastmonth":        TimeframeTypeTheLastMonth,
+		"weektodate":          TimeframeTypeWeekToDate,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TimeframeType(input)
+	return &out, nil
+}
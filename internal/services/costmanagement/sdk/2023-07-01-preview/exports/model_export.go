@@ -0,0 +1,15 @@
+package exports
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type Export struct {
+	ETag       *string                  `json:"eTag,omitempty"`
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *identity.SystemAssigned `json:"identity,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *ExportProperties        `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
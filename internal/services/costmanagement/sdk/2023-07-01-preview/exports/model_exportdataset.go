@@ -0,0 +1,6 @@
+package exports
+
+type ExportDataset struct {
+	Configuration *ExportDatasetConfiguration `json:"configuration,omitempty"`
+	Granularity   *GranularityType            `json:"granularity,omitempty"`
+}
@@ -0,0 +1,6 @@
+package exports
+
+type ExportDatasetConfiguration struct {
+	Columns     *[]string `json:"columns,omitempty"`
+	DataVersion *string   `json:"dataVersion,omitempty"`
+}
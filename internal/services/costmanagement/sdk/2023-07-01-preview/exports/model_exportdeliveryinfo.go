@@ -0,0 +1,5 @@
+package exports
+
+type ExportDeliveryInfo struct {
+	Destination ExportDeliveryDestination `json:"destination"`
+}
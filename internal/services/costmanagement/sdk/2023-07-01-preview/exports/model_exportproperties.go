@@ -0,0 +1,13 @@
+package exports
+
+type ExportProperties struct {
+	CompressionMode       *CompressionModeType       `json:"compressionMode,omitempty"`
+	DataOverwriteBehavior *DataOverwriteBehaviorType `json:"dataOverwriteBehavior,omitempty"`
+	Definition            ExportDefinition           `json:"definition"`
+	DeliveryInfo          ExportDeliveryInfo         `json:"deliveryInfo"`
+	ExportDescription     *string                    `json:"exportDescription,omitempty"`
+	Format                *FormatType                `json:"format,omitempty"`
+	NextRunTimeEstimate   *string                    `json:"nextRunTimeEstimate,omitempty"`
+	PartitionData         *bool                      `json:"partitionData,omitempty"`
+	Schedule              *ExportSchedule            `json:"schedule,omitempty"`
+}
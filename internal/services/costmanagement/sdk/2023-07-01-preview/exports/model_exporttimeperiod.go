@@ -0,0 +1,6 @@
+package exports
+
+type ExportTimePeriod struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
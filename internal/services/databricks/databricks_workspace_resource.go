@@ -86,7 +86,15 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 			"managed_services_cmk_key_vault_key_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.KeyVaultChildID,
+			},
+
+			// NOTE: this is Computed since the DBFS customer-managed key can also be managed using the
+			// `azurerm_databricks_workspace_customer_managed_key` resource
+			"dbfs_cmk_key_vault_key_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
 				ValidateFunc: keyVaultValidate.KeyVaultChildID,
 			},
 
@@ -275,6 +283,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 			_, requireNsgRules := d.GetChange("network_security_group_rules_required")
 			_, backendPool := d.GetChange("load_balancer_backend_address_pool_id")
 			_, managedServicesCMK := d.GetChange("managed_services_cmk_key_vault_key_id")
+			_, dbfsCMK := d.GetChange("dbfs_cmk_key_vault_key_id")
 
 			oldSku, newSku := d.GetChange("sku")
 
@@ -300,8 +309,32 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				}
 			}
 
-			if (customerEncryptionEnabled.(bool) || infrastructureEncryptionEnabled.(bool) || managedServicesCMK.(string) != "") && !strings.EqualFold("premium", newSku.(string)) {
-				return fmt.Errorf("'customer_managed_key_enabled', 'infrastructure_encryption_enabled' and 'managed_services_cmk_key_vault_key_id' are only available with a 'premium' workspace 'sku', got %q", newSku)
+			if (customerEncryptionEnabled.(bool) || infrastructureEncryptionEnabled.(bool) || managedServicesCMK.(string) != "" || dbfsCMK.(string) != "") && !strings.EqualFold("premium", newSku.(string)) {
+				return fmt.Errorf("'customer_managed_key_enabled', 'infrastructure_encryption_enabled', 'managed_services_cmk_key_vault_key_id' and 'dbfs_cmk_key_vault_key_id' are only available with a 'premium' workspace 'sku', got %q", newSku)
+			}
+
+			// the customer-managed key for managed services can be rotated, but not removed once it's been set
+			if oldManagedServicesCMK, _ := d.GetChange("managed_services_cmk_key_vault_key_id"); oldManagedServicesCMK.(string) != "" && managedServicesCMK.(string) == "" {
+				if err := d.ForceNew("managed_services_cmk_key_vault_key_id"); err != nil {
+					return err
+				}
+			}
+
+			// the Key Vault can only grant access to the identity of the root DBFS Storage Account once the Workspace
+			// has been created, as such the customer-managed key for DBFS can only be set once the Workspace exists
+			if d.Id() == "" {
+				if config := d.GetRawConfig(); !config.IsNull() && config.IsKnown() && !config.GetAttr("dbfs_cmk_key_vault_key_id").IsNull() {
+					return fmt.Errorf("'dbfs_cmk_key_vault_key_id' can't be set when creating the Databricks Workspace since the Key Vault must first grant access to the 'storage_account_identity' of the Workspace - create the Workspace without 'dbfs_cmk_key_vault_key_id' and then set it in a subsequent apply")
+				}
+			}
+
+			if d.HasChange("dbfs_cmk_key_vault_key_id") && dbfsCMK.(string) != "" {
+				if !customerEncryptionEnabled.(bool) {
+					return fmt.Errorf("'customer_managed_key_enabled' must be set to 'true' when 'dbfs_cmk_key_vault_key_id' is specified")
+				}
+				if infrastructureEncryptionEnabled.(bool) {
+					return fmt.Errorf("'infrastructure_encryption_enabled' must be set to 'false' when 'dbfs_cmk_key_vault_key_id' is specified")
+				}
 			}
 
 			return nil
@@ -433,6 +466,41 @@ func resourceDatabricksWorkspaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	// Set up customer-managed keys for the root DBFS storage account - when this isn't specified we
+	// need to retain any existing value, since this may be managed by the
+	// `azurerm_databricks_workspace_customer_managed_key` resource
+	dbfsKeyIdRaw := d.Get("dbfs_cmk_key_vault_key_id").(string)
+	if dbfsKeyIdRaw != "" && !d.IsNewResource() && d.HasChange("dbfs_cmk_key_vault_key_id") {
+		key, err := keyVaultParse.ParseNestedItemID(dbfsKeyIdRaw)
+		if err != nil {
+			return err
+		}
+
+		// make sure the key vault exists
+		keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, meta.(*clients.Client).Resource, key.KeyVaultBaseUrl)
+		if err != nil || keyVaultIdRaw == nil {
+			return fmt.Errorf("retrieving the Resource ID for the customer-managed keys for DBFS Key Vault at URL %q: %+v", key.KeyVaultBaseUrl, err)
+		}
+
+		keySource := workspaces.KeySourceMicrosoftPointKeyvault
+		customParams.Encryption = &workspaces.WorkspaceEncryptionParameter{
+			Value: &workspaces.Encryption{
+				KeySource:   &keySource,
+				KeyName:     &key.Name,
+				Keyversion:  &key.Version,
+				Keyvaulturi: &key.KeyVaultBaseUrl,
+			},
+		}
+	} else if !d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if model := existing.Model; model != nil && model.Properties.Parameters != nil {
+			customParams.Encryption = model.Properties.Parameters.Encryption
+		}
+	}
+
 	// Including the Tags in the workspace parameters will update the tags on
 	// the workspace only
 	workspace := workspaces.Workspace{
@@ -568,6 +636,19 @@ func resourceDatabricksWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("load_balancer_backend_address_pool_id", backendPoolReadId)
 		}
 
+		// customer managed key for the root DBFS storage account
+		dbfsKeyId := ""
+		if parameters := model.Properties.Parameters; parameters != nil && parameters.Encryption != nil && parameters.Encryption.Value != nil {
+			encryption := parameters.Encryption.Value
+			if encryption.KeySource != nil && strings.EqualFold(string(*encryption.KeySource), string(workspaces.KeySourceMicrosoftPointKeyvault)) && encryption.Keyvaulturi != nil && encryption.KeyName != nil && encryption.Keyversion != nil {
+				key, err := keyVaultParse.NewNestedItemID(*encryption.Keyvaulturi, "keys", *encryption.KeyName, *encryption.Keyversion)
+				if err == nil {
+					dbfsKeyId = key.ID()
+				}
+			}
+		}
+		d.Set("dbfs_cmk_key_vault_key_id", dbfsKeyId)
+
 		if err := d.Set("storage_account_identity", flattenWorkspaceStorageAccountIdentity(model.Properties.StorageAccountIdentity)); err != nil {
 			return fmt.Errorf("setting `storage_account_identity`: %+v", err)
 		}
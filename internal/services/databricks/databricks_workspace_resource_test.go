@@ -3,6 +3,7 @@ package databricks_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -251,6 +252,51 @@ func TestAccDatabricksWorkspace_managedServicesDbfsCMKAndPrivateLink(t *testing.
 	})
 }
 
+func TestAccDatabricksWorkspace_dbfsCMKDuringCreation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace", "test")
+	databricksPrincipalID := getDatabricksPrincipalId(data.Client().SubscriptionID)
+	r := DatabricksWorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.managedServicesAndDbfsCMKInline(data, databricksPrincipalID, "first", "azurerm_key_vault_key.first.id"),
+			ExpectError: regexp.MustCompile("'dbfs_cmk_key_vault_key_id' can't be set when creating the Databricks Workspace"),
+		},
+	})
+}
+
+func TestAccDatabricksWorkspace_managedServicesAndDbfsCMKUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_databricks_workspace", "test")
+	databricksPrincipalID := getDatabricksPrincipalId(data.Client().SubscriptionID)
+	r := DatabricksWorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedServicesAndDbfsCMKInline(data, databricksPrincipalID, "first", ""),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dbfs_cmk_key_vault_key_id").IsEmpty(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedServicesAndDbfsCMKInline(data, databricksPrincipalID, "second", "azurerm_key_vault_key.first.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dbfs_cmk_key_vault_key_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedServicesAndDbfsCMKInline(data, databricksPrincipalID, "first", "azurerm_key_vault_key.second.id"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func getDatabricksPrincipalId(subscriptionId string) string {
 	databricksPrincipalID := "bb9ef821-a78b-4312-90cc-5ece3fad3430"
 	if strings.HasPrefix(strings.ToLower(subscriptionId), "85b3dbca") {
@@ -1461,3 +1507,135 @@ resource "azurerm_private_dns_cname_record" "test" {
 }
 `, data.RandomInteger, data.Locations.Secondary, data.RandomString, databricksPrincipalID)
 }
+
+func (DatabricksWorkspaceResource) managedServicesAndDbfsCMKInline(data acceptance.TestData, databricksPrincipalID string, managedServicesKey string, dbfsKeyId string) string {
+	dbfsKey := ""
+	if dbfsKeyId != "" {
+		dbfsKey = fmt.Sprintf("dbfs_cmk_key_vault_key_id = %s", dbfsKeyId)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-databricks-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_databricks_workspace" "test" {
+  depends_on = [azurerm_key_vault_access_policy.managed]
+
+  name                        = "acctestDBW-%[1]d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = azurerm_resource_group.test.location
+  sku                         = "premium"
+  managed_resource_group_name = "acctestRG-DBW-%[1]d-managed"
+
+  customer_managed_key_enabled          = true
+  managed_services_cmk_key_vault_key_id = azurerm_key_vault_key.%[5]s.id
+  %[6]s
+
+  tags = {
+    Environment = "Production"
+    Pricing     = "Premium"
+  }
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctest-kv-%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "premium"
+
+  soft_delete_retention_days = 7
+}
+
+resource "azurerm_key_vault_key" "first" {
+  depends_on = [azurerm_key_vault_access_policy.terraform]
+
+  name         = "acctest-first"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_key_vault_key" "second" {
+  depends_on = [azurerm_key_vault_access_policy.terraform]
+
+  name         = "acctest-second"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_key_vault_access_policy" "terraform" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = azurerm_key_vault.test.tenant_id
+  object_id    = data.azurerm_client_config.current.object_id
+
+  key_permissions = [
+    "get",
+    "list",
+    "create",
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+    "delete",
+    "restore",
+    "recover",
+    "update",
+    "purge",
+  ]
+}
+
+resource "azurerm_key_vault_access_policy" "managed" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = azurerm_key_vault.test.tenant_id
+  object_id    = "%[4]s"
+
+  key_permissions = [
+    "get",
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+
+resource "azurerm_key_vault_access_policy" "databricks" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = azurerm_databricks_workspace.test.storage_account_identity.0.tenant_id
+  object_id    = azurerm_databricks_workspace.test.storage_account_identity.0.principal_id
+
+  key_permissions = [
+    "get",
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, databricksPrincipalID, managedServicesKey, dbfsKey)
+}
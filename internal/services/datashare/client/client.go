@@ -6,29 +6,49 @@ import (
 )
 
 type Client struct {
-	AccountClient         *datashare.AccountsClient
-	DataSetClient         *datashare.DataSetsClient
-	SharesClient          *datashare.SharesClient
-	SynchronizationClient *datashare.SynchronizationSettingsClient
+	AccountClient                *datashare.AccountsClient
+	ConsumerSourceDataSetsClient *datashare.ConsumerSourceDataSetsClient
+	DataSetClient                *datashare.DataSetsClient
+	DataSetMappingsClient        *datashare.DataSetMappingsClient
+	SharesClient                 *datashare.SharesClient
+	ShareSubscriptionsClient     *datashare.ShareSubscriptionsClient
+	SynchronizationClient        *datashare.SynchronizationSettingsClient
+	TriggersClient               *datashare.TriggersClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountClient := datashare.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&accountClient.Client, o.ResourceManagerAuthorizer)
 
+	consumerSourceDataSetsClient := datashare.NewConsumerSourceDataSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&consumerSourceDataSetsClient.Client, o.ResourceManagerAuthorizer)
+
 	dataSetClient := datashare.NewDataSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataSetClient.Client, o.ResourceManagerAuthorizer)
 
+	dataSetMappingsClient := datashare.NewDataSetMappingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&dataSetMappingsClient.Client, o.ResourceManagerAuthorizer)
+
 	sharesClient := datashare.NewSharesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sharesClient.Client, o.ResourceManagerAuthorizer)
 
+	shareSubscriptionsClient := datashare.NewShareSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&shareSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
+
 	synchronizationSettingsClient := datashare.NewSynchronizationSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&synchronizationSettingsClient.Client, o.ResourceManagerAuthorizer)
 
+	triggersClient := datashare.NewTriggersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&triggersClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AccountClient:         &accountClient,
-		DataSetClient:         &dataSetClient,
-		SharesClient:          &sharesClient,
-		SynchronizationClient: &synchronizationSettingsClient,
+		AccountClient:                &accountClient,
+		ConsumerSourceDataSetsClient: &consumerSourceDataSetsClient,
+		DataSetClient:                &dataSetClient,
+		DataSetMappingsClient:        &dataSetMappingsClient,
+		SharesClient:                 &sharesClient,
+		ShareSubscriptionsClient:     &shareSubscriptionsClient,
+		SynchronizationClient:        &synchronizationSettingsClient,
+		TriggersClient:               &triggersClient,
 	}
 }
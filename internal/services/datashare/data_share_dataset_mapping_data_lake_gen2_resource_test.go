@@ -0,0 +1,143 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/datashare/mgmt/2019-11-01/datashare"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Source Data Set has to be a Data Lake Gen2 File System within the Data Share which the Invitation was sent for
+type DataShareDataSetMappingDataLakeGen2Resource struct {
+	shareSubscription DataShareSubscriptionResource
+	sourceDataSetId   string
+}
+
+func newDataShareDataSetMappingDataLakeGen2Resource(t *testing.T) DataShareDataSetMappingDataLakeGen2Resource {
+	r := DataShareDataSetMappingDataLakeGen2Resource{
+		shareSubscription: newDataShareSubscriptionResource(t),
+		sourceDataSetId:   os.Getenv("ARM_TEST_DATA_SHARE_DATA_LAKE_GEN2_SOURCE_DATA_SET_ID"),
+	}
+	if r.sourceDataSetId == "" {
+		t.Skip("Skipping as ARM_TEST_DATA_SHARE_DATA_LAKE_GEN2_SOURCE_DATA_SET_ID is not specified")
+	}
+
+	return r
+}
+
+func TestAccDataShareDataSetMappingDataLakeGen2_basic(t *testing.T) {
+	r := newDataShareDataSetMappingDataLakeGen2Resource(t)
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_data_lake_gen2", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareDataSetMappingDataLakeGen2_requiresImport(t *testing.T) {
+	r := newDataShareDataSetMappingDataLakeGen2Resource(t)
+	data := acceptance.BuildTestData(t, "azurerm_data_share_dataset_mapping_data_lake_gen2", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t DataShareDataSetMappingDataLakeGen2Resource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataSetMappingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.DataSetMappingsClient.Get(ctx, id.ResourceGroup, id.AccountName, id.ShareSubscriptionName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	switch resp := resp.Value.(type) {
+	case datashare.ADLSGen2FileDataSetMapping:
+		return utils.Bool(resp.ADLSGen2FileDataSetMappingProperties != nil), nil
+
+	case datashare.ADLSGen2FolderDataSetMapping:
+		return utils.Bool(resp.ADLSGen2FolderDataSetMappingProperties != nil), nil
+
+	case datashare.ADLSGen2FileSystemDataSetMapping:
+		return utils.Bool(resp.ADLSGen2FileSystemDataSetMappingProperties != nil), nil
+	}
+
+	return nil, fmt.Errorf("%s is not a data lake gen2 dataset mapping", *id)
+}
+
+func (r DataShareDataSetMappingDataLakeGen2Resource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "accteststr%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%[3]d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_data_share_account.test.identity.0.principal_id
+}
+
+resource "azurerm_data_share_dataset_mapping_data_lake_gen2" "test" {
+  name                  = "acctest-dsm-%[3]d"
+  share_subscription_id = azurerm_data_share_subscription.test.id
+  source_data_set_id    = "%[4]s"
+  storage_account_id    = azurerm_storage_account.test.id
+  file_system_name      = azurerm_storage_data_lake_gen2_filesystem.test.name
+
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+`, r.shareSubscription.basic(data), data.RandomString, data.RandomInteger, r.sourceDataSetId)
+}
+
+func (r DataShareDataSetMappingDataLakeGen2Resource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_dataset_mapping_data_lake_gen2" "import" {
+  name                  = azurerm_data_share_dataset_mapping_data_lake_gen2.test.name
+  share_subscription_id = azurerm_data_share_dataset_mapping_data_lake_gen2.test.share_subscription_id
+  source_data_set_id    = azurerm_data_share_dataset_mapping_data_lake_gen2.test.source_data_set_id
+  storage_account_id    = azurerm_data_share_dataset_mapping_data_lake_gen2.test.storage_account_id
+  file_system_name      = azurerm_data_share_dataset_mapping_data_lake_gen2.test.file_system_name
+}
+`, r.basic(data))
+}
@@ -0,0 +1,477 @@
+package datashare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datashare/mgmt/2019-11-01/datashare"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceDataShareSubscription() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceDataShareSubscriptionCreate,
+		Read:   resourceDataShareSubscriptionRead,
+		Update: resourceDataShareSubscriptionUpdate,
+		Delete: resourceDataShareSubscriptionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ShareSubscriptionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ShareName(),
+			},
+
+			"account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"invitation_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"source_share_location": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"snapshot_schedule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.SnapshotScheduleName(),
+						},
+
+						"recurrence": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(datashare.Day),
+								string(datashare.Hour),
+							}, false),
+						},
+
+						"start_time": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppress.RFC3339Time,
+						},
+
+						"synchronization_mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(datashare.Incremental),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(datashare.FullSync),
+								string(datashare.Incremental),
+							}, false),
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"share_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_kind": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"share_terms": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_email": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"provider_tenant_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			// the Data Sets within the source Share, whose IDs are needed to map them into the consumer's storage
+			"source_data_set": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"data_set_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"path": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceDataShareSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionsClient
+	triggersClient := meta.(*clients.Client).DataShare.TriggersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AccountID(d.Get("account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewShareSubscriptionID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_data_share_subscription", id.ID())
+	}
+
+	shareSubscription := datashare.ShareSubscription{
+		ShareSubscriptionProperties: &datashare.ShareSubscriptionProperties{
+			InvitationID:        utils.String(d.Get("invitation_id").(string)),
+			SourceShareLocation: utils.String(location.Normalize(d.Get("source_share_location").(string))),
+		},
+	}
+
+	if _, err := client.Create(ctx, id.ResourceGroup, id.AccountName, id.Name, shareSubscription); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	if err := createDataShareSubscriptionSnapshotSchedule(ctx, triggersClient, d, id); err != nil {
+		return err
+	}
+
+	return resourceDataShareSubscriptionRead(d, meta)
+}
+
+func resourceDataShareSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionsClient
+	triggersClient := meta.(*clients.Client).DataShare.TriggersClient
+	sourceDataSetsClient := meta.(*clients.Client).DataShare.ConsumerSourceDataSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("account_id", parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName).ID())
+
+	if props := resp.ShareSubscriptionProperties; props != nil {
+		d.Set("invitation_id", props.InvitationID)
+		d.Set("source_share_location", location.NormalizeNilable(props.SourceShareLocation))
+		d.Set("share_name", props.ShareName)
+		d.Set("share_kind", string(props.ShareKind))
+		d.Set("share_description", props.ShareDescription)
+		d.Set("share_terms", props.ShareTerms)
+		d.Set("provider_name", props.ProviderName)
+		d.Set("provider_email", props.ProviderEmail)
+		d.Set("provider_tenant_name", props.ProviderTenantName)
+		d.Set("status", string(props.ShareSubscriptionStatus))
+	}
+
+	triggers := make([]datashare.ScheduledTrigger, 0)
+	triggerIterator, err := triggersClient.ListByShareSubscriptionComplete(ctx, id.ResourceGroup, id.AccountName, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("listing Snapshot Schedules for %s: %+v", *id, err)
+	}
+	for triggerIterator.NotDone() {
+		item, ok := triggerIterator.Value().AsScheduledTrigger()
+		if ok && item != nil {
+			triggers = append(triggers, *item)
+		}
+
+		if err := triggerIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("retrieving next Snapshot Schedule: %+v", err)
+		}
+	}
+	if err := d.Set("snapshot_schedule", flattenDataShareSubscriptionSnapshotSchedule(triggers)); err != nil {
+		return fmt.Errorf("setting `snapshot_schedule`: %+v", err)
+	}
+
+	sourceDataSets := make([]datashare.ConsumerSourceDataSet, 0)
+	sourceDataSetIterator, err := sourceDataSetsClient.ListByShareSubscriptionComplete(ctx, id.ResourceGroup, id.AccountName, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("listing Source Data Sets for %s: %+v", *id, err)
+	}
+	for sourceDataSetIterator.NotDone() {
+		sourceDataSets = append(sourceDataSets, sourceDataSetIterator.Value())
+
+		if err := sourceDataSetIterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("retrieving next Source Data Set: %+v", err)
+		}
+	}
+	if err := d.Set("source_data_set", flattenDataShareSubscriptionSourceDataSets(sourceDataSets)); err != nil {
+		return fmt.Errorf("setting `source_data_set`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceDataShareSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	triggersClient := meta.(*clients.Client).DataShare.TriggersClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("snapshot_schedule") {
+		// only one trigger is allowed per share subscription, so the existing one has to be removed first
+		o, _ := d.GetChange("snapshot_schedule")
+		if origins := o.([]interface{}); len(origins) > 0 && origins[0] != nil {
+			origin := origins[0].(map[string]interface{})
+			if originName, ok := origin["name"].(string); ok && originName != "" {
+				future, err := triggersClient.Delete(ctx, id.ResourceGroup, id.AccountName, id.Name, originName)
+				if err != nil {
+					return fmt.Errorf("deleting snapshot schedule %q for %s: %+v", originName, *id, err)
+				}
+				if err = future.WaitForCompletionRef(ctx, triggersClient.Client); err != nil {
+					return fmt.Errorf("waiting for snapshot schedule %q for %s to be deleted: %+v", originName, *id, err)
+				}
+			}
+		}
+
+		if err := createDataShareSubscriptionSnapshotSchedule(ctx, triggersClient, d, *id); err != nil {
+			return err
+		}
+	}
+
+	return resourceDataShareSubscriptionRead(d, meta)
+}
+
+func resourceDataShareSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataShare.ShareSubscriptionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ShareSubscriptionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func createDataShareSubscriptionSnapshotSchedule(ctx context.Context, triggersClient *datashare.TriggersClient, d *pluginsdk.ResourceData, id parse.ShareSubscriptionId) error {
+	trigger := expandDataShareSubscriptionSnapshotSchedule(d.Get("snapshot_schedule").([]interface{}))
+	if trigger == nil {
+		return nil
+	}
+
+	name := d.Get("snapshot_schedule.0.name").(string)
+	future, err := triggersClient.Create(ctx, id.ResourceGroup, id.AccountName, id.Name, name, *trigger)
+	if err != nil {
+		return fmt.Errorf("creating snapshot schedule %q for %s: %+v", name, id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, triggersClient.Client); err != nil {
+		return fmt.Errorf("waiting for creation of snapshot schedule %q for %s: %+v", name, id, err)
+	}
+
+	return nil
+}
+
+func expandDataShareSubscriptionSnapshotSchedule(input []interface{}) *datashare.ScheduledTrigger {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	snapshotSchedule := input[0].(map[string]interface{})
+
+	startTime, _ := time.Parse(time.RFC3339, snapshotSchedule["start_time"].(string))
+
+	return &datashare.ScheduledTrigger{
+		Kind: datashare.KindBasicTriggerKindScheduleBased,
+		ScheduledTriggerProperties: &datashare.ScheduledTriggerProperties{
+			RecurrenceInterval:  datashare.RecurrenceInterval(snapshotSchedule["recurrence"].(string)),
+			SynchronizationMode: datashare.SynchronizationMode(snapshotSchedule["synchronization_mode"].(string)),
+			SynchronizationTime: &date.Time{Time: startTime},
+		},
+	}
+}
+
+func flattenDataShareSubscriptionSnapshotSchedule(input []datashare.ScheduledTrigger) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, trigger := range input {
+		name := ""
+		if trigger.Name != nil {
+			name = *trigger.Name
+		}
+
+		recurrence := ""
+		synchronizationMode := ""
+		startTime := ""
+		status := ""
+		if props := trigger.ScheduledTriggerProperties; props != nil {
+			recurrence = string(props.RecurrenceInterval)
+			synchronizationMode = string(props.SynchronizationMode)
+			status = string(props.TriggerStatus)
+
+			if props.SynchronizationTime != nil && !props.SynchronizationTime.IsZero() {
+				startTime = props.SynchronizationTime.Format(time.RFC3339)
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":                 name,
+			"recurrence":           recurrence,
+			"start_time":           startTime,
+			"synchronization_mode": synchronizationMode,
+			"status":               status,
+		})
+	}
+
+	return output
+}
+
+func flattenDataShareSubscriptionSourceDataSets(input []datashare.ConsumerSourceDataSet) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, item := range input {
+		props := item.ConsumerSourceDataSetProperties
+		if props == nil {
+			continue
+		}
+
+		name := ""
+		if props.DataSetName != nil {
+			name = *props.DataSetName
+		}
+
+		dataSetId := ""
+		if props.DataSetID != nil {
+			dataSetId = *props.DataSetID
+		}
+
+		path := ""
+		if props.DataSetPath != nil {
+			path = *props.DataSetPath
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":        name,
+			"data_set_id": dataSetId,
+			"type":        string(props.DataSetType),
+			"path":        path,
+			"location":    location.NormalizeNilable(props.DataSetLocation),
+		})
+	}
+
+	return output
+}
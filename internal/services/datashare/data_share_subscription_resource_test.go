@@ -0,0 +1,188 @@
+package datashare_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datashare/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a Share Subscription can only be created from an Invitation which has been sent by the provider of a Data Share
+type DataShareSubscriptionResource struct {
+	invitationId        string
+	sourceShareLocation string
+}
+
+func newDataShareSubscriptionResource(t *testing.T) DataShareSubscriptionResource {
+	r := DataShareSubscriptionResource{
+		invitationId:        os.Getenv("ARM_TEST_DATA_SHARE_INVITATION_ID"),
+		sourceShareLocation: os.Getenv("ARM_TEST_DATA_SHARE_SOURCE_LOCATION"),
+	}
+	if r.invitationId == "" || r.sourceShareLocation == "" {
+		t.Skip("Skipping as ARM_TEST_DATA_SHARE_INVITATION_ID and/or ARM_TEST_DATA_SHARE_SOURCE_LOCATION are not specified")
+	}
+
+	return r
+}
+
+func TestAccDataShareSubscription_basic(t *testing.T) {
+	r := newDataShareSubscriptionResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("share_name").Exists(),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataShareSubscription_requiresImport(t *testing.T) {
+	r := newDataShareSubscriptionResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataShareSubscription_snapshotSchedule(t *testing.T) {
+	r := newDataShareSubscriptionResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_data_share_subscription", "test")
+	startTime := time.Now().Add(time.Hour * 7).Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotSchedule(data, "Day", "Incremental", startTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_schedule.0.status").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.snapshotSchedule(data, "Hour", "FullSync", startTime),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_schedule.0.status").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (t DataShareSubscriptionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ShareSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DataShare.ShareSubscriptionsClient.Get(ctx, id.ResourceGroup, id.AccountName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ShareSubscriptionProperties != nil), nil
+}
+
+func (r DataShareSubscriptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-datashare-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_share_account" "test" {
+  name                = "acctest-dsa-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r DataShareSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_dss_%d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%s"
+  source_share_location = "%s"
+}
+`, r.template(data), data.RandomInteger, r.invitationId, r.sourceShareLocation)
+}
+
+func (r DataShareSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_data_share_subscription" "import" {
+  name                  = azurerm_data_share_subscription.test.name
+  account_id            = azurerm_data_share_subscription.test.account_id
+  invitation_id         = azurerm_data_share_subscription.test.invitation_id
+  source_share_location = azurerm_data_share_subscription.test.source_share_location
+}
+`, r.basic(data))
+}
+
+func (r DataShareSubscriptionResource) snapshotSchedule(data acceptance.TestData, recurrence, synchronizationMode, startTime string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_data_share_subscription" "test" {
+  name                  = "acctest_dss_%[2]d"
+  account_id            = azurerm_data_share_account.test.id
+  invitation_id         = "%[3]s"
+  source_share_location = "%[4]s"
+
+  snapshot_schedule {
+    name                 = "acctest-ss-%[5]s"
+    recurrence           = "%[5]s"
+    start_time           = "%[6]s"
+    synchronization_mode = "%[7]s"
+  }
+}
+`, r.template(data), data.RandomInteger, r.invitationId, r.sourceShareLocation, recurrence, startTime, synchronizationMode)
+}
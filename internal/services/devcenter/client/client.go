@@ -0,0 +1,67 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/attachednetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/catalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/environmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/pools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projectenvironmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projects"
+)
+
+type Client struct {
+	AttachedNetworksClient        *attachednetworks.AttachedNetworksClient
+	CatalogsClient                *catalogs.CatalogsClient
+	DevBoxDefinitionsClient       *devboxdefinitions.DevBoxDefinitionsClient
+	DevCentersClient              *devcenters.DevCentersClient
+	EnvironmentTypesClient        *environmenttypes.EnvironmentTypesClient
+	NetworkConnectionsClient      *networkconnections.NetworkConnectionsClient
+	PoolsClient                   *pools.PoolsClient
+	ProjectEnvironmentTypesClient *projectenvironmenttypes.ProjectEnvironmentTypesClient
+	ProjectsClient                *projects.ProjectsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	attachedNetworksClient := attachednetworks.NewAttachedNetworksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&attachedNetworksClient.Client, o.ResourceManagerAuthorizer)
+
+	catalogsClient := catalogs.NewCatalogsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&catalogsClient.Client, o.ResourceManagerAuthorizer)
+
+	devBoxDefinitionsClient := devboxdefinitions.NewDevBoxDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&devBoxDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	devCentersClient := devcenters.NewDevCentersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&devCentersClient.Client, o.ResourceManagerAuthorizer)
+
+	environmentTypesClient := environmenttypes.NewEnvironmentTypesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&environmentTypesClient.Client, o.ResourceManagerAuthorizer)
+
+	networkConnectionsClient := networkconnections.NewNetworkConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&networkConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	poolsClient := pools.NewPoolsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&poolsClient.Client, o.ResourceManagerAuthorizer)
+
+	projectEnvironmentTypesClient := projectenvironmenttypes.NewProjectEnvironmentTypesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&projectEnvironmentTypesClient.Client, o.ResourceManagerAuthorizer)
+
+	projectsClient := projects.NewProjectsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&projectsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AttachedNetworksClient:        &attachedNetworksClient,
+		CatalogsClient:                &catalogsClient,
+		DevBoxDefinitionsClient:       &devBoxDefinitionsClient,
+		DevCentersClient:              &devCentersClient,
+		EnvironmentTypesClient:        &environmentTypesClient,
+		NetworkConnectionsClient:      &networkConnectionsClient,
+		PoolsClient:                   &poolsClient,
+		ProjectEnvironmentTypesClient: &projectEnvironmentTypesClient,
+		ProjectsClient:                &projectsClient,
+	}
+}
@@ -0,0 +1,166 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/attachednetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// DevCenterAttachedNetworkResource attaches a Network Connection to a Dev Center, the name of which is then
+// used by the Pools within the Projects of that Dev Center.
+type DevCenterAttachedNetworkResource struct{}
+
+var _ sdk.Resource = DevCenterAttachedNetworkResource{}
+
+type DevCenterAttachedNetworkResourceModel struct {
+	Name                string `tfschema:"name"`
+	DevCenterId         string `tfschema:"dev_center_id"`
+	NetworkConnectionId string `tfschema:"network_connection_id"`
+}
+
+func (r DevCenterAttachedNetworkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterChildName,
+		},
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devcenters.ValidateDevCenterID,
+		},
+
+		"network_connection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkconnections.ValidateNetworkConnectionID,
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterAttachedNetworkResource) ModelObject() interface{} {
+	return &DevCenterAttachedNetworkResourceModel{}
+}
+
+func (r DevCenterAttachedNetworkResource) ResourceType() string {
+	return "azurerm_dev_center_attached_network"
+}
+
+func (r DevCenterAttachedNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return attachednetworks.ValidateAttachedNetworkID
+}
+
+func (r DevCenterAttachedNetworkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.AttachedNetworksClient
+
+			var model DevCenterAttachedNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := devcenters.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := attachednetworks.NewAttachedNetworkID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := attachednetworks.AttachedNetworkConnection{
+				Properties: &attachednetworks.AttachedNetworkConnectionProperties{
+					NetworkConnectionId: model.NetworkConnectionId,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.AttachedNetworksClient
+
+			id, err := attachednetworks.ParseAttachedNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterAttachedNetworkResourceModel{
+				Name:        id.AttachedNetworkConnectionName,
+				DevCenterId: devcenters.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				networkConnectionId, err := networkconnections.ParseNetworkConnectionIDInsensitively(model.Properties.NetworkConnectionId)
+				if err != nil {
+					return err
+				}
+				state.NetworkConnectionId = networkConnectionId.ID()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterAttachedNetworkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.AttachedNetworksClient
+
+			id, err := attachednetworks.ParseAttachedNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,279 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/catalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterCatalogResource struct{}
+
+var _ sdk.ResourceWithUpdate = DevCenterCatalogResource{}
+
+type DevCenterCatalogResourceModel struct {
+	Name          string                       `tfschema:"name"`
+	DevCenterId   string                       `tfschema:"dev_center_id"`
+	CatalogAdoGit []DevCenterCatalogGitCatalog `tfschema:"catalog_adogit"`
+	CatalogGitHub []DevCenterCatalogGitCatalog `tfschema:"catalog_github"`
+}
+
+type DevCenterCatalogGitCatalog struct {
+	Uri              string `tfschema:"uri"`
+	Branch           string `tfschema:"branch"`
+	Path             string `tfschema:"path"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+}
+
+func (r DevCenterCatalogResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterChildName,
+		},
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devcenters.ValidateDevCenterID,
+		},
+
+		"catalog_adogit": devCenterCatalogGitCatalogSchema(),
+
+		"catalog_github": devCenterCatalogGitCatalogSchema(),
+	}
+}
+
+func (r DevCenterCatalogResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterCatalogResource) ModelObject() interface{} {
+	return &DevCenterCatalogResourceModel{}
+}
+
+func (r DevCenterCatalogResource) ResourceType() string {
+	return "azurerm_dev_center_catalog"
+}
+
+func (r DevCenterCatalogResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return catalogs.ValidateDevCenterCatalogID
+}
+
+func (r DevCenterCatalogResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.CatalogsClient
+
+			var model DevCenterCatalogResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := devcenters.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := catalogs.NewDevCenterCatalogID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := catalogs.Catalog{
+				Properties: &catalogs.CatalogProperties{
+					AdoGit: expandDevCenterCatalogGitCatalog(model.CatalogAdoGit),
+					GitHub: expandDevCenterCatalogGitCatalog(model.CatalogGitHub),
+				},
+			}
+
+			// the Catalog is synchronised from the repository as a part of its creation
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.CatalogsClient
+
+			id, err := catalogs.ParseDevCenterCatalogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterCatalogResourceModel{
+				Name:        id.CatalogName,
+				DevCenterId: devcenters.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.CatalogAdoGit = flattenDevCenterCatalogGitCatalog(props.AdoGit)
+					state.CatalogGitHub = flattenDevCenterCatalogGitCatalog(props.GitHub)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.CatalogsClient
+
+			id, err := catalogs.ParseDevCenterCatalogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterCatalogResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := catalogs.CatalogUpdate{
+				Properties: &catalogs.CatalogUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("catalog_adogit") {
+				payload.Properties.AdoGit = expandDevCenterCatalogGitCatalog(model.CatalogAdoGit)
+			}
+
+			if metadata.ResourceData.HasChange("catalog_github") {
+				payload.Properties.GitHub = expandDevCenterCatalogGitCatalog(model.CatalogGitHub)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			// unlike during creation, changes to the repository configuration aren't picked up until the Catalog is synced
+			if err := client.SyncThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("syncing %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterCatalogResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.CatalogsClient
+
+			id, err := catalogs.ParseDevCenterCatalogID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func devCenterCatalogGitCatalogSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"catalog_adogit", "catalog_github"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"uri": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsURLWithHTTPS,
+				},
+
+				"branch": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"key_vault_secret_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				},
+			},
+		},
+	}
+}
+
+func expandDevCenterCatalogGitCatalog(input []DevCenterCatalogGitCatalog) *catalogs.GitCatalog {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	return &catalogs.GitCatalog{
+		Branch:           utils.String(v.Branch),
+		Path:             utils.String(v.Path),
+		SecretIdentifier: utils.String(v.KeyVaultSecretId),
+		Uri:              utils.String(v.Uri),
+	}
+}
+
+func flattenDevCenterCatalogGitCatalog(input *catalogs.GitCatalog) []DevCenterCatalogGitCatalog {
+	if input == nil {
+		return make([]DevCenterCatalogGitCatalog, 0)
+	}
+
+	return []DevCenterCatalogGitCatalog{
+		{
+			Branch:           utils.NormalizeNilableString(input.Branch),
+			KeyVaultSecretId: utils.NormalizeNilableString(input.SecretIdentifier),
+			Path:             utils.NormalizeNilableString(input.Path),
+			Uri:              utils.NormalizeNilableString(input.Uri),
+		},
+	}
+}
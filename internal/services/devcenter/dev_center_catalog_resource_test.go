@@ -0,0 +1,180 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/catalogs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterCatalogResource struct {
+	repositoryUri    string
+	keyVaultSecretId string
+}
+
+// a Catalog syncs from a private Git repository, which requires a Personal Access Token stored in a Key Vault
+// Secret which the Dev Center's identity can read
+func newDevCenterCatalogResource(t *testing.T) DevCenterCatalogResource {
+	r := DevCenterCatalogResource{
+		repositoryUri:    os.Getenv("ARM_TEST_DEV_CENTER_CATALOG_REPOSITORY_URI"),
+		keyVaultSecretId: os.Getenv("ARM_TEST_DEV_CENTER_CATALOG_KEY_VAULT_SECRET_ID"),
+	}
+	if r.repositoryUri == "" || r.keyVaultSecretId == "" {
+		t.Skip("Skipping as ARM_TEST_DEV_CENTER_CATALOG_REPOSITORY_URI and/or ARM_TEST_DEV_CENTER_CATALOG_KEY_VAULT_SECRET_ID are not specified")
+	}
+
+	return r
+}
+
+func TestAccDevCenterCatalog_basic(t *testing.T) {
+	r := newDevCenterCatalogResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_catalog", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterCatalog_requiresImport(t *testing.T) {
+	r := newDevCenterCatalogResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_catalog", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterCatalog_complete(t *testing.T) {
+	r := newDevCenterCatalogResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_catalog", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterCatalog_update(t *testing.T) {
+	r := newDevCenterCatalogResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_catalog", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DevCenterCatalogResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := catalogs.ParseDevCenterCatalogID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.CatalogsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevCenterCatalogResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_catalog" "test" {
+  name          = "acctest-catalog-%d"
+  dev_center_id = azurerm_dev_center.test.id
+
+  catalog_github {
+    uri                 = "%s"
+    branch              = "main"
+    path                = "/Environments"
+    key_vault_secret_id = "%s"
+  }
+}
+`, DevCenterResource{}.complete(data), data.RandomInteger, r.repositoryUri, r.keyVaultSecretId)
+}
+
+func (r DevCenterCatalogResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_catalog" "import" {
+  name          = azurerm_dev_center_catalog.test.name
+  dev_center_id = azurerm_dev_center_catalog.test.dev_center_id
+
+  catalog_github {
+    uri                 = azurerm_dev_center_catalog.test.catalog_github.0.uri
+    branch              = azurerm_dev_center_catalog.test.catalog_github.0.branch
+    path                = azurerm_dev_center_catalog.test.catalog_github.0.path
+    key_vault_secret_id = azurerm_dev_center_catalog.test.catalog_github.0.key_vault_secret_id
+  }
+}
+`, r.basic(data))
+}
+
+func (r DevCenterCatalogResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_catalog" "test" {
+  name          = "acctest-catalog-%d"
+  dev_center_id = azurerm_dev_center.test.id
+
+  catalog_github {
+    uri                 = "%s"
+    branch              = "main"
+    path                = "/Templates"
+    key_vault_secret_id = "%s"
+  }
+}
+`, DevCenterResource{}.complete(data), data.RandomInteger, r.repositoryUri, r.keyVaultSecretId)
+}
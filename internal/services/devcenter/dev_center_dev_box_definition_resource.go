@@ -0,0 +1,263 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterDevBoxDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = DevCenterDevBoxDefinitionResource{}
+
+type DevCenterDevBoxDefinitionResourceModel struct {
+	Name                    string            `tfschema:"name"`
+	Location                string            `tfschema:"location"`
+	DevCenterId             string            `tfschema:"dev_center_id"`
+	ImageReferenceId        string            `tfschema:"image_reference_id"`
+	SkuName                 string            `tfschema:"sku_name"`
+	HibernateSupportEnabled bool              `tfschema:"hibernate_support_enabled"`
+	Tags                    map[string]string `tfschema:"tags"`
+}
+
+func (r DevCenterDevBoxDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterChildName,
+		},
+
+		"location": commonschema.Location(),
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devcenters.ValidateDevCenterID,
+		},
+
+		"image_reference_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"hibernate_support_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterDevBoxDefinitionResource) ModelObject() interface{} {
+	return &DevCenterDevBoxDefinitionResourceModel{}
+}
+
+func (r DevCenterDevBoxDefinitionResource) ResourceType() string {
+	return "azurerm_dev_center_dev_box_definition"
+}
+
+func (r DevCenterDevBoxDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return devboxdefinitions.ValidateDevCenterDevBoxDefinitionID
+}
+
+func (r DevCenterDevBoxDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			var model DevCenterDevBoxDefinitionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			devCenterId, err := devcenters.ParseDevCenterID(model.DevCenterId)
+			if err != nil {
+				return err
+			}
+
+			id := devboxdefinitions.NewDevCenterDevBoxDefinitionID(devCenterId.SubscriptionId, devCenterId.ResourceGroupName, devCenterId.DevCenterName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := devboxdefinitions.DevBoxDefinition{
+				Location: location.Normalize(model.Location),
+				Properties: &devboxdefinitions.DevBoxDefinitionProperties{
+					HibernateSupport: expandDevCenterDevBoxDefinitionHibernateSupport(model.HibernateSupportEnabled),
+					ImageReference: &devboxdefinitions.ImageReference{
+						Id: utils.String(model.ImageReferenceId),
+					},
+					Sku: &devboxdefinitions.Sku{
+						Name: model.SkuName,
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterDevBoxDefinitionResourceModel{
+				Name:        id.DevBoxDefinitionName,
+				DevCenterId: devcenters.NewDevCenterID(id.SubscriptionId, id.ResourceGroupName, id.DevCenterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					if props.HibernateSupport != nil {
+						state.HibernateSupportEnabled = *props.HibernateSupport == devboxdefinitions.HibernateSupportEnabled
+					}
+					if props.ImageReference != nil {
+						state.ImageReferenceId = utils.NormalizeNilableString(props.ImageReference.Id)
+					}
+					if props.Sku != nil {
+						state.SkuName = props.Sku.Name
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterDevBoxDefinitionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := devboxdefinitions.DevBoxDefinitionUpdate{
+				Properties: &devboxdefinitions.DevBoxDefinitionUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("hibernate_support_enabled") {
+				payload.Properties.HibernateSupport = expandDevCenterDevBoxDefinitionHibernateSupport(model.HibernateSupportEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("image_reference_id") {
+				payload.Properties.ImageReference = &devboxdefinitions.ImageReference{
+					Id: utils.String(model.ImageReferenceId),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("sku_name") {
+				payload.Properties.Sku = &devboxdefinitions.Sku{
+					Name: model.SkuName,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterDevBoxDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.DevBoxDefinitionsClient
+
+			id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterDevBoxDefinitionHibernateSupport(input bool) *devboxdefinitions.HibernateSupport {
+	hibernateSupport := devboxdefinitions.HibernateSupportDisabled
+	if input {
+		hibernateSupport = devboxdefinitions.HibernateSupportEnabled
+	}
+	return &hibernateSupport
+}
@@ -0,0 +1,155 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devboxdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterDevBoxDefinitionResource struct{}
+
+func TestAccDevCenterDevBoxDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_dev_box_definition", "test")
+	r := DevCenterDevBoxDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterDevBoxDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_dev_box_definition", "test")
+	r := DevCenterDevBoxDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterDevBoxDefinition_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_dev_box_definition", "test")
+	r := DevCenterDevBoxDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterDevBoxDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_dev_box_definition", "test")
+	r := DevCenterDevBoxDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DevCenterDevBoxDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := devboxdefinitions.ParseDevCenterDevBoxDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.DevBoxDefinitionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevCenterDevBoxDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_dev_box_definition" "test" {
+  name               = "acctest-dbd-%d"
+  location           = azurerm_resource_group.test.location
+  dev_center_id      = azurerm_dev_center.test.id
+  image_reference_id = "${azurerm_dev_center.test.id}/galleries/default/images/microsoftwindowsdesktop_windows-ent-cpc_win11-22h2-ent-cpc-os"
+  sku_name           = "general_i_8c32gb256ssd_v2"
+}
+`, DevCenterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r DevCenterDevBoxDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_dev_box_definition" "import" {
+  name               = azurerm_dev_center_dev_box_definition.test.name
+  location           = azurerm_dev_center_dev_box_definition.test.location
+  dev_center_id      = azurerm_dev_center_dev_box_definition.test.dev_center_id
+  image_reference_id = azurerm_dev_center_dev_box_definition.test.image_reference_id
+  sku_name           = azurerm_dev_center_dev_box_definition.test.sku_name
+}
+`, r.basic(data))
+}
+
+func (r DevCenterDevBoxDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_dev_box_definition" "test" {
+  name                      = "acctest-dbd-%d"
+  location                  = azurerm_resource_group.test.location
+  dev_center_id             = azurerm_dev_center.test.id
+  image_reference_id        = "${azurerm_dev_center.test.id}/galleries/default/images/microsoftvisualstudio_visualstudioplustools_vs-2022-ent-general-win11-m365-gen2"
+  sku_name                  = "general_i_8c32gb512ssd_v2"
+  hibernate_support_enabled = true
+
+  tags = {
+    environment = "test"
+  }
+}
+`, DevCenterResource{}.basic(data), data.RandomInteger)
+}
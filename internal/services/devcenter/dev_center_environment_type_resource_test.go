@@ -0,0 +1,145 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/environmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterEnvironmentTypeResource struct{}
+
+func TestAccDevCenterEnvironmentType_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_environment_type", "test")
+	r := DevCenterEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterEnvironmentType_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_environment_type", "test")
+	r := DevCenterEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterEnvironmentType_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_environment_type", "test")
+	r := DevCenterEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterEnvironmentType_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_environment_type", "test")
+	r := DevCenterEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DevCenterEnvironmentTypeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := environmenttypes.ParseDevCenterEnvironmentTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.EnvironmentTypesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevCenterEnvironmentTypeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_environment_type" "test" {
+  name          = "acctest-et-%d"
+  dev_center_id = azurerm_dev_center.test.id
+}
+`, DevCenterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r DevCenterEnvironmentTypeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_environment_type" "import" {
+  name          = azurerm_dev_center_environment_type.test.name
+  dev_center_id = azurerm_dev_center_environment_type.test.dev_center_id
+}
+`, r.basic(data))
+}
+
+func (r DevCenterEnvironmentTypeResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_environment_type" "test" {
+  name          = "acctest-et-%d"
+  dev_center_id = azurerm_dev_center.test.id
+
+  tags = {
+    environment = "test"
+  }
+}
+`, DevCenterResource{}.basic(data), data.RandomInteger)
+}
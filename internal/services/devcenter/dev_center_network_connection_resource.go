@@ -0,0 +1,312 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/networkconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterNetworkConnectionResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = DevCenterNetworkConnectionResource{}
+	_ sdk.ResourceWithCustomizeDiff = DevCenterNetworkConnectionResource{}
+)
+
+type DevCenterNetworkConnectionResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	DomainJoinType    string            `tfschema:"domain_join_type"`
+	SubnetId          string            `tfschema:"subnet_id"`
+	DomainName        string            `tfschema:"domain_name"`
+	DomainPassword    string            `tfschema:"domain_password"`
+	DomainUsername    string            `tfschema:"domain_username"`
+	OrganizationUnit  string            `tfschema:"organization_unit"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r DevCenterNetworkConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterChildName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"domain_join_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networkconnections.PossibleValuesForDomainJoinType(), false),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"domain_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"domain_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"domain_username"},
+		},
+
+		"domain_username": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"domain_password"},
+		},
+
+		"organization_unit": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterNetworkConnectionResource) ModelObject() interface{} {
+	return &DevCenterNetworkConnectionResourceModel{}
+}
+
+func (r DevCenterNetworkConnectionResource) ResourceType() string {
+	return "azurerm_dev_center_network_connection"
+}
+
+func (r DevCenterNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkconnections.ValidateNetworkConnectionID
+}
+
+func (r DevCenterNetworkConnectionResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model DevCenterNetworkConnectionResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// joining the Dev Boxes to an on-premises Active Directory requires the details of the Domain to join
+			if model.DomainJoinType == string(networkconnections.DomainJoinTypeHybridAzureADJoin) && (model.DomainName == "" || model.DomainUsername == "") {
+				return fmt.Errorf("`domain_name`, `domain_username` and `domain_password` must be specified when `domain_join_type` is `%s`", string(networkconnections.DomainJoinTypeHybridAzureADJoin))
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DevCenterNetworkConnectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := networkconnections.NewNetworkConnectionID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networkconnections.NetworkConnection{
+				Location: location.Normalize(model.Location),
+				Properties: &networkconnections.NetworkProperties{
+					DomainJoinType: networkconnections.DomainJoinType(model.DomainJoinType),
+					SubnetId:       utils.String(model.SubnetId),
+				},
+				Tags: &model.Tags,
+			}
+			if model.DomainName != "" {
+				payload.Properties.DomainName = utils.String(model.DomainName)
+			}
+			if model.DomainPassword != "" {
+				payload.Properties.DomainPassword = utils.String(model.DomainPassword)
+			}
+			if model.DomainUsername != "" {
+				payload.Properties.DomainUsername = utils.String(model.DomainUsername)
+			}
+			if model.OrganizationUnit != "" {
+				payload.Properties.OrganizationUnit = utils.String(model.OrganizationUnit)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterNetworkConnectionResourceModel{
+				Name:              id.NetworkConnectionName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			// the API doesn't return the `domain_password`, so this is pulled from the config
+			var config DevCenterNetworkConnectionResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.DomainPassword = config.DomainPassword
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.DomainJoinType = string(props.DomainJoinType)
+					state.DomainName = utils.NormalizeNilableString(props.DomainName)
+					state.DomainUsername = utils.NormalizeNilableString(props.DomainUsername)
+					state.OrganizationUnit = utils.NormalizeNilableString(props.OrganizationUnit)
+
+					if props.SubnetId != nil {
+						subnetId, err := networkParse.SubnetIDInsensitively(*props.SubnetId)
+						if err != nil {
+							return err
+						}
+						state.SubnetId = subnetId.ID()
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterNetworkConnectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := networkconnections.NetworkConnectionUpdate{
+				Properties: &networkconnections.NetworkConnectionUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("domain_name") {
+				payload.Properties.DomainName = utils.String(model.DomainName)
+			}
+
+			// the password is write-only, so it's sent alongside the username whenever either changes
+			if metadata.ResourceData.HasChanges("domain_password", "domain_username") {
+				payload.Properties.DomainPassword = utils.String(model.DomainPassword)
+				payload.Properties.DomainUsername = utils.String(model.DomainUsername)
+			}
+
+			if metadata.ResourceData.HasChange("organization_unit") {
+				payload.Properties.OrganizationUnit = utils.String(model.OrganizationUnit)
+			}
+
+			if metadata.ResourceData.HasChange("subnet_id") {
+				payload.Properties.SubnetId = utils.String(model.SubnetId)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterNetworkConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.NetworkConnectionsClient
+
+			id, err := networkconnections.ParseNetworkConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,346 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projectenvironmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectEnvironmentTypeResource struct{}
+
+var _ sdk.ResourceWithUpdate = DevCenterProjectEnvironmentTypeResource{}
+
+type DevCenterProjectEnvironmentTypeResourceModel struct {
+	Name                       string                                          `tfschema:"name"`
+	Location                   string                                          `tfschema:"location"`
+	DevCenterProjectId         string                                          `tfschema:"dev_center_project_id"`
+	DeploymentTargetId         string                                          `tfschema:"deployment_target_id"`
+	CreatorRoleAssignmentRoles []string                                        `tfschema:"creator_role_assignment_roles"`
+	UserRoleAssignment         []DevCenterProjectEnvironmentTypeUserAssignment `tfschema:"user_role_assignment"`
+	Tags                       map[string]string                               `tfschema:"tags"`
+}
+
+type DevCenterProjectEnvironmentTypeUserAssignment struct {
+	UserId string   `tfschema:"user_id"`
+	Roles  []string `tfschema:"roles"`
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		// the name of a Project Environment Type must match the name of an Environment Type within the Dev Center
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterChildName,
+		},
+
+		"location": commonschema.Location(),
+
+		"dev_center_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: projects.ValidateProjectID,
+		},
+
+		"deployment_target_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateSubscriptionID,
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+		"creator_role_assignment_roles": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"user_role_assignment": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"user_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+
+					"roles": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.IsUUID,
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) ModelObject() interface{} {
+	return &DevCenterProjectEnvironmentTypeResourceModel{}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) ResourceType() string {
+	return "azurerm_dev_center_project_environment_type"
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return projectenvironmenttypes.ValidateProjectEnvironmentTypeID
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectEnvironmentTypesClient
+
+			var model DevCenterProjectEnvironmentTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			projectId, err := projects.ParseProjectID(model.DevCenterProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := projectenvironmenttypes.NewProjectEnvironmentTypeID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.ProjectName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			status := projectenvironmenttypes.EnvironmentTypeEnableStatusEnabled
+			payload := projectenvironmenttypes.ProjectEnvironmentType{
+				Identity: identityValue,
+				Location: utils.String(location.Normalize(model.Location)),
+				Properties: &projectenvironmenttypes.ProjectEnvironmentTypeProperties{
+					CreatorRoleAssignment: &projectenvironmenttypes.ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment{
+						Roles: expandDevCenterProjectEnvironmentTypeRoles(model.CreatorRoleAssignmentRoles),
+					},
+					DeploymentTargetId:  utils.String(model.DeploymentTargetId),
+					Status:              &status,
+					UserRoleAssignments: expandDevCenterProjectEnvironmentTypeUserRoleAssignments(model.UserRoleAssignment),
+				},
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectEnvironmentTypesClient
+
+			id, err := projectenvironmenttypes.ParseProjectEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectEnvironmentTypeResourceModel{
+				Name:               id.EnvironmentTypeName,
+				DevCenterProjectId: projects.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.ProjectName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					state.DeploymentTargetId = utils.NormalizeNilableString(props.DeploymentTargetId)
+					if props.CreatorRoleAssignment != nil {
+						state.CreatorRoleAssignmentRoles = flattenDevCenterProjectEnvironmentTypeRoles(props.CreatorRoleAssignment.Roles)
+					}
+					state.UserRoleAssignment = flattenDevCenterProjectEnvironmentTypeUserRoleAssignments(props.UserRoleAssignments)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectEnvironmentTypesClient
+
+			id, err := projectenvironmenttypes.ParseProjectEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectEnvironmentTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := projectenvironmenttypes.ProjectEnvironmentTypeUpdate{
+				Properties: &projectenvironmenttypes.ProjectEnvironmentTypeUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			if metadata.ResourceData.HasChange("deployment_target_id") {
+				payload.Properties.DeploymentTargetId = utils.String(model.DeploymentTargetId)
+			}
+
+			if metadata.ResourceData.HasChange("creator_role_assignment_roles") {
+				payload.Properties.CreatorRoleAssignment = &projectenvironmenttypes.ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment{
+					Roles: expandDevCenterProjectEnvironmentTypeRoles(model.CreatorRoleAssignmentRoles),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("user_role_assignment") {
+				payload.Properties.UserRoleAssignments = expandDevCenterProjectEnvironmentTypeUserRoleAssignments(model.UserRoleAssignment)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectEnvironmentTypesClient
+
+			id, err := projectenvironmenttypes.ParseProjectEnvironmentTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDevCenterProjectEnvironmentTypeRoles(input []string) *map[string]projectenvironmenttypes.EnvironmentRole {
+	output := make(map[string]projectenvironmenttypes.EnvironmentRole)
+	for _, v := range input {
+		// the details of the Role are read-only, so only the Role Definition ID is sent
+		output[v] = projectenvironmenttypes.EnvironmentRole{}
+	}
+	return &output
+}
+
+func flattenDevCenterProjectEnvironmentTypeRoles(input *map[string]projectenvironmenttypes.EnvironmentRole) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for k := range *input {
+		output = append(output, k)
+	}
+	return output
+}
+
+func expandDevCenterProjectEnvironmentTypeUserRoleAssignments(input []DevCenterProjectEnvironmentTypeUserAssignment) *map[string]projectenvironmenttypes.UserRoleAssignmentValue {
+	output := make(map[string]projectenvironmenttypes.UserRoleAssignmentValue)
+	for _, v := range input {
+		output[v.UserId] = projectenvironmenttypes.UserRoleAssignmentValue{
+			Roles: expandDevCenterProjectEnvironmentTypeRoles(v.Roles),
+		}
+	}
+	return &output
+}
+
+func flattenDevCenterProjectEnvironmentTypeUserRoleAssignments(input *map[string]projectenvironmenttypes.UserRoleAssignmentValue) []DevCenterProjectEnvironmentTypeUserAssignment {
+	output := make([]DevCenterProjectEnvironmentTypeUserAssignment, 0)
+	if input == nil {
+		return output
+	}
+
+	for userId, v := range *input {
+		output = append(output, DevCenterProjectEnvironmentTypeUserAssignment{
+			UserId: userId,
+			Roles:  flattenDevCenterProjectEnvironmentTypeRoles(v.Roles),
+		})
+	}
+	return output
+}
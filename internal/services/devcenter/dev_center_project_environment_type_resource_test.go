@@ -0,0 +1,201 @@
+package devcenter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projectenvironmenttypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectEnvironmentTypeResource struct{}
+
+func TestAccDevCenterProjectEnvironmentType_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectEnvironmentType_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDevCenterProjectEnvironmentType_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDevCenterProjectEnvironmentType_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_project_environment_type", "test")
+	r := DevCenterProjectEnvironmentTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DevCenterProjectEnvironmentTypeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := projectenvironmenttypes.ParseProjectEnvironmentTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DevCenter.ProjectEnvironmentTypesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_dev_center_project" "test" {
+  name                = "acctestdcp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  dev_center_id       = azurerm_dev_center.test.id
+}
+
+resource "azurerm_dev_center_environment_type" "test" {
+  name          = "acctest-et-%[2]d"
+  dev_center_id = azurerm_dev_center.test.id
+}
+`, DevCenterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_environment_type" "test" {
+  name                  = azurerm_dev_center_environment_type.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = azurerm_dev_center_project.test.id
+  deployment_target_id  = data.azurerm_subscription.current.id
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.template(data))
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dev_center_project_environment_type" "import" {
+  name                  = azurerm_dev_center_project_environment_type.test.name
+  location              = azurerm_dev_center_project_environment_type.test.location
+  dev_center_project_id = azurerm_dev_center_project_environment_type.test.dev_center_project_id
+  deployment_target_id  = azurerm_dev_center_project_environment_type.test.deployment_target_id
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DevCenterProjectEnvironmentTypeResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_dev_center_project_environment_type" "test" {
+  name                  = azurerm_dev_center_environment_type.test.name
+  location              = azurerm_resource_group.test.location
+  dev_center_project_id = azurerm_dev_center_project.test.id
+  deployment_target_id  = data.azurerm_subscription.current.id
+
+  identity {
+    type         = "SystemAssigned, UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  # Contributor
+  creator_role_assignment_roles = ["b24988ac-6180-42a0-ab88-20f7382dd24c"]
+
+  user_role_assignment {
+    user_id = data.azurerm_client_config.current.object_id
+    # Reader
+    roles = ["acdd72a7-3385-48ef-bd42-f606fba81ae7"]
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
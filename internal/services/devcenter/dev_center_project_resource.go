@@ -0,0 +1,243 @@
+package devcenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/devcenters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/sdk/2023-04-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DevCenterProjectResource struct{}
+
+var _ sdk.ResourceWithUpdate = DevCenterProjectResource{}
+
+type DevCenterProjectResourceModel struct {
+	Name                   string            `tfschema:"name"`
+	ResourceGroupName      string            `tfschema:"resource_group_name"`
+	Location               string            `tfschema:"location"`
+	DevCenterId            string            `tfschema:"dev_center_id"`
+	Description            string            `tfschema:"description"`
+	MaximumDevBoxesPerUser int64             `tfschema:"maximum_dev_boxes_per_user"`
+	Tags                   map[string]string `tfschema:"tags"`
+	DevCenterUri           string            `tfschema:"dev_center_uri"`
+}
+
+func (r DevCenterProjectResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DevCenterChildName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"dev_center_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: devcenters.ValidateDevCenterID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"maximum_dev_boxes_per_user": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DevCenterProjectResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dev_center_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DevCenterProjectResource) ModelObject() interface{} {
+	return &DevCenterProjectResourceModel{}
+}
+
+func (r DevCenterProjectResource) ResourceType() string {
+	return "azurerm_dev_center_project"
+}
+
+func (r DevCenterProjectResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return projects.ValidateProjectID
+}
+
+func (r DevCenterProjectResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DevCenterProjectResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := projects.NewProjectID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := projects.Project{
+				Location: location.Normalize(model.Location),
+				Properties: &projects.ProjectProperties{
+					DevCenterId: model.DevCenterId,
+				},
+				Tags: &model.Tags,
+			}
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+			if model.MaximumDevBoxesPerUser != 0 {
+				payload.Properties.MaxDevBoxesPerUser = utils.Int64(model.MaximumDevBoxesPerUser)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectsClient
+
+			id, err := projects.ParseProjectID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DevCenterProjectResourceModel{
+				Name:              id.ProjectName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					devCenterId, err := devcenters.ParseDevCenterIDInsensitively(props.DevCenterId)
+					if err != nil {
+						return err
+					}
+					state.DevCenterId = devCenterId.ID()
+					state.Description = utils.NormalizeNilableString(props.Description)
+					state.DevCenterUri = utils.NormalizeNilableString(props.DevCenterUri)
+					state.MaximumDevBoxesPerUser = utils.NormaliseNilableInt64(props.MaxDevBoxesPerUser)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DevCenterProjectResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectsClient
+
+			id, err := projects.ParseProjectID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DevCenterProjectResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := projects.ProjectUpdate{
+				Properties: &projects.ProjectUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("maximum_dev_boxes_per_user") {
+				payload.Properties.MaxDevBoxesPerUser = utils.Int64(model.MaximumDevBoxesPerUser)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DevCenterProjectResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DevCenter.ProjectsClient
+
+			id, err := projects.ParseProjectID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
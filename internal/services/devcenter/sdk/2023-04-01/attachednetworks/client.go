@@ -0,0 +1,15 @@
+package attachednetworks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AttachedNetworksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAttachedNetworksClientWithBaseURI(endpoint string) AttachedNetworksClient {
+	return AttachedNetworksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,141 @@
+package attachednetworks
+
+import "strings"
+
+type DomainJoinType string
+
+const (
+	DomainJoinTypeAzureADJoin       DomainJoinType = "AzureADJoin"
+	DomainJoinTypeHybridAzureADJoin DomainJoinType = "HybridAzureADJoin"
+)
+
+func PossibleValuesForDomainJoinType() []string {
+	return []string{
+		string(DomainJoinTypeAzureADJoin),
+		string(DomainJoinTypeHybridAzureADJoin),
+	}
+}
+
+func parseDomainJoinType(input string) (*DomainJoinType, error) {
+	vals := map[string]DomainJoinType{
+		"azureadjoin":       DomainJoinTypeAzureADJoin,
+		"hybridazureadjoin": DomainJoinTypeHybridAzureADJoin,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DomainJoinType(input)
+	return &out, nil
+}
+
+type HealthCheckStatus string
+
+const (
+	HealthCheckStatusFailed  HealthCheckStatus = "Failed"
+	HealthCheckStatusPassed  HealthCheckStatus = "Passed"
+	HealthCheckStatusPending HealthCheckStatus = "Pending"
+	HealthCheckStatusRunning HealthCheckStatus = "Running"
+	HealthCheckStatusUnknown HealthCheckStatus = "Unknown"
+	HealthCheckStatusWarning HealthCheckStatus = "Warning"
+)
+
+func PossibleValuesForHealthCheckStatus() []string {
+	return []string{
+		string(HealthCheckStatusFailed),
+		string(HealthCheckStatusPassed),
+		string(HealthCheckStatusPending),
+		string(HealthCheckStatusRunning),
+		string(HealthCheckStatusUnknown),
+		string(HealthCheckStatusWarning),
+	}
+}
+
+func parseHealthCheckStatus(input string) (*HealthCheckStatus, error) {
+	vals := map[string]HealthCheckStatus{
+		"failed":  HealthCheckStatusFailed,
+		"passed":  HealthCheckStatusPassed,
+		"pending": HealthCheckStatusPending,
+		"running": HealthCheckStatusRunning,
+		"unknown": HealthCheckStatusUnknown,
+		"warning": HealthCheckStatusWarning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HealthCheckStatus(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted                  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled                  ProvisioningState = "Canceled"
+	ProvisioningStateCreated                   ProvisioningState = "Created"
+	ProvisioningStateCreating                  ProvisioningState = "Creating"
+	ProvisioningStateDeleted                   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting                  ProvisioningState = "Deleting"
+	ProvisioningStateFailed                    ProvisioningState = "Failed"
+	ProvisioningStateMovingResources           ProvisioningState = "MovingResources"
+	ProvisioningStateNotSpecified              ProvisioningState = "NotSpecified"
+	ProvisioningStateRolloutInProgress         ProvisioningState = "RolloutInProgress"
+	ProvisioningStateRunning                   ProvisioningState = "Running"
+	ProvisioningStateStorageProvisioningFailed ProvisioningState = "StorageProvisioningFailed"
+	ProvisioningStateSucceeded                 ProvisioningState = "Succeeded"
+	ProvisioningStateTransientFailure          ProvisioningState = "TransientFailure"
+	ProvisioningStateUpdated                   ProvisioningState = "Updated"
+	ProvisioningStateUpdating                  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreated),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMovingResources),
+		string(ProvisioningStateNotSpecified),
+		string(ProvisioningStateRolloutInProgress),
+		string(ProvisioningStateRunning),
+		string(ProvisioningStateStorageProvisioningFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateTransientFailure),
+		string(ProvisioningStateUpdated),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":                  ProvisioningStateAccepted,
+		"canceled":                  ProvisioningStateCanceled,
+		"created":                   ProvisioningStateCreated,
+		"creating":                  ProvisioningStateCreating,
+		"deleted":                   ProvisioningStateDeleted,
+		"deleting":                  ProvisioningStateDeleting,
+		"failed":                    ProvisioningStateFailed,
+		"movingresources":           ProvisioningStateMovingResources,
+		"notspecified":              ProvisioningStateNotSpecified,
+		"rolloutinprogress":         ProvisioningStateRolloutInProgress,
+		"running":                   ProvisioningStateRunning,
+		"storageprovisioningfailed": ProvisioningStateStorageProvisioningFailed,
+		"succeeded":                 ProvisioningStateSucceeded,
+		"transientfailure":          ProvisioningStateTransientFailure,
+		"updated":                   ProvisioningStateUpdated,
+		"updating":                  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
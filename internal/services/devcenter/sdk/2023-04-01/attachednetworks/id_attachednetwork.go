@@ -0,0 +1,137 @@
+package attachednetworks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = AttachedNetworkId{}
+
+// AttachedNetworkId is a struct representing the Resource ID for a Attached Network
+type AttachedNetworkId struct {
+	SubscriptionId                string
+	ResourceGroupName             string
+	DevCenterName                 string
+	AttachedNetworkConnectionName string
+}
+
+// NewAttachedNetworkID returns a new AttachedNetworkId struct
+func NewAttachedNetworkID(subscriptionId string, resourceGroupName string, devCenterName string, attachedNetworkConnectionName string) AttachedNetworkId {
+	return AttachedNetworkId{
+		SubscriptionId:                subscriptionId,
+		ResourceGroupName:             resourceGroupName,
+		DevCenterName:                 devCenterName,
+		AttachedNetworkConnectionName: attachedNetworkConnectionName,
+	}
+}
+
+// ParseAttachedNetworkID parses 'input' into a AttachedNetworkId
+func ParseAttachedNetworkID(input string) (*AttachedNetworkId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AttachedNetworkId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AttachedNetworkId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DevCenterName, ok = parsed.Parsed["devCenterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devCenterName' was not found in the resource id %q", input)
+	}
+
+	if id.AttachedNetworkConnectionName, ok = parsed.Parsed["attachedNetworkConnectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'attachedNetworkConnectionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseAttachedNetworkIDInsensitively parses 'input' case-insensitively into a AttachedNetworkId
+// note: this method should only be used for API response data and not user input
+func ParseAttachedNetworkIDInsensitively(input string) (*AttachedNetworkId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AttachedNetworkId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AttachedNetworkId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.DevCenterName, ok = parsed.Parsed["devCenterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'devCenterName' was not found in the resource id %q", input)
+	}
+
+	if id.AttachedNetworkConnectionName, ok = parsed.Parsed["attachedNetworkConnectionName"]; !ok {
+		return nil, fmt.Errorf("the segment 'attachedNetworkConnectionName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateAttachedNetworkID checks that 'input' can be parsed as a Attached Network ID
+func ValidateAttachedNetworkID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAttachedNetworkID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Attached Network ID
+func (id AttachedNetworkId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/devCenters/%s/attachedNetworks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DevCenterName, id.AttachedNetworkConnectionName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Attached Network ID
+func (id AttachedNetworkId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevCenter", "Microsoft.DevCenter", "Microsoft.DevCenter"),
+		resourceids.StaticSegment("staticDevCenters", "devCenters", "devCenters"),
+		resourceids.UserSpecifiedSegment("devCenterName", "devCenterValue"),
+		resourceids.StaticSegment("staticAttachedNetworks", "attachedNetworks", "attachedNetworks"),
+		resourceids.UserSpecifiedSegment("attachedNetworkConnectionName", "attachedNetworkConnectionValue"),
+	}
+}
+
+// String returns a human-readable description of this Attached Network ID
+func (id AttachedNetworkId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Dev Center Name: %q", id.DevCenterName),
+		fmt.Sprintf("Attached Network Connection Name: %q", id.AttachedNetworkConnectionName),
+	}
+	return fmt.Sprintf("Attached Network (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package attachednetworks
+
+type AttachedNetworkConnection struct {
+	Id         *string                              `json:"id,omitempty"`
+	Name       *string                              `json:"name,omitempty"`
+	Properties *AttachedNetworkConnectionProperties `json:"properties,omitempty"`
+	Type       *string                              `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package attachednetworks
+
+type AttachedNetworkConnectionProperties struct {
+	DomainJoinType            *DomainJoinType    `json:"domainJoinType,omitempty"`
+	HealthCheckStatus         *HealthCheckStatus `json:"healthCheckStatus,omitempty"`
+	NetworkConnectionId       string             `json:"networkConnectionId"`
+	NetworkConnectionLocation *string            `json:"networkConnectionLocation,omitempty"`
+	ProvisioningState         *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,15 @@
+package catalogs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CatalogsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCatalogsClientWithBaseURI(endpoint string) CatalogsClient {
+	return CatalogsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
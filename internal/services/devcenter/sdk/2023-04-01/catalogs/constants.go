@@ -0,0 +1,107 @@
+package catalogs
+
+import "strings"
+
+type CatalogSyncState string
+
+const (
+	CatalogSyncStateCanceled   CatalogSyncState = "Canceled"
+	CatalogSyncStateFailed     CatalogSyncState = "Failed"
+	CatalogSyncStateInProgress CatalogSyncState = "InProgress"
+	CatalogSyncStateSucceeded  CatalogSyncState = "Succeeded"
+)
+
+func PossibleValuesForCatalogSyncState() []string {
+	return []string{
+		string(CatalogSyncStateCanceled),
+		string(CatalogSyncStateFailed),
+		string(CatalogSyncStateInProgress),
+		string(CatalogSyncStateSucceeded),
+	}
+}
+
+func parseCatalogSyncState(input string) (*CatalogSyncState, error) {
+	vals := map[string]CatalogSyncState{
+		"canceled":   CatalogSyncStateCanceled,
+		"failed":     CatalogSyncStateFailed,
+		"inprogress": CatalogSyncStateInProgress,
+		"succeeded":  CatalogSyncStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CatalogSyncState(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted                  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled                  ProvisioningState = "Canceled"
+	ProvisioningStateCreated                   ProvisioningState = "Created"
+	ProvisioningStateCreating                  ProvisioningState = "Creating"
+	ProvisioningStateDeleted                   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting                  ProvisioningState = "Deleting"
+	ProvisioningStateFailed                    ProvisioningState = "Failed"
+	ProvisioningStateMovingResources           ProvisioningState = "MovingResources"
+	ProvisioningStateNotSpecified              ProvisioningState = "NotSpecified"
+	ProvisioningStateRolloutInProgress         ProvisioningState = "RolloutInProgress"
+	ProvisioningStateRunning                   ProvisioningState = "Running"
+	ProvisioningStateStorageProvisioningFailed ProvisioningState = "StorageProvisioningFailed"
+	ProvisioningStateSucceeded                 ProvisioningState = "Succeeded"
+	ProvisioningStateTransientFailure          ProvisioningState = "TransientFailure"
+	ProvisioningStateUpdated                   ProvisioningState = "Updated"
+	ProvisioningStateUpdating                  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreated),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMovingResources),
+		string(ProvisioningStateNotSpecified),
+		string(ProvisioningStateRolloutInProgress),
+		string(ProvisioningStateRunning),
+		string(ProvisioningStateStorageProvisioningFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateTransientFailure),
+		string(ProvisioningStateUpdated),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":                  ProvisioningStateAccepted,
+		"canceled":                  ProvisioningStateCanceled,
+		"created":                   ProvisioningStateCreated,
+		"creating":                  ProvisioningStateCreating,
+		"deleted":                   ProvisioningStateDeleted,
+		"deleting":                  ProvisioningStateDeleting,
+		"failed":                    ProvisioningStateFailed,
+		"movingresources":           ProvisioningStateMovingResources,
+		"notspecified":              ProvisioningStateNotSpecified,
+		"rolloutinprogress":         ProvisioningStateRolloutInProgress,
+		"running":                   ProvisioningStateRunning,
+		"storageprovisioningfailed": ProvisioningStateStorageProvisioningFailed,
+		"succeeded":                 ProvisioningStateSucceeded,
+		"transientfailure":          ProvisioningStateTransientFailure,
+		"updated":                   ProvisioningStateUpdated,
+		"updating":                  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
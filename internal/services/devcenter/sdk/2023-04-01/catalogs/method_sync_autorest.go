@@ -0,0 +1,74 @@
+package catalogs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SyncResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Sync ...
+func (c CatalogsClient) Sync(ctx context.Context, id DevCenterCatalogId) (result SyncResponse, err error) {
+	req, err := c.preparerForSync(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "catalogs.CatalogsClient", "Sync", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSync(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "catalogs.CatalogsClient", "Sync", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SyncThenPoll performs Sync then polls until it's completed
+func (c CatalogsClient) SyncThenPoll(ctx context.Context, id DevCenterCatalogId) error {
+	result, err := c.Sync(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Sync: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Sync: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSync prepares the Sync request.
+func (c CatalogsClient) preparerForSync(ctx context.Context, id DevCenterCatalogId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/sync", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSync sends the Sync request. The method will close the
+// http.Response Body if it receives an error.
+func (c CatalogsClient) senderForSync(ctx context.Context, req *http.Request) (future SyncResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
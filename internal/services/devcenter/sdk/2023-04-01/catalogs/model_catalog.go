@@ -0,0 +1,8 @@
+package catalogs
+
+type Catalog struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *CatalogProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package catalogs
+
+type CatalogProperties struct {
+	AdoGit            *GitCatalog        `json:"adoGit,omitempty"`
+	GitHub            *GitCatalog        `json:"gitHub,omitempty"`
+	LastSyncTime      *string            `json:"lastSyncTime,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	SyncState         *CatalogSyncState  `json:"syncState,omitempty"`
+}
@@ -0,0 +1,5 @@
+package catalogs
+
+type CatalogUpdate struct {
+	Properties *CatalogUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,6 @@
+package catalogs
+
+type CatalogUpdateProperties struct {
+	AdoGit *GitCatalog `json:"adoGit,omitempty"`
+	GitHub *GitCatalog `json:"gitHub,omitempty"`
+}
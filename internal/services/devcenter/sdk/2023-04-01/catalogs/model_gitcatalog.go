@@ -0,0 +1,8 @@
+package catalogs
+
+type GitCatalog struct {
+	Branch           *string `json:"branch,omitempty"`
+	Path             *string `json:"path,omitempty"`
+	SecretIdentifier *string `json:"secretIdentifier,omitempty"`
+	Uri              *string `json:"uri,omitempty"`
+}
@@ -0,0 +1,15 @@
+package devboxdefinitions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DevBoxDefinitionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDevBoxDefinitionsClientWithBaseURI(endpoint string) DevBoxDefinitionsClient {
+	return DevBoxDefinitionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,172 @@
+package devboxdefinitions
+
+import "strings"
+
+type HibernateSupport string
+
+const (
+	HibernateSupportDisabled HibernateSupport = "Disabled"
+	HibernateSupportEnabled  HibernateSupport = "Enabled"
+)
+
+func PossibleValuesForHibernateSupport() []string {
+	return []string{
+		string(HibernateSupportDisabled),
+		string(HibernateSupportEnabled),
+	}
+}
+
+func parseHibernateSupport(input string) (*HibernateSupport, error) {
+	vals := map[string]HibernateSupport{
+		"disabled": HibernateSupportDisabled,
+		"enabled":  HibernateSupportEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HibernateSupport(input)
+	return &out, nil
+}
+
+type ImageValidationStatus string
+
+const (
+	ImageValidationStatusFailed    ImageValidationStatus = "Failed"
+	ImageValidationStatusPending   ImageValidationStatus = "Pending"
+	ImageValidationStatusSucceeded ImageValidationStatus = "Succeeded"
+	ImageValidationStatusTimedOut  ImageValidationStatus = "TimedOut"
+	ImageValidationStatusUnknown   ImageValidationStatus = "Unknown"
+)
+
+func PossibleValuesForImageValidationStatus() []string {
+	return []string{
+		string(ImageValidationStatusFailed),
+		string(ImageValidationStatusPending),
+		string(ImageValidationStatusSucceeded),
+		string(ImageValidationStatusTimedOut),
+		string(ImageValidationStatusUnknown),
+	}
+}
+
+func parseImageValidationStatus(input string) (*ImageValidationStatus, error) {
+	vals := map[string]ImageValidationStatus{
+		"failed":    ImageValidationStatusFailed,
+		"pending":   ImageValidationStatusPending,
+		"succeeded": ImageValidationStatusSucceeded,
+		"timedout":  ImageValidationStatusTimedOut,
+		"unknown":   ImageValidationStatusUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ImageValidationStatus(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted                  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled                  ProvisioningState = "Canceled"
+	ProvisioningStateCreated                   ProvisioningState = "Created"
+	ProvisioningStateCreating                  ProvisioningState = "Creating"
+	ProvisioningStateDeleted                   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting                  ProvisioningState = "Deleting"
+	ProvisioningStateFailed                    ProvisioningState = "Failed"
+	ProvisioningStateMovingResources           ProvisioningState = "MovingResources"
+	ProvisioningStateNotSpecified              ProvisioningState = "NotSpecified"
+	ProvisioningStateRolloutInProgress         ProvisioningState = "RolloutInProgress"
+	ProvisioningStateRunning                   ProvisioningState = "Running"
+	ProvisioningStateStorageProvisioningFailed ProvisioningState = "StorageProvisioningFailed"
+	ProvisioningStateSucceeded                 ProvisioningState = "Succeeded"
+	ProvisioningStateTransientFailure          ProvisioningState = "TransientFailure"
+	ProvisioningStateUpdated                   ProvisioningState = "Updated"
+	ProvisioningStateUpdating                  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreated),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMovingResources),
+		string(ProvisioningStateNotSpecified),
+		string(ProvisioningStateRolloutInProgress),
+		string(ProvisioningStateRunning),
+		string(ProvisioningStateStorageProvisioningFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateTransientFailure),
+		string(ProvisioningStateUpdated),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":                  ProvisioningStateAccepted,
+		"canceled":                  ProvisioningStateCanceled,
+		"created":                   ProvisioningStateCreated,
+		"creating":                  ProvisioningStateCreating,
+		"deleted":                   ProvisioningStateDeleted,
+		"deleting":                  ProvisioningStateDeleting,
+		"failed":                    ProvisioningStateFailed,
+		"movingresources":           ProvisioningStateMovingResources,
+		"notspecified":              ProvisioningStateNotSpecified,
+		"rolloutinprogress":         ProvisioningStateRolloutInProgress,
+		"running":                   ProvisioningStateRunning,
+		"storageprovisioningfailed": ProvisioningStateStorageProvisioningFailed,
+		"succeeded":                 ProvisioningStateSucceeded,
+		"transientfailure":          ProvisioningStateTransientFailure,
+		"updated":                   ProvisioningStateUpdated,
+		"updating":                  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type SkuTier string
+
+const (
+	SkuTierBasic    SkuTier = "Basic"
+	SkuTierFree     SkuTier = "Free"
+	SkuTierPremium  SkuTier = "Premium"
+	SkuTierStandard SkuTier = "Standard"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierBasic),
+		string(SkuTierFree),
+		string(SkuTierPremium),
+		string(SkuTierStandard),
+	}
+}
+
+func parseSkuTier(input string) (*SkuTier, error) {
+	vals := map[string]SkuTier{
+		"basic":    SkuTierBasic,
+		"free":     SkuTierFree,
+		"premium":  SkuTierPremium,
+		"standard": SkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuTier(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package devboxdefinitions
+
+type DevBoxDefinitionProperties struct {
+	ActiveImageReference  *ImageReference        `json:"activeImageReference,omitempty"`
+	HibernateSupport      *HibernateSupport      `json:"hibernateSupport,omitempty"`
+	ImageReference        *ImageReference        `json:"imageReference,omitempty"`
+	ImageValidationStatus *ImageValidationStatus `json:"imageValidationStatus,omitempty"`
+	OsStorageType         *string                `json:"osStorageType,omitempty"`
+	ProvisioningState     *ProvisioningState     `json:"provisioningState,omitempty"`
+	Sku                   *Sku                   `json:"sku,omitempty"`
+}
@@ -0,0 +1,7 @@
+package devboxdefinitions
+
+type DevBoxDefinitionUpdate struct {
+	Location   *string                           `json:"location,omitempty"`
+	Properties *DevBoxDefinitionUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+}
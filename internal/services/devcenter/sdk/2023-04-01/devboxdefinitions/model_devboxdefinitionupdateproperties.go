@@ -0,0 +1,8 @@
+package devboxdefinitions
+
+type DevBoxDefinitionUpdateProperties struct {
+	HibernateSupport *HibernateSupport `json:"hibernateSupport,omitempty"`
+	ImageReference   *ImageReference   `json:"imageReference,omitempty"`
+	OsStorageType    *string           `json:"osStorageType,omitempty"`
+	Sku              *Sku              `json:"sku,omitempty"`
+}
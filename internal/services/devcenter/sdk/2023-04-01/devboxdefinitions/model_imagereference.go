@@ -0,0 +1,6 @@
+package devboxdefinitions
+
+type ImageReference struct {
+	ExactVersion *string `json:"exactVersion,omitempty"`
+	Id           *string `json:"id,omitempty"`
+}
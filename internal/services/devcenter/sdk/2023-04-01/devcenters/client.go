@@ -0,0 +1,15 @@
+package devcenters
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DevCentersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDevCentersClientWithBaseURI(endpoint string) DevCentersClient {
+	return DevCentersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
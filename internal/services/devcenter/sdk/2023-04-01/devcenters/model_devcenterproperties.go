@@ -0,0 +1,6 @@
+package devcenters
+
+type DevCenterProperties struct {
+	DevCenterUri      *string            `json:"devCenterUri,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
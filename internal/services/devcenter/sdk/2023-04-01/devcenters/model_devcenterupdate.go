@@ -0,0 +1,11 @@
+package devcenters
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type DevCenterUpdate struct {
+	Identity *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location *string                            `json:"location,omitempty"`
+	Tags     *map[string]string                 `json:"tags,omitempty"`
+}
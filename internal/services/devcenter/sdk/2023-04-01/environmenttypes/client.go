@@ -0,0 +1,15 @@
+package environmenttypes
+
+import "github.com/Azure/go-autorest/autorest"
+
+type EnvironmentTypesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewEnvironmentTypesClientWithBaseURI(endpoint string) EnvironmentTypesClient {
+	return EnvironmentTypesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package environmenttypes
+
+type EnvironmentType struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *EnvironmentTypeProperties `json:"properties,omitempty"`
+	Tags       *map[string]string         `json:"tags,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
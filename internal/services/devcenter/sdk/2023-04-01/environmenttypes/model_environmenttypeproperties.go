@@ -0,0 +1,5 @@
+package environmenttypes
+
+type EnvironmentTypeProperties struct {
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,5 @@
+package environmenttypes
+
+type EnvironmentTypeUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,15 @@
+package networkconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkConnectionsClientWithBaseURI(endpoint string) NetworkConnectionsClient {
+	return NetworkConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
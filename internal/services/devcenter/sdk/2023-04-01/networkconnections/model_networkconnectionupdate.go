@@ -0,0 +1,7 @@
+package networkconnections
+
+type NetworkConnectionUpdate struct {
+	Location   *string                            `json:"location,omitempty"`
+	Properties *NetworkConnectionUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
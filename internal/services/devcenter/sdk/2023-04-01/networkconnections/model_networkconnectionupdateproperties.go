@@ -0,0 +1,9 @@
+package networkconnections
+
+type NetworkConnectionUpdateProperties struct {
+	DomainName       *string `json:"domainName,omitempty"`
+	DomainPassword   *string `json:"domainPassword,omitempty"`
+	DomainUsername   *string `json:"domainUsername,omitempty"`
+	OrganizationUnit *string `json:"organizationUnit,omitempty"`
+	SubnetId         *string `json:"subnetId,omitempty"`
+}
@@ -0,0 +1,191 @@
+package pools
+
+import "strings"
+
+type HealthStatus string
+
+const (
+	HealthStatusHealthy   HealthStatus = "Healthy"
+	HealthStatusPending   HealthStatus = "Pending"
+	HealthStatusUnhealthy HealthStatus = "Unhealthy"
+	HealthStatusUnknown   HealthStatus = "Unknown"
+	HealthStatusWarning   HealthStatus = "Warning"
+)
+
+func PossibleValuesForHealthStatus() []string {
+	return []string{
+		string(HealthStatusHealthy),
+		string(HealthStatusPending),
+		string(HealthStatusUnhealthy),
+		string(HealthStatusUnknown),
+		string(HealthStatusWarning),
+	}
+}
+
+func parseHealthStatus(input string) (*HealthStatus, error) {
+	vals := map[string]HealthStatus{
+		"healthy":   HealthStatusHealthy,
+		"pending":   HealthStatusPending,
+		"unhealthy": HealthStatusUnhealthy,
+		"unknown":   HealthStatusUnknown,
+		"warning":   HealthStatusWarning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HealthStatus(input)
+	return &out, nil
+}
+
+type LicenseType string
+
+const (
+	LicenseTypeWindowsClient LicenseType = "Windows_Client"
+)
+
+func PossibleValuesForLicenseType() []string {
+	return []string{
+		string(LicenseTypeWindowsClient),
+	}
+}
+
+func parseLicenseType(input string) (*LicenseType, error) {
+	vals := map[string]LicenseType{
+		"windows_client": LicenseTypeWindowsClient,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseType(input)
+	return &out, nil
+}
+
+type LocalAdminStatus string
+
+const (
+	LocalAdminStatusDisabled LocalAdminStatus = "Disabled"
+	LocalAdminStatusEnabled  LocalAdminStatus = "Enabled"
+)
+
+func PossibleValuesForLocalAdminStatus() []string {
+	return []string{
+		string(LocalAdminStatusDisabled),
+		string(LocalAdminStatusEnabled),
+	}
+}
+
+func parseLocalAdminStatus(input string) (*LocalAdminStatus, error) {
+	vals := map[string]LocalAdminStatus{
+		"disabled": LocalAdminStatusDisabled,
+		"enabled":  LocalAdminStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LocalAdminStatus(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted                  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled                  ProvisioningState = "Canceled"
+	ProvisioningStateCreated                   ProvisioningState = "Created"
+	ProvisioningStateCreating                  ProvisioningState = "Creating"
+	ProvisioningStateDeleted                   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting                  ProvisioningState = "Deleting"
+	ProvisioningStateFailed                    ProvisioningState = "Failed"
+	ProvisioningStateMovingResources           ProvisioningState = "MovingResources"
+	ProvisioningStateNotSpecified              ProvisioningState = "NotSpecified"
+	ProvisioningStateRolloutInProgress         ProvisioningState = "RolloutInProgress"
+	ProvisioningStateRunning                   ProvisioningState = "Running"
+	ProvisioningStateStorageProvisioningFailed ProvisioningState = "StorageProvisioningFailed"
+	ProvisioningStateSucceeded                 ProvisioningState = "Succeeded"
+	ProvisioningStateTransientFailure          ProvisioningState = "TransientFailure"
+	ProvisioningStateUpdated                   ProvisioningState = "Updated"
+	ProvisioningStateUpdating                  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreated),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMovingResources),
+		string(ProvisioningStateNotSpecified),
+		string(ProvisioningStateRolloutInProgress),
+		string(ProvisioningStateRunning),
+		string(ProvisioningStateStorageProvisioningFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateTransientFailure),
+		string(ProvisioningStateUpdated),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":                  ProvisioningStateAccepted,
+		"canceled":                  ProvisioningStateCanceled,
+		"created":                   ProvisioningStateCreated,
+		"creating":                  ProvisioningStateCreating,
+		"deleted":                   ProvisioningStateDeleted,
+		"deleting":                  ProvisioningStateDeleting,
+		"failed":                    ProvisioningStateFailed,
+		"movingresources":           ProvisioningStateMovingResources,
+		"notspecified":              ProvisioningStateNotSpecified,
+		"rolloutinprogress":         ProvisioningStateRolloutInProgress,
+		"running":                   ProvisioningStateRunning,
+		"storageprovisioningfailed": ProvisioningStateStorageProvisioningFailed,
+		"succeeded":                 ProvisioningStateSucceeded,
+		"transientfailure":          ProvisioningStateTransientFailure,
+		"updated":                   ProvisioningStateUpdated,
+		"updating":                  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type StopOnDisconnectEnableStatus string
+
+const (
+	StopOnDisconnectEnableStatusDisabled StopOnDisconnectEnableStatus = "Disabled"
+	StopOnDisconnectEnableStatusEnabled  StopOnDisconnectEnableStatus = "Enabled"
+)
+
+func PossibleValuesForStopOnDisconnectEnableStatus() []string {
+	return []string{
+		string(StopOnDisconnectEnableStatusDisabled),
+		string(StopOnDisconnectEnableStatusEnabled),
+	}
+}
+
+func parseStopOnDisconnectEnableStatus(input string) (*StopOnDisconnectEnableStatus, error) {
+	vals := map[string]StopOnDisconnectEnableStatus{
+		"disabled": StopOnDisconnectEnableStatusDisabled,
+		"enabled":  StopOnDisconnectEnableStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StopOnDisconnectEnableStatus(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package pools
+
+type PoolProperties struct {
+	DevBoxDefinitionName  *string                        `json:"devBoxDefinitionName,omitempty"`
+	HealthStatus          *HealthStatus                  `json:"healthStatus,omitempty"`
+	LicenseType           *LicenseType                   `json:"licenseType,omitempty"`
+	LocalAdministrator    *LocalAdminStatus              `json:"localAdministrator,omitempty"`
+	NetworkConnectionName *string                        `json:"networkConnectionName,omitempty"`
+	ProvisioningState     *ProvisioningState             `json:"provisioningState,omitempty"`
+	StopOnDisconnect      *StopOnDisconnectConfiguration `json:"stopOnDisconnect,omitempty"`
+}
@@ -0,0 +1,7 @@
+package pools
+
+type PoolUpdate struct {
+	Location   *string               `json:"location,omitempty"`
+	Properties *PoolUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string    `json:"tags,omitempty"`
+}
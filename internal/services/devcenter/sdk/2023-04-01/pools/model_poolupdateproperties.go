@@ -0,0 +1,9 @@
+package pools
+
+type PoolUpdateProperties struct {
+	DevBoxDefinitionName  *string                        `json:"devBoxDefinitionName,omitempty"`
+	LicenseType           *LicenseType                   `json:"licenseType,omitempty"`
+	LocalAdministrator    *LocalAdminStatus              `json:"localAdministrator,omitempty"`
+	NetworkConnectionName *string                        `json:"networkConnectionName,omitempty"`
+	StopOnDisconnect      *StopOnDisconnectConfiguration `json:"stopOnDisconnect,omitempty"`
+}
@@ -0,0 +1,15 @@
+package projectenvironmenttypes
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ProjectEnvironmentTypesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewProjectEnvironmentTypesClientWithBaseURI(endpoint string) ProjectEnvironmentTypesClient {
+	return ProjectEnvironmentTypesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
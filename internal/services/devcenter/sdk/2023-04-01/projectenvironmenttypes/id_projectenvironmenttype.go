@@ -0,0 +1,137 @@
+package projectenvironmenttypes
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ProjectEnvironmentTypeId{}
+
+// ProjectEnvironmentTypeId is a struct representing the Resource ID for a Project Environment Type
+type ProjectEnvironmentTypeId struct {
+	SubscriptionId      string
+	ResourceGroupName   string
+	ProjectName         string
+	EnvironmentTypeName string
+}
+
+// NewProjectEnvironmentTypeID returns a new ProjectEnvironmentTypeId struct
+func NewProjectEnvironmentTypeID(subscriptionId string, resourceGroupName string, projectName string, environmentTypeName string) ProjectEnvironmentTypeId {
+	return ProjectEnvironmentTypeId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroupName:   resourceGroupName,
+		ProjectName:         projectName,
+		EnvironmentTypeName: environmentTypeName,
+	}
+}
+
+// ParseProjectEnvironmentTypeID parses 'input' into a ProjectEnvironmentTypeId
+func ParseProjectEnvironmentTypeID(input string) (*ProjectEnvironmentTypeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ProjectEnvironmentTypeId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ProjectEnvironmentTypeId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ProjectName, ok = parsed.Parsed["projectName"]; !ok {
+		return nil, fmt.Errorf("the segment 'projectName' was not found in the resource id %q", input)
+	}
+
+	if id.EnvironmentTypeName, ok = parsed.Parsed["environmentTypeName"]; !ok {
+		return nil, fmt.Errorf("the segment 'environmentTypeName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseProjectEnvironmentTypeIDInsensitively parses 'input' case-insensitively into a ProjectEnvironmentTypeId
+// note: this method should only be used for API response data and not user input
+func ParseProjectEnvironmentTypeIDInsensitively(input string) (*ProjectEnvironmentTypeId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ProjectEnvironmentTypeId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ProjectEnvironmentTypeId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.ProjectName, ok = parsed.Parsed["projectName"]; !ok {
+		return nil, fmt.Errorf("the segment 'projectName' was not found in the resource id %q", input)
+	}
+
+	if id.EnvironmentTypeName, ok = parsed.Parsed["environmentTypeName"]; !ok {
+		return nil, fmt.Errorf("the segment 'environmentTypeName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateProjectEnvironmentTypeID checks that 'input' can be parsed as a Project Environment Type ID
+func ValidateProjectEnvironmentTypeID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseProjectEnvironmentTypeID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Project Environment Type ID
+func (id ProjectEnvironmentTypeId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DevCenter/projects/%s/environmentTypes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.ProjectName, id.EnvironmentTypeName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Project Environment Type ID
+func (id ProjectEnvironmentTypeId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDevCenter", "Microsoft.DevCenter", "Microsoft.DevCenter"),
+		resourceids.StaticSegment("staticProjects", "projects", "projects"),
+		resourceids.UserSpecifiedSegment("projectName", "projectValue"),
+		resourceids.StaticSegment("staticEnvironmentTypes", "environmentTypes", "environmentTypes"),
+		resourceids.UserSpecifiedSegment("environmentTypeName", "environmentTypeValue"),
+	}
+}
+
+// String returns a human-readable description of this Project Environment Type ID
+func (id ProjectEnvironmentTypeId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Project Name: %q", id.ProjectName),
+		fmt.Sprintf("Environment Type Name: %q", id.EnvironmentTypeName),
+	}
+	return fmt.Sprintf("Project Environment Type (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,6 @@
+package projectenvironmenttypes
+
+type EnvironmentRole struct {
+	Description *string `json:"description,omitempty"`
+	RoleName    *string `json:"roleName,omitempty"`
+}
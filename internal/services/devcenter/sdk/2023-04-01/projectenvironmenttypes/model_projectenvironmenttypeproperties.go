@@ -0,0 +1,9 @@
+package projectenvironmenttypes
+
+type ProjectEnvironmentTypeProperties struct {
+	CreatorRoleAssignment *ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment `json:"creatorRoleAssignment,omitempty"`
+	DeploymentTargetId    *string                                                      `json:"deploymentTargetId,omitempty"`
+	ProvisioningState     *ProvisioningState                                           `json:"provisioningState,omitempty"`
+	Status                *EnvironmentTypeEnableStatus                                 `json:"status,omitempty"`
+	UserRoleAssignments   *map[string]UserRoleAssignmentValue                          `json:"userRoleAssignments,omitempty"`
+}
@@ -0,0 +1,11 @@
+package projectenvironmenttypes
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type ProjectEnvironmentTypeUpdate struct {
+	Identity   *identity.SystemAndUserAssignedMap      `json:"identity,omitempty"`
+	Properties *ProjectEnvironmentTypeUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                      `json:"tags,omitempty"`
+}
@@ -0,0 +1,8 @@
+package projectenvironmenttypes
+
+type ProjectEnvironmentTypeUpdateProperties struct {
+	CreatorRoleAssignment *ProjectEnvironmentTypeUpdatePropertiesCreatorRoleAssignment `json:"creatorRoleAssignment,omitempty"`
+	DeploymentTargetId    *string                                                      `json:"deploymentTargetId,omitempty"`
+	Status                *EnvironmentTypeEnableStatus                                 `json:"status,omitempty"`
+	UserRoleAssignments   *map[string]UserRoleAssignmentValue                          `json:"userRoleAssignments,omitempty"`
+}
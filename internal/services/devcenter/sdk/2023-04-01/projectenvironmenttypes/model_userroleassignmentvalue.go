@@ -0,0 +1,5 @@
+package projectenvironmenttypes
+
+type UserRoleAssignmentValue struct {
+	Roles *map[string]EnvironmentRole `json:"roles,omitempty"`
+}
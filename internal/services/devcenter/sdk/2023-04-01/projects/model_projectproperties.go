@@ -0,0 +1,9 @@
+package projects
+
+type ProjectProperties struct {
+	Description        *string            `json:"description,omitempty"`
+	DevCenterId        string             `json:"devCenterId"`
+	DevCenterUri       *string            `json:"devCenterUri,omitempty"`
+	MaxDevBoxesPerUser *int64             `json:"maxDevBoxesPerUser,omitempty"`
+	ProvisioningState  *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,7 @@
+package projects
+
+type ProjectUpdate struct {
+	Location   *string                  `json:"location,omitempty"`
+	Properties *ProjectUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+}
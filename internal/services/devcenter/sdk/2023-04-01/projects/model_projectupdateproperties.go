@@ -0,0 +1,7 @@
+package projects
+
+type ProjectUpdateProperties struct {
+	Description        *string `json:"description,omitempty"`
+	DevCenterId        *string `json:"devCenterId,omitempty"`
+	MaxDevBoxesPerUser *int64  `json:"maxDevBoxesPerUser,omitempty"`
+}
@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DevCenterName validates the name of a Dev Center, which has a shorter maximum length than the resources within it
+func DevCenterName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{2,25}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 26 characters, start with a letter or number and can only contain letters, numbers and hyphens, got %q", k, v))
+	}
+
+	return
+}
+
+// DevCenterChildName validates the name of the resources which exist within (or alongside) a Dev Center, such as
+// Projects, Catalogs, Environment Types, Dev Box Definitions and Pools
+func DevCenterChildName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-_.]{2,62}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 63 characters, start with a letter or number and can only contain letters, numbers, hyphens, underscores and periods, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,99 @@
+package validate
+
+import "testing"
+
+func TestDevCenterName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "ab",
+			Valid: false,
+		},
+		{
+			Input: "abc",
+			Valid: true,
+		},
+		{
+			Input: "-abc",
+			Valid: false,
+		},
+		{
+			Input: "dev-center-1",
+			Valid: true,
+		},
+		{
+			Input: "dev_center",
+			Valid: false,
+		},
+		{
+			Input: "abcdefghijklmnopqrstuvwxyz",
+			Valid: true,
+		},
+		{
+			Input: "abcdefghijklmnopqrstuvwxyz1",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := DevCenterName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestDevCenterChildName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "ab",
+			Valid: false,
+		},
+		{
+			Input: "abc",
+			Valid: true,
+		},
+		{
+			Input: "_abc",
+			Valid: false,
+		},
+		{
+			Input: "my_project.dev-1",
+			Valid: true,
+		},
+		{
+			Input: "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijk",
+			Valid: true,
+		},
+		{
+			Input: "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyzabcdefghijkl",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := DevCenterChildName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
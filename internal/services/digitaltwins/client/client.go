@@ -3,22 +3,40 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/digitaltwins/mgmt/2020-10-31/digitaltwins"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/digitaltwinsinstance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/timeseriesdatabaseconnections"
 )
 
 type Client struct {
-	EndpointClient *digitaltwins.EndpointClient
-	InstanceClient *digitaltwins.Client
+	EndpointClient                      *digitaltwins.EndpointClient
+	EndpointV20230131Client             *endpoints.EndpointsClient
+	InstanceClient                      *digitaltwins.Client
+	InstanceV20230131Client             *digitaltwinsinstance.DigitalTwinsInstanceClient
+	TimeSeriesDatabaseConnectionsClient *timeseriesdatabaseconnections.TimeSeriesDatabaseConnectionsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	endpointClient := digitaltwins.NewEndpointClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&endpointClient.Client, o.ResourceManagerAuthorizer)
 
+	endpointV20230131Client := endpoints.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&endpointV20230131Client.Client, o.ResourceManagerAuthorizer)
+
 	InstanceClient := digitaltwins.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&InstanceClient.Client, o.ResourceManagerAuthorizer)
 
+	instanceV20230131Client := digitaltwinsinstance.NewDigitalTwinsInstanceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&instanceV20230131Client.Client, o.ResourceManagerAuthorizer)
+
+	timeSeriesDatabaseConnectionsClient := timeseriesdatabaseconnections.NewTimeSeriesDatabaseConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&timeSeriesDatabaseConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		EndpointClient: &endpointClient,
-		InstanceClient: &InstanceClient,
+		EndpointClient:                      &endpointClient,
+		EndpointV20230131Client:             &endpointV20230131Client,
+		InstanceClient:                      &InstanceClient,
+		InstanceV20230131Client:             &instanceV20230131Client,
+		TimeSeriesDatabaseConnectionsClient: &timeSeriesDatabaseConnectionsClient,
 	}
 }
@@ -5,11 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/digitaltwins/mgmt/2020-10-31/digitaltwins"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -52,18 +54,38 @@ func resourceDigitalTwinsEndpointEventHub() *pluginsdk.Resource {
 
 			"eventhub_primary_connection_string": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"eventhub_primary_connection_string", "eventhub_endpoint_uri"},
+				RequiredWith: []string{"eventhub_secondary_connection_string"},
 			},
 
 			"eventhub_secondary_connection_string": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"eventhub_primary_connection_string"},
+			},
+
+			"eventhub_endpoint_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"eventhub_primary_connection_string", "eventhub_endpoint_uri"},
+				RequiredWith: []string{"eventhub_name"},
+			},
+
+			"eventhub_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: eventhubValidate.ValidateEventHubName(),
+				RequiredWith: []string{"eventhub_endpoint_uri"},
 			},
 
+			"identity": digitalTwinsEndpointIdentitySchema([]string{"eventhub_primary_connection_string"}),
+
 			"dead_letter_storage_secret": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -73,106 +95,109 @@ func resourceDigitalTwinsEndpointEventHub() *pluginsdk.Resource {
 		},
 	}
 }
+
 func resourceDigitalTwinsEndpointEventHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins.EndpointV20230131Client
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
 	digitalTwinsId, err := parse.DigitalTwinsInstanceID(d.Get("digital_twins_id").(string))
 	if err != nil {
 		return err
 	}
 
-	id := parse.NewDigitalTwinsEndpointID(subscriptionId, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name).ID()
+	id := endpoints.NewEndpointID(subscriptionId, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name)
+		existing, err := client.DigitalTwinsEndpointGet(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for present of existing Digital Twins Endpoint %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return tf.ImportAsExistsError("azurerm_digital_twins_endpoint_eventhub", id)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_digital_twins_endpoint_eventhub", id.ID())
 		}
 	}
 
-	properties := digitaltwins.EndpointResource{
-		Properties: &digitaltwins.EventHub{
-			EndpointType:                 digitaltwins.EndpointTypeEventHub,
-			ConnectionStringPrimaryKey:   utils.String(d.Get("eventhub_primary_connection_string").(string)),
-			ConnectionStringSecondaryKey: utils.String(d.Get("eventhub_secondary_connection_string").(string)),
-			DeadLetterSecret:             utils.String(d.Get("dead_letter_storage_secret").(string)),
-		},
+	endpointUri := d.Get("eventhub_endpoint_uri").(string)
+	properties := endpoints.EventHub{
+		AuthenticationType: expandDigitalTwinsEndpointAuthenticationType(endpointUri),
+		DeadLetterSecret:   utils.String(d.Get("dead_letter_storage_secret").(string)),
 	}
-
-	future, err := client.CreateOrUpdate(ctx, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name, properties)
-	if err != nil {
-		return fmt.Errorf("creating/updating Digital Twins Endpoint EventHub %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+	if endpointUri != "" {
+		properties.EndpointUri = utils.String(endpointUri)
+		properties.EntityPath = utils.String(d.Get("eventhub_name").(string))
+		properties.Identity = expandDigitalTwinsEndpointIdentity(d.Get("identity").([]interface{}))
+	} else {
+		properties.ConnectionStringPrimaryKey = utils.String(d.Get("eventhub_primary_connection_string").(string))
+		properties.ConnectionStringSecondaryKey = utils.String(d.Get("eventhub_secondary_connection_string").(string))
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of the Digital Twins Endpoint EventHub %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+	payload := endpoints.DigitalTwinsEndpointResource{
+		Properties: properties,
 	}
-
-	if _, err := client.Get(ctx, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name); err != nil {
-		return fmt.Errorf("retrieving Digital Twins Endpoint EventHub %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+	if err := client.DigitalTwinsEndpointCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	d.SetId(id)
+	d.SetId(parse.NewDigitalTwinsEndpointID(id.SubscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName, id.EndpointName).ID())
 
 	return resourceDigitalTwinsEndpointEventHubRead(d, meta)
 }
 
 func resourceDigitalTwinsEndpointEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins.EndpointV20230131Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DigitalTwinsEndpointID(d.Id())
+	parsed, err := parse.DigitalTwinsEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
+	id := endpoints.NewEndpointID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.DigitalTwinsInstanceName, parsed.EndpointName)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
+	resp, err := client.DigitalTwinsEndpointGet(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Digital Twins Event Hub Endpoint %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving Digital Twins Endpoint EventHub %q (Resource Group %q / Instance %q): %+v", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 	d.Set("name", id.EndpointName)
-	d.Set("digital_twins_id", parse.NewDigitalTwinsInstanceID(subscriptionId, id.ResourceGroup, id.DigitalTwinsInstanceName).ID())
-	if resp.Properties != nil {
-		if _, ok := resp.Properties.AsEventHub(); !ok {
-			return fmt.Errorf("retrieving Digital Twins Endpoint %q (Resource Group %q / Instance %q) is not type EventHub", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName)
+	d.Set("digital_twins_id", parse.NewDigitalTwinsInstanceID(subscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName).ID())
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props, ok := model.Properties.(endpoints.EventHub)
+		if !ok {
+			return fmt.Errorf("retrieving %s: endpoint is not of type EventHub", id)
+		}
+
+		d.Set("eventhub_endpoint_uri", props.EndpointUri)
+		d.Set("eventhub_name", props.EntityPath)
+		if err := d.Set("identity", flattenDigitalTwinsEndpointIdentity(props.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
 		}
 	}
 	return nil
 }
 
 func resourceDigitalTwinsEndpointEventHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins.EndpointV20230131Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DigitalTwinsEndpointID(d.Id())
+	parsed, err := parse.DigitalTwinsEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
+	id := endpoints.NewEndpointID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.DigitalTwinsInstanceName, parsed.EndpointName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
-	if err != nil {
-		return fmt.Errorf("deleting Digital Twins Endpoint EventHub %q (Resource Group %q / Instance %q): %+v", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName, err)
-	}
-
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of the Digital Twins Endpoint EventHub %q (Resource Group %q / Instance %q): %+v", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName, err)
+	if err := client.DigitalTwinsEndpointDeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 	return nil
 }
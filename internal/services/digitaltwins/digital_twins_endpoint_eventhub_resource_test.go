@@ -103,6 +103,21 @@ func TestAccDigitalTwinsEndpointEventHub_updateDeadLetter(t *testing.T) {
 	})
 }
 
+func TestAccDigitalTwinsEndpointEventHub_identityBased(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_digital_twins_endpoint_eventhub", "test")
+	r := DigitalTwinsEndpointEventHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityBased(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r DigitalTwinsEndpointEventHubResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DigitalTwinsEndpointID(state.ID)
 	if err != nil {
@@ -301,3 +316,70 @@ resource "azurerm_digital_twins_endpoint_eventhub" "test" {
 }
 `, r.template(data), data.RandomString, data.RandomInteger)
 }
+
+func (r DigitalTwinsEndpointEventHubResource) identityBased(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dtwin-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_digital_twins_instance" "test" {
+  name                = "acctest-DT-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  partition_count   = 2
+  message_retention = 1
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub.test.id
+  role_definition_name = "Azure Event Hubs Data Sender"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_digital_twins_endpoint_eventhub" "test" {
+  name                  = "acctest-EH-%[1]d"
+  digital_twins_id      = azurerm_digital_twins_instance.test.id
+  eventhub_endpoint_uri = "sb://${azurerm_eventhub_namespace.test.name}.servicebus.windows.net"
+  eventhub_name         = azurerm_eventhub.test.name
+
+  identity {
+    type                      = "UserAssigned"
+    user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,78 @@
+package digitaltwins
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func digitalTwinsEndpointIdentitySchema(conflictsWith []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: conflictsWith,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"type": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(endpoints.PossibleValuesForIdentityType(), false),
+				},
+
+				"user_assigned_identity_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+				},
+			},
+		},
+	}
+}
+
+func expandDigitalTwinsEndpointIdentity(input []interface{}) *endpoints.ManagedIdentityReference {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	identityType := endpoints.IdentityType(raw["type"].(string))
+	output := endpoints.ManagedIdentityReference{
+		Type: &identityType,
+	}
+	if v := raw["user_assigned_identity_id"].(string); v != "" {
+		output.UserAssignedIdentity = utils.String(v)
+	}
+
+	return &output
+}
+
+func flattenDigitalTwinsEndpointIdentity(input *endpoints.ManagedIdentityReference) []interface{} {
+	if input == nil || input.Type == nil {
+		return []interface{}{}
+	}
+
+	userAssignedIdentityId := ""
+	if input.UserAssignedIdentity != nil {
+		if id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.UserAssignedIdentity); err == nil {
+			userAssignedIdentityId = id.ID()
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                      string(*input.Type),
+			"user_assigned_identity_id": userAssignedIdentityId,
+		},
+	}
+}
+
+func expandDigitalTwinsEndpointAuthenticationType(endpointUri string) *endpoints.AuthenticationType {
+	authenticationType := endpoints.AuthenticationTypeKeyBased
+	if endpointUri != "" {
+		authenticationType = endpoints.AuthenticationTypeIdentityBased
+	}
+	return &authenticationType
+}
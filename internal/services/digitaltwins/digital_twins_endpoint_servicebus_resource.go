@@ -5,10 +5,11 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/digitaltwins/mgmt/2020-10-31/digitaltwins"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -52,18 +53,38 @@ func resourceDigitalTwinsEndpointServiceBus() *pluginsdk.Resource {
 
 			"servicebus_primary_connection_string": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"servicebus_primary_connection_string", "servicebus_endpoint_uri"},
+				RequiredWith: []string{"servicebus_secondary_connection_string"},
 			},
 
 			"servicebus_secondary_connection_string": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"servicebus_primary_connection_string"},
 			},
 
+			"servicebus_endpoint_uri": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ExactlyOneOf: []string{"servicebus_primary_connection_string", "servicebus_endpoint_uri"},
+				RequiredWith: []string{"servicebus_topic_name"},
+			},
+
+			"servicebus_topic_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"servicebus_endpoint_uri"},
+			},
+
+			"identity": digitalTwinsEndpointIdentitySchema([]string{"servicebus_primary_connection_string"}),
+
 			"dead_letter_storage_secret": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -73,106 +94,109 @@ func resourceDigitalTwinsEndpointServiceBus() *pluginsdk.Resource {
 		},
 	}
 }
+
 func resourceDigitalTwinsEndpointServiceBusCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins.EndpointV20230131Client
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
 	digitalTwinsId, err := parse.DigitalTwinsInstanceID(d.Get("digital_twins_id").(string))
 	if err != nil {
 		return err
 	}
 
-	id := parse.NewDigitalTwinsEndpointID(subscriptionId, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name).ID()
+	id := endpoints.NewEndpointID(subscriptionId, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, d.Get("name").(string))
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name)
+		existing, err := client.DigitalTwinsEndpointGet(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
-				return fmt.Errorf("checking for present of existing Digital Twins Endpoint %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return tf.ImportAsExistsError("azurerm_digital_twins_endpoint_servicebus", id)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_digital_twins_endpoint_servicebus", id.ID())
 		}
 	}
 
-	properties := digitaltwins.EndpointResource{
-		Properties: &digitaltwins.ServiceBus{
-			EndpointType:              digitaltwins.EndpointTypeServiceBus,
-			PrimaryConnectionString:   utils.String(d.Get("servicebus_primary_connection_string").(string)),
-			SecondaryConnectionString: utils.String(d.Get("servicebus_secondary_connection_string").(string)),
-			DeadLetterSecret:          utils.String(d.Get("dead_letter_storage_secret").(string)),
-		},
+	endpointUri := d.Get("servicebus_endpoint_uri").(string)
+	properties := endpoints.ServiceBus{
+		AuthenticationType: expandDigitalTwinsEndpointAuthenticationType(endpointUri),
+		DeadLetterSecret:   utils.String(d.Get("dead_letter_storage_secret").(string)),
 	}
-
-	future, err := client.CreateOrUpdate(ctx, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name, properties)
-	if err != nil {
-		return fmt.Errorf("creating/updating Digital Twins Endpoint ServiceBus %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+	if endpointUri != "" {
+		properties.EndpointUri = utils.String(endpointUri)
+		properties.EntityPath = utils.String(d.Get("servicebus_topic_name").(string))
+		properties.Identity = expandDigitalTwinsEndpointIdentity(d.Get("identity").([]interface{}))
+	} else {
+		properties.PrimaryConnectionString = utils.String(d.Get("servicebus_primary_connection_string").(string))
+		properties.SecondaryConnectionString = utils.String(d.Get("servicebus_secondary_connection_string").(string))
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of the Digital Twins Endpoint ServiceBus %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+	payload := endpoints.DigitalTwinsEndpointResource{
+		Properties: properties,
 	}
-
-	if _, err := client.Get(ctx, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, name); err != nil {
-		return fmt.Errorf("retrieving Digital Twins Endpoint ServiceBus %q (Resource Group %q / Instance %q): %+v", name, digitalTwinsId.ResourceGroup, digitalTwinsId.Name, err)
+	if err := client.DigitalTwinsEndpointCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
-	d.SetId(id)
+	d.SetId(parse.NewDigitalTwinsEndpointID(id.SubscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName, id.EndpointName).ID())
 
 	return resourceDigitalTwinsEndpointServiceBusRead(d, meta)
 }
 
 func resourceDigitalTwinsEndpointServiceBusRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins.EndpointV20230131Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DigitalTwinsEndpointID(d.Id())
+	parsed, err := parse.DigitalTwinsEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
+	id := endpoints.NewEndpointID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.DigitalTwinsInstanceName, parsed.EndpointName)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
+	resp, err := client.DigitalTwinsEndpointGet(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
-			log.Printf("[INFO] Digital Twins ServiceBus Endpoint %q does not exist - removing from state", d.Id())
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] Digital Twins Service Bus Endpoint %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving Digital Twins Endpoint ServiceBus %q (Resource Group %q / Instance %q): %+v", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 	d.Set("name", id.EndpointName)
-	d.Set("digital_twins_id", parse.NewDigitalTwinsInstanceID(subscriptionId, id.ResourceGroup, id.DigitalTwinsInstanceName).ID())
-	if resp.Properties != nil {
-		if _, ok := resp.Properties.AsServiceBus(); !ok {
-			return fmt.Errorf("retrieving Digital Twins Endpoint %q (Resource Group %q / Instance %q) is not type ServiceBus", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName)
+	d.Set("digital_twins_id", parse.NewDigitalTwinsInstanceID(subscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName).ID())
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props, ok := model.Properties.(endpoints.ServiceBus)
+		if !ok {
+			return fmt.Errorf("retrieving %s: endpoint is not of type ServiceBus", id)
+		}
+
+		d.Set("servicebus_endpoint_uri", props.EndpointUri)
+		d.Set("servicebus_topic_name", props.EntityPath)
+		if err := d.Set("identity", flattenDigitalTwinsEndpointIdentity(props.Identity)); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
 		}
 	}
 	return nil
 }
 
 func resourceDigitalTwinsEndpointServiceBusDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.EndpointClient
+	client := meta.(*clients.Client).DigitalTwins.EndpointV20230131Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DigitalTwinsEndpointID(d.Id())
+	parsed, err := parse.DigitalTwinsEndpointID(d.Id())
 	if err != nil {
 		return err
 	}
+	id := endpoints.NewEndpointID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.DigitalTwinsInstanceName, parsed.EndpointName)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
-	if err != nil {
-		return fmt.Errorf("deleting Digital Twins Endpoint ServiceBus %q (Resource Group %q / Instance %q): %+v", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName, err)
-	}
-
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of the Digital Twins Endpoint ServiceBus %q (Resource Group %q / Instance %q): %+v", id.EndpointName, id.ResourceGroup, id.DigitalTwinsInstanceName, err)
+	if err := client.DigitalTwinsEndpointDeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 	return nil
 }
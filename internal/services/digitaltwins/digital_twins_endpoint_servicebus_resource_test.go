@@ -103,6 +103,21 @@ func TestAccDigitalTwinsEndpointServicebus_updateDeadLetter(t *testing.T) {
 	})
 }
 
+func TestAccDigitalTwinsEndpointServicebus_identityBased(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_digital_twins_endpoint_servicebus", "test")
+	r := DigitalTwinsEndpointServiceBusResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identityBased(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r DigitalTwinsEndpointServiceBusResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DigitalTwinsEndpointID(state.ID)
 	if err != nil {
@@ -305,3 +320,58 @@ resource "azurerm_digital_twins_endpoint_servicebus" "test" {
 }
 `, r.template(data), data.RandomString, data.RandomInteger)
 }
+
+func (r DigitalTwinsEndpointServiceBusResource) identityBased(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dtwin-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_digital_twins_instance" "test" {
+  name                = "acctest-DT-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_servicebus_topic" "test" {
+  name                = "acctestservicebustopic-%[1]d"
+  namespace_name      = azurerm_servicebus_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_servicebus_topic.test.id
+  role_definition_name = "Azure Service Bus Data Sender"
+  principal_id         = azurerm_digital_twins_instance.test.identity.0.principal_id
+}
+
+resource "azurerm_digital_twins_endpoint_servicebus" "test" {
+  name                    = "acctest-EndpointSB-%[1]d"
+  digital_twins_id        = azurerm_digital_twins_instance.test.id
+  servicebus_endpoint_uri = "sb://${azurerm_servicebus_namespace.test.name}.servicebus.windows.net"
+  servicebus_topic_name   = azurerm_servicebus_topic.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -5,17 +5,20 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/digitaltwins/mgmt/2020-10-31/digitaltwins"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/digitaltwinsinstance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func resourceDigitalTwinsInstance() *pluginsdk.Resource {
@@ -49,6 +52,8 @@ func resourceDigitalTwinsInstance() *pluginsdk.Resource {
 
 			"location": azure.SchemaLocation(),
 
+			"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
 			"host_name": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -58,120 +63,135 @@ func resourceDigitalTwinsInstance() *pluginsdk.Resource {
 		},
 	}
 }
+
 func resourceDigitalTwinsInstanceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins.InstanceV20230131Client
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
-
-	id := parse.NewDigitalTwinsInstanceID(subscriptionId, resourceGroup, name).ID()
+	id := digitaltwinsinstance.NewDigitalTwinsInstanceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	existing, err := client.Get(ctx, resourceGroup, name)
+	existing, err := client.DigitalTwinsGet(ctx, id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
-			return fmt.Errorf("checking for existing Digital Twins Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 		}
 	}
-	if !utils.ResponseWasNotFound(existing.Response) {
-		return tf.ImportAsExistsError("azurerm_digital_twins_instance", id)
-	}
-
-	properties := digitaltwins.Description{
-		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_digital_twins_instance", id.ID())
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, properties)
+	identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
 	if err != nil {
-		return fmt.Errorf("creating Digital Twins Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of the Digital Twins Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	payload := digitaltwinsinstance.DigitalTwinsDescription{
+		Identity: identityValue,
+		Location: location.Normalize(d.Get("location").(string)),
+		Tags:     tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err = client.Get(ctx, resourceGroup, name); err != nil {
-		return fmt.Errorf("retrieving Digital Twins Instance %q (Resource Group %q): %+v", name, resourceGroup, err)
+	if err := client.DigitalTwinsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	d.SetId(id)
+	d.SetId(parse.NewDigitalTwinsInstanceID(id.SubscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName).ID())
 
 	return resourceDigitalTwinsInstanceRead(d, meta)
 }
 
 func resourceDigitalTwinsInstanceRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins.InstanceV20230131Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DigitalTwinsInstanceID(d.Id())
+	parsed, err := parse.DigitalTwinsInstanceID(d.Id())
 	if err != nil {
 		return err
 	}
+	id := digitaltwinsinstance.NewDigitalTwinsInstanceID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.Name)
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.DigitalTwinsGet(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Digital Twins Instance %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("retrieving Digital Twins Instance %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
-	if props := resp.Properties; props != nil {
-		d.Set("host_name", props.HostName)
+	d.Set("name", id.DigitalTwinsInstanceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("host_name", props.HostName)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceDigitalTwinsInstanceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins.InstanceV20230131Client
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DigitalTwinsInstanceID(d.Id())
+	parsed, err := parse.DigitalTwinsInstanceID(d.Id())
 	if err != nil {
 		return err
 	}
+	id := digitaltwinsinstance.NewDigitalTwinsInstanceID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.Name)
 
-	props := digitaltwins.PatchDescription{}
+	payload := digitaltwinsinstance.DigitalTwinsPatchDescription{}
+
+	if d.HasChange("identity") {
+		identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		payload.Identity = identityValue
+	}
 
 	if d.HasChange("tags") {
-		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+		payload.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, props); err != nil {
-		return fmt.Errorf("updating Digital Twins Instance %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if err := client.DigitalTwinsUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
 	return resourceDigitalTwinsInstanceRead(d, meta)
 }
 
 func resourceDigitalTwinsInstanceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).DigitalTwins.InstanceClient
+	client := meta.(*clients.Client).DigitalTwins.InstanceV20230131Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.DigitalTwinsInstanceID(d.Id())
+	parsed, err := parse.DigitalTwinsInstanceID(d.Id())
 	if err != nil {
 		return err
 	}
+	id := digitaltwinsinstance.NewDigitalTwinsInstanceID(parsed.SubscriptionId, parsed.ResourceGroup, parsed.Name)
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting Digital Twins Instance %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
-	}
-
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of the Digital Twins Instance %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	if err := client.DigitalTwinsDeleteThenPoll(ctx, id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
 	return nil
@@ -0,0 +1,275 @@
+package digitaltwins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/digitaltwinsinstance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/timeseriesdatabaseconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	kustoParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	kustoValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DigitalTwinsTimeSeriesDatabaseConnectionResource struct{}
+
+var _ sdk.Resource = DigitalTwinsTimeSeriesDatabaseConnectionResource{}
+
+type DigitalTwinsTimeSeriesDatabaseConnectionResourceModel struct {
+	Name                         string `tfschema:"name"`
+	DigitalTwinsId               string `tfschema:"digital_twins_id"`
+	EventHubName                 string `tfschema:"eventhub_name"`
+	EventHubNamespaceId          string `tfschema:"eventhub_namespace_id"`
+	EventHubNamespaceEndpointUri string `tfschema:"eventhub_namespace_endpoint_uri"`
+	EventHubConsumerGroupName    string `tfschema:"eventhub_consumer_group_name"`
+	KustoClusterId               string `tfschema:"kusto_cluster_id"`
+	KustoClusterUri              string `tfschema:"kusto_cluster_uri"`
+	KustoDatabaseName            string `tfschema:"kusto_database_name"`
+	KustoTableName               string `tfschema:"kusto_table_name"`
+	UserAssignedIdentityId       string `tfschema:"user_assigned_identity_id"`
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DigitalTwinsInstanceName,
+		},
+
+		"digital_twins_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: digitaltwinsinstance.ValidateDigitalTwinsInstanceID,
+		},
+
+		"eventhub_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: eventhubValidate.ValidateEventHubName(),
+		},
+
+		"eventhub_namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"eventhub_namespace_endpoint_uri": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kusto_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: kustoValidate.ClusterID,
+		},
+
+		"kusto_cluster_uri": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"kusto_database_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: kustoValidate.DatabaseName,
+		},
+
+		"eventhub_consumer_group_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "$Default",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kusto_table_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"user_assigned_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+	}
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) ModelObject() interface{} {
+	return &DigitalTwinsTimeSeriesDatabaseConnectionResourceModel{}
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) ResourceType() string {
+	return "azurerm_digital_twins_time_series_database_connection"
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return timeseriesdatabaseconnections.ValidateTimeSeriesDatabaseConnectionID
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DigitalTwins.TimeSeriesDatabaseConnectionsClient
+
+			var model DigitalTwinsTimeSeriesDatabaseConnectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			instanceId, err := digitaltwinsinstance.ParseDigitalTwinsInstanceID(model.DigitalTwinsId)
+			if err != nil {
+				return err
+			}
+
+			id := timeseriesdatabaseconnections.NewTimeSeriesDatabaseConnectionID(instanceId.SubscriptionId, instanceId.ResourceGroupName, instanceId.DigitalTwinsInstanceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := timeseriesdatabaseconnections.AzureDataExplorerConnectionProperties{
+				AdxDatabaseName:             model.KustoDatabaseName,
+				AdxEndpointUri:              model.KustoClusterUri,
+				AdxResourceId:               model.KustoClusterId,
+				EventHubConsumerGroup:       utils.String(model.EventHubConsumerGroupName),
+				EventHubEndpointUri:         model.EventHubNamespaceEndpointUri,
+				EventHubEntityPath:          model.EventHubName,
+				EventHubNamespaceResourceId: model.EventHubNamespaceId,
+			}
+			if model.KustoTableName != "" {
+				properties.AdxTableName = utils.String(model.KustoTableName)
+			}
+			if model.UserAssignedIdentityId != "" {
+				identityType := timeseriesdatabaseconnections.IdentityTypeUserAssigned
+				properties.Identity = &timeseriesdatabaseconnections.ManagedIdentityReference{
+					Type:                 &identityType,
+					UserAssignedIdentity: utils.String(model.UserAssignedIdentityId),
+				}
+			}
+
+			payload := timeseriesdatabaseconnections.TimeSeriesDatabaseConnection{
+				Properties: properties,
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DigitalTwins.TimeSeriesDatabaseConnectionsClient
+
+			id, err := timeseriesdatabaseconnections.ParseTimeSeriesDatabaseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DigitalTwinsTimeSeriesDatabaseConnectionResourceModel{
+				Name:           id.TimeSeriesDatabaseConnectionName,
+				DigitalTwinsId: digitaltwinsinstance.NewDigitalTwinsInstanceID(id.SubscriptionId, id.ResourceGroupName, id.DigitalTwinsInstanceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props, ok := model.Properties.(timeseriesdatabaseconnections.AzureDataExplorerConnectionProperties)
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected an Azure Data Explorer connection but got %+v", *id, model.Properties)
+				}
+
+				state.EventHubName = props.EventHubEntityPath
+				state.EventHubNamespaceEndpointUri = props.EventHubEndpointUri
+				state.EventHubConsumerGroupName = utils.NormalizeNilableString(props.EventHubConsumerGroup)
+				state.KustoClusterUri = props.AdxEndpointUri
+				state.KustoDatabaseName = props.AdxDatabaseName
+				state.KustoTableName = utils.NormalizeNilableString(props.AdxTableName)
+
+				eventHubNamespaceId, err := namespaces.ParseNamespaceIDInsensitively(props.EventHubNamespaceResourceId)
+				if err != nil {
+					return fmt.Errorf("parsing `eventhub_namespace_id`: %+v", err)
+				}
+				state.EventHubNamespaceId = eventHubNamespaceId.ID()
+
+				kustoClusterId, err := kustoParse.ClusterID(props.AdxResourceId)
+				if err != nil {
+					return fmt.Errorf("parsing `kusto_cluster_id`: %+v", err)
+				}
+				state.KustoClusterId = kustoClusterId.ID()
+
+				if identity := props.Identity; identity != nil && identity.Type != nil && *identity.Type == timeseriesdatabaseconnections.IdentityTypeUserAssigned {
+					state.UserAssignedIdentityId = utils.NormalizeNilableString(identity.UserAssignedIdentity)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DigitalTwins.TimeSeriesDatabaseConnectionsClient
+
+			id, err := timeseriesdatabaseconnections.ParseTimeSeriesDatabaseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,233 @@
+package digitaltwins_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/digitaltwins/sdk/2023-01-31/timeseriesdatabaseconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DigitalTwinsTimeSeriesDatabaseConnectionResource struct{}
+
+func TestAccDigitalTwinsTimeSeriesDatabaseConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_digital_twins_time_series_database_connection", "test")
+	r := DigitalTwinsTimeSeriesDatabaseConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDigitalTwinsTimeSeriesDatabaseConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_digital_twins_time_series_database_connection", "test")
+	r := DigitalTwinsTimeSeriesDatabaseConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDigitalTwinsTimeSeriesDatabaseConnection_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_digital_twins_time_series_database_connection", "test")
+	r := DigitalTwinsTimeSeriesDatabaseConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := timeseriesdatabaseconnections.ParseTimeSeriesDatabaseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.DigitalTwins.TimeSeriesDatabaseConnectionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dtwin-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_digital_twins_instance" "test" {
+  name                = "acctest-DT-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 7
+}
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctesteventhubcg-%[1]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_kusto_cluster" "test" {
+  name                = "acctestkc%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku {
+    name     = "Dev(No SLA)_Standard_D11_v2"
+    capacity = 1
+  }
+}
+
+resource "azurerm_kusto_database" "test" {
+  name                = "acctestkd-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  cluster_name        = azurerm_kusto_cluster.test.name
+}
+
+resource "azurerm_role_assignment" "eventhub" {
+  scope                = azurerm_eventhub.test.id
+  principal_id         = azurerm_digital_twins_instance.test.identity.0.principal_id
+  role_definition_name = "Azure Event Hubs Data Owner"
+}
+
+resource "azurerm_role_assignment" "kusto" {
+  scope                = azurerm_kusto_database.test.id
+  principal_id         = azurerm_digital_twins_instance.test.identity.0.principal_id
+  role_definition_name = "Contributor"
+}
+
+resource "azurerm_kusto_database_principal_assignment" "test" {
+  name                = "acctestkdpa-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_name        = azurerm_kusto_cluster.test.name
+  database_name       = azurerm_kusto_database.test.name
+
+  tenant_id      = azurerm_digital_twins_instance.test.identity.0.tenant_id
+  principal_id   = azurerm_digital_twins_instance.test.identity.0.principal_id
+  principal_type = "App"
+  role           = "Admin"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_digital_twins_time_series_database_connection" "test" {
+  name                            = "acctest-tsdc-%d"
+  digital_twins_id                = azurerm_digital_twins_instance.test.id
+  eventhub_name                   = azurerm_eventhub.test.name
+  eventhub_namespace_id           = azurerm_eventhub_namespace.test.id
+  eventhub_namespace_endpoint_uri = "sb://${azurerm_eventhub_namespace.test.name}.servicebus.windows.net"
+  kusto_cluster_id                = azurerm_kusto_cluster.test.id
+  kusto_cluster_uri               = azurerm_kusto_cluster.test.uri
+  kusto_database_name             = azurerm_kusto_database.test.name
+
+  depends_on = [
+    azurerm_role_assignment.eventhub,
+    azurerm_role_assignment.kusto,
+    azurerm_kusto_database_principal_assignment.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_digital_twins_time_series_database_connection" "import" {
+  name                            = azurerm_digital_twins_time_series_database_connection.test.name
+  digital_twins_id                = azurerm_digital_twins_time_series_database_connection.test.digital_twins_id
+  eventhub_name                   = azurerm_digital_twins_time_series_database_connection.test.eventhub_name
+  eventhub_namespace_id           = azurerm_digital_twins_time_series_database_connection.test.eventhub_namespace_id
+  eventhub_namespace_endpoint_uri = azurerm_digital_twins_time_series_database_connection.test.eventhub_namespace_endpoint_uri
+  kusto_cluster_id                = azurerm_digital_twins_time_series_database_connection.test.kusto_cluster_id
+  kusto_cluster_uri               = azurerm_digital_twins_time_series_database_connection.test.kusto_cluster_uri
+  kusto_database_name             = azurerm_digital_twins_time_series_database_connection.test.kusto_database_name
+}
+`, r.basic(data))
+}
+
+func (r DigitalTwinsTimeSeriesDatabaseConnectionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_digital_twins_time_series_database_connection" "test" {
+  name                            = "acctest-tsdc-%d"
+  digital_twins_id                = azurerm_digital_twins_instance.test.id
+  eventhub_name                   = azurerm_eventhub.test.name
+  eventhub_namespace_id           = azurerm_eventhub_namespace.test.id
+  eventhub_namespace_endpoint_uri = "sb://${azurerm_eventhub_namespace.test.name}.servicebus.windows.net"
+  eventhub_consumer_group_name    = azurerm_eventhub_consumer_group.test.name
+  kusto_cluster_id                = azurerm_kusto_cluster.test.id
+  kusto_cluster_uri               = azurerm_kusto_cluster.test.uri
+  kusto_database_name             = azurerm_kusto_database.test.name
+  kusto_table_name                = "mytable"
+
+  depends_on = [
+    azurerm_role_assignment.eventhub,
+    azurerm_role_assignment.kusto,
+    azurerm_kusto_database_principal_assignment.test,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,15 @@
+package digitaltwinsinstance
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DigitalTwinsInstanceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDigitalTwinsInstanceClientWithBaseURI(endpoint string) DigitalTwinsInstanceClient {
+	return DigitalTwinsInstanceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,86 @@
+package digitaltwinsinstance
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateDeleted      ProvisioningState = "Deleted"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateMoving       ProvisioningState = "Moving"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateRestoring    ProvisioningState = "Restoring"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateSuspending   ProvisioningState = "Suspending"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+	ProvisioningStateWarning      ProvisioningState = "Warning"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMoving),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateRestoring),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateSuspending),
+		string(ProvisioningStateUpdating),
+		string(ProvisioningStateWarning),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":     ProvisioningStateCanceled,
+		"deleted":      ProvisioningStateDeleted,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"moving":       ProvisioningStateMoving,
+		"provisioning": ProvisioningStateProvisioning,
+		"restoring":    ProvisioningStateRestoring,
+		"succeeded":    ProvisioningStateSucceeded,
+		"suspending":   ProvisioningStateSuspending,
+		"updating":     ProvisioningStateUpdating,
+		"warning":      ProvisioningStateWarning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled": PublicNetworkAccessDisabled,
+		"enabled":  PublicNetworkAccessEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
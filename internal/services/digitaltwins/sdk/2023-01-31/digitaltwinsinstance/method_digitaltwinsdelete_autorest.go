@@ -0,0 +1,73 @@
+package digitaltwinsinstance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type DigitalTwinsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// DigitalTwinsDelete ...
+func (c DigitalTwinsInstanceClient) DigitalTwinsDelete(ctx context.Context, id DigitalTwinsInstanceId) (result DigitalTwinsDeleteResponse, err error) {
+	req, err := c.preparerForDigitalTwinsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "digitaltwinsinstance.DigitalTwinsInstanceClient", "DigitalTwinsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDigitalTwinsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "digitaltwinsinstance.DigitalTwinsInstanceClient", "DigitalTwinsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DigitalTwinsDeleteThenPoll performs DigitalTwinsDelete then polls until it's completed
+func (c DigitalTwinsInstanceClient) DigitalTwinsDeleteThenPoll(ctx context.Context, id DigitalTwinsInstanceId) error {
+	result, err := c.DigitalTwinsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing DigitalTwinsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DigitalTwinsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDigitalTwinsDelete prepares the DigitalTwinsDelete request.
+func (c DigitalTwinsInstanceClient) preparerForDigitalTwinsDelete(ctx context.Context, id DigitalTwinsInstanceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDigitalTwinsDelete sends the DigitalTwinsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c DigitalTwinsInstanceClient) senderForDigitalTwinsDelete(ctx context.Context, req *http.Request) (future DigitalTwinsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,15 @@
+package digitaltwinsinstance
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type DigitalTwinsDescription struct {
+	Id         *string                            `json:"id,omitempty"`
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                             `json:"location"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *DigitalTwinsProperties            `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package digitaltwinsinstance
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type DigitalTwinsPatchDescription struct {
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Properties *DigitalTwinsPatchProperties       `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
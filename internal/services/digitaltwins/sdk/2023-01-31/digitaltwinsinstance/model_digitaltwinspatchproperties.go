@@ -0,0 +1,5 @@
+package digitaltwinsinstance
+
+type DigitalTwinsPatchProperties struct {
+	PublicNetworkAccess *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,9 @@
+package digitaltwinsinstance
+
+type DigitalTwinsProperties struct {
+	CreatedTime         *string              `json:"createdTime,omitempty"`
+	HostName            *string              `json:"hostName,omitempty"`
+	LastUpdatedTime     *string              `json:"lastUpdatedTime,omitempty"`
+	ProvisioningState   *ProvisioningState   `json:"provisioningState,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,9 @@
+package digitaltwinsinstance
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-31"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/digitaltwinsinstance/%s", defaultApiVersion)
+}
@@ -0,0 +1,46 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ DigitalTwinsEndpointResourceProperties = EventGrid{}
+
+type EventGrid struct {
+	AccessKey1         *string                    `json:"accessKey1,omitempty"`
+	AccessKey2         *string                    `json:"accessKey2,omitempty"`
+	AuthenticationType *AuthenticationType        `json:"authenticationType,omitempty"`
+	CreatedTime        *string                    `json:"createdTime,omitempty"`
+	DeadLetterSecret   *string                    `json:"deadLetterSecret,omitempty"`
+	DeadLetterUri      *string                    `json:"deadLetterUri,omitempty"`
+	Identity           *ManagedIdentityReference  `json:"identity,omitempty"`
+	ProvisioningState  *EndpointProvisioningState `json:"provisioningState,omitempty"`
+	TopicEndpoint      string                     `json:"TopicEndpoint"`
+
+	// Fields inherited from DigitalTwinsEndpointResourceProperties
+}
+
+var _ json.Marshaler = EventGrid{}
+
+func (s EventGrid) MarshalJSON() ([]byte, error) {
+	type wrapper EventGrid
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling EventGrid: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling EventGrid: %+v", err)
+	}
+	decoded["endpointType"] = "EventGrid"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling EventGrid: %+v", err)
+	}
+
+	return encoded, nil
+}
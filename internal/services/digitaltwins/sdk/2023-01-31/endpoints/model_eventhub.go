@@ -0,0 +1,47 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ DigitalTwinsEndpointResourceProperties = EventHub{}
+
+type EventHub struct {
+	AuthenticationType           *AuthenticationType        `json:"authenticationType,omitempty"`
+	CreatedTime                  *string                    `json:"createdTime,omitempty"`
+	DeadLetterSecret             *string                    `json:"deadLetterSecret,omitempty"`
+	DeadLetterUri                *string                    `json:"deadLetterUri,omitempty"`
+	Identity                     *ManagedIdentityReference  `json:"identity,omitempty"`
+	ProvisioningState            *EndpointProvisioningState `json:"provisioningState,omitempty"`
+	ConnectionStringPrimaryKey   *string                    `json:"connectionStringPrimaryKey,omitempty"`
+	ConnectionStringSecondaryKey *string                    `json:"connectionStringSecondaryKey,omitempty"`
+	EndpointUri                  *string                    `json:"endpointUri,omitempty"`
+	EntityPath                   *string                    `json:"entityPath,omitempty"`
+
+	// Fields inherited from DigitalTwinsEndpointResourceProperties
+}
+
+var _ json.Marshaler = EventHub{}
+
+func (s EventHub) MarshalJSON() ([]byte, error) {
+	type wrapper EventHub
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling EventHub: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling EventHub: %+v", err)
+	}
+	decoded["endpointType"] = "EventHub"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling EventHub: %+v", err)
+	}
+
+	return encoded, nil
+}
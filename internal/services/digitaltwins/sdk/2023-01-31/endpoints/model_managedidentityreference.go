@@ -0,0 +1,6 @@
+package endpoints
+
+type ManagedIdentityReference struct {
+	Type                 *IdentityType `json:"type,omitempty"`
+	UserAssignedIdentity *string       `json:"userAssignedIdentity,omitempty"`
+}
@@ -0,0 +1,47 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ DigitalTwinsEndpointResourceProperties = ServiceBus{}
+
+type ServiceBus struct {
+	AuthenticationType        *AuthenticationType        `json:"authenticationType,omitempty"`
+	CreatedTime               *string                    `json:"createdTime,omitempty"`
+	DeadLetterSecret          *string                    `json:"deadLetterSecret,omitempty"`
+	DeadLetterUri             *string                    `json:"deadLetterUri,omitempty"`
+	Identity                  *ManagedIdentityReference  `json:"identity,omitempty"`
+	ProvisioningState         *EndpointProvisioningState `json:"provisioningState,omitempty"`
+	EndpointUri               *string                    `json:"endpointUri,omitempty"`
+	EntityPath                *string                    `json:"entityPath,omitempty"`
+	PrimaryConnectionString   *string                    `json:"primaryConnectionString,omitempty"`
+	SecondaryConnectionString *string                    `json:"secondaryConnectionString,omitempty"`
+
+	// Fields inherited from DigitalTwinsEndpointResourceProperties
+}
+
+var _ json.Marshaler = ServiceBus{}
+
+func (s ServiceBus) MarshalJSON() ([]byte, error) {
+	type wrapper ServiceBus
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ServiceBus: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ServiceBus: %+v", err)
+	}
+	decoded["endpointType"] = "ServiceBus"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ServiceBus: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,9 @@
+package endpoints
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-31"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/endpoints/%s", defaultApiVersion)
+}
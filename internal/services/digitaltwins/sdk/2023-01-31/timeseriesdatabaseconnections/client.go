@@ -0,0 +1,15 @@
+package timeseriesdatabaseconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TimeSeriesDatabaseConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTimeSeriesDatabaseConnectionsClientWithBaseURI(endpoint string) TimeSeriesDatabaseConnectionsClient {
+	return TimeSeriesDatabaseConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,50 @@
+package timeseriesdatabaseconnections
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ TimeSeriesDatabaseConnectionProperties = AzureDataExplorerConnectionProperties{}
+
+type AzureDataExplorerConnectionProperties struct {
+	AdxDatabaseName                         string                             `json:"adxDatabaseName"`
+	AdxEndpointUri                          string                             `json:"adxEndpointUri"`
+	AdxRelationshipLifecycleEventsTableName *string                            `json:"adxRelationshipLifecycleEventsTableName,omitempty"`
+	AdxResourceId                           string                             `json:"adxResourceId"`
+	AdxTableName                            *string                            `json:"adxTableName,omitempty"`
+	AdxTwinLifecycleEventsTableName         *string                            `json:"adxTwinLifecycleEventsTableName,omitempty"`
+	EventHubConsumerGroup                   *string                            `json:"eventHubConsumerGroup,omitempty"`
+	EventHubEndpointUri                     string                             `json:"eventHubEndpointUri"`
+	EventHubEntityPath                      string                             `json:"eventHubEntityPath"`
+	EventHubNamespaceResourceId             string                             `json:"eventHubNamespaceResourceId"`
+	Identity                                *ManagedIdentityReference          `json:"identity,omitempty"`
+	ProvisioningState                       *TimeSeriesDatabaseConnectionState `json:"provisioningState,omitempty"`
+	RecordPropertyAndItemRemovals           *RecordPropertyAndItemRemovals     `json:"recordPropertyAndItemRemovals,omitempty"`
+
+	// Fields inherited from TimeSeriesDatabaseConnectionProperties
+}
+
+var _ json.Marshaler = AzureDataExplorerConnectionProperties{}
+
+func (s AzureDataExplorerConnectionProperties) MarshalJSON() ([]byte, error) {
+	type wrapper AzureDataExplorerConnectionProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling AzureDataExplorerConnectionProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling AzureDataExplorerConnectionProperties: %+v", err)
+	}
+	decoded["connectionType"] = "AzureDataExplorer"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling AzureDataExplorerConnectionProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
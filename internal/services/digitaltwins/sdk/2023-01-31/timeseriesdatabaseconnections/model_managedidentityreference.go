@@ -0,0 +1,6 @@
+package timeseriesdatabaseconnections
+
+type ManagedIdentityReference struct {
+	Type                 *IdentityType `json:"type,omitempty"`
+	UserAssignedIdentity *string       `json:"userAssignedIdentity,omitempty"`
+}
@@ -0,0 +1,41 @@
+package timeseriesdatabaseconnections
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type TimeSeriesDatabaseConnection struct {
+	Id         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties TimeSeriesDatabaseConnectionProperties `json:"properties"`
+	Type       *string                                `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &TimeSeriesDatabaseConnection{}
+
+func (s *TimeSeriesDatabaseConnection) UnmarshalJSON(bytes []byte) error {
+	type alias TimeSeriesDatabaseConnection
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into TimeSeriesDatabaseConnection: %+v", err)
+	}
+
+	s.Id = decoded.Id
+	s.Name = decoded.Name
+	s.Type = decoded.Type
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling TimeSeriesDatabaseConnection into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalTimeSeriesDatabaseConnectionPropertiesImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'TimeSeriesDatabaseConnection': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
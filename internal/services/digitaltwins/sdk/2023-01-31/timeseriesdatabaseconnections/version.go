@@ -0,0 +1,9 @@
+package timeseriesdatabaseconnections
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-31"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/timeseriesdatabaseconnections/%s", defaultApiVersion)
+}
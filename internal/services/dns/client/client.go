@@ -3,14 +3,19 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
 )
 
 type Client struct {
-	RecordSetsClient *dns.RecordSetsClient
-	ZonesClient      *dns.ZonesClient
+	DnssecConfigsClient *dnssecconfigs.DnssecConfigsClient
+	RecordSetsClient    *dns.RecordSetsClient
+	ZonesClient         *dns.ZonesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	DnssecConfigsClient := dnssecconfigs.NewDnssecConfigsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&DnssecConfigsClient.Client, o.ResourceManagerAuthorizer)
+
 	RecordSetsClient := dns.NewRecordSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&RecordSetsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -18,7 +23,8 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ZonesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		RecordSetsClient: &RecordSetsClient,
-		ZonesClient:      &ZonesClient,
+		DnssecConfigsClient: &DnssecConfigsClient,
+		RecordSetsClient:    &RecordSetsClient,
+		ZonesClient:         &ZonesClient,
 	}
 }
@@ -51,6 +51,21 @@ func dataSourceDnsZone() *pluginsdk.Resource {
 				Set:      pluginsdk.HashString,
 			},
 
+			"dnssec_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"dnssec_signing_key": dnsZoneDnssecSigningKeySchema(),
+
+			"dnssec_ds_records": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": tags.SchemaDataSource(),
 		},
 	}
@@ -111,6 +126,10 @@ func dataSourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := setDnsZoneDnssecConfig(ctx, meta.(*clients.Client).Dns.DnssecConfigsClient, d, resourceId); err != nil {
+		return err
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -56,6 +56,21 @@ func TestAccAzureRMDNSZoneDataSource_withoutResourceGroupName(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDNSZoneDataSource_dnssec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zone", "test")
+	r := AzureRMDNSZoneDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.dnssec(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("dnssec_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("dnssec_ds_records.0").Exists(),
+			),
+		},
+	})
+}
+
 func (AzureRMDNSZoneDataSource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -127,3 +142,27 @@ data "azurerm_dns_zone" "test" {
 }
 `, resourceGroupName, data.Locations.Primary, data.RandomInteger)
 }
+
+func (AzureRMDNSZoneDataSource) dnssec(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+  dnssec_enabled      = true
+}
+
+data "azurerm_dns_zone" "test" {
+  name                = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_dns_zone.test.resource_group_name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
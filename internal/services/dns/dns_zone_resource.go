@@ -1,16 +1,19 @@
 package dns
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2018-05-01/dns"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdk/2023-07-01-preview/dnssecconfigs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -140,11 +143,85 @@ func resourceDnsZone() *pluginsdk.Resource {
 				},
 			},
 
+			"dnssec_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"dnssec_signing_key": dnsZoneDnssecSigningKeySchema(),
+
+			"dnssec_ds_records": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
 }
 
+func dnsZoneDnssecSigningKeySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"key_tag": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"flags": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"protocol": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"public_key": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"security_algorithm_type": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+
+				"delegation_signer_info": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"digest_algorithm_type": {
+								Type:     pluginsdk.TypeInt,
+								Computed: true,
+							},
+
+							"digest_value": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"record": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Dns.ZonesClient
 	recordSetsClient := meta.(*clients.Client).Dns.RecordSetsClient
@@ -203,6 +280,20 @@ func resourceDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("dnssec_enabled") {
+		dnssecConfigsClient := meta.(*clients.Client).Dns.DnssecConfigsClient
+		dnssecZoneId := dnssecconfigs.NewDnsZoneID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.Name)
+		if d.Get("dnssec_enabled").(bool) {
+			if err := dnssecConfigsClient.CreateOrUpdateThenPoll(ctx, dnssecZoneId); err != nil {
+				return fmt.Errorf("enabling DNSSEC for DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		} else {
+			if err := dnssecConfigsClient.DeleteThenPoll(ctx, dnssecZoneId); err != nil {
+				return fmt.Errorf("disabling DNSSEC for DNS Zone %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		}
+	}
+
 	d.SetId(resourceId.ID())
 
 	return resourceDnsZoneRead(d, meta)
@@ -251,6 +342,10 @@ func resourceDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `soa_record`: %+v", err)
 	}
 
+	if err := setDnsZoneDnssecConfig(ctx, meta.(*clients.Client).Dns.DnssecConfigsClient, d, *id); err != nil {
+		return err
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -361,3 +456,107 @@ func flattenArmDNSZoneSOARecord(input *dns.RecordSet) []interface{} {
 		},
 	}
 }
+
+// setDnsZoneDnssecConfig sets the DNSSEC fields shared between the DNS Zone Resource and Data Source
+func setDnsZoneDnssecConfig(ctx context.Context, client *dnssecconfigs.DnssecConfigsClient, d *pluginsdk.ResourceData, id parse.DnsZoneId) error {
+	dnssecZoneId := dnssecconfigs.NewDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, dnssecZoneId)
+	if err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("retrieving DNSSEC Configuration for DNS Zone %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	var signingKeys *[]dnssecconfigs.SigningKey
+	if model := resp.Model; model != nil && model.Properties != nil {
+		signingKeys = model.Properties.SigningKeys
+	}
+
+	d.Set("dnssec_enabled", !response.WasNotFound(resp.HttpResponse))
+
+	if err := d.Set("dnssec_signing_key", flattenDnsZoneDnssecSigningKeys(signingKeys)); err != nil {
+		return fmt.Errorf("setting `dnssec_signing_key`: %+v", err)
+	}
+
+	if err := d.Set("dnssec_ds_records", flattenDnsZoneDnssecDelegationSignerRecords(signingKeys)); err != nil {
+		return fmt.Errorf("setting `dnssec_ds_records`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDnsZoneDnssecSigningKeys(input *[]dnssecconfigs.SigningKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, key := range *input {
+		delegationSignerInfo := make([]interface{}, 0)
+		if key.DelegationSignerInfo != nil {
+			for _, info := range *key.DelegationSignerInfo {
+				digestAlgorithmType := 0
+				if info.DigestAlgorithmType != nil {
+					digestAlgorithmType = int(*info.DigestAlgorithmType)
+				}
+
+				delegationSignerInfo = append(delegationSignerInfo, map[string]interface{}{
+					"digest_algorithm_type": digestAlgorithmType,
+					"digest_value":          utils.NormalizeNilableString(info.DigestValue),
+					"record":                utils.NormalizeNilableString(info.Record),
+				})
+			}
+		}
+
+		keyTag := 0
+		if key.KeyTag != nil {
+			keyTag = int(*key.KeyTag)
+		}
+
+		flags := 0
+		if key.Flags != nil {
+			flags = int(*key.Flags)
+		}
+
+		protocol := 0
+		if key.Protocol != nil {
+			protocol = int(*key.Protocol)
+		}
+
+		securityAlgorithmType := 0
+		if key.SecurityAlgorithmType != nil {
+			securityAlgorithmType = int(*key.SecurityAlgorithmType)
+		}
+
+		results = append(results, map[string]interface{}{
+			"key_tag":                 keyTag,
+			"flags":                   flags,
+			"protocol":                protocol,
+			"public_key":              utils.NormalizeNilableString(key.PublicKey),
+			"security_algorithm_type": securityAlgorithmType,
+			"delegation_signer_info":  delegationSignerInfo,
+		})
+	}
+
+	return results
+}
+
+// flattenDnsZoneDnssecDelegationSignerRecords returns the DS records which need to be added to the parent zone
+func flattenDnsZoneDnssecDelegationSignerRecords(input *[]dnssecconfigs.SigningKey) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, key := range *input {
+		if key.DelegationSignerInfo == nil {
+			continue
+		}
+
+		for _, info := range *key.DelegationSignerInfo {
+			if info.Record != nil && *info.Record != "" {
+				results = append(results, *info.Record)
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,7 @@
+package dnssecconfigs
+
+type DelegationSignerInfo struct {
+	DigestAlgorithmType *int64  `json:"digestAlgorithmType,omitempty"`
+	DigestValue         *string `json:"digestValue,omitempty"`
+	Record              *string `json:"record,omitempty"`
+}
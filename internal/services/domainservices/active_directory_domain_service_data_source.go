@@ -4,15 +4,16 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/domainservices/mgmt/2020-01-01/aad"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/sdk/2021-05-01/domainservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceActiveDirectoryDomainService() *pluginsdk.Resource {
@@ -129,6 +130,16 @@ func dataSourceActiveDirectoryDomainService() *pluginsdk.Resource {
 				Computed: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
+						"kerberos_armoring_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"kerberos_rc4_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
 						"ntlm_v1_enabled": {
 							Type:     pluginsdk.TypeBool,
 							Computed: true,
@@ -220,35 +231,37 @@ func dataSourceActiveDirectoryDomainServiceReplicaSetSchema() map[string]*plugin
 
 func dataSourceActiveDirectoryDomainServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DomainServices.DomainServicesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	resp, err := client.Get(ctx, resourceGroup, name)
+	resp, err := client.Get(ctx, domainservices.NewDomainServiceID(subscriptionId, resourceGroup, name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return nil
 		}
 		return err
 	}
 
-	if resp.ID == nil {
+	model := resp.Model
+	if model == nil || model.Id == nil {
 		return fmt.Errorf("reading Domain Service: ID was returned nil")
 	}
-	d.SetId(*resp.ID)
+	d.SetId(*model.Id)
 
 	d.Set("name", name)
 	d.Set("resource_group_name", resourceGroup)
 
-	if resp.Location == nil {
+	if model.Location == nil {
 		return fmt.Errorf("reading Domain Service %q: location was returned nil", d.Id())
 	}
-	d.Set("location", azure.NormalizeLocation(*resp.Location))
+	d.Set("location", azure.NormalizeLocation(*model.Location))
 
-	if props := resp.DomainServiceProperties; props != nil {
-		d.Set("deployment_id", props.DeploymentID)
+	if props := model.Properties; props != nil {
+		d.Set("deployment_id", props.DeploymentId)
 
 		domainConfigType := ""
 		if v := props.DomainConfigurationType; v != nil {
@@ -259,14 +272,14 @@ func dataSourceActiveDirectoryDomainServiceRead(d *pluginsdk.ResourceData, meta
 		d.Set("domain_name", props.DomainName)
 
 		d.Set("filtered_sync_enabled", false)
-		if props.FilteredSync == aad.FilteredSyncEnabled {
+		if props.FilteredSync != nil && *props.FilteredSync == domainservices.FilteredSyncEnabled {
 			d.Set("filtered_sync_enabled", true)
 		}
 
-		d.Set("resource_id", resp.ID)
+		d.Set("resource_id", model.Id)
 		d.Set("sku", props.Sku)
 		d.Set("sync_owner", props.SyncOwner)
-		d.Set("tenant_id", props.TenantID)
+		d.Set("tenant_id", props.TenantId)
 		d.Set("version", props.Version)
 
 		if err := d.Set("notifications", flattenDomainServiceNotifications(props.NotificationSettings)); err != nil {
@@ -287,5 +300,5 @@ func dataSourceActiveDirectoryDomainServiceRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 }
@@ -5,7 +5,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/domainservices/mgmt/2020-01-01/aad"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/sdk/2021-05-01/domainservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -93,79 +94,78 @@ func resourceActiveDirectoryDomainServiceReplicaSetCreate(d *pluginsdk.ResourceD
 	locks.ByName(domainServiceId.Name, DomainServiceResourceName)
 	defer locks.UnlockByName(domainServiceId.Name, DomainServiceResourceName)
 
-	domainService, err := client.Get(ctx, domainServiceId.ResourceGroup, domainServiceId.Name)
+	sdkDomainServiceId := domainservices.NewDomainServiceID(domainServiceId.SubscriptionId, domainServiceId.ResourceGroup, domainServiceId.Name)
+	resp, err := client.Get(ctx, sdkDomainServiceId)
 	if err != nil {
-		if utils.ResponseWasNotFound(domainService.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("could not find %s: %s", domainServiceId, err)
 		}
 		return fmt.Errorf("reading %s: %s", domainServiceId, err)
 	}
 
-	if domainService.DomainServiceProperties.ReplicaSets == nil || len(*domainService.DomainServiceProperties.ReplicaSets) == 0 {
+	domainService := resp.Model
+	if domainService == nil || domainService.Properties == nil || domainService.Properties.ReplicaSets == nil || len(*domainService.Properties.ReplicaSets) == 0 {
 		return fmt.Errorf("reading %s: returned with missing replica set information, expected at least 1 replica set: %s", domainServiceId, err)
 	}
 
 	subnetId := d.Get("subnet_id").(string)
-	replicaSets := *domainService.DomainServiceProperties.ReplicaSets
+	replicaSets := *domainService.Properties.ReplicaSets
 
 	for _, r := range replicaSets {
-		if r.ReplicaSetID == nil {
+		if r.ReplicaSetId == nil {
 			return fmt.Errorf("reading %s: a replica set was returned with a missing ReplicaSetID", domainServiceId)
 		}
-		if r.SubnetID == nil {
+		if r.SubnetId == nil {
 			return fmt.Errorf("reading %s: a replica set was returned with a missing SubnetID", domainServiceId)
 		}
 
 		// We assume that two replica sets cannot coexist in the same subnet
-		if strings.EqualFold(subnetId, *r.SubnetID) {
+		if strings.EqualFold(subnetId, *r.SubnetId) {
 			// Generate an ID here since we only know it once we know the ReplicaSetID
-			id := parse.NewDomainServiceReplicaSetID(domainServiceId.SubscriptionId, domainServiceId.ResourceGroup, domainServiceId.Name, *r.ReplicaSetID)
+			id := parse.NewDomainServiceReplicaSetID(domainServiceId.SubscriptionId, domainServiceId.ResourceGroup, domainServiceId.Name, *r.ReplicaSetId)
 			return tf.ImportAsExistsError("azurerm_active_directory_domain_service_replica_set", id.ID())
 		}
 	}
 
 	loc := location.Normalize(d.Get("location").(string))
-	replicaSets = append(replicaSets, aad.ReplicaSet{
+	replicaSets = append(replicaSets, domainservices.ReplicaSet{
 		Location: utils.String(loc),
-		SubnetID: utils.String(subnetId),
+		SubnetId: utils.String(subnetId),
 	})
 
-	domainService.DomainServiceProperties.ReplicaSets = &replicaSets
+	domainService.Properties.ReplicaSets = &replicaSets
 
-	future, err := client.CreateOrUpdate(ctx, domainServiceId.ResourceGroup, domainServiceId.Name, domainService)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, sdkDomainServiceId, *domainService); err != nil {
 		return fmt.Errorf("creating/updating Replica Sets for %s: %+v", domainServiceId, err)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for Replica Sets for %s: %+v", domainServiceId, err)
-	}
 
 	// We need to retrieve the domain service again to find out the new replica set ID
-	domainService, err = client.Get(ctx, domainServiceId.ResourceGroup, domainServiceId.Name)
+	resp, err = client.Get(ctx, sdkDomainServiceId)
 	if err != nil {
-		if utils.ResponseWasNotFound(domainService.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("could not find %s: %s", domainServiceId, err)
 		}
 		return fmt.Errorf("reading %s: %s", domainServiceId, err)
 	}
 
-	if domainService.DomainServiceProperties.ReplicaSets == nil || len(*domainService.DomainServiceProperties.ReplicaSets) == 0 {
+	domainService = resp.Model
+	if domainService == nil || domainService.Properties == nil || domainService.Properties.ReplicaSets == nil || len(*domainService.Properties.ReplicaSets) == 0 {
 		return fmt.Errorf("reading %s: returned with missing replica set information, expected at least 1 replica set: %s", domainServiceId, err)
 	}
 
 	var id parse.DomainServiceReplicaSetId
 	// Assuming that two replica sets cannot coexist in the same subnet, we identify our new replica set by its SubnetID
-	for _, r := range *domainService.DomainServiceProperties.ReplicaSets {
-		if r.ReplicaSetID == nil {
+	for _, r := range *domainService.Properties.ReplicaSets {
+		if r.ReplicaSetId == nil {
 			return fmt.Errorf("reading %s: a replica set was returned with a missing ReplicaSetID", domainServiceId)
 		}
-		if r.SubnetID == nil {
+		if r.SubnetId == nil {
 			return fmt.Errorf("reading %s: a replica set was returned with a missing SubnetID", domainServiceId)
 		}
 
-		if strings.EqualFold(subnetId, *r.SubnetID) {
+		if strings.EqualFold(subnetId, *r.SubnetId) {
 			// We found it!
-			id = parse.NewDomainServiceReplicaSetID(domainServiceId.SubscriptionId, domainServiceId.ResourceGroup, domainServiceId.Name, *r.ReplicaSetID)
+			id = parse.NewDomainServiceReplicaSetID(domainServiceId.SubscriptionId, domainServiceId.ResourceGroup, domainServiceId.Name, *r.ReplicaSetId)
 		}
 	}
 
@@ -174,17 +174,7 @@ func resourceActiveDirectoryDomainServiceReplicaSetCreate(d *pluginsdk.ResourceD
 	}
 
 	// Wait for all replica sets to become available with two domain controllers each before proceeding
-	timeout, _ := ctx.Deadline()
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:      []string{"pending"},
-		Target:       []string{"available"},
-		Refresh:      domainServiceControllerRefreshFunc(ctx, client, *domainServiceId, false),
-		Delay:        1 * time.Minute,
-		PollInterval: 1 * time.Minute,
-		Timeout:      time.Until(timeout),
-	}
-
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+	if err := waitForDomainServiceControllers(ctx, client, sdkDomainServiceId, false); err != nil {
 		return fmt.Errorf("waiting for both domain controllers to become available in all replica sets for %s: %+v", domainServiceId, err)
 	}
 
@@ -203,16 +193,17 @@ func resourceActiveDirectoryDomainServiceReplicaSetRead(d *pluginsdk.ResourceDat
 		return err
 	}
 
-	domainService, err := client.Get(ctx, id.ResourceGroup, id.DomainServiceName)
+	resp, err := client.Get(ctx, domainservices.NewDomainServiceID(id.SubscriptionId, id.ResourceGroup, id.DomainServiceName))
 	if err != nil {
-		if utils.ResponseWasNotFound(domainService.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
 		return err
 	}
 
-	if domainService.DomainServiceProperties.ReplicaSets == nil || len(*domainService.DomainServiceProperties.ReplicaSets) == 0 {
+	domainService := resp.Model
+	if domainService == nil || domainService.Properties == nil || domainService.Properties.ReplicaSets == nil || len(*domainService.Properties.ReplicaSets) == 0 {
 		return fmt.Errorf("reading %s: domain service returned with missing replica set information, expected at least 1 replica set: %s", id, err)
 	}
 
@@ -224,15 +215,15 @@ func resourceActiveDirectoryDomainServiceReplicaSetRead(d *pluginsdk.ResourceDat
 		subnetId                    string
 	)
 
-	replicaSets := *domainService.DomainServiceProperties.ReplicaSets
+	replicaSets := *domainService.Properties.ReplicaSets
 
 	for _, r := range replicaSets {
-		if r.ReplicaSetID == nil {
+		if r.ReplicaSetId == nil {
 			return fmt.Errorf("reading %s: a replica set was returned with a missing ReplicaSetID", id)
 		}
 
 		// ReplicaSetName in the ID struct is really the replica set ID
-		if *r.ReplicaSetID == id.ReplicaSetName {
+		if *r.ReplicaSetId == id.ReplicaSetName {
 			if r.DomainControllerIPAddress != nil {
 				domainControllerIpAddresses = *r.DomainControllerIPAddress
 			}
@@ -245,8 +236,8 @@ func resourceActiveDirectoryDomainServiceReplicaSetRead(d *pluginsdk.ResourceDat
 			if r.ServiceStatus != nil {
 				serviceStatus = *r.ServiceStatus
 			}
-			if r.SubnetID != nil {
-				subnetId = *r.SubnetID
+			if r.SubnetId != nil {
+				subnetId = *r.SubnetId
 			}
 		}
 	}
@@ -270,27 +261,29 @@ func resourceActiveDirectoryDomainServiceReplicaSetDelete(d *pluginsdk.ResourceD
 		return err
 	}
 
-	domainService, err := client.Get(ctx, id.ResourceGroup, id.DomainServiceName)
+	sdkDomainServiceId := domainservices.NewDomainServiceID(id.SubscriptionId, id.ResourceGroup, id.DomainServiceName)
+	resp, err := client.Get(ctx, sdkDomainServiceId)
 	if err != nil {
-		if utils.ResponseWasNotFound(domainService.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting %s: domain service was not found: %s", id, err)
 		}
 		return err
 	}
 
-	if domainService.DomainServiceProperties.ReplicaSets == nil || len(*domainService.DomainServiceProperties.ReplicaSets) == 0 {
+	domainService := resp.Model
+	if domainService == nil || domainService.Properties == nil || domainService.Properties.ReplicaSets == nil || len(*domainService.Properties.ReplicaSets) == 0 {
 		return fmt.Errorf("deleting %s: domain service returned with missing replica set information, expected at least 1 replica set: %s", id, err)
 	}
 
-	replicaSets := *domainService.DomainServiceProperties.ReplicaSets
+	replicaSets := *domainService.Properties.ReplicaSets
 
-	newReplicaSets := make([]aad.ReplicaSet, 0)
+	newReplicaSets := make([]domainservices.ReplicaSet, 0)
 	for _, r := range replicaSets {
-		if r.ReplicaSetID == nil {
+		if r.ReplicaSetId == nil {
 			return fmt.Errorf("deleting %s: a replica set was returned with a missing ReplicaSetID", id)
 		}
 
-		if *r.ReplicaSetID == id.ReplicaSetName {
+		if *r.ReplicaSetId == id.ReplicaSetName {
 			continue
 		}
 
@@ -301,31 +294,15 @@ func resourceActiveDirectoryDomainServiceReplicaSetDelete(d *pluginsdk.ResourceD
 		return fmt.Errorf("deleting %s: could not determine which replica set to remove", id)
 	}
 
-	domainService.DomainServiceProperties.ReplicaSets = &newReplicaSets
+	domainService.Properties.ReplicaSets = &newReplicaSets
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.DomainServiceName, domainService)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, sdkDomainServiceId, *domainService); err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
-	}
 
 	// Wait for all replica sets to become available with two domain controllers each before proceeding
-	// Generate a partial DomainServiceId since we don't need to know the initial replica set ID here
-	domainServiceId := parse.NewDomainServiceID(id.SubscriptionId, id.ResourceGroup, id.DomainServiceName, "")
-	timeout, _ := ctx.Deadline()
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:      []string{"pending"},
-		Target:       []string{"available"},
-		Refresh:      domainServiceControllerRefreshFunc(ctx, client, domainServiceId, true),
-		Delay:        1 * time.Minute,
-		PollInterval: 1 * time.Minute,
-		Timeout:      time.Until(timeout),
-	}
-
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for replica sets to finish updating for %s: %+v", domainServiceId, err)
+	if err := waitForDomainServiceControllers(ctx, client, sdkDomainServiceId, true); err != nil {
+		return fmt.Errorf("waiting for replica sets to finish updating for %s: %+v", sdkDomainServiceId, err)
 	}
 
 	return nil
@@ -2,15 +2,21 @@ package domainservices
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/domainservices/mgmt/2020-01-01/aad"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"golang.org/x/crypto/pkcs12"
 
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -19,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/sdk/2021-05-01/domainservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -109,6 +116,45 @@ func resourceActiveDirectoryDomainService() *pluginsdk.Resource {
 				},
 			},
 
+			"replica_set": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"location": location.SchemaWithoutForceNew(),
+
+						"subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: networkValidate.SubnetID,
+						},
+
+						"domain_controller_ip_addresses": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"external_access_ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"service_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"sku": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
@@ -176,15 +222,26 @@ func resourceActiveDirectoryDomainService() *pluginsdk.Resource {
 
 						"pfx_certificate": {
 							Type:         pluginsdk.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: azValidate.Base64EncodedString,
+							ExactlyOneOf: []string{"secure_ldap.0.pfx_certificate", "secure_ldap.0.pfx_certificate_key_vault_secret_id"},
+							RequiredWith: []string{"secure_ldap.0.pfx_certificate_password"},
 						},
 
 						"pfx_certificate_password": {
-							Type:      pluginsdk.TypeString,
-							Required:  true,
-							Sensitive: true,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"secure_ldap.0.pfx_certificate_key_vault_secret_id"},
+						},
+
+						// PFX bundles stored in Key Vault are not password protected, so a password isn't required here
+						"pfx_certificate_key_vault_secret_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+							ExactlyOneOf: []string{"secure_ldap.0.pfx_certificate", "secure_ldap.0.pfx_certificate_key_vault_secret_id"},
 						},
 
 						"certificate_expiry": {
@@ -212,6 +269,18 @@ func resourceActiveDirectoryDomainService() *pluginsdk.Resource {
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
+						"kerberos_armoring_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"kerberos_rc4_encryption_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
 						"ntlm_v1_enabled": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -277,12 +346,14 @@ func resourceActiveDirectoryDomainService() *pluginsdk.Resource {
 
 func resourceActiveDirectoryDomainServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DomainServices.DomainServicesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 	resourceErrorName := fmt.Sprintf("Domain Service (Name: %q, Resource Group: %q)", name, resourceGroup)
+	domainServiceId := domainservices.NewDomainServiceID(subscriptionId, resourceGroup, name)
 
 	locks.ByName(name, DomainServiceResourceName)
 	defer locks.UnlockByName(name, DomainServiceResourceName)
@@ -292,17 +363,17 @@ func resourceActiveDirectoryDomainServiceCreateUpdate(d *pluginsdk.ResourceData,
 	var id *parse.DomainServiceId
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, name)
+		existing, err := client.Get(ctx, domainServiceId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %s", resourceErrorName, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
+		if existing.Model != nil && existing.Model.Id != nil && *existing.Model.Id != "" {
 			// Parse the replica sets and assume the first one returned to be the initial replica set
 			// This is a best effort and the user can choose any replica set if they structure their config accordingly
-			props := existing.DomainServiceProperties
+			props := existing.Model.Properties
 			if props == nil {
 				return fmt.Errorf("checking for presence of existing %s: API response contained nil or missing properties", resourceErrorName)
 			}
@@ -311,7 +382,7 @@ func resourceActiveDirectoryDomainServiceCreateUpdate(d *pluginsdk.ResourceData,
 				return fmt.Errorf("checking for presence of existing %s: API response contained nil or missing replica set details", resourceErrorName)
 			}
 			initialReplicaSetId := replicaSets[0].(map[string]interface{})["id"].(string)
-			id := parse.NewDomainServiceID(client.SubscriptionID, resourceGroup, name, initialReplicaSetId)
+			id := parse.NewDomainServiceID(subscriptionId, resourceGroup, name, initialReplicaSetId)
 
 			return tf.ImportAsExistsError(DomainServiceResourceName, id.ID())
 		}
@@ -327,54 +398,55 @@ func resourceActiveDirectoryDomainServiceCreateUpdate(d *pluginsdk.ResourceData,
 	}
 
 	loc := location.Normalize(d.Get("location").(string))
-	filteredSync := aad.FilteredSyncDisabled
+	filteredSync := domainservices.FilteredSyncDisabled
 	if d.Get("filtered_sync_enabled").(bool) {
-		filteredSync = aad.FilteredSyncDisabled
+		filteredSync = domainservices.FilteredSyncEnabled
+	}
+
+	ldaps, err := expandDomainServiceLdaps(ctx, meta, d.Get("secure_ldap").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `secure_ldap`: %+v", err)
 	}
 
-	domainService := aad.DomainService{
-		DomainServiceProperties: &aad.DomainServiceProperties{
+	domainService := domainservices.DomainService{
+		Properties: &domainservices.DomainServiceProperties{
 			DomainName:             utils.String(d.Get("domain_name").(string)),
 			DomainSecuritySettings: expandDomainServiceSecurity(d.Get("security").([]interface{})),
-			FilteredSync:           filteredSync,
-			LdapsSettings:          expandDomainServiceLdaps(d.Get("secure_ldap").([]interface{})),
+			FilteredSync:           &filteredSync,
+			LdapsSettings:          ldaps,
 			NotificationSettings:   expandDomainServiceNotifications(d.Get("notifications").([]interface{})),
 			Sku:                    utils.String(d.Get("sku").(string)),
 		},
 		Location: utils.String(loc),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
 	if d.IsNewResource() {
 		// On resource creation, specify the initial replica set.
 		// No provision is made for changing the initial replica set, it should remain intact for the resource to function properly
-		replicaSets := []aad.ReplicaSet{
+		replicaSets := []domainservices.ReplicaSet{
 			{
 				Location: utils.String(loc),
-				SubnetID: utils.String(d.Get("initial_replica_set.0.subnet_id").(string)),
+				SubnetId: utils.String(d.Get("initial_replica_set.0.subnet_id").(string)),
 			},
 		}
-		domainService.DomainServiceProperties.ReplicaSets = &replicaSets
+		domainService.Properties.ReplicaSets = &replicaSets
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, domainService)
-	if err != nil {
+	if err := client.CreateOrUpdateThenPoll(ctx, domainServiceId, domainService); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", resourceErrorName, err)
 	}
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for %s: %+v", resourceErrorName, err)
-	}
 
 	// Retrieve the domain service to discover the unique ID for the initial replica set, which should not subsequently change
 	if d.IsNewResource() {
-		resp, err := client.Get(ctx, resourceGroup, name)
+		resp, err := client.Get(ctx, domainServiceId)
 		if err != nil {
 			return fmt.Errorf("retrieving %s after creating: %+v", resourceErrorName, err)
 		}
-		props := resp.DomainServiceProperties
-		if props == nil {
+		if resp.Model == nil || resp.Model.Properties == nil {
 			return fmt.Errorf("%s returned with no properties", resourceErrorName)
 		}
+		props := resp.Model.Properties
 		if props.ReplicaSets == nil {
 			return fmt.Errorf("%s returned with no replica set details", resourceErrorName)
 		}
@@ -386,7 +458,7 @@ func resourceActiveDirectoryDomainServiceCreateUpdate(d *pluginsdk.ResourceData,
 
 		// Once we know the initial replica set ID, we can build a resource ID
 		initialReplicaSetId := replicaSets[0].(map[string]interface{})["id"].(string)
-		newId := parse.NewDomainServiceID(client.SubscriptionID, resourceGroup, name, initialReplicaSetId)
+		newId := parse.NewDomainServiceID(subscriptionId, resourceGroup, name, initialReplicaSetId)
 		id = &newId
 		d.SetId(id.ID())
 
@@ -401,18 +473,16 @@ func resourceActiveDirectoryDomainServiceCreateUpdate(d *pluginsdk.ResourceData,
 
 	// A fully deployed domain service has 2 domain controllers per replica set, but the create operation completes early before the DCs are online.
 	// The domain service is still provisioning and further operations are blocked until both DCs are up and ready.
-	timeout, _ := ctx.Deadline()
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:      []string{"pending"},
-		Target:       []string{"available"},
-		Refresh:      domainServiceControllerRefreshFunc(ctx, client, *id, false),
-		Delay:        1 * time.Minute,
-		PollInterval: 1 * time.Minute,
-		Timeout:      time.Until(timeout),
+	if err := waitForDomainServiceControllers(ctx, client, domainServiceId, false); err != nil {
+		return fmt.Errorf("waiting for both domain controllers to become available in initial replica set for %s: %+v", id, err)
 	}
 
-	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for both domain controllers to become available in initial replica set for %s: %+v", id, err)
+	if d.HasChange("replica_set") {
+		oldReplicaSets, newReplicaSets := d.GetChange("replica_set")
+		initialSubnetId := d.Get("initial_replica_set.0.subnet_id").(string)
+		if err := updateDomainServiceReplicaSets(ctx, client, domainServiceId, initialSubnetId, oldReplicaSets.([]interface{}), newReplicaSets.([]interface{})); err != nil {
+			return fmt.Errorf("updating replica sets for %s: %+v", id, err)
+		}
 	}
 
 	return resourceActiveDirectoryDomainServiceRead(d, meta)
@@ -428,9 +498,9 @@ func resourceActiveDirectoryDomainServiceRead(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, domainservices.NewDomainServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -439,59 +509,81 @@ func resourceActiveDirectoryDomainServiceRead(d *pluginsdk.ResourceData, meta in
 
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("resource_id", resp.ID)
 
-	loc := location.NormalizeNilable(resp.Location)
-	d.Set("location", loc)
+	if model := resp.Model; model != nil {
+		d.Set("resource_id", model.Id)
 
-	if props := resp.DomainServiceProperties; props != nil {
-		d.Set("deployment_id", props.DeploymentID)
-		d.Set("domain_name", props.DomainName)
-		d.Set("sync_owner", props.SyncOwner)
-		d.Set("tenant_id", props.TenantID)
-		d.Set("version", props.Version)
+		loc := location.NormalizeNilable(model.Location)
+		d.Set("location", loc)
 
-		d.Set("filtered_sync_enabled", false)
-		if props.FilteredSync == aad.FilteredSyncEnabled {
-			d.Set("filtered_sync_enabled", true)
-		}
+		if props := model.Properties; props != nil {
+			d.Set("deployment_id", props.DeploymentId)
+			d.Set("domain_name", props.DomainName)
+			d.Set("sync_owner", props.SyncOwner)
+			d.Set("tenant_id", props.TenantId)
+			d.Set("version", props.Version)
 
-		d.Set("sku", props.Sku)
+			d.Set("filtered_sync_enabled", false)
+			if props.FilteredSync != nil && *props.FilteredSync == domainservices.FilteredSyncEnabled {
+				d.Set("filtered_sync_enabled", true)
+			}
 
-		if err := d.Set("notifications", flattenDomainServiceNotifications(props.NotificationSettings)); err != nil {
-			return fmt.Errorf("setting `notifications`: %+v", err)
-		}
+			d.Set("sku", props.Sku)
 
-		var initialReplicaSet interface{}
-		replicaSets := flattenDomainServiceReplicaSets(props.ReplicaSets)
+			if err := d.Set("notifications", flattenDomainServiceNotifications(props.NotificationSettings)); err != nil {
+				return fmt.Errorf("setting `notifications`: %+v", err)
+			}
 
-		// Determine the initial replica set. This is why we need to include InitialReplicaSetId in the resource ID,
-		// without it we would not be able to reliably support importing.
-		for _, replicaSetRaw := range replicaSets {
-			replicaSet := replicaSetRaw.(map[string]interface{})
-			if replicaSet["id"].(string) == id.InitialReplicaSetIdName {
-				initialReplicaSet = replicaSetRaw
-				break
+			var initialReplicaSet interface{}
+			replicaSets := flattenDomainServiceReplicaSets(props.ReplicaSets)
+
+			// Determine the initial replica set. This is why we need to include InitialReplicaSetId in the resource ID,
+			// without it we would not be able to reliably support importing.
+			for _, replicaSetRaw := range replicaSets {
+				replicaSet := replicaSetRaw.(map[string]interface{})
+				if replicaSet["id"].(string) == id.InitialReplicaSetIdName {
+					initialReplicaSet = replicaSetRaw
+					break
+				}
+			}
+			if initialReplicaSet == nil {
+				// It's safest to error out here, since we don't want to wipe the initial replica set from state if it was deleted manually
+				return fmt.Errorf("reading %s: could not determine initial replica set from API response", id)
+			}
+			if err := d.Set("initial_replica_set", []interface{}{initialReplicaSet}); err != nil {
+				return fmt.Errorf("setting `initial_replica_set`: %+v", err)
 			}
-		}
-		if initialReplicaSet == nil {
-			// It's safest to error out here, since we don't want to wipe the initial replica set from state if it was deleted manually
-			return fmt.Errorf("reading %s: could not determine initial replica set from API response", id)
-		}
-		if err := d.Set("initial_replica_set", []interface{}{initialReplicaSet}); err != nil {
-			return fmt.Errorf("setting `initial_replica_set`: %+v", err)
-		}
 
-		if err := d.Set("secure_ldap", flattenDomainServiceLdaps(d, props.LdapsSettings, false)); err != nil {
-			return fmt.Errorf("setting `secure_ldap`: %+v", err)
-		}
+			// Only the replica sets defined in the `replica_set` block are tracked here, since additional replica sets
+			// can also be managed using the `azurerm_active_directory_domain_service_replica_set` resource
+			if err := d.Set("replica_set", flattenDomainServiceManagedReplicaSets(d.Get("replica_set").([]interface{}), replicaSets)); err != nil {
+				return fmt.Errorf("setting `replica_set`: %+v", err)
+			}
+
+			secureLdap := flattenDomainServiceLdaps(d, props.LdapsSettings, false)
+			if secretId := d.Get("secure_ldap.0.pfx_certificate_key_vault_secret_id").(string); secretId != "" && props.LdapsSettings != nil && props.LdapsSettings.CertificateThumbprint != nil {
+				_, thumbprints, err := domainServiceLdapsCertificateFromKeyVault(ctx, meta, secretId)
+				if err != nil {
+					log.Printf("[WARN] unable to determine whether the LDAPS certificate for %s has been rotated in Key Vault: %+v", id, err)
+				} else if !domainServiceThumbprintsContain(thumbprints, *props.LdapsSettings.CertificateThumbprint) {
+					// Removing the Key Vault Secret ID from the state surfaces a diff, so that the latest certificate is applied
+					log.Printf("[DEBUG] the LDAPS certificate for %s differs from the Key Vault Secret %q - marking for rotation", id, secretId)
+					secureLdap[0].(map[string]interface{})["pfx_certificate_key_vault_secret_id"] = ""
+				}
+			}
+			if err := d.Set("secure_ldap", secureLdap); err != nil {
+				return fmt.Errorf("setting `secure_ldap`: %+v", err)
+			}
 
-		if err := d.Set("security", flattenDomainServiceSecurity(props.DomainSecuritySettings)); err != nil {
-			return fmt.Errorf("setting `security`: %+v", err)
+			if err := d.Set("security", flattenDomainServiceSecurity(props.DomainSecuritySettings)); err != nil {
+				return fmt.Errorf("setting `security`: %+v", err)
+			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceActiveDirectoryDomainServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -504,13 +596,13 @@ func resourceActiveDirectoryDomainServiceDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	future, err := client.Delete(ctx, domainservices.NewDomainServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
+	if err = future.Poller.PollUntilDone(); err != nil {
+		if !response.WasNotFound(future.Poller.HttpResponse) {
 			return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
 		}
 	}
@@ -518,18 +610,18 @@ func resourceActiveDirectoryDomainServiceDelete(d *pluginsdk.ResourceData, meta
 	return nil
 }
 
-func domainServiceControllerRefreshFunc(ctx context.Context, client *aad.DomainServicesClient, id parse.DomainServiceId, deleting bool) pluginsdk.StateRefreshFunc {
+func domainServiceControllerRefreshFunc(ctx context.Context, client *domainservices.DomainServicesClient, id domainservices.DomainServiceId, deleting bool) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Waiting for domain controllers to deploy...")
-		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		resp, err := client.Get(ctx, id)
 		if err != nil {
 			return nil, "error", err
 		}
-		if resp.DomainServiceProperties == nil || resp.DomainServiceProperties.ReplicaSets == nil || len(*resp.DomainServiceProperties.ReplicaSets) == 0 {
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.ReplicaSets == nil || len(*resp.Model.Properties.ReplicaSets) == 0 {
 			return nil, "error", fmt.Errorf("API error: `replicaSets` was not returned")
 		}
 		// Loop through all replica sets and ensure they are running and each have two available domain controllers
-		for _, repl := range *resp.DomainServiceProperties.ReplicaSets {
+		for _, repl := range *resp.Model.Properties.ReplicaSets {
 			if repl.ServiceStatus == nil {
 				return resp, "pending", nil
 			}
@@ -552,29 +644,212 @@ func domainServiceControllerRefreshFunc(ctx context.Context, client *aad.DomainS
 	}
 }
 
-func expandDomainServiceLdaps(input []interface{}) (ldaps *aad.LdapsSettings) {
-	ldaps = &aad.LdapsSettings{
-		Ldaps: aad.LdapsDisabled,
+// waitForDomainServiceControllers waits for all replica sets to become available with two domain controllers each
+func waitForDomainServiceControllers(ctx context.Context, client *domainservices.DomainServicesClient, id domainservices.DomainServiceId, deleting bool) error {
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"available"},
+		Refresh:      domainServiceControllerRefreshFunc(ctx, client, id, deleting),
+		Delay:        1 * time.Minute,
+		PollInterval: 1 * time.Minute,
+		Timeout:      time.Until(timeout),
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// updateDomainServiceReplicaSets removes the replica sets which are no longer defined in the `replica_set` block and then adds
+// the newly defined replica sets. Replica sets are identified by their subnet and are changed one at a time, waiting for the
+// domain controllers to become available in between, since further operations are blocked whilst a replica set is provisioning.
+// Any other replica sets (such as the initial replica set) are left untouched.
+func updateDomainServiceReplicaSets(ctx context.Context, client *domainservices.DomainServicesClient, id domainservices.DomainServiceId, initialSubnetId string, oldReplicaSets, newReplicaSets []interface{}) error {
+	for _, raw := range newReplicaSets {
+		if subnetId := raw.(map[string]interface{})["subnet_id"].(string); strings.EqualFold(subnetId, initialSubnetId) {
+			return fmt.Errorf("the subnet %q is used by the initial replica set and cannot be specified in a `replica_set` block", subnetId)
+		}
+	}
+
+	for _, raw := range oldReplicaSets {
+		subnetId := raw.(map[string]interface{})["subnet_id"].(string)
+		if domainServiceReplicaSetsContainSubnet(newReplicaSets, subnetId) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Removing the replica set in the subnet %q from %s..", subnetId, id)
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.ReplicaSets == nil {
+			return fmt.Errorf("retrieving %s: API response contained nil or missing replica set details", id)
+		}
+
+		replicaSets := make([]domainservices.ReplicaSet, 0)
+		for _, r := range *existing.Model.Properties.ReplicaSets {
+			if r.SubnetId != nil && strings.EqualFold(*r.SubnetId, subnetId) {
+				continue
+			}
+			replicaSets = append(replicaSets, r)
+		}
+		if len(replicaSets) == len(*existing.Model.Properties.ReplicaSets) {
+			// the replica set has already been removed
+			continue
+		}
+		existing.Model.Properties.ReplicaSets = &replicaSets
+
+		if err := client.CreateOrUpdateThenPoll(ctx, id, *existing.Model); err != nil {
+			return fmt.Errorf("removing the replica set in the subnet %q: %+v", subnetId, err)
+		}
+		if err := waitForDomainServiceControllers(ctx, client, id, true); err != nil {
+			return fmt.Errorf("waiting for the replica set in the subnet %q to be removed: %+v", subnetId, err)
+		}
+	}
+
+	for _, raw := range newReplicaSets {
+		v := raw.(map[string]interface{})
+		subnetId := v["subnet_id"].(string)
+		if domainServiceReplicaSetsContainSubnet(oldReplicaSets, subnetId) {
+			continue
+		}
+
+		log.Printf("[DEBUG] Adding a replica set in the subnet %q to %s..", subnetId, id)
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.ReplicaSets == nil {
+			return fmt.Errorf("retrieving %s: API response contained nil or missing replica set details", id)
+		}
+
+		replicaSets := *existing.Model.Properties.ReplicaSets
+		exists := false
+		for _, r := range replicaSets {
+			if r.SubnetId != nil && strings.EqualFold(*r.SubnetId, subnetId) {
+				exists = true
+				break
+			}
+		}
+		if exists {
+			// the replica set has already been added, for example when it was previously removed from the state
+			continue
+		}
+
+		replicaSets = append(replicaSets, domainservices.ReplicaSet{
+			Location: utils.String(location.Normalize(v["location"].(string))),
+			SubnetId: utils.String(subnetId),
+		})
+		existing.Model.Properties.ReplicaSets = &replicaSets
+
+		if err := client.CreateOrUpdateThenPoll(ctx, id, *existing.Model); err != nil {
+			return fmt.Errorf("adding a replica set in the subnet %q: %+v", subnetId, err)
+		}
+		if err := waitForDomainServiceControllers(ctx, client, id, false); err != nil {
+			return fmt.Errorf("waiting for both domain controllers to become available in the replica set in the subnet %q: %+v", subnetId, err)
+		}
+	}
+
+	return nil
+}
+
+func domainServiceReplicaSetsContainSubnet(input []interface{}, subnetId string) bool {
+	for _, raw := range input {
+		if strings.EqualFold(raw.(map[string]interface{})["subnet_id"].(string), subnetId) {
+			return true
+		}
+	}
+	return false
+}
+
+// domainServiceLdapsCertificateFromKeyVault retrieves the base64 encoded PFX bundle from a Key Vault Secret, using the latest
+// version of the Secret when the ID is versionless, along with the thumbprints of the certificates within the bundle
+func domainServiceLdapsCertificateFromKeyVault(ctx context.Context, meta interface{}, secretId string) (*string, []string, error) {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+
+	id, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(secretId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := client.GetSecret(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving Secret %q from Key Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if resp.Value == nil {
+		return nil, nil, fmt.Errorf("retrieving Secret %q from Key Vault %q: `value` was nil", id.Name, id.KeyVaultBaseUrl)
+	}
+	if resp.ContentType != nil && *resp.ContentType != "application/x-pkcs12" {
+		return nil, nil, fmt.Errorf("the Secret %q in Key Vault %q must contain a PFX bundle but has the content type %q", id.Name, id.KeyVaultBaseUrl, *resp.ContentType)
+	}
+
+	pfx, err := base64.StdEncoding.DecodeString(*resp.Value)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding the PFX bundle in the Secret %q: %+v", id.Name, err)
+	}
+
+	// note PFX bundles stored in Key Vault are not password protected
+	blocks, err := pkcs12.ToPEM(pfx, "")
+	if err != nil {
+		return nil, nil, fmt.Errorf("decoding the PFX bundle in the Secret %q: %+v", id.Name, err)
+	}
+
+	thumbprints := make([]string, 0)
+	for _, block := range blocks {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		thumbprint := sha1.Sum(block.Bytes) // #nosec G401
+		thumbprints = append(thumbprints, strings.ToUpper(hex.EncodeToString(thumbprint[:])))
+	}
+
+	return resp.Value, thumbprints, nil
+}
+
+func domainServiceThumbprintsContain(thumbprints []string, thumbprint string) bool {
+	for _, v := range thumbprints {
+		if strings.EqualFold(v, thumbprint) {
+			return true
+		}
+	}
+	return false
+}
+
+func expandDomainServiceLdaps(ctx context.Context, meta interface{}, input []interface{}) (*domainservices.LdapsSettings, error) {
+	ldapsEnabled := domainservices.LdapsDisabled
+	ldaps := &domainservices.LdapsSettings{
+		Ldaps: &ldapsEnabled,
 	}
 
 	if len(input) > 0 {
 		v := input[0].(map[string]interface{})
 		if v["enabled"].(bool) {
-			ldaps.Ldaps = aad.LdapsEnabled
+			ldapsEnabled = domainservices.LdapsEnabled
 		}
-		ldaps.PfxCertificate = utils.String(v["pfx_certificate"].(string))
-		ldaps.PfxCertificatePassword = utils.String(v["pfx_certificate_password"].(string))
-		if v["external_access_enabled"].(bool) {
-			ldaps.ExternalAccess = aad.Enabled
+
+		if secretId := v["pfx_certificate_key_vault_secret_id"].(string); secretId != "" {
+			pfx, _, err := domainServiceLdapsCertificateFromKeyVault(ctx, meta, secretId)
+			if err != nil {
+				return nil, err
+			}
+			ldaps.PfxCertificate = pfx
+			ldaps.PfxCertificatePassword = utils.String("")
 		} else {
-			ldaps.ExternalAccess = aad.Disabled
+			ldaps.PfxCertificate = utils.String(v["pfx_certificate"].(string))
+			ldaps.PfxCertificatePassword = utils.String(v["pfx_certificate_password"].(string))
 		}
+
+		externalAccess := domainservices.ExternalAccessDisabled
+		if v["external_access_enabled"].(bool) {
+			externalAccess = domainservices.ExternalAccessEnabled
+		}
+		ldaps.ExternalAccess = &externalAccess
 	}
 
-	return
+	return ldaps, nil
 }
 
-func expandDomainServiceNotifications(input []interface{}) *aad.NotificationSettings {
+func expandDomainServiceNotifications(input []interface{}) *domainservices.NotificationSettings {
 	if len(input) == 0 {
 		return nil
 	}
@@ -588,61 +863,71 @@ func expandDomainServiceNotifications(input []interface{}) *aad.NotificationSett
 		}
 	}
 
-	notifyDcAdmins := aad.NotifyDcAdminsDisabled
+	notifyDcAdmins := domainservices.NotifyDcAdminsDisabled
 	if n, ok := v["notify_dc_admins"]; ok && n.(bool) {
-		notifyDcAdmins = aad.NotifyDcAdminsEnabled
+		notifyDcAdmins = domainservices.NotifyDcAdminsEnabled
 	}
 
-	notifyGlobalAdmins := aad.NotifyGlobalAdminsDisabled
+	notifyGlobalAdmins := domainservices.NotifyGlobalAdminsDisabled
 	if n, ok := v["notify_global_admins"]; ok && n.(bool) {
-		notifyGlobalAdmins = aad.NotifyGlobalAdminsEnabled
+		notifyGlobalAdmins = domainservices.NotifyGlobalAdminsEnabled
 	}
 
-	return &aad.NotificationSettings{
+	return &domainservices.NotificationSettings{
 		AdditionalRecipients: &additionalRecipients,
-		NotifyDcAdmins:       notifyDcAdmins,
-		NotifyGlobalAdmins:   notifyGlobalAdmins,
+		NotifyDcAdmins:       &notifyDcAdmins,
+		NotifyGlobalAdmins:   &notifyGlobalAdmins,
 	}
 }
 
-func expandDomainServiceSecurity(input []interface{}) *aad.DomainSecuritySettings {
+func expandDomainServiceSecurity(input []interface{}) *domainservices.DomainSecuritySettings {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
 
-	ntlmV1 := aad.NtlmV1Disabled
-	syncKerberosPasswords := aad.SyncKerberosPasswordsDisabled
-	syncNtlmPasswords := aad.SyncNtlmPasswordsDisabled
-	syncOnPremPasswords := aad.SyncOnPremPasswordsDisabled
-	tlsV1 := aad.TLSV1Disabled
+	kerberosArmoring := domainservices.KerberosArmoringDisabled
+	kerberosRc4Encryption := domainservices.KerberosRc4EncryptionDisabled
+	ntlmV1 := domainservices.NtlmV1Disabled
+	syncKerberosPasswords := domainservices.SyncKerberosPasswordsDisabled
+	syncNtlmPasswords := domainservices.SyncNtlmPasswordsDisabled
+	syncOnPremPasswords := domainservices.SyncOnPremPasswordsDisabled
+	tlsV1 := domainservices.TlsV1Disabled
 
+	if v["kerberos_armoring_enabled"].(bool) {
+		kerberosArmoring = domainservices.KerberosArmoringEnabled
+	}
+	if v["kerberos_rc4_encryption_enabled"].(bool) {
+		kerberosRc4Encryption = domainservices.KerberosRc4EncryptionEnabled
+	}
 	if v["ntlm_v1_enabled"].(bool) {
-		ntlmV1 = aad.NtlmV1Enabled
+		ntlmV1 = domainservices.NtlmV1Enabled
 	}
 	if v["sync_kerberos_passwords"].(bool) {
-		syncKerberosPasswords = aad.SyncKerberosPasswordsEnabled
+		syncKerberosPasswords = domainservices.SyncKerberosPasswordsEnabled
 	}
 	if v["sync_ntlm_passwords"].(bool) {
-		syncNtlmPasswords = aad.SyncNtlmPasswordsEnabled
+		syncNtlmPasswords = domainservices.SyncNtlmPasswordsEnabled
 	}
 	if v["sync_on_prem_passwords"].(bool) {
-		syncOnPremPasswords = aad.SyncOnPremPasswordsEnabled
+		syncOnPremPasswords = domainservices.SyncOnPremPasswordsEnabled
 	}
 	if v["tls_v1_enabled"].(bool) {
-		tlsV1 = aad.TLSV1Enabled
+		tlsV1 = domainservices.TlsV1Enabled
 	}
 
-	return &aad.DomainSecuritySettings{
-		NtlmV1:                ntlmV1,
-		SyncKerberosPasswords: syncKerberosPasswords,
-		SyncNtlmPasswords:     syncNtlmPasswords,
-		SyncOnPremPasswords:   syncOnPremPasswords,
-		TLSV1:                 tlsV1,
+	return &domainservices.DomainSecuritySettings{
+		KerberosArmoring:      &kerberosArmoring,
+		KerberosRc4Encryption: &kerberosRc4Encryption,
+		NtlmV1:                &ntlmV1,
+		SyncKerberosPasswords: &syncKerberosPasswords,
+		SyncNtlmPasswords:     &syncNtlmPasswords,
+		SyncOnPremPasswords:   &syncOnPremPasswords,
+		TlsV1:                 &tlsV1,
 	}
 }
 
-func flattenDomainServiceLdaps(d *pluginsdk.ResourceData, input *aad.LdapsSettings, dataSource bool) []interface{} {
+func flattenDomainServiceLdaps(d *pluginsdk.ResourceData, input *domainservices.LdapsSettings, dataSource bool) []interface{} {
 	result := map[string]interface{}{
 		"enabled":                 false,
 		"external_access_enabled": false,
@@ -652,7 +937,7 @@ func flattenDomainServiceLdaps(d *pluginsdk.ResourceData, input *aad.LdapsSettin
 	}
 
 	if !dataSource {
-		// Read pfx_certificate and pfx_certificate_password from existing state since it's not returned
+		// Read pfx_certificate, pfx_certificate_password and pfx_certificate_key_vault_secret_id from existing state since they're not returned
 		result["pfx_certificate"] = ""
 		if v, ok := d.GetOk("secure_ldap.0.pfx_certificate"); ok {
 			result["pfx_certificate"] = v.(string)
@@ -661,17 +946,24 @@ func flattenDomainServiceLdaps(d *pluginsdk.ResourceData, input *aad.LdapsSettin
 		if v, ok := d.GetOk("secure_ldap.0.pfx_certificate_password"); ok {
 			result["pfx_certificate_password"] = v.(string)
 		}
+		result["pfx_certificate_key_vault_secret_id"] = ""
+		if v, ok := d.GetOk("secure_ldap.0.pfx_certificate_key_vault_secret_id"); ok {
+			result["pfx_certificate_key_vault_secret_id"] = v.(string)
+		}
 	}
 
 	if input != nil {
-		if input.ExternalAccess == aad.Enabled {
+		if input.ExternalAccess != nil && *input.ExternalAccess == domainservices.ExternalAccessEnabled {
 			result["external_access_enabled"] = true
 		}
-		if input.Ldaps == aad.LdapsEnabled {
+		if input.Ldaps != nil && *input.Ldaps == domainservices.LdapsEnabled {
 			result["enabled"] = true
 		}
 		if v := input.CertificateNotAfter; v != nil {
-			result["certificate_expiry"] = v.Format(time.RFC3339)
+			result["certificate_expiry"] = *v
+			if certificateExpiry, err := time.Parse(time.RFC3339, *v); err == nil {
+				result["certificate_expiry"] = certificateExpiry.Format(time.RFC3339)
+			}
 		}
 		if v := input.CertificateThumbprint; v != nil {
 			result["certificate_thumbprint"] = *v
@@ -684,7 +976,7 @@ func flattenDomainServiceLdaps(d *pluginsdk.ResourceData, input *aad.LdapsSettin
 	return []interface{}{result}
 }
 
-func flattenDomainServiceNotifications(input *aad.NotificationSettings) []interface{} {
+func flattenDomainServiceNotifications(input *domainservices.NotificationSettings) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
@@ -697,17 +989,17 @@ func flattenDomainServiceNotifications(input *aad.NotificationSettings) []interf
 	if input.AdditionalRecipients != nil {
 		result["additional_recipients"] = *input.AdditionalRecipients
 	}
-	if input.NotifyDcAdmins == aad.NotifyDcAdminsEnabled {
+	if input.NotifyDcAdmins != nil && *input.NotifyDcAdmins == domainservices.NotifyDcAdminsEnabled {
 		result["notify_dc_admins"] = true
 	}
-	if input.NotifyGlobalAdmins == aad.NotifyGlobalAdminsEnabled {
+	if input.NotifyGlobalAdmins != nil && *input.NotifyGlobalAdmins == domainservices.NotifyGlobalAdminsEnabled {
 		result["notify_global_admins"] = true
 	}
 
 	return []interface{}{result}
 }
 
-func flattenDomainServiceReplicaSets(input *[]aad.ReplicaSet) (ret []interface{}) {
+func flattenDomainServiceReplicaSets(input *[]domainservices.ReplicaSet) (ret []interface{}) {
 	if input == nil {
 		return
 	}
@@ -727,14 +1019,14 @@ func flattenDomainServiceReplicaSets(input *[]aad.ReplicaSet) (ret []interface{}
 		if in.ExternalAccessIPAddress != nil {
 			repl["external_access_ip_address"] = *in.ExternalAccessIPAddress
 		}
-		if in.ReplicaSetID != nil {
-			repl["id"] = *in.ReplicaSetID
+		if in.ReplicaSetId != nil {
+			repl["id"] = *in.ReplicaSetId
 		}
 		if in.ServiceStatus != nil {
 			repl["service_status"] = *in.ServiceStatus
 		}
-		if in.SubnetID != nil {
-			repl["subnet_id"] = *in.SubnetID
+		if in.SubnetId != nil {
+			repl["subnet_id"] = *in.SubnetId
 		}
 		ret = append(ret, repl)
 	}
@@ -742,31 +1034,55 @@ func flattenDomainServiceReplicaSets(input *[]aad.ReplicaSet) (ret []interface{}
 	return
 }
 
-func flattenDomainServiceSecurity(input *aad.DomainSecuritySettings) []interface{} {
+// flattenDomainServiceManagedReplicaSets returns the flattened replica sets which are defined in the `replica_set` block,
+// in the same order as the existing state. Replica sets which no longer exist are omitted, so that they're recreated.
+func flattenDomainServiceManagedReplicaSets(existing []interface{}, replicaSets []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+	for _, raw := range existing {
+		subnetId := raw.(map[string]interface{})["subnet_id"].(string)
+		for _, replicaSet := range replicaSets {
+			if strings.EqualFold(replicaSet.(map[string]interface{})["subnet_id"].(string), subnetId) {
+				result = append(result, replicaSet)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func flattenDomainServiceSecurity(input *domainservices.DomainSecuritySettings) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
 
 	result := map[string]bool{
-		"ntlm_v1_enabled":         false,
-		"sync_kerberos_passwords": false,
-		"sync_ntlm_passwords":     false,
-		"sync_on_prem_passwords":  false,
-		"tls_v1_enabled":          false,
+		"kerberos_armoring_enabled":       false,
+		"kerberos_rc4_encryption_enabled": false,
+		"ntlm_v1_enabled":                 false,
+		"sync_kerberos_passwords":         false,
+		"sync_ntlm_passwords":             false,
+		"sync_on_prem_passwords":          false,
+		"tls_v1_enabled":                  false,
+	}
+	if input.KerberosArmoring != nil && *input.KerberosArmoring == domainservices.KerberosArmoringEnabled {
+		result["kerberos_armoring_enabled"] = true
+	}
+	if input.KerberosRc4Encryption != nil && *input.KerberosRc4Encryption == domainservices.KerberosRc4EncryptionEnabled {
+		result["kerberos_rc4_encryption_enabled"] = true
 	}
-	if input.NtlmV1 == aad.NtlmV1Enabled {
+	if input.NtlmV1 != nil && *input.NtlmV1 == domainservices.NtlmV1Enabled {
 		result["ntlm_v1_enabled"] = true
 	}
-	if input.SyncKerberosPasswords == aad.SyncKerberosPasswordsEnabled {
+	if input.SyncKerberosPasswords != nil && *input.SyncKerberosPasswords == domainservices.SyncKerberosPasswordsEnabled {
 		result["sync_kerberos_passwords"] = true
 	}
-	if input.SyncNtlmPasswords == aad.SyncNtlmPasswordsEnabled {
+	if input.SyncNtlmPasswords != nil && *input.SyncNtlmPasswords == domainservices.SyncNtlmPasswordsEnabled {
 		result["sync_ntlm_passwords"] = true
 	}
-	if input.SyncOnPremPasswords == aad.SyncOnPremPasswordsEnabled {
+	if input.SyncOnPremPasswords != nil && *input.SyncOnPremPasswords == domainservices.SyncOnPremPasswordsEnabled {
 		result["sync_on_prem_passwords"] = true
 	}
-	if input.TLSV1 == aad.TLSV1Enabled {
+	if input.TlsV1 != nil && *input.TlsV1 == domainservices.TlsV1Enabled {
 		result["tls_v1_enabled"] = true
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/sdk/2021-05-01/domainservices"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -126,6 +127,8 @@ func TestAccActiveDirectoryDomainService_updateWithDatasource(t *testing.T) {
 				check.That(dataSourceData.ResourceName).Key("secure_ldap.0.external_access_enabled").HasValue("true"),
 				check.That(dataSourceData.ResourceName).Key("secure_ldap.0.public_certificate").Exists(),
 				check.That(dataSourceData.ResourceName).Key("security.#").HasValue("1"),
+				check.That(dataSourceData.ResourceName).Key("security.0.kerberos_armoring_enabled").HasValue("true"),
+				check.That(dataSourceData.ResourceName).Key("security.0.kerberos_rc4_encryption_enabled").HasValue("true"),
 				check.That(dataSourceData.ResourceName).Key("security.0.ntlm_v1_enabled").HasValue("true"),
 				check.That(dataSourceData.ResourceName).Key("security.0.sync_kerberos_passwords").HasValue("true"),
 				check.That(dataSourceData.ResourceName).Key("security.0.sync_ntlm_passwords").HasValue("true"),
@@ -150,6 +153,28 @@ func TestAccActiveDirectoryDomainService_updateWithDatasource(t *testing.T) {
 		},
 		data.ImportStep("secure_ldap.0.pfx_certificate", "secure_ldap.0.pfx_certificate_password"),
 
+		{
+			Config: r.completeWithInlineReplicaSetAndKeyVaultCertificate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replica_set.#").HasValue("1"),
+				check.That(data.ResourceName).Key("replica_set.0.id").Exists(),
+				check.That(data.ResourceName).Key("replica_set.0.domain_controller_ip_addresses.#").HasValue("2"),
+				check.That(data.ResourceName).Key("replica_set.0.service_status").HasValue("Running"),
+				check.That(data.ResourceName).Key("secure_ldap.0.certificate_thumbprint").Exists(),
+			),
+		},
+		data.ImportStep("replica_set", "secure_ldap.0.pfx_certificate_key_vault_secret_id"),
+
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replica_set.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("secure_ldap.0.pfx_certificate", "secure_ldap.0.pfx_certificate_password"),
+
 		{
 			Config:      r.requiresImport(data),
 			ExpectError: acceptance.RequiresImportError(data.ResourceType),
@@ -163,12 +188,12 @@ func (ActiveDirectoryDomainServiceResource) Exists(ctx context.Context, client *
 		return nil, err
 	}
 
-	resp, err := client.DomainServices.DomainServicesClient.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.DomainServices.DomainServicesClient.Get(ctx, domainservices.NewDomainServiceID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
 		return nil, fmt.Errorf("reading DomainService: %+v", err)
 	}
 
-	return utils.Bool(resp.ID != nil), nil
+	return utils.Bool(resp.Model != nil && resp.Model.Id != nil), nil
 }
 
 func (ActiveDirectoryDomainServiceReplicaSetResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
@@ -177,17 +202,17 @@ func (ActiveDirectoryDomainServiceReplicaSetResource) Exists(ctx context.Context
 		return nil, err
 	}
 
-	resp, err := client.DomainServices.DomainServicesClient.Get(ctx, id.ResourceGroup, id.DomainServiceName)
+	resp, err := client.DomainServices.DomainServicesClient.Get(ctx, domainservices.NewDomainServiceID(id.SubscriptionId, id.ResourceGroup, id.DomainServiceName))
 	if err != nil {
 		return nil, fmt.Errorf("reading DomainService: %+v", err)
 	}
 
-	if resp.ReplicaSets == nil || len(*resp.ReplicaSets) == 0 {
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.ReplicaSets == nil || len(*resp.Model.Properties.ReplicaSets) == 0 {
 		return nil, fmt.Errorf("DomainService response returned with nil or empty replicaSets")
 	}
 
-	for _, replica := range *resp.ReplicaSets {
-		if replica.ReplicaSetID != nil && *replica.ReplicaSetID == id.ReplicaSetName {
+	for _, replica := range *resp.Model.Properties.ReplicaSets {
+		if replica.ReplicaSetId != nil && *replica.ReplicaSetId == id.ReplicaSetName {
 			return utils.Bool(true), nil
 		}
 	}
@@ -340,11 +365,13 @@ resource "azurerm_active_directory_domain_service" "test" {
   }
 
   security {
-    ntlm_v1_enabled         = true
-    sync_kerberos_passwords = true
-    sync_ntlm_passwords     = true
-    sync_on_prem_passwords  = true
-    tls_v1_enabled          = true
+    kerberos_armoring_enabled       = true
+    kerberos_rc4_encryption_enabled = true
+    ntlm_v1_enabled                 = true
+    sync_kerberos_passwords         = true
+    sync_ntlm_passwords             = true
+    sync_on_prem_passwords          = true
+    tls_v1_enabled                  = true
   }
 
   tags = {
@@ -499,6 +526,310 @@ resource "azurerm_virtual_network_dns_servers" "test_secondary" {
 `, r.complete(data), data.Locations.Secondary, data.Locations.Ternary, data.RandomInteger)
 }
 
+func (r ActiveDirectoryDomainServiceResource) completeWithInlineReplicaSetAndKeyVaultCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acclongtestRG-aadds-%[2]d"
+  location = "%[1]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    certificate_permissions = ["Create", "Delete", "Get", "Import", "Purge", "Recover", "Update"]
+    secret_permissions      = ["Delete", "Get", "Set"]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name         = "acctestcert%[3]s"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate {
+    contents = "%[5]s"
+    password = "qwer5678"
+  }
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = false
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestVnet-aadds-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.10.0.0/16"]
+}
+
+resource "azurerm_subnet" "aadds" {
+  name                 = "acctestSubnet-aadds-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = [cidrsubnet(azurerm_virtual_network.test.address_space.0, 8, 0)]
+}
+
+resource "azurerm_subnet" "workload" {
+  name                 = "acctestSubnet-workload-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = [cidrsubnet(azurerm_virtual_network.test.address_space.0, 8, 1)]
+}
+
+resource "azurerm_network_security_group" "aadds" {
+  name                = "acctestNSG-aadds-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  security_rule {
+    name                       = "AllowSyncWithAzureAD"
+    priority                   = 101
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "AzureActiveDirectoryDomainServices"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "AllowRD"
+    priority                   = 201
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "3389"
+    source_address_prefix      = "CorpNetSaw"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "AllowPSRemoting"
+    priority                   = 301
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "5986"
+    source_address_prefix      = "AzureActiveDirectoryDomainServices"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "AllowLDAPS"
+    priority                   = 401
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "636"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+
+resource azurerm_subnet_network_security_group_association "test" {
+  subnet_id                 = azurerm_subnet.aadds.id
+  network_security_group_id = azurerm_network_security_group.aadds.id
+}
+
+resource "azurerm_resource_group" "test_secondary" {
+  name     = "acclongtestRG-aadds-secondary-%[2]d"
+  location = "%[6]s"
+}
+
+resource "azurerm_virtual_network" "test_secondary" {
+  name                = "acctestVnet-aadds-secondary-%[2]d"
+  location            = azurerm_resource_group.test_secondary.location
+  resource_group_name = azurerm_resource_group.test_secondary.name
+  address_space       = ["10.20.0.0/16"]
+}
+
+resource "azurerm_subnet" "aadds_secondary" {
+  name                 = "acctestSubnet-aadds-secondary-%[2]d"
+  resource_group_name  = azurerm_resource_group.test_secondary.name
+  virtual_network_name = azurerm_virtual_network.test_secondary.name
+  address_prefixes     = [cidrsubnet(azurerm_virtual_network.test_secondary.address_space.0, 8, 0)]
+}
+
+resource "azurerm_network_security_group" "aadds_secondary" {
+  name                = "acctestNSG-aadds-secondary-%[2]d"
+  location            = azurerm_resource_group.test_secondary.location
+  resource_group_name = azurerm_resource_group.test_secondary.name
+
+  security_rule {
+    name                       = "AllowSyncWithAzureAD"
+    priority                   = 101
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "443"
+    source_address_prefix      = "AzureActiveDirectoryDomainServices"
+    destination_address_prefix = "*"
+  }
+
+  security_rule {
+    name                       = "AllowPSRemoting"
+    priority                   = 301
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "5986"
+    source_address_prefix      = "AzureActiveDirectoryDomainServices"
+    destination_address_prefix = "*"
+  }
+}
+
+resource azurerm_subnet_network_security_group_association "test_secondary" {
+  subnet_id                 = azurerm_subnet.aadds_secondary.id
+  network_security_group_id = azurerm_network_security_group.aadds_secondary.id
+}
+
+resource "azurerm_virtual_network_peering" "test_primary_secondary" {
+  name                      = "acctestVnet-aadds-primary-secondary-%[2]d"
+  resource_group_name       = azurerm_virtual_network.test.resource_group_name
+  virtual_network_name      = azurerm_virtual_network.test.name
+  remote_virtual_network_id = azurerm_virtual_network.test_secondary.id
+
+  allow_forwarded_traffic      = true
+  allow_gateway_transit        = false
+  allow_virtual_network_access = true
+  use_remote_gateways          = false
+}
+
+resource "azurerm_virtual_network_peering" "test_secondary_primary" {
+  name                      = "acctestVnet-aadds-secondary-primary-%[2]d"
+  resource_group_name       = azurerm_virtual_network.test_secondary.resource_group_name
+  virtual_network_name      = azurerm_virtual_network.test_secondary.name
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+
+  allow_forwarded_traffic      = true
+  allow_gateway_transit        = false
+  allow_virtual_network_access = true
+  use_remote_gateways          = false
+}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_service_principal" "test" {
+  application_id = "2565bd9d-da50-47d4-8b85-4c97f669dc36" // published app for domain services
+}
+
+resource "azuread_group" "test" {
+  display_name     = "AAD DC Administrators"
+  description      = "Delegated group to administer Azure AD Domain Services"
+  security_enabled = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestAADDSAdminUser-%[2]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestAADDSAdminUser-%[2]d"
+  password            = "%[4]s"
+}
+
+resource "azuread_group_member" "test" {
+  group_object_id  = azuread_group.test.object_id
+  member_object_id = azuread_user.test.object_id
+}
+
+resource "azurerm_active_directory_domain_service" "test" {
+  name                = "acctest-%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  domain_name           = "never.gonna.shut.you.down"
+  sku                   = "Enterprise"
+  filtered_sync_enabled = false
+
+  initial_replica_set {
+    subnet_id = azurerm_subnet.aadds.id
+  }
+
+  replica_set {
+    location  = azurerm_resource_group.test_secondary.location
+    subnet_id = azurerm_subnet.aadds_secondary.id
+  }
+
+  notifications {
+    additional_recipients = ["notifyA@example.net", "notifyB@example.org"]
+    notify_dc_admins      = true
+    notify_global_admins  = true
+  }
+
+  secure_ldap {
+    enabled                             = true
+    external_access_enabled             = true
+    pfx_certificate_key_vault_secret_id = azurerm_key_vault_certificate.test.versionless_secret_id
+  }
+
+  security {
+    kerberos_armoring_enabled       = true
+    kerberos_rc4_encryption_enabled = true
+    ntlm_v1_enabled                 = true
+    sync_kerberos_passwords         = true
+    sync_ntlm_passwords             = true
+    sync_on_prem_passwords          = true
+    tls_v1_enabled                  = true
+  }
+
+  tags = {
+    Environment = "test"
+  }
+
+  depends_on = [
+    azuread_group.test,
+    azuread_group_member.test,
+    azuread_service_principal.test,
+    azuread_user.test,
+    azurerm_subnet_network_security_group_association.test,
+    azurerm_subnet_network_security_group_association.test_secondary,
+    azurerm_virtual_network_peering.test_primary_secondary,
+    azurerm_virtual_network_peering.test_secondary_primary,
+  ]
+}
+
+resource "azurerm_virtual_network_dns_servers" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  dns_servers        = azurerm_active_directory_domain_service.test.initial_replica_set.0.domain_controller_ip_addresses
+}
+`, data.Locations.Primary, data.RandomInteger, data.RandomString, r.adminPassword, secureLdapCertificate, data.Locations.Secondary)
+}
+
 func (r ActiveDirectoryDomainServiceResource) dataSource(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
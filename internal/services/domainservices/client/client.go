@@ -1,17 +1,16 @@
 package client
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/domainservices/mgmt/2020-01-01/aad"
-
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/domainservices/sdk/2021-05-01/domainservices"
 )
 
 type Client struct {
-	DomainServicesClient *aad.DomainServicesClient
+	DomainServicesClient *domainservices.DomainServicesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
-	domainServicesClient := aad.NewDomainServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	domainServicesClient := domainservices.NewDomainServicesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&domainServicesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
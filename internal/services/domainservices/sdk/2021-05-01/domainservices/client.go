@@ -0,0 +1,15 @@
+package domainservices
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DomainServicesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDomainServicesClientWithBaseURI(endpoint string) DomainServicesClient {
+	return DomainServicesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,17 @@
+package domainservices
+
+type DomainServiceProperties struct {
+	DeploymentId            *string                 `json:"deploymentId,omitempty"`
+	DomainConfigurationType *string                 `json:"domainConfigurationType,omitempty"`
+	DomainName              *string                 `json:"domainName,omitempty"`
+	DomainSecuritySettings  *DomainSecuritySettings `json:"domainSecuritySettings,omitempty"`
+	FilteredSync            *FilteredSync           `json:"filteredSync,omitempty"`
+	LdapsSettings           *LdapsSettings          `json:"ldapsSettings,omitempty"`
+	NotificationSettings    *NotificationSettings   `json:"notificationSettings,omitempty"`
+	ProvisioningState       *string                 `json:"provisioningState,omitempty"`
+	ReplicaSets             *[]ReplicaSet           `json:"replicaSets,omitempty"`
+	Sku                     *string                 `json:"sku,omitempty"`
+	SyncOwner               *string                 `json:"syncOwner,omitempty"`
+	TenantId                *string                 `json:"tenantId,omitempty"`
+	Version                 *int64                  `json:"version,omitempty"`
+}
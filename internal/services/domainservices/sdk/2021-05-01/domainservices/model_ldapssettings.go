@@ -0,0 +1,11 @@
+package domainservices
+
+type LdapsSettings struct {
+	CertificateNotAfter    *string         `json:"certificateNotAfter,omitempty"`
+	CertificateThumbprint  *string         `json:"certificateThumbprint,omitempty"`
+	ExternalAccess         *ExternalAccess `json:"externalAccess,omitempty"`
+	Ldaps                  *Ldaps          `json:"ldaps,omitempty"`
+	PfxCertificate         *string         `json:"pfxCertificate,omitempty"`
+	PfxCertificatePassword *string         `json:"pfxCertificatePassword,omitempty"`
+	PublicCertificate      *string         `json:"publicCertificate,omitempty"`
+}
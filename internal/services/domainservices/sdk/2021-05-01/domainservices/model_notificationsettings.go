@@ -0,0 +1,7 @@
+package domainservices
+
+type NotificationSettings struct {
+	AdditionalRecipients *[]string           `json:"additionalRecipients,omitempty"`
+	NotifyDcAdmins       *NotifyDcAdmins     `json:"notifyDcAdmins,omitempty"`
+	NotifyGlobalAdmins   *NotifyGlobalAdmins `json:"notifyGlobalAdmins,omitempty"`
+}
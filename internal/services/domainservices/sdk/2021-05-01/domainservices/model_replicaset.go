@@ -0,0 +1,12 @@
+package domainservices
+
+type ReplicaSet struct {
+	DomainControllerIPAddress *[]string `json:"domainControllerIpAddress,omitempty"`
+	ExternalAccessIPAddress   *string   `json:"externalAccessIpAddress,omitempty"`
+	HealthLastEvaluated       *string   `json:"healthLastEvaluated,omitempty"`
+	Location                  *string   `json:"location,omitempty"`
+	ReplicaSetId              *string   `json:"replicaSetId,omitempty"`
+	ServiceStatus             *string   `json:"serviceStatus,omitempty"`
+	SubnetId                  *string   `json:"subnetId,omitempty"`
+	VnetSiteId                *string   `json:"vnetSiteId,omitempty"`
+}
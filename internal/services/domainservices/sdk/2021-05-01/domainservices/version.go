@@ -0,0 +1,9 @@
+package domainservices
+
+import "fmt"
+
+const defaultApiVersion = "2021-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/domainservices/%s", defaultApiVersion)
+}
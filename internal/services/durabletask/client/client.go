@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/sdk/2025-11-01/schedulers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/sdk/2025-11-01/taskhubs"
+)
+
+type Client struct {
+	SchedulersClient *schedulers.SchedulersClient
+	TaskHubsClient   *taskhubs.TaskHubsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	schedulersClient := schedulers.NewSchedulersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&schedulersClient.Client, o.ResourceManagerAuthorizer)
+
+	taskHubsClient := taskhubs.NewTaskHubsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&taskHubsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		SchedulersClient: &schedulersClient,
+		TaskHubsClient:   &taskHubsClient,
+	}
+}
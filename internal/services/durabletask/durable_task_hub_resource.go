@@ -0,0 +1,162 @@
+package durabletask
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/sdk/2025-11-01/schedulers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/sdk/2025-11-01/taskhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DurableTaskHubResource struct{}
+
+var _ sdk.Resource = DurableTaskHubResource{}
+
+type DurableTaskHubModel struct {
+	Name         string `tfschema:"name"`
+	SchedulerId  string `tfschema:"scheduler_id"`
+	DashboardUrl string `tfschema:"dashboard_url"`
+}
+
+func (r DurableTaskHubResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,62}[a-zA-Z0-9]$`),
+				"`name` must be between 3 and 64 characters, can contain only letters, numbers and hyphens, and must start and end with a letter or number",
+			),
+		},
+
+		"scheduler_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: schedulers.ValidateSchedulerID,
+		},
+	}
+}
+
+func (r DurableTaskHubResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dashboard_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DurableTaskHubResource) ModelObject() interface{} {
+	return &DurableTaskHubModel{}
+}
+
+func (r DurableTaskHubResource) ResourceType() string {
+	return "azurerm_durable_task_hub"
+}
+
+func (r DurableTaskHubResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return taskhubs.ValidateTaskHubID
+}
+
+func (r DurableTaskHubResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DurableTask.TaskHubsClient
+
+			var model DurableTaskHubModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			schedulerId, err := schedulers.ParseSchedulerID(model.SchedulerId)
+			if err != nil {
+				return err
+			}
+
+			id := taskhubs.NewTaskHubID(schedulerId.SubscriptionId, schedulerId.ResourceGroupName, schedulerId.SchedulerName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := taskhubs.TaskHub{
+				Properties: &taskhubs.TaskHubProperties{},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DurableTaskHubResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DurableTask.TaskHubsClient
+
+			id, err := taskhubs.ParseTaskHubID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DurableTaskHubModel{
+				Name:        id.TaskHubName,
+				SchedulerId: schedulers.NewSchedulerID(id.SubscriptionId, id.ResourceGroupName, id.SchedulerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.DashboardUrl = utils.NormalizeNilableString(props.DashboardUrl)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DurableTaskHubResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DurableTask.TaskHubsClient
+
+			id, err := taskhubs.ParseTaskHubID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
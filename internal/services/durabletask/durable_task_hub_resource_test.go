@@ -0,0 +1,155 @@
+package durabletask_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/sdk/2025-11-01/taskhubs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DurableTaskHubResource struct{}
+
+func TestAccDurableTaskHub_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_durable_task_hub", "test")
+	r := DurableTaskHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dashboard_url").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDurableTaskHub_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_durable_task_hub", "test")
+	r := DurableTaskHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDurableTaskHub_functionApp(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_durable_task_hub", "test")
+	r := DurableTaskHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.functionApp(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DurableTaskHubResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := taskhubs.ParseTaskHubID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DurableTask.TaskHubsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DurableTaskHubResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_durable_task_hub" "test" {
+  name         = "acctestdth-%d"
+  scheduler_id = azurerm_durable_task_scheduler.test.id
+}
+`, DurableTaskSchedulerResource{}.basic(data), data.RandomInteger)
+}
+
+func (r DurableTaskHubResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_durable_task_hub" "import" {
+  name         = azurerm_durable_task_hub.test.name
+  scheduler_id = azurerm_durable_task_hub.test.scheduler_id
+}
+`, r.basic(data))
+}
+
+func (r DurableTaskHubResource) functionApp(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  kind                = "elastic"
+  reserved            = true
+
+  sku {
+    tier = "ElasticPremium"
+    size = "EP1"
+  }
+}
+
+resource "azurerm_function_app" "test" {
+  name                       = "acctest-%[3]d-func"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  app_service_plan_id        = azurerm_app_service_plan.test.id
+  storage_account_name       = azurerm_storage_account.test.name
+  storage_account_access_key = azurerm_storage_account.test.primary_access_key
+  os_type                    = "linux"
+  version                    = "~4"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  app_settings = {
+    DURABLE_TASK_SCHEDULER_CONNECTION_STRING = "Endpoint=${azurerm_durable_task_scheduler.test.endpoint};Authentication=ManagedIdentity"
+    TASKHUB_NAME                             = azurerm_durable_task_hub.test.name
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_durable_task_hub.test.id
+  role_definition_name = "Durable Task Data Contributor"
+  principal_id         = azurerm_function_app.test.identity.0.principal_id
+}
+`, r.basic(data), data.RandomString, data.RandomInteger)
+}
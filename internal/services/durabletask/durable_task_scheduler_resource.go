@@ -0,0 +1,279 @@
+package durabletask
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/sdk/2025-11-01/schedulers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DurableTaskSchedulerResource struct{}
+
+var _ sdk.ResourceWithUpdate = DurableTaskSchedulerResource{}
+
+type DurableTaskSchedulerModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	SkuName           string            `tfschema:"sku_name"`
+	Capacity          int64             `tfschema:"capacity"`
+	IPAllowList       []string          `tfschema:"ip_allow_list"`
+	Tags              map[string]string `tfschema:"tags"`
+	Endpoint          string            `tfschema:"endpoint"`
+	RedundancyState   string            `tfschema:"redundancy_state"`
+}
+
+func (r DurableTaskSchedulerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,62}[a-zA-Z0-9]$`),
+				"`name` must be between 3 and 64 characters, can contain only letters, numbers and hyphens, and must start and end with a letter or number",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice(
+				schedulers.PossibleValuesForSchedulerSkuName(),
+				false,
+			),
+		},
+
+		// capacity is only configurable for the Dedicated SKU, where each unit is a Capacity Unit
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 3),
+		},
+
+		"ip_allow_list": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r DurableTaskSchedulerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"redundancy_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DurableTaskSchedulerResource) ModelObject() interface{} {
+	return &DurableTaskSchedulerModel{}
+}
+
+func (r DurableTaskSchedulerResource) ResourceType() string {
+	return "azurerm_durable_task_scheduler"
+}
+
+func (r DurableTaskSchedulerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schedulers.ValidateSchedulerID
+}
+
+func (r DurableTaskSchedulerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DurableTask.SchedulersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model DurableTaskSchedulerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := schedulers.NewSchedulerID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := schedulers.Scheduler{
+				Location: location.Normalize(model.Location),
+				Properties: &schedulers.SchedulerProperties{
+					IPAllowlist: expandDurableTaskSchedulerIPAllowList(model.IPAllowList),
+					Sku: schedulers.SchedulerSku{
+						Name: schedulers.SchedulerSkuName(model.SkuName),
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.Capacity > 0 {
+				payload.Properties.Sku.Capacity = utils.Int64(model.Capacity)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r DurableTaskSchedulerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DurableTask.SchedulersClient
+
+			id, err := schedulers.ParseSchedulerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := DurableTaskSchedulerModel{
+				Name:              id.SchedulerName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+
+				if props := model.Properties; props != nil {
+					state.Endpoint = utils.NormalizeNilableString(props.Endpoint)
+					state.IPAllowList = props.IPAllowlist
+
+					state.SkuName = string(props.Sku.Name)
+					if props.Sku.Capacity != nil {
+						state.Capacity = *props.Sku.Capacity
+					}
+					if props.Sku.RedundancyState != nil {
+						state.RedundancyState = string(*props.Sku.RedundancyState)
+					}
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DurableTaskSchedulerResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DurableTask.SchedulersClient
+
+			id, err := schedulers.ParseSchedulerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DurableTaskSchedulerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("capacity") {
+				payload.Properties.Sku.Capacity = utils.Int64(model.Capacity)
+			}
+
+			if metadata.ResourceData.HasChange("ip_allow_list") {
+				payload.Properties.IPAllowlist = expandDurableTaskSchedulerIPAllowList(model.IPAllowList)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DurableTaskSchedulerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.DurableTask.SchedulersClient
+
+			id, err := schedulers.ParseSchedulerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDurableTaskSchedulerIPAllowList(input []string) []string {
+	// the API requires an allow list to be specified, an empty list would block all traffic so we default to allowing all
+	if len(input) == 0 {
+		return []string{"0.0.0.0/0"}
+	}
+
+	return input
+}
@@ -0,0 +1,184 @@
+package durabletask_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/durabletask/sdk/2025-11-01/schedulers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DurableTaskSchedulerResource struct{}
+
+func TestAccDurableTaskScheduler_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_durable_task_scheduler", "test")
+	r := DurableTaskSchedulerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("endpoint").Exists(),
+				check.That(data.ResourceName).Key("ip_allow_list.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDurableTaskScheduler_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_durable_task_scheduler", "test")
+	r := DurableTaskSchedulerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDurableTaskScheduler_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_durable_task_scheduler", "test")
+	r := DurableTaskSchedulerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("capacity").HasValue("1"),
+				check.That(data.ResourceName).Key("redundancy_state").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDurableTaskScheduler_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_durable_task_scheduler", "test")
+	r := DurableTaskSchedulerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.completeUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("capacity").HasValue("3"),
+				check.That(data.ResourceName).Key("ip_allow_list.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r DurableTaskSchedulerResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := schedulers.ParseSchedulerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.DurableTask.SchedulersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DurableTaskSchedulerResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_durable_task_scheduler" "test" {
+  name                = "acctestdts-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Consumption"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r DurableTaskSchedulerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_durable_task_scheduler" "import" {
+  name                = azurerm_durable_task_scheduler.test.name
+  resource_group_name = azurerm_durable_task_scheduler.test.resource_group_name
+  location            = azurerm_durable_task_scheduler.test.location
+  sku_name            = azurerm_durable_task_scheduler.test.sku_name
+}
+`, r.basic(data))
+}
+
+func (r DurableTaskSchedulerResource) complete(data acceptance.TestData, capacity int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_durable_task_scheduler" "test" {
+  name                = "acctestdts-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Dedicated"
+  capacity            = %d
+  ip_allow_list       = ["10.0.0.0/16", "192.168.1.0/24"]
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, capacity)
+}
+
+func (r DurableTaskSchedulerResource) completeUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_durable_task_scheduler" "test" {
+  name                = "acctestdts-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "Dedicated"
+  capacity            = 3
+  ip_allow_list       = ["10.0.0.0/16"]
+
+  tags = {
+    ENV = "Prod"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (DurableTaskSchedulerResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-dts-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
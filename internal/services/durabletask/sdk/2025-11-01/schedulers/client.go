@@ -0,0 +1,15 @@
+package schedulers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SchedulersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSchedulersClientWithBaseURI(endpoint string) SchedulersClient {
+	return SchedulersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
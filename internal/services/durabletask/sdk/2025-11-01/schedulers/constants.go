@@ -0,0 +1,102 @@
+package schedulers
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted     ProvisioningState = "Accepted"
+	ProvisioningStateCanceled     ProvisioningState = "Canceled"
+	ProvisioningStateDeleting     ProvisioningState = "Deleting"
+	ProvisioningStateFailed       ProvisioningState = "Failed"
+	ProvisioningStateProvisioning ProvisioningState = "Provisioning"
+	ProvisioningStateSucceeded    ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating     ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateProvisioning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":     ProvisioningStateAccepted,
+		"canceled":     ProvisioningStateCanceled,
+		"deleting":     ProvisioningStateDeleting,
+		"failed":       ProvisioningStateFailed,
+		"provisioning": ProvisioningStateProvisioning,
+		"succeeded":    ProvisioningStateSucceeded,
+		"updating":     ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type RedundancyState string
+
+const (
+	RedundancyStateNone RedundancyState = "None"
+	RedundancyStateZone RedundancyState = "Zone"
+)
+
+func PossibleValuesForRedundancyState() []string {
+	return []string{
+		string(RedundancyStateNone),
+		string(RedundancyStateZone),
+	}
+}
+
+func parseRedundancyState(input string) (*RedundancyState, error) {
+	vals := map[string]RedundancyState{
+		"none": RedundancyStateNone,
+		"zone": RedundancyStateZone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RedundancyState(input)
+	return &out, nil
+}
+
+type SchedulerSkuName string
+
+const (
+	SchedulerSkuNameConsumption SchedulerSkuName = "Consumption"
+	SchedulerSkuNameDedicated   SchedulerSkuName = "Dedicated"
+)
+
+func PossibleValuesForSchedulerSkuName() []string {
+	return []string{
+		string(SchedulerSkuNameConsumption),
+		string(SchedulerSkuNameDedicated),
+	}
+}
+
+func parseSchedulerSkuName(input string) (*SchedulerSkuName, error) {
+	vals := map[string]SchedulerSkuName{
+		"consumption": SchedulerSkuNameConsumption,
+		"dedicated":   SchedulerSkuNameDedicated,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SchedulerSkuName(input)
+	return &out, nil
+}
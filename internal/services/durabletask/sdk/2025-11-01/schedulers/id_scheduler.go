@@ -0,0 +1,124 @@
+package schedulers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SchedulerId{}
+
+// SchedulerId is a struct representing the Resource ID for a Scheduler
+type SchedulerId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	SchedulerName     string
+}
+
+// NewSchedulerID returns a new SchedulerId struct
+func NewSchedulerID(subscriptionId string, resourceGroupName string, schedulerName string) SchedulerId {
+	return SchedulerId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		SchedulerName:     schedulerName,
+	}
+}
+
+// ParseSchedulerID parses 'input' into a SchedulerId
+func ParseSchedulerID(input string) (*SchedulerId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SchedulerId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SchedulerId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.SchedulerName, ok = parsed.Parsed["schedulerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'schedulerName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseSchedulerIDInsensitively parses 'input' case-insensitively into a SchedulerId
+// note: this method should only be used for API response data and not user input
+func ParseSchedulerIDInsensitively(input string) (*SchedulerId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SchedulerId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SchedulerId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.SchedulerName, ok = parsed.Parsed["schedulerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'schedulerName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateSchedulerID checks that 'input' can be parsed as a Scheduler ID
+func ValidateSchedulerID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSchedulerID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scheduler ID
+func (id SchedulerId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DurableTask/schedulers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.SchedulerName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scheduler ID
+func (id SchedulerId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDurableTask", "Microsoft.DurableTask", "Microsoft.DurableTask"),
+		resourceids.StaticSegment("staticSchedulers", "schedulers", "schedulers"),
+		resourceids.UserSpecifiedSegment("schedulerName", "schedulerValue"),
+	}
+}
+
+// String returns a human-readable description of this Scheduler ID
+func (id SchedulerId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Scheduler Name: %q", id.SchedulerName),
+	}
+	return fmt.Sprintf("Scheduler (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,8 @@
+package schedulers
+
+type SchedulerProperties struct {
+	Endpoint          *string            `json:"endpoint,omitempty"`
+	IPAllowlist       []string           `json:"ipAllowlist"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Sku               SchedulerSku       `json:"sku"`
+}
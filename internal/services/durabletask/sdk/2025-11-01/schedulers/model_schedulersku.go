@@ -0,0 +1,7 @@
+package schedulers
+
+type SchedulerSku struct {
+	Capacity        *int64           `json:"capacity,omitempty"`
+	Name            SchedulerSkuName `json:"name"`
+	RedundancyState *RedundancyState `json:"redundancyState,omitempty"`
+}
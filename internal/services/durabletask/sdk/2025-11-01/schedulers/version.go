@@ -0,0 +1,9 @@
+package schedulers
+
+import "fmt"
+
+const defaultApiVersion = "2025-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/schedulers/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package taskhubs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type TaskHubsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewTaskHubsClientWithBaseURI(endpoint string) TaskHubsClient {
+	return TaskHubsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,137 @@
+package taskhubs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = TaskHubId{}
+
+// TaskHubId is a struct representing the Resource ID for a Task Hub
+type TaskHubId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	SchedulerName     string
+	TaskHubName       string
+}
+
+// NewTaskHubID returns a new TaskHubId struct
+func NewTaskHubID(subscriptionId string, resourceGroupName string, schedulerName string, taskHubName string) TaskHubId {
+	return TaskHubId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		SchedulerName:     schedulerName,
+		TaskHubName:       taskHubName,
+	}
+}
+
+// ParseTaskHubID parses 'input' into a TaskHubId
+func ParseTaskHubID(input string) (*TaskHubId, error) {
+	parser := resourceids.NewParserFromResourceIdType(TaskHubId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := TaskHubId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.SchedulerName, ok = parsed.Parsed["schedulerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'schedulerName' was not found in the resource id %q", input)
+	}
+
+	if id.TaskHubName, ok = parsed.Parsed["taskHubName"]; !ok {
+		return nil, fmt.Errorf("the segment 'taskHubName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseTaskHubIDInsensitively parses 'input' case-insensitively into a TaskHubId
+// note: this method should only be used for API response data and not user input
+func ParseTaskHubIDInsensitively(input string) (*TaskHubId, error) {
+	parser := resourceids.NewParserFromResourceIdType(TaskHubId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := TaskHubId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.SchedulerName, ok = parsed.Parsed["schedulerName"]; !ok {
+		return nil, fmt.Errorf("the segment 'schedulerName' was not found in the resource id %q", input)
+	}
+
+	if id.TaskHubName, ok = parsed.Parsed["taskHubName"]; !ok {
+		return nil, fmt.Errorf("the segment 'taskHubName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateTaskHubID checks that 'input' can be parsed as a Task Hub ID
+func ValidateTaskHubID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseTaskHubID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Task Hub ID
+func (id TaskHubId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DurableTask/schedulers/%s/taskHubs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.SchedulerName, id.TaskHubName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Task Hub ID
+func (id TaskHubId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftDurableTask", "Microsoft.DurableTask", "Microsoft.DurableTask"),
+		resourceids.StaticSegment("staticSchedulers", "schedulers", "schedulers"),
+		resourceids.UserSpecifiedSegment("schedulerName", "schedulerValue"),
+		resourceids.StaticSegment("staticTaskHubs", "taskHubs", "taskHubs"),
+		resourceids.UserSpecifiedSegment("taskHubName", "taskHubValue"),
+	}
+}
+
+// String returns a human-readable description of this Task Hub ID
+func (id TaskHubId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Scheduler Name: %q", id.SchedulerName),
+		fmt.Sprintf("Task Hub Name: %q", id.TaskHubName),
+	}
+	return fmt.Sprintf("Task Hub (%s)", strings.Join(components, "\n"))
+}
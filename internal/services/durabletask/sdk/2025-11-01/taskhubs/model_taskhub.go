@@ -0,0 +1,8 @@
+package taskhubs
+
+type TaskHub struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *TaskHubProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
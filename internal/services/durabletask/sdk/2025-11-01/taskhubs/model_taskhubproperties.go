@@ -0,0 +1,6 @@
+package taskhubs
+
+type TaskHubProperties struct {
+	DashboardUrl      *string            `json:"dashboardUrl,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
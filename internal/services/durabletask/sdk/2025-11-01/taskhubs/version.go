@@ -0,0 +1,9 @@
+package taskhubs
+
+import "fmt"
+
+const defaultApiVersion = "2025-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/taskhubs/%s", defaultApiVersion)
+}
@@ -0,0 +1,358 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/elasticsans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSanResource struct{}
+
+var _ sdk.ResourceWithUpdate = ElasticSanResource{}
+
+type ElasticSanResourceModel struct {
+	Name                       string               `tfschema:"name"`
+	ResourceGroupName          string               `tfschema:"resource_group_name"`
+	Location                   string               `tfschema:"location"`
+	BaseSizeInTiB              int                  `tfschema:"base_size_in_tib"`
+	ExtendedSizeInTiB          int                  `tfschema:"extended_size_in_tib"`
+	Sku                        []ElasticSanSkuModel `tfschema:"sku"`
+	Zones                      []string             `tfschema:"zones"`
+	PublicNetworkAccessEnabled bool                 `tfschema:"public_network_access_enabled"`
+	Tags                       map[string]string    `tfschema:"tags"`
+	TotalIops                  int                  `tfschema:"total_iops"`
+	TotalMBps                  int                  `tfschema:"total_mbps"`
+	TotalSizeInTiB             int                  `tfschema:"total_size_in_tib"`
+	TotalVolumeSizeInGiB       int                  `tfschema:"total_volume_size_in_gib"`
+	VolumeGroupCount           int                  `tfschema:"volume_group_count"`
+}
+
+type ElasticSanSkuModel struct {
+	Name string `tfschema:"name"`
+	Tier string `tfschema:"tier"`
+}
+
+func (r ElasticSanResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,22}[a-z0-9]$`),
+				"`name` must be between 3 and 24 characters, start and end with a lowercase letter or number and may only contain lowercase letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"base_size_in_tib": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+
+		"sku": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(elasticsans.PossibleValuesForSkuName(), false),
+					},
+
+					"tier": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(elasticsans.SkuTierPremium),
+						ValidateFunc: validation.StringInSlice(elasticsans.PossibleValuesForSkuTier(), false),
+					},
+				},
+			},
+		},
+
+		"extended_size_in_tib": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 100),
+		},
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		// Premium_ZRS SANs are spread across zones by the platform, so these can only be specified for Premium_LRS
+		"zones": azure.SchemaSingleZone(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ElasticSanResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"total_iops": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_mbps": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_size_in_tib": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"total_volume_size_in_gib": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"volume_group_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSanResource) ModelObject() interface{} {
+	return &ElasticSanResourceModel{}
+}
+
+func (r ElasticSanResource) ResourceType() string {
+	return "azurerm_elastic_san"
+}
+
+func (r ElasticSanResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return elasticsans.ValidateElasticSanID
+}
+
+func (r ElasticSanResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ElasticSanResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := elasticsans.NewElasticSanID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := elasticsans.ElasticSan{
+				Location: location.Normalize(model.Location),
+				Properties: elasticsans.ElasticSanProperties{
+					BaseSizeTiB:             int64(model.BaseSizeInTiB),
+					ExtendedCapacitySizeTiB: int64(model.ExtendedSizeInTiB),
+					PublicNetworkAccess:     expandElasticSanPublicNetworkAccess(model.PublicNetworkAccessEnabled),
+					Sku:                     expandElasticSanSku(model.Sku),
+				},
+				Tags: &model.Tags,
+			}
+			if len(model.Zones) > 0 {
+				payload.Properties.AvailabilityZones = &model.Zones
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+
+			id, err := elasticsans.ParseElasticSanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ElasticSanResourceModel{
+				Name:              id.ElasticSanName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.BaseSizeInTiB = int(props.BaseSizeTiB)
+				state.ExtendedSizeInTiB = int(props.ExtendedCapacitySizeTiB)
+				state.PublicNetworkAccessEnabled = props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == elasticsans.PublicNetworkAccessEnabled
+				state.Sku = flattenElasticSanSku(props.Sku)
+				if props.AvailabilityZones != nil {
+					state.Zones = *props.AvailabilityZones
+				}
+				if props.TotalIops != nil {
+					state.TotalIops = int(*props.TotalIops)
+				}
+				if props.TotalMBps != nil {
+					state.TotalMBps = int(*props.TotalMBps)
+				}
+				if props.TotalSizeTiB != nil {
+					state.TotalSizeInTiB = int(*props.TotalSizeTiB)
+				}
+				if props.TotalVolumeSizeGiB != nil {
+					state.TotalVolumeSizeInGiB = int(*props.TotalVolumeSizeGiB)
+				}
+				if props.VolumeGroupCount != nil {
+					state.VolumeGroupCount = int(*props.VolumeGroupCount)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSanResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+
+			id, err := elasticsans.ParseElasticSanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ElasticSanResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := elasticsans.ElasticSanUpdate{
+				Properties: &elasticsans.ElasticSanUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("base_size_in_tib") {
+				payload.Properties.BaseSizeTiB = utils.Int64(int64(model.BaseSizeInTiB))
+			}
+
+			if metadata.ResourceData.HasChange("extended_size_in_tib") {
+				payload.Properties.ExtendedCapacitySizeTiB = utils.Int64(int64(model.ExtendedSizeInTiB))
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				payload.Properties.PublicNetworkAccess = expandElasticSanPublicNetworkAccess(model.PublicNetworkAccessEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.ElasticSansClient
+
+			id, err := elasticsans.ParseElasticSanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandElasticSanPublicNetworkAccess(input bool) *elasticsans.PublicNetworkAccess {
+	publicNetworkAccess := elasticsans.PublicNetworkAccessDisabled
+	if input {
+		publicNetworkAccess = elasticsans.PublicNetworkAccessEnabled
+	}
+	return &publicNetworkAccess
+}
+
+func expandElasticSanSku(input []ElasticSanSkuModel) elasticsans.Sku {
+	if len(input) == 0 {
+		return elasticsans.Sku{}
+	}
+
+	sku := elasticsans.Sku{
+		Name: elasticsans.SkuName(input[0].Name),
+	}
+	if input[0].Tier != "" {
+		tier := elasticsans.SkuTier(input[0].Tier)
+		sku.Tier = &tier
+	}
+	return sku
+}
+
+func flattenElasticSanSku(input elasticsans.Sku) []ElasticSanSkuModel {
+	tier := ""
+	if input.Tier != nil {
+		tier = string(*input.Tier)
+	}
+
+	return []ElasticSanSkuModel{
+		{
+			Name: string(input.Name),
+			Tier: tier,
+		},
+	}
+}
@@ -0,0 +1,451 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumegroups"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSanVolumeGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = ElasticSanVolumeGroupResource{}
+
+type ElasticSanVolumeGroupResourceModel struct {
+	Name           string                                 `tfschema:"name"`
+	ElasticSanId   string                                 `tfschema:"elastic_san_id"`
+	EncryptionType string                                 `tfschema:"encryption_type"`
+	Encryption     []ElasticSanVolumeGroupEncryptionModel `tfschema:"encryption"`
+	NetworkRule    []ElasticSanVolumeGroupNetworkRule     `tfschema:"network_rule"`
+	ProtocolType   string                                 `tfschema:"protocol_type"`
+}
+
+type ElasticSanVolumeGroupEncryptionModel struct {
+	KeyVaultKeyId                 string `tfschema:"key_vault_key_id"`
+	UserAssignedIdentityId        string `tfschema:"user_assigned_identity_id"`
+	CurrentVersionedKeyId         string `tfschema:"current_versioned_key_id"`
+	CurrentVersionedKeyExpiration string `tfschema:"current_versioned_key_expiration_timestamp"`
+	LastKeyRotationTimestamp      string `tfschema:"last_key_rotation_timestamp"`
+}
+
+type ElasticSanVolumeGroupNetworkRule struct {
+	SubnetId string `tfschema:"subnet_id"`
+	Action   string `tfschema:"action"`
+}
+
+func (r ElasticSanVolumeGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,61}[a-z0-9]$`),
+				"`name` must be between 3 and 63 characters, start and end with a lowercase letter or number and may only contain lowercase letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"elastic_san_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: volumegroups.ValidateElasticSanID,
+		},
+
+		"encryption_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(volumegroups.EncryptionTypeEncryptionAtRestWithPlatformKey),
+			ValidateFunc: validation.StringInSlice(volumegroups.PossibleValuesForEncryptionType(), false),
+		},
+
+		"encryption": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_vault_key_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+
+					"current_versioned_key_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"current_versioned_key_expiration_timestamp": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"last_key_rotation_timestamp": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemOrUserAssignedIdentity(),
+
+		"network_rule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(volumegroups.ActionAllow),
+						ValidateFunc: validation.StringInSlice(volumegroups.PossibleValuesForAction(), false),
+					},
+				},
+			},
+		},
+
+		"protocol_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(volumegroups.StorageTargetTypeIscsi),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(volumegroups.StorageTargetTypeIscsi),
+			}, false),
+		},
+	}
+}
+
+func (r ElasticSanVolumeGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ElasticSanVolumeGroupResource) ModelObject() interface{} {
+	return &ElasticSanVolumeGroupResourceModel{}
+}
+
+func (r ElasticSanVolumeGroupResource) ResourceType() string {
+	return "azurerm_elastic_san_volume_group"
+}
+
+func (r ElasticSanVolumeGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return volumegroups.ValidateVolumeGroupID
+}
+
+func (r ElasticSanVolumeGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			var model ElasticSanVolumeGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			elasticSanId, err := volumegroups.ParseElasticSanID(model.ElasticSanId)
+			if err != nil {
+				return err
+			}
+
+			id := volumegroups.NewVolumeGroupID(elasticSanId.SubscriptionId, elasticSanId.ResourceGroupName, elasticSanId.ElasticSanName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemOrUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			encryptionProperties, err := expandElasticSanVolumeGroupEncryption(model.EncryptionType, model.Encryption)
+			if err != nil {
+				return err
+			}
+
+			encryptionType := volumegroups.EncryptionType(model.EncryptionType)
+			protocolType := volumegroups.StorageTargetType(model.ProtocolType)
+			payload := volumegroups.VolumeGroup{
+				Identity: expandedIdentity,
+				Properties: &volumegroups.VolumeGroupProperties{
+					Encryption:           &encryptionType,
+					EncryptionProperties: encryptionProperties,
+					NetworkAcls:          expandElasticSanVolumeGroupNetworkRules(model.NetworkRule),
+					ProtocolType:         &protocolType,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			id, err := volumegroups.ParseVolumeGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ElasticSanVolumeGroupResourceModel{
+				Name:         id.VolumeGroupName,
+				ElasticSanId: volumegroups.NewElasticSanID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				flattenedIdentity, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					if props.Encryption != nil {
+						state.EncryptionType = string(*props.Encryption)
+					}
+					if props.ProtocolType != nil {
+						state.ProtocolType = string(*props.ProtocolType)
+					}
+
+					encryption, err := flattenElasticSanVolumeGroupEncryption(props.EncryptionProperties)
+					if err != nil {
+						return err
+					}
+					state.Encryption = encryption
+					state.NetworkRule = flattenElasticSanVolumeGroupNetworkRules(props.NetworkAcls)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSanVolumeGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			id, err := volumegroups.ParseVolumeGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ElasticSanVolumeGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := volumegroups.VolumeGroupUpdate{
+				Properties: &volumegroups.VolumeGroupUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemOrUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChanges("encryption_type", "encryption") {
+				encryptionProperties, err := expandElasticSanVolumeGroupEncryption(model.EncryptionType, model.Encryption)
+				if err != nil {
+					return err
+				}
+
+				encryptionType := volumegroups.EncryptionType(model.EncryptionType)
+				payload.Properties.Encryption = &encryptionType
+				payload.Properties.EncryptionProperties = encryptionProperties
+			}
+
+			if metadata.ResourceData.HasChange("network_rule") {
+				payload.Properties.NetworkAcls = expandElasticSanVolumeGroupNetworkRules(model.NetworkRule)
+			}
+
+			if metadata.ResourceData.HasChange("protocol_type") {
+				protocolType := volumegroups.StorageTargetType(model.ProtocolType)
+				payload.Properties.ProtocolType = &protocolType
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumeGroupsClient
+
+			id, err := volumegroups.ParseVolumeGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandElasticSanVolumeGroupEncryption(encryptionType string, input []ElasticSanVolumeGroupEncryptionModel) (*volumegroups.EncryptionProperties, error) {
+	if len(input) == 0 {
+		if encryptionType == string(volumegroups.EncryptionTypeEncryptionAtRestWithCustomerManagedKey) {
+			return nil, fmt.Errorf("an `encryption` block must be specified when `encryption_type` is set to %q", encryptionType)
+		}
+		return nil, nil
+	}
+
+	if encryptionType != string(volumegroups.EncryptionTypeEncryptionAtRestWithCustomerManagedKey) {
+		return nil, fmt.Errorf("an `encryption` block can only be specified when `encryption_type` is set to %q", string(volumegroups.EncryptionTypeEncryptionAtRestWithCustomerManagedKey))
+	}
+
+	v := input[0]
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(v.KeyVaultKeyId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+	}
+
+	result := volumegroups.EncryptionProperties{
+		KeyVaultProperties: &volumegroups.KeyVaultProperties{
+			KeyName:     utils.String(keyId.Name),
+			KeyVaultUri: utils.String(keyId.KeyVaultBaseUrl),
+		},
+	}
+
+	// omitting the version opts into automatic key rotation
+	if keyId.Version != "" {
+		result.KeyVaultProperties.KeyVersion = utils.String(keyId.Version)
+	}
+
+	if v.UserAssignedIdentityId != "" {
+		result.EncryptionIdentity = &volumegroups.EncryptionIdentity{
+			UserAssignedIdentity: utils.String(v.UserAssignedIdentityId),
+		}
+	}
+
+	return &result, nil
+}
+
+func flattenElasticSanVolumeGroupEncryption(input *volumegroups.EncryptionProperties) ([]ElasticSanVolumeGroupEncryptionModel, error) {
+	if input == nil || input.KeyVaultProperties == nil {
+		return []ElasticSanVolumeGroupEncryptionModel{}, nil
+	}
+
+	props := input.KeyVaultProperties
+	keyId, err := keyVaultParse.NewNestedItemID(utils.NormalizeNilableString(props.KeyVaultUri), "keys", utils.NormalizeNilableString(props.KeyName), utils.NormalizeNilableString(props.KeyVersion))
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Key Vault Key ID: %+v", err)
+	}
+
+	result := ElasticSanVolumeGroupEncryptionModel{
+		KeyVaultKeyId:                 keyId.ID(),
+		CurrentVersionedKeyId:         utils.NormalizeNilableString(props.CurrentVersionedKeyIdentifier),
+		CurrentVersionedKeyExpiration: utils.NormalizeNilableString(props.CurrentVersionedKeyExpirationTimestamp),
+		LastKeyRotationTimestamp:      utils.NormalizeNilableString(props.LastKeyRotationTimestamp),
+	}
+
+	if input.EncryptionIdentity != nil && input.EncryptionIdentity.UserAssignedIdentity != nil {
+		identityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.EncryptionIdentity.UserAssignedIdentity)
+		if err != nil {
+			return nil, err
+		}
+		result.UserAssignedIdentityId = identityId.ID()
+	}
+
+	return []ElasticSanVolumeGroupEncryptionModel{result}, nil
+}
+
+func expandElasticSanVolumeGroupNetworkRules(input []ElasticSanVolumeGroupNetworkRule) *volumegroups.NetworkRuleSet {
+	rules := make([]volumegroups.VirtualNetworkRule, 0)
+	for _, v := range input {
+		action := volumegroups.Action(v.Action)
+		rules = append(rules, volumegroups.VirtualNetworkRule{
+			Action: &action,
+			Id:     v.SubnetId,
+		})
+	}
+
+	return &volumegroups.NetworkRuleSet{
+		VirtualNetworkRules: &rules,
+	}
+}
+
+func flattenElasticSanVolumeGroupNetworkRules(input *volumegroups.NetworkRuleSet) []ElasticSanVolumeGroupNetworkRule {
+	results := make([]ElasticSanVolumeGroupNetworkRule, 0)
+	if input == nil || input.VirtualNetworkRules == nil {
+		return results
+	}
+
+	for _, v := range *input.VirtualNetworkRules {
+		action := string(volumegroups.ActionAllow)
+		if v.Action != nil {
+			action = string(*v.Action)
+		}
+		results = append(results, ElasticSanVolumeGroupNetworkRule{
+			SubnetId: v.Id,
+			Action:   action,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,273 @@
+package elasticsan_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSanVolumeGroupResource struct{}
+
+func TestAccElasticSanVolumeGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_group", "test")
+	r := ElasticSanVolumeGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("protocol_type").HasValue("Iscsi"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSanVolumeGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_group", "test")
+	r := ElasticSanVolumeGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccElasticSanVolumeGroup_networkRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_group", "test")
+	r := ElasticSanVolumeGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.networkRules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_rule.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSanVolumeGroup_customerManagedKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_group", "test")
+	r := ElasticSanVolumeGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customerManagedKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("encryption.0.current_versioned_key_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ElasticSanVolumeGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := volumegroups.ParseVolumeGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ElasticSan.VolumeGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ElasticSanVolumeGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san" "test" {
+  name                = "acctestesan%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  base_size_in_tib    = 1
+
+  sku {
+    name = "Premium_LRS"
+  }
+}
+`, ElasticSanResource{}.template(data), data.RandomString)
+}
+
+func (r ElasticSanVolumeGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name           = "acctestesvg-%d"
+  elastic_san_id = azurerm_elastic_san.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ElasticSanVolumeGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume_group" "import" {
+  name           = azurerm_elastic_san_volume_group.test.name
+  elastic_san_id = azurerm_elastic_san_volume_group.test.elastic_san_id
+}
+`, r.basic(data))
+}
+
+func (r ElasticSanVolumeGroupResource) networkRules(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%[2]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+  service_endpoints    = ["Microsoft.Storage.Global"]
+}
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name           = "acctestesvg-%[2]d"
+  elastic_san_id = azurerm_elastic_san.test.id
+
+  network_rule {
+    subnet_id = azurerm_subnet.test.id
+    action    = "Allow"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ElasticSanVolumeGroupResource) customerManagedKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      recover_soft_deleted_key_vaults = false
+      purge_soft_delete_on_destroy    = false
+    }
+  }
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-esan-%[1]d"
+  location = %[2]q
+}
+
+resource "azurerm_elastic_san" "test" {
+  name                = "acctestesan%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  base_size_in_tib    = 1
+
+  sku {
+    name = "Premium_LRS"
+  }
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "acctestkv-%[3]s"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+  sku_name                 = "standard"
+  purge_protection_enabled = true
+}
+
+resource "azurerm_key_vault_access_policy" "client" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = data.azurerm_client_config.current.tenant_id
+  object_id    = data.azurerm_client_config.current.object_id
+
+  key_permissions = ["Create", "Delete", "Get", "Purge", "GetRotationPolicy"]
+}
+
+resource "azurerm_key_vault_access_policy" "identity" {
+  key_vault_id = azurerm_key_vault.test.id
+  tenant_id    = azurerm_user_assigned_identity.test.tenant_id
+  object_id    = azurerm_user_assigned_identity.test.principal_id
+
+  key_permissions = ["Get", "UnwrapKey", "WrapKey"]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "acctestkvk-%[3]s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["unwrapKey", "wrapKey"]
+
+  depends_on = [
+    azurerm_key_vault_access_policy.client,
+    azurerm_key_vault_access_policy.identity,
+  ]
+}
+
+resource "azurerm_elastic_san_volume_group" "test" {
+  name            = "acctestesvg-%[1]d"
+  elastic_san_id  = azurerm_elastic_san.test.id
+  encryption_type = "EncryptionAtRestWithCustomerManagedKey"
+
+  encryption {
+    key_vault_key_id          = azurerm_key_vault_key.test.versionless_id
+    user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
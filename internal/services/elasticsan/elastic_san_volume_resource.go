@@ -0,0 +1,312 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSanVolumeResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ElasticSanVolumeResource{}
+	_ sdk.ResourceWithCustomizeDiff = ElasticSanVolumeResource{}
+)
+
+type ElasticSanVolumeResourceModel struct {
+	Name                 string                         `tfschema:"name"`
+	VolumeGroupId        string                         `tfschema:"volume_group_id"`
+	SizeInGiB            int                            `tfschema:"size_in_gib"`
+	CreateSource         []ElasticSanVolumeCreateSource `tfschema:"create_source"`
+	TargetIqn            string                         `tfschema:"target_iqn"`
+	TargetPortalHostname string                         `tfschema:"target_portal_hostname"`
+	TargetPortalPort     int                            `tfschema:"target_portal_port"`
+	VolumeId             string                         `tfschema:"volume_id"`
+}
+
+type ElasticSanVolumeCreateSource struct {
+	SourceType string `tfschema:"source_type"`
+	SourceId   string `tfschema:"source_id"`
+}
+
+func (r ElasticSanVolumeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,61}[a-z0-9]$`),
+				"`name` must be between 3 and 63 characters, start and end with a lowercase letter or number and may only contain lowercase letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"volume_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: volumes.ValidateVolumeGroupID,
+		},
+
+		"size_in_gib": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 65536),
+		},
+
+		"create_source": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(volumes.VolumeCreateOptionDisk),
+							string(volumes.VolumeCreateOptionDiskRestorePoint),
+							string(volumes.VolumeCreateOptionDiskSnapshot),
+							string(volumes.VolumeCreateOptionVolumeSnapshot),
+						}, false),
+					},
+
+					"source_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_iqn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"target_portal_hostname": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"target_portal_port": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"volume_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) ModelObject() interface{} {
+	return &ElasticSanVolumeResourceModel{}
+}
+
+func (r ElasticSanVolumeResource) ResourceType() string {
+	return "azurerm_elastic_san_volume"
+}
+
+func (r ElasticSanVolumeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return volumes.ValidateVolumeID
+}
+
+func (r ElasticSanVolumeResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// volumes can be grown in-place but not shrunk
+			if metadata.ResourceDiff.Id() != "" && metadata.ResourceDiff.HasChange("size_in_gib") {
+				old, new := metadata.ResourceDiff.GetChange("size_in_gib")
+				if new.(int) < old.(int) {
+					return metadata.ResourceDiff.ForceNew("size_in_gib")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			var model ElasticSanVolumeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			volumeGroupId, err := volumes.ParseVolumeGroupID(model.VolumeGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := volumes.NewVolumeID(volumeGroupId.SubscriptionId, volumeGroupId.ResourceGroupName, volumeGroupId.ElasticSanName, volumeGroupId.VolumeGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := volumes.Volume{
+				Properties: volumes.VolumeProperties{
+					CreationData: expandElasticSanVolumeCreateSource(model.CreateSource),
+					SizeGiB:      int64(model.SizeInGiB),
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ElasticSanVolumeResourceModel{
+				Name:          id.VolumeName,
+				VolumeGroupId: volumes.NewVolumeGroupID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName, id.VolumeGroupName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.SizeInGiB = int(props.SizeGiB)
+				state.CreateSource = flattenElasticSanVolumeCreateSource(props.CreationData)
+				state.VolumeId = utils.NormalizeNilableString(props.VolumeId)
+
+				if target := props.StorageTarget; target != nil {
+					state.TargetIqn = utils.NormalizeNilableString(target.TargetIqn)
+					state.TargetPortalHostname = utils.NormalizeNilableString(target.TargetPortalHostname)
+					if target.TargetPortalPort != nil {
+						state.TargetPortalPort = int(*target.TargetPortalPort)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ElasticSanVolumeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("size_in_gib") {
+				payload := volumes.VolumeUpdate{
+					Properties: &volumes.VolumeUpdateProperties{
+						SizeGiB: utils.Int64(int64(model.SizeInGiB)),
+					},
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.VolumesClient
+
+			id, err := volumes.ParseVolumeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandElasticSanVolumeCreateSource(input []ElasticSanVolumeCreateSource) *volumes.SourceCreationData {
+	if len(input) == 0 {
+		return nil
+	}
+
+	createSource := volumes.VolumeCreateOption(input[0].SourceType)
+	return &volumes.SourceCreationData{
+		CreateSource: &createSource,
+		SourceId:     utils.String(input[0].SourceId),
+	}
+}
+
+func flattenElasticSanVolumeCreateSource(input *volumes.SourceCreationData) []ElasticSanVolumeCreateSource {
+	// volumes created empty report a `None` source, which isn't something that can be specified
+	if input == nil || input.CreateSource == nil || *input.CreateSource == volumes.VolumeCreateOptionNone {
+		return []ElasticSanVolumeCreateSource{}
+	}
+
+	return []ElasticSanVolumeCreateSource{
+		{
+			SourceType: string(*input.CreateSource),
+			SourceId:   utils.NormalizeNilableString(input.SourceId),
+		},
+	}
+}
@@ -0,0 +1,148 @@
+package elasticsan_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSanVolumeResource struct{}
+
+func TestAccElasticSanVolume_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSanVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_iqn").Exists(),
+				check.That(data.ResourceName).Key("target_portal_hostname").Exists(),
+				check.That(data.ResourceName).Key("target_portal_port").Exists(),
+				check.That(data.ResourceName).Key("volume_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSanVolume_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSanVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccElasticSanVolume_resize(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSanVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("size_in_gib").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccElasticSanVolume_fromSnapshot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "restored")
+	r := ElasticSanVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fromSnapshot(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("create_source.0.source_type").HasValue("VolumeSnapshot"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ElasticSanVolumeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := volumes.ParseVolumeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ElasticSan.VolumesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ElasticSanVolumeResource) basic(data acceptance.TestData, sizeInGiB int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "test" {
+  name            = "acctestesv-%d"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = %d
+}
+`, ElasticSanVolumeGroupResource{}.basic(data), data.RandomInteger, sizeInGiB)
+}
+
+func (r ElasticSanVolumeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "import" {
+  name            = azurerm_elastic_san_volume.test.name
+  volume_group_id = azurerm_elastic_san_volume.test.volume_group_id
+  size_in_gib     = azurerm_elastic_san_volume.test.size_in_gib
+}
+`, r.basic(data, 1))
+}
+
+func (r ElasticSanVolumeResource) fromSnapshot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_elastic_san_volume" "restored" {
+  name            = "acctestesv-restored-%d"
+  volume_group_id = azurerm_elastic_san_volume_group.test.id
+  size_in_gib     = 1
+
+  create_source {
+    source_type = "VolumeSnapshot"
+    source_id   = azurerm_elastic_san_volume_snapshot.test.id
+  }
+}
+`, ElasticSanVolumeSnapshotResource{}.basic(data), data.RandomInteger)
+}
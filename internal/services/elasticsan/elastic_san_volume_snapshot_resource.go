@@ -0,0 +1,188 @@
+package elasticsan
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/snapshots"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/sdk/2023-01-01/volumes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ElasticSanVolumeSnapshotResource struct{}
+
+var _ sdk.Resource = ElasticSanVolumeSnapshotResource{}
+
+type ElasticSanVolumeSnapshotResourceModel struct {
+	Name                  string `tfschema:"name"`
+	VolumeGroupId         string `tfschema:"volume_group_id"`
+	SourceVolumeId        string `tfschema:"source_volume_id"`
+	SourceVolumeSizeInGiB int    `tfschema:"source_volume_size_in_gib"`
+	SourceVolumeName      string `tfschema:"source_volume_name"`
+}
+
+func (r ElasticSanVolumeSnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,61}[a-z0-9]$`),
+				"`name` must be between 3 and 63 characters, start and end with a lowercase letter or number and may only contain lowercase letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"volume_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: snapshots.ValidateVolumeGroupID,
+		},
+
+		"source_volume_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: volumes.ValidateVolumeID,
+		},
+	}
+}
+
+func (r ElasticSanVolumeSnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source_volume_size_in_gib": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"source_volume_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ElasticSanVolumeSnapshotResource) ModelObject() interface{} {
+	return &ElasticSanVolumeSnapshotResourceModel{}
+}
+
+func (r ElasticSanVolumeSnapshotResource) ResourceType() string {
+	return "azurerm_elastic_san_volume_snapshot"
+}
+
+func (r ElasticSanVolumeSnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return snapshots.ValidateSnapshotID
+}
+
+func (r ElasticSanVolumeSnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.SnapshotsClient
+
+			var model ElasticSanVolumeSnapshotResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			volumeGroupId, err := snapshots.ParseVolumeGroupID(model.VolumeGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := snapshots.NewSnapshotID(volumeGroupId.SubscriptionId, volumeGroupId.ResourceGroupName, volumeGroupId.ElasticSanName, volumeGroupId.VolumeGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := snapshots.Snapshot{
+				Properties: snapshots.SnapshotProperties{
+					CreationData: snapshots.SnapshotCreationData{
+						SourceId: model.SourceVolumeId,
+					},
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ElasticSanVolumeSnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.SnapshotsClient
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ElasticSanVolumeSnapshotResourceModel{
+				Name:          id.SnapshotName,
+				VolumeGroupId: snapshots.NewVolumeGroupID(id.SubscriptionId, id.ResourceGroupName, id.ElasticSanName, id.VolumeGroupName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+
+				sourceVolumeId, err := volumes.ParseVolumeIDInsensitively(props.CreationData.SourceId)
+				if err != nil {
+					return err
+				}
+				state.SourceVolumeId = sourceVolumeId.ID()
+				state.SourceVolumeName = utils.NormalizeNilableString(props.VolumeName)
+				if props.SourceVolumeSizeGiB != nil {
+					state.SourceVolumeSizeInGiB = int(*props.SourceVolumeSizeGiB)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ElasticSanVolumeSnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ElasticSan.SnapshotsClient
+
+			id, err := snapshots.ParseSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
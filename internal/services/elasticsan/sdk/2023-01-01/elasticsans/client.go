@@ -0,0 +1,15 @@
+package elasticsans
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ElasticSansClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewElasticSansClientWithBaseURI(endpoint string) ElasticSansClient {
+	return ElasticSansClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,10 @@
+package elasticsans
+
+type ElasticSan struct {
+	Id         *string              `json:"id,omitempty"`
+	Location   string               `json:"location"`
+	Name       *string              `json:"name,omitempty"`
+	Properties ElasticSanProperties `json:"properties"`
+	Tags       *map[string]string   `json:"tags,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
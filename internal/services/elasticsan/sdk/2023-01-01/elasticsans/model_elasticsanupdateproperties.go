@@ -0,0 +1,7 @@
+package elasticsans
+
+type ElasticSanUpdateProperties struct {
+	BaseSizeTiB             *int64               `json:"baseSizeTiB,omitempty"`
+	ExtendedCapacitySizeTiB *int64               `json:"extendedCapacitySizeTiB,omitempty"`
+	PublicNetworkAccess     *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,49 @@
+package snapshots
+
+import "strings"
+
+type ProvisioningStates string
+
+const (
+	ProvisioningStatesCanceled  ProvisioningStates = "Canceled"
+	ProvisioningStatesCreating  ProvisioningStates = "Creating"
+	ProvisioningStatesDeleting  ProvisioningStates = "Deleting"
+	ProvisioningStatesFailed    ProvisioningStates = "Failed"
+	ProvisioningStatesInvalid   ProvisioningStates = "Invalid"
+	ProvisioningStatesPending   ProvisioningStates = "Pending"
+	ProvisioningStatesSucceeded ProvisioningStates = "Succeeded"
+	ProvisioningStatesUpdating  ProvisioningStates = "Updating"
+)
+
+func PossibleValuesForProvisioningStates() []string {
+	return []string{
+		string(ProvisioningStatesCanceled),
+		string(ProvisioningStatesCreating),
+		string(ProvisioningStatesDeleting),
+		string(ProvisioningStatesFailed),
+		string(ProvisioningStatesInvalid),
+		string(ProvisioningStatesPending),
+		string(ProvisioningStatesSucceeded),
+		string(ProvisioningStatesUpdating),
+	}
+}
+
+func parseProvisioningStates(input string) (*ProvisioningStates, error) {
+	vals := map[string]ProvisioningStates{
+		"canceled":  ProvisioningStatesCanceled,
+		"creating":  ProvisioningStatesCreating,
+		"deleting":  ProvisioningStatesDeleting,
+		"failed":    ProvisioningStatesFailed,
+		"invalid":   ProvisioningStatesInvalid,
+		"pending":   ProvisioningStatesPending,
+		"succeeded": ProvisioningStatesSucceeded,
+		"updating":  ProvisioningStatesUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningStates(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package snapshots
+
+type SnapshotProperties struct {
+	CreationData        SnapshotCreationData `json:"creationData"`
+	ProvisioningState   *ProvisioningStates  `json:"provisioningState,omitempty"`
+	SourceVolumeSizeGiB *int64               `json:"sourceVolumeSizeGiB,omitempty"`
+	VolumeName          *string              `json:"volumeName,omitempty"`
+}
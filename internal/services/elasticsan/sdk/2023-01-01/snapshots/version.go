@@ -0,0 +1,9 @@
+package snapshots
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/snapshots/%s", defaultApiVersion)
+}
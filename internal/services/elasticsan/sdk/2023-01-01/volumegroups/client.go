@@ -0,0 +1,15 @@
+package volumegroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type VolumeGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewVolumeGroupsClientWithBaseURI(endpoint string) VolumeGroupsClient {
+	return VolumeGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
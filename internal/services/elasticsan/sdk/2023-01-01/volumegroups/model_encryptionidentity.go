@@ -0,0 +1,5 @@
+package volumegroups
+
+type EncryptionIdentity struct {
+	UserAssignedIdentity *string `json:"userAssignedIdentity,omitempty"`
+}
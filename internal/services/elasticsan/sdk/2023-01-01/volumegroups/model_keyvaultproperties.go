@@ -0,0 +1,10 @@
+package volumegroups
+
+type KeyVaultProperties struct {
+	CurrentVersionedKeyExpirationTimestamp *string `json:"currentVersionedKeyExpirationTimestamp,omitempty"`
+	CurrentVersionedKeyIdentifier          *string `json:"currentVersionedKeyIdentifier,omitempty"`
+	KeyName                                *string `json:"keyName,omitempty"`
+	KeyVaultUri                            *string `json:"keyVaultUri,omitempty"`
+	KeyVersion                             *string `json:"keyVersion,omitempty"`
+	LastKeyRotationTimestamp               *string `json:"lastKeyRotationTimestamp,omitempty"`
+}
@@ -0,0 +1,6 @@
+package volumegroups
+
+type VirtualNetworkRule struct {
+	Action *Action `json:"action,omitempty"`
+	Id     string  `json:"id"`
+}
@@ -0,0 +1,9 @@
+package volumegroups
+
+type VolumeGroupProperties struct {
+	Encryption           *EncryptionType       `json:"encryption,omitempty"`
+	EncryptionProperties *EncryptionProperties `json:"encryptionProperties,omitempty"`
+	NetworkAcls          *NetworkRuleSet       `json:"networkAcls,omitempty"`
+	ProtocolType         *StorageTargetType    `json:"protocolType,omitempty"`
+	ProvisioningState    *ProvisioningStates   `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,8 @@
+package volumes
+
+type Volume struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties VolumeProperties `json:"properties"`
+	Type       *string          `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package volumes
+
+type VolumeUpdateProperties struct {
+	ManagedBy *ManagedByInfo `json:"managedBy,omitempty"`
+	SizeGiB   *int64         `json:"sizeGiB,omitempty"`
+}
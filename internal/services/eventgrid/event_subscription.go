@@ -1347,6 +1347,34 @@ func flattenDeliveryProperties(d *pluginsdk.ResourceData, input *[]eventgrid.Bas
 	return deliveryProperties
 }
 
+// eventSubscriptionDeliveryAttributeMappings returns the Delivery Attribute Mappings of the destination, if it supports them
+func eventSubscriptionDeliveryAttributeMappings(destination eventgrid.BasicEventSubscriptionDestination) *[]eventgrid.BasicDeliveryAttributeMapping {
+	if destination == nil {
+		return nil
+	}
+
+	if v, ok := destination.AsAzureFunctionEventSubscriptionDestination(); ok && v.AzureFunctionEventSubscriptionDestinationProperties != nil {
+		return v.DeliveryAttributeMappings
+	}
+	if v, ok := destination.AsEventHubEventSubscriptionDestination(); ok && v.EventHubEventSubscriptionDestinationProperties != nil {
+		return v.DeliveryAttributeMappings
+	}
+	if v, ok := destination.AsHybridConnectionEventSubscriptionDestination(); ok && v.HybridConnectionEventSubscriptionDestinationProperties != nil {
+		return v.DeliveryAttributeMappings
+	}
+	if v, ok := destination.AsServiceBusQueueEventSubscriptionDestination(); ok && v.ServiceBusQueueEventSubscriptionDestinationProperties != nil {
+		return v.DeliveryAttributeMappings
+	}
+	if v, ok := destination.AsServiceBusTopicEventSubscriptionDestination(); ok && v.ServiceBusTopicEventSubscriptionDestinationProperties != nil {
+		return v.DeliveryAttributeMappings
+	}
+	if v, ok := destination.AsWebHookEventSubscriptionDestination(); ok && v.WebHookEventSubscriptionDestinationProperties != nil {
+		return v.DeliveryAttributeMappings
+	}
+
+	return nil
+}
+
 func flattenEventGridEventSubscriptionHybridConnectionEndpoint(input *eventgrid.HybridConnectionEventSubscriptionDestination) []interface{} {
 	if input == nil {
 		return nil
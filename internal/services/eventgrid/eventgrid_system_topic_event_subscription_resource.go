@@ -120,6 +120,8 @@ func resourceEventGridSystemTopicEventSubscription() *pluginsdk.Resource {
 
 			"delivery_identity": eventSubscriptionSchemaIdentity(),
 
+			"delivery_property": eventSubscriptionSchemaDeliveryProperty(),
+
 			"dead_letter_identity": eventSubscriptionSchemaIdentity(),
 
 			"storage_blob_dead_letter_destination": eventSubscriptionSchemaStorageBlobDeadletterDestination(),
@@ -141,7 +143,7 @@ func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *pluginsdk.Reso
 
 	id := parse.NewSystemTopicEventSubscriptionID(subscriptionId, d.Get("resource_group_name").(string), d.Get("system_topic").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.SystemTopicName, id.SubscriptionId)
+		existing, err := client.Get(ctx, id.ResourceGroup, id.SystemTopicName, id.EventSubscriptionName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
@@ -158,6 +160,12 @@ func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *pluginsdk.Reso
 		return fmt.Errorf("One of the following endpoint types must be specificed to create an EventGrid System Topic Event Subscription: %q", PossibleSystemTopicEventSubscriptionEndpointTypes())
 	}
 
+	if _, ok := d.GetOk("delivery_property"); ok {
+		if _, ok := d.GetOk("storage_queue_endpoint"); ok {
+			return fmt.Errorf("`delivery_property` is not supported when using a `storage_queue_endpoint`")
+		}
+	}
+
 	filter, err := expandEventGridEventSubscriptionFilter(d)
 	if err != nil {
 		return fmt.Errorf("expanding `filters`: %+v", err)
@@ -179,6 +187,13 @@ func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *pluginsdk.Reso
 	}
 
 	if v, ok := d.GetOk("delivery_identity"); ok {
+		// delivery using a Managed Identity is only supported by the endpoints which are Azure resources with RBAC
+		for _, endpoint := range []string{"azure_function_endpoint", "webhook_endpoint"} {
+			if _, ok := d.GetOk(endpoint); ok {
+				return fmt.Errorf("`delivery_identity` is not supported when using a `%s`, it can only be used with an Event Hub, Service Bus Queue, Service Bus Topic or Storage Queue endpoint", endpoint)
+			}
+		}
+
 		deliveryIdentityRaw := v.([]interface{})
 		deliveryIdentity, err := expandEventGridEventSubscriptionIdentity(deliveryIdentityRaw)
 		if err != nil {
@@ -271,6 +286,10 @@ func resourceEventGridSystemTopicEventSubscriptionRead(d *pluginsdk.ResourceData
 			return fmt.Errorf("setting `delivery_identity`: %+v", err)
 		}
 
+		if err := d.Set("delivery_property", flattenDeliveryProperties(d, eventSubscriptionDeliveryAttributeMappings(destination))); err != nil {
+			return fmt.Errorf("setting `delivery_property`: %+v", err)
+		}
+
 		if azureFunctionEndpoint, ok := destination.AsAzureFunctionEventSubscriptionDestination(); ok {
 			if err := d.Set("azure_function_endpoint", flattenEventGridEventSubscriptionAzureFunctionEndpoint(azureFunctionEndpoint)); err != nil {
 				return fmt.Errorf("setting `azure_function_endpoint`: %+v", err)
@@ -11,9 +11,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2018-01-01-preview/eventhubsclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2018-01-01-preview/networkrulesets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2022-01-01-preview/applicationgroups"
 )
 
 type Client struct {
+	ApplicationGroupsClient                *applicationgroups.ApplicationGroupsClient
 	ClusterClient                          *eventhubsclusters.EventHubsClustersClient
 	ConsumerGroupClient                    *consumergroups.ConsumerGroupsClient
 	DisasterRecoveryConfigsClient          *disasterrecoveryconfigs.DisasterRecoveryConfigsClient
@@ -26,6 +28,9 @@ type Client struct {
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	applicationGroupsClient := applicationgroups.NewApplicationGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&applicationGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	clustersClient := eventhubsclusters.NewEventHubsClustersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&clustersClient.Client, o.ResourceManagerAuthorizer)
 
@@ -54,6 +59,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&networkRuleSetsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		ApplicationGroupsClient:                &applicationGroupsClient,
 		ClusterClient:                          &clustersClient,
 		ConsumerGroupClient:                    &consumerGroupsClient,
 		DisasterRecoveryConfigsClient:          &disasterRecoveryConfigsClient,
@@ -0,0 +1,286 @@
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2021-01-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2022-01-01-preview/applicationgroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// EventHubNamespaceApplicationGroupResource manages an Application Group within an EventHub Namespace, which groups
+// the clients using either a Shared Access Policy or an Azure AD Application so that they can be throttled together.
+type EventHubNamespaceApplicationGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventHubNamespaceApplicationGroupResource{}
+
+type EventHubNamespaceApplicationGroupResourceModel struct {
+	Name             string                                    `tfschema:"name"`
+	NamespaceId      string                                    `tfschema:"namespace_id"`
+	ClientIdentifier string                                    `tfschema:"client_identifier"`
+	Enabled          bool                                      `tfschema:"enabled"`
+	ThrottlingPolicy []EventHubNamespaceApplicationGroupPolicy `tfschema:"throttling_policy"`
+}
+
+type EventHubNamespaceApplicationGroupPolicy struct {
+	Name               string `tfschema:"name"`
+	MetricId           string `tfschema:"metric_id"`
+	RateLimitThreshold int64  `tfschema:"rate_limit_threshold"`
+}
+
+func (r EventHubNamespaceApplicationGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._-]{0,254}[a-zA-Z0-9])?$`),
+				"`name` must be between 1 and 256 characters, can contain only letters, numbers, periods, hyphens and underscores and must start and end with a letter or number",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"client_identifier": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(SASKeyName|AADAppID)=.+$`),
+				"`client_identifier` must be in the format `SASKeyName={name of the shared access policy}` or `AADAppID={client ID of the Azure AD application}`",
+			),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"throttling_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"metric_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(applicationgroups.PossibleValuesForMetricId(), false),
+					},
+
+					"rate_limit_threshold": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EventHubNamespaceApplicationGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventHubNamespaceApplicationGroupResource) ModelObject() interface{} {
+	return &EventHubNamespaceApplicationGroupResourceModel{}
+}
+
+func (r EventHubNamespaceApplicationGroupResource) ResourceType() string {
+	return "azurerm_eventhub_namespace_application_group"
+}
+
+func (r EventHubNamespaceApplicationGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationgroups.ValidateApplicationGroupID
+}
+
+func (r EventHubNamespaceApplicationGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+
+			var model EventHubNamespaceApplicationGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := namespaces.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationgroups.NewApplicationGroupID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := applicationgroups.ApplicationGroup{
+				Properties: &applicationgroups.ApplicationGroupProperties{
+					ClientAppGroupIdentifier: model.ClientIdentifier,
+					IsEnabled:                utils.Bool(model.Enabled),
+					Policies:                 expandEventHubNamespaceApplicationGroupPolicies(model.ThrottlingPolicy),
+				},
+			}
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventHubNamespaceApplicationGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+
+			id, err := applicationgroups.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventHubNamespaceApplicationGroupResourceModel{
+				Name:        id.ApplicationGroupName,
+				NamespaceId: namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ClientIdentifier = props.ClientAppGroupIdentifier
+					state.Enabled = props.IsEnabled == nil || *props.IsEnabled
+					state.ThrottlingPolicy = flattenEventHubNamespaceApplicationGroupPolicies(props.Policies)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventHubNamespaceApplicationGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+
+			id, err := applicationgroups.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventHubNamespaceApplicationGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("enabled") {
+				payload.Properties.IsEnabled = utils.Bool(model.Enabled)
+			}
+			if metadata.ResourceData.HasChange("throttling_policy") {
+				payload.Properties.Policies = expandEventHubNamespaceApplicationGroupPolicies(model.ThrottlingPolicy)
+			}
+
+			if _, err := client.CreateOrUpdateApplicationGroup(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventHubNamespaceApplicationGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Eventhub.ApplicationGroupsClient
+
+			id, err := applicationgroups.ParseApplicationGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventHubNamespaceApplicationGroupPolicies(input []EventHubNamespaceApplicationGroupPolicy) *[]applicationgroups.ApplicationGroupPolicy {
+	policies := make([]applicationgroups.ApplicationGroupPolicy, 0)
+	for _, item := range input {
+		policies = append(policies, applicationgroups.ThrottlingPolicy{
+			Name:               item.Name,
+			MetricId:           applicationgroups.MetricId(item.MetricId),
+			RateLimitThreshold: item.RateLimitThreshold,
+		})
+	}
+	return &policies
+}
+
+func flattenEventHubNamespaceApplicationGroupPolicies(input *[]applicationgroups.ApplicationGroupPolicy) []EventHubNamespaceApplicationGroupPolicy {
+	output := make([]EventHubNamespaceApplicationGroupPolicy, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range *input {
+		// Throttling Policies are the only type of policy which can currently be assigned to an Application Group
+		if policy, ok := item.(applicationgroups.ThrottlingPolicy); ok {
+			output = append(output, EventHubNamespaceApplicationGroupPolicy{
+				Name:               policy.Name,
+				MetricId:           string(policy.MetricId),
+				RateLimitThreshold: policy.RateLimitThreshold,
+			})
+		}
+	}
+	return output
+}
@@ -0,0 +1,15 @@
+package applicationgroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ApplicationGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewApplicationGroupsClientWithBaseURI(endpoint string) ApplicationGroupsClient {
+	return ApplicationGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
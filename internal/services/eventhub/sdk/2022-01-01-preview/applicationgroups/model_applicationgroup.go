@@ -0,0 +1,8 @@
+package applicationgroups
+
+type ApplicationGroup struct {
+	Id         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *ApplicationGroupProperties `json:"properties,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
@@ -0,0 +1,45 @@
+package applicationgroups
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ApplicationGroupPolicy interface {
+}
+
+func unmarshalApplicationGroupPolicyImplementation(input []byte) (ApplicationGroupPolicy, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling ApplicationGroupPolicy into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "ThrottlingPolicy") {
+		var out ThrottlingPolicy
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ThrottlingPolicy: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawApplicationGroupPolicyImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawApplicationGroupPolicyImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
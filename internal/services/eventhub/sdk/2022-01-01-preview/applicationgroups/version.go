@@ -0,0 +1,9 @@
+package applicationgroups
+
+import "fmt"
+
+const defaultApiVersion = "2022-01-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/applicationgroups/%s", defaultApiVersion)
+}
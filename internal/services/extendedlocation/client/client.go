@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+)
+
+type Client struct {
+	CustomLocationsClient *customlocations.CustomLocationsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	customLocationsClient := customlocations.NewCustomLocationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&customLocationsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CustomLocationsClient: &customLocationsClient,
+	}
+}
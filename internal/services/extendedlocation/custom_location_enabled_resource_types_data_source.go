@@ -0,0 +1,156 @@
+package extendedlocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CustomLocationEnabledResourceTypesDataSource struct{}
+
+var _ sdk.DataSource = CustomLocationEnabledResourceTypesDataSource{}
+
+type CustomLocationEnabledResourceTypesDataSourceModel struct {
+	CustomLocationId     string                     `tfschema:"custom_location_id"`
+	EnabledResourceTypes []EnabledResourceTypeModel `tfschema:"enabled_resource_types"`
+}
+
+type EnabledResourceTypeModel struct {
+	ClusterExtensionId string               `tfschema:"cluster_extension_id"`
+	ExtensionType      string               `tfschema:"extension_type"`
+	TypesMetadata      []TypesMetadataModel `tfschema:"types_metadata"`
+}
+
+type TypesMetadataModel struct {
+	ApiVersion                string `tfschema:"api_version"`
+	ResourceProviderNamespace string `tfschema:"resource_provider_namespace"`
+	ResourceType              string `tfschema:"resource_type"`
+}
+
+func (d CustomLocationEnabledResourceTypesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"custom_location_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: customlocations.ValidateCustomLocationID,
+		},
+	}
+}
+
+func (d CustomLocationEnabledResourceTypesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"enabled_resource_types": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"cluster_extension_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"extension_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"types_metadata": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"api_version": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"resource_provider_namespace": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"resource_type": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d CustomLocationEnabledResourceTypesDataSource) ModelObject() interface{} {
+	return &CustomLocationEnabledResourceTypesDataSourceModel{}
+}
+
+func (d CustomLocationEnabledResourceTypesDataSource) ResourceType() string {
+	return "azurerm_custom_location_enabled_resource_types"
+}
+
+func (d CustomLocationEnabledResourceTypesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ExtendedLocation.CustomLocationsClient
+
+			var model CustomLocationEnabledResourceTypesDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := customlocations.ParseCustomLocationID(model.CustomLocationId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListEnabledResourceTypes(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing enabled resource types for %s: %+v", *id, err)
+			}
+
+			model.EnabledResourceTypes = make([]EnabledResourceTypeModel, 0)
+			if resp.Model != nil && resp.Model.Value != nil {
+				model.EnabledResourceTypes = flattenEnabledResourceTypes(*resp.Model.Value)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func flattenEnabledResourceTypes(input []customlocations.EnabledResourceType) []EnabledResourceTypeModel {
+	output := make([]EnabledResourceTypeModel, 0)
+	for _, v := range input {
+		props := v.Properties
+		if props == nil {
+			continue
+		}
+
+		typesMetadata := make([]TypesMetadataModel, 0)
+		if props.TypesMetadata != nil {
+			for _, t := range *props.TypesMetadata {
+				typesMetadata = append(typesMetadata, TypesMetadataModel{
+					ApiVersion:                utils.NormalizeNilableString(t.ApiVersion),
+					ResourceProviderNamespace: utils.NormalizeNilableString(t.ResourceProviderNamespace),
+					ResourceType:              utils.NormalizeNilableString(t.ResourceType),
+				})
+			}
+		}
+
+		output = append(output, EnabledResourceTypeModel{
+			ClusterExtensionId: utils.NormalizeNilableString(props.ClusterExtensionId),
+			ExtensionType:      utils.NormalizeNilableString(props.ExtensionType),
+			TypesMetadata:      typesMetadata,
+		})
+	}
+	return output
+}
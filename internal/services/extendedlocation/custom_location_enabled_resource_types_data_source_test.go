@@ -0,0 +1,37 @@
+package extendedlocation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CustomLocationEnabledResourceTypesDataSource struct{}
+
+func TestAccCustomLocationEnabledResourceTypesDataSource_basic(t *testing.T) {
+	r := newCustomLocationResource(t)
+	data := acceptance.BuildTestData(t, "data.azurerm_custom_location_enabled_resource_types", "test")
+	d := CustomLocationEnabledResourceTypesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data, r),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("enabled_resource_types.#").Exists(),
+				check.That(data.ResourceName).Key("enabled_resource_types.0.cluster_extension_id").Exists(),
+			),
+		},
+	})
+}
+
+func (CustomLocationEnabledResourceTypesDataSource) basic(data acceptance.TestData, r CustomLocationResource) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_custom_location_enabled_resource_types" "test" {
+  custom_location_id = azurerm_custom_location.test.id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,346 @@
+package extendedlocation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2022-11-01/extensions"
+	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CustomLocationResource struct{}
+
+var _ sdk.ResourceWithUpdate = CustomLocationResource{}
+
+type CustomLocationResourceModel struct {
+	Name                string                         `tfschema:"name"`
+	ResourceGroupName   string                         `tfschema:"resource_group_name"`
+	Location            string                         `tfschema:"location"`
+	HostResourceId      string                         `tfschema:"host_resource_id"`
+	HostType            string                         `tfschema:"host_type"`
+	Namespace           string                         `tfschema:"namespace"`
+	ClusterExtensionIds []string                       `tfschema:"cluster_extension_ids"`
+	DisplayName         string                         `tfschema:"display_name"`
+	Authentication      []CustomLocationAuthentication `tfschema:"authentication"`
+	Tags                map[string]string              `tfschema:"tags"`
+}
+
+type CustomLocationAuthentication struct {
+	Type  string `tfschema:"type"`
+	Value string `tfschema:"value"`
+}
+
+func (r CustomLocationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"host_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: containerValidate.ConnectedClusterID,
+		},
+
+		"host_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(customlocations.HostTypeKubernetes),
+			ValidateFunc: validation.StringInSlice(customlocations.PossibleValuesForHostType(), false),
+		},
+
+		"namespace": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cluster_extension_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: extensions.ValidateScopedExtensionID,
+			},
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r CustomLocationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CustomLocationResource) ModelObject() interface{} {
+	return &CustomLocationResourceModel{}
+}
+
+func (r CustomLocationResource) ResourceType() string {
+	return "azurerm_custom_location"
+}
+
+func (r CustomLocationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return customlocations.ValidateCustomLocationID
+}
+
+func (r CustomLocationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ExtendedLocation.CustomLocationsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model CustomLocationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := customlocations.NewCustomLocationID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := validateCustomLocationClusterExtensionIds(model.HostResourceId, model.ClusterExtensionIds); err != nil {
+				return err
+			}
+
+			hostType := customlocations.HostType(model.HostType)
+			props := customlocations.CustomLocationProperties{
+				Authentication:      expandCustomLocationAuthentication(model.Authentication),
+				ClusterExtensionIds: &model.ClusterExtensionIds,
+				HostResourceId:      utils.String(model.HostResourceId),
+				HostType:            &hostType,
+				Namespace:           utils.String(model.Namespace),
+			}
+			if model.DisplayName != "" {
+				props.DisplayName = utils.String(model.DisplayName)
+			}
+
+			payload := customlocations.CustomLocation{
+				Location:   location.Normalize(model.Location),
+				Properties: &props,
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CustomLocationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ExtendedLocation.CustomLocationsClient
+
+			id, err := customlocations.ParseCustomLocationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CustomLocationResourceModel{
+				Name:              id.CustomLocationName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			// the API doesn't return the authentication value, so this is pulled from the config
+			var config CustomLocationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.Authentication = config.Authentication
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					clusterExtensionIds := make([]string, 0)
+					if props.ClusterExtensionIds != nil {
+						clusterExtensionIds = *props.ClusterExtensionIds
+					}
+					state.ClusterExtensionIds = clusterExtensionIds
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					state.HostResourceId = utils.NormalizeNilableString(props.HostResourceId)
+					if props.HostType != nil {
+						state.HostType = string(*props.HostType)
+					}
+					state.Namespace = utils.NormalizeNilableString(props.Namespace)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CustomLocationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ExtendedLocation.CustomLocationsClient
+
+			id, err := customlocations.ParseCustomLocationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CustomLocationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			props := customlocations.CustomLocationProperties{}
+			payload := customlocations.PatchableCustomLocations{
+				Properties: &props,
+			}
+
+			if metadata.ResourceData.HasChange("cluster_extension_ids") {
+				if err := validateCustomLocationClusterExtensionIds(model.HostResourceId, model.ClusterExtensionIds); err != nil {
+					return err
+				}
+				props.ClusterExtensionIds = &model.ClusterExtensionIds
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				props.DisplayName = utils.String(model.DisplayName)
+			}
+
+			if metadata.ResourceData.HasChange("authentication") {
+				props.Authentication = expandCustomLocationAuthentication(model.Authentication)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CustomLocationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ExtendedLocation.CustomLocationsClient
+
+			id, err := customlocations.ParseCustomLocationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// validateCustomLocationClusterExtensionIds ensures that each of the Cluster Extensions is installed on the host
+// cluster, since the API only surfaces this as a generic failure once the long running operation has completed
+func validateCustomLocationClusterExtensionIds(hostResourceId string, clusterExtensionIds []string) error {
+	for _, v := range clusterExtensionIds {
+		extensionId, err := extensions.ParseScopedExtensionID(v)
+		if err != nil {
+			return err
+		}
+
+		if !strings.EqualFold(extensionId.Scope, hostResourceId) {
+			return fmt.Errorf("the Cluster Extension %q must be installed on the host cluster %q", v, hostResourceId)
+		}
+	}
+
+	return nil
+}
+
+func expandCustomLocationAuthentication(input []CustomLocationAuthentication) *customlocations.CustomLocationPropertiesAuthentication {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := customlocations.CustomLocationPropertiesAuthentication{
+		Value: utils.String(input[0].Value),
+	}
+	if input[0].Type != "" {
+		output.Type = utils.String(input[0].Type)
+	}
+
+	return &output
+}
@@ -0,0 +1,202 @@
+package extendedlocation_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/extendedlocation/sdk/2021-08-15/customlocations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CustomLocationResource struct {
+	connectedClusterId string
+	clusterExtensionId string
+}
+
+// a Custom Location can only be created on an Arc-enabled Kubernetes Cluster which is connected using the
+// Arc agents and has a Cluster Extension (e.g. `microsoft.vmware`) installed
+func newCustomLocationResource(t *testing.T) CustomLocationResource {
+	r := CustomLocationResource{
+		connectedClusterId: os.Getenv("ARM_TEST_CONNECTED_CLUSTER_ID"),
+		clusterExtensionId: os.Getenv("ARM_TEST_CLUSTER_EXTENSION_ID"),
+	}
+	if r.connectedClusterId == "" || r.clusterExtensionId == "" {
+		t.Skip("Skipping as ARM_TEST_CONNECTED_CLUSTER_ID and/or ARM_TEST_CLUSTER_EXTENSION_ID are not specified")
+	}
+
+	return r
+}
+
+func TestAccCustomLocation_basic(t *testing.T) {
+	r := newCustomLocationResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_location", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCustomLocation_requiresImport(t *testing.T) {
+	r := newCustomLocationResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_location", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCustomLocation_complete(t *testing.T) {
+	r := newCustomLocationResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_location", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "one"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication"),
+	})
+}
+
+func TestAccCustomLocation_update(t *testing.T) {
+	r := newCustomLocationResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_location", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "one"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication"),
+		{
+			Config: r.complete(data, "two"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication"),
+	})
+}
+
+func (CustomLocationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := customlocations.ParseCustomLocationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ExtendedLocation.CustomLocationsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CustomLocationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cl-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r CustomLocationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_location" "test" {
+  name                  = "acctest-cl-%d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  host_resource_id      = "%s"
+  namespace             = "acctest-%d"
+  cluster_extension_ids = ["%s"]
+}
+`, r.template(data), data.RandomInteger, r.connectedClusterId, data.RandomInteger, r.clusterExtensionId)
+}
+
+func (r CustomLocationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_location" "import" {
+  name                  = azurerm_custom_location.test.name
+  resource_group_name   = azurerm_custom_location.test.resource_group_name
+  location              = azurerm_custom_location.test.location
+  host_resource_id      = azurerm_custom_location.test.host_resource_id
+  namespace             = azurerm_custom_location.test.namespace
+  cluster_extension_ids = azurerm_custom_location.test.cluster_extension_ids
+}
+`, r.basic(data))
+}
+
+func (r CustomLocationResource) complete(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_custom_location" "test" {
+  name                  = "acctest-cl-%[2]d"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  host_resource_id      = "%[3]s"
+  host_type             = "Kubernetes"
+  namespace             = "acctest-%[2]d"
+  cluster_extension_ids = ["%[4]s"]
+  display_name          = "acctest-cl-%[5]s"
+
+  authentication {
+    type  = "KubeConfig"
+    value = base64encode("kubeconfig-%[5]s")
+  }
+
+  tags = {
+    environment = "%[5]s"
+  }
+}
+`, r.template(data), data.RandomInteger, r.connectedClusterId, r.clusterExtensionId, tag)
+}
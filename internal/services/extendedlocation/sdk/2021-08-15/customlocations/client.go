@@ -0,0 +1,15 @@
+package customlocations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CustomLocationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCustomLocationsClientWithBaseURI(endpoint string) CustomLocationsClient {
+	return CustomLocationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,28 @@
+package customlocations
+
+import "strings"
+
+type HostType string
+
+const (
+	HostTypeKubernetes HostType = "Kubernetes"
+)
+
+func PossibleValuesForHostType() []string {
+	return []string{
+		string(HostTypeKubernetes),
+	}
+}
+
+func parseHostType(input string) (*HostType, error) {
+	vals := map[string]HostType{
+		"kubernetes": HostTypeKubernetes,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HostType(input)
+	return &out, nil
+}
@@ -0,0 +1,65 @@
+package customlocations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListEnabledResourceTypesResponse struct {
+	HttpResponse *http.Response
+	Model        *EnabledResourceTypesListResult
+}
+
+// ListEnabledResourceTypes ...
+func (c CustomLocationsClient) ListEnabledResourceTypes(ctx context.Context, id CustomLocationId) (result ListEnabledResourceTypesResponse, err error) {
+	req, err := c.preparerForListEnabledResourceTypes(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "customlocations.CustomLocationsClient", "ListEnabledResourceTypes", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "customlocations.CustomLocationsClient", "ListEnabledResourceTypes", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListEnabledResourceTypes(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "customlocations.CustomLocationsClient", "ListEnabledResourceTypes", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListEnabledResourceTypes prepares the ListEnabledResourceTypes request.
+func (c CustomLocationsClient) preparerForListEnabledResourceTypes(ctx context.Context, id CustomLocationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/enabledResourceTypes", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListEnabledResourceTypes handles the response to the ListEnabledResourceTypes request. The method always
+// closes the http.Response Body.
+func (c CustomLocationsClient) responderForListEnabledResourceTypes(resp *http.Response) (result ListEnabledResourceTypesResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,10 @@
+package customlocations
+
+type CustomLocation struct {
+	Id         *string                   `json:"id,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *CustomLocationProperties `json:"properties,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
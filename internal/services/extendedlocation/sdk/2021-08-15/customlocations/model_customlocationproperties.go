@@ -0,0 +1,11 @@
+package customlocations
+
+type CustomLocationProperties struct {
+	Authentication      *CustomLocationPropertiesAuthentication `json:"authentication,omitempty"`
+	ClusterExtensionIds *[]string                               `json:"clusterExtensionIds,omitempty"`
+	DisplayName         *string                                 `json:"displayName,omitempty"`
+	HostResourceId      *string                                 `json:"hostResourceId,omitempty"`
+	HostType            *HostType                               `json:"hostType,omitempty"`
+	Namespace           *string                                 `json:"namespace,omitempty"`
+	ProvisioningState   *string                                 `json:"provisioningState,omitempty"`
+}
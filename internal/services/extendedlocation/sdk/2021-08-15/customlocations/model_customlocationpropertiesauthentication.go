@@ -0,0 +1,6 @@
+package customlocations
+
+type CustomLocationPropertiesAuthentication struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
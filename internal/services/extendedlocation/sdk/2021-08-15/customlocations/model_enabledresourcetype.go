@@ -0,0 +1,8 @@
+package customlocations
+
+type EnabledResourceType struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *EnabledResourceTypeProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package customlocations
+
+type EnabledResourceTypeProperties struct {
+	ClusterExtensionId *string                                              `json:"clusterExtensionId,omitempty"`
+	ExtensionType      *string                                              `json:"extensionType,omitempty"`
+	TypesMetadata      *[]EnabledResourceTypePropertiesTypesMetadataInlined `json:"typesMetadata,omitempty"`
+}
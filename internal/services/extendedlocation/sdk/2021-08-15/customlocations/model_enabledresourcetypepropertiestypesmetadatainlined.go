@@ -0,0 +1,7 @@
+package customlocations
+
+type EnabledResourceTypePropertiesTypesMetadataInlined struct {
+	ApiVersion                *string `json:"apiVersion,omitempty"`
+	ResourceProviderNamespace *string `json:"resourceProviderNamespace,omitempty"`
+	ResourceType              *string `json:"resourceType,omitempty"`
+}
@@ -0,0 +1,6 @@
+package customlocations
+
+type EnabledResourceTypesListResult struct {
+	NextLink *string                `json:"nextLink,omitempty"`
+	Value    *[]EnabledResourceType `json:"value,omitempty"`
+}
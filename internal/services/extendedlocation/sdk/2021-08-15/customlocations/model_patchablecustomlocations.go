@@ -0,0 +1,6 @@
+package customlocations
+
+type PatchableCustomLocations struct {
+	Properties *CustomLocationProperties `json:"properties,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+}
@@ -0,0 +1,9 @@
+package customlocations
+
+import "fmt"
+
+const defaultApiVersion = "2021-08-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/customlocations/%s", defaultApiVersion)
+}
@@ -3,12 +3,18 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicydeployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicydrafts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicyrulecollectiongroupdrafts"
 )
 
 type Client struct {
-	AzureFirewallsClient          *network.AzureFirewallsClient
-	FirewallPolicyClient          *network.FirewallPoliciesClient
-	FirewallPolicyRuleGroupClient *network.FirewallPolicyRuleCollectionGroupsClient
+	AzureFirewallsClient               *network.AzureFirewallsClient
+	FirewallPolicyClient               *network.FirewallPoliciesClient
+	FirewallPolicyDeploymentsClient    *firewallpolicydeployments.FirewallPolicyDeploymentsClient
+	FirewallPolicyDraftsClient         *firewallpolicydrafts.FirewallPolicyDraftsClient
+	FirewallPolicyRuleGroupClient      *network.FirewallPolicyRuleCollectionGroupsClient
+	FirewallPolicyRuleGroupDraftClient *firewallpolicyrulecollectiongroupdrafts.FirewallPolicyRuleCollectionGroupDraftsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,12 +24,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	policyClient := network.NewFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyClient.Client, o.ResourceManagerAuthorizer)
 
+	policyDeploymentsClient := firewallpolicydeployments.NewFirewallPolicyDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyDeploymentsClient.Client, o.ResourceManagerAuthorizer)
+
+	policyDraftsClient := firewallpolicydrafts.NewFirewallPolicyDraftsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyDraftsClient.Client, o.ResourceManagerAuthorizer)
+
 	policyRuleGroupClient := network.NewFirewallPolicyRuleCollectionGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyRuleGroupClient.Client, o.ResourceManagerAuthorizer)
 
+	policyRuleGroupDraftClient := firewallpolicyrulecollectiongroupdrafts.NewFirewallPolicyRuleCollectionGroupDraftsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyRuleGroupDraftClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AzureFirewallsClient:          &firewallsClient,
-		FirewallPolicyClient:          &policyClient,
-		FirewallPolicyRuleGroupClient: &policyRuleGroupClient,
+		AzureFirewallsClient:               &firewallsClient,
+		FirewallPolicyClient:               &policyClient,
+		FirewallPolicyDeploymentsClient:    &policyDeploymentsClient,
+		FirewallPolicyDraftsClient:         &policyDraftsClient,
+		FirewallPolicyRuleGroupClient:      &policyRuleGroupClient,
+		FirewallPolicyRuleGroupDraftClient: &policyRuleGroupDraftClient,
 	}
 }
@@ -0,0 +1,131 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicydeployments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceFirewallPolicyDeployment deploys the pending drafts of a Firewall Policy and its Rule Collection Groups,
+// there's no Azure resource backing this - it exists to trigger a deployment whenever the `triggers` change.
+func resourceFirewallPolicyDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyDeploymentCreate,
+		Read:   resourceFirewallPolicyDeploymentRead,
+		Update: resourceFirewallPolicyDeploymentUpdate,
+		Delete: resourceFirewallPolicyDeploymentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyID,
+			},
+
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceFirewallPolicyDeploymentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := deployFirewallPolicy(ctx, meta.(*clients.Client).Firewall.FirewallPolicyDeploymentsClient, *id); err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyDeploymentRead(d, meta)
+}
+
+func resourceFirewallPolicyDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("firewall_policy_id", id.ID())
+
+	return nil
+}
+
+func resourceFirewallPolicyDeploymentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("triggers") {
+		if err := deployFirewallPolicy(ctx, meta.(*clients.Client).Firewall.FirewallPolicyDeploymentsClient, *id); err != nil {
+			return err
+		}
+	}
+
+	return resourceFirewallPolicyDeploymentRead(d, meta)
+}
+
+func resourceFirewallPolicyDeploymentDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	// a deployment can't be undone, so there's nothing to do here other than removing it from the state
+	log.Printf("[DEBUG] removing the Firewall Policy Deployment %q from the state - the deployed changes will remain", d.Id())
+	return nil
+}
+
+func deployFirewallPolicy(ctx context.Context, client *firewallpolicydeployments.FirewallPolicyDeploymentsClient, id parse.FirewallPolicyId) error {
+	locks.ByName(id.Name, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.Name, azureFirewallPolicyResourceName)
+
+	if err := client.DeployThenPoll(ctx, firewallpolicydeployments.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.Name)); err != nil {
+		return fmt.Errorf("deploying the drafts for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyDeploymentResource struct{}
+
+func TestAccFirewallPolicyDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_deployment", "test")
+	r := FirewallPolicyDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 500),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule_collection_group_draft.test").Key("priority").HasValue("500"),
+			),
+		},
+		{
+			// once deployed the drafts are read back from the Firewall Policy itself, so this shouldn't show a diff
+			Config:   r.basic(data, 500),
+			PlanOnly: true,
+		},
+		{
+			Config: r.basic(data, 600),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_firewall_policy_rule_collection_group_draft.test").Key("priority").HasValue("600"),
+			),
+		},
+	})
+}
+
+func (FirewallPolicyDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.FirewallPolicyPropertiesFormat != nil), nil
+}
+
+func (FirewallPolicyDeploymentResource) basic(data acceptance.TestData, priority int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-deploy-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-deploy-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_draft" "test" {
+  firewall_policy_id       = azurerm_firewall_policy.test.id
+  threat_intelligence_mode = "Deny"
+}
+
+resource "azurerm_firewall_policy_rule_collection_group_draft" "test" {
+  name               = "acctest-fwpolicy-deploy-%[1]d"
+  firewall_policy_id = azurerm_firewall_policy_draft.test.firewall_policy_id
+  priority           = %[3]d
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+}
+
+resource "azurerm_firewall_policy_deployment" "test" {
+  firewall_policy_id = azurerm_firewall_policy.test.id
+
+  triggers = {
+    policy_draft                = sha1(jsonencode(azurerm_firewall_policy_draft.test))
+    rule_collection_group_draft = sha1(jsonencode(azurerm_firewall_policy_rule_collection_group_draft.test))
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, priority)
+}
@@ -0,0 +1,278 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicydrafts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the name of a Firewall Policy Draft is fixed, there's only ever a single draft for a Firewall Policy
+const firewallPolicyDraftName = "default"
+
+func resourceFirewallPolicyDraft() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyDraftCreateUpdate,
+		Read:   resourceFirewallPolicyDraftRead,
+		Update: resourceFirewallPolicyDraftCreateUpdate,
+		Delete: resourceFirewallPolicyDraftDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyDraftID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyID,
+			},
+
+			"base_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.FirewallPolicyID,
+			},
+
+			"dns": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"servers": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+						},
+						"proxy_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"threat_intelligence_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(network.AzureFirewallThreatIntelModeAlert),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AzureFirewallThreatIntelModeAlert),
+					string(network.AzureFirewallThreatIntelModeDeny),
+					string(network.AzureFirewallThreatIntelModeOff),
+				}, false),
+			},
+
+			"threat_intelligence_allowlist": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"ip_addresses": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPv4Address),
+							},
+							AtLeastOneOf: []string{"threat_intelligence_allowlist.0.ip_addresses", "threat_intelligence_allowlist.0.fqdns"},
+						},
+						"fqdns": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							AtLeastOneOf: []string{"threat_intelligence_allowlist.0.ip_addresses", "threat_intelligence_allowlist.0.fqdns"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFirewallPolicyDraftCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyDraftsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	policyId, err := parse.FirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyDraftID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name, firewallPolicyDraftName)
+	draftId := firewallpolicydrafts.NewFirewallPolicyID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, draftId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_firewall_policy_draft", id.ID())
+		}
+	}
+
+	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
+
+	payload := firewallpolicydrafts.FirewallPolicyDraft{
+		Properties: &firewallpolicydrafts.FirewallPolicyDraftProperties{
+			DnsSettings:          expandFirewallPolicyDNSSetting(d.Get("dns").([]interface{})),
+			ThreatIntelMode:      network.AzureFirewallThreatIntelMode(d.Get("threat_intelligence_mode").(string)),
+			ThreatIntelWhitelist: expandFirewallPolicyThreatIntelWhitelist(d.Get("threat_intelligence_allowlist").([]interface{})),
+		},
+	}
+	if v, ok := d.GetOk("base_policy_id"); ok {
+		payload.Properties.BasePolicy = &network.SubResource{ID: utils.String(v.(string))}
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, draftId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyDraftRead(d, meta)
+}
+
+func resourceFirewallPolicyDraftRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyDraftsClient
+	policyClient := meta.(*clients.Client).Firewall.FirewallPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyId := parse.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)
+	d.Set("firewall_policy_id", policyId.ID())
+
+	resp, err := client.Get(ctx, firewallpolicydrafts.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName))
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		// once a draft has been deployed it's merged into the Firewall Policy and removed, at which point the
+		// Firewall Policy itself reflects the contents of the draft
+		policy, err := policyClient.Get(ctx, policyId.ResourceGroup, policyId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(policy.Response) {
+				log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", policyId, err)
+		}
+
+		if props := policy.FirewallPolicyPropertiesFormat; props != nil {
+			return setFirewallPolicyDraftProperties(d, props.BasePolicy, props.DNSSettings, props.ThreatIntelMode, props.ThreatIntelWhitelist)
+		}
+
+		return nil
+	}
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			return setFirewallPolicyDraftProperties(d, props.BasePolicy, props.DnsSettings, props.ThreatIntelMode, props.ThreatIntelWhitelist)
+		}
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyDraftDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyDraftsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	if resp, err := client.Delete(ctx, firewallpolicydrafts.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName)); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func setFirewallPolicyDraftProperties(d *pluginsdk.ResourceData, basePolicy *network.SubResource, dnsSettings *network.DNSSettings, threatIntelMode network.AzureFirewallThreatIntelMode, threatIntelWhitelist *network.FirewallPolicyThreatIntelWhitelist) error {
+	basePolicyId := ""
+	if basePolicy != nil && basePolicy.ID != nil {
+		basePolicyId = *basePolicy.ID
+	}
+	d.Set("base_policy_id", basePolicyId)
+	d.Set("threat_intelligence_mode", string(threatIntelMode))
+
+	if err := d.Set("dns", flattenFirewallPolicyDraftDNSSetting(dnsSettings)); err != nil {
+		return fmt.Errorf("setting `dns`: %+v", err)
+	}
+
+	if err := d.Set("threat_intelligence_allowlist", flattenFirewallPolicyThreatIntelWhitelist(threatIntelWhitelist)); err != nil {
+		return fmt.Errorf("setting `threat_intelligence_allowlist`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenFirewallPolicyDraftDNSSetting(input *network.DNSSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	proxyEnabled := false
+	if input.EnableProxy != nil {
+		proxyEnabled = *input.EnableProxy
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"servers":       utils.FlattenStringSlice(input.Servers),
+			"proxy_enabled": proxyEnabled,
+		},
+	}
+}
@@ -0,0 +1,150 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicydrafts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyDraftResource struct{}
+
+func TestAccFirewallPolicyDraft_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_draft", "test")
+	r := FirewallPolicyDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyDraft_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_draft", "test")
+	r := FirewallPolicyDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyDraft_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_draft", "test")
+	r := FirewallPolicyDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (FirewallPolicyDraftResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyDraftID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyDraftsClient.Get(ctx, firewallpolicydrafts.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (FirewallPolicyDraftResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-draft-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-draft-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyDraftResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_draft" "test" {
+  firewall_policy_id = azurerm_firewall_policy.test.id
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyDraftResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_draft" "test" {
+  firewall_policy_id       = azurerm_firewall_policy.test.id
+  threat_intelligence_mode = "Deny"
+
+  threat_intelligence_allowlist {
+    ip_addresses = ["1.1.1.1", "2.2.2.2", "10.0.0.0/16"]
+    fqdns        = ["foo.com", "bar.com"]
+  }
+
+  dns {
+    servers       = ["1.1.1.1", "2.2.2.2"]
+    proxy_enabled = true
+  }
+}
+`, r.template(data))
+}
+
+func (r FirewallPolicyDraftResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_draft" "import" {
+  firewall_policy_id = azurerm_firewall_policy_draft.test.firewall_policy_id
+}
+`, r.basic(data))
+}
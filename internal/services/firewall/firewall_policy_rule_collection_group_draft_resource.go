@@ -0,0 +1,193 @@
+package firewall
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicyrulecollectiongroupdrafts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceFirewallPolicyRuleCollectionGroupDraft() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPolicyRuleCollectionGroupDraftCreateUpdate,
+		Read:   resourceFirewallPolicyRuleCollectionGroupDraftRead,
+		Update: resourceFirewallPolicyRuleCollectionGroupDraftCreateUpdate,
+		Delete: resourceFirewallPolicyRuleCollectionGroupDraftDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FirewallPolicyRuleCollectionGroupDraftID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyRuleCollectionGroupName(),
+			},
+
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FirewallPolicyID,
+			},
+
+			"priority": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"application_rule_collection": firewallPolicyApplicationRuleCollectionSchema(),
+
+			"network_rule_collection": firewallPolicyNetworkRuleCollectionSchema(),
+
+			"nat_rule_collection": firewallPolicyNatRuleCollectionSchema(),
+		},
+	}
+}
+
+func resourceFirewallPolicyRuleCollectionGroupDraftCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupDraftClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	policyId, err := parse.FirewallPolicyID(d.Get("firewall_policy_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPolicyRuleCollectionGroupDraftID(policyId.SubscriptionId, policyId.ResourceGroup, policyId.Name, d.Get("name").(string), firewallPolicyDraftName)
+	draftId := firewallpolicyrulecollectiongroupdrafts.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, draftId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_firewall_policy_rule_collection_group_draft", id.ID())
+		}
+	}
+
+	locks.ByName(policyId.Name, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(policyId.Name, azureFirewallPolicyResourceName)
+
+	ruleCollections, err := expandFirewallPolicyRuleCollections(d)
+	if err != nil {
+		return err
+	}
+
+	payload := firewallpolicyrulecollectiongroupdrafts.FirewallPolicyRuleCollectionGroupDraft{
+		Properties: &network.FirewallPolicyRuleCollectionGroupProperties{
+			Priority:        utils.Int32(int32(d.Get("priority").(int))),
+			RuleCollections: ruleCollections,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, draftId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPolicyRuleCollectionGroupDraftRead(d, meta)
+}
+
+func resourceFirewallPolicyRuleCollectionGroupDraftRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupDraftClient
+	groupClient := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionGroupDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", id.RuleCollectionGroupName)
+	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
+
+	resp, err := client.Get(ctx, firewallpolicyrulecollectiongroupdrafts.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName))
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		// once a draft has been deployed it's merged into the Rule Collection Group and removed, at which point
+		// the Rule Collection Group itself reflects the contents of the draft
+		groupId := parse.NewFirewallPolicyRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
+		group, err := groupClient.Get(ctx, groupId.ResourceGroup, groupId.FirewallPolicyName, groupId.RuleCollectionGroupName)
+		if err != nil {
+			if utils.ResponseWasNotFound(group.Response) {
+				log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", groupId, err)
+		}
+
+		if props := group.FirewallPolicyRuleCollectionGroupProperties; props != nil {
+			d.Set("priority", props.Priority)
+			return setFirewallPolicyRuleCollections(d, props.RuleCollections)
+		}
+
+		return nil
+	}
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("priority", props.Priority)
+			return setFirewallPolicyRuleCollections(d, props.RuleCollections)
+		}
+	}
+
+	return nil
+}
+
+func resourceFirewallPolicyRuleCollectionGroupDraftDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Firewall.FirewallPolicyRuleGroupDraftClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPolicyRuleCollectionGroupDraftID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+	defer locks.UnlockByName(id.FirewallPolicyName, azureFirewallPolicyResourceName)
+
+	if resp, err := client.Delete(ctx, firewallpolicyrulecollectiongroupdrafts.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/sdk/2023-09-01/firewallpolicyrulecollectiongroupdrafts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPolicyRuleCollectionGroupDraftResource struct{}
+
+func TestAccFirewallPolicyRuleCollectionGroupDraft_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group_draft", "test")
+	r := FirewallPolicyRuleCollectionGroupDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollectionGroupDraft_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group_draft", "test")
+	r := FirewallPolicyRuleCollectionGroupDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFirewallPolicyRuleCollectionGroupDraft_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_policy_rule_collection_group_draft", "test")
+	r := FirewallPolicyRuleCollectionGroupDraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (FirewallPolicyRuleCollectionGroupDraftResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPolicyRuleCollectionGroupDraftID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Firewall.FirewallPolicyRuleGroupDraftClient.Get(ctx, firewallpolicyrulecollectiongroupdrafts.NewRuleCollectionGroupID(id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (FirewallPolicyRuleCollectionGroupDraftResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-fwpolicy-RCGD-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_firewall_policy" "test" {
+  name                = "acctest-fwpolicy-RCGD-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_firewall_policy_draft" "test" {
+  firewall_policy_id = azurerm_firewall_policy.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r FirewallPolicyRuleCollectionGroupDraftResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group_draft" "test" {
+  name               = "acctest-fwpolicy-RCGD-%d"
+  firewall_policy_id = azurerm_firewall_policy_draft.test.firewall_policy_id
+  priority           = 500
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r FirewallPolicyRuleCollectionGroupDraftResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group_draft" "test" {
+  name               = "acctest-fwpolicy-RCGD-%d"
+  firewall_policy_id = azurerm_firewall_policy_draft.test.firewall_policy_id
+  priority           = 500
+
+  application_rule_collection {
+    name     = "app_rule_collection1"
+    priority = 500
+    action   = "Deny"
+    rule {
+      name = "app_rule_collection1_rule1"
+      protocols {
+        type = "Http"
+        port = 80
+      }
+      protocols {
+        type = "Https"
+        port = 443
+      }
+      source_addresses  = ["10.0.0.1"]
+      destination_fqdns = ["pluginsdk.io"]
+    }
+  }
+
+  network_rule_collection {
+    name     = "network_rule_collection1"
+    priority = 400
+    action   = "Deny"
+    rule {
+      name                  = "network_rule_collection1_rule1"
+      protocols             = ["TCP", "UDP"]
+      source_addresses      = ["10.0.0.1"]
+      destination_addresses = ["192.168.1.1", "ApiManagement"]
+      destination_ports     = ["80", "1000-2000"]
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r FirewallPolicyRuleCollectionGroupDraftResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_policy_rule_collection_group_draft" "import" {
+  name               = azurerm_firewall_policy_rule_collection_group_draft.test.name
+  firewall_policy_id = azurerm_firewall_policy_rule_collection_group_draft.test.firewall_policy_id
+  priority           = azurerm_firewall_policy_rule_collection_group_draft.test.priority
+}
+`, r.basic(data))
+}
@@ -60,355 +60,367 @@ func resourceFirewallPolicyRuleCollectionGroup() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(100, 65000),
 			},
 
-			"application_rule_collection": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				MinItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(100, 65000),
-						},
-						"action": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
-								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
-							}, false),
-						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"description": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"type": {
-													Type:     pluginsdk.TypeString,
-													Required: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
-														string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
-													}, false),
-												},
-												"port": {
-													Type:         pluginsdk.TypeInt,
-													Required:     true,
-													ValidateFunc: validation.IntBetween(0, 64000),
-												},
-											},
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_urls": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdn_tags": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
+			"application_rule_collection": firewallPolicyApplicationRuleCollectionSchema(),
+
+			"network_rule_collection": firewallPolicyNetworkRuleCollectionSchema(),
+
+			"nat_rule_collection": firewallPolicyNatRuleCollectionSchema(),
+		},
+	}
+}
+
+func firewallPolicyApplicationRuleCollectionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"priority": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(100, 65000),
+				},
+				"action": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+						string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+					}, false),
+				},
+				"rule": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							"description": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							"protocols": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"type": {
+											Type:     pluginsdk.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(network.FirewallPolicyRuleApplicationProtocolTypeHTTP),
+												string(network.FirewallPolicyRuleApplicationProtocolTypeHTTPS),
+											}, false),
 										},
-									},
-									"terminate_tls": {
-										Type:     pluginsdk.TypeBool,
-										Optional: true,
-									},
-									"web_categories": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
+										"port": {
+											Type:         pluginsdk.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(0, 64000),
 										},
 									},
 								},
 							},
+							"source_addresses": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.Any(
+										validation.IsIPAddress,
+										validation.IsCIDR,
+										validation.StringInSlice([]string{`*`}, false),
+									),
+								},
+							},
+							"source_ip_groups": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_addresses": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.Any(
+										validation.IsIPAddress,
+										validation.IsCIDR,
+										validation.StringInSlice([]string{`*`}, false),
+									),
+								},
+							},
+							"destination_fqdns": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_urls": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_fqdn_tags": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"terminate_tls": {
+								Type:     pluginsdk.TypeBool,
+								Optional: true,
+							},
+							"web_categories": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
 						},
 					},
 				},
 			},
+		},
+	}
+}
 
-			"network_rule_collection": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				MinItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(100, 65000),
-						},
-						"action": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
-								string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
-							}, false),
-						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolAny),
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-												string(network.FirewallPolicyRuleNetworkProtocolICMP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											// Can be IP address, CIDR, "*", or service tag
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_fqdns": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												azValidate.PortOrPortRangeWithin(1, 65535),
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
+func firewallPolicyNetworkRuleCollectionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"priority": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(100, 65000),
+				},
+				"action": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.FirewallPolicyFilterRuleCollectionActionTypeAllow),
+						string(network.FirewallPolicyFilterRuleCollectionActionTypeDeny),
+					}, false),
+				},
+				"rule": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							"protocols": {
+								Type:     pluginsdk.TypeSet,
+								Required: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(network.FirewallPolicyRuleNetworkProtocolAny),
+										string(network.FirewallPolicyRuleNetworkProtocolTCP),
+										string(network.FirewallPolicyRuleNetworkProtocolUDP),
+										string(network.FirewallPolicyRuleNetworkProtocolICMP),
+									}, false),
+								},
+							},
+							"source_addresses": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.Any(
+										validation.IsIPAddress,
+										validation.IsCIDR,
+										validation.StringInSlice([]string{`*`}, false),
+									),
+								},
+							},
+							"source_ip_groups": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_addresses": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									// Can be IP address, CIDR, "*", or service tag
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_ip_groups": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_fqdns": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_ports": {
+								Type:     pluginsdk.TypeSet,
+								Required: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.Any(
+										azValidate.PortOrPortRangeWithin(1, 65535),
+										validation.StringInSlice([]string{`*`}, false),
+									),
 								},
 							},
 						},
 					},
 				},
 			},
+		},
+	}
+}
 
-			"nat_rule_collection": {
-				Type:     pluginsdk.TypeSet,
-				Optional: true,
-				MinItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-						"priority": {
-							Type:         pluginsdk.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(100, 65000),
-						},
-						"action": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-							ValidateFunc: validation.StringInSlice([]string{
-								// Hardcode to using `Dnat` instead of the one defined in Swagger (i.e. network.DNAT) because of: https://github.com/Azure/azure-rest-api-specs/issues/9986
-								// Setting `StateFunc: state.IgnoreCase` will cause other issues, as tracked by: https://github.com/hashicorp/terraform-plugin-sdk/issues/485
-								// Another solution is to customize the hash function for the containing block, but as there are a couple of properties here, especially
-								// has property whose type is another nested block (Set), so the implementation is nontrivial and error-prone.
-								"Dnat",
-							}, false),
-						},
-						"rule": {
-							Type:     pluginsdk.TypeSet,
-							Required: true,
-							MinItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"name": {
-										Type:         pluginsdk.TypeString,
-										Required:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
-									"protocols": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												string(network.FirewallPolicyRuleNetworkProtocolTCP),
-												string(network.FirewallPolicyRuleNetworkProtocolUDP),
-											}, false),
-										},
-									},
-									"source_addresses": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.Any(
-												validation.IsIPAddress,
-												validation.IsCIDR,
-												validation.StringInSlice([]string{`*`}, false),
-											),
-										},
-									},
-									"source_ip_groups": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: validation.StringIsNotEmpty,
-										},
-									},
-									"destination_address": {
-										Type:     pluginsdk.TypeString,
-										Optional: true,
-										ValidateFunc: validation.Any(
-											validation.IsIPAddress,
-											validation.IsCIDR,
-										),
-									},
-									"destination_ports": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Schema{
-											Type:         pluginsdk.TypeString,
-											ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
-										},
-									},
-									"translated_address": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.IsIPAddress,
-									},
-									"translated_port": {
-										Type:         pluginsdk.TypeInt,
-										Required:     true,
-										ValidateFunc: validation.IsPortNumber,
-									},
-									"translated_fqdn": {
-										Type:         pluginsdk.TypeString,
-										Optional:     true,
-										ValidateFunc: validation.StringIsNotEmpty,
-									},
+func firewallPolicyNatRuleCollectionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeSet,
+		Optional: true,
+		MinItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				"priority": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(100, 65000),
+				},
+				"action": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						// Hardcode to using `Dnat` instead of the one defined in Swagger (i.e. network.DNAT) because of: https://github.com/Azure/azure-rest-api-specs/issues/9986
+						// Setting `StateFunc: state.IgnoreCase` will cause other issues, as tracked by: https://github.com/hashicorp/terraform-plugin-sdk/issues/485
+						// Another solution is to customize the hash function for the containing block, but as there are a couple of properties here, especially
+						// has property whose type is another nested block (Set), so the implementation is nontrivial and error-prone.
+						"Dnat",
+					}, false),
+				},
+				"rule": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"name": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							"protocols": {
+								Type:     pluginsdk.TypeSet,
+								Required: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(network.FirewallPolicyRuleNetworkProtocolTCP),
+										string(network.FirewallPolicyRuleNetworkProtocolUDP),
+									}, false),
 								},
 							},
+							"source_addresses": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type: pluginsdk.TypeString,
+									ValidateFunc: validation.Any(
+										validation.IsIPAddress,
+										validation.IsCIDR,
+										validation.StringInSlice([]string{`*`}, false),
+									),
+								},
+							},
+							"source_ip_groups": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+							"destination_address": {
+								Type:     pluginsdk.TypeString,
+								Optional: true,
+								ValidateFunc: validation.Any(
+									validation.IsIPAddress,
+									validation.IsCIDR,
+								),
+							},
+							"destination_ports": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: azValidate.PortOrPortRangeWithin(1, 64000),
+								},
+							},
+							"translated_address": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.IsIPAddress,
+							},
+							"translated_port": {
+								Type:         pluginsdk.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"translated_fqdn": {
+								Type:         pluginsdk.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
 						},
 					},
 				},
@@ -449,17 +461,11 @@ func resourceFirewallPolicyRuleCollectionGroupCreateUpdate(d *pluginsdk.Resource
 			Priority: utils.Int32(int32(d.Get("priority").(int))),
 		},
 	}
-	var rulesCollections []network.BasicFirewallPolicyRuleCollection
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
-	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
-
-	natRules, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())
+	rulesCollections, err := expandFirewallPolicyRuleCollections(d)
 	if err != nil {
-		return fmt.Errorf("expanding NAT rule collection: %w", err)
+		return err
 	}
-	rulesCollections = append(rulesCollections, natRules...)
-
-	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = &rulesCollections
+	param.FirewallPolicyRuleCollectionGroupProperties.RuleCollections = rulesCollections
 
 	future, err := client.CreateOrUpdate(ctx, policyId.ResourceGroup, policyId.Name, name, param)
 	if err != nil {
@@ -511,22 +517,7 @@ func resourceFirewallPolicyRuleCollectionGroupRead(d *pluginsdk.ResourceData, me
 	d.Set("priority", resp.Priority)
 	d.Set("firewall_policy_id", parse.NewFirewallPolicyID(subscriptionId, id.ResourceGroup, id.FirewallPolicyName).ID())
 
-	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(resp.RuleCollections)
-	if err != nil {
-		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
-	}
-
-	if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
-		return fmt.Errorf("setting `application_rule_collection`: %+v", err)
-	}
-	if err := d.Set("network_rule_collection", networkRuleCollections); err != nil {
-		return fmt.Errorf("setting `network_rule_collection`: %+v", err)
-	}
-	if err := d.Set("nat_rule_collection", natRuleCollections); err != nil {
-		return fmt.Errorf("setting `nat_rule_collection`: %+v", err)
-	}
-
-	return nil
+	return setFirewallPolicyRuleCollections(d, resp.RuleCollections)
 }
 
 func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -555,6 +546,39 @@ func resourceFirewallPolicyRuleCollectionGroupDelete(d *pluginsdk.ResourceData,
 	return nil
 }
 
+func setFirewallPolicyRuleCollections(d *pluginsdk.ResourceData, input *[]network.BasicFirewallPolicyRuleCollection) error {
+	applicationRuleCollections, networkRuleCollections, natRuleCollections, err := flattenFirewallPolicyRuleCollection(input)
+	if err != nil {
+		return fmt.Errorf("flattening Firewall Policy Rule Collections: %+v", err)
+	}
+
+	if err := d.Set("application_rule_collection", applicationRuleCollections); err != nil {
+		return fmt.Errorf("setting `application_rule_collection`: %+v", err)
+	}
+	if err := d.Set("network_rule_collection", networkRuleCollections); err != nil {
+		return fmt.Errorf("setting `network_rule_collection`: %+v", err)
+	}
+	if err := d.Set("nat_rule_collection", natRuleCollections); err != nil {
+		return fmt.Errorf("setting `nat_rule_collection`: %+v", err)
+	}
+
+	return nil
+}
+
+func expandFirewallPolicyRuleCollections(d *pluginsdk.ResourceData) (*[]network.BasicFirewallPolicyRuleCollection, error) {
+	var rulesCollections []network.BasicFirewallPolicyRuleCollection
+	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionApplication(d.Get("application_rule_collection").(*pluginsdk.Set).List())...)
+	rulesCollections = append(rulesCollections, expandFirewallPolicyRuleCollectionNetwork(d.Get("network_rule_collection").(*pluginsdk.Set).List())...)
+
+	natRules, err := expandFirewallPolicyRuleCollectionNat(d.Get("nat_rule_collection").(*pluginsdk.Set).List())
+	if err != nil {
+		return nil, fmt.Errorf("expanding NAT rule collection: %w", err)
+	}
+	rulesCollections = append(rulesCollections, natRules...)
+
+	return &rulesCollections, nil
+}
+
 func expandFirewallPolicyRuleCollectionApplication(input []interface{}) []network.BasicFirewallPolicyRuleCollection {
 	return expandFirewallPolicyFilterRuleCollection(input, expandFirewallPolicyRuleApplication)
 }
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FirewallPolicyDraftId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	FirewallPolicyName string
+	Name               string
+}
+
+func NewFirewallPolicyDraftID(subscriptionId, resourceGroup, firewallPolicyName, name string) FirewallPolicyDraftId {
+	return FirewallPolicyDraftId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		FirewallPolicyName: firewallPolicyName,
+		Name:               name,
+	}
+}
+
+func (id FirewallPolicyDraftId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Firewall Policy Name %q", id.FirewallPolicyName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Firewall Policy Draft", segmentsStr)
+}
+
+func (id FirewallPolicyDraftId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s/firewallPolicyDrafts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.Name)
+}
+
+// FirewallPolicyDraftID parses a FirewallPolicyDraft ID into an FirewallPolicyDraftId struct
+func FirewallPolicyDraftID(input string) (*FirewallPolicyDraftId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FirewallPolicyDraftId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("firewallPolicyDrafts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FirewallPolicyRuleCollectionGroupDraftId struct {
+	SubscriptionId               string
+	ResourceGroup                string
+	FirewallPolicyName           string
+	RuleCollectionGroupName      string
+	RuleCollectionGroupDraftName string
+}
+
+func NewFirewallPolicyRuleCollectionGroupDraftID(subscriptionId, resourceGroup, firewallPolicyName, ruleCollectionGroupName, ruleCollectionGroupDraftName string) FirewallPolicyRuleCollectionGroupDraftId {
+	return FirewallPolicyRuleCollectionGroupDraftId{
+		SubscriptionId:               subscriptionId,
+		ResourceGroup:                resourceGroup,
+		FirewallPolicyName:           firewallPolicyName,
+		RuleCollectionGroupName:      ruleCollectionGroupName,
+		RuleCollectionGroupDraftName: ruleCollectionGroupDraftName,
+	}
+}
+
+func (id FirewallPolicyRuleCollectionGroupDraftId) String() string {
+	segments := []string{
+		fmt.Sprintf("Rule Collection Group Draft Name %q", id.RuleCollectionGroupDraftName),
+		fmt.Sprintf("Rule Collection Group Name %q", id.RuleCollectionGroupName),
+		fmt.Sprintf("Firewall Policy Name %q", id.FirewallPolicyName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Firewall Policy Rule Collection Group Draft", segmentsStr)
+}
+
+func (id FirewallPolicyRuleCollectionGroupDraftId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/firewallPolicies/%s/ruleCollectionGroups/%s/ruleCollectionGroupDrafts/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName, id.RuleCollectionGroupDraftName)
+}
+
+// FirewallPolicyRuleCollectionGroupDraftID parses a FirewallPolicyRuleCollectionGroupDraft ID into an FirewallPolicyRuleCollectionGroupDraftId struct
+func FirewallPolicyRuleCollectionGroupDraftID(input string) (*FirewallPolicyRuleCollectionGroupDraftId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FirewallPolicyRuleCollectionGroupDraftId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleCollectionGroupName, err = id.PopSegment("ruleCollectionGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleCollectionGroupDraftName, err = id.PopSegment("ruleCollectionGroupDrafts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,15 @@
+package firewallpolicydeployments
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FirewallPolicyDeploymentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFirewallPolicyDeploymentsClientWithBaseURI(endpoint string) FirewallPolicyDeploymentsClient {
+	return FirewallPolicyDeploymentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,74 @@
+package firewallpolicydeployments
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type DeployResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Deploy ...
+func (c FirewallPolicyDeploymentsClient) Deploy(ctx context.Context, id FirewallPolicyId) (result DeployResponse, err error) {
+	req, err := c.preparerForDeploy(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicydeployments.FirewallPolicyDeploymentsClient", "Deploy", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDeploy(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "firewallpolicydeployments.FirewallPolicyDeploymentsClient", "Deploy", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DeployThenPoll performs Deploy then polls until it's completed
+func (c FirewallPolicyDeploymentsClient) DeployThenPoll(ctx context.Context, id FirewallPolicyId) error {
+	result, err := c.Deploy(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing Deploy: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Deploy: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDeploy prepares the Deploy request.
+func (c FirewallPolicyDeploymentsClient) preparerForDeploy(ctx context.Context, id FirewallPolicyId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/deploy", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDeploy sends the Deploy request. The method will close the
+// http.Response Body if it receives an error.
+func (c FirewallPolicyDeploymentsClient) senderForDeploy(ctx context.Context, req *http.Request) (future DeployResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
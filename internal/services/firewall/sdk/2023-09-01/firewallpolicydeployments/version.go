@@ -0,0 +1,9 @@
+package firewallpolicydeployments
+
+import "fmt"
+
+const defaultApiVersion = "2023-09-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/firewallpolicydeployments/%s", defaultApiVersion)
+}
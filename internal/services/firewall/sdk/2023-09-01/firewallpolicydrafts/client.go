@@ -0,0 +1,15 @@
+package firewallpolicydrafts
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FirewallPolicyDraftsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFirewallPolicyDraftsClientWithBaseURI(endpoint string) FirewallPolicyDraftsClient {
+	return FirewallPolicyDraftsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
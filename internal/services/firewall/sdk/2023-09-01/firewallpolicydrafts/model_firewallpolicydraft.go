@@ -0,0 +1,8 @@
+package firewallpolicydrafts
+
+type FirewallPolicyDraft struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *FirewallPolicyDraftProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
@@ -0,0 +1,12 @@
+package firewallpolicydrafts
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+)
+
+type FirewallPolicyDraftProperties struct {
+	BasePolicy           *network.SubResource                        `json:"basePolicy,omitempty"`
+	DnsSettings          *network.DNSSettings                        `json:"dnsSettings,omitempty"`
+	ThreatIntelMode      network.AzureFirewallThreatIntelMode        `json:"threatIntelMode,omitempty"`
+	ThreatIntelWhitelist *network.FirewallPolicyThreatIntelWhitelist `json:"threatIntelWhitelist,omitempty"`
+}
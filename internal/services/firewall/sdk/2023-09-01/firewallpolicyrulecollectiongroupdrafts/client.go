@@ -0,0 +1,15 @@
+package firewallpolicyrulecollectiongroupdrafts
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FirewallPolicyRuleCollectionGroupDraftsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFirewallPolicyRuleCollectionGroupDraftsClientWithBaseURI(endpoint string) FirewallPolicyRuleCollectionGroupDraftsClient {
+	return FirewallPolicyRuleCollectionGroupDraftsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
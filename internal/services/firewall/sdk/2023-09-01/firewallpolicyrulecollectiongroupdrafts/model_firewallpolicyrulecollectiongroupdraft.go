@@ -0,0 +1,12 @@
+package firewallpolicyrulecollectiongroupdrafts
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+)
+
+type FirewallPolicyRuleCollectionGroupDraft struct {
+	Id         *string                                              `json:"id,omitempty"`
+	Name       *string                                              `json:"name,omitempty"`
+	Properties *network.FirewallPolicyRuleCollectionGroupProperties `json:"properties,omitempty"`
+	Type       *string                                              `json:"type,omitempty"`
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-05-01/frontdoors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2025-03-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -290,3 +291,41 @@ func verifyLoadBalancingAndHealthProbeSettings(backendPools []interface{}, loadB
 
 	return nil
 }
+
+func frontDoorFirewallPolicyCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	if d.Get("sku_name").(string) == string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor) {
+		return nil
+	}
+
+	// the JavaScript Challenge and CAPTCHA actions are only supported by the Premium tier
+	for _, field := range []string{"js_challenge_cookie_expiration_in_minutes", "captcha_cookie_expiration_in_minutes"} {
+		if _, ok := d.GetOk(field); ok {
+			return fmt.Errorf("%q can only be specified when `sku_name` is %q", field, string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor))
+		}
+	}
+
+	for _, item := range d.Get("custom_rule").([]interface{}) {
+		rule := item.(map[string]interface{})
+		if action := rule["action"].(string); frontDoorFirewallPolicyActionRequiresPremium(action) {
+			return fmt.Errorf("the `custom_rule` %q uses the %q action which can only be used when `sku_name` is %q", rule["name"].(string), action, string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor))
+		}
+	}
+
+	for _, managedRule := range d.Get("managed_rule").([]interface{}) {
+		for _, item := range managedRule.(map[string]interface{})["override"].([]interface{}) {
+			override := item.(map[string]interface{})
+			for _, r := range override["rule"].([]interface{}) {
+				rule := r.(map[string]interface{})
+				if action := rule["action"].(string); frontDoorFirewallPolicyActionRequiresPremium(action) {
+					return fmt.Errorf("the override for rule %q in rule group %q uses the %q action which can only be used when `sku_name` is %q", rule["rule_id"].(string), override["rule_group_name"].(string), action, string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func frontDoorFirewallPolicyActionRequiresPremium(action string) bool {
+	return action == string(webapplicationfirewallpolicies.ActionTypeJSChallenge) || action == string(webapplicationfirewallpolicies.ActionTypeCAPTCHA)
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/parse"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-04-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2025-03-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -34,6 +34,8 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 			0: migration.WebApplicationFirewallPolicyV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(frontDoorFirewallPolicyCustomizeDiff),
+
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.WebApplicationFirewallPolicyIDInsensitively(id)
 			return err
@@ -74,6 +76,32 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				Default: string(webapplicationfirewallpolicies.PolicyModePrevention),
 			},
 
+			"sku_name": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(webapplicationfirewallpolicies.SkuNameClassicAzureFrontDoor),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(webapplicationfirewallpolicies.SkuNameClassicAzureFrontDoor),
+					string(webapplicationfirewallpolicies.SkuNameStandardAzureFrontDoor),
+					string(webapplicationfirewallpolicies.SkuNamePremiumAzureFrontDoor),
+				}, false),
+			},
+
+			"js_challenge_cookie_expiration_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
+			"captcha_cookie_expiration_in_minutes": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 1440),
+			},
+
 			"redirect_url": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -149,6 +177,8 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(webapplicationfirewallpolicies.ActionTypeAllow),
 								string(webapplicationfirewallpolicies.ActionTypeBlock),
+								string(webapplicationfirewallpolicies.ActionTypeCAPTCHA),
+								string(webapplicationfirewallpolicies.ActionTypeJSChallenge),
 								string(webapplicationfirewallpolicies.ActionTypeLog),
 								string(webapplicationfirewallpolicies.ActionTypeRedirect),
 							}, false),
@@ -261,6 +291,18 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
+						// only supported (and defaulted by the API) for `Microsoft_DefaultRuleSet` 2.0 and later
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(webapplicationfirewallpolicies.ManagedRuleSetActionTypeBlock),
+								string(webapplicationfirewallpolicies.ManagedRuleSetActionTypeLog),
+								string(webapplicationfirewallpolicies.ManagedRuleSetActionTypeRedirect),
+							}, false),
+						},
+
 						"exclusion": {
 							Type:     pluginsdk.TypeList,
 							MaxItems: 100,
@@ -272,6 +314,7 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 										Required: true,
 										ValidateFunc: validation.StringInSlice([]string{
 											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
+											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames),
 											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
 											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
 											string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
@@ -320,6 +363,7 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 													Required: true,
 													ValidateFunc: validation.StringInSlice([]string{
 														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
+														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames),
 														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
 														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
 														string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
@@ -374,6 +418,7 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 																Required: true,
 																ValidateFunc: validation.StringInSlice([]string{
 																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableQueryStringArgNames),
+																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames),
 																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
 																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestCookieNames),
 																	string(webapplicationfirewallpolicies.ManagedRuleExclusionMatchVariableRequestHeaderNames),
@@ -405,6 +450,7 @@ func resourceFrontDoorFirewallPolicy() *pluginsdk.Resource {
 													ValidateFunc: validation.StringInSlice([]string{
 														string(webapplicationfirewallpolicies.ActionTypeAllow),
 														string(webapplicationfirewallpolicies.ActionTypeBlock),
+														string(webapplicationfirewallpolicies.ActionTypeJSChallenge),
 														string(webapplicationfirewallpolicies.ActionTypeLog),
 														string(webapplicationfirewallpolicies.ActionTypeRedirect),
 													}, false),
@@ -462,6 +508,7 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta
 		enabled = webapplicationfirewallpolicies.PolicyEnabledStateEnabled
 	}
 	mode := webapplicationfirewallpolicies.PolicyMode(d.Get("mode").(string))
+	skuName := webapplicationfirewallpolicies.SkuName(d.Get("sku_name").(string))
 	redirectUrl := d.Get("redirect_url").(string)
 	customBlockResponseStatusCode := d.Get("custom_block_response_status_code").(int)
 	customBlockResponseBody := d.Get("custom_block_response_body").(string)
@@ -481,9 +528,18 @@ func resourceFrontDoorFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta
 			CustomRules:  expandFrontDoorFirewallCustomRules(customRules),
 			ManagedRules: expandFrontDoorFirewallManagedRules(managedRules),
 		},
+		Sku: &webapplicationfirewallpolicies.Sku{
+			Name: &skuName,
+		},
 		Tags: tagsHelper.Expand(t),
 	}
 
+	if v, ok := d.GetOk("js_challenge_cookie_expiration_in_minutes"); ok {
+		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.JavascriptChallengeExpirationInMinutes = utils.Int64(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("captcha_cookie_expiration_in_minutes"); ok {
+		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.CaptchaExpirationInMinutes = utils.Int64(int64(v.(int)))
+	}
 	if redirectUrl != "" {
 		frontdoorWebApplicationFirewallPolicy.Properties.PolicySettings.RedirectUrl = utils.String(redirectUrl)
 	}
@@ -529,6 +585,13 @@ func resourceFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta interfa
 		if location := model.Location; location != nil {
 			d.Set("location", azure.NormalizeLocation(*location))
 		}
+
+		skuName := string(webapplicationfirewallpolicies.SkuNameClassicAzureFrontDoor)
+		if model.Sku != nil && model.Sku.Name != nil {
+			skuName = string(*model.Sku.Name)
+		}
+		d.Set("sku_name", skuName)
+
 		if properties := model.Properties; properties != nil {
 			if policy := properties.PolicySettings; policy != nil {
 				if policy.EnabledState != nil {
@@ -540,6 +603,8 @@ func resourceFrontDoorFirewallPolicyRead(d *pluginsdk.ResourceData, meta interfa
 				d.Set("redirect_url", policy.RedirectUrl)
 				d.Set("custom_block_response_status_code", policy.CustomBlockResponseStatusCode)
 				d.Set("custom_block_response_body", policy.CustomBlockResponseBody)
+				d.Set("js_challenge_cookie_expiration_in_minutes", policy.JavascriptChallengeExpirationInMinutes)
+				d.Set("captcha_cookie_expiration_in_minutes", policy.CaptchaExpirationInMinutes)
 			}
 
 			if err := d.Set("custom_rule", flattenFrontDoorFirewallCustomRules(properties.CustomRules)); err != nil {
@@ -688,6 +753,11 @@ func expandFrontDoorFirewallManagedRules(input []interface{}) *webapplicationfir
 			RuleSetVersion: version,
 		}
 
+		if action := managedRule["action"].(string); action != "" {
+			ruleSetAction := webapplicationfirewallpolicies.ManagedRuleSetActionType(action)
+			managedRuleSet.RuleSetAction = &ruleSetAction
+		}
+
 		if exclusions := expandFrontDoorFirewallManagedRuleGroupExclusion(exclusions); exclusions != nil {
 			managedRuleSet.Exclusions = exclusions
 		}
@@ -866,6 +936,12 @@ func flattenFrontDoorFirewallManagedRules(input *webapplicationfirewallpolicies.
 
 		output["version"] = r.RuleSetVersion
 
+		action := ""
+		if r.RuleSetAction != nil {
+			action = string(*r.RuleSetAction)
+		}
+		output["action"] = action
+
 		if v := r.RuleGroupOverrides; v != nil {
 			output["override"] = flattenFrontDoorFirewallOverrides(v)
 		}
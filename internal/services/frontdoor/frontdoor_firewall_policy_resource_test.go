@@ -3,12 +3,13 @@ package frontdoor_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-04-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2025-03-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -107,6 +108,36 @@ func TestAccFrontDoorFirewallPolicy_complete(t *testing.T) {
 	})
 }
 
+func TestAccFrontDoorFirewallPolicy_premiumChallenges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_frontdoor_firewall_policy", "test")
+	r := FrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premiumChallenges(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("Premium_AzureFrontDoor"),
+				check.That(data.ResourceName).Key("js_challenge_cookie_expiration_in_minutes").HasValue("45"),
+				check.That(data.ResourceName).Key("captcha_cookie_expiration_in_minutes").HasValue("60"),
+				check.That(data.ResourceName).Key("custom_rule.0.action").HasValue("JSChallenge"),
+				check.That(data.ResourceName).Key("custom_rule.1.action").HasValue("CAPTCHA"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFrontDoorFirewallPolicy_challengeRequiresPremium(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_frontdoor_firewall_policy", "test")
+	r := FrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.challengeOnClassic(data),
+			ExpectError: regexp.MustCompile("can only be used when `sku_name` is \"Premium_AzureFrontDoor\""),
+		},
+	})
+}
+
 func (FrontDoorFirewallPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := webapplicationfirewallpolicies.ParseFrontDoorWebApplicationFirewallPoliciesIDInsensitively(state.ID)
 	if err != nil {
@@ -351,3 +382,124 @@ resource "azurerm_frontdoor_firewall_policy" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (FrontDoorFirewallPolicyResource) premiumChallenges(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "testaccRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                                      = "testAccFrontDoorWAF%[1]d"
+  resource_group_name                       = azurerm_resource_group.test.name
+  sku_name                                  = "Premium_AzureFrontDoor"
+  js_challenge_cookie_expiration_in_minutes = 45
+  captcha_cookie_expiration_in_minutes      = 60
+
+  custom_rule {
+    name     = "Challenge"
+    enabled  = true
+    priority = 1
+    type     = "MatchRule"
+    action   = "JSChallenge"
+
+    match_condition {
+      match_variable     = "RequestUri"
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["/login"]
+    }
+  }
+
+  custom_rule {
+    name     = "Captcha"
+    enabled  = true
+    priority = 2
+    type     = "MatchRule"
+    action   = "CAPTCHA"
+
+    match_condition {
+      match_variable     = "RequestUri"
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["/signup"]
+    }
+  }
+
+  managed_rule {
+    type    = "Microsoft_DefaultRuleSet"
+    version = "2.1"
+    action  = "Block"
+
+    override {
+      rule_group_name = "SQLI"
+
+      rule {
+        rule_id = "942100"
+        enabled = true
+        action  = "Log"
+
+        exclusion {
+          match_variable = "RequestBodyJsonArgNames"
+          operator       = "Equals"
+          selector       = "query"
+        }
+      }
+    }
+  }
+
+  managed_rule {
+    type    = "Microsoft_BotManagerRuleSet"
+    version = "1.1"
+
+    override {
+      rule_group_name = "UnknownBots"
+
+      rule {
+        rule_id = "Bot300100"
+        enabled = true
+        action  = "JSChallenge"
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (FrontDoorFirewallPolicyResource) challengeOnClassic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "testaccRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_frontdoor_firewall_policy" "test" {
+  name                = "testAccFrontDoorWAF%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+
+  custom_rule {
+    name     = "Challenge"
+    enabled  = true
+    priority = 1
+    type     = "MatchRule"
+    action   = "JSChallenge"
+
+    match_condition {
+      match_variable     = "RequestUri"
+      operator           = "Contains"
+      negation_condition = false
+      match_values       = ["/login"]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
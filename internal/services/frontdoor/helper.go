@@ -3,8 +3,8 @@ package frontdoor
 import (
 	"fmt"
 
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-04-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2020-05-01/frontdoors"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/frontdoor/sdk/2025-03-01/webapplicationfirewallpolicies"
 )
 
 func isFrontDoorFrontendEndpointConfigurable(currentState frontdoors.CustomHttpsProvisioningState, customHttpsProvisioningEnabled bool, frontendEndpointId frontdoors.FrontendEndpointId) error {
@@ -5,16 +5,20 @@ import "strings"
 type ActionType string
 
 const (
-	ActionTypeAllow    ActionType = "Allow"
-	ActionTypeBlock    ActionType = "Block"
-	ActionTypeLog      ActionType = "Log"
-	ActionTypeRedirect ActionType = "Redirect"
+	ActionTypeAllow       ActionType = "Allow"
+	ActionTypeBlock       ActionType = "Block"
+	ActionTypeCAPTCHA     ActionType = "CAPTCHA"
+	ActionTypeJSChallenge ActionType = "JSChallenge"
+	ActionTypeLog         ActionType = "Log"
+	ActionTypeRedirect    ActionType = "Redirect"
 )
 
 func PossibleValuesForActionType() []string {
 	return []string{
 		string(ActionTypeAllow),
 		string(ActionTypeBlock),
+		string(ActionTypeCAPTCHA),
+		string(ActionTypeJSChallenge),
 		string(ActionTypeLog),
 		string(ActionTypeRedirect),
 	}
@@ -22,10 +26,12 @@ func PossibleValuesForActionType() []string {
 
 func parseActionType(input string) (*ActionType, error) {
 	vals := map[string]ActionType{
-		"allow":    ActionTypeAllow,
-		"block":    ActionTypeBlock,
-		"log":      ActionTypeLog,
-		"redirect": ActionTypeRedirect,
+		"allow":       ActionTypeAllow,
+		"block":       ActionTypeBlock,
+		"captcha":     ActionTypeCAPTCHA,
+		"jschallenge": ActionTypeJSChallenge,
+		"log":         ActionTypeLog,
+		"redirect":    ActionTypeRedirect,
 	}
 	if v, ok := vals[strings.ToLower(input)]; ok {
 		return &v, nil
@@ -96,6 +102,7 @@ type ManagedRuleExclusionMatchVariable string
 
 const (
 	ManagedRuleExclusionMatchVariableQueryStringArgNames     ManagedRuleExclusionMatchVariable = "QueryStringArgNames"
+	ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames ManagedRuleExclusionMatchVariable = "RequestBodyJsonArgNames"
 	ManagedRuleExclusionMatchVariableRequestBodyPostArgNames ManagedRuleExclusionMatchVariable = "RequestBodyPostArgNames"
 	ManagedRuleExclusionMatchVariableRequestCookieNames      ManagedRuleExclusionMatchVariable = "RequestCookieNames"
 	ManagedRuleExclusionMatchVariableRequestHeaderNames      ManagedRuleExclusionMatchVariable = "RequestHeaderNames"
@@ -104,6 +111,7 @@ const (
 func PossibleValuesForManagedRuleExclusionMatchVariable() []string {
 	return []string{
 		string(ManagedRuleExclusionMatchVariableQueryStringArgNames),
+		string(ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames),
 		string(ManagedRuleExclusionMatchVariableRequestBodyPostArgNames),
 		string(ManagedRuleExclusionMatchVariableRequestCookieNames),
 		string(ManagedRuleExclusionMatchVariableRequestHeaderNames),
@@ -113,6 +121,7 @@ func PossibleValuesForManagedRuleExclusionMatchVariable() []string {
 func parseManagedRuleExclusionMatchVariable(input string) (*ManagedRuleExclusionMatchVariable, error) {
 	vals := map[string]ManagedRuleExclusionMatchVariable{
 		"querystringargnames":     ManagedRuleExclusionMatchVariableQueryStringArgNames,
+		"requestbodyjsonargnames": ManagedRuleExclusionMatchVariableRequestBodyJsonArgNames,
 		"requestbodypostargnames": ManagedRuleExclusionMatchVariableRequestBodyPostArgNames,
 		"requestcookienames":      ManagedRuleExclusionMatchVariableRequestCookieNames,
 		"requestheadernames":      ManagedRuleExclusionMatchVariableRequestHeaderNames,
@@ -163,6 +172,37 @@ func parseManagedRuleExclusionSelectorMatchOperator(input string) (*ManagedRuleE
 	return &out, nil
 }
 
+type ManagedRuleSetActionType string
+
+const (
+	ManagedRuleSetActionTypeBlock    ManagedRuleSetActionType = "Block"
+	ManagedRuleSetActionTypeLog      ManagedRuleSetActionType = "Log"
+	ManagedRuleSetActionTypeRedirect ManagedRuleSetActionType = "Redirect"
+)
+
+func PossibleValuesForManagedRuleSetActionType() []string {
+	return []string{
+		string(ManagedRuleSetActionTypeBlock),
+		string(ManagedRuleSetActionTypeLog),
+		string(ManagedRuleSetActionTypeRedirect),
+	}
+}
+
+func parseManagedRuleSetActionType(input string) (*ManagedRuleSetActionType, error) {
+	vals := map[string]ManagedRuleSetActionType{
+		"block":    ManagedRuleSetActionTypeBlock,
+		"log":      ManagedRuleSetActionTypeLog,
+		"redirect": ManagedRuleSetActionTypeRedirect,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedRuleSetActionType(input)
+	return &out, nil
+}
+
 type MatchVariable string
 
 const (
@@ -433,3 +473,34 @@ func parseTransformType(input string) (*TransformType, error) {
 	out := TransformType(input)
 	return &out, nil
 }
+
+type SkuName string
+
+const (
+	SkuNameClassicAzureFrontDoor  SkuName = "Classic_AzureFrontDoor"
+	SkuNamePremiumAzureFrontDoor  SkuName = "Premium_AzureFrontDoor"
+	SkuNameStandardAzureFrontDoor SkuName = "Standard_AzureFrontDoor"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameClassicAzureFrontDoor),
+		string(SkuNamePremiumAzureFrontDoor),
+		string(SkuNameStandardAzureFrontDoor),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"classic_azurefrontdoor":  SkuNameClassicAzureFrontDoor,
+		"premium_azurefrontdoor":  SkuNamePremiumAzureFrontDoor,
+		"standard_azurefrontdoor": SkuNameStandardAzureFrontDoor,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
@@ -3,6 +3,7 @@ package webapplicationfirewallpolicies
 type ManagedRuleSet struct {
 	Exclusions         *[]ManagedRuleExclusion     `json:"exclusions,omitempty"`
 	RuleGroupOverrides *[]ManagedRuleGroupOverride `json:"ruleGroupOverrides,omitempty"`
+	RuleSetAction      *ManagedRuleSetActionType   `json:"ruleSetAction,omitempty"`
 	RuleSetType        string                      `json:"ruleSetType"`
 	RuleSetVersion     string                      `json:"ruleSetVersion"`
 }
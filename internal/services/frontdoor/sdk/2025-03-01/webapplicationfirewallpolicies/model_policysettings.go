@@ -0,0 +1,11 @@
+package webapplicationfirewallpolicies
+
+type PolicySettings struct {
+	CaptchaExpirationInMinutes             *int64              `json:"captchaExpirationInMinutes,omitempty"`
+	CustomBlockResponseBody                *string             `json:"customBlockResponseBody,omitempty"`
+	CustomBlockResponseStatusCode          *int64              `json:"customBlockResponseStatusCode,omitempty"`
+	EnabledState                           *PolicyEnabledState `json:"enabledState,omitempty"`
+	JavascriptChallengeExpirationInMinutes *int64              `json:"javascriptChallengeExpirationInMinutes,omitempty"`
+	Mode                                   *PolicyMode         `json:"mode,omitempty"`
+	RedirectUrl                            *string             `json:"redirectUrl,omitempty"`
+}
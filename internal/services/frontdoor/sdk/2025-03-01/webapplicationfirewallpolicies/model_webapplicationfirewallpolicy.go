@@ -6,6 +6,7 @@ type WebApplicationFirewallPolicy struct {
 	Location   *string                                 `json:"location,omitempty"`
 	Name       *string                                 `json:"name,omitempty"`
 	Properties *WebApplicationFirewallPolicyProperties `json:"properties,omitempty"`
+	Sku        *Sku                                    `json:"sku,omitempty"`
 	Tags       *map[string]string                      `json:"tags,omitempty"`
 	Type       *string                                 `json:"type,omitempty"`
 }
@@ -46,24 +46,14 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 			roles := rolesRaw[0].(map[string]interface{})
 			workerNodes := roles["worker_node"].([]interface{})
 			workerNode := workerNodes[0].(map[string]interface{})
-			if d.HasChange("roles.0.worker_node.0.target_instance_count") {
-				targetInstanceCount := workerNode["target_instance_count"].(int)
-				params := hdinsight.ClusterResizeParameters{
-					TargetInstanceCount: utils.Int32(int32(targetInstanceCount)),
-				}
-
-				future, err := client.Resize(ctx, resourceGroup, name, params)
-				if err != nil {
-					return fmt.Errorf("resizing the HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
-				}
 
-				if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-					return fmt.Errorf("waiting for the HDInsight %q Cluster %q (Resource Group %q) to finish resizing: %+v", clusterKind, name, resourceGroup, err)
-				}
+			// the autoscale configuration is updated first since a cluster can't be manually resized whilst autoscale is enabled
+			var autoscale *hdinsight.Autoscale
+			if v, ok := workerNode["autoscale"]; ok {
+				autoscale = ExpandHDInsightNodeAutoScaleDefinition(v.([]interface{}))
 			}
 
 			if d.HasChange("roles.0.worker_node.0.autoscale") {
-				autoscale := ExpandHDInsightNodeAutoScaleDefinition(workerNode["autoscale"].([]interface{}))
 				params := hdinsight.AutoscaleConfigurationUpdateParameter{
 					Autoscale: autoscale,
 				}
@@ -78,6 +68,26 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 					return fmt.Errorf("waiting for changing autoscale of the HDInsight %q Cluster %q (Resource Group %q) to finish resizing: %+v", clusterKind, name, resourceGroup, err)
 				}
 			}
+
+			if d.HasChange("roles.0.worker_node.0.target_instance_count") {
+				if autoscale != nil {
+					log.Printf("[DEBUG] Skipping resizing the HDInsight %q Cluster since autoscale is enabled", clusterKind)
+				} else {
+					targetInstanceCount := workerNode["target_instance_count"].(int)
+					params := hdinsight.ClusterResizeParameters{
+						TargetInstanceCount: utils.Int32(int32(targetInstanceCount)),
+					}
+
+					future, err := client.Resize(ctx, resourceGroup, name, params)
+					if err != nil {
+						return fmt.Errorf("resizing the HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
+					}
+
+					if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+						return fmt.Errorf("waiting for the HDInsight %q Cluster %q (Resource Group %q) to finish resizing: %+v", clusterKind, name, resourceGroup, err)
+					}
+				}
+			}
 		}
 
 		// The API can add an edge node but can't remove them without force newing the pluginsdk. We'll check for adding here
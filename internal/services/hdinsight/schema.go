@@ -71,6 +71,20 @@ func SchemaHDInsightClusterVersion() *pluginsdk.Schema {
 	}
 }
 
+// hdinsightTargetInstanceCountDiffSuppressFunc suppresses changes to the `target_instance_count` whilst autoscale is
+// configured, since the number of instances is then managed by the service - once autoscale is removed, any
+// difference between the configured and the actual number of instances shows up as a diff so the cluster is resized
+func hdinsightTargetInstanceCountDiffSuppressFunc(schemaLocation string) pluginsdk.SchemaDiffSuppressFunc {
+	return func(_, old, _ string, d *pluginsdk.ResourceData) bool {
+		if old == "" {
+			return false
+		}
+
+		autoscale, ok := d.GetOk(fmt.Sprintf("%s.0.autoscale", schemaLocation))
+		return ok && len(autoscale.([]interface{})) > 0
+	}
+}
+
 func hdinsightClusterVersionDiffSuppressFunc(_, old, new string, _ *pluginsdk.ResourceData) bool {
 	// `3.6` gets converted to `3.6.1000.67`; so let's just compare major/minor if possible
 	o := strings.Split(old, ".")
@@ -805,6 +819,8 @@ func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNo
 		}
 
 		if definition.CanAutoScaleByCapacity || definition.CanAutoScaleOnSchedule {
+			result["target_instance_count"].DiffSuppressFunc = hdinsightTargetInstanceCountDiffSuppressFunc(schemaLocation)
+
 			autoScales := map[string]*pluginsdk.Schema{}
 
 			if definition.CanAutoScaleByCapacity {
@@ -1,6 +1,12 @@
 package hdinsight
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/hdinsight/mgmt/2018-06-01/hdinsight"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
 
 func TestHDInsightClusterVersionDiffSuppress(t *testing.T) {
 	tests := []struct {
@@ -49,3 +55,99 @@ func TestHDInsightClusterVersionDiffSuppress(t *testing.T) {
 		})
 	}
 }
+
+func TestFlattenHDInsightNodeDefinitionTargetInstanceCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		autoscale *hdinsight.Autoscale
+	}{
+		{
+			name:      "autoscale disabled",
+			autoscale: nil,
+		},
+		{
+			name: "autoscale enabled",
+			autoscale: &hdinsight.Autoscale{
+				Capacity: &hdinsight.AutoscaleCapacity{
+					MinInstanceCount: utils.Int32(2),
+					MaxInstanceCount: utils.Int32(5),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &hdinsight.Role{
+				TargetInstanceCount:    utils.Int32(4),
+				AutoscaleConfiguration: tt.autoscale,
+			}
+			existing := []interface{}{
+				map[string]interface{}{
+					"password":              "",
+					"ssh_keys":              pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
+					"vm_size":               "Standard_D4_v2",
+					"target_instance_count": 2,
+				},
+			}
+
+			output := FlattenHDInsightNodeDefinition(input, existing, hdInsightHadoopClusterWorkerNodeDefinition)
+			if actual := output[0].(map[string]interface{})["target_instance_count"].(int); actual != 4 {
+				t.Errorf("Expected the `target_instance_count` to be the actual number of instances (4) but got %d", actual)
+			}
+		})
+	}
+}
+
+func TestHDInsightTargetInstanceCountDiffSuppress(t *testing.T) {
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"worker_node": SchemaHDInsightNodeDefinition("worker_node", hdInsightHadoopClusterWorkerNodeDefinition, true),
+	}
+	suppressFunc := hdinsightTargetInstanceCountDiffSuppressFunc("worker_node")
+
+	tests := []struct {
+		name       string
+		autoscale  []interface{}
+		old        string
+		suppressed bool
+	}{
+		{
+			name:       "creating with autoscale",
+			autoscale:  []interface{}{map[string]interface{}{"capacity": []interface{}{map[string]interface{}{"min_instance_count": 2, "max_instance_count": 5}}}},
+			old:        "",
+			suppressed: false,
+		},
+		{
+			name:       "enabling or updating autoscale",
+			autoscale:  []interface{}{map[string]interface{}{"capacity": []interface{}{map[string]interface{}{"min_instance_count": 2, "max_instance_count": 5}}}},
+			old:        "4",
+			suppressed: true,
+		},
+		{
+			name:       "disabling autoscale or resizing",
+			autoscale:  []interface{}{},
+			old:        "4",
+			suppressed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := (&pluginsdk.Resource{Schema: resourceSchema}).TestResourceData()
+			err := d.Set("worker_node", []interface{}{
+				map[string]interface{}{
+					"vm_size":               "Standard_D4_v2",
+					"username":              "adminuser",
+					"password":              "Passw0rd1234!",
+					"target_instance_count": 3,
+					"autoscale":             tt.autoscale,
+				},
+			})
+			if err != nil {
+				t.Fatalf("setting `worker_node`: %+v", err)
+			}
+
+			if actual := suppressFunc("worker_node.0.target_instance_count", tt.old, "3", d); actual != tt.suppressed {
+				t.Errorf("Expected %q to be %t but got %t", tt.name, tt.suppressed, actual)
+			}
+		})
+	}
+}
@@ -3,14 +3,19 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/storagecache/mgmt/2021-09-01/storagecache"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/sdk/2023-05-01/amlfilesystems"
 )
 
 type Client struct {
+	AmlFilesystemsClient *amlfilesystems.AmlFilesystemsClient
 	CachesClient         *storagecache.CachesClient
 	StorageTargetsClient *storagecache.StorageTargetsClient
 }
 
 func NewClient(options *common.ClientOptions) *Client {
+	amlFilesystemsClient := amlfilesystems.NewAmlFilesystemsClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&amlFilesystemsClient.Client, options.ResourceManagerAuthorizer)
+
 	cachesClient := storagecache.NewCachesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&cachesClient.Client, options.ResourceManagerAuthorizer)
 
@@ -18,6 +23,7 @@ func NewClient(options *common.ClientOptions) *Client {
 	options.ConfigureClient(&storageTargetsClient.Client, options.ResourceManagerAuthorizer)
 
 	return &Client{
+		AmlFilesystemsClient: &amlFilesystemsClient,
 		CachesClient:         &cachesClient,
 		StorageTargetsClient: &storageTargetsClient,
 	}
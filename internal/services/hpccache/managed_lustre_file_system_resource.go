@@ -0,0 +1,497 @@
+package hpccache
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hpccache/sdk/2023-05-01/amlfilesystems"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ManagedLustreFileSystemResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagedLustreFileSystemResource{}
+
+type ManagedLustreFileSystemModel struct {
+	Name                string                                     `tfschema:"name"`
+	ResourceGroupName   string                                     `tfschema:"resource_group_name"`
+	Location            string                                     `tfschema:"location"`
+	SkuName             string                                     `tfschema:"sku_name"`
+	StorageCapacityInTB int                                        `tfschema:"storage_capacity_in_tb"`
+	SubnetId            string                                     `tfschema:"subnet_id"`
+	Zones               []string                                   `tfschema:"zones"`
+	MaintenanceWindow   []ManagedLustreFileSystemMaintenanceWindow `tfschema:"maintenance_window"`
+	HsmSetting          []ManagedLustreFileSystemHsmSetting        `tfschema:"hsm_setting"`
+	EncryptionKey       []ManagedLustreFileSystemEncryptionKey     `tfschema:"encryption_key"`
+	Tags                map[string]string                          `tfschema:"tags"`
+	MgsAddress          string                                     `tfschema:"mgs_address"`
+	MountCommand        string                                     `tfschema:"mount_command"`
+}
+
+type ManagedLustreFileSystemMaintenanceWindow struct {
+	DayOfWeek      string `tfschema:"day_of_week"`
+	TimeOfDayInUTC string `tfschema:"time_of_day_in_utc"`
+}
+
+type ManagedLustreFileSystemHsmSetting struct {
+	ContainerId        string `tfschema:"container_id"`
+	LoggingContainerId string `tfschema:"logging_container_id"`
+	ImportPrefix       string `tfschema:"import_prefix"`
+}
+
+type ManagedLustreFileSystemEncryptionKey struct {
+	KeyUrl        string `tfschema:"key_url"`
+	SourceVaultId string `tfschema:"source_vault_id"`
+}
+
+func (r ManagedLustreFileSystemResource) Arguments() map[string]*pluginsdk.Schema {
+	// the identity of a Managed Lustre File System can't be changed once it's been created
+	identitySchema := commonschema.UserAssignedIdentity()
+	identitySchema.ForceNew = true
+	identitySchema.Elem.(*pluginsdk.Resource).Schema["type"].ForceNew = true
+	identitySchema.Elem.(*pluginsdk.Resource).Schema["identity_ids"].ForceNew = true
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[0-9a-zA-Z][-0-9a-zA-Z_]{0,78}[0-9a-zA-Z]$`),
+				"`name` must be between 2 and 80 characters, start and end with a letter or number and may only contain letters, numbers, hyphens and underscores",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"AMLFS-Durable-Premium-40",
+				"AMLFS-Durable-Premium-125",
+				"AMLFS-Durable-Premium-250",
+				"AMLFS-Durable-Premium-500",
+			}, false),
+		},
+
+		"storage_capacity_in_tb": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(4),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"zones": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"maintenance_window": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"day_of_week": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(amlfilesystems.PossibleValuesForMaintenanceDayOfWeekType(), false),
+					},
+
+					"time_of_day_in_utc": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringMatch(
+							regexp.MustCompile(`^([0-1][0-9]|2[0-3]):[0-5][0-9]$`),
+							"`time_of_day_in_utc` must be in the format `HH:MM`",
+						),
+					},
+				},
+			},
+		},
+
+		// the container is used both as the source for imports and the target for archives (exports)
+		"hsm_setting": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"container_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: storageValidate.StorageContainerResourceManagerID,
+					},
+
+					"logging_container_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: storageValidate.StorageContainerResourceManagerID,
+					},
+
+					"import_prefix": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      "/",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"identity": identitySchema,
+
+		"encryption_key": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			RequiredWith: []string{"identity"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemId,
+					},
+
+					"source_vault_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.VaultID,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ManagedLustreFileSystemResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"mgs_address": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"mount_command": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemResource) ModelObject() interface{} {
+	return &ManagedLustreFileSystemModel{}
+}
+
+func (r ManagedLustreFileSystemResource) ResourceType() string {
+	return "azurerm_managed_lustre_file_system"
+}
+
+func (r ManagedLustreFileSystemResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return amlfilesystems.ValidateAmlFilesystemID
+}
+
+func (r ManagedLustreFileSystemResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HPCCache.AmlFilesystemsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ManagedLustreFileSystemModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := amlfilesystems.NewAmlFilesystemID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := amlfilesystems.AmlFilesystem{
+				Location: location.Normalize(model.Location),
+				Properties: &amlfilesystems.AmlFilesystemProperties{
+					EncryptionSettings: expandManagedLustreFileSystemEncryptionKey(model.EncryptionKey),
+					FilesystemSubnet:   model.SubnetId,
+					Hsm:                expandManagedLustreFileSystemHsmSetting(model.HsmSetting),
+					MaintenanceWindow:  expandManagedLustreFileSystemMaintenanceWindow(model.MaintenanceWindow),
+					StorageCapacityTiB: float64(model.StorageCapacityInTB),
+				},
+				Sku: &amlfilesystems.SkuName{
+					Name: utils.String(model.SkuName),
+				},
+				Tags:  &model.Tags,
+				Zones: &model.Zones,
+			}
+			if identityValue.Type != identity.TypeNone {
+				payload.Identity = identityValue
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HPCCache.AmlFilesystemsClient
+
+			id, err := amlfilesystems.ParseAmlFilesystemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagedLustreFileSystemModel{
+				Name:              id.AmlFilesystemName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Sku != nil {
+					state.SkuName = utils.NormalizeNilableString(model.Sku.Name)
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+				if model.Zones != nil {
+					state.Zones = *model.Zones
+				}
+
+				identityValue, err := identity.FlattenUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					state.EncryptionKey = flattenManagedLustreFileSystemEncryptionKey(props.EncryptionSettings)
+					state.HsmSetting = flattenManagedLustreFileSystemHsmSetting(props.Hsm)
+					state.MaintenanceWindow = flattenManagedLustreFileSystemMaintenanceWindow(props.MaintenanceWindow)
+					state.StorageCapacityInTB = int(props.StorageCapacityTiB)
+					state.SubnetId = props.FilesystemSubnet
+
+					if info := props.ClientInfo; info != nil {
+						state.MgsAddress = utils.NormalizeNilableString(info.MgsAddress)
+						state.MountCommand = utils.NormalizeNilableString(info.MountCommand)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HPCCache.AmlFilesystemsClient
+
+			id, err := amlfilesystems.ParseAmlFilesystemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagedLustreFileSystemModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := amlfilesystems.AmlFilesystemUpdate{
+				Properties: &amlfilesystems.AmlFilesystemUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("encryption_key") {
+				// an empty object is sent to remove the customer-managed key
+				encryption := expandManagedLustreFileSystemEncryptionKey(model.EncryptionKey)
+				if encryption == nil {
+					encryption = &amlfilesystems.AmlFilesystemEncryptionSettings{}
+				}
+				payload.Properties.EncryptionSettings = encryption
+			}
+
+			if metadata.ResourceData.HasChange("maintenance_window") {
+				maintenanceWindow := expandManagedLustreFileSystemMaintenanceWindow(model.MaintenanceWindow)
+				payload.Properties.MaintenanceWindow = &amlfilesystems.AmlFilesystemUpdatePropertiesMaintenanceWindow{
+					DayOfWeek:    maintenanceWindow.DayOfWeek,
+					TimeOfDayUTC: maintenanceWindow.TimeOfDayUTC,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagedLustreFileSystemResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HPCCache.AmlFilesystemsClient
+
+			id, err := amlfilesystems.ParseAmlFilesystemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandManagedLustreFileSystemMaintenanceWindow(input []ManagedLustreFileSystemMaintenanceWindow) amlfilesystems.AmlFilesystemPropertiesMaintenanceWindow {
+	if len(input) == 0 {
+		return amlfilesystems.AmlFilesystemPropertiesMaintenanceWindow{}
+	}
+
+	dayOfWeek := amlfilesystems.MaintenanceDayOfWeekType(input[0].DayOfWeek)
+	return amlfilesystems.AmlFilesystemPropertiesMaintenanceWindow{
+		DayOfWeek:    &dayOfWeek,
+		TimeOfDayUTC: utils.String(input[0].TimeOfDayInUTC),
+	}
+}
+
+func flattenManagedLustreFileSystemMaintenanceWindow(input amlfilesystems.AmlFilesystemPropertiesMaintenanceWindow) []ManagedLustreFileSystemMaintenanceWindow {
+	dayOfWeek := ""
+	if input.DayOfWeek != nil {
+		dayOfWeek = string(*input.DayOfWeek)
+	}
+
+	return []ManagedLustreFileSystemMaintenanceWindow{
+		{
+			DayOfWeek:      dayOfWeek,
+			TimeOfDayInUTC: utils.NormalizeNilableString(input.TimeOfDayUTC),
+		},
+	}
+}
+
+func expandManagedLustreFileSystemHsmSetting(input []ManagedLustreFileSystemHsmSetting) *amlfilesystems.AmlFilesystemPropertiesHsm {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &amlfilesystems.AmlFilesystemPropertiesHsm{
+		Settings: &amlfilesystems.AmlFilesystemHsmSettings{
+			Container:        input[0].ContainerId,
+			ImportPrefix:     utils.String(input[0].ImportPrefix),
+			LoggingContainer: input[0].LoggingContainerId,
+		},
+	}
+}
+
+func flattenManagedLustreFileSystemHsmSetting(input *amlfilesystems.AmlFilesystemPropertiesHsm) []ManagedLustreFileSystemHsmSetting {
+	if input == nil || input.Settings == nil {
+		return []ManagedLustreFileSystemHsmSetting{}
+	}
+
+	return []ManagedLustreFileSystemHsmSetting{
+		{
+			ContainerId:        input.Settings.Container,
+			ImportPrefix:       utils.NormalizeNilableString(input.Settings.ImportPrefix),
+			LoggingContainerId: input.Settings.LoggingContainer,
+		},
+	}
+}
+
+func expandManagedLustreFileSystemEncryptionKey(input []ManagedLustreFileSystemEncryptionKey) *amlfilesystems.AmlFilesystemEncryptionSettings {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &amlfilesystems.AmlFilesystemEncryptionSettings{
+		KeyEncryptionKey: &amlfilesystems.KeyVaultKeyReference{
+			KeyUrl: input[0].KeyUrl,
+			SourceVault: amlfilesystems.KeyVaultKeyReferenceSourceVault{
+				Id: utils.String(input[0].SourceVaultId),
+			},
+		},
+	}
+}
+
+func flattenManagedLustreFileSystemEncryptionKey(input *amlfilesystems.AmlFilesystemEncryptionSettings) []ManagedLustreFileSystemEncryptionKey {
+	if input == nil || input.KeyEncryptionKey == nil {
+		return []ManagedLustreFileSystemEncryptionKey{}
+	}
+
+	return []ManagedLustreFileSystemEncryptionKey{
+		{
+			KeyUrl:        input.KeyEncryptionKey.KeyUrl,
+			SourceVaultId: utils.NormalizeNilableString(input.KeyEncryptionKey.SourceVault.Id),
+		},
+	}
+}
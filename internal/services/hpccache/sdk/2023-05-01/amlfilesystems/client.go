@@ -0,0 +1,15 @@
+package amlfilesystems
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AmlFilesystemsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAmlFilesystemsClientWithBaseURI(endpoint string) AmlFilesystemsClient {
+	return AmlFilesystemsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,123 @@
+package amlfilesystems
+
+import "strings"
+
+type AmlFilesystemHealthStateType string
+
+const (
+	AmlFilesystemHealthStateTypeAvailable     AmlFilesystemHealthStateType = "Available"
+	AmlFilesystemHealthStateTypeDegraded      AmlFilesystemHealthStateType = "Degraded"
+	AmlFilesystemHealthStateTypeMaintenance   AmlFilesystemHealthStateType = "Maintenance"
+	AmlFilesystemHealthStateTypeTransitioning AmlFilesystemHealthStateType = "Transitioning"
+	AmlFilesystemHealthStateTypeUnavailable   AmlFilesystemHealthStateType = "Unavailable"
+)
+
+func PossibleValuesForAmlFilesystemHealthStateType() []string {
+	return []string{
+		string(AmlFilesystemHealthStateTypeAvailable),
+		string(AmlFilesystemHealthStateTypeDegraded),
+		string(AmlFilesystemHealthStateTypeMaintenance),
+		string(AmlFilesystemHealthStateTypeTransitioning),
+		string(AmlFilesystemHealthStateTypeUnavailable),
+	}
+}
+
+func parseAmlFilesystemHealthStateType(input string) (*AmlFilesystemHealthStateType, error) {
+	vals := map[string]AmlFilesystemHealthStateType{
+		"available":     AmlFilesystemHealthStateTypeAvailable,
+		"degraded":      AmlFilesystemHealthStateTypeDegraded,
+		"maintenance":   AmlFilesystemHealthStateTypeMaintenance,
+		"transitioning": AmlFilesystemHealthStateTypeTransitioning,
+		"unavailable":   AmlFilesystemHealthStateTypeUnavailable,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AmlFilesystemHealthStateType(input)
+	return &out, nil
+}
+
+type AmlFilesystemProvisioningStateType string
+
+const (
+	AmlFilesystemProvisioningStateTypeCanceled  AmlFilesystemProvisioningStateType = "Canceled"
+	AmlFilesystemProvisioningStateTypeCreated   AmlFilesystemProvisioningStateType = "Created"
+	AmlFilesystemProvisioningStateTypeDeleting  AmlFilesystemProvisioningStateType = "Deleting"
+	AmlFilesystemProvisioningStateTypeFailed    AmlFilesystemProvisioningStateType = "Failed"
+	AmlFilesystemProvisioningStateTypeSucceeded AmlFilesystemProvisioningStateType = "Succeeded"
+	AmlFilesystemProvisioningStateTypeUpdating  AmlFilesystemProvisioningStateType = "Updating"
+)
+
+func PossibleValuesForAmlFilesystemProvisioningStateType() []string {
+	return []string{
+		string(AmlFilesystemProvisioningStateTypeCanceled),
+		string(AmlFilesystemProvisioningStateTypeCreated),
+		string(AmlFilesystemProvisioningStateTypeDeleting),
+		string(AmlFilesystemProvisioningStateTypeFailed),
+		string(AmlFilesystemProvisioningStateTypeSucceeded),
+		string(AmlFilesystemProvisioningStateTypeUpdating),
+	}
+}
+
+func parseAmlFilesystemProvisioningStateType(input string) (*AmlFilesystemProvisioningStateType, error) {
+	vals := map[string]AmlFilesystemProvisioningStateType{
+		"canceled":  AmlFilesystemProvisioningStateTypeCanceled,
+		"created":   AmlFilesystemProvisioningStateTypeCreated,
+		"deleting":  AmlFilesystemProvisioningStateTypeDeleting,
+		"failed":    AmlFilesystemProvisioningStateTypeFailed,
+		"succeeded": AmlFilesystemProvisioningStateTypeSucceeded,
+		"updating":  AmlFilesystemProvisioningStateTypeUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AmlFilesystemProvisioningStateType(input)
+	return &out, nil
+}
+
+type MaintenanceDayOfWeekType string
+
+const (
+	MaintenanceDayOfWeekTypeFriday    MaintenanceDayOfWeekType = "Friday"
+	MaintenanceDayOfWeekTypeMonday    MaintenanceDayOfWeekType = "Monday"
+	MaintenanceDayOfWeekTypeSaturday  MaintenanceDayOfWeekType = "Saturday"
+	MaintenanceDayOfWeekTypeSunday    MaintenanceDayOfWeekType = "Sunday"
+	MaintenanceDayOfWeekTypeThursday  MaintenanceDayOfWeekType = "Thursday"
+	MaintenanceDayOfWeekTypeTuesday   MaintenanceDayOfWeekType = "Tuesday"
+	MaintenanceDayOfWeekTypeWednesday MaintenanceDayOfWeekType = "Wednesday"
+)
+
+func PossibleValuesForMaintenanceDayOfWeekType() []string {
+	return []string{
+		string(MaintenanceDayOfWeekTypeFriday),
+		string(MaintenanceDayOfWeekTypeMonday),
+		string(MaintenanceDayOfWeekTypeSaturday),
+		string(MaintenanceDayOfWeekTypeSunday),
+		string(MaintenanceDayOfWeekTypeThursday),
+		string(MaintenanceDayOfWeekTypeTuesday),
+		string(MaintenanceDayOfWeekTypeWednesday),
+	}
+}
+
+func parseMaintenanceDayOfWeekType(input string) (*MaintenanceDayOfWeekType, error) {
+	vals := map[string]MaintenanceDayOfWeekType{
+		"friday":    MaintenanceDayOfWeekTypeFriday,
+		"monday":    MaintenanceDayOfWeekTypeMonday,
+		"saturday":  MaintenanceDayOfWeekTypeSaturday,
+		"sunday":    MaintenanceDayOfWeekTypeSunday,
+		"thursday":  MaintenanceDayOfWeekTypeThursday,
+		"tuesday":   MaintenanceDayOfWeekTypeTuesday,
+		"wednesday": MaintenanceDayOfWeekTypeWednesday,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := MaintenanceDayOfWeekType(input)
+	return &out, nil
+}
@@ -0,0 +1,17 @@
+package amlfilesystems
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type AmlFilesystem struct {
+	Id         *string                   `json:"id,omitempty"`
+	Identity   *identity.UserAssignedMap `json:"identity,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *AmlFilesystemProperties  `json:"properties,omitempty"`
+	Sku        *SkuName                  `json:"sku,omitempty"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+	Zones      *[]string                 `json:"zones,omitempty"`
+}
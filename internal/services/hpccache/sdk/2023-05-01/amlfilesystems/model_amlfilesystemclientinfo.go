@@ -0,0 +1,7 @@
+package amlfilesystems
+
+type AmlFilesystemClientInfo struct {
+	LustreVersion *string `json:"lustreVersion,omitempty"`
+	MgsAddress    *string `json:"mgsAddress,omitempty"`
+	MountCommand  *string `json:"mountCommand,omitempty"`
+}
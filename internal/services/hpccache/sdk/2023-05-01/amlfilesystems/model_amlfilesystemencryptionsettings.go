@@ -0,0 +1,5 @@
+package amlfilesystems
+
+type AmlFilesystemEncryptionSettings struct {
+	KeyEncryptionKey *KeyVaultKeyReference `json:"keyEncryptionKey,omitempty"`
+}
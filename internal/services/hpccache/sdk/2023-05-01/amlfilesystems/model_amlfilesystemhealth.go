@@ -0,0 +1,7 @@
+package amlfilesystems
+
+type AmlFilesystemHealth struct {
+	State             *AmlFilesystemHealthStateType `json:"state,omitempty"`
+	StatusCode        *string                       `json:"statusCode,omitempty"`
+	StatusDescription *string                       `json:"statusDescription,omitempty"`
+}
@@ -0,0 +1,7 @@
+package amlfilesystems
+
+type AmlFilesystemHsmSettings struct {
+	Container        string  `json:"container"`
+	ImportPrefix     *string `json:"importPrefix,omitempty"`
+	LoggingContainer string  `json:"loggingContainer"`
+}
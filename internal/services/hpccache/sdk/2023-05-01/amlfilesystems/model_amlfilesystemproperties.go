@@ -0,0 +1,13 @@
+package amlfilesystems
+
+type AmlFilesystemProperties struct {
+	ClientInfo                *AmlFilesystemClientInfo                 `json:"clientInfo,omitempty"`
+	EncryptionSettings        *AmlFilesystemEncryptionSettings         `json:"encryptionSettings,omitempty"`
+	FilesystemSubnet          string                                   `json:"filesystemSubnet"`
+	Health                    *AmlFilesystemHealth                     `json:"health,omitempty"`
+	Hsm                       *AmlFilesystemPropertiesHsm              `json:"hsm,omitempty"`
+	MaintenanceWindow         AmlFilesystemPropertiesMaintenanceWindow `json:"maintenanceWindow"`
+	ProvisioningState         *AmlFilesystemProvisioningStateType      `json:"provisioningState,omitempty"`
+	StorageCapacityTiB        float64                                  `json:"storageCapacityTiB"`
+	ThroughputProvisionedMBps *int64                                   `json:"throughputProvisionedMBps,omitempty"`
+}
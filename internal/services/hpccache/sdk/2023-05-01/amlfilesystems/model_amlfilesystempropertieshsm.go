@@ -0,0 +1,5 @@
+package amlfilesystems
+
+type AmlFilesystemPropertiesHsm struct {
+	Settings *AmlFilesystemHsmSettings `json:"settings,omitempty"`
+}
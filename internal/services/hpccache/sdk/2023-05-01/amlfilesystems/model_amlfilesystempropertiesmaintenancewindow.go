@@ -0,0 +1,6 @@
+package amlfilesystems
+
+type AmlFilesystemPropertiesMaintenanceWindow struct {
+	DayOfWeek    *MaintenanceDayOfWeekType `json:"dayOfWeek,omitempty"`
+	TimeOfDayUTC *string                   `json:"timeOfDayUTC,omitempty"`
+}
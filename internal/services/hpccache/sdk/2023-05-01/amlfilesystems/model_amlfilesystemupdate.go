@@ -0,0 +1,6 @@
+package amlfilesystems
+
+type AmlFilesystemUpdate struct {
+	Properties *AmlFilesystemUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string             `json:"tags,omitempty"`
+}
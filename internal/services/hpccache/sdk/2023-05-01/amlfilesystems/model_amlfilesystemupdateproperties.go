@@ -0,0 +1,6 @@
+package amlfilesystems
+
+type AmlFilesystemUpdateProperties struct {
+	EncryptionSettings *AmlFilesystemEncryptionSettings                `json:"encryptionSettings,omitempty"`
+	MaintenanceWindow  *AmlFilesystemUpdatePropertiesMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+}
@@ -0,0 +1,6 @@
+package amlfilesystems
+
+type AmlFilesystemUpdatePropertiesMaintenanceWindow struct {
+	DayOfWeek    *MaintenanceDayOfWeekType `json:"dayOfWeek,omitempty"`
+	TimeOfDayUTC *string                   `json:"timeOfDayUTC,omitempty"`
+}
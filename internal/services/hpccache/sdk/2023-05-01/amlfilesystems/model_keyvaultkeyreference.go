@@ -0,0 +1,6 @@
+package amlfilesystems
+
+type KeyVaultKeyReference struct {
+	KeyUrl      string                          `json:"keyUrl"`
+	SourceVault KeyVaultKeyReferenceSourceVault `json:"sourceVault"`
+}
@@ -0,0 +1,5 @@
+package amlfilesystems
+
+type KeyVaultKeyReferenceSourceVault struct {
+	Id *string `json:"id,omitempty"`
+}
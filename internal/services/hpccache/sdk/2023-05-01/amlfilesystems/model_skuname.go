@@ -0,0 +1,5 @@
+package amlfilesystems
+
+type SkuName struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,251 @@
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Arc Machine only supports a single License Profile, which is always named `default`
+const arcMachineLicenseProfileName = "default"
+
+type ArcMachineEsuLicenseAssignmentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineEsuLicenseAssignmentResource{}
+
+type ArcMachineEsuLicenseAssignmentResourceModel struct {
+	ArcMachineId   string `tfschema:"arc_machine_id"`
+	LicenseId      string `tfschema:"license_id"`
+	EsuEligibility string `tfschema:"esu_eligibility"`
+	EsuKeyState    string `tfschema:"esu_key_state"`
+	ServerType     string `tfschema:"server_type"`
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"license_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: licenses.ValidateLicenseID,
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"esu_eligibility": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_key_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"server_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) ModelObject() interface{} {
+	return &ArcMachineEsuLicenseAssignmentResourceModel{}
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) ResourceType() string {
+	return "azurerm_arc_machine_esu_license_assignment"
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return licenseprofiles.ValidateLicenseProfileID
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+			machinesClient := metadata.Client.HybridCompute.MachinesClient
+
+			var model ArcMachineEsuLicenseAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := licenseprofiles.NewLicenseProfileID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, arcMachineLicenseProfileName)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				if model := existing.Model; model != nil && model.Properties != nil && model.Properties.EsuProfile != nil && model.Properties.EsuProfile.AssignedLicense != nil && *model.Properties.EsuProfile.AssignedLicense != "" {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			// the License Profile has to be created in the same location as the Arc Machine
+			machine, err := machinesClient.Get(ctx, *machineId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *machineId, err)
+			}
+			if machine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *machineId)
+			}
+
+			payload := licenseprofiles.LicenseProfile{
+				Location: location.Normalize(machine.Model.Location),
+				Properties: &licenseprofiles.LicenseProfileProperties{
+					EsuProfile: &licenseprofiles.LicenseProfileArmEsuProperties{
+						AssignedLicense: utils.String(model.LicenseId),
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := licenseprofiles.ParseLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineEsuLicenseAssignmentResourceModel{
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.EsuProfile != nil {
+				profile := model.Properties.EsuProfile
+
+				assignedLicense := utils.NormalizeNilableString(profile.AssignedLicense)
+				if strings.TrimSpace(assignedLicense) == "" {
+					return metadata.MarkAsGone(id)
+				}
+
+				licenseId, err := licenses.ParseLicenseIDInsensitively(assignedLicense)
+				if err != nil {
+					return fmt.Errorf("parsing `assignedLicense`: %+v", err)
+				}
+				state.LicenseId = licenseId.ID()
+
+				if profile.EsuEligibility != nil {
+					state.EsuEligibility = string(*profile.EsuEligibility)
+				}
+				if profile.EsuKeyState != nil {
+					state.EsuKeyState = string(*profile.EsuKeyState)
+				}
+				if profile.ServerType != nil {
+					state.ServerType = string(*profile.ServerType)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := licenseprofiles.ParseLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineEsuLicenseAssignmentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("license_id") {
+				payload.Properties = &licenseprofiles.LicenseProfileProperties{
+					EsuProfile: &licenseprofiles.LicenseProfileArmEsuProperties{
+						AssignedLicense: utils.String(model.LicenseId),
+					},
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicenseProfilesClient
+
+			id, err := licenseprofiles.ParseLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
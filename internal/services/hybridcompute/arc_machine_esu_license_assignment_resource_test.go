@@ -0,0 +1,166 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineEsuLicenseAssignmentResource struct {
+	arcMachineId string
+}
+
+// licenses can only be assigned to a Windows Server 2012 machine which has been onboarded using the Connected Machine agent
+func newArcMachineEsuLicenseAssignmentResource(t *testing.T) ArcMachineEsuLicenseAssignmentResource {
+	r := ArcMachineEsuLicenseAssignmentResource{
+		arcMachineId: os.Getenv("ARM_TEST_ARC_MACHINE_ID"),
+	}
+	if r.arcMachineId == "" {
+		t.Skip("Skipping as ARM_TEST_ARC_MACHINE_ID is not specified")
+	}
+
+	return r
+}
+
+func TestAccArcMachineEsuLicenseAssignment_basic(t *testing.T) {
+	r := newArcMachineEsuLicenseAssignmentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_esu_license_assignment", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineEsuLicenseAssignment_requiresImport(t *testing.T) {
+	r := newArcMachineEsuLicenseAssignmentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_esu_license_assignment", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineEsuLicenseAssignment_update(t *testing.T) {
+	r := newArcMachineEsuLicenseAssignmentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_esu_license_assignment", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ArcMachineEsuLicenseAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := licenseprofiles.ParseLicenseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.LicenseProfilesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.EsuProfile != nil {
+		return utils.Bool(model.Properties.EsuProfile.AssignedLicense != nil && *model.Properties.EsuProfile.AssignedLicense != ""), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_arc_machine_esu_license" "test" {
+  name                = "acctest-hcl-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  edition             = "Standard"
+  core_type           = "vCore"
+  processor_count     = 8
+  state               = "Activated"
+  target              = "Windows Server 2012"
+}
+
+resource "azurerm_arc_machine_esu_license" "other" {
+  name                = "acctest-hcl2-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  edition             = "Standard"
+  core_type           = "vCore"
+  processor_count     = 8
+  state               = "Activated"
+  target              = "Windows Server 2012"
+}
+`, ArcMachineResource{}.template(data), data.RandomInteger)
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_esu_license_assignment" "test" {
+  arc_machine_id = "%s"
+  license_id     = azurerm_arc_machine_esu_license.test.id
+}
+`, r.template(data), r.arcMachineId)
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_esu_license_assignment" "import" {
+  arc_machine_id = azurerm_arc_machine_esu_license_assignment.test.arc_machine_id
+  license_id     = azurerm_arc_machine_esu_license_assignment.test.license_id
+}
+`, r.basic(data))
+}
+
+func (r ArcMachineEsuLicenseAssignmentResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_esu_license_assignment" "test" {
+  arc_machine_id = "%s"
+  license_id     = azurerm_arc_machine_esu_license.other.id
+}
+`, r.template(data), r.arcMachineId)
+}
@@ -0,0 +1,320 @@
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineEsuLicenseResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ArcMachineEsuLicenseResource{}
+	_ sdk.ResourceWithCustomizeDiff = ArcMachineEsuLicenseResource{}
+)
+
+type ArcMachineEsuLicenseResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	Edition           string            `tfschema:"edition"`
+	CoreType          string            `tfschema:"core_type"`
+	ProcessorCount    int64             `tfschema:"processor_count"`
+	State             string            `tfschema:"state"`
+	Target            string            `tfschema:"target"`
+	Tags              map[string]string `tfschema:"tags"`
+	AssignedLicenses  int64             `tfschema:"assigned_licenses"`
+	ImmutableId       string            `tfschema:"immutable_id"`
+}
+
+func (r ArcMachineEsuLicenseResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"edition": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseEdition(), false),
+		},
+
+		"core_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseCoreType(), false),
+		},
+
+		"processor_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(8),
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseState(), false),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseTarget(), false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineEsuLicenseResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assigned_licenses": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseResource) ModelObject() interface{} {
+	return &ArcMachineEsuLicenseResourceModel{}
+}
+
+func (r ArcMachineEsuLicenseResource) ResourceType() string {
+	return "azurerm_arc_machine_esu_license"
+}
+
+func (r ArcMachineEsuLicenseResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return licenses.ValidateLicenseID
+}
+
+func (r ArcMachineEsuLicenseResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ArcMachineEsuLicenseResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// physical core licenses are sold in packs of 16 cores, virtual core licenses in packs of 8 cores
+			if model.CoreType == string(licenses.LicenseCoreTypePCore) && model.ProcessorCount < 16 {
+				return fmt.Errorf("`processor_count` must be at least 16 when `core_type` is `%s`", licenses.LicenseCoreTypePCore)
+			}
+
+			// once activated a license can't be moved to a different target or have its capacity reduced
+			if metadata.ResourceDiff.Id() != "" {
+				oldState, _ := metadata.ResourceDiff.GetChange("state")
+				if oldState.(string) == string(licenses.LicenseStateActivated) {
+					if metadata.ResourceDiff.HasChange("target") {
+						if err := metadata.ResourceDiff.ForceNew("target"); err != nil {
+							return err
+						}
+					}
+					if oldCount, newCount := metadata.ResourceDiff.GetChange("processor_count"); newCount.(int) < oldCount.(int) {
+						return fmt.Errorf("`processor_count` cannot be decreased once the license has been activated")
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ArcMachineEsuLicenseResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := licenses.NewLicenseID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			licenseType := licenses.LicenseTypeESU
+			payload := licenses.License{
+				Location: location.Normalize(model.Location),
+				Properties: &licenses.LicenseProperties{
+					LicenseDetails: expandArcMachineEsuLicenseDetails(model),
+					LicenseType:    &licenseType,
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineEsuLicenseResourceModel{
+				Name:              id.LicenseName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil && props.LicenseDetails != nil {
+					details := props.LicenseDetails
+					if details.AssignedLicenses != nil {
+						state.AssignedLicenses = *details.AssignedLicenses
+					}
+					if details.Edition != nil {
+						state.Edition = string(*details.Edition)
+					}
+					state.ImmutableId = utils.NormalizeNilableString(details.ImmutableId)
+					if details.Processors != nil {
+						state.ProcessorCount = *details.Processors
+					}
+					if details.State != nil {
+						state.State = string(*details.State)
+					}
+					if details.Target != nil {
+						state.Target = string(*details.Target)
+					}
+					if details.Type != nil {
+						state.CoreType = string(*details.Type)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineEsuLicenseResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChanges("edition", "core_type", "processor_count", "state", "target") {
+				payload.Properties.LicenseDetails = expandArcMachineEsuLicenseDetails(model)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineEsuLicenseResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.LicensesClient
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineEsuLicenseDetails(input ArcMachineEsuLicenseResourceModel) *licenses.LicenseDetails {
+	edition := licenses.LicenseEdition(input.Edition)
+	state := licenses.LicenseState(input.State)
+	target := licenses.LicenseTarget(input.Target)
+	coreType := licenses.LicenseCoreType(input.CoreType)
+
+	return &licenses.LicenseDetails{
+		Edition:    &edition,
+		Processors: utils.Int64(input.ProcessorCount),
+		State:      &state,
+		Target:     &target,
+		Type:       &coreType,
+	}
+}
@@ -0,0 +1,173 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineEsuLicenseResource struct{}
+
+func TestAccArcMachineEsuLicense_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_esu_license", "test")
+	r := ArcMachineEsuLicenseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Deactivated", 8),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineEsuLicense_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_esu_license", "test")
+	r := ArcMachineEsuLicenseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Deactivated", 8),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineEsuLicense_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_esu_license", "test")
+	r := ArcMachineEsuLicenseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "Deactivated", 8),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Activated", 16),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("immutable_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.basic(data, "Activated", 8),
+			ExpectError: regexp.MustCompile("`processor_count` cannot be decreased once the license has been activated"),
+		},
+		{
+			Config: r.basic(data, "Deactivated", 16),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineEsuLicense_physicalCoresMinimum(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_esu_license", "test")
+	r := ArcMachineEsuLicenseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.physicalCores(data, 8),
+			ExpectError: regexp.MustCompile("`processor_count` must be at least 16 when `core_type` is `pCore`"),
+		},
+		{
+			Config: r.physicalCores(data, 16),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ArcMachineEsuLicenseResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := licenses.ParseLicenseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.LicensesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ArcMachineEsuLicenseResource) basic(data acceptance.TestData, state string, processorCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_esu_license" "test" {
+  name                = "acctest-hcl-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  edition             = "Standard"
+  core_type           = "vCore"
+  processor_count     = %d
+  state               = "%s"
+  target              = "Windows Server 2012"
+}
+`, ArcMachineResource{}.template(data), data.RandomInteger, processorCount, state)
+}
+
+func (r ArcMachineEsuLicenseResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_esu_license" "import" {
+  name                = azurerm_arc_machine_esu_license.test.name
+  resource_group_name = azurerm_arc_machine_esu_license.test.resource_group_name
+  location            = azurerm_arc_machine_esu_license.test.location
+  edition             = azurerm_arc_machine_esu_license.test.edition
+  core_type           = azurerm_arc_machine_esu_license.test.core_type
+  processor_count     = azurerm_arc_machine_esu_license.test.processor_count
+  state               = azurerm_arc_machine_esu_license.test.state
+  target              = azurerm_arc_machine_esu_license.test.target
+}
+`, r.basic(data, "Deactivated", 8))
+}
+
+func (r ArcMachineEsuLicenseResource) physicalCores(data acceptance.TestData, processorCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_esu_license" "test" {
+  name                = "acctest-hcl-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  edition             = "Datacenter"
+  core_type           = "pCore"
+  processor_count     = %d
+  state               = "Deactivated"
+  target              = "Windows Server 2012 R2"
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, ArcMachineResource{}.template(data), data.RandomInteger, processorCount)
+}
@@ -0,0 +1,350 @@
+package hybridcompute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machineextensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineExtensionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineExtensionResource{}
+
+type ArcMachineExtensionResourceModel struct {
+	Name                           string            `tfschema:"name"`
+	ArcMachineId                   string            `tfschema:"arc_machine_id"`
+	Location                       string            `tfschema:"location"`
+	Publisher                      string            `tfschema:"publisher"`
+	Type                           string            `tfschema:"type"`
+	TypeHandlerVersion             string            `tfschema:"type_handler_version"`
+	AutomaticUpgradeEnabled        bool              `tfschema:"automatic_upgrade_enabled"`
+	AutoUpgradeMinorVersionEnabled bool              `tfschema:"auto_upgrade_minor_version_enabled"`
+	ForceUpdateTag                 string            `tfschema:"force_update_tag"`
+	Settings                       string            `tfschema:"settings"`
+	ProtectedSettings              string            `tfschema:"protected_settings"`
+	Tags                           map[string]string `tfschema:"tags"`
+}
+
+func (r ArcMachineExtensionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"publisher": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"type_handler_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"automatic_upgrade_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"auto_upgrade_minor_version_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"force_update_tag": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"settings": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"protected_settings": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			Sensitive:        true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineExtensionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ArcMachineExtensionResource) ModelObject() interface{} {
+	return &ArcMachineExtensionResourceModel{}
+}
+
+func (r ArcMachineExtensionResource) ResourceType() string {
+	return "azurerm_arc_machine_extension"
+}
+
+func (r ArcMachineExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machineextensions.ValidateExtensionID
+}
+
+func (r ArcMachineExtensionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			var model ArcMachineExtensionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := machineextensions.NewExtensionID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			settings, err := expandArcMachineExtensionSettings(model.Settings)
+			if err != nil {
+				return fmt.Errorf("expanding `settings`: %+v", err)
+			}
+
+			protectedSettings, err := expandArcMachineExtensionSettings(model.ProtectedSettings)
+			if err != nil {
+				return fmt.Errorf("expanding `protected_settings`: %+v", err)
+			}
+
+			payload := machineextensions.MachineExtension{
+				Location: location.Normalize(model.Location),
+				Properties: &machineextensions.MachineExtensionProperties{
+					AutoUpgradeMinorVersion: utils.Bool(model.AutoUpgradeMinorVersionEnabled),
+					EnableAutomaticUpgrade:  utils.Bool(model.AutomaticUpgradeEnabled),
+					ProtectedSettings:       protectedSettings,
+					Publisher:               utils.String(model.Publisher),
+					Settings:                settings,
+					Type:                    utils.String(model.Type),
+				},
+				Tags: &model.Tags,
+			}
+			if model.TypeHandlerVersion != "" {
+				payload.Properties.TypeHandlerVersion = utils.String(model.TypeHandlerVersion)
+			}
+			if model.ForceUpdateTag != "" {
+				payload.Properties.ForceUpdateTag = utils.String(model.ForceUpdateTag)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineExtensionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineExtensionResourceModel{
+				Name:         id.ExtensionName,
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+			}
+
+			// the protected settings are not returned by the API, so use the value from the config
+			if v, ok := metadata.ResourceData.GetOk("protected_settings"); ok {
+				state.ProtectedSettings = v.(string)
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.AutoUpgradeMinorVersionEnabled = props.AutoUpgradeMinorVersion != nil && *props.AutoUpgradeMinorVersion
+					state.AutomaticUpgradeEnabled = props.EnableAutomaticUpgrade != nil && *props.EnableAutomaticUpgrade
+					state.ForceUpdateTag = utils.NormalizeNilableString(props.ForceUpdateTag)
+					state.Publisher = utils.NormalizeNilableString(props.Publisher)
+					state.Type = utils.NormalizeNilableString(props.Type)
+					state.TypeHandlerVersion = utils.NormalizeNilableString(props.TypeHandlerVersion)
+
+					settings, err := flattenArcMachineExtensionSettings(props.Settings)
+					if err != nil {
+						return fmt.Errorf("flattening `settings`: %+v", err)
+					}
+					state.Settings = settings
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineExtensionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := machineextensions.MachineExtensionUpdate{
+				Properties: &machineextensions.MachineExtensionUpdateProperties{},
+			}
+			if metadata.ResourceData.HasChange("automatic_upgrade_enabled") {
+				payload.Properties.EnableAutomaticUpgrade = utils.Bool(model.AutomaticUpgradeEnabled)
+			}
+			if metadata.ResourceData.HasChange("auto_upgrade_minor_version_enabled") {
+				payload.Properties.AutoUpgradeMinorVersion = utils.Bool(model.AutoUpgradeMinorVersionEnabled)
+			}
+			if metadata.ResourceData.HasChange("force_update_tag") {
+				payload.Properties.ForceUpdateTag = utils.String(model.ForceUpdateTag)
+			}
+			if metadata.ResourceData.HasChange("settings") {
+				settings, err := expandArcMachineExtensionSettings(model.Settings)
+				if err != nil {
+					return fmt.Errorf("expanding `settings`: %+v", err)
+				}
+				payload.Properties.Settings = settings
+			}
+			if metadata.ResourceData.HasChange("protected_settings") {
+				protectedSettings, err := expandArcMachineExtensionSettings(model.ProtectedSettings)
+				if err != nil {
+					return fmt.Errorf("expanding `protected_settings`: %+v", err)
+				}
+				payload.Properties.ProtectedSettings = protectedSettings
+			}
+			if metadata.ResourceData.HasChange("type_handler_version") {
+				payload.Properties.TypeHandlerVersion = utils.String(model.TypeHandlerVersion)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineExtensionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineExtensionsClient
+
+			id, err := machineextensions.ParseExtensionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineExtensionSettings(input string) (*interface{}, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	var output interface{}
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+	return &output, nil
+}
+
+func flattenArcMachineExtensionSettings(input *interface{}) (string, error) {
+	if input == nil || *input == nil {
+		return "", nil
+	}
+
+	output, err := json.Marshal(*input)
+	if err != nil {
+		return "", err
+	}
+	return string(output), nil
+}
@@ -0,0 +1,200 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machineextensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineExtensionResource struct {
+	arcMachineId string
+}
+
+// extensions can only be installed on a machine which has been onboarded using the Connected Machine agent,
+// which must be located in the primary test location
+func newArcMachineExtensionResource(t *testing.T) ArcMachineExtensionResource {
+	r := ArcMachineExtensionResource{
+		arcMachineId: os.Getenv("ARM_TEST_ARC_MACHINE_ID"),
+	}
+	if r.arcMachineId == "" {
+		t.Skip("Skipping as ARM_TEST_ARC_MACHINE_ID is not specified")
+	}
+
+	return r
+}
+
+func TestAccArcMachineExtension_basic(t *testing.T) {
+	r := newArcMachineExtensionResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineExtension_requiresImport(t *testing.T) {
+	r := newArcMachineExtensionResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineExtension_complete(t *testing.T) {
+	r := newArcMachineExtensionResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, "one"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_settings"),
+	})
+}
+
+func TestAccArcMachineExtension_update(t *testing.T) {
+	r := newArcMachineExtensionResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_extension", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "one"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_settings"),
+		{
+			Config: r.complete(data, "two"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_settings"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ArcMachineExtensionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := machineextensions.ParseExtensionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.MachineExtensionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ArcMachineExtensionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_arc_machine_extension" "test" {
+  name           = "acctest-hce-%d"
+  arc_machine_id = "%s"
+  location       = "%s"
+  publisher      = "Microsoft.Azure.Monitor"
+  type           = "AzureMonitorWindowsAgent"
+}
+`, data.RandomInteger, r.arcMachineId, data.Locations.Primary)
+}
+
+func (r ArcMachineExtensionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_extension" "import" {
+  name           = azurerm_arc_machine_extension.test.name
+  arc_machine_id = azurerm_arc_machine_extension.test.arc_machine_id
+  location       = azurerm_arc_machine_extension.test.location
+  publisher      = azurerm_arc_machine_extension.test.publisher
+  type           = azurerm_arc_machine_extension.test.type
+}
+`, r.basic(data))
+}
+
+func (r ArcMachineExtensionResource) complete(data acceptance.TestData, tag string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_arc_machine_extension" "test" {
+  name                               = "acctest-hce-%[1]d"
+  arc_machine_id                     = "%[2]s"
+  location                           = "%[3]s"
+  publisher                          = "Microsoft.Azure.Monitor"
+  type                               = "AzureMonitorWindowsAgent"
+  type_handler_version               = "1.0"
+  automatic_upgrade_enabled          = false
+  auto_upgrade_minor_version_enabled = false
+  force_update_tag                   = "%[4]s"
+
+  settings = jsonencode({
+    "authentication" = {
+      "managedIdentity" = {
+        "identifier-name"  = "mi_res_id"
+        "identifier-value" = "%[2]s"
+      }
+    }
+  })
+
+  protected_settings = jsonencode({
+    "proxy" = {
+      "mode" = "none"
+    }
+  })
+
+  tags = {
+    environment = "%[4]s"
+  }
+}
+`, data.RandomInteger, r.arcMachineId, data.Locations.Primary, tag)
+}
@@ -0,0 +1,561 @@
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machineruncommands"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineRunCommandResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineRunCommandResource{}
+
+type ArcMachineRunCommandResourceModel struct {
+	Name                      string                           `tfschema:"name"`
+	ArcMachineId              string                           `tfschema:"arc_machine_id"`
+	Location                  string                           `tfschema:"location"`
+	Source                    []RunCommandSourceModel          `tfschema:"source"`
+	Parameter                 []RunCommandParameterModel       `tfschema:"parameter"`
+	ProtectedParameter        []RunCommandParameterModel       `tfschema:"protected_parameter"`
+	RunAsUser                 string                           `tfschema:"run_as_user"`
+	RunAsPassword             string                           `tfschema:"run_as_password"`
+	OutputBlobUri             string                           `tfschema:"output_blob_uri"`
+	OutputBlobManagedIdentity []RunCommandManagedIdentityModel `tfschema:"output_blob_managed_identity"`
+	ErrorBlobUri              string                           `tfschema:"error_blob_uri"`
+	ErrorBlobManagedIdentity  []RunCommandManagedIdentityModel `tfschema:"error_blob_managed_identity"`
+	TimeoutInSeconds          int64                            `tfschema:"timeout_in_seconds"`
+	AsyncExecutionEnabled     bool                             `tfschema:"async_execution_enabled"`
+	Tags                      map[string]string                `tfschema:"tags"`
+	InstanceView              []RunCommandInstanceViewModel    `tfschema:"instance_view"`
+}
+
+type RunCommandSourceModel struct {
+	Script                   string                           `tfschema:"script"`
+	ScriptUri                string                           `tfschema:"script_uri"`
+	CommandId                string                           `tfschema:"command_id"`
+	ScriptUriManagedIdentity []RunCommandManagedIdentityModel `tfschema:"script_uri_managed_identity"`
+}
+
+type RunCommandParameterModel struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type RunCommandManagedIdentityModel struct {
+	ClientId string `tfschema:"client_id"`
+	ObjectId string `tfschema:"object_id"`
+}
+
+type RunCommandInstanceViewModel struct {
+	ExecutionState   string `tfschema:"execution_state"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	ExitCode         int64  `tfschema:"exit_code"`
+	Output           string `tfschema:"output"`
+	Error            string `tfschema:"error"`
+	StartTime        string `tfschema:"start_time"`
+	EndTime          string `tfschema:"end_time"`
+}
+
+func runCommandManagedIdentitySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"client_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+
+				"object_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+func runCommandParameterSchema(sensitive bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:      pluginsdk.TypeList,
+		Optional:  true,
+		Sensitive: sensitive,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"value": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					Sensitive:    sensitive,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"script_uri_managed_identity": runCommandManagedIdentitySchema(),
+				},
+			},
+		},
+
+		"parameter": runCommandParameterSchema(false),
+
+		"protected_parameter": runCommandParameterSchema(true),
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"run_as_user"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"output_blob_managed_identity": runCommandManagedIdentitySchema(),
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"error_blob_managed_identity": runCommandManagedIdentitySchema(),
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(0, 86400),
+		},
+
+		"async_execution_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) ModelObject() interface{} {
+	return &ArcMachineRunCommandResourceModel{}
+}
+
+func (r ArcMachineRunCommandResource) ResourceType() string {
+	return "azurerm_arc_machine_run_command"
+}
+
+func (r ArcMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return machineruncommands.ValidateRunCommandID
+}
+
+func (r ArcMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+
+			var model ArcMachineRunCommandResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := machineruncommands.NewRunCommandID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := machineruncommands.MachineRunCommand{
+				Location:   location.Normalize(model.Location),
+				Properties: expandArcMachineRunCommandProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+
+			id, err := machineruncommands.ParseRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the protected parameters, password and blob URIs (which contain a SAS token) aren't returned by the API
+			var config ArcMachineRunCommandResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := ArcMachineRunCommandResourceModel{
+				Name:               id.RunCommandName,
+				ArcMachineId:       machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName).ID(),
+				ProtectedParameter: config.ProtectedParameter,
+				RunAsPassword:      config.RunAsPassword,
+				OutputBlobUri:      config.OutputBlobUri,
+				ErrorBlobUri:       config.ErrorBlobUri,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				if props := model.Properties; props != nil {
+					state.AsyncExecutionEnabled = props.AsyncExecution != nil && *props.AsyncExecution
+					state.ErrorBlobManagedIdentity = flattenRunCommandManagedIdentity(props.ErrorBlobManagedIdentity)
+					state.OutputBlobManagedIdentity = flattenRunCommandManagedIdentity(props.OutputBlobManagedIdentity)
+					state.Parameter = flattenRunCommandParameters(props.Parameters)
+					state.RunAsUser = utils.NormalizeNilableString(props.RunAsUser)
+					state.Source = flattenRunCommandSource(props.Source)
+					state.InstanceView = flattenRunCommandInstanceView(props.InstanceView)
+					if props.TimeoutInSeconds != nil {
+						state.TimeoutInSeconds = *props.TimeoutInSeconds
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+
+			id, err := machineruncommands.ParseRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineRunCommandResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the run command is re-run whenever it's updated, so the whole definition is sent
+			payload := machineruncommands.MachineRunCommand{
+				Location:   location.Normalize(model.Location),
+				Properties: expandArcMachineRunCommandProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.MachineRunCommandsClient
+
+			id, err := machineruncommands.ParseRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandArcMachineRunCommandProperties(input ArcMachineRunCommandResourceModel) *machineruncommands.MachineRunCommandProperties {
+	output := machineruncommands.MachineRunCommandProperties{
+		AsyncExecution:            utils.Bool(input.AsyncExecutionEnabled),
+		ErrorBlobManagedIdentity:  expandRunCommandManagedIdentity(input.ErrorBlobManagedIdentity),
+		OutputBlobManagedIdentity: expandRunCommandManagedIdentity(input.OutputBlobManagedIdentity),
+		Parameters:                expandRunCommandParameters(input.Parameter),
+		ProtectedParameters:       expandRunCommandParameters(input.ProtectedParameter),
+		Source:                    expandRunCommandSource(input.Source),
+		TimeoutInSeconds:          utils.Int64(input.TimeoutInSeconds),
+	}
+	if input.ErrorBlobUri != "" {
+		output.ErrorBlobUri = utils.String(input.ErrorBlobUri)
+	}
+	if input.OutputBlobUri != "" {
+		output.OutputBlobUri = utils.String(input.OutputBlobUri)
+	}
+	if input.RunAsUser != "" {
+		output.RunAsUser = utils.String(input.RunAsUser)
+	}
+	if input.RunAsPassword != "" {
+		output.RunAsPassword = utils.String(input.RunAsPassword)
+	}
+	return &output
+}
+
+func expandRunCommandSource(input []RunCommandSourceModel) *machineruncommands.MachineRunCommandScriptSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := machineruncommands.MachineRunCommandScriptSource{
+		ScriptUriManagedIdentity: expandRunCommandManagedIdentity(v.ScriptUriManagedIdentity),
+	}
+	if v.Script != "" {
+		output.Script = utils.String(v.Script)
+	}
+	if v.ScriptUri != "" {
+		output.ScriptUri = utils.String(v.ScriptUri)
+	}
+	if v.CommandId != "" {
+		output.CommandId = utils.String(v.CommandId)
+	}
+	return &output
+}
+
+func flattenRunCommandSource(input *machineruncommands.MachineRunCommandScriptSource) []RunCommandSourceModel {
+	if input == nil {
+		return []RunCommandSourceModel{}
+	}
+
+	return []RunCommandSourceModel{
+		{
+			CommandId:                utils.NormalizeNilableString(input.CommandId),
+			Script:                   utils.NormalizeNilableString(input.Script),
+			ScriptUri:                utils.NormalizeNilableString(input.ScriptUri),
+			ScriptUriManagedIdentity: flattenRunCommandManagedIdentity(input.ScriptUriManagedIdentity),
+		},
+	}
+}
+
+func expandRunCommandParameters(input []RunCommandParameterModel) *[]machineruncommands.RunCommandInputParameter {
+	output := make([]machineruncommands.RunCommandInputParameter, 0)
+	for _, v := range input {
+		output = append(output, machineruncommands.RunCommandInputParameter{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+	return &output
+}
+
+func flattenRunCommandParameters(input *[]machineruncommands.RunCommandInputParameter) []RunCommandParameterModel {
+	output := make([]RunCommandParameterModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, RunCommandParameterModel{
+			Name:  v.Name,
+			Value: v.Value,
+		})
+	}
+	return output
+}
+
+func expandRunCommandManagedIdentity(input []RunCommandManagedIdentityModel) *machineruncommands.RunCommandManagedIdentity {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := machineruncommands.RunCommandManagedIdentity{}
+	if input[0].ClientId != "" {
+		output.ClientId = utils.String(input[0].ClientId)
+	}
+	if input[0].ObjectId != "" {
+		output.ObjectId = utils.String(input[0].ObjectId)
+	}
+	return &output
+}
+
+func flattenRunCommandManagedIdentity(input *machineruncommands.RunCommandManagedIdentity) []RunCommandManagedIdentityModel {
+	if input == nil {
+		return []RunCommandManagedIdentityModel{}
+	}
+
+	return []RunCommandManagedIdentityModel{
+		{
+			ClientId: utils.NormalizeNilableString(input.ClientId),
+			ObjectId: utils.NormalizeNilableString(input.ObjectId),
+		},
+	}
+}
+
+func flattenRunCommandInstanceView(input *machineruncommands.MachineRunCommandInstanceView) []RunCommandInstanceViewModel {
+	if input == nil {
+		return []RunCommandInstanceViewModel{}
+	}
+
+	output := RunCommandInstanceViewModel{
+		EndTime:          utils.NormalizeNilableString(input.EndTime),
+		Error:            utils.NormalizeNilableString(input.Error),
+		ExecutionMessage: utils.NormalizeNilableString(input.ExecutionMessage),
+		Output:           utils.NormalizeNilableString(input.Output),
+		StartTime:        utils.NormalizeNilableString(input.StartTime),
+	}
+	if input.ExecutionState != nil {
+		output.ExecutionState = string(*input.ExecutionState)
+	}
+	if input.ExitCode != nil {
+		output.ExitCode = *input.ExitCode
+	}
+	return []RunCommandInstanceViewModel{output}
+}
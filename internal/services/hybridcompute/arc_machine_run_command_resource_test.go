@@ -0,0 +1,238 @@
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machineruncommands"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ArcMachineRunCommandResource struct {
+	arcMachineId string
+}
+
+// run commands can only be executed on a machine which has been onboarded using the Connected Machine agent,
+// which must be located in the primary test location
+func newArcMachineRunCommandResource(t *testing.T) ArcMachineRunCommandResource {
+	r := ArcMachineRunCommandResource{
+		arcMachineId: os.Getenv("ARM_TEST_ARC_MACHINE_ID"),
+	}
+	if r.arcMachineId == "" {
+		t.Skip("Skipping as ARM_TEST_ARC_MACHINE_ID is not specified")
+	}
+
+	return r
+}
+
+func TestAccArcMachineRunCommand_basic(t *testing.T) {
+	r := newArcMachineRunCommandResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_run_command", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("instance_view.0.execution_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineRunCommand_requiresImport(t *testing.T) {
+	r := newArcMachineRunCommandResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_run_command", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineRunCommand_complete(t *testing.T) {
+	r := newArcMachineRunCommandResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_run_command", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_parameter", "run_as_password", "output_blob_uri", "error_blob_uri"),
+	})
+}
+
+func TestAccArcMachineRunCommand_update(t *testing.T) {
+	r := newArcMachineRunCommandResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_run_command", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("protected_parameter", "run_as_password", "output_blob_uri", "error_blob_uri"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ArcMachineRunCommandResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := machineruncommands.ParseRunCommandID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.MachineRunCommandsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ArcMachineRunCommandResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_arc_machine_run_command" "test" {
+  name           = "acctest-hcrc-%d"
+  arc_machine_id = "%s"
+  location       = "%s"
+
+  source {
+    script = "Write-Host Hello World"
+  }
+}
+`, data.RandomInteger, r.arcMachineId, data.Locations.Primary)
+}
+
+func (r ArcMachineRunCommandResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_run_command" "import" {
+  name           = azurerm_arc_machine_run_command.test.name
+  arc_machine_id = azurerm_arc_machine_run_command.test.arc_machine_id
+  location       = azurerm_arc_machine_run_command.test.location
+
+  source {
+    script = "Write-Host Hello World"
+  }
+}
+`, r.basic(data))
+}
+
+func (r ArcMachineRunCommandResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-hybridcompute-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[4]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "output"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_arc_machine_run_command" "test" {
+  name                    = "acctest-hcrc-%[1]d"
+  arc_machine_id          = "%[2]s"
+  location                = "%[3]s"
+  run_as_user             = "adminuser"
+  run_as_password         = "P@ssw0rd1234!"
+  timeout_in_seconds      = 3600
+  async_execution_enabled = false
+  output_blob_uri         = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/output.txt"
+  error_blob_uri          = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/error.txt"
+
+  source {
+    script = "param($greeting, $secret) Write-Host $greeting"
+  }
+
+  parameter {
+    name  = "greeting"
+    value = "Hello World"
+  }
+
+  protected_parameter {
+    name  = "secret"
+    value = "s3cr3t"
+  }
+
+  output_blob_managed_identity {
+    client_id = azurerm_user_assigned_identity.test.client_id
+  }
+
+  error_blob_managed_identity {
+    client_id = azurerm_user_assigned_identity.test.client_id
+  }
+
+  tags = {
+    environment = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, r.arcMachineId, data.Locations.Primary, data.RandomString)
+}
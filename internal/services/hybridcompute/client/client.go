@@ -0,0 +1,43 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machineextensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machineruncommands"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machines"
+)
+
+type Client struct {
+	LicenseProfilesClient    *licenseprofiles.LicenseProfilesClient
+	LicensesClient           *licenses.LicensesClient
+	MachineExtensionsClient  *machineextensions.MachineExtensionsClient
+	MachineRunCommandsClient *machineruncommands.MachineRunCommandsClient
+	MachinesClient           *machines.MachinesClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	licenseProfilesClient := licenseprofiles.NewLicenseProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&licenseProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	licensesClient := licenses.NewLicensesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&licensesClient.Client, o.ResourceManagerAuthorizer)
+
+	machineExtensionsClient := machineextensions.NewMachineExtensionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&machineExtensionsClient.Client, o.ResourceManagerAuthorizer)
+
+	machineRunCommandsClient := machineruncommands.NewMachineRunCommandsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&machineRunCommandsClient.Client, o.ResourceManagerAuthorizer)
+
+	machinesClient := machines.NewMachinesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&machinesClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		LicenseProfilesClient:    &licenseProfilesClient,
+		LicensesClient:           &licensesClient,
+		MachineExtensionsClient:  &machineExtensionsClient,
+		MachineRunCommandsClient: &machineRunCommandsClient,
+		MachinesClient:           &machinesClient,
+	}
+}
@@ -0,0 +1,35 @@
+package hybridcompute
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type Registration struct{}
+
+var _ sdk.TypedServiceRegistration = Registration{}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ArcMachineEsuLicenseAssignmentResource{},
+		ArcMachineEsuLicenseResource{},
+		ArcMachineExtensionResource{},
+		ArcMachineResource{},
+		ArcMachineRunCommandResource{},
+	}
+}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "Hybrid Compute"
+}
+
+// WebsiteCategories returns a list of categories which can be used for the sidebar
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Hybrid Compute",
+	}
+}
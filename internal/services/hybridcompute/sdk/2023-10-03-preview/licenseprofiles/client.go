@@ -0,0 +1,15 @@
+package licenseprofiles
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LicenseProfilesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLicenseProfilesClientWithBaseURI(endpoint string) LicenseProfilesClient {
+	return LicenseProfilesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
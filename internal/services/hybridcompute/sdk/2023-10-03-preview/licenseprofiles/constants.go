@@ -0,0 +1,136 @@
+package licenseprofiles
+
+import "strings"
+
+type EsuEligibility string
+
+const (
+	EsuEligibilityEligible   EsuEligibility = "Eligible"
+	EsuEligibilityIneligible EsuEligibility = "Ineligible"
+	EsuEligibilityUnknown    EsuEligibility = "Unknown"
+)
+
+func PossibleValuesForEsuEligibility() []string {
+	return []string{
+		string(EsuEligibilityEligible),
+		string(EsuEligibilityIneligible),
+		string(EsuEligibilityUnknown),
+	}
+}
+
+func parseEsuEligibility(input string) (*EsuEligibility, error) {
+	vals := map[string]EsuEligibility{
+		"eligible":   EsuEligibilityEligible,
+		"ineligible": EsuEligibilityIneligible,
+		"unknown":    EsuEligibilityUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EsuEligibility(input)
+	return &out, nil
+}
+
+type EsuKeyState string
+
+const (
+	EsuKeyStateActive   EsuKeyState = "Active"
+	EsuKeyStateInactive EsuKeyState = "Inactive"
+)
+
+func PossibleValuesForEsuKeyState() []string {
+	return []string{
+		string(EsuKeyStateActive),
+		string(EsuKeyStateInactive),
+	}
+}
+
+func parseEsuKeyState(input string) (*EsuKeyState, error) {
+	vals := map[string]EsuKeyState{
+		"active":   EsuKeyStateActive,
+		"inactive": EsuKeyStateInactive,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EsuKeyState(input)
+	return &out, nil
+}
+
+type EsuServerType string
+
+const (
+	EsuServerTypeDatacenter EsuServerType = "Datacenter"
+	EsuServerTypeStandard   EsuServerType = "Standard"
+)
+
+func PossibleValuesForEsuServerType() []string {
+	return []string{
+		string(EsuServerTypeDatacenter),
+		string(EsuServerTypeStandard),
+	}
+}
+
+func parseEsuServerType(input string) (*EsuServerType, error) {
+	vals := map[string]EsuServerType{
+		"datacenter": EsuServerTypeDatacenter,
+		"standard":   EsuServerTypeStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EsuServerType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleted   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":  ProvisioningStateAccepted,
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleted":   ProvisioningStateDeleted,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
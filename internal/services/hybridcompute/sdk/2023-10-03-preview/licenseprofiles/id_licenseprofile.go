@@ -0,0 +1,137 @@
+package licenseprofiles
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = LicenseProfileId{}
+
+// LicenseProfileId is a struct representing the Resource ID for a License Profile
+type LicenseProfileId struct {
+	SubscriptionId     string
+	ResourceGroupName  string
+	MachineName        string
+	LicenseProfileName string
+}
+
+// NewLicenseProfileID returns a new LicenseProfileId struct
+func NewLicenseProfileID(subscriptionId string, resourceGroupName string, machineName string, licenseProfileName string) LicenseProfileId {
+	return LicenseProfileId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroupName:  resourceGroupName,
+		MachineName:        machineName,
+		LicenseProfileName: licenseProfileName,
+	}
+}
+
+// ParseLicenseProfileID parses 'input' into a LicenseProfileId
+func ParseLicenseProfileID(input string) (*LicenseProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LicenseProfileId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LicenseProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.MachineName, ok = parsed.Parsed["machineName"]; !ok {
+		return nil, fmt.Errorf("the segment 'machineName' was not found in the resource id %q", input)
+	}
+
+	if id.LicenseProfileName, ok = parsed.Parsed["licenseProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'licenseProfileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseLicenseProfileIDInsensitively parses 'input' case-insensitively into a LicenseProfileId
+// note: this method should only be used for API response data and not user input
+func ParseLicenseProfileIDInsensitively(input string) (*LicenseProfileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LicenseProfileId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LicenseProfileId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.MachineName, ok = parsed.Parsed["machineName"]; !ok {
+		return nil, fmt.Errorf("the segment 'machineName' was not found in the resource id %q", input)
+	}
+
+	if id.LicenseProfileName, ok = parsed.Parsed["licenseProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'licenseProfileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateLicenseProfileID checks that 'input' can be parsed as a License Profile ID
+func ValidateLicenseProfileID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseLicenseProfileID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted License Profile ID
+func (id LicenseProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s/licenseProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.MachineName, id.LicenseProfileName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this License Profile ID
+func (id LicenseProfileId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftHybridCompute", "Microsoft.HybridCompute", "Microsoft.HybridCompute"),
+		resourceids.StaticSegment("staticMachines", "machines", "machines"),
+		resourceids.UserSpecifiedSegment("machineName", "machineValue"),
+		resourceids.StaticSegment("staticLicenseProfiles", "licenseProfiles", "licenseProfiles"),
+		resourceids.UserSpecifiedSegment("licenseProfileName", "licenseProfileValue"),
+	}
+}
+
+// String returns a human-readable description of this License Profile ID
+func (id LicenseProfileId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Machine Name: %q", id.MachineName),
+		fmt.Sprintf("License Profile Name: %q", id.LicenseProfileName),
+	}
+	return fmt.Sprintf("License Profile (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,9 @@
+package licenseprofiles
+
+type LicenseProfileArmEsuProperties struct {
+	AssignedLicense            *string         `json:"assignedLicense,omitempty"`
+	AssignedLicenseImmutableId *string         `json:"assignedLicenseImmutableId,omitempty"`
+	EsuEligibility             *EsuEligibility `json:"esuEligibility,omitempty"`
+	EsuKeyState                *EsuKeyState    `json:"esuKeyState,omitempty"`
+	ServerType                 *EsuServerType  `json:"serverType,omitempty"`
+}
@@ -0,0 +1,6 @@
+package licenseprofiles
+
+type LicenseProfileProperties struct {
+	EsuProfile        *LicenseProfileArmEsuProperties `json:"esuProfile,omitempty"`
+	ProvisioningState *ProvisioningState              `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,15 @@
+package licenses
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LicensesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLicensesClientWithBaseURI(endpoint string) LicensesClient {
+	return LicensesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
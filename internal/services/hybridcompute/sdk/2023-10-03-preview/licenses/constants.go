@@ -0,0 +1,186 @@
+package licenses
+
+import "strings"
+
+type LicenseCoreType string
+
+const (
+	LicenseCoreTypePCore LicenseCoreType = "pCore"
+	LicenseCoreTypeVCore LicenseCoreType = "vCore"
+)
+
+func PossibleValuesForLicenseCoreType() []string {
+	return []string{
+		string(LicenseCoreTypePCore),
+		string(LicenseCoreTypeVCore),
+	}
+}
+
+func parseLicenseCoreType(input string) (*LicenseCoreType, error) {
+	vals := map[string]LicenseCoreType{
+		"pcore": LicenseCoreTypePCore,
+		"vcore": LicenseCoreTypeVCore,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseCoreType(input)
+	return &out, nil
+}
+
+type LicenseEdition string
+
+const (
+	LicenseEditionDatacenter LicenseEdition = "Datacenter"
+	LicenseEditionStandard   LicenseEdition = "Standard"
+)
+
+func PossibleValuesForLicenseEdition() []string {
+	return []string{
+		string(LicenseEditionDatacenter),
+		string(LicenseEditionStandard),
+	}
+}
+
+func parseLicenseEdition(input string) (*LicenseEdition, error) {
+	vals := map[string]LicenseEdition{
+		"datacenter": LicenseEditionDatacenter,
+		"standard":   LicenseEditionStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseEdition(input)
+	return &out, nil
+}
+
+type LicenseState string
+
+const (
+	LicenseStateActivated   LicenseState = "Activated"
+	LicenseStateDeactivated LicenseState = "Deactivated"
+)
+
+func PossibleValuesForLicenseState() []string {
+	return []string{
+		string(LicenseStateActivated),
+		string(LicenseStateDeactivated),
+	}
+}
+
+func parseLicenseState(input string) (*LicenseState, error) {
+	vals := map[string]LicenseState{
+		"activated":   LicenseStateActivated,
+		"deactivated": LicenseStateDeactivated,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseState(input)
+	return &out, nil
+}
+
+type LicenseTarget string
+
+const (
+	LicenseTargetWindowsServer2012   LicenseTarget = "Windows Server 2012"
+	LicenseTargetWindowsServer2012R2 LicenseTarget = "Windows Server 2012 R2"
+)
+
+func PossibleValuesForLicenseTarget() []string {
+	return []string{
+		string(LicenseTargetWindowsServer2012),
+		string(LicenseTargetWindowsServer2012R2),
+	}
+}
+
+func parseLicenseTarget(input string) (*LicenseTarget, error) {
+	vals := map[string]LicenseTarget{
+		"windows server 2012":    LicenseTargetWindowsServer2012,
+		"windows server 2012 r2": LicenseTargetWindowsServer2012R2,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseTarget(input)
+	return &out, nil
+}
+
+type LicenseType string
+
+const (
+	LicenseTypeESU LicenseType = "ESU"
+)
+
+func PossibleValuesForLicenseType() []string {
+	return []string{
+		string(LicenseTypeESU),
+	}
+}
+
+func parseLicenseType(input string) (*LicenseType, error) {
+	vals := map[string]LicenseType{
+		"esu": LicenseTypeESU,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := LicenseType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleted   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":  ProvisioningStateAccepted,
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleted":   ProvisioningStateDeleted,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,11 @@
+package licenses
+
+type LicenseDetails struct {
+	AssignedLicenses *int64           `json:"assignedLicenses,omitempty"`
+	Edition          *LicenseEdition  `json:"edition,omitempty"`
+	ImmutableId      *string          `json:"immutableId,omitempty"`
+	Processors       *int64           `json:"processors,omitempty"`
+	State            *LicenseState    `json:"state,omitempty"`
+	Target           *LicenseTarget   `json:"target,omitempty"`
+	Type             *LicenseCoreType `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package licenses
+
+type LicenseProperties struct {
+	LicenseDetails    *LicenseDetails    `json:"licenseDetails,omitempty"`
+	LicenseType       *LicenseType       `json:"licenseType,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	TenantId          *string            `json:"tenantId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package licenses
+
+import "fmt"
+
+const defaultApiVersion = "2023-10-03-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/licenses/%s", defaultApiVersion)
+}
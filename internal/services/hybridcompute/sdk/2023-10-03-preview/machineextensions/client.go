@@ -0,0 +1,15 @@
+package machineextensions
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MachineExtensionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMachineExtensionsClientWithBaseURI(endpoint string) MachineExtensionsClient {
+	return MachineExtensionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
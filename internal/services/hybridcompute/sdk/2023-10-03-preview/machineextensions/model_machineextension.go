@@ -0,0 +1,10 @@
+package machineextensions
+
+type MachineExtension struct {
+	Id         *string                     `json:"id,omitempty"`
+	Location   string                      `json:"location"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *MachineExtensionProperties `json:"properties,omitempty"`
+	Tags       *map[string]string          `json:"tags,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package machineextensions
+
+type MachineExtensionInstanceView struct {
+	Name               *string `json:"name,omitempty"`
+	Type               *string `json:"type,omitempty"`
+	TypeHandlerVersion *string `json:"typeHandlerVersion,omitempty"`
+}
@@ -0,0 +1,6 @@
+package machineextensions
+
+type MachineExtensionUpdate struct {
+	Properties *MachineExtensionUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                `json:"tags,omitempty"`
+}
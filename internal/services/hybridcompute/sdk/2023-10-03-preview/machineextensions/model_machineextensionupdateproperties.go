@@ -0,0 +1,12 @@
+package machineextensions
+
+type MachineExtensionUpdateProperties struct {
+	AutoUpgradeMinorVersion *bool        `json:"autoUpgradeMinorVersion,omitempty"`
+	EnableAutomaticUpgrade  *bool        `json:"enableAutomaticUpgrade,omitempty"`
+	ForceUpdateTag          *string      `json:"forceUpdateTag,omitempty"`
+	ProtectedSettings       *interface{} `json:"protectedSettings,omitempty"`
+	Publisher               *string      `json:"publisher,omitempty"`
+	Settings                *interface{} `json:"settings,omitempty"`
+	Type                    *string      `json:"type,omitempty"`
+	TypeHandlerVersion      *string      `json:"typeHandlerVersion,omitempty"`
+}
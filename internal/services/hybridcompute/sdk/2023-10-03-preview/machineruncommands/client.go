@@ -0,0 +1,15 @@
+package machineruncommands
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MachineRunCommandsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMachineRunCommandsClientWithBaseURI(endpoint string) MachineRunCommandsClient {
+	return MachineRunCommandsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,46 @@
+package machineruncommands
+
+import "strings"
+
+type ExecutionState string
+
+const (
+	ExecutionStateCanceled  ExecutionState = "Canceled"
+	ExecutionStateFailed    ExecutionState = "Failed"
+	ExecutionStatePending   ExecutionState = "Pending"
+	ExecutionStateRunning   ExecutionState = "Running"
+	ExecutionStateSucceeded ExecutionState = "Succeeded"
+	ExecutionStateTimedOut  ExecutionState = "TimedOut"
+	ExecutionStateUnknown   ExecutionState = "Unknown"
+)
+
+func PossibleValuesForExecutionState() []string {
+	return []string{
+		string(ExecutionStateCanceled),
+		string(ExecutionStateFailed),
+		string(ExecutionStatePending),
+		string(ExecutionStateRunning),
+		string(ExecutionStateSucceeded),
+		string(ExecutionStateTimedOut),
+		string(ExecutionStateUnknown),
+	}
+}
+
+func parseExecutionState(input string) (*ExecutionState, error) {
+	vals := map[string]ExecutionState{
+		"canceled":  ExecutionStateCanceled,
+		"failed":    ExecutionStateFailed,
+		"pending":   ExecutionStatePending,
+		"running":   ExecutionStateRunning,
+		"succeeded": ExecutionStateSucceeded,
+		"timedout":  ExecutionStateTimedOut,
+		"unknown":   ExecutionStateUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExecutionState(input)
+	return &out, nil
+}
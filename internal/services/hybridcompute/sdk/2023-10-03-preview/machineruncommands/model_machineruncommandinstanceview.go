@@ -0,0 +1,11 @@
+package machineruncommands
+
+type MachineRunCommandInstanceView struct {
+	EndTime          *string         `json:"endTime,omitempty"`
+	Error            *string         `json:"error,omitempty"`
+	ExecutionMessage *string         `json:"executionMessage,omitempty"`
+	ExecutionState   *ExecutionState `json:"executionState,omitempty"`
+	ExitCode         *int64          `json:"exitCode,omitempty"`
+	Output           *string         `json:"output,omitempty"`
+	StartTime        *string         `json:"startTime,omitempty"`
+}
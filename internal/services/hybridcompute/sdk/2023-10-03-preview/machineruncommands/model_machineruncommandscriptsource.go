@@ -0,0 +1,8 @@
+package machineruncommands
+
+type MachineRunCommandScriptSource struct {
+	CommandId                *string                    `json:"commandId,omitempty"`
+	Script                   *string                    `json:"script,omitempty"`
+	ScriptUri                *string                    `json:"scriptUri,omitempty"`
+	ScriptUriManagedIdentity *RunCommandManagedIdentity `json:"scriptUriManagedIdentity,omitempty"`
+}
@@ -0,0 +1,6 @@
+package machineruncommands
+
+type RunCommandInputParameter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
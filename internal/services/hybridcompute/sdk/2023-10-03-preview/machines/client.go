@@ -0,0 +1,15 @@
+package machines
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MachinesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMachinesClientWithBaseURI(endpoint string) MachinesClient {
+	return MachinesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,77 @@
+package machines
+
+import "strings"
+
+type ArcKindEnum string
+
+const (
+	ArcKindEnumAVS    ArcKindEnum = "AVS"
+	ArcKindEnumAWS    ArcKindEnum = "AWS"
+	ArcKindEnumEPS    ArcKindEnum = "EPS"
+	ArcKindEnumGCP    ArcKindEnum = "GCP"
+	ArcKindEnumHCI    ArcKindEnum = "HCI"
+	ArcKindEnumSCVMM  ArcKindEnum = "SCVMM"
+	ArcKindEnumVMware ArcKindEnum = "VMware"
+)
+
+func PossibleValuesForArcKindEnum() []string {
+	return []string{
+		string(ArcKindEnumAVS),
+		string(ArcKindEnumAWS),
+		string(ArcKindEnumEPS),
+		string(ArcKindEnumGCP),
+		string(ArcKindEnumHCI),
+		string(ArcKindEnumSCVMM),
+		string(ArcKindEnumVMware),
+	}
+}
+
+func parseArcKindEnum(input string) (*ArcKindEnum, error) {
+	vals := map[string]ArcKindEnum{
+		"avs":    ArcKindEnumAVS,
+		"aws":    ArcKindEnumAWS,
+		"eps":    ArcKindEnumEPS,
+		"gcp":    ArcKindEnumGCP,
+		"hci":    ArcKindEnumHCI,
+		"scvmm":  ArcKindEnumSCVMM,
+		"vmware": ArcKindEnumVMware,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ArcKindEnum(input)
+	return &out, nil
+}
+
+type StatusTypes string
+
+const (
+	StatusTypesConnected    StatusTypes = "Connected"
+	StatusTypesDisconnected StatusTypes = "Disconnected"
+	StatusTypesError        StatusTypes = "Error"
+)
+
+func PossibleValuesForStatusTypes() []string {
+	return []string{
+		string(StatusTypesConnected),
+		string(StatusTypesDisconnected),
+		string(StatusTypesError),
+	}
+}
+
+func parseStatusTypes(input string) (*StatusTypes, error) {
+	vals := map[string]StatusTypes{
+		"connected":    StatusTypesConnected,
+		"disconnected": StatusTypesDisconnected,
+		"error":        StatusTypesError,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StatusTypes(input)
+	return &out, nil
+}
@@ -0,0 +1,13 @@
+package machines
+
+type MachineProperties struct {
+	AgentVersion      *string      `json:"agentVersion,omitempty"`
+	ClientPublicKey   *string      `json:"clientPublicKey,omitempty"`
+	MachineFqdn       *string      `json:"machineFqdn,omitempty"`
+	OsName            *string      `json:"osName,omitempty"`
+	OsType            *string      `json:"osType,omitempty"`
+	OsVersion         *string      `json:"osVersion,omitempty"`
+	ProvisioningState *string      `json:"provisioningState,omitempty"`
+	Status            *StatusTypes `json:"status,omitempty"`
+	VMId              *string      `json:"vmId,omitempty"`
+}
@@ -49,11 +49,15 @@ func keyPermissions() []string {
 		"Delete",
 		"Encrypt",
 		"Get",
+		"GetRotationPolicy",
 		"Import",
 		"List",
 		"Purge",
 		"Recover",
+		"Release",
 		"Restore",
+		"Rotate",
+		"SetRotationPolicy",
 		"Sign",
 		"UnwrapKey",
 		"Update",
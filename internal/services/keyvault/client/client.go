@@ -4,13 +4,19 @@ import (
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/preview/keyvault/mgmt/2020-04-01-preview/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/2023-07-01/networksecurityperimeterconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/2023-07-01/vaults"
+	keyvaultV73 "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.3/keyvault"
 )
 
 type Client struct {
-	ManagedHsmClient *keyvault.ManagedHsmsClient
-	ManagementClient *keyvaultmgmt.BaseClient
-	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+	ManagedHsmClient                             *keyvault.ManagedHsmsClient
+	ManagementClient                             *keyvaultmgmt.BaseClient
+	ManagementV73Client                          *keyvaultV73.BaseClient
+	NetworkSecurityPerimeterConfigurationsClient *networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfigurationsClient
+	VaultsClient                                 *keyvault.VaultsClient
+	VaultsNetworkingClient                       *vaults.VaultsClient
+	options                                      *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -20,14 +26,26 @@ func NewClient(o *common.ClientOptions) *Client {
 	managementClient := keyvaultmgmt.New()
 	o.ConfigureClient(&managementClient.Client, o.KeyVaultAuthorizer)
 
+	managementV73Client := keyvaultV73.New()
+	o.ConfigureClient(&managementV73Client.Client, o.KeyVaultAuthorizer)
+
+	networkSecurityPerimeterConfigurationsClient := networksecurityperimeterconfigurations.NewNetworkSecurityPerimeterConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&networkSecurityPerimeterConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
 	vaultsClient := keyvault.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
+	vaultsNetworkingClient := vaults.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&vaultsNetworkingClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ManagedHsmClient: &managedHsmClient,
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
-		options:          o,
+		ManagedHsmClient:    &managedHsmClient,
+		ManagementClient:    &managementClient,
+		ManagementV73Client: &managementV73Client,
+		NetworkSecurityPerimeterConfigurationsClient: &networkSecurityPerimeterConfigurationsClient,
+		VaultsClient:           &vaultsClient,
+		VaultsNetworkingClient: &vaultsNetworkingClient,
+		options:                o,
 	}
 }
 
@@ -11,14 +11,18 @@ import (
 	"fmt"
 	"log"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultV73 "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/7.3/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -43,6 +47,30 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			exportable := diff.Get("exportable").(bool)
+			hasReleasePolicy := len(diff.Get("release_policy").([]interface{})) > 0
+			if exportable && !hasReleasePolicy {
+				return fmt.Errorf("a `release_policy` must be specified when `exportable` is enabled")
+			}
+			if hasReleasePolicy && !exportable {
+				return fmt.Errorf("a `release_policy` can only be specified when `exportable` is enabled")
+			}
+			if keyType := diff.Get("key_type").(string); exportable && keyType != string(keyvault.RSAHSM) && keyType != string(keyvault.ECHSM) {
+				return fmt.Errorf("`exportable` can only be enabled when `key_type` is %q or %q", string(keyvault.RSAHSM), string(keyvault.ECHSM))
+			}
+
+			// once a Release Policy has been marked as immutable it can no longer be changed, so the Key has to be recreated
+			if diff.Id() != "" && diff.HasChange("release_policy") {
+				old, _ := diff.GetChange("release_policy")
+				if v := old.([]interface{}); len(v) > 0 && v[0] != nil && v[0].(map[string]interface{})["immutable"].(bool) {
+					return diff.ForceNew("release_policy")
+				}
+			}
+
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -132,6 +160,85 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"exportable": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"release_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"policy_json": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: suppress.DiffSuppressKeyReleasePolicy,
+						},
+
+						"content_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "application/json; charset=utf-8",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"immutable": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: azValidate.ISO8601Duration,
+						},
+
+						// Key Vault defaults to notifying 30 days before the Key expires
+						"notify_before_expiry": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: azValidate.ISO8601Duration,
+						},
+
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: azValidate.ISO8601Duration,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -181,6 +288,7 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
+	v73Client := meta.(*clients.Client).KeyVault.ManagementV73Client
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -213,20 +321,25 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	// TODO: support Importing Keys once this is fixed:
 	// https://github.com/Azure/azure-rest-api-specs/issues/1747
-	parameters := keyvault.KeyCreateParameters{
-		Kty:    keyvault.JSONWebKeyType(keyType),
+	parameters := keyvaultV73.KeyCreateParameters{
+		Kty:    keyvaultV73.JSONWebKeyType(keyType),
 		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
+		KeyAttributes: &keyvaultV73.KeyAttributes{
 			Enabled: utils.Bool(true),
 		},
 
 		Tags: tags.Expand(t),
 	}
 
-	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
+	if d.Get("exportable").(bool) {
+		parameters.KeyAttributes.Exportable = utils.Bool(true)
+		parameters.ReleasePolicy = expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+	}
+
+	if parameters.Kty == keyvaultV73.EC || parameters.Kty == keyvaultV73.ECHSM {
 		curveName := d.Get("curve").(string)
-		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
-	} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
+		parameters.Curve = utils.String(curveName)
+	} else if parameters.Kty == keyvaultV73.RSA || parameters.Kty == keyvaultV73.RSAHSM {
 		keySize, ok := d.GetOk("key_size")
 		if !ok {
 			return fmt.Errorf("Key size is required when creating an RSA key")
@@ -248,7 +361,7 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		parameters.KeyAttributes.Expires = &expirationUnixTime
 	}
 
-	if resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
+	if resp, err := v73Client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
 		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeys && utils.ResponseWasConflict(resp.Response) {
 			recoveredKey, err := client.RecoverDeletedKey(ctx, *keyVaultBaseUri, name)
 			if err != nil {
@@ -276,6 +389,12 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v := d.Get("rotation_policy").([]interface{}); len(v) > 0 {
+		if _, err := v73Client.UpdateKeyRotationPolicy(ctx, *keyVaultBaseUri, name, expandKeyVaultKeyRotationPolicy(v)); err != nil {
+			return fmt.Errorf("setting the Rotation Policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
 	// "" indicates the latest version
 	read, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
 	if err != nil {
@@ -289,7 +408,7 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	client := meta.(*clients.Client).KeyVault.ManagementV73Client
 	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -325,14 +444,18 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	keyOptions := expandKeyVaultKeyOptions(d)
 	t := d.Get("tags").(map[string]interface{})
 
-	parameters := keyvault.KeyUpdateParameters{
+	parameters := keyvaultV73.KeyUpdateParameters{
 		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
+		KeyAttributes: &keyvaultV73.KeyAttributes{
 			Enabled: utils.Bool(true),
 		},
 		Tags: tags.Expand(t),
 	}
 
+	if d.HasChange("release_policy") {
+		parameters.ReleasePolicy = expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+	}
+
 	if v, ok := d.GetOk("not_before_date"); ok {
 		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
 		notBeforeUnixTime := date.UnixTime(notBeforeDate)
@@ -349,12 +472,18 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if d.HasChange("rotation_policy") {
+		if _, err := client.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, expandKeyVaultKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))); err != nil {
+			return fmt.Errorf("updating the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
 func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
-	client := meta.(*clients.Client).KeyVault.ManagementClient
+	client := meta.(*clients.Client).KeyVault.ManagementV73Client
 	resourcesClient := meta.(*clients.Client).Resource
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -424,7 +553,12 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("curve", key.Crv)
 	}
 
+	exportable := false
 	if attributes := resp.Attributes; attributes != nil {
+		if attributes.Exportable != nil {
+			exportable = *attributes.Exportable
+		}
+
 		if v := attributes.NotBefore; v != nil {
 			d.Set("not_before_date", time.Time(*v).Format(time.RFC3339))
 		}
@@ -433,12 +567,35 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
 		}
 	}
+	d.Set("exportable", exportable)
+
+	releasePolicy, err := flattenKeyVaultKeyReleasePolicy(resp.ReleasePolicy, d.Get("release_policy").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("flattening `release_policy`: %+v", err)
+	}
+	if err := d.Set("release_policy", releasePolicy); err != nil {
+		return fmt.Errorf("setting `release_policy`: %+v", err)
+	}
+
+	rotationPolicy, err := client.GetKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		// the Rotation Policy requires the `GetRotationPolicy` permission, which existing configurations may not have granted
+		if !utils.ResponseWasForbidden(rotationPolicy.Response) && !utils.ResponseWasNotFound(rotationPolicy.Response) {
+			return fmt.Errorf("retrieving the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		log.Printf("[DEBUG] Unable to retrieve the Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+	} else {
+		configured := len(d.Get("rotation_policy").([]interface{})) > 0
+		if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(rotationPolicy, configured)); err != nil {
+			return fmt.Errorf("setting `rotation_policy`: %+v", err)
+		}
+	}
 
 	// Computed
 	d.Set("version", id.Version)
 	d.Set("versionless_id", id.VersionlessID())
 	if key := resp.Key; key != nil {
-		if key.Kty == keyvault.RSA || key.Kty == keyvault.RSAHSM {
+		if key.Kty == keyvaultV73.RSA || key.Kty == keyvaultV73.RSAHSM {
 			nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
 			if err != nil {
 				return fmt.Errorf("failed to decode N: %+v", err)
@@ -455,7 +612,7 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 			if err != nil {
 				return fmt.Errorf("failed to read public key: %+v", err)
 			}
-		} else if key.Kty == keyvault.EC || key.Kty == keyvault.ECHSM {
+		} else if key.Kty == keyvaultV73.EC || key.Kty == keyvaultV73.ECHSM {
 			// do ec keys
 			xBytes, err := base64.RawURLEncoding.DecodeString(*key.X)
 			if err != nil {
@@ -469,7 +626,7 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 				X: big.NewInt(0).SetBytes(xBytes),
 				Y: big.NewInt(0).SetBytes(yBytes),
 			}
-			switch key.Crv {
+			switch keyvault.JSONWebKeyCurveName(utils.NormalizeNilableString(key.Crv)) {
 			case keyvault.P256:
 				publicKey.Curve = elliptic.P256()
 			case keyvault.P384:
@@ -564,12 +721,12 @@ func (d deleteAndPurgeKey) NestedItemHasBeenPurged(ctx context.Context) (autores
 	return resp.Response, err
 }
 
-func expandKeyVaultKeyOptions(d *pluginsdk.ResourceData) *[]keyvault.JSONWebKeyOperation {
+func expandKeyVaultKeyOptions(d *pluginsdk.ResourceData) *[]string {
 	options := d.Get("key_opts").([]interface{})
-	results := make([]keyvault.JSONWebKeyOperation, 0, len(options))
+	results := make([]string, 0, len(options))
 
 	for _, option := range options {
-		results = append(results, keyvault.JSONWebKeyOperation(option.(string)))
+		results = append(results, option.(string))
 	}
 
 	return &results
@@ -585,6 +742,139 @@ func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 	return results
 }
 
+func expandKeyVaultKeyReleasePolicy(input []interface{}) *keyvaultV73.KeyReleasePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &keyvaultV73.KeyReleasePolicy{
+		ContentType:   utils.String(v["content_type"].(string)),
+		Immutable:     utils.Bool(v["immutable"].(bool)),
+		EncodedPolicy: utils.String(base64.RawURLEncoding.EncodeToString([]byte(v["policy_json"].(string)))),
+	}
+}
+
+func flattenKeyVaultKeyReleasePolicy(input *keyvaultV73.KeyReleasePolicy, existing []interface{}) ([]interface{}, error) {
+	if input == nil || input.EncodedPolicy == nil {
+		return []interface{}{}, nil
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*input.EncodedPolicy, "="))
+	if err != nil {
+		return nil, fmt.Errorf("decoding policy: %+v", err)
+	}
+	policy := string(decoded)
+
+	// Key Vault normalizes the policy, so the configured value is retained when it's equivalent
+	if len(existing) > 0 && existing[0] != nil {
+		if v := existing[0].(map[string]interface{})["policy_json"].(string); suppress.KeyReleasePoliciesAreEquivalent(v, policy) {
+			policy = v
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"content_type": utils.NormalizeNilableString(input.ContentType),
+			"immutable":    input.Immutable != nil && *input.Immutable,
+			"policy_json":  policy,
+		},
+	}, nil
+}
+
+func expandKeyVaultKeyRotationPolicy(input []interface{}) keyvaultV73.KeyRotationPolicy {
+	lifetimeActions := make([]keyvaultV73.LifetimeActions, 0)
+	if len(input) == 0 || input[0] == nil {
+		// an empty policy removes any automatic rotation and notifications
+		return keyvaultV73.KeyRotationPolicy{
+			LifetimeActions: &lifetimeActions,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	if notifyBeforeExpiry := v["notify_before_expiry"].(string); notifyBeforeExpiry != "" {
+		lifetimeActions = append(lifetimeActions, keyvaultV73.LifetimeActions{
+			Action: &keyvaultV73.LifetimeActionsType{
+				Type: keyvaultV73.Notify,
+			},
+			Trigger: &keyvaultV73.LifetimeActionsTrigger{
+				TimeBeforeExpiry: utils.String(notifyBeforeExpiry),
+			},
+		})
+	}
+
+	if automatic := v["automatic"].([]interface{}); len(automatic) > 0 && automatic[0] != nil {
+		raw := automatic[0].(map[string]interface{})
+		trigger := keyvaultV73.LifetimeActionsTrigger{}
+		if timeAfterCreation := raw["time_after_creation"].(string); timeAfterCreation != "" {
+			trigger.TimeAfterCreate = utils.String(timeAfterCreation)
+		}
+		if timeBeforeExpiry := raw["time_before_expiry"].(string); timeBeforeExpiry != "" {
+			trigger.TimeBeforeExpiry = utils.String(timeBeforeExpiry)
+		}
+
+		lifetimeActions = append(lifetimeActions, keyvaultV73.LifetimeActions{
+			Action: &keyvaultV73.LifetimeActionsType{
+				Type: keyvaultV73.Rotate,
+			},
+			Trigger: &trigger,
+		})
+	}
+
+	policy := keyvaultV73.KeyRotationPolicy{
+		LifetimeActions: &lifetimeActions,
+	}
+	if expireAfter := v["expire_after"].(string); expireAfter != "" {
+		policy.Attributes = &keyvaultV73.KeyRotationPolicyAttributes{
+			ExpiryTime: utils.String(expireAfter),
+		}
+	}
+
+	return policy
+}
+
+// flattenKeyVaultKeyRotationPolicy flattens the Rotation Policy - since Key Vault assigns a default policy (which only
+// notifies 30 days before expiry) to every Key, the default policy is only flattened when a `rotation_policy` is configured
+func flattenKeyVaultKeyRotationPolicy(input keyvaultV73.KeyRotationPolicy, configured bool) []interface{} {
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Trigger == nil {
+				continue
+			}
+
+			switch {
+			case strings.EqualFold(string(action.Action.Type), string(keyvaultV73.Notify)):
+				notifyBeforeExpiry = utils.NormalizeNilableString(action.Trigger.TimeBeforeExpiry)
+
+			case strings.EqualFold(string(action.Action.Type), string(keyvaultV73.Rotate)):
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": utils.NormalizeNilableString(action.Trigger.TimeAfterCreate),
+					"time_before_expiry":  utils.NormalizeNilableString(action.Trigger.TimeBeforeExpiry),
+				})
+			}
+		}
+	}
+
+	if !configured && expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"automatic":            automatic,
+			"expire_after":         expireAfter,
+			"notify_before_expiry": notifyBeforeExpiry,
+		},
+	}
+}
+
 // Credit to Hashicorp modified from https://github.com/hashicorp/terraform-provider-tls/blob/v3.1.0/internal/provider/util.go#L79-L105
 func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
 	pubKeyBytes, err := x509.MarshalPKIXPublicKey(pubKey)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/2023-07-01/networksecurityperimeterconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/2023-07-01/vaults"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network"
 	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -62,6 +65,8 @@ func resourceKeyVault() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceKeyVaultCustomizeDiff),
+
 		Schema: func() map[string]*pluginsdk.Schema {
 			rSchema := map[string]*pluginsdk.Schema{
 				"name": {
@@ -164,6 +169,14 @@ func resourceKeyVault() *pluginsdk.Resource {
 									string(keyvault.AzureServices),
 								}, false),
 							},
+							"bypass_operations": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Schema{
+									Type:         pluginsdk.TypeString,
+									ValidateFunc: validation.StringInSlice(vaults.PossibleValuesForBypassOperation(), false),
+								},
+							},
 							"ip_rules": {
 								Type:     pluginsdk.TypeSet,
 								Optional: true,
@@ -174,7 +187,30 @@ func resourceKeyVault() *pluginsdk.Resource {
 										commonValidate.CIDR,
 									),
 								},
-								Set: set.HashIPv4AddressOrCIDR,
+								Set:           set.HashIPv4AddressOrCIDR,
+								ConflictsWith: []string{"network_acls.0.ip_rule"},
+							},
+							"ip_rule": {
+								Type:     pluginsdk.TypeSet,
+								Optional: true,
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"value": {
+											Type:     pluginsdk.TypeString,
+											Required: true,
+											ValidateFunc: validation.Any(
+												commonValidate.IPv4Address,
+												commonValidate.CIDR,
+											),
+										},
+										"description": {
+											Type:         pluginsdk.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringLenBetween(1, 128),
+										},
+									},
+								},
+								ConflictsWith: []string{"network_acls.0.ip_rules"},
 							},
 							"virtual_network_subnet_ids": {
 								Type:     pluginsdk.TypeSet,
@@ -186,6 +222,13 @@ func resourceKeyVault() *pluginsdk.Resource {
 					},
 				},
 
+				"public_network_access": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      string(vaults.PublicNetworkAccessEnabled),
+					ValidateFunc: validation.StringInSlice(vaults.PossibleValuesForPublicNetworkAccess(), false),
+				},
+
 				"purge_protection_enabled": {
 					Type:     pluginsdk.TypeBool,
 					Optional: true,
@@ -222,6 +265,39 @@ func resourceKeyVault() *pluginsdk.Resource {
 				"tags": tags.Schema(),
 
 				// Computed
+				"network_security_perimeter_configuration": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"network_security_perimeter_id": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+							"perimeter_guid": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+							"profile_name": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+							"association_name": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+							"access_mode": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+							"provisioning_state": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+
 				"vault_uri": {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
@@ -388,6 +464,22 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// the granular bypass options, IP Rule descriptions and perimeter-aware public network access are only
+	// available in a newer API version, so are applied once the Key Vault exists
+	publicNetworkAccess := vaults.PublicNetworkAccess(d.Get("public_network_access").(string))
+	if publicNetworkAccess != vaults.PublicNetworkAccessEnabled || keyVaultNetworkAclsRequireNewerApi(networkAclsRaw) {
+		networkingClient := meta.(*clients.Client).KeyVault.VaultsNetworkingClient
+		payload := vaults.VaultPatchParameters{
+			Properties: &vaults.VaultPatchProperties{
+				NetworkAcls:         expandKeyVaultNetworkRuleSet(networkAclsRaw),
+				PublicNetworkAccess: &publicNetworkAccess,
+			},
+		}
+		if _, err := networkingClient.Update(ctx, vaults.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.Name), payload); err != nil {
+			return fmt.Errorf("updating the network rules for %s: %+v", id, err)
+		}
+	}
+
 	if v, ok := d.GetOk("contact"); ok {
 		contacts := KeyVaultMgmt.Contacts{
 			ContactList: expandKeyVaultCertificateContactList(v.(*pluginsdk.Set).List()),
@@ -474,13 +566,11 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		update.Properties.EnableRbacAuthorization = utils.Bool(d.Get("enable_rbac_authorization").(bool))
 	}
 
-	if d.HasChange("network_acls") {
-		if update.Properties == nil {
-			update.Properties = &keyvault.VaultPatchProperties{}
-		}
+	if d.HasChanges("network_acls", "public_network_access") {
+		networkingClient := meta.(*clients.Client).KeyVault.VaultsNetworkingClient
 
 		networkAclsRaw := d.Get("network_acls").([]interface{})
-		networkAcls, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
+		_, subnetIds := expandKeyVaultNetworkAcls(networkAclsRaw)
 
 		// also lock on the Virtual Network ID's since modifications in the networking stack are exclusive
 		virtualNetworkNames := make([]string, 0)
@@ -498,7 +588,16 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
 
-		update.Properties.NetworkAcls = networkAcls
+		publicNetworkAccess := vaults.PublicNetworkAccess(d.Get("public_network_access").(string))
+		payload := vaults.VaultPatchParameters{
+			Properties: &vaults.VaultPatchProperties{
+				NetworkAcls:         expandKeyVaultNetworkRuleSet(networkAclsRaw),
+				PublicNetworkAccess: &publicNetworkAccess,
+			},
+		}
+		if _, err := networkingClient.Update(ctx, vaults.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.Name), payload); err != nil {
+			return fmt.Errorf("updating the network rules for %s: %+v", *id, err)
+		}
 	}
 
 	if d.HasChange("purge_protection_enabled") {
@@ -600,6 +699,8 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).KeyVault.VaultsClient
+	networkingClient := meta.(*clients.Client).KeyVault.VaultsNetworkingClient
+	perimeterConfigurationsClient := meta.(*clients.Client).KeyVault.NetworkSecurityPerimeterConfigurationsClient
 	managementClient := meta.(*clients.Client).KeyVault.ManagementClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -666,10 +767,34 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 	d.Set("sku_name", skuName)
 
-	if err := d.Set("network_acls", flattenKeyVaultNetworkAcls(props.NetworkAcls)); err != nil {
+	vaultId := vaults.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	networkingResp, err := networkingClient.Get(ctx, vaultId)
+	if err != nil {
+		return fmt.Errorf("retrieving the network rules for %s: %+v", *id, err)
+	}
+
+	publicNetworkAccess := string(vaults.PublicNetworkAccessEnabled)
+	var networkAcls *vaults.NetworkRuleSet
+	if model := networkingResp.Model; model != nil {
+		if v := model.Properties.PublicNetworkAccess; v != nil {
+			publicNetworkAccess = string(*v)
+		}
+		networkAcls = model.Properties.NetworkAcls
+	}
+	d.Set("public_network_access", publicNetworkAccess)
+
+	if err := d.Set("network_acls", flattenKeyVaultNetworkAcls(networkAcls, d.Get("network_acls").([]interface{}))); err != nil {
 		return fmt.Errorf("setting `network_acls` for KeyVault %q: %+v", *resp.Name, err)
 	}
 
+	perimeterConfigurations, err := perimeterConfigurationsClient.ListByVaultComplete(ctx, networksecurityperimeterconfigurations.NewVaultID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("listing the Network Security Perimeter Configurations for %s: %+v", *id, err)
+	}
+	if err := d.Set("network_security_perimeter_configuration", flattenKeyVaultNetworkSecurityPerimeterConfigurations(perimeterConfigurations.Items)); err != nil {
+		return fmt.Errorf("setting `network_security_perimeter_configuration` for KeyVault %q: %+v", *resp.Name, err)
+	}
+
 	flattenedPolicies := flattenAccessPolicies(props.AccessPolicies)
 	if err := d.Set("access_policy", flattenedPolicies); err != nil {
 		return fmt.Errorf("setting `access_policy` for KeyVault %q: %+v", *resp.Name, err)
@@ -797,6 +922,20 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceKeyVaultCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if v, ok := diff.GetOk("network_acls"); ok {
+		acls := v.([]interface{})
+		if len(acls) > 0 && acls[0] != nil {
+			raw := acls[0].(map[string]interface{})
+			if raw["bypass"].(string) != string(keyvault.AzureServices) && raw["bypass_operations"].(*pluginsdk.Set).Len() > 0 {
+				return fmt.Errorf("`bypass_operations` can only be specified when `bypass` is set to `AzureServices`")
+			}
+		}
+	}
+
+	return nil
+}
+
 func keyVaultRefreshFunc(vaultUri string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		log.Printf("[DEBUG] Checking to see if KeyVault %q is available..", vaultUri)
@@ -831,12 +970,10 @@ func expandKeyVaultNetworkAcls(input []interface{}) (*keyvault.NetworkRuleSet, [
 	bypass := v["bypass"].(string)
 	defaultAction := v["default_action"].(string)
 
-	ipRulesRaw := v["ip_rules"].(*pluginsdk.Set)
 	ipRules := make([]keyvault.IPRule, 0)
-
-	for _, v := range ipRulesRaw.List() {
+	for _, v := range expandKeyVaultNetworkAclsIPRules(v) {
 		rule := keyvault.IPRule{
-			Value: utils.String(v.(string)),
+			Value: utils.String(v.Value),
 		}
 		ipRules = append(ipRules, rule)
 	}
@@ -879,12 +1016,14 @@ func expandKeyVaultCertificateContactList(input []interface{}) *[]KeyVaultMgmt.C
 	return &results
 }
 
-func flattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) []interface{} {
+func flattenKeyVaultNetworkAcls(input *vaults.NetworkRuleSet, existing []interface{}) []interface{} {
 	if input == nil {
 		return []interface{}{
 			map[string]interface{}{
 				"bypass":                     string(keyvault.AzureServices),
+				"bypass_operations":          pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
 				"default_action":             string(keyvault.Allow),
+				"ip_rule":                    []interface{}{},
 				"ip_rules":                   pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
 				"virtual_network_subnet_ids": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{}),
 			},
@@ -893,30 +1032,82 @@ func flattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) []interface{} {
 
 	output := make(map[string]interface{})
 
-	output["bypass"] = string(input.Bypass)
-	output["default_action"] = string(input.DefaultAction)
+	bypass := string(keyvault.AzureServices)
+	if input.Bypass != nil {
+		bypass = string(*input.Bypass)
+	}
+	output["bypass"] = bypass
+
+	bypassOperations := make([]interface{}, 0)
+	if input.BypassOperations != nil {
+		for _, v := range *input.BypassOperations {
+			bypassOperations = append(bypassOperations, string(v))
+		}
+	}
+	output["bypass_operations"] = pluginsdk.NewSet(pluginsdk.HashString, bypassOperations)
+
+	defaultAction := string(keyvault.Allow)
+	if input.DefaultAction != nil {
+		defaultAction = string(*input.DefaultAction)
+	}
+	output["default_action"] = defaultAction
+
+	// the API returns each IP Rule in its canonical CIDR form (e.g. `1.2.3.4` becomes `1.2.3.4/32`), so where an
+	// equivalent value is already present we keep that, rather than showing a diff purely due to the formatting
+	existingValues := make(map[string]string)
+	useIPRuleBlocks := false
+	if len(existing) > 0 && existing[0] != nil {
+		raw := existing[0].(map[string]interface{})
+		if v, ok := raw["ip_rules"].(*pluginsdk.Set); ok {
+			for _, item := range v.List() {
+				existingValues[normalizeKeyVaultIPRule(item.(string))] = item.(string)
+			}
+		}
+		if v, ok := raw["ip_rule"].(*pluginsdk.Set); ok {
+			for _, item := range v.List() {
+				value := item.(map[string]interface{})["value"].(string)
+				existingValues[normalizeKeyVaultIPRule(value)] = value
+				useIPRuleBlocks = true
+			}
+		}
+	}
 
 	ipRules := make([]interface{}, 0)
+	ipRuleBlocks := make([]interface{}, 0)
 	if input.IPRules != nil {
 		for _, v := range *input.IPRules {
-			if v.Value == nil {
-				continue
+			value := v.Value
+			if existingValue, ok := existingValues[normalizeKeyVaultIPRule(value)]; ok {
+				value = existingValue
 			}
 
-			ipRules = append(ipRules, *v.Value)
+			description := ""
+			if v.Description != nil {
+				description = *v.Description
+				useIPRuleBlocks = true
+			}
+
+			ipRules = append(ipRules, value)
+			ipRuleBlocks = append(ipRuleBlocks, map[string]interface{}{
+				"value":       value,
+				"description": description,
+			})
 		}
 	}
-	output["ip_rules"] = pluginsdk.NewSet(pluginsdk.HashString, ipRules)
+
+	if useIPRuleBlocks {
+		output["ip_rule"] = ipRuleBlocks
+		output["ip_rules"] = pluginsdk.NewSet(pluginsdk.HashString, []interface{}{})
+	} else {
+		output["ip_rule"] = []interface{}{}
+		output["ip_rules"] = pluginsdk.NewSet(pluginsdk.HashString, ipRules)
+	}
 
 	virtualNetworkRules := make([]interface{}, 0)
 	if input.VirtualNetworkRules != nil {
 		for _, v := range *input.VirtualNetworkRules {
-			if v.ID == nil {
-				continue
-			}
-
-			id := *v.ID
-			subnetId, err := networkParse.SubnetIDInsensitively(*v.ID)
+			id := v.Id
+			subnetId, err := networkParse.SubnetIDInsensitively(v.Id)
 			if err == nil {
 				id = subnetId.ID()
 			}
@@ -929,6 +1120,149 @@ func flattenKeyVaultNetworkAcls(input *keyvault.NetworkRuleSet) []interface{} {
 	return []interface{}{output}
 }
 
+func expandKeyVaultNetworkRuleSet(input []interface{}) *vaults.NetworkRuleSet {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	bypassOperations := make([]vaults.BypassOperation, 0)
+	for _, item := range v["bypass_operations"].(*pluginsdk.Set).List() {
+		bypassOperations = append(bypassOperations, vaults.BypassOperation(item.(string)))
+	}
+
+	networkRules := make([]vaults.VirtualNetworkRule, 0)
+	for _, item := range v["virtual_network_subnet_ids"].(*pluginsdk.Set).List() {
+		networkRules = append(networkRules, vaults.VirtualNetworkRule{
+			Id: item.(string),
+		})
+	}
+
+	ipRules := expandKeyVaultNetworkAclsIPRules(v)
+
+	bypass := vaults.NetworkRuleBypassOptions(v["bypass"].(string))
+	defaultAction := vaults.NetworkRuleAction(v["default_action"].(string))
+	return &vaults.NetworkRuleSet{
+		Bypass:              &bypass,
+		BypassOperations:    &bypassOperations,
+		DefaultAction:       &defaultAction,
+		IPRules:             &ipRules,
+		VirtualNetworkRules: &networkRules,
+	}
+}
+
+func expandKeyVaultNetworkAclsIPRules(input map[string]interface{}) []vaults.IPRule {
+	output := make([]vaults.IPRule, 0)
+
+	for _, item := range input["ip_rules"].(*pluginsdk.Set).List() {
+		output = append(output, vaults.IPRule{
+			Value: item.(string),
+		})
+	}
+
+	for _, item := range input["ip_rule"].(*pluginsdk.Set).List() {
+		raw := item.(map[string]interface{})
+		rule := vaults.IPRule{
+			Value: raw["value"].(string),
+		}
+		if description := raw["description"].(string); description != "" {
+			rule.Description = utils.String(description)
+		}
+		output = append(output, rule)
+	}
+
+	return output
+}
+
+// keyVaultNetworkAclsRequireNewerApi returns whether the Network ACLs use any of the fields which
+// can't be sent when the Key Vault is created using the older API version
+func keyVaultNetworkAclsRequireNewerApi(input []interface{}) bool {
+	if len(input) == 0 || input[0] == nil {
+		return false
+	}
+
+	v := input[0].(map[string]interface{})
+	if v["bypass_operations"].(*pluginsdk.Set).Len() > 0 {
+		return true
+	}
+
+	for _, rule := range expandKeyVaultNetworkAclsIPRules(v) {
+		if rule.Description != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeKeyVaultIPRule returns the canonical CIDR form of an IP Rule, as returned by the API
+func normalizeKeyVaultIPRule(input string) string {
+	if ip := net.ParseIP(input); ip != nil {
+		return fmt.Sprintf("%s/32", ip.String())
+	}
+
+	if _, network, err := net.ParseCIDR(input); err == nil {
+		return network.String()
+	}
+
+	return input
+}
+
+func flattenKeyVaultNetworkSecurityPerimeterConfigurations(input []networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfiguration) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, item := range input {
+		props := item.Properties
+		if props == nil {
+			continue
+		}
+
+		perimeterId := ""
+		perimeterGuid := ""
+		if perimeter := props.NetworkSecurityPerimeter; perimeter != nil {
+			if perimeter.Id != nil {
+				perimeterId = *perimeter.Id
+			}
+			if perimeter.PerimeterGuid != nil {
+				perimeterGuid = *perimeter.PerimeterGuid
+			}
+		}
+
+		profileName := ""
+		if profile := props.Profile; profile != nil && profile.Name != nil {
+			profileName = *profile.Name
+		}
+
+		associationName := ""
+		accessMode := ""
+		if association := props.ResourceAssociation; association != nil {
+			if association.Name != nil {
+				associationName = *association.Name
+			}
+			if association.AccessMode != nil {
+				accessMode = string(*association.AccessMode)
+			}
+		}
+
+		provisioningState := ""
+		if props.ProvisioningState != nil {
+			provisioningState = string(*props.ProvisioningState)
+		}
+
+		output = append(output, map[string]interface{}{
+			"network_security_perimeter_id": perimeterId,
+			"perimeter_guid":                perimeterGuid,
+			"profile_name":                  profileName,
+			"association_name":              associationName,
+			"access_mode":                   accessMode,
+			"provisioning_state":            provisioningState,
+		})
+	}
+
+	return output
+}
+
 func flattenKeyVaultCertificateContactList(input KeyVaultMgmt.Contacts) []interface{} {
 	results := make([]interface{}, 0)
 	if input.ContactList == nil {
@@ -69,6 +69,85 @@ func TestAccKeyVault_networkAcls(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
+		// the configured formatting of the IP Rules is retained, whereas an import uses the canonical CIDR form
+		data.ImportStep("network_acls.0.ip_rules"),
+	})
+}
+
+func TestAccKeyVault_networkAclsGranular(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
+	r := KeyVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.networkAcls(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.networkAclsGranular(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("network_acls.0.ip_rule.#").HasValue("2"),
+				check.That(data.ResourceName).Key("network_acls.0.bypass_operations.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.networkAcls(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVault_publicNetworkAccess(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
+	r := KeyVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.publicNetworkAccess(data, "Disabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.publicNetworkAccess(data, "Enabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVault_networkSecurityPerimeter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault", "test")
+	r := KeyVaultResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.networkSecurityPerimeter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// the association is created after the Key Vault, so a refresh is needed for it to be exposed
+			Config: r.networkSecurityPerimeter(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("public_network_access").HasValue("SecuredByPerimeter"),
+				check.That(data.ResourceName).Key("network_security_perimeter_configuration.#").HasValue("1"),
+				check.That(data.ResourceName).Key("network_security_perimeter_configuration.0.access_mode").HasValue("Enforced"),
+			),
+		},
 		data.ImportStep(),
 	})
 }
@@ -654,6 +733,120 @@ resource "azurerm_key_vault" "test" {
 `, r.networkAclsTemplate(data), data.RandomInteger)
 }
 
+func (r KeyVaultResource) networkAclsGranular(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "test" {
+  name                       = "vault%d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    key_permissions = [
+      "create",
+    ]
+
+    secret_permissions = [
+      "set",
+    ]
+  }
+
+  network_acls {
+    default_action             = "Deny"
+    bypass                     = "AzureServices"
+    bypass_operations          = ["Keys", "Secrets"]
+    virtual_network_subnet_ids = [azurerm_subnet.test_a.id]
+
+    ip_rule {
+      value       = "123.0.0.101/32"
+      description = "office"
+    }
+
+    ip_rule {
+      value = "123.0.1.0/24"
+    }
+  }
+}
+`, r.networkAclsTemplate(data), data.RandomInteger)
+}
+
+func (KeyVaultResource) publicNetworkAccess(data acceptance.TestData, publicNetworkAccess string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "vault%d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+  public_network_access      = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, publicNetworkAccess)
+}
+
+func (KeyVaultResource) networkSecurityPerimeter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_security_perimeter" "test" {
+  name                = "acctest-nsp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_network_security_perimeter_profile" "test" {
+  name                          = "acctest-profile-%[1]d"
+  network_security_perimeter_id = azurerm_network_security_perimeter.test.id
+}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "vault%[1]d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+  public_network_access      = "SecuredByPerimeter"
+}
+
+resource "azurerm_network_security_perimeter_association" "test" {
+  name                                  = "acctest-assoc-%[1]d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  resource_id                           = azurerm_key_vault.test.id
+  access_mode                           = "Enforced"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (KeyVaultResource) update(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,15 @@
+package networksecurityperimeterconfigurations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkSecurityPerimeterConfigurationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkSecurityPerimeterConfigurationsClientWithBaseURI(endpoint string) NetworkSecurityPerimeterConfigurationsClient {
+	return NetworkSecurityPerimeterConfigurationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
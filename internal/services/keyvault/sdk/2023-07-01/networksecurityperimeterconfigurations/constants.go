@@ -0,0 +1,74 @@
+package networksecurityperimeterconfigurations
+
+import "strings"
+
+type NetworkSecurityPerimeterConfigurationProvisioningState string
+
+const (
+	NetworkSecurityPerimeterConfigurationProvisioningStateAccepted  NetworkSecurityPerimeterConfigurationProvisioningState = "Accepted"
+	NetworkSecurityPerimeterConfigurationProvisioningStateCanceled  NetworkSecurityPerimeterConfigurationProvisioningState = "Canceled"
+	NetworkSecurityPerimeterConfigurationProvisioningStateDeleting  NetworkSecurityPerimeterConfigurationProvisioningState = "Deleting"
+	NetworkSecurityPerimeterConfigurationProvisioningStateFailed    NetworkSecurityPerimeterConfigurationProvisioningState = "Failed"
+	NetworkSecurityPerimeterConfigurationProvisioningStateSucceeded NetworkSecurityPerimeterConfigurationProvisioningState = "Succeeded"
+	NetworkSecurityPerimeterConfigurationProvisioningStateUpdating  NetworkSecurityPerimeterConfigurationProvisioningState = "Updating"
+)
+
+func PossibleValuesForNetworkSecurityPerimeterConfigurationProvisioningState() []string {
+	return []string{
+		string(NetworkSecurityPerimeterConfigurationProvisioningStateAccepted),
+		string(NetworkSecurityPerimeterConfigurationProvisioningStateCanceled),
+		string(NetworkSecurityPerimeterConfigurationProvisioningStateDeleting),
+		string(NetworkSecurityPerimeterConfigurationProvisioningStateFailed),
+		string(NetworkSecurityPerimeterConfigurationProvisioningStateSucceeded),
+		string(NetworkSecurityPerimeterConfigurationProvisioningStateUpdating),
+	}
+}
+
+func parseNetworkSecurityPerimeterConfigurationProvisioningState(input string) (*NetworkSecurityPerimeterConfigurationProvisioningState, error) {
+	vals := map[string]NetworkSecurityPerimeterConfigurationProvisioningState{
+		"accepted":  NetworkSecurityPerimeterConfigurationProvisioningStateAccepted,
+		"canceled":  NetworkSecurityPerimeterConfigurationProvisioningStateCanceled,
+		"deleting":  NetworkSecurityPerimeterConfigurationProvisioningStateDeleting,
+		"failed":    NetworkSecurityPerimeterConfigurationProvisioningStateFailed,
+		"succeeded": NetworkSecurityPerimeterConfigurationProvisioningStateSucceeded,
+		"updating":  NetworkSecurityPerimeterConfigurationProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NetworkSecurityPerimeterConfigurationProvisioningState(input)
+	return &out, nil
+}
+
+type ResourceAssociationAccessMode string
+
+const (
+	ResourceAssociationAccessModeAudit    ResourceAssociationAccessMode = "Audit"
+	ResourceAssociationAccessModeEnforced ResourceAssociationAccessMode = "Enforced"
+	ResourceAssociationAccessModeLearning ResourceAssociationAccessMode = "Learning"
+)
+
+func PossibleValuesForResourceAssociationAccessMode() []string {
+	return []string{
+		string(ResourceAssociationAccessModeAudit),
+		string(ResourceAssociationAccessModeEnforced),
+		string(ResourceAssociationAccessModeLearning),
+	}
+}
+
+func parseResourceAssociationAccessMode(input string) (*ResourceAssociationAccessMode, error) {
+	vals := map[string]ResourceAssociationAccessMode{
+		"audit":    ResourceAssociationAccessModeAudit,
+		"enforced": ResourceAssociationAccessModeEnforced,
+		"learning": ResourceAssociationAccessModeLearning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ResourceAssociationAccessMode(input)
+	return &out, nil
+}
@@ -0,0 +1,183 @@
+package networksecurityperimeterconfigurations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListByVaultResponse struct {
+	HttpResponse *http.Response
+	Model        *[]NetworkSecurityPerimeterConfiguration
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (ListByVaultResponse, error)
+}
+
+type ListByVaultCompleteResult struct {
+	Items []NetworkSecurityPerimeterConfiguration
+}
+
+func (r ListByVaultResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r ListByVaultResponse) LoadMore(ctx context.Context) (resp ListByVaultResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// ListByVault ...
+func (c NetworkSecurityPerimeterConfigurationsClient) ListByVault(ctx context.Context, id VaultId) (resp ListByVaultResponse, err error) {
+	req, err := c.preparerForListByVault(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfigurationsClient", "ListByVault", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfigurationsClient", "ListByVault", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForListByVault(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfigurationsClient", "ListByVault", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// ListByVaultComplete retrieves all of the results into a single object
+func (c NetworkSecurityPerimeterConfigurationsClient) ListByVaultComplete(ctx context.Context, id VaultId) (ListByVaultCompleteResult, error) {
+	return c.ListByVaultCompleteMatchingPredicate(ctx, id, NetworkSecurityPerimeterConfigurationPredicate{})
+}
+
+// ListByVaultCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c NetworkSecurityPerimeterConfigurationsClient) ListByVaultCompleteMatchingPredicate(ctx context.Context, id VaultId, predicate NetworkSecurityPerimeterConfigurationPredicate) (resp ListByVaultCompleteResult, err error) {
+	items := make([]NetworkSecurityPerimeterConfiguration, 0)
+
+	page, err := c.ListByVault(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := ListByVaultCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForListByVault prepares the ListByVault request.
+func (c NetworkSecurityPerimeterConfigurationsClient) preparerForListByVault(ctx context.Context, id VaultId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/networkSecurityPerimeterConfigurations", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForListByVaultWithNextLink prepares the ListByVault request with the given nextLink token.
+func (c NetworkSecurityPerimeterConfigurationsClient) preparerForListByVaultWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListByVault handles the response to the ListByVault request. The method always
+// closes the http.Response Body.
+func (c NetworkSecurityPerimeterConfigurationsClient) responderForListByVault(resp *http.Response) (result ListByVaultResponse, err error) {
+	type page struct {
+		Values   []NetworkSecurityPerimeterConfiguration `json:"value"`
+		NextLink *string                                 `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result ListByVaultResponse, err error) {
+			req, err := c.preparerForListByVaultWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfigurationsClient", "ListByVault", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfigurationsClient", "ListByVault", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForListByVault(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "networksecurityperimeterconfigurations.NetworkSecurityPerimeterConfigurationsClient", "ListByVault", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
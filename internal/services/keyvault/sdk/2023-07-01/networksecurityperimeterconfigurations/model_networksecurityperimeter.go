@@ -0,0 +1,7 @@
+package networksecurityperimeterconfigurations
+
+type NetworkSecurityPerimeter struct {
+	Id            *string `json:"id,omitempty"`
+	Location      *string `json:"location,omitempty"`
+	PerimeterGuid *string `json:"perimeterGuid,omitempty"`
+}
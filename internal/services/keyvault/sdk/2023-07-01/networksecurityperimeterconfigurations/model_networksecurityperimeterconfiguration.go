@@ -0,0 +1,8 @@
+package networksecurityperimeterconfigurations
+
+type NetworkSecurityPerimeterConfiguration struct {
+	Id         *string                                          `json:"id,omitempty"`
+	Name       *string                                          `json:"name,omitempty"`
+	Properties *NetworkSecurityPerimeterConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                                          `json:"type,omitempty"`
+}
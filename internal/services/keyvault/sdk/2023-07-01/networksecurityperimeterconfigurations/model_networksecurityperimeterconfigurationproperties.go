@@ -0,0 +1,8 @@
+package networksecurityperimeterconfigurations
+
+type NetworkSecurityPerimeterConfigurationProperties struct {
+	NetworkSecurityPerimeter *NetworkSecurityPerimeter                               `json:"networkSecurityPerimeter,omitempty"`
+	Profile                  *NetworkSecurityProfile                                 `json:"profile,omitempty"`
+	ProvisioningState        *NetworkSecurityPerimeterConfigurationProvisioningState `json:"provisioningState,omitempty"`
+	ResourceAssociation      *ResourceAssociation                                    `json:"resourceAssociation,omitempty"`
+}
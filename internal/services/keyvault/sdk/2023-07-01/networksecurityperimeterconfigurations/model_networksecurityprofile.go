@@ -0,0 +1,6 @@
+package networksecurityperimeterconfigurations
+
+type NetworkSecurityProfile struct {
+	AccessRulesVersion *int64  `json:"accessRulesVersion,omitempty"`
+	Name               *string `json:"name,omitempty"`
+}
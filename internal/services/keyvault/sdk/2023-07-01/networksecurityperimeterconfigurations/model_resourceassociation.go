@@ -0,0 +1,6 @@
+package networksecurityperimeterconfigurations
+
+type ResourceAssociation struct {
+	AccessMode *ResourceAssociationAccessMode `json:"accessMode,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+}
@@ -0,0 +1,9 @@
+package networksecurityperimeterconfigurations
+
+import "fmt"
+
+const defaultApiVersion = "2023-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/networksecurityperimeterconfigurations/%s", defaultApiVersion)
+}
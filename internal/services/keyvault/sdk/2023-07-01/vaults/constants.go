@@ -0,0 +1,121 @@
+package vaults
+
+import "strings"
+
+type BypassOperation string
+
+const (
+	BypassOperationCertificates BypassOperation = "Certificates"
+	BypassOperationKeys         BypassOperation = "Keys"
+	BypassOperationSecrets      BypassOperation = "Secrets"
+)
+
+func PossibleValuesForBypassOperation() []string {
+	return []string{
+		string(BypassOperationCertificates),
+		string(BypassOperationKeys),
+		string(BypassOperationSecrets),
+	}
+}
+
+func parseBypassOperation(input string) (*BypassOperation, error) {
+	vals := map[string]BypassOperation{
+		"certificates": BypassOperationCertificates,
+		"keys":         BypassOperationKeys,
+		"secrets":      BypassOperationSecrets,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BypassOperation(input)
+	return &out, nil
+}
+
+type NetworkRuleAction string
+
+const (
+	NetworkRuleActionAllow NetworkRuleAction = "Allow"
+	NetworkRuleActionDeny  NetworkRuleAction = "Deny"
+)
+
+func PossibleValuesForNetworkRuleAction() []string {
+	return []string{
+		string(NetworkRuleActionAllow),
+		string(NetworkRuleActionDeny),
+	}
+}
+
+func parseNetworkRuleAction(input string) (*NetworkRuleAction, error) {
+	vals := map[string]NetworkRuleAction{
+		"allow": NetworkRuleActionAllow,
+		"deny":  NetworkRuleActionDeny,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NetworkRuleAction(input)
+	return &out, nil
+}
+
+type NetworkRuleBypassOptions string
+
+const (
+	NetworkRuleBypassOptionsAzureServices NetworkRuleBypassOptions = "AzureServices"
+	NetworkRuleBypassOptionsNone          NetworkRuleBypassOptions = "None"
+)
+
+func PossibleValuesForNetworkRuleBypassOptions() []string {
+	return []string{
+		string(NetworkRuleBypassOptionsAzureServices),
+		string(NetworkRuleBypassOptionsNone),
+	}
+}
+
+func parseNetworkRuleBypassOptions(input string) (*NetworkRuleBypassOptions, error) {
+	vals := map[string]NetworkRuleBypassOptions{
+		"azureservices": NetworkRuleBypassOptionsAzureServices,
+		"none":          NetworkRuleBypassOptionsNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NetworkRuleBypassOptions(input)
+	return &out, nil
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled           PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled            PublicNetworkAccess = "Enabled"
+	PublicNetworkAccessSecuredByPerimeter PublicNetworkAccess = "SecuredByPerimeter"
+)
+
+func PossibleValuesForPublicNetworkAccess() []string {
+	return []string{
+		string(PublicNetworkAccessDisabled),
+		string(PublicNetworkAccessEnabled),
+		string(PublicNetworkAccessSecuredByPerimeter),
+	}
+}
+
+func parsePublicNetworkAccess(input string) (*PublicNetworkAccess, error) {
+	vals := map[string]PublicNetworkAccess{
+		"disabled":           PublicNetworkAccessDisabled,
+		"enabled":            PublicNetworkAccessEnabled,
+		"securedbyperimeter": PublicNetworkAccessSecuredByPerimeter,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccess(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package vaults
+
+type IPRule struct {
+	Description *string `json:"description,omitempty"`
+	Value       string  `json:"value"`
+}
@@ -0,0 +1,9 @@
+package vaults
+
+type NetworkRuleSet struct {
+	Bypass              *NetworkRuleBypassOptions `json:"bypass,omitempty"`
+	BypassOperations    *[]BypassOperation        `json:"bypassOperations,omitempty"`
+	DefaultAction       *NetworkRuleAction        `json:"defaultAction,omitempty"`
+	IPRules             *[]IPRule                 `json:"ipRules,omitempty"`
+	VirtualNetworkRules *[]VirtualNetworkRule     `json:"virtualNetworkRules,omitempty"`
+}
@@ -0,0 +1,10 @@
+package vaults
+
+type Vault struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   *string            `json:"location,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties VaultProperties    `json:"properties"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package vaults
+
+type VaultPatchProperties struct {
+	NetworkAcls         *NetworkRuleSet      `json:"networkAcls,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,7 @@
+package vaults
+
+type VaultProperties struct {
+	NetworkAcls         *NetworkRuleSet      `json:"networkAcls,omitempty"`
+	PublicNetworkAccess *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+	VaultUri            *string              `json:"vaultUri,omitempty"`
+}
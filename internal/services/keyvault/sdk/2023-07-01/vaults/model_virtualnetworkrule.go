@@ -0,0 +1,6 @@
+package vaults
+
+type VirtualNetworkRule struct {
+	Id                               string `json:"id"`
+	IgnoreMissingVnetServiceEndpoint *bool  `json:"ignoreMissingVnetServiceEndpoint,omitempty"`
+}
@@ -0,0 +1,9 @@
+package vaults
+
+import "fmt"
+
+const defaultApiVersion = "2023-07-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/vaults/%s", defaultApiVersion)
+}
@@ -0,0 +1,445 @@
+// Package keyvault implements the Azure Key Vault data plane API version 7.3.
+//
+// The key vault client performs cryptographic key operations and vault operations against the Key Vault service.
+package keyvault
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/tracing"
+)
+
+// BaseClient is the base client for Keyvault.
+type BaseClient struct {
+	autorest.Client
+}
+
+// New creates an instance of the BaseClient client.
+func New() BaseClient {
+	return NewWithoutDefaults()
+}
+
+// NewWithoutDefaults creates an instance of the BaseClient client.
+func NewWithoutDefaults() BaseClient {
+	return BaseClient{
+		Client: autorest.NewClientWithUserAgent(UserAgent()),
+	}
+}
+
+// CreateKey the create key operation can be used to create any key type in Azure Key Vault. If the named key
+// already exists, Azure Key Vault creates a new version of the key. It requires the keys/create permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name for the new key. The system will generate the version name for the new key.
+// parameters - the parameters to create a key.
+func (client BaseClient) CreateKey(ctx context.Context, vaultBaseURL string, keyName string, parameters KeyCreateParameters) (result KeyBundle, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.CreateKey")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.CreateKeyPreparer(ctx, vaultBaseURL, keyName, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "CreateKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.CreateKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "CreateKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.CreateKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "CreateKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// CreateKeyPreparer prepares the CreateKey request.
+func (client BaseClient) CreateKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string, parameters KeyCreateParameters) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	const APIVersion = "7.3"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/create", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithJSON(parameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// CreateKeySender sends the CreateKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) CreateKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// CreateKeyResponder handles the response to the CreateKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) CreateKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// GetKey gets the public part of a stored key, including the release policy of exportable keys. This
+// operation requires the keys/get permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of the key to get.
+// keyVersion - adding the version parameter retrieves a specific version of a key. This URI fragment is optional.
+// If not specified, the latest version of the key is returned.
+func (client BaseClient) GetKey(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string) (result KeyBundle, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetKey")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetKeyPreparer(ctx, vaultBaseURL, keyName, keyVersion)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetKeyPreparer prepares the GetKey request.
+func (client BaseClient) GetKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name":    autorest.Encode("path", keyName),
+		"key-version": autorest.Encode("path", keyVersion),
+	}
+
+	const APIVersion = "7.3"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/{key-version}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetKeySender sends the GetKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetKeyResponder handles the response to the GetKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// GetKeyRotationPolicy the GetKeyRotationPolicy operation returns the specified key policy resources in the specified key vault.
+// This operation requires the keys/get permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of the key in a given key vault.
+func (client BaseClient) GetKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string) (result KeyRotationPolicy, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetKeyRotationPolicy")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetKeyRotationPolicyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetKeyRotationPolicySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetKeyRotationPolicyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetKeyRotationPolicyPreparer prepares the GetKeyRotationPolicy request.
+func (client BaseClient) GetKeyRotationPolicyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	const APIVersion = "7.3"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetKeyRotationPolicySender sends the GetKeyRotationPolicy request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetKeyRotationPolicySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetKeyRotationPolicyResponder handles the response to the GetKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetKeyRotationPolicyResponder(resp *http.Response) (result KeyRotationPolicy, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// UpdateKey in order to perform this operation, the key must already exist in the Key Vault. Note: The
+// cryptographic material of a key itself cannot be changed. This operation requires the keys/update permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of key to update.
+// keyVersion - the version of the key to update.
+// parameters - the parameters of the key to update.
+func (client BaseClient) UpdateKey(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string, parameters KeyUpdateParameters) (result KeyBundle, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.UpdateKey")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.UpdateKeyPreparer(ctx, vaultBaseURL, keyName, keyVersion, parameters)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateKeyPreparer prepares the UpdateKey request.
+func (client BaseClient) UpdateKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string, keyVersion string, parameters KeyUpdateParameters) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name":    autorest.Encode("path", keyName),
+		"key-version": autorest.Encode("path", keyVersion),
+	}
+
+	const APIVersion = "7.3"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/{key-version}", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithJSON(parameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateKeySender sends the UpdateKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateKeyResponder handles the response to the UpdateKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// UpdateKeyRotationPolicy set specified members in the key policy. Leave others as undefined. This operation requires the
+// keys/update permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of the key in the given vault.
+// keyRotationPolicy - the policy for the key.
+func (client BaseClient) UpdateKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string, keyRotationPolicy KeyRotationPolicy) (result KeyRotationPolicy, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.UpdateKeyRotationPolicy")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.UpdateKeyRotationPolicyPreparer(ctx, vaultBaseURL, keyName, keyRotationPolicy)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateKeyRotationPolicySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateKeyRotationPolicyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateKeyRotationPolicyPreparer prepares the UpdateKeyRotationPolicy request.
+func (client BaseClient) UpdateKeyRotationPolicyPreparer(ctx context.Context, vaultBaseURL string, keyName string, keyRotationPolicy KeyRotationPolicy) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	const APIVersion = "7.3"
+	queryParameters := map[string]interface{}{
+		"api-version": APIVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithJSON(keyRotationPolicy))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateKeyRotationPolicySender sends the UpdateKeyRotationPolicy request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateKeyRotationPolicySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateKeyRotationPolicyResponder handles the response to the UpdateKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateKeyRotationPolicyResponder(resp *http.Response) (result KeyRotationPolicy, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
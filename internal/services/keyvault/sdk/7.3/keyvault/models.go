@@ -0,0 +1,182 @@
+package keyvault
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+//
+// Code generated by Microsoft (R) AutoRest Code Generator.
+// Changes may cause incorrect behavior and will be lost if the code is regenerated.
+
+import (
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// The package's fully qualified name.
+const fqdn = "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.3/keyvault"
+
+// ActionType enumerates the values for action type.
+type ActionType string
+
+const (
+	// Notify notify the key owner that the key is about to expire
+	Notify ActionType = "Notify"
+	// Rotate rotate the key based on the key policy
+	Rotate ActionType = "Rotate"
+)
+
+// JSONWebKeyType enumerates the values for json web key type.
+type JSONWebKeyType string
+
+const (
+	// EC Elliptic Curve.
+	EC JSONWebKeyType = "EC"
+	// ECHSM Elliptic Curve with a private key which is not exportable from the HSM.
+	ECHSM JSONWebKeyType = "EC-HSM"
+	// Oct Octet sequence (used to represent symmetric keys)
+	Oct JSONWebKeyType = "oct"
+	// OctHSM Octet sequence (used to represent symmetric keys) which is not exportable from the HSM.
+	OctHSM JSONWebKeyType = "oct-HSM"
+	// RSA RSA (https://tools.ietf.org/html/rfc3447)
+	RSA JSONWebKeyType = "RSA"
+	// RSAHSM RSA with a private key which is not exportable from the HSM.
+	RSAHSM JSONWebKeyType = "RSA-HSM"
+)
+
+// JSONWebKey as of http://tools.ietf.org/html/draft-ietf-jose-json-web-key-18
+type JSONWebKey struct {
+	// Kid - Key identifier.
+	Kid *string `json:"kid,omitempty"`
+	// Kty - JsonWebKey Key Type (kty), as defined in https://tools.ietf.org/html/draft-ietf-jose-json-web-algorithms-40. Possible values include: 'EC', 'ECHSM', 'RSA', 'RSAHSM', 'Oct', 'OctHSM'
+	Kty    JSONWebKeyType `json:"kty,omitempty"`
+	KeyOps *[]string      `json:"key_ops,omitempty"`
+	// N - RSA modulus.
+	N *string `json:"n,omitempty"`
+	// E - RSA public exponent.
+	E *string `json:"e,omitempty"`
+	// Crv - Elliptic curve name.
+	Crv *string `json:"crv,omitempty"`
+	// X - X component of an EC public key.
+	X *string `json:"x,omitempty"`
+	// Y - Y component of an EC public key.
+	Y *string `json:"y,omitempty"`
+}
+
+// KeyAttributes the attributes of a key managed by the key vault service.
+type KeyAttributes struct {
+	// RecoverableDays - READ-ONLY; softDelete data retention days. Value should be >=7 and <=90 when softDelete enabled, otherwise 0.
+	RecoverableDays *int32 `json:"recoverableDays,omitempty"`
+	// RecoveryLevel - READ-ONLY; Reflects the deletion recovery level currently in effect for keys in the current vault.
+	RecoveryLevel *string `json:"recoveryLevel,omitempty"`
+	// Exportable - Indicates if the private key can be exported. Release policy must be provided when creating the first version of an exportable key.
+	Exportable *bool `json:"exportable,omitempty"`
+	// Enabled - Determines whether the object is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+	// NotBefore - Not before date in UTC.
+	NotBefore *date.UnixTime `json:"nbf,omitempty"`
+	// Expires - Expiry date in UTC.
+	Expires *date.UnixTime `json:"exp,omitempty"`
+	// Created - READ-ONLY; Creation time in UTC.
+	Created *date.UnixTime `json:"created,omitempty"`
+	// Updated - READ-ONLY; Last updated time in UTC.
+	Updated *date.UnixTime `json:"updated,omitempty"`
+}
+
+// KeyBundle a KeyBundle consisting of a WebKey plus its attributes.
+type KeyBundle struct {
+	autorest.Response `json:"-"`
+	// Key - The Json web key.
+	Key *JSONWebKey `json:"key,omitempty"`
+	// Attributes - The key management attributes.
+	Attributes *KeyAttributes `json:"attributes,omitempty"`
+	// Tags - Application specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+	// Managed - READ-ONLY; True if the key's lifetime is managed by key vault. If this is a key backing a certificate, then managed will be true.
+	Managed *bool `json:"managed,omitempty"`
+	// ReleasePolicy - The policy rules under which the key can be exported.
+	ReleasePolicy *KeyReleasePolicy `json:"release_policy,omitempty"`
+}
+
+// KeyReleasePolicy the policy rules under which the key can be exported.
+type KeyReleasePolicy struct {
+	// ContentType - Content type and version of key release policy
+	ContentType *string `json:"contentType,omitempty"`
+	// Immutable - Defines the mutability state of the policy. Once marked immutable, this flag cannot be reset and the policy cannot be changed under any circumstances.
+	Immutable *bool `json:"immutable,omitempty"`
+	// EncodedPolicy - Blob encoding the policy rules under which the key can be released. Blob must be base64 URL encoded.
+	EncodedPolicy *string `json:"data,omitempty"`
+}
+
+// KeyCreateParameters the key create parameters.
+type KeyCreateParameters struct {
+	// Kty - The type of key to create. Possible values include: 'EC', 'ECHSM', 'RSA', 'RSAHSM', 'Oct', 'OctHSM'
+	Kty JSONWebKeyType `json:"kty,omitempty"`
+	// KeySize - The key size in bits. For example: 2048, 3072, or 4096 for RSA.
+	KeySize *int32 `json:"key_size,omitempty"`
+	// PublicExponent - The public exponent for a RSA key.
+	PublicExponent *int32 `json:"public_exponent,omitempty"`
+	// KeyOps - Json web key operations.
+	KeyOps *[]string `json:"key_ops,omitempty"`
+	// KeyAttributes - The attributes of the key.
+	KeyAttributes *KeyAttributes `json:"attributes,omitempty"`
+	// Tags - Application specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+	// Curve - Elliptic curve name.
+	Curve *string `json:"crv,omitempty"`
+	// ReleasePolicy - The policy rules under which the key can be exported.
+	ReleasePolicy *KeyReleasePolicy `json:"release_policy,omitempty"`
+}
+
+// KeyUpdateParameters the key update parameters.
+type KeyUpdateParameters struct {
+	// KeyOps - Json web key operations. For more information on possible key operations, see JsonWebKeyOperation.
+	KeyOps *[]string `json:"key_ops,omitempty"`
+	// KeyAttributes - The attributes of the key.
+	KeyAttributes *KeyAttributes `json:"attributes,omitempty"`
+	// Tags - Application specific metadata in the form of key-value pairs.
+	Tags map[string]*string `json:"tags"`
+	// ReleasePolicy - The policy rules under which the key can be exported.
+	ReleasePolicy *KeyReleasePolicy `json:"release_policy,omitempty"`
+}
+
+// KeyRotationPolicy management policy for a key.
+type KeyRotationPolicy struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The key policy id.
+	ID *string `json:"id,omitempty"`
+	// LifetimeActions - Actions that will be performed by Key Vault over the lifetime of a key. For preview, lifetimeActions can only have two items at maximum: one for rotate, one for notify. Notification time would be default to 30 days before expiry and it is not configurable.
+	LifetimeActions *[]LifetimeActions `json:"lifetimeActions,omitempty"`
+	// Attributes - The key rotation policy attributes.
+	Attributes *KeyRotationPolicyAttributes `json:"attributes,omitempty"`
+}
+
+// KeyRotationPolicyAttributes the key rotation policy attributes.
+type KeyRotationPolicyAttributes struct {
+	// ExpiryTime - The expiryTime will be applied on the new key version. It should be at least 28 days. It will be in ISO 8601 Format. Examples: 90 days: P90D, 3 months: P3M, 48 hours: PT48H, 1 year and 10 days: P1Y10D
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+	// Created - READ-ONLY; The key rotation policy created time in UTC.
+	Created *date.UnixTime `json:"created,omitempty"`
+	// Updated - READ-ONLY; The key rotation policy's last updated time in UTC.
+	Updated *date.UnixTime `json:"updated,omitempty"`
+}
+
+// LifetimeActions action and its trigger that will be performed by Key Vault over the lifetime of a key.
+type LifetimeActions struct {
+	// Trigger - The condition that will execute the action.
+	Trigger *LifetimeActionsTrigger `json:"trigger,omitempty"`
+	// Action - The action that will be executed.
+	Action *LifetimeActionsType `json:"action,omitempty"`
+}
+
+// LifetimeActionsTrigger a condition to be satisfied for an action to be executed.
+type LifetimeActionsTrigger struct {
+	// TimeAfterCreate - Time after creation to attempt to rotate. It only applies to rotate. It will be in ISO 8601 duration format. Example: 90 days : "P90D"
+	TimeAfterCreate *string `json:"timeAfterCreate,omitempty"`
+	// TimeBeforeExpiry - Time before expiry to attempt to rotate or notify. It will be in ISO 8601 duration format. Example: 90 days : "P90D"
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
+
+// LifetimeActionsType the action that will be executed.
+type LifetimeActionsType struct {
+	// Type - The type of the action. Possible values include: 'Rotate', 'Notify'
+	Type ActionType `json:"type,omitempty"`
+}
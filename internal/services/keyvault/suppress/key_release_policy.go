@@ -0,0 +1,81 @@
+package suppress
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// defaultKeyReleasePolicyVersion is the version which Key Vault assigns to a Release Policy when none is specified
+const defaultKeyReleasePolicyVersion = "1.0.0"
+
+// DiffSuppressKeyReleasePolicy suppresses the diff between a Key Release Policy and the version which Key Vault
+// returns, since Key Vault normalizes the policy (the casing of the property names, the trailing slash on the
+// authority and the types of the claim values) before storing it
+func DiffSuppressKeyReleasePolicy(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return KeyReleasePoliciesAreEquivalent(old, new)
+}
+
+func KeyReleasePoliciesAreEquivalent(old, new string) bool {
+	if old == new {
+		return true
+	}
+
+	oldPolicy, err := normalizeKeyReleasePolicy(old)
+	if err != nil {
+		return false
+	}
+	newPolicy, err := normalizeKeyReleasePolicy(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldPolicy, newPolicy)
+}
+
+func normalizeKeyReleasePolicy(input string) (interface{}, error) {
+	var raw interface{}
+	if err := json.Unmarshal([]byte(input), &raw); err != nil {
+		return nil, err
+	}
+
+	normalized := normalizeKeyReleasePolicyValue("", raw)
+	if policy, ok := normalized.(map[string]interface{}); ok {
+		if _, ok := policy["version"]; !ok {
+			policy["version"] = defaultKeyReleasePolicyVersion
+		}
+	}
+
+	return normalized, nil
+}
+
+func normalizeKeyReleasePolicyValue(key string, input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		output := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			k = strings.ToLower(k)
+			output[k] = normalizeKeyReleasePolicyValue(k, item)
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			output = append(output, normalizeKeyReleasePolicyValue(key, item))
+		}
+		return output
+
+	case nil:
+		return nil
+	}
+
+	value := fmt.Sprintf("%v", input)
+	if key == "authority" {
+		value = strings.ToLower(strings.TrimSuffix(value, "/"))
+	}
+	return value
+}
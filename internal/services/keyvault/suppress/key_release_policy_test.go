@@ -0,0 +1,74 @@
+package suppress
+
+import "testing"
+
+func TestKeyReleasePoliciesAreEquivalent(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Old        string
+		New        string
+		Equivalent bool
+	}{
+		{
+			Name:       "identical",
+			Old:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			New:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "whitespace",
+			Old:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			New:        "{\n  \"version\": \"1.0.0\",\n  \"anyOf\": [{\"authority\": \"https://sharedeus.eus.attest.azure.net/\", \"allOf\": [{\"claim\": \"x-ms-attestation-type\", \"equals\": \"sevsnpvm\"}]}]\n}",
+			Equivalent: true,
+		},
+		{
+			Name:       "property name casing",
+			Old:        `{"version":"1.0.0","anyof":[{"authority":"https://sharedeus.eus.attest.azure.net/","allof":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			New:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "authority trailing slash",
+			Old:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			New:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "boolean claim value",
+			Old:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-compliance-status","equals":"true"}]}]}`,
+			New:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-compliance-status","equals":true}]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "default version",
+			Old:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			New:        `{"anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			Equivalent: true,
+		},
+		{
+			Name:       "different claim",
+			Old:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			New:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"tdxvm"}]}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "different authority",
+			Old:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedeus.eus.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			New:        `{"version":"1.0.0","anyOf":[{"authority":"https://sharedweu.weu.attest.azure.net/","allOf":[{"claim":"x-ms-attestation-type","equals":"sevsnpvm"}]}]}`,
+			Equivalent: false,
+		},
+		{
+			Name:       "invalid json",
+			Old:        `{"version":"1.0.0"`,
+			New:        `{"version":"1.0.0"}`,
+			Equivalent: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+		if actual := KeyReleasePoliciesAreEquivalent(tc.Old, tc.New); actual != tc.Equivalent {
+			t.Fatalf("Expected %t but got %t for %q", tc.Equivalent, actual, tc.Name)
+		}
+	}
+}
@@ -1,8 +1,14 @@
 package client
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/Azure/azure-sdk-for-go/services/kusto/mgmt/2021-01-01/kusto"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/dataplane"
 )
 
 type Client struct {
@@ -13,6 +19,30 @@ type Client struct {
 	DataConnectionsClient                *kusto.DataConnectionsClient
 	DatabasePrincipalAssignmentsClient   *kusto.DatabasePrincipalAssignmentsClient
 	ScriptsClient                        *kusto.ScriptsClient
+	tokenFunc                            func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc                  func(c *autorest.Client, authorizer autorest.Authorizer)
+}
+
+// DataPlaneClient returns a client which can run control commands against the specified Kusto Cluster
+func (c Client) DataPlaneClient(ctx context.Context, clusterId parse.ClusterId) (*dataplane.BaseClient, error) {
+	cluster, err := c.ClustersClient.Get(ctx, clusterId.ResourceGroup, clusterId.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", clusterId, err)
+	}
+
+	if cluster.ClusterProperties == nil || cluster.ClusterProperties.URI == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.uri` was nil", clusterId)
+	}
+
+	endpoint := *cluster.ClusterProperties.URI
+	auth, err := c.tokenFunc(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("obtaining auth token for %q: %+v", endpoint, err)
+	}
+
+	client := dataplane.NewWithoutDefaults(endpoint)
+	c.configureClientFunc(&client.Client, auth)
+	return &client, nil
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -44,5 +74,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		DataConnectionsClient:                &DataConnectionsClient,
 		DatabasePrincipalAssignmentsClient:   &DatabasePrincipalAssignmentsClient,
 		ScriptsClient:                        &ScriptsClient,
+		tokenFunc:                            o.TokenFunc,
+		configureClientFunc:                  o.ConfigureClient,
 	}
 }
@@ -0,0 +1,461 @@
+package kusto
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/dataplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KustoTablePolicyModel struct {
+	DatabaseId                      string `tfschema:"database_id"`
+	TableName                       string `tfschema:"table_name"`
+	MaterializedViewName            string `tfschema:"materialized_view_name"`
+	HotCachePeriodInDays            int    `tfschema:"hot_cache_period_in_days"`
+	RetentionSoftDeletePeriodInDays int    `tfschema:"retention_soft_delete_period_in_days"`
+	RetentionRecoverabilityEnabled  bool   `tfschema:"retention_recoverability_enabled"`
+}
+
+var _ sdk.Resource = KustoTablePolicyResource{}
+var _ sdk.ResourceWithUpdate = KustoTablePolicyResource{}
+
+type KustoTablePolicyResource struct{}
+
+func (r KustoTablePolicyResource) ResourceType() string {
+	return "azurerm_kusto_table_policy"
+}
+
+func (r KustoTablePolicyResource) ModelObject() interface{} {
+	return &KustoTablePolicyModel{}
+}
+
+func (r KustoTablePolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		if _, err := parseKustoTablePolicyID(v); err != nil {
+			errors = append(errors, err)
+		}
+
+		return
+	}
+}
+
+func (r KustoTablePolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DatabaseID,
+		},
+
+		"table_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"table_name", "materialized_view_name"},
+		},
+
+		"materialized_view_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"table_name", "materialized_view_name"},
+		},
+
+		"hot_cache_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			AtLeastOneOf: []string{"hot_cache_period_in_days", "retention_soft_delete_period_in_days"},
+		},
+
+		"retention_soft_delete_period_in_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 36500),
+			AtLeastOneOf: []string{"hot_cache_period_in_days", "retention_soft_delete_period_in_days"},
+		},
+
+		"retention_recoverability_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			Default:      true,
+			RequiredWith: []string{"retention_soft_delete_period_in_days"},
+		},
+	}
+}
+
+func (r KustoTablePolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KustoTablePolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model KustoTablePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.DatabaseID(model.DatabaseId)
+			if err != nil {
+				return err
+			}
+
+			entity := kustoTablePolicyEntity{
+				databaseId: *databaseId,
+				name:       model.TableName,
+				kind:       "table",
+			}
+			if model.MaterializedViewName != "" {
+				entity.name = model.MaterializedViewName
+				entity.kind = "materialized-view"
+			}
+
+			client, err := metadata.Client.Kusto.DataPlaneClient(ctx, parse.NewClusterID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ClusterName))
+			if err != nil {
+				return err
+			}
+
+			existing, err := entity.read(ctx, client)
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing policies for %s: %+v", entity, err)
+			}
+			if existing.cachingPolicy != nil || existing.retentionPolicy != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), entity)
+			}
+
+			if err := entity.apply(ctx, client, model, metadata.ResourceData.HasChanges); err != nil {
+				return fmt.Errorf("creating policies for %s: %+v", entity, err)
+			}
+
+			metadata.SetID(entity)
+			return nil
+		},
+	}
+}
+
+func (r KustoTablePolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			entity, err := parseKustoTablePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			databaseId := entity.databaseId
+			database, err := metadata.Client.Kusto.DatabasesClient.Get(ctx, databaseId.ResourceGroup, databaseId.ClusterName, databaseId.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(database.Response) {
+					return metadata.MarkAsGone(databaseId)
+				}
+				return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+			}
+
+			client, err := metadata.Client.Kusto.DataPlaneClient(ctx, parse.NewClusterID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ClusterName))
+			if err != nil {
+				return err
+			}
+
+			policies, err := entity.read(ctx, client)
+			if err != nil {
+				if kustoEntityWasNotFound(err) {
+					return metadata.MarkAsGone(entity)
+				}
+				return fmt.Errorf("retrieving policies for %s: %+v", entity, err)
+			}
+
+			state := KustoTablePolicyModel{
+				DatabaseId:                     databaseId.ID(),
+				RetentionRecoverabilityEnabled: true,
+			}
+			if entity.kind == "materialized-view" {
+				state.MaterializedViewName = entity.name
+			} else {
+				state.TableName = entity.name
+			}
+
+			if policy := policies.cachingPolicy; policy != nil && policy.DataHotSpan != nil {
+				days, err := kustoTimespanToDays(policy.DataHotSpan.Value)
+				if err != nil {
+					return fmt.Errorf("parsing `DataHotSpan` for %s: %+v", entity, err)
+				}
+				state.HotCachePeriodInDays = days
+			}
+
+			if policy := policies.retentionPolicy; policy != nil {
+				days, err := kustoTimespanToDays(policy.SoftDeletePeriod)
+				if err != nil {
+					return fmt.Errorf("parsing `SoftDeletePeriod` for %s: %+v", entity, err)
+				}
+				state.RetentionSoftDeletePeriodInDays = days
+				state.RetentionRecoverabilityEnabled = strings.EqualFold(policy.Recoverability, "Enabled")
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KustoTablePolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			entity, err := parseKustoTablePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KustoTablePolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId := entity.databaseId
+			client, err := metadata.Client.Kusto.DataPlaneClient(ctx, parse.NewClusterID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ClusterName))
+			if err != nil {
+				return err
+			}
+
+			if err := entity.apply(ctx, client, model, metadata.ResourceData.HasChanges); err != nil {
+				return fmt.Errorf("updating policies for %s: %+v", entity, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KustoTablePolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			entity, err := parseKustoTablePolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			databaseId := entity.databaseId
+			client, err := metadata.Client.Kusto.DataPlaneClient(ctx, parse.NewClusterID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ClusterName))
+			if err != nil {
+				return err
+			}
+
+			for _, policy := range []string{"caching", "retention"} {
+				if _, err := client.ExecuteManagementCommand(ctx, databaseId.Name, entity.command(".delete", policy, "")); err != nil {
+					if kustoEntityWasNotFound(err) {
+						return nil
+					}
+					return fmt.Errorf("deleting %s policy for %s: %+v", policy, entity, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// kustoTablePolicyEntity is either a Table or a Materialized View within a Kusto Database
+type kustoTablePolicyEntity struct {
+	databaseId parse.DatabaseId
+	name       string
+	kind       string
+}
+
+func (e kustoTablePolicyEntity) ID() string {
+	if e.kind == "materialized-view" {
+		return parse.NewMaterializedViewID(e.databaseId.SubscriptionId, e.databaseId.ResourceGroup, e.databaseId.ClusterName, e.databaseId.Name, e.name).ID()
+	}
+	return parse.NewTableID(e.databaseId.SubscriptionId, e.databaseId.ResourceGroup, e.databaseId.ClusterName, e.databaseId.Name, e.name).ID()
+}
+
+func (e kustoTablePolicyEntity) String() string {
+	if e.kind == "materialized-view" {
+		return parse.NewMaterializedViewID(e.databaseId.SubscriptionId, e.databaseId.ResourceGroup, e.databaseId.ClusterName, e.databaseId.Name, e.name).String()
+	}
+	return parse.NewTableID(e.databaseId.SubscriptionId, e.databaseId.ResourceGroup, e.databaseId.ClusterName, e.databaseId.Name, e.name).String()
+}
+
+func (e kustoTablePolicyEntity) command(verb string, policy string, suffix string) string {
+	command := fmt.Sprintf("%s %s ['%s'] policy %s", verb, e.kind, strings.ReplaceAll(e.name, "'", "\\'"), policy)
+	if suffix != "" {
+		command = fmt.Sprintf("%s %s", command, suffix)
+	}
+	return command
+}
+
+type kustoTablePolicies struct {
+	cachingPolicy   *kustoCachingPolicy
+	retentionPolicy *kustoRetentionPolicy
+}
+
+type kustoCachingPolicy struct {
+	DataHotSpan *struct {
+		Value string `json:"Value"`
+	} `json:"DataHotSpan"`
+}
+
+type kustoRetentionPolicy struct {
+	SoftDeletePeriod string `json:"SoftDeletePeriod"`
+	Recoverability   string `json:"Recoverability"`
+}
+
+func (e kustoTablePolicyEntity) read(ctx context.Context, client *dataplane.BaseClient) (*kustoTablePolicies, error) {
+	result := kustoTablePolicies{}
+
+	caching, err := e.showPolicy(ctx, client, "caching")
+	if err != nil {
+		return nil, err
+	}
+	if caching != "" {
+		var policy kustoCachingPolicy
+		if err := json.Unmarshal([]byte(caching), &policy); err != nil {
+			return nil, fmt.Errorf("unmarshaling caching policy: %+v", err)
+		}
+		result.cachingPolicy = &policy
+	}
+
+	retention, err := e.showPolicy(ctx, client, "retention")
+	if err != nil {
+		return nil, err
+	}
+	if retention != "" {
+		var policy kustoRetentionPolicy
+		if err := json.Unmarshal([]byte(retention), &policy); err != nil {
+			return nil, fmt.Errorf("unmarshaling retention policy: %+v", err)
+		}
+		result.retentionPolicy = &policy
+	}
+
+	return &result, nil
+}
+
+// showPolicy returns the JSON representation of the policy set on the entity, or an empty string when the
+// policy is inherited from the database
+func (e kustoTablePolicyEntity) showPolicy(ctx context.Context, client *dataplane.BaseClient, policy string) (string, error) {
+	resp, err := client.ExecuteManagementCommand(ctx, e.databaseId.Name, e.command(".show", policy, ""))
+	if err != nil {
+		return "", err
+	}
+
+	rows, err := resp.PrimaryResult()
+	if err != nil {
+		return "", err
+	}
+
+	if len(rows) == 0 {
+		return "", nil
+	}
+
+	value, ok := rows[0]["Policy"].(string)
+	if !ok || value == "" || value == "null" {
+		return "", nil
+	}
+
+	return value, nil
+}
+
+func (e kustoTablePolicyEntity) apply(ctx context.Context, client *dataplane.BaseClient, model KustoTablePolicyModel, hasChanges func(keys ...string) bool) error {
+	database := e.databaseId.Name
+
+	if hasChanges("hot_cache_period_in_days") {
+		command := e.command(".delete", "caching", "")
+		if model.HotCachePeriodInDays > 0 {
+			command = e.command(".alter", "caching", fmt.Sprintf("hot = %dd", model.HotCachePeriodInDays))
+		}
+
+		if _, err := client.ExecuteManagementCommand(ctx, database, command); err != nil {
+			return fmt.Errorf("setting caching policy: %+v", err)
+		}
+	}
+
+	if hasChanges("retention_soft_delete_period_in_days", "retention_recoverability_enabled") {
+		command := e.command(".delete", "retention", "")
+		if model.RetentionSoftDeletePeriodInDays > 0 {
+			recoverability := "Disabled"
+			if model.RetentionRecoverabilityEnabled {
+				recoverability = "Enabled"
+			}
+
+			policy, err := json.Marshal(kustoRetentionPolicy{
+				SoftDeletePeriod: fmt.Sprintf("%d.00:00:00", model.RetentionSoftDeletePeriodInDays),
+				Recoverability:   recoverability,
+			})
+			if err != nil {
+				return fmt.Errorf("marshaling retention policy: %+v", err)
+			}
+
+			command = e.command(".alter", "retention", fmt.Sprintf("```%s```", string(policy)))
+		}
+
+		if _, err := client.ExecuteManagementCommand(ctx, database, command); err != nil {
+			return fmt.Errorf("setting retention policy: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func parseKustoTablePolicyID(input string) (*kustoTablePolicyEntity, error) {
+	if tableId, err := parse.TableID(input); err == nil {
+		return &kustoTablePolicyEntity{
+			databaseId: parse.NewDatabaseID(tableId.SubscriptionId, tableId.ResourceGroup, tableId.ClusterName, tableId.DatabaseName),
+			name:       tableId.Name,
+			kind:       "table",
+		}, nil
+	}
+
+	viewId, err := parse.MaterializedViewID(input)
+	if err != nil {
+		return nil, fmt.Errorf("expected %q to be either a Kusto Table ID or a Kusto Materialized View ID", input)
+	}
+
+	return &kustoTablePolicyEntity{
+		databaseId: parse.NewDatabaseID(viewId.SubscriptionId, viewId.ResourceGroup, viewId.ClusterName, viewId.DatabaseName),
+		name:       viewId.Name,
+		kind:       "materialized-view",
+	}, nil
+}
+
+// kustoTimespanToDays converts a Kusto timespan (e.g. `7.00:00:00`) into a whole number of days
+func kustoTimespanToDays(input string) (int, error) {
+	if input == "" {
+		return 0, nil
+	}
+
+	if !strings.Contains(input, ".") {
+		// timespans of less than one day are rendered without a day component
+		return 0, nil
+	}
+
+	days, err := strconv.Atoi(strings.SplitN(input, ".", 2)[0])
+	if err != nil {
+		return 0, fmt.Errorf("parsing %q as a timespan: %+v", input, err)
+	}
+
+	return days, nil
+}
+
+func kustoEntityWasNotFound(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "EntityNotFound")
+}
@@ -0,0 +1,157 @@
+package kusto_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KustoTablePolicyResource struct{}
+
+func TestAccKustoTablePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_table_policy", "test")
+	r := KustoTablePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hot_cache_period_in_days").HasValue("7"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKustoTablePolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_table_policy", "test")
+	r := KustoTablePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccKustoTablePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_table_policy", "test")
+	r := KustoTablePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("retention_soft_delete_period_in_days").HasValue("90"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KustoTablePolicyResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.TableID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataPlaneClient, err := client.Kusto.DataPlaneClient(ctx, parse.NewClusterID(id.SubscriptionId, id.ResourceGroup, id.ClusterName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := dataPlaneClient.ExecuteManagementCommand(ctx, id.DatabaseName, fmt.Sprintf(".show table ['%s'] policy caching", id.Name))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving caching policy for %s: %+v", *id, err)
+	}
+
+	rows, err := resp.PrimaryResult()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving caching policy for %s: %+v", *id, err)
+	}
+
+	if len(rows) == 0 {
+		return utils.Bool(false), nil
+	}
+
+	policy, ok := rows[0]["Policy"].(string)
+	return utils.Bool(ok && policy != "" && policy != "null"), nil
+}
+
+func (r KustoTablePolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_script" "test" {
+  name        = "acctest-ks-%d"
+  database_id = azurerm_kusto_database.test.id
+  url         = azurerm_storage_blob.test.id
+  sas_token   = data.azurerm_storage_account_blob_container_sas.test.sas
+}
+`, KustoScriptResource{}.template(data), data.RandomInteger)
+}
+
+func (r KustoTablePolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table_policy" "test" {
+  database_id              = azurerm_kusto_script.test.database_id
+  table_name               = "MyTable"
+  hot_cache_period_in_days = 7
+}
+`, r.template(data))
+}
+
+func (r KustoTablePolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table_policy" "import" {
+  database_id              = azurerm_kusto_table_policy.test.database_id
+  table_name               = azurerm_kusto_table_policy.test.table_name
+  hot_cache_period_in_days = azurerm_kusto_table_policy.test.hot_cache_period_in_days
+}
+`, r.basic(data))
+}
+
+func (r KustoTablePolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_kusto_table_policy" "test" {
+  database_id                          = azurerm_kusto_script.test.database_id
+  table_name                           = "MyTable"
+  hot_cache_period_in_days             = 14
+  retention_soft_delete_period_in_days = 90
+  retention_recoverability_enabled     = false
+}
+`, r.template(data))
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MaterializedViewId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ClusterName    string
+	DatabaseName   string
+	Name           string
+}
+
+func NewMaterializedViewID(subscriptionId, resourceGroup, clusterName, databaseName, name string) MaterializedViewId {
+	return MaterializedViewId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ClusterName:    clusterName,
+		DatabaseName:   databaseName,
+		Name:           name,
+	}
+}
+
+func (id MaterializedViewId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Cluster Name %q", id.ClusterName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Materialized View", segmentsStr)
+}
+
+func (id MaterializedViewId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Kusto/Clusters/%s/Databases/%s/MaterializedViews/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name)
+}
+
+// MaterializedViewID parses a MaterializedView ID into an MaterializedViewId struct
+func MaterializedViewID(input string) (*MaterializedViewId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MaterializedViewId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("MaterializedViews"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
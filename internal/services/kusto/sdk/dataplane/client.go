@@ -0,0 +1,103 @@
+// Package dataplane implements a minimal client for the Kusto (Azure Data Explorer) REST API v1,
+// which is used to run control commands against a database on a Kusto Cluster.
+package dataplane
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/tracing"
+)
+
+const fqdn = "github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/sdk/dataplane"
+
+// BaseClient is the base client for the Kusto Data Plane.
+type BaseClient struct {
+	autorest.Client
+	Endpoint string
+}
+
+// NewWithoutDefaults creates an instance of the BaseClient client.
+func NewWithoutDefaults(endpoint string) BaseClient {
+	return BaseClient{
+		Client:   autorest.NewClientWithUserAgent(""),
+		Endpoint: endpoint,
+	}
+}
+
+// ExecuteManagementCommand runs a control command against the specified database.
+// Parameters:
+// database - the name of the database the command should be run in the context of.
+// command - the control command to run, for example `.show table MyTable policy caching`.
+func (client BaseClient) ExecuteManagementCommand(ctx context.Context, database string, command string) (result ManagementCommandResult, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.ExecuteManagementCommand")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.ExecuteManagementCommandPreparer(ctx, database, command)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "ExecuteManagementCommand", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.ExecuteManagementCommandSender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "ExecuteManagementCommand", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.ExecuteManagementCommandResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "dataplane.BaseClient", "ExecuteManagementCommand", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// ExecuteManagementCommandPreparer prepares the ExecuteManagementCommand request.
+func (client BaseClient) ExecuteManagementCommandPreparer(ctx context.Context, database string, command string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"endpoint": client.Endpoint,
+	}
+
+	body := managementCommandRequest{
+		Database: database,
+		Command:  command,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{endpoint}", urlParameters),
+		autorest.WithPath("/v1/rest/mgmt"),
+		autorest.WithJSON(body))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// ExecuteManagementCommandSender sends the ExecuteManagementCommand request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) ExecuteManagementCommandSender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// ExecuteManagementCommandResponder handles the response to the ExecuteManagementCommand request. The method always
+// closes the http.Response Body.
+func (client BaseClient) ExecuteManagementCommandResponder(resp *http.Response) (result ManagementCommandResult, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -0,0 +1,55 @@
+package dataplane
+
+import (
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type managementCommandRequest struct {
+	Database string `json:"db"`
+	Command  string `json:"csl"`
+}
+
+// ManagementCommandResult is the v1 response returned from running a control command.
+type ManagementCommandResult struct {
+	autorest.Response `json:"-"`
+	Tables            []Table `json:"Tables"`
+}
+
+// Table is a single result table returned from a control command.
+type Table struct {
+	TableName string          `json:"TableName"`
+	Columns   []Column        `json:"Columns"`
+	Rows      [][]interface{} `json:"Rows"`
+}
+
+// Column describes a single column within a result Table.
+type Column struct {
+	ColumnName string `json:"ColumnName"`
+	DataType   string `json:"DataType"`
+	ColumnType string `json:"ColumnType"`
+}
+
+// PrimaryResult returns the rows of the first result table as a list of column-name to value maps.
+func (r ManagementCommandResult) PrimaryResult() ([]map[string]interface{}, error) {
+	if len(r.Tables) == 0 {
+		return nil, fmt.Errorf("the response contained no result tables")
+	}
+
+	table := r.Tables[0]
+	rows := make([]map[string]interface{}, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		if len(row) != len(table.Columns) {
+			return nil, fmt.Errorf("expected %d columns in row but got %d", len(table.Columns), len(row))
+		}
+
+		values := make(map[string]interface{}, len(row))
+		for i, column := range table.Columns {
+			values[column.ColumnName] = row[i]
+		}
+		rows = append(rows, values)
+	}
+
+	return rows, nil
+}
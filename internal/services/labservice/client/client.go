@@ -0,0 +1,43 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/images"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/users"
+)
+
+type Client struct {
+	ImagesClient    *images.ImagesClient
+	LabPlansClient  *labplans.LabPlansClient
+	LabsClient      *labs.LabsClient
+	SchedulesClient *schedules.SchedulesClient
+	UsersClient     *users.UsersClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	imagesClient := images.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
+
+	labPlansClient := labplans.NewLabPlansClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&labPlansClient.Client, o.ResourceManagerAuthorizer)
+
+	labsClient := labs.NewLabsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&labsClient.Client, o.ResourceManagerAuthorizer)
+
+	schedulesClient := schedules.NewSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&schedulesClient.Client, o.ResourceManagerAuthorizer)
+
+	usersClient := users.NewUsersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&usersClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ImagesClient:    &imagesClient,
+		LabPlansClient:  &labPlansClient,
+		LabsClient:      &labsClient,
+		SchedulesClient: &schedulesClient,
+		UsersClient:     &usersClient,
+	}
+}
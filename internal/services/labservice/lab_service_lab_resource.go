@@ -0,0 +1,764 @@
+package labservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labs"
+	labValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServiceLabResource struct{}
+
+var _ sdk.ResourceWithUpdate = LabServiceLabResource{}
+
+type LabServiceLabResourceModel struct {
+	Name              string              `tfschema:"name"`
+	ResourceGroupName string              `tfschema:"resource_group_name"`
+	Location          string              `tfschema:"location"`
+	Title             string              `tfschema:"title"`
+	LabPlanId         string              `tfschema:"lab_plan_id"`
+	Description       string              `tfschema:"description"`
+	AutoShutdown      []AutoShutdown      `tfschema:"auto_shutdown"`
+	ConnectionSetting []ConnectionSetting `tfschema:"connection_setting"`
+	Network           []LabNetwork        `tfschema:"network"`
+	Security          []Security          `tfschema:"security"`
+	VirtualMachine    []VirtualMachine    `tfschema:"virtual_machine"`
+	Tags              map[string]string   `tfschema:"tags"`
+}
+
+type ConnectionSetting struct {
+	ClientRdpAccess string `tfschema:"client_rdp_access"`
+	ClientSshAccess string `tfschema:"client_ssh_access"`
+}
+
+type LabNetwork struct {
+	SubnetId       string `tfschema:"subnet_id"`
+	LoadBalancerId string `tfschema:"load_balancer_id"`
+	PublicIpId     string `tfschema:"public_ip_id"`
+}
+
+type Security struct {
+	OpenAccessEnabled bool   `tfschema:"open_access_enabled"`
+	RegistrationCode  string `tfschema:"registration_code"`
+}
+
+type VirtualMachine struct {
+	AdminUser                               []Credential     `tfschema:"admin_user"`
+	ImageReference                          []ImageReference `tfschema:"image_reference"`
+	Sku                                     []Sku            `tfschema:"sku"`
+	UsageQuota                              string           `tfschema:"usage_quota"`
+	CreateOption                            string           `tfschema:"create_option"`
+	SharedPasswordEnabled                   bool             `tfschema:"shared_password_enabled"`
+	AdditionalCapabilityGpuDriversInstalled bool             `tfschema:"additional_capability_gpu_drivers_installed"`
+}
+
+type Credential struct {
+	Username string `tfschema:"username"`
+	Password string `tfschema:"password"`
+}
+
+type ImageReference struct {
+	Id        string `tfschema:"id"`
+	Offer     string `tfschema:"offer"`
+	Publisher string `tfschema:"publisher"`
+	Sku       string `tfschema:"sku"`
+	Version   string `tfschema:"version"`
+}
+
+type Sku struct {
+	Name     string `tfschema:"name"`
+	Capacity int64  `tfschema:"capacity"`
+}
+
+func (r LabServiceLabResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: labValidate.LabServiceName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"title": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringLenBetween(1, 120),
+		},
+
+		"security": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"open_access_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+
+					"registration_code": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"virtual_machine": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_user": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"username": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"password": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									Sensitive:    true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"image_reference": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"id": {
+									Type:          pluginsdk.TypeString,
+									Optional:      true,
+									ForceNew:      true,
+									ValidateFunc:  azure.ValidateResourceID,
+									ConflictsWith: []string{"virtual_machine.0.image_reference.0.offer", "virtual_machine.0.image_reference.0.publisher", "virtual_machine.0.image_reference.0.sku", "virtual_machine.0.image_reference.0.version"},
+								},
+
+								"offer": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									RequiredWith: []string{"virtual_machine.0.image_reference.0.publisher", "virtual_machine.0.image_reference.0.sku", "virtual_machine.0.image_reference.0.version"},
+								},
+
+								"publisher": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									RequiredWith: []string{"virtual_machine.0.image_reference.0.offer", "virtual_machine.0.image_reference.0.sku", "virtual_machine.0.image_reference.0.version"},
+								},
+
+								"sku": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									RequiredWith: []string{"virtual_machine.0.image_reference.0.offer", "virtual_machine.0.image_reference.0.publisher", "virtual_machine.0.image_reference.0.version"},
+								},
+
+								"version": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+									RequiredWith: []string{"virtual_machine.0.image_reference.0.offer", "virtual_machine.0.image_reference.0.publisher", "virtual_machine.0.image_reference.0.sku"},
+								},
+							},
+						},
+					},
+
+					"sku": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"capacity": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(0, 400),
+								},
+							},
+						},
+					},
+
+					// the number of hours each user can use their Virtual Machine outside of the scheduled hours
+					"usage_quota": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      "PT0S",
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"create_option": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Default:      string(labs.CreateOptionImage),
+						ValidateFunc: validation.StringInSlice(labs.PossibleValuesForCreateOption(), false),
+					},
+
+					"shared_password_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"additional_capability_gpu_drivers_installed": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+
+		"lab_plan_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: labplans.ValidateLabPlanID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"auto_shutdown": autoShutdownSchema("auto_shutdown"),
+
+		"connection_setting": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_rdp_access": connectionTypeSchema(),
+
+					"client_ssh_access": connectionTypeSchema(),
+				},
+			},
+		},
+
+		"network": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+
+					"load_balancer_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"public_ip_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r LabServiceLabResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LabServiceLabResource) ModelObject() interface{} {
+	return &LabServiceLabResourceModel{}
+}
+
+func (r LabServiceLabResource) ResourceType() string {
+	return "azurerm_lab_service_lab"
+}
+
+func (r LabServiceLabResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return labs.ValidateLabID
+}
+
+func (r LabServiceLabResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model LabServiceLabResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := labs.NewLabID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := labs.Lab{
+				Location: location.Normalize(model.Location),
+				Properties: labs.LabProperties{
+					AutoShutdownProfile:   *expandLabServiceLabAutoShutdown(model.AutoShutdown),
+					ConnectionProfile:     *expandLabServiceLabConnectionSetting(model.ConnectionSetting),
+					SecurityProfile:       *expandLabServiceLabSecurity(model.Security),
+					Title:                 utils.String(model.Title),
+					VirtualMachineProfile: *expandLabServiceLabVirtualMachine(model.VirtualMachine),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if model.LabPlanId != "" {
+				payload.Properties.LabPlanId = utils.String(model.LabPlanId)
+			}
+
+			if len(model.Network) > 0 && model.Network[0].SubnetId != "" {
+				payload.Properties.NetworkProfile = &labs.LabNetworkProfile{
+					SubnetId: utils.String(model.Network[0].SubnetId),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LabServiceLabResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabsClient
+
+			id, err := labs.ParseLabID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the API doesn't return the password of the admin user, so this is pulled from the config
+			var config LabServiceLabResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := LabServiceLabResourceModel{
+				Name:              id.LabName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.AutoShutdown = flattenLabServiceLabAutoShutdown(props.AutoShutdownProfile)
+				state.ConnectionSetting = flattenLabServiceLabConnectionSetting(props.ConnectionProfile)
+				state.Description = utils.NormalizeNilableString(props.Description)
+				state.Network = flattenLabServiceLabNetwork(props.NetworkProfile)
+				state.Security = flattenLabServiceLabSecurity(props.SecurityProfile)
+				state.Title = utils.NormalizeNilableString(props.Title)
+				state.VirtualMachine = flattenLabServiceLabVirtualMachine(props.VirtualMachineProfile, config.VirtualMachine)
+
+				if props.LabPlanId != nil {
+					labPlanId, err := labplans.ParseLabPlanIDInsensitively(*props.LabPlanId)
+					if err != nil {
+						return err
+					}
+					state.LabPlanId = labPlanId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LabServiceLabResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabsClient
+
+			id, err := labs.ParseLabID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LabServiceLabResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := labs.LabUpdate{
+				Properties: &labs.LabUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("auto_shutdown") {
+				payload.Properties.AutoShutdownProfile = expandLabServiceLabAutoShutdown(model.AutoShutdown)
+			}
+
+			if metadata.ResourceData.HasChange("connection_setting") {
+				payload.Properties.ConnectionProfile = expandLabServiceLabConnectionSetting(model.ConnectionSetting)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("lab_plan_id") {
+				payload.Properties.LabPlanId = utils.String(model.LabPlanId)
+			}
+
+			if metadata.ResourceData.HasChange("security") {
+				payload.Properties.SecurityProfile = expandLabServiceLabSecurity(model.Security)
+			}
+
+			if metadata.ResourceData.HasChange("title") {
+				payload.Properties.Title = utils.String(model.Title)
+			}
+
+			// only the capacity and the usage quota can be updated, however the whole profile has to be sent
+			if metadata.ResourceData.HasChange("virtual_machine") {
+				payload.Properties.VirtualMachineProfile = expandLabServiceLabVirtualMachine(model.VirtualMachine)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LabServiceLabResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabsClient
+
+			id, err := labs.ParseLabID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLabServiceLabAutoShutdown(input []AutoShutdown) *labs.AutoShutdownProfile {
+	output := labs.AutoShutdownProfile{}
+	shutdownOnDisconnect := labs.EnableStateDisabled
+	shutdownWhenNotConnected := labs.EnableStateDisabled
+	shutdownOnIdle := labs.ShutdownOnIdleModeNone
+
+	if len(input) > 0 {
+		v := input[0]
+
+		if v.DisconnectDelay != "" {
+			shutdownOnDisconnect = labs.EnableStateEnabled
+			output.DisconnectDelay = utils.String(v.DisconnectDelay)
+		}
+
+		if v.NoConnectDelay != "" {
+			shutdownWhenNotConnected = labs.EnableStateEnabled
+			output.NoConnectDelay = utils.String(v.NoConnectDelay)
+		}
+
+		if v.ShutdownOnIdle != "" {
+			shutdownOnIdle = labs.ShutdownOnIdleMode(v.ShutdownOnIdle)
+			if v.IdleDelay != "" {
+				output.IdleDelay = utils.String(v.IdleDelay)
+			}
+		}
+	}
+
+	output.ShutdownOnDisconnect = &shutdownOnDisconnect
+	output.ShutdownOnIdle = &shutdownOnIdle
+	output.ShutdownWhenNotConnected = &shutdownWhenNotConnected
+
+	return &output
+}
+
+func flattenLabServiceLabAutoShutdown(input labs.AutoShutdownProfile) []AutoShutdown {
+	output := AutoShutdown{}
+	if input.ShutdownOnDisconnect != nil && *input.ShutdownOnDisconnect == labs.EnableStateEnabled {
+		output.DisconnectDelay = utils.NormalizeNilableString(input.DisconnectDelay)
+	}
+
+	if input.ShutdownWhenNotConnected != nil && *input.ShutdownWhenNotConnected == labs.EnableStateEnabled {
+		output.NoConnectDelay = utils.NormalizeNilableString(input.NoConnectDelay)
+	}
+
+	if input.ShutdownOnIdle != nil && *input.ShutdownOnIdle != labs.ShutdownOnIdleModeNone {
+		output.ShutdownOnIdle = string(*input.ShutdownOnIdle)
+		output.IdleDelay = utils.NormalizeNilableString(input.IdleDelay)
+	}
+
+	if output == (AutoShutdown{}) {
+		return []AutoShutdown{}
+	}
+
+	return []AutoShutdown{
+		output,
+	}
+}
+
+func expandLabServiceLabConnectionSetting(input []ConnectionSetting) *labs.ConnectionProfile {
+	clientRdpAccess := labs.ConnectionTypeNone
+	clientSshAccess := labs.ConnectionTypeNone
+	webRdpAccess := labs.ConnectionTypeNone
+	webSshAccess := labs.ConnectionTypeNone
+
+	if len(input) > 0 {
+		clientRdpAccess = labs.ConnectionType(input[0].ClientRdpAccess)
+		clientSshAccess = labs.ConnectionType(input[0].ClientSshAccess)
+	}
+
+	return &labs.ConnectionProfile{
+		ClientRdpAccess: &clientRdpAccess,
+		ClientSshAccess: &clientSshAccess,
+		WebRdpAccess:    &webRdpAccess,
+		WebSshAccess:    &webSshAccess,
+	}
+}
+
+func flattenLabServiceLabConnectionSetting(input labs.ConnectionProfile) []ConnectionSetting {
+	output := ConnectionSetting{
+		ClientRdpAccess: string(labs.ConnectionTypeNone),
+		ClientSshAccess: string(labs.ConnectionTypeNone),
+	}
+	if input.ClientRdpAccess != nil {
+		output.ClientRdpAccess = string(*input.ClientRdpAccess)
+	}
+	if input.ClientSshAccess != nil {
+		output.ClientSshAccess = string(*input.ClientSshAccess)
+	}
+
+	if output.ClientRdpAccess == string(labs.ConnectionTypeNone) && output.ClientSshAccess == string(labs.ConnectionTypeNone) {
+		return []ConnectionSetting{}
+	}
+
+	return []ConnectionSetting{
+		output,
+	}
+}
+
+func flattenLabServiceLabNetwork(input *labs.LabNetworkProfile) []LabNetwork {
+	if input == nil {
+		return []LabNetwork{}
+	}
+
+	return []LabNetwork{
+		{
+			LoadBalancerId: utils.NormalizeNilableString(input.LoadBalancerId),
+			PublicIpId:     utils.NormalizeNilableString(input.PublicIPId),
+			SubnetId:       utils.NormalizeNilableString(input.SubnetId),
+		},
+	}
+}
+
+func expandLabServiceLabSecurity(input []Security) *labs.SecurityProfile {
+	openAccess := labs.EnableStateDisabled
+	if len(input) > 0 && input[0].OpenAccessEnabled {
+		openAccess = labs.EnableStateEnabled
+	}
+
+	return &labs.SecurityProfile{
+		OpenAccess: &openAccess,
+	}
+}
+
+func flattenLabServiceLabSecurity(input labs.SecurityProfile) []Security {
+	return []Security{
+		{
+			OpenAccessEnabled: input.OpenAccess != nil && *input.OpenAccess == labs.EnableStateEnabled,
+			RegistrationCode:  utils.NormalizeNilableString(input.RegistrationCode),
+		},
+	}
+}
+
+func expandLabServiceLabVirtualMachine(input []VirtualMachine) *labs.VirtualMachineProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	installGpuDrivers := labs.EnableStateDisabled
+	if v.AdditionalCapabilityGpuDriversInstalled {
+		installGpuDrivers = labs.EnableStateEnabled
+	}
+
+	useSharedPassword := labs.EnableStateDisabled
+	if v.SharedPasswordEnabled {
+		useSharedPassword = labs.EnableStateEnabled
+	}
+
+	output := labs.VirtualMachineProfile{
+		AdditionalCapabilities: &labs.VirtualMachineAdditionalCapabilities{
+			InstallGpuDrivers: &installGpuDrivers,
+		},
+		CreateOption:      labs.CreateOption(v.CreateOption),
+		UsageQuota:        v.UsageQuota,
+		UseSharedPassword: &useSharedPassword,
+	}
+
+	if len(v.AdminUser) > 0 {
+		output.AdminUser = labs.Credentials{
+			Username: v.AdminUser[0].Username,
+			Password: utils.String(v.AdminUser[0].Password),
+		}
+	}
+
+	if len(v.ImageReference) > 0 {
+		imageReference := v.ImageReference[0]
+		if imageReference.Id != "" {
+			output.ImageReference.Id = utils.String(imageReference.Id)
+		}
+		if imageReference.Offer != "" {
+			output.ImageReference.Offer = utils.String(imageReference.Offer)
+		}
+		if imageReference.Publisher != "" {
+			output.ImageReference.Publisher = utils.String(imageReference.Publisher)
+		}
+		if imageReference.Sku != "" {
+			output.ImageReference.Sku = utils.String(imageReference.Sku)
+		}
+		if imageReference.Version != "" {
+			output.ImageReference.Version = utils.String(imageReference.Version)
+		}
+	}
+
+	if len(v.Sku) > 0 {
+		output.Sku = labs.Sku{
+			Name:     v.Sku[0].Name,
+			Capacity: utils.Int64(v.Sku[0].Capacity),
+		}
+	}
+
+	return &output
+}
+
+func flattenLabServiceLabVirtualMachine(input labs.VirtualMachineProfile, config []VirtualMachine) []VirtualMachine {
+	adminUser := Credential{
+		Username: input.AdminUser.Username,
+	}
+	if len(config) > 0 && len(config[0].AdminUser) > 0 {
+		adminUser.Password = config[0].AdminUser[0].Password
+	}
+
+	output := VirtualMachine{
+		AdminUser: []Credential{
+			adminUser,
+		},
+		CreateOption: string(input.CreateOption),
+		ImageReference: []ImageReference{
+			{
+				Id:        utils.NormalizeNilableString(input.ImageReference.Id),
+				Offer:     utils.NormalizeNilableString(input.ImageReference.Offer),
+				Publisher: utils.NormalizeNilableString(input.ImageReference.Publisher),
+				Sku:       utils.NormalizeNilableString(input.ImageReference.Sku),
+				Version:   utils.NormalizeNilableString(input.ImageReference.Version),
+			},
+		},
+		SharedPasswordEnabled: input.UseSharedPassword != nil && *input.UseSharedPassword == labs.EnableStateEnabled,
+		Sku: []Sku{
+			{
+				Name:     input.Sku.Name,
+				Capacity: utils.NormaliseNilableInt64(input.Sku.Capacity),
+			},
+		},
+		UsageQuota: input.UsageQuota,
+	}
+
+	if v := input.AdditionalCapabilities; v != nil && v.InstallGpuDrivers != nil {
+		output.AdditionalCapabilityGpuDriversInstalled = *v.InstallGpuDrivers == labs.EnableStateEnabled
+	}
+
+	return []VirtualMachine{
+		output,
+	}
+}
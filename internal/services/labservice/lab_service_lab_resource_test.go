@@ -0,0 +1,260 @@
+package labservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServiceLabResource struct{}
+
+func TestAccLabServiceLab_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password"),
+	})
+}
+
+func TestAccLabServiceLab_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLabServiceLab_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password"),
+	})
+}
+
+func TestAccLabServiceLab_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_lab", "test")
+	r := LabServiceLabResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("virtual_machine.0.admin_user.0.password"),
+	})
+}
+
+func (LabServiceLabResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := labs.ParseLabID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LabService.LabsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LabServiceLabResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-lab-%d"
+  location = "%s"
+}
+
+resource "azurerm_lab_service_plan" "test" {
+  name                = "acctest-lsp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  allowed_regions     = [azurerm_resource_group.test.location]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r LabServiceLabResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_lab_service_lab" "test" {
+  name                = "acctest-lab-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  title               = "Test Title"
+  lab_plan_id         = azurerm_lab_service_plan.test.id
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    usage_quota = "%[3]s"
+
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = %[4]d
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, "PT0S", 0)
+}
+
+func (r LabServiceLabResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_lab" "import" {
+  name                = azurerm_lab_service_lab.test.name
+  resource_group_name = azurerm_lab_service_lab.test.resource_group_name
+  location            = azurerm_lab_service_lab.test.location
+  title               = azurerm_lab_service_lab.test.title
+  lab_plan_id         = azurerm_lab_service_lab.test.lab_plan_id
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = 0
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r LabServiceLabResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_lab_service_lab" "test" {
+  name                = "acctest-lab-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  title               = "Test Title"
+  lab_plan_id         = azurerm_lab_service_plan.test.id
+
+  security {
+    open_access_enabled = false
+  }
+
+  virtual_machine {
+    usage_quota = "%[3]s"
+
+    admin_user {
+      username = "testadmin"
+      password = "Password1234!"
+    }
+
+    image_reference {
+      offer     = "0001-com-ubuntu-server-focal"
+      publisher = "canonical"
+      sku       = "20_04-lts"
+      version   = "latest"
+    }
+
+    sku {
+      name     = "Classic_Fsv2_2_4GB_128_S_SSD"
+      capacity = %[4]d
+    }
+  }
+
+  description = "Test Description"
+
+  auto_shutdown {
+    disconnect_delay = "PT15M"
+    idle_delay       = "PT15M"
+    no_connect_delay = "PT15M"
+    shutdown_on_idle = "LowUsage"
+  }
+
+  connection_setting {
+    client_ssh_access = "Public"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, "PT10H", 1)
+}
@@ -0,0 +1,190 @@
+package labservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/images"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// LabServicePlanImageResource enables one of the images which are made available to a Lab Plan (either from the
+// Marketplace or from the Shared Image Gallery attached to the Lab Plan) - these images exist on the Lab Plan
+// regardless, so creating this resource enables the image and deleting it disables the image again.
+type LabServicePlanImageResource struct{}
+
+var _ sdk.Resource = LabServicePlanImageResource{}
+
+type LabServicePlanImageResourceModel struct {
+	Name             string `tfschema:"name"`
+	LabServicePlanId string `tfschema:"lab_service_plan_id"`
+	DisplayName      string `tfschema:"display_name"`
+	OsType           string `tfschema:"os_type"`
+}
+
+func (r LabServicePlanImageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"lab_service_plan_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: labplans.ValidateLabPlanID,
+		},
+	}
+}
+
+func (r LabServicePlanImageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LabServicePlanImageResource) ModelObject() interface{} {
+	return &LabServicePlanImageResourceModel{}
+}
+
+func (r LabServicePlanImageResource) ResourceType() string {
+	return "azurerm_lab_service_plan_image"
+}
+
+func (r LabServicePlanImageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return images.ValidateImageID
+}
+
+func (r LabServicePlanImageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.ImagesClient
+
+			var model LabServicePlanImageResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			labPlanId, err := labplans.ParseLabPlanID(model.LabServicePlanId)
+			if err != nil {
+				return err
+			}
+
+			id := images.NewImageID(labPlanId.SubscriptionId, labPlanId.ResourceGroupName, labPlanId.LabPlanName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found - the image must be available to the Lab Plan before it can be enabled", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if isLabServicePlanImageEnabled(existing.Model) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := updateLabServicePlanImageEnabledState(ctx, client, id, images.EnableStateEnabled); err != nil {
+				return fmt.Errorf("enabling %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LabServicePlanImageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.ImagesClient
+
+			id, err := images.ParseImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// a disabled image is treated as having been removed
+			if !isLabServicePlanImageEnabled(resp.Model) {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := LabServicePlanImageResourceModel{
+				Name:             id.ImageName,
+				LabServicePlanId: labplans.NewLabPlanID(id.SubscriptionId, id.ResourceGroupName, id.LabPlanName).ID(),
+			}
+
+			if props := resp.Model.Properties; props != nil {
+				state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				if props.OsType != nil {
+					state.OsType = string(*props.OsType)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LabServicePlanImageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.ImagesClient
+
+			id, err := images.ParseImageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := updateLabServicePlanImageEnabledState(ctx, client, *id, images.EnableStateDisabled); err != nil {
+				return fmt.Errorf("disabling %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func isLabServicePlanImageEnabled(input *images.Image) bool {
+	return input != nil && input.Properties != nil && input.Properties.EnabledState != nil && *input.Properties.EnabledState == images.EnableStateEnabled
+}
+
+func updateLabServicePlanImageEnabledState(ctx context.Context, client *images.ImagesClient, id images.ImageId, enabledState images.EnableState) error {
+	payload := images.ImageUpdate{
+		Properties: &images.ImageUpdateProperties{
+			EnabledState: &enabledState,
+		},
+	}
+
+	if _, err := client.Update(ctx, id, payload); err != nil {
+		return err
+	}
+
+	return nil
+}
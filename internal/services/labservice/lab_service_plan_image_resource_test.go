@@ -0,0 +1,102 @@
+package labservice_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/images"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServicePlanImageResource struct {
+	imageName string
+}
+
+// the images which are available to a Lab Plan vary by region, so the name of the image to enable is specified
+// through an environment variable
+func newLabServicePlanImageResource(t *testing.T) LabServicePlanImageResource {
+	r := LabServicePlanImageResource{
+		imageName: os.Getenv("ARM_TEST_LAB_SERVICE_PLAN_IMAGE_NAME"),
+	}
+	if r.imageName == "" {
+		t.Skip("Skipping as ARM_TEST_LAB_SERVICE_PLAN_IMAGE_NAME is not specified")
+	}
+
+	return r
+}
+
+func TestAccLabServicePlanImage_basic(t *testing.T) {
+	r := newLabServicePlanImageResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_plan_image", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLabServicePlanImage_requiresImport(t *testing.T) {
+	r := newLabServicePlanImageResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_plan_image", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (LabServicePlanImageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := images.ParseImageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LabService.ImagesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.EnabledState != nil && *resp.Model.Properties.EnabledState == images.EnableStateEnabled), nil
+}
+
+func (r LabServicePlanImageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_plan_image" "test" {
+  name                = "%s"
+  lab_service_plan_id = azurerm_lab_service_plan.test.id
+}
+`, LabServicePlanResource{}.basic(data), r.imageName)
+}
+
+func (r LabServicePlanImageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_plan_image" "import" {
+  name                = azurerm_lab_service_plan_image.test.name
+  lab_service_plan_id = azurerm_lab_service_plan_image.test.lab_service_plan_id
+}
+`, r.basic(data))
+}
@@ -0,0 +1,583 @@
+package labservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labplans"
+	labValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServicePlanResource struct{}
+
+var _ sdk.ResourceWithUpdate = LabServicePlanResource{}
+
+type LabServicePlanResourceModel struct {
+	Name                   string                  `tfschema:"name"`
+	ResourceGroupName      string                  `tfschema:"resource_group_name"`
+	Location               string                  `tfschema:"location"`
+	AllowedRegions         []string                `tfschema:"allowed_regions"`
+	DefaultAutoShutdown    []AutoShutdown          `tfschema:"default_auto_shutdown"`
+	DefaultConnection      []DefaultConnection     `tfschema:"default_connection"`
+	DefaultNetworkSubnetId string                  `tfschema:"default_network_subnet_id"`
+	SharedGalleryId        string                  `tfschema:"shared_gallery_id"`
+	Support                []LabServicePlanSupport `tfschema:"support"`
+	Tags                   map[string]string       `tfschema:"tags"`
+}
+
+type AutoShutdown struct {
+	DisconnectDelay string `tfschema:"disconnect_delay"`
+	IdleDelay       string `tfschema:"idle_delay"`
+	NoConnectDelay  string `tfschema:"no_connect_delay"`
+	ShutdownOnIdle  string `tfschema:"shutdown_on_idle"`
+}
+
+type DefaultConnection struct {
+	ClientRdpAccess string `tfschema:"client_rdp_access"`
+	ClientSshAccess string `tfschema:"client_ssh_access"`
+	WebRdpAccess    string `tfschema:"web_rdp_access"`
+	WebSshAccess    string `tfschema:"web_ssh_access"`
+}
+
+type LabServicePlanSupport struct {
+	Email        string `tfschema:"email"`
+	Instructions string `tfschema:"instructions"`
+	Phone        string `tfschema:"phone"`
+	Url          string `tfschema:"url"`
+}
+
+func (r LabServicePlanResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: labValidate.LabServiceName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"allowed_regions": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:             pluginsdk.TypeString,
+				ValidateFunc:     location.EnhancedValidate,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+		},
+
+		"default_auto_shutdown": autoShutdownSchema("default_auto_shutdown"),
+
+		"default_connection": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_rdp_access": connectionTypeSchema(),
+
+					"client_ssh_access": connectionTypeSchema(),
+
+					"web_rdp_access": connectionTypeSchema(),
+
+					"web_ssh_access": connectionTypeSchema(),
+				},
+			},
+		},
+
+		"default_network_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"shared_gallery_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: computeValidate.SharedImageGalleryID,
+		},
+
+		"support": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"email": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						AtLeastOneOf: []string{"support.0.email", "support.0.instructions", "support.0.phone", "support.0.url"},
+					},
+
+					"instructions": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						AtLeastOneOf: []string{"support.0.email", "support.0.instructions", "support.0.phone", "support.0.url"},
+					},
+
+					"phone": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						AtLeastOneOf: []string{"support.0.email", "support.0.instructions", "support.0.phone", "support.0.url"},
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+						AtLeastOneOf: []string{"support.0.email", "support.0.instructions", "support.0.phone", "support.0.url"},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r LabServicePlanResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LabServicePlanResource) ModelObject() interface{} {
+	return &LabServicePlanResourceModel{}
+}
+
+func (r LabServicePlanResource) ResourceType() string {
+	return "azurerm_lab_service_plan"
+}
+
+func (r LabServicePlanResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return labplans.ValidateLabPlanID
+}
+
+func (r LabServicePlanResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabPlansClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model LabServicePlanResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := labplans.NewLabPlanID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := labplans.LabPlan{
+				Location: location.Normalize(model.Location),
+				Properties: labplans.LabPlanProperties{
+					AllowedRegions:             expandLabServicePlanAllowedRegions(model.AllowedRegions),
+					DefaultAutoShutdownProfile: expandLabServicePlanAutoShutdown(model.DefaultAutoShutdown),
+					DefaultConnectionProfile:   expandLabServicePlanDefaultConnection(model.DefaultConnection),
+					SupportInfo:                expandLabServicePlanSupport(model.Support),
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.DefaultNetworkSubnetId != "" {
+				payload.Properties.DefaultNetworkProfile = &labplans.LabPlanNetworkProfile{
+					SubnetId: utils.String(model.DefaultNetworkSubnetId),
+				}
+			}
+
+			if model.SharedGalleryId != "" {
+				payload.Properties.SharedGalleryId = utils.String(model.SharedGalleryId)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LabServicePlanResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabPlansClient
+
+			id, err := labplans.ParseLabPlanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LabServicePlanResourceModel{
+				Name:              id.LabPlanName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.AllowedRegions = flattenLabServicePlanAllowedRegions(props.AllowedRegions)
+				state.DefaultAutoShutdown = flattenLabServicePlanAutoShutdown(props.DefaultAutoShutdownProfile)
+				state.DefaultConnection = flattenLabServicePlanDefaultConnection(props.DefaultConnectionProfile)
+				state.SharedGalleryId = utils.NormalizeNilableString(props.SharedGalleryId)
+				state.Support = flattenLabServicePlanSupport(props.SupportInfo)
+
+				if props.DefaultNetworkProfile != nil {
+					state.DefaultNetworkSubnetId = utils.NormalizeNilableString(props.DefaultNetworkProfile.SubnetId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LabServicePlanResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabPlansClient
+
+			id, err := labplans.ParseLabPlanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LabServicePlanResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := labplans.LabPlanUpdate{
+				Properties: &labplans.LabPlanUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("allowed_regions") {
+				payload.Properties.AllowedRegions = expandLabServicePlanAllowedRegions(model.AllowedRegions)
+			}
+
+			if metadata.ResourceData.HasChange("default_auto_shutdown") {
+				payload.Properties.DefaultAutoShutdownProfile = expandLabServicePlanAutoShutdown(model.DefaultAutoShutdown)
+			}
+
+			if metadata.ResourceData.HasChange("default_connection") {
+				payload.Properties.DefaultConnectionProfile = expandLabServicePlanDefaultConnection(model.DefaultConnection)
+			}
+
+			if metadata.ResourceData.HasChange("default_network_subnet_id") {
+				payload.Properties.DefaultNetworkProfile = &labplans.LabPlanNetworkProfile{}
+				if model.DefaultNetworkSubnetId != "" {
+					payload.Properties.DefaultNetworkProfile.SubnetId = utils.String(model.DefaultNetworkSubnetId)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("shared_gallery_id") {
+				payload.Properties.SharedGalleryId = utils.String(model.SharedGalleryId)
+			}
+
+			if metadata.ResourceData.HasChange("support") {
+				payload.Properties.SupportInfo = expandLabServicePlanSupport(model.Support)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LabServicePlanResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.LabPlansClient
+
+			id, err := labplans.ParseLabPlanID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// autoShutdownSchema returns the schema for the idle auto-shutdown policy which is used both as the default for the
+// Labs within a Lab Plan and on each Lab - each policy is enabled by specifying its delay
+func autoShutdownSchema(key string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"disconnect_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+
+				"idle_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601Duration,
+					RequiredWith: []string{fmt.Sprintf("%s.0.shutdown_on_idle", key)},
+				},
+
+				"no_connect_delay": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+
+				"shutdown_on_idle": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(labplans.ShutdownOnIdleModeLowUsage),
+						string(labplans.ShutdownOnIdleModeUserAbsence),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func connectionTypeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		Default:      string(labplans.ConnectionTypeNone),
+		ValidateFunc: validation.StringInSlice(labplans.PossibleValuesForConnectionType(), false),
+	}
+}
+
+func expandLabServicePlanAllowedRegions(input []string) *[]string {
+	result := make([]string, 0)
+	for _, v := range input {
+		result = append(result, location.Normalize(v))
+	}
+
+	return &result
+}
+
+func flattenLabServicePlanAllowedRegions(input *[]string) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, location.Normalize(v))
+	}
+
+	return result
+}
+
+func expandLabServicePlanAutoShutdown(input []AutoShutdown) *labplans.AutoShutdownProfile {
+	output := labplans.AutoShutdownProfile{}
+	shutdownOnDisconnect := labplans.EnableStateDisabled
+	shutdownWhenNotConnected := labplans.EnableStateDisabled
+	shutdownOnIdle := labplans.ShutdownOnIdleModeNone
+
+	if len(input) > 0 {
+		v := input[0]
+
+		if v.DisconnectDelay != "" {
+			shutdownOnDisconnect = labplans.EnableStateEnabled
+			output.DisconnectDelay = utils.String(v.DisconnectDelay)
+		}
+
+		if v.NoConnectDelay != "" {
+			shutdownWhenNotConnected = labplans.EnableStateEnabled
+			output.NoConnectDelay = utils.String(v.NoConnectDelay)
+		}
+
+		if v.ShutdownOnIdle != "" {
+			shutdownOnIdle = labplans.ShutdownOnIdleMode(v.ShutdownOnIdle)
+			if v.IdleDelay != "" {
+				output.IdleDelay = utils.String(v.IdleDelay)
+			}
+		}
+	}
+
+	output.ShutdownOnDisconnect = &shutdownOnDisconnect
+	output.ShutdownOnIdle = &shutdownOnIdle
+	output.ShutdownWhenNotConnected = &shutdownWhenNotConnected
+
+	return &output
+}
+
+func flattenLabServicePlanAutoShutdown(input *labplans.AutoShutdownProfile) []AutoShutdown {
+	if input == nil {
+		return []AutoShutdown{}
+	}
+
+	output := AutoShutdown{}
+	if input.ShutdownOnDisconnect != nil && *input.ShutdownOnDisconnect == labplans.EnableStateEnabled {
+		output.DisconnectDelay = utils.NormalizeNilableString(input.DisconnectDelay)
+	}
+
+	if input.ShutdownWhenNotConnected != nil && *input.ShutdownWhenNotConnected == labplans.EnableStateEnabled {
+		output.NoConnectDelay = utils.NormalizeNilableString(input.NoConnectDelay)
+	}
+
+	if input.ShutdownOnIdle != nil && *input.ShutdownOnIdle != labplans.ShutdownOnIdleModeNone {
+		output.ShutdownOnIdle = string(*input.ShutdownOnIdle)
+		output.IdleDelay = utils.NormalizeNilableString(input.IdleDelay)
+	}
+
+	if output == (AutoShutdown{}) {
+		return []AutoShutdown{}
+	}
+
+	return []AutoShutdown{
+		output,
+	}
+}
+
+func expandLabServicePlanDefaultConnection(input []DefaultConnection) *labplans.ConnectionProfile {
+	clientRdpAccess := labplans.ConnectionTypeNone
+	clientSshAccess := labplans.ConnectionTypeNone
+	webRdpAccess := labplans.ConnectionTypeNone
+	webSshAccess := labplans.ConnectionTypeNone
+
+	if len(input) > 0 {
+		v := input[0]
+		clientRdpAccess = labplans.ConnectionType(v.ClientRdpAccess)
+		clientSshAccess = labplans.ConnectionType(v.ClientSshAccess)
+		webRdpAccess = labplans.ConnectionType(v.WebRdpAccess)
+		webSshAccess = labplans.ConnectionType(v.WebSshAccess)
+	}
+
+	return &labplans.ConnectionProfile{
+		ClientRdpAccess: &clientRdpAccess,
+		ClientSshAccess: &clientSshAccess,
+		WebRdpAccess:    &webRdpAccess,
+		WebSshAccess:    &webSshAccess,
+	}
+}
+
+func flattenLabServicePlanDefaultConnection(input *labplans.ConnectionProfile) []DefaultConnection {
+	if input == nil {
+		return []DefaultConnection{}
+	}
+
+	output := DefaultConnection{
+		ClientRdpAccess: string(labplans.ConnectionTypeNone),
+		ClientSshAccess: string(labplans.ConnectionTypeNone),
+		WebRdpAccess:    string(labplans.ConnectionTypeNone),
+		WebSshAccess:    string(labplans.ConnectionTypeNone),
+	}
+	if input.ClientRdpAccess != nil {
+		output.ClientRdpAccess = string(*input.ClientRdpAccess)
+	}
+	if input.ClientSshAccess != nil {
+		output.ClientSshAccess = string(*input.ClientSshAccess)
+	}
+	if input.WebRdpAccess != nil {
+		output.WebRdpAccess = string(*input.WebRdpAccess)
+	}
+	if input.WebSshAccess != nil {
+		output.WebSshAccess = string(*input.WebSshAccess)
+	}
+
+	none := string(labplans.ConnectionTypeNone)
+	if output.ClientRdpAccess == none && output.ClientSshAccess == none && output.WebRdpAccess == none && output.WebSshAccess == none {
+		return []DefaultConnection{}
+	}
+
+	return []DefaultConnection{
+		output,
+	}
+}
+
+func expandLabServicePlanSupport(input []LabServicePlanSupport) *labplans.SupportInfo {
+	output := labplans.SupportInfo{}
+	if len(input) == 0 {
+		return &output
+	}
+
+	v := input[0]
+	if v.Email != "" {
+		output.Email = utils.String(v.Email)
+	}
+	if v.Instructions != "" {
+		output.Instructions = utils.String(v.Instructions)
+	}
+	if v.Phone != "" {
+		output.Phone = utils.String(v.Phone)
+	}
+	if v.Url != "" {
+		output.Url = utils.String(v.Url)
+	}
+
+	return &output
+}
+
+func flattenLabServicePlanSupport(input *labplans.SupportInfo) []LabServicePlanSupport {
+	if input == nil {
+		return []LabServicePlanSupport{}
+	}
+
+	output := LabServicePlanSupport{
+		Email:        utils.NormalizeNilableString(input.Email),
+		Instructions: utils.NormalizeNilableString(input.Instructions),
+		Phone:        utils.NormalizeNilableString(input.Phone),
+		Url:          utils.NormalizeNilableString(input.Url),
+	}
+	if output == (LabServicePlanSupport{}) {
+		return []LabServicePlanSupport{}
+	}
+
+	return []LabServicePlanSupport{
+		output,
+	}
+}
@@ -0,0 +1,183 @@
+package labservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServicePlanResource struct{}
+
+func TestAccLabServicePlan_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_plan", "test")
+	r := LabServicePlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLabServicePlan_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_plan", "test")
+	r := LabServicePlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLabServicePlan_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_plan", "test")
+	r := LabServicePlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLabServicePlan_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_plan", "test")
+	r := LabServicePlanResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (LabServicePlanResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := labplans.ParseLabPlanID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LabService.LabPlansClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LabServicePlanResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-lsp-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r LabServicePlanResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_plan" "test" {
+  name                = "acctest-lsp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  allowed_regions     = [azurerm_resource_group.test.location]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r LabServicePlanResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_plan" "import" {
+  name                = azurerm_lab_service_plan.test.name
+  resource_group_name = azurerm_lab_service_plan.test.resource_group_name
+  location            = azurerm_lab_service_plan.test.location
+  allowed_regions     = azurerm_lab_service_plan.test.allowed_regions
+}
+`, r.basic(data))
+}
+
+func (r LabServicePlanResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_plan" "test" {
+  name                = "acctest-lsp-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  allowed_regions     = [azurerm_resource_group.test.location]
+
+  default_auto_shutdown {
+    disconnect_delay = "PT15M"
+    idle_delay       = "PT15M"
+    no_connect_delay = "PT15M"
+    shutdown_on_idle = "UserAbsence"
+  }
+
+  default_connection {
+    client_rdp_access = "Public"
+    client_ssh_access = "Public"
+  }
+
+  support {
+    email        = "company@terraform.io"
+    instructions = "Contact support for help"
+    phone        = "+1-555-555-5555"
+    url          = "https://www.terraform.io/"
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
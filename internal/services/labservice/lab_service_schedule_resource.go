@@ -0,0 +1,337 @@
+package labservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/schedules"
+	labValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServiceScheduleResource struct{}
+
+var _ sdk.ResourceWithUpdate = LabServiceScheduleResource{}
+
+type LabServiceScheduleResourceModel struct {
+	Name       string       `tfschema:"name"`
+	LabId      string       `tfschema:"lab_id"`
+	StopTime   string       `tfschema:"stop_time"`
+	TimeZone   string       `tfschema:"time_zone"`
+	Notes      string       `tfschema:"notes"`
+	Recurrence []Recurrence `tfschema:"recurrence"`
+	StartTime  string       `tfschema:"start_time"`
+}
+
+type Recurrence struct {
+	ExpirationDate string   `tfschema:"expiration_date"`
+	Frequency      string   `tfschema:"frequency"`
+	Interval       int64    `tfschema:"interval"`
+	WeekDays       []string `tfschema:"week_days"`
+}
+
+func (r LabServiceScheduleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: labValidate.LabServiceName,
+		},
+
+		"lab_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: labs.ValidateLabID,
+		},
+
+		"stop_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"time_zone": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"notes": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"recurrence": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expiration_date": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"frequency": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(schedules.PossibleValuesForRecurrenceFrequency(), false),
+					},
+
+					"interval": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 365),
+					},
+
+					"week_days": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice(schedules.PossibleValuesForWeekDay(), false),
+						},
+					},
+				},
+			},
+		},
+
+		"start_time": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+	}
+}
+
+func (r LabServiceScheduleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LabServiceScheduleResource) ModelObject() interface{} {
+	return &LabServiceScheduleResourceModel{}
+}
+
+func (r LabServiceScheduleResource) ResourceType() string {
+	return "azurerm_lab_service_schedule"
+}
+
+func (r LabServiceScheduleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return schedules.ValidateScheduleID
+}
+
+func (r LabServiceScheduleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.SchedulesClient
+
+			var model LabServiceScheduleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			labId, err := labs.ParseLabID(model.LabId)
+			if err != nil {
+				return err
+			}
+
+			id := schedules.NewScheduleID(labId.SubscriptionId, labId.ResourceGroupName, labId.LabName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := schedules.Schedule{
+				Properties: schedules.ScheduleProperties{
+					RecurrencePattern: expandLabServiceScheduleRecurrence(model.Recurrence),
+					StopAt:            model.StopTime,
+					TimeZoneId:        model.TimeZone,
+				},
+			}
+
+			if model.Notes != "" {
+				payload.Properties.Notes = utils.String(model.Notes)
+			}
+
+			if model.StartTime != "" {
+				payload.Properties.StartAt = utils.String(model.StartTime)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LabServiceScheduleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.SchedulesClient
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LabServiceScheduleResourceModel{
+				Name:  id.ScheduleName,
+				LabId: labs.NewLabID(id.SubscriptionId, id.ResourceGroupName, id.LabName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.Notes = utils.NormalizeNilableString(props.Notes)
+				state.Recurrence = flattenLabServiceScheduleRecurrence(props.RecurrencePattern)
+				state.StartTime = utils.NormalizeNilableString(props.StartAt)
+				state.StopTime = props.StopAt
+				state.TimeZone = props.TimeZoneId
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LabServiceScheduleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.SchedulesClient
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LabServiceScheduleResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := schedules.ScheduleUpdate{
+				Properties: &schedules.ScheduleUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("notes") {
+				payload.Properties.Notes = utils.String(model.Notes)
+			}
+
+			if metadata.ResourceData.HasChange("recurrence") {
+				payload.Properties.RecurrencePattern = expandLabServiceScheduleRecurrence(model.Recurrence)
+			}
+
+			if metadata.ResourceData.HasChange("start_time") {
+				payload.Properties.StartAt = utils.String(model.StartTime)
+			}
+
+			if metadata.ResourceData.HasChange("stop_time") {
+				payload.Properties.StopAt = utils.String(model.StopTime)
+			}
+
+			if metadata.ResourceData.HasChange("time_zone") {
+				payload.Properties.TimeZoneId = utils.String(model.TimeZone)
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LabServiceScheduleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.SchedulesClient
+
+			id, err := schedules.ParseScheduleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandLabServiceScheduleRecurrence(input []Recurrence) *schedules.RecurrencePattern {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := schedules.RecurrencePattern{
+		ExpirationDate: v.ExpirationDate,
+		Frequency:      schedules.RecurrenceFrequency(v.Frequency),
+	}
+
+	if v.Interval != 0 {
+		output.Interval = utils.Int64(v.Interval)
+	}
+
+	if len(v.WeekDays) > 0 {
+		weekDays := make([]schedules.WeekDay, 0)
+		for _, weekDay := range v.WeekDays {
+			weekDays = append(weekDays, schedules.WeekDay(weekDay))
+		}
+		output.WeekDays = &weekDays
+	}
+
+	return &output
+}
+
+func flattenLabServiceScheduleRecurrence(input *schedules.RecurrencePattern) []Recurrence {
+	if input == nil {
+		return []Recurrence{}
+	}
+
+	output := Recurrence{
+		ExpirationDate: input.ExpirationDate,
+		Frequency:      string(input.Frequency),
+		Interval:       utils.NormaliseNilableInt64(input.Interval),
+	}
+
+	if input.WeekDays != nil {
+		for _, weekDay := range *input.WeekDays {
+			output.WeekDays = append(output.WeekDays, string(weekDay))
+		}
+	}
+
+	return []Recurrence{
+		output,
+	}
+}
@@ -0,0 +1,156 @@
+package labservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/schedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServiceScheduleResource struct{}
+
+func TestAccLabServiceSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_schedule", "test")
+	r := LabServiceScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLabServiceSchedule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_schedule", "test")
+	r := LabServiceScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLabServiceSchedule_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_schedule", "test")
+	r := LabServiceScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLabServiceSchedule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_schedule", "test")
+	r := LabServiceScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (LabServiceScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := schedules.ParseScheduleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LabService.SchedulesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LabServiceScheduleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_schedule" "test" {
+  name      = "acctest-lss-%d"
+  lab_id    = azurerm_lab_service_lab.test.id
+  stop_time = "2030-11-29T06:00:00Z"
+  time_zone = "America/Los_Angeles"
+}
+`, LabServiceLabResource{}.basic(data), data.RandomInteger)
+}
+
+func (r LabServiceScheduleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_schedule" "import" {
+  name      = azurerm_lab_service_schedule.test.name
+  lab_id    = azurerm_lab_service_schedule.test.lab_id
+  stop_time = azurerm_lab_service_schedule.test.stop_time
+  time_zone = azurerm_lab_service_schedule.test.time_zone
+}
+`, r.basic(data))
+}
+
+func (r LabServiceScheduleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_schedule" "test" {
+  name       = "acctest-lss-%d"
+  lab_id     = azurerm_lab_service_lab.test.id
+  start_time = "2030-11-28T06:00:00Z"
+  stop_time  = "2030-11-29T06:00:00Z"
+  time_zone  = "America/Los_Angeles"
+  notes      = "Testing"
+
+  recurrence {
+    expiration_date = "2030-12-29T06:00:00Z"
+    frequency       = "Weekly"
+    interval        = 1
+    week_days       = ["Monday", "Tuesday"]
+  }
+}
+`, LabServiceLabResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,205 @@
+package labservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/labs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/users"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServiceUserResource struct{}
+
+var _ sdk.ResourceWithUpdate = LabServiceUserResource{}
+
+type LabServiceUserResourceModel struct {
+	Name                 string `tfschema:"name"`
+	LabId                string `tfschema:"lab_id"`
+	Email                string `tfschema:"email"`
+	AdditionalUsageQuota string `tfschema:"additional_usage_quota"`
+}
+
+func (r LabServiceUserResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"lab_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: labs.ValidateLabID,
+		},
+
+		"email": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// the number of hours this user can use their Virtual Machine in addition to the usage quota of the Lab
+		"additional_usage_quota": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "PT0S",
+			ValidateFunc: validate.ISO8601Duration,
+		},
+	}
+}
+
+func (r LabServiceUserResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LabServiceUserResource) ModelObject() interface{} {
+	return &LabServiceUserResourceModel{}
+}
+
+func (r LabServiceUserResource) ResourceType() string {
+	return "azurerm_lab_service_user"
+}
+
+func (r LabServiceUserResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return users.ValidateUserID
+}
+
+func (r LabServiceUserResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.UsersClient
+
+			var model LabServiceUserResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			labId, err := labs.ParseLabID(model.LabId)
+			if err != nil {
+				return err
+			}
+
+			id := users.NewUserID(labId.SubscriptionId, labId.ResourceGroupName, labId.LabName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := users.User{
+				Properties: users.UserProperties{
+					AdditionalUsageQuota: utils.String(model.AdditionalUsageQuota),
+					Email:                model.Email,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LabServiceUserResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.UsersClient
+
+			id, err := users.ParseUserID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := LabServiceUserResourceModel{
+				Name:  id.UserName,
+				LabId: labs.NewLabID(id.SubscriptionId, id.ResourceGroupName, id.LabName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.AdditionalUsageQuota = utils.NormalizeNilableString(model.Properties.AdditionalUsageQuota)
+				state.Email = model.Properties.Email
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LabServiceUserResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.UsersClient
+
+			id, err := users.ParseUserID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LabServiceUserResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := users.UserUpdate{
+				Properties: &users.UserUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("additional_usage_quota") {
+				payload.Properties.AdditionalUsageQuota = utils.String(model.AdditionalUsageQuota)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LabServiceUserResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.LabService.UsersClient
+
+			id, err := users.ParseUserID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
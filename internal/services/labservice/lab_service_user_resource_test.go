@@ -0,0 +1,145 @@
+package labservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/labservice/sdk/2022-08-01/users"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LabServiceUserResource struct{}
+
+func TestAccLabServiceUser_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_user", "test")
+	r := LabServiceUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLabServiceUser_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_user", "test")
+	r := LabServiceUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLabServiceUser_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_user", "test")
+	r := LabServiceUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLabServiceUser_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lab_service_user", "test")
+	r := LabServiceUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (LabServiceUserResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := users.ParseUserID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.LabService.UsersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LabServiceUserResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_user" "test" {
+  name   = "acctest-lsu-%d"
+  lab_id = azurerm_lab_service_lab.test.id
+  email  = "terraform-acctest@hashicorp.com"
+}
+`, LabServiceLabResource{}.basic(data), data.RandomInteger)
+}
+
+func (r LabServiceUserResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_user" "import" {
+  name   = azurerm_lab_service_user.test.name
+  lab_id = azurerm_lab_service_user.test.lab_id
+  email  = azurerm_lab_service_user.test.email
+}
+`, r.basic(data))
+}
+
+func (r LabServiceUserResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_lab_service_user" "test" {
+  name                   = "acctest-lsu-%d"
+  lab_id                 = azurerm_lab_service_lab.test.id
+  email                  = "terraform-acctest@hashicorp.com"
+  additional_usage_quota = "PT10H"
+}
+`, LabServiceLabResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,15 @@
+package images
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ImagesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewImagesClientWithBaseURI(endpoint string) ImagesClient {
+	return ImagesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
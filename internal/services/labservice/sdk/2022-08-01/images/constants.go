@@ -0,0 +1,127 @@
+package images
+
+import "strings"
+
+type EnableState string
+
+const (
+	EnableStateDisabled EnableState = "Disabled"
+	EnableStateEnabled  EnableState = "Enabled"
+)
+
+func PossibleValuesForEnableState() []string {
+	return []string{
+		string(EnableStateDisabled),
+		string(EnableStateEnabled),
+	}
+}
+
+func parseEnableState(input string) (*EnableState, error) {
+	vals := map[string]EnableState{
+		"disabled": EnableStateDisabled,
+		"enabled":  EnableStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EnableState(input)
+	return &out, nil
+}
+
+type OsState string
+
+const (
+	OsStateGeneralized OsState = "Generalized"
+	OsStateSpecialized OsState = "Specialized"
+)
+
+func PossibleValuesForOsState() []string {
+	return []string{
+		string(OsStateGeneralized),
+		string(OsStateSpecialized),
+	}
+}
+
+func parseOsState(input string) (*OsState, error) {
+	vals := map[string]OsState{
+		"generalized": OsStateGeneralized,
+		"specialized": OsStateSpecialized,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OsState(input)
+	return &out, nil
+}
+
+type OsType string
+
+const (
+	OsTypeLinux   OsType = "Linux"
+	OsTypeWindows OsType = "Windows"
+)
+
+func PossibleValuesForOsType() []string {
+	return []string{
+		string(OsTypeLinux),
+		string(OsTypeWindows),
+	}
+}
+
+func parseOsType(input string) (*OsType, error) {
+	vals := map[string]OsType{
+		"linux":   OsTypeLinux,
+		"windows": OsTypeWindows,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OsType(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateLocked    ProvisioningState = "Locked"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateLocked),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"locked":    ProvisioningStateLocked,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
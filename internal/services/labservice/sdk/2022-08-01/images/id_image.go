@@ -0,0 +1,137 @@
+package images
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ImageId{}
+
+// ImageId is a struct representing the Resource ID for a Image
+type ImageId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	LabPlanName       string
+	ImageName         string
+}
+
+// NewImageID returns a new ImageId struct
+func NewImageID(subscriptionId string, resourceGroupName string, labPlanName string, imageName string) ImageId {
+	return ImageId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		LabPlanName:       labPlanName,
+		ImageName:         imageName,
+	}
+}
+
+// ParseImageID parses 'input' into a ImageId
+func ParseImageID(input string) (*ImageId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ImageId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ImageId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LabPlanName, ok = parsed.Parsed["labPlanName"]; !ok {
+		return nil, fmt.Errorf("the segment 'labPlanName' was not found in the resource id %q", input)
+	}
+
+	if id.ImageName, ok = parsed.Parsed["imageName"]; !ok {
+		return nil, fmt.Errorf("the segment 'imageName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseImageIDInsensitively parses 'input' case-insensitively into a ImageId
+// note: this method should only be used for API response data and not user input
+func ParseImageIDInsensitively(input string) (*ImageId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ImageId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ImageId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LabPlanName, ok = parsed.Parsed["labPlanName"]; !ok {
+		return nil, fmt.Errorf("the segment 'labPlanName' was not found in the resource id %q", input)
+	}
+
+	if id.ImageName, ok = parsed.Parsed["imageName"]; !ok {
+		return nil, fmt.Errorf("the segment 'imageName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateImageID checks that 'input' can be parsed as a Image ID
+func ValidateImageID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseImageID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Image ID
+func (id ImageId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.LabServices/labPlans/%s/images/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.LabPlanName, id.ImageName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Image ID
+func (id ImageId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftLabServices", "Microsoft.LabServices", "Microsoft.LabServices"),
+		resourceids.StaticSegment("staticLabPlans", "labPlans", "labPlans"),
+		resourceids.UserSpecifiedSegment("labPlanName", "labPlanValue"),
+		resourceids.StaticSegment("staticImages", "images", "images"),
+		resourceids.UserSpecifiedSegment("imageName", "imageValue"),
+	}
+}
+
+// String returns a human-readable description of this Image ID
+func (id ImageId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Lab Plan Name: %q", id.LabPlanName),
+		fmt.Sprintf("Image Name: %q", id.ImageName),
+	}
+	return fmt.Sprintf("Image (%s)", strings.Join(components, "\n"))
+}
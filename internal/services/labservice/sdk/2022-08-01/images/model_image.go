@@ -0,0 +1,8 @@
+package images
+
+type Image struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *ImageProperties `json:"properties,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
@@ -0,0 +1,5 @@
+package images
+
+type ImageUpdate struct {
+	Properties *ImageUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,5 @@
+package images
+
+type ImageUpdateProperties struct {
+	EnabledState *EnableState `json:"enabledState,omitempty"`
+}
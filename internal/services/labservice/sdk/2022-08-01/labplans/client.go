@@ -0,0 +1,15 @@
+package labplans
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LabPlansClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLabPlansClientWithBaseURI(endpoint string) LabPlansClient {
+	return LabPlansClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,5 @@
+package labplans
+
+type LabPlanNetworkProfile struct {
+	SubnetId *string `json:"subnetId,omitempty"`
+}
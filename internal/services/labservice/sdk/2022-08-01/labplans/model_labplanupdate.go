@@ -0,0 +1,6 @@
+package labplans
+
+type LabPlanUpdate struct {
+	Properties *LabPlanUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+}
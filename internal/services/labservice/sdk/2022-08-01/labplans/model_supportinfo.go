@@ -0,0 +1,8 @@
+package labplans
+
+type SupportInfo struct {
+	Email        *string `json:"email,omitempty"`
+	Instructions *string `json:"instructions,omitempty"`
+	Phone        *string `json:"phone,omitempty"`
+	Url          *string `json:"url,omitempty"`
+}
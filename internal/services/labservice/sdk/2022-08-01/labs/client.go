@@ -0,0 +1,15 @@
+package labs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LabsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLabsClientWithBaseURI(endpoint string) LabsClient {
+	return LabsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
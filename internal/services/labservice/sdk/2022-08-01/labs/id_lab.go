@@ -0,0 +1,124 @@
+package labs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = LabId{}
+
+// LabId is a struct representing the Resource ID for a Lab
+type LabId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	LabName           string
+}
+
+// NewLabID returns a new LabId struct
+func NewLabID(subscriptionId string, resourceGroupName string, labName string) LabId {
+	return LabId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		LabName:           labName,
+	}
+}
+
+// ParseLabID parses 'input' into a LabId
+func ParseLabID(input string) (*LabId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LabId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LabId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LabName, ok = parsed.Parsed["labName"]; !ok {
+		return nil, fmt.Errorf("the segment 'labName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseLabIDInsensitively parses 'input' case-insensitively into a LabId
+// note: this method should only be used for API response data and not user input
+func ParseLabIDInsensitively(input string) (*LabId, error) {
+	parser := resourceids.NewParserFromResourceIdType(LabId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := LabId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.LabName, ok = parsed.Parsed["labName"]; !ok {
+		return nil, fmt.Errorf("the segment 'labName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateLabID checks that 'input' can be parsed as a Lab ID
+func ValidateLabID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseLabID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Lab ID
+func (id LabId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.LabServices/labs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.LabName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Lab ID
+func (id LabId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftLabServices", "Microsoft.LabServices", "Microsoft.LabServices"),
+		resourceids.StaticSegment("staticLabs", "labs", "labs"),
+		resourceids.UserSpecifiedSegment("labName", "labValue"),
+	}
+}
+
+// String returns a human-readable description of this Lab ID
+func (id LabId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Lab Name: %q", id.LabName),
+	}
+	return fmt.Sprintf("Lab (%s)", strings.Join(components, "\n"))
+}
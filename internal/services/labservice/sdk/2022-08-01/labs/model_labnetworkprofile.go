@@ -0,0 +1,7 @@
+package labs
+
+type LabNetworkProfile struct {
+	LoadBalancerId *string `json:"loadBalancerId,omitempty"`
+	PublicIPId     *string `json:"publicIpId,omitempty"`
+	SubnetId       *string `json:"subnetId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package labs
+
+type LabUpdate struct {
+	Properties *LabUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string   `json:"tags,omitempty"`
+}
@@ -0,0 +1,5 @@
+package labs
+
+type VirtualMachineAdditionalCapabilities struct {
+	InstallGpuDrivers *EnableState `json:"installGpuDrivers,omitempty"`
+}
@@ -0,0 +1,9 @@
+package labs
+
+import "fmt"
+
+const defaultApiVersion = "2022-08-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/labs/%s", defaultApiVersion)
+}
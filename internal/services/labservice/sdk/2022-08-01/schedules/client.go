@@ -0,0 +1,15 @@
+package schedules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SchedulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSchedulesClientWithBaseURI(endpoint string) SchedulesClient {
+	return SchedulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,114 @@
+package schedules
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateLocked    ProvisioningState = "Locked"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateLocked),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"locked":    ProvisioningStateLocked,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily  RecurrenceFrequency = "Daily"
+	RecurrenceFrequencyWeekly RecurrenceFrequency = "Weekly"
+)
+
+func PossibleValuesForRecurrenceFrequency() []string {
+	return []string{
+		string(RecurrenceFrequencyDaily),
+		string(RecurrenceFrequencyWeekly),
+	}
+}
+
+func parseRecurrenceFrequency(input string) (*RecurrenceFrequency, error) {
+	vals := map[string]RecurrenceFrequency{
+		"daily":  RecurrenceFrequencyDaily,
+		"weekly": RecurrenceFrequencyWeekly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := RecurrenceFrequency(input)
+	return &out, nil
+}
+
+type WeekDay string
+
+const (
+	WeekDayFriday    WeekDay = "Friday"
+	WeekDayMonday    WeekDay = "Monday"
+	WeekDaySaturday  WeekDay = "Saturday"
+	WeekDaySunday    WeekDay = "Sunday"
+	WeekDayThursday  WeekDay = "Thursday"
+	WeekDayTuesday   WeekDay = "Tuesday"
+	WeekDayWednesday WeekDay = "Wednesday"
+)
+
+func PossibleValuesForWeekDay() []string {
+	return []string{
+		string(WeekDayFriday),
+		string(WeekDayMonday),
+		string(WeekDaySaturday),
+		string(WeekDaySunday),
+		string(WeekDayThursday),
+		string(WeekDayTuesday),
+		string(WeekDayWednesday),
+	}
+}
+
+func parseWeekDay(input string) (*WeekDay, error) {
+	vals := map[string]WeekDay{
+		"friday":    WeekDayFriday,
+		"monday":    WeekDayMonday,
+		"saturday":  WeekDaySaturday,
+		"sunday":    WeekDaySunday,
+		"thursday":  WeekDayThursday,
+		"tuesday":   WeekDayTuesday,
+		"wednesday": WeekDayWednesday,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WeekDay(input)
+	return &out, nil
+}
@@ -0,0 +1,8 @@
+package schedules
+
+type Schedule struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties ScheduleProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,10 @@
+package schedules
+
+type ScheduleProperties struct {
+	Notes             *string            `json:"notes,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	RecurrencePattern *RecurrencePattern `json:"recurrencePattern,omitempty"`
+	StartAt           *string            `json:"startAt,omitempty"`
+	StopAt            string             `json:"stopAt"`
+	TimeZoneId        string             `json:"timeZoneId"`
+}
@@ -0,0 +1,5 @@
+package schedules
+
+type ScheduleUpdate struct {
+	Properties *ScheduleUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,9 @@
+package schedules
+
+type ScheduleUpdateProperties struct {
+	Notes             *string            `json:"notes,omitempty"`
+	RecurrencePattern *RecurrencePattern `json:"recurrencePattern,omitempty"`
+	StartAt           *string            `json:"startAt,omitempty"`
+	StopAt            *string            `json:"stopAt,omitempty"`
+	TimeZoneId        *string            `json:"timeZoneId,omitempty"`
+}
@@ -0,0 +1,15 @@
+package users
+
+import "github.com/Azure/go-autorest/autorest"
+
+type UsersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewUsersClientWithBaseURI(endpoint string) UsersClient {
+	return UsersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package users
+
+type User struct {
+	Id         *string        `json:"id,omitempty"`
+	Name       *string        `json:"name,omitempty"`
+	Properties UserProperties `json:"properties,omitempty"`
+	Type       *string        `json:"type,omitempty"`
+}
@@ -0,0 +1,12 @@
+package users
+
+type UserProperties struct {
+	AdditionalUsageQuota *string            `json:"additionalUsageQuota,omitempty"`
+	DisplayName          *string            `json:"displayName,omitempty"`
+	Email                string             `json:"email"`
+	InvitationSent       *string            `json:"invitationSent,omitempty"`
+	InvitationState      *InvitationState   `json:"invitationState,omitempty"`
+	ProvisioningState    *ProvisioningState `json:"provisioningState,omitempty"`
+	RegistrationState    *RegistrationState `json:"registrationState,omitempty"`
+	TotalUsage           *string            `json:"totalUsage,omitempty"`
+}
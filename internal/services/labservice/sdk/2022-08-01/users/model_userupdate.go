@@ -0,0 +1,5 @@
+package users
+
+type UserUpdate struct {
+	Properties *UserUpdateProperties `json:"properties,omitempty"`
+}
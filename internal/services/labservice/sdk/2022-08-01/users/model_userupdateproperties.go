@@ -0,0 +1,5 @@
+package users
+
+type UserUpdateProperties struct {
+	AdditionalUsageQuota *string `json:"additionalUsageQuota,omitempty"`
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// LabServiceName validates the name of a Lab Plan, Lab or Schedule
+func LabServiceName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[-\w.()]{1,100}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 100 characters and can only contain letters, numbers, hyphens, underscores, periods and parentheses, got %q", k, v))
+	}
+
+	return
+}
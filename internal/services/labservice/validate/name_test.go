@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLabServiceName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "a",
+			expected: true,
+		},
+		{
+			input:    "acctest-lab_1.(2)",
+			expected: true,
+		},
+		{
+			input:    "acctest lab",
+			expected: false,
+		},
+		{
+			input:    "acctest/lab",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("a", 100),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("a", 101),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := LabServiceName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t for %q", v.expected, actual, v.input)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2024-05-01/loadtestadministration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2024-05-01/loadtestrun"
+)
+
+// dataPlaneTokenEndpoint is the audience used to obtain tokens for the Load Testing data plane, which is shared
+// between all Load Tests rather than being specific to the data plane URI of each one
+const dataPlaneTokenEndpoint = "https://cnt-prod.loadtesting.azure.com"
+
+type Client struct {
+	LoadTestsClient     *loadtests.LoadTestsClient
+	tokenFunc           func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc func(c *autorest.Client, authorizer autorest.Authorizer)
+}
+
+func (c Client) dataPlaneEndpoint(ctx context.Context, loadTestId loadtests.LoadTestId) (*string, autorest.Authorizer, error) {
+	resp, err := c.LoadTestsClient.Get(ctx, loadTestId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving %s: %+v", loadTestId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.DataPlaneURI == nil {
+		return nil, nil, fmt.Errorf("retrieving %s: `properties.dataPlaneURI` was nil", loadTestId)
+	}
+
+	auth, err := c.tokenFunc(dataPlaneTokenEndpoint)
+	if err != nil {
+		return nil, nil, fmt.Errorf("obtaining auth token for %q: %+v", dataPlaneTokenEndpoint, err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s", *resp.Model.Properties.DataPlaneURI)
+	return &endpoint, auth, nil
+}
+
+// AdministrationClient returns a client for managing the Tests within the specified Load Test
+func (c Client) AdministrationClient(ctx context.Context, loadTestId loadtests.LoadTestId) (*loadtestadministration.LoadTestAdministrationClient, error) {
+	endpoint, auth, err := c.dataPlaneEndpoint(ctx, loadTestId)
+	if err != nil {
+		return nil, err
+	}
+
+	client := loadtestadministration.NewLoadTestAdministrationClientWithBaseURI(*endpoint)
+	c.configureClientFunc(&client.Client, auth)
+	return &client, nil
+}
+
+// TestRunClient returns a client for managing the Test Runs within the specified Load Test
+func (c Client) TestRunClient(ctx context.Context, loadTestId loadtests.LoadTestId) (*loadtestrun.LoadTestRunClient, error) {
+	endpoint, auth, err := c.dataPlaneEndpoint(ctx, loadTestId)
+	if err != nil {
+		return nil, err
+	}
+
+	client := loadtestrun.NewLoadTestRunClientWithBaseURI(*endpoint)
+	c.configureClientFunc(&client.Client, auth)
+	return &client, nil
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	loadTestsClient := loadtests.NewLoadTestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&loadTestsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		LoadTestsClient:     &loadTestsClient,
+		tokenFunc:           o.TokenFunc,
+		configureClientFunc: o.ConfigureClient,
+	}
+}
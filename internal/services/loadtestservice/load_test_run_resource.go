@@ -0,0 +1,390 @@
+package loadtestservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2024-05-01/loadtestrun"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LoadTestRunResource struct{}
+
+var _ sdk.Resource = LoadTestRunResource{}
+
+type LoadTestRunResourceModel struct {
+	LoadTestTestId    string            `tfschema:"load_test_test_id"`
+	Name              string            `tfschema:"name"`
+	DisplayName       string            `tfschema:"display_name"`
+	Description       string            `tfschema:"description"`
+	Triggers          map[string]string `tfschema:"triggers"`
+	WaitForCompletion bool              `tfschema:"wait_for_completion"`
+	FailOnTestFailure bool              `tfschema:"fail_on_test_failure"`
+	Status            string            `tfschema:"status"`
+	TestResult        string            `tfschema:"test_result"`
+	StartTime         string            `tfschema:"start_time"`
+	EndTime           string            `tfschema:"end_time"`
+	VirtualUsers      int64             `tfschema:"virtual_users"`
+	PortalUrl         string            `tfschema:"portal_url"`
+}
+
+func (r LoadTestRunResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"load_test_test_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.LoadTestTestID,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9_-]{2,50}$`),
+				"`name` must be between 2 and 50 characters and can only contain lowercase letters, numbers, underscores and hyphens",
+			),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(2, 50),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 100),
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"wait_for_completion": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"fail_on_test_failure": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (r LoadTestRunResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"test_result": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"end_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"virtual_users": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"portal_url": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r LoadTestRunResource) ModelObject() interface{} {
+	return &LoadTestRunResourceModel{}
+}
+
+func (r LoadTestRunResource) ResourceType() string {
+	return "azurerm_load_test_run"
+}
+
+func (r LoadTestRunResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.LoadTestRunID
+}
+
+func (r LoadTestRunResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 6 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LoadTestRunResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			testId, err := parse.LoadTestTestID(model.LoadTestTestId)
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(testId.SubscriptionId, testId.ResourceGroup, testId.LoadTestName)
+			client, err := metadata.Client.LoadTestService.TestRunClient(ctx, loadTestId)
+			if err != nil {
+				return fmt.Errorf("building data plane client for %s: %+v", loadTestId, err)
+			}
+
+			id := parse.NewLoadTestRunID(testId.SubscriptionId, testId.ResourceGroup, testId.LoadTestName, model.Name)
+			testRunId := loadtestrun.NewTestRunID(id.TestRunName)
+
+			existing, err := client.GetTestRun(ctx, testRunId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := loadtestrun.TestRun{
+				TestId: utils.String(testId.TestName),
+			}
+			if model.DisplayName != "" {
+				payload.DisplayName = utils.String(model.DisplayName)
+			}
+			if model.Description != "" {
+				payload.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdateTestRun(ctx, testRunId, payload); err != nil {
+				return fmt.Errorf("starting %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if !model.WaitForCompletion {
+				return nil
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			log.Printf("[DEBUG] waiting for %s to complete", id)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending: []string{"Running"},
+				Target:  []string{"Completed"},
+				Refresh: loadTestRunCompletionRefreshFunc(ctx, client, testRunId),
+				// test runs take at least a few minutes to provision the engines, so there's no point polling frequently
+				MinTimeout: 30 * time.Second,
+				Timeout:    time.Until(deadline),
+			}
+			result, err := stateConf.WaitForStateContext(ctx)
+			if err != nil {
+				return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+			}
+
+			testRun := result.(loadtestrun.GetTestRunResponse).Model
+			if testRun == nil {
+				return nil
+			}
+
+			if testRun.Status != nil {
+				switch *testRun.Status {
+				case loadtestrun.StatusFAILED, loadtestrun.StatusVALIDATIONFAILURE:
+					return fmt.Errorf("%s completed with the status %q: %s", id, string(*testRun.Status), flattenLoadTestRunErrorDetails(testRun.ErrorDetails))
+				}
+			}
+
+			if model.FailOnTestFailure && testRun.TestResult != nil && *testRun.TestResult == loadtestrun.PFTestResultFAILED {
+				return fmt.Errorf("%s completed but didn't meet the pass/fail criteria of the Test - the results are available at %s", id, utils.NormalizeNilableString(testRun.PortalUrl))
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LoadTestRunResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.LoadTestRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+			client, err := metadata.Client.LoadTestService.TestRunClient(ctx, loadTestId)
+			if err != nil {
+				return fmt.Errorf("building data plane client for %s: %+v", loadTestId, err)
+			}
+
+			resp, err := client.GetTestRun(ctx, loadtestrun.NewTestRunID(id.TestRunName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state LoadTestRunResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.Name = id.TestRunName
+
+			if model := resp.Model; model != nil {
+				if model.TestId != nil {
+					state.LoadTestTestId = parse.NewLoadTestTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName, *model.TestId).ID()
+				}
+				state.DisplayName = utils.NormalizeNilableString(model.DisplayName)
+				state.Description = utils.NormalizeNilableString(model.Description)
+				state.StartTime = utils.NormalizeNilableString(model.StartDateTime)
+				state.EndTime = utils.NormalizeNilableString(model.EndDateTime)
+				state.PortalUrl = utils.NormalizeNilableString(model.PortalUrl)
+
+				state.Status = ""
+				if model.Status != nil {
+					state.Status = string(*model.Status)
+				}
+
+				state.TestResult = ""
+				if model.TestResult != nil {
+					state.TestResult = string(*model.TestResult)
+				}
+
+				state.VirtualUsers = 0
+				if model.VirtualUsers != nil {
+					state.VirtualUsers = *model.VirtualUsers
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LoadTestRunResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.LoadTestRunID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+			client, err := metadata.Client.LoadTestService.TestRunClient(ctx, loadTestId)
+			if err != nil {
+				return fmt.Errorf("building data plane client for %s: %+v", loadTestId, err)
+			}
+
+			testRunId := loadtestrun.NewTestRunID(id.TestRunName)
+			resp, err := client.GetTestRun(ctx, testRunId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// a Test Run which is still in progress has to be stopped before it can be deleted
+			if model := resp.Model; model != nil && model.Status != nil && !loadTestRunIsComplete(*model.Status) {
+				if _, err := client.StopTestRun(ctx, testRunId); err != nil {
+					return fmt.Errorf("stopping %s: %+v", *id, err)
+				}
+
+				deadline, ok := ctx.Deadline()
+				if !ok {
+					return fmt.Errorf("internal-error: context had no deadline")
+				}
+
+				log.Printf("[DEBUG] waiting for %s to stop", *id)
+				stateConf := &pluginsdk.StateChangeConf{
+					Pending:    []string{"Running"},
+					Target:     []string{"Completed"},
+					Refresh:    loadTestRunCompletionRefreshFunc(ctx, client, testRunId),
+					MinTimeout: 15 * time.Second,
+					Timeout:    time.Until(deadline),
+				}
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+					return fmt.Errorf("waiting for %s to stop: %+v", *id, err)
+				}
+			}
+
+			if _, err := client.DeleteTestRun(ctx, testRunId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func loadTestRunCompletionRefreshFunc(ctx context.Context, client *loadtestrun.LoadTestRunClient, id loadtestrun.TestRunId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetTestRun(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if model := resp.Model; model != nil && model.Status != nil && loadTestRunIsComplete(*model.Status) {
+			return resp, "Completed", nil
+		}
+		return resp, "Running", nil
+	}
+}
+
+func loadTestRunIsComplete(status loadtestrun.Status) bool {
+	switch status {
+	case loadtestrun.StatusCANCELLED, loadtestrun.StatusDONE, loadtestrun.StatusFAILED, loadtestrun.StatusVALIDATIONFAILURE:
+		return true
+	}
+	return false
+}
+
+func flattenLoadTestRunErrorDetails(input *[]loadtestrun.ErrorDetails) string {
+	if input == nil {
+		return ""
+	}
+
+	messages := make([]string, 0)
+	for _, v := range *input {
+		if v.Message != nil {
+			messages = append(messages, *v.Message)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
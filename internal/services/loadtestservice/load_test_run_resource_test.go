@@ -0,0 +1,74 @@
+package loadtestservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2024-05-01/loadtestrun"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LoadTestRunResource struct{}
+
+func TestAccLoadTestRun_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_run", "test")
+	r := LoadTestRunResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("DONE"),
+				check.That(data.ResourceName).Key("test_result").Exists(),
+				check.That(data.ResourceName).Key("portal_url").IsSet(),
+			),
+		},
+		data.ImportStep("triggers", "wait_for_completion", "fail_on_test_failure"),
+	})
+}
+
+func (LoadTestRunResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LoadTestRunID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.LoadTestService.TestRunClient(ctx, loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetTestRun(ctx, loadtestrun.NewTestRunID(id.TestRunName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (LoadTestRunResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_load_test_run" "test" {
+  load_test_test_id = azurerm_load_test_test.test.id
+  name              = "acctest-run-%d"
+
+  triggers = {
+    run = "1"
+  }
+}
+`, LoadTestTestResource{}.basic(data), data.RandomInteger)
+}
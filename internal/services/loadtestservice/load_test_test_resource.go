@@ -0,0 +1,855 @@
+package loadtestservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2024-05-01/loadtestadministration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LoadTestTestResource struct{}
+
+var _ sdk.ResourceWithUpdate = LoadTestTestResource{}
+
+type LoadTestTestResourceModel struct {
+	LoadTestId                  string                      `tfschema:"load_test_id"`
+	Name                        string                      `tfschema:"name"`
+	DisplayName                 string                      `tfschema:"display_name"`
+	Description                 string                      `tfschema:"description"`
+	TestType                    string                      `tfschema:"test_type"`
+	EngineInstances             int64                       `tfschema:"engine_instances"`
+	TestScript                  []LoadTestTestFile          `tfschema:"test_script"`
+	AdditionalFile              []LoadTestTestFile          `tfschema:"additional_file"`
+	EnvironmentVariables        map[string]string           `tfschema:"environment_variables"`
+	Secret                      []LoadTestTestSecret        `tfschema:"secret"`
+	KeyVaultReferenceIdentityId string                      `tfschema:"key_vault_reference_identity_id"`
+	PassFailCriterion           []LoadTestPassFailCriterion `tfschema:"pass_fail_criterion"`
+	AppComponent                []LoadTestAppComponent      `tfschema:"app_component"`
+}
+
+type LoadTestTestFile struct {
+	FileName string `tfschema:"file_name"`
+	Content  string `tfschema:"content"`
+}
+
+type LoadTestTestSecret struct {
+	Name             string `tfschema:"name"`
+	KeyVaultSecretId string `tfschema:"key_vault_secret_id"`
+}
+
+type LoadTestPassFailCriterion struct {
+	ClientMetric string  `tfschema:"client_metric"`
+	Aggregate    string  `tfschema:"aggregate"`
+	Condition    string  `tfschema:"condition"`
+	Value        float64 `tfschema:"value"`
+	RequestName  string  `tfschema:"request_name"`
+	Action       string  `tfschema:"action"`
+}
+
+type LoadTestAppComponent struct {
+	ResourceId string `tfschema:"resource_id"`
+	Kind       string `tfschema:"kind"`
+}
+
+func (r LoadTestTestResource) Arguments() map[string]*pluginsdk.Schema {
+	fileSchema := func() map[string]*pluginsdk.Schema {
+		return map[string]*pluginsdk.Schema{
+			"file_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 50),
+			},
+
+			"content": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		}
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"load_test_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: loadtests.ValidateLoadTestID,
+		},
+
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-z0-9_-]{2,50}$`),
+				"`name` must be between 2 and 50 characters and can only contain lowercase letters, numbers, underscores and hyphens",
+			),
+		},
+
+		"test_script": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: fileSchema(),
+			},
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(2, 50),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 100),
+		},
+
+		"test_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(loadtestadministration.TestKindJMX),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(loadtestadministration.TestKindJMX),
+				string(loadtestadministration.TestKindLocust),
+			}, false),
+		},
+
+		"engine_instances": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 400),
+		},
+
+		"additional_file": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: fileSchema(),
+			},
+		},
+
+		"environment_variables": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"key_vault_secret_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+				},
+			},
+		},
+
+		"key_vault_reference_identity_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+		},
+
+		"pass_fail_criterion": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"client_metric": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(loadtestadministration.PossibleValuesForPFMetrics(), false),
+					},
+
+					"aggregate": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(loadtestadministration.PossibleValuesForPFAgFunc(), false),
+					},
+
+					"condition": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"<", ">"}, false),
+					},
+
+					"value": {
+						Type:     pluginsdk.TypeFloat,
+						Required: true,
+					},
+
+					"request_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"action": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(loadtestadministration.PFActionContinue),
+						ValidateFunc: validation.StringInSlice(loadtestadministration.PossibleValuesForPFAction(), false),
+					},
+				},
+			},
+		},
+
+		"app_component": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"resource_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"kind": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r LoadTestTestResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LoadTestTestResource) ModelObject() interface{} {
+	return &LoadTestTestResourceModel{}
+}
+
+func (r LoadTestTestResource) ResourceType() string {
+	return "azurerm_load_test_test"
+}
+
+func (r LoadTestTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.LoadTestTestID
+}
+
+func (r LoadTestTestResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model LoadTestTestResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loadTestId, err := loadtests.ParseLoadTestID(model.LoadTestId)
+			if err != nil {
+				return err
+			}
+
+			client, err := metadata.Client.LoadTestService.AdministrationClient(ctx, *loadTestId)
+			if err != nil {
+				return fmt.Errorf("building data plane client for %s: %+v", *loadTestId, err)
+			}
+
+			id := parse.NewLoadTestTestID(loadTestId.SubscriptionId, loadTestId.ResourceGroupName, loadTestId.LoadTestName, model.Name)
+			testId := loadtestadministration.NewTestID(id.TestName)
+
+			existing, err := client.GetTest(ctx, testId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdateTest(ctx, testId, expandLoadTestTest(model, nil)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			// the Test is created before the files are uploaded, so it's tracked from here on to ensure it's cleaned up
+			// should any of the uploads fail
+			metadata.SetID(id)
+
+			for _, file := range model.AdditionalFile {
+				if err := uploadLoadTestFile(ctx, client, testId, file, loadtestadministration.FileTypeADDITIONALARTIFACTS); err != nil {
+					return err
+				}
+			}
+
+			if err := uploadLoadTestFile(ctx, client, testId, model.TestScript[0], loadTestScriptFileType(model.TestType)); err != nil {
+				return err
+			}
+
+			if len(model.AppComponent) > 0 {
+				if _, err := client.CreateOrUpdateAppComponents(ctx, testId, expandLoadTestAppComponents(model.AppComponent, nil)); err != nil {
+					return fmt.Errorf("creating the App Components for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LoadTestTestResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.LoadTestTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+			client, err := metadata.Client.LoadTestService.AdministrationClient(ctx, loadTestId)
+			if err != nil {
+				return fmt.Errorf("building data plane client for %s: %+v", loadTestId, err)
+			}
+
+			testId := loadtestadministration.NewTestID(id.TestName)
+			resp, err := client.GetTest(ctx, testId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			appComponents, err := client.GetAppComponents(ctx, testId)
+			if err != nil && !response.WasNotFound(appComponents.HttpResponse) {
+				return fmt.Errorf("retrieving the App Components for %s: %+v", *id, err)
+			}
+
+			// the contents of the uploaded files can't be retrieved from the API, so they're kept from the state
+			var existing LoadTestTestResourceModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := LoadTestTestResourceModel{
+				LoadTestId:           loadTestId.ID(),
+				Name:                 id.TestName,
+				EnvironmentVariables: make(map[string]string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.DisplayName = utils.NormalizeNilableString(model.DisplayName)
+				state.Description = utils.NormalizeNilableString(model.Description)
+				state.KeyVaultReferenceIdentityId = utils.NormalizeNilableString(model.KeyvaultReferenceIdentityId)
+
+				state.TestType = string(loadtestadministration.TestKindJMX)
+				if model.Kind != nil {
+					state.TestType = string(*model.Kind)
+				}
+
+				state.EngineInstances = 1
+				if model.LoadTestConfiguration != nil && model.LoadTestConfiguration.EngineInstances != nil {
+					state.EngineInstances = *model.LoadTestConfiguration.EngineInstances
+				}
+
+				if model.EnvironmentVariables != nil {
+					for k, v := range *model.EnvironmentVariables {
+						if v != nil {
+							state.EnvironmentVariables[k] = *v
+						}
+					}
+				}
+
+				state.Secret = flattenLoadTestSecrets(model.Secrets)
+
+				if model.PassFailCriteria != nil {
+					state.PassFailCriterion = flattenLoadTestPassFailCriteria(model.PassFailCriteria.PassFailMetrics)
+				}
+
+				if artifacts := model.InputArtifacts; artifacts != nil {
+					if artifacts.TestScriptFileInfo != nil {
+						state.TestScript = flattenLoadTestFiles([]loadtestadministration.TestFileInfo{*artifacts.TestScriptFileInfo}, existing.TestScript)
+					}
+					if artifacts.AdditionalFileInfo != nil {
+						state.AdditionalFile = flattenLoadTestFiles(*artifacts.AdditionalFileInfo, existing.AdditionalFile)
+					}
+				}
+			}
+
+			if model := appComponents.Model; model != nil {
+				state.AppComponent = flattenLoadTestAppComponents(model.Components)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LoadTestTestResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.LoadTestTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model LoadTestTestResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+			client, err := metadata.Client.LoadTestService.AdministrationClient(ctx, loadTestId)
+			if err != nil {
+				return fmt.Errorf("building data plane client for %s: %+v", loadTestId, err)
+			}
+
+			testId := loadtestadministration.NewTestID(id.TestName)
+			existing, err := client.GetTest(ctx, testId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the Test is updated using a JSON Merge Patch, so the existing Test is used to remove any items
+			// which are no longer defined
+			if _, err := client.CreateOrUpdateTest(ctx, testId, expandLoadTestTest(model, existing.Model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if metadata.ResourceData.HasChange("additional_file") {
+				oldRaw, _ := metadata.ResourceData.GetChange("additional_file")
+				var oldFiles []LoadTestTestFile
+				for _, v := range oldRaw.([]interface{}) {
+					if raw, ok := v.(map[string]interface{}); ok {
+						oldFiles = append(oldFiles, LoadTestTestFile{
+							FileName: raw["file_name"].(string),
+							Content:  raw["content"].(string),
+						})
+					}
+				}
+
+				if err := updateLoadTestAdditionalFiles(ctx, client, testId, oldFiles, model.AdditionalFile); err != nil {
+					return err
+				}
+			}
+
+			if metadata.ResourceData.HasChange("test_script") {
+				oldRaw, _ := metadata.ResourceData.GetChange("test_script.0.file_name")
+				if oldFileName := oldRaw.(string); oldFileName != "" && oldFileName != model.TestScript[0].FileName {
+					if _, err := client.DeleteTestFile(ctx, loadtestadministration.NewFileID(id.TestName, oldFileName)); err != nil {
+						return fmt.Errorf("deleting the test script %q for %s: %+v", oldFileName, *id, err)
+					}
+				}
+
+				if err := uploadLoadTestFile(ctx, client, testId, model.TestScript[0], loadTestScriptFileType(model.TestType)); err != nil {
+					return err
+				}
+			}
+
+			if metadata.ResourceData.HasChange("app_component") {
+				appComponents, err := client.GetAppComponents(ctx, testId)
+				if err != nil && !response.WasNotFound(appComponents.HttpResponse) {
+					return fmt.Errorf("retrieving the App Components for %s: %+v", *id, err)
+				}
+
+				if _, err := client.CreateOrUpdateAppComponents(ctx, testId, expandLoadTestAppComponents(model.AppComponent, appComponents.Model)); err != nil {
+					return fmt.Errorf("updating the App Components for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LoadTestTestResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.LoadTestTestID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			loadTestId := loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName)
+			client, err := metadata.Client.LoadTestService.AdministrationClient(ctx, loadTestId)
+			if err != nil {
+				return fmt.Errorf("building data plane client for %s: %+v", loadTestId, err)
+			}
+
+			if _, err := client.DeleteTest(ctx, loadtestadministration.NewTestID(id.TestName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func loadTestScriptFileType(testType string) loadtestadministration.FileType {
+	if testType == string(loadtestadministration.TestKindLocust) {
+		return loadtestadministration.FileTypeTESTSCRIPT
+	}
+	return loadtestadministration.FileTypeJMXFILE
+}
+
+func uploadLoadTestFile(ctx context.Context, client *loadtestadministration.LoadTestAdministrationClient, testId loadtestadministration.TestId, file LoadTestTestFile, fileType loadtestadministration.FileType) error {
+	fileId := loadtestadministration.NewFileID(testId.TestId, file.FileName)
+	options := loadtestadministration.UploadTestFileOperationOptions{
+		FileType: &fileType,
+	}
+	if _, err := client.UploadTestFile(ctx, fileId, []byte(file.Content), options); err != nil {
+		return fmt.Errorf("uploading %q to the Test %q: %+v", file.FileName, testId.TestId, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	// test scripts are validated asynchronously once they've been uploaded, and the Test can't be run until this
+	// has completed
+	log.Printf("[DEBUG] waiting for %q in the Test %q to be validated", file.FileName, testId.TestId)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(loadtestadministration.FileValidationStatusNOTVALIDATED),
+			string(loadtestadministration.FileValidationStatusVALIDATIONINITIATED),
+		},
+		Target: []string{
+			string(loadtestadministration.FileValidationStatusVALIDATIONSUCCESS),
+			string(loadtestadministration.FileValidationStatusVALIDATIONNOTREQUIRED),
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetTestFile(ctx, fileId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %q from the Test %q: %+v", file.FileName, testId.TestId, err)
+			}
+
+			if resp.Model == nil || resp.Model.ValidationStatus == nil {
+				return resp, string(loadtestadministration.FileValidationStatusVALIDATIONNOTREQUIRED), nil
+			}
+
+			status := *resp.Model.ValidationStatus
+			if status == loadtestadministration.FileValidationStatusVALIDATIONFAILURE {
+				return nil, "", fmt.Errorf("validation failed: %s", utils.NormalizeNilableString(resp.Model.ValidationFailureDetails))
+			}
+			return resp, string(status), nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %q in the Test %q to be validated: %+v", file.FileName, testId.TestId, err)
+	}
+
+	return nil
+}
+
+func updateLoadTestAdditionalFiles(ctx context.Context, client *loadtestadministration.LoadTestAdministrationClient, testId loadtestadministration.TestId, oldFiles, newFiles []LoadTestTestFile) error {
+	oldContents := make(map[string]string)
+	for _, file := range oldFiles {
+		oldContents[file.FileName] = file.Content
+	}
+
+	newFileNames := make(map[string]struct{})
+	for _, file := range newFiles {
+		newFileNames[file.FileName] = struct{}{}
+
+		if content, ok := oldContents[file.FileName]; ok && content == file.Content {
+			continue
+		}
+		if err := uploadLoadTestFile(ctx, client, testId, file, loadtestadministration.FileTypeADDITIONALARTIFACTS); err != nil {
+			return err
+		}
+	}
+
+	for fileName := range oldContents {
+		if _, ok := newFileNames[fileName]; ok {
+			continue
+		}
+		if _, err := client.DeleteTestFile(ctx, loadtestadministration.NewFileID(testId.TestId, fileName)); err != nil {
+			return fmt.Errorf("deleting %q from the Test %q: %+v", fileName, testId.TestId, err)
+		}
+	}
+
+	return nil
+}
+
+// expandLoadTestTest builds the JSON Merge Patch payload for a Test - items which exist within the `existing` Test
+// but are no longer defined are sent as `null` so that they're removed
+func expandLoadTestTest(model LoadTestTestResourceModel, existing *loadtestadministration.Test) loadtestadministration.Test {
+	kind := loadtestadministration.TestKind(model.TestType)
+	payload := loadtestadministration.Test{
+		Description: utils.String(model.Description),
+		Kind:        &kind,
+		LoadTestConfiguration: &loadtestadministration.LoadTestConfiguration{
+			EngineInstances: utils.Int64(model.EngineInstances),
+		},
+	}
+
+	if model.DisplayName != "" {
+		payload.DisplayName = utils.String(model.DisplayName)
+	}
+
+	payload.KeyvaultReferenceIdentityType = utils.String(string(identity.TypeSystemAssigned))
+	if model.KeyVaultReferenceIdentityId != "" {
+		payload.KeyvaultReferenceIdentityType = utils.String(string(identity.TypeUserAssigned))
+		payload.KeyvaultReferenceIdentityId = utils.String(model.KeyVaultReferenceIdentityId)
+	}
+
+	environmentVariables := make(map[string]*string)
+	secrets := make(map[string]*loadtestadministration.Secret)
+	passFailMetrics := make(map[string]*loadtestadministration.PassFailMetric)
+	if existing != nil {
+		if existing.EnvironmentVariables != nil {
+			for k := range *existing.EnvironmentVariables {
+				environmentVariables[k] = nil
+			}
+		}
+		if existing.Secrets != nil {
+			for k := range *existing.Secrets {
+				secrets[k] = nil
+			}
+		}
+		if existing.PassFailCriteria != nil && existing.PassFailCriteria.PassFailMetrics != nil {
+			for k := range *existing.PassFailCriteria.PassFailMetrics {
+				passFailMetrics[k] = nil
+			}
+		}
+	}
+
+	for k, v := range model.EnvironmentVariables {
+		environmentVariables[k] = utils.String(v)
+	}
+	payload.EnvironmentVariables = &environmentVariables
+
+	secretType := loadtestadministration.SecretTypeAKVSECRETURI
+	for _, secret := range model.Secret {
+		secrets[secret.Name] = &loadtestadministration.Secret{
+			Type:  &secretType,
+			Value: utils.String(secret.KeyVaultSecretId),
+		}
+	}
+	payload.Secrets = &secrets
+
+	for _, criterion := range model.PassFailCriterion {
+		action := loadtestadministration.PFAction(criterion.Action)
+		aggregate := loadtestadministration.PFAgFunc(criterion.Aggregate)
+		clientMetric := loadtestadministration.PFMetrics(criterion.ClientMetric)
+		metric := &loadtestadministration.PassFailMetric{
+			Action:       &action,
+			Aggregate:    &aggregate,
+			ClientMetric: &clientMetric,
+			Condition:    utils.String(criterion.Condition),
+			Value:        utils.Float(criterion.Value),
+		}
+		if criterion.RequestName != "" {
+			metric.RequestName = utils.String(criterion.RequestName)
+		}
+		passFailMetrics[loadTestPassFailCriterionKey(criterion)] = metric
+	}
+	payload.PassFailCriteria = &loadtestadministration.PassFailCriteria{
+		PassFailMetrics: &passFailMetrics,
+	}
+
+	return payload
+}
+
+// loadTestPassFailCriterionKey returns a stable key for a pass/fail criterion, since the API requires each criterion
+// to be keyed by an ID which isn't otherwise exposed
+func loadTestPassFailCriterionKey(input LoadTestPassFailCriterion) string {
+	value := strconv.FormatFloat(input.Value, 'f', -1, 64)
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%s|%s|%s", input.ClientMetric, input.Aggregate, input.Condition, value, input.RequestName, input.Action)))
+	return fmt.Sprintf("%x", hash[:16])
+}
+
+func expandLoadTestAppComponents(input []LoadTestAppComponent, existing *loadtestadministration.TestAppComponents) loadtestadministration.TestAppComponents {
+	components := make(map[string]*loadtestadministration.AppComponent)
+	if existing != nil {
+		for k := range existing.Components {
+			components[k] = nil
+		}
+	}
+
+	for _, v := range input {
+		component := &loadtestadministration.AppComponent{
+			ResourceId:   v.ResourceId,
+			ResourceName: loadTestAppComponentResourceName(v.ResourceId),
+			ResourceType: loadTestAppComponentResourceType(v.ResourceId),
+		}
+		if v.Kind != "" {
+			component.Kind = utils.String(v.Kind)
+		}
+		components[v.ResourceId] = component
+	}
+
+	return loadtestadministration.TestAppComponents{
+		Components: components,
+	}
+}
+
+var loadTestAppComponentIdRegex = regexp.MustCompile(`(?i)/providers/([^/]+)/((?:[^/]+/[^/]+/)*[^/]+)/([^/]+)$`)
+
+func loadTestAppComponentResourceName(resourceId string) string {
+	if matches := loadTestAppComponentIdRegex.FindStringSubmatch(resourceId); len(matches) == 4 {
+		return matches[3]
+	}
+	return ""
+}
+
+// loadTestAppComponentResourceType returns the fully qualified type of a resource, for example
+// `Microsoft.Web/sites` or `Microsoft.Sql/servers/databases`
+func loadTestAppComponentResourceType(resourceId string) string {
+	matches := loadTestAppComponentIdRegex.FindStringSubmatch(resourceId)
+	if len(matches) != 4 {
+		return ""
+	}
+
+	resourceType := matches[1]
+	segments := strings.Split(matches[2], "/")
+	for i := 0; i < len(segments); i += 2 {
+		resourceType = fmt.Sprintf("%s/%s", resourceType, segments[i])
+	}
+	return resourceType
+}
+
+func flattenLoadTestFiles(input []loadtestadministration.TestFileInfo, existing []LoadTestTestFile) []LoadTestTestFile {
+	contents := make(map[string]string)
+	for _, file := range existing {
+		contents[file.FileName] = file.Content
+	}
+
+	output := make([]LoadTestTestFile, 0)
+	for _, file := range input {
+		output = append(output, LoadTestTestFile{
+			FileName: file.FileName,
+			Content:  contents[file.FileName],
+		})
+	}
+
+	// the files are returned in an arbitrary order, so they're kept in the order they were defined in
+	order := make(map[string]int)
+	for i, file := range existing {
+		order[file.FileName] = i
+	}
+	sort.SliceStable(output, func(i, j int) bool {
+		oi, iOk := order[output[i].FileName]
+		oj, jOk := order[output[j].FileName]
+		if iOk && jOk {
+			return oi < oj
+		}
+		if iOk != jOk {
+			return iOk
+		}
+		return output[i].FileName < output[j].FileName
+	})
+
+	return output
+}
+
+func flattenLoadTestSecrets(input *map[string]*loadtestadministration.Secret) []LoadTestTestSecret {
+	output := make([]LoadTestTestSecret, 0)
+	if input == nil {
+		return output
+	}
+
+	for name, secret := range *input {
+		if secret == nil || secret.Type == nil || *secret.Type != loadtestadministration.SecretTypeAKVSECRETURI {
+			continue
+		}
+		output = append(output, LoadTestTestSecret{
+			Name:             name,
+			KeyVaultSecretId: utils.NormalizeNilableString(secret.Value),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Name < output[j].Name
+	})
+
+	return output
+}
+
+func flattenLoadTestPassFailCriteria(input *map[string]*loadtestadministration.PassFailMetric) []LoadTestPassFailCriterion {
+	output := make([]LoadTestPassFailCriterion, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, metric := range *input {
+		if metric == nil {
+			continue
+		}
+
+		criterion := LoadTestPassFailCriterion{
+			Condition:   utils.NormalizeNilableString(metric.Condition),
+			RequestName: utils.NormalizeNilableString(metric.RequestName),
+			Action:      string(loadtestadministration.PFActionContinue),
+		}
+		if metric.ClientMetric != nil {
+			criterion.ClientMetric = string(*metric.ClientMetric)
+		}
+		if metric.Aggregate != nil {
+			criterion.Aggregate = string(*metric.Aggregate)
+		}
+		if metric.Value != nil {
+			criterion.Value = *metric.Value
+		}
+		if metric.Action != nil {
+			criterion.Action = string(*metric.Action)
+		}
+		output = append(output, criterion)
+	}
+
+	return output
+}
+
+func flattenLoadTestAppComponents(input map[string]*loadtestadministration.AppComponent) []LoadTestAppComponent {
+	output := make([]LoadTestAppComponent, 0)
+	for _, component := range input {
+		if component == nil {
+			continue
+		}
+		output = append(output, LoadTestAppComponent{
+			ResourceId: component.ResourceId,
+			Kind:       utils.NormalizeNilableString(component.Kind),
+		})
+	}
+
+	return output
+}
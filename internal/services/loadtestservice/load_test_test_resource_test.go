@@ -0,0 +1,237 @@
+package loadtestservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2022-12-01/loadtests"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loadtestservice/sdk/2024-05-01/loadtestadministration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type LoadTestTestResource struct{}
+
+func TestAccLoadTestTest_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_test", "test")
+	r := LoadTestTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+	})
+}
+
+func TestAccLoadTestTest_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_test", "test")
+	r := LoadTestTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLoadTestTest_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_load_test_test", "test")
+	r := LoadTestTestResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pass_fail_criterion.#").HasValue("2"),
+				check.That(data.ResourceName).Key("app_component.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("test_script.0.content", "additional_file.0.content"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("pass_fail_criterion.#").HasValue("0"),
+				check.That(data.ResourceName).Key("app_component.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("test_script.0.content"),
+	})
+}
+
+func (LoadTestTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LoadTestTestID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := clients.LoadTestService.AdministrationClient(ctx, loadtests.NewLoadTestID(id.SubscriptionId, id.ResourceGroup, id.LoadTestName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetTest(ctx, loadtestadministration.NewTestID(id.TestName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r LoadTestTestResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_load_test_test" "test" {
+  load_test_id = azurerm_load_test.test.id
+  name         = "acctest-%d"
+
+  test_script {
+    file_name = "test.jmx"
+    content   = <<XML
+%s
+XML
+  }
+}
+`, LoadTestResource{}.basic(data), data.RandomInteger, r.jmxScript())
+}
+
+func (r LoadTestTestResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_load_test_test" "import" {
+  load_test_id = azurerm_load_test_test.test.load_test_id
+  name         = azurerm_load_test_test.test.name
+
+  test_script {
+    file_name = azurerm_load_test_test.test.test_script.0.file_name
+    content   = azurerm_load_test_test.test.test_script.0.content
+  }
+}
+`, r.basic(data))
+}
+
+func (r LoadTestTestResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestsp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+  sku_name            = "B1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestwa-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  service_plan_id     = azurerm_service_plan.test.id
+
+  site_config {}
+}
+
+resource "azurerm_load_test_test" "test" {
+  load_test_id     = azurerm_load_test.test.id
+  name             = "acctest-%[2]d"
+  display_name     = "Acceptance Test"
+  description      = "Test for acceptance testing"
+  engine_instances = 2
+
+  test_script {
+    file_name = "test.jmx"
+    content   = <<XML
+%[3]s
+XML
+  }
+
+  additional_file {
+    file_name = "users.csv"
+    content   = "username\nuser1\nuser2\n"
+  }
+
+  environment_variables = {
+    webapp = azurerm_linux_web_app.test.default_hostname
+  }
+
+  pass_fail_criterion {
+    client_metric = "response_time_ms"
+    aggregate     = "avg"
+    condition     = ">"
+    value         = 500
+  }
+
+  pass_fail_criterion {
+    client_metric = "error"
+    aggregate     = "percentage"
+    condition     = ">"
+    value         = 5
+    action        = "stop"
+  }
+
+  app_component {
+    resource_id = azurerm_linux_web_app.test.id
+  }
+}
+`, LoadTestResource{}.basic(data), data.RandomInteger, r.jmxScript())
+}
+
+func (LoadTestTestResource) jmxScript() string {
+	return `<?xml version="1.0" encoding="UTF-8"?>
+<jmeterTestPlan version="1.2" properties="5.0" jmeter="5.5">
+  <hashTree>
+    <TestPlan guiclass="TestPlanGui" testclass="TestPlan" testname="Test Plan" enabled="true">
+      <elementProp name="TestPlan.user_defined_variables" elementType="Arguments" guiclass="ArgumentsPanel" testclass="Arguments" enabled="true">
+        <collectionProp name="Arguments.arguments"/>
+      </elementProp>
+    </TestPlan>
+    <hashTree>
+      <ThreadGroup guiclass="ThreadGroupGui" testclass="ThreadGroup" testname="Thread Group" enabled="true">
+        <stringProp name="ThreadGroup.on_sample_error">continue</stringProp>
+        <elementProp name="ThreadGroup.main_controller" elementType="LoopController" guiclass="LoopControlPanel" testclass="LoopController" enabled="true">
+          <boolProp name="LoopController.continue_forever">false</boolProp>
+          <stringProp name="LoopController.loops">1</stringProp>
+        </elementProp>
+        <stringProp name="ThreadGroup.num_threads">1</stringProp>
+        <stringProp name="ThreadGroup.ramp_time">1</stringProp>
+      </ThreadGroup>
+      <hashTree>
+        <HTTPSamplerProxy guiclass="HttpTestSampleGui" testclass="HTTPSamplerProxy" testname="Homepage" enabled="true">
+          <stringProp name="HTTPSampler.domain">www.example.com</stringProp>
+          <stringProp name="HTTPSampler.protocol">https</stringProp>
+          <stringProp name="HTTPSampler.path">/</stringProp>
+          <stringProp name="HTTPSampler.method">GET</stringProp>
+        </HTTPSamplerProxy>
+        <hashTree/>
+      </hashTree>
+    </hashTree>
+  </hashTree>
+</jmeterTestPlan>`
+}
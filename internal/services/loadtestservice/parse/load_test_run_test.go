@@ -0,0 +1,128 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = LoadTestRunId{}
+
+func TestLoadTestRunIDFormatter(t *testing.T) {
+	actual := NewLoadTestRunID("12345678-1234-9876-4563-123456789012", "resGroup1", "loadTest1", "testRun1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1/testRuns/testRun1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLoadTestRunID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *LoadTestRunId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing LoadTestName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/",
+			Error: true,
+		},
+
+		{
+			// missing value for LoadTestName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/",
+			Error: true,
+		},
+
+		{
+			// missing TestRunName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1/",
+			Error: true,
+		},
+
+		{
+			// missing value for TestRunName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1/testRuns/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1/testRuns/testRun1",
+			Expected: &LoadTestRunId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				LoadTestName:   "loadTest1",
+				TestRunName:    "testRun1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.LOADTESTSERVICE/LOADTESTS/LOADTEST1/TESTRUNS/TESTRUN1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := LoadTestRunID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.LoadTestName != v.Expected.LoadTestName {
+			t.Fatalf("Expected %q but got %q for LoadTestName", v.Expected.LoadTestName, actual.LoadTestName)
+		}
+		if actual.TestRunName != v.Expected.TestRunName {
+			t.Fatalf("Expected %q but got %q for TestRunName", v.Expected.TestRunName, actual.TestRunName)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type LoadTestTestId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LoadTestName   string
+	TestName       string
+}
+
+func NewLoadTestTestID(subscriptionId, resourceGroup, loadTestName, testName string) LoadTestTestId {
+	return LoadTestTestId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LoadTestName:   loadTestName,
+		TestName:       testName,
+	}
+}
+
+func (id LoadTestTestId) String() string {
+	segments := []string{
+		fmt.Sprintf("Test Name %q", id.TestName),
+		fmt.Sprintf("Load Test Name %q", id.LoadTestName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Load Test Test", segmentsStr)
+}
+
+func (id LoadTestTestId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.LoadTestService/loadTests/%s/tests/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadTestName, id.TestName)
+}
+
+// LoadTestTestID parses a LoadTestTest ID into an LoadTestTestId struct
+func LoadTestTestID(input string) (*LoadTestTestId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LoadTestTestId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LoadTestName, err = id.PopSegment("loadTests"); err != nil {
+		return nil, err
+	}
+	if resourceId.TestName, err = id.PopSegment("tests"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,4 @@
+package loadtestservice
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LoadTestTest -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1/tests/test1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=LoadTestRun -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.LoadTestService/loadTests/loadTest1/testRuns/testRun1
@@ -0,0 +1,7 @@
+package loadtests
+
+type LoadTestProperties struct {
+	DataPlaneURI      *string `json:"dataPlaneURI,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,15 @@
+package loadtestadministration
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LoadTestAdministrationClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLoadTestAdministrationClientWithBaseURI(endpoint string) LoadTestAdministrationClient {
+	return LoadTestAdministrationClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,293 @@
+package loadtestadministration
+
+import "strings"
+
+type FileType string
+
+const (
+	FileTypeADDITIONALARTIFACTS FileType = "ADDITIONAL_ARTIFACTS"
+	FileTypeJMXFILE             FileType = "JMX_FILE"
+	FileTypeTESTSCRIPT          FileType = "TEST_SCRIPT"
+	FileTypeURLTESTCONFIG       FileType = "URL_TEST_CONFIG"
+	FileTypeUSERPROPERTIES      FileType = "USER_PROPERTIES"
+	FileTypeZIPPEDARTIFACTS     FileType = "ZIPPED_ARTIFACTS"
+)
+
+func PossibleValuesForFileType() []string {
+	return []string{
+		string(FileTypeADDITIONALARTIFACTS),
+		string(FileTypeJMXFILE),
+		string(FileTypeTESTSCRIPT),
+		string(FileTypeURLTESTCONFIG),
+		string(FileTypeUSERPROPERTIES),
+		string(FileTypeZIPPEDARTIFACTS),
+	}
+}
+
+func parseFileType(input string) (*FileType, error) {
+	vals := map[string]FileType{
+		"additional_artifacts": FileTypeADDITIONALARTIFACTS,
+		"jmx_file":             FileTypeJMXFILE,
+		"test_script":          FileTypeTESTSCRIPT,
+		"url_test_config":      FileTypeURLTESTCONFIG,
+		"user_properties":      FileTypeUSERPROPERTIES,
+		"zipped_artifacts":     FileTypeZIPPEDARTIFACTS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FileType(input)
+	return &out, nil
+}
+
+type FileValidationStatus string
+
+const (
+	FileValidationStatusNOTVALIDATED          FileValidationStatus = "NOT_VALIDATED"
+	FileValidationStatusVALIDATIONFAILURE     FileValidationStatus = "VALIDATION_FAILURE"
+	FileValidationStatusVALIDATIONINITIATED   FileValidationStatus = "VALIDATION_INITIATED"
+	FileValidationStatusVALIDATIONNOTREQUIRED FileValidationStatus = "VALIDATION_NOT_REQUIRED"
+	FileValidationStatusVALIDATIONSUCCESS     FileValidationStatus = "VALIDATION_SUCCESS"
+)
+
+func PossibleValuesForFileValidationStatus() []string {
+	return []string{
+		string(FileValidationStatusNOTVALIDATED),
+		string(FileValidationStatusVALIDATIONFAILURE),
+		string(FileValidationStatusVALIDATIONINITIATED),
+		string(FileValidationStatusVALIDATIONNOTREQUIRED),
+		string(FileValidationStatusVALIDATIONSUCCESS),
+	}
+}
+
+func parseFileValidationStatus(input string) (*FileValidationStatus, error) {
+	vals := map[string]FileValidationStatus{
+		"not_validated":           FileValidationStatusNOTVALIDATED,
+		"validation_failure":      FileValidationStatusVALIDATIONFAILURE,
+		"validation_initiated":    FileValidationStatusVALIDATIONINITIATED,
+		"validation_not_required": FileValidationStatusVALIDATIONNOTREQUIRED,
+		"validation_success":      FileValidationStatusVALIDATIONSUCCESS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := FileValidationStatus(input)
+	return &out, nil
+}
+
+type PFAction string
+
+const (
+	PFActionContinue PFAction = "continue"
+	PFActionStop     PFAction = "stop"
+)
+
+func PossibleValuesForPFAction() []string {
+	return []string{
+		string(PFActionContinue),
+		string(PFActionStop),
+	}
+}
+
+func parsePFAction(input string) (*PFAction, error) {
+	vals := map[string]PFAction{
+		"continue": PFActionContinue,
+		"stop":     PFActionStop,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PFAction(input)
+	return &out, nil
+}
+
+type PFAgFunc string
+
+const (
+	PFAgFuncAvg                    PFAgFunc = "avg"
+	PFAgFuncCount                  PFAgFunc = "count"
+	PFAgFuncMax                    PFAgFunc = "max"
+	PFAgFuncMin                    PFAgFunc = "min"
+	PFAgFuncPFiveZero              PFAgFunc = "p50"
+	PFAgFuncPNineFive              PFAgFunc = "p95"
+	PFAgFuncPNineNine              PFAgFunc = "p99"
+	PFAgFuncPNineNinePointNine     PFAgFunc = "p99.9"
+	PFAgFuncPNineNinePointNineNine PFAgFunc = "p99.99"
+	PFAgFuncPNineZero              PFAgFunc = "p90"
+	PFAgFuncPSevenFive             PFAgFunc = "p75"
+	PFAgFuncPercentage             PFAgFunc = "percentage"
+)
+
+func PossibleValuesForPFAgFunc() []string {
+	return []string{
+		string(PFAgFuncAvg),
+		string(PFAgFuncCount),
+		string(PFAgFuncMax),
+		string(PFAgFuncMin),
+		string(PFAgFuncPFiveZero),
+		string(PFAgFuncPNineFive),
+		string(PFAgFuncPNineNine),
+		string(PFAgFuncPNineNinePointNine),
+		string(PFAgFuncPNineNinePointNineNine),
+		string(PFAgFuncPNineZero),
+		string(PFAgFuncPSevenFive),
+		string(PFAgFuncPercentage),
+	}
+}
+
+func parsePFAgFunc(input string) (*PFAgFunc, error) {
+	vals := map[string]PFAgFunc{
+		"avg":        PFAgFuncAvg,
+		"count":      PFAgFuncCount,
+		"max":        PFAgFuncMax,
+		"min":        PFAgFuncMin,
+		"p50":        PFAgFuncPFiveZero,
+		"p95":        PFAgFuncPNineFive,
+		"p99":        PFAgFuncPNineNine,
+		"p99.9":      PFAgFuncPNineNinePointNine,
+		"p99.99":     PFAgFuncPNineNinePointNineNine,
+		"p90":        PFAgFuncPNineZero,
+		"p75":        PFAgFuncPSevenFive,
+		"percentage": PFAgFuncPercentage,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PFAgFunc(input)
+	return &out, nil
+}
+
+type PFMetrics string
+
+const (
+	PFMetricsError          PFMetrics = "error"
+	PFMetricsLatency        PFMetrics = "latency"
+	PFMetricsRequests       PFMetrics = "requests"
+	PFMetricsRequestsPerSec PFMetrics = "requests_per_sec"
+	PFMetricsResponseTimeMs PFMetrics = "response_time_ms"
+)
+
+func PossibleValuesForPFMetrics() []string {
+	return []string{
+		string(PFMetricsError),
+		string(PFMetricsLatency),
+		string(PFMetricsRequests),
+		string(PFMetricsRequestsPerSec),
+		string(PFMetricsResponseTimeMs),
+	}
+}
+
+func parsePFMetrics(input string) (*PFMetrics, error) {
+	vals := map[string]PFMetrics{
+		"error":            PFMetricsError,
+		"latency":          PFMetricsLatency,
+		"requests":         PFMetricsRequests,
+		"requests_per_sec": PFMetricsRequestsPerSec,
+		"response_time_ms": PFMetricsResponseTimeMs,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PFMetrics(input)
+	return &out, nil
+}
+
+type PFResult string
+
+const (
+	PFResultFailed       PFResult = "failed"
+	PFResultPassed       PFResult = "passed"
+	PFResultUndetermined PFResult = "undetermined"
+)
+
+func PossibleValuesForPFResult() []string {
+	return []string{
+		string(PFResultFailed),
+		string(PFResultPassed),
+		string(PFResultUndetermined),
+	}
+}
+
+func parsePFResult(input string) (*PFResult, error) {
+	vals := map[string]PFResult{
+		"failed":       PFResultFailed,
+		"passed":       PFResultPassed,
+		"undetermined": PFResultUndetermined,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PFResult(input)
+	return &out, nil
+}
+
+type SecretType string
+
+const (
+	SecretTypeAKVSECRETURI SecretType = "AKV_SECRET_URI"
+	SecretTypeSECRETVALUE  SecretType = "SECRET_VALUE"
+)
+
+func PossibleValuesForSecretType() []string {
+	return []string{
+		string(SecretTypeAKVSECRETURI),
+		string(SecretTypeSECRETVALUE),
+	}
+}
+
+func parseSecretType(input string) (*SecretType, error) {
+	vals := map[string]SecretType{
+		"akv_secret_uri": SecretTypeAKVSECRETURI,
+		"secret_value":   SecretTypeSECRETVALUE,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SecretType(input)
+	return &out, nil
+}
+
+type TestKind string
+
+const (
+	TestKindJMX    TestKind = "JMX"
+	TestKindLocust TestKind = "Locust"
+	TestKindURL    TestKind = "URL"
+)
+
+func PossibleValuesForTestKind() []string {
+	return []string{
+		string(TestKindJMX),
+		string(TestKindLocust),
+		string(TestKindURL),
+	}
+}
+
+func parseTestKind(input string) (*TestKind, error) {
+	vals := map[string]TestKind{
+		"jmx":    TestKindJMX,
+		"locust": TestKindLocust,
+		"url":    TestKindURL,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TestKind(input)
+	return &out, nil
+}
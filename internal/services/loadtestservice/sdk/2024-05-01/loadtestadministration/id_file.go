@@ -0,0 +1,109 @@
+package loadtestadministration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = FileId{}
+
+// FileId is a struct representing the Resource ID for a File
+type FileId struct {
+	TestId   string
+	FileName string
+}
+
+// NewFileID returns a new FileId struct
+func NewFileID(testId string, fileName string) FileId {
+	return FileId{
+		TestId:   testId,
+		FileName: fileName,
+	}
+}
+
+// ParseFileID parses 'input' into a FileId
+func ParseFileID(input string) (*FileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(FileId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := FileId{}
+
+	if id.TestId, ok = parsed.Parsed["testId"]; !ok {
+		return nil, fmt.Errorf("the segment 'testId' was not found in the resource id %q", input)
+	}
+
+	if id.FileName, ok = parsed.Parsed["fileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'fileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseFileIDInsensitively parses 'input' case-insensitively into a FileId
+// note: this method should only be used for API response data and not user input
+func ParseFileIDInsensitively(input string) (*FileId, error) {
+	parser := resourceids.NewParserFromResourceIdType(FileId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := FileId{}
+
+	if id.TestId, ok = parsed.Parsed["testId"]; !ok {
+		return nil, fmt.Errorf("the segment 'testId' was not found in the resource id %q", input)
+	}
+
+	if id.FileName, ok = parsed.Parsed["fileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'fileName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateFileID checks that 'input' can be parsed as a File ID
+func ValidateFileID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseFileID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted File ID
+func (id FileId) ID() string {
+	fmtString := "/tests/%s/files/%s"
+	return fmt.Sprintf(fmtString, id.TestId, id.FileName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this File ID
+func (id FileId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticTests", "tests", "tests"),
+		resourceids.UserSpecifiedSegment("testId", "testIdValue"),
+		resourceids.StaticSegment("staticFiles", "files", "files"),
+		resourceids.UserSpecifiedSegment("fileName", "fileValue"),
+	}
+}
+
+// String returns a human-readable description of this File ID
+func (id FileId) String() string {
+	components := []string{
+		fmt.Sprintf("Test Id: %q", id.TestId),
+		fmt.Sprintf("File Name: %q", id.FileName),
+	}
+	return fmt.Sprintf("File (%s)", strings.Join(components, "\n"))
+}
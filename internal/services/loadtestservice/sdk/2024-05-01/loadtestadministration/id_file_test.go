@@ -0,0 +1,184 @@
+package loadtestadministration
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = FileId{}
+
+func TestNewFileID(t *testing.T) {
+	id := NewFileID("testIdValue", "fileValue")
+
+	if id.TestId != "testIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'TestId'", id.TestId, "testIdValue")
+	}
+
+	if id.FileName != "fileValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'FileName'", id.FileName, "fileValue")
+	}
+}
+
+func TestFormatFileID(t *testing.T) {
+	actual := NewFileID("testIdValue", "fileValue").ID()
+	expected := "/tests/testIdValue/files/fileValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseFileID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FileId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests/testIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests/testIdValue/files",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/tests/testIdValue/files/fileValue",
+			Expected: &FileId{
+				TestId:   "testIdValue",
+				FileName: "fileValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/tests/testIdValue/files/fileValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseFileID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TestId != v.Expected.TestId {
+			t.Fatalf("Expected %q but got %q for TestId", v.Expected.TestId, actual.TestId)
+		}
+
+		if actual.FileName != v.Expected.FileName {
+			t.Fatalf("Expected %q but got %q for FileName", v.Expected.FileName, actual.FileName)
+		}
+
+	}
+}
+
+func TestParseFileIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FileId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/TeStS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests/testIdValue",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests/testIdValue/files",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/TeStS/TeStIdVaLuE/FiLeS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/tests/testIdValue/files/fileValue",
+			Expected: &FileId{
+				TestId:   "testIdValue",
+				FileName: "fileValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/tests/testIdValue/files/fileValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/TeStS/TeStIdVaLuE/FiLeS/FiLeVaLuE",
+			Expected: &FileId{
+				TestId:   "TeStIdVaLuE",
+				FileName: "FiLeVaLuE",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/TeStS/TeStIdVaLuE/FiLeS/FiLeVaLuE/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseFileIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TestId != v.Expected.TestId {
+			t.Fatalf("Expected %q but got %q for TestId", v.Expected.TestId, actual.TestId)
+		}
+
+		if actual.FileName != v.Expected.FileName {
+			t.Fatalf("Expected %q but got %q for FileName", v.Expected.FileName, actual.FileName)
+		}
+
+	}
+}
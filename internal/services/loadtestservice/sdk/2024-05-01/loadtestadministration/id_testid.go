@@ -0,0 +1,96 @@
+package loadtestadministration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = TestId{}
+
+// TestId is a struct representing the Resource ID for a Test
+type TestId struct {
+	TestId string
+}
+
+// NewTestID returns a new TestId struct
+func NewTestID(testId string) TestId {
+	return TestId{
+		TestId: testId,
+	}
+}
+
+// ParseTestID parses 'input' into a TestId
+func ParseTestID(input string) (*TestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(TestId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := TestId{}
+
+	if id.TestId, ok = parsed.Parsed["testId"]; !ok {
+		return nil, fmt.Errorf("the segment 'testId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseTestIDInsensitively parses 'input' case-insensitively into a TestId
+// note: this method should only be used for API response data and not user input
+func ParseTestIDInsensitively(input string) (*TestId, error) {
+	parser := resourceids.NewParserFromResourceIdType(TestId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := TestId{}
+
+	if id.TestId, ok = parsed.Parsed["testId"]; !ok {
+		return nil, fmt.Errorf("the segment 'testId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateTestID checks that 'input' can be parsed as a Test ID
+func ValidateTestID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseTestID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Test ID
+func (id TestId) ID() string {
+	fmtString := "/tests/%s"
+	return fmt.Sprintf(fmtString, id.TestId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Test ID
+func (id TestId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticTests", "tests", "tests"),
+		resourceids.UserSpecifiedSegment("testId", "testIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Test ID
+func (id TestId) String() string {
+	components := []string{
+		fmt.Sprintf("Test Id: %q", id.TestId),
+	}
+	return fmt.Sprintf("Test (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,144 @@
+package loadtestadministration
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = TestId{}
+
+func TestNewTestID(t *testing.T) {
+	id := NewTestID("testIdValue")
+
+	if id.TestId != "testIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'TestId'", id.TestId, "testIdValue")
+	}
+}
+
+func TestFormatTestID(t *testing.T) {
+	actual := NewTestID("testIdValue").ID()
+	expected := "/tests/testIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseTestID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *TestId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/tests/testIdValue",
+			Expected: &TestId{
+				TestId: "testIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/tests/testIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseTestID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TestId != v.Expected.TestId {
+			t.Fatalf("Expected %q but got %q for TestId", v.Expected.TestId, actual.TestId)
+		}
+
+	}
+}
+
+func TestParseTestIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *TestId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/tests",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/TeStS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/tests/testIdValue",
+			Expected: &TestId{
+				TestId: "testIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/tests/testIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/TeStS/TeStIdVaLuE",
+			Expected: &TestId{
+				TestId: "TeStIdVaLuE",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/TeStS/TeStIdVaLuE/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseTestIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TestId != v.Expected.TestId {
+			t.Fatalf("Expected %q but got %q for TestId", v.Expected.TestId, actual.TestId)
+		}
+
+	}
+}
@@ -0,0 +1,66 @@
+package loadtestadministration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateOrUpdateAppComponentsResponse struct {
+	HttpResponse *http.Response
+	Model        *TestAppComponents
+}
+
+// CreateOrUpdateAppComponents ...
+func (c LoadTestAdministrationClient) CreateOrUpdateAppComponents(ctx context.Context, id TestId, input TestAppComponents) (result CreateOrUpdateAppComponentsResponse, err error) {
+	req, err := c.preparerForCreateOrUpdateAppComponents(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "CreateOrUpdateAppComponents", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "CreateOrUpdateAppComponents", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateOrUpdateAppComponents(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "CreateOrUpdateAppComponents", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateOrUpdateAppComponents prepares the CreateOrUpdateAppComponents request.
+func (c LoadTestAdministrationClient) preparerForCreateOrUpdateAppComponents(ctx context.Context, id TestId, input TestAppComponents) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/app-components", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateOrUpdateAppComponents handles the response to the CreateOrUpdateAppComponents request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForCreateOrUpdateAppComponents(resp *http.Response) (result CreateOrUpdateAppComponentsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
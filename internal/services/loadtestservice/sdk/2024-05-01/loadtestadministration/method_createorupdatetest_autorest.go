@@ -0,0 +1,65 @@
+package loadtestadministration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateOrUpdateTestResponse struct {
+	HttpResponse *http.Response
+	Model        *Test
+}
+
+// CreateOrUpdateTest ...
+func (c LoadTestAdministrationClient) CreateOrUpdateTest(ctx context.Context, id TestId, input Test) (result CreateOrUpdateTestResponse, err error) {
+	req, err := c.preparerForCreateOrUpdateTest(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "CreateOrUpdateTest", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "CreateOrUpdateTest", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateOrUpdateTest(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "CreateOrUpdateTest", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateOrUpdateTest prepares the CreateOrUpdateTest request.
+func (c LoadTestAdministrationClient) preparerForCreateOrUpdateTest(ctx context.Context, id TestId, input Test) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateOrUpdateTest handles the response to the CreateOrUpdateTest request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForCreateOrUpdateTest(resp *http.Response) (result CreateOrUpdateTestResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
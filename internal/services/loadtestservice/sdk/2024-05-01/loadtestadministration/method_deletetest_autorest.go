@@ -0,0 +1,61 @@
+package loadtestadministration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DeleteTestResponse struct {
+	HttpResponse *http.Response
+}
+
+// DeleteTest ...
+func (c LoadTestAdministrationClient) DeleteTest(ctx context.Context, id TestId) (result DeleteTestResponse, err error) {
+	req, err := c.preparerForDeleteTest(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "DeleteTest", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "DeleteTest", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDeleteTest(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "DeleteTest", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDeleteTest prepares the DeleteTest request.
+func (c LoadTestAdministrationClient) preparerForDeleteTest(ctx context.Context, id TestId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDeleteTest handles the response to the DeleteTest request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForDeleteTest(resp *http.Response) (result DeleteTestResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,61 @@
+package loadtestadministration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DeleteTestFileResponse struct {
+	HttpResponse *http.Response
+}
+
+// DeleteTestFile ...
+func (c LoadTestAdministrationClient) DeleteTestFile(ctx context.Context, id FileId) (result DeleteTestFileResponse, err error) {
+	req, err := c.preparerForDeleteTestFile(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "DeleteTestFile", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "DeleteTestFile", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDeleteTestFile(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "DeleteTestFile", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDeleteTestFile prepares the DeleteTestFile request.
+func (c LoadTestAdministrationClient) preparerForDeleteTestFile(ctx context.Context, id FileId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDeleteTestFile handles the response to the DeleteTestFile request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForDeleteTestFile(resp *http.Response) (result DeleteTestFileResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
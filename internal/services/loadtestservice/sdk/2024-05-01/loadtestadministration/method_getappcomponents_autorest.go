@@ -0,0 +1,65 @@
+package loadtestadministration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetAppComponentsResponse struct {
+	HttpResponse *http.Response
+	Model        *TestAppComponents
+}
+
+// GetAppComponents ...
+func (c LoadTestAdministrationClient) GetAppComponents(ctx context.Context, id TestId) (result GetAppComponentsResponse, err error) {
+	req, err := c.preparerForGetAppComponents(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetAppComponents", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetAppComponents", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetAppComponents(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetAppComponents", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetAppComponents prepares the GetAppComponents request.
+func (c LoadTestAdministrationClient) preparerForGetAppComponents(ctx context.Context, id TestId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/app-components", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetAppComponents handles the response to the GetAppComponents request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForGetAppComponents(resp *http.Response) (result GetAppComponentsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
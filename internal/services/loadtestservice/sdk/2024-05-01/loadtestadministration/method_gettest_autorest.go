@@ -0,0 +1,64 @@
+package loadtestadministration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetTestResponse struct {
+	HttpResponse *http.Response
+	Model        *Test
+}
+
+// GetTest ...
+func (c LoadTestAdministrationClient) GetTest(ctx context.Context, id TestId) (result GetTestResponse, err error) {
+	req, err := c.preparerForGetTest(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetTest", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetTest", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetTest(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetTest", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetTest prepares the GetTest request.
+func (c LoadTestAdministrationClient) preparerForGetTest(ctx context.Context, id TestId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetTest handles the response to the GetTest request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForGetTest(resp *http.Response) (result GetTestResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package loadtestadministration
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetTestFileResponse struct {
+	HttpResponse *http.Response
+	Model        *TestFileInfo
+}
+
+// GetTestFile ...
+func (c LoadTestAdministrationClient) GetTestFile(ctx context.Context, id FileId) (result GetTestFileResponse, err error) {
+	req, err := c.preparerForGetTestFile(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetTestFile", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetTestFile", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetTestFile(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "GetTestFile", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetTestFile prepares the GetTestFile request.
+func (c LoadTestAdministrationClient) preparerForGetTestFile(ctx context.Context, id FileId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetTestFile handles the response to the GetTestFile request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForGetTestFile(resp *http.Response) (result GetTestFileResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
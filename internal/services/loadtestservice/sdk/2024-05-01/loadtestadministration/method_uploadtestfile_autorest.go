@@ -0,0 +1,95 @@
+package loadtestadministration
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type UploadTestFileResponse struct {
+	HttpResponse *http.Response
+	Model        *TestFileInfo
+}
+
+type UploadTestFileOperationOptions struct {
+	FileType *FileType
+}
+
+func DefaultUploadTestFileOperationOptions() UploadTestFileOperationOptions {
+	return UploadTestFileOperationOptions{}
+}
+
+func (o UploadTestFileOperationOptions) toHeaders() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	return out
+}
+
+func (o UploadTestFileOperationOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+	if o.FileType != nil {
+		out["fileType"] = *o.FileType
+	}
+
+	return out
+}
+
+// UploadTestFile ...
+func (c LoadTestAdministrationClient) UploadTestFile(ctx context.Context, id FileId, input []byte, options UploadTestFileOperationOptions) (result UploadTestFileResponse, err error) {
+	req, err := c.preparerForUploadTestFile(ctx, id, input, options)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "UploadTestFile", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "UploadTestFile", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForUploadTestFile(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestadministration.LoadTestAdministrationClient", "UploadTestFile", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForUploadTestFile prepares the UploadTestFile request.
+func (c LoadTestAdministrationClient) preparerForUploadTestFile(ctx context.Context, id FileId, input []byte, options UploadTestFileOperationOptions) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/octet-stream"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithFile(io.NopCloser(bytes.NewReader(input))),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForUploadTestFile handles the response to the UploadTestFile request. The method always
+// closes the http.Response Body.
+func (c LoadTestAdministrationClient) responderForUploadTestFile(resp *http.Response) (result UploadTestFileResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
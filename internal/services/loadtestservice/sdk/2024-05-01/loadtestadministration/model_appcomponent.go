@@ -0,0 +1,11 @@
+package loadtestadministration
+
+type AppComponent struct {
+	DisplayName    *string `json:"displayName,omitempty"`
+	Kind           *string `json:"kind,omitempty"`
+	ResourceGroup  *string `json:"resourceGroup,omitempty"`
+	ResourceId     string  `json:"resourceId"`
+	ResourceName   string  `json:"resourceName"`
+	ResourceType   string  `json:"resourceType"`
+	SubscriptionId *string `json:"subscriptionId,omitempty"`
+}
@@ -0,0 +1,7 @@
+package loadtestadministration
+
+type LoadTestConfiguration struct {
+	EngineInstances *int64 `json:"engineInstances,omitempty"`
+	QuickStartTest  *bool  `json:"quickStartTest,omitempty"`
+	SplitAllCSVs    *bool  `json:"splitAllCSVs,omitempty"`
+}
@@ -0,0 +1,7 @@
+package loadtestadministration
+
+type PassFailCriteria struct {
+	// PassFailMetrics uses pointer values since this is sent as a JSON Merge Patch,
+	// where a null value removes the metric
+	PassFailMetrics *map[string]*PassFailMetric `json:"passFailMetrics,omitempty"`
+}
@@ -0,0 +1,12 @@
+package loadtestadministration
+
+type PassFailMetric struct {
+	Action       *PFAction  `json:"action,omitempty"`
+	ActualValue  *float64   `json:"actualValue,omitempty"`
+	Aggregate    *PFAgFunc  `json:"aggregate,omitempty"`
+	ClientMetric *PFMetrics `json:"clientMetric,omitempty"`
+	Condition    *string    `json:"condition,omitempty"`
+	RequestName  *string    `json:"requestName,omitempty"`
+	Result       *PFResult  `json:"result,omitempty"`
+	Value        *float64   `json:"value,omitempty"`
+}
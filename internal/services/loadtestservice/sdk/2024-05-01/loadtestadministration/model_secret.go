@@ -0,0 +1,6 @@
+package loadtestadministration
+
+type Secret struct {
+	Type  *SecretType `json:"type,omitempty"`
+	Value *string     `json:"value,omitempty"`
+}
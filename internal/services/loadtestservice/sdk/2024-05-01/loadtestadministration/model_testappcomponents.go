@@ -0,0 +1,12 @@
+package loadtestadministration
+
+type TestAppComponents struct {
+	// Components uses pointer values since this is sent as a JSON Merge Patch,
+	// where a null value removes the component
+	Components           map[string]*AppComponent `json:"components"`
+	CreatedBy            *string                  `json:"createdBy,omitempty"`
+	CreatedDateTime      *string                  `json:"createdDateTime,omitempty"`
+	LastModifiedBy       *string                  `json:"lastModifiedBy,omitempty"`
+	LastModifiedDateTime *string                  `json:"lastModifiedDateTime,omitempty"`
+	TestId               *string                  `json:"testId,omitempty"`
+}
@@ -0,0 +1,10 @@
+package loadtestadministration
+
+type TestFileInfo struct {
+	ExpireDateTime           *string               `json:"expireDateTime,omitempty"`
+	FileName                 string                `json:"fileName"`
+	FileType                 *FileType             `json:"fileType,omitempty"`
+	Url                      *string               `json:"url,omitempty"`
+	ValidationFailureDetails *string               `json:"validationFailureDetails,omitempty"`
+	ValidationStatus         *FileValidationStatus `json:"validationStatus,omitempty"`
+}
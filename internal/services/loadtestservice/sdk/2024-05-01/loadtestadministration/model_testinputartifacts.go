@@ -0,0 +1,10 @@
+package loadtestadministration
+
+type TestInputArtifacts struct {
+	AdditionalFileInfo        *[]TestFileInfo `json:"additionalFileInfo,omitempty"`
+	ConfigFileInfo            *TestFileInfo   `json:"configFileInfo,omitempty"`
+	InputArtifactsZipFileInfo *TestFileInfo   `json:"inputArtifactsZipFileInfo,omitempty"`
+	TestScriptFileInfo        *TestFileInfo   `json:"testScriptFileInfo,omitempty"`
+	UrlTestConfigFileInfo     *TestFileInfo   `json:"urlTestConfigFileInfo,omitempty"`
+	UserPropFileInfo          *TestFileInfo   `json:"userPropFileInfo,omitempty"`
+}
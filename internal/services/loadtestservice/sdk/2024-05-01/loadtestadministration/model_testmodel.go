@@ -0,0 +1,19 @@
+package loadtestadministration
+
+type Test struct {
+	CreatedBy                     *string                `json:"createdBy,omitempty"`
+	CreatedDateTime               *string                `json:"createdDateTime,omitempty"`
+	Description                   *string                `json:"description,omitempty"`
+	DisplayName                   *string                `json:"displayName,omitempty"`
+	EnvironmentVariables          *map[string]*string    `json:"environmentVariables,omitempty"`
+	InputArtifacts                *TestInputArtifacts    `json:"inputArtifacts,omitempty"`
+	KeyvaultReferenceIdentityId   *string                `json:"keyvaultReferenceIdentityId,omitempty"`
+	KeyvaultReferenceIdentityType *string                `json:"keyvaultReferenceIdentityType,omitempty"`
+	Kind                          *TestKind              `json:"kind,omitempty"`
+	LastModifiedBy                *string                `json:"lastModifiedBy,omitempty"`
+	LastModifiedDateTime          *string                `json:"lastModifiedDateTime,omitempty"`
+	LoadTestConfiguration         *LoadTestConfiguration `json:"loadTestConfiguration,omitempty"`
+	PassFailCriteria              *PassFailCriteria      `json:"passFailCriteria,omitempty"`
+	Secrets                       *map[string]*Secret    `json:"secrets,omitempty"`
+	TestId                        *string                `json:"testId,omitempty"`
+}
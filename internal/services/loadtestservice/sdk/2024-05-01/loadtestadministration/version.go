@@ -0,0 +1,9 @@
+package loadtestadministration
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/loadtestadministration/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package loadtestrun
+
+import "github.com/Azure/go-autorest/autorest"
+
+type LoadTestRunClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewLoadTestRunClientWithBaseURI(endpoint string) LoadTestRunClient {
+	return LoadTestRunClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,104 @@
+package loadtestrun
+
+import "strings"
+
+type PFTestResult string
+
+const (
+	PFTestResultFAILED        PFTestResult = "FAILED"
+	PFTestResultNOTAPPLICABLE PFTestResult = "NOT_APPLICABLE"
+	PFTestResultPASSED        PFTestResult = "PASSED"
+)
+
+func PossibleValuesForPFTestResult() []string {
+	return []string{
+		string(PFTestResultFAILED),
+		string(PFTestResultNOTAPPLICABLE),
+		string(PFTestResultPASSED),
+	}
+}
+
+func parsePFTestResult(input string) (*PFTestResult, error) {
+	vals := map[string]PFTestResult{
+		"failed":         PFTestResultFAILED,
+		"not_applicable": PFTestResultNOTAPPLICABLE,
+		"passed":         PFTestResultPASSED,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PFTestResult(input)
+	return &out, nil
+}
+
+type Status string
+
+const (
+	StatusACCEPTED          Status = "ACCEPTED"
+	StatusCANCELLED         Status = "CANCELLED"
+	StatusCANCELLING        Status = "CANCELLING"
+	StatusCONFIGURED        Status = "CONFIGURED"
+	StatusCONFIGURING       Status = "CONFIGURING"
+	StatusDEPROVISIONED     Status = "DEPROVISIONED"
+	StatusDEPROVISIONING    Status = "DEPROVISIONING"
+	StatusDONE              Status = "DONE"
+	StatusEXECUTED          Status = "EXECUTED"
+	StatusEXECUTING         Status = "EXECUTING"
+	StatusFAILED            Status = "FAILED"
+	StatusNOTSTARTED        Status = "NOTSTARTED"
+	StatusPROVISIONED       Status = "PROVISIONED"
+	StatusPROVISIONING      Status = "PROVISIONING"
+	StatusVALIDATIONFAILURE Status = "VALIDATION_FAILURE"
+	StatusVALIDATIONSUCCESS Status = "VALIDATION_SUCCESS"
+)
+
+func PossibleValuesForStatus() []string {
+	return []string{
+		string(StatusACCEPTED),
+		string(StatusCANCELLED),
+		string(StatusCANCELLING),
+		string(StatusCONFIGURED),
+		string(StatusCONFIGURING),
+		string(StatusDEPROVISIONED),
+		string(StatusDEPROVISIONING),
+		string(StatusDONE),
+		string(StatusEXECUTED),
+		string(StatusEXECUTING),
+		string(StatusFAILED),
+		string(StatusNOTSTARTED),
+		string(StatusPROVISIONED),
+		string(StatusPROVISIONING),
+		string(StatusVALIDATIONFAILURE),
+		string(StatusVALIDATIONSUCCESS),
+	}
+}
+
+func parseStatus(input string) (*Status, error) {
+	vals := map[string]Status{
+		"accepted":           StatusACCEPTED,
+		"cancelled":          StatusCANCELLED,
+		"cancelling":         StatusCANCELLING,
+		"configured":         StatusCONFIGURED,
+		"configuring":        StatusCONFIGURING,
+		"deprovisioned":      StatusDEPROVISIONED,
+		"deprovisioning":     StatusDEPROVISIONING,
+		"done":               StatusDONE,
+		"executed":           StatusEXECUTED,
+		"executing":          StatusEXECUTING,
+		"failed":             StatusFAILED,
+		"notstarted":         StatusNOTSTARTED,
+		"provisioned":        StatusPROVISIONED,
+		"provisioning":       StatusPROVISIONING,
+		"validation_failure": StatusVALIDATIONFAILURE,
+		"validation_success": StatusVALIDATIONSUCCESS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Status(input)
+	return &out, nil
+}
@@ -0,0 +1,96 @@
+package loadtestrun
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = TestRunId{}
+
+// TestRunId is a struct representing the Resource ID for a Test Run
+type TestRunId struct {
+	TestRunId string
+}
+
+// NewTestRunID returns a new TestRunId struct
+func NewTestRunID(testRunId string) TestRunId {
+	return TestRunId{
+		TestRunId: testRunId,
+	}
+}
+
+// ParseTestRunID parses 'input' into a TestRunId
+func ParseTestRunID(input string) (*TestRunId, error) {
+	parser := resourceids.NewParserFromResourceIdType(TestRunId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := TestRunId{}
+
+	if id.TestRunId, ok = parsed.Parsed["testRunId"]; !ok {
+		return nil, fmt.Errorf("the segment 'testRunId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseTestRunIDInsensitively parses 'input' case-insensitively into a TestRunId
+// note: this method should only be used for API response data and not user input
+func ParseTestRunIDInsensitively(input string) (*TestRunId, error) {
+	parser := resourceids.NewParserFromResourceIdType(TestRunId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := TestRunId{}
+
+	if id.TestRunId, ok = parsed.Parsed["testRunId"]; !ok {
+		return nil, fmt.Errorf("the segment 'testRunId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateTestRunID checks that 'input' can be parsed as a Test Run ID
+func ValidateTestRunID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseTestRunID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Test Run ID
+func (id TestRunId) ID() string {
+	fmtString := "/test-runs/%s"
+	return fmt.Sprintf(fmtString, id.TestRunId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Test Run ID
+func (id TestRunId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticTestruns", "test-runs", "test-runs"),
+		resourceids.UserSpecifiedSegment("testRunId", "testRunIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Test Run ID
+func (id TestRunId) String() string {
+	components := []string{
+		fmt.Sprintf("Test Run Id: %q", id.TestRunId),
+	}
+	return fmt.Sprintf("Test Run (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,144 @@
+package loadtestrun
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = TestRunId{}
+
+func TestNewTestRunID(t *testing.T) {
+	id := NewTestRunID("testRunIdValue")
+
+	if id.TestRunId != "testRunIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'TestRunId'", id.TestRunId, "testRunIdValue")
+	}
+}
+
+func TestFormatTestRunID(t *testing.T) {
+	actual := NewTestRunID("testRunIdValue").ID()
+	expected := "/test-runs/testRunIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseTestRunID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *TestRunId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/test-runs",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/test-runs/testRunIdValue",
+			Expected: &TestRunId{
+				TestRunId: "testRunIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/test-runs/testRunIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseTestRunID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TestRunId != v.Expected.TestRunId {
+			t.Fatalf("Expected %q but got %q for TestRunId", v.Expected.TestRunId, actual.TestRunId)
+		}
+
+	}
+}
+
+func TestParseTestRunIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *TestRunId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/test-runs",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/TeSt-rUnS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/test-runs/testRunIdValue",
+			Expected: &TestRunId{
+				TestRunId: "testRunIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/test-runs/testRunIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/TeSt-rUnS/TeStRuNiDvAlUe",
+			Expected: &TestRunId{
+				TestRunId: "TeStRuNiDvAlUe",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/TeSt-rUnS/TeStRuNiDvAlUe/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseTestRunIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.TestRunId != v.Expected.TestRunId {
+			t.Fatalf("Expected %q but got %q for TestRunId", v.Expected.TestRunId, actual.TestRunId)
+		}
+
+	}
+}
@@ -0,0 +1,65 @@
+package loadtestrun
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateOrUpdateTestRunResponse struct {
+	HttpResponse *http.Response
+	Model        *TestRun
+}
+
+// CreateOrUpdateTestRun ...
+func (c LoadTestRunClient) CreateOrUpdateTestRun(ctx context.Context, id TestRunId, input TestRun) (result CreateOrUpdateTestRunResponse, err error) {
+	req, err := c.preparerForCreateOrUpdateTestRun(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "CreateOrUpdateTestRun", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "CreateOrUpdateTestRun", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateOrUpdateTestRun(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "CreateOrUpdateTestRun", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateOrUpdateTestRun prepares the CreateOrUpdateTestRun request.
+func (c LoadTestRunClient) preparerForCreateOrUpdateTestRun(ctx context.Context, id TestRunId, input TestRun) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/merge-patch+json"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateOrUpdateTestRun handles the response to the CreateOrUpdateTestRun request. The method always
+// closes the http.Response Body.
+func (c LoadTestRunClient) responderForCreateOrUpdateTestRun(resp *http.Response) (result CreateOrUpdateTestRunResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,61 @@
+package loadtestrun
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DeleteTestRunResponse struct {
+	HttpResponse *http.Response
+}
+
+// DeleteTestRun ...
+func (c LoadTestRunClient) DeleteTestRun(ctx context.Context, id TestRunId) (result DeleteTestRunResponse, err error) {
+	req, err := c.preparerForDeleteTestRun(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "DeleteTestRun", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "DeleteTestRun", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDeleteTestRun(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "DeleteTestRun", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDeleteTestRun prepares the DeleteTestRun request.
+func (c LoadTestRunClient) preparerForDeleteTestRun(ctx context.Context, id TestRunId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDeleteTestRun handles the response to the DeleteTestRun request. The method always
+// closes the http.Response Body.
+func (c LoadTestRunClient) responderForDeleteTestRun(resp *http.Response) (result DeleteTestRunResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package loadtestrun
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetTestRunResponse struct {
+	HttpResponse *http.Response
+	Model        *TestRun
+}
+
+// GetTestRun ...
+func (c LoadTestRunClient) GetTestRun(ctx context.Context, id TestRunId) (result GetTestRunResponse, err error) {
+	req, err := c.preparerForGetTestRun(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "GetTestRun", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "GetTestRun", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetTestRun(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "GetTestRun", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetTestRun prepares the GetTestRun request.
+func (c LoadTestRunClient) preparerForGetTestRun(ctx context.Context, id TestRunId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetTestRun handles the response to the GetTestRun request. The method always
+// closes the http.Response Body.
+func (c LoadTestRunClient) responderForGetTestRun(resp *http.Response) (result GetTestRunResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package loadtestrun
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type StopTestRunResponse struct {
+	HttpResponse *http.Response
+	Model        *TestRun
+}
+
+// StopTestRun ...
+func (c LoadTestRunClient) StopTestRun(ctx context.Context, id TestRunId) (result StopTestRunResponse, err error) {
+	req, err := c.preparerForStopTestRun(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "StopTestRun", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "StopTestRun", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForStopTestRun(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "loadtestrun.LoadTestRunClient", "StopTestRun", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForStopTestRun prepares the StopTestRun request.
+func (c LoadTestRunClient) preparerForStopTestRun(ctx context.Context, id TestRunId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s:stop", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForStopTestRun handles the response to the StopTestRun request. The method always
+// closes the http.Response Body.
+func (c LoadTestRunClient) responderForStopTestRun(resp *http.Response) (result StopTestRunResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
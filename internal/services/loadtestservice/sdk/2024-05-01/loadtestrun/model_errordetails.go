@@ -0,0 +1,5 @@
+package loadtestrun
+
+type ErrorDetails struct {
+	Message *string `json:"message,omitempty"`
+}
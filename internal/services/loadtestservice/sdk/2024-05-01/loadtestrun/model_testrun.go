@@ -0,0 +1,17 @@
+package loadtestrun
+
+type TestRun struct {
+	Description      *string         `json:"description,omitempty"`
+	DisplayName      *string         `json:"displayName,omitempty"`
+	Duration         *int64          `json:"duration,omitempty"`
+	EndDateTime      *string         `json:"endDateTime,omitempty"`
+	ErrorDetails     *[]ErrorDetails `json:"errorDetails,omitempty"`
+	ExecutedDateTime *string         `json:"executedDateTime,omitempty"`
+	PortalUrl        *string         `json:"portalUrl,omitempty"`
+	StartDateTime    *string         `json:"startDateTime,omitempty"`
+	Status           *Status         `json:"status,omitempty"`
+	TestId           *string         `json:"testId,omitempty"`
+	TestResult       *PFTestResult   `json:"testResult,omitempty"`
+	TestRunId        *string         `json:"testRunId,omitempty"`
+	VirtualUsers     *int64          `json:"virtualUsers,omitempty"`
+}
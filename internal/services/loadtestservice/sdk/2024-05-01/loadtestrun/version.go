@@ -0,0 +1,9 @@
+package loadtestrun
+
+import "fmt"
+
+const defaultApiVersion = "2024-05-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/loadtestrun/%s", defaultApiVersion)
+}
@@ -0,0 +1,278 @@
+package machinelearning
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaceconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAIFoundryConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAIFoundryConnectionCreateUpdate,
+		Read:   resourceAIFoundryConnectionRead,
+		Update: resourceAIFoundryConnectionCreateUpdate,
+		Delete: resourceAIFoundryConnectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := workspaceconnections.ParseConnectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceConnectionName,
+			},
+
+			// connections can be created on either a hub (and shared with its projects) or a single project
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: workspaces.ValidateWorkspaceID,
+			},
+
+			"category": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(workspaceconnections.ConnectionCategoryAIServices),
+					string(workspaceconnections.ConnectionCategoryAzureBlob),
+					string(workspaceconnections.ConnectionCategoryAzureOpenAI),
+					string(workspaceconnections.ConnectionCategoryCognitiveSearch),
+					string(workspaceconnections.ConnectionCategoryCognitiveService),
+				}, false),
+			},
+
+			"target": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"auth_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(workspaceconnections.ConnectionAuthTypeAAD),
+					string(workspaceconnections.ConnectionAuthTypeAccountKey),
+					string(workspaceconnections.ConnectionAuthTypeApiKey),
+				}, false),
+			},
+
+			// the key is never returned by the API, so it's not read back into state
+			"key": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"metadata": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"shared_to_all_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAIFoundryConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.WorkspaceConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := workspaces.ParseWorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := workspaceconnections.NewConnectionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_ai_foundry_connection", id.ID())
+		}
+	}
+
+	properties, err := expandAIFoundryConnectionProperties(d)
+	if err != nil {
+		return err
+	}
+
+	payload := workspaceconnections.WorkspaceConnectionPropertiesV2BasicResource{
+		Properties: properties,
+	}
+
+	if _, err := client.Create(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceAIFoundryConnectionRead(d, meta)
+}
+
+func resourceAIFoundryConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.WorkspaceConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaceconnections.ParseConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ConnectionName)
+	d.Set("workspace_id", workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		var authType workspaceconnections.ConnectionAuthType
+		var category *workspaceconnections.ConnectionCategory
+		var isSharedToAll *bool
+		var metadata *map[string]string
+		var target *string
+
+		switch props := model.Properties.(type) {
+		case workspaceconnections.AADAuthTypeWorkspaceConnectionProperties:
+			authType = workspaceconnections.ConnectionAuthTypeAAD
+			category, isSharedToAll, metadata, target = props.Category, props.IsSharedToAll, props.Metadata, props.Target
+		case workspaceconnections.AccountKeyAuthTypeWorkspaceConnectionProperties:
+			authType = workspaceconnections.ConnectionAuthTypeAccountKey
+			category, isSharedToAll, metadata, target = props.Category, props.IsSharedToAll, props.Metadata, props.Target
+		case workspaceconnections.ApiKeyAuthWorkspaceConnectionProperties:
+			authType = workspaceconnections.ConnectionAuthTypeApiKey
+			category, isSharedToAll, metadata, target = props.Category, props.IsSharedToAll, props.Metadata, props.Target
+		default:
+			return fmt.Errorf("%s uses an unsupported authentication type", *id)
+		}
+
+		d.Set("auth_type", string(authType))
+
+		categoryValue := ""
+		if category != nil {
+			categoryValue = string(*category)
+		}
+		d.Set("category", categoryValue)
+		d.Set("shared_to_all_enabled", isSharedToAll != nil && *isSharedToAll)
+		d.Set("target", target)
+
+		metadataValue := make(map[string]interface{})
+		if metadata != nil {
+			for k, v := range *metadata {
+				metadataValue[k] = v
+			}
+		}
+		if err := d.Set("metadata", metadataValue); err != nil {
+			return fmt.Errorf("setting `metadata`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAIFoundryConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.WorkspaceConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaceconnections.ParseConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandAIFoundryConnectionProperties(d *pluginsdk.ResourceData) (workspaceconnections.WorkspaceConnectionPropertiesV2, error) {
+	category := workspaceconnections.ConnectionCategory(d.Get("category").(string))
+	isSharedToAll := utils.Bool(d.Get("shared_to_all_enabled").(bool))
+	target := utils.String(d.Get("target").(string))
+
+	metadata := make(map[string]string)
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+
+	key := d.Get("key").(string)
+	authType := workspaceconnections.ConnectionAuthType(d.Get("auth_type").(string))
+	if authType != workspaceconnections.ConnectionAuthTypeAAD && key == "" {
+		return nil, fmt.Errorf("`key` must be specified when `auth_type` is `%s`", authType)
+	}
+	if authType == workspaceconnections.ConnectionAuthTypeAAD && key != "" {
+		return nil, fmt.Errorf("`key` cannot be specified when `auth_type` is `%s`", authType)
+	}
+
+	switch authType {
+	case workspaceconnections.ConnectionAuthTypeAccountKey:
+		return workspaceconnections.AccountKeyAuthTypeWorkspaceConnectionProperties{
+			Category:      &category,
+			Credentials:   &workspaceconnections.WorkspaceConnectionAccountKey{Key: utils.String(key)},
+			IsSharedToAll: isSharedToAll,
+			Metadata:      &metadata,
+			Target:        target,
+		}, nil
+	case workspaceconnections.ConnectionAuthTypeApiKey:
+		return workspaceconnections.ApiKeyAuthWorkspaceConnectionProperties{
+			Category:      &category,
+			Credentials:   &workspaceconnections.WorkspaceConnectionApiKey{Key: utils.String(key)},
+			IsSharedToAll: isSharedToAll,
+			Metadata:      &metadata,
+			Target:        target,
+		}, nil
+	}
+
+	return workspaceconnections.AADAuthTypeWorkspaceConnectionProperties{
+		Category:      &category,
+		IsSharedToAll: isSharedToAll,
+		Metadata:      &metadata,
+		Target:        target,
+	}, nil
+}
@@ -0,0 +1,198 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaceconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AIFoundryConnectionResource struct{}
+
+func TestAccAIFoundryConnection_apiKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+	})
+}
+
+func TestAccAIFoundryConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAIFoundryConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+		{
+			Config: r.aad(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auth_type").HasValue("AAD"),
+			),
+		},
+		data.ImportStep("key"),
+	})
+}
+
+func TestAccAIFoundryConnection_storageOnProject(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageOnProject(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key"),
+	})
+}
+
+func (r AIFoundryConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspaceconnections.ParseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.WorkspaceConnectionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r AIFoundryConnectionResource) apiKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestconn%d"
+  workspace_id = azurerm_ai_foundry.test.id
+  category     = "AzureOpenAI"
+  target       = azurerm_cognitive_account.test.endpoint
+  auth_type    = "ApiKey"
+  key          = azurerm_cognitive_account.test.primary_access_key
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_cognitive_account.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnectionResource) aad(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name                  = "acctestconn%d"
+  workspace_id          = azurerm_ai_foundry.test.id
+  category              = "AzureOpenAI"
+  target                = azurerm_cognitive_account.test.endpoint
+  auth_type             = "AAD"
+  shared_to_all_enabled = false
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_cognitive_account.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnectionResource) storageOnProject(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name                  = "data"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestconn%d"
+  workspace_id = azurerm_ai_foundry_project.test.id
+  category     = "AzureBlob"
+  target       = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"
+  auth_type    = "AccountKey"
+  key          = azurerm_storage_account.test.primary_access_key
+
+  metadata = {
+    AccountName   = azurerm_storage_account.test.name
+    ContainerName = azurerm_storage_container.test.name
+  }
+}
+`, AIFoundryProjectResource{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "import" {
+  name         = azurerm_ai_foundry_connection.test.name
+  workspace_id = azurerm_ai_foundry_connection.test.workspace_id
+  category     = azurerm_ai_foundry_connection.test.category
+  target       = azurerm_ai_foundry_connection.test.target
+  auth_type    = azurerm_ai_foundry_connection.test.auth_type
+  key          = azurerm_cognitive_account.test.primary_access_key
+}
+`, r.apiKey(data))
+}
+
+func (r AIFoundryConnectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                  = "acctestcogacc-%d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  kind                  = "OpenAI"
+  sku_name              = "S0"
+  custom_subdomain_name = "acctestcogacc-%d"
+}
+`, AIFoundryResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,284 @@
+package machinelearning
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceAIFoundryProject() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAIFoundryProjectCreate,
+		Read:   resourceAIFoundryProjectRead,
+		Update: resourceAIFoundryProjectUpdate,
+		Delete: resourceAIFoundryProjectDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := workspaces.ParseWorkspaceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceName,
+			},
+
+			// a project must be created in the same location as its hub
+			"location": commonschema.Location(),
+
+			"ai_services_hub_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     workspaces.ValidateWorkspaceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"identity": aiFoundryIdentitySchema(false),
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"friendly_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"high_business_impact_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"primary_user_assigned_identity": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"project_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceAIFoundryProjectCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	hubId, err := workspaces.ParseWorkspaceID(d.Get("ai_services_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// projects are always created alongside their hub
+	id := workspaces.NewWorkspaceID(hubId.SubscriptionId, hubId.ResourceGroupName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_ai_foundry_project", id.ID())
+	}
+
+	identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	skuTier := workspaces.SkuTierBasic
+	payload := workspaces.Workspace{
+		Identity: identityValue,
+		Kind:     utils.String(aiFoundryKindProject),
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &workspaces.WorkspaceProperties{
+			HbiWorkspace:  utils.Bool(d.Get("high_business_impact_enabled").(bool)),
+			HubResourceId: utils.String(hubId.ID()),
+		},
+		Sku: &workspaces.Sku{
+			Name: "Basic",
+			Tier: &skuTier,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		payload.Properties.Description = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("friendly_name"); ok {
+		payload.Properties.FriendlyName = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("primary_user_assigned_identity"); ok {
+		payload.Properties.PrimaryUserAssignedIdentity = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceAIFoundryProjectRead(d, meta)
+}
+
+func resourceAIFoundryProjectRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaces.ParseWorkspaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.WorkspaceName)
+
+	if model := resp.Model; model != nil {
+		if model.Kind == nil || !strings.EqualFold(*model.Kind, aiFoundryKindProject) {
+			return fmt.Errorf("%s is not an AI Foundry project", *id)
+		}
+
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			hubId := ""
+			if props.HubResourceId != nil {
+				parsedHubId, err := workspaces.ParseWorkspaceIDInsensitively(*props.HubResourceId)
+				if err != nil {
+					return fmt.Errorf("parsing `hubResourceId`: %+v", err)
+				}
+				hubId = parsedHubId.ID()
+			}
+			d.Set("ai_services_hub_id", hubId)
+			d.Set("description", props.Description)
+			d.Set("friendly_name", props.FriendlyName)
+			d.Set("high_business_impact_enabled", props.HbiWorkspace)
+			d.Set("primary_user_assigned_identity", props.PrimaryUserAssignedIdentity)
+			d.Set("project_id", props.WorkspaceId)
+		}
+
+		if err := d.Set("tags", tags.Flatten(model.Tags)); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAIFoundryProjectUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaces.ParseWorkspaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := workspaces.WorkspaceUpdateParameters{
+		Properties: &workspaces.WorkspacePropertiesUpdateParameters{},
+	}
+
+	if d.HasChange("identity") {
+		identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		payload.Identity = identityValue
+	}
+
+	if d.HasChange("description") {
+		payload.Properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("friendly_name") {
+		payload.Properties.FriendlyName = utils.String(d.Get("friendly_name").(string))
+	}
+
+	if d.HasChange("primary_user_assigned_identity") {
+		payload.Properties.PrimaryUserAssignedIdentity = utils.String(d.Get("primary_user_assigned_identity").(string))
+	}
+
+	if d.HasChange("tags") {
+		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceAIFoundryProjectRead(d, meta)
+}
+
+func resourceAIFoundryProjectDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaces.ParseWorkspaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
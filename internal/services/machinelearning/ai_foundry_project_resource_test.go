@@ -0,0 +1,133 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AIFoundryProjectResource struct{}
+
+func TestAccAIFoundryProject_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_project", "test")
+	r := AIFoundryProjectResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("project_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryProject_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_project", "test")
+	r := AIFoundryProjectResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAIFoundryProject_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_project", "test")
+	r := AIFoundryProjectResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AIFoundryProjectResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workspaces.ParseWorkspaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.AIFoundryClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r AIFoundryProjectResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_project" "test" {
+  name               = "acctestaip-%d"
+  location           = azurerm_ai_foundry.test.location
+  ai_services_hub_id = azurerm_ai_foundry.test.id
+}
+`, AIFoundryResource{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryProjectResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_project" "test" {
+  name               = "acctestaip-%d"
+  location           = azurerm_ai_foundry.test.location
+  ai_services_hub_id = azurerm_ai_foundry.test.id
+  description        = "AI Foundry project for acceptance tests"
+  friendly_name      = "acctest project"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, AIFoundryResource{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryProjectResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_project" "import" {
+  name               = azurerm_ai_foundry_project.test.name
+  location           = azurerm_ai_foundry_project.test.location
+  ai_services_hub_id = azurerm_ai_foundry_project.test.ai_services_hub_id
+}
+`, r.basic(data))
+}
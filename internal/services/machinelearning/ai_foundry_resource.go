@@ -0,0 +1,385 @@
+package machinelearning
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// AI Foundry hubs and projects are Machine Learning workspaces of a specific kind
+const (
+	aiFoundryKindHub     = "Hub"
+	aiFoundryKindProject = "Project"
+)
+
+func resourceAIFoundry() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceAIFoundryCreate,
+		Read:   resourceAIFoundryRead,
+		Update: resourceAIFoundryUpdate,
+		Delete: resourceAIFoundryDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := workspaces.ParseWorkspaceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceName,
+			},
+
+			"location": commonschema.Location(),
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"storage_account_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"key_vault_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     keyVaultValidate.VaultID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"identity": aiFoundryIdentitySchema(true),
+
+			"application_insights_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"container_registry_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"friendly_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"high_business_impact_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"managed_network": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"isolation_mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(workspaces.IsolationModeAllowInternetOutbound),
+								string(workspaces.IsolationModeAllowOnlyApprovedOutbound),
+								string(workspaces.IsolationModeDisabled),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"primary_user_assigned_identity": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"discovery_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"workspace_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceAIFoundryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := workspaces.NewWorkspaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_ai_foundry", id.ID())
+	}
+
+	identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	skuTier := workspaces.SkuTierBasic
+	payload := workspaces.Workspace{
+		Identity: identityValue,
+		Kind:     utils.String(aiFoundryKindHub),
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Properties: &workspaces.WorkspaceProperties{
+			HbiWorkspace:        utils.Bool(d.Get("high_business_impact_enabled").(bool)),
+			KeyVault:            utils.String(d.Get("key_vault_id").(string)),
+			ManagedNetwork:      expandMachineLearningWorkspaceManagedNetwork(d.Get("managed_network").([]interface{})),
+			PublicNetworkAccess: expandAIFoundryPublicNetworkAccess(d.Get("public_network_access_enabled").(bool)),
+			StorageAccount:      utils.String(d.Get("storage_account_id").(string)),
+		},
+		Sku: &workspaces.Sku{
+			Name: "Basic",
+			Tier: &skuTier,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("application_insights_id"); ok {
+		payload.Properties.ApplicationInsights = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("container_registry_id"); ok {
+		payload.Properties.ContainerRegistry = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("description"); ok {
+		payload.Properties.Description = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("friendly_name"); ok {
+		payload.Properties.FriendlyName = utils.String(v.(string))
+	}
+	if v, ok := d.GetOk("primary_user_assigned_identity"); ok {
+		payload.Properties.PrimaryUserAssignedIdentity = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceAIFoundryRead(d, meta)
+}
+
+func resourceAIFoundryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaces.ParseWorkspaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.WorkspaceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if model.Kind == nil || !strings.EqualFold(*model.Kind, aiFoundryKindHub) {
+			return fmt.Errorf("%s is not an AI Foundry hub", *id)
+		}
+
+		d.Set("location", location.NormalizeNilable(model.Location))
+
+		flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		if props := model.Properties; props != nil {
+			d.Set("application_insights_id", props.ApplicationInsights)
+			d.Set("container_registry_id", props.ContainerRegistry)
+			d.Set("description", props.Description)
+			d.Set("discovery_url", props.DiscoveryUrl)
+			d.Set("friendly_name", props.FriendlyName)
+			d.Set("high_business_impact_enabled", props.HbiWorkspace)
+			d.Set("key_vault_id", props.KeyVault)
+			d.Set("primary_user_assigned_identity", props.PrimaryUserAssignedIdentity)
+			d.Set("public_network_access_enabled", props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == workspaces.PublicNetworkAccessTypeEnabled)
+			d.Set("storage_account_id", props.StorageAccount)
+			d.Set("workspace_id", props.WorkspaceId)
+
+			if err := d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(props.ManagedNetwork)); err != nil {
+				return fmt.Errorf("setting `managed_network`: %+v", err)
+			}
+		}
+
+		if err := d.Set("tags", tags.Flatten(model.Tags)); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAIFoundryUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaces.ParseWorkspaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := workspaces.WorkspaceUpdateParameters{
+		Properties: &workspaces.WorkspacePropertiesUpdateParameters{},
+	}
+
+	if d.HasChange("identity") {
+		identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		payload.Identity = identityValue
+	}
+
+	if d.HasChange("application_insights_id") {
+		payload.Properties.ApplicationInsights = utils.String(d.Get("application_insights_id").(string))
+	}
+
+	if d.HasChange("container_registry_id") {
+		payload.Properties.ContainerRegistry = utils.String(d.Get("container_registry_id").(string))
+	}
+
+	if d.HasChange("description") {
+		payload.Properties.Description = utils.String(d.Get("description").(string))
+	}
+
+	if d.HasChange("friendly_name") {
+		payload.Properties.FriendlyName = utils.String(d.Get("friendly_name").(string))
+	}
+
+	if d.HasChange("managed_network") {
+		payload.Properties.ManagedNetwork = expandMachineLearningWorkspaceManagedNetwork(d.Get("managed_network").([]interface{}))
+	}
+
+	if d.HasChange("primary_user_assigned_identity") {
+		payload.Properties.PrimaryUserAssignedIdentity = utils.String(d.Get("primary_user_assigned_identity").(string))
+	}
+
+	if d.HasChange("public_network_access_enabled") {
+		payload.Properties.PublicNetworkAccess = expandAIFoundryPublicNetworkAccess(d.Get("public_network_access_enabled").(bool))
+	}
+
+	if d.HasChange("tags") {
+		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceAIFoundryRead(d, meta)
+}
+
+func resourceAIFoundryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.AIFoundryClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workspaces.ParseWorkspaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// aiFoundryIdentitySchema returns the shared identity schema, which hubs require and projects make optional
+func aiFoundryIdentitySchema(required bool) *pluginsdk.Schema {
+	s := commonschema.SystemAssignedUserAssignedIdentity()
+	if required {
+		s.Optional = false
+		s.Required = true
+	}
+	return s
+}
+
+func expandAIFoundryPublicNetworkAccess(enabled bool) *workspaces.PublicNetworkAccessType {
+	publicNetworkAccess := workspaces.PublicNetworkAccessTypeDisabled
+	if enabled {
+		publicNetworkAccess = workspaces.PublicNetworkAccessTypeEnabled
+	}
+	return &publicNetworkAccess
+}
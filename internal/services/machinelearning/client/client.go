@@ -3,11 +3,28 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/machinelearningservices/mgmt/2021-07-01/machinelearningservices"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/batchdeployment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/batchendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/machinelearningcomputes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/registry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaceconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaces"
 )
 
 type Client struct {
-	WorkspacesClient             *machinelearningservices.WorkspacesClient
-	MachineLearningComputeClient *machinelearningservices.ComputeClient
+	WorkspacesClient               *machinelearningservices.WorkspacesClient
+	MachineLearningComputeClient   *machinelearningservices.ComputeClient
+	AIFoundryClient                *workspaces.WorkspacesClient
+	BatchDeploymentClient          *batchdeployment.BatchDeploymentClient
+	BatchEndpointClient            *batchendpoint.BatchEndpointClient
+	ComputesClient                 *machinelearningcomputes.MachineLearningComputesClient
+	ManagedNetworkClient           *managednetwork.ManagedNetworkClient
+	RegistryClient                 *registry.RegistryClient
+	ServerlessEndpointClient       *serverlessendpoint.ServerlessEndpointClient
+	WorkspaceConnectionsClient     *workspaceconnections.WorkspaceConnectionsClient
+	WorkspacesManagedNetworkClient *workspaces.WorkspacesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -17,8 +34,44 @@ func NewClient(o *common.ClientOptions) *Client {
 	MachineLearningComputeClient := machinelearningservices.NewComputeClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&MachineLearningComputeClient.Client, o.ResourceManagerAuthorizer)
 
+	AIFoundryClient := workspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AIFoundryClient.Client, o.ResourceManagerAuthorizer)
+
+	BatchDeploymentClient := batchdeployment.NewBatchDeploymentClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&BatchDeploymentClient.Client, o.ResourceManagerAuthorizer)
+
+	BatchEndpointClient := batchendpoint.NewBatchEndpointClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&BatchEndpointClient.Client, o.ResourceManagerAuthorizer)
+
+	ComputesClient := machinelearningcomputes.NewMachineLearningComputesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ComputesClient.Client, o.ResourceManagerAuthorizer)
+
+	ManagedNetworkClient := managednetwork.NewManagedNetworkClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ManagedNetworkClient.Client, o.ResourceManagerAuthorizer)
+
+	RegistryClient := registry.NewRegistryClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&RegistryClient.Client, o.ResourceManagerAuthorizer)
+
+	ServerlessEndpointClient := serverlessendpoint.NewServerlessEndpointClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&ServerlessEndpointClient.Client, o.ResourceManagerAuthorizer)
+
+	WorkspaceConnectionsClient := workspaceconnections.NewWorkspaceConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WorkspaceConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	WorkspacesManagedNetworkClient := workspaces.NewWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WorkspacesManagedNetworkClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		WorkspacesClient:             &WorkspacesClient,
-		MachineLearningComputeClient: &MachineLearningComputeClient,
+		WorkspacesClient:               &WorkspacesClient,
+		MachineLearningComputeClient:   &MachineLearningComputeClient,
+		AIFoundryClient:                &AIFoundryClient,
+		BatchDeploymentClient:          &BatchDeploymentClient,
+		BatchEndpointClient:            &BatchEndpointClient,
+		ComputesClient:                 &ComputesClient,
+		ManagedNetworkClient:           &ManagedNetworkClient,
+		RegistryClient:                 &RegistryClient,
+		ServerlessEndpointClient:       &ServerlessEndpointClient,
+		WorkspaceConnectionsClient:     &WorkspaceConnectionsClient,
+		WorkspacesManagedNetworkClient: &WorkspacesManagedNetworkClient,
 	}
 }
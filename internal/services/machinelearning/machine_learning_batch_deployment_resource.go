@@ -0,0 +1,471 @@
+package machinelearning
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/batchdeployment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/batchendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMachineLearningBatchDeployment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMachineLearningBatchDeploymentCreate,
+		Read:   resourceMachineLearningBatchDeploymentRead,
+		Update: resourceMachineLearningBatchDeploymentUpdate,
+		Delete: resourceMachineLearningBatchDeploymentDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := batchdeployment.ParseBatchDeploymentID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.EndpointName,
+			},
+
+			"batch_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: batchendpoint.ValidateBatchEndpointID,
+			},
+
+			"model_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"compute_cluster_id": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateFunc:     validate.ComputeClusterID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"code_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"code_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"scoring_script": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"environment_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"environment_variables": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"instance_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"max_concurrency_per_instance": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"mini_batch_size": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"error_threshold": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"logging_level": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(batchdeployment.BatchLoggingLevelInfo),
+				ValidateFunc: validation.StringInSlice(batchdeployment.PossibleValuesForBatchLoggingLevel(), false),
+			},
+
+			"output_action": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(batchdeployment.BatchOutputActionAppendRow),
+				ValidateFunc: validation.StringInSlice(batchdeployment.PossibleValuesForBatchOutputAction(), false),
+			},
+
+			"output_file_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "predictions.csv",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"retry_settings": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"max_retries": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"timeout": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "PT30S",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func resourceMachineLearningBatchDeploymentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchDeploymentClient
+	endpointClient := meta.(*clients.Client).MachineLearning.BatchEndpointClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	endpointId, err := batchendpoint.ParseBatchEndpointID(d.Get("batch_endpoint_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := batchdeployment.NewBatchDeploymentID(endpointId.SubscriptionId, endpointId.ResourceGroupName, endpointId.WorkspaceName, endpointId.BatchEndpointName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_machine_learning_batch_deployment", id.ID())
+	}
+
+	// deployments must live in the same location as their endpoint
+	endpoint, err := endpointClient.Get(ctx, *endpointId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *endpointId, err)
+	}
+	if endpoint.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *endpointId)
+	}
+
+	payload := batchdeployment.BatchDeploymentTrackedResource{
+		Location:   endpoint.Model.Location,
+		Properties: expandMachineLearningBatchDeploymentProperties(d),
+		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMachineLearningBatchDeploymentRead(d, meta)
+}
+
+func resourceMachineLearningBatchDeploymentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchDeploymentClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := batchdeployment.ParseBatchDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DeploymentName)
+	d.Set("batch_endpoint_id", batchendpoint.NewBatchEndpointID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.BatchEndpointName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+
+		modelId := ""
+		if props.Model != nil && props.Model.AssetId != nil {
+			modelId = *props.Model.AssetId
+		}
+		d.Set("model_id", modelId)
+		d.Set("compute_cluster_id", props.Compute)
+		d.Set("description", props.Description)
+		d.Set("environment_id", props.EnvironmentId)
+		d.Set("output_file_name", props.OutputFileName)
+
+		if err := d.Set("code_configuration", flattenMachineLearningBatchDeploymentCodeConfiguration(props.CodeConfiguration)); err != nil {
+			return fmt.Errorf("setting `code_configuration`: %+v", err)
+		}
+
+		environmentVariables := make(map[string]interface{})
+		if props.EnvironmentVariables != nil {
+			for k, v := range *props.EnvironmentVariables {
+				environmentVariables[k] = v
+			}
+		}
+		if err := d.Set("environment_variables", environmentVariables); err != nil {
+			return fmt.Errorf("setting `environment_variables`: %+v", err)
+		}
+
+		instanceCount := 1
+		if props.Resources != nil && props.Resources.InstanceCount != nil {
+			instanceCount = int(*props.Resources.InstanceCount)
+		}
+		d.Set("instance_count", instanceCount)
+
+		errorThreshold := -1
+		if props.ErrorThreshold != nil {
+			errorThreshold = int(*props.ErrorThreshold)
+		}
+		d.Set("error_threshold", errorThreshold)
+
+		maxConcurrencyPerInstance := 1
+		if props.MaxConcurrencyPerInstance != nil {
+			maxConcurrencyPerInstance = int(*props.MaxConcurrencyPerInstance)
+		}
+		d.Set("max_concurrency_per_instance", maxConcurrencyPerInstance)
+
+		miniBatchSize := 10
+		if props.MiniBatchSize != nil {
+			miniBatchSize = int(*props.MiniBatchSize)
+		}
+		d.Set("mini_batch_size", miniBatchSize)
+
+		loggingLevel := string(batchdeployment.BatchLoggingLevelInfo)
+		if props.LoggingLevel != nil {
+			loggingLevel = string(*props.LoggingLevel)
+		}
+		d.Set("logging_level", loggingLevel)
+
+		outputAction := string(batchdeployment.BatchOutputActionAppendRow)
+		if props.OutputAction != nil {
+			outputAction = string(*props.OutputAction)
+		}
+		d.Set("output_action", outputAction)
+
+		if err := d.Set("retry_settings", flattenMachineLearningBatchDeploymentRetrySettings(props.RetrySettings)); err != nil {
+			return fmt.Errorf("setting `retry_settings`: %+v", err)
+		}
+
+		if err := d.Set("tags", tags.Flatten(model.Tags)); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMachineLearningBatchDeploymentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchDeploymentClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := batchdeployment.ParseBatchDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+
+	payload := *existing.Model
+	payload.Properties = expandMachineLearningBatchDeploymentProperties(d)
+	payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceMachineLearningBatchDeploymentRead(d, meta)
+}
+
+func resourceMachineLearningBatchDeploymentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.BatchDeploymentClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := batchdeployment.ParseBatchDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMachineLearningBatchDeploymentProperties(d *pluginsdk.ResourceData) batchdeployment.BatchDeployment {
+	loggingLevel := batchdeployment.BatchLoggingLevel(d.Get("logging_level").(string))
+	outputAction := batchdeployment.BatchOutputAction(d.Get("output_action").(string))
+
+	props := batchdeployment.BatchDeployment{
+		Compute:                   utils.String(d.Get("compute_cluster_id").(string)),
+		Description:               utils.String(d.Get("description").(string)),
+		ErrorThreshold:            utils.Int64(int64(d.Get("error_threshold").(int))),
+		LoggingLevel:              &loggingLevel,
+		MaxConcurrencyPerInstance: utils.Int64(int64(d.Get("max_concurrency_per_instance").(int))),
+		MiniBatchSize:             utils.Int64(int64(d.Get("mini_batch_size").(int))),
+		Model: &batchdeployment.AssetReference{
+			AssetId:       utils.String(d.Get("model_id").(string)),
+			ReferenceType: batchdeployment.ReferenceTypeId,
+		},
+		OutputAction:   &outputAction,
+		OutputFileName: utils.String(d.Get("output_file_name").(string)),
+		Resources: &batchdeployment.DeploymentResourceConfiguration{
+			InstanceCount: utils.Int64(int64(d.Get("instance_count").(int))),
+		},
+		RetrySettings: expandMachineLearningBatchDeploymentRetrySettings(d.Get("retry_settings").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("environment_id"); ok {
+		props.EnvironmentId = utils.String(v.(string))
+	}
+
+	if raw := d.Get("code_configuration").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		v := raw[0].(map[string]interface{})
+		props.CodeConfiguration = &batchdeployment.CodeConfiguration{
+			CodeId:        utils.String(v["code_id"].(string)),
+			ScoringScript: v["scoring_script"].(string),
+		}
+	}
+
+	environmentVariables := make(map[string]string)
+	for k, v := range d.Get("environment_variables").(map[string]interface{}) {
+		environmentVariables[k] = v.(string)
+	}
+	props.EnvironmentVariables = &environmentVariables
+
+	return props
+}
+
+func expandMachineLearningBatchDeploymentRetrySettings(input []interface{}) *batchdeployment.BatchRetrySettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &batchdeployment.BatchRetrySettings{
+		MaxRetries: utils.Int64(int64(v["max_retries"].(int))),
+		Timeout:    utils.String(v["timeout"].(string)),
+	}
+}
+
+func flattenMachineLearningBatchDeploymentRetrySettings(input *batchdeployment.BatchRetrySettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	maxRetries := 0
+	if input.MaxRetries != nil {
+		maxRetries = int(*input.MaxRetries)
+	}
+	timeout := ""
+	if input.Timeout != nil {
+		timeout = *input.Timeout
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_retries": maxRetries,
+			"timeout":     timeout,
+		},
+	}
+}
+
+func flattenMachineLearningBatchDeploymentCodeConfiguration(input *batchdeployment.CodeConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	codeId := ""
+	if input.CodeId != nil {
+		codeId = *input.CodeId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code_id":        codeId,
+			"scoring_script": input.ScoringScript,
+		},
+	}
+}
@@ -0,0 +1,158 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/batchdeployment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MachineLearningBatchDeploymentResource struct {
+	modelId string
+}
+
+func TestAccMachineLearningBatchDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_batch_deployment", "test")
+	r := MachineLearningBatchDeploymentResource{
+		modelId: os.Getenv("ARM_TEST_MACHINE_LEARNING_MODEL_ID"),
+	}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningBatchDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_batch_deployment", "test")
+	r := MachineLearningBatchDeploymentResource{
+		modelId: os.Getenv("ARM_TEST_MACHINE_LEARNING_MODEL_ID"),
+	}
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("mini_batch_size").HasValue("5"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MachineLearningBatchDeploymentResource) preCheck(t *testing.T) {
+	if r.modelId == "" {
+		t.Skip("Skipping as `ARM_TEST_MACHINE_LEARNING_MODEL_ID` is not specified")
+	}
+}
+
+func (r MachineLearningBatchDeploymentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := batchdeployment.ParseBatchDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.BatchDeploymentClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MachineLearningBatchDeploymentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_batch_deployment" "test" {
+  name               = "acctestbd%d"
+  batch_endpoint_id  = azurerm_machine_learning_batch_endpoint.test.id
+  model_id           = "%s"
+  compute_cluster_id = azurerm_machine_learning_compute_cluster.test.id
+}
+`, r.template(data), data.RandomIntOfLength(8), r.modelId)
+}
+
+func (r MachineLearningBatchDeploymentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_batch_deployment" "test" {
+  name                         = "acctestbd%d"
+  batch_endpoint_id            = azurerm_machine_learning_batch_endpoint.test.id
+  model_id                     = "%s"
+  compute_cluster_id           = azurerm_machine_learning_compute_cluster.test.id
+  description                  = "acceptance test batch deployment"
+  instance_count               = 1
+  max_concurrency_per_instance = 2
+  mini_batch_size              = 5
+  error_threshold              = 10
+  logging_level                = "Warning"
+  output_action                = "SummaryOnly"
+  output_file_name             = "results.csv"
+
+  environment_variables = {
+    AZUREML_BI_TEXT_INPUT = "1"
+  }
+
+  retry_settings {
+    max_retries = 5
+    timeout     = "PT1M"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomIntOfLength(8), r.modelId)
+}
+
+func (r MachineLearningBatchDeploymentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_compute_cluster" "test" {
+  name                          = "CC-%d"
+  location                      = azurerm_resource_group.test.location
+  vm_priority                   = "LowPriority"
+  vm_size                       = "STANDARD_DS2_V2"
+  machine_learning_workspace_id = azurerm_machine_learning_workspace.test.id
+
+  scale_settings {
+    min_node_count                       = 0
+    max_node_count                       = 1
+    scale_down_nodes_after_idle_duration = "PT30S"
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, MachineLearningBatchEndpointResource{}.basic(data), data.RandomIntOfLength(8))
+}
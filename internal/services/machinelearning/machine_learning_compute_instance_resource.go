@@ -1,6 +1,7 @@
 package machinelearning
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -11,9 +12,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/machinelearningcomputes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -27,6 +30,7 @@ func resourceComputeInstance() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceComputeInstanceCreate,
 		Read:   resourceComputeInstanceRead,
+		Update: resourceComputeInstanceUpdate,
 		Delete: resourceComputeInstanceDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -37,6 +41,7 @@ func resourceComputeInstance() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -102,6 +107,12 @@ func resourceComputeInstance() *pluginsdk.Resource {
 
 			"identity": SystemAssignedUserAssigned{}.Schema(),
 
+			"idle_shutdown_after": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: helpersValidate.ISO8601DurationBetween("PT15M", "P3D"),
+			},
+
 			"local_auth_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -109,6 +120,42 @@ func resourceComputeInstance() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"schedule": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"action": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(machinelearningcomputes.ComputePowerActionStart),
+								string(machinelearningcomputes.ComputePowerActionStop),
+							}, false),
+						},
+
+						"cron_expression": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"time_zone": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "UTC",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
 			"ssh": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -207,6 +254,15 @@ func resourceComputeInstanceCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	d.SetId(id.ID())
 
+	// idle shutdown and schedules aren't available in the API version used by the compute client, so are applied once the instance exists
+	_, hasIdleShutdown := d.GetOk("idle_shutdown_after")
+	_, hasSchedules := d.GetOk("schedule")
+	if hasIdleShutdown || hasSchedules {
+		if err := updateComputeInstanceSchedules(ctx, meta, machinelearningcomputes.NewComputeID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name), d); err != nil {
+			return err
+		}
+	}
+
 	return resourceComputeInstanceRead(d, meta)
 }
 
@@ -263,9 +319,59 @@ func resourceComputeInstanceRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("compute resource %s is not a ComputeInstance Compute", id)
 	}
 
+	computesClient := meta.(*clients.Client).MachineLearning.ComputesClient
+	computeResp, err := computesClient.ComputeGet(ctx, machinelearningcomputes.NewComputeID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving schedules for Machine Learning Compute (%q): %+v", id, err)
+	}
+
+	idleShutdownAfter := ""
+	schedules := make([]interface{}, 0)
+	if model := computeResp.Model; model != nil {
+		if instance, ok := model.Properties.(machinelearningcomputes.ComputeInstance); ok && instance.Properties != nil {
+			if instance.Properties.IdleTimeBeforeShutdown != nil {
+				idleShutdownAfter = *instance.Properties.IdleTimeBeforeShutdown
+			}
+			schedules = flattenComputeInstanceSchedules(instance.Properties.Schedules)
+		}
+	}
+	d.Set("idle_shutdown_after", idleShutdownAfter)
+	if err := d.Set("schedule", schedules); err != nil {
+		return fmt.Errorf("setting `schedule`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
+func resourceComputeInstanceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.ComputesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ComputeID(d.Id())
+	if err != nil {
+		return err
+	}
+	computeId := machinelearningcomputes.NewComputeID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name)
+
+	if d.HasChange("idle_shutdown_after") {
+		payload := machinelearningcomputes.IdleShutdownSetting{
+			IdleTimeBeforeShutdown: utils.String(d.Get("idle_shutdown_after").(string)),
+		}
+		if _, err := client.ComputeUpdateIdleShutdownSetting(ctx, computeId, payload); err != nil {
+			return fmt.Errorf("updating idle shutdown setting for Machine Learning Compute (%q): %+v", id, err)
+		}
+	}
+
+	if d.HasChange("schedule") {
+		if err := updateComputeInstanceSchedules(ctx, meta, computeId, d); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeInstanceRead(d, meta)
+}
+
 func resourceComputeInstanceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MachineLearning.MachineLearningComputeClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -286,6 +392,107 @@ func resourceComputeInstanceDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+// updateComputeInstanceSchedules applies the idle shutdown and schedule settings to an existing Compute Instance,
+// retrieving the instance first so that the remaining properties are sent back unchanged
+func updateComputeInstanceSchedules(ctx context.Context, meta interface{}, id machinelearningcomputes.ComputeId, d *pluginsdk.ResourceData) error {
+	client := meta.(*clients.Client).MachineLearning.ComputesClient
+
+	existing, err := client.ComputeGet(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+
+	instance, ok := existing.Model.Properties.(machinelearningcomputes.ComputeInstance)
+	if !ok {
+		return fmt.Errorf("%s is not a ComputeInstance Compute", id)
+	}
+	if instance.Properties == nil {
+		instance.Properties = &machinelearningcomputes.ComputeInstanceProperties{}
+	}
+
+	if v := d.Get("idle_shutdown_after").(string); v != "" {
+		instance.Properties.IdleTimeBeforeShutdown = utils.String(v)
+	}
+	instance.Properties.Schedules = expandComputeInstanceSchedules(d.Get("schedule").([]interface{}))
+
+	payload := *existing.Model
+	payload.Properties = instance
+	if err := client.ComputeCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("updating schedules for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandComputeInstanceSchedules(input []interface{}) *machinelearningcomputes.ComputeSchedules {
+	schedules := make([]machinelearningcomputes.ComputeStartStopSchedule, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		action := machinelearningcomputes.ComputePowerAction(v["action"].(string))
+		status := machinelearningcomputes.ScheduleStatusDisabled
+		if v["enabled"].(bool) {
+			status = machinelearningcomputes.ScheduleStatusEnabled
+		}
+		triggerType := machinelearningcomputes.TriggerTypeCron
+
+		schedules = append(schedules, machinelearningcomputes.ComputeStartStopSchedule{
+			Action: &action,
+			Cron: &machinelearningcomputes.Cron{
+				Expression: utils.String(v["cron_expression"].(string)),
+				TimeZone:   utils.String(v["time_zone"].(string)),
+			},
+			Status:      &status,
+			TriggerType: &triggerType,
+		})
+	}
+
+	return &machinelearningcomputes.ComputeSchedules{
+		ComputeStartStop: &schedules,
+	}
+}
+
+func flattenComputeInstanceSchedules(input *machinelearningcomputes.ComputeSchedules) []interface{} {
+	if input == nil || input.ComputeStartStop == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0)
+	for _, schedule := range *input.ComputeStartStop {
+		// only cron based schedules can be managed by this resource
+		if schedule.Cron == nil {
+			continue
+		}
+
+		action := ""
+		if schedule.Action != nil {
+			action = string(*schedule.Action)
+		}
+
+		cronExpression := ""
+		if schedule.Cron.Expression != nil {
+			cronExpression = *schedule.Cron.Expression
+		}
+
+		timeZone := ""
+		if schedule.Cron.TimeZone != nil {
+			timeZone = *schedule.Cron.TimeZone
+		}
+
+		results = append(results, map[string]interface{}{
+			"action":          action,
+			"cron_expression": cronExpression,
+			"time_zone":       timeZone,
+			"enabled":         schedule.Status == nil || *schedule.Status == machinelearningcomputes.ScheduleStatusEnabled,
+		})
+	}
+
+	return results
+}
+
 func expandComputePersonalComputeInstanceSetting(input []interface{}) *machinelearningservices.PersonalComputeInstanceSettings {
 	if len(input) == 0 {
 		return nil
@@ -103,6 +103,35 @@ func TestAccComputeInstance_identity(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_schedules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_compute_instance", "test")
+	r := ComputeInstanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.schedules(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.schedulesUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ComputeInstanceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	computeClient := client.MachineLearning.MachineLearningComputeClient
 	id, err := parse.ComputeID(state.ID)
@@ -136,6 +165,56 @@ resource "azurerm_machine_learning_compute_instance" "test" {
 `, template, data.RandomIntOfLength(8))
 }
 
+func (r ComputeInstanceResource) schedules(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_compute_instance" "test" {
+  name                          = "acctest%d"
+  location                      = azurerm_resource_group.test.location
+  machine_learning_workspace_id = azurerm_machine_learning_workspace.test.id
+  virtual_machine_size          = "STANDARD_DS2_V2"
+  local_auth_enabled            = false
+  idle_shutdown_after           = "PT30M"
+
+  schedule {
+    action          = "Stop"
+    cron_expression = "0 18 * * 1-5"
+  }
+}
+`, template, data.RandomIntOfLength(8))
+}
+
+func (r ComputeInstanceResource) schedulesUpdated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_compute_instance" "test" {
+  name                          = "acctest%d"
+  location                      = azurerm_resource_group.test.location
+  machine_learning_workspace_id = azurerm_machine_learning_workspace.test.id
+  virtual_machine_size          = "STANDARD_DS2_V2"
+  local_auth_enabled            = false
+  idle_shutdown_after           = "PT1H"
+
+  schedule {
+    action          = "Start"
+    cron_expression = "0 8 * * 1-5"
+    time_zone       = "Pacific Standard Time"
+  }
+
+  schedule {
+    action          = "Stop"
+    cron_expression = "0 18 * * 1-5"
+    time_zone       = "Pacific Standard Time"
+    enabled         = false
+  }
+}
+`, template, data.RandomIntOfLength(8))
+}
+
 func (r ComputeInstanceResource) complete(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,464 @@
+package machinelearning
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/registry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMachineLearningRegistry() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMachineLearningRegistryCreate,
+		Read:   resourceMachineLearningRegistryRead,
+		Update: resourceMachineLearningRegistryUpdate,
+		Delete: resourceMachineLearningRegistryDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := registry.ParseRegistryID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RegistryName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			"location": commonschema.Location(),
+
+			"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+			"primary_region": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: machineLearningRegistryRegionSchema(false),
+				},
+			},
+
+			"replication_region": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: machineLearningRegistryRegionSchema(true),
+				},
+			},
+
+			"public_network_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"discovery_url": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"intellectual_property_publisher": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"managed_resource_group_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"ml_flow_registry_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"tags": commonschema.Tags(),
+		},
+	}
+}
+
+func machineLearningRegistryRegionSchema(includeLocation bool) map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"storage_account_type": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "Standard_LRS",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Standard_LRS",
+				"Standard_GRS",
+				"Standard_RAGRS",
+				"Standard_ZRS",
+				"Standard_GZRS",
+				"Standard_RAGZRS",
+				"Premium_LRS",
+				"Premium_ZRS",
+			}, false),
+		},
+
+		"hns_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"container_registry_sku": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "Premium",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Premium",
+			}, false),
+		},
+
+		"system_created_container_registry_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"system_created_storage_account_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+
+	if includeLocation {
+		s["location"] = commonschema.LocationWithoutForceNew()
+	}
+
+	return s
+}
+
+func resourceMachineLearningRegistryCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.RegistryClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := registry.NewRegistryID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_machine_learning_registry", id.ID())
+	}
+
+	identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	primaryLocation := location.Normalize(d.Get("location").(string))
+	regions := expandMachineLearningRegistryRegions(primaryLocation, d.Get("primary_region").([]interface{}), d.Get("replication_region").([]interface{}), nil)
+
+	payload := registry.RegistryTrackedResource{
+		Identity: identityValue,
+		Location: primaryLocation,
+		Properties: registry.Registry{
+			PublicNetworkAccess: expandMachineLearningRegistryPublicNetworkAccess(d.Get("public_network_access_enabled").(bool)),
+			RegionDetails:       regions,
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMachineLearningRegistryRead(d, meta)
+}
+
+func resourceMachineLearningRegistryRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.RegistryClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := registry.ParseRegistryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RegistryName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		primaryLocation := location.Normalize(model.Location)
+		d.Set("location", primaryLocation)
+
+		flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
+		props := model.Properties
+		primary, replicas := flattenMachineLearningRegistryRegions(primaryLocation, props.RegionDetails)
+		if err := d.Set("primary_region", primary); err != nil {
+			return fmt.Errorf("setting `primary_region`: %+v", err)
+		}
+		if err := d.Set("replication_region", replicas); err != nil {
+			return fmt.Errorf("setting `replication_region`: %+v", err)
+		}
+
+		publicNetworkAccessEnabled := true
+		if props.PublicNetworkAccess != nil {
+			publicNetworkAccessEnabled = strings.EqualFold(*props.PublicNetworkAccess, "Enabled")
+		}
+		d.Set("public_network_access_enabled", publicNetworkAccessEnabled)
+		d.Set("discovery_url", props.DiscoveryUrl)
+		d.Set("intellectual_property_publisher", props.IntellectualPropertyPublisher)
+		d.Set("ml_flow_registry_uri", props.MlFlowRegistryUri)
+
+		managedResourceGroupId := ""
+		if props.ManagedResourceGroup != nil && props.ManagedResourceGroup.ResourceId != nil {
+			managedResourceGroupId = *props.ManagedResourceGroup.ResourceId
+		}
+		d.Set("managed_resource_group_id", managedResourceGroupId)
+
+		if err := d.Set("tags", tags.Flatten(model.Tags)); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMachineLearningRegistryUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.RegistryClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := registry.ParseRegistryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", *id)
+	}
+	payload := *existing.Model
+
+	if d.HasChange("identity") {
+		identityValue, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		payload.Identity = identityValue
+	}
+
+	if d.HasChange("public_network_access_enabled") {
+		payload.Properties.PublicNetworkAccess = expandMachineLearningRegistryPublicNetworkAccess(d.Get("public_network_access_enabled").(bool))
+	}
+
+	if d.HasChange("replication_region") {
+		// regions which already exist are sent back as-is so that the system created accounts are retained
+		payload.Properties.RegionDetails = expandMachineLearningRegistryRegions(location.Normalize(payload.Location), d.Get("primary_region").([]interface{}), d.Get("replication_region").([]interface{}), payload.Properties.RegionDetails)
+	}
+
+	if d.HasChange("tags") {
+		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceMachineLearningRegistryRead(d, meta)
+}
+
+func resourceMachineLearningRegistryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.RegistryClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := registry.ParseRegistryID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMachineLearningRegistryPublicNetworkAccess(enabled bool) *string {
+	if enabled {
+		return utils.String("Enabled")
+	}
+	return utils.String("Disabled")
+}
+
+func expandMachineLearningRegistryRegions(primaryLocation string, primaryInput []interface{}, replicaInput []interface{}, existing *[]registry.RegistryRegionArmDetails) *[]registry.RegistryRegionArmDetails {
+	existingRegions := make(map[string]registry.RegistryRegionArmDetails)
+	if existing != nil {
+		for _, v := range *existing {
+			if v.Location != nil {
+				existingRegions[location.Normalize(*v.Location)] = v
+			}
+		}
+	}
+
+	primary := map[string]interface{}{}
+	if len(primaryInput) > 0 && primaryInput[0] != nil {
+		primary = primaryInput[0].(map[string]interface{})
+	}
+
+	regions := []registry.RegistryRegionArmDetails{
+		expandMachineLearningRegistryRegion(primaryLocation, primary, existingRegions),
+	}
+	for _, raw := range replicaInput {
+		v := raw.(map[string]interface{})
+		regions = append(regions, expandMachineLearningRegistryRegion(location.Normalize(v["location"].(string)), v, existingRegions))
+	}
+
+	return &regions
+}
+
+func expandMachineLearningRegistryRegion(regionLocation string, input map[string]interface{}, existing map[string]registry.RegistryRegionArmDetails) registry.RegistryRegionArmDetails {
+	if v, ok := existing[regionLocation]; ok {
+		return v
+	}
+
+	storageAccountType := "Standard_LRS"
+	if v, ok := input["storage_account_type"].(string); ok && v != "" {
+		storageAccountType = v
+	}
+	hnsEnabled := false
+	if v, ok := input["hns_enabled"].(bool); ok {
+		hnsEnabled = v
+	}
+	acrSku := "Premium"
+	if v, ok := input["container_registry_sku"].(string); ok && v != "" {
+		acrSku = v
+	}
+
+	return registry.RegistryRegionArmDetails{
+		Location: utils.String(regionLocation),
+		AcrDetails: &[]registry.AcrDetails{
+			{
+				SystemCreatedAcrAccount: &registry.SystemCreatedAcrAccount{
+					AcrAccountSku: utils.String(acrSku),
+				},
+			},
+		},
+		StorageAccountDetails: &[]registry.StorageAccountDetails{
+			{
+				SystemCreatedStorageAccount: &registry.SystemCreatedStorageAccount{
+					StorageAccountHnsEnabled: utils.Bool(hnsEnabled),
+					StorageAccountType:       utils.String(storageAccountType),
+				},
+			},
+		},
+	}
+}
+
+func flattenMachineLearningRegistryRegions(primaryLocation string, input *[]registry.RegistryRegionArmDetails) ([]interface{}, []interface{}) {
+	primary := make([]interface{}, 0)
+	replicas := make([]interface{}, 0)
+	if input == nil {
+		return primary, replicas
+	}
+
+	for _, v := range *input {
+		regionLocation := ""
+		if v.Location != nil {
+			regionLocation = location.Normalize(*v.Location)
+		}
+
+		acrSku := ""
+		acrId := ""
+		if v.AcrDetails != nil && len(*v.AcrDetails) > 0 {
+			if acr := (*v.AcrDetails)[0].SystemCreatedAcrAccount; acr != nil {
+				if acr.AcrAccountSku != nil {
+					acrSku = *acr.AcrAccountSku
+				}
+				if acr.ArmResourceId != nil && acr.ArmResourceId.ResourceId != nil {
+					acrId = *acr.ArmResourceId.ResourceId
+				}
+			}
+		}
+
+		storageAccountType := ""
+		storageAccountId := ""
+		hnsEnabled := false
+		if v.StorageAccountDetails != nil && len(*v.StorageAccountDetails) > 0 {
+			if account := (*v.StorageAccountDetails)[0].SystemCreatedStorageAccount; account != nil {
+				if account.StorageAccountType != nil {
+					storageAccountType = *account.StorageAccountType
+				}
+				if account.StorageAccountHnsEnabled != nil {
+					hnsEnabled = *account.StorageAccountHnsEnabled
+				}
+				if account.ArmResourceId != nil && account.ArmResourceId.ResourceId != nil {
+					storageAccountId = *account.ArmResourceId.ResourceId
+				}
+			}
+		}
+
+		region := map[string]interface{}{
+			"container_registry_sku":               acrSku,
+			"hns_enabled":                          hnsEnabled,
+			"storage_account_type":                 storageAccountType,
+			"system_created_container_registry_id": acrId,
+			"system_created_storage_account_id":    storageAccountId,
+		}
+
+		if regionLocation == primaryLocation {
+			primary = append(primary, region)
+			continue
+		}
+
+		region["location"] = regionLocation
+		replicas = append(replicas, region)
+	}
+
+	return primary, replicas
+}
@@ -0,0 +1,135 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MachineLearningServerlessEndpointResource struct{}
+
+func TestAccMachineLearningServerlessEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_serverless_endpoint", "test")
+	r := MachineLearningServerlessEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inference_endpoint_uri").Exists(),
+				check.That(data.ResourceName).Key("primary_key").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMachineLearningServerlessEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_serverless_endpoint", "test")
+	r := MachineLearningServerlessEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMachineLearningServerlessEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_serverless_endpoint", "test")
+	r := MachineLearningServerlessEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MachineLearningServerlessEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := serverlessendpoint.ParseServerlessEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.ServerlessEndpointClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MachineLearningServerlessEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_serverless_endpoint" "test" {
+  name                          = "acctestse%d"
+  location                      = azurerm_resource_group.test.location
+  machine_learning_workspace_id = azurerm_machine_learning_workspace.test.id
+  model_id                      = "azureml://registries/azureml-meta/models/Llama-2-7b-chat"
+}
+`, MachineLearningBatchEndpointResource{}.template(data), data.RandomIntOfLength(8))
+}
+
+func (r MachineLearningServerlessEndpointResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_serverless_endpoint" "test" {
+  name                          = "acctestse%d"
+  location                      = azurerm_resource_group.test.location
+  machine_learning_workspace_id = azurerm_machine_learning_workspace.test.id
+  model_id                      = "azureml://registries/azureml-meta/models/Llama-2-7b-chat"
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, MachineLearningBatchEndpointResource{}.template(data), data.RandomIntOfLength(8))
+}
+
+func (r MachineLearningServerlessEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_serverless_endpoint" "import" {
+  name                          = azurerm_machine_learning_serverless_endpoint.test.name
+  location                      = azurerm_machine_learning_serverless_endpoint.test.location
+  machine_learning_workspace_id = azurerm_machine_learning_serverless_endpoint.test.machine_learning_workspace_id
+  model_id                      = azurerm_machine_learning_serverless_endpoint.test.model_id
+}
+`, r.basic(data))
+}
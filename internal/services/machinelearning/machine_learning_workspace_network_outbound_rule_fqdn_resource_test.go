@@ -0,0 +1,93 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspaceNetworkOutboundRuleFqdnResource struct{}
+
+func TestAccWorkspaceNetworkOutboundRuleFqdn_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_network_outbound_rule_fqdn", "test")
+	r := WorkspaceNetworkOutboundRuleFqdnResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceNetworkOutboundRuleFqdn_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_network_outbound_rule_fqdn", "test")
+	r := WorkspaceNetworkOutboundRuleFqdnResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WorkspaceNetworkOutboundRuleFqdnResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managednetwork.ParseOutboundRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.ManagedNetworkClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WorkspaceNetworkOutboundRuleFqdnResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_network_outbound_rule_fqdn" "test" {
+  name             = "acctest-fqdn-%d"
+  workspace_id     = azurerm_machine_learning_workspace.test.id
+  destination_fqdn = "example.com"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WorkspaceNetworkOutboundRuleFqdnResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_network_outbound_rule_fqdn" "import" {
+  name             = azurerm_machine_learning_workspace_network_outbound_rule_fqdn.test.name
+  workspace_id     = azurerm_machine_learning_workspace_network_outbound_rule_fqdn.test.workspace_id
+  destination_fqdn = azurerm_machine_learning_workspace_network_outbound_rule_fqdn.test.destination_fqdn
+}
+`, r.basic(data))
+}
+
+// template is shared by the outbound rule tests, since each rule requires a workspace which only allows approved outbound traffic
+func (r WorkspaceNetworkOutboundRuleFqdnResource) template(data acceptance.TestData) string {
+	return WorkspaceResource{}.managedNetwork(data, "AllowOnlyApprovedOutbound")
+}
@@ -0,0 +1,160 @@
+package machinelearning
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMachineLearningWorkspaceNetworkOutboundRulePrivateEndpoint() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMachineLearningWorkspaceNetworkOutboundRulePrivateEndpointCreate,
+		Read:   resourceMachineLearningWorkspaceNetworkOutboundRulePrivateEndpointRead,
+		Delete: resourceMachineLearningWorkspaceNetworkOutboundRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := managednetwork.ParseOutboundRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"service_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"sub_resource_target": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"spark_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceMachineLearningWorkspaceNetworkOutboundRulePrivateEndpointCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.ManagedNetworkClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.WorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := managednetwork.NewOutboundRuleID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint", id.ID())
+	}
+
+	category := managednetwork.RuleCategoryUserDefined
+	payload := managednetwork.OutboundRuleBasicResource{
+		Properties: managednetwork.PrivateEndpointOutboundRule{
+			Category: &category,
+			Destination: &managednetwork.PrivateEndpointDestination{
+				ServiceResourceId: utils.String(d.Get("service_resource_id").(string)),
+				SparkEnabled:      utils.Bool(d.Get("spark_enabled").(bool)),
+				SubresourceTarget: utils.String(d.Get("sub_resource_target").(string)),
+			},
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMachineLearningWorkspaceNetworkOutboundRulePrivateEndpointRead(d, meta)
+}
+
+func resourceMachineLearningWorkspaceNetworkOutboundRulePrivateEndpointRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.ManagedNetworkClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := managednetwork.ParseOutboundRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.OutboundRuleName)
+	d.Set("workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		rule, ok := model.Properties.(managednetwork.PrivateEndpointOutboundRule)
+		if !ok {
+			return fmt.Errorf("retrieving %s: expected a Private Endpoint outbound rule but got %+v", *id, model.Properties)
+		}
+
+		if destination := rule.Destination; destination != nil {
+			d.Set("service_resource_id", destination.ServiceResourceId)
+			d.Set("sub_resource_target", destination.SubresourceTarget)
+
+			sparkEnabled := false
+			if destination.SparkEnabled != nil {
+				sparkEnabled = *destination.SparkEnabled
+			}
+			d.Set("spark_enabled", sparkEnabled)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspaceNetworkOutboundRulePrivateEndpointResource struct{}
+
+func TestAccWorkspaceNetworkOutboundRulePrivateEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint", "test")
+	r := WorkspaceNetworkOutboundRulePrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceNetworkOutboundRulePrivateEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint", "test")
+	r := WorkspaceNetworkOutboundRulePrivateEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WorkspaceNetworkOutboundRulePrivateEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managednetwork.ParseOutboundRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.ManagedNetworkClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WorkspaceNetworkOutboundRulePrivateEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "target" {
+  name                     = "acctestsapet%d"
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint" "test" {
+  name                = "acctest-pe-%d"
+  workspace_id        = azurerm_machine_learning_workspace.test.id
+  service_resource_id = azurerm_storage_account.target.id
+  sub_resource_target = "blob"
+}
+`, WorkspaceNetworkOutboundRuleFqdnResource{}.template(data), data.RandomIntOfLength(12), data.RandomInteger)
+}
+
+func (r WorkspaceNetworkOutboundRulePrivateEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint" "import" {
+  name                = azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint.test.name
+  workspace_id        = azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint.test.workspace_id
+  service_resource_id = azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint.test.service_resource_id
+  sub_resource_target = azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint.test.sub_resource_target
+}
+`, r.basic(data))
+}
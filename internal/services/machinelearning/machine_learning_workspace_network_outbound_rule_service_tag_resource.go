@@ -0,0 +1,161 @@
+package machinelearning
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMachineLearningWorkspaceNetworkOutboundRuleServiceTag() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMachineLearningWorkspaceNetworkOutboundRuleServiceTagCreate,
+		Read:   resourceMachineLearningWorkspaceNetworkOutboundRuleServiceTagRead,
+		Delete: resourceMachineLearningWorkspaceNetworkOutboundRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := managednetwork.ParseOutboundRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"service_tag": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"protocol": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"*",
+					"ICMP",
+					"TCP",
+					"UDP",
+				}, false),
+			},
+
+			"port_ranges": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceMachineLearningWorkspaceNetworkOutboundRuleServiceTagCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.ManagedNetworkClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	workspaceId, err := parse.WorkspaceID(d.Get("workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := managednetwork.NewOutboundRuleID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_machine_learning_workspace_network_outbound_rule_service_tag", id.ID())
+	}
+
+	category := managednetwork.RuleCategoryUserDefined
+	action := managednetwork.RuleActionAllow
+	payload := managednetwork.OutboundRuleBasicResource{
+		Properties: managednetwork.ServiceTagOutboundRule{
+			Category: &category,
+			Destination: &managednetwork.ServiceTagDestination{
+				Action:     &action,
+				PortRanges: utils.String(d.Get("port_ranges").(string)),
+				Protocol:   utils.String(d.Get("protocol").(string)),
+				ServiceTag: utils.String(d.Get("service_tag").(string)),
+			},
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMachineLearningWorkspaceNetworkOutboundRuleServiceTagRead(d, meta)
+}
+
+func resourceMachineLearningWorkspaceNetworkOutboundRuleServiceTagRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MachineLearning.ManagedNetworkClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := managednetwork.ParseOutboundRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.OutboundRuleName)
+	d.Set("workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID())
+
+	if model := resp.Model; model != nil {
+		rule, ok := model.Properties.(managednetwork.ServiceTagOutboundRule)
+		if !ok {
+			return fmt.Errorf("retrieving %s: expected a Service Tag outbound rule but got %+v", *id, model.Properties)
+		}
+
+		if destination := rule.Destination; destination != nil {
+			d.Set("service_tag", destination.ServiceTag)
+			d.Set("protocol", destination.Protocol)
+			d.Set("port_ranges", destination.PortRanges)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/managednetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspaceNetworkOutboundRuleServiceTagResource struct{}
+
+func TestAccWorkspaceNetworkOutboundRuleServiceTag_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_network_outbound_rule_service_tag", "test")
+	r := WorkspaceNetworkOutboundRuleServiceTagResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceNetworkOutboundRuleServiceTag_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace_network_outbound_rule_service_tag", "test")
+	r := WorkspaceNetworkOutboundRuleServiceTagResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WorkspaceNetworkOutboundRuleServiceTagResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managednetwork.ParseOutboundRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MachineLearning.ManagedNetworkClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WorkspaceNetworkOutboundRuleServiceTagResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_network_outbound_rule_service_tag" "test" {
+  name         = "acctest-st-%d"
+  workspace_id = azurerm_machine_learning_workspace.test.id
+  service_tag  = "AppService"
+  protocol     = "TCP"
+  port_ranges  = "443"
+}
+`, WorkspaceNetworkOutboundRuleFqdnResource{}.template(data), data.RandomInteger)
+}
+
+func (r WorkspaceNetworkOutboundRuleServiceTagResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace_network_outbound_rule_service_tag" "import" {
+  name         = azurerm_machine_learning_workspace_network_outbound_rule_service_tag.test.name
+  workspace_id = azurerm_machine_learning_workspace_network_outbound_rule_service_tag.test.workspace_id
+  service_tag  = azurerm_machine_learning_workspace_network_outbound_rule_service_tag.test.service_tag
+  protocol     = azurerm_machine_learning_workspace_network_outbound_rule_service_tag.test.protocol
+  port_ranges  = azurerm_machine_learning_workspace_network_outbound_rule_service_tag.test.port_ranges
+}
+`, r.basic(data))
+}
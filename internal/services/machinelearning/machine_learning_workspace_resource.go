@@ -1,6 +1,7 @@
 package machinelearning
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/sdk/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -183,6 +185,26 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 				}, true),
 			},
 
+			"managed_network": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"isolation_mode": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(workspaces.IsolationModeAllowInternetOutbound),
+								string(workspaces.IsolationModeAllowOnlyApprovedOutbound),
+								string(workspaces.IsolationModeDisabled),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"discovery_url": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -262,6 +284,13 @@ func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta inte
 	id := parse.NewWorkspaceID(subscriptionId, resGroup, name)
 	d.SetId(id.ID())
 
+	// the managed network isn't available in the API version used by the workspace client, so is configured once the workspace exists
+	if managedNetwork := expandMachineLearningWorkspaceManagedNetwork(d.Get("managed_network").([]interface{})); managedNetwork != nil {
+		if err := updateMachineLearningWorkspaceManagedNetwork(ctx, meta, id, managedNetwork); err != nil {
+			return err
+		}
+	}
+
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
 
@@ -316,6 +345,20 @@ func resourceMachineLearningWorkspaceRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("flattening encryption on Workspace %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	managedNetworkClient := meta.(*clients.Client).MachineLearning.WorkspacesManagedNetworkClient
+	workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	managedNetworkResp, err := managedNetworkClient.Get(ctx, workspaceId)
+	if err != nil {
+		return fmt.Errorf("retrieving managed network for %s: %+v", workspaceId, err)
+	}
+	var managedNetwork *workspaces.ManagedNetworkSettings
+	if model := managedNetworkResp.Model; model != nil && model.Properties != nil {
+		managedNetwork = model.Properties.ManagedNetwork
+	}
+	if err := d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(managedNetwork)); err != nil {
+		return fmt.Errorf("setting `managed_network`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -357,6 +400,14 @@ func resourceMachineLearningWorkspaceUpdate(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("updating Machine Learning Workspace %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
 	}
 
+	if d.HasChange("managed_network") {
+		if managedNetwork := expandMachineLearningWorkspaceManagedNetwork(d.Get("managed_network").([]interface{})); managedNetwork != nil {
+			if err := updateMachineLearningWorkspaceManagedNetwork(ctx, meta, *id, managedNetwork); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
 
@@ -448,3 +499,49 @@ func flattenMachineLearningWorkspaceEncryption(encryption *machinelearningservic
 		},
 	}
 }
+
+func updateMachineLearningWorkspaceManagedNetwork(ctx context.Context, meta interface{}, id parse.WorkspaceId, input *workspaces.ManagedNetworkSettings) error {
+	client := meta.(*clients.Client).MachineLearning.WorkspacesManagedNetworkClient
+	workspaceId := workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	payload := workspaces.WorkspaceUpdateParameters{
+		Properties: &workspaces.WorkspacePropertiesUpdateParameters{
+			ManagedNetwork: input,
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, workspaceId, payload); err != nil {
+		return fmt.Errorf("updating managed network for %s: %+v", workspaceId, err)
+	}
+
+	return nil
+}
+
+func expandMachineLearningWorkspaceManagedNetwork(input []interface{}) *workspaces.ManagedNetworkSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	isolationMode := workspaces.IsolationMode(raw["isolation_mode"].(string))
+
+	return &workspaces.ManagedNetworkSettings{
+		IsolationMode: &isolationMode,
+	}
+}
+
+func flattenMachineLearningWorkspaceManagedNetwork(input *workspaces.ManagedNetworkSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	isolationMode := string(workspaces.IsolationModeDisabled)
+	if input.IsolationMode != nil {
+		isolationMode = string(*input.IsolationMode)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"isolation_mode": isolationMode,
+		},
+	}
+}
@@ -132,6 +132,35 @@ func TestAccMachineLearningWorkspace_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccMachineLearningWorkspace_managedNetwork(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
+	r := WorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedNetwork(data, "AllowInternetOutbound"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedNetwork(data, "AllowOnlyApprovedOutbound"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r WorkspaceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	workspacesClient := client.MachineLearning.WorkspacesClient
 	id, err := parse.WorkspaceID(state.ID)
@@ -170,6 +199,30 @@ resource "azurerm_machine_learning_workspace" "test" {
 `, template, data.RandomIntOfLength(16))
 }
 
+func (r WorkspaceResource) managedNetwork(data acceptance.TestData, isolationMode string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_machine_learning_workspace" "test" {
+  name                    = "acctest-MLW-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  key_vault_id            = azurerm_key_vault.test.id
+  storage_account_id      = azurerm_storage_account.test.id
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  managed_network {
+    isolation_mode = "%s"
+  }
+}
+`, template, data.RandomIntOfLength(16), isolationMode)
+}
+
 func (r WorkspaceResource) basicUpdated(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
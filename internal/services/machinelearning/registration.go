@@ -27,10 +27,20 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_machine_learning_workspace":         resourceMachineLearningWorkspace(),
-		"azurerm_machine_learning_inference_cluster": resourceAksInferenceCluster(),
-		"azurerm_machine_learning_compute_cluster":   resourceComputeCluster(),
-		"azurerm_machine_learning_compute_instance":  resourceComputeInstance(),
-		"azurerm_machine_learning_synapse_spark":     resourceSynapseSpark(),
+		"azurerm_machine_learning_workspace":                                        resourceMachineLearningWorkspace(),
+		"azurerm_machine_learning_inference_cluster":                                resourceAksInferenceCluster(),
+		"azurerm_machine_learning_compute_cluster":                                  resourceComputeCluster(),
+		"azurerm_machine_learning_compute_instance":                                 resourceComputeInstance(),
+		"azurerm_machine_learning_synapse_spark":                                    resourceSynapseSpark(),
+		"azurerm_machine_learning_registry":                                         resourceMachineLearningRegistry(),
+		"azurerm_machine_learning_batch_endpoint":                                   resourceMachineLearningBatchEndpoint(),
+		"azurerm_machine_learning_batch_deployment":                                 resourceMachineLearningBatchDeployment(),
+		"azurerm_machine_learning_serverless_endpoint":                              resourceMachineLearningServerlessEndpoint(),
+		"azurerm_machine_learning_workspace_network_outbound_rule_fqdn":             resourceMachineLearningWorkspaceNetworkOutboundRuleFqdn(),
+		"azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint": resourceMachineLearningWorkspaceNetworkOutboundRulePrivateEndpoint(),
+		"azurerm_machine_learning_workspace_network_outbound_rule_service_tag":      resourceMachineLearningWorkspaceNetworkOutboundRuleServiceTag(),
+		"azurerm_ai_foundry":                                                        resourceAIFoundry(),
+		"azurerm_ai_foundry_connection":                                             resourceAIFoundryConnection(),
+		"azurerm_ai_foundry_project":                                                resourceAIFoundryProject(),
 	}
 }
@@ -0,0 +1,15 @@
+package batchdeployment
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BatchDeploymentClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBatchDeploymentClientWithBaseURI(endpoint string) BatchDeploymentClient {
+	return BatchDeploymentClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,6 @@
+package batchdeployment
+
+type AssetReference struct {
+	AssetId       *string       `json:"assetId,omitempty"`
+	ReferenceType ReferenceType `json:"referenceType"`
+}
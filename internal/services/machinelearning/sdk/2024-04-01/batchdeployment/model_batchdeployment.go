@@ -0,0 +1,20 @@
+package batchdeployment
+
+type BatchDeployment struct {
+	CodeConfiguration         *CodeConfiguration               `json:"codeConfiguration,omitempty"`
+	Compute                   *string                          `json:"compute,omitempty"`
+	Description               *string                          `json:"description,omitempty"`
+	EnvironmentId             *string                          `json:"environmentId,omitempty"`
+	EnvironmentVariables      *map[string]string               `json:"environmentVariables,omitempty"`
+	ErrorThreshold            *int64                           `json:"errorThreshold,omitempty"`
+	LoggingLevel              *BatchLoggingLevel               `json:"loggingLevel,omitempty"`
+	MaxConcurrencyPerInstance *int64                           `json:"maxConcurrencyPerInstance,omitempty"`
+	MiniBatchSize             *int64                           `json:"miniBatchSize,omitempty"`
+	Model                     *AssetReference                  `json:"model,omitempty"`
+	OutputAction              *BatchOutputAction               `json:"outputAction,omitempty"`
+	OutputFileName            *string                          `json:"outputFileName,omitempty"`
+	Properties                *map[string]string               `json:"properties,omitempty"`
+	ProvisioningState         *DeploymentProvisioningState     `json:"provisioningState,omitempty"`
+	Resources                 *DeploymentResourceConfiguration `json:"resources,omitempty"`
+	RetrySettings             *BatchRetrySettings              `json:"retrySettings,omitempty"`
+}
@@ -0,0 +1,6 @@
+package batchdeployment
+
+type BatchRetrySettings struct {
+	MaxRetries *int64  `json:"maxRetries,omitempty"`
+	Timeout    *string `json:"timeout,omitempty"`
+}
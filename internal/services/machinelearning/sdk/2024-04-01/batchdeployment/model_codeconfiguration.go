@@ -0,0 +1,6 @@
+package batchdeployment
+
+type CodeConfiguration struct {
+	CodeId        *string `json:"codeId,omitempty"`
+	ScoringScript string  `json:"scoringScript"`
+}
@@ -0,0 +1,6 @@
+package batchdeployment
+
+type DeploymentResourceConfiguration struct {
+	InstanceCount *int64  `json:"instanceCount,omitempty"`
+	InstanceType  *string `json:"instanceType,omitempty"`
+}
@@ -0,0 +1,15 @@
+package batchendpoint
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BatchEndpointClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBatchEndpointClientWithBaseURI(endpoint string) BatchEndpointClient {
+	return BatchEndpointClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
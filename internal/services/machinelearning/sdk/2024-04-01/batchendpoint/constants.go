@@ -0,0 +1,108 @@
+package batchendpoint
+
+import "strings"
+
+type EndpointAuthMode string
+
+const (
+	EndpointAuthModeAADToken EndpointAuthMode = "AADToken"
+	EndpointAuthModeAMLToken EndpointAuthMode = "AMLToken"
+	EndpointAuthModeKey      EndpointAuthMode = "Key"
+)
+
+func PossibleValuesForEndpointAuthMode() []string {
+	return []string{
+		string(EndpointAuthModeAADToken),
+		string(EndpointAuthModeAMLToken),
+		string(EndpointAuthModeKey),
+	}
+}
+
+func parseEndpointAuthMode(input string) (*EndpointAuthMode, error) {
+	vals := map[string]EndpointAuthMode{
+		"aadtoken": EndpointAuthModeAADToken,
+		"amltoken": EndpointAuthModeAMLToken,
+		"key":      EndpointAuthModeKey,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EndpointAuthMode(input)
+	return &out, nil
+}
+
+type EndpointProvisioningState string
+
+const (
+	EndpointProvisioningStateCanceled  EndpointProvisioningState = "Canceled"
+	EndpointProvisioningStateCreating  EndpointProvisioningState = "Creating"
+	EndpointProvisioningStateDeleting  EndpointProvisioningState = "Deleting"
+	EndpointProvisioningStateFailed    EndpointProvisioningState = "Failed"
+	EndpointProvisioningStateSucceeded EndpointProvisioningState = "Succeeded"
+	EndpointProvisioningStateUpdating  EndpointProvisioningState = "Updating"
+)
+
+func PossibleValuesForEndpointProvisioningState() []string {
+	return []string{
+		string(EndpointProvisioningStateCanceled),
+		string(EndpointProvisioningStateCreating),
+		string(EndpointProvisioningStateDeleting),
+		string(EndpointProvisioningStateFailed),
+		string(EndpointProvisioningStateSucceeded),
+		string(EndpointProvisioningStateUpdating),
+	}
+}
+
+func parseEndpointProvisioningState(input string) (*EndpointProvisioningState, error) {
+	vals := map[string]EndpointProvisioningState{
+		"canceled":  EndpointProvisioningStateCanceled,
+		"creating":  EndpointProvisioningStateCreating,
+		"deleting":  EndpointProvisioningStateDeleting,
+		"failed":    EndpointProvisioningStateFailed,
+		"succeeded": EndpointProvisioningStateSucceeded,
+		"updating":  EndpointProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EndpointProvisioningState(input)
+	return &out, nil
+}
+
+type SkuTier string
+
+const (
+	SkuTierBasic    SkuTier = "Basic"
+	SkuTierFree     SkuTier = "Free"
+	SkuTierPremium  SkuTier = "Premium"
+	SkuTierStandard SkuTier = "Standard"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierBasic),
+		string(SkuTierFree),
+		string(SkuTierPremium),
+		string(SkuTierStandard),
+	}
+}
+
+func parseSkuTier(input string) (*SkuTier, error) {
+	vals := map[string]SkuTier{
+		"basic":    SkuTierBasic,
+		"free":     SkuTierFree,
+		"premium":  SkuTierPremium,
+		"standard": SkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuTier(input)
+	return &out, nil
+}
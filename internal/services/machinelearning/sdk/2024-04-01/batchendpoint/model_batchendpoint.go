@@ -0,0 +1,11 @@
+package batchendpoint
+
+type BatchEndpoint struct {
+	AuthMode          EndpointAuthMode           `json:"authMode"`
+	Defaults          *BatchEndpointDefaults     `json:"defaults,omitempty"`
+	Description       *string                    `json:"description,omitempty"`
+	Properties        *map[string]string         `json:"properties,omitempty"`
+	ProvisioningState *EndpointProvisioningState `json:"provisioningState,omitempty"`
+	ScoringUri        *string                    `json:"scoringUri,omitempty"`
+	SwaggerUri        *string                    `json:"swaggerUri,omitempty"`
+}
@@ -0,0 +1,5 @@
+package batchendpoint
+
+type BatchEndpointDefaults struct {
+	DeploymentName *string `json:"deploymentName,omitempty"`
+}
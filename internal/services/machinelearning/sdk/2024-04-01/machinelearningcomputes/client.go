@@ -0,0 +1,15 @@
+package machinelearningcomputes
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MachineLearningComputesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMachineLearningComputesClientWithBaseURI(endpoint string) MachineLearningComputesClient {
+	return MachineLearningComputesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
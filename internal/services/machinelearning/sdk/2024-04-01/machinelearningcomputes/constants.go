@@ -0,0 +1,87 @@
+package machinelearningcomputes
+
+import "strings"
+
+type ComputePowerAction string
+
+const (
+	ComputePowerActionStart ComputePowerAction = "Start"
+	ComputePowerActionStop  ComputePowerAction = "Stop"
+)
+
+func PossibleValuesForComputePowerAction() []string {
+	return []string{
+		string(ComputePowerActionStart),
+		string(ComputePowerActionStop),
+	}
+}
+
+func parseComputePowerAction(input string) (*ComputePowerAction, error) {
+	vals := map[string]ComputePowerAction{
+		"start": ComputePowerActionStart,
+		"stop":  ComputePowerActionStop,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ComputePowerAction(input)
+	return &out, nil
+}
+
+type ScheduleStatus string
+
+const (
+	ScheduleStatusDisabled ScheduleStatus = "Disabled"
+	ScheduleStatusEnabled  ScheduleStatus = "Enabled"
+)
+
+func PossibleValuesForScheduleStatus() []string {
+	return []string{
+		string(ScheduleStatusDisabled),
+		string(ScheduleStatusEnabled),
+	}
+}
+
+func parseScheduleStatus(input string) (*ScheduleStatus, error) {
+	vals := map[string]ScheduleStatus{
+		"disabled": ScheduleStatusDisabled,
+		"enabled":  ScheduleStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ScheduleStatus(input)
+	return &out, nil
+}
+
+type TriggerType string
+
+const (
+	TriggerTypeCron       TriggerType = "Cron"
+	TriggerTypeRecurrence TriggerType = "Recurrence"
+)
+
+func PossibleValuesForTriggerType() []string {
+	return []string{
+		string(TriggerTypeCron),
+		string(TriggerTypeRecurrence),
+	}
+}
+
+func parseTriggerType(input string) (*TriggerType, error) {
+	vals := map[string]TriggerType{
+		"cron":       TriggerTypeCron,
+		"recurrence": TriggerTypeRecurrence,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := TriggerType(input)
+	return &out, nil
+}
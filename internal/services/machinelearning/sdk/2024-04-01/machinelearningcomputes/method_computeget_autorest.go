@@ -0,0 +1,64 @@
+package machinelearningcomputes
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ComputeGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ComputeResource
+}
+
+// ComputeGet ...
+func (c MachineLearningComputesClient) ComputeGet(ctx context.Context, id ComputeId) (result ComputeGetResponse, err error) {
+	req, err := c.preparerForComputeGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machinelearningcomputes.MachineLearningComputesClient", "ComputeGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machinelearningcomputes.MachineLearningComputesClient", "ComputeGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForComputeGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machinelearningcomputes.MachineLearningComputesClient", "ComputeGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForComputeGet prepares the ComputeGet request.
+func (c MachineLearningComputesClient) preparerForComputeGet(ctx context.Context, id ComputeId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForComputeGet handles the response to the ComputeGet request. The method always
+// closes the http.Response Body.
+func (c MachineLearningComputesClient) responderForComputeGet(resp *http.Response) (result ComputeGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package machinelearningcomputes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ComputeUpdateIdleShutdownSettingResponse struct {
+	HttpResponse *http.Response
+}
+
+// ComputeUpdateIdleShutdownSetting ...
+func (c MachineLearningComputesClient) ComputeUpdateIdleShutdownSetting(ctx context.Context, id ComputeId, input IdleShutdownSetting) (result ComputeUpdateIdleShutdownSettingResponse, err error) {
+	req, err := c.preparerForComputeUpdateIdleShutdownSetting(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machinelearningcomputes.MachineLearningComputesClient", "ComputeUpdateIdleShutdownSetting", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machinelearningcomputes.MachineLearningComputesClient", "ComputeUpdateIdleShutdownSetting", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForComputeUpdateIdleShutdownSetting(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "machinelearningcomputes.MachineLearningComputesClient", "ComputeUpdateIdleShutdownSetting", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForComputeUpdateIdleShutdownSetting prepares the ComputeUpdateIdleShutdownSetting request.
+func (c MachineLearningComputesClient) preparerForComputeUpdateIdleShutdownSetting(ctx context.Context, id ComputeId, input IdleShutdownSetting) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/updateIdleShutdownSetting", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForComputeUpdateIdleShutdownSetting handles the response to the ComputeUpdateIdleShutdownSetting request. The method always
+// closes the http.Response Body.
+func (c MachineLearningComputesClient) responderForComputeUpdateIdleShutdownSetting(resp *http.Response) (result ComputeUpdateIdleShutdownSettingResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
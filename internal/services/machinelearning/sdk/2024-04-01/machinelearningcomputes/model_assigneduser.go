@@ -0,0 +1,6 @@
+package machinelearningcomputes
+
+type AssignedUser struct {
+	ObjectId string `json:"objectId"`
+	TenantId string `json:"tenantId"`
+}
@@ -0,0 +1,45 @@
+package machinelearningcomputes
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type Compute interface {
+}
+
+func unmarshalComputeImplementation(input []byte) (Compute, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling Compute into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["computeType"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "ComputeInstance") {
+		var out ComputeInstance
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ComputeInstance: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawComputeImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawComputeImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
@@ -0,0 +1,42 @@
+package machinelearningcomputes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Compute = ComputeInstance{}
+
+type ComputeInstance struct {
+	ComputeLocation  *string                    `json:"computeLocation,omitempty"`
+	Description      *string                    `json:"description,omitempty"`
+	DisableLocalAuth *bool                      `json:"disableLocalAuth,omitempty"`
+	Properties       *ComputeInstanceProperties `json:"properties,omitempty"`
+	ResourceId       *string                    `json:"resourceId,omitempty"`
+
+	// Fields inherited from Compute
+}
+
+var _ json.Marshaler = ComputeInstance{}
+
+func (s ComputeInstance) MarshalJSON() ([]byte, error) {
+	type wrapper ComputeInstance
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ComputeInstance: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ComputeInstance: %+v", err)
+	}
+	decoded["computeType"] = "ComputeInstance"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ComputeInstance: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,13 @@
+package machinelearningcomputes
+
+type ComputeInstanceProperties struct {
+	ApplicationSharingPolicy         *string                          `json:"applicationSharingPolicy,omitempty"`
+	ComputeInstanceAuthorizationType *string                          `json:"computeInstanceAuthorizationType,omitempty"`
+	EnableNodePublicIP               *bool                            `json:"enableNodePublicIp,omitempty"`
+	IdleTimeBeforeShutdown           *string                          `json:"idleTimeBeforeShutdown,omitempty"`
+	PersonalComputeInstanceSettings  *PersonalComputeInstanceSettings `json:"personalComputeInstanceSettings,omitempty"`
+	Schedules                        *ComputeSchedules                `json:"schedules,omitempty"`
+	SshSettings                      *ComputeInstanceSshSettings      `json:"sshSettings,omitempty"`
+	Subnet                           *ResourceId                      `json:"subnet,omitempty"`
+	VMSize                           *string                          `json:"vmSize,omitempty"`
+}
@@ -0,0 +1,8 @@
+package machinelearningcomputes
+
+type ComputeInstanceSshSettings struct {
+	AdminPublicKey  *string `json:"adminPublicKey,omitempty"`
+	AdminUserName   *string `json:"adminUserName,omitempty"`
+	SshPort         *int64  `json:"sshPort,omitempty"`
+	SshPublicAccess *string `json:"sshPublicAccess,omitempty"`
+}
@@ -0,0 +1,47 @@
+package machinelearningcomputes
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type ComputeResource struct {
+	Id         *string                 `json:"id,omitempty"`
+	Identity   *ManagedServiceIdentity `json:"identity,omitempty"`
+	Location   *string                 `json:"location,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties Compute                 `json:"properties"`
+	Tags       *map[string]string      `json:"tags,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &ComputeResource{}
+
+func (s *ComputeResource) UnmarshalJSON(bytes []byte) error {
+	type alias ComputeResource
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into ComputeResource: %+v", err)
+	}
+
+	s.Id = decoded.Id
+	s.Identity = decoded.Identity
+	s.Location = decoded.Location
+	s.Name = decoded.Name
+	s.Tags = decoded.Tags
+	s.Type = decoded.Type
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling ComputeResource into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalComputeImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'ComputeResource': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
@@ -0,0 +1,5 @@
+package machinelearningcomputes
+
+type ComputeSchedules struct {
+	ComputeStartStop *[]ComputeStartStopSchedule `json:"computeStartStop,omitempty"`
+}
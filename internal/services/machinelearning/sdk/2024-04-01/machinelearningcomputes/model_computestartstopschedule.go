@@ -0,0 +1,9 @@
+package machinelearningcomputes
+
+type ComputeStartStopSchedule struct {
+	Action      *ComputePowerAction `json:"action,omitempty"`
+	Cron        *Cron               `json:"cron,omitempty"`
+	Id          *string             `json:"id,omitempty"`
+	Status      *ScheduleStatus     `json:"status,omitempty"`
+	TriggerType *TriggerType        `json:"triggerType,omitempty"`
+}
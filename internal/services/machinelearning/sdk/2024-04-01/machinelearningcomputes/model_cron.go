@@ -0,0 +1,7 @@
+package machinelearningcomputes
+
+type Cron struct {
+	Expression *string `json:"expression,omitempty"`
+	StartTime  *string `json:"startTime,omitempty"`
+	TimeZone   *string `json:"timeZone,omitempty"`
+}
@@ -0,0 +1,5 @@
+package machinelearningcomputes
+
+type IdleShutdownSetting struct {
+	IdleTimeBeforeShutdown *string `json:"idleTimeBeforeShutdown,omitempty"`
+}
@@ -0,0 +1,8 @@
+package machinelearningcomputes
+
+type ManagedServiceIdentity struct {
+	PrincipalId            *string                          `json:"principalId,omitempty"`
+	TenantId               *string                          `json:"tenantId,omitempty"`
+	Type                   string                           `json:"type"`
+	UserAssignedIdentities *map[string]UserAssignedIdentity `json:"userAssignedIdentities,omitempty"`
+}
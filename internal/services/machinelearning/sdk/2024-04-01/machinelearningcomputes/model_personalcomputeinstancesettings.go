@@ -0,0 +1,5 @@
+package machinelearningcomputes
+
+type PersonalComputeInstanceSettings struct {
+	AssignedUser *AssignedUser `json:"assignedUser,omitempty"`
+}
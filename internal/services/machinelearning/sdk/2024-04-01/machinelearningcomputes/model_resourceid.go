@@ -0,0 +1,5 @@
+package machinelearningcomputes
+
+type ResourceId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,15 @@
+package managednetwork
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ManagedNetworkClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewManagedNetworkClientWithBaseURI(endpoint string) ManagedNetworkClient {
+	return ManagedNetworkClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,137 @@
+package managednetwork
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = OutboundRuleId{}
+
+// OutboundRuleId is a struct representing the Resource ID for a Outbound Rule
+type OutboundRuleId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	WorkspaceName     string
+	OutboundRuleName  string
+}
+
+// NewOutboundRuleID returns a new OutboundRuleId struct
+func NewOutboundRuleID(subscriptionId string, resourceGroupName string, workspaceName string, outboundRuleName string) OutboundRuleId {
+	return OutboundRuleId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		WorkspaceName:     workspaceName,
+		OutboundRuleName:  outboundRuleName,
+	}
+}
+
+// ParseOutboundRuleID parses 'input' into a OutboundRuleId
+func ParseOutboundRuleID(input string) (*OutboundRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(OutboundRuleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := OutboundRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.OutboundRuleName, ok = parsed.Parsed["outboundRuleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'outboundRuleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseOutboundRuleIDInsensitively parses 'input' case-insensitively into a OutboundRuleId
+// note: this method should only be used for API response data and not user input
+func ParseOutboundRuleIDInsensitively(input string) (*OutboundRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(OutboundRuleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := OutboundRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.WorkspaceName, ok = parsed.Parsed["workspaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'workspaceName' was not found in the resource id %q", input)
+	}
+
+	if id.OutboundRuleName, ok = parsed.Parsed["outboundRuleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'outboundRuleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateOutboundRuleID checks that 'input' can be parsed as a Outbound Rule ID
+func ValidateOutboundRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseOutboundRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Outbound Rule ID
+func (id OutboundRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MachineLearningServices/workspaces/%s/outboundRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.OutboundRuleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Outbound Rule ID
+func (id OutboundRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMachineLearningServices", "Microsoft.MachineLearningServices", "Microsoft.MachineLearningServices"),
+		resourceids.StaticSegment("staticWorkspaces", "workspaces", "workspaces"),
+		resourceids.UserSpecifiedSegment("workspaceName", "workspaceValue"),
+		resourceids.StaticSegment("staticOutboundRules", "outboundRules", "outboundRules"),
+		resourceids.UserSpecifiedSegment("outboundRuleName", "outboundRuleValue"),
+	}
+}
+
+// String returns a human-readable description of this Outbound Rule ID
+func (id OutboundRuleId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Workspace Name: %q", id.WorkspaceName),
+		fmt.Sprintf("Outbound Rule Name: %q", id.OutboundRuleName),
+	}
+	return fmt.Sprintf("Outbound Rule (%s)", strings.Join(components, "\n"))
+}
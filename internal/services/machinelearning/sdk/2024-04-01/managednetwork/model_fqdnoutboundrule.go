@@ -0,0 +1,40 @@
+package managednetwork
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ OutboundRule = FqdnOutboundRule{}
+
+type FqdnOutboundRule struct {
+	Destination *string       `json:"destination,omitempty"`
+	Category    *RuleCategory `json:"category,omitempty"`
+	Status      *RuleStatus   `json:"status,omitempty"`
+
+	// Fields inherited from OutboundRule
+}
+
+var _ json.Marshaler = FqdnOutboundRule{}
+
+func (s FqdnOutboundRule) MarshalJSON() ([]byte, error) {
+	type wrapper FqdnOutboundRule
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling FqdnOutboundRule: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling FqdnOutboundRule: %+v", err)
+	}
+	decoded["type"] = "FQDN"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling FqdnOutboundRule: %+v", err)
+	}
+
+	return encoded, nil
+}
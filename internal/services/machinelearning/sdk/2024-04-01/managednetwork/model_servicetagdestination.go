@@ -0,0 +1,9 @@
+package managednetwork
+
+type ServiceTagDestination struct {
+	Action          *RuleAction `json:"action,omitempty"`
+	AddressPrefixes *[]string   `json:"addressPrefixes,omitempty"`
+	PortRanges      *string     `json:"portRanges,omitempty"`
+	Protocol        *string     `json:"protocol,omitempty"`
+	ServiceTag      *string     `json:"serviceTag,omitempty"`
+}
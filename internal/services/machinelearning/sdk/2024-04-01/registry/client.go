@@ -0,0 +1,15 @@
+package registry
+
+import "github.com/Azure/go-autorest/autorest"
+
+type RegistryClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRegistryClientWithBaseURI(endpoint string) RegistryClient {
+	return RegistryClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,124 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = RegistryId{}
+
+// RegistryId is a struct representing the Resource ID for a Registry
+type RegistryId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	RegistryName      string
+}
+
+// NewRegistryID returns a new RegistryId struct
+func NewRegistryID(subscriptionId string, resourceGroupName string, registryName string) RegistryId {
+	return RegistryId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		RegistryName:      registryName,
+	}
+}
+
+// ParseRegistryID parses 'input' into a RegistryId
+func ParseRegistryID(input string) (*RegistryId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RegistryId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RegistryId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.RegistryName, ok = parsed.Parsed["registryName"]; !ok {
+		return nil, fmt.Errorf("the segment 'registryName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseRegistryIDInsensitively parses 'input' case-insensitively into a RegistryId
+// note: this method should only be used for API response data and not user input
+func ParseRegistryIDInsensitively(input string) (*RegistryId, error) {
+	parser := resourceids.NewParserFromResourceIdType(RegistryId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := RegistryId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.RegistryName, ok = parsed.Parsed["registryName"]; !ok {
+		return nil, fmt.Errorf("the segment 'registryName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateRegistryID checks that 'input' can be parsed as a Registry ID
+func ValidateRegistryID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRegistryID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Registry ID
+func (id RegistryId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.MachineLearningServices/registries/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.RegistryName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Registry ID
+func (id RegistryId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMachineLearningServices", "Microsoft.MachineLearningServices", "Microsoft.MachineLearningServices"),
+		resourceids.StaticSegment("staticRegistries", "registries", "registries"),
+		resourceids.UserSpecifiedSegment("registryName", "registryValue"),
+	}
+}
+
+// String returns a human-readable description of this Registry ID
+func (id RegistryId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Registry Name: %q", id.RegistryName),
+	}
+	return fmt.Sprintf("Registry (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,5 @@
+package registry
+
+type ArmResourceId struct {
+	ResourceId *string `json:"resourceId,omitempty"`
+}
@@ -0,0 +1,10 @@
+package registry
+
+type Registry struct {
+	DiscoveryUrl                  *string                     `json:"discoveryUrl,omitempty"`
+	IntellectualPropertyPublisher *string                     `json:"intellectualPropertyPublisher,omitempty"`
+	ManagedResourceGroup          *ArmResourceId              `json:"managedResourceGroup,omitempty"`
+	MlFlowRegistryUri             *string                     `json:"mlFlowRegistryUri,omitempty"`
+	PublicNetworkAccess           *string                     `json:"publicNetworkAccess,omitempty"`
+	RegionDetails                 *[]RegistryRegionArmDetails `json:"regionDetails,omitempty"`
+}
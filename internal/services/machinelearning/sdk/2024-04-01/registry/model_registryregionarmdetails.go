@@ -0,0 +1,7 @@
+package registry
+
+type RegistryRegionArmDetails struct {
+	AcrDetails            *[]AcrDetails            `json:"acrDetails,omitempty"`
+	Location              *string                  `json:"location,omitempty"`
+	StorageAccountDetails *[]StorageAccountDetails `json:"storageAccountDetails,omitempty"`
+}
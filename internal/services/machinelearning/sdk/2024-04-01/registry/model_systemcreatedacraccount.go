@@ -0,0 +1,7 @@
+package registry
+
+type SystemCreatedAcrAccount struct {
+	AcrAccountName *string        `json:"acrAccountName,omitempty"`
+	AcrAccountSku  *string        `json:"acrAccountSku,omitempty"`
+	ArmResourceId  *ArmResourceId `json:"armResourceId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package registry
+
+type SystemCreatedStorageAccount struct {
+	AllowBlobPublicAccess    *bool          `json:"allowBlobPublicAccess,omitempty"`
+	ArmResourceId            *ArmResourceId `json:"armResourceId,omitempty"`
+	StorageAccountHnsEnabled *bool          `json:"storageAccountHnsEnabled,omitempty"`
+	StorageAccountName       *string        `json:"storageAccountName,omitempty"`
+	StorageAccountType       *string        `json:"storageAccountType,omitempty"`
+}
@@ -0,0 +1,5 @@
+package registry
+
+type UserCreatedAcrAccount struct {
+	ArmResourceId *ArmResourceId `json:"armResourceId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package registry
+
+type UserCreatedStorageAccount struct {
+	ArmResourceId *ArmResourceId `json:"armResourceId,omitempty"`
+}
@@ -0,0 +1,9 @@
+package registry
+
+import "fmt"
+
+const defaultApiVersion = "2024-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/registry/%s", defaultApiVersion)
+}
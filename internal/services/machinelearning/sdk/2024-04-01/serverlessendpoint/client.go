@@ -0,0 +1,15 @@
+package serverlessendpoint
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ServerlessEndpointClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewServerlessEndpointClientWithBaseURI(endpoint string) ServerlessEndpointClient {
+	return ServerlessEndpointClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,139 @@
+package serverlessendpoint
+
+import "strings"
+
+type ContentSafetyStatus string
+
+const (
+	ContentSafetyStatusDisabled ContentSafetyStatus = "Disabled"
+	ContentSafetyStatusEnabled  ContentSafetyStatus = "Enabled"
+)
+
+func PossibleValuesForContentSafetyStatus() []string {
+	return []string{
+		string(ContentSafetyStatusDisabled),
+		string(ContentSafetyStatusEnabled),
+	}
+}
+
+func parseContentSafetyStatus(input string) (*ContentSafetyStatus, error) {
+	vals := map[string]ContentSafetyStatus{
+		"disabled": ContentSafetyStatusDisabled,
+		"enabled":  ContentSafetyStatusEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ContentSafetyStatus(input)
+	return &out, nil
+}
+
+type ServerlessEndpointState string
+
+const (
+	ServerlessEndpointStateCreating       ServerlessEndpointState = "Creating"
+	ServerlessEndpointStateCreationFailed ServerlessEndpointState = "CreationFailed"
+	ServerlessEndpointStateDeleting       ServerlessEndpointState = "Deleting"
+	ServerlessEndpointStateDeletionFailed ServerlessEndpointState = "DeletionFailed"
+	ServerlessEndpointStateOnline         ServerlessEndpointState = "Online"
+	ServerlessEndpointStateReinstating    ServerlessEndpointState = "Reinstating"
+	ServerlessEndpointStateSuspended      ServerlessEndpointState = "Suspended"
+	ServerlessEndpointStateSuspending     ServerlessEndpointState = "Suspending"
+	ServerlessEndpointStateUnknown        ServerlessEndpointState = "Unknown"
+)
+
+func PossibleValuesForServerlessEndpointState() []string {
+	return []string{
+		string(ServerlessEndpointStateCreating),
+		string(ServerlessEndpointStateCreationFailed),
+		string(ServerlessEndpointStateDeleting),
+		string(ServerlessEndpointStateDeletionFailed),
+		string(ServerlessEndpointStateOnline),
+		string(ServerlessEndpointStateReinstating),
+		string(ServerlessEndpointStateSuspended),
+		string(ServerlessEndpointStateSuspending),
+		string(ServerlessEndpointStateUnknown),
+	}
+}
+
+func parseServerlessEndpointState(input string) (*ServerlessEndpointState, error) {
+	vals := map[string]ServerlessEndpointState{
+		"creating":       ServerlessEndpointStateCreating,
+		"creationfailed": ServerlessEndpointStateCreationFailed,
+		"deleting":       ServerlessEndpointStateDeleting,
+		"deletionfailed": ServerlessEndpointStateDeletionFailed,
+		"online":         ServerlessEndpointStateOnline,
+		"reinstating":    ServerlessEndpointStateReinstating,
+		"suspended":      ServerlessEndpointStateSuspended,
+		"suspending":     ServerlessEndpointStateSuspending,
+		"unknown":        ServerlessEndpointStateUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ServerlessEndpointState(input)
+	return &out, nil
+}
+
+type ServerlessInferenceEndpointAuthMode string
+
+const (
+	ServerlessInferenceEndpointAuthModeKey ServerlessInferenceEndpointAuthMode = "Key"
+)
+
+func PossibleValuesForServerlessInferenceEndpointAuthMode() []string {
+	return []string{
+		string(ServerlessInferenceEndpointAuthModeKey),
+	}
+}
+
+func parseServerlessInferenceEndpointAuthMode(input string) (*ServerlessInferenceEndpointAuthMode, error) {
+	vals := map[string]ServerlessInferenceEndpointAuthMode{
+		"key": ServerlessInferenceEndpointAuthModeKey,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ServerlessInferenceEndpointAuthMode(input)
+	return &out, nil
+}
+
+type SkuTier string
+
+const (
+	SkuTierBasic    SkuTier = "Basic"
+	SkuTierFree     SkuTier = "Free"
+	SkuTierPremium  SkuTier = "Premium"
+	SkuTierStandard SkuTier = "Standard"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierBasic),
+		string(SkuTierFree),
+		string(SkuTierPremium),
+		string(SkuTierStandard),
+	}
+}
+
+func parseSkuTier(input string) (*SkuTier, error) {
+	vals := map[string]SkuTier{
+		"basic":    SkuTierBasic,
+		"free":     SkuTierFree,
+		"premium":  SkuTierPremium,
+		"standard": SkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuTier(input)
+	return &out, nil
+}
@@ -0,0 +1,65 @@
+package serverlessendpoint
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ListKeysResponse struct {
+	HttpResponse *http.Response
+	Model        *EndpointAuthKeys
+}
+
+// ListKeys ...
+func (c ServerlessEndpointClient) ListKeys(ctx context.Context, id ServerlessEndpointId) (result ListKeysResponse, err error) {
+	req, err := c.preparerForListKeys(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ListKeys", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ListKeys", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForListKeys(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "serverlessendpoint.ServerlessEndpointClient", "ListKeys", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForListKeys prepares the ListKeys request.
+func (c ServerlessEndpointClient) preparerForListKeys(ctx context.Context, id ServerlessEndpointId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listKeys", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForListKeys handles the response to the ListKeys request. The method always
+// closes the http.Response Body.
+func (c ServerlessEndpointClient) responderForListKeys(resp *http.Response) (result ListKeysResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,5 @@
+package serverlessendpoint
+
+type ContentSafety struct {
+	ContentSafetyStatus ContentSafetyStatus `json:"contentSafetyStatus"`
+}
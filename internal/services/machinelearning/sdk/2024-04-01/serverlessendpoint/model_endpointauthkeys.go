@@ -0,0 +1,6 @@
+package serverlessendpoint
+
+type EndpointAuthKeys struct {
+	PrimaryKey   *string `json:"primaryKey,omitempty"`
+	SecondaryKey *string `json:"secondaryKey,omitempty"`
+}
@@ -0,0 +1,5 @@
+package serverlessendpoint
+
+type ModelSettings struct {
+	ModelId string `json:"modelId"`
+}
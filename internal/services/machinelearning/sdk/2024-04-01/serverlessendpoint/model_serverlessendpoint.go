@@ -0,0 +1,11 @@
+package serverlessendpoint
+
+type ServerlessEndpoint struct {
+	AuthMode                  ServerlessInferenceEndpointAuthMode `json:"authMode"`
+	ContentSafety             *ContentSafety                      `json:"contentSafety,omitempty"`
+	EndpointState             *ServerlessEndpointState            `json:"endpointState,omitempty"`
+	InferenceEndpoint         *ServerlessInferenceEndpoint        `json:"inferenceEndpoint,omitempty"`
+	MarketplaceSubscriptionId *string                             `json:"marketplaceSubscriptionId,omitempty"`
+	ModelSettings             *ModelSettings                      `json:"modelSettings,omitempty"`
+	ProvisioningState         *string                             `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,6 @@
+package serverlessendpoint
+
+type ServerlessInferenceEndpoint struct {
+	Headers *map[string]string `json:"headers,omitempty"`
+	Uri     string             `json:"uri"`
+}
@@ -0,0 +1,9 @@
+package serverlessendpoint
+
+import "fmt"
+
+const defaultApiVersion = "2024-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/serverlessendpoint/%s", defaultApiVersion)
+}
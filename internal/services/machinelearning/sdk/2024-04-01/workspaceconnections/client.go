@@ -0,0 +1,15 @@
+package workspaceconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkspaceConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkspaceConnectionsClientWithBaseURI(endpoint string) WorkspaceConnectionsClient {
+	return WorkspaceConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
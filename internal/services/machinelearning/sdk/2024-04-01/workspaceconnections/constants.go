@@ -0,0 +1,71 @@
+package workspaceconnections
+
+import "strings"
+
+type ConnectionAuthType string
+
+const (
+	ConnectionAuthTypeAAD        ConnectionAuthType = "AAD"
+	ConnectionAuthTypeAccountKey ConnectionAuthType = "AccountKey"
+	ConnectionAuthTypeApiKey     ConnectionAuthType = "ApiKey"
+)
+
+func PossibleValuesForConnectionAuthType() []string {
+	return []string{
+		string(ConnectionAuthTypeAAD),
+		string(ConnectionAuthTypeAccountKey),
+		string(ConnectionAuthTypeApiKey),
+	}
+}
+
+func parseConnectionAuthType(input string) (*ConnectionAuthType, error) {
+	vals := map[string]ConnectionAuthType{
+		"aad":        ConnectionAuthTypeAAD,
+		"accountkey": ConnectionAuthTypeAccountKey,
+		"apikey":     ConnectionAuthTypeApiKey,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConnectionAuthType(input)
+	return &out, nil
+}
+
+type ConnectionCategory string
+
+const (
+	ConnectionCategoryAIServices       ConnectionCategory = "AIServices"
+	ConnectionCategoryAzureBlob        ConnectionCategory = "AzureBlob"
+	ConnectionCategoryAzureOpenAI      ConnectionCategory = "AzureOpenAI"
+	ConnectionCategoryCognitiveSearch  ConnectionCategory = "CognitiveSearch"
+	ConnectionCategoryCognitiveService ConnectionCategory = "CognitiveService"
+)
+
+func PossibleValuesForConnectionCategory() []string {
+	return []string{
+		string(ConnectionCategoryAIServices),
+		string(ConnectionCategoryAzureBlob),
+		string(ConnectionCategoryAzureOpenAI),
+		string(ConnectionCategoryCognitiveSearch),
+		string(ConnectionCategoryCognitiveService),
+	}
+}
+
+func parseConnectionCategory(input string) (*ConnectionCategory, error) {
+	vals := map[string]ConnectionCategory{
+		"aiservices":       ConnectionCategoryAIServices,
+		"azureblob":        ConnectionCategoryAzureBlob,
+		"azureopenai":      ConnectionCategoryAzureOpenAI,
+		"cognitivesearch":  ConnectionCategoryCognitiveSearch,
+		"cognitiveservice": ConnectionCategoryCognitiveService,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConnectionCategory(input)
+	return &out, nil
+}
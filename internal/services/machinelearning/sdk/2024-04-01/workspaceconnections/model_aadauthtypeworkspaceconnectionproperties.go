@@ -0,0 +1,42 @@
+package workspaceconnections
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ WorkspaceConnectionPropertiesV2 = AADAuthTypeWorkspaceConnectionProperties{}
+
+type AADAuthTypeWorkspaceConnectionProperties struct {
+	Category      *ConnectionCategory `json:"category,omitempty"`
+	ExpiryTime    *string             `json:"expiryTime,omitempty"`
+	IsSharedToAll *bool               `json:"isSharedToAll,omitempty"`
+	Metadata      *map[string]string  `json:"metadata,omitempty"`
+	Target        *string             `json:"target,omitempty"`
+
+	// Fields inherited from WorkspaceConnectionPropertiesV2
+}
+
+var _ json.Marshaler = AADAuthTypeWorkspaceConnectionProperties{}
+
+func (s AADAuthTypeWorkspaceConnectionProperties) MarshalJSON() ([]byte, error) {
+	type wrapper AADAuthTypeWorkspaceConnectionProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling AADAuthTypeWorkspaceConnectionProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling AADAuthTypeWorkspaceConnectionProperties: %+v", err)
+	}
+	decoded["authType"] = "AAD"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling AADAuthTypeWorkspaceConnectionProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
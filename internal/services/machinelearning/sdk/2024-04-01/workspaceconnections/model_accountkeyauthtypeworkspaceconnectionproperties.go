@@ -0,0 +1,43 @@
+package workspaceconnections
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ WorkspaceConnectionPropertiesV2 = AccountKeyAuthTypeWorkspaceConnectionProperties{}
+
+type AccountKeyAuthTypeWorkspaceConnectionProperties struct {
+	Category      *ConnectionCategory            `json:"category,omitempty"`
+	ExpiryTime    *string                        `json:"expiryTime,omitempty"`
+	IsSharedToAll *bool                          `json:"isSharedToAll,omitempty"`
+	Metadata      *map[string]string             `json:"metadata,omitempty"`
+	Target        *string                        `json:"target,omitempty"`
+	Credentials   *WorkspaceConnectionAccountKey `json:"credentials,omitempty"`
+
+	// Fields inherited from WorkspaceConnectionPropertiesV2
+}
+
+var _ json.Marshaler = AccountKeyAuthTypeWorkspaceConnectionProperties{}
+
+func (s AccountKeyAuthTypeWorkspaceConnectionProperties) MarshalJSON() ([]byte, error) {
+	type wrapper AccountKeyAuthTypeWorkspaceConnectionProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling AccountKeyAuthTypeWorkspaceConnectionProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling AccountKeyAuthTypeWorkspaceConnectionProperties: %+v", err)
+	}
+	decoded["authType"] = "AccountKey"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling AccountKeyAuthTypeWorkspaceConnectionProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
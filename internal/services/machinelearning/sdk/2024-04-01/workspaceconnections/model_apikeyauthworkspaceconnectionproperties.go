@@ -0,0 +1,43 @@
+package workspaceconnections
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ WorkspaceConnectionPropertiesV2 = ApiKeyAuthWorkspaceConnectionProperties{}
+
+type ApiKeyAuthWorkspaceConnectionProperties struct {
+	Category      *ConnectionCategory        `json:"category,omitempty"`
+	ExpiryTime    *string                    `json:"expiryTime,omitempty"`
+	IsSharedToAll *bool                      `json:"isSharedToAll,omitempty"`
+	Metadata      *map[string]string         `json:"metadata,omitempty"`
+	Target        *string                    `json:"target,omitempty"`
+	Credentials   *WorkspaceConnectionApiKey `json:"credentials,omitempty"`
+
+	// Fields inherited from WorkspaceConnectionPropertiesV2
+}
+
+var _ json.Marshaler = ApiKeyAuthWorkspaceConnectionProperties{}
+
+func (s ApiKeyAuthWorkspaceConnectionProperties) MarshalJSON() ([]byte, error) {
+	type wrapper ApiKeyAuthWorkspaceConnectionProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling ApiKeyAuthWorkspaceConnectionProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling ApiKeyAuthWorkspaceConnectionProperties: %+v", err)
+	}
+	decoded["authType"] = "ApiKey"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling ApiKeyAuthWorkspaceConnectionProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,5 @@
+package workspaceconnections
+
+type WorkspaceConnectionAccountKey struct {
+	Key *string `json:"key,omitempty"`
+}
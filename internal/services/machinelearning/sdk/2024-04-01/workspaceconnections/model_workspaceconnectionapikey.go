@@ -0,0 +1,5 @@
+package workspaceconnections
+
+type WorkspaceConnectionApiKey struct {
+	Key *string `json:"key,omitempty"`
+}
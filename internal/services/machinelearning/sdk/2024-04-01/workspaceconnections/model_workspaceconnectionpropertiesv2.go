@@ -0,0 +1,61 @@
+package workspaceconnections
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type WorkspaceConnectionPropertiesV2 interface {
+}
+
+func unmarshalWorkspaceConnectionPropertiesV2Implementation(input []byte) (WorkspaceConnectionPropertiesV2, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling WorkspaceConnectionPropertiesV2 into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["authType"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "AAD") {
+		var out AADAuthTypeWorkspaceConnectionProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into AADAuthTypeWorkspaceConnectionProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "AccountKey") {
+		var out AccountKeyAuthTypeWorkspaceConnectionProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into AccountKeyAuthTypeWorkspaceConnectionProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "ApiKey") {
+		var out ApiKeyAuthWorkspaceConnectionProperties
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ApiKeyAuthWorkspaceConnectionProperties: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawWorkspaceConnectionPropertiesV2Impl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawWorkspaceConnectionPropertiesV2Impl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+
+}
@@ -0,0 +1,41 @@
+package workspaceconnections
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type WorkspaceConnectionPropertiesV2BasicResource struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties WorkspaceConnectionPropertiesV2 `json:"properties"`
+	Type       *string                         `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &WorkspaceConnectionPropertiesV2BasicResource{}
+
+func (s *WorkspaceConnectionPropertiesV2BasicResource) UnmarshalJSON(bytes []byte) error {
+	type alias WorkspaceConnectionPropertiesV2BasicResource
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into WorkspaceConnectionPropertiesV2BasicResource: %+v", err)
+	}
+
+	s.Id = decoded.Id
+	s.Name = decoded.Name
+	s.Type = decoded.Type
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling WorkspaceConnectionPropertiesV2BasicResource into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalWorkspaceConnectionPropertiesV2Implementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'WorkspaceConnectionPropertiesV2BasicResource': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package workspaceconnections
+
+import "fmt"
+
+const defaultApiVersion = "2024-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/workspaceconnections/%s", defaultApiVersion)
+}
@@ -0,0 +1,167 @@
+package workspaces
+
+import "strings"
+
+type IsolationMode string
+
+const (
+	IsolationModeAllowInternetOutbound     IsolationMode = "AllowInternetOutbound"
+	IsolationModeAllowOnlyApprovedOutbound IsolationMode = "AllowOnlyApprovedOutbound"
+	IsolationModeDisabled                  IsolationMode = "Disabled"
+)
+
+func PossibleValuesForIsolationMode() []string {
+	return []string{
+		string(IsolationModeAllowInternetOutbound),
+		string(IsolationModeAllowOnlyApprovedOutbound),
+		string(IsolationModeDisabled),
+	}
+}
+
+func parseIsolationMode(input string) (*IsolationMode, error) {
+	vals := map[string]IsolationMode{
+		"allowinternetoutbound":     IsolationModeAllowInternetOutbound,
+		"allowonlyapprovedoutbound": IsolationModeAllowOnlyApprovedOutbound,
+		"disabled":                  IsolationModeDisabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IsolationMode(input)
+	return &out, nil
+}
+
+type ManagedNetworkStatus string
+
+const (
+	ManagedNetworkStatusActive   ManagedNetworkStatus = "Active"
+	ManagedNetworkStatusInactive ManagedNetworkStatus = "Inactive"
+)
+
+func PossibleValuesForManagedNetworkStatus() []string {
+	return []string{
+		string(ManagedNetworkStatusActive),
+		string(ManagedNetworkStatusInactive),
+	}
+}
+
+func parseManagedNetworkStatus(input string) (*ManagedNetworkStatus, error) {
+	vals := map[string]ManagedNetworkStatus{
+		"active":   ManagedNetworkStatusActive,
+		"inactive": ManagedNetworkStatusInactive,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedNetworkStatus(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUnknown   ProvisioningState = "Unknown"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUnknown),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"unknown":   ProvisioningStateUnknown,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type PublicNetworkAccessType string
+
+const (
+	PublicNetworkAccessTypeDisabled PublicNetworkAccessType = "Disabled"
+	PublicNetworkAccessTypeEnabled  PublicNetworkAccessType = "Enabled"
+)
+
+func PossibleValuesForPublicNetworkAccessType() []string {
+	return []string{
+		string(PublicNetworkAccessTypeDisabled),
+		string(PublicNetworkAccessTypeEnabled),
+	}
+}
+
+func parsePublicNetworkAccessType(input string) (*PublicNetworkAccessType, error) {
+	vals := map[string]PublicNetworkAccessType{
+		"disabled": PublicNetworkAccessTypeDisabled,
+		"enabled":  PublicNetworkAccessTypeEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PublicNetworkAccessType(input)
+	return &out, nil
+}
+
+type SkuTier string
+
+const (
+	SkuTierBasic    SkuTier = "Basic"
+	SkuTierFree     SkuTier = "Free"
+	SkuTierPremium  SkuTier = "Premium"
+	SkuTierStandard SkuTier = "Standard"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierBasic),
+		string(SkuTierFree),
+		string(SkuTierPremium),
+		string(SkuTierStandard),
+	}
+}
+
+func parseSkuTier(input string) (*SkuTier, error) {
+	vals := map[string]SkuTier{
+		"basic":    SkuTierBasic,
+		"free":     SkuTierFree,
+		"premium":  SkuTierPremium,
+		"standard": SkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuTier(input)
+	return &out, nil
+}
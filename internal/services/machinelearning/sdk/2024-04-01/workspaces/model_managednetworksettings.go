@@ -0,0 +1,7 @@
+package workspaces
+
+type ManagedNetworkSettings struct {
+	IsolationMode *IsolationMode                 `json:"isolationMode,omitempty"`
+	NetworkId     *string                        `json:"networkId,omitempty"`
+	Status        *ManagedNetworkProvisionStatus `json:"status,omitempty"`
+}
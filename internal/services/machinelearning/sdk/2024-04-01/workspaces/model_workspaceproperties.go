@@ -0,0 +1,18 @@
+package workspaces
+
+type WorkspaceProperties struct {
+	ApplicationInsights         *string                  `json:"applicationInsights,omitempty"`
+	ContainerRegistry           *string                  `json:"containerRegistry,omitempty"`
+	Description                 *string                  `json:"description,omitempty"`
+	DiscoveryUrl                *string                  `json:"discoveryUrl,omitempty"`
+	FriendlyName                *string                  `json:"friendlyName,omitempty"`
+	HbiWorkspace                *bool                    `json:"hbiWorkspace,omitempty"`
+	HubResourceId               *string                  `json:"hubResourceId,omitempty"`
+	KeyVault                    *string                  `json:"keyVault,omitempty"`
+	ManagedNetwork              *ManagedNetworkSettings  `json:"managedNetwork,omitempty"`
+	PrimaryUserAssignedIdentity *string                  `json:"primaryUserAssignedIdentity,omitempty"`
+	ProvisioningState           *ProvisioningState       `json:"provisioningState,omitempty"`
+	PublicNetworkAccess         *PublicNetworkAccessType `json:"publicNetworkAccess,omitempty"`
+	StorageAccount              *string                  `json:"storageAccount,omitempty"`
+	WorkspaceId                 *string                  `json:"workspaceId,omitempty"`
+}
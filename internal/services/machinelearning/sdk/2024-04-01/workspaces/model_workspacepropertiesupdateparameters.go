@@ -0,0 +1,11 @@
+package workspaces
+
+type WorkspacePropertiesUpdateParameters struct {
+	ApplicationInsights         *string                  `json:"applicationInsights,omitempty"`
+	ContainerRegistry           *string                  `json:"containerRegistry,omitempty"`
+	Description                 *string                  `json:"description,omitempty"`
+	FriendlyName                *string                  `json:"friendlyName,omitempty"`
+	ManagedNetwork              *ManagedNetworkSettings  `json:"managedNetwork,omitempty"`
+	PrimaryUserAssignedIdentity *string                  `json:"primaryUserAssignedIdentity,omitempty"`
+	PublicNetworkAccess         *PublicNetworkAccessType `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,12 @@
+package workspaces
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type WorkspaceUpdateParameters struct {
+	Identity   *identity.SystemAndUserAssignedMap   `json:"identity,omitempty"`
+	Properties *WorkspacePropertiesUpdateParameters `json:"properties,omitempty"`
+	Sku        *Sku                                 `json:"sku,omitempty"`
+	Tags       *map[string]string                   `json:"tags,omitempty"`
+}
@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func EndpointName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// endpoint names are part of the scoring uri, so they're restricted to a DNS-safe subset
+	if matched := regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9-]{1,30}[a-zA-Z0-9]$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 3 and 32 characters, must start with a letter, end with an alphanumeric character and may only include alphanumeric characters and '-'", k))
+	}
+	return
+}
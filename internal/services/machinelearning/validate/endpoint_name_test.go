@@ -0,0 +1,66 @@
+package validate
+
+import "testing"
+
+func TestEndpointName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// cannot start with a number
+			input:    "1hello",
+			expected: false,
+		},
+		{
+			// cannot end with a hyphen
+			input:    "hello-",
+			expected: false,
+		},
+		{
+			// hyphen in the middle
+			input:    "hello-world",
+			expected: true,
+		},
+		{
+			// cannot contain underscores
+			input:    "hello_world",
+			expected: false,
+		},
+		{
+			// 2 chars
+			input:    "ab",
+			expected: false,
+		},
+		{
+			// 32 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdef",
+			expected: true,
+		},
+		{
+			// 33 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefg",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		_, errors := EndpointName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func WorkspaceConnectionName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9\-_]{2,32}$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be between 3 and 33 characters, must start with an alphanumeric character and may only include alphanumeric characters, '-' and '_'", k))
+	}
+	return
+}
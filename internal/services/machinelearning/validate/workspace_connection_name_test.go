@@ -0,0 +1,66 @@
+package validate
+
+import "testing"
+
+func TestWorkspaceConnectionName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// basic example
+			input:    "hello",
+			expected: true,
+		},
+		{
+			// cannot start with a hyphen
+			input:    "-hello",
+			expected: false,
+		},
+		{
+			// cannot start with an underscore
+			input:    "_hello",
+			expected: false,
+		},
+		{
+			// hyphens and underscores in the middle
+			input:    "hello-wor_ld",
+			expected: true,
+		},
+		{
+			// cannot contain other special symbols
+			input:    "hello.world",
+			expected: false,
+		},
+		{
+			// 2 chars
+			input:    "ab",
+			expected: false,
+		},
+		{
+			// 33 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefg",
+			expected: true,
+		},
+		{
+			// 34 chars
+			input:    "abcdefghijklmnopqrstuvwxyzabcdefgh",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		_, errors := WorkspaceConnectionName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
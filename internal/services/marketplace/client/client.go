@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privateoffers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestore"
+)
+
+type Client struct {
+	PrivateOffersClient *privateoffers.PrivateOffersClient
+	PrivateStoreClient  *privatestore.PrivateStoreClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	privateOffersClient := privateoffers.NewPrivateOffersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&privateOffersClient.Client, o.ResourceManagerAuthorizer)
+
+	privateStoreClient := privatestore.NewPrivateStoreClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&privateStoreClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		PrivateOffersClient: &privateOffersClient,
+		PrivateStoreClient:  &privateStoreClient,
+	}
+}
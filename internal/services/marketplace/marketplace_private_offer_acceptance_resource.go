@@ -0,0 +1,212 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privateoffers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// MarketplacePrivateOfferAcceptanceResource accepts a private offer (and the terms of one or more of its plans) which a
+// publisher has extended to the Subscription - an acceptance can't be withdrawn, so deleting this resource only removes
+// it from the state.
+type MarketplacePrivateOfferAcceptanceResource struct{}
+
+var _ sdk.Resource = MarketplacePrivateOfferAcceptanceResource{}
+
+type MarketplacePrivateOfferAcceptanceResourceModel struct {
+	PrivateOfferId string   `tfschema:"private_offer_id"`
+	PlanIds        []string `tfschema:"plan_ids"`
+	PublisherId    string   `tfschema:"publisher_id"`
+	OfferId        string   `tfschema:"offer_id"`
+	AcceptedAt     string   `tfschema:"accepted_at"`
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_offer_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"plan_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"publisher_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"offer_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"accepted_at": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) ModelObject() interface{} {
+	return &MarketplacePrivateOfferAcceptanceResourceModel{}
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) ResourceType() string {
+	return "azurerm_marketplace_private_offer_acceptance"
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privateoffers.ValidatePrivateOfferID
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateOffersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MarketplacePrivateOfferAcceptanceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := privateoffers.NewPrivateOfferID(subscriptionId, model.PrivateOfferId)
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			props := existing.Model.Properties
+			state := ""
+			if props.State != nil {
+				state = string(*props.State)
+			}
+			switch state {
+			case string(privateoffers.PrivateOfferStateAccepted):
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			case string(privateoffers.PrivateOfferStatePending):
+			default:
+				return fmt.Errorf("%s can only be accepted when it's %q but it's %q", id, string(privateoffers.PrivateOfferStatePending), state)
+			}
+
+			available := make([]string, 0)
+			if props.Plans != nil {
+				for _, plan := range *props.Plans {
+					if plan.PlanId != nil {
+						available = append(available, *plan.PlanId)
+					}
+				}
+			}
+			for _, planId := range model.PlanIds {
+				if !utils.SliceContainsValue(available, planId) {
+					return fmt.Errorf("the plan %q isn't part of %s - possible values are %q", planId, id, strings.Join(available, ", "))
+				}
+			}
+
+			planIds := model.PlanIds
+			payload := privateoffers.AcceptPrivateOfferRequest{
+				Properties: &privateoffers.AcceptPrivateOfferRequestProperties{
+					PlanIds:       &planIds,
+					TermsAccepted: utils.Bool(true),
+				},
+			}
+			if err := client.AcceptThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("accepting %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateOffersClient
+
+			id, err := privateoffers.ParsePrivateOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MarketplacePrivateOfferAcceptanceResourceModel{
+				PrivateOfferId: id.PrivateOfferId,
+				PlanIds:        make([]string, 0),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.State == nil || *props.State != privateoffers.PrivateOfferStateAccepted {
+						log.Printf("[DEBUG] %s hasn't been accepted - removing from state", *id)
+						return metadata.MarkAsGone(id)
+					}
+
+					state.PublisherId = utils.NormalizeNilableString(props.PublisherId)
+					state.OfferId = utils.NormalizeNilableString(props.OfferId)
+					state.AcceptedAt = utils.NormalizeNilableString(props.AcceptedAt)
+
+					if props.AcceptedPlanIds != nil {
+						state.PlanIds = *props.AcceptedPlanIds
+						sort.Strings(state.PlanIds)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplacePrivateOfferAcceptanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := privateoffers.ParsePrivateOfferID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] the acceptance of %s can't be withdrawn - removing from state", *id)
+			return nil
+		},
+	}
+}
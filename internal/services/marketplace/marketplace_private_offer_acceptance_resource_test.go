@@ -0,0 +1,71 @@
+package marketplace_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privateoffers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MarketplacePrivateOfferAcceptanceResource struct{}
+
+func TestAccMarketplacePrivateOfferAcceptance_basic(t *testing.T) {
+	// a private offer can only be accepted once and has to be extended to the test Subscription by a publisher
+	if os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_OFFER_ID") == "" || os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_OFFER_PLAN_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_MARKETPLACE_PRIVATE_OFFER_ID and/or ARM_TEST_MARKETPLACE_PRIVATE_OFFER_PLAN_ID are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_offer_acceptance", "test")
+	r := MarketplacePrivateOfferAcceptanceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("offer_id").IsSet(),
+				check.That(data.ResourceName).Key("accepted_at").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MarketplacePrivateOfferAcceptanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privateoffers.ParsePrivateOfferID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Marketplace.PrivateOffersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	accepted := resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.State != nil && *resp.Model.Properties.State == privateoffers.PrivateOfferStateAccepted
+	return utils.Bool(accepted), nil
+}
+
+func (MarketplacePrivateOfferAcceptanceResource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_marketplace_private_offer_acceptance" "test" {
+  private_offer_id = "%s"
+  plan_ids         = ["%s"]
+}
+`, os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_OFFER_ID"), os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_OFFER_PLAN_ID"))
+}
@@ -0,0 +1,159 @@
+package marketplace_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestore"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MarketplacePrivateStoreCollectionOfferResource struct{}
+
+func TestAccMarketplacePrivateStoreCollectionOffer_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_STORE") == "" {
+		t.Skip(privateStoreTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection_offer", "test")
+	r := MarketplacePrivateStoreCollectionOfferResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("offer_display_name").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollectionOffer_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_STORE") == "" {
+		t.Skip(privateStoreTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection_offer", "test")
+	r := MarketplacePrivateStoreCollectionOfferResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollectionOffer_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_STORE") == "" {
+		t.Skip(privateStoreTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection_offer", "test")
+	r := MarketplacePrivateStoreCollectionOfferResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.plans(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("plan_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MarketplacePrivateStoreCollectionOfferResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privatestore.ParseOfferID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Marketplace.PrivateStoreClient.CollectionsOfferGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (MarketplacePrivateStoreCollectionOfferResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  private_store_id = data.azurerm_client_config.current.tenant_id
+  name             = "acctest-collection-%d"
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, data.RandomInteger)
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection_offer" "test" {
+  collection_id = azurerm_marketplace_private_store_collection.test.id
+  offer_id      = "canonical.0001-com-ubuntu-server-jammy"
+}
+`, r.template(data))
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection_offer" "import" {
+  collection_id = azurerm_marketplace_private_store_collection_offer.test.collection_id
+  offer_id      = azurerm_marketplace_private_store_collection_offer.test.offer_id
+}
+`, r.basic(data))
+}
+
+func (r MarketplacePrivateStoreCollectionOfferResource) plans(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection_offer" "test" {
+  collection_id = azurerm_marketplace_private_store_collection.test.id
+  offer_id      = "canonical.0001-com-ubuntu-server-jammy"
+  plan_ids      = ["22_04-lts", "22_04-lts-gen2"]
+}
+`, r.template(data))
+}
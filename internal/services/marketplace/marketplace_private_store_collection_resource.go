@@ -0,0 +1,247 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestore"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// MarketplacePrivateStoreCollectionResource manages a Collection within the Private Azure Marketplace of a Tenant, which
+// controls the Marketplace offers that can be deployed into a set of Subscriptions.
+type MarketplacePrivateStoreCollectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = MarketplacePrivateStoreCollectionResource{}
+
+type MarketplacePrivateStoreCollectionResourceModel struct {
+	PrivateStoreId          string   `tfschema:"private_store_id"`
+	Name                    string   `tfschema:"name"`
+	AllSubscriptionsEnabled bool     `tfschema:"all_subscriptions_enabled"`
+	SubscriptionIds         []string `tfschema:"subscription_ids"`
+	ApproveAllItemsEnabled  bool     `tfschema:"approve_all_items_enabled"`
+	Enabled                 bool     `tfschema:"enabled"`
+	NumberOfOffers          int64    `tfschema:"number_of_offers"`
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_store_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"all_subscriptions_enabled": {
+			Type:          pluginsdk.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"subscription_ids"},
+		},
+
+		"subscription_ids": {
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			ConflictsWith: []string{"all_subscriptions_enabled"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"approve_all_items_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"number_of_offers": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) ModelObject() interface{} {
+	return &MarketplacePrivateStoreCollectionResourceModel{}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) ResourceType() string {
+	return "azurerm_marketplace_private_store_collection"
+}
+
+func (r MarketplacePrivateStoreCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privatestore.ValidateCollectionID
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreClient
+
+			var model MarketplacePrivateStoreCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			privateStoreId := privatestore.NewPrivateStoreID(model.PrivateStoreId)
+			if _, err := client.PrivateStoreGet(ctx, privateStoreId); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", privateStoreId, err)
+			}
+
+			// the ID of a Collection is a GUID chosen by the caller, the `name` is only a display name
+			collectionId, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating an ID for the Collection: %+v", err)
+			}
+
+			id := privatestore.NewCollectionID(model.PrivateStoreId, collectionId)
+
+			payload := privatestore.Collection{
+				Properties: expandMarketplacePrivateStoreCollectionProperties(model),
+			}
+			if _, err := client.CollectionsCreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreClient
+
+			id, err := privatestore.ParseCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.CollectionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MarketplacePrivateStoreCollectionResourceModel{
+				PrivateStoreId:  id.PrivateStoreId,
+				SubscriptionIds: make([]string, 0),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Name = utils.NormalizeNilableString(props.CollectionName)
+					state.AllSubscriptionsEnabled = props.AllSubscriptions != nil && *props.AllSubscriptions
+					state.ApproveAllItemsEnabled = props.ApproveAllItems != nil && *props.ApproveAllItems
+					state.Enabled = props.Enabled != nil && *props.Enabled
+
+					if props.NumberOfOffers != nil {
+						state.NumberOfOffers = *props.NumberOfOffers
+					}
+
+					// the API returns all of the Subscriptions within the Tenant when the Collection applies to all of them
+					if !state.AllSubscriptionsEnabled && props.SubscriptionsList != nil {
+						state.SubscriptionIds = *props.SubscriptionsList
+						sort.Strings(state.SubscriptionIds)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreClient
+
+			id, err := privatestore.ParseCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MarketplacePrivateStoreCollectionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := privatestore.Collection{
+				Properties: expandMarketplacePrivateStoreCollectionProperties(model),
+			}
+			if _, err := client.CollectionsCreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MarketplacePrivateStoreCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Marketplace.PrivateStoreClient
+
+			id, err := privatestore.ParseCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CollectionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMarketplacePrivateStoreCollectionProperties(input MarketplacePrivateStoreCollectionResourceModel) *privatestore.CollectionProperties {
+	subscriptionIds := input.SubscriptionIds
+	if subscriptionIds == nil {
+		subscriptionIds = make([]string, 0)
+	}
+
+	return &privatestore.CollectionProperties{
+		AllSubscriptions:  utils.Bool(input.AllSubscriptionsEnabled),
+		ApproveAllItems:   utils.Bool(input.ApproveAllItemsEnabled),
+		CollectionName:    utils.String(input.Name),
+		Enabled:           utils.Bool(input.Enabled),
+		SubscriptionsList: &subscriptionIds,
+	}
+}
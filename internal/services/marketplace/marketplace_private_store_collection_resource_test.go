@@ -0,0 +1,148 @@
+package marketplace_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/marketplace/sdk/2023-01-01/privatestore"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MarketplacePrivateStoreCollectionResource struct{}
+
+// privateStoreTestSkipMessage is used to skip the Private Azure Marketplace tests unless explicitly enabled, since these
+// require the Marketplace Admin role on the Tenant and change which offers can be deployed into its Subscriptions
+const privateStoreTestSkipMessage = "Skipping as ARM_TEST_MARKETPLACE_PRIVATE_STORE is not specified"
+
+func TestAccMarketplacePrivateStoreCollection_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_STORE") == "" {
+		t.Skip(privateStoreTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMarketplacePrivateStoreCollection_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_MARKETPLACE_PRIVATE_STORE") == "" {
+		t.Skip(privateStoreTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_private_store_collection", "test")
+	r := MarketplacePrivateStoreCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.subscriptions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.allSubscriptions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MarketplacePrivateStoreCollectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privatestore.ParseCollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Marketplace.PrivateStoreClient.CollectionsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (MarketplacePrivateStoreCollectionResource) template() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+`
+}
+
+func (r MarketplacePrivateStoreCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  private_store_id = data.azurerm_client_config.current.tenant_id
+  name             = "acctest-collection-%d"
+}
+`, r.template(), data.RandomInteger)
+}
+
+func (r MarketplacePrivateStoreCollectionResource) subscriptions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  private_store_id          = data.azurerm_client_config.current.tenant_id
+  name                      = "acctest-collection-updated-%d"
+  subscription_ids          = [data.azurerm_client_config.current.subscription_id]
+  approve_all_items_enabled = true
+  enabled                   = false
+}
+`, r.template(), data.RandomInteger)
+}
+
+func (r MarketplacePrivateStoreCollectionResource) allSubscriptions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_private_store_collection" "test" {
+  private_store_id          = data.azurerm_client_config.current.tenant_id
+  name                      = "acctest-collection-updated-%d"
+  all_subscriptions_enabled = true
+}
+`, r.template(), data.RandomInteger)
+}
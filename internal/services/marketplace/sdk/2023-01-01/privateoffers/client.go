@@ -0,0 +1,15 @@
+package privateoffers
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrivateOffersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrivateOffersClientWithBaseURI(endpoint string) PrivateOffersClient {
+	return PrivateOffersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,37 @@
+package privateoffers
+
+import "strings"
+
+type PrivateOfferState string
+
+const (
+	PrivateOfferStateAccepted  PrivateOfferState = "Accepted"
+	PrivateOfferStateExpired   PrivateOfferState = "Expired"
+	PrivateOfferStatePending   PrivateOfferState = "Pending"
+	PrivateOfferStateWithdrawn PrivateOfferState = "Withdrawn"
+)
+
+func PossibleValuesForPrivateOfferState() []string {
+	return []string{
+		string(PrivateOfferStateAccepted),
+		string(PrivateOfferStateExpired),
+		string(PrivateOfferStatePending),
+		string(PrivateOfferStateWithdrawn),
+	}
+}
+
+func parsePrivateOfferState(input string) (*PrivateOfferState, error) {
+	vals := map[string]PrivateOfferState{
+		"accepted":  PrivateOfferStateAccepted,
+		"expired":   PrivateOfferStateExpired,
+		"pending":   PrivateOfferStatePending,
+		"withdrawn": PrivateOfferStateWithdrawn,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PrivateOfferState(input)
+	return &out, nil
+}
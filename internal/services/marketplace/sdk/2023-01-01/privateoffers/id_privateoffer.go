@@ -0,0 +1,111 @@
+package privateoffers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = PrivateOfferId{}
+
+// PrivateOfferId is a struct representing the Resource ID for a Private Offer
+type PrivateOfferId struct {
+	SubscriptionId string
+	PrivateOfferId string
+}
+
+// NewPrivateOfferID returns a new PrivateOfferId struct
+func NewPrivateOfferID(subscriptionId string, privateOfferId string) PrivateOfferId {
+	return PrivateOfferId{
+		SubscriptionId: subscriptionId,
+		PrivateOfferId: privateOfferId,
+	}
+}
+
+// ParsePrivateOfferID parses 'input' into a PrivateOfferId
+func ParsePrivateOfferID(input string) (*PrivateOfferId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PrivateOfferId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PrivateOfferId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.PrivateOfferId, ok = parsed.Parsed["privateOfferId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateOfferId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParsePrivateOfferIDInsensitively parses 'input' case-insensitively into a PrivateOfferId
+// note: this method should only be used for API response data and not user input
+func ParsePrivateOfferIDInsensitively(input string) (*PrivateOfferId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PrivateOfferId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PrivateOfferId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.PrivateOfferId, ok = parsed.Parsed["privateOfferId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateOfferId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidatePrivateOfferID checks that 'input' can be parsed as a Private Offer ID
+func ValidatePrivateOfferID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePrivateOfferID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Private Offer ID
+func (id PrivateOfferId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Marketplace/privateOffers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.PrivateOfferId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Private Offer ID
+func (id PrivateOfferId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMarketplace", "Microsoft.Marketplace", "Microsoft.Marketplace"),
+		resourceids.StaticSegment("staticPrivateOffers", "privateOffers", "privateOffers"),
+		resourceids.UserSpecifiedSegment("privateOfferId", "privateOfferIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Private Offer ID
+func (id PrivateOfferId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Private Offer Id: %q", id.PrivateOfferId),
+	}
+	return fmt.Sprintf("Private Offer (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,75 @@
+package privateoffers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type AcceptResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Accept ...
+func (c PrivateOffersClient) Accept(ctx context.Context, id PrivateOfferId, input AcceptPrivateOfferRequest) (result AcceptResponse, err error) {
+	req, err := c.preparerForAccept(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privateoffers.PrivateOffersClient", "Accept", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForAccept(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privateoffers.PrivateOffersClient", "Accept", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// AcceptThenPoll performs Accept then polls until it's completed
+func (c PrivateOffersClient) AcceptThenPoll(ctx context.Context, id PrivateOfferId, input AcceptPrivateOfferRequest) error {
+	result, err := c.Accept(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Accept: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Accept: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForAccept prepares the Accept request.
+func (c PrivateOffersClient) preparerForAccept(ctx context.Context, id PrivateOfferId, input AcceptPrivateOfferRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/accept", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForAccept sends the Accept request. The method will close the
+// http.Response Body if it receives an error.
+func (c PrivateOffersClient) senderForAccept(ctx context.Context, req *http.Request) (future AcceptResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,5 @@
+package privateoffers
+
+type AcceptPrivateOfferRequest struct {
+	Properties *AcceptPrivateOfferRequestProperties `json:"properties,omitempty"`
+}
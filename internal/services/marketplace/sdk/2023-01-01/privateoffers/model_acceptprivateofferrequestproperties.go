@@ -0,0 +1,6 @@
+package privateoffers
+
+type AcceptPrivateOfferRequestProperties struct {
+	PlanIds       *[]string `json:"planIds,omitempty"`
+	TermsAccepted *bool     `json:"termsAccepted,omitempty"`
+}
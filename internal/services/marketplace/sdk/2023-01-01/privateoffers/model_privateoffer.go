@@ -0,0 +1,8 @@
+package privateoffers
+
+type PrivateOffer struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *PrivateOfferProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package privateoffers
+
+type PrivateOfferPlan struct {
+	PlanDisplayName *string `json:"planDisplayName,omitempty"`
+	PlanId          *string `json:"planId,omitempty"`
+}
@@ -0,0 +1,12 @@
+package privateoffers
+
+type PrivateOfferProperties struct {
+	AcceptBy         *string             `json:"acceptBy,omitempty"`
+	AcceptedAt       *string             `json:"acceptedAt,omitempty"`
+	AcceptedPlanIds  *[]string           `json:"acceptedPlanIds,omitempty"`
+	OfferDisplayName *string             `json:"offerDisplayName,omitempty"`
+	OfferId          *string             `json:"offerId,omitempty"`
+	Plans            *[]PrivateOfferPlan `json:"plans,omitempty"`
+	PublisherId      *string             `json:"publisherId,omitempty"`
+	State            *PrivateOfferState  `json:"state,omitempty"`
+}
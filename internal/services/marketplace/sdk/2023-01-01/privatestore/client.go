@@ -0,0 +1,15 @@
+package privatestore
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrivateStoreClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrivateStoreClientWithBaseURI(endpoint string) PrivateStoreClient {
+	return PrivateStoreClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
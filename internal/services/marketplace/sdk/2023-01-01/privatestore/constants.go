@@ -0,0 +1,65 @@
+package privatestore
+
+import "strings"
+
+type Accessibility string
+
+const (
+	AccessibilityPrivateSubscriptionOnLevel Accessibility = "PrivateSubscriptionOnLevel"
+	AccessibilityPrivateTenantOnLevel       Accessibility = "PrivateTenantOnLevel"
+	AccessibilityPublic                     Accessibility = "Public"
+	AccessibilityUnknown                    Accessibility = "Unknown"
+)
+
+func PossibleValuesForAccessibility() []string {
+	return []string{
+		string(AccessibilityPrivateSubscriptionOnLevel),
+		string(AccessibilityPrivateTenantOnLevel),
+		string(AccessibilityPublic),
+		string(AccessibilityUnknown),
+	}
+}
+
+func parseAccessibility(input string) (*Accessibility, error) {
+	vals := map[string]Accessibility{
+		"privatesubscriptiononlevel": AccessibilityPrivateSubscriptionOnLevel,
+		"privatetenantonlevel":       AccessibilityPrivateTenantOnLevel,
+		"public":                     AccessibilityPublic,
+		"unknown":                    AccessibilityUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Accessibility(input)
+	return &out, nil
+}
+
+type Availability string
+
+const (
+	AvailabilityDisabled Availability = "disabled"
+	AvailabilityEnabled  Availability = "enabled"
+)
+
+func PossibleValuesForAvailability() []string {
+	return []string{
+		string(AvailabilityDisabled),
+		string(AvailabilityEnabled),
+	}
+}
+
+func parseAvailability(input string) (*Availability, error) {
+	vals := map[string]Availability{
+		"disabled": AvailabilityDisabled,
+		"enabled":  AvailabilityEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Availability(input)
+	return &out, nil
+}
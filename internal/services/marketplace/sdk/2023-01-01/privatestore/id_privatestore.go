@@ -0,0 +1,98 @@
+package privatestore
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = PrivateStoreId{}
+
+// PrivateStoreId is a struct representing the Resource ID for a Private Store
+type PrivateStoreId struct {
+	PrivateStoreId string
+}
+
+// NewPrivateStoreID returns a new PrivateStoreId struct
+func NewPrivateStoreID(privateStoreId string) PrivateStoreId {
+	return PrivateStoreId{
+		PrivateStoreId: privateStoreId,
+	}
+}
+
+// ParsePrivateStoreID parses 'input' into a PrivateStoreId
+func ParsePrivateStoreID(input string) (*PrivateStoreId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PrivateStoreId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PrivateStoreId{}
+
+	if id.PrivateStoreId, ok = parsed.Parsed["privateStoreId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateStoreId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParsePrivateStoreIDInsensitively parses 'input' case-insensitively into a PrivateStoreId
+// note: this method should only be used for API response data and not user input
+func ParsePrivateStoreIDInsensitively(input string) (*PrivateStoreId, error) {
+	parser := resourceids.NewParserFromResourceIdType(PrivateStoreId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := PrivateStoreId{}
+
+	if id.PrivateStoreId, ok = parsed.Parsed["privateStoreId"]; !ok {
+		return nil, fmt.Errorf("the segment 'privateStoreId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidatePrivateStoreID checks that 'input' can be parsed as a Private Store ID
+func ValidatePrivateStoreID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePrivateStoreID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Private Store ID
+func (id PrivateStoreId) ID() string {
+	fmtString := "/providers/Microsoft.Marketplace/privateStores/%s"
+	return fmt.Sprintf(fmtString, id.PrivateStoreId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Private Store ID
+func (id PrivateStoreId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftMarketplace", "Microsoft.Marketplace", "Microsoft.Marketplace"),
+		resourceids.StaticSegment("staticPrivateStores", "privateStores", "privateStores"),
+		resourceids.UserSpecifiedSegment("privateStoreId", "privateStoreIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Private Store ID
+func (id PrivateStoreId) String() string {
+	components := []string{
+		fmt.Sprintf("Private Store Id: %q", id.PrivateStoreId),
+	}
+	return fmt.Sprintf("Private Store (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,174 @@
+package privatestore
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = PrivateStoreId{}
+
+func TestNewPrivateStoreID(t *testing.T) {
+	id := NewPrivateStoreID("privateStoreIdValue")
+
+	if id.PrivateStoreId != "privateStoreIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'PrivateStoreId'", id.PrivateStoreId, "privateStoreIdValue")
+	}
+}
+
+func TestFormatPrivateStoreID(t *testing.T) {
+	actual := NewPrivateStoreID("privateStoreIdValue").ID()
+	expected := "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParsePrivateStoreID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateStoreId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Expected: &PrivateStoreId{
+				PrivateStoreId: "privateStoreIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParsePrivateStoreID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+	}
+}
+
+func TestParsePrivateStoreIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PrivateStoreId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.MaRkEtPlAcE",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Marketplace/privateStores",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.MaRkEtPlAcE/PrIvAtEsToReS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue",
+			Expected: &PrivateStoreId{
+				PrivateStoreId: "privateStoreIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Marketplace/privateStores/privateStoreIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.MaRkEtPlAcE/PrIvAtEsToReS/PrIvAtEsToReIdVaLuE",
+			Expected: &PrivateStoreId{
+				PrivateStoreId: "PrIvAtEsToReIdVaLuE",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.MaRkEtPlAcE/PrIvAtEsToReS/PrIvAtEsToReIdVaLuE/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParsePrivateStoreIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.PrivateStoreId != v.Expected.PrivateStoreId {
+			t.Fatalf("Expected %q but got %q for PrivateStoreId", v.Expected.PrivateStoreId, actual.PrivateStoreId)
+		}
+
+	}
+}
@@ -0,0 +1,65 @@
+package privatestore
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CollectionsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *Collection
+}
+
+// CollectionsCreateOrUpdate ...
+func (c PrivateStoreClient) CollectionsCreateOrUpdate(ctx context.Context, id CollectionId, input Collection) (result CollectionsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForCollectionsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCollectionsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCollectionsCreateOrUpdate prepares the CollectionsCreateOrUpdate request.
+func (c PrivateStoreClient) preparerForCollectionsCreateOrUpdate(ctx context.Context, id CollectionId, input Collection) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCollectionsCreateOrUpdate handles the response to the CollectionsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c PrivateStoreClient) responderForCollectionsCreateOrUpdate(resp *http.Response) (result CollectionsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,61 @@
+package privatestore
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CollectionsDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// CollectionsDelete ...
+func (c PrivateStoreClient) CollectionsDelete(ctx context.Context, id CollectionId) (result CollectionsDeleteResponse, err error) {
+	req, err := c.preparerForCollectionsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCollectionsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCollectionsDelete prepares the CollectionsDelete request.
+func (c PrivateStoreClient) preparerForCollectionsDelete(ctx context.Context, id CollectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCollectionsDelete handles the response to the CollectionsDelete request. The method always
+// closes the http.Response Body.
+func (c PrivateStoreClient) responderForCollectionsDelete(resp *http.Response) (result CollectionsDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package privatestore
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CollectionsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Collection
+}
+
+// CollectionsGet ...
+func (c PrivateStoreClient) CollectionsGet(ctx context.Context, id CollectionId) (result CollectionsGetResponse, err error) {
+	req, err := c.preparerForCollectionsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCollectionsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCollectionsGet prepares the CollectionsGet request.
+func (c PrivateStoreClient) preparerForCollectionsGet(ctx context.Context, id CollectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCollectionsGet handles the response to the CollectionsGet request. The method always
+// closes the http.Response Body.
+func (c PrivateStoreClient) responderForCollectionsGet(resp *http.Response) (result CollectionsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
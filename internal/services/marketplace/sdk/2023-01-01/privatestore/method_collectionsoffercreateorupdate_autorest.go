@@ -0,0 +1,65 @@
+package privatestore
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CollectionsOfferCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *Offer
+}
+
+// CollectionsOfferCreateOrUpdate ...
+func (c PrivateStoreClient) CollectionsOfferCreateOrUpdate(ctx context.Context, id OfferId, input Offer) (result CollectionsOfferCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForCollectionsOfferCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsOfferCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsOfferCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCollectionsOfferCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsOfferCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCollectionsOfferCreateOrUpdate prepares the CollectionsOfferCreateOrUpdate request.
+func (c PrivateStoreClient) preparerForCollectionsOfferCreateOrUpdate(ctx context.Context, id OfferId, input Offer) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCollectionsOfferCreateOrUpdate handles the response to the CollectionsOfferCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c PrivateStoreClient) responderForCollectionsOfferCreateOrUpdate(resp *http.Response) (result CollectionsOfferCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
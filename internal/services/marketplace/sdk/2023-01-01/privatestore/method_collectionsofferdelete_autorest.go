@@ -0,0 +1,61 @@
+package privatestore
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CollectionsOfferDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// CollectionsOfferDelete ...
+func (c PrivateStoreClient) CollectionsOfferDelete(ctx context.Context, id OfferId) (result CollectionsOfferDeleteResponse, err error) {
+	req, err := c.preparerForCollectionsOfferDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsOfferDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsOfferDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCollectionsOfferDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "CollectionsOfferDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCollectionsOfferDelete prepares the CollectionsOfferDelete request.
+func (c PrivateStoreClient) preparerForCollectionsOfferDelete(ctx context.Context, id OfferId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCollectionsOfferDelete handles the response to the CollectionsOfferDelete request. The method always
+// closes the http.Response Body.
+func (c PrivateStoreClient) responderForCollectionsOfferDelete(resp *http.Response) (result CollectionsOfferDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package privatestore
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PrivateStoreGetResponse struct {
+	HttpResponse *http.Response
+	Model        *PrivateStore
+}
+
+// PrivateStoreGet ...
+func (c PrivateStoreClient) PrivateStoreGet(ctx context.Context, id PrivateStoreId) (result PrivateStoreGetResponse, err error) {
+	req, err := c.preparerForPrivateStoreGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "PrivateStoreGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "PrivateStoreGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPrivateStoreGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatestore.PrivateStoreClient", "PrivateStoreGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPrivateStoreGet prepares the PrivateStoreGet request.
+func (c PrivateStoreClient) preparerForPrivateStoreGet(ctx context.Context, id PrivateStoreId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPrivateStoreGet handles the response to the PrivateStoreGet request. The method always
+// closes the http.Response Body.
+func (c PrivateStoreClient) responderForPrivateStoreGet(resp *http.Response) (result PrivateStoreGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,8 @@
+package privatestore
+
+type Collection struct {
+	Id         *string               `json:"id,omitempty"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *CollectionProperties `json:"properties,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
@@ -0,0 +1,13 @@
+package privatestore
+
+type CollectionProperties struct {
+	AllSubscriptions          *bool     `json:"allSubscriptions,omitempty"`
+	ApproveAllItems           *bool     `json:"approveAllItems,omitempty"`
+	ApproveAllItemsModifiedAt *string   `json:"approveAllItemsModifiedAt,omitempty"`
+	Claim                     *string   `json:"claim,omitempty"`
+	CollectionId              *string   `json:"collectionId,omitempty"`
+	CollectionName            *string   `json:"collectionName,omitempty"`
+	Enabled                   *bool     `json:"enabled,omitempty"`
+	NumberOfOffers            *int64    `json:"numberOfOffers,omitempty"`
+	SubscriptionsList         *[]string `json:"subscriptionsList,omitempty"`
+}
@@ -0,0 +1,8 @@
+package privatestore
+
+type Offer struct {
+	Id         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Properties *OfferProperties `json:"properties,omitempty"`
+	Type       *string          `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package privatestore
+
+type Plan struct {
+	Accessibility   *Accessibility `json:"accessibility,omitempty"`
+	PlanDisplayName *string        `json:"planDisplayName,omitempty"`
+	PlanId          *string        `json:"planId,omitempty"`
+	SkuId           *string        `json:"skuId,omitempty"`
+}
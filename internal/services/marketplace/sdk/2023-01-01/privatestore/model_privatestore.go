@@ -0,0 +1,8 @@
+package privatestore
+
+type PrivateStore struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *PrivateStoreProperties `json:"properties,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
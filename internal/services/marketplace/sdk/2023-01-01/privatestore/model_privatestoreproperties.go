@@ -0,0 +1,11 @@
+package privatestore
+
+type PrivateStoreProperties struct {
+	Availability     *Availability `json:"availability,omitempty"`
+	CollectionIds    *[]string     `json:"collectionIds,omitempty"`
+	ETag             *string       `json:"eTag,omitempty"`
+	IsGov            *bool         `json:"isGov,omitempty"`
+	PrivateStoreId   *string       `json:"privateStoreId,omitempty"`
+	PrivateStoreName *string       `json:"privateStoreName,omitempty"`
+	TenantId         *string       `json:"tenantId,omitempty"`
+}
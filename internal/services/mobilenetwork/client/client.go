@@ -0,0 +1,67 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/datanetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/packetcorecontrolplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/sim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/simgroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/simpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/site"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/slice"
+)
+
+type Client struct {
+	DataNetworkClient            *datanetwork.DataNetworkClient
+	MobileNetworkClient          *mobilenetwork.MobileNetworkClient
+	PacketCoreControlPlaneClient *packetcorecontrolplane.PacketCoreControlPlaneClient
+	SIMClient                    *sim.SIMClient
+	SIMGroupClient               *simgroup.SIMGroupClient
+	SIMPolicyClient              *simpolicy.SIMPolicyClient
+	ServiceClient                *service.ServiceClient
+	SiteClient                   *site.SiteClient
+	SliceClient                  *slice.SliceClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	dataNetworkClient := datanetwork.NewDataNetworkClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&dataNetworkClient.Client, o.ResourceManagerAuthorizer)
+
+	mobileNetworkClient := mobilenetwork.NewMobileNetworkClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&mobileNetworkClient.Client, o.ResourceManagerAuthorizer)
+
+	packetCoreControlPlaneClient := packetcorecontrolplane.NewPacketCoreControlPlaneClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&packetCoreControlPlaneClient.Client, o.ResourceManagerAuthorizer)
+
+	simClient := sim.NewSIMClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&simClient.Client, o.ResourceManagerAuthorizer)
+
+	simGroupClient := simgroup.NewSIMGroupClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&simGroupClient.Client, o.ResourceManagerAuthorizer)
+
+	simPolicyClient := simpolicy.NewSIMPolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&simPolicyClient.Client, o.ResourceManagerAuthorizer)
+
+	serviceClient := service.NewServiceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&serviceClient.Client, o.ResourceManagerAuthorizer)
+
+	siteClient := site.NewSiteClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&siteClient.Client, o.ResourceManagerAuthorizer)
+
+	sliceClient := slice.NewSliceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sliceClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		DataNetworkClient:            &dataNetworkClient,
+		MobileNetworkClient:          &mobileNetworkClient,
+		PacketCoreControlPlaneClient: &packetCoreControlPlaneClient,
+		SIMClient:                    &simClient,
+		SIMGroupClient:               &simGroupClient,
+		SIMPolicyClient:              &simPolicyClient,
+		ServiceClient:                &serviceClient,
+		SiteClient:                   &siteClient,
+		SliceClient:                  &sliceClient,
+	}
+}
@@ -0,0 +1,175 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/datanetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type DataNetworkResource struct{}
+
+func TestAccDataNetwork_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_data_network", "test")
+	r := DataNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataNetwork_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_data_network", "test")
+	r := DataNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDataNetwork_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_data_network", "test")
+	r := DataNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataNetwork_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_data_network", "test")
+	r := DataNetworkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DataNetworkResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := datanetwork.ParseDataNetworkID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MobileNetwork.DataNetworkClient.DataNetworksGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r DataNetworkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_data_network" "test" {
+  name              = "acctest-mndn-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
+
+func (r DataNetworkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_data_network" "import" {
+  name              = azurerm_mobile_network_data_network.test.name
+  mobile_network_id = azurerm_mobile_network_data_network.test.mobile_network_id
+  location          = azurerm_mobile_network_data_network.test.location
+}
+`, r.basic(data))
+}
+
+func (r DataNetworkResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_data_network" "test" {
+  name              = "acctest-mndn-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  description = "my favourite data network"
+
+  tags = {
+    key = "value"
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
+
+func (r DataNetworkResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_data_network" "test" {
+  name              = "acctest-mndn-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  description = "my updated data network"
+
+  tags = {
+    key = "updated"
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,332 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/sim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/simpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// EncryptedSimsResource uploads a batch of SIMs whose credentials have been encrypted by the SIM vendor
+// into a SIM Group, since this is a bulk action the ID of this resource is the ID of the SIM Group.
+type EncryptedSimsResource struct{}
+
+var _ sdk.Resource = EncryptedSimsResource{}
+
+type EncryptedSimsResourceModel struct {
+	MobileNetworkSimGroupId string              `tfschema:"mobile_network_sim_group_id"`
+	Version                 int64               `tfschema:"version"`
+	AzureKeyIdentifier      int64               `tfschema:"azure_key_identifier"`
+	VendorKeyFingerprint    string              `tfschema:"vendor_key_fingerprint"`
+	EncryptedTransportKey   string              `tfschema:"encrypted_transport_key"`
+	SignedTransportKey      string              `tfschema:"signed_transport_key"`
+	Sim                     []EncryptedSimModel `tfschema:"sim"`
+}
+
+type EncryptedSimModel struct {
+	Name                                  string                          `tfschema:"name"`
+	EncryptedCredentials                  string                          `tfschema:"encrypted_credentials"`
+	InternationalMobileSubscriberIdentity string                          `tfschema:"international_mobile_subscriber_identity"`
+	IntegratedCircuitCardIdentifier       string                          `tfschema:"integrated_circuit_card_identifier"`
+	DeviceType                            string                          `tfschema:"device_type"`
+	SimPolicyId                           string                          `tfschema:"sim_policy_id"`
+	StaticIpConfiguration                 []SimStaticIpConfigurationModel `tfschema:"static_ip_configuration"`
+	SimState                              string                          `tfschema:"sim_state"`
+}
+
+func (r EncryptedSimsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"mobile_network_sim_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sim.ValidateSimGroupID,
+		},
+
+		"version": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"azure_key_identifier": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"vendor_key_fingerprint": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"encrypted_transport_key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"signed_transport_key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sim": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"encrypted_credentials": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"international_mobile_subscriber_identity": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{5,15}$`), "`international_mobile_subscriber_identity` must be between 5 and 15 digits"),
+					},
+
+					"integrated_circuit_card_identifier": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^89[0-9]{17,18}$`), "`integrated_circuit_card_identifier` must be 19 or 20 digits starting with `89`"),
+					},
+
+					"device_type": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sim_policy_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: simpolicy.ValidateSimPolicyID,
+					},
+
+					"static_ip_configuration": simStaticIpConfigurationSchema(true),
+
+					"sim_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EncryptedSimsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EncryptedSimsResource) ModelObject() interface{} {
+	return &EncryptedSimsResourceModel{}
+}
+
+func (r EncryptedSimsResource) ResourceType() string {
+	return "azurerm_mobile_network_encrypted_sims"
+}
+
+func (r EncryptedSimsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sim.ValidateSimGroupID
+}
+
+func (r EncryptedSimsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMClient
+
+			var model EncryptedSimsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := sim.ParseSimGroupID(model.MobileNetworkSimGroupId)
+			if err != nil {
+				return err
+			}
+
+			// uploading a SIM which already exists would silently overwrite it, so check each of them first
+			for _, v := range model.Sim {
+				simId := sim.NewSimID(id.SubscriptionId, id.ResourceGroupName, id.SimGroupName, v.Name)
+				existing, err := client.SimsGet(ctx, simId)
+				if err != nil && !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", simId, err)
+				}
+				if !response.WasNotFound(existing.HttpResponse) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), simId)
+				}
+			}
+
+			sims := make([]sim.SimNameAndEncryptedProperties, 0)
+			for _, v := range model.Sim {
+				properties := sim.EncryptedSimPropertiesFormat{
+					EncryptedCredentials:                  utils.String(v.EncryptedCredentials),
+					IntegratedCircuitCardIdentifier:       utils.String(v.IntegratedCircuitCardIdentifier),
+					InternationalMobileSubscriberIdentity: v.InternationalMobileSubscriberIdentity,
+					StaticIPConfiguration:                 expandSimStaticIpConfiguration(v.StaticIpConfiguration),
+				}
+				if v.DeviceType != "" {
+					properties.DeviceType = utils.String(v.DeviceType)
+				}
+				if v.SimPolicyId != "" {
+					properties.SimPolicy = &sim.SimPolicyResourceId{
+						Id: v.SimPolicyId,
+					}
+				}
+
+				sims = append(sims, sim.SimNameAndEncryptedProperties{
+					Name:       v.Name,
+					Properties: properties,
+				})
+			}
+
+			payload := sim.EncryptedSimUploadList{
+				AzureKeyIdentifier:    model.AzureKeyIdentifier,
+				EncryptedTransportKey: model.EncryptedTransportKey,
+				SignedTransportKey:    model.SignedTransportKey,
+				Sims:                  sims,
+				VendorKeyFingerprint:  model.VendorKeyFingerprint,
+				Version:               model.Version,
+			}
+
+			if err := client.SimsBulkUploadEncryptedThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("uploading encrypted SIMs to %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EncryptedSimsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMClient
+
+			id, err := sim.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the transport keys and credentials are write-only, so the SIMs which were uploaded are tracked in the state
+			var state EncryptedSimsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+			state.MobileNetworkSimGroupId = id.ID()
+
+			sims := make([]EncryptedSimModel, 0)
+			for _, v := range state.Sim {
+				simId := sim.NewSimID(id.SubscriptionId, id.ResourceGroupName, id.SimGroupName, v.Name)
+				resp, err := client.SimsGet(ctx, simId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						continue
+					}
+					return fmt.Errorf("retrieving %s: %+v", simId, err)
+				}
+
+				if model := resp.Model; model != nil {
+					props := model.Properties
+					v.DeviceType = utils.NormalizeNilableString(props.DeviceType)
+					v.IntegratedCircuitCardIdentifier = utils.NormalizeNilableString(props.IntegratedCircuitCardIdentifier)
+					v.InternationalMobileSubscriberIdentity = props.InternationalMobileSubscriberIdentity
+					v.SimPolicyId = ""
+					if props.SimPolicy != nil {
+						simPolicyId, err := simpolicy.ParseSimPolicyIDInsensitively(props.SimPolicy.Id)
+						if err != nil {
+							return err
+						}
+						v.SimPolicyId = simPolicyId.ID()
+					}
+					v.SimState = ""
+					if props.SimState != nil {
+						v.SimState = string(*props.SimState)
+					}
+					v.StaticIpConfiguration = flattenSimStaticIpConfiguration(props.StaticIPConfiguration)
+				}
+
+				sims = append(sims, v)
+			}
+
+			if len(state.Sim) > 0 && len(sims) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+			state.Sim = sims
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EncryptedSimsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMClient
+
+			id, err := sim.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EncryptedSimsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			names := make([]string, 0)
+			for _, v := range model.Sim {
+				names = append(names, v.Name)
+			}
+
+			payload := sim.SimDeleteList{
+				Sims: names,
+			}
+			if err := client.SimsBulkDeleteThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("deleting encrypted SIMs from %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
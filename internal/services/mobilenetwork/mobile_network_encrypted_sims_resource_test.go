@@ -0,0 +1,141 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/sim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EncryptedSimsResource struct {
+	vendorKeyFingerprint  string
+	encryptedTransportKey string
+	signedTransportKey    string
+	encryptedCredentials  string
+}
+
+// the SIM credentials have to be encrypted by the SIM vendor, so these values need to be supplied for these tests
+func newEncryptedSimsResource(t *testing.T) EncryptedSimsResource {
+	r := EncryptedSimsResource{
+		vendorKeyFingerprint:  os.Getenv("ARM_TEST_MOBILE_NETWORK_VENDOR_KEY_FINGERPRINT"),
+		encryptedTransportKey: os.Getenv("ARM_TEST_MOBILE_NETWORK_ENCRYPTED_TRANSPORT_KEY"),
+		signedTransportKey:    os.Getenv("ARM_TEST_MOBILE_NETWORK_SIGNED_TRANSPORT_KEY"),
+		encryptedCredentials:  os.Getenv("ARM_TEST_MOBILE_NETWORK_ENCRYPTED_CREDENTIALS"),
+	}
+	if r.vendorKeyFingerprint == "" || r.encryptedTransportKey == "" || r.signedTransportKey == "" || r.encryptedCredentials == "" {
+		t.Skip("Skipping as ARM_TEST_MOBILE_NETWORK_VENDOR_KEY_FINGERPRINT, ARM_TEST_MOBILE_NETWORK_ENCRYPTED_TRANSPORT_KEY, ARM_TEST_MOBILE_NETWORK_SIGNED_TRANSPORT_KEY and ARM_TEST_MOBILE_NETWORK_ENCRYPTED_CREDENTIALS are not specified")
+	}
+
+	return r
+}
+
+func TestAccEncryptedSims_basic(t *testing.T) {
+	r := newEncryptedSimsResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_encrypted_sims", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sim.0.sim_state").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccEncryptedSims_requiresImport(t *testing.T) {
+	r := newEncryptedSimsResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_encrypted_sims", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_mobile_network_encrypted_sims"),
+		},
+	})
+}
+
+func (EncryptedSimsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	simGroupId, err := sim.ParseSimGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := sim.NewSimID(simGroupId.SubscriptionId, simGroupId.ResourceGroupName, simGroupId.SimGroupName, state.Attributes["sim.0.name"])
+	resp, err := clients.MobileNetwork.SIMClient.SimsGet(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EncryptedSimsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_sim_group" "test" {
+  name                = "acctest-mnsg-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  mobile_network_id   = azurerm_mobile_network.test.id
+}
+
+resource "azurerm_mobile_network_encrypted_sims" "test" {
+  mobile_network_sim_group_id = azurerm_mobile_network_sim_group.test.id
+  version                     = 1
+  azure_key_identifier        = 1
+  vendor_key_fingerprint      = "%[3]s"
+  encrypted_transport_key     = "%[4]s"
+  signed_transport_key        = "%[5]s"
+
+  sim {
+    name                                     = "acctest-mnsim-%[2]d"
+    encrypted_credentials                    = "%[6]s"
+    integrated_circuit_card_identifier       = "8900000000000000000"
+    international_mobile_subscriber_identity = "000000000000000"
+    sim_policy_id                            = azurerm_mobile_network_sim_policy.test.id
+  }
+}
+`, SimPolicyResource{}.basic(data), data.RandomInteger, r.vendorKeyFingerprint, r.encryptedTransportKey, r.signedTransportKey, r.encryptedCredentials)
+}
+
+func (r EncryptedSimsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_encrypted_sims" "import" {
+  mobile_network_sim_group_id = azurerm_mobile_network_encrypted_sims.test.mobile_network_sim_group_id
+  version                     = azurerm_mobile_network_encrypted_sims.test.version
+  azure_key_identifier        = azurerm_mobile_network_encrypted_sims.test.azure_key_identifier
+  vendor_key_fingerprint      = azurerm_mobile_network_encrypted_sims.test.vendor_key_fingerprint
+  encrypted_transport_key     = azurerm_mobile_network_encrypted_sims.test.encrypted_transport_key
+  signed_transport_key        = azurerm_mobile_network_encrypted_sims.test.signed_transport_key
+
+  sim {
+    name                                     = "acctest-mnsim-%[2]d"
+    encrypted_credentials                    = "%[3]s"
+    integrated_circuit_card_identifier       = "8900000000000000000"
+    international_mobile_subscriber_identity = "000000000000000"
+  }
+}
+`, r.basic(data), data.RandomInteger, r.encryptedCredentials)
+}
@@ -0,0 +1,654 @@
+package mobilenetwork
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/packetcorecontrolplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/site"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PacketCoreControlPlaneResource struct{}
+
+var _ sdk.ResourceWithUpdate = PacketCoreControlPlaneResource{}
+
+type PacketCoreControlPlaneResourceModel struct {
+	Name                          string                        `tfschema:"name"`
+	ResourceGroupName             string                        `tfschema:"resource_group_name"`
+	Location                      string                        `tfschema:"location"`
+	SiteIds                       []string                      `tfschema:"site_ids"`
+	Sku                           string                        `tfschema:"sku"`
+	ControlPlaneAccessName        string                        `tfschema:"control_plane_access_name"`
+	ControlPlaneAccessIPv4Address string                        `tfschema:"control_plane_access_ipv4_address"`
+	ControlPlaneAccessIPv4Subnet  string                        `tfschema:"control_plane_access_ipv4_subnet"`
+	ControlPlaneAccessIPv4Gateway string                        `tfschema:"control_plane_access_ipv4_gateway"`
+	CoreNetworkTechnology         string                        `tfschema:"core_network_technology"`
+	UserEquipmentMtuInBytes       int64                         `tfschema:"user_equipment_mtu_in_bytes"`
+	LocalDiagnosticsAccess        []LocalDiagnosticsAccessModel `tfschema:"local_diagnostics_access"`
+	Platform                      []PacketCorePlatformModel     `tfschema:"platform"`
+	InteroperabilitySettingsJson  string                        `tfschema:"interoperability_settings_json"`
+	SoftwareVersion               string                        `tfschema:"software_version"`
+	Tags                          map[string]string             `tfschema:"tags"`
+	RollbackVersion               string                        `tfschema:"rollback_version"`
+	InstallationState             string                        `tfschema:"installation_state"`
+	ReinstallRequired             bool                          `tfschema:"reinstall_required"`
+}
+
+type LocalDiagnosticsAccessModel struct {
+	AuthenticationType        string `tfschema:"authentication_type"`
+	HttpsServerCertificateUrl string `tfschema:"https_server_certificate_url"`
+}
+
+type PacketCorePlatformModel struct {
+	Type                   string `tfschema:"type"`
+	EdgeDeviceId           string `tfschema:"edge_device_id"`
+	StackHciClusterId      string `tfschema:"stack_hci_cluster_id"`
+	ArcKubernetesClusterId string `tfschema:"arc_kubernetes_cluster_id"`
+	CustomLocationId       string `tfschema:"custom_location_id"`
+}
+
+func (r PacketCoreControlPlaneResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"site_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: site.ValidateSiteID,
+			},
+		},
+
+		"sku": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(packetcorecontrolplane.PossibleValuesForBillingSku(), false),
+		},
+
+		"local_diagnostics_access": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"authentication_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(packetcorecontrolplane.PossibleValuesForAuthenticationType(), false),
+					},
+
+					"https_server_certificate_url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+		},
+
+		"control_plane_access_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"control_plane_access_ipv4_address": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+
+		"control_plane_access_ipv4_subnet": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsCIDR,
+		},
+
+		"control_plane_access_ipv4_gateway": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+
+		"core_network_technology": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(packetcorecontrolplane.PossibleValuesForCoreNetworkType(), false),
+		},
+
+		"user_equipment_mtu_in_bytes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1440,
+			ValidateFunc: validation.IntBetween(1280, 1930),
+		},
+
+		"platform": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(packetcorecontrolplane.PossibleValuesForPlatformType(), false),
+					},
+
+					"edge_device_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"stack_hci_cluster_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"arc_kubernetes_cluster_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"custom_location_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"interoperability_settings_json": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+		},
+
+		"identity": commonschema.UserAssignedIdentity(),
+
+		"software_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r PacketCoreControlPlaneResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"rollback_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"installation_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"reinstall_required": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r PacketCoreControlPlaneResource) ModelObject() interface{} {
+	return &PacketCoreControlPlaneResourceModel{}
+}
+
+func (r PacketCoreControlPlaneResource) ResourceType() string {
+	return "azurerm_mobile_network_packet_core_control_plane"
+}
+
+func (r PacketCoreControlPlaneResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return packetcorecontrolplane.ValidatePacketCoreControlPlaneID
+}
+
+func (r PacketCoreControlPlaneResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.PacketCoreControlPlaneClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model PacketCoreControlPlaneResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := packetcorecontrolplane.NewPacketCoreControlPlaneID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.PacketCoreControlPlanesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			interopSettings, err := expandPacketCoreInteropSettings(model.InteroperabilitySettingsJson)
+			if err != nil {
+				return err
+			}
+
+			payload := packetcorecontrolplane.PacketCoreControlPlane{
+				Location: location.Normalize(model.Location),
+				Properties: packetcorecontrolplane.PacketCoreControlPlanePropertiesFormat{
+					ControlPlaneAccessInterface: expandPacketCoreControlPlaneAccessInterface(model),
+					InteropSettings:             interopSettings,
+					LocalDiagnosticsAccess:      expandPacketCoreLocalDiagnosticsAccess(model.LocalDiagnosticsAccess),
+					Platform:                    expandPacketCorePlatform(model.Platform),
+					Sites:                       expandPacketCoreSites(model.SiteIds),
+					Sku:                         packetcorecontrolplane.BillingSku(model.Sku),
+					UeMtu:                       utils.Int64(model.UserEquipmentMtuInBytes),
+				},
+				Tags: &model.Tags,
+			}
+			if identityValue.Type != identity.TypeNone {
+				payload.Identity = identityValue
+			}
+			if model.CoreNetworkTechnology != "" {
+				coreNetworkTechnology := packetcorecontrolplane.CoreNetworkType(model.CoreNetworkTechnology)
+				payload.Properties.CoreNetworkTechnology = &coreNetworkTechnology
+			}
+			if model.SoftwareVersion != "" {
+				payload.Properties.Version = utils.String(model.SoftwareVersion)
+			}
+
+			if err := client.PacketCoreControlPlanesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PacketCoreControlPlaneResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.PacketCoreControlPlaneClient
+
+			id, err := packetcorecontrolplane.ParsePacketCoreControlPlaneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.PacketCoreControlPlanesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := PacketCoreControlPlaneResourceModel{
+				Name:              id.PacketCoreControlPlaneName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				identityValue, err := identity.FlattenUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				props := model.Properties
+				state.ControlPlaneAccessName = utils.NormalizeNilableString(props.ControlPlaneAccessInterface.Name)
+				state.ControlPlaneAccessIPv4Address = utils.NormalizeNilableString(props.ControlPlaneAccessInterface.IPv4Address)
+				state.ControlPlaneAccessIPv4Subnet = utils.NormalizeNilableString(props.ControlPlaneAccessInterface.IPv4Subnet)
+				state.ControlPlaneAccessIPv4Gateway = utils.NormalizeNilableString(props.ControlPlaneAccessInterface.IPv4Gateway)
+				if props.CoreNetworkTechnology != nil {
+					state.CoreNetworkTechnology = string(*props.CoreNetworkTechnology)
+				}
+				if props.UeMtu != nil {
+					state.UserEquipmentMtuInBytes = *props.UeMtu
+				}
+				state.LocalDiagnosticsAccess = flattenPacketCoreLocalDiagnosticsAccess(props.LocalDiagnosticsAccess)
+				state.Platform = flattenPacketCorePlatform(props.Platform)
+				state.Sku = string(props.Sku)
+				state.SoftwareVersion = utils.NormalizeNilableString(props.Version)
+				state.RollbackVersion = utils.NormalizeNilableString(props.RollbackVersion)
+
+				siteIds, err := flattenPacketCoreSites(props.Sites)
+				if err != nil {
+					return err
+				}
+				state.SiteIds = siteIds
+
+				interopSettings, err := flattenPacketCoreInteropSettings(props.InteropSettings)
+				if err != nil {
+					return err
+				}
+				state.InteroperabilitySettingsJson = interopSettings
+
+				if installation := props.Installation; installation != nil {
+					if installation.State != nil {
+						state.InstallationState = string(*installation.State)
+					}
+					state.ReinstallRequired = installation.ReinstallRequired != nil && *installation.ReinstallRequired == packetcorecontrolplane.ReinstallRequiredRequired
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PacketCoreControlPlaneResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.PacketCoreControlPlaneClient
+
+			id, err := packetcorecontrolplane.ParsePacketCoreControlPlaneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PacketCoreControlPlaneResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.PacketCoreControlPlanesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// moving back to the previously installed version has to be done using the rollback action,
+			// since the packet core keeps the configuration for that version rather than re-deploying it
+			if metadata.ResourceData.HasChange("software_version") && existing.Model.Properties.RollbackVersion != nil && *existing.Model.Properties.RollbackVersion == model.SoftwareVersion {
+				if err := client.PacketCoreControlPlanesRollbackThenPoll(ctx, *id); err != nil {
+					return fmt.Errorf("rolling back %s to version %q: %+v", *id, model.SoftwareVersion, err)
+				}
+
+				existing, err = client.PacketCoreControlPlanesGet(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+			}
+
+			payload := *existing.Model
+			// the installation block is read-only and is rejected by the API if sent back
+			payload.Properties.Installation = nil
+			if metadata.ResourceData.HasChanges("control_plane_access_name", "control_plane_access_ipv4_address", "control_plane_access_ipv4_subnet", "control_plane_access_ipv4_gateway") {
+				payload.Properties.ControlPlaneAccessInterface = expandPacketCoreControlPlaneAccessInterface(model)
+			}
+			if metadata.ResourceData.HasChange("core_network_technology") {
+				payload.Properties.CoreNetworkTechnology = nil
+				if model.CoreNetworkTechnology != "" {
+					coreNetworkTechnology := packetcorecontrolplane.CoreNetworkType(model.CoreNetworkTechnology)
+					payload.Properties.CoreNetworkTechnology = &coreNetworkTechnology
+				}
+			}
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+			if metadata.ResourceData.HasChange("interoperability_settings_json") {
+				interopSettings, err := expandPacketCoreInteropSettings(model.InteroperabilitySettingsJson)
+				if err != nil {
+					return err
+				}
+				payload.Properties.InteropSettings = interopSettings
+			}
+			if metadata.ResourceData.HasChange("local_diagnostics_access") {
+				payload.Properties.LocalDiagnosticsAccess = expandPacketCoreLocalDiagnosticsAccess(model.LocalDiagnosticsAccess)
+			}
+			if metadata.ResourceData.HasChange("platform") {
+				payload.Properties.Platform = expandPacketCorePlatform(model.Platform)
+			}
+			if metadata.ResourceData.HasChange("site_ids") {
+				payload.Properties.Sites = expandPacketCoreSites(model.SiteIds)
+			}
+			if metadata.ResourceData.HasChange("sku") {
+				payload.Properties.Sku = packetcorecontrolplane.BillingSku(model.Sku)
+			}
+			if metadata.ResourceData.HasChange("software_version") && model.SoftwareVersion != "" {
+				payload.Properties.Version = utils.String(model.SoftwareVersion)
+			}
+			if metadata.ResourceData.HasChange("user_equipment_mtu_in_bytes") {
+				payload.Properties.UeMtu = utils.Int64(model.UserEquipmentMtuInBytes)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.PacketCoreControlPlanesCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			// some changes (e.g. to the control plane interface or the sites) are only applied once the packet core
+			// has been reinstalled, which the API surfaces rather than performing - so trigger it here
+			resp, err := client.PacketCoreControlPlanesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if model := resp.Model; model != nil && model.Properties.Installation != nil {
+				if v := model.Properties.Installation.ReinstallRequired; v != nil && *v == packetcorecontrolplane.ReinstallRequiredRequired {
+					if err := client.PacketCoreControlPlanesReinstallThenPoll(ctx, *id); err != nil {
+						return fmt.Errorf("reinstalling %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PacketCoreControlPlaneResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.PacketCoreControlPlaneClient
+
+			id, err := packetcorecontrolplane.ParsePacketCoreControlPlaneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.PacketCoreControlPlanesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPacketCoreControlPlaneAccessInterface(input PacketCoreControlPlaneResourceModel) packetcorecontrolplane.InterfaceProperties {
+	output := packetcorecontrolplane.InterfaceProperties{}
+	if input.ControlPlaneAccessName != "" {
+		output.Name = utils.String(input.ControlPlaneAccessName)
+	}
+	if input.ControlPlaneAccessIPv4Address != "" {
+		output.IPv4Address = utils.String(input.ControlPlaneAccessIPv4Address)
+	}
+	if input.ControlPlaneAccessIPv4Subnet != "" {
+		output.IPv4Subnet = utils.String(input.ControlPlaneAccessIPv4Subnet)
+	}
+	if input.ControlPlaneAccessIPv4Gateway != "" {
+		output.IPv4Gateway = utils.String(input.ControlPlaneAccessIPv4Gateway)
+	}
+	return output
+}
+
+func expandPacketCoreLocalDiagnosticsAccess(input []LocalDiagnosticsAccessModel) packetcorecontrolplane.LocalDiagnosticsAccessConfiguration {
+	output := packetcorecontrolplane.LocalDiagnosticsAccessConfiguration{}
+	if len(input) == 0 {
+		return output
+	}
+
+	output.AuthenticationType = packetcorecontrolplane.AuthenticationType(input[0].AuthenticationType)
+	if input[0].HttpsServerCertificateUrl != "" {
+		output.HTTPSServerCertificate = &packetcorecontrolplane.HTTPSServerCertificate{
+			CertificateUrl: input[0].HttpsServerCertificateUrl,
+		}
+	}
+	return output
+}
+
+func flattenPacketCoreLocalDiagnosticsAccess(input packetcorecontrolplane.LocalDiagnosticsAccessConfiguration) []LocalDiagnosticsAccessModel {
+	output := LocalDiagnosticsAccessModel{
+		AuthenticationType: string(input.AuthenticationType),
+	}
+	if input.HTTPSServerCertificate != nil {
+		output.HttpsServerCertificateUrl = input.HTTPSServerCertificate.CertificateUrl
+	}
+	return []LocalDiagnosticsAccessModel{output}
+}
+
+func expandPacketCorePlatform(input []PacketCorePlatformModel) packetcorecontrolplane.PlatformConfiguration {
+	output := packetcorecontrolplane.PlatformConfiguration{}
+	if len(input) == 0 {
+		return output
+	}
+
+	v := input[0]
+	output.Type = packetcorecontrolplane.PlatformType(v.Type)
+	if v.EdgeDeviceId != "" {
+		output.AzureStackEdgeDevice = &packetcorecontrolplane.AzureStackEdgeDeviceResourceId{
+			Id: v.EdgeDeviceId,
+		}
+	}
+	if v.StackHciClusterId != "" {
+		output.AzureStackHciCluster = &packetcorecontrolplane.AzureStackHCIClusterResourceId{
+			Id: v.StackHciClusterId,
+		}
+	}
+	if v.ArcKubernetesClusterId != "" {
+		output.ConnectedCluster = &packetcorecontrolplane.ConnectedClusterResourceId{
+			Id: v.ArcKubernetesClusterId,
+		}
+	}
+	if v.CustomLocationId != "" {
+		output.CustomLocation = &packetcorecontrolplane.CustomLocationResourceId{
+			Id: v.CustomLocationId,
+		}
+	}
+	return output
+}
+
+func flattenPacketCorePlatform(input packetcorecontrolplane.PlatformConfiguration) []PacketCorePlatformModel {
+	if input.Type == "" {
+		return []PacketCorePlatformModel{}
+	}
+
+	output := PacketCorePlatformModel{
+		Type: string(input.Type),
+	}
+	if input.AzureStackEdgeDevice != nil {
+		output.EdgeDeviceId = input.AzureStackEdgeDevice.Id
+	}
+	if input.AzureStackHciCluster != nil {
+		output.StackHciClusterId = input.AzureStackHciCluster.Id
+	}
+	if input.ConnectedCluster != nil {
+		output.ArcKubernetesClusterId = input.ConnectedCluster.Id
+	}
+	if input.CustomLocation != nil {
+		output.CustomLocationId = input.CustomLocation.Id
+	}
+	return []PacketCorePlatformModel{output}
+}
+
+func expandPacketCoreSites(input []string) []packetcorecontrolplane.SiteResourceId {
+	output := make([]packetcorecontrolplane.SiteResourceId, 0)
+	for _, v := range input {
+		output = append(output, packetcorecontrolplane.SiteResourceId{
+			Id: v,
+		})
+	}
+	return output
+}
+
+func flattenPacketCoreSites(input []packetcorecontrolplane.SiteResourceId) ([]string, error) {
+	output := make([]string, 0)
+	for _, v := range input {
+		siteId, err := site.ParseSiteIDInsensitively(v.Id)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, siteId.ID())
+	}
+	return output, nil
+}
+
+func expandPacketCoreInteropSettings(input string) (*interface{}, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	var output interface{}
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, fmt.Errorf("unmarshaling `interoperability_settings_json`: %+v", err)
+	}
+	return &output, nil
+}
+
+func flattenPacketCoreInteropSettings(input *interface{}) (string, error) {
+	if input == nil || *input == nil {
+		return "", nil
+	}
+
+	output, err := json.Marshal(*input)
+	if err != nil {
+		return "", fmt.Errorf("marshaling `interoperability_settings_json`: %+v", err)
+	}
+	return string(output), nil
+}
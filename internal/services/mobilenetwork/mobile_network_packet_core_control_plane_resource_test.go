@@ -0,0 +1,222 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/packetcorecontrolplane"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PacketCoreControlPlaneResource struct{}
+
+func TestAccPacketCoreControlPlane_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_packet_core_control_plane", "test")
+	r := PacketCoreControlPlaneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPacketCoreControlPlane_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_packet_core_control_plane", "test")
+	r := PacketCoreControlPlaneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPacketCoreControlPlane_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_packet_core_control_plane", "test")
+	r := PacketCoreControlPlaneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPacketCoreControlPlane_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_packet_core_control_plane", "test")
+	r := PacketCoreControlPlaneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("reinstall_required").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PacketCoreControlPlaneResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := packetcorecontrolplane.ParsePacketCoreControlPlaneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MobileNetwork.PacketCoreControlPlaneClient.PacketCoreControlPlanesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r PacketCoreControlPlaneResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_site" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+}
+
+resource "azurerm_databox_edge_device" "test" {
+  name                = "acctest-dd-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "EdgeP_Base-Standard"
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger, data.RandomString)
+}
+
+func (r PacketCoreControlPlaneResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_packet_core_control_plane" "test" {
+  name                = "acctest-mnpccp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "G0"
+  site_ids            = [azurerm_mobile_network_site.test.id]
+
+  local_diagnostics_access {
+    authentication_type = "AAD"
+  }
+
+  platform {
+    type           = "AKS-HCI"
+    edge_device_id = azurerm_databox_edge_device.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PacketCoreControlPlaneResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_packet_core_control_plane" "import" {
+  name                = azurerm_mobile_network_packet_core_control_plane.test.name
+  resource_group_name = azurerm_mobile_network_packet_core_control_plane.test.resource_group_name
+  location            = azurerm_mobile_network_packet_core_control_plane.test.location
+  sku                 = azurerm_mobile_network_packet_core_control_plane.test.sku
+  site_ids            = azurerm_mobile_network_packet_core_control_plane.test.site_ids
+
+  local_diagnostics_access {
+    authentication_type = "AAD"
+  }
+
+  platform {
+    type           = "AKS-HCI"
+    edge_device_id = azurerm_databox_edge_device.test.id
+  }
+}
+`, r.basic(data))
+}
+
+func (r PacketCoreControlPlaneResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-mnpccp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_mobile_network_packet_core_control_plane" "test" {
+  name                              = "acctest-mnpccp-%[2]d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  sku                               = "G0"
+  site_ids                          = [azurerm_mobile_network_site.test.id]
+  control_plane_access_name         = "default-interface"
+  control_plane_access_ipv4_address = "192.168.1.199"
+  control_plane_access_ipv4_gateway = "192.168.1.1"
+  control_plane_access_ipv4_subnet  = "192.168.1.0/25"
+  core_network_technology           = "5GC"
+  user_equipment_mtu_in_bytes       = 1600
+
+  interoperability_settings_json = jsonencode({
+    "mtu" = 1440
+  })
+
+  local_diagnostics_access {
+    authentication_type = "Password"
+  }
+
+  platform {
+    type           = "AKS-HCI"
+    edge_device_id = azurerm_databox_edge_device.test.id
+  }
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,228 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MobileNetworkResource struct{}
+
+var _ sdk.ResourceWithUpdate = MobileNetworkResource{}
+
+type MobileNetworkResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	MobileCountryCode string            `tfschema:"mobile_country_code"`
+	MobileNetworkCode string            `tfschema:"mobile_network_code"`
+	ServiceKey        string            `tfschema:"service_key"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r MobileNetworkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"mobile_country_code": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{3}$`), "`mobile_country_code` must be a 3 digit number"),
+		},
+
+		"mobile_network_code": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{2,3}$`), "`mobile_network_code` must be a 2 or 3 digit number"),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MobileNetworkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"service_key": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MobileNetworkResource) ModelObject() interface{} {
+	return &MobileNetworkResourceModel{}
+}
+
+func (r MobileNetworkResource) ResourceType() string {
+	return "azurerm_mobile_network"
+}
+
+func (r MobileNetworkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return mobilenetwork.ValidateMobileNetworkID
+}
+
+func (r MobileNetworkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.MobileNetworkClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MobileNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := mobilenetwork.NewMobileNetworkID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.MobileNetworksGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := mobilenetwork.MobileNetwork{
+				Location: location.Normalize(model.Location),
+				Properties: mobilenetwork.MobileNetworkPropertiesFormat{
+					PublicLandMobileNetworkIdentifier: mobilenetwork.PlmnId{
+						Mcc: model.MobileCountryCode,
+						Mnc: model.MobileNetworkCode,
+					},
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.MobileNetworksCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MobileNetworkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.MobileNetworkClient
+
+			id, err := mobilenetwork.ParseMobileNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.MobileNetworksGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MobileNetworkResourceModel{
+				Name:              id.MobileNetworkName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.MobileCountryCode = props.PublicLandMobileNetworkIdentifier.Mcc
+				state.MobileNetworkCode = props.PublicLandMobileNetworkIdentifier.Mnc
+				state.ServiceKey = utils.NormalizeNilableString(props.ServiceKey)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MobileNetworkResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.MobileNetworkClient
+
+			id, err := mobilenetwork.ParseMobileNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MobileNetworkResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.MobileNetworksGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("mobile_country_code") {
+				payload.Properties.PublicLandMobileNetworkIdentifier.Mcc = model.MobileCountryCode
+			}
+			if metadata.ResourceData.HasChange("mobile_network_code") {
+				payload.Properties.PublicLandMobileNetworkIdentifier.Mnc = model.MobileNetworkCode
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.MobileNetworksCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MobileNetworkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.MobileNetworkClient
+
+			id, err := mobilenetwork.ParseMobileNetworkID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.MobileNetworksDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,578 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceResource struct{}
+
+var _ sdk.ResourceWithUpdate = ServiceResource{}
+
+type ServiceResourceModel struct {
+	Name              string            `tfschema:"name"`
+	MobileNetworkId   string            `tfschema:"mobile_network_id"`
+	Location          string            `tfschema:"location"`
+	ServicePrecedence int64             `tfschema:"service_precedence"`
+	ServiceQosPolicy  []QosPolicyModel  `tfschema:"service_qos_policy"`
+	PccRule           []PccRuleModel    `tfschema:"pcc_rule"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+type QosPolicyModel struct {
+	AllocationAndRetentionPriorityLevel int64          `tfschema:"allocation_and_retention_priority_level"`
+	QosIndicator                        int64          `tfschema:"qos_indicator"`
+	PreemptionCapability                string         `tfschema:"preemption_capability"`
+	PreemptionVulnerability             string         `tfschema:"preemption_vulnerability"`
+	MaximumBitRate                      []BitRateModel `tfschema:"maximum_bit_rate"`
+	GuaranteedBitRate                   []BitRateModel `tfschema:"guaranteed_bit_rate"`
+}
+
+type BitRateModel struct {
+	Downlink string `tfschema:"downlink"`
+	Uplink   string `tfschema:"uplink"`
+}
+
+type PccRuleModel struct {
+	Name                    string                         `tfschema:"name"`
+	Precedence              int64                          `tfschema:"precedence"`
+	TrafficControlEnabled   bool                           `tfschema:"traffic_control_enabled"`
+	QosPolicy               []QosPolicyModel               `tfschema:"qos_policy"`
+	ServiceDataFlowTemplate []ServiceDataFlowTemplateModel `tfschema:"service_data_flow_template"`
+}
+
+type ServiceDataFlowTemplateModel struct {
+	Name         string   `tfschema:"name"`
+	Direction    string   `tfschema:"direction"`
+	Protocol     []string `tfschema:"protocol"`
+	RemoteIpList []string `tfschema:"remote_ip_list"`
+	Ports        []string `tfschema:"ports"`
+}
+
+func bitRateSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"downlink": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.BitRate,
+				},
+
+				"uplink": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validate.BitRate,
+				},
+			},
+		},
+	}
+}
+
+func qosPolicySchema(required bool, withGuaranteedBitRate bool) *pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"allocation_and_retention_priority_level": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      9,
+			ValidateFunc: validation.IntBetween(1, 127),
+		},
+
+		"qos_indicator": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(1, 127),
+		},
+
+		"preemption_capability": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(service.PreemptionCapabilityNotPreempt),
+			ValidateFunc: validation.StringInSlice(service.PossibleValuesForPreemptionCapability(), false),
+		},
+
+		"preemption_vulnerability": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(service.PreemptionVulnerabilityPreemptable),
+			ValidateFunc: validation.StringInSlice(service.PossibleValuesForPreemptionVulnerability(), false),
+		},
+
+		"maximum_bit_rate": bitRateSchema(),
+	}
+
+	if withGuaranteedBitRate {
+		gbr := bitRateSchema()
+		gbr.Required = false
+		gbr.Optional = true
+		s["guaranteed_bit_rate"] = gbr
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func (r ServiceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"mobile_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mobilenetwork.ValidateMobileNetworkID,
+		},
+
+		"location": commonschema.Location(),
+
+		"service_precedence": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntBetween(0, 255),
+		},
+
+		"pcc_rule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"precedence": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+
+					"service_data_flow_template": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"direction": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringInSlice(service.PossibleValuesForSdfDirection(), false),
+								},
+
+								"protocol": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"remote_ip_list": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+
+								"ports": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+
+					"qos_policy": qosPolicySchema(false, true),
+
+					"traffic_control_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+				},
+			},
+		},
+
+		"service_qos_policy": qosPolicySchema(false, false),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ServiceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServiceResource) ModelObject() interface{} {
+	return &ServiceResourceModel{}
+}
+
+func (r ServiceResource) ResourceType() string {
+	return "azurerm_mobile_network_service"
+}
+
+func (r ServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return service.ValidateServiceID
+}
+
+func (r ServiceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.ServiceClient
+
+			var model ServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			mobileNetworkId, err := mobilenetwork.ParseMobileNetworkID(model.MobileNetworkId)
+			if err != nil {
+				return err
+			}
+
+			id := service.NewServiceID(mobileNetworkId.SubscriptionId, mobileNetworkId.ResourceGroupName, mobileNetworkId.MobileNetworkName, model.Name)
+			existing, err := client.ServicesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := service.Service{
+				Location: location.Normalize(model.Location),
+				Properties: service.ServicePropertiesFormat{
+					PccRules:          expandServicePccRules(model.PccRule),
+					ServicePrecedence: model.ServicePrecedence,
+					ServiceQosPolicy:  expandServiceQosPolicy(model.ServiceQosPolicy),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.ServicesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ServicesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ServiceResourceModel{
+				Name:            id.ServiceName,
+				MobileNetworkId: mobilenetwork.NewMobileNetworkID(id.SubscriptionId, id.ResourceGroupName, id.MobileNetworkName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.PccRule = flattenServicePccRules(props.PccRules)
+				state.ServicePrecedence = props.ServicePrecedence
+				state.ServiceQosPolicy = flattenServiceQosPolicy(props.ServiceQosPolicy)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServiceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.ServicesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("pcc_rule") {
+				payload.Properties.PccRules = expandServicePccRules(model.PccRule)
+			}
+			if metadata.ResourceData.HasChange("service_precedence") {
+				payload.Properties.ServicePrecedence = model.ServicePrecedence
+			}
+			if metadata.ResourceData.HasChange("service_qos_policy") {
+				payload.Properties.ServiceQosPolicy = expandServiceQosPolicy(model.ServiceQosPolicy)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.ServicesCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.ServicesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandServiceAmbr(input []BitRateModel) service.Ambr {
+	if len(input) == 0 {
+		return service.Ambr{}
+	}
+
+	return service.Ambr{
+		Downlink: input[0].Downlink,
+		Uplink:   input[0].Uplink,
+	}
+}
+
+func flattenServiceAmbr(input service.Ambr) []BitRateModel {
+	return []BitRateModel{
+		{
+			Downlink: input.Downlink,
+			Uplink:   input.Uplink,
+		},
+	}
+}
+
+func expandServiceQosPolicy(input []QosPolicyModel) *service.QosPolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	preemptionCapability := service.PreemptionCapability(v.PreemptionCapability)
+	preemptionVulnerability := service.PreemptionVulnerability(v.PreemptionVulnerability)
+	return &service.QosPolicy{
+		AllocationAndRetentionPriorityLevel: utils.Int64(v.AllocationAndRetentionPriorityLevel),
+		Fiveqi:                              utils.Int64(v.QosIndicator),
+		MaximumBitRate:                      expandServiceAmbr(v.MaximumBitRate),
+		PreemptionCapability:                &preemptionCapability,
+		PreemptionVulnerability:             &preemptionVulnerability,
+	}
+}
+
+func flattenServiceQosPolicy(input *service.QosPolicy) []QosPolicyModel {
+	if input == nil {
+		return []QosPolicyModel{}
+	}
+
+	output := QosPolicyModel{
+		MaximumBitRate: flattenServiceAmbr(input.MaximumBitRate),
+	}
+	if input.AllocationAndRetentionPriorityLevel != nil {
+		output.AllocationAndRetentionPriorityLevel = *input.AllocationAndRetentionPriorityLevel
+	}
+	if input.Fiveqi != nil {
+		output.QosIndicator = *input.Fiveqi
+	}
+	if input.PreemptionCapability != nil {
+		output.PreemptionCapability = string(*input.PreemptionCapability)
+	}
+	if input.PreemptionVulnerability != nil {
+		output.PreemptionVulnerability = string(*input.PreemptionVulnerability)
+	}
+	return []QosPolicyModel{output}
+}
+
+func expandServicePccRules(input []PccRuleModel) []service.PccRuleConfiguration {
+	output := make([]service.PccRuleConfiguration, 0)
+	for _, v := range input {
+		trafficControl := service.TrafficControlPermissionBlocked
+		if v.TrafficControlEnabled {
+			trafficControl = service.TrafficControlPermissionEnabled
+		}
+
+		rule := service.PccRuleConfiguration{
+			RuleName:                 v.Name,
+			RulePrecedence:           v.Precedence,
+			ServiceDataFlowTemplates: expandServiceDataFlowTemplates(v.ServiceDataFlowTemplate),
+			TrafficControl:           &trafficControl,
+		}
+
+		if len(v.QosPolicy) > 0 {
+			policy := v.QosPolicy[0]
+			preemptionCapability := service.PreemptionCapability(policy.PreemptionCapability)
+			preemptionVulnerability := service.PreemptionVulnerability(policy.PreemptionVulnerability)
+			rule.RuleQosPolicy = &service.PccRuleQosPolicy{
+				AllocationAndRetentionPriorityLevel: utils.Int64(policy.AllocationAndRetentionPriorityLevel),
+				Fiveqi:                              utils.Int64(policy.QosIndicator),
+				MaximumBitRate:                      expandServiceAmbr(policy.MaximumBitRate),
+				PreemptionCapability:                &preemptionCapability,
+				PreemptionVulnerability:             &preemptionVulnerability,
+			}
+			if len(policy.GuaranteedBitRate) > 0 {
+				guaranteedBitRate := expandServiceAmbr(policy.GuaranteedBitRate)
+				rule.RuleQosPolicy.GuaranteedBitRate = &guaranteedBitRate
+			}
+		}
+
+		output = append(output, rule)
+	}
+	return output
+}
+
+func flattenServicePccRules(input []service.PccRuleConfiguration) []PccRuleModel {
+	output := make([]PccRuleModel, 0)
+	for _, v := range input {
+		rule := PccRuleModel{
+			Name:                    v.RuleName,
+			Precedence:              v.RulePrecedence,
+			ServiceDataFlowTemplate: flattenServiceDataFlowTemplates(v.ServiceDataFlowTemplates),
+			TrafficControlEnabled:   v.TrafficControl == nil || *v.TrafficControl == service.TrafficControlPermissionEnabled,
+		}
+
+		if policy := v.RuleQosPolicy; policy != nil {
+			qosPolicy := QosPolicyModel{
+				MaximumBitRate: flattenServiceAmbr(policy.MaximumBitRate),
+			}
+			if policy.AllocationAndRetentionPriorityLevel != nil {
+				qosPolicy.AllocationAndRetentionPriorityLevel = *policy.AllocationAndRetentionPriorityLevel
+			}
+			if policy.Fiveqi != nil {
+				qosPolicy.QosIndicator = *policy.Fiveqi
+			}
+			if policy.PreemptionCapability != nil {
+				qosPolicy.PreemptionCapability = string(*policy.PreemptionCapability)
+			}
+			if policy.PreemptionVulnerability != nil {
+				qosPolicy.PreemptionVulnerability = string(*policy.PreemptionVulnerability)
+			}
+			if policy.GuaranteedBitRate != nil {
+				qosPolicy.GuaranteedBitRate = flattenServiceAmbr(*policy.GuaranteedBitRate)
+			}
+			rule.QosPolicy = []QosPolicyModel{qosPolicy}
+		}
+
+		output = append(output, rule)
+	}
+	return output
+}
+
+func expandServiceDataFlowTemplates(input []ServiceDataFlowTemplateModel) []service.ServiceDataFlowTemplate {
+	output := make([]service.ServiceDataFlowTemplate, 0)
+	for _, v := range input {
+		template := service.ServiceDataFlowTemplate{
+			Direction:    service.SdfDirection(v.Direction),
+			Protocol:     v.Protocol,
+			RemoteIPList: v.RemoteIpList,
+			TemplateName: v.Name,
+		}
+		if len(v.Ports) > 0 {
+			ports := v.Ports
+			template.Ports = &ports
+		}
+		output = append(output, template)
+	}
+	return output
+}
+
+func flattenServiceDataFlowTemplates(input []service.ServiceDataFlowTemplate) []ServiceDataFlowTemplateModel {
+	output := make([]ServiceDataFlowTemplateModel, 0)
+	for _, v := range input {
+		template := ServiceDataFlowTemplateModel{
+			Direction:    string(v.Direction),
+			Name:         v.TemplateName,
+			Protocol:     v.Protocol,
+			RemoteIpList: v.RemoteIPList,
+		}
+		if v.Ports != nil {
+			template.Ports = *v.Ports
+		}
+		output = append(output, template)
+	}
+	return output
+}
@@ -0,0 +1,272 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceResource struct{}
+
+func TestAccService_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccService_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccService_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccService_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := service.ParseServiceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MobileNetwork.ServiceClient.ServicesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ServiceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_service" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  service_precedence = 0
+
+  pcc_rule {
+    name       = "default-rule"
+    precedence = 1
+
+    service_data_flow_template {
+      name           = "IP-to-server"
+      direction      = "Uplink"
+      protocol       = ["ip"]
+      remote_ip_list = ["10.3.4.0/24"]
+    }
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ServiceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_service" "import" {
+  name              = azurerm_mobile_network_service.test.name
+  mobile_network_id = azurerm_mobile_network_service.test.mobile_network_id
+  location          = azurerm_mobile_network_service.test.location
+
+  service_precedence = 0
+
+  pcc_rule {
+    name       = "default-rule"
+    precedence = 1
+
+    service_data_flow_template {
+      name           = "IP-to-server"
+      direction      = "Uplink"
+      protocol       = ["ip"]
+      remote_ip_list = ["10.3.4.0/24"]
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ServiceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_service" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  service_precedence = 0
+
+  pcc_rule {
+    name                    = "default-rule"
+    precedence              = 1
+    traffic_control_enabled = true
+
+    qos_policy {
+      allocation_and_retention_priority_level = 9
+      qos_indicator                           = 9
+      preemption_capability                   = "NotPreempt"
+      preemption_vulnerability                = "Preemptable"
+
+      guaranteed_bit_rate {
+        downlink = "100 Mbps"
+        uplink   = "10 Mbps"
+      }
+
+      maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "100 Mbps"
+      }
+    }
+
+    service_data_flow_template {
+      name           = "IP-to-server"
+      direction      = "Uplink"
+      protocol       = ["ip"]
+      remote_ip_list = ["10.3.4.0/24"]
+      ports          = []
+    }
+  }
+
+  service_qos_policy {
+    allocation_and_retention_priority_level = 9
+    qos_indicator                           = 9
+    preemption_capability                   = "NotPreempt"
+    preemption_vulnerability                = "Preemptable"
+
+    maximum_bit_rate {
+      downlink = "1 Gbps"
+      uplink   = "100 Mbps"
+    }
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ServiceResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_service" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  service_precedence = 1
+
+  pcc_rule {
+    name                    = "updated-rule"
+    precedence              = 2
+    traffic_control_enabled = false
+
+    qos_policy {
+      allocation_and_retention_priority_level = 10
+      qos_indicator                           = 10
+      preemption_capability                   = "MayPreempt"
+      preemption_vulnerability                = "NotPreemptable"
+
+      maximum_bit_rate {
+        downlink = "2 Gbps"
+        uplink   = "200 Mbps"
+      }
+    }
+
+    service_data_flow_template {
+      name           = "IP-to-server"
+      direction      = "Bidirectional"
+      protocol       = ["tcp"]
+      remote_ip_list = ["10.3.5.0/24"]
+      ports          = ["8080"]
+    }
+  }
+
+  tags = {
+    key = "updated"
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
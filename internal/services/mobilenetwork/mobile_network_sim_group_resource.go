@@ -0,0 +1,262 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/simgroup"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SimGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = SimGroupResource{}
+
+type SimGroupResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	MobileNetworkId   string            `tfschema:"mobile_network_id"`
+	EncryptionKeyUrl  string            `tfschema:"encryption_key_url"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r SimGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"mobile_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mobilenetwork.ValidateMobileNetworkID,
+		},
+
+		"encryption_key_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"identity": commonschema.UserAssignedIdentity(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SimGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SimGroupResource) ModelObject() interface{} {
+	return &SimGroupResourceModel{}
+}
+
+func (r SimGroupResource) ResourceType() string {
+	return "azurerm_mobile_network_sim_group"
+}
+
+func (r SimGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return simgroup.ValidateSimGroupID
+}
+
+func (r SimGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMGroupClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model SimGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := simgroup.NewSimGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.SimGroupsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := simgroup.SimGroup{
+				Location: location.Normalize(model.Location),
+				Properties: simgroup.SimGroupPropertiesFormat{
+					MobileNetwork: &simgroup.MobileNetworkResourceId{
+						Id: model.MobileNetworkId,
+					},
+				},
+				Tags: &model.Tags,
+			}
+			if identityValue.Type != identity.TypeNone {
+				payload.Identity = identityValue
+			}
+			if model.EncryptionKeyUrl != "" {
+				payload.Properties.EncryptionKey = &simgroup.KeyVaultKey{
+					KeyUrl: utils.String(model.EncryptionKeyUrl),
+				}
+			}
+
+			if err := client.SimGroupsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SimGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMGroupClient
+
+			id, err := simgroup.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SimGroupsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SimGroupResourceModel{
+				Name:              id.SimGroupName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				identityValue, err := identity.FlattenUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				props := model.Properties
+				if props.EncryptionKey != nil {
+					state.EncryptionKeyUrl = utils.NormalizeNilableString(props.EncryptionKey.KeyUrl)
+				}
+				if props.MobileNetwork != nil {
+					mobileNetworkId, err := mobilenetwork.ParseMobileNetworkIDInsensitively(props.MobileNetwork.Id)
+					if err != nil {
+						return err
+					}
+					state.MobileNetworkId = mobileNetworkId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SimGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMGroupClient
+
+			id, err := simgroup.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SimGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.SimGroupsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("encryption_key_url") {
+				payload.Properties.EncryptionKey = nil
+				if model.EncryptionKeyUrl != "" {
+					payload.Properties.EncryptionKey = &simgroup.KeyVaultKey{
+						KeyUrl: utils.String(model.EncryptionKeyUrl),
+					}
+				}
+			}
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.SimGroupsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SimGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMGroupClient
+
+			id, err := simgroup.ParseSimGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.SimGroupsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,586 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/datanetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/simpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/slice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SimPolicyResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = SimPolicyResource{}
+	_ sdk.ResourceWithCustomizeDiff = SimPolicyResource{}
+)
+
+type SimPolicyResourceModel struct {
+	Name                                 string                `tfschema:"name"`
+	MobileNetworkId                      string                `tfschema:"mobile_network_id"`
+	Location                             string                `tfschema:"location"`
+	DefaultSliceId                       string                `tfschema:"default_slice_id"`
+	RegistrationTimerInSeconds           int64                 `tfschema:"registration_timer_in_seconds"`
+	RatFrequencySelectionPriorityIndex   int64                 `tfschema:"rat_frequency_selection_priority_index"`
+	UserEquipmentAggregateMaximumBitRate []BitRateModel        `tfschema:"user_equipment_aggregate_maximum_bit_rate"`
+	Slice                                []SimPolicySliceModel `tfschema:"slice"`
+	Tags                                 map[string]string     `tfschema:"tags"`
+}
+
+type SimPolicySliceModel struct {
+	SliceId              string                      `tfschema:"slice_id"`
+	DefaultDataNetworkId string                      `tfschema:"default_data_network_id"`
+	DataNetwork          []SimPolicyDataNetworkModel `tfschema:"data_network"`
+}
+
+type SimPolicyDataNetworkModel struct {
+	DataNetworkId                       string         `tfschema:"data_network_id"`
+	AllowedServicesIds                  []string       `tfschema:"allowed_services_ids"`
+	SessionAggregateMaximumBitRate      []BitRateModel `tfschema:"session_aggregate_maximum_bit_rate"`
+	QosIndicator                        int64          `tfschema:"qos_indicator"`
+	AllocationAndRetentionPriorityLevel int64          `tfschema:"allocation_and_retention_priority_level"`
+	DefaultSessionType                  string         `tfschema:"default_session_type"`
+	AdditionalAllowedSessionTypes       []string       `tfschema:"additional_allowed_session_types"`
+	MaxBufferedPackets                  int64          `tfschema:"max_buffered_packets"`
+	PreemptionCapability                string         `tfschema:"preemption_capability"`
+	PreemptionVulnerability             string         `tfschema:"preemption_vulnerability"`
+}
+
+func (r SimPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"mobile_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mobilenetwork.ValidateMobileNetworkID,
+		},
+
+		"location": commonschema.Location(),
+
+		"default_slice_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: slice.ValidateSliceID,
+		},
+
+		"slice": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"slice_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: slice.ValidateSliceID,
+					},
+
+					"default_data_network_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: datanetwork.ValidateDataNetworkID,
+					},
+
+					"data_network": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"data_network_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: datanetwork.ValidateDataNetworkID,
+								},
+
+								"allowed_services_ids": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: service.ValidateServiceID,
+									},
+								},
+
+								"session_aggregate_maximum_bit_rate": bitRateSchema(),
+
+								"qos_indicator": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ValidateFunc: validation.IntBetween(1, 127),
+								},
+
+								"allocation_and_retention_priority_level": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      9,
+									ValidateFunc: validation.IntBetween(1, 127),
+								},
+
+								"default_session_type": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(simpolicy.PduSessionTypeIPv4),
+									ValidateFunc: validation.StringInSlice(simpolicy.PossibleValuesForPduSessionType(), false),
+								},
+
+								"additional_allowed_session_types": {
+									Type:     pluginsdk.TypeList,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type:         pluginsdk.TypeString,
+										ValidateFunc: validation.StringInSlice(simpolicy.PossibleValuesForPduSessionType(), false),
+									},
+								},
+
+								"max_buffered_packets": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      10,
+									ValidateFunc: validation.IntAtLeast(0),
+								},
+
+								"preemption_capability": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(simpolicy.PreemptionCapabilityNotPreempt),
+									ValidateFunc: validation.StringInSlice(simpolicy.PossibleValuesForPreemptionCapability(), false),
+								},
+
+								"preemption_vulnerability": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									Default:      string(simpolicy.PreemptionVulnerabilityPreemptable),
+									ValidateFunc: validation.StringInSlice(simpolicy.PossibleValuesForPreemptionVulnerability(), false),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"user_equipment_aggregate_maximum_bit_rate": bitRateSchema(),
+
+		"registration_timer_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3240,
+			ValidateFunc: validation.IntAtLeast(30),
+		},
+
+		"rat_frequency_selection_priority_index": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(1, 256),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SimPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SimPolicyResource) ModelObject() interface{} {
+	return &SimPolicyResourceModel{}
+}
+
+func (r SimPolicyResource) ResourceType() string {
+	return "azurerm_mobile_network_sim_policy"
+}
+
+func (r SimPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return simpolicy.ValidateSimPolicyID
+}
+
+func (r SimPolicyResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SimPolicyResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the IDs may not be known until apply, in which case the API will validate these
+			if model.DefaultSliceId != "" {
+				found := false
+				for _, s := range model.Slice {
+					if s.SliceId == "" || strings.EqualFold(s.SliceId, model.DefaultSliceId) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return fmt.Errorf("`default_slice_id` must be one of the `slice_id`'s specified in the `slice` blocks")
+				}
+			}
+
+			for _, s := range model.Slice {
+				if s.DefaultDataNetworkId == "" {
+					continue
+				}
+				found := false
+				for _, dn := range s.DataNetwork {
+					if dn.DataNetworkId == "" || strings.EqualFold(dn.DataNetworkId, s.DefaultDataNetworkId) {
+						found = true
+						break
+					}
+				}
+				if !found {
+					return fmt.Errorf("the `default_data_network_id` %q must be one of the `data_network_id`'s specified in the `data_network` blocks of the same `slice`", s.DefaultDataNetworkId)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SimPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+
+			var model SimPolicyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			mobileNetworkId, err := mobilenetwork.ParseMobileNetworkID(model.MobileNetworkId)
+			if err != nil {
+				return err
+			}
+
+			id := simpolicy.NewSimPolicyID(mobileNetworkId.SubscriptionId, mobileNetworkId.ResourceGroupName, mobileNetworkId.MobileNetworkName, model.Name)
+			existing, err := client.SimPoliciesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := simpolicy.SimPolicy{
+				Location:   location.Normalize(model.Location),
+				Properties: expandSimPolicyProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.SimPoliciesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SimPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+
+			id, err := simpolicy.ParseSimPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SimPoliciesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SimPolicyResourceModel{
+				Name:            id.SimPolicyName,
+				MobileNetworkId: mobilenetwork.NewMobileNetworkID(id.SubscriptionId, id.ResourceGroupName, id.MobileNetworkName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				defaultSliceId, err := slice.ParseSliceIDInsensitively(props.DefaultSlice.Id)
+				if err != nil {
+					return err
+				}
+				state.DefaultSliceId = defaultSliceId.ID()
+
+				if props.RegistrationTimer != nil {
+					state.RegistrationTimerInSeconds = *props.RegistrationTimer
+				}
+				if props.RfspIndex != nil {
+					state.RatFrequencySelectionPriorityIndex = *props.RfspIndex
+				}
+				state.UserEquipmentAggregateMaximumBitRate = flattenSimPolicyAmbr(props.UeAmbr)
+
+				slices, err := flattenSimPolicySliceConfigurations(props.SliceConfigurations)
+				if err != nil {
+					return err
+				}
+				state.Slice = slices
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SimPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+
+			id, err := simpolicy.ParseSimPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SimPolicyResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.SimPoliciesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// the SIM Policy is updated in place, the API propagates the changes to any SIMs which use it
+			payload := *existing.Model
+			properties := expandSimPolicyProperties(model)
+			if metadata.ResourceData.HasChange("default_slice_id") {
+				payload.Properties.DefaultSlice = properties.DefaultSlice
+			}
+			if metadata.ResourceData.HasChange("slice") {
+				payload.Properties.SliceConfigurations = properties.SliceConfigurations
+			}
+			if metadata.ResourceData.HasChange("user_equipment_aggregate_maximum_bit_rate") {
+				payload.Properties.UeAmbr = properties.UeAmbr
+			}
+			if metadata.ResourceData.HasChange("registration_timer_in_seconds") {
+				payload.Properties.RegistrationTimer = properties.RegistrationTimer
+			}
+			if metadata.ResourceData.HasChange("rat_frequency_selection_priority_index") {
+				payload.Properties.RfspIndex = properties.RfspIndex
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.SimPoliciesCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SimPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMPolicyClient
+
+			id, err := simpolicy.ParseSimPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.SimPoliciesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSimPolicyProperties(input SimPolicyResourceModel) simpolicy.SimPolicyPropertiesFormat {
+	output := simpolicy.SimPolicyPropertiesFormat{
+		DefaultSlice: simpolicy.SliceResourceId{
+			Id: input.DefaultSliceId,
+		},
+		RegistrationTimer:   utils.Int64(input.RegistrationTimerInSeconds),
+		SliceConfigurations: expandSimPolicySliceConfigurations(input.Slice),
+		UeAmbr:              expandSimPolicyAmbr(input.UserEquipmentAggregateMaximumBitRate),
+	}
+	if input.RatFrequencySelectionPriorityIndex != 0 {
+		output.RfspIndex = utils.Int64(input.RatFrequencySelectionPriorityIndex)
+	}
+	return output
+}
+
+func expandSimPolicyAmbr(input []BitRateModel) simpolicy.Ambr {
+	if len(input) == 0 {
+		return simpolicy.Ambr{}
+	}
+
+	return simpolicy.Ambr{
+		Downlink: input[0].Downlink,
+		Uplink:   input[0].Uplink,
+	}
+}
+
+func flattenSimPolicyAmbr(input simpolicy.Ambr) []BitRateModel {
+	return []BitRateModel{
+		{
+			Downlink: input.Downlink,
+			Uplink:   input.Uplink,
+		},
+	}
+}
+
+func expandSimPolicySliceConfigurations(input []SimPolicySliceModel) []simpolicy.SliceConfiguration {
+	output := make([]simpolicy.SliceConfiguration, 0)
+	for _, s := range input {
+		dataNetworks := make([]simpolicy.DataNetworkConfiguration, 0)
+		for _, dn := range s.DataNetwork {
+			allowedServices := make([]simpolicy.ServiceResourceId, 0)
+			for _, serviceId := range dn.AllowedServicesIds {
+				allowedServices = append(allowedServices, simpolicy.ServiceResourceId{
+					Id: serviceId,
+				})
+			}
+
+			additionalAllowedSessionTypes := make([]simpolicy.PduSessionType, 0)
+			for _, sessionType := range dn.AdditionalAllowedSessionTypes {
+				additionalAllowedSessionTypes = append(additionalAllowedSessionTypes, simpolicy.PduSessionType(sessionType))
+			}
+
+			defaultSessionType := simpolicy.PduSessionType(dn.DefaultSessionType)
+			preemptionCapability := simpolicy.PreemptionCapability(dn.PreemptionCapability)
+			preemptionVulnerability := simpolicy.PreemptionVulnerability(dn.PreemptionVulnerability)
+			dataNetworks = append(dataNetworks, simpolicy.DataNetworkConfiguration{
+				AdditionalAllowedSessionTypes:       &additionalAllowedSessionTypes,
+				AllocationAndRetentionPriorityLevel: utils.Int64(dn.AllocationAndRetentionPriorityLevel),
+				AllowedServices:                     allowedServices,
+				DataNetwork: simpolicy.DataNetworkResourceId{
+					Id: dn.DataNetworkId,
+				},
+				DefaultSessionType:             &defaultSessionType,
+				Fiveqi:                         utils.Int64(dn.QosIndicator),
+				MaximumNumberOfBufferedPackets: utils.Int64(dn.MaxBufferedPackets),
+				PreemptionCapability:           &preemptionCapability,
+				PreemptionVulnerability:        &preemptionVulnerability,
+				SessionAmbr:                    expandSimPolicyAmbr(dn.SessionAggregateMaximumBitRate),
+			})
+		}
+
+		output = append(output, simpolicy.SliceConfiguration{
+			DataNetworkConfigurations: dataNetworks,
+			DefaultDataNetwork: simpolicy.DataNetworkResourceId{
+				Id: s.DefaultDataNetworkId,
+			},
+			Slice: simpolicy.SliceResourceId{
+				Id: s.SliceId,
+			},
+		})
+	}
+	return output
+}
+
+func flattenSimPolicySliceConfigurations(input []simpolicy.SliceConfiguration) ([]SimPolicySliceModel, error) {
+	output := make([]SimPolicySliceModel, 0)
+	for _, s := range input {
+		sliceId, err := slice.ParseSliceIDInsensitively(s.Slice.Id)
+		if err != nil {
+			return nil, err
+		}
+		defaultDataNetworkId, err := datanetwork.ParseDataNetworkIDInsensitively(s.DefaultDataNetwork.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		dataNetworks := make([]SimPolicyDataNetworkModel, 0)
+		for _, dn := range s.DataNetworkConfigurations {
+			dataNetworkId, err := datanetwork.ParseDataNetworkIDInsensitively(dn.DataNetwork.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			allowedServicesIds := make([]string, 0)
+			for _, v := range dn.AllowedServices {
+				serviceId, err := service.ParseServiceIDInsensitively(v.Id)
+				if err != nil {
+					return nil, err
+				}
+				allowedServicesIds = append(allowedServicesIds, serviceId.ID())
+			}
+
+			additionalAllowedSessionTypes := make([]string, 0)
+			if dn.AdditionalAllowedSessionTypes != nil {
+				for _, v := range *dn.AdditionalAllowedSessionTypes {
+					additionalAllowedSessionTypes = append(additionalAllowedSessionTypes, string(v))
+				}
+			}
+
+			dataNetwork := SimPolicyDataNetworkModel{
+				DataNetworkId:                  dataNetworkId.ID(),
+				AllowedServicesIds:             allowedServicesIds,
+				AdditionalAllowedSessionTypes:  additionalAllowedSessionTypes,
+				SessionAggregateMaximumBitRate: flattenSimPolicyAmbr(dn.SessionAmbr),
+			}
+			if dn.AllocationAndRetentionPriorityLevel != nil {
+				dataNetwork.AllocationAndRetentionPriorityLevel = *dn.AllocationAndRetentionPriorityLevel
+			}
+			if dn.DefaultSessionType != nil {
+				dataNetwork.DefaultSessionType = string(*dn.DefaultSessionType)
+			}
+			if dn.Fiveqi != nil {
+				dataNetwork.QosIndicator = *dn.Fiveqi
+			}
+			if dn.MaximumNumberOfBufferedPackets != nil {
+				dataNetwork.MaxBufferedPackets = *dn.MaximumNumberOfBufferedPackets
+			}
+			if dn.PreemptionCapability != nil {
+				dataNetwork.PreemptionCapability = string(*dn.PreemptionCapability)
+			}
+			if dn.PreemptionVulnerability != nil {
+				dataNetwork.PreemptionVulnerability = string(*dn.PreemptionVulnerability)
+			}
+			dataNetworks = append(dataNetworks, dataNetwork)
+		}
+
+		output = append(output, SimPolicySliceModel{
+			SliceId:              sliceId.ID(),
+			DefaultDataNetworkId: defaultDataNetworkId.ID(),
+			DataNetwork:          dataNetworks,
+		})
+	}
+	return output, nil
+}
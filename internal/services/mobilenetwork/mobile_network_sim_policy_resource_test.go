@@ -0,0 +1,333 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/simpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SimPolicyResource struct{}
+
+func TestAccSimPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	r := SimPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSimPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	r := SimPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSimPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	r := SimPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSimPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim_policy", "test")
+	r := SimPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (SimPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := simpolicy.ParseSimPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MobileNetwork.SIMPolicyClient.SimPoliciesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SimPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_data_network" "test" {
+  name              = "acctest-mndn-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+}
+
+resource "azurerm_mobile_network_service" "test" {
+  name               = "acctest-mns-%[2]d"
+  mobile_network_id  = azurerm_mobile_network.test.id
+  location           = azurerm_resource_group.test.location
+  service_precedence = 0
+
+  pcc_rule {
+    name       = "default-rule"
+    precedence = 1
+
+    service_data_flow_template {
+      name           = "IP-to-server"
+      direction      = "Uplink"
+      protocol       = ["ip"]
+      remote_ip_list = ["10.3.4.0/24"]
+    }
+  }
+}
+
+resource "azurerm_mobile_network_slice" "test" {
+  name              = "acctest-mnsl-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  single_network_slice_selection_assistance_information {
+    slice_service_type = 1
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SimPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_sim_policy" "test" {
+  name              = "acctest-mnsp-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+  default_slice_id  = azurerm_mobile_network_slice.test.id
+
+  slice {
+    slice_id                = azurerm_mobile_network_slice.test.id
+    default_data_network_id = azurerm_mobile_network_data_network.test.id
+
+    data_network {
+      data_network_id      = azurerm_mobile_network_data_network.test.id
+      allowed_services_ids = [azurerm_mobile_network_service.test.id]
+      qos_indicator        = 9
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "500 Mbps"
+      }
+    }
+  }
+
+  user_equipment_aggregate_maximum_bit_rate {
+    downlink = "1 Gbps"
+    uplink   = "500 Mbps"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SimPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_sim_policy" "import" {
+  name              = azurerm_mobile_network_sim_policy.test.name
+  mobile_network_id = azurerm_mobile_network_sim_policy.test.mobile_network_id
+  location          = azurerm_mobile_network_sim_policy.test.location
+  default_slice_id  = azurerm_mobile_network_sim_policy.test.default_slice_id
+
+  slice {
+    slice_id                = azurerm_mobile_network_slice.test.id
+    default_data_network_id = azurerm_mobile_network_data_network.test.id
+
+    data_network {
+      data_network_id      = azurerm_mobile_network_data_network.test.id
+      allowed_services_ids = [azurerm_mobile_network_service.test.id]
+      qos_indicator        = 9
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "500 Mbps"
+      }
+    }
+  }
+
+  user_equipment_aggregate_maximum_bit_rate {
+    downlink = "1 Gbps"
+    uplink   = "500 Mbps"
+  }
+}
+`, r.basic(data))
+}
+
+func (r SimPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_sim_policy" "test" {
+  name                                   = "acctest-mnsp-%[2]d"
+  mobile_network_id                      = azurerm_mobile_network.test.id
+  location                               = azurerm_resource_group.test.location
+  default_slice_id                       = azurerm_mobile_network_slice.test.id
+  registration_timer_in_seconds          = 3240
+  rat_frequency_selection_priority_index = 1
+
+  slice {
+    slice_id                = azurerm_mobile_network_slice.test.id
+    default_data_network_id = azurerm_mobile_network_data_network.test.id
+
+    data_network {
+      data_network_id                         = azurerm_mobile_network_data_network.test.id
+      allowed_services_ids                    = [azurerm_mobile_network_service.test.id]
+      qos_indicator                           = 9
+      allocation_and_retention_priority_level = 9
+      default_session_type                    = "IPv4"
+      additional_allowed_session_types        = []
+      max_buffered_packets                    = 200
+      preemption_capability                   = "NotPreempt"
+      preemption_vulnerability                = "Preemptable"
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "500 Mbps"
+      }
+    }
+  }
+
+  user_equipment_aggregate_maximum_bit_rate {
+    downlink = "1 Gbps"
+    uplink   = "500 Mbps"
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SimPolicyResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_data_network" "second" {
+  name              = "acctest-mndn2-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+}
+
+resource "azurerm_mobile_network_sim_policy" "test" {
+  name                                   = "acctest-mnsp-%[2]d"
+  mobile_network_id                      = azurerm_mobile_network.test.id
+  location                               = azurerm_resource_group.test.location
+  default_slice_id                       = azurerm_mobile_network_slice.test.id
+  registration_timer_in_seconds          = 3000
+  rat_frequency_selection_priority_index = 2
+
+  slice {
+    slice_id                = azurerm_mobile_network_slice.test.id
+    default_data_network_id = azurerm_mobile_network_data_network.second.id
+
+    data_network {
+      data_network_id                         = azurerm_mobile_network_data_network.test.id
+      allowed_services_ids                    = [azurerm_mobile_network_service.test.id]
+      qos_indicator                           = 7
+      allocation_and_retention_priority_level = 5
+      default_session_type                    = "IPv6"
+      additional_allowed_session_types        = ["IPv4"]
+      max_buffered_packets                    = 100
+      preemption_capability                   = "MayPreempt"
+      preemption_vulnerability                = "NotPreemptable"
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "2 Gbps"
+        uplink   = "1 Gbps"
+      }
+    }
+
+    data_network {
+      data_network_id      = azurerm_mobile_network_data_network.second.id
+      allowed_services_ids = [azurerm_mobile_network_service.test.id]
+      qos_indicator        = 9
+
+      session_aggregate_maximum_bit_rate {
+        downlink = "1 Gbps"
+        uplink   = "500 Mbps"
+      }
+    }
+  }
+
+  user_equipment_aggregate_maximum_bit_rate {
+    downlink = "2 Gbps"
+    uplink   = "1 Gbps"
+  }
+
+  tags = {
+    key = "updated"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
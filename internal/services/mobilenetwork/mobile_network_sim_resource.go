@@ -0,0 +1,376 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/sim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/simpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/slice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SimResource struct{}
+
+var _ sdk.ResourceWithUpdate = SimResource{}
+
+type SimResourceModel struct {
+	Name                                  string                          `tfschema:"name"`
+	MobileNetworkSimGroupId               string                          `tfschema:"mobile_network_sim_group_id"`
+	AuthenticationKey                     string                          `tfschema:"authentication_key"`
+	OperatorKeyCode                       string                          `tfschema:"operator_key_code"`
+	InternationalMobileSubscriberIdentity string                          `tfschema:"international_mobile_subscriber_identity"`
+	IntegratedCircuitCardIdentifier       string                          `tfschema:"integrated_circuit_card_identifier"`
+	DeviceType                            string                          `tfschema:"device_type"`
+	SimPolicyId                           string                          `tfschema:"sim_policy_id"`
+	StaticIpConfiguration                 []SimStaticIpConfigurationModel `tfschema:"static_ip_configuration"`
+	SimState                              string                          `tfschema:"sim_state"`
+	VendorKeyFingerprint                  string                          `tfschema:"vendor_key_fingerprint"`
+	VendorName                            string                          `tfschema:"vendor_name"`
+}
+
+type SimStaticIpConfigurationModel struct {
+	AttachedDataNetworkId string `tfschema:"attached_data_network_id"`
+	SliceId               string `tfschema:"slice_id"`
+	StaticIpv4Address     string `tfschema:"static_ipv4_address"`
+}
+
+func simStaticIpConfigurationSchema(forceNew bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: forceNew,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"attached_data_network_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+
+				"slice_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: slice.ValidateSliceID,
+				},
+
+				"static_ipv4_address": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     forceNew,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+		},
+	}
+}
+
+func (r SimResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"mobile_network_sim_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sim.ValidateSimGroupID,
+		},
+
+		"authentication_key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{32}$`), "`authentication_key` must be a 32 character hexadecimal string"),
+		},
+
+		"operator_key_code": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{32}$`), "`operator_key_code` must be a 32 character hexadecimal string"),
+		},
+
+		"international_mobile_subscriber_identity": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{5,15}$`), "`international_mobile_subscriber_identity` must be between 5 and 15 digits"),
+		},
+
+		"integrated_circuit_card_identifier": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^89[0-9]{17,18}$`), "`integrated_circuit_card_identifier` must be 19 or 20 digits starting with `89`"),
+		},
+
+		"device_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sim_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: simpolicy.ValidateSimPolicyID,
+		},
+
+		"static_ip_configuration": simStaticIpConfigurationSchema(false),
+	}
+}
+
+func (r SimResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sim_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"vendor_key_fingerprint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"vendor_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SimResource) ModelObject() interface{} {
+	return &SimResourceModel{}
+}
+
+func (r SimResource) ResourceType() string {
+	return "azurerm_mobile_network_sim"
+}
+
+func (r SimResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sim.ValidateSimID
+}
+
+func (r SimResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMClient
+
+			var model SimResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			simGroupId, err := sim.ParseSimGroupID(model.MobileNetworkSimGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := sim.NewSimID(simGroupId.SubscriptionId, simGroupId.ResourceGroupName, simGroupId.SimGroupName, model.Name)
+			existing, err := client.SimsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := sim.Sim{
+				Properties: expandSimProperties(model),
+			}
+
+			if err := client.SimsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SimResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMClient
+
+			id, err := sim.ParseSimID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SimsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the authentication key and operator key code aren't returned by the API
+			var config SimResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := SimResourceModel{
+				Name:                    id.SimName,
+				MobileNetworkSimGroupId: sim.NewSimGroupID(id.SubscriptionId, id.ResourceGroupName, id.SimGroupName).ID(),
+				AuthenticationKey:       config.AuthenticationKey,
+				OperatorKeyCode:         config.OperatorKeyCode,
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.DeviceType = utils.NormalizeNilableString(props.DeviceType)
+				state.IntegratedCircuitCardIdentifier = utils.NormalizeNilableString(props.IntegratedCircuitCardIdentifier)
+				state.InternationalMobileSubscriberIdentity = props.InternationalMobileSubscriberIdentity
+				if props.SimPolicy != nil {
+					simPolicyId, err := simpolicy.ParseSimPolicyIDInsensitively(props.SimPolicy.Id)
+					if err != nil {
+						return err
+					}
+					state.SimPolicyId = simPolicyId.ID()
+				}
+				if props.SimState != nil {
+					state.SimState = string(*props.SimState)
+				}
+				state.StaticIpConfiguration = flattenSimStaticIpConfiguration(props.StaticIPConfiguration)
+				state.VendorKeyFingerprint = utils.NormalizeNilableString(props.VendorKeyFingerprint)
+				state.VendorName = utils.NormalizeNilableString(props.VendorName)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SimResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMClient
+
+			id, err := sim.ParseSimID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SimResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the credentials are write-only so the whole SIM has to be sent
+			payload := sim.Sim{
+				Properties: expandSimProperties(model),
+			}
+
+			if err := client.SimsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SimResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SIMClient
+
+			id, err := sim.ParseSimID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.SimsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSimProperties(input SimResourceModel) sim.SimPropertiesFormat {
+	output := sim.SimPropertiesFormat{
+		AuthenticationKey:                     utils.String(input.AuthenticationKey),
+		IntegratedCircuitCardIdentifier:       utils.String(input.IntegratedCircuitCardIdentifier),
+		InternationalMobileSubscriberIdentity: input.InternationalMobileSubscriberIdentity,
+		OperatorKeyCode:                       utils.String(input.OperatorKeyCode),
+		StaticIPConfiguration:                 expandSimStaticIpConfiguration(input.StaticIpConfiguration),
+	}
+	if input.DeviceType != "" {
+		output.DeviceType = utils.String(input.DeviceType)
+	}
+	if input.SimPolicyId != "" {
+		output.SimPolicy = &sim.SimPolicyResourceId{
+			Id: input.SimPolicyId,
+		}
+	}
+	return output
+}
+
+func expandSimStaticIpConfiguration(input []SimStaticIpConfigurationModel) *[]sim.SimStaticIPProperties {
+	output := make([]sim.SimStaticIPProperties, 0)
+	for _, v := range input {
+		item := sim.SimStaticIPProperties{
+			AttachedDataNetwork: &sim.AttachedDataNetworkResourceId{
+				Id: v.AttachedDataNetworkId,
+			},
+			Slice: &sim.SliceResourceId{
+				Id: v.SliceId,
+			},
+		}
+		if v.StaticIpv4Address != "" {
+			item.StaticIP = &sim.SimStaticIPPropertiesStaticIP{
+				IPv4Address: utils.String(v.StaticIpv4Address),
+			}
+		}
+		output = append(output, item)
+	}
+	return &output
+}
+
+func flattenSimStaticIpConfiguration(input *[]sim.SimStaticIPProperties) []SimStaticIpConfigurationModel {
+	output := make([]SimStaticIpConfigurationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		item := SimStaticIpConfigurationModel{}
+		if v.AttachedDataNetwork != nil {
+			item.AttachedDataNetworkId = v.AttachedDataNetwork.Id
+		}
+		if v.Slice != nil {
+			if sliceId, err := slice.ParseSliceIDInsensitively(v.Slice.Id); err == nil {
+				item.SliceId = sliceId.ID()
+			}
+		}
+		if v.StaticIP != nil {
+			item.StaticIpv4Address = utils.NormalizeNilableString(v.StaticIP.IPv4Address)
+		}
+		output = append(output, item)
+	}
+	return output
+}
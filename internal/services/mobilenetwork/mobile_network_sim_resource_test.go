@@ -0,0 +1,169 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/sim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SimResource struct{}
+
+func TestAccSim_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim", "test")
+	r := SimResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication_key", "operator_key_code"),
+	})
+}
+
+func TestAccSim_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim", "test")
+	r := SimResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSim_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim", "test")
+	r := SimResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication_key", "operator_key_code"),
+	})
+}
+
+func TestAccSim_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_sim", "test")
+	r := SimResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication_key", "operator_key_code"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication_key", "operator_key_code"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("authentication_key", "operator_key_code"),
+	})
+}
+
+func (SimResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sim.ParseSimID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MobileNetwork.SIMClient.SimsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SimResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_sim_group" "test" {
+  name                = "acctest-mnsg-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  mobile_network_id   = azurerm_mobile_network.test.id
+}
+
+resource "azurerm_mobile_network_sim" "test" {
+  name                                     = "acctest-mnsim-%[2]d"
+  mobile_network_sim_group_id              = azurerm_mobile_network_sim_group.test.id
+  authentication_key                       = "00000000000000000000000000000000"
+  integrated_circuit_card_identifier       = "8900000000000000000"
+  international_mobile_subscriber_identity = "000000000000000"
+  operator_key_code                        = "00000000000000000000000000000000"
+}
+`, SimPolicyResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SimResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_sim" "import" {
+  name                                     = azurerm_mobile_network_sim.test.name
+  mobile_network_sim_group_id              = azurerm_mobile_network_sim.test.mobile_network_sim_group_id
+  authentication_key                       = azurerm_mobile_network_sim.test.authentication_key
+  integrated_circuit_card_identifier       = azurerm_mobile_network_sim.test.integrated_circuit_card_identifier
+  international_mobile_subscriber_identity = azurerm_mobile_network_sim.test.international_mobile_subscriber_identity
+  operator_key_code                        = azurerm_mobile_network_sim.test.operator_key_code
+}
+`, r.basic(data))
+}
+
+func (r SimResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_sim_group" "test" {
+  name                = "acctest-mnsg-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  mobile_network_id   = azurerm_mobile_network.test.id
+}
+
+resource "azurerm_mobile_network_sim" "test" {
+  name                                     = "acctest-mnsim-%[2]d"
+  mobile_network_sim_group_id              = azurerm_mobile_network_sim_group.test.id
+  authentication_key                       = "11111111111111111111111111111111"
+  integrated_circuit_card_identifier       = "8900000000000000000"
+  international_mobile_subscriber_identity = "000000000000000"
+  operator_key_code                        = "11111111111111111111111111111111"
+  device_type                              = "Mobile"
+  sim_policy_id                            = azurerm_mobile_network_sim_policy.test.id
+}
+`, SimPolicyResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,272 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/mobilenetwork"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/slice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SliceResource struct{}
+
+var _ sdk.ResourceWithUpdate = SliceResource{}
+
+type SliceResourceModel struct {
+	Name                                             string            `tfschema:"name"`
+	MobileNetworkId                                  string            `tfschema:"mobile_network_id"`
+	Location                                         string            `tfschema:"location"`
+	Description                                      string            `tfschema:"description"`
+	SingleNetworkSliceSelectionAssistanceInformation []SnssaiModel     `tfschema:"single_network_slice_selection_assistance_information"`
+	Tags                                             map[string]string `tfschema:"tags"`
+}
+
+type SnssaiModel struct {
+	SliceDifferentiator string `tfschema:"slice_differentiator"`
+	SliceServiceType    int64  `tfschema:"slice_service_type"`
+}
+
+func (r SliceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"mobile_network_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: mobilenetwork.ValidateMobileNetworkID,
+		},
+
+		"location": commonschema.Location(),
+
+		"single_network_slice_selection_assistance_information": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"slice_service_type": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+
+					"slice_differentiator": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[A-Fa-f0-9]{6}$`), "`slice_differentiator` must be a 6 digit hexadecimal number"),
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SliceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SliceResource) ModelObject() interface{} {
+	return &SliceResourceModel{}
+}
+
+func (r SliceResource) ResourceType() string {
+	return "azurerm_mobile_network_slice"
+}
+
+func (r SliceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return slice.ValidateSliceID
+}
+
+func (r SliceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SliceClient
+
+			var model SliceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			mobileNetworkId, err := mobilenetwork.ParseMobileNetworkID(model.MobileNetworkId)
+			if err != nil {
+				return err
+			}
+
+			id := slice.NewSliceID(mobileNetworkId.SubscriptionId, mobileNetworkId.ResourceGroupName, mobileNetworkId.MobileNetworkName, model.Name)
+			existing, err := client.SlicesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := slice.Slice{
+				Location: location.Normalize(model.Location),
+				Properties: slice.SlicePropertiesFormat{
+					Snssai: expandSliceSnssai(model.SingleNetworkSliceSelectionAssistanceInformation),
+				},
+				Tags: &model.Tags,
+			}
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if err := client.SlicesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SliceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SliceClient
+
+			id, err := slice.ParseSliceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SlicesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SliceResourceModel{
+				Name:            id.SliceName,
+				MobileNetworkId: mobilenetwork.NewMobileNetworkID(id.SubscriptionId, id.ResourceGroupName, id.MobileNetworkName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				state.Description = utils.NormalizeNilableString(model.Properties.Description)
+				state.SingleNetworkSliceSelectionAssistanceInformation = []SnssaiModel{
+					{
+						SliceDifferentiator: utils.NormalizeNilableString(model.Properties.Snssai.Sd),
+						SliceServiceType:    model.Properties.Snssai.Sst,
+					},
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SliceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SliceClient
+
+			id, err := slice.ParseSliceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SliceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.SlicesGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = nil
+				if model.Description != "" {
+					payload.Properties.Description = utils.String(model.Description)
+				}
+			}
+			if metadata.ResourceData.HasChange("single_network_slice_selection_assistance_information") {
+				payload.Properties.Snssai = expandSliceSnssai(model.SingleNetworkSliceSelectionAssistanceInformation)
+			}
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if err := client.SlicesCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SliceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MobileNetwork.SliceClient
+
+			id, err := slice.ParseSliceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.SlicesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSliceSnssai(input []SnssaiModel) slice.Snssai {
+	if len(input) == 0 {
+		return slice.Snssai{}
+	}
+
+	output := slice.Snssai{
+		Sst: input[0].SliceServiceType,
+	}
+	if input[0].SliceDifferentiator != "" {
+		output.Sd = utils.String(input[0].SliceDifferentiator)
+	}
+	return output
+}
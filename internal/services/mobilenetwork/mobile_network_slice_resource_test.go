@@ -0,0 +1,193 @@
+package mobilenetwork_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mobilenetwork/sdk/2022-11-01/slice"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SliceResource struct{}
+
+func TestAccSlice_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_slice", "test")
+	r := SliceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSlice_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_slice", "test")
+	r := SliceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSlice_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_slice", "test")
+	r := SliceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSlice_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mobile_network_slice", "test")
+	r := SliceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (SliceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := slice.ParseSliceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MobileNetwork.SliceClient.SlicesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SliceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_slice" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  single_network_slice_selection_assistance_information {
+    slice_service_type = 1
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SliceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mobile_network_slice" "import" {
+  name              = azurerm_mobile_network_slice.test.name
+  mobile_network_id = azurerm_mobile_network_slice.test.mobile_network_id
+  location          = azurerm_mobile_network_slice.test.location
+
+  single_network_slice_selection_assistance_information {
+    slice_service_type = 1
+  }
+}
+`, r.basic(data))
+}
+
+func (r SliceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_slice" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  description = "my favourite slice"
+
+  single_network_slice_selection_assistance_information {
+    slice_service_type   = 1
+    slice_differentiator = "1abcde"
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
+
+func (r SliceResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mobile_network_slice" "test" {
+  name              = "acctest-mns-%[2]d"
+  mobile_network_id = azurerm_mobile_network.test.id
+  location          = azurerm_resource_group.test.location
+
+  description = "my updated slice"
+
+  single_network_slice_selection_assistance_information {
+    slice_service_type   = 2
+    slice_differentiator = "2abcde"
+  }
+
+  tags = {
+    key = "updated"
+  }
+}
+`, MobileNetworkResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,15 @@
+package datanetwork
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DataNetworkClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDataNetworkClientWithBaseURI(endpoint string) DataNetworkClient {
+	return DataNetworkClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
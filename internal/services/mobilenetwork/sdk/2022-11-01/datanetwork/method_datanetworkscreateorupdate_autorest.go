@@ -0,0 +1,75 @@
+package datanetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type DataNetworksCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// DataNetworksCreateOrUpdate ...
+func (c DataNetworkClient) DataNetworksCreateOrUpdate(ctx context.Context, id DataNetworkId, input DataNetwork) (result DataNetworksCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForDataNetworksCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDataNetworksCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DataNetworksCreateOrUpdateThenPoll performs DataNetworksCreateOrUpdate then polls until it's completed
+func (c DataNetworkClient) DataNetworksCreateOrUpdateThenPoll(ctx context.Context, id DataNetworkId, input DataNetwork) error {
+	result, err := c.DataNetworksCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing DataNetworksCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DataNetworksCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDataNetworksCreateOrUpdate prepares the DataNetworksCreateOrUpdate request.
+func (c DataNetworkClient) preparerForDataNetworksCreateOrUpdate(ctx context.Context, id DataNetworkId, input DataNetwork) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDataNetworksCreateOrUpdate sends the DataNetworksCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c DataNetworkClient) senderForDataNetworksCreateOrUpdate(ctx context.Context, req *http.Request) (future DataNetworksCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
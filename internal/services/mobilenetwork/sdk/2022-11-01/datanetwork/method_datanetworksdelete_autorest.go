@@ -0,0 +1,73 @@
+package datanetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type DataNetworksDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// DataNetworksDelete ...
+func (c DataNetworkClient) DataNetworksDelete(ctx context.Context, id DataNetworkId) (result DataNetworksDeleteResponse, err error) {
+	req, err := c.preparerForDataNetworksDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDataNetworksDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DataNetworksDeleteThenPoll performs DataNetworksDelete then polls until it's completed
+func (c DataNetworkClient) DataNetworksDeleteThenPoll(ctx context.Context, id DataNetworkId) error {
+	result, err := c.DataNetworksDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing DataNetworksDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DataNetworksDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDataNetworksDelete prepares the DataNetworksDelete request.
+func (c DataNetworkClient) preparerForDataNetworksDelete(ctx context.Context, id DataNetworkId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDataNetworksDelete sends the DataNetworksDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c DataNetworkClient) senderForDataNetworksDelete(ctx context.Context, req *http.Request) (future DataNetworksDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package datanetwork
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DataNetworksGetResponse struct {
+	HttpResponse *http.Response
+	Model        *DataNetwork
+}
+
+// DataNetworksGet ...
+func (c DataNetworkClient) DataNetworksGet(ctx context.Context, id DataNetworkId) (result DataNetworksGetResponse, err error) {
+	req, err := c.preparerForDataNetworksGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDataNetworksGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDataNetworksGet prepares the DataNetworksGet request.
+func (c DataNetworkClient) preparerForDataNetworksGet(ctx context.Context, id DataNetworkId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDataNetworksGet handles the response to the DataNetworksGet request. The method always
+// closes the http.Response Body.
+func (c DataNetworkClient) responderForDataNetworksGet(resp *http.Response) (result DataNetworksGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
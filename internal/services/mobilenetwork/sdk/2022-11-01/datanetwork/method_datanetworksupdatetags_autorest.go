@@ -0,0 +1,65 @@
+package datanetwork
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DataNetworksUpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *DataNetwork
+}
+
+// DataNetworksUpdateTags ...
+func (c DataNetworkClient) DataNetworksUpdateTags(ctx context.Context, id DataNetworkId, input TagsObject) (result DataNetworksUpdateTagsResponse, err error) {
+	req, err := c.preparerForDataNetworksUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDataNetworksUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "datanetwork.DataNetworkClient", "DataNetworksUpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDataNetworksUpdateTags prepares the DataNetworksUpdateTags request.
+func (c DataNetworkClient) preparerForDataNetworksUpdateTags(ctx context.Context, id DataNetworkId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDataNetworksUpdateTags handles the response to the DataNetworksUpdateTags request. The method always
+// closes the http.Response Body.
+func (c DataNetworkClient) responderForDataNetworksUpdateTags(resp *http.Response) (result DataNetworksUpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
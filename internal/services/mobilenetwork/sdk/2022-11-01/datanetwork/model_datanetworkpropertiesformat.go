@@ -0,0 +1,6 @@
+package datanetwork
+
+type DataNetworkPropertiesFormat struct {
+	Description       *string            `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
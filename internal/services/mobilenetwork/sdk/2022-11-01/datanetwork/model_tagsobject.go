@@ -0,0 +1,5 @@
+package datanetwork
+
+type TagsObject struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
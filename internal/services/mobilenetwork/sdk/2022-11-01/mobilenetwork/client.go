@@ -0,0 +1,15 @@
+package mobilenetwork
+
+import "github.com/Azure/go-autorest/autorest"
+
+type MobileNetworkClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMobileNetworkClientWithBaseURI(endpoint string) MobileNetworkClient {
+	return MobileNetworkClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,75 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type MobileNetworksCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// MobileNetworksCreateOrUpdate ...
+func (c MobileNetworkClient) MobileNetworksCreateOrUpdate(ctx context.Context, id MobileNetworkId, input MobileNetwork) (result MobileNetworksCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForMobileNetworksCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForMobileNetworksCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// MobileNetworksCreateOrUpdateThenPoll performs MobileNetworksCreateOrUpdate then polls until it's completed
+func (c MobileNetworkClient) MobileNetworksCreateOrUpdateThenPoll(ctx context.Context, id MobileNetworkId, input MobileNetwork) error {
+	result, err := c.MobileNetworksCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing MobileNetworksCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after MobileNetworksCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForMobileNetworksCreateOrUpdate prepares the MobileNetworksCreateOrUpdate request.
+func (c MobileNetworkClient) preparerForMobileNetworksCreateOrUpdate(ctx context.Context, id MobileNetworkId, input MobileNetwork) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForMobileNetworksCreateOrUpdate sends the MobileNetworksCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c MobileNetworkClient) senderForMobileNetworksCreateOrUpdate(ctx context.Context, req *http.Request) (future MobileNetworksCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,73 @@
+package mobilenetwork
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type MobileNetworksDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// MobileNetworksDelete ...
+func (c MobileNetworkClient) MobileNetworksDelete(ctx context.Context, id MobileNetworkId) (result MobileNetworksDeleteResponse, err error) {
+	req, err := c.preparerForMobileNetworksDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForMobileNetworksDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// MobileNetworksDeleteThenPoll performs MobileNetworksDelete then polls until it's completed
+func (c MobileNetworkClient) MobileNetworksDeleteThenPoll(ctx context.Context, id MobileNetworkId) error {
+	result, err := c.MobileNetworksDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing MobileNetworksDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after MobileNetworksDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForMobileNetworksDelete prepares the MobileNetworksDelete request.
+func (c MobileNetworkClient) preparerForMobileNetworksDelete(ctx context.Context, id MobileNetworkId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForMobileNetworksDelete sends the MobileNetworksDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c MobileNetworkClient) senderForMobileNetworksDelete(ctx context.Context, req *http.Request) (future MobileNetworksDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
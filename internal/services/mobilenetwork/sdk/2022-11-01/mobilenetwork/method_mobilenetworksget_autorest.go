@@ -0,0 +1,64 @@
+package mobilenetwork
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type MobileNetworksGetResponse struct {
+	HttpResponse *http.Response
+	Model        *MobileNetwork
+}
+
+// MobileNetworksGet ...
+func (c MobileNetworkClient) MobileNetworksGet(ctx context.Context, id MobileNetworkId) (result MobileNetworksGetResponse, err error) {
+	req, err := c.preparerForMobileNetworksGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForMobileNetworksGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForMobileNetworksGet prepares the MobileNetworksGet request.
+func (c MobileNetworkClient) preparerForMobileNetworksGet(ctx context.Context, id MobileNetworkId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForMobileNetworksGet handles the response to the MobileNetworksGet request. The method always
+// closes the http.Response Body.
+func (c MobileNetworkClient) responderForMobileNetworksGet(resp *http.Response) (result MobileNetworksGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
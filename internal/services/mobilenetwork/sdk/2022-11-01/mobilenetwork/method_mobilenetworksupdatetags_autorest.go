@@ -0,0 +1,65 @@
+package mobilenetwork
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type MobileNetworksUpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *MobileNetwork
+}
+
+// MobileNetworksUpdateTags ...
+func (c MobileNetworkClient) MobileNetworksUpdateTags(ctx context.Context, id MobileNetworkId, input TagsObject) (result MobileNetworksUpdateTagsResponse, err error) {
+	req, err := c.preparerForMobileNetworksUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForMobileNetworksUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "mobilenetwork.MobileNetworkClient", "MobileNetworksUpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForMobileNetworksUpdateTags prepares the MobileNetworksUpdateTags request.
+func (c MobileNetworkClient) preparerForMobileNetworksUpdateTags(ctx context.Context, id MobileNetworkId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForMobileNetworksUpdateTags handles the response to the MobileNetworksUpdateTags request. The method always
+// closes the http.Response Body.
+func (c MobileNetworkClient) responderForMobileNetworksUpdateTags(resp *http.Response) (result MobileNetworksUpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,10 @@
+package mobilenetwork
+
+type MobileNetwork struct {
+	Id         *string                       `json:"id,omitempty"`
+	Location   string                        `json:"location"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties MobileNetworkPropertiesFormat `json:"properties"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package mobilenetwork
+
+type MobileNetworkPropertiesFormat struct {
+	ProvisioningState                 *ProvisioningState `json:"provisioningState,omitempty"`
+	PublicLandMobileNetworkIdentifier PlmnId             `json:"publicLandMobileNetworkIdentifier"`
+	ServiceKey                        *string            `json:"serviceKey,omitempty"`
+}
@@ -0,0 +1,6 @@
+package mobilenetwork
+
+type PlmnId struct {
+	Mcc string `json:"mcc"`
+	Mnc string `json:"mnc"`
+}
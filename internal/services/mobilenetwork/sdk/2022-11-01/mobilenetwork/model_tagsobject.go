@@ -0,0 +1,5 @@
+package mobilenetwork
+
+type TagsObject struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,15 @@
+package packetcorecontrolplane
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PacketCoreControlPlaneClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPacketCoreControlPlaneClientWithBaseURI(endpoint string) PacketCoreControlPlaneClient {
+	return PacketCoreControlPlaneClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
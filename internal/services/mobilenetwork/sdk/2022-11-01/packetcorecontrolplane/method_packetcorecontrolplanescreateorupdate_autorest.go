@@ -0,0 +1,75 @@
+package packetcorecontrolplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type PacketCoreControlPlanesCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// PacketCoreControlPlanesCreateOrUpdate ...
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesCreateOrUpdate(ctx context.Context, id PacketCoreControlPlaneId, input PacketCoreControlPlane) (result PacketCoreControlPlanesCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForPacketCoreControlPlanesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPacketCoreControlPlanesCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PacketCoreControlPlanesCreateOrUpdateThenPoll performs PacketCoreControlPlanesCreateOrUpdate then polls until it's completed
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesCreateOrUpdateThenPoll(ctx context.Context, id PacketCoreControlPlaneId, input PacketCoreControlPlane) error {
+	result, err := c.PacketCoreControlPlanesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing PacketCoreControlPlanesCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after PacketCoreControlPlanesCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPacketCoreControlPlanesCreateOrUpdate prepares the PacketCoreControlPlanesCreateOrUpdate request.
+func (c PacketCoreControlPlaneClient) preparerForPacketCoreControlPlanesCreateOrUpdate(ctx context.Context, id PacketCoreControlPlaneId, input PacketCoreControlPlane) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPacketCoreControlPlanesCreateOrUpdate sends the PacketCoreControlPlanesCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c PacketCoreControlPlaneClient) senderForPacketCoreControlPlanesCreateOrUpdate(ctx context.Context, req *http.Request) (future PacketCoreControlPlanesCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,73 @@
+package packetcorecontrolplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type PacketCoreControlPlanesDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// PacketCoreControlPlanesDelete ...
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesDelete(ctx context.Context, id PacketCoreControlPlaneId) (result PacketCoreControlPlanesDeleteResponse, err error) {
+	req, err := c.preparerForPacketCoreControlPlanesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPacketCoreControlPlanesDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PacketCoreControlPlanesDeleteThenPoll performs PacketCoreControlPlanesDelete then polls until it's completed
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesDeleteThenPoll(ctx context.Context, id PacketCoreControlPlaneId) error {
+	result, err := c.PacketCoreControlPlanesDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing PacketCoreControlPlanesDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after PacketCoreControlPlanesDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPacketCoreControlPlanesDelete prepares the PacketCoreControlPlanesDelete request.
+func (c PacketCoreControlPlaneClient) preparerForPacketCoreControlPlanesDelete(ctx context.Context, id PacketCoreControlPlaneId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPacketCoreControlPlanesDelete sends the PacketCoreControlPlanesDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c PacketCoreControlPlaneClient) senderForPacketCoreControlPlanesDelete(ctx context.Context, req *http.Request) (future PacketCoreControlPlanesDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
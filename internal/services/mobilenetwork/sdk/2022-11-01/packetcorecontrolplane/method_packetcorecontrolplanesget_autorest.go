@@ -0,0 +1,64 @@
+package packetcorecontrolplane
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PacketCoreControlPlanesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *PacketCoreControlPlane
+}
+
+// PacketCoreControlPlanesGet ...
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesGet(ctx context.Context, id PacketCoreControlPlaneId) (result PacketCoreControlPlanesGetResponse, err error) {
+	req, err := c.preparerForPacketCoreControlPlanesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPacketCoreControlPlanesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPacketCoreControlPlanesGet prepares the PacketCoreControlPlanesGet request.
+func (c PacketCoreControlPlaneClient) preparerForPacketCoreControlPlanesGet(ctx context.Context, id PacketCoreControlPlaneId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPacketCoreControlPlanesGet handles the response to the PacketCoreControlPlanesGet request. The method always
+// closes the http.Response Body.
+func (c PacketCoreControlPlaneClient) responderForPacketCoreControlPlanesGet(resp *http.Response) (result PacketCoreControlPlanesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,74 @@
+package packetcorecontrolplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type PacketCoreControlPlanesReinstallResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// PacketCoreControlPlanesReinstall ...
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesReinstall(ctx context.Context, id PacketCoreControlPlaneId) (result PacketCoreControlPlanesReinstallResponse, err error) {
+	req, err := c.preparerForPacketCoreControlPlanesReinstall(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesReinstall", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPacketCoreControlPlanesReinstall(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesReinstall", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PacketCoreControlPlanesReinstallThenPoll performs PacketCoreControlPlanesReinstall then polls until it's completed
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesReinstallThenPoll(ctx context.Context, id PacketCoreControlPlaneId) error {
+	result, err := c.PacketCoreControlPlanesReinstall(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing PacketCoreControlPlanesReinstall: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after PacketCoreControlPlanesReinstall: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPacketCoreControlPlanesReinstall prepares the PacketCoreControlPlanesReinstall request.
+func (c PacketCoreControlPlaneClient) preparerForPacketCoreControlPlanesReinstall(ctx context.Context, id PacketCoreControlPlaneId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/reinstall", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPacketCoreControlPlanesReinstall sends the PacketCoreControlPlanesReinstall request. The method will close the
+// http.Response Body if it receives an error.
+func (c PacketCoreControlPlaneClient) senderForPacketCoreControlPlanesReinstall(ctx context.Context, req *http.Request) (future PacketCoreControlPlanesReinstallResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,74 @@
+package packetcorecontrolplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type PacketCoreControlPlanesRollbackResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// PacketCoreControlPlanesRollback ...
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesRollback(ctx context.Context, id PacketCoreControlPlaneId) (result PacketCoreControlPlanesRollbackResponse, err error) {
+	req, err := c.preparerForPacketCoreControlPlanesRollback(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesRollback", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPacketCoreControlPlanesRollback(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesRollback", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PacketCoreControlPlanesRollbackThenPoll performs PacketCoreControlPlanesRollback then polls until it's completed
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesRollbackThenPoll(ctx context.Context, id PacketCoreControlPlaneId) error {
+	result, err := c.PacketCoreControlPlanesRollback(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing PacketCoreControlPlanesRollback: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after PacketCoreControlPlanesRollback: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPacketCoreControlPlanesRollback prepares the PacketCoreControlPlanesRollback request.
+func (c PacketCoreControlPlaneClient) preparerForPacketCoreControlPlanesRollback(ctx context.Context, id PacketCoreControlPlaneId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/rollback", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPacketCoreControlPlanesRollback sends the PacketCoreControlPlanesRollback request. The method will close the
+// http.Response Body if it receives an error.
+func (c PacketCoreControlPlaneClient) senderForPacketCoreControlPlanesRollback(ctx context.Context, req *http.Request) (future PacketCoreControlPlanesRollbackResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
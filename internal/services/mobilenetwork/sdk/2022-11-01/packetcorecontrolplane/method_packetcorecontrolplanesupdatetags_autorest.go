@@ -0,0 +1,65 @@
+package packetcorecontrolplane
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PacketCoreControlPlanesUpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *PacketCoreControlPlane
+}
+
+// PacketCoreControlPlanesUpdateTags ...
+func (c PacketCoreControlPlaneClient) PacketCoreControlPlanesUpdateTags(ctx context.Context, id PacketCoreControlPlaneId, input TagsObject) (result PacketCoreControlPlanesUpdateTagsResponse, err error) {
+	req, err := c.preparerForPacketCoreControlPlanesUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPacketCoreControlPlanesUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "packetcorecontrolplane.PacketCoreControlPlaneClient", "PacketCoreControlPlanesUpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPacketCoreControlPlanesUpdateTags prepares the PacketCoreControlPlanesUpdateTags request.
+func (c PacketCoreControlPlaneClient) preparerForPacketCoreControlPlanesUpdateTags(ctx context.Context, id PacketCoreControlPlaneId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPacketCoreControlPlanesUpdateTags handles the response to the PacketCoreControlPlanesUpdateTags request. The method always
+// closes the http.Response Body.
+func (c PacketCoreControlPlaneClient) responderForPacketCoreControlPlanesUpdateTags(resp *http.Response) (result PacketCoreControlPlanesUpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,5 @@
+package packetcorecontrolplane
+
+type AsyncOperationId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,5 @@
+package packetcorecontrolplane
+
+type AzureStackEdgeDeviceResourceId struct {
+	Id string `json:"id"`
+}
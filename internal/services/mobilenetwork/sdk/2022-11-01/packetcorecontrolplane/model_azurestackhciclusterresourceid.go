@@ -0,0 +1,5 @@
+package packetcorecontrolplane
+
+type AzureStackHCIClusterResourceId struct {
+	Id string `json:"id"`
+}
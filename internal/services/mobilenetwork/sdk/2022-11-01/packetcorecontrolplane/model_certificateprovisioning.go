@@ -0,0 +1,6 @@
+package packetcorecontrolplane
+
+type CertificateProvisioning struct {
+	Reason *string                       `json:"reason,omitempty"`
+	State  *CertificateProvisioningState `json:"state,omitempty"`
+}
@@ -0,0 +1,5 @@
+package packetcorecontrolplane
+
+type ConnectedClusterResourceId struct {
+	Id string `json:"id"`
+}
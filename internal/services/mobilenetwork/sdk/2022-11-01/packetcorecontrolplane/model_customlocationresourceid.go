@@ -0,0 +1,5 @@
+package packetcorecontrolplane
+
+type CustomLocationResourceId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,5 @@
+package packetcorecontrolplane
+
+type DiagnosticsUploadConfiguration struct {
+	StorageAccountContainerUrl string `json:"storageAccountContainerUrl"`
+}
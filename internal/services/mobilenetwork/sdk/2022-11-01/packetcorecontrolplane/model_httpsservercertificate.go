@@ -0,0 +1,6 @@
+package packetcorecontrolplane
+
+type HTTPSServerCertificate struct {
+	CertificateUrl string                   `json:"certificateUrl"`
+	Provisioning   *CertificateProvisioning `json:"provisioning,omitempty"`
+}
@@ -0,0 +1,8 @@
+package packetcorecontrolplane
+
+type Installation struct {
+	Operation         *AsyncOperationId     `json:"operation,omitempty"`
+	Reasons           *[]InstallationReason `json:"reasons,omitempty"`
+	ReinstallRequired *ReinstallRequired    `json:"reinstallRequired,omitempty"`
+	State             *InstallationState    `json:"state,omitempty"`
+}
@@ -0,0 +1,8 @@
+package packetcorecontrolplane
+
+type InterfaceProperties struct {
+	IPv4Address *string `json:"ipv4Address,omitempty"`
+	IPv4Gateway *string `json:"ipv4Gateway,omitempty"`
+	IPv4Subnet  *string `json:"ipv4Subnet,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
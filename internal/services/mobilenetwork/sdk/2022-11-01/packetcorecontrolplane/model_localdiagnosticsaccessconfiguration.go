@@ -0,0 +1,6 @@
+package packetcorecontrolplane
+
+type LocalDiagnosticsAccessConfiguration struct {
+	AuthenticationType     AuthenticationType      `json:"authenticationType"`
+	HTTPSServerCertificate *HTTPSServerCertificate `json:"httpsServerCertificate,omitempty"`
+}
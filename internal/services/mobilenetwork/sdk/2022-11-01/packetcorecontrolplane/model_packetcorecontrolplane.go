@@ -0,0 +1,15 @@
+package packetcorecontrolplane
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type PacketCoreControlPlane struct {
+	Id         *string                                `json:"id,omitempty"`
+	Identity   *identity.UserAssignedMap              `json:"identity,omitempty"`
+	Location   string                                 `json:"location"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties PacketCoreControlPlanePropertiesFormat `json:"properties"`
+	Tags       *map[string]string                     `json:"tags,omitempty"`
+	Type       *string                                `json:"type,omitempty"`
+}
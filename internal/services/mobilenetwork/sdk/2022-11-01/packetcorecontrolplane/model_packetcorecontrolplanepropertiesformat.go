@@ -0,0 +1,17 @@
+package packetcorecontrolplane
+
+type PacketCoreControlPlanePropertiesFormat struct {
+	ControlPlaneAccessInterface InterfaceProperties                 `json:"controlPlaneAccessInterface"`
+	CoreNetworkTechnology       *CoreNetworkType                    `json:"coreNetworkTechnology,omitempty"`
+	DiagnosticsUpload           *DiagnosticsUploadConfiguration     `json:"diagnosticsUpload,omitempty"`
+	Installation                *Installation                       `json:"installation,omitempty"`
+	InteropSettings             *interface{}                        `json:"interopSettings,omitempty"`
+	LocalDiagnosticsAccess      LocalDiagnosticsAccessConfiguration `json:"localDiagnosticsAccess"`
+	Platform                    PlatformConfiguration               `json:"platform"`
+	ProvisioningState           *ProvisioningState                  `json:"provisioningState,omitempty"`
+	RollbackVersion             *string                             `json:"rollbackVersion,omitempty"`
+	Sites                       []SiteResourceId                    `json:"sites"`
+	Sku                         BillingSku                          `json:"sku"`
+	UeMtu                       *int64                              `json:"ueMtu,omitempty"`
+	Version                     *string                             `json:"version,omitempty"`
+}
@@ -0,0 +1,9 @@
+package packetcorecontrolplane
+
+type PlatformConfiguration struct {
+	AzureStackEdgeDevice *AzureStackEdgeDeviceResourceId `json:"azureStackEdgeDevice,omitempty"`
+	AzureStackHciCluster *AzureStackHCIClusterResourceId `json:"azureStackHciCluster,omitempty"`
+	ConnectedCluster     *ConnectedClusterResourceId     `json:"connectedCluster,omitempty"`
+	CustomLocation       *CustomLocationResourceId       `json:"customLocation,omitempty"`
+	Type                 PlatformType                    `json:"type"`
+}
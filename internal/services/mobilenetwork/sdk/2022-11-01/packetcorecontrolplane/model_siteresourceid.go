@@ -0,0 +1,5 @@
+package packetcorecontrolplane
+
+type SiteResourceId struct {
+	Id string `json:"id"`
+}
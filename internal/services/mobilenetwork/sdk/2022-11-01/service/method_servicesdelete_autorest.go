@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ServicesDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ServicesDelete ...
+func (c ServiceClient) ServicesDelete(ctx context.Context, id ServiceId) (result ServicesDeleteResponse, err error) {
+	req, err := c.preparerForServicesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "service.ServiceClient", "ServicesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForServicesDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "service.ServiceClient", "ServicesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ServicesDeleteThenPoll performs ServicesDelete then polls until it's completed
+func (c ServiceClient) ServicesDeleteThenPoll(ctx context.Context, id ServiceId) error {
+	result, err := c.ServicesDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing ServicesDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ServicesDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForServicesDelete prepares the ServicesDelete request.
+func (c ServiceClient) preparerForServicesDelete(ctx context.Context, id ServiceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForServicesDelete sends the ServicesDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c ServiceClient) senderForServicesDelete(ctx context.Context, req *http.Request) (future ServicesDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ServicesUpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *Service
+}
+
+// ServicesUpdateTags ...
+func (c ServiceClient) ServicesUpdateTags(ctx context.Context, id ServiceId, input TagsObject) (result ServicesUpdateTagsResponse, err error) {
+	req, err := c.preparerForServicesUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "service.ServiceClient", "ServicesUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "service.ServiceClient", "ServicesUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForServicesUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "service.ServiceClient", "ServicesUpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForServicesUpdateTags prepares the ServicesUpdateTags request.
+func (c ServiceClient) preparerForServicesUpdateTags(ctx context.Context, id ServiceId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForServicesUpdateTags handles the response to the ServicesUpdateTags request. The method always
+// closes the http.Response Body.
+func (c ServiceClient) responderForServicesUpdateTags(resp *http.Response) (result ServicesUpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
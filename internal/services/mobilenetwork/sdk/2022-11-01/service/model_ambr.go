@@ -0,0 +1,6 @@
+package service
+
+type Ambr struct {
+	Downlink string `json:"downlink"`
+	Uplink   string `json:"uplink"`
+}
@@ -0,0 +1,9 @@
+package service
+
+type PccRuleConfiguration struct {
+	RuleName                 string                    `json:"ruleName"`
+	RulePrecedence           int64                     `json:"rulePrecedence"`
+	RuleQosPolicy            *PccRuleQosPolicy         `json:"ruleQosPolicy,omitempty"`
+	ServiceDataFlowTemplates []ServiceDataFlowTemplate `json:"serviceDataFlowTemplates"`
+	TrafficControl           *TrafficControlPermission `json:"trafficControl,omitempty"`
+}
@@ -0,0 +1,10 @@
+package service
+
+type PccRuleQosPolicy struct {
+	AllocationAndRetentionPriorityLevel *int64                   `json:"allocationAndRetentionPriorityLevel,omitempty"`
+	Fiveqi                              *int64                   `json:"5qi,omitempty"`
+	GuaranteedBitRate                   *Ambr                    `json:"guaranteedBitRate,omitempty"`
+	MaximumBitRate                      Ambr                     `json:"maximumBitRate"`
+	PreemptionCapability                *PreemptionCapability    `json:"preemptionCapability,omitempty"`
+	PreemptionVulnerability             *PreemptionVulnerability `json:"preemptionVulnerability,omitempty"`
+}
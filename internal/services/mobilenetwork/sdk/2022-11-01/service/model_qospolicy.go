@@ -0,0 +1,9 @@
+package service
+
+type QosPolicy struct {
+	AllocationAndRetentionPriorityLevel *int64                   `json:"allocationAndRetentionPriorityLevel,omitempty"`
+	Fiveqi                              *int64                   `json:"5qi,omitempty"`
+	MaximumBitRate                      Ambr                     `json:"maximumBitRate"`
+	PreemptionCapability                *PreemptionCapability    `json:"preemptionCapability,omitempty"`
+	PreemptionVulnerability             *PreemptionVulnerability `json:"preemptionVulnerability,omitempty"`
+}
@@ -0,0 +1,9 @@
+package service
+
+type ServiceDataFlowTemplate struct {
+	Direction    SdfDirection `json:"direction"`
+	Ports        *[]string    `json:"ports,omitempty"`
+	Protocol     []string     `json:"protocol"`
+	RemoteIPList []string     `json:"remoteIpList"`
+	TemplateName string       `json:"templateName"`
+}
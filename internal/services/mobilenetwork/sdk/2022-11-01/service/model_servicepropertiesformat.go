@@ -0,0 +1,8 @@
+package service
+
+type ServicePropertiesFormat struct {
+	PccRules          []PccRuleConfiguration `json:"pccRules"`
+	ProvisioningState *ProvisioningState     `json:"provisioningState,omitempty"`
+	ServicePrecedence int64                  `json:"servicePrecedence"`
+	ServiceQosPolicy  *QosPolicy             `json:"serviceQosPolicy,omitempty"`
+}
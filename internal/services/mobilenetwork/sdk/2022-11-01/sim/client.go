@@ -0,0 +1,15 @@
+package sim
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SIMClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSIMClientWithBaseURI(endpoint string) SIMClient {
+	return SIMClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,117 @@
+package sim
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleted   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUnknown   ProvisioningState = "Unknown"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUnknown),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":  ProvisioningStateAccepted,
+		"canceled":  ProvisioningStateCanceled,
+		"deleted":   ProvisioningStateDeleted,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"unknown":   ProvisioningStateUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type SimState string
+
+const (
+	SimStateDisabled SimState = "Disabled"
+	SimStateInvalid  SimState = "Invalid"
+	SimStateEnabled  SimState = "Enabled"
+)
+
+func PossibleValuesForSimState() []string {
+	return []string{
+		string(SimStateDisabled),
+		string(SimStateInvalid),
+		string(SimStateEnabled),
+	}
+}
+
+func parseSimState(input string) (*SimState, error) {
+	vals := map[string]SimState{
+		"disabled": SimStateDisabled,
+		"invalid":  SimStateInvalid,
+		"enabled":  SimStateEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SimState(input)
+	return &out, nil
+}
+
+type SiteProvisioningState string
+
+const (
+	SiteProvisioningStateAdding        SiteProvisioningState = "Adding"
+	SiteProvisioningStateDeleting      SiteProvisioningState = "Deleting"
+	SiteProvisioningStateFailed        SiteProvisioningState = "Failed"
+	SiteProvisioningStateNotApplicable SiteProvisioningState = "NotApplicable"
+	SiteProvisioningStateProvisioned   SiteProvisioningState = "Provisioned"
+	SiteProvisioningStateUpdating      SiteProvisioningState = "Updating"
+)
+
+func PossibleValuesForSiteProvisioningState() []string {
+	return []string{
+		string(SiteProvisioningStateAdding),
+		string(SiteProvisioningStateDeleting),
+		string(SiteProvisioningStateFailed),
+		string(SiteProvisioningStateNotApplicable),
+		string(SiteProvisioningStateProvisioned),
+		string(SiteProvisioningStateUpdating),
+	}
+}
+
+func parseSiteProvisioningState(input string) (*SiteProvisioningState, error) {
+	vals := map[string]SiteProvisioningState{
+		"adding":        SiteProvisioningStateAdding,
+		"deleting":      SiteProvisioningStateDeleting,
+		"failed":        SiteProvisioningStateFailed,
+		"notapplicable": SiteProvisioningStateNotApplicable,
+		"provisioned":   SiteProvisioningStateProvisioned,
+		"updating":      SiteProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SiteProvisioningState(input)
+	return &out, nil
+}
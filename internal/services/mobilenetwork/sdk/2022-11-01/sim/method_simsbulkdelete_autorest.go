@@ -0,0 +1,75 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SimsBulkDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SimsBulkDelete ...
+func (c SIMClient) SimsBulkDelete(ctx context.Context, id SimGroupId, input SimDeleteList) (result SimsBulkDeleteResponse, err error) {
+	req, err := c.preparerForSimsBulkDelete(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsBulkDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSimsBulkDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsBulkDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SimsBulkDeleteThenPoll performs SimsBulkDelete then polls until it's completed
+func (c SIMClient) SimsBulkDeleteThenPoll(ctx context.Context, id SimGroupId, input SimDeleteList) error {
+	result, err := c.SimsBulkDelete(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SimsBulkDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SimsBulkDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSimsBulkDelete prepares the SimsBulkDelete request.
+func (c SIMClient) preparerForSimsBulkDelete(ctx context.Context, id SimGroupId, input SimDeleteList) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/deleteSims", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSimsBulkDelete sends the SimsBulkDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c SIMClient) senderForSimsBulkDelete(ctx context.Context, req *http.Request) (future SimsBulkDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,75 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SimsBulkUploadEncryptedResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SimsBulkUploadEncrypted ...
+func (c SIMClient) SimsBulkUploadEncrypted(ctx context.Context, id SimGroupId, input EncryptedSimUploadList) (result SimsBulkUploadEncryptedResponse, err error) {
+	req, err := c.preparerForSimsBulkUploadEncrypted(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsBulkUploadEncrypted", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSimsBulkUploadEncrypted(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsBulkUploadEncrypted", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SimsBulkUploadEncryptedThenPoll performs SimsBulkUploadEncrypted then polls until it's completed
+func (c SIMClient) SimsBulkUploadEncryptedThenPoll(ctx context.Context, id SimGroupId, input EncryptedSimUploadList) error {
+	result, err := c.SimsBulkUploadEncrypted(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SimsBulkUploadEncrypted: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SimsBulkUploadEncrypted: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSimsBulkUploadEncrypted prepares the SimsBulkUploadEncrypted request.
+func (c SIMClient) preparerForSimsBulkUploadEncrypted(ctx context.Context, id SimGroupId, input EncryptedSimUploadList) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/uploadEncryptedSims", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSimsBulkUploadEncrypted sends the SimsBulkUploadEncrypted request. The method will close the
+// http.Response Body if it receives an error.
+func (c SIMClient) senderForSimsBulkUploadEncrypted(ctx context.Context, req *http.Request) (future SimsBulkUploadEncryptedResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
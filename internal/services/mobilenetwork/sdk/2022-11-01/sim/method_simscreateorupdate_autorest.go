@@ -0,0 +1,75 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SimsCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SimsCreateOrUpdate ...
+func (c SIMClient) SimsCreateOrUpdate(ctx context.Context, id SimId, input Sim) (result SimsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSimsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSimsCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SimsCreateOrUpdateThenPoll performs SimsCreateOrUpdate then polls until it's completed
+func (c SIMClient) SimsCreateOrUpdateThenPoll(ctx context.Context, id SimId, input Sim) error {
+	result, err := c.SimsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SimsCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SimsCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSimsCreateOrUpdate prepares the SimsCreateOrUpdate request.
+func (c SIMClient) preparerForSimsCreateOrUpdate(ctx context.Context, id SimId, input Sim) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSimsCreateOrUpdate sends the SimsCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SIMClient) senderForSimsCreateOrUpdate(ctx context.Context, req *http.Request) (future SimsCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
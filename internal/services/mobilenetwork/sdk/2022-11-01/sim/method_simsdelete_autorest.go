@@ -0,0 +1,73 @@
+package sim
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SimsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SimsDelete ...
+func (c SIMClient) SimsDelete(ctx context.Context, id SimId) (result SimsDeleteResponse, err error) {
+	req, err := c.preparerForSimsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSimsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SimsDeleteThenPoll performs SimsDelete then polls until it's completed
+func (c SIMClient) SimsDeleteThenPoll(ctx context.Context, id SimId) error {
+	result, err := c.SimsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SimsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SimsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSimsDelete prepares the SimsDelete request.
+func (c SIMClient) preparerForSimsDelete(ctx context.Context, id SimId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSimsDelete sends the SimsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c SIMClient) senderForSimsDelete(ctx context.Context, req *http.Request) (future SimsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package sim
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SimsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Sim
+}
+
+// SimsGet ...
+func (c SIMClient) SimsGet(ctx context.Context, id SimId) (result SimsGetResponse, err error) {
+	req, err := c.preparerForSimsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSimsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "sim.SIMClient", "SimsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSimsGet prepares the SimsGet request.
+func (c SIMClient) preparerForSimsGet(ctx context.Context, id SimId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSimsGet handles the response to the SimsGet request. The method always
+// closes the http.Response Body.
+func (c SIMClient) responderForSimsGet(resp *http.Response) (result SimsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,5 @@
+package sim
+
+type AttachedDataNetworkResourceId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,15 @@
+package sim
+
+type EncryptedSimPropertiesFormat struct {
+	DeviceType                            *string                           `json:"deviceType,omitempty"`
+	IntegratedCircuitCardIdentifier       *string                           `json:"integratedCircuitCardIdentifier,omitempty"`
+	EncryptedCredentials                  *string                           `json:"encryptedCredentials,omitempty"`
+	InternationalMobileSubscriberIdentity string                            `json:"internationalMobileSubscriberIdentity"`
+	ProvisioningState                     *ProvisioningState                `json:"provisioningState,omitempty"`
+	SimPolicy                             *SimPolicyResourceId              `json:"simPolicy,omitempty"`
+	SimState                              *SimState                         `json:"simState,omitempty"`
+	SiteProvisioningState                 *map[string]SiteProvisioningState `json:"siteProvisioningState,omitempty"`
+	StaticIPConfiguration                 *[]SimStaticIPProperties          `json:"staticIpConfiguration,omitempty"`
+	VendorKeyFingerprint                  *string                           `json:"vendorKeyFingerprint,omitempty"`
+	VendorName                            *string                           `json:"vendorName,omitempty"`
+}
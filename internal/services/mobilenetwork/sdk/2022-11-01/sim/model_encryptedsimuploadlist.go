@@ -0,0 +1,10 @@
+package sim
+
+type EncryptedSimUploadList struct {
+	AzureKeyIdentifier    int64                           `json:"azureKeyIdentifier"`
+	EncryptedTransportKey string                          `json:"encryptedTransportKey"`
+	SignedTransportKey    string                          `json:"signedTransportKey"`
+	Sims                  []SimNameAndEncryptedProperties `json:"sims"`
+	VendorKeyFingerprint  string                          `json:"vendorKeyFingerprint"`
+	Version               int64                           `json:"version"`
+}
@@ -0,0 +1,8 @@
+package sim
+
+type Sim struct {
+	Id         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties SimPropertiesFormat `json:"properties"`
+	Type       *string             `json:"type,omitempty"`
+}
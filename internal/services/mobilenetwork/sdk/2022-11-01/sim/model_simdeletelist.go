@@ -0,0 +1,5 @@
+package sim
+
+type SimDeleteList struct {
+	Sims []string `json:"sims"`
+}
@@ -0,0 +1,6 @@
+package sim
+
+type SimNameAndEncryptedProperties struct {
+	Name       string                       `json:"name"`
+	Properties EncryptedSimPropertiesFormat `json:"properties"`
+}
@@ -0,0 +1,5 @@
+package sim
+
+type SimPolicyResourceId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,7 @@
+package sim
+
+type SimStaticIPProperties struct {
+	AttachedDataNetwork *AttachedDataNetworkResourceId `json:"attachedDataNetwork,omitempty"`
+	Slice               *SliceResourceId               `json:"slice,omitempty"`
+	StaticIP            *SimStaticIPPropertiesStaticIP `json:"staticIp,omitempty"`
+}
@@ -0,0 +1,5 @@
+package sim
+
+type SimStaticIPPropertiesStaticIP struct {
+	IPv4Address *string `json:"ipv4Address,omitempty"`
+}
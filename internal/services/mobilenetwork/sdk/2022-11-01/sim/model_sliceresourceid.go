@@ -0,0 +1,5 @@
+package sim
+
+type SliceResourceId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,15 @@
+package simgroup
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SIMGroupClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSIMGroupClientWithBaseURI(endpoint string) SIMGroupClient {
+	return SIMGroupClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,75 @@
+package simgroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SimGroupsCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SimGroupsCreateOrUpdate ...
+func (c SIMGroupClient) SimGroupsCreateOrUpdate(ctx context.Context, id SimGroupId, input SimGroup) (result SimGroupsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSimGroupsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSimGroupsCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SimGroupsCreateOrUpdateThenPoll performs SimGroupsCreateOrUpdate then polls until it's completed
+func (c SIMGroupClient) SimGroupsCreateOrUpdateThenPoll(ctx context.Context, id SimGroupId, input SimGroup) error {
+	result, err := c.SimGroupsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SimGroupsCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SimGroupsCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSimGroupsCreateOrUpdate prepares the SimGroupsCreateOrUpdate request.
+func (c SIMGroupClient) preparerForSimGroupsCreateOrUpdate(ctx context.Context, id SimGroupId, input SimGroup) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSimGroupsCreateOrUpdate sends the SimGroupsCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SIMGroupClient) senderForSimGroupsCreateOrUpdate(ctx context.Context, req *http.Request) (future SimGroupsCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
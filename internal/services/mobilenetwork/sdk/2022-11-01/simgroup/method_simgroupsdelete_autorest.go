@@ -0,0 +1,73 @@
+package simgroup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SimGroupsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SimGroupsDelete ...
+func (c SIMGroupClient) SimGroupsDelete(ctx context.Context, id SimGroupId) (result SimGroupsDeleteResponse, err error) {
+	req, err := c.preparerForSimGroupsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSimGroupsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SimGroupsDeleteThenPoll performs SimGroupsDelete then polls until it's completed
+func (c SIMGroupClient) SimGroupsDeleteThenPoll(ctx context.Context, id SimGroupId) error {
+	result, err := c.SimGroupsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SimGroupsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SimGroupsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSimGroupsDelete prepares the SimGroupsDelete request.
+func (c SIMGroupClient) preparerForSimGroupsDelete(ctx context.Context, id SimGroupId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSimGroupsDelete sends the SimGroupsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c SIMGroupClient) senderForSimGroupsDelete(ctx context.Context, req *http.Request) (future SimGroupsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package simgroup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SimGroupsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *SimGroup
+}
+
+// SimGroupsGet ...
+func (c SIMGroupClient) SimGroupsGet(ctx context.Context, id SimGroupId) (result SimGroupsGetResponse, err error) {
+	req, err := c.preparerForSimGroupsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSimGroupsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSimGroupsGet prepares the SimGroupsGet request.
+func (c SIMGroupClient) preparerForSimGroupsGet(ctx context.Context, id SimGroupId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSimGroupsGet handles the response to the SimGroupsGet request. The method always
+// closes the http.Response Body.
+func (c SIMGroupClient) responderForSimGroupsGet(resp *http.Response) (result SimGroupsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
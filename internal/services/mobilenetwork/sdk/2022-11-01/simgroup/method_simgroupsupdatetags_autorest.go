@@ -0,0 +1,65 @@
+package simgroup
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SimGroupsUpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *SimGroup
+}
+
+// SimGroupsUpdateTags ...
+func (c SIMGroupClient) SimGroupsUpdateTags(ctx context.Context, id SimGroupId, input TagsObject) (result SimGroupsUpdateTagsResponse, err error) {
+	req, err := c.preparerForSimGroupsUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSimGroupsUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simgroup.SIMGroupClient", "SimGroupsUpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSimGroupsUpdateTags prepares the SimGroupsUpdateTags request.
+func (c SIMGroupClient) preparerForSimGroupsUpdateTags(ctx context.Context, id SimGroupId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSimGroupsUpdateTags handles the response to the SimGroupsUpdateTags request. The method always
+// closes the http.Response Body.
+func (c SIMGroupClient) responderForSimGroupsUpdateTags(resp *http.Response) (result SimGroupsUpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,5 @@
+package simgroup
+
+type KeyVaultKey struct {
+	KeyUrl *string `json:"keyUrl,omitempty"`
+}
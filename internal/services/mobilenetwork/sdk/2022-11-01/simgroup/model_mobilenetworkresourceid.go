@@ -0,0 +1,5 @@
+package simgroup
+
+type MobileNetworkResourceId struct {
+	Id string `json:"id"`
+}
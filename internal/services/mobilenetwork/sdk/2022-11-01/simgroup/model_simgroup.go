@@ -0,0 +1,15 @@
+package simgroup
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type SimGroup struct {
+	Id         *string                   `json:"id,omitempty"`
+	Identity   *identity.UserAssignedMap `json:"identity,omitempty"`
+	Location   string                    `json:"location"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties SimGroupPropertiesFormat  `json:"properties"`
+	Tags       *map[string]string        `json:"tags,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
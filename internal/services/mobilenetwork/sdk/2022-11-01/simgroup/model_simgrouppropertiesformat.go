@@ -0,0 +1,7 @@
+package simgroup
+
+type SimGroupPropertiesFormat struct {
+	EncryptionKey     *KeyVaultKey             `json:"encryptionKey,omitempty"`
+	MobileNetwork     *MobileNetworkResourceId `json:"mobileNetwork,omitempty"`
+	ProvisioningState *ProvisioningState       `json:"provisioningState,omitempty"`
+}
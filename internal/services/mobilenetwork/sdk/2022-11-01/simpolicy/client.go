@@ -0,0 +1,15 @@
+package simpolicy
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SIMPolicyClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSIMPolicyClientWithBaseURI(endpoint string) SIMPolicyClient {
+	return SIMPolicyClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,170 @@
+package simpolicy
+
+import "strings"
+
+type PduSessionType string
+
+const (
+	PduSessionTypeIPv4 PduSessionType = "IPv4"
+	PduSessionTypeIPv6 PduSessionType = "IPv6"
+)
+
+func PossibleValuesForPduSessionType() []string {
+	return []string{
+		string(PduSessionTypeIPv4),
+		string(PduSessionTypeIPv6),
+	}
+}
+
+func parsePduSessionType(input string) (*PduSessionType, error) {
+	vals := map[string]PduSessionType{
+		"ipv4": PduSessionTypeIPv4,
+		"ipv6": PduSessionTypeIPv6,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PduSessionType(input)
+	return &out, nil
+}
+
+type PreemptionCapability string
+
+const (
+	PreemptionCapabilityMayPreempt PreemptionCapability = "MayPreempt"
+	PreemptionCapabilityNotPreempt PreemptionCapability = "NotPreempt"
+)
+
+func PossibleValuesForPreemptionCapability() []string {
+	return []string{
+		string(PreemptionCapabilityMayPreempt),
+		string(PreemptionCapabilityNotPreempt),
+	}
+}
+
+func parsePreemptionCapability(input string) (*PreemptionCapability, error) {
+	vals := map[string]PreemptionCapability{
+		"maypreempt": PreemptionCapabilityMayPreempt,
+		"notpreempt": PreemptionCapabilityNotPreempt,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PreemptionCapability(input)
+	return &out, nil
+}
+
+type PreemptionVulnerability string
+
+const (
+	PreemptionVulnerabilityNotPreemptable PreemptionVulnerability = "NotPreemptable"
+	PreemptionVulnerabilityPreemptable    PreemptionVulnerability = "Preemptable"
+)
+
+func PossibleValuesForPreemptionVulnerability() []string {
+	return []string{
+		string(PreemptionVulnerabilityNotPreemptable),
+		string(PreemptionVulnerabilityPreemptable),
+	}
+}
+
+func parsePreemptionVulnerability(input string) (*PreemptionVulnerability, error) {
+	vals := map[string]PreemptionVulnerability{
+		"notpreemptable": PreemptionVulnerabilityNotPreemptable,
+		"preemptable":    PreemptionVulnerabilityPreemptable,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PreemptionVulnerability(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateAccepted  ProvisioningState = "Accepted"
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleted   ProvisioningState = "Deleted"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUnknown   ProvisioningState = "Unknown"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateAccepted),
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleted),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUnknown),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"accepted":  ProvisioningStateAccepted,
+		"canceled":  ProvisioningStateCanceled,
+		"deleted":   ProvisioningStateDeleted,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"unknown":   ProvisioningStateUnknown,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type SiteProvisioningState string
+
+const (
+	SiteProvisioningStateAdding        SiteProvisioningState = "Adding"
+	SiteProvisioningStateDeleting      SiteProvisioningState = "Deleting"
+	SiteProvisioningStateFailed        SiteProvisioningState = "Failed"
+	SiteProvisioningStateNotApplicable SiteProvisioningState = "NotApplicable"
+	SiteProvisioningStateProvisioned   SiteProvisioningState = "Provisioned"
+	SiteProvisioningStateUpdating      SiteProvisioningState = "Updating"
+)
+
+func PossibleValuesForSiteProvisioningState() []string {
+	return []string{
+		string(SiteProvisioningStateAdding),
+		string(SiteProvisioningStateDeleting),
+		string(SiteProvisioningStateFailed),
+		string(SiteProvisioningStateNotApplicable),
+		string(SiteProvisioningStateProvisioned),
+		string(SiteProvisioningStateUpdating),
+	}
+}
+
+func parseSiteProvisioningState(input string) (*SiteProvisioningState, error) {
+	vals := map[string]SiteProvisioningState{
+		"adding":        SiteProvisioningStateAdding,
+		"deleting":      SiteProvisioningStateDeleting,
+		"failed":        SiteProvisioningStateFailed,
+		"notapplicable": SiteProvisioningStateNotApplicable,
+		"provisioned":   SiteProvisioningStateProvisioned,
+		"updating":      SiteProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SiteProvisioningState(input)
+	return &out, nil
+}
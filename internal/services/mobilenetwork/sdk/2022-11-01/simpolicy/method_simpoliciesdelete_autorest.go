@@ -0,0 +1,73 @@
+package simpolicy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SimPoliciesDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SimPoliciesDelete ...
+func (c SIMPolicyClient) SimPoliciesDelete(ctx context.Context, id SimPolicyId) (result SimPoliciesDeleteResponse, err error) {
+	req, err := c.preparerForSimPoliciesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simpolicy.SIMPolicyClient", "SimPoliciesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSimPoliciesDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "simpolicy.SIMPolicyClient", "SimPoliciesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SimPoliciesDeleteThenPoll performs SimPoliciesDelete then polls until it's completed
+func (c SIMPolicyClient) SimPoliciesDeleteThenPoll(ctx context.Context, id SimPolicyId) error {
+	result, err := c.SimPoliciesDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SimPoliciesDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SimPoliciesDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSimPoliciesDelete prepares the SimPoliciesDelete request.
+func (c SIMPolicyClient) preparerForSimPoliciesDelete(ctx context.Context, id SimPolicyId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSimPoliciesDelete sends the SimPoliciesDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c SIMPolicyClient) senderForSimPoliciesDelete(ctx context.Context, req *http.Request) (future SimPoliciesDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,6 @@
+package simpolicy
+
+type Ambr struct {
+	Downlink string `json:"downlink"`
+	Uplink   string `json:"uplink"`
+}
@@ -0,0 +1,5 @@
+package simpolicy
+
+type DataNetworkResourceId struct {
+	Id string `json:"id"`
+}
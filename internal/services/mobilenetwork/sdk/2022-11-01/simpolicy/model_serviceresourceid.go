@@ -0,0 +1,5 @@
+package simpolicy
+
+type ServiceResourceId struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,7 @@
+package simpolicy
+
+type SliceConfiguration struct {
+	DataNetworkConfigurations []DataNetworkConfiguration `json:"dataNetworkConfigurations"`
+	DefaultDataNetwork        DataNetworkResourceId      `json:"defaultDataNetwork"`
+	Slice                     SliceResourceId            `json:"slice"`
+}
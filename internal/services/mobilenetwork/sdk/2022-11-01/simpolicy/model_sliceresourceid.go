@@ -0,0 +1,5 @@
+package simpolicy
+
+type SliceResourceId struct {
+	Id string `json:"id"`
+}
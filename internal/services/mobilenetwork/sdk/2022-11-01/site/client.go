@@ -0,0 +1,15 @@
+package site
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SiteClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSiteClientWithBaseURI(endpoint string) SiteClient {
+	return SiteClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,75 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SitesCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SitesCreateOrUpdate ...
+func (c SiteClient) SitesCreateOrUpdate(ctx context.Context, id SiteId, input Site) (result SitesCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSitesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSitesCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SitesCreateOrUpdateThenPoll performs SitesCreateOrUpdate then polls until it's completed
+func (c SiteClient) SitesCreateOrUpdateThenPoll(ctx context.Context, id SiteId, input Site) error {
+	result, err := c.SitesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SitesCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SitesCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSitesCreateOrUpdate prepares the SitesCreateOrUpdate request.
+func (c SiteClient) preparerForSitesCreateOrUpdate(ctx context.Context, id SiteId, input Site) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSitesCreateOrUpdate sends the SitesCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SiteClient) senderForSitesCreateOrUpdate(ctx context.Context, req *http.Request) (future SitesCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
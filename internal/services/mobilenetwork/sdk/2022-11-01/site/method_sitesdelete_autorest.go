@@ -0,0 +1,73 @@
+package site
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SitesDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SitesDelete ...
+func (c SiteClient) SitesDelete(ctx context.Context, id SiteId) (result SitesDeleteResponse, err error) {
+	req, err := c.preparerForSitesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSitesDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SitesDeleteThenPoll performs SitesDelete then polls until it's completed
+func (c SiteClient) SitesDeleteThenPoll(ctx context.Context, id SiteId) error {
+	result, err := c.SitesDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SitesDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SitesDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSitesDelete prepares the SitesDelete request.
+func (c SiteClient) preparerForSitesDelete(ctx context.Context, id SiteId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSitesDelete sends the SitesDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c SiteClient) senderForSitesDelete(ctx context.Context, req *http.Request) (future SitesDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
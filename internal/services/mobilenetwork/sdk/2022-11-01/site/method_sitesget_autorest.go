@@ -0,0 +1,64 @@
+package site
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SitesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Site
+}
+
+// SitesGet ...
+func (c SiteClient) SitesGet(ctx context.Context, id SiteId) (result SitesGetResponse, err error) {
+	req, err := c.preparerForSitesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSitesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSitesGet prepares the SitesGet request.
+func (c SiteClient) preparerForSitesGet(ctx context.Context, id SiteId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSitesGet handles the response to the SitesGet request. The method always
+// closes the http.Response Body.
+func (c SiteClient) responderForSitesGet(resp *http.Response) (result SitesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package site
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SitesUpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *Site
+}
+
+// SitesUpdateTags ...
+func (c SiteClient) SitesUpdateTags(ctx context.Context, id SiteId, input TagsObject) (result SitesUpdateTagsResponse, err error) {
+	req, err := c.preparerForSitesUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSitesUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "site.SiteClient", "SitesUpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSitesUpdateTags prepares the SitesUpdateTags request.
+func (c SiteClient) preparerForSitesUpdateTags(ctx context.Context, id SiteId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSitesUpdateTags handles the response to the SitesUpdateTags request. The method always
+// closes the http.Response Body.
+func (c SiteClient) responderForSitesUpdateTags(resp *http.Response) (result SitesUpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
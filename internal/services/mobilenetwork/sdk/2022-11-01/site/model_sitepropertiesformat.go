@@ -0,0 +1,6 @@
+package site
+
+type SitePropertiesFormat struct {
+	NetworkFunctions  *[]SubResource     `json:"networkFunctions,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,5 @@
+package site
+
+type SubResource struct {
+	Id string `json:"id"`
+}
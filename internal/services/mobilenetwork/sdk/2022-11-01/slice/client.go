@@ -0,0 +1,15 @@
+package slice
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SliceClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSliceClientWithBaseURI(endpoint string) SliceClient {
+	return SliceClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,75 @@
+package slice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SlicesCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SlicesCreateOrUpdate ...
+func (c SliceClient) SlicesCreateOrUpdate(ctx context.Context, id SliceId, input Slice) (result SlicesCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSlicesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSlicesCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SlicesCreateOrUpdateThenPoll performs SlicesCreateOrUpdate then polls until it's completed
+func (c SliceClient) SlicesCreateOrUpdateThenPoll(ctx context.Context, id SliceId, input Slice) error {
+	result, err := c.SlicesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SlicesCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SlicesCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSlicesCreateOrUpdate prepares the SlicesCreateOrUpdate request.
+func (c SliceClient) preparerForSlicesCreateOrUpdate(ctx context.Context, id SliceId, input Slice) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSlicesCreateOrUpdate sends the SlicesCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SliceClient) senderForSlicesCreateOrUpdate(ctx context.Context, req *http.Request) (future SlicesCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
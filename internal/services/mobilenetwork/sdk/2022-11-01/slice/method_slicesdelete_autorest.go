@@ -0,0 +1,73 @@
+package slice
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SlicesDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SlicesDelete ...
+func (c SliceClient) SlicesDelete(ctx context.Context, id SliceId) (result SlicesDeleteResponse, err error) {
+	req, err := c.preparerForSlicesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSlicesDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SlicesDeleteThenPoll performs SlicesDelete then polls until it's completed
+func (c SliceClient) SlicesDeleteThenPoll(ctx context.Context, id SliceId) error {
+	result, err := c.SlicesDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SlicesDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SlicesDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSlicesDelete prepares the SlicesDelete request.
+func (c SliceClient) preparerForSlicesDelete(ctx context.Context, id SliceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSlicesDelete sends the SlicesDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c SliceClient) senderForSlicesDelete(ctx context.Context, req *http.Request) (future SlicesDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package slice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SlicesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Slice
+}
+
+// SlicesGet ...
+func (c SliceClient) SlicesGet(ctx context.Context, id SliceId) (result SlicesGetResponse, err error) {
+	req, err := c.preparerForSlicesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSlicesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSlicesGet prepares the SlicesGet request.
+func (c SliceClient) preparerForSlicesGet(ctx context.Context, id SliceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSlicesGet handles the response to the SlicesGet request. The method always
+// closes the http.Response Body.
+func (c SliceClient) responderForSlicesGet(resp *http.Response) (result SlicesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package slice
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SlicesUpdateTagsResponse struct {
+	HttpResponse *http.Response
+	Model        *Slice
+}
+
+// SlicesUpdateTags ...
+func (c SliceClient) SlicesUpdateTags(ctx context.Context, id SliceId, input TagsObject) (result SlicesUpdateTagsResponse, err error) {
+	req, err := c.preparerForSlicesUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSlicesUpdateTags(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "slice.SliceClient", "SlicesUpdateTags", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSlicesUpdateTags prepares the SlicesUpdateTags request.
+func (c SliceClient) preparerForSlicesUpdateTags(ctx context.Context, id SliceId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSlicesUpdateTags handles the response to the SlicesUpdateTags request. The method always
+// closes the http.Response Body.
+func (c SliceClient) responderForSlicesUpdateTags(resp *http.Response) (result SlicesUpdateTagsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,10 @@
+package slice
+
+type Slice struct {
+	Id         *string               `json:"id,omitempty"`
+	Location   string                `json:"location"`
+	Name       *string               `json:"name,omitempty"`
+	Properties SlicePropertiesFormat `json:"properties"`
+	Tags       *map[string]string    `json:"tags,omitempty"`
+	Type       *string               `json:"type,omitempty"`
+}
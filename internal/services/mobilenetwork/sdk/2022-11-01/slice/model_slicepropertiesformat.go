@@ -0,0 +1,7 @@
+package slice
+
+type SlicePropertiesFormat struct {
+	Description       *string            `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Snssai            Snssai             `json:"snssai"`
+}
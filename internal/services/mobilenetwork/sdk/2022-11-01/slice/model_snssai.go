@@ -0,0 +1,6 @@
+package slice
+
+type Snssai struct {
+	Sd  *string `json:"sd,omitempty"`
+	Sst int64   `json:"sst"`
+}
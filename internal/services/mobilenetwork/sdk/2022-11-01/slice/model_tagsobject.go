@@ -0,0 +1,5 @@
+package slice
+
+type TagsObject struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// BitRate validates a bit rate in the format used by the Mobile Network API, e.g. `1 Gbps` or `500.5 Mbps`
+func BitRate(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^\d+(\.\d+)? (bps|Kbps|Mbps|Gbps|Tbps)$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be a number followed by a unit of `bps`, `Kbps`, `Mbps`, `Gbps` or `Tbps` (e.g. `1 Gbps`), got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,52 @@
+package validate
+
+import "testing"
+
+func TestBitRate(t *testing.T) {
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{
+			input: "",
+			valid: false,
+		},
+		{
+			input: "1 Gbps",
+			valid: true,
+		},
+		{
+			input: "500.5 Mbps",
+			valid: true,
+		},
+		{
+			input: "100 bps",
+			valid: true,
+		},
+		{
+			input: "1Gbps",
+			valid: false,
+		},
+		{
+			input: "1 gbps",
+			valid: false,
+		},
+		{
+			input: "Gbps",
+			valid: false,
+		},
+		{
+			input: "1.5.1 Kbps",
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			_, errors := BitRate(test.input, "bit_rate")
+			if valid := len(errors) == 0; valid != test.valid {
+				t.Fatalf("expected %q to be valid %t but got %t", test.input, test.valid, valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,523 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+var (
+	alertProcessingRuleScheduleDateTimeRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}$`)
+	alertProcessingRuleScheduleTimeRegex     = regexp.MustCompile(`^\d{2}:\d{2}:\d{2}$`)
+)
+
+// the order of the conditions is retained so that these are sent to the API consistently
+var alertProcessingRuleConditionFields = []struct {
+	key   string
+	field alertprocessingrules.Field
+}{
+	{key: "alert_context", field: alertprocessingrules.FieldAlertContext},
+	{key: "alert_rule_id", field: alertprocessingrules.FieldAlertRuleId},
+	{key: "alert_rule_name", field: alertprocessingrules.FieldAlertRuleName},
+	{key: "description", field: alertprocessingrules.FieldDescription},
+	{key: "monitor_condition", field: alertprocessingrules.FieldMonitorCondition},
+	{key: "monitor_service", field: alertprocessingrules.FieldMonitorService},
+	{key: "severity", field: alertprocessingrules.FieldSeverity},
+	{key: "signal_type", field: alertprocessingrules.FieldSignalType},
+	{key: "target_resource", field: alertprocessingrules.FieldTargetResource},
+	{key: "target_resource_group", field: alertprocessingrules.FieldTargetResourceGroup},
+	{key: "target_resource_type", field: alertprocessingrules.FieldTargetResourceType},
+}
+
+func schemaAlertProcessingRuleConditions() *pluginsdk.Schema {
+	operators := alertprocessingrules.PossibleValuesForOperator()
+	equalityOperators := []string{
+		string(alertprocessingrules.OperatorEquals),
+		string(alertprocessingrules.OperatorNotEquals),
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"alert_context": schemaAlertProcessingRuleCondition(operators, nil),
+
+				"alert_rule_id": schemaAlertProcessingRuleCondition(operators, nil),
+
+				"alert_rule_name": schemaAlertProcessingRuleCondition(operators, nil),
+
+				"description": schemaAlertProcessingRuleCondition(operators, nil),
+
+				"monitor_condition": schemaAlertProcessingRuleCondition(equalityOperators, []string{
+					"Fired",
+					"Resolved",
+				}),
+
+				"monitor_service": schemaAlertProcessingRuleCondition(equalityOperators, []string{
+					"ActivityLog Administrative",
+					"ActivityLog Autoscale",
+					"ActivityLog Policy",
+					"ActivityLog Recommendation",
+					"ActivityLog Security",
+					"Application Insights",
+					"Azure Backup",
+					"Azure Stack Edge",
+					"Azure Stack Hub",
+					"Custom",
+					"Data Box Gateway",
+					"Health Platform",
+					"Log Alerts V2",
+					"Log Analytics",
+					"Platform",
+					"Prometheus",
+					"Resource Health",
+					"Smart Detector",
+					"VM Insights - Health",
+				}),
+
+				"severity": schemaAlertProcessingRuleCondition(equalityOperators, []string{
+					"Sev0",
+					"Sev1",
+					"Sev2",
+					"Sev3",
+					"Sev4",
+				}),
+
+				"signal_type": schemaAlertProcessingRuleCondition(equalityOperators, []string{
+					"Metric",
+					"Log",
+					"Unknown",
+					"Health",
+				}),
+
+				"target_resource": schemaAlertProcessingRuleCondition(operators, nil),
+
+				"target_resource_group": schemaAlertProcessingRuleCondition(operators, nil),
+
+				"target_resource_type": schemaAlertProcessingRuleCondition(operators, nil),
+			},
+		},
+	}
+}
+
+func schemaAlertProcessingRuleCondition(operatorValidateItems, valuesValidateItems []string) *pluginsdk.Schema {
+	valuesValidateFunc := validation.StringIsNotEmpty
+	if len(valuesValidateItems) > 0 {
+		valuesValidateFunc = validation.StringInSlice(valuesValidateItems, false)
+	}
+
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"operator": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(operatorValidateItems, false),
+				},
+
+				"values": {
+					Type:     pluginsdk.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: valuesValidateFunc,
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaAlertProcessingRuleSchedule() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"effective_from": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(alertProcessingRuleScheduleDateTimeRegex, "`effective_from` must be in the format `YYYY-MM-DDThh:mm:ss`"),
+				},
+
+				"effective_until": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(alertProcessingRuleScheduleDateTimeRegex, "`effective_until` must be in the format `YYYY-MM-DDThh:mm:ss`"),
+				},
+
+				"time_zone": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      "UTC",
+					ValidateFunc: computeValidate.VirtualMachineTimeZone(),
+				},
+
+				"recurrence": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"daily": {
+								Type:         pluginsdk.TypeList,
+								Optional:     true,
+								AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"start_time": schemaAlertProcessingRuleRecurrenceTime(),
+
+										"end_time": schemaAlertProcessingRuleRecurrenceTime(),
+									},
+								},
+							},
+
+							"weekly": {
+								Type:         pluginsdk.TypeList,
+								Optional:     true,
+								AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"days_of_week": {
+											Type:     pluginsdk.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &pluginsdk.Schema{
+												Type:         pluginsdk.TypeString,
+												ValidateFunc: validation.StringInSlice(alertprocessingrules.PossibleValuesForDaysOfWeek(), false),
+											},
+										},
+
+										"start_time": schemaAlertProcessingRuleRecurrenceTime(),
+
+										"end_time": schemaAlertProcessingRuleRecurrenceTime(),
+									},
+								},
+							},
+
+							"monthly": {
+								Type:         pluginsdk.TypeList,
+								Optional:     true,
+								AtLeastOneOf: []string{"schedule.0.recurrence.0.daily", "schedule.0.recurrence.0.weekly", "schedule.0.recurrence.0.monthly"},
+								Elem: &pluginsdk.Resource{
+									Schema: map[string]*pluginsdk.Schema{
+										"days_of_month": {
+											Type:     pluginsdk.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &pluginsdk.Schema{
+												Type:         pluginsdk.TypeInt,
+												ValidateFunc: validation.IntBetween(1, 31),
+											},
+										},
+
+										"start_time": schemaAlertProcessingRuleRecurrenceTime(),
+
+										"end_time": schemaAlertProcessingRuleRecurrenceTime(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaAlertProcessingRuleRecurrenceTime() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringMatch(alertProcessingRuleScheduleTimeRegex, "the time must be in the format `hh:mm:ss`"),
+	}
+}
+
+func expandAlertProcessingRuleConditions(input []interface{}) *[]alertprocessingrules.Condition {
+	conditions := make([]alertprocessingrules.Condition, 0)
+	if len(input) == 0 || input[0] == nil {
+		return &conditions
+	}
+
+	v := input[0].(map[string]interface{})
+	for _, field := range alertProcessingRuleConditionFields {
+		raw := v[field.key].([]interface{})
+		if len(raw) == 0 || raw[0] == nil {
+			continue
+		}
+
+		condition := raw[0].(map[string]interface{})
+		f := field.field
+		operator := alertprocessingrules.Operator(condition["operator"].(string))
+		conditions = append(conditions, alertprocessingrules.Condition{
+			Field:    &f,
+			Operator: &operator,
+			Values:   utils.ExpandStringSlice(condition["values"].([]interface{})),
+		})
+	}
+
+	return &conditions
+}
+
+func expandAlertProcessingRuleSchedule(input []interface{}) *alertprocessingrules.Schedule {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	schedule := alertprocessingrules.Schedule{
+		TimeZone:    utils.String(v["time_zone"].(string)),
+		Recurrences: expandAlertProcessingRuleScheduleRecurrences(v["recurrence"].([]interface{})),
+	}
+
+	if effectiveFrom := v["effective_from"].(string); effectiveFrom != "" {
+		schedule.EffectiveFrom = utils.String(effectiveFrom)
+	}
+
+	if effectiveUntil := v["effective_until"].(string); effectiveUntil != "" {
+		schedule.EffectiveUntil = utils.String(effectiveUntil)
+	}
+
+	return &schedule
+}
+
+func expandAlertProcessingRuleScheduleRecurrences(input []interface{}) *[]alertprocessingrules.Recurrence {
+	recurrences := make([]alertprocessingrules.Recurrence, 0)
+	if len(input) == 0 || input[0] == nil {
+		return &recurrences
+	}
+
+	v := input[0].(map[string]interface{})
+
+	for _, item := range v["daily"].([]interface{}) {
+		raw := item.(map[string]interface{})
+		startTime, endTime := expandAlertProcessingRuleRecurrenceTimes(raw)
+		recurrences = append(recurrences, alertprocessingrules.DailyRecurrence{
+			StartTime: startTime,
+			EndTime:   endTime,
+		})
+	}
+
+	for _, item := range v["weekly"].([]interface{}) {
+		raw := item.(map[string]interface{})
+		startTime, endTime := expandAlertProcessingRuleRecurrenceTimes(raw)
+
+		daysOfWeek := make([]alertprocessingrules.DaysOfWeek, 0)
+		for _, day := range raw["days_of_week"].([]interface{}) {
+			daysOfWeek = append(daysOfWeek, alertprocessingrules.DaysOfWeek(day.(string)))
+		}
+
+		recurrences = append(recurrences, alertprocessingrules.WeeklyRecurrence{
+			DaysOfWeek: daysOfWeek,
+			StartTime:  startTime,
+			EndTime:    endTime,
+		})
+	}
+
+	for _, item := range v["monthly"].([]interface{}) {
+		raw := item.(map[string]interface{})
+		startTime, endTime := expandAlertProcessingRuleRecurrenceTimes(raw)
+
+		daysOfMonth := make([]int64, 0)
+		for _, day := range raw["days_of_month"].([]interface{}) {
+			daysOfMonth = append(daysOfMonth, int64(day.(int)))
+		}
+
+		recurrences = append(recurrences, alertprocessingrules.MonthlyRecurrence{
+			DaysOfMonth: daysOfMonth,
+			StartTime:   startTime,
+			EndTime:     endTime,
+		})
+	}
+
+	return &recurrences
+}
+
+func expandAlertProcessingRuleRecurrenceTimes(input map[string]interface{}) (*string, *string) {
+	var startTime, endTime *string
+	if v := input["start_time"].(string); v != "" {
+		startTime = utils.String(v)
+	}
+	if v := input["end_time"].(string); v != "" {
+		endTime = utils.String(v)
+	}
+	return startTime, endTime
+}
+
+func flattenAlertProcessingRuleConditions(input *[]alertprocessingrules.Condition) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return make([]interface{}, 0)
+	}
+
+	result := make(map[string]interface{})
+	for _, field := range alertProcessingRuleConditionFields {
+		result[field.key] = make([]interface{}, 0)
+	}
+
+	for _, condition := range *input {
+		if condition.Field == nil {
+			continue
+		}
+
+		key := ""
+		for _, field := range alertProcessingRuleConditionFields {
+			if field.field == *condition.Field {
+				key = field.key
+			}
+		}
+		if key == "" {
+			continue
+		}
+
+		operator := ""
+		if condition.Operator != nil {
+			operator = string(*condition.Operator)
+		}
+
+		result[key] = []interface{}{
+			map[string]interface{}{
+				"operator": operator,
+				"values":   utils.FlattenStringSlice(condition.Values),
+			},
+		}
+	}
+
+	return []interface{}{result}
+}
+
+func flattenAlertProcessingRuleSchedule(input *alertprocessingrules.Schedule) []interface{} {
+	if input == nil {
+		return make([]interface{}, 0)
+	}
+
+	effectiveFrom := ""
+	if input.EffectiveFrom != nil {
+		effectiveFrom = *input.EffectiveFrom
+	}
+
+	effectiveUntil := ""
+	if input.EffectiveUntil != nil {
+		effectiveUntil = *input.EffectiveUntil
+	}
+
+	timeZone := ""
+	if input.TimeZone != nil {
+		timeZone = *input.TimeZone
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"effective_from":  effectiveFrom,
+			"effective_until": effectiveUntil,
+			"time_zone":       timeZone,
+			"recurrence":      flattenAlertProcessingRuleScheduleRecurrences(input.Recurrences),
+		},
+	}
+}
+
+func flattenAlertProcessingRuleScheduleRecurrences(input *[]alertprocessingrules.Recurrence) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return make([]interface{}, 0)
+	}
+
+	daily := make([]interface{}, 0)
+	weekly := make([]interface{}, 0)
+	monthly := make([]interface{}, 0)
+
+	for _, item := range *input {
+		switch recurrence := item.(type) {
+		case alertprocessingrules.DailyRecurrence:
+			daily = append(daily, map[string]interface{}{
+				"start_time": flattenAlertProcessingRuleRecurrenceTime(recurrence.StartTime),
+				"end_time":   flattenAlertProcessingRuleRecurrenceTime(recurrence.EndTime),
+			})
+
+		case alertprocessingrules.WeeklyRecurrence:
+			daysOfWeek := make([]interface{}, 0)
+			for _, day := range recurrence.DaysOfWeek {
+				daysOfWeek = append(daysOfWeek, string(day))
+			}
+			weekly = append(weekly, map[string]interface{}{
+				"days_of_week": daysOfWeek,
+				"start_time":   flattenAlertProcessingRuleRecurrenceTime(recurrence.StartTime),
+				"end_time":     flattenAlertProcessingRuleRecurrenceTime(recurrence.EndTime),
+			})
+
+		case alertprocessingrules.MonthlyRecurrence:
+			daysOfMonth := make([]interface{}, 0)
+			for _, day := range recurrence.DaysOfMonth {
+				daysOfMonth = append(daysOfMonth, int(day))
+			}
+			monthly = append(monthly, map[string]interface{}{
+				"days_of_month": daysOfMonth,
+				"start_time":    flattenAlertProcessingRuleRecurrenceTime(recurrence.StartTime),
+				"end_time":      flattenAlertProcessingRuleRecurrenceTime(recurrence.EndTime),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"daily":   daily,
+			"weekly":  weekly,
+			"monthly": monthly,
+		},
+	}
+}
+
+func flattenAlertProcessingRuleRecurrenceTime(input *string) string {
+	if input == nil {
+		return ""
+	}
+	return *input
+}
+
+func importMonitorAlertProcessingRule(actionType alertprocessingrules.ActionType) pluginsdk.ImporterFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) (data []*pluginsdk.ResourceData, err error) {
+		id, err := alertprocessingrules.ParseActionRuleID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		client := meta.(*clients.Client).Monitor.AlertProcessingRulesClient
+
+		resp, err := client.AlertProcessingRulesGetByName(ctx, *id)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil {
+			return nil, fmt.Errorf("retrieving %s: `properties` was nil", *id)
+		}
+
+		for _, action := range resp.Model.Properties.Actions {
+			var t alertprocessingrules.ActionType
+			switch action.(type) {
+			case alertprocessingrules.AddActionGroups:
+				t = alertprocessingrules.ActionTypeAddActionGroups
+			case alertprocessingrules.RemoveAllActionGroups:
+				t = alertprocessingrules.ActionTypeRemoveAllActionGroups
+			}
+
+			if t != actionType {
+				return nil, fmt.Errorf("Monitor Alert Processing Rule has mismatched action type, expected: %q, got %q", actionType, t)
+			}
+		}
+
+		return []*pluginsdk.ResourceData{d}, nil
+	}
+}
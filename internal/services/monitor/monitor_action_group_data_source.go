@@ -4,13 +4,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-09-01/actiongroupsapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
-	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func dataSourceMonitorActionGroup() *pluginsdk.Resource {
@@ -302,6 +303,38 @@ func dataSourceMonitorActionGroup() *pluginsdk.Resource {
 					},
 				},
 			},
+			"event_hub_receiver": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"event_hub_namespace": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"event_hub_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"subscription_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"use_common_alert_schema": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -314,16 +347,18 @@ func dataSourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{
 
 	id := parse.NewActionGroupID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.ActionGroupsGet(ctx, actiongroupsapis.NewActionGroupID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("%s was not found", id)
 		}
 		return fmt.Errorf("making Read request on %s: %+v", id, err)
 	}
 	d.SetId(id.ID())
 
-	if group := resp.ActionGroup; group != nil {
+	if model := resp.Model; model != nil && model.Properties != nil {
+		group := model.Properties
+
 		d.Set("short_name", group.GroupShortName)
 		d.Set("enabled", group.Enabled)
 
@@ -365,6 +400,10 @@ func dataSourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{
 		if err = d.Set("arm_role_receiver", flattenMonitorActionGroupRoleReceiver(group.ArmRoleReceivers)); err != nil {
 			return fmt.Errorf("setting `arm_role_receiver`: %+v", err)
 		}
+
+		if err = d.Set("event_hub_receiver", flattenMonitorActionGroupEventHubReceiver(group.EventHubReceivers)); err != nil {
+			return fmt.Errorf("setting `event_hub_receiver`: %+v", err)
+		}
 	}
 
 	return nil
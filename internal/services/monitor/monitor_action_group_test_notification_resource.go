@@ -0,0 +1,322 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-09-01/actiongroupsapis"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// the ID of the Test Notification is only returned within the `Location` header of the initial response
+var actionGroupTestNotificationIdRegex = regexp.MustCompile(`(?i)/notificationStatus/([^/?]+)`)
+
+func resourceMonitorActionGroupTestNotification() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorActionGroupTestNotificationCreate,
+		Read:   resourceMonitorActionGroupTestNotificationRead,
+		Delete: resourceMonitorActionGroupTestNotificationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := actiongroupsapis.ParseNotificationStatusID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"action_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ActionGroupID,
+			},
+
+			"alert_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"activitylog",
+					"actualcostbudget",
+					"forecastedbudget",
+					"logalertv1metricmeasurement",
+					"logalertv1numresult",
+					"logalertv2",
+					"metricsdynamicthreshold",
+					"metricstaticthreshold",
+					"resourcehealth",
+					"servicehealth",
+					"smartalert",
+					"webtestalert",
+				}, false),
+			},
+
+			// used to send a new Test Notification without changing the Action Group or the Alert Type
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"created_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"completed_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"action_detail": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mechanism_type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"sub_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"send_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"detail": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMonitorActionGroupTestNotificationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ActionGroupID(d.Get("action_group_id").(string))
+	if err != nil {
+		return err
+	}
+	actionGroupId := actiongroupsapis.NewActionGroupID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	existing, err := client.ActionGroupsGet(ctx, actionGroupId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+	group := existing.Model.Properties
+
+	// the Test Notification is sent to each of the Receivers currently configured on the Action Group
+	payload := actiongroupsapis.NotificationRequestBody{
+		AlertType:                  d.Get("alert_type").(string),
+		ArmRoleReceivers:           group.ArmRoleReceivers,
+		AutomationRunbookReceivers: group.AutomationRunbookReceivers,
+		AzureAppPushReceivers:      group.AzureAppPushReceivers,
+		AzureFunctionReceivers:     group.AzureFunctionReceivers,
+		EmailReceivers:             group.EmailReceivers,
+		EventHubReceivers:          group.EventHubReceivers,
+		ItsmReceivers:              group.ItsmReceivers,
+		LogicAppReceivers:          group.LogicAppReceivers,
+		SmsReceivers:               group.SmsReceivers,
+		VoiceReceivers:             group.VoiceReceivers,
+		WebhookReceivers:           group.WebhookReceivers,
+	}
+
+	resp, err := client.CreateNotificationsAtActionGroupResourceLevel(ctx, actionGroupId, payload)
+	if err != nil {
+		return fmt.Errorf("sending Test Notification for %s: %+v", *id, err)
+	}
+
+	if resp.HttpResponse == nil {
+		return fmt.Errorf("sending Test Notification for %s: response was nil", *id)
+	}
+	matches := actionGroupTestNotificationIdRegex.FindStringSubmatch(resp.HttpResponse.Header.Get("Location"))
+	if len(matches) != 2 {
+		return fmt.Errorf("sending Test Notification for %s: unable to determine the Notification ID from the `Location` header %q", *id, resp.HttpResponse.Header.Get("Location"))
+	}
+
+	notificationId := actiongroupsapis.NewNotificationStatusID(id.SubscriptionId, id.ResourceGroup, id.Name, matches[1])
+
+	log.Printf("[DEBUG] Waiting for %s to complete", notificationId)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"InProgress"},
+		Target:     []string{"Completed"},
+		Refresh:    monitorActionGroupTestNotificationRefreshFunc(ctx, client, notificationId),
+		MinTimeout: 15 * time.Second,
+		Timeout:    d.Timeout(pluginsdk.TimeoutCreate),
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", notificationId, err)
+	}
+
+	d.SetId(notificationId.ID())
+
+	return resourceMonitorActionGroupTestNotificationRead(d, meta)
+}
+
+func resourceMonitorActionGroupTestNotificationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.ActionGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := actiongroupsapis.ParseNotificationStatusID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetTestNotificationsAtActionGroupResourceLevel(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("action_group_id", parse.NewActionGroupID(id.SubscriptionId, id.ResourceGroupName, id.ActionGroupName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("state", model.State)
+
+		createdTime := ""
+		if model.CreatedTime != nil {
+			createdTime = *model.CreatedTime
+		}
+		d.Set("created_time", createdTime)
+
+		completedTime := ""
+		if model.CompletedTime != nil {
+			completedTime = *model.CompletedTime
+		}
+		d.Set("completed_time", completedTime)
+
+		if err := d.Set("action_detail", flattenMonitorActionGroupTestNotificationActionDetails(model.ActionDetails)); err != nil {
+			return fmt.Errorf("setting `action_detail`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorActionGroupTestNotificationDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	id, err := actiongroupsapis.ParseNotificationStatusID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// a Test Notification can't be recalled once it's been sent, so this only removes it from the state
+	log.Printf("[DEBUG] Removing %s from the state", *id)
+
+	return nil
+}
+
+func monitorActionGroupTestNotificationRefreshFunc(ctx context.Context, client *actiongroupsapis.ActionGroupsAPIsClient, id actiongroupsapis.NotificationStatusId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetTestNotificationsAtActionGroupResourceLevel(ctx, id)
+		if err != nil {
+			// the status of the Test Notification can take a few seconds to become available
+			if response.WasNotFound(resp.HttpResponse) {
+				return resp, "InProgress", nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.CompletedTime == nil || *resp.Model.CompletedTime == "" {
+			return resp, "InProgress", nil
+		}
+
+		return resp, "Completed", nil
+	}
+}
+
+func flattenMonitorActionGroupTestNotificationActionDetails(input *[]actiongroupsapis.ActionDetail) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		mechanismType := ""
+		if item.MechanismType != nil {
+			mechanismType = *item.MechanismType
+		}
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		status := ""
+		if item.Status != nil {
+			status = *item.Status
+		}
+
+		subState := ""
+		if item.SubState != nil {
+			subState = *item.SubState
+		}
+
+		sendTime := ""
+		if item.SendTime != nil {
+			sendTime = *item.SendTime
+		}
+
+		detail := ""
+		if item.Detail != nil {
+			detail = *item.Detail
+		}
+
+		results = append(results, map[string]interface{}{
+			"mechanism_type": mechanismType,
+			"name":           name,
+			"status":         status,
+			"sub_state":      subState,
+			"send_time":      sendTime,
+			"detail":         detail,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,407 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2021-07-01/compute"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machineextensions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	monitorAgentPublisher        = "Microsoft.Azure.Monitor"
+	monitorAgentLinuxExtension   = "AzureMonitorLinuxAgent"
+	monitorAgentWindowsExtension = "AzureMonitorWindowsAgent"
+)
+
+// monitorAgentTarget is the machine the Azure Monitor Agent is installed on, which is either
+// a Virtual Machine, a Virtual Machine Scale Set or an Arc enabled Machine
+type monitorAgentTarget struct {
+	virtualMachineId         *computeParse.VirtualMachineId
+	virtualMachineScaleSetId *computeParse.VirtualMachineScaleSetId
+	machineId                *machines.MachineId
+}
+
+// monitorAgentTargetDetails are the properties of the target machine which are required to install the agent
+type monitorAgentTargetDetails struct {
+	location                 string
+	osType                   string
+	systemAssignedIdentity   bool
+	userAssignedIdentityIds  []string
+	supportsUserAssignedAuth bool
+}
+
+// monitorAgentExtension is the subset of the extension properties which are managed by this provider
+type monitorAgentExtension struct {
+	typeHandlerVersion      string
+	autoUpgradeMinorVersion bool
+	automaticUpgradeEnabled bool
+	userAssignedIdentityId  string
+}
+
+func parseMonitorAgentTarget(input string) (*monitorAgentTarget, error) {
+	if id, err := computeParse.VirtualMachineID(input); err == nil {
+		return &monitorAgentTarget{virtualMachineId: id}, nil
+	}
+	if id, err := computeParse.VirtualMachineScaleSetID(input); err == nil {
+		return &monitorAgentTarget{virtualMachineScaleSetId: id}, nil
+	}
+	if id, err := machines.ParseMachineIDInsensitively(input); err == nil {
+		return &monitorAgentTarget{machineId: id}, nil
+	}
+
+	return nil, fmt.Errorf("%q is not a Virtual Machine, Virtual Machine Scale Set or Arc Machine ID", input)
+}
+
+// parseMonitorAgentAssociationID parses the ID of the Azure Monitor Agent extension into the target machine and the extension name
+func parseMonitorAgentAssociationID(input string) (*monitorAgentTarget, string, error) {
+	if id, err := computeParse.VirtualMachineExtensionID(input); err == nil {
+		vmId := computeParse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName)
+		return &monitorAgentTarget{virtualMachineId: &vmId}, id.ExtensionName, nil
+	}
+	if id, err := computeParse.VirtualMachineScaleSetExtensionID(input); err == nil {
+		vmssId := computeParse.NewVirtualMachineScaleSetID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName)
+		return &monitorAgentTarget{virtualMachineScaleSetId: &vmssId}, id.ExtensionName, nil
+	}
+	if id, err := machineextensions.ParseExtensionIDInsensitively(input); err == nil {
+		machineId := machines.NewMachineID(id.SubscriptionId, id.ResourceGroupName, id.MachineName)
+		return &monitorAgentTarget{machineId: &machineId}, id.ExtensionName, nil
+	}
+
+	return nil, "", fmt.Errorf("%q is not a Virtual Machine, Virtual Machine Scale Set or Arc Machine Extension ID", input)
+}
+
+func monitorAgentExtensionName(osType string) string {
+	if strings.EqualFold(osType, string(compute.OperatingSystemTypesWindows)) {
+		return monitorAgentWindowsExtension
+	}
+	return monitorAgentLinuxExtension
+}
+
+func (t monitorAgentTarget) ID() string {
+	switch {
+	case t.virtualMachineId != nil:
+		return t.virtualMachineId.ID()
+	case t.virtualMachineScaleSetId != nil:
+		return t.virtualMachineScaleSetId.ID()
+	default:
+		return t.machineId.ID()
+	}
+}
+
+func (t monitorAgentTarget) extensionID(name string) string {
+	switch {
+	case t.virtualMachineId != nil:
+		return computeParse.NewVirtualMachineExtensionID(t.virtualMachineId.SubscriptionId, t.virtualMachineId.ResourceGroup, t.virtualMachineId.Name, name).ID()
+	case t.virtualMachineScaleSetId != nil:
+		return computeParse.NewVirtualMachineScaleSetExtensionID(t.virtualMachineScaleSetId.SubscriptionId, t.virtualMachineScaleSetId.ResourceGroup, t.virtualMachineScaleSetId.Name, name).ID()
+	default:
+		return machineextensions.NewExtensionID(t.machineId.SubscriptionId, t.machineId.ResourceGroupName, t.machineId.MachineName, name).ID()
+	}
+}
+
+func (t monitorAgentTarget) String() string {
+	switch {
+	case t.virtualMachineId != nil:
+		return fmt.Sprintf("Virtual Machine %q (Resource Group %q)", t.virtualMachineId.Name, t.virtualMachineId.ResourceGroup)
+	case t.virtualMachineScaleSetId != nil:
+		return fmt.Sprintf("Virtual Machine Scale Set %q (Resource Group %q)", t.virtualMachineScaleSetId.Name, t.virtualMachineScaleSetId.ResourceGroup)
+	default:
+		return t.machineId.String()
+	}
+}
+
+// details retrieves the location, operating system and identities of the target machine, returning nil if it doesn't exist
+func (t monitorAgentTarget) details(ctx context.Context, meta interface{}) (*monitorAgentTargetDetails, error) {
+	switch {
+	case t.virtualMachineId != nil:
+		client := meta.(*clients.Client).Compute.VMClient
+		resp, err := client.Get(ctx, t.virtualMachineId.ResourceGroup, t.virtualMachineId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", t, err)
+		}
+
+		out := monitorAgentTargetDetails{
+			location:                 location.NormalizeNilable(resp.Location),
+			supportsUserAssignedAuth: true,
+		}
+		if props := resp.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+			out.osType = string(props.StorageProfile.OsDisk.OsType)
+		}
+		if identity := resp.Identity; identity != nil {
+			out.systemAssignedIdentity = identity.Type == compute.ResourceIdentityTypeSystemAssigned || identity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned
+			for k := range identity.UserAssignedIdentities {
+				out.userAssignedIdentityIds = append(out.userAssignedIdentityIds, k)
+			}
+		}
+		return &out, nil
+
+	case t.virtualMachineScaleSetId != nil:
+		client := meta.(*clients.Client).Compute.VMScaleSetClient
+		resp, err := client.Get(ctx, t.virtualMachineScaleSetId.ResourceGroup, t.virtualMachineScaleSetId.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", t, err)
+		}
+
+		out := monitorAgentTargetDetails{
+			location:                 location.NormalizeNilable(resp.Location),
+			supportsUserAssignedAuth: true,
+		}
+		if props := resp.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil {
+			if storage := props.VirtualMachineProfile.StorageProfile; storage != nil && storage.OsDisk != nil {
+				out.osType = string(storage.OsDisk.OsType)
+			}
+		}
+		if identity := resp.Identity; identity != nil {
+			out.systemAssignedIdentity = identity.Type == compute.ResourceIdentityTypeSystemAssigned || identity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned
+			for k := range identity.UserAssignedIdentities {
+				out.userAssignedIdentityIds = append(out.userAssignedIdentityIds, k)
+			}
+		}
+		return &out, nil
+
+	default:
+		client := meta.(*clients.Client).HybridCompute.MachinesClient
+		resp, err := client.Get(ctx, *t.machineId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", t, err)
+		}
+
+		// Arc enabled Machines always authenticate using the System Assigned Identity of the Connected Machine Agent
+		out := monitorAgentTargetDetails{
+			systemAssignedIdentity: true,
+		}
+		if model := resp.Model; model != nil {
+			out.location = location.Normalize(model.Location)
+			if props := model.Properties; props != nil && props.OsType != nil {
+				out.osType = *props.OsType
+			}
+		}
+		return &out, nil
+	}
+}
+
+// validateIdentity ensures the target machine has an identity which the agent is able to authenticate with
+func (d monitorAgentTargetDetails) validateIdentity(target monitorAgentTarget, userAssignedIdentityId string) error {
+	if userAssignedIdentityId == "" {
+		if !d.systemAssignedIdentity {
+			return fmt.Errorf("%s must have a System Assigned Identity enabled when `user_assigned_identity_id` is not specified", target)
+		}
+		return nil
+	}
+
+	if !d.supportsUserAssignedAuth {
+		return fmt.Errorf("`user_assigned_identity_id` is not supported for %s", target)
+	}
+
+	for _, v := range d.userAssignedIdentityIds {
+		if strings.EqualFold(v, userAssignedIdentityId) {
+			return nil
+		}
+	}
+	return fmt.Errorf("the User Assigned Identity %q must be assigned to %s", userAssignedIdentityId, target)
+}
+
+func (t monitorAgentTarget) createOrUpdateExtension(ctx context.Context, meta interface{}, name string, details monitorAgentTargetDetails, input monitorAgentExtension) error {
+	extensionType := name
+	settings := expandMonitorAgentExtensionSettings(input.userAssignedIdentityId)
+
+	switch {
+	case t.virtualMachineId != nil:
+		client := meta.(*clients.Client).Compute.VMExtensionClient
+		extension := compute.VirtualMachineExtension{
+			Location: utils.String(details.location),
+			VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+				Publisher:               utils.String(monitorAgentPublisher),
+				Type:                    utils.String(extensionType),
+				TypeHandlerVersion:      utils.String(input.typeHandlerVersion),
+				AutoUpgradeMinorVersion: utils.Bool(input.autoUpgradeMinorVersion),
+				EnableAutomaticUpgrade:  utils.Bool(input.automaticUpgradeEnabled),
+				Settings:                settings,
+			},
+		}
+		future, err := client.CreateOrUpdate(ctx, t.virtualMachineId.ResourceGroup, t.virtualMachineId.Name, name, extension)
+		if err != nil {
+			return err
+		}
+		return future.WaitForCompletionRef(ctx, client.Client)
+
+	case t.virtualMachineScaleSetId != nil:
+		client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+		extension := compute.VirtualMachineScaleSetExtension{
+			Name: utils.String(name),
+			VirtualMachineScaleSetExtensionProperties: &compute.VirtualMachineScaleSetExtensionProperties{
+				Publisher:               utils.String(monitorAgentPublisher),
+				Type:                    utils.String(extensionType),
+				TypeHandlerVersion:      utils.String(input.typeHandlerVersion),
+				AutoUpgradeMinorVersion: utils.Bool(input.autoUpgradeMinorVersion),
+				EnableAutomaticUpgrade:  utils.Bool(input.automaticUpgradeEnabled),
+				Settings:                settings,
+			},
+		}
+		future, err := client.CreateOrUpdate(ctx, t.virtualMachineScaleSetId.ResourceGroup, t.virtualMachineScaleSetId.Name, name, extension)
+		if err != nil {
+			return err
+		}
+		return future.WaitForCompletionRef(ctx, client.Client)
+
+	default:
+		client := meta.(*clients.Client).HybridCompute.MachineExtensionsClient
+		var extensionSettings interface{} = settings
+		extension := machineextensions.MachineExtension{
+			Location: details.location,
+			Properties: &machineextensions.MachineExtensionProperties{
+				Publisher:               utils.String(monitorAgentPublisher),
+				Type:                    utils.String(extensionType),
+				TypeHandlerVersion:      utils.String(input.typeHandlerVersion),
+				AutoUpgradeMinorVersion: utils.Bool(input.autoUpgradeMinorVersion),
+				EnableAutomaticUpgrade:  utils.Bool(input.automaticUpgradeEnabled),
+				Settings:                &extensionSettings,
+			},
+		}
+		id := machineextensions.NewExtensionID(t.machineId.SubscriptionId, t.machineId.ResourceGroupName, t.machineId.MachineName, name)
+		return client.CreateOrUpdateThenPoll(ctx, id, extension)
+	}
+}
+
+// getExtension retrieves the Azure Monitor Agent extension, returning nil if it doesn't exist
+func (t monitorAgentTarget) getExtension(ctx context.Context, meta interface{}, name string) (*monitorAgentExtension, error) {
+	switch {
+	case t.virtualMachineId != nil:
+		client := meta.(*clients.Client).Compute.VMExtensionClient
+		resp, err := client.Get(ctx, t.virtualMachineId.ResourceGroup, t.virtualMachineId.Name, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		out := monitorAgentExtension{}
+		if props := resp.VirtualMachineExtensionProperties; props != nil {
+			out.typeHandlerVersion = utils.NormalizeNilableString(props.TypeHandlerVersion)
+			out.autoUpgradeMinorVersion = props.AutoUpgradeMinorVersion != nil && *props.AutoUpgradeMinorVersion
+			out.automaticUpgradeEnabled = props.EnableAutomaticUpgrade != nil && *props.EnableAutomaticUpgrade
+			out.userAssignedIdentityId = flattenMonitorAgentExtensionSettings(props.Settings)
+		}
+		return &out, nil
+
+	case t.virtualMachineScaleSetId != nil:
+		client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+		resp, err := client.Get(ctx, t.virtualMachineScaleSetId.ResourceGroup, t.virtualMachineScaleSetId.Name, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		out := monitorAgentExtension{}
+		if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil {
+			out.typeHandlerVersion = utils.NormalizeNilableString(props.TypeHandlerVersion)
+			out.autoUpgradeMinorVersion = props.AutoUpgradeMinorVersion != nil && *props.AutoUpgradeMinorVersion
+			out.automaticUpgradeEnabled = props.EnableAutomaticUpgrade != nil && *props.EnableAutomaticUpgrade
+			out.userAssignedIdentityId = flattenMonitorAgentExtensionSettings(props.Settings)
+		}
+		return &out, nil
+
+	default:
+		client := meta.(*clients.Client).HybridCompute.MachineExtensionsClient
+		id := machineextensions.NewExtensionID(t.machineId.SubscriptionId, t.machineId.ResourceGroupName, t.machineId.MachineName, name)
+		resp, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		out := monitorAgentExtension{}
+		if model := resp.Model; model != nil && model.Properties != nil {
+			props := model.Properties
+			out.typeHandlerVersion = utils.NormalizeNilableString(props.TypeHandlerVersion)
+			out.autoUpgradeMinorVersion = props.AutoUpgradeMinorVersion != nil && *props.AutoUpgradeMinorVersion
+			out.automaticUpgradeEnabled = props.EnableAutomaticUpgrade != nil && *props.EnableAutomaticUpgrade
+			if props.Settings != nil {
+				out.userAssignedIdentityId = flattenMonitorAgentExtensionSettings(*props.Settings)
+			}
+		}
+		return &out, nil
+	}
+}
+
+func (t monitorAgentTarget) deleteExtension(ctx context.Context, meta interface{}, name string) error {
+	switch {
+	case t.virtualMachineId != nil:
+		client := meta.(*clients.Client).Compute.VMExtensionClient
+		future, err := client.Delete(ctx, t.virtualMachineId.ResourceGroup, t.virtualMachineId.Name, name)
+		if err != nil {
+			return err
+		}
+		return future.WaitForCompletionRef(ctx, client.Client)
+
+	case t.virtualMachineScaleSetId != nil:
+		client := meta.(*clients.Client).Compute.VMScaleSetExtensionsClient
+		future, err := client.Delete(ctx, t.virtualMachineScaleSetId.ResourceGroup, t.virtualMachineScaleSetId.Name, name)
+		if err != nil {
+			return err
+		}
+		return future.WaitForCompletionRef(ctx, client.Client)
+
+	default:
+		client := meta.(*clients.Client).HybridCompute.MachineExtensionsClient
+		id := machineextensions.NewExtensionID(t.machineId.SubscriptionId, t.machineId.ResourceGroupName, t.machineId.MachineName, name)
+		return client.DeleteThenPoll(ctx, id)
+	}
+}
+
+func expandMonitorAgentExtensionSettings(userAssignedIdentityId string) map[string]interface{} {
+	if userAssignedIdentityId == "" {
+		return map[string]interface{}{}
+	}
+
+	return map[string]interface{}{
+		"authentication": map[string]interface{}{
+			"managedIdentity": map[string]interface{}{
+				"identifier-name":  "mi_res_id",
+				"identifier-value": userAssignedIdentityId,
+			},
+		},
+	}
+}
+
+func flattenMonitorAgentExtensionSettings(input interface{}) string {
+	settings, ok := input.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	authentication, ok := settings["authentication"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	managedIdentity, ok := authentication["managedIdentity"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if name, ok := managedIdentity["identifier-name"].(string); !ok || name != "mi_res_id" {
+		return ""
+	}
+	value, _ := managedIdentity["identifier-value"].(string)
+	return value
+}
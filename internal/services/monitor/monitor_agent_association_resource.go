@@ -0,0 +1,451 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionruleassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const (
+	// the Data Collection Endpoint association must use this name for the agent to pick up its configuration
+	monitorAgentDataCollectionEndpointAssociationName = "configurationAccessEndpoint"
+
+	monitorAgentDataCollectionRuleAssociationPrefix = "ama-"
+)
+
+func resourceMonitorAgentAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorAgentAssociationCreate,
+		Read:   resourceMonitorAgentAssociationRead,
+		Update: resourceMonitorAgentAssociationUpdate,
+		Delete: resourceMonitorAgentAssociationDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, name, err := parseMonitorAgentAssociationID(id)
+			if err != nil {
+				return err
+			}
+			if name != monitorAgentLinuxExtension && name != monitorAgentWindowsExtension {
+				return fmt.Errorf("expected the extension name to be %q or %q but got %q", monitorAgentLinuxExtension, monitorAgentWindowsExtension, name)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateMonitorAgentTargetID,
+			},
+
+			"data_collection_rule_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.DataCollectionRuleID,
+				},
+			},
+
+			"data_collection_endpoint_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.DataCollectionEndpointID,
+			},
+
+			"type_handler_version": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "1.0",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"auto_upgrade_minor_version_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"automatic_upgrade_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
+
+			"extension_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"os_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMonitorAgentAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	target, err := parseMonitorAgentTarget(d.Get("target_resource_id").(string))
+	if err != nil {
+		return err
+	}
+
+	details, err := target.details(ctx, meta)
+	if err != nil {
+		return err
+	}
+	if details == nil {
+		return fmt.Errorf("%s was not found", *target)
+	}
+
+	extension := monitorAgentExtension{
+		typeHandlerVersion:      d.Get("type_handler_version").(string),
+		autoUpgradeMinorVersion: d.Get("auto_upgrade_minor_version_enabled").(bool),
+		automaticUpgradeEnabled: d.Get("automatic_upgrade_enabled").(bool),
+		userAssignedIdentityId:  d.Get("user_assigned_identity_id").(string),
+	}
+	if err := details.validateIdentity(*target, extension.userAssignedIdentityId); err != nil {
+		return err
+	}
+
+	ruleIds, err := expandMonitorAgentDataCollectionRuleIds(d.Get("data_collection_rule_ids").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	name := monitorAgentExtensionName(details.osType)
+	id := target.extensionID(name)
+
+	existing, err := target.getExtension(ctx, meta, name)
+	if err != nil {
+		return fmt.Errorf("checking for presence of existing Extension %q for %s: %+v", name, *target, err)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsError("azurerm_monitor_agent_association", id)
+	}
+
+	if err := target.createOrUpdateExtension(ctx, meta, name, *details, extension); err != nil {
+		return fmt.Errorf("creating Extension %q for %s: %+v", name, *target, err)
+	}
+
+	// the extension and its associations are created as a single unit, so if any of the associations
+	// can't be created everything which has been created so far is removed again
+	created := make([]datacollectionruleassociations.ScopedDataCollectionRuleAssociationId, 0)
+	rollback := func(err error) error {
+		for _, associationId := range created {
+			if _, deleteErr := client.Delete(ctx, associationId); deleteErr != nil {
+				log.Printf("[WARN] unable to remove %s after a failed creation: %+v", associationId, deleteErr)
+			}
+		}
+		if deleteErr := target.deleteExtension(ctx, meta, name); deleteErr != nil {
+			log.Printf("[WARN] unable to remove Extension %q for %s after a failed creation: %+v", name, *target, deleteErr)
+		}
+		return err
+	}
+
+	for _, ruleId := range ruleIds {
+		associationId, err := createMonitorAgentDataCollectionRuleAssociation(ctx, client, *target, ruleId)
+		if err != nil {
+			return rollback(err)
+		}
+		created = append(created, *associationId)
+	}
+
+	if endpointId := d.Get("data_collection_endpoint_id").(string); endpointId != "" {
+		associationId, err := createMonitorAgentDataCollectionEndpointAssociation(ctx, client, *target, endpointId)
+		if err != nil {
+			return rollback(err)
+		}
+		created = append(created, *associationId)
+	}
+
+	d.SetId(id)
+	return resourceMonitorAgentAssociationRead(d, meta)
+}
+
+func resourceMonitorAgentAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	target, name, err := parseMonitorAgentAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	details, err := target.details(ctx, meta)
+	if err != nil {
+		return err
+	}
+	if details == nil {
+		log.Printf("[INFO] %s does not exist - removing from state", *target)
+		d.SetId("")
+		return nil
+	}
+
+	extension, err := target.getExtension(ctx, meta, name)
+	if err != nil {
+		return fmt.Errorf("retrieving Extension %q for %s: %+v", name, *target, err)
+	}
+	if extension == nil {
+		log.Printf("[INFO] Extension %q for %s does not exist - removing from state", name, *target)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("target_resource_id", target.ID())
+	d.Set("extension_name", name)
+	d.Set("os_type", details.osType)
+	d.Set("type_handler_version", extension.typeHandlerVersion)
+	d.Set("auto_upgrade_minor_version_enabled", extension.autoUpgradeMinorVersion)
+	d.Set("automatic_upgrade_enabled", extension.automaticUpgradeEnabled)
+	d.Set("user_assigned_identity_id", extension.userAssignedIdentityId)
+
+	associations, err := client.ListByResourceComplete(ctx, datacollectionruleassociations.NewScopeID(target.ID()))
+	if err != nil {
+		return fmt.Errorf("listing Data Collection Rule Associations for %s: %+v", *target, err)
+	}
+
+	ruleIds := make([]interface{}, 0)
+	endpointId := ""
+	for _, association := range associations.Items {
+		if association.Name == nil || association.Properties == nil {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(*association.Name, monitorAgentDataCollectionEndpointAssociationName):
+			if v := association.Properties.DataCollectionEndpointId; v != nil {
+				endpointId = *v
+			}
+		case strings.HasPrefix(*association.Name, monitorAgentDataCollectionRuleAssociationPrefix):
+			if v := association.Properties.DataCollectionRuleId; v != nil {
+				ruleIds = append(ruleIds, *v)
+			}
+		}
+	}
+
+	if err := d.Set("data_collection_rule_ids", ruleIds); err != nil {
+		return fmt.Errorf("setting `data_collection_rule_ids`: %+v", err)
+	}
+	d.Set("data_collection_endpoint_id", endpointId)
+
+	return nil
+}
+
+func resourceMonitorAgentAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	target, name, err := parseMonitorAgentAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("type_handler_version", "auto_upgrade_minor_version_enabled", "automatic_upgrade_enabled", "user_assigned_identity_id") {
+		details, err := target.details(ctx, meta)
+		if err != nil {
+			return err
+		}
+		if details == nil {
+			return fmt.Errorf("%s was not found", *target)
+		}
+
+		extension := monitorAgentExtension{
+			typeHandlerVersion:      d.Get("type_handler_version").(string),
+			autoUpgradeMinorVersion: d.Get("auto_upgrade_minor_version_enabled").(bool),
+			automaticUpgradeEnabled: d.Get("automatic_upgrade_enabled").(bool),
+			userAssignedIdentityId:  d.Get("user_assigned_identity_id").(string),
+		}
+		if err := details.validateIdentity(*target, extension.userAssignedIdentityId); err != nil {
+			return err
+		}
+
+		if err := target.createOrUpdateExtension(ctx, meta, name, *details, extension); err != nil {
+			return fmt.Errorf("updating Extension %q for %s: %+v", name, *target, err)
+		}
+	}
+
+	if d.HasChange("data_collection_rule_ids") {
+		oldRaw, newRaw := d.GetChange("data_collection_rule_ids")
+		toRemove := oldRaw.(*pluginsdk.Set).Difference(newRaw.(*pluginsdk.Set)).List()
+		toAdd := newRaw.(*pluginsdk.Set).Difference(oldRaw.(*pluginsdk.Set)).List()
+
+		if _, err := expandMonitorAgentDataCollectionRuleIds(newRaw.(*pluginsdk.Set).List()); err != nil {
+			return err
+		}
+
+		removeIds, err := expandMonitorAgentDataCollectionRuleIds(toRemove)
+		if err != nil {
+			return err
+		}
+		for _, ruleId := range removeIds {
+			associationId := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(target.ID(), monitorAgentDataCollectionRuleAssociationName(ruleId))
+			if _, err := client.Delete(ctx, associationId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", associationId, err)
+			}
+		}
+
+		addIds, err := expandMonitorAgentDataCollectionRuleIds(toAdd)
+		if err != nil {
+			return err
+		}
+		for _, ruleId := range addIds {
+			if _, err := createMonitorAgentDataCollectionRuleAssociation(ctx, client, *target, ruleId); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("data_collection_endpoint_id") {
+		if endpointId := d.Get("data_collection_endpoint_id").(string); endpointId != "" {
+			if _, err := createMonitorAgentDataCollectionEndpointAssociation(ctx, client, *target, endpointId); err != nil {
+				return err
+			}
+		} else {
+			associationId := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(target.ID(), monitorAgentDataCollectionEndpointAssociationName)
+			if _, err := client.Delete(ctx, associationId); err != nil {
+				return fmt.Errorf("deleting %s: %+v", associationId, err)
+			}
+		}
+	}
+
+	return resourceMonitorAgentAssociationRead(d, meta)
+}
+
+func resourceMonitorAgentAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DataCollectionRuleAssociationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	target, name, err := parseMonitorAgentAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the associations are removed before the agent so that no data is collected from a partially configured machine
+	ruleIds, err := expandMonitorAgentDataCollectionRuleIds(d.Get("data_collection_rule_ids").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+	for _, ruleId := range ruleIds {
+		associationId := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(target.ID(), monitorAgentDataCollectionRuleAssociationName(ruleId))
+		if resp, err := client.Delete(ctx, associationId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", associationId, err)
+		}
+	}
+
+	if d.Get("data_collection_endpoint_id").(string) != "" {
+		associationId := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(target.ID(), monitorAgentDataCollectionEndpointAssociationName)
+		if resp, err := client.Delete(ctx, associationId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", associationId, err)
+		}
+	}
+
+	if err := target.deleteExtension(ctx, meta, name); err != nil {
+		return fmt.Errorf("deleting Extension %q for %s: %+v", name, *target, err)
+	}
+
+	return nil
+}
+
+func validateMonitorAgentTargetID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parseMonitorAgentTarget(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %+v", k, err))
+	}
+
+	return
+}
+
+// expandMonitorAgentDataCollectionRuleIds parses the Data Collection Rule IDs, ensuring that each of them results
+// in a unique association name since this is derived from the name of the Data Collection Rule
+func expandMonitorAgentDataCollectionRuleIds(input []interface{}) ([]parse.DataCollectionRuleId, error) {
+	out := make([]parse.DataCollectionRuleId, 0)
+	names := make(map[string]string)
+	for _, v := range input {
+		id, err := parse.DataCollectionRuleID(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.ToLower(monitorAgentDataCollectionRuleAssociationName(*id))
+		if existing, ok := names[name]; ok {
+			return nil, fmt.Errorf("the Data Collection Rules %q and %q must have different names", existing, id.ID())
+		}
+		names[name] = id.ID()
+
+		out = append(out, *id)
+	}
+	return out, nil
+}
+
+func monitorAgentDataCollectionRuleAssociationName(id parse.DataCollectionRuleId) string {
+	return monitorAgentDataCollectionRuleAssociationPrefix + id.Name
+}
+
+func createMonitorAgentDataCollectionRuleAssociation(ctx context.Context, client *datacollectionruleassociations.DataCollectionRuleAssociationsClient, target monitorAgentTarget, ruleId parse.DataCollectionRuleId) (*datacollectionruleassociations.ScopedDataCollectionRuleAssociationId, error) {
+	id := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(target.ID(), monitorAgentDataCollectionRuleAssociationName(ruleId))
+	association := datacollectionruleassociations.DataCollectionRuleAssociationProxyOnlyResource{
+		Properties: &datacollectionruleassociations.DataCollectionRuleAssociation{
+			DataCollectionRuleId: utils.String(ruleId.ID()),
+		},
+	}
+	if _, err := client.Create(ctx, id, association); err != nil {
+		return nil, fmt.Errorf("creating %s: %+v", id, err)
+	}
+	return &id, nil
+}
+
+func createMonitorAgentDataCollectionEndpointAssociation(ctx context.Context, client *datacollectionruleassociations.DataCollectionRuleAssociationsClient, target monitorAgentTarget, endpointId string) (*datacollectionruleassociations.ScopedDataCollectionRuleAssociationId, error) {
+	id := datacollectionruleassociations.NewScopedDataCollectionRuleAssociationID(target.ID(), monitorAgentDataCollectionEndpointAssociationName)
+	association := datacollectionruleassociations.DataCollectionRuleAssociationProxyOnlyResource{
+		Properties: &datacollectionruleassociations.DataCollectionRuleAssociation{
+			DataCollectionEndpointId: utils.String(endpointId),
+		},
+	}
+	if _, err := client.Create(ctx, id, association); err != nil {
+		return nil, fmt.Errorf("creating %s: %+v", id, err)
+	}
+	return &id, nil
+}
@@ -0,0 +1,309 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	computeParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorAgentAssociationResource struct{}
+
+func TestAccMonitorAgentAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_agent_association", "test")
+	r := MonitorAgentAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("extension_name").HasValue("AzureMonitorLinuxAgent"),
+				check.That(data.ResourceName).Key("os_type").HasValue("Linux"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAgentAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_agent_association", "test")
+	r := MonitorAgentAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorAgentAssociation_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_agent_association", "test")
+	r := MonitorAgentAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_collection_rule_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAgentAssociation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_agent_association", "test")
+	r := MonitorAgentAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_collection_endpoint_id").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MonitorAgentAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := computeParse.VirtualMachineExtensionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.VMExtensionClient.Get(ctx, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MonitorAgentAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_agent_association" "test" {
+  target_resource_id       = azurerm_linux_virtual_machine.test.id
+  data_collection_rule_ids = [jsondecode(azurerm_resource_group_template_deployment.rule[0].output_content).id.value]
+}
+`, r.template(data))
+}
+
+func (r MonitorAgentAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_agent_association" "import" {
+  target_resource_id       = azurerm_monitor_agent_association.test.target_resource_id
+  data_collection_rule_ids = azurerm_monitor_agent_association.test.data_collection_rule_ids
+}
+`, r.basic(data))
+}
+
+func (r MonitorAgentAssociationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_template_deployment" "endpoint" {
+  name                = "acctest-dce-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Insights/dataCollectionEndpoints",
+      "apiVersion": "2021-09-01-preview",
+      "name": "acctest-dce-%[2]d",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "networkAcls": {
+          "publicNetworkAccess": "Enabled"
+        }
+      }
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "String",
+      "value": "[resourceId('Microsoft.Insights/dataCollectionEndpoints', 'acctest-dce-%[2]d')]"
+    }
+  }
+}
+TEMPLATE
+}
+
+resource "azurerm_monitor_agent_association" "test" {
+  target_resource_id                 = azurerm_linux_virtual_machine.test.id
+  data_collection_rule_ids           = [for d in azurerm_resource_group_template_deployment.rule : jsondecode(d.output_content).id.value]
+  data_collection_endpoint_id        = jsondecode(azurerm_resource_group_template_deployment.endpoint.output_content).id.value
+  type_handler_version               = "1.21"
+  auto_upgrade_minor_version_enabled = false
+  automatic_upgrade_enabled          = false
+  user_assigned_identity_id          = azurerm_user_assigned_identity.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (MonitorAgentAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-monitor-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestnw-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestnic-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_linux_virtual_machine" "test" {
+  name                            = "acctestVM-%[1]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  size                            = "Standard_F2"
+  admin_username                  = "adminuser"
+  admin_password                  = "P@$$w0rd1234!"
+  disable_password_authentication = false
+  network_interface_ids = [
+    azurerm_network_interface.test.id,
+  ]
+
+  identity {
+    type = "SystemAssigned, UserAssigned"
+    identity_ids = [
+      azurerm_user_assigned_identity.test.id,
+    ]
+  }
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "18.04-LTS"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_resource_group_template_deployment" "rule" {
+  count               = 2
+  name                = "acctest-dcr-%[1]d-${count.index}"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.Insights/dataCollectionRules",
+      "apiVersion": "2021-09-01-preview",
+      "name": "acctest-dcr-%[1]d-${count.index}",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "dataSources": {
+          "performanceCounters": [
+            {
+              "name": "perfCounters",
+              "streams": ["Microsoft-InsightsMetrics"],
+              "samplingFrequencyInSeconds": 60,
+              "counterSpecifiers": ["Processor(*)\\%% Processor Time"]
+            }
+          ]
+        },
+        "destinations": {
+          "azureMonitorMetrics": {
+            "name": "metrics"
+          }
+        },
+        "dataFlows": [
+          {
+            "streams": ["Microsoft-InsightsMetrics"],
+            "destinations": ["metrics"]
+          }
+        ]
+      }
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "String",
+      "value": "[resourceId('Microsoft.Insights/dataCollectionRules', 'acctest-dcr-%[1]d-${count.index}')]"
+    }
+  }
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,183 @@
+package monitor
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-08-08/alertprocessingrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMonitorAlertProcessingRuleSuppression() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorAlertProcessingRuleSuppressionCreateUpdate,
+		Read:   resourceMonitorAlertProcessingRuleSuppressionRead,
+		Update: resourceMonitorAlertProcessingRuleSuppressionCreateUpdate,
+		Delete: resourceMonitorAlertProcessingRuleSuppressionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := alertprocessingrules.ParseActionRuleID(id)
+			return err
+		}, importMonitorAlertProcessingRule(alertprocessingrules.ActionTypeRemoveAllActionGroups)),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ActionRuleName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"scopes": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"condition": schemaAlertProcessingRuleConditions(),
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"schedule": schemaAlertProcessingRuleSchedule(),
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMonitorAlertProcessingRuleSuppressionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AlertProcessingRulesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := alertprocessingrules.NewActionRuleID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.AlertProcessingRulesGetByName(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_monitor_alert_processing_rule_suppression", id.ID())
+		}
+	}
+
+	alertProcessingRule := alertprocessingrules.AlertProcessingRule{
+		// the location is always global from the portal
+		Location: location.Normalize("Global"),
+		Properties: &alertprocessingrules.AlertProcessingRuleProperties{
+			Actions: []alertprocessingrules.Action{
+				alertprocessingrules.RemoveAllActionGroups{},
+			},
+			Conditions:  expandAlertProcessingRuleConditions(d.Get("condition").([]interface{})),
+			Description: utils.String(d.Get("description").(string)),
+			Enabled:     utils.Bool(d.Get("enabled").(bool)),
+			Schedule:    expandAlertProcessingRuleSchedule(d.Get("schedule").([]interface{})),
+			Scopes:      *utils.ExpandStringSlice(d.Get("scopes").([]interface{})),
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if _, err := client.AlertProcessingRulesCreateOrUpdate(ctx, id, alertProcessingRule); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMonitorAlertProcessingRuleSuppressionRead(d, meta)
+}
+
+func resourceMonitorAlertProcessingRuleSuppressionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AlertProcessingRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := alertprocessingrules.ParseActionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AlertProcessingRulesGetByName(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ActionRuleName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			d.Set("enabled", props.Enabled == nil || *props.Enabled)
+			d.Set("scopes", utils.FlattenStringSlice(&props.Scopes))
+
+			if err := d.Set("condition", flattenAlertProcessingRuleConditions(props.Conditions)); err != nil {
+				return fmt.Errorf("setting `condition`: %+v", err)
+			}
+
+			if err := d.Set("schedule", flattenAlertProcessingRuleSchedule(props.Schedule)); err != nil {
+				return fmt.Errorf("setting `schedule`: %+v", err)
+			}
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
+}
+
+func resourceMonitorAlertProcessingRuleSuppressionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.AlertProcessingRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := alertprocessingrules.ParseActionRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.AlertProcessingRulesDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	return nil
+}
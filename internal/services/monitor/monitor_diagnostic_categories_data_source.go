@@ -2,12 +2,12 @@ package monitor
 
 import (
 	"fmt"
-	"strings"
+	"sort"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-05-01-preview/diagnosticsettingscategories"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
@@ -40,6 +40,37 @@ func dataSourceMonitorDiagnosticCategories() *pluginsdk.Resource {
 				Set:      pluginsdk.HashString,
 				Computed: true,
 			},
+
+			"log_category_groups": {
+				Type:     pluginsdk.TypeSet,
+				Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+				Set:      pluginsdk.HashString,
+				Computed: true,
+			},
+
+			"categories": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"category_groups": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -50,42 +81,60 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 	defer cancel()
 
 	actualResourceId := d.Get("resource_id").(string)
-	// trim off the leading `/` since the CheckExistenceByID / List methods don't expect it
-	resourceId := strings.TrimPrefix(actualResourceId, "/")
+	id := diagnosticsettingscategories.NewScopeID(actualResourceId)
 
 	// then retrieve the possible Diagnostics Categories for this Resource
-	categories, err := categoriesClient.List(ctx, resourceId)
+	categories, err := categoriesClient.DiagnosticSettingsCategoryListComplete(ctx, id)
 	if err != nil {
 		return fmt.Errorf("retrieving Diagnostics Categories for Resource %q: %+v", actualResourceId, err)
 	}
 
-	if categories.Value == nil {
-		return fmt.Errorf("retrieving Diagnostics Categories for Resource %q: `categories.Value` was nil", actualResourceId)
-	}
-
 	d.SetId(actualResourceId)
-	val := *categories.Value
 
 	metrics := make([]string, 0)
 	logs := make([]string, 0)
+	logCategoryGroups := make(map[string]struct{})
+	results := make([]interface{}, 0)
 
-	for _, v := range val {
+	for _, v := range categories.Items {
 		if v.Name == nil {
 			continue
 		}
 
-		if category := v.DiagnosticSettingsCategory; category != nil {
-			switch category.CategoryType {
-			case insights.CategoryTypeLogs:
-				logs = append(logs, *v.Name)
-			case insights.CategoryTypeMetrics:
-				metrics = append(metrics, *v.Name)
-			default:
-				return fmt.Errorf("Unsupported category type %q", string(category.CategoryType))
+		category := v.Properties
+		if category == nil || category.CategoryType == nil {
+			continue
+		}
+
+		groups := make([]string, 0)
+		switch *category.CategoryType {
+		case diagnosticsettingscategories.CategoryTypeLogs:
+			logs = append(logs, *v.Name)
+			if category.CategoryGroups != nil {
+				groups = *category.CategoryGroups
 			}
+			for _, group := range groups {
+				logCategoryGroups[group] = struct{}{}
+			}
+		case diagnosticsettingscategories.CategoryTypeMetrics:
+			metrics = append(metrics, *v.Name)
+		default:
+			return fmt.Errorf("Unsupported category type %q", string(*category.CategoryType))
 		}
+
+		results = append(results, map[string]interface{}{
+			"name":            *v.Name,
+			"type":            string(*category.CategoryType),
+			"category_groups": groups,
+		})
 	}
 
+	groups := make([]string, 0)
+	for k := range logCategoryGroups {
+		groups = append(groups, k)
+	}
+	sort.Strings(groups)
+
 	if err := d.Set("logs", logs); err != nil {
 		return fmt.Errorf("setting `logs`: %+v", err)
 	}
@@ -94,5 +143,13 @@ func dataSourceMonitorDiagnosticCategoriesRead(d *pluginsdk.ResourceData, meta i
 		return fmt.Errorf("setting `metrics`: %+v", err)
 	}
 
+	if err := d.Set("log_category_groups", groups); err != nil {
+		return fmt.Errorf("setting `log_category_groups`: %+v", err)
+	}
+
+	if err := d.Set("categories", results); err != nil {
+		return fmt.Errorf("setting `categories`: %+v", err)
+	}
+
 	return nil
 }
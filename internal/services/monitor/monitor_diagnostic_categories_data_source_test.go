@@ -21,6 +21,8 @@ func TestAccDataSourceMonitorDiagnosticCategories_appService(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("metrics.#").Exists(),
 				check.That(data.ResourceName).Key("logs.#").Exists(),
+				check.That(data.ResourceName).Key("log_category_groups.#").Exists(),
+				check.That(data.ResourceName).Key("categories.#").Exists(),
 			),
 		},
 	})
@@ -36,6 +38,8 @@ func TestAccDataSourceMonitorDiagnosticCategories_storageAccount(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("metrics.#").Exists(),
 				check.That(data.ResourceName).Key("logs.#").Exists(),
+				check.That(data.ResourceName).Key("log_category_groups.#").Exists(),
+				check.That(data.ResourceName).Key("categories.#").Exists(),
 			),
 		},
 	})
@@ -0,0 +1,455 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	authRuleParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/authorizationrulesnamespaces"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceMonitorDiagnosticSettingBatch applies the same Diagnostic Setting to many Target Resources. The Target
+// Resources are reconciled in parallel, and only the Target Resources where the Diagnostic Setting exists and matches
+// the configuration are kept in the state - such that any Target Resources which failed or drifted are re-applied.
+//
+// Since the Target Resources can change, the ID of this resource is a UUID - as such this is imported using an ID in
+// the format `{name}|{targetResourceId},{targetResourceId}`.
+func resourceMonitorDiagnosticSettingBatch() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorDiagnosticSettingBatchCreate,
+		Read:   resourceMonitorDiagnosticSettingBatchRead,
+		Update: resourceMonitorDiagnosticSettingBatchUpdate,
+		Delete: resourceMonitorDiagnosticSettingBatchDelete,
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, _, err := parseMonitorDiagnosticSettingBatchImportId(id)
+			return err
+		}, importMonitorDiagnosticSettingBatch),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MonitorDiagnosticSettingName,
+			},
+
+			"target_resource_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+				Set: pluginsdk.HashString,
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+
+			"eventhub_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: eventhubValidate.ValidateEventHubName(),
+			},
+
+			"eventhub_authorization_rule_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: authRuleParse.ValidateAuthorizationRuleID,
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: logAnalyticsValidate.LogAnalyticsWorkspaceID,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"log_analytics_destination_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Dedicated",
+					"AzureDiagnostics",
+				}, false),
+			},
+
+			"log": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     monitorDiagnosticSettingLogSchema(),
+			},
+
+			"metric": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     monitorDiagnosticSettingMetricSchema(),
+			},
+		},
+	}
+}
+
+func resourceMonitorDiagnosticSettingBatchCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	props, err := expandMonitorDiagnosticSettings(d)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating an ID for the Monitor Diagnostic Setting Batch %q: %+v", name, err)
+	}
+
+	parallelism := d.Get("parallelism").(int)
+	targetResourceIds := d.Get("target_resource_ids").(*pluginsdk.Set)
+	operations := monitorDiagnosticSettingBatchApplyOperations(ctx, client, name, *props, *utils.ExpandStringSlice(targetResourceIds.List()))
+	if failed, errs := runMonitorDiagnosticSettingBatchOperations(operations, parallelism); len(errs) > 0 {
+		// returning an error once the ID is set would taint this resource (re-creating the Diagnostic Setting on every
+		// Target Resource), so instead the Diagnostic Setting is removed from the Target Resources where it was applied
+		succeeded := targetResourceIds.Difference(pluginsdk.NewSet(pluginsdk.HashString, utils.FlattenStringSlice(&failed)))
+		log.Printf("[DEBUG] Removing Monitor Diagnostic Setting %q from the %d Target Resources where it was applied..", name, succeeded.Len())
+		rollback := monitorDiagnosticSettingBatchDeleteOperations(ctx, client, name, *utils.ExpandStringSlice(succeeded.List()), d.Timeout(pluginsdk.TimeoutCreate))
+		if _, rollbackErrs := runMonitorDiagnosticSettingBatchOperations(rollback, parallelism); len(rollbackErrs) > 0 {
+			errs = append(errs, rollbackErrs...)
+		}
+
+		return fmt.Errorf("applying Monitor Diagnostic Setting %q to %d of %d Target Resources:\n%s", name, len(failed), len(operations), strings.Join(errs, "\n"))
+	}
+
+	d.SetId(id)
+
+	return resourceMonitorDiagnosticSettingBatchRead(d, meta)
+}
+
+func resourceMonitorDiagnosticSettingBatchRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	expected, err := expandMonitorDiagnosticSettings(d)
+	if err != nil {
+		return err
+	}
+
+	logs := d.Get("log").(*pluginsdk.Set)
+	metrics := d.Get("metric").(*pluginsdk.Set)
+
+	var mu sync.Mutex
+	inSync := make([]string, 0)
+	operations := make([]monitorDiagnosticSettingBatchOperation, 0)
+	for _, raw := range d.Get("target_resource_ids").(*pluginsdk.Set).List() {
+		targetResourceId := raw.(string)
+		operations = append(operations, newMonitorDiagnosticSettingBatchOperation(targetResourceId, func() error {
+			resp, err := client.Get(ctx, diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, name))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					log.Printf("[DEBUG] Monitor Diagnostic Setting %q was not found for Target Resource %q - removing from state", name, targetResourceId)
+					return nil
+				}
+				return fmt.Errorf("retrieving Monitor Diagnostic Setting %q for Target Resource %q: %+v", name, targetResourceId, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil || !monitorDiagnosticSettingBatchInSync(*expected, *resp.Model.Properties, logs, metrics) {
+				log.Printf("[DEBUG] Monitor Diagnostic Setting %q for Target Resource %q differs from the configuration - removing from state", name, targetResourceId)
+				return nil
+			}
+
+			mu.Lock()
+			inSync = append(inSync, targetResourceId)
+			mu.Unlock()
+			return nil
+		}))
+	}
+
+	if _, errs := runMonitorDiagnosticSettingBatchOperations(operations, d.Get("parallelism").(int)); len(errs) > 0 {
+		return fmt.Errorf("retrieving Monitor Diagnostic Setting %q for %d of %d Target Resources:\n%s", name, len(errs), len(operations), strings.Join(errs, "\n"))
+	}
+
+	if err := d.Set("target_resource_ids", inSync); err != nil {
+		return fmt.Errorf("setting `target_resource_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMonitorDiagnosticSettingBatchUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	props, err := expandMonitorDiagnosticSettings(d)
+	if err != nil {
+		return err
+	}
+
+	oldRaw, newRaw := d.GetChange("target_resource_ids")
+	oldTargets := oldRaw.(*pluginsdk.Set)
+	newTargets := newRaw.(*pluginsdk.Set)
+
+	// when the Diagnostic Setting itself has changed it's re-applied to every Target Resource, otherwise only to
+	// the Target Resources which have been added (or were removed from the state by a refresh)
+	toApply := newTargets.Difference(oldTargets)
+	if d.HasChanges("eventhub_name", "eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "log_analytics_destination_type", "log", "metric") {
+		toApply = newTargets
+	}
+	toRemove := oldTargets.Difference(newTargets)
+
+	operations := monitorDiagnosticSettingBatchApplyOperations(ctx, client, name, *props, *utils.ExpandStringSlice(toApply.List()))
+	operations = append(operations, monitorDiagnosticSettingBatchDeleteOperations(ctx, client, name, *utils.ExpandStringSlice(toRemove.List()), d.Timeout(pluginsdk.TimeoutUpdate))...)
+	if failed, errs := runMonitorDiagnosticSettingBatchOperations(operations, d.Get("parallelism").(int)); len(errs) > 0 {
+		// rather than tainting this resource, only the Target Resources where the Diagnostic Setting is in sync are
+		// kept in the state - such that only the Target Resources which failed are re-applied during the next apply
+		if err := resourceMonitorDiagnosticSettingBatchRead(d, meta); err != nil {
+			return err
+		}
+
+		// the Target Resources where the Diagnostic Setting couldn't be removed are kept, so they're removed next time
+		targets := d.Get("target_resource_ids").(*pluginsdk.Set)
+		for _, v := range failed {
+			if toRemove.Contains(v) {
+				targets.Add(v)
+			}
+		}
+		if err := d.Set("target_resource_ids", targets.List()); err != nil {
+			return fmt.Errorf("setting `target_resource_ids`: %+v", err)
+		}
+
+		return fmt.Errorf("updating Monitor Diagnostic Setting %q for %d of %d Target Resources:\n%s", name, len(failed), len(operations), strings.Join(errs, "\n"))
+	}
+
+	return resourceMonitorDiagnosticSettingBatchRead(d, meta)
+}
+
+func resourceMonitorDiagnosticSettingBatchDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	targetResourceIds := utils.ExpandStringSlice(d.Get("target_resource_ids").(*pluginsdk.Set).List())
+	operations := monitorDiagnosticSettingBatchDeleteOperations(ctx, client, name, *targetResourceIds, d.Timeout(pluginsdk.TimeoutDelete))
+	if _, errs := runMonitorDiagnosticSettingBatchOperations(operations, d.Get("parallelism").(int)); len(errs) > 0 {
+		return fmt.Errorf("deleting Monitor Diagnostic Setting %q for %d of %d Target Resources:\n%s", name, len(errs), len(operations), strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+func monitorDiagnosticSettingBatchApplyOperations(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, name string, props diagnosticsettings.DiagnosticSettings, targetResourceIds []string) []monitorDiagnosticSettingBatchOperation {
+	operations := make([]monitorDiagnosticSettingBatchOperation, 0)
+	for _, v := range targetResourceIds {
+		targetResourceId := v
+		operations = append(operations, newMonitorDiagnosticSettingBatchOperation(targetResourceId, func() error {
+			id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, name)
+			payload := diagnosticsettings.DiagnosticSettingsResource{
+				Properties: &props,
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("applying to Target Resource %q: %+v", targetResourceId, err)
+			}
+			return nil
+		}))
+	}
+	return operations
+}
+
+func monitorDiagnosticSettingBatchDeleteOperations(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, name string, targetResourceIds []string, timeout time.Duration) []monitorDiagnosticSettingBatchOperation {
+	operations := make([]monitorDiagnosticSettingBatchOperation, 0)
+	for _, v := range targetResourceIds {
+		targetResourceId := v
+		operations = append(operations, newMonitorDiagnosticSettingBatchOperation(targetResourceId, func() error {
+			id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, name)
+			if resp, err := client.Delete(ctx, id); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting from Target Resource %q: %+v", targetResourceId, err)
+				}
+			}
+
+			// API appears to be eventually consistent (identified during tainting this resource)
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:                   []string{"Exists"},
+				Target:                    []string{"NotFound"},
+				Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, id),
+				MinTimeout:                15 * time.Second,
+				ContinuousTargetOccurence: 5,
+				Timeout:                   timeout,
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for deletion from Target Resource %q: %+v", targetResourceId, err)
+			}
+			return nil
+		}))
+	}
+	return operations
+}
+
+// monitorDiagnosticSettingBatchOperation is an operation against the Diagnostic Setting of a single Target Resource
+type monitorDiagnosticSettingBatchOperation struct {
+	targetResourceId string
+	run              func() error
+}
+
+func newMonitorDiagnosticSettingBatchOperation(targetResourceId string, run func() error) monitorDiagnosticSettingBatchOperation {
+	return monitorDiagnosticSettingBatchOperation{
+		targetResourceId: targetResourceId,
+		run:              run,
+	}
+}
+
+// runMonitorDiagnosticSettingBatchOperations runs the operations with the specified parallelism, returning the Target
+// Resources and (sorted) errors of every operation which failed rather than stopping at the first failure
+func runMonitorDiagnosticSettingBatchOperations(operations []monitorDiagnosticSettingBatchOperation, parallelism int) ([]string, []string) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failed := make([]string, 0)
+	errs := make([]string, 0)
+	semaphore := make(chan struct{}, parallelism)
+	for _, operation := range operations {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(operation monitorDiagnosticSettingBatchOperation) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := operation.run(); err != nil {
+				mu.Lock()
+				failed = append(failed, operation.targetResourceId)
+				errs = append(errs, err.Error())
+				mu.Unlock()
+			}
+		}(operation)
+	}
+	wg.Wait()
+
+	sort.Strings(failed)
+	sort.Strings(errs)
+	return failed, errs
+}
+
+// importMonitorDiagnosticSettingBatch adopts the existing Diagnostic Setting on the Target Resources - using the
+// Diagnostic Setting on the first Target Resource as the configuration which the others are compared against
+func importMonitorDiagnosticSettingBatch(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+
+	name, targetResourceIds, err := parseMonitorDiagnosticSettingBatchImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceIds[0], name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("Monitor Diagnostic Setting %q was not found for Target Resource %q", name, targetResourceIds[0])
+		}
+		return nil, fmt.Errorf("retrieving Monitor Diagnostic Setting %q for Target Resource %q: %+v", name, targetResourceIds[0], err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving Monitor Diagnostic Setting %q for Target Resource %q: `properties` was nil", name, targetResourceIds[0])
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return nil, fmt.Errorf("generating an ID for the Monitor Diagnostic Setting Batch %q: %+v", name, err)
+	}
+	d.SetId(id)
+
+	d.Set("name", name)
+	d.Set("target_resource_ids", targetResourceIds)
+	d.Set("parallelism", 10)
+	if err := setMonitorDiagnosticSettingProperties(d, *resp.Model.Properties); err != nil {
+		return nil, err
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// parseMonitorDiagnosticSettingBatchImportId parses an Import ID in the format `{name}|{targetResourceId},{targetResourceId}`
+func parseMonitorDiagnosticSettingBatchImportId(input string) (string, []string, error) {
+	v := strings.Split(input, "|")
+	if len(v) != 2 || v[0] == "" || v[1] == "" {
+		return "", nil, fmt.Errorf("expected the Import ID to be in the format `{name}|{targetResourceId},{targetResourceId}` but got %q", input)
+	}
+
+	name := v[0]
+	if _, errs := validate.MonitorDiagnosticSettingName(name, "name"); len(errs) > 0 {
+		return "", nil, fmt.Errorf("parsing the name %q: %+v", name, errs[0])
+	}
+
+	targetResourceIds := make([]string, 0)
+	for _, targetResourceId := range strings.Split(v[1], ",") {
+		if _, err := azure.ParseAzureResourceID(targetResourceId); err != nil {
+			return "", nil, fmt.Errorf("parsing the Target Resource ID %q: %+v", targetResourceId, err)
+		}
+		targetResourceIds = append(targetResourceIds, targetResourceId)
+	}
+
+	return name, targetResourceIds, nil
+}
+
+// monitorDiagnosticSettingBatchInSync determines whether the Diagnostic Setting of a Target Resource matches the one
+// which was applied, the `log` and `metric` blocks are compared in the same manner as `azurerm_monitor_diagnostic_setting`
+func monitorDiagnosticSettingBatchInSync(expected, actual diagnosticsettings.DiagnosticSettings, logs, metrics *pluginsdk.Set) bool {
+	if !strings.EqualFold(utils.NormalizeNilableString(expected.EventHubAuthorizationRuleId), utils.NormalizeNilableString(actual.EventHubAuthorizationRuleId)) {
+		return false
+	}
+	if expected.EventHubAuthorizationRuleId != nil && utils.NormalizeNilableString(expected.EventHubName) != utils.NormalizeNilableString(actual.EventHubName) {
+		return false
+	}
+	if !strings.EqualFold(utils.NormalizeNilableString(expected.WorkspaceId), utils.NormalizeNilableString(actual.WorkspaceId)) {
+		return false
+	}
+	if !strings.EqualFold(utils.NormalizeNilableString(expected.StorageAccountId), utils.NormalizeNilableString(actual.StorageAccountId)) {
+		return false
+	}
+	if expected.LogAnalyticsDestinationType != nil && !strings.EqualFold(*expected.LogAnalyticsDestinationType, utils.NormalizeNilableString(actual.LogAnalyticsDestinationType)) {
+		return false
+	}
+
+	if !logs.Equal(pluginsdk.NewSet(logs.F, flattenMonitorDiagnosticLogs(actual.Logs, logs.List()))) {
+		return false
+	}
+
+	return metrics.Equal(pluginsdk.NewSet(metrics.F, flattenMonitorDiagnosticMetrics(actual.Metrics, metrics.List())))
+}
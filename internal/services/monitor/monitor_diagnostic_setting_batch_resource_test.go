@@ -0,0 +1,194 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorDiagnosticSettingBatchResource struct{}
+
+func TestAccMonitorDiagnosticSettingBatch_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_batch", "test")
+	r := MonitorDiagnosticSettingBatchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("3"),
+			),
+		},
+		r.importStep(data, 3),
+	})
+}
+
+func TestAccMonitorDiagnosticSettingBatch_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting_batch", "test")
+	r := MonitorDiagnosticSettingBatchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.basic(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("5"),
+			),
+		},
+		{
+			Config: r.updated(data, 5),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("5"),
+			),
+		},
+		{
+			Config: r.basic(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+// importStep imports the Diagnostic Setting Batch using the name and Target Resources, since the ID is generated
+func (MonitorDiagnosticSettingBatchResource) importStep(data acceptance.TestData, count int) acceptance.TestStep {
+	return acceptance.TestStep{
+		ResourceName: data.ResourceName,
+		ImportState:  true,
+		ImportStateIdFunc: func(state *acceptance.State) (string, error) {
+			rs, ok := state.RootModule().Resources[data.ResourceName]
+			if !ok {
+				return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+			}
+
+			targetResourceIds := make([]string, 0)
+			for k, v := range rs.Primary.Attributes {
+				if strings.HasPrefix(k, "target_resource_ids.") && k != "target_resource_ids.#" {
+					targetResourceIds = append(targetResourceIds, v)
+				}
+			}
+
+			return fmt.Sprintf("%s|%s", rs.Primary.Attributes["name"], strings.Join(targetResourceIds, ",")), nil
+		},
+		ImportStateCheck: func(states []*acceptance.InstanceState) error {
+			if len(states) != 1 {
+				return fmt.Errorf("expected 1 imported resource but got %d", len(states))
+			}
+			attributes := states[0].Attributes
+			if v := attributes["target_resource_ids.#"]; v != fmt.Sprint(count) {
+				return fmt.Errorf("expected %d `target_resource_ids` but got %s", count, v)
+			}
+			if v := attributes["name"]; v != fmt.Sprintf("acctest-DS-%d", data.RandomInteger) {
+				return fmt.Errorf("expected the `name` to be %q but got %q", fmt.Sprintf("acctest-DS-%d", data.RandomInteger), v)
+			}
+			return nil
+		},
+	}
+}
+
+func (MonitorDiagnosticSettingBatchResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	name := state.Attributes["name"]
+	for k, v := range state.Attributes {
+		if !strings.HasPrefix(k, "target_resource_ids.") || k == "target_resource_ids.#" {
+			continue
+		}
+
+		resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, diagnosticsettings.NewScopedDiagnosticSettingID(v, name))
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving Monitor Diagnostic Setting %q for Target Resource %q: %+v", name, v, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (MonitorDiagnosticSettingBatchResource) template(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-LAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_storage_account" "test" {
+  count                    = %[4]d
+  name                     = "acctestsa%[3]s${count.index}"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_replication_type = "LRS"
+  account_tier             = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, count)
+}
+
+func (r MonitorDiagnosticSettingBatchResource) basic(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_batch" "test" {
+  name                       = "acctest-DS-%d"
+  target_resource_ids        = azurerm_storage_account.test.*.id
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  metric {
+    category = "Transaction"
+  }
+}
+`, r.template(data, count), data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingBatchResource) updated(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting_batch" "test" {
+  name                           = "acctest-DS-%d"
+  target_resource_ids            = azurerm_storage_account.test.*.id
+  log_analytics_workspace_id     = azurerm_log_analytics_workspace.test.id
+  log_analytics_destination_type = "Dedicated"
+  parallelism                    = 2
+
+  metric {
+    category = "Transaction"
+  }
+
+  metric {
+    category = "Capacity"
+    enabled  = false
+  }
+}
+`, r.template(data, count), data.RandomInteger)
+}
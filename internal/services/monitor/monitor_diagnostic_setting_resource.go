@@ -7,7 +7,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -16,6 +15,7 @@ import (
 	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
 	logAnalyticsParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/parse"
 	logAnalyticsValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-05-01-preview/diagnosticsettings"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -96,76 +96,93 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			"log": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
+				Elem:     monitorDiagnosticSettingLogSchema(),
+			},
+
+			"metric": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem:     monitorDiagnosticSettingMetricSchema(),
+			},
+		},
+	}
+}
+
+func monitorDiagnosticSettingLogSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"category": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"category_group": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"retention_policy": {
+				Type:       pluginsdk.TypeList,
+				Optional:   true,
+				MaxItems:   1,
+				Deprecated: "the retention policy of Diagnostic Settings is deprecated by Azure and will be removed in a future version - the retention of data within a Storage Account should instead be managed using the `azurerm_storage_management_policy` resource",
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
-						"category": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
 						"enabled": {
 							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  true,
+							Required: true,
 						},
 
-						"retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-								},
-							},
+						"days": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 					},
 				},
 			},
+		},
+	}
+}
 
-			"metric": {
-				Type:     pluginsdk.TypeSet,
+func monitorDiagnosticSettingMetricSchema() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"category": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
 				Optional: true,
+				Default:  true,
+			},
+
+			"retention_policy": {
+				Type:       pluginsdk.TypeList,
+				Optional:   true,
+				MaxItems:   1,
+				Deprecated: "the retention policy of Diagnostic Settings is deprecated by Azure and will be removed in a future version - the retention of data within a Storage Account should instead be managed using the `azurerm_storage_management_policy` resource",
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
-						"category": {
-							Type:     pluginsdk.TypeString,
-							Required: true,
-						},
-
 						"enabled": {
 							Type:     pluginsdk.TypeBool,
-							Optional: true,
-							Default:  true,
+							Required: true,
 						},
 
-						"retention_policy": {
-							Type:     pluginsdk.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"enabled": {
-										Type:     pluginsdk.TypeBool,
-										Required: true,
-									},
-
-									"days": {
-										Type:         pluginsdk.TypeInt,
-										Optional:     true,
-										ValidateFunc: validation.IntAtLeast(0),
-									},
-								},
-							},
+						"days": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
 						},
 					},
 				},
@@ -182,102 +199,39 @@ func resourceMonitorDiagnosticSettingCreateUpdate(d *pluginsdk.ResourceData, met
 
 	name := d.Get("name").(string)
 	actualResourceId := d.Get("target_resource_id").(string)
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(actualResourceId, name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, actualResourceId, name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing Monitor Diagnostic Setting %q for Resource %q: %s", name, actualResourceId, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
-			return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", *existing.ID)
+		if existing.Model != nil && existing.Model.Id != nil && *existing.Model.Id != "" {
+			return tf.ImportAsExistsError("azurerm_monitor_diagnostic_setting", *existing.Model.Id)
 		}
 	}
 
-	logsRaw := d.Get("log").(*pluginsdk.Set).List()
-	logs := expandMonitorDiagnosticsSettingsLogs(logsRaw)
-	metricsRaw := d.Get("metric").(*pluginsdk.Set).List()
-	metrics := expandMonitorDiagnosticsSettingsMetrics(metricsRaw)
-
-	// if no blocks are specified  the API "creates" but 404's on Read
-	if len(logs) == 0 && len(metrics) == 0 {
-		return fmt.Errorf("At least one `log` or `metric` block must be specified")
-	}
-
-	// also if there's none enabled
-	valid := false
-	for _, v := range logs {
-		if v.Enabled != nil && *v.Enabled {
-			valid = true
-			break
-		}
-	}
-	if !valid {
-		for _, v := range metrics {
-			if v.Enabled != nil && *v.Enabled {
-				valid = true
-				break
-			}
-		}
-	}
-
-	if !valid {
-		return fmt.Errorf("At least one `log` or `metric` must be enabled")
-	}
-
-	properties := insights.DiagnosticSettingsResource{
-		DiagnosticSettings: &insights.DiagnosticSettings{
-			Logs:    &logs,
-			Metrics: &metrics,
-		},
-	}
-
-	valid = false
-	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
-	eventHubName := d.Get("eventhub_name").(string)
-	if eventHubAuthorizationRuleId != "" {
-		properties.DiagnosticSettings.EventHubAuthorizationRuleID = utils.String(eventHubAuthorizationRuleId)
-		properties.DiagnosticSettings.EventHubName = utils.String(eventHubName)
-		valid = true
-	}
-
-	workspaceId := d.Get("log_analytics_workspace_id").(string)
-	if workspaceId != "" {
-		properties.DiagnosticSettings.WorkspaceID = utils.String(workspaceId)
-		valid = true
-	}
-
-	storageAccountId := d.Get("storage_account_id").(string)
-	if storageAccountId != "" {
-		properties.DiagnosticSettings.StorageAccountID = utils.String(storageAccountId)
-		valid = true
-	}
-
-	if v := d.Get("log_analytics_destination_type").(string); v != "" {
-		if workspaceId != "" {
-			properties.DiagnosticSettings.LogAnalyticsDestinationType = &v
-		} else {
-			return fmt.Errorf("`log_analytics_workspace_id` must be set for `log_analytics_destination_type` to be used")
-		}
+	props, err := expandMonitorDiagnosticSettings(d)
+	if err != nil {
+		return err
 	}
 
-	if !valid {
-		return fmt.Errorf("Either a `eventhub_authorization_rule_id`, `log_analytics_workspace_id` or `storage_account_id` must be set")
+	properties := diagnosticsettings.DiagnosticSettingsResource{
+		Properties: props,
 	}
 
-	// the Azure SDK prefixes the URI with a `/` such this makes a bad request if we don't trim the `/`
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
-	if _, err := client.CreateOrUpdate(ctx, targetResourceId, properties, name); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
 		return fmt.Errorf("creating Monitor Diagnostics Setting %q for Resource %q: %+v", name, actualResourceId, err)
 	}
 
-	read, err := client.Get(ctx, targetResourceId, name)
+	read, err := client.Get(ctx, id)
 	if err != nil {
 		return err
 	}
-	if read.ID == nil {
+	if read.Model == nil || read.Model.Id == nil {
 		return fmt.Errorf("Cannot read ID for Monitor Diagnostics %q for Resource ID %q", name, actualResourceId)
 	}
 
@@ -297,10 +251,9 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	}
 
 	actualResourceId := id.ResourceID
-	targetResourceId := strings.TrimPrefix(actualResourceId, "/")
-	resp, err := client.Get(ctx, targetResourceId, id.Name)
+	resp, err := client.Get(ctx, diagnosticsettings.NewScopedDiagnosticSettingID(actualResourceId, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[WARN] Monitor Diagnostics Setting %q was not found for Resource %q - removing from state!", id.Name, actualResourceId)
 			d.SetId("")
 			return nil
@@ -312,10 +265,57 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	d.Set("name", id.Name)
 	d.Set("target_resource_id", id.ResourceID)
 
-	d.Set("eventhub_name", resp.EventHubName)
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if err := setMonitorDiagnosticSettingProperties(d, *model.Properties); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := ParseMonitorDiagnosticId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	settingId := diagnosticsettings.NewScopedDiagnosticSettingID(id.ResourceID, id.Name)
+	resp, err := client.Delete(ctx, settingId)
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.Name, id.ResourceID, err)
+		}
+	}
+
+	// API appears to be eventually consistent (identified during tainting this resource)
+	log.Printf("[DEBUG] Waiting for Monitor Diagnostic Setting %q for Resource %q to disappear", id.Name, id.ResourceID)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:                   []string{"Exists"},
+		Target:                    []string{"NotFound"},
+		Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, settingId),
+		MinTimeout:                15 * time.Second,
+		ContinuousTargetOccurence: 5,
+		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Monitor Diagnostic Setting %q for Resource %q to become available: %s", id.Name, id.ResourceID, err)
+	}
+
+	return nil
+}
+
+// setMonitorDiagnosticSettingProperties sets the destinations and the `log` and `metric` blocks of a Diagnostic Setting
+func setMonitorDiagnosticSettingProperties(d *pluginsdk.ResourceData, props diagnosticsettings.DiagnosticSettings) error {
+	d.Set("eventhub_name", props.EventHubName)
 	eventhubAuthorizationRuleId := ""
-	if resp.EventHubAuthorizationRuleID != nil && *resp.EventHubAuthorizationRuleID != "" {
-		authRuleId := utils.NormalizeNilableString(resp.EventHubAuthorizationRuleID)
+	if props.EventHubAuthorizationRuleId != nil && *props.EventHubAuthorizationRuleId != "" {
+		authRuleId := utils.NormalizeNilableString(props.EventHubAuthorizationRuleId)
 		parsedId, err := authRuleParse.ParseAuthorizationRuleID(authRuleId)
 		if err != nil {
 			return err
@@ -326,8 +326,8 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	d.Set("eventhub_authorization_rule_id", eventhubAuthorizationRuleId)
 
 	workspaceId := ""
-	if resp.WorkspaceID != nil && *resp.WorkspaceID != "" {
-		parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*resp.WorkspaceID)
+	if props.WorkspaceId != nil && *props.WorkspaceId != "" {
+		parsedId, err := logAnalyticsParse.LogAnalyticsWorkspaceID(*props.WorkspaceId)
 		if err != nil {
 			return err
 		}
@@ -337,8 +337,8 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	d.Set("log_analytics_workspace_id", workspaceId)
 
 	storageAccountId := ""
-	if resp.StorageAccountID != nil && *resp.StorageAccountID != "" {
-		parsedId, err := storageParse.StorageAccountID(*resp.StorageAccountID)
+	if props.StorageAccountId != nil && *props.StorageAccountId != "" {
+		parsedId, err := storageParse.StorageAccountID(*props.StorageAccountId)
 		if err != nil {
 			return err
 		}
@@ -347,144 +347,183 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	}
 	d.Set("storage_account_id", storageAccountId)
 
-	d.Set("log_analytics_destination_type", resp.LogAnalyticsDestinationType)
+	d.Set("log_analytics_destination_type", props.LogAnalyticsDestinationType)
 
-	if err := d.Set("log", flattenMonitorDiagnosticLogs(resp.Logs)); err != nil {
+	if err := d.Set("log", flattenMonitorDiagnosticLogs(props.Logs, d.Get("log").(*pluginsdk.Set).List())); err != nil {
 		return fmt.Errorf("setting `log`: %+v", err)
 	}
 
-	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Metrics)); err != nil {
+	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(props.Metrics, d.Get("metric").(*pluginsdk.Set).List())); err != nil {
 		return fmt.Errorf("setting `metric`: %+v", err)
 	}
 
 	return nil
 }
 
-func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
-	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
-	defer cancel()
+func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, id diagnosticsettings.ScopedDiagnosticSettingId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(res.HttpResponse) {
+				return "NotFound", "NotFound", nil
+			}
+			return nil, "", fmt.Errorf("issuing read request in monitorDiagnosticSettingDeletedRefreshFunc: %s", err)
+		}
 
-	id, err := ParseMonitorDiagnosticId(d.Id())
-	if err != nil {
-		return err
+		return res, "Exists", nil
 	}
+}
 
-	targetResourceId := strings.TrimPrefix(id.ResourceID, "/")
-	resp, err := client.Delete(ctx, targetResourceId, id.Name)
+// expandMonitorDiagnosticSettings builds and validates the Diagnostic Setting from the destinations and the `log`
+// and `metric` blocks, which are shared between the `azurerm_monitor_diagnostic_setting` resources
+func expandMonitorDiagnosticSettings(d *pluginsdk.ResourceData) (*diagnosticsettings.DiagnosticSettings, error) {
+	logsRaw := d.Get("log").(*pluginsdk.Set).List()
+	logs, err := expandMonitorDiagnosticsSettingsLogs(logsRaw)
 	if err != nil {
-		if !response.WasNotFound(resp.Response) {
-			return fmt.Errorf("deleting Monitor Diagnostics Setting %q for Resource %q: %+v", id.Name, targetResourceId, err)
+		return nil, err
+	}
+	metricsRaw := d.Get("metric").(*pluginsdk.Set).List()
+	metrics := expandMonitorDiagnosticsSettingsMetrics(metricsRaw)
+
+	// if no blocks are specified  the API "creates" but 404's on Read
+	if len(logs) == 0 && len(metrics) == 0 {
+		return nil, fmt.Errorf("At least one `log` or `metric` block must be specified")
+	}
+
+	// also if there's none enabled
+	valid := false
+	for _, v := range logs {
+		if v.Enabled {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		for _, v := range metrics {
+			if v.Enabled {
+				valid = true
+				break
+			}
 		}
 	}
 
-	// API appears to be eventually consistent (identified during tainting this resource)
-	log.Printf("[DEBUG] Waiting for Monitor Diagnostic Setting %q for Resource %q to disappear", id.Name, id.ResourceID)
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:                   []string{"Exists"},
-		Target:                    []string{"NotFound"},
-		Refresh:                   monitorDiagnosticSettingDeletedRefreshFunc(ctx, client, targetResourceId, id.Name),
-		MinTimeout:                15 * time.Second,
-		ContinuousTargetOccurence: 5,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+	if !valid {
+		return nil, fmt.Errorf("At least one `log` or `metric` must be enabled")
 	}
 
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for Monitor Diagnostic Setting %q for Resource %q to become available: %s", id.Name, id.ResourceID, err)
+	properties := diagnosticsettings.DiagnosticSettings{
+		Logs:    &logs,
+		Metrics: &metrics,
 	}
 
-	return nil
-}
+	valid = false
+	eventHubAuthorizationRuleId := d.Get("eventhub_authorization_rule_id").(string)
+	eventHubName := d.Get("eventhub_name").(string)
+	if eventHubAuthorizationRuleId != "" {
+		properties.EventHubAuthorizationRuleId = utils.String(eventHubAuthorizationRuleId)
+		properties.EventHubName = utils.String(eventHubName)
+		valid = true
+	}
 
-func monitorDiagnosticSettingDeletedRefreshFunc(ctx context.Context, client *insights.DiagnosticSettingsClient, targetResourceId string, name string) pluginsdk.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, targetResourceId, name)
-		if err != nil {
-			if utils.ResponseWasNotFound(res.Response) {
-				return "NotFound", "NotFound", nil
-			}
-			return nil, "", fmt.Errorf("issuing read request in monitorDiagnosticSettingDeletedRefreshFunc: %s", err)
+	workspaceId := d.Get("log_analytics_workspace_id").(string)
+	if workspaceId != "" {
+		properties.WorkspaceId = utils.String(workspaceId)
+		valid = true
+	}
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	if storageAccountId != "" {
+		properties.StorageAccountId = utils.String(storageAccountId)
+		valid = true
+	}
+
+	if v := d.Get("log_analytics_destination_type").(string); v != "" {
+		if workspaceId != "" {
+			properties.LogAnalyticsDestinationType = &v
+		} else {
+			return nil, fmt.Errorf("`log_analytics_workspace_id` must be set for `log_analytics_destination_type` to be used")
 		}
+	}
 
-		return res, "Exists", nil
+	if !valid {
+		return nil, fmt.Errorf("Either a `eventhub_authorization_rule_id`, `log_analytics_workspace_id` or `storage_account_id` must be set")
 	}
+
+	return &properties, nil
 }
 
-func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSettings {
-	results := make([]insights.LogSettings, 0)
+func expandMonitorDiagnosticsSettingsLogs(input []interface{}) ([]diagnosticsettings.LogSettings, error) {
+	results := make([]diagnosticsettings.LogSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
 
 		category := v["category"].(string)
-		enabled := v["enabled"].(bool)
-		policiesRaw := v["retention_policy"].([]interface{})
-		var retentionPolicy *insights.RetentionPolicy
-		if len(policiesRaw) != 0 {
-			policyRaw := policiesRaw[0].(map[string]interface{})
-			retentionDays := policyRaw["days"].(int)
-			retentionEnabled := policyRaw["enabled"].(bool)
-			retentionPolicy = &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
-			}
+		categoryGroup := v["category_group"].(string)
+		if (category == "") == (categoryGroup == "") {
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified within each `log` block")
 		}
 
-		output := insights.LogSettings{
-			Category:        utils.String(category),
-			Enabled:         utils.Bool(enabled),
-			RetentionPolicy: retentionPolicy,
+		output := diagnosticsettings.LogSettings{
+			Enabled:         v["enabled"].(bool),
+			RetentionPolicy: expandMonitorDiagnosticsSettingsRetentionPolicy(v["retention_policy"].([]interface{})),
+		}
+		if category != "" {
+			output.Category = utils.String(category)
+		}
+		if categoryGroup != "" {
+			output.CategoryGroup = utils.String(categoryGroup)
 		}
 
 		results = append(results, output)
 	}
 
-	return results
+	return results, nil
 }
 
-func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
+func flattenMonitorDiagnosticLogs(input *[]diagnosticsettings.LogSettings, existing []interface{}) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, v := range *input {
-		output := make(map[string]interface{})
-
+		category := ""
 		if v.Category != nil {
-			output["category"] = *v.Category
+			category = *v.Category
 		}
 
-		if v.Enabled != nil {
-			output["enabled"] = *v.Enabled
+		categoryGroup := ""
+		if v.CategoryGroup != nil {
+			categoryGroup = *v.CategoryGroup
 		}
 
-		policies := make([]interface{}, 0)
-
-		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
-
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
-
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
+		config := findMonitorDiagnosticSettingsBlock(existing, "category", category)
+		if categoryGroup != "" {
+			config = findMonitorDiagnosticSettingsBlock(existing, "category_group", categoryGroup)
+			if config != nil {
+				// the API doesn't preserve the casing of the Category Group
+				categoryGroup = config["category_group"].(string)
 			}
-
-			policies = append(policies, outputPolicy)
 		}
 
-		output["retention_policy"] = policies
+		if !includeMonitorDiagnosticSettingsEntry(categoryGroup, v.Enabled, v.RetentionPolicy, config) {
+			continue
+		}
 
-		results = append(results, output)
+		results = append(results, map[string]interface{}{
+			"category":         category,
+			"category_group":   categoryGroup,
+			"enabled":          v.Enabled,
+			"retention_policy": flattenMonitorDiagnosticsSettingsRetentionPolicy(v.RetentionPolicy, config),
+		})
 	}
 
 	return results
 }
 
-func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.MetricSettings {
-	results := make([]insights.MetricSettings, 0)
+func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []diagnosticsettings.MetricSettings {
+	results := make([]diagnosticsettings.MetricSettings, 0)
 
 	for _, raw := range input {
 		v := raw.(map[string]interface{})
@@ -492,21 +531,10 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.Met
 		category := v["category"].(string)
 		enabled := v["enabled"].(bool)
 
-		policiesRaw := v["retention_policy"].([]interface{})
-		var retentionPolicy *insights.RetentionPolicy
-		if len(policiesRaw) > 0 && policiesRaw[0] != nil {
-			policyRaw := policiesRaw[0].(map[string]interface{})
-			retentionDays := policyRaw["days"].(int)
-			retentionEnabled := policyRaw["enabled"].(bool)
-			retentionPolicy = &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
-			}
-		}
-		output := insights.MetricSettings{
+		output := diagnosticsettings.MetricSettings{
 			Category:        utils.String(category),
-			Enabled:         utils.Bool(enabled),
-			RetentionPolicy: retentionPolicy,
+			Enabled:         enabled,
+			RetentionPolicy: expandMonitorDiagnosticsSettingsRetentionPolicy(v["retention_policy"].([]interface{})),
 		}
 
 		results = append(results, output)
@@ -515,45 +543,93 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.Met
 	return results
 }
 
-func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interface{} {
+func flattenMonitorDiagnosticMetrics(input *[]diagnosticsettings.MetricSettings, existing []interface{}) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
 	for _, v := range *input {
-		output := make(map[string]interface{})
-
+		category := ""
 		if v.Category != nil {
-			output["category"] = *v.Category
+			category = *v.Category
 		}
 
-		if v.Enabled != nil {
-			output["enabled"] = *v.Enabled
-		}
+		config := findMonitorDiagnosticSettingsBlock(existing, "category", category)
+		results = append(results, map[string]interface{}{
+			"category":         category,
+			"enabled":          v.Enabled,
+			"retention_policy": flattenMonitorDiagnosticsSettingsRetentionPolicy(v.RetentionPolicy, config),
+		})
+	}
+
+	return results
+}
 
-		policies := make([]interface{}, 0)
+func expandMonitorDiagnosticsSettingsRetentionPolicy(input []interface{}) *diagnosticsettings.RetentionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
 
-		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
+	v := input[0].(map[string]interface{})
+	return &diagnosticsettings.RetentionPolicy{
+		Days:    int64(v["days"].(int)),
+		Enabled: v["enabled"].(bool),
+	}
+}
 
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
+func flattenMonitorDiagnosticsSettingsRetentionPolicy(input *diagnosticsettings.RetentionPolicy, config map[string]interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
 
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
-			}
+	// the API returns a disabled Retention Policy once it's been removed, which is ignored when the `log` or `metric`
+	// block is configured without a `retention_policy` block - otherwise (e.g. when importing) this is returned, such
+	// that configurations which specify a disabled Retention Policy don't show a diff
+	if !input.Enabled && input.Days == 0 {
+		if config != nil && len(config["retention_policy"].([]interface{})) == 0 {
+			return []interface{}{}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"days":    int(input.Days),
+			"enabled": input.Enabled,
+		},
+	}
+}
+
+// findMonitorDiagnosticSettingsBlock returns the `log` or `metric` block within the existing configuration
+// where the value of the specified key matches (case-insensitively), or nil when it's not configured
+func findMonitorDiagnosticSettingsBlock(input []interface{}, key, value string) map[string]interface{} {
+	if value == "" {
+		return nil
+	}
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
 
-			policies = append(policies, outputPolicy)
+		v := raw.(map[string]interface{})
+		if strings.EqualFold(v[key].(string), value) {
+			return v
 		}
+	}
 
-		output["retention_policy"] = policies
+	return nil
+}
 
-		results = append(results, output)
+// includeMonitorDiagnosticSettingsEntry determines whether a `log` entry returned from the API should be set into the
+// state - disabled Category Groups which aren't configured are ignored to avoid a perpetual diff, however disabled
+// Categories are always included since these can be configured (and must be retained when importing)
+func includeMonitorDiagnosticSettingsEntry(categoryGroup string, enabled bool, retentionPolicy *diagnosticsettings.RetentionPolicy, config map[string]interface{}) bool {
+	if config != nil || enabled || categoryGroup == "" {
+		return true
 	}
 
-	return results
+	return retentionPolicy != nil && retentionPolicy.Enabled
 }
 
 type monitorDiagnosticId struct {
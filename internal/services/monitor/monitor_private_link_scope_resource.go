@@ -5,14 +5,17 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2021-07-01-preview/privatelinkscopesapis"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -46,6 +49,46 @@ func resourceMonitorPrivateLinkScope() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			"ingestion_access_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(privatelinkscopesapis.AccessModeOpen),
+				ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+			},
+
+			"query_access_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(privatelinkscopesapis.AccessModeOpen),
+				ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+			},
+
+			"exclusion": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"private_endpoint_connection_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"ingestion_access_mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+						},
+
+						"query_access_mode": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(privatelinkscopesapis.PossibleValuesForAccessMode(), false),
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -61,26 +104,34 @@ func resourceMonitorPrivateLinkScopeCreateUpdate(d *pluginsdk.ResourceData, meta
 	resourceGroup := d.Get("resource_group_name").(string)
 
 	id := parse.NewPrivateLinkScopeID(subscriptionId, resourceGroup, name)
+	scopeId := privatelinkscopesapis.NewPrivateLinkScopeID(id.SubscriptionId, id.ResourceGroup, id.Name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		existing, err := client.PrivateLinkScopesGet(ctx, scopeId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_monitor_private_link_scope", id.ID())
 		}
 	}
 
-	parameters := insights.AzureMonitorPrivateLinkScope{
-		Location: utils.String("Global"),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+	parameters := privatelinkscopesapis.AzureMonitorPrivateLinkScope{
+		Location: "Global",
+		Properties: privatelinkscopesapis.AzureMonitorPrivateLinkScopeProperties{
+			AccessModeSettings: privatelinkscopesapis.AccessModeSettings{
+				Exclusions:          expandMonitorPrivateLinkScopeAccessModeExclusions(d.Get("exclusion").([]interface{})),
+				IngestionAccessMode: privatelinkscopesapis.AccessMode(d.Get("ingestion_access_mode").(string)),
+				QueryAccessMode:     privatelinkscopesapis.AccessMode(d.Get("query_access_mode").(string)),
+			},
+		},
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
+	if _, err := client.PrivateLinkScopesCreateOrUpdate(ctx, scopeId, parameters); err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 
@@ -99,9 +150,9 @@ func resourceMonitorPrivateLinkScopeRead(d *pluginsdk.ResourceData, meta interfa
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.PrivateLinkScopesGet(ctx, privatelinkscopesapis.NewPrivateLinkScopeID(id.SubscriptionId, id.ResourceGroup, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s does not exist - removing from state!", id)
 			d.SetId("")
 			return nil
@@ -112,7 +163,19 @@ func resourceMonitorPrivateLinkScopeRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	if model := resp.Model; model != nil {
+		settings := model.Properties.AccessModeSettings
+		d.Set("ingestion_access_mode", string(settings.IngestionAccessMode))
+		d.Set("query_access_mode", string(settings.QueryAccessMode))
+
+		if err := d.Set("exclusion", flattenMonitorPrivateLinkScopeAccessModeExclusions(settings.Exclusions)); err != nil {
+			return fmt.Errorf("setting `exclusion`: %+v", err)
+		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
+	}
+
+	return nil
 }
 
 func resourceMonitorPrivateLinkScopeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -125,14 +188,59 @@ func resourceMonitorPrivateLinkScopeDelete(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
-		return fmt.Errorf("deleting %s: %+v", id, err)
+	if err := client.PrivateLinkScopesDeleteThenPoll(ctx, privatelinkscopesapis.NewPrivateLinkScopeID(id.SubscriptionId, id.ResourceGroup, id.Name)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	return nil
+}
+
+func expandMonitorPrivateLinkScopeAccessModeExclusions(input []interface{}) *[]privatelinkscopesapis.AccessModeSettingsExclusion {
+	results := make([]privatelinkscopesapis.AccessModeSettingsExclusion, 0)
+
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		ingestionAccessMode := privatelinkscopesapis.AccessMode(v["ingestion_access_mode"].(string))
+		queryAccessMode := privatelinkscopesapis.AccessMode(v["query_access_mode"].(string))
+
+		results = append(results, privatelinkscopesapis.AccessModeSettingsExclusion{
+			PrivateEndpointConnectionName: utils.String(v["private_endpoint_connection_name"].(string)),
+			IngestionAccessMode:           &ingestionAccessMode,
+			QueryAccessMode:               &queryAccessMode,
+		})
 	}
 
-	return nil
+	return &results
+}
+
+func flattenMonitorPrivateLinkScopeAccessModeExclusions(input *[]privatelinkscopesapis.AccessModeSettingsExclusion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		privateEndpointConnectionName := ""
+		if item.PrivateEndpointConnectionName != nil {
+			privateEndpointConnectionName = *item.PrivateEndpointConnectionName
+		}
+
+		ingestionAccessMode := ""
+		if item.IngestionAccessMode != nil {
+			ingestionAccessMode = string(*item.IngestionAccessMode)
+		}
+
+		queryAccessMode := ""
+		if item.QueryAccessMode != nil {
+			queryAccessMode = string(*item.QueryAccessMode)
+		}
+
+		results = append(results, map[string]interface{}{
+			"private_endpoint_connection_name": privateEndpointConnectionName,
+			"ingestion_access_mode":            ingestionAccessMode,
+			"query_access_mode":                queryAccessMode,
+		})
+	}
+
+	return results
 }
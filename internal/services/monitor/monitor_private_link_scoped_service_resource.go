@@ -3,6 +3,7 @@ package monitor
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2021-07-01-preview/insights"
@@ -95,13 +96,25 @@ func resourceMonitorPrivateLinkScopedServiceCreateUpdate(d *pluginsdk.ResourceDa
 		},
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.PrivateLinkScopeName, id.ScopedResourceName, parameters)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", id, err)
-	}
+	// the Private Link Scope only allows a single Scoped Resource to be modified at a time, so when
+	// many components are attached in parallel the API returns a Conflict which should be retried
+	err := pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutCreate), func() *pluginsdk.RetryError {
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.PrivateLinkScopeName, id.ScopedResourceName, parameters)
+		if err != nil {
+			if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusConflict {
+				return pluginsdk.RetryableError(fmt.Errorf("creating/updating %s: %+v", id, err))
+			}
+			return pluginsdk.NonRetryableError(fmt.Errorf("creating/updating %s: %+v", id, err))
+		}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("waiting for creation/update of %s: %+v", id, err))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	d.SetId(id.ID())
@@ -149,14 +162,19 @@ func resourceMonitorPrivateLinkScopedServiceDelete(d *pluginsdk.ResourceData, me
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.PrivateLinkScopeName, id.ScopedResourceName)
-	if err != nil {
-		return fmt.Errorf("deleting %s: %+v", id, err)
-	}
+	return pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutDelete), func() *pluginsdk.RetryError {
+		future, err := client.Delete(ctx, id.ResourceGroup, id.PrivateLinkScopeName, id.ScopedResourceName)
+		if err != nil {
+			if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusConflict {
+				return pluginsdk.RetryableError(fmt.Errorf("deleting %s: %+v", *id, err))
+			}
+			return pluginsdk.NonRetryableError(fmt.Errorf("deleting %s: %+v", *id, err))
+		}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
-	}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return pluginsdk.NonRetryableError(fmt.Errorf("waiting for deletion of %s: %+v", *id, err))
+		}
 
-	return nil
+		return nil
+	})
 }
@@ -45,6 +45,25 @@ func TestAccMonitorPrivateLinkScopedService_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccMonitorPrivateLinkScopedService_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_private_link_scoped_service", "test")
+	r := MonitorPrivateLinkScopedServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_monitor_private_link_scoped_service.workspace").ExistsInAzure(r),
+				check.That("azurerm_monitor_private_link_scoped_service.other.0").ExistsInAzure(r),
+				check.That("azurerm_monitor_private_link_scoped_service.other.1").ExistsInAzure(r),
+				check.That("azurerm_monitor_private_link_scoped_service.other.2").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r MonitorPrivateLinkScopedServiceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.PrivateLinkScopedServiceID(state.ID)
 	if err != nil {
@@ -106,3 +125,39 @@ resource "azurerm_monitor_private_link_scoped_service" "import" {
 }
 `, r.basic(data))
 }
+
+func (r MonitorPrivateLinkScopedServiceResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-law-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_monitor_private_link_scoped_service" "workspace" {
+  name                = "acctest-plss-law-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scope_name          = azurerm_monitor_private_link_scope.test.name
+  linked_resource_id  = azurerm_log_analytics_workspace.test.id
+}
+
+resource "azurerm_application_insights" "other" {
+  count               = 3
+  name                = "acctest-appinsights-${count.index}-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  application_type    = "web"
+}
+
+resource "azurerm_monitor_private_link_scoped_service" "other" {
+  count               = 3
+  name                = "acctest-plss-${count.index}-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  scope_name          = azurerm_monitor_private_link_scope.test.name
+  linked_resource_id  = azurerm_application_insights.other[count.index].id
+}
+`, r.basic(data), data.RandomInteger)
+}
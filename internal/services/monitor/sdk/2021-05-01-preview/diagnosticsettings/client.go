@@ -0,0 +1,15 @@
+package diagnosticsettings
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DiagnosticSettingsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDiagnosticSettingsClientWithBaseURI(endpoint string) DiagnosticSettingsClient {
+	return DiagnosticSettingsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
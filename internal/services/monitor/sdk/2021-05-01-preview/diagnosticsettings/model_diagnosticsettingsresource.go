@@ -0,0 +1,8 @@
+package diagnosticsettings
+
+type DiagnosticSettingsResource struct {
+	Id         *string             `json:"id,omitempty"`
+	Name       *string             `json:"name,omitempty"`
+	Properties *DiagnosticSettings `json:"properties,omitempty"`
+	Type       *string             `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package diagnosticsettings
+
+type MetricSettings struct {
+	Category        *string          `json:"category,omitempty"`
+	Enabled         bool             `json:"enabled"`
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty"`
+	TimeGrain       *string          `json:"timeGrain,omitempty"`
+}
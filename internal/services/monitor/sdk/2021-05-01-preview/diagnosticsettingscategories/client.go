@@ -0,0 +1,15 @@
+package diagnosticsettingscategories
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DiagnosticSettingsCategoriesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDiagnosticSettingsCategoriesClientWithBaseURI(endpoint string) DiagnosticSettingsCategoriesClient {
+	return DiagnosticSettingsCategoriesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,183 @@
+package diagnosticsettingscategories
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DiagnosticSettingsCategoryListResponse struct {
+	HttpResponse *http.Response
+	Model        *[]DiagnosticSettingsCategoryResource
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (DiagnosticSettingsCategoryListResponse, error)
+}
+
+type DiagnosticSettingsCategoryListCompleteResult struct {
+	Items []DiagnosticSettingsCategoryResource
+}
+
+func (r DiagnosticSettingsCategoryListResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r DiagnosticSettingsCategoryListResponse) LoadMore(ctx context.Context) (resp DiagnosticSettingsCategoryListResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// DiagnosticSettingsCategoryList ...
+func (c DiagnosticSettingsCategoriesClient) DiagnosticSettingsCategoryList(ctx context.Context, id ScopeId) (resp DiagnosticSettingsCategoryListResponse, err error) {
+	req, err := c.preparerForDiagnosticSettingsCategoryList(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "DiagnosticSettingsCategoryList", nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "DiagnosticSettingsCategoryList", resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderForDiagnosticSettingsCategoryList(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "DiagnosticSettingsCategoryList", resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// DiagnosticSettingsCategoryListComplete retrieves all of the results into a single object
+func (c DiagnosticSettingsCategoriesClient) DiagnosticSettingsCategoryListComplete(ctx context.Context, id ScopeId) (DiagnosticSettingsCategoryListCompleteResult, error) {
+	return c.DiagnosticSettingsCategoryListCompleteMatchingPredicate(ctx, id, DiagnosticSettingsCategoryResourcePredicate{})
+}
+
+// DiagnosticSettingsCategoryListCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c DiagnosticSettingsCategoriesClient) DiagnosticSettingsCategoryListCompleteMatchingPredicate(ctx context.Context, id ScopeId, predicate DiagnosticSettingsCategoryResourcePredicate) (resp DiagnosticSettingsCategoryListCompleteResult, err error) {
+	items := make([]DiagnosticSettingsCategoryResource, 0)
+
+	page, err := c.DiagnosticSettingsCategoryList(ctx, id)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := DiagnosticSettingsCategoryListCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+// preparerForDiagnosticSettingsCategoryList prepares the DiagnosticSettingsCategoryList request.
+func (c DiagnosticSettingsCategoriesClient) preparerForDiagnosticSettingsCategoryList(ctx context.Context, id ScopeId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/providers/Microsoft.Insights/diagnosticSettingsCategories", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// preparerForDiagnosticSettingsCategoryListWithNextLink prepares the DiagnosticSettingsCategoryList request with the given nextLink token.
+func (c DiagnosticSettingsCategoriesClient) preparerForDiagnosticSettingsCategoryListWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %q: %+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDiagnosticSettingsCategoryList handles the response to the DiagnosticSettingsCategoryList request. The method always
+// closes the http.Response Body.
+func (c DiagnosticSettingsCategoriesClient) responderForDiagnosticSettingsCategoryList(resp *http.Response) (result DiagnosticSettingsCategoryListResponse, err error) {
+	type page struct {
+		Values   []DiagnosticSettingsCategoryResource `json:"value"`
+		NextLink *string                              `json:"nextLink"`
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result DiagnosticSettingsCategoryListResponse, err error) {
+			req, err := c.preparerForDiagnosticSettingsCategoryListWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "DiagnosticSettingsCategoryList", nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "DiagnosticSettingsCategoryList", result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderForDiagnosticSettingsCategoryList(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, "diagnosticsettingscategories.DiagnosticSettingsCategoriesClient", "DiagnosticSettingsCategoryList", result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}
+	return
+}
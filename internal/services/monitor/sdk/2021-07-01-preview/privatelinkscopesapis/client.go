@@ -0,0 +1,15 @@
+package privatelinkscopesapis
+
+import "github.com/Azure/go-autorest/autorest"
+
+type PrivateLinkScopesAPIsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrivateLinkScopesAPIsClientWithBaseURI(endpoint string) PrivateLinkScopesAPIsClient {
+	return PrivateLinkScopesAPIsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,31 @@
+package privatelinkscopesapis
+
+import "strings"
+
+type AccessMode string
+
+const (
+	AccessModeOpen        AccessMode = "Open"
+	AccessModePrivateOnly AccessMode = "PrivateOnly"
+)
+
+func PossibleValuesForAccessMode() []string {
+	return []string{
+		string(AccessModeOpen),
+		string(AccessModePrivateOnly),
+	}
+}
+
+func parseAccessMode(input string) (*AccessMode, error) {
+	vals := map[string]AccessMode{
+		"open":        AccessModeOpen,
+		"privateonly": AccessModePrivateOnly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AccessMode(input)
+	return &out, nil
+}
@@ -0,0 +1,64 @@
+package privatelinkscopesapis
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type PrivateLinkScopesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *AzureMonitorPrivateLinkScope
+}
+
+// PrivateLinkScopesGet ...
+func (c PrivateLinkScopesAPIsClient) PrivateLinkScopesGet(ctx context.Context, id PrivateLinkScopeId) (result PrivateLinkScopesGetResponse, err error) {
+	req, err := c.preparerForPrivateLinkScopesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatelinkscopesapis.PrivateLinkScopesAPIsClient", "PrivateLinkScopesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatelinkscopesapis.PrivateLinkScopesAPIsClient", "PrivateLinkScopesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForPrivateLinkScopesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "privatelinkscopesapis.PrivateLinkScopesAPIsClient", "PrivateLinkScopesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForPrivateLinkScopesGet prepares the PrivateLinkScopesGet request.
+func (c PrivateLinkScopesAPIsClient) preparerForPrivateLinkScopesGet(ctx context.Context, id PrivateLinkScopeId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForPrivateLinkScopesGet handles the response to the PrivateLinkScopesGet request. The method always
+// closes the http.Response Body.
+func (c PrivateLinkScopesAPIsClient) responderForPrivateLinkScopesGet(resp *http.Response) (result PrivateLinkScopesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
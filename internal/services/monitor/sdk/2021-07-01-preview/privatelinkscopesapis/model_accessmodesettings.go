@@ -0,0 +1,7 @@
+package privatelinkscopesapis
+
+type AccessModeSettings struct {
+	Exclusions          *[]AccessModeSettingsExclusion `json:"exclusions,omitempty"`
+	IngestionAccessMode AccessMode                     `json:"ingestionAccessMode"`
+	QueryAccessMode     AccessMode                     `json:"queryAccessMode"`
+}
@@ -0,0 +1,7 @@
+package privatelinkscopesapis
+
+type AccessModeSettingsExclusion struct {
+	IngestionAccessMode           *AccessMode `json:"ingestionAccessMode,omitempty"`
+	PrivateEndpointConnectionName *string     `json:"privateEndpointConnectionName,omitempty"`
+	QueryAccessMode               *AccessMode `json:"queryAccessMode,omitempty"`
+}
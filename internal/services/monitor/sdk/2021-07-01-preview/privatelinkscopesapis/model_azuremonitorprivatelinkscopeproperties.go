@@ -0,0 +1,6 @@
+package privatelinkscopesapis
+
+type AzureMonitorPrivateLinkScopeProperties struct {
+	AccessModeSettings AccessModeSettings `json:"accessModeSettings"`
+	ProvisioningState  *string            `json:"provisioningState,omitempty"`
+}
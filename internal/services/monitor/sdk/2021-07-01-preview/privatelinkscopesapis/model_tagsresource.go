@@ -0,0 +1,5 @@
+package privatelinkscopesapis
+
+type TagsResource struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
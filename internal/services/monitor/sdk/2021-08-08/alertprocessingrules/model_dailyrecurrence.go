@@ -0,0 +1,39 @@
+package alertprocessingrules
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ Recurrence = DailyRecurrence{}
+
+type DailyRecurrence struct {
+	EndTime   *string `json:"endTime,omitempty"`
+	StartTime *string `json:"startTime,omitempty"`
+
+	// Fields inherited from Recurrence
+}
+
+var _ json.Marshaler = DailyRecurrence{}
+
+func (s DailyRecurrence) MarshalJSON() ([]byte, error) {
+	type wrapper DailyRecurrence
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling DailyRecurrence: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling DailyRecurrence: %+v", err)
+	}
+	decoded["recurrenceType"] = "Daily"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling DailyRecurrence: %+v", err)
+	}
+
+	return encoded, nil
+}
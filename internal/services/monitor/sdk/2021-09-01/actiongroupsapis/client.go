@@ -0,0 +1,15 @@
+package actiongroupsapis
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ActionGroupsAPIsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewActionGroupsAPIsClientWithBaseURI(endpoint string) ActionGroupsAPIsClient {
+	return ActionGroupsAPIsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,34 @@
+package actiongroupsapis
+
+import "strings"
+
+type ReceiverStatus string
+
+const (
+	ReceiverStatusDisabled     ReceiverStatus = "Disabled"
+	ReceiverStatusEnabled      ReceiverStatus = "Enabled"
+	ReceiverStatusNotSpecified ReceiverStatus = "NotSpecified"
+)
+
+func PossibleValuesForReceiverStatus() []string {
+	return []string{
+		string(ReceiverStatusDisabled),
+		string(ReceiverStatusEnabled),
+		string(ReceiverStatusNotSpecified),
+	}
+}
+
+func parseReceiverStatus(input string) (*ReceiverStatus, error) {
+	vals := map[string]ReceiverStatus{
+		"disabled":     ReceiverStatusDisabled,
+		"enabled":      ReceiverStatusEnabled,
+		"notspecified": ReceiverStatusNotSpecified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReceiverStatus(input)
+	return &out, nil
+}
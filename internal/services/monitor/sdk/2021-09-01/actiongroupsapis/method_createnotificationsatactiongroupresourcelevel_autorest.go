@@ -0,0 +1,66 @@
+package actiongroupsapis
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateNotificationsAtActionGroupResourceLevelResponse struct {
+	HttpResponse *http.Response
+	Model        *TestNotificationDetailsResponse
+}
+
+// CreateNotificationsAtActionGroupResourceLevel ...
+func (c ActionGroupsAPIsClient) CreateNotificationsAtActionGroupResourceLevel(ctx context.Context, id ActionGroupId, input NotificationRequestBody) (result CreateNotificationsAtActionGroupResourceLevelResponse, err error) {
+	req, err := c.preparerForCreateNotificationsAtActionGroupResourceLevel(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "CreateNotificationsAtActionGroupResourceLevel", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "CreateNotificationsAtActionGroupResourceLevel", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateNotificationsAtActionGroupResourceLevel(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "actiongroupsapis.ActionGroupsAPIsClient", "CreateNotificationsAtActionGroupResourceLevel", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateNotificationsAtActionGroupResourceLevel prepares the CreateNotificationsAtActionGroupResourceLevel request.
+func (c ActionGroupsAPIsClient) preparerForCreateNotificationsAtActionGroupResourceLevel(ctx context.Context, id ActionGroupId, input NotificationRequestBody) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/createNotifications", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateNotificationsAtActionGroupResourceLevel handles the response to the CreateNotificationsAtActionGroupResourceLevel request. The method always
+// closes the http.Response Body.
+func (c ActionGroupsAPIsClient) responderForCreateNotificationsAtActionGroupResourceLevel(resp *http.Response) (result CreateNotificationsAtActionGroupResourceLevelResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusAccepted, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
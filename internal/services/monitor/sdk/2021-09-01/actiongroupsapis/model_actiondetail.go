@@ -0,0 +1,10 @@
+package actiongroupsapis
+
+type ActionDetail struct {
+	Detail        *string `json:"Detail,omitempty"`
+	MechanismType *string `json:"MechanismType,omitempty"`
+	Name          *string `json:"Name,omitempty"`
+	SendTime      *string `json:"SendTime,omitempty"`
+	Status        *string `json:"Status,omitempty"`
+	SubState      *string `json:"SubState,omitempty"`
+}
@@ -0,0 +1,9 @@
+package actiongroupsapis
+
+type AzureFunctionReceiver struct {
+	FunctionAppResourceId string `json:"functionAppResourceId"`
+	FunctionName          string `json:"functionName"`
+	HTTPTriggerUrl        string `json:"httpTriggerUrl"`
+	Name                  string `json:"name"`
+	UseCommonAlertSchema  *bool  `json:"useCommonAlertSchema,omitempty"`
+}
@@ -0,0 +1,6 @@
+package actiongroupsapis
+
+type Context struct {
+	ContextType        *string `json:"contextType,omitempty"`
+	NotificationSource *string `json:"notificationSource,omitempty"`
+}
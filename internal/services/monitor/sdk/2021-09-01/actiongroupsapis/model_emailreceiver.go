@@ -0,0 +1,8 @@
+package actiongroupsapis
+
+type EmailReceiver struct {
+	EmailAddress         string          `json:"emailAddress"`
+	Name                 string          `json:"name"`
+	Status               *ReceiverStatus `json:"status,omitempty"`
+	UseCommonAlertSchema *bool           `json:"useCommonAlertSchema,omitempty"`
+}
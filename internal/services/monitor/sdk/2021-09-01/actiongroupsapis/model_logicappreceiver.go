@@ -0,0 +1,8 @@
+package actiongroupsapis
+
+type LogicAppReceiver struct {
+	CallbackUrl          string `json:"callbackUrl"`
+	Name                 string `json:"name"`
+	ResourceId           string `json:"resourceId"`
+	UseCommonAlertSchema *bool  `json:"useCommonAlertSchema,omitempty"`
+}
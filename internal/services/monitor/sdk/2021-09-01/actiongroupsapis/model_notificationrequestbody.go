@@ -0,0 +1,16 @@
+package actiongroupsapis
+
+type NotificationRequestBody struct {
+	AlertType                  string                       `json:"alertType"`
+	ArmRoleReceivers           *[]ArmRoleReceiver           `json:"armRoleReceivers,omitempty"`
+	AutomationRunbookReceivers *[]AutomationRunbookReceiver `json:"automationRunbookReceivers,omitempty"`
+	AzureAppPushReceivers      *[]AzureAppPushReceiver      `json:"azureAppPushReceivers,omitempty"`
+	AzureFunctionReceivers     *[]AzureFunctionReceiver     `json:"azureFunctionReceivers,omitempty"`
+	EmailReceivers             *[]EmailReceiver             `json:"emailReceivers,omitempty"`
+	EventHubReceivers          *[]EventHubReceiver          `json:"eventHubReceivers,omitempty"`
+	ItsmReceivers              *[]ItsmReceiver              `json:"itsmReceivers,omitempty"`
+	LogicAppReceivers          *[]LogicAppReceiver          `json:"logicAppReceivers,omitempty"`
+	SmsReceivers               *[]SmsReceiver               `json:"smsReceivers,omitempty"`
+	VoiceReceivers             *[]VoiceReceiver             `json:"voiceReceivers,omitempty"`
+	WebhookReceivers           *[]WebhookReceiver           `json:"webhookReceivers,omitempty"`
+}
@@ -0,0 +1,8 @@
+package datacollectionruleassociations
+
+type DataCollectionRuleAssociation struct {
+	DataCollectionEndpointId *string `json:"dataCollectionEndpointId,omitempty"`
+	DataCollectionRuleId     *string `json:"dataCollectionRuleId,omitempty"`
+	Description              *string `json:"description,omitempty"`
+	ProvisioningState        *string `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,15 @@
+package autoscalesettings
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AutoScaleSettingsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAutoScaleSettingsClientWithBaseURI(endpoint string) AutoScaleSettingsClient {
+	return AutoScaleSettingsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,7 @@
+package autoscalesettings
+
+type EmailNotification struct {
+	CustomEmails                       *[]string `json:"customEmails,omitempty"`
+	SendToSubscriptionAdministrator    *bool     `json:"sendToSubscriptionAdministrator,omitempty"`
+	SendToSubscriptionCoAdministrators *bool     `json:"sendToSubscriptionCoAdministrators,omitempty"`
+}
@@ -0,0 +1,6 @@
+package autoscalesettings
+
+type PredictiveAutoscalePolicy struct {
+	ScaleLookAheadTime *string                            `json:"scaleLookAheadTime,omitempty"`
+	ScaleMode          PredictiveAutoscalePolicyScaleMode `json:"scaleMode"`
+}
@@ -0,0 +1,6 @@
+package autoscalesettings
+
+type Recurrence struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Schedule  RecurrentSchedule   `json:"schedule"`
+}
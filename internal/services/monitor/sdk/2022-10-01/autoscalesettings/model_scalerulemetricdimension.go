@@ -0,0 +1,7 @@
+package autoscalesettings
+
+type ScaleRuleMetricDimension struct {
+	DimensionName string                                `json:"DimensionName"`
+	Operator      ScaleRuleMetricDimensionOperationType `json:"Operator"`
+	Values        []string                              `json:"Values"`
+}
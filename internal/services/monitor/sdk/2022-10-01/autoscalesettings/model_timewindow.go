@@ -0,0 +1,7 @@
+package autoscalesettings
+
+type TimeWindow struct {
+	End      string  `json:"end"`
+	Start    string  `json:"start"`
+	TimeZone *string `json:"timeZone,omitempty"`
+}
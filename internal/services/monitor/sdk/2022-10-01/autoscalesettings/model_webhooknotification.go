@@ -0,0 +1,6 @@
+package autoscalesettings
+
+type WebhookNotification struct {
+	Properties *map[string]string `json:"properties,omitempty"`
+	ServiceUri *string            `json:"serviceUri,omitempty"`
+}
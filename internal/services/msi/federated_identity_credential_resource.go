@@ -0,0 +1,219 @@
+package msi
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2023-01-31/federatedidentitycredentials"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// Azure only allows a single write to the Federated Identity Credentials of a User Assigned Identity at a time
+// and rejects any concurrent writes with a Conflict - as such these are serialized per User Assigned Identity
+// and any remaining conflicts (e.g. from writes outside of Terraform) are retried.
+const federatedIdentityCredentialConcurrentWritesErrorCode = "ConcurrentFederatedIdentityCredentialsWritesForSingleManagedIdentity"
+
+func resourceArmFederatedIdentityCredential() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceArmFederatedIdentityCredentialCreateUpdate,
+		Read:   resourceArmFederatedIdentityCredentialRead,
+		Update: resourceArmFederatedIdentityCredentialCreateUpdate,
+		Delete: resourceArmFederatedIdentityCredentialDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FederatedIdentityCredentialName,
+			},
+
+			"parent_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: federatedidentitycredentials.ValidateUserAssignedIdentityID,
+			},
+
+			// the API accepts a list but currently only supports a single audience
+			"audience": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.FederatedIdentityCredentialAudience,
+				},
+			},
+
+			"issuer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"subject": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 600),
+			},
+		},
+	}
+}
+
+func resourceArmFederatedIdentityCredentialCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := federatedidentitycredentials.ParseUserAssignedIdentityID(d.Get("parent_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := federatedidentitycredentials.NewFederatedIdentityCredentialID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.UserAssignedIdentityName, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_federated_identity_credential", id.ID())
+		}
+	}
+
+	audiences := make([]string, 0)
+	for _, v := range d.Get("audience").([]interface{}) {
+		audiences = append(audiences, v.(string))
+	}
+
+	payload := federatedidentitycredentials.FederatedIdentityCredential{
+		Properties: &federatedidentitycredentials.FederatedIdentityCredentialProperties{
+			Audiences: audiences,
+			Issuer:    d.Get("issuer").(string),
+			Subject:   d.Get("subject").(string),
+		},
+	}
+
+	timeout := d.Timeout(pluginsdk.TimeoutCreate)
+	if !d.IsNewResource() {
+		timeout = d.Timeout(pluginsdk.TimeoutUpdate)
+	}
+
+	locks.ByID(parentId.ID())
+	defer locks.UnlockByID(parentId.ID())
+
+	err = pluginsdk.Retry(timeout, func() *pluginsdk.RetryError {
+		resp, err := client.CreateOrUpdate(ctx, id, payload)
+		if err != nil {
+			if wasFederatedIdentityCredentialConcurrentWrite(resp.HttpResponse, err) {
+				log.Printf("[DEBUG] Concurrent write to the Federated Identity Credentials of %s, retrying..", *parentId)
+				return pluginsdk.RetryableError(err)
+			}
+			return pluginsdk.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceArmFederatedIdentityCredentialRead(d, meta)
+}
+
+func resourceArmFederatedIdentityCredentialRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.FederatedIdentityCredentialName)
+	d.Set("parent_id", federatedidentitycredentials.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, id.UserAssignedIdentityName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("audience", utils.FlattenStringSlice(&props.Audiences))
+			d.Set("issuer", props.Issuer)
+			d.Set("subject", props.Subject)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFederatedIdentityCredentialDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSI.FederatedIdentityCredentialsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parentId := federatedidentitycredentials.NewUserAssignedIdentityID(id.SubscriptionId, id.ResourceGroupName, id.UserAssignedIdentityName)
+	locks.ByID(parentId.ID())
+	defer locks.UnlockByID(parentId.ID())
+
+	err = pluginsdk.Retry(d.Timeout(pluginsdk.TimeoutDelete), func() *pluginsdk.RetryError {
+		resp, err := client.Delete(ctx, *id)
+		if err != nil {
+			if wasFederatedIdentityCredentialConcurrentWrite(resp.HttpResponse, err) {
+				log.Printf("[DEBUG] Concurrent write to the Federated Identity Credentials of %s, retrying..", parentId)
+				return pluginsdk.RetryableError(err)
+			}
+			return pluginsdk.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func wasFederatedIdentityCredentialConcurrentWrite(resp *http.Response, err error) bool {
+	return response.WasConflict(resp) || strings.Contains(err.Error(), federatedIdentityCredentialConcurrentWritesErrorCode)
+}
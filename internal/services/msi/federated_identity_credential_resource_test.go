@@ -0,0 +1,177 @@
+package msi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/sdk/2023-01-31/federatedidentitycredentials"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FederatedIdentityCredentialResource struct{}
+
+func TestAccFederatedIdentityCredential_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credential", "test")
+	r := FederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFederatedIdentityCredential_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credential", "test")
+	r := FederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccFederatedIdentityCredential_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credential", "test")
+	r := FederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccFederatedIdentityCredential_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_federated_identity_credential", "test")
+	r := FederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_federated_identity_credential.test.0").ExistsInAzure(r),
+				check.That("azurerm_federated_identity_credential.test.1").ExistsInAzure(r),
+				check.That("azurerm_federated_identity_credential.test.2").ExistsInAzure(r),
+				check.That("azurerm_federated_identity_credential.test.3").ExistsInAzure(r),
+				check.That("azurerm_federated_identity_credential.test.4").ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (r FederatedIdentityCredentialResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := federatedidentitycredentials.ParseFederatedIdentityCredentialID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSI.FederatedIdentityCredentialsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r FederatedIdentityCredentialResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_federated_identity_credential" "test" {
+  name      = "acctest-%s"
+  parent_id = azurerm_user_assigned_identity.test.id
+  audience  = ["api://AzureADTokenExchange"]
+  issuer    = "https://token.actions.githubusercontent.com"
+  subject   = "repo:hashicorp/terraform-provider-azurerm:ref:refs/heads/main"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r FederatedIdentityCredentialResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_federated_identity_credential" "test" {
+  name      = "acctest-%s"
+  parent_id = azurerm_user_assigned_identity.test.id
+  audience  = ["api://AzureADTokenExchangeUpdated"]
+  issuer    = "https://token.actions.githubusercontent.com"
+  subject   = "repo:hashicorp/terraform-provider-azurerm:environment:production"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r FederatedIdentityCredentialResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_federated_identity_credential" "import" {
+  name      = azurerm_federated_identity_credential.test.name
+  parent_id = azurerm_federated_identity_credential.test.parent_id
+  audience  = azurerm_federated_identity_credential.test.audience
+  issuer    = azurerm_federated_identity_credential.test.issuer
+  subject   = azurerm_federated_identity_credential.test.subject
+}
+`, r.basic(data))
+}
+
+func (r FederatedIdentityCredentialResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_federated_identity_credential" "test" {
+  count = 5
+
+  name      = "acctest-%s-${count.index}"
+  parent_id = azurerm_user_assigned_identity.test.id
+  audience  = ["api://AzureADTokenExchange"]
+  issuer    = "https://token.actions.githubusercontent.com"
+  subject   = "repo:hashicorp/terraform-provider-azurerm:environment:env${count.index}"
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r FederatedIdentityCredentialResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
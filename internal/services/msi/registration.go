@@ -30,6 +30,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_user_assigned_identity": resourceArmUserAssignedIdentity(),
+		"azurerm_federated_identity_credential": resourceArmFederatedIdentityCredential(),
+		"azurerm_user_assigned_identity":        resourceArmUserAssignedIdentity(),
 	}
 }
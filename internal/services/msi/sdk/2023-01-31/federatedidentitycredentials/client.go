@@ -0,0 +1,15 @@
+package federatedidentitycredentials
+
+import "github.com/Azure/go-autorest/autorest"
+
+type FederatedIdentityCredentialsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFederatedIdentityCredentialsClientWithBaseURI(endpoint string) FederatedIdentityCredentialsClient {
+	return FederatedIdentityCredentialsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
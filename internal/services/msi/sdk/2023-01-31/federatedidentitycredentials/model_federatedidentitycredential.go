@@ -0,0 +1,8 @@
+package federatedidentitycredentials
+
+type FederatedIdentityCredential struct {
+	Id         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties *FederatedIdentityCredentialProperties `json:"properties,omitempty"`
+	Type       *string                                `json:"type,omitempty"`
+}
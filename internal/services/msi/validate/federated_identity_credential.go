@@ -0,0 +1,44 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+func FederatedIdentityCredentialName(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{2,119}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 3 and 120 characters, start with a letter or number and may only contain letters, numbers, hyphens and underscores", key))
+	}
+
+	return
+}
+
+func FederatedIdentityCredentialAudience(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if strings.TrimSpace(v) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", key))
+		return
+	}
+
+	if strings.ContainsAny(v, " \t\r\n") {
+		errors = append(errors, fmt.Errorf("%q must not contain whitespace", key))
+	}
+
+	if len(v) > 600 {
+		errors = append(errors, fmt.Errorf("%q must be at most 600 characters", key))
+	}
+
+	return
+}
@@ -0,0 +1,98 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFederatedIdentityCredentialName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "ab",
+			Valid: false,
+		},
+		{
+			Input: "abc",
+			Valid: true,
+		},
+		{
+			Input: "-abc",
+			Valid: false,
+		},
+		{
+			Input: "github_actions-main",
+			Valid: true,
+		},
+		{
+			Input: "kubernetes.default",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 120),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 121),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := FederatedIdentityCredentialName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
+
+func TestFederatedIdentityCredentialAudience(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "   ",
+			Valid: false,
+		},
+		{
+			Input: "api://AzureADTokenExchange",
+			Valid: true,
+		},
+		{
+			Input: "api://AzureADTokenExchange ",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 600),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 601),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := FederatedIdentityCredentialAudience(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -18,6 +18,10 @@ type Client struct {
 	FirewallRulesClient                                *sql.FirewallRulesClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
+	JobStepsClient                                     *sql.JobStepsClient
+	JobTargetGroupsClient                              *sql.JobTargetGroupsClient
+	JobsClient                                         *sql.JobsClient
+	LedgerDigestUploadsClient                          *sql.LedgerDigestUploadsClient
 	ReplicationLinksClient                             *sql.ReplicationLinksClient
 	RestorableDroppedDatabasesClient                   *sql.RestorableDroppedDatabasesClient
 	ServerAzureADAdministratorsClient                  *sql.ServerAzureADAdministratorsClient
@@ -63,6 +67,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	jobCredentialsClient := sql.NewJobCredentialsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobCredentialsClient.Client, o.ResourceManagerAuthorizer)
 
+	jobStepsClient := sql.NewJobStepsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobStepsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobTargetGroupsClient := sql.NewJobTargetGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobTargetGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobsClient := sql.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
+	ledgerDigestUploadsClient := sql.NewLedgerDigestUploadsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ledgerDigestUploadsClient.Client, o.ResourceManagerAuthorizer)
+
 	failoverGroupsClient := sql.NewFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&failoverGroupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -125,6 +141,10 @@ func NewClient(o *common.ClientOptions) *Client {
 		ElasticPoolsClient:                                 &elasticPoolsClient,
 		JobAgentsClient:                                    &jobAgentsClient,
 		JobCredentialsClient:                               &jobCredentialsClient,
+		JobStepsClient:                                     &jobStepsClient,
+		JobTargetGroupsClient:                              &jobTargetGroupsClient,
+		JobsClient:                                         &jobsClient,
+		LedgerDigestUploadsClient:                          &ledgerDigestUploadsClient,
 		FailoverGroupsClient:                               &failoverGroupsClient,
 		FirewallRulesClient:                                &firewallRulesClient,
 		ReplicationLinksClient:                             &replicationLinksClient,
@@ -45,6 +45,24 @@ func dataSourceMsSqlDatabase() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"ledger_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"ledger_digest_upload": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_endpoint": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"license_type": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -87,6 +105,7 @@ func dataSourceMsSqlDatabase() *pluginsdk.Resource {
 
 func dataSourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MSSQL.DatabasesClient
+	ledgerDigestUploadsClient := meta.(*clients.Client).MSSQL.LedgerDigestUploadsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -113,6 +132,7 @@ func dataSourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) er
 	if props := resp.DatabaseProperties; props != nil {
 		d.Set("collation", props.Collation)
 		d.Set("elastic_pool_id", props.ElasticPoolID)
+		d.Set("ledger_enabled", props.IsLedgerOn != nil && *props.IsLedgerOn)
 		d.Set("license_type", props.LicenseType)
 		if props.MaxSizeBytes != nil {
 			d.Set("max_size_gb", int32((*props.MaxSizeBytes)/int64(1073741824)))
@@ -128,5 +148,13 @@ func dataSourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) er
 		d.Set("zone_redundant", props.ZoneRedundant)
 	}
 
+	ledgerResp, err := ledgerDigestUploadsClient.Get(ctx, serverId.ResourceGroup, serverId.Name, name)
+	if err != nil {
+		return fmt.Errorf("retrieving Ledger Digest Uploads for Database %q (Resource Group %q, SQL Server %q): %+v", name, serverId.ResourceGroup, serverId.Name, err)
+	}
+	if err := d.Set("ledger_digest_upload", flattenMsSqlDatabaseLedgerDigestUpload(ledgerResp.LedgerDigestUploadsProperties)); err != nil {
+		return fmt.Errorf("setting `ledger_digest_upload`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
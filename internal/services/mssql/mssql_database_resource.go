@@ -119,6 +119,28 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"ledger_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"ledger_digest_upload": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"storage_endpoint": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsURLWithHTTPS,
+						},
+					},
+				},
+			},
+
 			"long_term_retention_policy": helper.LongTermRetentionPolicySchema(),
 
 			"short_term_retention_policy": helper.ShortTermRetentionPolicySchema(),
@@ -385,6 +407,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	replicationLinksClient := meta.(*clients.Client).MSSQL.ReplicationLinksClient
 	resourcesClient := meta.(*clients.Client).Resource.ResourcesClient
 	transparentEncryptionClient := meta.(*clients.Client).MSSQL.TransparentDataEncryptionsClient
+	ledgerDigestUploadsClient := meta.(*clients.Client).MSSQL.LedgerDigestUploadsClient
 
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -500,6 +523,7 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 			LicenseType:                      sql.DatabaseLicenseType(d.Get("license_type").(string)),
 			MinCapacity:                      utils.Float(d.Get("min_capacity").(float64)),
 			HighAvailabilityReplicaCount:     utils.Int32(int32(d.Get("read_replica_count").(int))),
+			IsLedgerOn:                       utils.Bool(d.Get("ledger_enabled").(bool)),
 			SampleName:                       sql.SampleName(d.Get("sample_name").(string)),
 			RequestedBackupStorageRedundancy: expandMsSqlBackupStorageRedundancy(d.Get("storage_account_type").(string)),
 			ZoneRedundant:                    utils.Bool(d.Get("zone_redundant").(bool)),
@@ -675,6 +699,28 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("ledger_digest_upload") {
+		if createMode == string(sql.CreateModeOnlineSecondary) || createMode == string(sql.CreateModeSecondary) {
+			return fmt.Errorf("cannot configure `ledger_digest_upload` in secondary create mode for %s", id)
+		}
+
+		if v := d.Get("ledger_digest_upload").([]interface{}); len(v) > 0 && v[0] != nil {
+			raw := v[0].(map[string]interface{})
+			payload := sql.LedgerDigestUploads{
+				LedgerDigestUploadsProperties: &sql.LedgerDigestUploadsProperties{
+					DigestStorageEndpoint: utils.String(raw["storage_endpoint"].(string)),
+				},
+			}
+			if _, err := ledgerDigestUploadsClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.Name, payload); err != nil {
+				return fmt.Errorf("enabling Ledger Digest Uploads for %s: %+v", id, err)
+			}
+		} else if !d.IsNewResource() {
+			if _, err := ledgerDigestUploadsClient.Disable(ctx, id.ResourceGroup, id.ServerName, id.Name); err != nil {
+				return fmt.Errorf("disabling Ledger Digest Uploads for %s: %+v", id, err)
+			}
+		}
+	}
+
 	if d.HasChange("short_term_retention_policy") {
 		v := d.Get("short_term_retention_policy")
 		backupShortTermPolicyProps := helper.ExpandShortTermRetentionPolicy(v.([]interface{}))
@@ -707,6 +753,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	shortTermRetentionClient := meta.(*clients.Client).MSSQL.BackupShortTermRetentionPoliciesClient
 	geoBackupPoliciesClient := meta.(*clients.Client).MSSQL.GeoBackupPoliciesClient
 	transparentEncryptionClient := meta.(*clients.Client).MSSQL.TransparentDataEncryptionsClient
+	ledgerDigestUploadsClient := meta.(*clients.Client).MSSQL.LedgerDigestUploadsClient
 
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -736,6 +783,7 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		d.Set("collation", props.Collation)
 		d.Set("elastic_pool_id", props.ElasticPoolID)
 		d.Set("license_type", props.LicenseType)
+		d.Set("ledger_enabled", props.IsLedgerOn != nil && *props.IsLedgerOn)
 		if props.MaxSizeBytes != nil {
 			d.Set("max_size_gb", int32((*props.MaxSizeBytes)/int64(1073741824)))
 		}
@@ -772,6 +820,19 @@ func resourceMsSqlDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 	d.Set("extended_auditing_policy", extendedAuditingPolicy)
 
+	ledgerDigestUpload := []interface{}{}
+	if createMode, ok := d.GetOk("create_mode"); !ok || (createMode.(string) != "Secondary" && createMode.(string) != "OnlineSecondary") {
+		ledgerResp, err := ledgerDigestUploadsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving Ledger Digest Uploads for %s: %+v", id, err)
+		}
+
+		ledgerDigestUpload = flattenMsSqlDatabaseLedgerDigestUpload(ledgerResp.LedgerDigestUploadsProperties)
+	}
+	if err := d.Set("ledger_digest_upload", ledgerDigestUpload); err != nil {
+		return fmt.Errorf("setting `ledger_digest_upload`: %+v", err)
+	}
+
 	geoBackupPolicy := true
 
 	// Hyper Scale SKU's do not currently support LRP and do not honour normal SRP operations
@@ -957,6 +1018,19 @@ func expandMsSqlServerSecurityAlertPolicy(d *pluginsdk.ResourceData) sql.Databas
 }
 
 // TODO - 3.0: change output to API enums
+func flattenMsSqlDatabaseLedgerDigestUpload(input *sql.LedgerDigestUploadsProperties) []interface{} {
+	// the API always returns the digest upload configuration, which is only meaningful whilst it's enabled
+	if input == nil || input.State != sql.LedgerDigestUploadsStateEnabled || input.DigestStorageEndpoint == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_endpoint": *input.DigestStorageEndpoint,
+		},
+	}
+}
+
 func flattenMsSqlBackupStorageRedundancy(currentBackupStorageRedundancy sql.CurrentBackupStorageRedundancy) string {
 	switch currentBackupStorageRedundancy {
 	case sql.CurrentBackupStorageRedundancyLocal:
@@ -698,6 +698,39 @@ func TestAccMsSqlDatabase_geoBackupPolicy(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_ledger(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ledger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ledger_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("ledger_digest_upload.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.ledgerDigestUpload(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ledger_digest_upload.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.ledger(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("ledger_digest_upload.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMsSqlDatabase_transitDataEncryption(t *testing.T) {
 	if !features.ThreePointOh() {
 		t.Skipf("This test runs only on 3.0")
@@ -1748,3 +1781,73 @@ resource "azurerm_mssql_database" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r MsSqlDatabaseResource) ledgerTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_mssql_server.test.identity.0.principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r MsSqlDatabaseResource) ledger(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name           = "acctest-db-%[2]d"
+  server_id      = azurerm_mssql_server.test.id
+  ledger_enabled = true
+}
+`, r.ledgerTemplate(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseResource) ledgerDigestUpload(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_database" "test" {
+  name           = "acctest-db-%[2]d"
+  server_id      = azurerm_mssql_server.test.id
+  ledger_enabled = true
+
+  ledger_digest_upload {
+    storage_endpoint = azurerm_storage_account.test.primary_blob_endpoint
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.ledgerTemplate(data), data.RandomInteger)
+}
@@ -0,0 +1,136 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlJobResource struct{}
+
+func TestAccMsSqlJob_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job", "test")
+	r := MsSqlJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJob_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job", "test")
+	r := MsSqlJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJob_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job", "test")
+	r := MsSqlJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.type").HasValue("Recurring"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.JobID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MsSqlJobResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctest-job-%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+`, MsSqlJobTargetGroupResource{}.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job" "import" {
+  name         = azurerm_mssql_job.test.name
+  job_agent_id = azurerm_mssql_job.test.job_agent_id
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctest-job-%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  description  = "Rebuilds the indexes of each database every day"
+
+  schedule {
+    type       = "Recurring"
+    interval   = "P1D"
+    start_time = "2099-01-01T02:00:00Z"
+    end_time   = "2099-12-31T02:00:00Z"
+  }
+}
+`, MsSqlJobTargetGroupResource{}.template(data), data.RandomInteger)
+}
@@ -0,0 +1,361 @@
+package mssql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlJobStep() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlJobStepCreateUpdate,
+		Read:   resourceMsSqlJobStepRead,
+		Update: resourceMsSqlJobStepCreateUpdate,
+		Delete: resourceMsSqlJobStepDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.JobStepID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobID,
+			},
+
+			"job_step_index": {
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"job_target_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.JobTargetGroupID,
+			},
+
+			"job_credential_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.JobCredentialID,
+			},
+
+			"sql_script": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"timeout_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      43200,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_attempts": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"initial_retry_interval_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"maximum_retry_interval_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      120,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_interval_backoff_multiplier": {
+				Type:         pluginsdk.TypeFloat,
+				Optional:     true,
+				Default:      2.0,
+				ValidateFunc: validation.FloatAtLeast(1),
+			},
+
+			"output_target": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mssql_database_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.DatabaseID,
+						},
+
+						"job_credential_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.JobCredentialID,
+						},
+
+						"table_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"schema_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "dbo",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobStepCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobStepsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	jobId, err := parse.JobID(d.Get("job_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewJobStepID(jobId.SubscriptionId, jobId.ResourceGroup, jobId.ServerName, jobId.JobAgentName, jobId.Name, d.Get("name").(string))
+
+	// each change to a Job Step creates a new version of the Job, so changes to the Steps of a Job are serialised
+	locks.ByID(jobId.ID())
+	defer locks.UnlockByID(jobId.ID())
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job_step", id.ID())
+		}
+	}
+
+	output, err := expandMsSqlJobStepOutput(d.Get("output_target").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	step := sql.JobStep{
+		JobStepProperties: &sql.JobStepProperties{
+			StepID:      utils.Int32(int32(d.Get("job_step_index").(int))),
+			TargetGroup: utils.String(d.Get("job_target_group_id").(string)),
+			Credential:  utils.String(d.Get("job_credential_id").(string)),
+			Action: &sql.JobStepAction{
+				Type:   sql.JobStepActionTypeTSQL,
+				Source: sql.JobStepActionSourceInline,
+				Value:  utils.String(d.Get("sql_script").(string)),
+			},
+			Output: output,
+			ExecutionOptions: &sql.JobStepExecutionOptions{
+				TimeoutSeconds:                 utils.Int32(int32(d.Get("timeout_seconds").(int))),
+				RetryAttempts:                  utils.Int32(int32(d.Get("retry_attempts").(int))),
+				InitialRetryIntervalSeconds:    utils.Int32(int32(d.Get("initial_retry_interval_seconds").(int))),
+				MaximumRetryIntervalSeconds:    utils.Int32(int32(d.Get("maximum_retry_interval_seconds").(int))),
+				RetryIntervalBackoffMultiplier: utils.Float(d.Get("retry_interval_backoff_multiplier").(float64)),
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName, step); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlJobStepRead(d, meta)
+}
+
+func resourceMsSqlJobStepRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobStepsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobStepID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.Set("name", id.StepName)
+	d.Set("job_id", parse.NewJobID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName).ID())
+
+	if props := resp.JobStepProperties; props != nil {
+		d.Set("job_step_index", props.StepID)
+
+		targetGroupId := ""
+		if props.TargetGroup != nil {
+			targetGroupId = *props.TargetGroup
+			if v, err := parse.JobTargetGroupID(targetGroupId); err == nil {
+				targetGroupId = v.ID()
+			}
+		}
+		d.Set("job_target_group_id", targetGroupId)
+
+		credentialId := ""
+		if props.Credential != nil {
+			credentialId = *props.Credential
+			if v, err := parse.JobCredentialID(credentialId); err == nil {
+				credentialId = v.ID()
+			}
+		}
+		d.Set("job_credential_id", credentialId)
+
+		sqlScript := ""
+		if props.Action != nil && props.Action.Value != nil {
+			sqlScript = *props.Action.Value
+		}
+		d.Set("sql_script", sqlScript)
+
+		if options := props.ExecutionOptions; options != nil {
+			d.Set("timeout_seconds", options.TimeoutSeconds)
+			d.Set("retry_attempts", options.RetryAttempts)
+			d.Set("initial_retry_interval_seconds", options.InitialRetryIntervalSeconds)
+			d.Set("maximum_retry_interval_seconds", options.MaximumRetryIntervalSeconds)
+			d.Set("retry_interval_backoff_multiplier", options.RetryIntervalBackoffMultiplier)
+		}
+
+		if err := d.Set("output_target", flattenMsSqlJobStepOutput(props.Output)); err != nil {
+			return fmt.Errorf("setting `output_target`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobStepDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobStepsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobStepID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	jobId := parse.NewJobID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName)
+	locks.ByID(jobId.ID())
+	defer locks.UnlockByID(jobId.ID())
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobStepOutput(input []interface{}) (*sql.JobStepOutput, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	databaseId, err := parse.DatabaseID(v["mssql_database_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionId, err := uuid.FromString(databaseId.SubscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Subscription ID %q of the `output_target` as a UUID: %+v", databaseId.SubscriptionId, err)
+	}
+
+	return &sql.JobStepOutput{
+		Type:              sql.JobStepOutputTypeSQLDatabase,
+		SubscriptionID:    &subscriptionId,
+		ResourceGroupName: utils.String(databaseId.ResourceGroup),
+		ServerName:        utils.String(databaseId.ServerName),
+		DatabaseName:      utils.String(databaseId.Name),
+		SchemaName:        utils.String(v["schema_name"].(string)),
+		TableName:         utils.String(v["table_name"].(string)),
+		Credential:        utils.String(v["job_credential_id"].(string)),
+	}, nil
+}
+
+func flattenMsSqlJobStepOutput(input *sql.JobStepOutput) []interface{} {
+	if input == nil || input.SubscriptionID == nil || input.ResourceGroupName == nil || input.ServerName == nil || input.DatabaseName == nil {
+		return []interface{}{}
+	}
+
+	databaseId := parse.NewDatabaseID(input.SubscriptionID.String(), *input.ResourceGroupName, *input.ServerName, *input.DatabaseName)
+
+	credentialId := ""
+	if input.Credential != nil {
+		credentialId = *input.Credential
+		if v, err := parse.JobCredentialID(credentialId); err == nil {
+			credentialId = v.ID()
+		}
+	}
+
+	schemaName := ""
+	if input.SchemaName != nil {
+		schemaName = *input.SchemaName
+	}
+
+	tableName := ""
+	if input.TableName != nil {
+		tableName = *input.TableName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mssql_database_id": databaseId.ID(),
+			"job_credential_id": credentialId,
+			"schema_name":       schemaName,
+			"table_name":        tableName,
+		},
+	}
+}
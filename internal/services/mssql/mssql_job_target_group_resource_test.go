@@ -0,0 +1,204 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlJobTargetGroupResource struct{}
+
+func TestAccMsSqlJobTargetGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_target_group", "test")
+	r := MsSqlJobTargetGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJobTargetGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_target_group", "test")
+	r := MsSqlJobTargetGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJobTargetGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_target_group", "test")
+	r := MsSqlJobTargetGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("job_target.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobTargetGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.JobTargetGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobTargetGroupsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlJobTargetGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-jobs-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestmssqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dministr4t0r"
+  administrator_login_password = "superSecur3!!!"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctestmssqldb%[1]d"
+  server_id = azurerm_mssql_server.test.id
+  collation = "SQL_Latin1_General_CP1_CI_AS"
+  sku_name  = "S1"
+}
+
+resource "azurerm_mssql_job_agent" "test" {
+  name        = "acctestmssqljobagent%[1]d"
+  location    = azurerm_resource_group.test.location
+  database_id = azurerm_mssql_database.test.id
+}
+
+resource "azurerm_mssql_job_credential" "test" {
+  name         = "acctestmssqljobcredential%[1]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  username     = "test"
+  password     = "test"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MsSqlJobTargetGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_job_target_group" "test" {
+  name         = "acctest-tg-%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobTargetGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_target_group" "import" {
+  name         = azurerm_mssql_job_target_group.test.name
+  job_agent_id = azurerm_mssql_job_target_group.test.job_agent_id
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobTargetGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                = "acctest-pool-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  server_name         = azurerm_mssql_server.test.name
+  max_size_gb         = 4.8828125
+
+  sku {
+    name     = "BasicPool"
+    tier     = "Basic"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 5
+  }
+}
+
+resource "azurerm_mssql_job_target_group" "test" {
+  name         = "acctest-tg-%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+
+  job_target {
+    server_name       = azurerm_mssql_server.test.name
+    job_credential_id = azurerm_mssql_job_credential.test.id
+  }
+
+  job_target {
+    server_name       = azurerm_mssql_server.test.name
+    elastic_pool_name = azurerm_mssql_elasticpool.test.name
+    job_credential_id = azurerm_mssql_job_credential.test.id
+  }
+
+  job_target {
+    server_name     = azurerm_mssql_server.test.name
+    database_name   = azurerm_mssql_database.test.name
+    membership_type = "Exclude"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
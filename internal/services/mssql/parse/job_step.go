@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type JobStepId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServerName     string
+	JobAgentName   string
+	JobName        string
+	StepName       string
+}
+
+func NewJobStepID(subscriptionId, resourceGroup, serverName, jobAgentName, jobName, stepName string) JobStepId {
+	return JobStepId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		JobAgentName:   jobAgentName,
+		JobName:        jobName,
+		StepName:       stepName,
+	}
+}
+
+func (id JobStepId) String() string {
+	segments := []string{
+		fmt.Sprintf("Step Name %q", id.StepName),
+		fmt.Sprintf("Job Name %q", id.JobName),
+		fmt.Sprintf("Job Agent Name %q", id.JobAgentName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Job Step", segmentsStr)
+}
+
+func (id JobStepId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/jobAgents/%s/jobs/%s/steps/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName)
+}
+
+// JobStepID parses a JobStep ID into an JobStepId struct
+func JobStepID(input string) (*JobStepId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := JobStepId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.JobAgentName, err = id.PopSegment("jobAgents"); err != nil {
+		return nil, err
+	}
+	if resourceId.JobName, err = id.PopSegment("jobs"); err != nil {
+		return nil, err
+	}
+	if resourceId.StepName, err = id.PopSegment("steps"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -40,6 +40,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_mssql_elasticpool":                                     resourceMsSqlElasticPool(),
 		"azurerm_mssql_job_agent":                                       resourceMsSqlJobAgent(),
 		"azurerm_mssql_job_credential":                                  resourceMsSqlJobCredential(),
+		"azurerm_mssql_job":                                             resourceMsSqlJob(),
+		"azurerm_mssql_job_step":                                        resourceMsSqlJobStep(),
+		"azurerm_mssql_job_target_group":                                resourceMsSqlJobTargetGroup(),
 		"azurerm_mssql_firewall_rule":                                   resourceMsSqlFirewallRule(),
 		"azurerm_mssql_server":                                          resourceMsSqlServer(),
 		"azurerm_mssql_server_extended_auditing_policy":                 resourceMsSqlServerExtendedAuditingPolicy(),
@@ -11,6 +11,8 @@ type Client struct {
 	VolumeClient           *netapp.VolumesClient
 	SnapshotClient         *netapp.SnapshotsClient
 	SnapshotPoliciesClient *netapp.SnapshotPoliciesClient
+	BackupPoliciesClient   *netapp.BackupPoliciesClient
+	VaultsClient           *netapp.VaultsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -29,11 +31,19 @@ func NewClient(o *common.ClientOptions) *Client {
 	snapshotPoliciesClient := netapp.NewSnapshotPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&snapshotPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	backupPoliciesClient := netapp.NewBackupPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&backupPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	vaultsClient := netapp.NewVaultsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AccountClient:          &accountClient,
 		PoolClient:             &poolClient,
 		VolumeClient:           &volumeClient,
 		SnapshotClient:         &snapshotClient,
 		SnapshotPoliciesClient: &snapshotPoliciesClient,
+		BackupPoliciesClient:   &backupPoliciesClient,
+		VaultsClient:           &vaultsClient,
 	}
 }
@@ -0,0 +1,217 @@
+package netapp
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/netapp/mgmt/2021-06-01/netapp"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/parse"
+	netAppValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetAppBackupPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetAppBackupPolicyCreate,
+		Read:   resourceNetAppBackupPolicyRead,
+		Update: resourceNetAppBackupPolicyUpdate,
+		Delete: resourceNetAppBackupPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.BackupPolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: netAppValidate.SnapshotName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: netAppValidate.AccountName,
+			},
+
+			"daily_backups_to_keep": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(2, 1019),
+			},
+
+			"weekly_backups_to_keep": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1019),
+			},
+
+			"monthly_backups_to_keep": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 1019),
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceNetAppBackupPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.BackupPoliciesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewBackupPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), d.Get("name").(string))
+	existing, err := client.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_netapp_backup_policy", id.ID())
+	}
+
+	parameters := netapp.BackupPolicy{
+		Location:               utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		BackupPolicyProperties: expandNetAppBackupPolicyProperties(d),
+		Tags:                   tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.Create(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetAppBackupPolicyRead(d, meta)
+}
+
+func resourceNetAppBackupPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.BackupPoliciesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	parameters := netapp.BackupPolicyPatch{
+		Location:               utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		BackupPolicyProperties: expandNetAppBackupPolicyProperties(d),
+		Tags:                   tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", *id, err)
+	}
+
+	return resourceNetAppBackupPolicyRead(d, meta)
+}
+
+func resourceNetAppBackupPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.BackupPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.NetAppAccountName)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+	if props := resp.BackupPolicyProperties; props != nil {
+		d.Set("daily_backups_to_keep", props.DailyBackupsToKeep)
+		d.Set("weekly_backups_to_keep", props.WeeklyBackupsToKeep)
+		d.Set("monthly_backups_to_keep", props.MonthlyBackupsToKeep)
+		d.Set("enabled", props.Enabled)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceNetAppBackupPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.BackupPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BackupPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.NetAppAccountName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandNetAppBackupPolicyProperties(d *pluginsdk.ResourceData) *netapp.BackupPolicyProperties {
+	return &netapp.BackupPolicyProperties{
+		DailyBackupsToKeep:   utils.Int32(int32(d.Get("daily_backups_to_keep").(int))),
+		WeeklyBackupsToKeep:  utils.Int32(int32(d.Get("weekly_backups_to_keep").(int))),
+		MonthlyBackupsToKeep: utils.Int32(int32(d.Get("monthly_backups_to_keep").(int))),
+		Enabled:              utils.Bool(d.Get("enabled").(bool)),
+	}
+}
@@ -0,0 +1,76 @@
+package netapp
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/netapp/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceNetAppBackupVault() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetAppBackupVaultRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AccountName,
+			},
+
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"location": azure.SchemaLocationForDataSource(),
+		},
+	}
+}
+
+func dataSourceNetAppBackupVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).NetApp.VaultsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId := parse.NewAccountID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string))
+
+	// the Backup Vault is provisioned by the service alongside the NetApp Account, there's only ever one per Account
+	resp, err := client.List(ctx, accountId.ResourceGroup, accountId.NetAppAccountName)
+	if err != nil {
+		return fmt.Errorf("listing Backup Vaults for %s: %+v", accountId, err)
+	}
+	if resp.Value == nil || len(*resp.Value) == 0 || (*resp.Value)[0].ID == nil {
+		return fmt.Errorf("no Backup Vault was found for %s", accountId)
+	}
+
+	vault := (*resp.Value)[0]
+	id, err := parse.BackupVaultID(*vault.ID)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("name", id.VaultName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.NetAppAccountName)
+
+	if location := vault.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	return nil
+}
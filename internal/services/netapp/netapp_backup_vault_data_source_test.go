@@ -0,0 +1,38 @@
+package netapp_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetAppBackupVaultDataSource struct {
+}
+
+func TestAccDataSourceNetAppBackupVault_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_netapp_backup_vault", "test")
+	r := NetAppBackupVaultDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("id").Exists(),
+				check.That(data.ResourceName).Key("name").Exists(),
+			),
+		},
+	})
+}
+
+func (NetAppBackupVaultDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_netapp_backup_vault" "test" {
+  resource_group_name = azurerm_netapp_account.test.resource_group_name
+  account_name        = azurerm_netapp_account.test.name
+}
+`, NetAppSnapshotPolicyResource{}.template(data))
+}
@@ -237,12 +237,12 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
 				MaxItems: 1,
-				ForceNew: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"endpoint_type": {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
+							ForceNew: true,
 							Default:  "dst",
 							ValidateFunc: validation.StringInSlice([]string{
 								"dst",
@@ -254,18 +254,64 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 						"remote_volume_resource_id": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
+							ForceNew:     true,
 							ValidateFunc: azure.ValidateResourceID,
 						},
 
 						"replication_frequency": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
+							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"10minutes",
 								"daily",
 								"hourly",
 							}, false),
 						},
+
+						// breaking and re-syncing the replication allows failing over to (and back from) the destination volume
+						"mirror_state": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(netapp.MirrorStateMirrored),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(netapp.MirrorStateBroken),
+								string(netapp.MirrorStateMirrored),
+							}, false),
+						},
+
+						"force_break_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"data_protection_backup_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"backup_policy_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: netAppValidate.BackupPolicyID,
+						},
+
+						"backup_vault_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: netAppValidate.BackupVaultID,
+						},
+
+						"policy_enforced": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
 					},
 				},
 			},
@@ -335,6 +381,7 @@ func resourceNetAppVolumeCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	dataProtectionReplication := expandNetAppVolumeDataProtectionReplication(dataProtectionReplicationRaw)
 	dataProtectionSnapshotPolicy := expandNetAppVolumeDataProtectionSnapshotPolicy(dataProtectionSnapshotPolicyRaw)
+	dataProtectionBackupPolicy := expandNetAppVolumeDataProtectionBackupPolicy(d.Get("data_protection_backup_policy").([]interface{}), !d.IsNewResource())
 
 	authorizeReplication := false
 	volumeType := ""
@@ -348,6 +395,10 @@ func resourceNetAppVolumeCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("snapshot policy cannot be enabled on a data protection volume, NetApp Volume %q (Resource Group %q)", id.Name, id.ResourceGroup)
 	}
 
+	if dataProtectionBackupPolicy.Backup != nil && dataProtectionBackupPolicy.Backup.BackupEnabled != nil && *dataProtectionBackupPolicy.Backup.BackupEnabled && volumeType != "" {
+		return fmt.Errorf("backup policy cannot be enabled on a data protection volume, NetApp Volume %q (Resource Group %q)", id.Name, id.ResourceGroup)
+	}
+
 	snapshotDirectoryVisible := d.Get("snapshot_directory_visible").(bool)
 
 	// Handling volume creation from snapshot case
@@ -432,6 +483,7 @@ func resourceNetAppVolumeCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 			DataProtection: &netapp.VolumePropertiesDataProtection{
 				Replication: dataProtectionReplication.Replication,
 				Snapshot:    dataProtectionSnapshotPolicy.Snapshot,
+				Backup:      dataProtectionBackupPolicy.Backup,
 			},
 			SnapshotDirectoryVisible: utils.Bool(snapshotDirectoryVisible),
 		},
@@ -456,8 +508,8 @@ func resourceNetAppVolumeCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	// If this is a data replication secondary volume, authorize replication on primary volume
-	if authorizeReplication {
+	// If this is a new data replication secondary volume, authorize replication on primary volume
+	if authorizeReplication && d.IsNewResource() {
 		replVolID, err := parse.VolumeID(*dataProtectionReplication.Replication.RemoteVolumeResourceID)
 		if err != nil {
 			return err
@@ -489,6 +541,14 @@ func resourceNetAppVolumeCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	if volumeType == "DataProtection" && d.HasChange("data_protection_replication.0.mirror_state") {
+		mirrorState := d.Get("data_protection_replication.0.mirror_state").(string)
+		forceBreak := d.Get("data_protection_replication.0.force_break_enabled").(bool)
+		if err := updateNetAppVolumeReplicationMirrorState(ctx, client, id, mirrorState, forceBreak); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceNetAppVolumeRead(d, meta)
@@ -538,12 +598,31 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if err := d.Set("mount_ip_addresses", flattenNetAppVolumeMountIPAddresses(props.MountTargets)); err != nil {
 			return fmt.Errorf("setting `mount_ip_addresses`: %+v", err)
 		}
-		if err := d.Set("data_protection_replication", flattenNetAppVolumeDataProtectionReplication(props.DataProtection)); err != nil {
+		dataProtectionReplication := flattenNetAppVolumeDataProtectionReplication(props.DataProtection)
+		if len(dataProtectionReplication) > 0 {
+			// the replication is `Uninitialized` until the baseline transfer completes, which is still heading towards `Mirrored`
+			mirrorState := string(netapp.MirrorStateMirrored)
+			status, err := client.ReplicationStatusMethod(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name)
+			if err != nil {
+				log.Printf("[DEBUG] retrieving replication status for %s: %+v", *id, err)
+				mirrorState = d.Get("data_protection_replication.0.mirror_state").(string)
+			} else if status.MirrorState == netapp.MirrorStateBroken {
+				mirrorState = string(netapp.MirrorStateBroken)
+			}
+
+			replication := dataProtectionReplication[0].(map[string]interface{})
+			replication["mirror_state"] = mirrorState
+			replication["force_break_enabled"] = d.Get("data_protection_replication.0.force_break_enabled").(bool)
+		}
+		if err := d.Set("data_protection_replication", dataProtectionReplication); err != nil {
 			return fmt.Errorf("setting `data_protection_replication`: %+v", err)
 		}
 		if err := d.Set("data_protection_snapshot_policy", flattenNetAppVolumeDataProtectionSnapshotPolicy(props.DataProtection)); err != nil {
 			return fmt.Errorf("setting `data_protection_snapshot_policy`: %+v", err)
 		}
+		if err := d.Set("data_protection_backup_policy", flattenNetAppVolumeDataProtectionBackupPolicy(props.DataProtection)); err != nil {
+			return fmt.Errorf("setting `data_protection_backup_policy`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -577,7 +656,7 @@ func resourceNetAppVolumeDelete(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 
 		// Checking replication status before deletion, it need to be broken before proceeding with deletion
-		if res, err := client.ReplicationStatusMethod(ctx, replicaVolumeId.ResourceGroup, replicaVolumeId.NetAppAccountName, replicaVolumeId.CapacityPoolName, replicaVolumeId.Name); err == nil {
+		if res, err := client.ReplicationStatusMethod(ctx, replicaVolumeId.ResourceGroup, replicaVolumeId.NetAppAccountName, replicaVolumeId.CapacityPoolName, replicaVolumeId.Name); err == nil && res.MirrorState != netapp.MirrorStateBroken {
 			// Wait for replication state = "mirrored"
 			if strings.ToLower(string(res.MirrorState)) == "uninitialized" {
 				if err := waitForReplMirrorState(ctx, client, *replicaVolumeId, "mirrored"); err != nil {
@@ -630,6 +709,63 @@ func resourceNetAppVolumeDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+func updateNetAppVolumeReplicationMirrorState(ctx context.Context, client *netapp.VolumesClient, id parse.VolumeId, desiredState string, forceBreak bool) error {
+	status, err := client.ReplicationStatusMethod(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving replication status for %s: %+v", id, err)
+	}
+
+	switch desiredState {
+	case string(netapp.MirrorStateBroken):
+		if status.MirrorState == netapp.MirrorStateBroken {
+			return nil
+		}
+
+		// the replication can't be broken until the baseline transfer has completed
+		if status.MirrorState == netapp.MirrorStateUninitialized {
+			if err := waitForReplMirrorState(ctx, client, id, "mirrored"); err != nil {
+				return fmt.Errorf("waiting for replica %s to become 'mirrored': %+v", id, err)
+			}
+		}
+
+		future, err := client.BreakReplication(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name, &netapp.BreakReplicationRequest{
+			ForceBreakReplication: utils.Bool(forceBreak),
+		})
+		if err != nil {
+			return fmt.Errorf("breaking replication for %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the breaking of replication for %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for the replication of %s to be in broken state", id)
+		if err := waitForReplMirrorState(ctx, client, id, "broken"); err != nil {
+			return fmt.Errorf("waiting for the breaking of replication for %s: %+v", id, err)
+		}
+
+	case string(netapp.MirrorStateMirrored):
+		if status.MirrorState != netapp.MirrorStateBroken {
+			return nil
+		}
+
+		// re-syncing from the destination volume overwrites any changes made to it while the replication was broken
+		future, err := client.ResyncReplication(ctx, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name)
+		if err != nil {
+			return fmt.Errorf("re-syncing replication for %s: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the re-sync of replication for %s: %+v", id, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for the replication of %s to be in mirrored state", id)
+		if err := waitForReplMirrorState(ctx, client, id, "mirrored"); err != nil {
+			return fmt.Errorf("waiting for the re-sync of replication for %s: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
 func waitForVolumeCreation(ctx context.Context, client *netapp.VolumesClient, id parse.VolumeId) error {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -1014,6 +1150,50 @@ func flattenNetAppVolumeDataProtectionReplication(input *netapp.VolumeProperties
 	}
 }
 
+func expandNetAppVolumeDataProtectionBackupPolicy(input []interface{}, disableIfRemoved bool) *netapp.VolumePropertiesDataProtection {
+	if len(input) == 0 || input[0] == nil {
+		// backups have to be explicitly disabled, omitting them from the payload leaves them as-is
+		if disableIfRemoved {
+			return &netapp.VolumePropertiesDataProtection{
+				Backup: &netapp.VolumeBackupProperties{
+					BackupEnabled: utils.Bool(false),
+				},
+			}
+		}
+		return &netapp.VolumePropertiesDataProtection{}
+	}
+
+	backupRaw := input[0].(map[string]interface{})
+
+	return &netapp.VolumePropertiesDataProtection{
+		Backup: &netapp.VolumeBackupProperties{
+			BackupEnabled:  utils.Bool(true),
+			BackupPolicyID: utils.String(backupRaw["backup_policy_id"].(string)),
+			VaultID:        utils.String(backupRaw["backup_vault_id"].(string)),
+			PolicyEnforced: utils.Bool(backupRaw["policy_enforced"].(bool)),
+		},
+	}
+}
+
+func flattenNetAppVolumeDataProtectionBackupPolicy(input *netapp.VolumePropertiesDataProtection) []interface{} {
+	if input == nil || input.Backup == nil || input.Backup.BackupEnabled == nil || !*input.Backup.BackupEnabled {
+		return []interface{}{}
+	}
+
+	policyEnforced := false
+	if input.Backup.PolicyEnforced != nil {
+		policyEnforced = *input.Backup.PolicyEnforced
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"backup_policy_id": utils.NormalizeNilableString(input.Backup.BackupPolicyID),
+			"backup_vault_id":  utils.NormalizeNilableString(input.Backup.VaultID),
+			"policy_enforced":  policyEnforced,
+		},
+	}
+}
+
 func flattenNetAppVolumeDataProtectionSnapshotPolicy(input *netapp.VolumePropertiesDataProtection) []interface{} {
 	if input == nil || input.Snapshot == nil {
 		return []interface{}{}
@@ -79,6 +79,61 @@ func TestAccNetAppVolume_crossRegionReplication(t *testing.T) {
 	})
 }
 
+func TestAccNetAppVolume_crossRegionReplicationBreakAndResync(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_secondary")
+	r := NetAppVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossRegionReplication(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossRegionReplicationMirrorState(data, "Broken"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication.0.mirror_state").HasValue("Broken"),
+			),
+		},
+		data.ImportStep("data_protection_replication.0.force_break_enabled"),
+		{
+			Config: r.crossRegionReplicationMirrorState(data, "Mirrored"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication.0.mirror_state").HasValue("Mirrored"),
+			),
+		},
+		data.ImportStep("data_protection_replication.0.force_break_enabled"),
+	})
+}
+
+func TestAccNetAppVolume_backupPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test")
+	r := NetAppVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.backupPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_backup_policy.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_backup_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNetAppVolume_nfsv3FromSnapshot(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_snapshot_vol")
 	r := NetAppVolumeResource{}
@@ -406,6 +461,102 @@ resource "azurerm_netapp_volume" "test_secondary" {
 `, template, data.RandomInteger, "germanywestcentral")
 }
 
+func (r NetAppVolumeResource) crossRegionReplicationMirrorState(data acceptance.TestData, mirrorState string) string {
+	template := r.templateForCrossRegionReplication(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_netapp_volume" "test_primary" {
+  name                = "acctest-NetAppVolume-primary-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+  pool_name           = azurerm_netapp_pool.test.name
+  volume_path         = "my-unique-file-path-primary-%[2]d"
+  service_level       = "Standard"
+  subnet_id           = azurerm_subnet.test.id
+  protocols           = ["NFSv3"]
+  storage_quota_in_gb = 100
+
+  export_policy_rule {
+    rule_index        = 1
+    allowed_clients   = ["0.0.0.0/0"]
+    protocols_enabled = ["NFSv3"]
+    unix_read_only    = false
+    unix_read_write   = true
+  }
+}
+
+resource "azurerm_netapp_volume" "test_secondary" {
+  name                       = "acctest-NetAppVolume-secondary-%[2]d"
+  location                   = "%[3]s"
+  resource_group_name        = azurerm_resource_group.test.name
+  account_name               = azurerm_netapp_account.test_secondary.name
+  pool_name                  = azurerm_netapp_pool.test_secondary.name
+  volume_path                = "my-unique-file-path-secondary-%[2]d"
+  service_level              = "Standard"
+  subnet_id                  = azurerm_subnet.test_secondary.id
+  protocols                  = ["NFSv3"]
+  storage_quota_in_gb        = 100
+  snapshot_directory_visible = false
+
+  export_policy_rule {
+    rule_index        = 1
+    allowed_clients   = ["0.0.0.0/0"]
+    protocols_enabled = ["NFSv3"]
+    unix_read_only    = false
+    unix_read_write   = true
+  }
+
+  data_protection_replication {
+    endpoint_type             = "dst"
+    remote_volume_location    = azurerm_resource_group.test.location
+    remote_volume_resource_id = azurerm_netapp_volume.test_primary.id
+    replication_frequency     = "10minutes"
+    mirror_state              = "%[4]s"
+    force_break_enabled       = true
+  }
+}
+`, template, data.RandomInteger, "germanywestcentral", mirrorState)
+}
+
+func (NetAppVolumeResource) backupPolicy(data acceptance.TestData) string {
+	template := NetAppVolumeResource{}.template(data)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_netapp_backup_policy" "test" {
+  name                  = "acctest-NetAppBackupPolicy-%[2]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  account_name          = azurerm_netapp_account.test.name
+  daily_backups_to_keep = 2
+}
+
+data "azurerm_netapp_backup_vault" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+}
+
+resource "azurerm_netapp_volume" "test" {
+  name                = "acctest-NetAppVolume-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_netapp_account.test.name
+  pool_name           = azurerm_netapp_pool.test.name
+  volume_path         = "my-unique-file-path-%[2]d"
+  service_level       = "Standard"
+  subnet_id           = azurerm_subnet.test.id
+  storage_quota_in_gb = 100
+
+  data_protection_backup_policy {
+    backup_policy_id = azurerm_netapp_backup_policy.test.id
+    backup_vault_id  = data.azurerm_netapp_backup_vault.test.id
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (NetAppVolumeResource) nfsv3FromSnapshot(data acceptance.TestData) string {
 	template := NetAppVolumeResource{}.template(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type BackupVaultId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	NetAppAccountName string
+	VaultName         string
+}
+
+func NewBackupVaultID(subscriptionId, resourceGroup, netAppAccountName, vaultName string) BackupVaultId {
+	return BackupVaultId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		NetAppAccountName: netAppAccountName,
+		VaultName:         vaultName,
+	}
+}
+
+func (id BackupVaultId) String() string {
+	segments := []string{
+		fmt.Sprintf("Vault Name %q", id.VaultName),
+		fmt.Sprintf("Net App Account Name %q", id.NetAppAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Backup Vault", segmentsStr)
+}
+
+func (id BackupVaultId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.NetApp/netAppAccounts/%s/vaults/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetAppAccountName, id.VaultName)
+}
+
+// BackupVaultID parses a BackupVault ID into an BackupVaultId struct
+func BackupVaultID(input string) (*BackupVaultId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := BackupVaultId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.VaultName, err = id.PopSegment("vaults"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
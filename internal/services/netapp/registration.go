@@ -22,6 +22,7 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_netapp_account":         dataSourceNetAppAccount(),
+		"azurerm_netapp_backup_vault":    dataSourceNetAppBackupVault(),
 		"azurerm_netapp_pool":            dataSourceNetAppPool(),
 		"azurerm_netapp_volume":          dataSourceNetAppVolume(),
 		"azurerm_netapp_snapshot":        dataSourceNetAppSnapshot(),
@@ -33,6 +34,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_netapp_account":         resourceNetAppAccount(),
+		"azurerm_netapp_backup_policy":   resourceNetAppBackupPolicy(),
 		"azurerm_netapp_pool":            resourceNetAppPool(),
 		"azurerm_netapp_volume":          resourceNetAppVolume(),
 		"azurerm_netapp_snapshot":        resourceNetAppSnapshot(),
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	msiParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+const applicationGatewayResourceName = "azurerm_application_gateway"
+
 // See https://github.com/Azure/azure-sdk-for-go/blob/master/services/network/mgmt/2018-04-01/network/models.go
 func possibleApplicationGatewaySslCipherSuiteValues() []string {
 	cipherSuites := make([]string, 0)
@@ -542,9 +545,11 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 							},
 						},
 
+						// Computed since this can also be managed using `azurerm_web_application_firewall_policy_http_listener_association`
 						"firewall_policy_id": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: azure.ValidateResourceID,
 						},
 
@@ -1332,9 +1337,11 @@ func resourceApplicationGateway() *pluginsdk.Resource {
 										Computed: true,
 									},
 
+									// Computed since this can also be managed using `azurerm_web_application_firewall_policy_path_rule_association`
 									"firewall_policy_id": {
 										Type:         pluginsdk.TypeString,
 										Optional:     true,
+										Computed:     true,
 										ValidateFunc: networkValidate.ApplicationGatewayWebApplicationFirewallPolicyID,
 									},
 								},
@@ -1528,6 +1535,10 @@ func resourceApplicationGatewayCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
 	id := parse.NewApplicationGatewayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
 		if err != nil {
@@ -1883,6 +1894,9 @@ func resourceApplicationGatewayDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	locks.ByName(id.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(id.Name, applicationGatewayResourceName)
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
@@ -3,63 +3,77 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/subnets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/webapplicationfirewallpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeteraccessrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeters"
 )
 
 type Client struct {
-	ApplicationGatewaysClient              *network.ApplicationGatewaysClient
-	ApplicationSecurityGroupsClient        *network.ApplicationSecurityGroupsClient
-	BastionHostsClient                     *network.BastionHostsClient
-	ConnectionMonitorsClient               *network.ConnectionMonitorsClient
-	DDOSProtectionPlansClient              *network.DdosProtectionPlansClient
-	ExpressRouteAuthsClient                *network.ExpressRouteCircuitAuthorizationsClient
-	ExpressRouteCircuitsClient             *network.ExpressRouteCircuitsClient
-	ExpressRouteCircuitConnectionClient    *network.ExpressRouteCircuitConnectionsClient
-	ExpressRouteConnectionsClient          *network.ExpressRouteConnectionsClient
-	ExpressRouteGatewaysClient             *network.ExpressRouteGatewaysClient
-	ExpressRoutePeeringsClient             *network.ExpressRouteCircuitPeeringsClient
-	ExpressRoutePortsClient                *network.ExpressRoutePortsClient
-	FlowLogsClient                         *network.FlowLogsClient
-	HubRouteTableClient                    *network.HubRouteTablesClient
-	HubVirtualNetworkConnectionClient      *network.HubVirtualNetworkConnectionsClient
-	InterfacesClient                       *network.InterfacesClient
-	IPGroupsClient                         *network.IPGroupsClient
-	LocalNetworkGatewaysClient             *network.LocalNetworkGatewaysClient
-	NatRuleClient                          *network.NatRulesClient
-	PointToSiteVpnGatewaysClient           *network.P2sVpnGatewaysClient
-	ProfileClient                          *network.ProfilesClient
-	PacketCapturesClient                   *network.PacketCapturesClient
-	PrivateEndpointClient                  *network.PrivateEndpointsClient
-	PublicIPsClient                        *network.PublicIPAddressesClient
-	PublicIPPrefixesClient                 *network.PublicIPPrefixesClient
-	RoutesClient                           *network.RoutesClient
-	RouteFiltersClient                     *network.RouteFiltersClient
-	RouteTablesClient                      *network.RouteTablesClient
-	SecurityGroupClient                    *network.SecurityGroupsClient
-	SecurityPartnerProviderClient          *network.SecurityPartnerProvidersClient
-	SecurityRuleClient                     *network.SecurityRulesClient
-	ServiceEndpointPoliciesClient          *network.ServiceEndpointPoliciesClient
-	ServiceEndpointPolicyDefinitionsClient *network.ServiceEndpointPolicyDefinitionsClient
-	ServiceTagsClient                      *network.ServiceTagsClient
-	SubnetsClient                          *network.SubnetsClient
-	NatGatewayClient                       *network.NatGatewaysClient
-	VirtualHubBgpConnectionClient          *network.VirtualHubBgpConnectionClient
-	VirtualHubIPClient                     *network.VirtualHubIPConfigurationClient
-	VnetGatewayConnectionsClient           *network.VirtualNetworkGatewayConnectionsClient
-	VnetGatewayClient                      *network.VirtualNetworkGatewaysClient
-	VnetClient                             *network.VirtualNetworksClient
-	VnetPeeringsClient                     *network.VirtualNetworkPeeringsClient
-	VirtualWanClient                       *network.VirtualWansClient
-	VirtualHubClient                       *network.VirtualHubsClient
-	VpnConnectionsClient                   *network.VpnConnectionsClient
-	VpnGatewaysClient                      *network.VpnGatewaysClient
-	VpnServerConfigurationsClient          *network.VpnServerConfigurationsClient
-	VpnSitesClient                         *network.VpnSitesClient
-	WatcherClient                          *network.WatchersClient
-	WebApplicationFirewallPoliciesClient   *network.WebApplicationFirewallPoliciesClient
-	PrivateDnsZoneGroupClient              *network.PrivateDNSZoneGroupsClient
-	PrivateLinkServiceClient               *network.PrivateLinkServicesClient
-	ServiceAssociationLinkClient           *network.ServiceAssociationLinksClient
-	ResourceNavigationLinkClient           *network.ResourceNavigationLinksClient
+	ApplicationGatewaysClient                     *network.ApplicationGatewaysClient
+	ApplicationSecurityGroupsClient               *network.ApplicationSecurityGroupsClient
+	BastionHostsClient                            *network.BastionHostsClient
+	ConnectionMonitorsClient                      *network.ConnectionMonitorsClient
+	CustomIPPrefixesClient                        *network.CustomIPPrefixesClient
+	DDOSProtectionPlansClient                     *network.DdosProtectionPlansClient
+	ExpressRouteAuthsClient                       *network.ExpressRouteCircuitAuthorizationsClient
+	ExpressRouteCircuitsClient                    *network.ExpressRouteCircuitsClient
+	ExpressRouteCircuitConnectionClient           *network.ExpressRouteCircuitConnectionsClient
+	ExpressRouteConnectionsClient                 *network.ExpressRouteConnectionsClient
+	ExpressRouteGatewaysClient                    *network.ExpressRouteGatewaysClient
+	ExpressRoutePeeringsClient                    *network.ExpressRouteCircuitPeeringsClient
+	ExpressRoutePortsClient                       *network.ExpressRoutePortsClient
+	FlowLogsClient                                *network.FlowLogsClient
+	HubRouteTableClient                           *network.HubRouteTablesClient
+	HubVirtualNetworkConnectionClient             *network.HubVirtualNetworkConnectionsClient
+	InterfacesClient                              *network.InterfacesClient
+	IPGroupsClient                                *network.IPGroupsClient
+	LocalNetworkGatewaysClient                    *network.LocalNetworkGatewaysClient
+	NatRuleClient                                 *network.NatRulesClient
+	NetworkSecurityPerimeterAccessRulesClient     *networksecurityperimeteraccessrules.NetworkSecurityPerimeterAccessRulesClient
+	NetworkSecurityPerimeterAssociationsClient    *networksecurityperimeterassociations.NetworkSecurityPerimeterAssociationsClient
+	NetworkSecurityPerimeterProfilesClient        *networksecurityperimeterprofiles.NetworkSecurityPerimeterProfilesClient
+	NetworkSecurityPerimetersClient               *networksecurityperimeters.NetworkSecurityPerimetersClient
+	PointToSiteVpnGatewaysClient                  *network.P2sVpnGatewaysClient
+	ProfileClient                                 *network.ProfilesClient
+	PacketCapturesClient                          *network.PacketCapturesClient
+	PrivateEndpointClient                         *network.PrivateEndpointsClient
+	PublicIPsClient                               *network.PublicIPAddressesClient
+	PublicIPPrefixesClient                        *network.PublicIPPrefixesClient
+	RoutesClient                                  *network.RoutesClient
+	RouteFiltersClient                            *network.RouteFiltersClient
+	RouteTablesClient                             *network.RouteTablesClient
+	SecurityGroupClient                           *network.SecurityGroupsClient
+	SecurityPartnerProviderClient                 *network.SecurityPartnerProvidersClient
+	SecurityRuleClient                            *network.SecurityRulesClient
+	ServiceEndpointPoliciesClient                 *network.ServiceEndpointPoliciesClient
+	ServiceEndpointPolicyDefinitionsClient        *network.ServiceEndpointPolicyDefinitionsClient
+	ServiceTagsClient                             *network.ServiceTagsClient
+	SubnetsClient                                 *network.SubnetsClient
+	SubnetsV20230501Client                        *subnets.SubnetsClient
+	NatGatewayClient                              *network.NatGatewaysClient
+	VirtualHubBgpConnectionClient                 *network.VirtualHubBgpConnectionClient
+	VirtualHubBgpConnectionsClient                *network.VirtualHubBgpConnectionsClient
+	VirtualHubIPClient                            *network.VirtualHubIPConfigurationClient
+	VnetGatewayConnectionsClient                  *network.VirtualNetworkGatewayConnectionsClient
+	VnetGatewayClient                             *network.VirtualNetworkGatewaysClient
+	VnetClient                                    *network.VirtualNetworksClient
+	VnetPeeringsClient                            *network.VirtualNetworkPeeringsClient
+	VirtualWanClient                              *network.VirtualWansClient
+	VirtualHubClient                              *network.VirtualHubsClient
+	VpnConnectionsClient                          *network.VpnConnectionsClient
+	VpnGatewaysClient                             *network.VpnGatewaysClient
+	VpnServerConfigurationsClient                 *network.VpnServerConfigurationsClient
+	VpnSitesClient                                *network.VpnSitesClient
+	WatcherClient                                 *network.WatchersClient
+	WebApplicationFirewallPoliciesClient          *network.WebApplicationFirewallPoliciesClient
+	WebApplicationFirewallPoliciesV20230501Client *webapplicationfirewallpolicies.WebApplicationFirewallPoliciesClient
+	PrivateDnsZoneGroupClient                     *network.PrivateDNSZoneGroupsClient
+	PrivateLinkServiceClient                      *network.PrivateLinkServicesClient
+	ServiceAssociationLinkClient                  *network.ServiceAssociationLinksClient
+	ResourceNavigationLinkClient                  *network.ResourceNavigationLinksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -75,6 +89,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ConnectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ConnectionMonitorsClient.Client, o.ResourceManagerAuthorizer)
 
+	CustomIPPrefixesClient := network.NewCustomIPPrefixesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&CustomIPPrefixesClient.Client, o.ResourceManagerAuthorizer)
+
 	DDOSProtectionPlansClient := network.NewDdosProtectionPlansClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DDOSProtectionPlansClient.Client, o.ResourceManagerAuthorizer)
 
@@ -120,6 +137,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	NatRuleClient := network.NewNatRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NatRuleClient.Client, o.ResourceManagerAuthorizer)
 
+	NetworkSecurityPerimeterAccessRulesClient := networksecurityperimeteraccessrules.NewNetworkSecurityPerimeterAccessRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkSecurityPerimeterAccessRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkSecurityPerimeterAssociationsClient := networksecurityperimeterassociations.NewNetworkSecurityPerimeterAssociationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkSecurityPerimeterAssociationsClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkSecurityPerimeterProfilesClient := networksecurityperimeterprofiles.NewNetworkSecurityPerimeterProfilesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkSecurityPerimeterProfilesClient.Client, o.ResourceManagerAuthorizer)
+
+	NetworkSecurityPerimetersClient := networksecurityperimeters.NewNetworkSecurityPerimetersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NetworkSecurityPerimetersClient.Client, o.ResourceManagerAuthorizer)
+
 	pointToSiteVpnGatewaysClient := network.NewP2sVpnGatewaysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&pointToSiteVpnGatewaysClient.Client, o.ResourceManagerAuthorizer)
 
@@ -183,9 +212,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	SubnetsClient := network.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SubnetsClient.Client, o.ResourceManagerAuthorizer)
 
+	SubnetsV20230501Client := subnets.NewSubnetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&SubnetsV20230501Client.Client, o.ResourceManagerAuthorizer)
+
 	VirtualHubBgpConnectionClient := network.NewVirtualHubBgpConnectionClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubBgpConnectionClient.Client, o.ResourceManagerAuthorizer)
 
+	VirtualHubBgpConnectionsClient := network.NewVirtualHubBgpConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VirtualHubBgpConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	VirtualHubIPClient := network.NewVirtualHubIPConfigurationClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VirtualHubIPClient.Client, o.ResourceManagerAuthorizer)
 
@@ -219,6 +254,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	WebApplicationFirewallPoliciesClient := network.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&WebApplicationFirewallPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	WebApplicationFirewallPoliciesV20230501Client := webapplicationfirewallpolicies.NewWebApplicationFirewallPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WebApplicationFirewallPoliciesV20230501Client.Client, o.ResourceManagerAuthorizer)
+
 	ServiceAssociationLinkClient := network.NewServiceAssociationLinksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ServiceAssociationLinkClient.Client, o.ResourceManagerAuthorizer)
 
@@ -226,59 +264,67 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&ResourceNavigationLinkClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ApplicationGatewaysClient:              &ApplicationGatewaysClient,
-		ApplicationSecurityGroupsClient:        &ApplicationSecurityGroupsClient,
-		BastionHostsClient:                     &BastionHostsClient,
-		ConnectionMonitorsClient:               &ConnectionMonitorsClient,
-		DDOSProtectionPlansClient:              &DDOSProtectionPlansClient,
-		ExpressRouteAuthsClient:                &ExpressRouteAuthsClient,
-		ExpressRouteCircuitsClient:             &ExpressRouteCircuitsClient,
-		ExpressRouteCircuitConnectionClient:    &ExpressRouteCircuitConnectionClient,
-		ExpressRouteConnectionsClient:          &ExpressRouteConnectionsClient,
-		ExpressRouteGatewaysClient:             &ExpressRouteGatewaysClient,
-		ExpressRoutePeeringsClient:             &ExpressRoutePeeringsClient,
-		ExpressRoutePortsClient:                &ExpressRoutePortsClient,
-		FlowLogsClient:                         &FlowLogsClient,
-		HubRouteTableClient:                    &HubRouteTableClient,
-		HubVirtualNetworkConnectionClient:      &HubVirtualNetworkConnectionClient,
-		InterfacesClient:                       &InterfacesClient,
-		IPGroupsClient:                         &IpGroupsClient,
-		LocalNetworkGatewaysClient:             &LocalNetworkGatewaysClient,
-		NatRuleClient:                          &NatRuleClient,
-		PointToSiteVpnGatewaysClient:           &pointToSiteVpnGatewaysClient,
-		ProfileClient:                          &ProfileClient,
-		PacketCapturesClient:                   &PacketCapturesClient,
-		PrivateEndpointClient:                  &PrivateEndpointClient,
-		PublicIPsClient:                        &PublicIPsClient,
-		PublicIPPrefixesClient:                 &PublicIPPrefixesClient,
-		RoutesClient:                           &RoutesClient,
-		RouteFiltersClient:                     &RouteFiltersClient,
-		RouteTablesClient:                      &RouteTablesClient,
-		SecurityGroupClient:                    &SecurityGroupClient,
-		SecurityPartnerProviderClient:          &SecurityPartnerProviderClient,
-		SecurityRuleClient:                     &SecurityRuleClient,
-		ServiceEndpointPoliciesClient:          &ServiceEndpointPoliciesClient,
-		ServiceEndpointPolicyDefinitionsClient: &ServiceEndpointPolicyDefinitionsClient,
-		ServiceTagsClient:                      &ServiceTagsClient,
-		SubnetsClient:                          &SubnetsClient,
-		NatGatewayClient:                       &NatGatewayClient,
-		VirtualHubBgpConnectionClient:          &VirtualHubBgpConnectionClient,
-		VirtualHubIPClient:                     &VirtualHubIPClient,
-		VnetGatewayConnectionsClient:           &VnetGatewayConnectionsClient,
-		VnetGatewayClient:                      &VnetGatewayClient,
-		VnetClient:                             &VnetClient,
-		VnetPeeringsClient:                     &VnetPeeringsClient,
-		VirtualWanClient:                       &VirtualWanClient,
-		VirtualHubClient:                       &VirtualHubClient,
-		VpnConnectionsClient:                   &vpnConnectionsClient,
-		VpnGatewaysClient:                      &vpnGatewaysClient,
-		VpnServerConfigurationsClient:          &vpnServerConfigurationsClient,
-		VpnSitesClient:                         &vpnSitesClient,
-		WatcherClient:                          &WatcherClient,
-		WebApplicationFirewallPoliciesClient:   &WebApplicationFirewallPoliciesClient,
-		PrivateDnsZoneGroupClient:              &PrivateDnsZoneGroupClient,
-		PrivateLinkServiceClient:               &PrivateLinkServiceClient,
-		ServiceAssociationLinkClient:           &ServiceAssociationLinkClient,
-		ResourceNavigationLinkClient:           &ResourceNavigationLinkClient,
+		ApplicationGatewaysClient:                     &ApplicationGatewaysClient,
+		ApplicationSecurityGroupsClient:               &ApplicationSecurityGroupsClient,
+		BastionHostsClient:                            &BastionHostsClient,
+		ConnectionMonitorsClient:                      &ConnectionMonitorsClient,
+		CustomIPPrefixesClient:                        &CustomIPPrefixesClient,
+		DDOSProtectionPlansClient:                     &DDOSProtectionPlansClient,
+		ExpressRouteAuthsClient:                       &ExpressRouteAuthsClient,
+		ExpressRouteCircuitsClient:                    &ExpressRouteCircuitsClient,
+		ExpressRouteCircuitConnectionClient:           &ExpressRouteCircuitConnectionClient,
+		ExpressRouteConnectionsClient:                 &ExpressRouteConnectionsClient,
+		ExpressRouteGatewaysClient:                    &ExpressRouteGatewaysClient,
+		ExpressRoutePeeringsClient:                    &ExpressRoutePeeringsClient,
+		ExpressRoutePortsClient:                       &ExpressRoutePortsClient,
+		FlowLogsClient:                                &FlowLogsClient,
+		HubRouteTableClient:                           &HubRouteTableClient,
+		HubVirtualNetworkConnectionClient:             &HubVirtualNetworkConnectionClient,
+		InterfacesClient:                              &InterfacesClient,
+		IPGroupsClient:                                &IpGroupsClient,
+		LocalNetworkGatewaysClient:                    &LocalNetworkGatewaysClient,
+		NatRuleClient:                                 &NatRuleClient,
+		NetworkSecurityPerimeterAccessRulesClient:     &NetworkSecurityPerimeterAccessRulesClient,
+		NetworkSecurityPerimeterAssociationsClient:    &NetworkSecurityPerimeterAssociationsClient,
+		NetworkSecurityPerimeterProfilesClient:        &NetworkSecurityPerimeterProfilesClient,
+		NetworkSecurityPerimetersClient:               &NetworkSecurityPerimetersClient,
+		PointToSiteVpnGatewaysClient:                  &pointToSiteVpnGatewaysClient,
+		ProfileClient:                                 &ProfileClient,
+		PacketCapturesClient:                          &PacketCapturesClient,
+		PrivateEndpointClient:                         &PrivateEndpointClient,
+		PublicIPsClient:                               &PublicIPsClient,
+		PublicIPPrefixesClient:                        &PublicIPPrefixesClient,
+		RoutesClient:                                  &RoutesClient,
+		RouteFiltersClient:                            &RouteFiltersClient,
+		RouteTablesClient:                             &RouteTablesClient,
+		SecurityGroupClient:                           &SecurityGroupClient,
+		SecurityPartnerProviderClient:                 &SecurityPartnerProviderClient,
+		SecurityRuleClient:                            &SecurityRuleClient,
+		ServiceEndpointPoliciesClient:                 &ServiceEndpointPoliciesClient,
+		ServiceEndpointPolicyDefinitionsClient:        &ServiceEndpointPolicyDefinitionsClient,
+		ServiceTagsClient:                             &ServiceTagsClient,
+		SubnetsClient:                                 &SubnetsClient,
+		SubnetsV20230501Client:                        &SubnetsV20230501Client,
+		NatGatewayClient:                              &NatGatewayClient,
+		VirtualHubBgpConnectionClient:                 &VirtualHubBgpConnectionClient,
+		VirtualHubBgpConnectionsClient:                &VirtualHubBgpConnectionsClient,
+		VirtualHubIPClient:                            &VirtualHubIPClient,
+		VnetGatewayConnectionsClient:                  &VnetGatewayConnectionsClient,
+		VnetGatewayClient:                             &VnetGatewayClient,
+		VnetClient:                                    &VnetClient,
+		VnetPeeringsClient:                            &VnetPeeringsClient,
+		VirtualWanClient:                              &VirtualWanClient,
+		VirtualHubClient:                              &VirtualHubClient,
+		VpnConnectionsClient:                          &vpnConnectionsClient,
+		VpnGatewaysClient:                             &vpnGatewaysClient,
+		VpnServerConfigurationsClient:                 &vpnServerConfigurationsClient,
+		VpnSitesClient:                                &vpnSitesClient,
+		WatcherClient:                                 &WatcherClient,
+		WebApplicationFirewallPoliciesClient:          &WebApplicationFirewallPoliciesClient,
+		WebApplicationFirewallPoliciesV20230501Client: &WebApplicationFirewallPoliciesV20230501Client,
+		PrivateDnsZoneGroupClient:                     &PrivateDnsZoneGroupClient,
+		PrivateLinkServiceClient:                      &PrivateLinkServiceClient,
+		ServiceAssociationLinkClient:                  &ServiceAssociationLinkClient,
+		ResourceNavigationLinkClient:                  &ResourceNavigationLinkClient,
 	}
 }
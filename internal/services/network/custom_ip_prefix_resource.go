@@ -0,0 +1,402 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceCustomIpPrefix() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceCustomIpPrefixCreate,
+		Read:   resourceCustomIpPrefixRead,
+		Update: resourceCustomIpPrefixUpdate,
+		Delete: resourceCustomIpPrefixDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CustomIpPrefixID(id)
+			return err
+		}),
+
+		// validating, provisioning and commissioning a Custom IP Prefix can take several hours
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(9 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(9 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(9 * time.Hour),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": azure.SchemaLocation(),
+
+			"cidr": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			"zones": azure.SchemaMultipleZones(),
+
+			"parent_custom_ip_prefix_id": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.CustomIpPrefixID,
+				ConflictsWith: []string{"roa_validity_end_date", "wan_validation_signed_message"},
+			},
+
+			// the Route Origin Authorization and the signed message are used by Azure to validate ownership of the range
+			"roa_validity_end_date": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+					"`roa_validity_end_date` must be a date in the format `YYYY-MM-DD`",
+				),
+				RequiredWith: []string{"wan_validation_signed_message"},
+			},
+
+			"wan_validation_signed_message": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"roa_validity_end_date"},
+			},
+
+			"commissioning_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"commissioned_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_prefix_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceCustomIpPrefixCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewCustomIpPrefixID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.CustomIPPrefixeName, "")
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_custom_ip_prefix", id.ID())
+	}
+
+	cidr := d.Get("cidr").(string)
+	props := network.CustomIPPrefixPropertiesFormat{
+		Cidr: utils.String(cidr),
+	}
+
+	if v := d.Get("roa_validity_end_date").(string); v != "" {
+		endDate, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return fmt.Errorf("parsing `roa_validity_end_date`: %+v", err)
+		}
+		// the authorization message must match the one which was signed to prove ownership of the range
+		props.AuthorizationMessage = utils.String(fmt.Sprintf("%s|%s|%s", subscriptionId, cidr, endDate.Format("20060102")))
+		props.SignedMessage = utils.String(d.Get("wan_validation_signed_message").(string))
+	}
+
+	if v := d.Get("parent_custom_ip_prefix_id").(string); v != "" {
+		props.CustomIPPrefixParent = &network.CustomIPPrefix{
+			ID: utils.String(v),
+		}
+	}
+
+	parameters := network.CustomIPPrefix{
+		Location:                       utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		CustomIPPrefixPropertiesFormat: &props,
+		Tags:                           tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if zones := azure.ExpandZones(d.Get("zones").([]interface{})); zones != nil && len(*zones) > 0 {
+		parameters.Zones = zones
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CustomIPPrefixeName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	// once created the range is validated and then provisioned, which must complete before it can be commissioned
+	log.Printf("[DEBUG] Waiting for %s to be provisioned", id)
+	if err := customIpPrefixWaitForCommissionedState(ctx, client, id, []string{string(network.CommissionedStateProvisioning)}, network.CommissionedStateProvisioned, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if d.Get("commissioning_enabled").(bool) {
+		if err := customIpPrefixCommission(ctx, client, id, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.CustomIPPrefixeName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomIPPrefixeName)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+	d.Set("zones", azure.FlattenZones(resp.Zones))
+
+	if props := resp.CustomIPPrefixPropertiesFormat; props != nil {
+		d.Set("cidr", props.Cidr)
+		d.Set("commissioned_state", string(props.CommissionedState))
+		d.Set("commissioning_enabled", props.CommissionedState == network.CommissionedStateCommissioned || props.CommissionedState == network.CommissionedStateCommissioning)
+
+		parentId := ""
+		if parent := props.CustomIPPrefixParent; parent != nil && parent.ID != nil {
+			parentId = *parent.ID
+		}
+		d.Set("parent_custom_ip_prefix_id", parentId)
+
+		if props.SignedMessage != nil {
+			d.Set("wan_validation_signed_message", props.SignedMessage)
+		}
+
+		publicIpPrefixIds := make([]string, 0)
+		if prefixes := props.PublicIPPrefixes; prefixes != nil {
+			for _, prefix := range *prefixes {
+				if prefix.ID != nil {
+					publicIpPrefixIds = append(publicIpPrefixIds, *prefix.ID)
+				}
+			}
+		}
+		if err := d.Set("public_ip_prefix_ids", publicIpPrefixIds); err != nil {
+			return fmt.Errorf("setting `public_ip_prefix_ids`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceCustomIpPrefixUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("tags") {
+		parameters := network.TagsObject{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if _, err := client.UpdateTags(ctx, id.ResourceGroup, id.CustomIPPrefixeName, parameters); err != nil {
+			return fmt.Errorf("updating tags for %s: %+v", *id, err)
+		}
+	}
+
+	if d.HasChange("commissioning_enabled") {
+		if d.Get("commissioning_enabled").(bool) {
+			err = customIpPrefixCommission(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate))
+		} else {
+			err = customIpPrefixDecommission(ctx, client, *id, d.Timeout(pluginsdk.TimeoutUpdate))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceCustomIpPrefixRead(d, meta)
+}
+
+func resourceCustomIpPrefixDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.CustomIPPrefixesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CustomIpPrefixID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.CustomIPPrefixeName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// a commissioned range has to be decommissioned before it can be deprovisioned and deleted
+	if props := existing.CustomIPPrefixPropertiesFormat; props != nil {
+		switch props.CommissionedState {
+		case network.CommissionedStateCommissioning, network.CommissionedStateCommissioned:
+			if err := customIpPrefixDecommission(ctx, client, *id, d.Timeout(pluginsdk.TimeoutDelete)); err != nil {
+				return err
+			}
+		}
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.CustomIPPrefixeName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func customIpPrefixCommission(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId, timeout time.Duration) error {
+	log.Printf("[DEBUG] Commissioning %s", id)
+	if err := customIpPrefixSetCommissionedState(ctx, client, id, network.CommissionedStateCommissioning); err != nil {
+		return err
+	}
+
+	return customIpPrefixWaitForCommissionedState(ctx, client, id, []string{string(network.CommissionedStateProvisioned), string(network.CommissionedStateCommissioning)}, network.CommissionedStateCommissioned, timeout)
+}
+
+func customIpPrefixDecommission(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId, timeout time.Duration) error {
+	log.Printf("[DEBUG] Decommissioning %s", id)
+	if err := customIpPrefixSetCommissionedState(ctx, client, id, network.CommissionedStateDecommissioning); err != nil {
+		return err
+	}
+
+	return customIpPrefixWaitForCommissionedState(ctx, client, id, []string{string(network.CommissionedStateCommissioned), string(network.CommissionedStateDecommissioning)}, network.CommissionedStateProvisioned, timeout)
+}
+
+func customIpPrefixSetCommissionedState(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId, state network.CommissionedState) error {
+	existing, err := client.Get(ctx, id.ResourceGroup, id.CustomIPPrefixeName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.CustomIPPrefixPropertiesFormat == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	existing.CustomIPPrefixPropertiesFormat.CommissionedState = state
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CustomIPPrefixeName, existing)
+	if err != nil {
+		return fmt.Errorf("setting the commissioned state of %s to %q: %+v", id, string(state), err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the commissioned state of %s to be set to %q: %+v", id, string(state), err)
+	}
+
+	return nil
+}
+
+func customIpPrefixWaitForCommissionedState(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId, pending []string, target network.CommissionedState, timeout time.Duration) error {
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    pending,
+		Target:     []string{string(target)},
+		Refresh:    customIpPrefixCommissionedStateRefreshFunc(ctx, client, id),
+		MinTimeout: 1 * time.Minute,
+		Timeout:    timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to become %q: %+v", id, string(target), err)
+	}
+
+	return nil
+}
+
+func customIpPrefixCommissionedStateRefreshFunc(ctx context.Context, client *network.CustomIPPrefixesClient, id parse.CustomIpPrefixId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id.ResourceGroup, id.CustomIPPrefixeName, "")
+		if err != nil {
+			return nil, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		props := res.CustomIPPrefixPropertiesFormat
+		if props == nil {
+			return nil, "Error", fmt.Errorf("retrieving %s: `properties` was nil", id)
+		}
+
+		if props.ProvisioningState == network.ProvisioningStateFailed {
+			reason := ""
+			if props.FailedReason != nil {
+				reason = *props.FailedReason
+			}
+			return res, "Failed", fmt.Errorf("%s failed to validate or provision: %s", id, reason)
+		}
+
+		return res, string(props.CommissionedState), nil
+	}
+}
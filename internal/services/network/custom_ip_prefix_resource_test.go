@@ -0,0 +1,187 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CustomIpPrefixResource struct {
+	cidr               string
+	roaValidityEndDate string
+	signedMessage      string
+}
+
+// a Custom IP Prefix can only be provisioned for a range which is owned by the test account and has a valid Route
+// Origin Authorization for Microsoft's ASN, so the range and its signed validation message have to be provided
+func newCustomIpPrefixResource(t *testing.T) CustomIpPrefixResource {
+	r := CustomIpPrefixResource{
+		cidr:               os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_CIDR"),
+		roaValidityEndDate: os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_ROA_VALIDITY_END_DATE"),
+		signedMessage:      os.Getenv("ARM_TEST_CUSTOM_IP_PREFIX_WAN_VALIDATION_SIGNED_MESSAGE"),
+	}
+	if r.cidr == "" || r.roaValidityEndDate == "" || r.signedMessage == "" {
+		t.Skip("Skipping as ARM_TEST_CUSTOM_IP_PREFIX_CIDR, ARM_TEST_CUSTOM_IP_PREFIX_ROA_VALIDITY_END_DATE and/or ARM_TEST_CUSTOM_IP_PREFIX_WAN_VALIDATION_SIGNED_MESSAGE are not specified")
+	}
+
+	return r
+}
+
+func TestAccCustomIpPrefix_basic(t *testing.T) {
+	r := newCustomIpPrefixResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioned_state").HasValue("Provisioned"),
+			),
+		},
+		data.ImportStep("roa_validity_end_date", "wan_validation_signed_message"),
+	})
+}
+
+func TestAccCustomIpPrefix_requiresImport(t *testing.T) {
+	r := newCustomIpPrefixResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCustomIpPrefix_commissioning(t *testing.T) {
+	r := newCustomIpPrefixResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_custom_ip_prefix", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("roa_validity_end_date", "wan_validation_signed_message"),
+		{
+			Config: r.commissioned(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("commissioned_state").HasValue("Commissioned"),
+				check.That("azurerm_public_ip_prefix.test").Key("custom_ip_prefix_id").Exists(),
+			),
+		},
+		data.ImportStep("roa_validity_end_date", "wan_validation_signed_message"),
+	})
+}
+
+func (r CustomIpPrefixResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CustomIpPrefixID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.CustomIPPrefixesClient.Get(ctx, id.ResourceGroup, id.CustomIPPrefixeName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r CustomIpPrefixResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cip-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                          = "acctest-cip-%[1]d"
+  location                      = azurerm_resource_group.test.location
+  resource_group_name           = azurerm_resource_group.test.name
+  cidr                          = "%[3]s"
+  zones                         = ["1", "2", "3"]
+  roa_validity_end_date         = "%[4]s"
+  wan_validation_signed_message = "%[5]s"
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, r.cidr, r.roaValidityEndDate, r.signedMessage)
+}
+
+func (r CustomIpPrefixResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_custom_ip_prefix" "import" {
+  name                          = azurerm_custom_ip_prefix.test.name
+  location                      = azurerm_custom_ip_prefix.test.location
+  resource_group_name           = azurerm_custom_ip_prefix.test.resource_group_name
+  cidr                          = azurerm_custom_ip_prefix.test.cidr
+  zones                         = azurerm_custom_ip_prefix.test.zones
+  roa_validity_end_date         = azurerm_custom_ip_prefix.test.roa_validity_end_date
+  wan_validation_signed_message = azurerm_custom_ip_prefix.test.wan_validation_signed_message
+}
+`, r.basic(data))
+}
+
+func (r CustomIpPrefixResource) commissioned(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cip-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_custom_ip_prefix" "test" {
+  name                          = "acctest-cip-%[1]d"
+  location                      = azurerm_resource_group.test.location
+  resource_group_name           = azurerm_resource_group.test.name
+  cidr                          = "%[3]s"
+  zones                         = ["1", "2", "3"]
+  roa_validity_end_date         = "%[4]s"
+  wan_validation_signed_message = "%[5]s"
+  commissioning_enabled         = true
+
+  tags = {
+    ENV = "Test"
+  }
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctestpublicipprefix-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  prefix_length       = 31
+  custom_ip_prefix_id = azurerm_custom_ip_prefix.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, r.cidr, r.roaValidityEndDate, r.signedMessage)
+}
@@ -55,6 +55,28 @@ func TestAccNatGatewayPublicIpPrefixAssociation_updateNatGateway(t *testing.T) {
 	})
 }
 
+func TestAccNatGatewayPublicIpPrefixAssociation_swapPublicIpPrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_nat_gateway_public_ip_prefix_association", "test")
+	r := NatGatewayPublicIpPrefixAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		// intentional as this is a Virtual Resource
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.swapPublicIpPrefix(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNatGatewayPublicIpPrefixAssociation_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_nat_gateway_public_ip_prefix_association", "test")
 	r := NatGatewayPublicIpPrefixAssociationResource{}
@@ -180,6 +202,32 @@ resource "azurerm_nat_gateway_public_ip_prefix_association" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r NatGatewayPublicIpPrefixAssociationResource) swapPublicIpPrefix(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_prefix" "other" {
+  name                = "acctestpublicIPPrefix-other-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  prefix_length       = 30
+  zones               = ["1"]
+}
+
+resource "azurerm_nat_gateway" "test" {
+  name                = "acctest-NatGateway-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Standard"
+}
+
+resource "azurerm_nat_gateway_public_ip_prefix_association" "test" {
+  nat_gateway_id      = azurerm_nat_gateway.test.id
+  public_ip_prefix_id = azurerm_public_ip_prefix.other.id
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
 func (NatGatewayPublicIpPrefixAssociationResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
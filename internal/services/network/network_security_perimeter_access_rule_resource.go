@@ -0,0 +1,254 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeteraccessrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkSecurityPerimeterAccessRule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkSecurityPerimeterAccessRuleCreateUpdate,
+		Read:   resourceNetworkSecurityPerimeterAccessRuleRead,
+		Update: resourceNetworkSecurityPerimeterAccessRuleCreateUpdate,
+		Delete: resourceNetworkSecurityPerimeterAccessRuleDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := networksecurityperimeteraccessrules.ParseAccessRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkSecurityPerimeterName,
+			},
+
+			"network_security_perimeter_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networksecurityperimeterprofiles.ValidateProfileID,
+			},
+
+			"direction": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(networksecurityperimeteraccessrules.AccessRuleDirectionInbound),
+					string(networksecurityperimeteraccessrules.AccessRuleDirectionOutbound),
+				}, false),
+			},
+
+			"address_prefixes": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+				ExactlyOneOf: []string{"address_prefixes", "fully_qualified_domain_names", "subscription_ids"},
+			},
+
+			"fully_qualified_domain_names": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				ExactlyOneOf: []string{"address_prefixes", "fully_qualified_domain_names", "subscription_ids"},
+			},
+
+			"subscription_ids": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: commonids.ValidateSubscriptionID,
+				},
+				ExactlyOneOf: []string{"address_prefixes", "fully_qualified_domain_names", "subscription_ids"},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceNetworkSecurityPerimeterAccessRuleCustomizeDiff),
+	}
+}
+
+func resourceNetworkSecurityPerimeterAccessRuleCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	direction := d.Get("direction").(string)
+	if direction == "" {
+		return nil
+	}
+
+	// inbound rules match on the caller, whereas outbound rules match on the destination
+	if direction == string(networksecurityperimeteraccessrules.AccessRuleDirectionInbound) {
+		if len(d.Get("fully_qualified_domain_names").(*pluginsdk.Set).List()) > 0 {
+			return fmt.Errorf("`fully_qualified_domain_names` can only be specified when `direction` is `Outbound`")
+		}
+		return nil
+	}
+
+	if len(d.Get("address_prefixes").(*pluginsdk.Set).List()) > 0 || len(d.Get("subscription_ids").(*pluginsdk.Set).List()) > 0 {
+		return fmt.Errorf("`address_prefixes` and `subscription_ids` can only be specified when `direction` is `Inbound`")
+	}
+
+	return nil
+}
+
+func resourceNetworkSecurityPerimeterAccessRuleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterAccessRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := networksecurityperimeterprofiles.ParseProfileID(d.Get("network_security_perimeter_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := networksecurityperimeteraccessrules.NewAccessRuleID(profileId.SubscriptionId, profileId.ResourceGroupName, profileId.NetworkSecurityPerimeterName, profileId.ProfileName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_security_perimeter_access_rule", id.ID())
+		}
+	}
+
+	direction := networksecurityperimeteraccessrules.AccessRuleDirection(d.Get("direction").(string))
+	parameters := networksecurityperimeteraccessrules.NspAccessRule{
+		Properties: &networksecurityperimeteraccessrules.NspAccessRuleProperties{
+			AddressPrefixes:           utils.ExpandStringSlice(d.Get("address_prefixes").(*pluginsdk.Set).List()),
+			Direction:                 &direction,
+			FullyQualifiedDomainNames: utils.ExpandStringSlice(d.Get("fully_qualified_domain_names").(*pluginsdk.Set).List()),
+			Subscriptions:             expandNetworkSecurityPerimeterAccessRuleSubscriptions(d.Get("subscription_ids").(*pluginsdk.Set).List()),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkSecurityPerimeterAccessRuleRead(d, meta)
+}
+
+func resourceNetworkSecurityPerimeterAccessRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterAccessRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.AccessRuleName)
+	d.Set("network_security_perimeter_profile_id", networksecurityperimeterprofiles.NewProfileID(id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityPerimeterName, id.ProfileName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			direction := ""
+			if props.Direction != nil {
+				direction = string(*props.Direction)
+			}
+			d.Set("direction", direction)
+
+			if err := d.Set("address_prefixes", utils.FlattenStringSlice(props.AddressPrefixes)); err != nil {
+				return fmt.Errorf("setting `address_prefixes`: %+v", err)
+			}
+			if err := d.Set("fully_qualified_domain_names", utils.FlattenStringSlice(props.FullyQualifiedDomainNames)); err != nil {
+				return fmt.Errorf("setting `fully_qualified_domain_names`: %+v", err)
+			}
+			if err := d.Set("subscription_ids", flattenNetworkSecurityPerimeterAccessRuleSubscriptions(props.Subscriptions)); err != nil {
+				return fmt.Errorf("setting `subscription_ids`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkSecurityPerimeterAccessRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterAccessRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
+
+func expandNetworkSecurityPerimeterAccessRuleSubscriptions(input []interface{}) *[]networksecurityperimeteraccessrules.SubscriptionId {
+	results := make([]networksecurityperimeteraccessrules.SubscriptionId, 0)
+	for _, item := range input {
+		results = append(results, networksecurityperimeteraccessrules.SubscriptionId{
+			Id: utils.String(item.(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenNetworkSecurityPerimeterAccessRuleSubscriptions(input *[]networksecurityperimeteraccessrules.SubscriptionId) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Id != nil {
+			results = append(results, *item.Id)
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,177 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeteraccessrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkSecurityPerimeterAccessRuleResource struct{}
+
+func TestAccNetworkSecurityPerimeterAccessRule_inboundAddressPrefixes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.inboundAddressPrefixes(data, `["10.0.0.0/16"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.inboundAddressPrefixes(data, `["10.0.0.0/16", "192.168.0.0/24"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_inboundSubscriptions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.inboundSubscriptions(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_outboundFqdns(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.outboundFqdns(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_invalidDirection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidDirection(data),
+			ExpectError: regexp.MustCompile("`fully_qualified_domain_names` can only be specified when `direction` is `Outbound`"),
+		},
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.inboundAddressPrefixes(data, `["10.0.0.0/16"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkSecurityPerimeterAccessRulesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) inboundAddressPrefixes(data acceptance.TestData, addressPrefixes string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Inbound"
+  address_prefixes                      = %s
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger, addressPrefixes)
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) inboundSubscriptions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Inbound"
+  subscription_ids                      = [data.azurerm_subscription.current.id]
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) outboundFqdns(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Outbound"
+  fully_qualified_domain_names          = ["www.contoso.com", "www.example.com"]
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) invalidDirection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Inbound"
+  fully_qualified_domain_names          = ["www.contoso.com"]
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "import" {
+  name                                  = azurerm_network_security_perimeter_access_rule.test.name
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_access_rule.test.network_security_perimeter_profile_id
+  direction                             = azurerm_network_security_perimeter_access_rule.test.direction
+  address_prefixes                      = azurerm_network_security_perimeter_access_rule.test.address_prefixes
+}
+`, r.inboundAddressPrefixes(data, `["10.0.0.0/16"]`))
+}
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkSecurityPerimeterAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkSecurityPerimeterAssociationCreateUpdate,
+		Read:   resourceNetworkSecurityPerimeterAssociationRead,
+		Update: resourceNetworkSecurityPerimeterAssociationCreateUpdate,
+		Delete: resourceNetworkSecurityPerimeterAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := networksecurityperimeterassociations.ParseResourceAssociationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkSecurityPerimeterName,
+			},
+
+			"network_security_perimeter_profile_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networksecurityperimeterprofiles.ValidateProfileID,
+			},
+
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"access_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(networksecurityperimeterassociations.AssociationAccessModeLearning),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(networksecurityperimeterassociations.AssociationAccessModeAudit),
+					string(networksecurityperimeterassociations.AssociationAccessModeEnforced),
+					string(networksecurityperimeterassociations.AssociationAccessModeLearning),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceNetworkSecurityPerimeterAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterAssociationsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	profileId, err := networksecurityperimeterprofiles.ParseProfileID(d.Get("network_security_perimeter_profile_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// the association belongs to the perimeter rather than the profile, a resource can only be associated with a single profile
+	id := networksecurityperimeterassociations.NewResourceAssociationID(profileId.SubscriptionId, profileId.ResourceGroupName, profileId.NetworkSecurityPerimeterName, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_network_security_perimeter_association", id.ID())
+		}
+	}
+
+	accessMode := networksecurityperimeterassociations.AssociationAccessMode(d.Get("access_mode").(string))
+	parameters := networksecurityperimeterassociations.NspAssociation{
+		Properties: &networksecurityperimeterassociations.NspAssociationProperties{
+			AccessMode: &accessMode,
+			PrivateLinkResource: &networksecurityperimeterassociations.SubResource{
+				Id: utils.String(d.Get("resource_id").(string)),
+			},
+			Profile: &networksecurityperimeterassociations.SubResource{
+				Id: utils.String(profileId.ID()),
+			},
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkSecurityPerimeterAssociationRead(d, meta)
+}
+
+func resourceNetworkSecurityPerimeterAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterAssociationsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networksecurityperimeterassociations.ParseResourceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ResourceAssociationName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			accessMode := ""
+			if props.AccessMode != nil {
+				accessMode = string(*props.AccessMode)
+			}
+			d.Set("access_mode", accessMode)
+
+			resourceId := ""
+			if props.PrivateLinkResource != nil && props.PrivateLinkResource.Id != nil {
+				resourceId = *props.PrivateLinkResource.Id
+			}
+			d.Set("resource_id", resourceId)
+
+			profileId := ""
+			if props.Profile != nil && props.Profile.Id != nil {
+				parsed, err := networksecurityperimeterprofiles.ParseProfileIDInsensitively(*props.Profile.Id)
+				if err != nil {
+					return err
+				}
+				profileId = parsed.ID()
+			}
+			d.Set("network_security_perimeter_profile_id", profileId)
+		}
+	}
+
+	return nil
+}
+
+func resourceNetworkSecurityPerimeterAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterAssociationsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networksecurityperimeterassociations.ParseResourceAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
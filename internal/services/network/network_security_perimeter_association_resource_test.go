@@ -0,0 +1,138 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterassociations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkSecurityPerimeterAssociationResource struct{}
+
+func TestAccNetworkSecurityPerimeterAssociation_keyVault(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_association", "test")
+	r := NetworkSecurityPerimeterAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVault(data, "Learning"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.keyVault(data, "Enforced"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAssociation_storageAccount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_association", "test")
+	r := NetworkSecurityPerimeterAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageAccount(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_association", "test")
+	r := NetworkSecurityPerimeterAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.keyVault(data, "Learning"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (NetworkSecurityPerimeterAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networksecurityperimeterassociations.ParseResourceAssociationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkSecurityPerimeterAssociationsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (NetworkSecurityPerimeterAssociationResource) keyVault(data acceptance.TestData, accessMode string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+
+resource "azurerm_network_security_perimeter_association" "test" {
+  name                                  = "acctest-nspassoc-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  resource_id                           = azurerm_key_vault.test.id
+  access_mode                           = "%s"
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomString, data.RandomInteger, accessMode)
+}
+
+func (NetworkSecurityPerimeterAssociationResource) storageAccount(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_network_security_perimeter_association" "test" {
+  name                                  = "acctest-nspassoc-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  resource_id                           = azurerm_storage_account.test.id
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomString, data.RandomInteger)
+}
+
+func (r NetworkSecurityPerimeterAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_association" "import" {
+  name                                  = azurerm_network_security_perimeter_association.test.name
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_association.test.network_security_perimeter_profile_id
+  resource_id                           = azurerm_network_security_perimeter_association.test.resource_id
+  access_mode                           = azurerm_network_security_perimeter_association.test.access_mode
+}
+`, r.keyVault(data, "Learning"))
+}
@@ -0,0 +1,127 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceNetworkSecurityPerimeterProfile() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkSecurityPerimeterProfileCreate,
+		Read:   resourceNetworkSecurityPerimeterProfileRead,
+		Delete: resourceNetworkSecurityPerimeterProfileDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := networksecurityperimeterprofiles.ParseProfileID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkSecurityPerimeterName,
+			},
+
+			"network_security_perimeter_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networksecurityperimeters.ValidateNetworkSecurityPerimeterID,
+			},
+		},
+	}
+}
+
+func resourceNetworkSecurityPerimeterProfileCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterProfilesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	perimeterId, err := networksecurityperimeters.ParseNetworkSecurityPerimeterID(d.Get("network_security_perimeter_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := networksecurityperimeterprofiles.NewProfileID(perimeterId.SubscriptionId, perimeterId.ResourceGroupName, perimeterId.NetworkSecurityPerimeterName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_network_security_perimeter_profile", id.ID())
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, networksecurityperimeterprofiles.NspProfile{}); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkSecurityPerimeterProfileRead(d, meta)
+}
+
+func resourceNetworkSecurityPerimeterProfileRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterProfilesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networksecurityperimeterprofiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ProfileName)
+	d.Set("network_security_perimeter_id", networksecurityperimeters.NewNetworkSecurityPerimeterID(id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityPerimeterName).ID())
+
+	return nil
+}
+
+func resourceNetworkSecurityPerimeterProfileDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.NetworkSecurityPerimeterProfilesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := networksecurityperimeterprofiles.ParseProfileID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", *id, err)
+		}
+	}
+
+	return nil
+}
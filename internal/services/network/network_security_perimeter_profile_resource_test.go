@@ -0,0 +1,82 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkSecurityPerimeterProfileResource struct{}
+
+func TestAccNetworkSecurityPerimeterProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_profile", "test")
+	r := NetworkSecurityPerimeterProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_profile", "test")
+	r := NetworkSecurityPerimeterProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (NetworkSecurityPerimeterProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networksecurityperimeterprofiles.ParseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkSecurityPerimeterProfilesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (NetworkSecurityPerimeterProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_profile" "test" {
+  name                          = "acctest-nspprofile-%d"
+  network_security_perimeter_id = azurerm_network_security_perimeter.test.id
+}
+`, NetworkSecurityPerimeterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityPerimeterProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_profile" "import" {
+  name                          = azurerm_network_security_perimeter_profile.test.name
+  network_security_perimeter_id = azurerm_network_security_perimeter_profile.test.network_security_perimeter_id
+}
+`, r.basic(data))
+}
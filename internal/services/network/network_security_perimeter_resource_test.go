@@ -0,0 +1,144 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-08-01-preview/networksecurityperimeters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkSecurityPerimeterResource struct{}
+
+func TestAccNetworkSecurityPerimeter_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter", "test")
+	r := NetworkSecurityPerimeterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("perimeter_guid").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeter_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter", "test")
+	r := NetworkSecurityPerimeterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeter_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter", "test")
+	r := NetworkSecurityPerimeterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (NetworkSecurityPerimeterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networksecurityperimeters.ParseNetworkSecurityPerimeterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.NetworkSecurityPerimetersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (NetworkSecurityPerimeterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nsp-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_security_perimeter" "test" {
+  name                = "acctest-nsp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (NetworkSecurityPerimeterResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nsp-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_network_security_perimeter" "test" {
+  name                = "acctest-nsp-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r NetworkSecurityPerimeterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter" "import" {
+  name                = azurerm_network_security_perimeter.test.name
+  resource_group_name = azurerm_network_security_perimeter.test.resource_group_name
+  location            = azurerm_network_security_perimeter.test.location
+}
+`, r.basic(data))
+}
@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type CustomIpPrefixId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	CustomIPPrefixeName string
+}
+
+func NewCustomIpPrefixID(subscriptionId, resourceGroup, customIPPrefixeName string) CustomIpPrefixId {
+	return CustomIpPrefixId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		CustomIPPrefixeName: customIPPrefixeName,
+	}
+}
+
+func (id CustomIpPrefixId) String() string {
+	segments := []string{
+		fmt.Sprintf("Custom I P Prefixe Name %q", id.CustomIPPrefixeName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Custom Ip Prefix", segmentsStr)
+}
+
+func (id CustomIpPrefixId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/customIPPrefixes/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CustomIPPrefixeName)
+}
+
+// CustomIpPrefixID parses a CustomIpPrefix ID into an CustomIpPrefixId struct
+func CustomIpPrefixID(input string) (*CustomIpPrefixId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CustomIpPrefixId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.CustomIPPrefixeName, err = id.PopSegment("customIPPrefixes"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -45,6 +47,34 @@ func dataSourcePublicIpPrefix() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"custom_ip_prefix_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_addresses": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"ip_address": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"zones": azure.SchemaZonesComputed(),
 
 			"tags": tags.SchemaDataSource(),
@@ -78,6 +108,61 @@ func dataSourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) e
 	if props := resp.PublicIPPrefixPropertiesFormat; props != nil {
 		d.Set("prefix_length", props.PrefixLength)
 		d.Set("ip_prefix", props.IPPrefix)
+
+		customIpPrefixId := ""
+		if prefix := props.CustomIPPrefix; prefix != nil && prefix.ID != nil {
+			customIpPrefixId = *prefix.ID
+		}
+		d.Set("custom_ip_prefix_id", customIpPrefixId)
+
+		publicIpAddresses, err := flattenPublicIpPrefixDataSourcePublicIpAddresses(d, meta, props.PublicIPAddresses)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("public_ip_addresses", publicIpAddresses); err != nil {
+			return fmt.Errorf("setting `public_ip_addresses`: %+v", err)
+		}
 	}
 	return tags.FlattenAndSet(d, resp.Tags)
 }
+
+// flattenPublicIpPrefixDataSourcePublicIpAddresses looks up each of the Public IP Addresses allocated from the prefix,
+// since the prefix only references them by ID
+func flattenPublicIpPrefixDataSourcePublicIpAddresses(d *pluginsdk.ResourceData, meta interface{}, input *[]network.ReferencedPublicIPAddress) ([]interface{}, error) {
+	client := meta.(*clients.Client).Network.PublicIPsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, item := range *input {
+		if item.ID == nil {
+			continue
+		}
+
+		id, err := parse.PublicIpAddressID(*item.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		ipAddress := ""
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+		if props := resp.PublicIPAddressPropertiesFormat; props != nil && props.IPAddress != nil {
+			ipAddress = *props.IPAddress
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":         id.ID(),
+			"name":       id.Name,
+			"ip_address": ipAddress,
+		})
+	}
+
+	return results, nil
+}
@@ -34,6 +34,23 @@ func TestAccDataSourcePublicIPPrefix_basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePublicIPPrefix_publicIpAddresses(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_public_ip_prefix", "test")
+	r := PublicIPPrefixDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.publicIpAddresses(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("public_ip_addresses.#").HasValue("1"),
+				check.That(data.ResourceName).Key("public_ip_addresses.0.id").Exists(),
+				check.That(data.ResourceName).Key("public_ip_addresses.0.name").HasValue(fmt.Sprintf("acctestpublicip-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("public_ip_addresses.0.ip_address").Exists(),
+			),
+		},
+	})
+}
+
 func (PublicIPPrefixDataSource) basic(name string, resourceGroupName string, data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -67,3 +84,39 @@ data "azurerm_public_ip_prefix" "test" {
 }
 `, resourceGroupName, data.Locations.Primary, name)
 }
+
+func (PublicIPPrefixDataSource) publicIpAddresses(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip_prefix" "test" {
+  name                = "acctestpublicipprefix-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  prefix_length       = 30
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+  public_ip_prefix_id = azurerm_public_ip_prefix.test.id
+}
+
+data "azurerm_public_ip_prefix" "test" {
+  name                = azurerm_public_ip_prefix.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_public_ip.test]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -97,6 +98,13 @@ func resourcePublicIpPrefix() *pluginsdk.Resource {
 				}, true),
 			},
 
+			"custom_ip_prefix_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CustomIpPrefixID,
+			},
+
 			"ip_prefix": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -185,6 +193,12 @@ func resourcePublicIpPrefixCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		Zones: zones,
 	}
 
+	if v := d.Get("custom_ip_prefix_id").(string); v != "" {
+		publicIpPrefix.PublicIPPrefixPropertiesFormat.CustomIPPrefix = &network.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.PublicIPPrefixeName, publicIpPrefix)
 	if err != nil {
 		return fmt.Errorf("creating/Updating %s: %+v", id, err)
@@ -250,6 +264,12 @@ func resourcePublicIpPrefixRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if version := props.PublicIPAddressVersion; version != "" {
 			d.Set("ip_version", string(props.PublicIPAddressVersion))
 		}
+
+		customIpPrefixId := ""
+		if prefix := props.CustomIPPrefix; prefix != nil && prefix.ID != nil {
+			customIpPrefixId = *prefix.ID
+		}
+		d.Set("custom_ip_prefix_id", customIpPrefixId)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -0,0 +1,15 @@
+package subnets
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SubnetsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSubnetsClientWithBaseURI(endpoint string) SubnetsClient {
+	return SubnetsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,93 @@
+package subnets
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type VirtualNetworkPrivateEndpointNetworkPolicies string
+
+const (
+	VirtualNetworkPrivateEndpointNetworkPoliciesDisabled VirtualNetworkPrivateEndpointNetworkPolicies = "Disabled"
+	VirtualNetworkPrivateEndpointNetworkPoliciesEnabled  VirtualNetworkPrivateEndpointNetworkPolicies = "Enabled"
+)
+
+func PossibleValuesForVirtualNetworkPrivateEndpointNetworkPolicies() []string {
+	return []string{
+		string(VirtualNetworkPrivateEndpointNetworkPoliciesDisabled),
+		string(VirtualNetworkPrivateEndpointNetworkPoliciesEnabled),
+	}
+}
+
+func parseVirtualNetworkPrivateEndpointNetworkPolicies(input string) (*VirtualNetworkPrivateEndpointNetworkPolicies, error) {
+	vals := map[string]VirtualNetworkPrivateEndpointNetworkPolicies{
+		"disabled": VirtualNetworkPrivateEndpointNetworkPoliciesDisabled,
+		"enabled":  VirtualNetworkPrivateEndpointNetworkPoliciesEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VirtualNetworkPrivateEndpointNetworkPolicies(input)
+	return &out, nil
+}
+
+type VirtualNetworkPrivateLinkServiceNetworkPolicies string
+
+const (
+	VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled VirtualNetworkPrivateLinkServiceNetworkPolicies = "Disabled"
+	VirtualNetworkPrivateLinkServiceNetworkPoliciesEnabled  VirtualNetworkPrivateLinkServiceNetworkPolicies = "Enabled"
+)
+
+func PossibleValuesForVirtualNetworkPrivateLinkServiceNetworkPolicies() []string {
+	return []string{
+		string(VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled),
+		string(VirtualNetworkPrivateLinkServiceNetworkPoliciesEnabled),
+	}
+}
+
+func parseVirtualNetworkPrivateLinkServiceNetworkPolicies(input string) (*VirtualNetworkPrivateLinkServiceNetworkPolicies, error) {
+	vals := map[string]VirtualNetworkPrivateLinkServiceNetworkPolicies{
+		"disabled": VirtualNetworkPrivateLinkServiceNetworkPoliciesDisabled,
+		"enabled":  VirtualNetworkPrivateLinkServiceNetworkPoliciesEnabled,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VirtualNetworkPrivateLinkServiceNetworkPolicies(input)
+	return &out, nil
+}
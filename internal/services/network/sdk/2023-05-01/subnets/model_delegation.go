@@ -0,0 +1,9 @@
+package subnets
+
+type Delegation struct {
+	Etag       *string                            `json:"etag,omitempty"`
+	Id         *string                            `json:"id,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *ServiceDelegationPropertiesFormat `json:"properties,omitempty"`
+	Type       *string                            `json:"type,omitempty"`
+}
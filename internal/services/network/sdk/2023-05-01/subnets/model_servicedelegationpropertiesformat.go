@@ -0,0 +1,7 @@
+package subnets
+
+type ServiceDelegationPropertiesFormat struct {
+	Actions           *[]string          `json:"actions,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	ServiceName       *string            `json:"serviceName,omitempty"`
+}
@@ -0,0 +1,7 @@
+package subnets
+
+type ServiceEndpointPropertiesFormat struct {
+	Locations         *[]string          `json:"locations,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	Service           *string            `json:"service,omitempty"`
+}
@@ -0,0 +1,18 @@
+package subnets
+
+type SubnetPropertiesFormat struct {
+	AddressPrefix                      *string                                          `json:"addressPrefix,omitempty"`
+	AddressPrefixes                    *[]string                                        `json:"addressPrefixes,omitempty"`
+	ApplicationGatewayIPConfigurations *[]SubResource                                   `json:"applicationGatewayIPConfigurations,omitempty"`
+	DefaultOutboundAccess              *bool                                            `json:"defaultOutboundAccess,omitempty"`
+	Delegations                        *[]Delegation                                    `json:"delegations,omitempty"`
+	IPAllocations                      *[]SubResource                                   `json:"ipAllocations,omitempty"`
+	NatGateway                         *SubResource                                     `json:"natGateway,omitempty"`
+	NetworkSecurityGroup               *SubResource                                     `json:"networkSecurityGroup,omitempty"`
+	PrivateEndpointNetworkPolicies     *VirtualNetworkPrivateEndpointNetworkPolicies    `json:"privateEndpointNetworkPolicies,omitempty"`
+	PrivateLinkServiceNetworkPolicies  *VirtualNetworkPrivateLinkServiceNetworkPolicies `json:"privateLinkServiceNetworkPolicies,omitempty"`
+	ProvisioningState                  *ProvisioningState                               `json:"provisioningState,omitempty"`
+	RouteTable                         *SubResource                                     `json:"routeTable,omitempty"`
+	ServiceEndpointPolicies            *[]SubResource                                   `json:"serviceEndpointPolicies,omitempty"`
+	ServiceEndpoints                   *[]ServiceEndpointPropertiesFormat               `json:"serviceEndpoints,omitempty"`
+}
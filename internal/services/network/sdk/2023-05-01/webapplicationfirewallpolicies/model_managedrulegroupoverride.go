@@ -0,0 +1,6 @@
+package webapplicationfirewallpolicies
+
+type ManagedRuleGroupOverride struct {
+	RuleGroupName string                 `json:"ruleGroupName"`
+	Rules         *[]ManagedRuleOverride `json:"rules,omitempty"`
+}
@@ -0,0 +1,9 @@
+package webapplicationfirewallpolicies
+
+type MatchCondition struct {
+	MatchValues      []string                           `json:"matchValues"`
+	MatchVariables   []MatchVariable                    `json:"matchVariables"`
+	NegationConditon *bool                              `json:"negationConditon,omitempty"`
+	Operator         WebApplicationFirewallOperator     `json:"operator"`
+	Transforms       *[]WebApplicationFirewallTransform `json:"transforms,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webapplicationfirewallpolicies
+
+type OwaspCrsExclusionEntry struct {
+	MatchVariable         OwaspCrsExclusionEntryMatchVariable         `json:"matchVariable"`
+	Selector              string                                      `json:"selector"`
+	SelectorMatchOperator OwaspCrsExclusionEntrySelectorMatchOperator `json:"selectorMatchOperator"`
+}
@@ -0,0 +1,9 @@
+package webapplicationfirewallpolicies
+
+type PolicySettings struct {
+	FileUploadLimitInMb    *int64                              `json:"fileUploadLimitInMb,omitempty"`
+	MaxRequestBodySizeInKb *int64                              `json:"maxRequestBodySizeInKb,omitempty"`
+	Mode                   *WebApplicationFirewallMode         `json:"mode,omitempty"`
+	RequestBodyCheck       *bool                               `json:"requestBodyCheck,omitempty"`
+	State                  *WebApplicationFirewallEnabledState `json:"state,omitempty"`
+}
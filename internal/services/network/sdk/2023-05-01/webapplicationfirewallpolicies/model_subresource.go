@@ -0,0 +1,5 @@
+package webapplicationfirewallpolicies
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
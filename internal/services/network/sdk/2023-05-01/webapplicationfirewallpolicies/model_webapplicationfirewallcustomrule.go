@@ -0,0 +1,10 @@
+package webapplicationfirewallpolicies
+
+type WebApplicationFirewallCustomRule struct {
+	Action          WebApplicationFirewallAction   `json:"action"`
+	Etag            *string                        `json:"etag,omitempty"`
+	MatchConditions []MatchCondition               `json:"matchConditions"`
+	Name            *string                        `json:"name,omitempty"`
+	Priority        int64                          `json:"priority"`
+	RuleType        WebApplicationFirewallRuleType `json:"ruleType"`
+}
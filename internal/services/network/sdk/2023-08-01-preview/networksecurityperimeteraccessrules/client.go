@@ -0,0 +1,15 @@
+package networksecurityperimeteraccessrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkSecurityPerimeterAccessRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkSecurityPerimeterAccessRulesClientWithBaseURI(endpoint string) NetworkSecurityPerimeterAccessRulesClient {
+	return NetworkSecurityPerimeterAccessRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,150 @@
+package networksecurityperimeteraccessrules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = AccessRuleId{}
+
+// AccessRuleId is a struct representing the Resource ID for a Access Rule
+type AccessRuleId struct {
+	SubscriptionId               string
+	ResourceGroupName            string
+	NetworkSecurityPerimeterName string
+	ProfileName                  string
+	AccessRuleName               string
+}
+
+// NewAccessRuleID returns a new AccessRuleId struct
+func NewAccessRuleID(subscriptionId string, resourceGroupName string, networkSecurityPerimeterName string, profileName string, accessRuleName string) AccessRuleId {
+	return AccessRuleId{
+		SubscriptionId:               subscriptionId,
+		ResourceGroupName:            resourceGroupName,
+		NetworkSecurityPerimeterName: networkSecurityPerimeterName,
+		ProfileName:                  profileName,
+		AccessRuleName:               accessRuleName,
+	}
+}
+
+// ParseAccessRuleID parses 'input' into a AccessRuleId
+func ParseAccessRuleID(input string) (*AccessRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AccessRuleId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AccessRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkSecurityPerimeterName, ok = parsed.Parsed["networkSecurityPerimeterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkSecurityPerimeterName' was not found in the resource id %q", input)
+	}
+
+	if id.ProfileName, ok = parsed.Parsed["profileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'profileName' was not found in the resource id %q", input)
+	}
+
+	if id.AccessRuleName, ok = parsed.Parsed["accessRuleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'accessRuleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseAccessRuleIDInsensitively parses 'input' case-insensitively into a AccessRuleId
+// note: this method should only be used for API response data and not user input
+func ParseAccessRuleIDInsensitively(input string) (*AccessRuleId, error) {
+	parser := resourceids.NewParserFromResourceIdType(AccessRuleId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := AccessRuleId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NetworkSecurityPerimeterName, ok = parsed.Parsed["networkSecurityPerimeterName"]; !ok {
+		return nil, fmt.Errorf("the segment 'networkSecurityPerimeterName' was not found in the resource id %q", input)
+	}
+
+	if id.ProfileName, ok = parsed.Parsed["profileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'profileName' was not found in the resource id %q", input)
+	}
+
+	if id.AccessRuleName, ok = parsed.Parsed["accessRuleName"]; !ok {
+		return nil, fmt.Errorf("the segment 'accessRuleName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateAccessRuleID checks that 'input' can be parsed as a Access Rule ID
+func ValidateAccessRuleID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseAccessRuleID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Access Rule ID
+func (id AccessRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityPerimeters/%s/profiles/%s/accessRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityPerimeterName, id.ProfileName, id.AccessRuleName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Access Rule ID
+func (id AccessRuleId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticNetworkSecurityPerimeters", "networkSecurityPerimeters", "networkSecurityPerimeters"),
+		resourceids.UserSpecifiedSegment("networkSecurityPerimeterName", "networkSecurityPerimeterValue"),
+		resourceids.StaticSegment("staticProfiles", "profiles", "profiles"),
+		resourceids.UserSpecifiedSegment("profileName", "profileValue"),
+		resourceids.StaticSegment("staticAccessRules", "accessRules", "accessRules"),
+		resourceids.UserSpecifiedSegment("accessRuleName", "accessRuleValue"),
+	}
+}
+
+// String returns a human-readable description of this Access Rule ID
+func (id AccessRuleId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Network Security Perimeter Name: %q", id.NetworkSecurityPerimeterName),
+		fmt.Sprintf("Profile Name: %q", id.ProfileName),
+		fmt.Sprintf("Access Rule Name: %q", id.AccessRuleName),
+	}
+	return fmt.Sprintf("Access Rule (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,10 @@
+package networksecurityperimeteraccessrules
+
+type NspAccessRule struct {
+	Id         *string                  `json:"id,omitempty"`
+	Location   *string                  `json:"location,omitempty"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *NspAccessRuleProperties `json:"properties,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
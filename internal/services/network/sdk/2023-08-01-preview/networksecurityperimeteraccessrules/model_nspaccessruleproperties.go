@@ -0,0 +1,13 @@
+package networksecurityperimeteraccessrules
+
+type NspAccessRuleProperties struct {
+	AddressPrefixes           *[]string                   `json:"addressPrefixes,omitempty"`
+	Direction                 *AccessRuleDirection        `json:"direction,omitempty"`
+	EmailAddresses            *[]string                   `json:"emailAddresses,omitempty"`
+	FullyQualifiedDomainNames *[]string                   `json:"fullyQualifiedDomainNames,omitempty"`
+	NetworkSecurityPerimeters *[]PerimeterBasedAccessRule `json:"networkSecurityPerimeters,omitempty"`
+	PhoneNumbers              *[]string                   `json:"phoneNumbers,omitempty"`
+	ProvisioningState         *NspProvisioningState       `json:"provisioningState,omitempty"`
+	ServiceTags               *[]string                   `json:"serviceTags,omitempty"`
+	Subscriptions             *[]SubscriptionId           `json:"subscriptions,omitempty"`
+}
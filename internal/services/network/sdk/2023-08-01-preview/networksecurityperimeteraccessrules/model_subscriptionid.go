@@ -0,0 +1,5 @@
+package networksecurityperimeteraccessrules
+
+type SubscriptionId struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,15 @@
+package networksecurityperimeterassociations
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkSecurityPerimeterAssociationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkSecurityPerimeterAssociationsClientWithBaseURI(endpoint string) NetworkSecurityPerimeterAssociationsClient {
+	return NetworkSecurityPerimeterAssociationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
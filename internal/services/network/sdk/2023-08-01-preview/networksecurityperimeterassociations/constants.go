@@ -0,0 +1,74 @@
+package networksecurityperimeterassociations
+
+import "strings"
+
+type AssociationAccessMode string
+
+const (
+	AssociationAccessModeAudit    AssociationAccessMode = "Audit"
+	AssociationAccessModeEnforced AssociationAccessMode = "Enforced"
+	AssociationAccessModeLearning AssociationAccessMode = "Learning"
+)
+
+func PossibleValuesForAssociationAccessMode() []string {
+	return []string{
+		string(AssociationAccessModeAudit),
+		string(AssociationAccessModeEnforced),
+		string(AssociationAccessModeLearning),
+	}
+}
+
+func parseAssociationAccessMode(input string) (*AssociationAccessMode, error) {
+	vals := map[string]AssociationAccessMode{
+		"audit":    AssociationAccessModeAudit,
+		"enforced": AssociationAccessModeEnforced,
+		"learning": AssociationAccessModeLearning,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AssociationAccessMode(input)
+	return &out, nil
+}
+
+type NspProvisioningState string
+
+const (
+	NspProvisioningStateAccepted  NspProvisioningState = "Accepted"
+	NspProvisioningStateCreating  NspProvisioningState = "Creating"
+	NspProvisioningStateDeleting  NspProvisioningState = "Deleting"
+	NspProvisioningStateFailed    NspProvisioningState = "Failed"
+	NspProvisioningStateSucceeded NspProvisioningState = "Succeeded"
+	NspProvisioningStateUpdating  NspProvisioningState = "Updating"
+)
+
+func PossibleValuesForNspProvisioningState() []string {
+	return []string{
+		string(NspProvisioningStateAccepted),
+		string(NspProvisioningStateCreating),
+		string(NspProvisioningStateDeleting),
+		string(NspProvisioningStateFailed),
+		string(NspProvisioningStateSucceeded),
+		string(NspProvisioningStateUpdating),
+	}
+}
+
+func parseNspProvisioningState(input string) (*NspProvisioningState, error) {
+	vals := map[string]NspProvisioningState{
+		"accepted":  NspProvisioningStateAccepted,
+		"creating":  NspProvisioningStateCreating,
+		"deleting":  NspProvisioningStateDeleting,
+		"failed":    NspProvisioningStateFailed,
+		"succeeded": NspProvisioningStateSucceeded,
+		"updating":  NspProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NspProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,9 @@
+package networksecurityperimeterassociations
+
+type NspAssociationProperties struct {
+	AccessMode            *AssociationAccessMode `json:"accessMode,omitempty"`
+	HasProvisioningIssues *string                `json:"hasProvisioningIssues,omitempty"`
+	PrivateLinkResource   *SubResource           `json:"privateLinkResource,omitempty"`
+	Profile               *SubResource           `json:"profile,omitempty"`
+	ProvisioningState     *NspProvisioningState  `json:"provisioningState,omitempty"`
+}
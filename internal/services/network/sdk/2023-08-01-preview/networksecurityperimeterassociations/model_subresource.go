@@ -0,0 +1,5 @@
+package networksecurityperimeterassociations
+
+type SubResource struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,9 @@
+package networksecurityperimeterassociations
+
+import "fmt"
+
+const defaultApiVersion = "2023-08-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/networksecurityperimeterassociations/%s", defaultApiVersion)
+}
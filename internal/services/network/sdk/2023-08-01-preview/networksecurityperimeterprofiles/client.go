@@ -0,0 +1,15 @@
+package networksecurityperimeterprofiles
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkSecurityPerimeterProfilesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkSecurityPerimeterProfilesClientWithBaseURI(endpoint string) NetworkSecurityPerimeterProfilesClient {
+	return NetworkSecurityPerimeterProfilesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
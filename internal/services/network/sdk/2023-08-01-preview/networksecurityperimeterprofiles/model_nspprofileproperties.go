@@ -0,0 +1,6 @@
+package networksecurityperimeterprofiles
+
+type NspProfileProperties struct {
+	AccessRulesVersion        *string `json:"accessRulesVersion,omitempty"`
+	DiagnosticSettingsVersion *string `json:"diagnosticSettingsVersion,omitempty"`
+}
@@ -0,0 +1,15 @@
+package networksecurityperimeters
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NetworkSecurityPerimetersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNetworkSecurityPerimetersClientWithBaseURI(endpoint string) NetworkSecurityPerimetersClient {
+	return NetworkSecurityPerimetersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
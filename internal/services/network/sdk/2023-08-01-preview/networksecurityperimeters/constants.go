@@ -0,0 +1,43 @@
+package networksecurityperimeters
+
+import "strings"
+
+type NspProvisioningState string
+
+const (
+	NspProvisioningStateAccepted  NspProvisioningState = "Accepted"
+	NspProvisioningStateCreating  NspProvisioningState = "Creating"
+	NspProvisioningStateDeleting  NspProvisioningState = "Deleting"
+	NspProvisioningStateFailed    NspProvisioningState = "Failed"
+	NspProvisioningStateSucceeded NspProvisioningState = "Succeeded"
+	NspProvisioningStateUpdating  NspProvisioningState = "Updating"
+)
+
+func PossibleValuesForNspProvisioningState() []string {
+	return []string{
+		string(NspProvisioningStateAccepted),
+		string(NspProvisioningStateCreating),
+		string(NspProvisioningStateDeleting),
+		string(NspProvisioningStateFailed),
+		string(NspProvisioningStateSucceeded),
+		string(NspProvisioningStateUpdating),
+	}
+}
+
+func parseNspProvisioningState(input string) (*NspProvisioningState, error) {
+	vals := map[string]NspProvisioningState{
+		"accepted":  NspProvisioningStateAccepted,
+		"creating":  NspProvisioningStateCreating,
+		"deleting":  NspProvisioningStateDeleting,
+		"failed":    NspProvisioningStateFailed,
+		"succeeded": NspProvisioningStateSucceeded,
+		"updating":  NspProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NspProvisioningState(input)
+	return &out, nil
+}
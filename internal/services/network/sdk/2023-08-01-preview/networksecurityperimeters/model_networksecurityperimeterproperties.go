@@ -0,0 +1,6 @@
+package networksecurityperimeters
+
+type NetworkSecurityPerimeterProperties struct {
+	PerimeterGuid     *string               `json:"perimeterGuid,omitempty"`
+	ProvisioningState *NspProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/subnets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -65,6 +67,11 @@ func dataSourceSubnet() *pluginsdk.Resource {
 				},
 			},
 
+			"default_outbound_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"enforce_private_link_endpoint_network_policies": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -79,15 +86,15 @@ func dataSourceSubnet() *pluginsdk.Resource {
 }
 
 func dataSourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network.SubnetsV20230501Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id := parse.NewSubnetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("virtual_network_name").(string), d.Get("name").(string))
-	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	resp, err := client.Get(ctx, subnets.NewSubnetID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("Error: %s was not found", id)
 		}
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -98,7 +105,8 @@ func dataSourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("virtual_network_name", id.VirtualNetworkName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	if props := resp.SubnetPropertiesFormat; props != nil {
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
 		d.Set("address_prefix", props.AddressPrefix)
 		if props.AddressPrefixes == nil {
 			if props.AddressPrefix != nil && len(*props.AddressPrefix) > 0 {
@@ -110,18 +118,33 @@ func dataSourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("address_prefixes", utils.FlattenStringSlice(props.AddressPrefixes))
 		}
 
-		d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateEndpointNetworkPolicies)))
-		d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateLinkServiceNetworkPolicies)))
+		defaultOutboundAccessEnabled := true
+		if props.DefaultOutboundAccess != nil {
+			defaultOutboundAccessEnabled = *props.DefaultOutboundAccess
+		}
+		d.Set("default_outbound_access_enabled", defaultOutboundAccessEnabled)
+
+		privateEndpointNetworkPolicies := ""
+		if props.PrivateEndpointNetworkPolicies != nil {
+			privateEndpointNetworkPolicies = string(*props.PrivateEndpointNetworkPolicies)
+		}
+		d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
+
+		privateLinkServiceNetworkPolicies := ""
+		if props.PrivateLinkServiceNetworkPolicies != nil {
+			privateLinkServiceNetworkPolicies = string(*props.PrivateLinkServiceNetworkPolicies)
+		}
+		d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
 
 		networkSecurityGroupId := ""
-		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
-			networkSecurityGroupId = *props.NetworkSecurityGroup.ID
+		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.Id != nil {
+			networkSecurityGroupId = *props.NetworkSecurityGroup.Id
 		}
 		d.Set("network_security_group_id", networkSecurityGroupId)
 
 		routeTableId := ""
-		if props.RouteTable != nil && props.RouteTable.ID != nil {
-			routeTableId = *props.RouteTable.ID
+		if props.RouteTable != nil && props.RouteTable.Id != nil {
+			routeTableId = *props.RouteTable.Id
 		}
 		d.Set("route_table_id", routeTableId)
 
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/subnets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -165,6 +167,14 @@ func resourceSubnet() *pluginsdk.Resource {
 				},
 			},
 
+			// outbound access without an explicit outbound method (NAT Gateway, Load Balancer, Public IP etc) is being
+			// retired, so this needs to be configurable on every subnet rather than only through the Virtual Network
+			"default_outbound_access_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"enforce_private_link_endpoint_network_policies": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -183,6 +193,7 @@ func resourceSubnet() *pluginsdk.Resource {
 // TODO: refactor the create/flatten functions
 func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.SubnetsClient
+	subnetsClient := meta.(*clients.Client).Network.SubnetsV20230501Client
 	vnetClient := meta.(*clients.Client).Network.VnetClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -205,7 +216,9 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
-	properties := network.SubnetPropertiesFormat{}
+	properties := subnets.SubnetPropertiesFormat{
+		DefaultOutboundAccess: utils.Bool(d.Get("default_outbound_access_enabled").(bool)),
+	}
 	if value, ok := d.GetOk("address_prefixes"); ok {
 		var addressPrefixes []string
 		for _, item := range value.([]interface{}) {
@@ -226,8 +239,10 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	// Network policies like network security groups are not supported by private endpoints.
 	privateEndpointNetworkPolicies := d.Get("enforce_private_link_endpoint_network_policies").(bool)
 	privateLinkServiceNetworkPolicies := d.Get("enforce_private_link_service_network_policies").(bool)
-	properties.PrivateEndpointNetworkPolicies = network.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
-	properties.PrivateLinkServiceNetworkPolicies = network.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
+	privateEndpointNetworkPoliciesValue := subnets.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
+	privateLinkServiceNetworkPoliciesValue := subnets.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
+	properties.PrivateEndpointNetworkPolicies = &privateEndpointNetworkPoliciesValue
+	properties.PrivateLinkServiceNetworkPolicies = &privateLinkServiceNetworkPoliciesValue
 
 	serviceEndpointsRaw := d.Get("service_endpoints").([]interface{})
 	properties.ServiceEndpoints = expandSubnetServiceEndpoints(serviceEndpointsRaw)
@@ -238,20 +253,16 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	delegationsRaw := d.Get("delegation").([]interface{})
 	properties.Delegations = expandSubnetDelegation(delegationsRaw)
 
-	subnet := network.Subnet{
-		Name:                   utils.String(id.Name),
-		SubnetPropertiesFormat: &properties,
+	subnet := subnets.Subnet{
+		Name:       utils.String(id.Name),
+		Properties: &properties,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
-	if err != nil {
+	subnetId := subnets.NewSubnetID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+	if err := subnetsClient.CreateOrUpdateThenPoll(ctx, subnetId, subnet); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
-	}
-
 	timeout, _ := ctx.Deadline()
 
 	stateConf := &pluginsdk.StateChangeConf{
@@ -283,6 +294,7 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.SubnetsClient
+	subnetsClient := meta.(*clients.Client).Network.SubnetsV20230501Client
 	vnetClient := meta.(*clients.Client).Network.VnetClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -298,18 +310,25 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	locks.ByName(id.Name, SubnetResourceName)
 	defer locks.UnlockByName(id.Name, SubnetResourceName)
 
-	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	subnetId := subnets.NewSubnetID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+	existing, err := subnetsClient.Get(ctx, subnetId)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	if existing.SubnetPropertiesFormat == nil {
+	if existing.Model == nil || existing.Model.Properties == nil {
 		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
 	}
 
 	// TODO: locking on the NSG/Route Table if applicable
 
-	props := *existing.SubnetPropertiesFormat
+	// the existing Subnet is updated so that any properties managed outside of this resource (e.g. the Network
+	// Security Group, Route Table and NAT Gateway associations) are retained
+	props := *existing.Model.Properties
+
+	if d.HasChange("default_outbound_access_enabled") {
+		props.DefaultOutboundAccess = utils.Bool(d.Get("default_outbound_access_enabled").(bool))
+	}
 
 	if d.HasChange("address_prefix") {
 		props.AddressPrefix = utils.String(d.Get("address_prefix").(string))
@@ -338,13 +357,13 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("enforce_private_link_endpoint_network_policies") {
-		v := d.Get("enforce_private_link_endpoint_network_policies").(bool)
-		props.PrivateEndpointNetworkPolicies = network.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(v))
+		v := subnets.VirtualNetworkPrivateEndpointNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(d.Get("enforce_private_link_endpoint_network_policies").(bool)))
+		props.PrivateEndpointNetworkPolicies = &v
 	}
 
 	if d.HasChange("enforce_private_link_service_network_policies") {
-		v := d.Get("enforce_private_link_service_network_policies").(bool)
-		props.PrivateLinkServiceNetworkPolicies = network.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(v))
+		v := subnets.VirtualNetworkPrivateLinkServiceNetworkPolicies(expandSubnetPrivateLinkNetworkPolicy(d.Get("enforce_private_link_service_network_policies").(bool)))
+		props.PrivateLinkServiceNetworkPolicies = &v
 	}
 
 	if d.HasChange("service_endpoints") {
@@ -357,20 +376,15 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		props.ServiceEndpointPolicies = expandSubnetServiceEndpointPolicies(serviceEndpointPoliciesRaw)
 	}
 
-	subnet := network.Subnet{
-		Name:                   utils.String(id.Name),
-		SubnetPropertiesFormat: &props,
+	subnet := subnets.Subnet{
+		Name:       utils.String(id.Name),
+		Properties: &props,
 	}
 
-	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, subnet)
-	if err != nil {
+	if err := subnetsClient.CreateOrUpdateThenPoll(ctx, subnetId, subnet); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
-	}
-
 	timeout, _ := ctx.Deadline()
 
 	stateConf := &pluginsdk.StateChangeConf{
@@ -400,7 +414,7 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 }
 
 func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.SubnetsClient
+	client := meta.(*clients.Client).Network.SubnetsV20230501Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -409,9 +423,9 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name, "")
+	resp, err := client.Get(ctx, subnets.NewSubnetID(id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
@@ -422,7 +436,8 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("virtual_network_name", id.VirtualNetworkName)
 	d.Set("resource_group_name", id.ResourceGroup)
 
-	if props := resp.SubnetPropertiesFormat; props != nil {
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := model.Properties
 		d.Set("address_prefix", props.AddressPrefix)
 		if props.AddressPrefixes == nil {
 			if props.AddressPrefix != nil && len(*props.AddressPrefix) > 0 {
@@ -434,13 +449,29 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			d.Set("address_prefixes", props.AddressPrefixes)
 		}
 
-		delegation := flattenSubnetDelegation(props.Delegations)
+		delegation := flattenSubnetDelegation(props.Delegations, d.Get("delegation").([]interface{}))
 		if err := d.Set("delegation", delegation); err != nil {
 			return fmt.Errorf("flattening `delegation`: %+v", err)
 		}
 
-		d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateEndpointNetworkPolicies)))
-		d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(string(props.PrivateLinkServiceNetworkPolicies)))
+		// the API omits this when it's the default of enabled
+		defaultOutboundAccessEnabled := true
+		if props.DefaultOutboundAccess != nil {
+			defaultOutboundAccessEnabled = *props.DefaultOutboundAccess
+		}
+		d.Set("default_outbound_access_enabled", defaultOutboundAccessEnabled)
+
+		privateEndpointNetworkPolicies := ""
+		if props.PrivateEndpointNetworkPolicies != nil {
+			privateEndpointNetworkPolicies = string(*props.PrivateEndpointNetworkPolicies)
+		}
+		d.Set("enforce_private_link_endpoint_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateEndpointNetworkPolicies))
+
+		privateLinkServiceNetworkPolicies := ""
+		if props.PrivateLinkServiceNetworkPolicies != nil {
+			privateLinkServiceNetworkPolicies = string(*props.PrivateLinkServiceNetworkPolicies)
+		}
+		d.Set("enforce_private_link_service_network_policies", flattenSubnetPrivateLinkNetworkPolicy(privateLinkServiceNetworkPolicies))
 
 		serviceEndpoints := flattenSubnetServiceEndpoints(props.ServiceEndpoints)
 		if err := d.Set("service_endpoints", serviceEndpoints); err != nil {
@@ -484,12 +515,12 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func expandSubnetServiceEndpoints(input []interface{}) *[]network.ServiceEndpointPropertiesFormat {
-	endpoints := make([]network.ServiceEndpointPropertiesFormat, 0)
+func expandSubnetServiceEndpoints(input []interface{}) *[]subnets.ServiceEndpointPropertiesFormat {
+	endpoints := make([]subnets.ServiceEndpointPropertiesFormat, 0)
 
 	for _, svcEndpointRaw := range input {
 		if svc, ok := svcEndpointRaw.(string); ok {
-			endpoint := network.ServiceEndpointPropertiesFormat{
+			endpoint := subnets.ServiceEndpointPropertiesFormat{
 				Service: &svc,
 			}
 			endpoints = append(endpoints, endpoint)
@@ -499,7 +530,7 @@ func expandSubnetServiceEndpoints(input []interface{}) *[]network.ServiceEndpoin
 	return &endpoints
 }
 
-func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPropertiesFormat) []string {
+func flattenSubnetServiceEndpoints(serviceEndpoints *[]subnets.ServiceEndpointPropertiesFormat) []string {
 	endpoints := make([]string, 0)
 
 	if serviceEndpoints == nil {
@@ -515,8 +546,8 @@ func flattenSubnetServiceEndpoints(serviceEndpoints *[]network.ServiceEndpointPr
 	return endpoints
 }
 
-func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
-	retDelegations := make([]network.Delegation, 0)
+func expandSubnetDelegation(input []interface{}) *[]subnets.Delegation {
+	retDelegations := make([]subnets.Delegation, 0)
 
 	for _, deleValue := range input {
 		deleData := deleValue.(map[string]interface{})
@@ -532,9 +563,9 @@ func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
 			retSrvActions = append(retSrvActions, srvActionData)
 		}
 
-		retDelegation := network.Delegation{
+		retDelegation := subnets.Delegation{
 			Name: &deleName,
-			ServiceDelegationPropertiesFormat: &network.ServiceDelegationPropertiesFormat{
+			Properties: &subnets.ServiceDelegationPropertiesFormat{
 				ServiceName: &srvName,
 				Actions:     &retSrvActions,
 			},
@@ -546,11 +577,24 @@ func expandSubnetDelegation(input []interface{}) *[]network.Delegation {
 	return &retDelegations
 }
 
-func flattenSubnetDelegation(delegations *[]network.Delegation) []interface{} {
+// flattenSubnetDelegation flattens the Delegations - since the API doesn't guarantee the order of the `actions`
+// for a Service Delegation, the configured order is retained when the same actions are returned
+func flattenSubnetDelegation(delegations *[]subnets.Delegation, existing []interface{}) []interface{} {
 	if delegations == nil {
 		return []interface{}{}
 	}
 
+	existingActions := make(map[string][]interface{})
+	for _, raw := range existing {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if serviceDelegations := v["service_delegation"].([]interface{}); len(serviceDelegations) > 0 && serviceDelegations[0] != nil {
+			existingActions[v["name"].(string)] = serviceDelegations[0].(map[string]interface{})["actions"].([]interface{})
+		}
+	}
+
 	retDeles := make([]interface{}, 0)
 
 	for _, dele := range *delegations {
@@ -561,13 +605,17 @@ func flattenSubnetDelegation(delegations *[]network.Delegation) []interface{} {
 
 		svcDeles := make([]interface{}, 0)
 		svcDele := make(map[string]interface{})
-		if props := dele.ServiceDelegationPropertiesFormat; props != nil {
+		if props := dele.Properties; props != nil {
 			if v := props.ServiceName; v != nil {
 				svcDele["name"] = *v
 			}
 
 			if v := props.Actions; v != nil {
-				svcDele["actions"] = *v
+				actions := *v
+				if configured, ok := existingActions[utils.NormalizeNilableString(dele.Name)]; ok && subnetDelegationActionsAreEquivalent(configured, actions) {
+					actions = *utils.ExpandStringSlice(configured)
+				}
+				svcDele["actions"] = actions
 			}
 		}
 
@@ -581,6 +629,21 @@ func flattenSubnetDelegation(delegations *[]network.Delegation) []interface{} {
 	return retDeles
 }
 
+func subnetDelegationActionsAreEquivalent(configured []interface{}, actual []string) bool {
+	if len(configured) != len(actual) {
+		return false
+	}
+
+	expected := *utils.ExpandStringSlice(configured)
+	for _, action := range actual {
+		if !utils.SliceContainsValue(expected, action) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // TODO: confirm this logic below
 
 func expandSubnetPrivateLinkNetworkPolicy(enabled bool) string {
@@ -601,16 +664,16 @@ func flattenSubnetPrivateLinkNetworkPolicy(input string) bool {
 	return strings.EqualFold(input, "Disabled")
 }
 
-func expandSubnetServiceEndpointPolicies(input []interface{}) *[]network.ServiceEndpointPolicy {
-	output := make([]network.ServiceEndpointPolicy, 0)
+func expandSubnetServiceEndpointPolicies(input []interface{}) *[]subnets.SubResource {
+	output := make([]subnets.SubResource, 0)
 	for _, policy := range input {
 		policy := policy.(string)
-		output = append(output, network.ServiceEndpointPolicy{ID: &policy})
+		output = append(output, subnets.SubResource{Id: &policy})
 	}
 	return &output
 }
 
-func flattenSubnetServiceEndpointPolicies(input *[]network.ServiceEndpointPolicy) []interface{} {
+func flattenSubnetServiceEndpointPolicies(input *[]subnets.SubResource) []interface{} {
 	if input == nil {
 		return nil
 	}
@@ -618,8 +681,8 @@ func flattenSubnetServiceEndpointPolicies(input *[]network.ServiceEndpointPolicy
 	var output []interface{}
 	for _, policy := range *input {
 		id := ""
-		if policy.ID != nil {
-			id = *policy.ID
+		if policy.Id != nil {
+			id = *policy.Id
 		}
 		output = append(output, id)
 	}
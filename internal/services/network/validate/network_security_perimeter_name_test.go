@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetworkSecurityPerimeterName(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    "a",
+			Expected: true,
+		},
+		{
+			Input:    "example-nsp",
+			Expected: true,
+		},
+		{
+			Input:    "example.nsp_",
+			Expected: true,
+		},
+		{
+			Input:    "-example",
+			Expected: false,
+		},
+		{
+			Input:    "example-",
+			Expected: false,
+		},
+		{
+			Input:    "example/nsp",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("s", 80),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("s", 81),
+			Expected: false,
+		},
+	}
+	for _, v := range testCases {
+		_, errors := NetworkSecurityPerimeterName(v.Input, "name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t for %q but got %t (and %d errors)", v.Expected, v.Input, result, len(errors))
+		}
+	}
+}
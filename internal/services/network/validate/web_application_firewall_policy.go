@@ -15,6 +15,9 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 	"crs_41_xss_attacks",
 	"crs_42_tight_security",
 	"crs_45_trojans",
+	"BadBots",
+	"GoodBots",
+	"UnknownBots",
 	"General",
 	"REQUEST-911-METHOD-ENFORCEMENT",
 	"REQUEST-913-SCANNER-DETECTION",
@@ -33,6 +36,7 @@ var ValidateWebApplicationFirewallPolicyRuleGroupName = validation.StringInSlice
 var ValidateWebApplicationFirewallPolicyRuleSetVersion = validation.StringInSlice([]string{
 	"0.1",
 	"1.0",
+	"1.1",
 	"2.2.9",
 	"3.0",
 	"3.1",
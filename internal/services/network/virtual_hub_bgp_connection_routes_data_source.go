@@ -0,0 +1,232 @@
+package network
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceVirtualHubBgpConnectionRoutes() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceVirtualHubBgpConnectionRoutesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"virtual_hub_bgp_connection_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.BgpConnectionID,
+			},
+
+			"learned_route": virtualHubBgpConnectionRouteSchema(),
+
+			"advertised_route": virtualHubBgpConnectionRouteSchema(),
+		},
+	}
+}
+
+func virtualHubBgpConnectionRouteSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"instance": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"local_address": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"network": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"next_hop": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"source_peer": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"origin": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"as_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"weight": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVirtualHubBgpConnectionRoutesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.VirtualHubBgpConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BgpConnectionID(d.Get("virtual_hub_bgp_connection_id").(string))
+	if err != nil {
+		return err
+	}
+
+	learnedFuture, err := client.ListLearnedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing learned routes for %s: %+v", *id, err)
+	}
+	learnedRoutes, err := virtualHubBgpConnectionRoutesResult(ctx, client, learnedFuture.FutureAPI)
+	if err != nil {
+		return fmt.Errorf("retrieving learned routes for %s: %+v", *id, err)
+	}
+
+	advertisedFuture, err := client.ListAdvertisedRoutes(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
+	if err != nil {
+		return fmt.Errorf("listing advertised routes for %s: %+v", *id, err)
+	}
+	advertisedRoutes, err := virtualHubBgpConnectionRoutesResult(ctx, client, advertisedFuture.FutureAPI)
+	if err != nil {
+		return fmt.Errorf("retrieving advertised routes for %s: %+v", *id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("virtual_hub_bgp_connection_id", id.ID())
+
+	if err := d.Set("learned_route", learnedRoutes); err != nil {
+		return fmt.Errorf("setting `learned_route`: %+v", err)
+	}
+
+	if err := d.Set("advertised_route", advertisedRoutes); err != nil {
+		return fmt.Errorf("setting `advertised_route`: %+v", err)
+	}
+
+	return nil
+}
+
+// virtualHubBgpConnectionRoutesResult waits for the route listing operation to complete and parses the result.
+// The API returns the routes keyed by the Route Server instance they were learned on/advertised from
+// (e.g. `{"RouteServiceRole_IN_0": [...], "RouteServiceRole_IN_1": [...]}`) rather than the `value` list
+// described in the API Specification - so the response is parsed manually to support both shapes.
+func virtualHubBgpConnectionRoutesResult(ctx context.Context, client *network.VirtualHubBgpConnectionsClient, future azure.FutureAPI) ([]interface{}, error) {
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(client, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	resp, err := future.GetResult(sender)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving result: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	results := make([]interface{}, 0)
+	if resp.StatusCode == http.StatusNoContent {
+		return results, nil
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("parsing result: %+v", err)
+	}
+
+	instances := make([]string, 0)
+	for instance := range payload {
+		instances = append(instances, instance)
+	}
+	sort.Strings(instances)
+
+	for _, instance := range instances {
+		var routes []network.PeerRoute
+		if err := json.Unmarshal(payload[instance], &routes); err != nil {
+			// `nextLink` and any other non-route properties can be ignored
+			continue
+		}
+
+		if instance == "value" {
+			instance = ""
+		}
+
+		for _, route := range routes {
+			results = append(results, flattenVirtualHubBgpConnectionRoute(instance, route))
+		}
+	}
+
+	return results, nil
+}
+
+func flattenVirtualHubBgpConnectionRoute(instance string, input network.PeerRoute) map[string]interface{} {
+	localAddress := ""
+	if input.LocalAddress != nil {
+		localAddress = *input.LocalAddress
+	}
+
+	routeNetwork := ""
+	if input.NetworkProperty != nil {
+		routeNetwork = *input.NetworkProperty
+	}
+
+	nextHop := ""
+	if input.NextHop != nil {
+		nextHop = *input.NextHop
+	}
+
+	sourcePeer := ""
+	if input.SourcePeer != nil {
+		sourcePeer = *input.SourcePeer
+	}
+
+	origin := ""
+	if input.Origin != nil {
+		origin = *input.Origin
+	}
+
+	asPath := ""
+	if input.AsPath != nil {
+		asPath = *input.AsPath
+	}
+
+	weight := 0
+	if input.Weight != nil {
+		weight = int(*input.Weight)
+	}
+
+	return map[string]interface{}{
+		"instance":      instance,
+		"local_address": localAddress,
+		"network":       routeNetwork,
+		"next_hop":      nextHop,
+		"source_peer":   sourcePeer,
+		"origin":        origin,
+		"as_path":       asPath,
+		"weight":        weight,
+	}
+}
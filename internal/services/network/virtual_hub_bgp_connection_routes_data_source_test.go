@@ -0,0 +1,37 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type VirtualHubBgpConnectionRoutesDataSource struct{}
+
+func TestAccDataSourceVirtualHubBgpConnectionRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_virtual_hub_bgp_connection_routes", "test")
+	r := VirtualHubBgpConnectionRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("virtual_hub_bgp_connection_id").Exists(),
+				check.That(data.ResourceName).Key("learned_route.#").Exists(),
+				check.That(data.ResourceName).Key("advertised_route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (VirtualHubBgpConnectionRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_virtual_hub_bgp_connection_routes" "test" {
+  virtual_hub_bgp_connection_id = azurerm_virtual_hub_bgp_connection.test.id
+}
+`, VirtualHubBGPConnectionResource{}.basic(data))
+}
@@ -100,6 +100,22 @@ func resourceVirtualHub() *pluginsdk.Resource {
 				},
 			},
 
+			"hub_routing_preference": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.PreferredRoutingGatewayExpressRoute),
+					string(network.PreferredRoutingGatewayVpnGateway),
+				}, false),
+			},
+
+			"branch_to_branch_traffic_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 
 			"default_route_table_id": {
@@ -144,7 +160,8 @@ func resourceVirtualHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	parameters := network.VirtualHub{
 		Location: utils.String(location),
 		VirtualHubProperties: &network.VirtualHubProperties{
-			RouteTable: expandVirtualHubRoute(route),
+			RouteTable:                 expandVirtualHubRoute(route),
+			AllowBranchToBranchTraffic: utils.Bool(d.Get("branch_to_branch_traffic_enabled").(bool)),
 		},
 		Tags: tags.Expand(t),
 	}
@@ -157,6 +174,10 @@ func resourceVirtualHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 		parameters.VirtualHubProperties.Sku = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("hub_routing_preference"); ok {
+		parameters.VirtualHubProperties.PreferredRoutingGateway = network.PreferredRoutingGateway(v.(string))
+	}
+
 	if v, ok := d.GetOk("virtual_wan_id"); ok {
 		parameters.VirtualHubProperties.VirtualWan = &network.SubResource{
 			ID: utils.String(v.(string)),
@@ -228,6 +249,8 @@ func resourceVirtualHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	if props := resp.VirtualHubProperties; props != nil {
 		d.Set("address_prefix", props.AddressPrefix)
 		d.Set("sku", props.Sku)
+		d.Set("hub_routing_preference", string(props.PreferredRoutingGateway))
+		d.Set("branch_to_branch_traffic_enabled", props.AllowBranchToBranchTraffic != nil && *props.AllowBranchToBranchTraffic)
 
 		if err := d.Set("route", flattenVirtualHubRoute(props.RouteTable)); err != nil {
 			return fmt.Errorf("setting `route`: %+v", err)
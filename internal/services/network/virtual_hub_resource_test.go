@@ -86,6 +86,31 @@ func TestAccVirtualHub_tags(t *testing.T) {
 	})
 }
 
+func TestAccVirtualHub_routingPreference(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub", "test")
+	r := VirtualHubResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.routingPreference(data, "ExpressRoute", false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hub_routing_preference").HasValue("ExpressRoute"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.routingPreference(data, "VpnGateway", true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("hub_routing_preference").HasValue("VpnGateway"),
+				check.That(data.ResourceName).Key("branch_to_branch_traffic_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t VirtualHubResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VirtualHubID(state.ID)
 	if err != nil {
@@ -184,6 +209,22 @@ resource "azurerm_virtual_hub" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r VirtualHubResource) routingPreference(data acceptance.TestData, preference string, branchToBranch bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                             = "acctestVHUB-%d"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = azurerm_resource_group.test.location
+  virtual_wan_id                   = azurerm_virtual_wan.test.id
+  address_prefix                   = "10.0.1.0/24"
+  hub_routing_preference           = "%s"
+  branch_to_branch_traffic_enabled = %t
+}
+`, r.template(data), data.RandomInteger, preference, branchToBranch)
+}
+
 func (VirtualHubResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
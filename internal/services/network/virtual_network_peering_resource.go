@@ -1,6 +1,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -32,6 +33,8 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVirtualNetworkPeeringCustomizeDiff),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -83,6 +86,21 @@ func resourceVirtualNetworkPeering() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			// changes to the values in this map result in the peering being re-synced with the remote
+			// Virtual Network, which is required when the Address Space of the remote Virtual Network changes
+			"triggers": {
+				Type:     pluginsdk.TypeMap,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"peering_sync_level": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -165,6 +183,7 @@ func resourceVirtualNetworkPeeringRead(d *pluginsdk.ResourceData, meta interface
 		d.Set("allow_forwarded_traffic", peer.AllowForwardedTraffic)
 		d.Set("allow_gateway_transit", peer.AllowGatewayTransit)
 		d.Set("use_remote_gateways", peer.UseRemoteGateways)
+		d.Set("peering_sync_level", string(peer.PeeringSyncLevel))
 		if network := peer.RemoteVirtualNetwork; network != nil {
 			d.Set("remote_virtual_network_id", network.ID)
 		}
@@ -198,6 +217,21 @@ func resourceVirtualNetworkPeeringDelete(d *pluginsdk.ResourceData, meta interfa
 	return err
 }
 
+func resourceVirtualNetworkPeeringCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	// when the Address Space of the remote Virtual Network has changed the local side of the peering needs
+	// to be re-synced, which happens as a part of any update to the peering
+	switch network.VirtualNetworkPeeringLevel(d.Get("peering_sync_level").(string)) {
+	case network.VirtualNetworkPeeringLevelLocalNotInSync, network.VirtualNetworkPeeringLevelLocalAndRemoteNotInSync:
+		return d.SetNewComputed("peering_sync_level")
+	}
+
+	return nil
+}
+
 func getVirtualNetworkPeeringProperties(d *pluginsdk.ResourceData) *network.VirtualNetworkPeeringPropertiesFormat {
 	allowVirtualNetworkAccess := d.Get("allow_virtual_network_access").(bool)
 	allowForwardedTraffic := d.Get("allow_forwarded_traffic").(bool)
@@ -96,6 +96,33 @@ func TestAccVirtualNetworkPeering_update(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkPeering_syncRemoteAddressSpace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering", "test1")
+	r := VirtualNetworkPeeringResource{}
+	secondResourceName := "azurerm_virtual_network_peering.test2"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.syncRemoteAddressSpace(data, `"10.0.2.0/24"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("peering_sync_level").HasValue("FullyInSync"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.syncRemoteAddressSpace(data, `"10.0.2.0/24", "10.0.3.0/24"`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("peering_sync_level").HasValue("FullyInSync"),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
 func (t VirtualNetworkPeeringResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.VirtualNetworkPeeringID(state.ID)
 	if err != nil {
@@ -228,3 +255,54 @@ resource "azurerm_virtual_network_peering" "test2" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
+
+func (VirtualNetworkPeeringResource) syncRemoteAddressSpace(data acceptance.TestData, remoteAddressSpace string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test1" {
+  name                = "acctestvirtnet-1-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.1.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network" "test2" {
+  name                = "acctestvirtnet-2-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = [%s]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network_peering" "test1" {
+  name                         = "acctestpeer-1-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  virtual_network_name         = azurerm_virtual_network.test1.name
+  remote_virtual_network_id    = azurerm_virtual_network.test2.id
+  allow_virtual_network_access = true
+
+  triggers = {
+    remote_address_space = join(",", azurerm_virtual_network.test2.address_space)
+  }
+}
+
+resource "azurerm_virtual_network_peering" "test2" {
+  name                         = "acctestpeer-2-%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  virtual_network_name         = azurerm_virtual_network.test2.name
+  remote_virtual_network_id    = azurerm_virtual_network.test1.id
+  allow_virtual_network_access = true
+
+  triggers = {
+    remote_address_space = join(",", azurerm_virtual_network.test1.address_space)
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, remoteAddressSpace, data.RandomInteger, data.RandomInteger)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/subnets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -143,6 +144,12 @@ func resourceVirtualNetwork() *pluginsdk.Resource {
 							Optional: true,
 						},
 
+						"default_outbound_access_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
 						"id": {
 							Type:     pluginsdk.TypeString,
 							Computed: true,
@@ -235,6 +242,10 @@ func resourceVirtualNetworkCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("waiting for provisioning state of %s: %+v", id, err)
 	}
 
+	if err := updateVirtualNetworkSubnetsDefaultOutboundAccess(ctx, meta.(*clients.Client).Network.SubnetsV20230501Client, id, d.Get("subnet").(*pluginsdk.Set).List()); err != nil {
+		return err
+	}
+
 	d.SetId(id.ID())
 	return resourceVirtualNetworkRead(d, meta)
 }
@@ -277,7 +288,12 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 			return fmt.Errorf("setting `ddos_protection_plan`: %+v", err)
 		}
 
-		if err := d.Set("subnet", flattenVirtualNetworkSubnets(props.Subnets)); err != nil {
+		subnetsResp, err := meta.(*clients.Client).Network.SubnetsV20230501Client.ListComplete(ctx, subnets.NewVirtualNetworkID(id.SubscriptionId, id.ResourceGroup, id.Name))
+		if err != nil {
+			return fmt.Errorf("listing Subnets for %s: %+v", *id, err)
+		}
+
+		if err := d.Set("subnet", flattenVirtualNetworkSubnets(props.Subnets, subnetsResp.Items)); err != nil {
 			return fmt.Errorf("setting `subnets`: %+v", err)
 		}
 
@@ -428,11 +444,25 @@ func flattenVirtualNetworkDDoSProtectionPlan(input *network.VirtualNetworkProper
 	}
 }
 
-func flattenVirtualNetworkSubnets(input *[]network.Subnet) *pluginsdk.Set {
+func flattenVirtualNetworkSubnets(input *[]network.Subnet, subnetsWithDefaultOutboundAccess []subnets.Subnet) *pluginsdk.Set {
 	results := &pluginsdk.Set{
 		F: resourceAzureSubnetHash,
 	}
 
+	// `defaultOutboundAccess` isn't available in the API Version used for the Virtual Network
+	defaultOutboundAccessEnabled := make(map[string]bool)
+	for _, subnet := range subnetsWithDefaultOutboundAccess {
+		if subnet.Name == nil {
+			continue
+		}
+
+		enabled := true
+		if subnet.Properties != nil && subnet.Properties.DefaultOutboundAccess != nil {
+			enabled = *subnet.Properties.DefaultOutboundAccess
+		}
+		defaultOutboundAccessEnabled[*subnet.Name] = enabled
+	}
+
 	if subnets := input; subnets != nil {
 		for _, subnet := range *input {
 			output := map[string]interface{}{}
@@ -441,8 +471,13 @@ func flattenVirtualNetworkSubnets(input *[]network.Subnet) *pluginsdk.Set {
 				output["id"] = *id
 			}
 
+			output["default_outbound_access_enabled"] = true
 			if name := subnet.Name; name != nil {
 				output["name"] = *name
+
+				if v, ok := defaultOutboundAccessEnabled[*name]; ok {
+					output["default_outbound_access_enabled"] = v
+				}
 			}
 
 			if props := subnet.SubnetPropertiesFormat; props != nil {
@@ -487,11 +522,49 @@ func resourceAzureSubnetHash(v interface{}) int {
 		if v, ok := m["security_group"]; ok {
 			buf.WriteString(v.(string))
 		}
+		// only included when disabled so that the hash of existing subnets is unchanged
+		if v, ok := m["default_outbound_access_enabled"]; ok && !v.(bool) {
+			buf.WriteString("defaultOutboundAccessDisabled")
+		}
 	}
 
 	return pluginsdk.HashString(buf.String())
 }
 
+// updateVirtualNetworkSubnetsDefaultOutboundAccess updates `defaultOutboundAccess` on the Subnets defined within
+// the Virtual Network, since this isn't available in the API Version used for the Virtual Network
+func updateVirtualNetworkSubnetsDefaultOutboundAccess(ctx context.Context, client *subnets.SubnetsClient, id parse.VirtualNetworkId, input []interface{}) error {
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		enabled := v["default_outbound_access_enabled"].(bool)
+
+		subnetId := subnets.NewSubnetID(id.SubscriptionId, id.ResourceGroup, id.Name, name)
+		existing, err := client.Get(ctx, subnetId)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", subnetId, err)
+		}
+		if existing.Model == nil || existing.Model.Properties == nil {
+			return fmt.Errorf("retrieving %s: `properties` was nil", subnetId)
+		}
+
+		current := true
+		if existing.Model.Properties.DefaultOutboundAccess != nil {
+			current = *existing.Model.Properties.DefaultOutboundAccess
+		}
+		if current == enabled {
+			continue
+		}
+
+		existing.Model.Properties.DefaultOutboundAccess = utils.Bool(enabled)
+		if err := client.CreateOrUpdateThenPoll(ctx, subnetId, *existing.Model); err != nil {
+			return fmt.Errorf("updating `default_outbound_access_enabled` for %s: %+v", subnetId, err)
+		}
+	}
+
+	return nil
+}
+
 func getExistingSubnet(ctx context.Context, resGroup string, vnetName string, subnetName string, meta interface{}) (*network.Subnet, error) {
 	subnetClient := meta.(*clients.Client).Network.SubnetsClient
 	resp, err := subnetClient.Get(ctx, resGroup, vnetName, subnetName, "")
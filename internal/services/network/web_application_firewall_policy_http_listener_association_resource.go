@@ -0,0 +1,195 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceWebApplicationFirewallPolicyHttpListenerAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebApplicationFirewallPolicyHttpListenerAssociationCreateUpdate,
+		Read:   resourceWebApplicationFirewallPolicyHttpListenerAssociationRead,
+		Update: resourceWebApplicationFirewallPolicyHttpListenerAssociationCreateUpdate,
+		Delete: resourceWebApplicationFirewallPolicyHttpListenerAssociationDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayHTTPListenerID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"http_listener_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationGatewayHTTPListenerID,
+			},
+
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ApplicationGatewayWebApplicationFirewallPolicyID,
+			},
+		},
+	}
+}
+
+func resourceWebApplicationFirewallPolicyHttpListenerAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Get("http_listener_id").(string))
+	if err != nil {
+		return err
+	}
+
+	firewallPolicyId := d.Get("firewall_policy_id").(string)
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway, id.HttpListenerName)
+	if listener == nil || listener.ApplicationGatewayHTTPListenerPropertiesFormat == nil {
+		return fmt.Errorf("%s was not found", *id)
+	}
+
+	if d.IsNewResource() && listener.FirewallPolicy != nil && listener.FirewallPolicy.ID != nil && *listener.FirewallPolicy.ID != "" {
+		return tf.ImportAsExistsError("azurerm_web_application_firewall_policy_http_listener_association", id.ID())
+	}
+
+	listener.FirewallPolicy = &network.SubResource{
+		ID: utils.String(firewallPolicyId),
+	}
+
+	if err := updateApplicationGatewayForFirewallPolicyAssociation(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("associating %s with %s: %+v", *id, firewallPolicyId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceWebApplicationFirewallPolicyHttpListenerAssociationRead(d, meta)
+}
+
+func resourceWebApplicationFirewallPolicyHttpListenerAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway, id.HttpListenerName)
+	if listener == nil || listener.ApplicationGatewayHTTPListenerPropertiesFormat == nil || listener.FirewallPolicy == nil || listener.FirewallPolicy.ID == nil {
+		log.Printf("[DEBUG] Web Application Firewall Policy Association for %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("http_listener_id", id.ID())
+	d.Set("firewall_policy_id", listener.FirewallPolicy.ID)
+
+	return nil
+}
+
+func resourceWebApplicationFirewallPolicyHttpListenerAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayHTTPListenerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	listener := findApplicationGatewayHTTPListener(gateway, id.HttpListenerName)
+	if listener == nil || listener.ApplicationGatewayHTTPListenerPropertiesFormat == nil || listener.FirewallPolicy == nil {
+		return nil
+	}
+
+	listener.FirewallPolicy = nil
+
+	if err := updateApplicationGatewayForFirewallPolicyAssociation(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("removing Web Application Firewall Policy Association from %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func findApplicationGatewayHTTPListener(gateway network.ApplicationGateway, name string) *network.ApplicationGatewayHTTPListener {
+	if gateway.ApplicationGatewayPropertiesFormat == nil || gateway.HTTPListeners == nil {
+		return nil
+	}
+
+	for i, listener := range *gateway.HTTPListeners {
+		if listener.Name != nil && strings.EqualFold(*listener.Name, name) {
+			return &(*gateway.HTTPListeners)[i]
+		}
+	}
+
+	return nil
+}
+
+// updateApplicationGatewayForFirewallPolicyAssociation updates the Application Gateway using the (modified) existing definition,
+// so that the association resources don't need to be aware of the rest of the Application Gateway's configuration
+func updateApplicationGatewayForFirewallPolicyAssociation(ctx context.Context, client *network.ApplicationGatewaysClient, id parse.ApplicationGatewayId, gateway network.ApplicationGateway) error {
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, gateway)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceWebApplicationFirewallPolicyPathRuleAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebApplicationFirewallPolicyPathRuleAssociationCreateUpdate,
+		Read:   resourceWebApplicationFirewallPolicyPathRuleAssociationRead,
+		Update: resourceWebApplicationFirewallPolicyPathRuleAssociationCreateUpdate,
+		Delete: resourceWebApplicationFirewallPolicyPathRuleAssociationDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApplicationGatewayURLPathMapPathRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"path_rule_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApplicationGatewayURLPathMapPathRuleID,
+			},
+
+			"firewall_policy_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ApplicationGatewayWebApplicationFirewallPolicyID,
+			},
+		},
+	}
+}
+
+func resourceWebApplicationFirewallPolicyPathRuleAssociationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayURLPathMapPathRuleID(d.Get("path_rule_id").(string))
+	if err != nil {
+		return err
+	}
+
+	firewallPolicyId := d.Get("firewall_policy_id").(string)
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	rule := findApplicationGatewayURLPathMapPathRule(gateway, id.UrlPathMapName, id.PathRuleName)
+	if rule == nil || rule.ApplicationGatewayPathRulePropertiesFormat == nil {
+		return fmt.Errorf("%s was not found", *id)
+	}
+
+	if d.IsNewResource() && rule.FirewallPolicy != nil && rule.FirewallPolicy.ID != nil && *rule.FirewallPolicy.ID != "" {
+		return tf.ImportAsExistsError("azurerm_web_application_firewall_policy_path_rule_association", id.ID())
+	}
+
+	rule.FirewallPolicy = &network.SubResource{
+		ID: utils.String(firewallPolicyId),
+	}
+
+	if err := updateApplicationGatewayForFirewallPolicyAssociation(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("associating %s with %s: %+v", *id, firewallPolicyId, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceWebApplicationFirewallPolicyPathRuleAssociationRead(d, meta)
+}
+
+func resourceWebApplicationFirewallPolicyPathRuleAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayURLPathMapPathRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gateway, err := client.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			log.Printf("[DEBUG] Application Gateway for %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	rule := findApplicationGatewayURLPathMapPathRule(gateway, id.UrlPathMapName, id.PathRuleName)
+	if rule == nil || rule.ApplicationGatewayPathRulePropertiesFormat == nil || rule.FirewallPolicy == nil || rule.FirewallPolicy.ID == nil {
+		log.Printf("[DEBUG] Web Application Firewall Policy Association for %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path_rule_id", id.ID())
+	d.Set("firewall_policy_id", rule.FirewallPolicy.ID)
+
+	return nil
+}
+
+func resourceWebApplicationFirewallPolicyPathRuleAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ApplicationGatewaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApplicationGatewayURLPathMapPathRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	gatewayId := parse.NewApplicationGatewayID(id.SubscriptionId, id.ResourceGroup, id.ApplicationGatewayName)
+	locks.ByName(gatewayId.Name, applicationGatewayResourceName)
+	defer locks.UnlockByName(gatewayId.Name, applicationGatewayResourceName)
+
+	gateway, err := client.Get(ctx, gatewayId.ResourceGroup, gatewayId.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", gatewayId, err)
+	}
+
+	rule := findApplicationGatewayURLPathMapPathRule(gateway, id.UrlPathMapName, id.PathRuleName)
+	if rule == nil || rule.ApplicationGatewayPathRulePropertiesFormat == nil || rule.FirewallPolicy == nil {
+		return nil
+	}
+
+	rule.FirewallPolicy = nil
+
+	if err := updateApplicationGatewayForFirewallPolicyAssociation(ctx, client, gatewayId, gateway); err != nil {
+		return fmt.Errorf("removing Web Application Firewall Policy Association from %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func findApplicationGatewayURLPathMapPathRule(gateway network.ApplicationGateway, urlPathMapName, pathRuleName string) *network.ApplicationGatewayPathRule {
+	if gateway.ApplicationGatewayPropertiesFormat == nil || gateway.URLPathMaps == nil {
+		return nil
+	}
+
+	for _, pathMap := range *gateway.URLPathMaps {
+		if pathMap.Name == nil || !strings.EqualFold(*pathMap.Name, urlPathMapName) {
+			continue
+		}
+
+		if pathMap.ApplicationGatewayURLPathMapPropertiesFormat == nil || pathMap.PathRules == nil {
+			return nil
+		}
+
+		for i, rule := range *pathMap.PathRules {
+			if rule.Name != nil && strings.EqualFold(*rule.Name, pathRuleName) {
+				return &(*pathMap.PathRules)[i]
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebApplicationFirewallPolicyPathRuleAssociationResource struct{}
+
+func TestAccWebApplicationFirewallPolicyPathRuleAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy_path_rule_association", "test")
+	r := WebApplicationFirewallPolicyPathRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebApplicationFirewallPolicyPathRuleAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy_path_rule_association", "test")
+	r := WebApplicationFirewallPolicyPathRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccWebApplicationFirewallPolicyPathRuleAssociation_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy_path_rule_association", "test")
+	r := WebApplicationFirewallPolicyPathRuleAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (WebApplicationFirewallPolicyPathRuleAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ApplicationGatewayURLPathMapPathRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.ApplicationGatewaysClient.Get(ctx, id.ResourceGroup, id.ApplicationGatewayName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Application Gateway for %s: %+v", *id, err)
+	}
+
+	if props := resp.ApplicationGatewayPropertiesFormat; props != nil && props.URLPathMaps != nil {
+		for _, pathMap := range *props.URLPathMaps {
+			if pathMap.Name == nil || !strings.EqualFold(*pathMap.Name, id.UrlPathMapName) {
+				continue
+			}
+
+			if pathMapProps := pathMap.ApplicationGatewayURLPathMapPropertiesFormat; pathMapProps != nil && pathMapProps.PathRules != nil {
+				for _, rule := range *pathMapProps.PathRules {
+					if rule.Name == nil || !strings.EqualFold(*rule.Name, id.PathRuleName) {
+						continue
+					}
+
+					if ruleProps := rule.ApplicationGatewayPathRulePropertiesFormat; ruleProps != nil && ruleProps.FirewallPolicy != nil {
+						return utils.Bool(true), nil
+					}
+				}
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r WebApplicationFirewallPolicyPathRuleAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy_path_rule_association" "test" {
+  path_rule_id       = "${azurerm_application_gateway.test.id}/urlPathMaps/${local.url_path_map_name}/pathRules/${local.path_rule_name}"
+  firewall_policy_id = azurerm_web_application_firewall_policy.first.id
+}
+`, r.template(data))
+}
+
+func (r WebApplicationFirewallPolicyPathRuleAssociationResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy_path_rule_association" "test" {
+  path_rule_id       = "${azurerm_application_gateway.test.id}/urlPathMaps/${local.url_path_map_name}/pathRules/${local.path_rule_name}"
+  firewall_policy_id = azurerm_web_application_firewall_policy.second.id
+}
+`, r.template(data))
+}
+
+func (r WebApplicationFirewallPolicyPathRuleAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_application_firewall_policy_path_rule_association" "import" {
+  path_rule_id       = azurerm_web_application_firewall_policy_path_rule_association.test.path_rule_id
+  firewall_policy_id = azurerm_web_application_firewall_policy_path_rule_association.test.firewall_policy_id
+}
+`, r.basic(data))
+}
+
+func (WebApplicationFirewallPolicyPathRuleAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+locals {
+  backend_address_pool_name      = "${azurerm_virtual_network.test.name}-beap"
+  frontend_port_name             = "${azurerm_virtual_network.test.name}-feport"
+  frontend_ip_configuration_name = "${azurerm_virtual_network.test.name}-feip"
+  http_setting_name              = "${azurerm_virtual_network.test.name}-be-htst"
+  listener_name                  = "${azurerm_virtual_network.test.name}-httplstn"
+  request_routing_rule_name      = "${azurerm_virtual_network.test.name}-rqrt"
+  path_rule_name                 = "${azurerm_virtual_network.test.name}-pathrule1"
+  url_path_map_name              = "${azurerm_virtual_network.test.name}-urlpath1"
+}
+
+resource "azurerm_public_ip" "teststd" {
+  name                = "acctest-PubIpStd-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_web_application_firewall_policy" "gateway" {
+  name                = "acctest-fwp-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy_settings {
+    enabled = true
+    mode    = "Prevention"
+  }
+
+  managed_rules {
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.2"
+    }
+  }
+}
+
+resource "azurerm_web_application_firewall_policy" "first" {
+  name                = "acctest-fwp-first-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy_settings {
+    enabled = true
+    mode    = "Detection"
+  }
+
+  managed_rules {
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.2"
+    }
+  }
+}
+
+resource "azurerm_web_application_firewall_policy" "second" {
+  name                = "acctest-fwp-second-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  policy_settings {
+    enabled = true
+    mode    = "Prevention"
+  }
+
+  managed_rules {
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.2"
+    }
+  }
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestag-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "WAF_v2"
+    tier     = "WAF_v2"
+    capacity = 1
+  }
+
+  firewall_policy_id = azurerm_web_application_firewall_policy.gateway.id
+
+  gateway_ip_configuration {
+    name      = "my-gateway-ip-configuration"
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  frontend_port {
+    name = local.frontend_port_name
+    port = 80
+  }
+
+  frontend_ip_configuration {
+    name                 = local.frontend_ip_configuration_name
+    public_ip_address_id = azurerm_public_ip.teststd.id
+  }
+
+  backend_address_pool {
+    name = local.backend_address_pool_name
+  }
+
+  backend_http_settings {
+    name                  = local.http_setting_name
+    cookie_based_affinity = "Disabled"
+    port                  = 80
+    protocol              = "Http"
+    request_timeout       = 1
+  }
+
+  http_listener {
+    name                           = local.listener_name
+    frontend_ip_configuration_name = local.frontend_ip_configuration_name
+    frontend_port_name             = local.frontend_port_name
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name               = local.request_routing_rule_name
+    rule_type          = "PathBasedRouting"
+    url_path_map_name  = local.url_path_map_name
+    http_listener_name = local.listener_name
+  }
+
+  url_path_map {
+    name                               = local.url_path_map_name
+    default_backend_address_pool_name  = local.backend_address_pool_name
+    default_backend_http_settings_name = local.http_setting_name
+
+    path_rule {
+      name                       = local.path_rule_name
+      backend_address_pool_name  = local.backend_address_pool_name
+      backend_http_settings_name = local.http_setting_name
+      paths = [
+        "/test",
+      ]
+    }
+  }
+}
+`, ApplicationGatewayResource{}.template(data), data.RandomInteger)
+}
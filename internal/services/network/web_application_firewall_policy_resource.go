@@ -5,11 +5,12 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/sdk/2023-05-01/webapplicationfirewallpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -25,7 +26,7 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 		Update: resourceWebApplicationFirewallPolicyCreateUpdate,
 		Delete: resourceWebApplicationFirewallPolicyDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(id)
+			_, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID(id)
 			return err
 		}),
 
@@ -57,9 +58,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallActionAllow),
-								string(network.WebApplicationFirewallActionBlock),
-								string(network.WebApplicationFirewallActionLog),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallActionAllow),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallActionBlock),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallActionLog),
 							}, false),
 						},
 						"match_conditions": {
@@ -83,14 +84,14 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 													Type:     pluginsdk.TypeString,
 													Required: true,
 													ValidateFunc: validation.StringInSlice([]string{
-														string(network.WebApplicationFirewallMatchVariableRemoteAddr),
-														string(network.WebApplicationFirewallMatchVariableRequestMethod),
-														string(network.WebApplicationFirewallMatchVariableQueryString),
-														string(network.WebApplicationFirewallMatchVariablePostArgs),
-														string(network.WebApplicationFirewallMatchVariableRequestURI),
-														string(network.WebApplicationFirewallMatchVariableRequestHeaders),
-														string(network.WebApplicationFirewallMatchVariableRequestBody),
-														string(network.WebApplicationFirewallMatchVariableRequestCookies),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRemoteAddr),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestMethod),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableQueryString),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariablePostArgs),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestUri),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestHeaders),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestBody),
+														string(webapplicationfirewallpolicies.WebApplicationFirewallMatchVariableRequestCookies),
 													}, false),
 												},
 												"selector": {
@@ -104,17 +105,17 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Required: true,
 										ValidateFunc: validation.StringInSlice([]string{
-											string(network.WebApplicationFirewallOperatorIPMatch),
-											string(network.WebApplicationFirewallOperatorGeoMatch),
-											string(network.WebApplicationFirewallOperatorEqual),
-											string(network.WebApplicationFirewallOperatorContains),
-											string(network.WebApplicationFirewallOperatorLessThan),
-											string(network.WebApplicationFirewallOperatorGreaterThan),
-											string(network.WebApplicationFirewallOperatorLessThanOrEqual),
-											string(network.WebApplicationFirewallOperatorGreaterThanOrEqual),
-											string(network.WebApplicationFirewallOperatorBeginsWith),
-											string(network.WebApplicationFirewallOperatorEndsWith),
-											string(network.WebApplicationFirewallOperatorRegex),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorIPMatch),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorGeoMatch),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorEqual),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorContains),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorLessThan),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorGreaterThan),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorLessThanOrEqual),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorGreaterThanOrEqual),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorBeginsWith),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorEndsWith),
+											string(webapplicationfirewallpolicies.WebApplicationFirewallOperatorRegex),
 										}, false),
 									},
 									"negation_condition": {
@@ -127,12 +128,12 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Elem: &pluginsdk.Schema{
 											Type: pluginsdk.TypeString,
 											ValidateFunc: validation.StringInSlice([]string{
-												string(network.WebApplicationFirewallTransformHTMLEntityDecode),
-												string(network.WebApplicationFirewallTransformLowercase),
-												string(network.WebApplicationFirewallTransformRemoveNulls),
-												string(network.WebApplicationFirewallTransformTrim),
-												string(network.WebApplicationFirewallTransformURLDecode),
-												string(network.WebApplicationFirewallTransformURLEncode),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformHtmlEntityDecode),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformLowercase),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformRemoveNulls),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformTrim),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformUrlDecode),
+												string(webapplicationfirewallpolicies.WebApplicationFirewallTransformUrlEncode),
 											}, false),
 										},
 									},
@@ -147,8 +148,8 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallRuleTypeMatchRule),
-								string(network.WebApplicationFirewallRuleTypeInvalid),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallRuleTypeMatchRule),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallRuleTypeInvalid),
 							}, false),
 						},
 						"name": {
@@ -174,9 +175,9 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Required: true,
 										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestArgNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestCookieNames),
-											string(network.OwaspCrsExclusionEntryMatchVariableRequestHeaderNames),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntryMatchVariableRequestArgNames),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntryMatchVariableRequestCookieNames),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntryMatchVariableRequestHeaderNames),
 										}, false),
 									},
 									"selector": {
@@ -188,11 +189,11 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Required: true,
 										ValidateFunc: validation.StringInSlice([]string{
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
-											string(network.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorContains),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorEndsWith),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorEquals),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorEqualsAny),
+											string(webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperatorStartsWith),
 										}, false),
 									},
 								},
@@ -226,11 +227,39 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 												},
 												"disabled_rules": {
 													Type:     pluginsdk.TypeList,
-													Required: true,
+													Optional: true,
 													Elem: &pluginsdk.Schema{
 														Type: pluginsdk.TypeString,
 													},
 												},
+												"rule": {
+													Type:     pluginsdk.TypeList,
+													Optional: true,
+													Elem: &pluginsdk.Resource{
+														Schema: map[string]*pluginsdk.Schema{
+															"id": {
+																Type:         pluginsdk.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringIsNotEmpty,
+															},
+															"enabled": {
+																Type:     pluginsdk.TypeBool,
+																Optional: true,
+																Default:  false,
+															},
+															"action": {
+																Type:     pluginsdk.TypeString,
+																Optional: true,
+																ValidateFunc: validation.StringInSlice([]string{
+																	string(webapplicationfirewallpolicies.ActionTypeAllow),
+																	string(webapplicationfirewallpolicies.ActionTypeAnomalyScoring),
+																	string(webapplicationfirewallpolicies.ActionTypeBlock),
+																	string(webapplicationfirewallpolicies.ActionTypeLog),
+																}, false),
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -256,10 +285,10 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Optional: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								string(network.WebApplicationFirewallModePrevention),
-								string(network.WebApplicationFirewallModeDetection),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallModePrevention),
+								string(webapplicationfirewallpolicies.WebApplicationFirewallModeDetection),
 							}, false),
-							Default: string(network.WebApplicationFirewallModePrevention),
+							Default: string(webapplicationfirewallpolicies.WebApplicationFirewallModePrevention),
 						},
 						"request_body_check": {
 							Type:     pluginsdk.TypeBool,
@@ -300,22 +329,22 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 }
 
 func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
+	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesV20230501Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewApplicationGatewayWebApplicationFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	id := webapplicationfirewallpolicies.NewApplicationGatewayWebApplicationFirewallPolicyID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
 	if d.IsNewResource() {
-		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		resp, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(resp.Response) {
+			if !response.WasNotFound(resp.HttpResponse) {
 				return fmt.Errorf("checking for present of existing %s: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(resp.Response) {
-			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", *resp.ID)
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_web_application_firewall_policy", id.ID())
 		}
 	}
 
@@ -325,17 +354,17 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData,
 	managedRules := d.Get("managed_rules").([]interface{})
 	t := d.Get("tags").(map[string]interface{})
 
-	parameters := network.WebApplicationFirewallPolicy{
+	parameters := webapplicationfirewallpolicies.WebApplicationFirewallPolicy{
 		Location: utils.String(location),
-		WebApplicationFirewallPolicyPropertiesFormat: &network.WebApplicationFirewallPolicyPropertiesFormat{
+		Properties: &webapplicationfirewallpolicies.WebApplicationFirewallPolicyPropertiesFormat{
 			CustomRules:    expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(customRules),
 			PolicySettings: expandWebApplicationFirewallPolicyPolicySettings(policySettings),
 			ManagedRules:   expandWebApplicationFirewallPolicyManagedRulesDefinition(managedRules),
 		},
-		Tags: tags.Expand(t),
+		Tags: tagsHelper.Expand(t),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -345,18 +374,18 @@ func resourceWebApplicationFirewallPolicyCreateUpdate(d *pluginsdk.ResourceData,
 }
 
 func resourceWebApplicationFirewallPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
+	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesV20230501Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
+	id, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Web Application Firewall Policy %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -364,56 +393,57 @@ func resourceWebApplicationFirewallPolicyRead(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("reading %s: %+v", *id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	if location := resp.Location; location != nil {
-		d.Set("location", azure.NormalizeLocation(*location))
-	}
-	if webApplicationFirewallPolicyPropertiesFormat := resp.WebApplicationFirewallPolicyPropertiesFormat; webApplicationFirewallPolicyPropertiesFormat != nil {
-		if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(webApplicationFirewallPolicyPropertiesFormat.CustomRules)); err != nil {
-			return fmt.Errorf("setting `custom_rules`: %+v", err)
-		}
-		if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicyPolicySettings(webApplicationFirewallPolicyPropertiesFormat.PolicySettings)); err != nil {
-			return fmt.Errorf("setting `policy_settings`: %+v", err)
-		}
-		if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRulesDefinition(webApplicationFirewallPolicyPropertiesFormat.ManagedRules)); err != nil {
-			return fmt.Errorf("setting `managed_rules`: %+v", err)
-		}
-		if err := d.Set("http_listener_ids", flattenSubResourcesToIDs(webApplicationFirewallPolicyPropertiesFormat.HTTPListeners)); err != nil {
-			return fmt.Errorf("setting `http_listeners`: %+v", err)
+	d.Set("name", id.ApplicationGatewayWebApplicationFirewallPolicyName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if location := model.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
 		}
-		if err := d.Set("path_based_rule_ids", flattenSubResourcesToIDs(webApplicationFirewallPolicyPropertiesFormat.PathBasedRules)); err != nil {
-			return fmt.Errorf("setting `path_based_rules`: %+v", err)
+		if props := model.Properties; props != nil {
+			if err := d.Set("custom_rules", flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(props.CustomRules)); err != nil {
+				return fmt.Errorf("setting `custom_rules`: %+v", err)
+			}
+			if err := d.Set("policy_settings", flattenWebApplicationFirewallPolicyPolicySettings(props.PolicySettings)); err != nil {
+				return fmt.Errorf("setting `policy_settings`: %+v", err)
+			}
+			configuredRuleIds := webApplicationFirewallPolicyConfiguredOverrideRuleIds(d.Get("managed_rules").([]interface{}))
+			if err := d.Set("managed_rules", flattenWebApplicationFirewallPolicyManagedRulesDefinition(props.ManagedRules, configuredRuleIds)); err != nil {
+				return fmt.Errorf("setting `managed_rules`: %+v", err)
+			}
+			if err := d.Set("http_listener_ids", flattenWebApplicationFirewallPolicySubResourcesToIDs(props.HTTPListeners)); err != nil {
+				return fmt.Errorf("setting `http_listeners`: %+v", err)
+			}
+			if err := d.Set("path_based_rule_ids", flattenWebApplicationFirewallPolicySubResourcesToIDs(props.PathBasedRules)); err != nil {
+				return fmt.Errorf("setting `path_based_rules`: %+v", err)
+			}
 		}
+
+		return tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceWebApplicationFirewallPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesClient
+	client := meta.(*clients.Client).Network.WebApplicationFirewallPoliciesV20230501Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
+	id, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", *id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
-	}
-
 	return nil
 }
 
-func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []interface{}) *[]network.WebApplicationFirewallCustomRule {
-	results := make([]network.WebApplicationFirewallCustomRule, 0)
+func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []interface{}) *[]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule {
+	results := make([]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		name := v["name"].(string)
@@ -422,12 +452,12 @@ func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []
 		matchConditions := v["match_conditions"].([]interface{})
 		action := v["action"].(string)
 
-		result := network.WebApplicationFirewallCustomRule{
-			Action:          network.WebApplicationFirewallAction(action),
+		result := webapplicationfirewallpolicies.WebApplicationFirewallCustomRule{
+			Action:          webapplicationfirewallpolicies.WebApplicationFirewallAction(action),
 			MatchConditions: expandWebApplicationFirewallPolicyMatchCondition(matchConditions),
 			Name:            utils.String(name),
-			Priority:        utils.Int32(int32(priority)),
-			RuleType:        network.WebApplicationFirewallRuleType(ruleType),
+			Priority:        int64(priority),
+			RuleType:        webapplicationfirewallpolicies.WebApplicationFirewallRuleType(ruleType),
 		}
 
 		results = append(results, result)
@@ -435,48 +465,48 @@ func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyPolicySettings(input []interface{}) *network.PolicySettings {
+func expandWebApplicationFirewallPolicyPolicySettings(input []interface{}) *webapplicationfirewallpolicies.PolicySettings {
 	if len(input) == 0 {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
 
-	enabled := network.WebApplicationFirewallEnabledStateDisabled
+	enabled := webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateDisabled
 	if value, ok := v["enabled"].(bool); ok && value {
-		enabled = network.WebApplicationFirewallEnabledStateEnabled
+		enabled = webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateEnabled
 	}
-	mode := v["mode"].(string)
+	mode := webapplicationfirewallpolicies.WebApplicationFirewallMode(v["mode"].(string))
 	requestBodyCheck := v["request_body_check"].(bool)
 	maxRequestBodySizeInKb := v["max_request_body_size_in_kb"].(int)
 	fileUploadLimitInMb := v["file_upload_limit_in_mb"].(int)
 
-	result := network.PolicySettings{
-		State:                  enabled,
-		Mode:                   network.WebApplicationFirewallMode(mode),
+	result := webapplicationfirewallpolicies.PolicySettings{
+		State:                  &enabled,
+		Mode:                   &mode,
 		RequestBodyCheck:       utils.Bool(requestBodyCheck),
-		MaxRequestBodySizeInKb: utils.Int32(int32(maxRequestBodySizeInKb)),
-		FileUploadLimitInMb:    utils.Int32(int32(fileUploadLimitInMb)),
+		MaxRequestBodySizeInKb: utils.Int64(int64(maxRequestBodySizeInKb)),
+		FileUploadLimitInMb:    utils.Int64(int64(fileUploadLimitInMb)),
 	}
 	return &result
 }
 
-func expandWebApplicationFirewallPolicyManagedRulesDefinition(input []interface{}) *network.ManagedRulesDefinition {
+func expandWebApplicationFirewallPolicyManagedRulesDefinition(input []interface{}) webapplicationfirewallpolicies.ManagedRulesDefinition {
 	if len(input) == 0 {
-		return nil
+		return webapplicationfirewallpolicies.ManagedRulesDefinition{}
 	}
 	v := input[0].(map[string]interface{})
 
 	exclusions := v["exclusion"].([]interface{})
 	managedRuleSets := v["managed_rule_set"].([]interface{})
 
-	return &network.ManagedRulesDefinition{
+	return webapplicationfirewallpolicies.ManagedRulesDefinition{
 		Exclusions:      expandWebApplicationFirewallPolicyExclusions(exclusions),
 		ManagedRuleSets: expandWebApplicationFirewallPolicyManagedRuleSet(managedRuleSets),
 	}
 }
 
-func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]network.OwaspCrsExclusionEntry {
-	results := make([]network.OwaspCrsExclusionEntry, 0)
+func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]webapplicationfirewallpolicies.OwaspCrsExclusionEntry {
+	results := make([]webapplicationfirewallpolicies.OwaspCrsExclusionEntry, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
@@ -484,10 +514,10 @@ func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]networ
 		selectorMatchOperator := v["selector_match_operator"].(string)
 		selector := v["selector"].(string)
 
-		result := network.OwaspCrsExclusionEntry{
-			MatchVariable:         network.OwaspCrsExclusionEntryMatchVariable(matchVariable),
-			SelectorMatchOperator: network.OwaspCrsExclusionEntrySelectorMatchOperator(selectorMatchOperator),
-			Selector:              utils.String(selector),
+		result := webapplicationfirewallpolicies.OwaspCrsExclusionEntry{
+			MatchVariable:         webapplicationfirewallpolicies.OwaspCrsExclusionEntryMatchVariable(matchVariable),
+			SelectorMatchOperator: webapplicationfirewallpolicies.OwaspCrsExclusionEntrySelectorMatchOperator(selectorMatchOperator),
+			Selector:              selector,
 		}
 
 		results = append(results, result)
@@ -495,8 +525,8 @@ func expandWebApplicationFirewallPolicyExclusions(input []interface{}) *[]networ
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) *[]network.ManagedRuleSet {
-	results := make([]network.ManagedRuleSet, 0)
+func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) []webapplicationfirewallpolicies.ManagedRuleSet {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleSet, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
@@ -506,28 +536,29 @@ func expandWebApplicationFirewallPolicyManagedRuleSet(input []interface{}) *[]ne
 		if value, exists := v["rule_group_override"]; exists {
 			ruleGroupOverrides = value.([]interface{})
 		}
-		result := network.ManagedRuleSet{
-			RuleSetType:        utils.String(ruleSetType),
-			RuleSetVersion:     utils.String(ruleSetVersion),
+		result := webapplicationfirewallpolicies.ManagedRuleSet{
+			RuleSetType:        ruleSetType,
+			RuleSetVersion:     ruleSetVersion,
 			RuleGroupOverrides: expandWebApplicationFirewallPolicyRuleGroupOverrides(ruleGroupOverrides),
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *[]network.ManagedRuleGroupOverride {
-	results := make([]network.ManagedRuleGroupOverride, 0)
+func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleGroupOverride, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 
 		ruleGroupName := v["rule_group_name"].(string)
 		disabledRules := v["disabled_rules"].([]interface{})
+		rules := v["rule"].([]interface{})
 
-		result := network.ManagedRuleGroupOverride{
-			RuleGroupName: utils.String(ruleGroupName),
-			Rules:         expandWebApplicationFirewallPolicyRules(disabledRules),
+		result := webapplicationfirewallpolicies.ManagedRuleGroupOverride{
+			RuleGroupName: ruleGroupName,
+			Rules:         expandWebApplicationFirewallPolicyRules(disabledRules, rules),
 		}
 
 		results = append(results, result)
@@ -535,14 +566,34 @@ func expandWebApplicationFirewallPolicyRuleGroupOverrides(input []interface{}) *
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyRules(input []interface{}) *[]network.ManagedRuleOverride {
-	results := make([]network.ManagedRuleOverride, 0)
-	for _, item := range input {
-		ruleID := item.(string)
+func expandWebApplicationFirewallPolicyRules(disabledRules []interface{}, rules []interface{}) *[]webapplicationfirewallpolicies.ManagedRuleOverride {
+	results := make([]webapplicationfirewallpolicies.ManagedRuleOverride, 0)
+	for _, item := range disabledRules {
+		state := webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled
+		result := webapplicationfirewallpolicies.ManagedRuleOverride{
+			RuleId: item.(string),
+			State:  &state,
+		}
+
+		results = append(results, result)
+	}
+
+	for _, item := range rules {
+		v := item.(map[string]interface{})
+
+		state := webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled
+		if v["enabled"].(bool) {
+			state = webapplicationfirewallpolicies.ManagedRuleEnabledStateEnabled
+		}
 
-		result := network.ManagedRuleOverride{
-			RuleID: utils.String(ruleID),
-			State:  network.ManagedRuleEnabledStateDisabled,
+		result := webapplicationfirewallpolicies.ManagedRuleOverride{
+			RuleId: v["id"].(string),
+			State:  &state,
+		}
+
+		if action := v["action"].(string); action != "" {
+			actionType := webapplicationfirewallpolicies.ActionType(action)
+			result.Action = &actionType
 		}
 
 		results = append(results, result)
@@ -550,8 +601,8 @@ func expandWebApplicationFirewallPolicyRules(input []interface{}) *[]network.Man
 	return &results
 }
 
-func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) *[]network.MatchCondition {
-	results := make([]network.MatchCondition, 0)
+func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) []webapplicationfirewallpolicies.MatchCondition {
+	results := make([]webapplicationfirewallpolicies.MatchCondition, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		matchVariables := v["match_variables"].([]interface{})
@@ -560,41 +611,41 @@ func expandWebApplicationFirewallPolicyMatchCondition(input []interface{}) *[]ne
 		matchValues := v["match_values"].([]interface{})
 		transformsRaw := v["transforms"].(*pluginsdk.Set).List()
 
-		var transforms []network.WebApplicationFirewallTransform
+		var transforms []webapplicationfirewallpolicies.WebApplicationFirewallTransform
 		for _, trans := range transformsRaw {
-			transforms = append(transforms, network.WebApplicationFirewallTransform(trans.(string)))
+			transforms = append(transforms, webapplicationfirewallpolicies.WebApplicationFirewallTransform(trans.(string)))
 		}
-		result := network.MatchCondition{
-			MatchValues:      utils.ExpandStringSlice(matchValues),
+		result := webapplicationfirewallpolicies.MatchCondition{
+			MatchValues:      *utils.ExpandStringSlice(matchValues),
 			MatchVariables:   expandWebApplicationFirewallPolicyMatchVariable(matchVariables),
 			NegationConditon: utils.Bool(negationCondition),
-			Operator:         network.WebApplicationFirewallOperator(operator),
+			Operator:         webapplicationfirewallpolicies.WebApplicationFirewallOperator(operator),
 			Transforms:       &transforms,
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func expandWebApplicationFirewallPolicyMatchVariable(input []interface{}) *[]network.MatchVariable {
-	results := make([]network.MatchVariable, 0)
+func expandWebApplicationFirewallPolicyMatchVariable(input []interface{}) []webapplicationfirewallpolicies.MatchVariable {
+	results := make([]webapplicationfirewallpolicies.MatchVariable, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
 		variableName := v["variable_name"].(string)
 		selector := v["selector"].(string)
 
-		result := network.MatchVariable{
+		result := webapplicationfirewallpolicies.MatchVariable{
 			Selector:     utils.String(selector),
-			VariableName: network.WebApplicationFirewallMatchVariable(variableName),
+			VariableName: webapplicationfirewallpolicies.WebApplicationFirewallMatchVariable(variableName),
 		}
 
 		results = append(results, result)
 	}
-	return &results
+	return results
 }
 
-func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *[]network.WebApplicationFirewallCustomRule) []interface{} {
+func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *[]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -608,9 +659,7 @@ func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *
 		}
 		v["action"] = string(item.Action)
 		v["match_conditions"] = flattenWebApplicationFirewallPolicyMatchCondition(item.MatchConditions)
-		if priority := item.Priority; priority != nil {
-			v["priority"] = int(*priority)
-		}
+		v["priority"] = int(item.Priority)
 		v["rule_type"] = string(item.RuleType)
 
 		results = append(results, v)
@@ -619,39 +668,45 @@ func flattenWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input *
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyPolicySettings(input *network.PolicySettings) []interface{} {
+func flattenWebApplicationFirewallPolicyPolicySettings(input *webapplicationfirewallpolicies.PolicySettings) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
 
 	result := make(map[string]interface{})
 
-	result["enabled"] = input.State == network.WebApplicationFirewallEnabledStateEnabled
-	result["mode"] = string(input.Mode)
+	mode := ""
+	if input.Mode != nil {
+		mode = string(*input.Mode)
+	}
+	maxRequestBodySizeInKb := 0
+	if input.MaxRequestBodySizeInKb != nil {
+		maxRequestBodySizeInKb = int(*input.MaxRequestBodySizeInKb)
+	}
+	fileUploadLimitInMb := 0
+	if input.FileUploadLimitInMb != nil {
+		fileUploadLimitInMb = int(*input.FileUploadLimitInMb)
+	}
+
+	result["enabled"] = input.State != nil && *input.State == webapplicationfirewallpolicies.WebApplicationFirewallEnabledStateEnabled
+	result["mode"] = mode
 	result["request_body_check"] = input.RequestBodyCheck
-	result["max_request_body_size_in_kb"] = int(*input.MaxRequestBodySizeInKb)
-	result["file_upload_limit_in_mb"] = int(*input.FileUploadLimitInMb)
+	result["max_request_body_size_in_kb"] = maxRequestBodySizeInKb
+	result["file_upload_limit_in_mb"] = fileUploadLimitInMb
 
 	return []interface{}{result}
 }
 
-func flattenWebApplicationFirewallPolicyManagedRulesDefinition(input *network.ManagedRulesDefinition) []interface{} {
-	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
-
+func flattenWebApplicationFirewallPolicyManagedRulesDefinition(input webapplicationfirewallpolicies.ManagedRulesDefinition, configuredRuleIds map[string]map[string]bool) []interface{} {
 	v := make(map[string]interface{})
 
 	v["exclusion"] = flattenWebApplicationFirewallPolicyExclusions(input.Exclusions)
-	v["managed_rule_set"] = flattenWebApplicationFirewallPolicyManagedRuleSets(input.ManagedRuleSets)
-
-	results = append(results, v)
+	v["managed_rule_set"] = flattenWebApplicationFirewallPolicyManagedRuleSets(input.ManagedRuleSets, configuredRuleIds)
 
-	return results
+	return []interface{}{v}
 }
 
-func flattenWebApplicationFirewallPolicyExclusions(input *[]network.OwaspCrsExclusionEntry) []interface{} {
+func flattenWebApplicationFirewallPolicyExclusions(input *[]webapplicationfirewallpolicies.OwaspCrsExclusionEntry) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -660,12 +715,8 @@ func flattenWebApplicationFirewallPolicyExclusions(input *[]network.OwaspCrsExcl
 	for _, item := range *input {
 		v := make(map[string]interface{})
 
-		selector := item.Selector
-
 		v["match_variable"] = string(item.MatchVariable)
-		if selector != nil {
-			v["selector"] = *selector
-		}
+		v["selector"] = item.Selector
 		v["selector_match_operator"] = string(item.SelectorMatchOperator)
 
 		results = append(results, v)
@@ -673,25 +724,22 @@ func flattenWebApplicationFirewallPolicyExclusions(input *[]network.OwaspCrsExcl
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyManagedRuleSets(input *[]network.ManagedRuleSet) []interface{} {
+func flattenWebApplicationFirewallPolicyManagedRuleSets(input []webapplicationfirewallpolicies.ManagedRuleSet, configuredRuleIds map[string]map[string]bool) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
-	for _, item := range *input {
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		v["type"] = item.RuleSetType
 		v["version"] = item.RuleSetVersion
-		v["rule_group_override"] = flattenWebApplicationFirewallPolicyRuleGroupOverrides(item.RuleGroupOverrides)
+		v["rule_group_override"] = flattenWebApplicationFirewallPolicyRuleGroupOverrides(item.RuleGroupOverrides, configuredRuleIds)
 
 		results = append(results, v)
 	}
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]network.ManagedRuleGroupOverride) []interface{} {
+func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]webapplicationfirewallpolicies.ManagedRuleGroupOverride, configuredRuleIds map[string]map[string]bool) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -700,38 +748,77 @@ func flattenWebApplicationFirewallPolicyRuleGroupOverrides(input *[]network.Mana
 	for _, item := range *input {
 		v := make(map[string]interface{})
 
+		disabledRules, rules := flattenWebApplicationFirewallPolicyManagedRuleOverrides(item.Rules, configuredRuleIds[item.RuleGroupName])
+
 		v["rule_group_name"] = item.RuleGroupName
-		v["disabled_rules"] = flattenWebApplicationFirewallPolicyManagedRuleOverrides(item.Rules)
+		v["disabled_rules"] = disabledRules
+		v["rule"] = rules
 
 		results = append(results, v)
 	}
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyManagedRuleOverrides(input *[]network.ManagedRuleOverride) []string {
-	results := make([]string, 0)
+// flattenWebApplicationFirewallPolicyManagedRuleOverrides splits the rule overrides into `disabled_rules` and `rule` - a rule which
+// is only disabled is returned within `disabled_rules` unless it's been configured using a `rule` block.
+func flattenWebApplicationFirewallPolicyManagedRuleOverrides(input *[]webapplicationfirewallpolicies.ManagedRuleOverride, configuredRuleIds map[string]bool) ([]string, []interface{}) {
+	disabledRules := make([]string, 0)
+	rules := make([]interface{}, 0)
 	if input == nil {
-		return results
+		return disabledRules, rules
 	}
 
 	for _, item := range *input {
-		if item.State == "" || item.State == network.ManagedRuleEnabledStateDisabled {
-			v := *item.RuleID
+		disabled := item.State == nil || *item.State == webapplicationfirewallpolicies.ManagedRuleEnabledStateDisabled
+
+		action := ""
+		if item.Action != nil {
+			action = string(*item.Action)
+		}
 
-			results = append(results, v)
+		if disabled && action == "" && !configuredRuleIds[item.RuleId] {
+			disabledRules = append(disabledRules, item.RuleId)
+			continue
 		}
+
+		rules = append(rules, map[string]interface{}{
+			"id":      item.RuleId,
+			"enabled": !disabled,
+			"action":  action,
+		})
 	}
 
-	return results
+	return disabledRules, rules
 }
 
-func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCondition) []interface{} {
-	results := make([]interface{}, 0)
-	if input == nil {
+// webApplicationFirewallPolicyConfiguredOverrideRuleIds returns the ID's of the rules configured using a `rule` block, keyed by rule group name
+func webApplicationFirewallPolicyConfiguredOverrideRuleIds(input []interface{}) map[string]map[string]bool {
+	results := make(map[string]map[string]bool)
+	if len(input) == 0 || input[0] == nil {
 		return results
 	}
 
-	for _, item := range *input {
+	for _, ruleSet := range input[0].(map[string]interface{})["managed_rule_set"].([]interface{}) {
+		for _, item := range ruleSet.(map[string]interface{})["rule_group_override"].([]interface{}) {
+			override := item.(map[string]interface{})
+			groupName := override["rule_group_name"].(string)
+			if _, ok := results[groupName]; !ok {
+				results[groupName] = make(map[string]bool)
+			}
+
+			for _, rule := range override["rule"].([]interface{}) {
+				results[groupName][rule.(map[string]interface{})["id"].(string)] = true
+			}
+		}
+	}
+
+	return results
+}
+
+func flattenWebApplicationFirewallPolicyMatchCondition(input []webapplicationfirewallpolicies.MatchCondition) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		var transforms []interface{}
@@ -740,7 +827,7 @@ func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCon
 				transforms = append(transforms, string(trans))
 			}
 		}
-		v["match_values"] = utils.FlattenStringSlice(item.MatchValues)
+		v["match_values"] = utils.FlattenStringSlice(&item.MatchValues)
 		v["match_variables"] = flattenWebApplicationFirewallPolicyMatchVariable(item.MatchVariables)
 		if negationCondition := item.NegationConditon; negationCondition != nil {
 			v["negation_condition"] = *negationCondition
@@ -754,13 +841,10 @@ func flattenWebApplicationFirewallPolicyMatchCondition(input *[]network.MatchCon
 	return results
 }
 
-func flattenWebApplicationFirewallPolicyMatchVariable(input *[]network.MatchVariable) []interface{} {
+func flattenWebApplicationFirewallPolicyMatchVariable(input []webapplicationfirewallpolicies.MatchVariable) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
-	for _, item := range *input {
+	for _, item := range input {
 		v := make(map[string]interface{})
 
 		if selector := item.Selector; selector != nil {
@@ -773,3 +857,20 @@ func flattenWebApplicationFirewallPolicyMatchVariable(input *[]network.MatchVari
 
 	return results
 }
+
+func flattenWebApplicationFirewallPolicySubResourcesToIDs(input *[]webapplicationfirewallpolicies.SubResource) []interface{} {
+	ids := make([]interface{}, 0)
+	if input == nil {
+		return ids
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+
+		ids = append(ids, *v.Id)
+	}
+
+	return ids
+}
@@ -173,6 +173,32 @@ func TestAccWebApplicationFirewallPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccWebApplicationFirewallPolicy_botManagerRuleOverrides(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy", "test")
+	r := WebApplicationFirewallResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.botManagerRuleOverrides(data, "Log"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_rules.0.managed_rule_set.1.type").HasValue("Microsoft_BotManagerRuleSet"),
+				check.That(data.ResourceName).Key("managed_rules.0.managed_rule_set.1.version").HasValue("1.1"),
+				check.That(data.ResourceName).Key("managed_rules.0.managed_rule_set.1.rule_group_override.0.rule.0.action").HasValue("Log"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.botManagerRuleOverrides(data, "Block"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_rules.0.managed_rule_set.1.rule_group_override.0.rule.0.action").HasValue("Block"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t WebApplicationFirewallResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ApplicationGatewayWebApplicationFirewallPolicyID(state.ID)
 	if err != nil {
@@ -321,3 +347,60 @@ resource "azurerm_web_application_firewall_policy" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (WebApplicationFirewallResource) botManagerRuleOverrides(data acceptance.TestData, action string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  managed_rules {
+    managed_rule_set {
+      type    = "OWASP"
+      version = "3.2"
+
+      rule_group_override {
+        rule_group_name = "REQUEST-920-PROTOCOL-ENFORCEMENT"
+        disabled_rules  = ["920300"]
+
+        rule {
+          id      = "920440"
+          enabled = true
+          action  = "Log"
+        }
+      }
+    }
+
+    managed_rule_set {
+      type    = "Microsoft_BotManagerRuleSet"
+      version = "1.1"
+
+      rule_group_override {
+        rule_group_name = "BadBots"
+
+        rule {
+          id      = "100100"
+          enabled = true
+          action  = "%s"
+        }
+      }
+    }
+  }
+
+  policy_settings {
+    enabled = true
+    mode    = "Prevention"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, action)
+}
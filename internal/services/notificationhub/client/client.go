@@ -3,22 +3,28 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	notificationHubsV20230901 "github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/sdk/2023-09-01/notificationhubs"
 )
 
 type Client struct {
-	HubsClient       *notificationhubs.Client
-	NamespacesClient *notificationhubs.NamespacesClient
+	HubsClient          *notificationhubs.Client
+	HubsV20230901Client *notificationHubsV20230901.NotificationHubsClient
+	NamespacesClient    *notificationhubs.NamespacesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	hubsClient := notificationhubs.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&hubsClient.Client, o.ResourceManagerAuthorizer)
 
+	hubsV20230901Client := notificationHubsV20230901.NewNotificationHubsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&hubsV20230901Client.Client, o.ResourceManagerAuthorizer)
+
 	namespacesClient := notificationhubs.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&namespacesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		HubsClient:       &hubsClient,
-		NamespacesClient: &namespacesClient,
+		HubsClient:          &hubsClient,
+		HubsV20230901Client: &hubsV20230901Client,
+		NamespacesClient:    &namespacesClient,
 	}
 }
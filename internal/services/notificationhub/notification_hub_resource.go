@@ -7,15 +7,15 @@ import (
 	"strconv"
 	"time"
 
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
-
-	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
-
-	"github.com/Azure/azure-sdk-for-go/services/notificationhubs/mgmt/2017-04-01/notificationhubs"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/sdk/2023-09-01/notificationhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -67,6 +67,20 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				diff.ForceNew("apns_credential")
 			}
 
+			oBrowser, nBrowser := diff.GetChange("browser_credential.#")
+			oBrowseri := oBrowser.(int)
+			nBrowseri := nBrowser.(int)
+			if nBrowseri < oBrowseri {
+				diff.ForceNew("browser_credential")
+			}
+
+			oFCMV1, nFCMV1 := diff.GetChange("fcm_v1_credential.#")
+			oFCMV1i := oFCMV1.(int)
+			nFCMV1i := nFCMV1.(int)
+			if nFCMV1i < oFCMV1i {
+				diff.ForceNew("fcm_v1_credential")
+			}
+
 			oGCM, nGCM := diff.GetChange("gcm_credential.#")
 			oGCMi := oGCM.(int)
 			nGCMi := nGCM.(int)
@@ -133,6 +147,60 @@ func resourceNotificationHub() *pluginsdk.Resource {
 				},
 			},
 
+			"browser_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						// the Subject is the contact for the push service, either a `mailto:` or `https://` URI
+						"subject": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"vapid_private_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"vapid_public_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			// NOTE: these values are taken from the Service Account JSON file for the Firebase project
+			"fcm_v1_credential": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"client_email": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"private_key": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"project_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"gcm_credential": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -154,35 +222,37 @@ func resourceNotificationHub() *pluginsdk.Resource {
 }
 
 func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs.HubsV20230901Client
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id := parse.NewNotificationHubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("name").(string))
+	id := notificationhubs.NewNotificationHubID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+		existing, err := client.Get(ctx, id)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_notification_hub", id.ID())
 		}
 	}
 
-	parameters := notificationhubs.CreateOrUpdateParameters{
-		Location: utils.String(location.Normalize(d.Get("location").(string))),
-		Properties: &notificationhubs.Properties{
-			ApnsCredential: expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
-			GcmCredential:  expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
+	parameters := notificationhubs.NotificationHubResource{
+		Location: location.Normalize(d.Get("location").(string)),
+		Properties: &notificationhubs.NotificationHubProperties{
+			ApnsCredential:    expandNotificationHubsAPNSCredentials(d.Get("apns_credential").([]interface{})),
+			BrowserCredential: expandNotificationHubsBrowserCredentials(d.Get("browser_credential").([]interface{})),
+			FcmV1Credential:   expandNotificationHubsFCMV1Credentials(d.Get("fcm_v1_credential").([]interface{})),
+			GcmCredential:     expandNotificationHubsGCMCredentials(d.Get("gcm_credential").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.NamespaceName, id.Name, parameters); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
@@ -208,34 +278,34 @@ func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	return resourceNotificationHubRead(d, meta)
 }
 
-func notificationHubStateRefreshFunc(ctx context.Context, client *notificationhubs.Client, id parse.NotificationHubId) pluginsdk.StateRefreshFunc {
+func notificationHubStateRefreshFunc(ctx context.Context, client *notificationhubs.NotificationHubsClient, id notificationhubs.NotificationHubId) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+		res, err := client.Get(ctx, id)
 		if err != nil {
-			if utils.ResponseWasNotFound(res.Response) {
+			if response.WasNotFound(res.HttpResponse) {
 				return nil, "404", nil
 			}
 
 			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
 		}
 
-		return res, strconv.Itoa(res.StatusCode), nil
+		return res, strconv.Itoa(res.HttpResponse.StatusCode), nil
 	}
 }
 
 func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs.HubsV20230901Client
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.NotificationHubID(d.Id())
+	id, err := notificationhubs.ParseNotificationHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state", *id)
 			d.SetId("")
 			return nil
@@ -244,44 +314,63 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	credentials, err := client.GetPnsCredentials(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	credentials, err := client.GetPnsCredentials(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving credentials for %s: %+v", *id, err)
 	}
 
-	d.Set("name", resp.Name)
+	d.Set("name", id.NotificationHubName)
 	d.Set("namespace_name", id.NamespaceName)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 
-	if props := credentials.PnsCredentialsProperties; props != nil {
-		apns := flattenNotificationHubsAPNSCredentials(props.ApnsCredential)
-		if setErr := d.Set("apns_credential", apns); setErr != nil {
-			return fmt.Errorf("setting `apns_credential`: %+v", setErr)
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
 		}
+	}
+
+	if model := credentials.Model; model != nil {
+		if props := model.Properties; props != nil {
+			apns := flattenNotificationHubsAPNSCredentials(props.ApnsCredential)
+			if setErr := d.Set("apns_credential", apns); setErr != nil {
+				return fmt.Errorf("setting `apns_credential`: %+v", setErr)
+			}
 
-		gcm := flattenNotificationHubsGCMCredentials(props.GcmCredential)
-		if setErr := d.Set("gcm_credential", gcm); setErr != nil {
-			return fmt.Errorf("setting `gcm_credential`: %+v", setErr)
+			browser := flattenNotificationHubsBrowserCredentials(props.BrowserCredential)
+			if setErr := d.Set("browser_credential", browser); setErr != nil {
+				return fmt.Errorf("setting `browser_credential`: %+v", setErr)
+			}
+
+			fcmV1 := flattenNotificationHubsFCMV1Credentials(props.FcmV1Credential)
+			if setErr := d.Set("fcm_v1_credential", fcmV1); setErr != nil {
+				return fmt.Errorf("setting `fcm_v1_credential`: %+v", setErr)
+			}
+
+			gcm := flattenNotificationHubsGCMCredentials(props.GcmCredential)
+			if setErr := d.Set("gcm_credential", gcm); setErr != nil {
+				return fmt.Errorf("setting `gcm_credential`: %+v", setErr)
+			}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceNotificationHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).NotificationHubs.HubsClient
+	client := meta.(*clients.Client).NotificationHubs.HubsV20230901Client
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.NotificationHubID(d.Id())
+	id, err := notificationhubs.ParseNotificationHubID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.NamespaceName, id.Name)
+	resp, err := client.Delete(ctx, *id)
 	if err != nil {
-		if !utils.ResponseWasNotFound(resp) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting %s: %+v", *id, err)
 		}
 	}
@@ -308,11 +397,11 @@ func expandNotificationHubsAPNSCredentials(inputs []interface{}) *notificationhu
 	endpoint := applicationEndpoints[applicationMode]
 
 	credentials := notificationhubs.ApnsCredential{
-		ApnsCredentialProperties: &notificationhubs.ApnsCredentialProperties{
-			AppID:    utils.String(teamId),
+		Properties: notificationhubs.ApnsCredentialProperties{
+			AppId:    utils.String(teamId),
 			AppName:  utils.String(bundleId),
-			Endpoint: utils.String(endpoint),
-			KeyID:    utils.String(keyId),
+			Endpoint: endpoint,
+			KeyId:    utils.String(keyId),
 			Token:    utils.String(token),
 		},
 	}
@@ -325,35 +414,93 @@ func flattenNotificationHubsAPNSCredentials(input *notificationhubs.ApnsCredenti
 	}
 
 	output := make(map[string]interface{})
+	props := input.Properties
 
-	if bundleId := input.AppName; bundleId != nil {
+	if bundleId := props.AppName; bundleId != nil {
 		output["bundle_id"] = *bundleId
 	}
 
-	if endpoint := input.Endpoint; endpoint != nil {
-		applicationEndpoints := map[string]string{
-			apnsProductionEndpoint: apnsProductionName,
-			apnsSandboxEndpoint:    apnsSandboxName,
-		}
-		applicationMode := applicationEndpoints[*endpoint]
-		output["application_mode"] = applicationMode
+	applicationEndpoints := map[string]string{
+		apnsProductionEndpoint: apnsProductionName,
+		apnsSandboxEndpoint:    apnsSandboxName,
 	}
+	output["application_mode"] = applicationEndpoints[props.Endpoint]
 
-	if keyId := input.KeyID; keyId != nil {
+	if keyId := props.KeyId; keyId != nil {
 		output["key_id"] = *keyId
 	}
 
-	if teamId := input.AppID; teamId != nil {
+	if teamId := props.AppId; teamId != nil {
 		output["team_id"] = *teamId
 	}
 
-	if token := input.Token; token != nil {
+	if token := props.Token; token != nil {
 		output["token"] = *token
 	}
 
 	return []interface{}{output}
 }
 
+func expandNotificationHubsBrowserCredentials(inputs []interface{}) *notificationhubs.BrowserCredential {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	credentials := notificationhubs.BrowserCredential{
+		Properties: notificationhubs.BrowserCredentialProperties{
+			Subject:         input["subject"].(string),
+			VapidPrivateKey: input["vapid_private_key"].(string),
+			VapidPublicKey:  input["vapid_public_key"].(string),
+		},
+	}
+	return &credentials
+}
+
+func flattenNotificationHubsBrowserCredentials(input *notificationhubs.BrowserCredential) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"subject":           input.Properties.Subject,
+		"vapid_private_key": input.Properties.VapidPrivateKey,
+		"vapid_public_key":  input.Properties.VapidPublicKey,
+	}
+
+	return []interface{}{output}
+}
+
+func expandNotificationHubsFCMV1Credentials(inputs []interface{}) *notificationhubs.FcmV1Credential {
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	input := inputs[0].(map[string]interface{})
+	credentials := notificationhubs.FcmV1Credential{
+		Properties: notificationhubs.FcmV1CredentialProperties{
+			ClientEmail: input["client_email"].(string),
+			PrivateKey:  input["private_key"].(string),
+			ProjectId:   input["project_id"].(string),
+		},
+	}
+	return &credentials
+}
+
+func flattenNotificationHubsFCMV1Credentials(input *notificationhubs.FcmV1Credential) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{
+		"client_email": input.Properties.ClientEmail,
+		"private_key":  input.Properties.PrivateKey,
+		"project_id":   input.Properties.ProjectId,
+	}
+
+	return []interface{}{output}
+}
+
 func expandNotificationHubsGCMCredentials(inputs []interface{}) *notificationhubs.GcmCredential {
 	if len(inputs) == 0 {
 		return nil
@@ -362,8 +509,8 @@ func expandNotificationHubsGCMCredentials(inputs []interface{}) *notificationhub
 	input := inputs[0].(map[string]interface{})
 	apiKey := input["api_key"].(string)
 	credentials := notificationhubs.GcmCredential{
-		GcmCredentialProperties: &notificationhubs.GcmCredentialProperties{
-			GoogleAPIKey: utils.String(apiKey),
+		Properties: notificationhubs.GcmCredentialProperties{
+			GoogleApiKey: apiKey,
 		},
 	}
 	return &credentials
@@ -374,11 +521,8 @@ func flattenNotificationHubsGCMCredentials(input *notificationhubs.GcmCredential
 		return []interface{}{}
 	}
 
-	output := make(map[string]interface{})
-	if props := input.GcmCredentialProperties; props != nil {
-		if apiKey := props.GoogleAPIKey; apiKey != nil {
-			output["api_key"] = *apiKey
-		}
+	output := map[string]interface{}{
+		"api_key": input.Properties.GoogleApiKey,
 	}
 
 	return []interface{}{output}
@@ -3,6 +3,7 @@ package notificationhub_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -79,6 +80,60 @@ func TestAccNotificationHub_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccNotificationHub_browserCredential(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_notification_hub", "test")
+	r := NotificationHubResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("browser_credential.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.browserCredential(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("browser_credential.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("browser_credential.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNotificationHub_fcmV1Credential(t *testing.T) {
+	// the FCM v1 credentials are validated against Firebase, so these need to come from a real Service Account
+	clientEmail := os.Getenv("ARM_TEST_NOTIFICATION_HUB_FCM_V1_CLIENT_EMAIL")
+	privateKey := os.Getenv("ARM_TEST_NOTIFICATION_HUB_FCM_V1_PRIVATE_KEY")
+	projectId := os.Getenv("ARM_TEST_NOTIFICATION_HUB_FCM_V1_PROJECT_ID")
+	if clientEmail == "" || privateKey == "" || projectId == "" {
+		t.Skip("Skipping as ARM_TEST_NOTIFICATION_HUB_FCM_V1_CLIENT_EMAIL, ARM_TEST_NOTIFICATION_HUB_FCM_V1_PRIVATE_KEY and ARM_TEST_NOTIFICATION_HUB_FCM_V1_PROJECT_ID are not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_notification_hub", "test")
+	r := NotificationHubResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fcmV1Credential(data, clientEmail, privateKey, projectId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fcm_v1_credential.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (NotificationHubResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NotificationHubID(state.ID)
 	if err != nil {
@@ -166,3 +221,66 @@ resource "azurerm_notification_hub" "import" {
 }
 `, template)
 }
+
+func (r NotificationHubResource) browserCredential(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_notification_hub" "test" {
+  name                = "acctestnh-%d"
+  namespace_name      = azurerm_notification_hub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  browser_credential {
+    subject           = "mailto:acctest@example.com"
+    vapid_private_key = "x2cj8g8RBpASJOemszJ0pGHbXm6wF_y7d9OiT-PNvzg"
+    vapid_public_key  = "BOw6oXUmDh6dfUCZ-Jq4bPBvT_cpxc9U_u93wVSmEGyu3stbqO8DoGskCX3mIqxk9uxZF_ftA42e1tX4Uh7Ew8s"
+  }
+
+  tags = {
+    env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NotificationHubResource) fcmV1Credential(data acceptance.TestData, clientEmail, privateKey, projectId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_notification_hub" "test" {
+  name                = "acctestnh-%d"
+  namespace_name      = azurerm_notification_hub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  fcm_v1_credential {
+    client_email = %q
+    private_key  = %q
+    project_id   = %q
+  }
+}
+`, r.template(data), data.RandomInteger, clientEmail, privateKey, projectId)
+}
+
+func (NotificationHubResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRGpol-%d"
+  location = "%s"
+}
+
+resource "azurerm_notification_hub_namespace" "test" {
+  name                = "acctestnhn-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  namespace_type      = "NotificationHub"
+  sku_name            = "Free"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,15 @@
+package notificationhubs
+
+import "github.com/Azure/go-autorest/autorest"
+
+type NotificationHubsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewNotificationHubsClientWithBaseURI(endpoint string) NotificationHubsClient {
+	return NotificationHubsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
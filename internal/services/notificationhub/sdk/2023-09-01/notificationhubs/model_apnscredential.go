@@ -0,0 +1,5 @@
+package notificationhubs
+
+type ApnsCredential struct {
+	Properties ApnsCredentialProperties `json:"properties"`
+}
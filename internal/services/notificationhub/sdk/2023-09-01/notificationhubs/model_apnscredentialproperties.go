@@ -0,0 +1,12 @@
+package notificationhubs
+
+type ApnsCredentialProperties struct {
+	ApnsCertificate *string `json:"apnsCertificate,omitempty"`
+	AppId           *string `json:"appId,omitempty"`
+	AppName         *string `json:"appName,omitempty"`
+	CertificateKey  *string `json:"certificateKey,omitempty"`
+	Endpoint        string  `json:"endpoint"`
+	KeyId           *string `json:"keyId,omitempty"`
+	Thumbprint      *string `json:"thumbprint,omitempty"`
+	Token           *string `json:"token,omitempty"`
+}
@@ -0,0 +1,5 @@
+package notificationhubs
+
+type BrowserCredential struct {
+	Properties BrowserCredentialProperties `json:"properties"`
+}
@@ -0,0 +1,7 @@
+package notificationhubs
+
+type BrowserCredentialProperties struct {
+	Subject         string `json:"subject"`
+	VapidPrivateKey string `json:"vapidPrivateKey"`
+	VapidPublicKey  string `json:"vapidPublicKey"`
+}
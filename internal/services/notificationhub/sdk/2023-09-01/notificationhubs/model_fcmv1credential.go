@@ -0,0 +1,5 @@
+package notificationhubs
+
+type FcmV1Credential struct {
+	Properties FcmV1CredentialProperties `json:"properties"`
+}
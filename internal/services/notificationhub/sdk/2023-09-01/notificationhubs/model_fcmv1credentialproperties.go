@@ -0,0 +1,7 @@
+package notificationhubs
+
+type FcmV1CredentialProperties struct {
+	ClientEmail string `json:"clientEmail"`
+	PrivateKey  string `json:"privateKey"`
+	ProjectId   string `json:"projectId"`
+}
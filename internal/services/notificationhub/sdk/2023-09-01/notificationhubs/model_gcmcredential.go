@@ -0,0 +1,5 @@
+package notificationhubs
+
+type GcmCredential struct {
+	Properties GcmCredentialProperties `json:"properties"`
+}
@@ -0,0 +1,6 @@
+package notificationhubs
+
+type GcmCredentialProperties struct {
+	GcmEndpoint  *string `json:"gcmEndpoint,omitempty"`
+	GoogleApiKey string  `json:"googleApiKey"`
+}
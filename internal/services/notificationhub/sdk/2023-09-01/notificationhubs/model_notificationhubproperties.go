@@ -0,0 +1,10 @@
+package notificationhubs
+
+type NotificationHubProperties struct {
+	ApnsCredential    *ApnsCredential    `json:"apnsCredential,omitempty"`
+	BrowserCredential *BrowserCredential `json:"browserCredential,omitempty"`
+	FcmV1Credential   *FcmV1Credential   `json:"fcmV1Credential,omitempty"`
+	GcmCredential     *GcmCredential     `json:"gcmCredential,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	RegistrationTtl   *string            `json:"registrationTtl,omitempty"`
+}
@@ -0,0 +1,8 @@
+package notificationhubs
+
+type PnsCredentials struct {
+	ApnsCredential    *ApnsCredential    `json:"apnsCredential,omitempty"`
+	BrowserCredential *BrowserCredential `json:"browserCredential,omitempty"`
+	FcmV1Credential   *FcmV1Credential   `json:"fcmV1Credential,omitempty"`
+	GcmCredential     *GcmCredential     `json:"gcmCredential,omitempty"`
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contact"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contactprofile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/spacecraft"
+)
+
+type Client struct {
+	ContactClient        *contact.ContactClient
+	ContactProfileClient *contactprofile.ContactProfileClient
+	SpacecraftClient     *spacecraft.SpacecraftClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	contactClient := contact.NewContactClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&contactClient.Client, o.ResourceManagerAuthorizer)
+
+	contactProfileClient := contactprofile.NewContactProfileClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&contactProfileClient.Client, o.ResourceManagerAuthorizer)
+
+	spacecraftClient := spacecraft.NewSpacecraftClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&spacecraftClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ContactClient:        &contactClient,
+		ContactProfileClient: &contactProfileClient,
+		SpacecraftClient:     &spacecraftClient,
+	}
+}
@@ -0,0 +1,231 @@
+package orbital
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contactprofile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/spacecraft"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AvailableContactsDataSource struct{}
+
+var _ sdk.DataSource = AvailableContactsDataSource{}
+
+type AvailableContactsDataSourceModel struct {
+	SpacecraftId      string                   `tfschema:"spacecraft_id"`
+	ContactProfileId  string                   `tfschema:"contact_profile_id"`
+	GroundStationName string                   `tfschema:"ground_station_name"`
+	StartTime         string                   `tfschema:"start_time"`
+	EndTime           string                   `tfschema:"end_time"`
+	AvailableContacts []AvailableContactsModel `tfschema:"available_contacts"`
+}
+
+type AvailableContactsModel struct {
+	SpacecraftName          string  `tfschema:"spacecraft_name"`
+	GroundStationName       string  `tfschema:"ground_station_name"`
+	MaximumElevationDegrees float64 `tfschema:"maximum_elevation_degrees"`
+	TxStartTime             string  `tfschema:"tx_start_time"`
+	TxEndTime               string  `tfschema:"tx_end_time"`
+	RxStartTime             string  `tfschema:"rx_start_time"`
+	RxEndTime               string  `tfschema:"rx_end_time"`
+	StartAzimuthDegrees     float64 `tfschema:"start_azimuth_degrees"`
+	EndAzimuthDegrees       float64 `tfschema:"end_azimuth_degrees"`
+	StartElevationDegrees   float64 `tfschema:"start_elevation_degrees"`
+	EndElevationDegrees     float64 `tfschema:"end_elevation_degrees"`
+}
+
+func (d AvailableContactsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"spacecraft_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: spacecraft.ValidateSpacecraftID,
+		},
+
+		"contact_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: contactprofile.ValidateContactProfileID,
+		},
+
+		"ground_station_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"start_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"end_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+	}
+}
+
+func (d AvailableContactsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"available_contacts": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"spacecraft_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ground_station_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"maximum_elevation_degrees": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"tx_start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tx_end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"rx_start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"rx_end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_azimuth_degrees": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"end_azimuth_degrees": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"start_elevation_degrees": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"end_elevation_degrees": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d AvailableContactsDataSource) ModelObject() interface{} {
+	return &AvailableContactsDataSourceModel{}
+}
+
+func (d AvailableContactsDataSource) ResourceType() string {
+	return "azurerm_orbital_available_contacts"
+}
+
+func (d AvailableContactsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.SpacecraftClient
+
+			var model AvailableContactsDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			spacecraftId, err := spacecraft.ParseSpacecraftID(model.SpacecraftId)
+			if err != nil {
+				return err
+			}
+
+			payload := spacecraft.ContactParameters{
+				ContactProfile: spacecraft.ContactParametersContactProfile{
+					Id: model.ContactProfileId,
+				},
+				EndTime:           model.EndTime,
+				GroundStationName: model.GroundStationName,
+				StartTime:         model.StartTime,
+			}
+
+			resp, err := client.SpacecraftsListAvailableContacts(ctx, *spacecraftId, payload)
+			if err != nil {
+				return fmt.Errorf("listing available contacts for %s: %+v", *spacecraftId, err)
+			}
+
+			model.AvailableContacts = make([]AvailableContactsModel, 0)
+			if resp.Model != nil && resp.Model.Value != nil {
+				model.AvailableContacts = flattenAvailableContacts(*resp.Model.Value)
+			}
+
+			metadata.SetID(spacecraftId)
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func flattenAvailableContacts(input []spacecraft.AvailableContacts) []AvailableContactsModel {
+	output := make([]AvailableContactsModel, 0)
+	for _, v := range input {
+		item := AvailableContactsModel{
+			GroundStationName: utils.NormalizeNilableString(v.GroundStationName),
+		}
+
+		if v.Spacecraft != nil {
+			if id, err := spacecraft.ParseSpacecraftIDInsensitively(v.Spacecraft.Id); err == nil {
+				item.SpacecraftName = id.SpacecraftName
+			}
+		}
+
+		if props := v.Properties; props != nil {
+			item.TxStartTime = utils.NormalizeNilableString(props.TxStartTime)
+			item.TxEndTime = utils.NormalizeNilableString(props.TxEndTime)
+			item.RxStartTime = utils.NormalizeNilableString(props.RxStartTime)
+			item.RxEndTime = utils.NormalizeNilableString(props.RxEndTime)
+			if props.MaximumElevationDegrees != nil {
+				item.MaximumElevationDegrees = *props.MaximumElevationDegrees
+			}
+			if props.StartAzimuthDegrees != nil {
+				item.StartAzimuthDegrees = *props.StartAzimuthDegrees
+			}
+			if props.EndAzimuthDegrees != nil {
+				item.EndAzimuthDegrees = *props.EndAzimuthDegrees
+			}
+			if props.StartElevationDegrees != nil {
+				item.StartElevationDegrees = *props.StartElevationDegrees
+			}
+			if props.EndElevationDegrees != nil {
+				item.EndElevationDegrees = *props.EndElevationDegrees
+			}
+		}
+
+		output = append(output, item)
+	}
+	return output
+}
@@ -0,0 +1,43 @@
+package orbital_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AvailableContactsDataSource struct{}
+
+func TestAccAvailableContactsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_orbital_available_contacts", "test")
+	r := AvailableContactsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("available_contacts.#").Exists(),
+			),
+		},
+	})
+}
+
+func (AvailableContactsDataSource) basic(data acceptance.TestData) string {
+	startTime := time.Now().UTC().Truncate(time.Hour).Add(time.Hour)
+	endTime := startTime.Add(24 * time.Hour)
+
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_orbital_available_contacts" "test" {
+  spacecraft_id       = azurerm_orbital_spacecraft.test.id
+  contact_profile_id  = azurerm_orbital_contact_profile.test.id
+  ground_station_name = "WESTUS2_0"
+  start_time          = "%s"
+  end_time            = "%s"
+}
+`, ContactResource{}.template(data), startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
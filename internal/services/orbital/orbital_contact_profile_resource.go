@@ -0,0 +1,527 @@
+package orbital
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contactprofile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContactProfileResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ContactProfileResource{}
+	_ sdk.ResourceWithCustomizeDiff = ContactProfileResource{}
+)
+
+type ContactProfileResourceModel struct {
+	Name                         string                    `tfschema:"name"`
+	ResourceGroupName            string                    `tfschema:"resource_group_name"`
+	Location                     string                    `tfschema:"location"`
+	MinimumViableContactDuration string                    `tfschema:"minimum_viable_contact_duration"`
+	AutoTracking                 string                    `tfschema:"auto_tracking"`
+	NetworkConfigurationSubnetId string                    `tfschema:"network_configuration_subnet_id"`
+	Links                        []ContactProfileLinkModel `tfschema:"links"`
+	MinimumElevationDegrees      float64                   `tfschema:"minimum_elevation_degrees"`
+	EventHubUri                  string                    `tfschema:"event_hub_uri"`
+	Tags                         map[string]string         `tfschema:"tags"`
+}
+
+type ContactProfileLinkModel struct {
+	Name         string                           `tfschema:"name"`
+	Direction    string                           `tfschema:"direction"`
+	Polarization string                           `tfschema:"polarization"`
+	Channels     []ContactProfileLinkChannelModel `tfschema:"channels"`
+}
+
+type ContactProfileLinkChannelModel struct {
+	Name                      string          `tfschema:"name"`
+	BandwidthMhz              float64         `tfschema:"bandwidth_mhz"`
+	CenterFrequencyMhz        float64         `tfschema:"center_frequency_mhz"`
+	EndPoint                  []EndPointModel `tfschema:"end_point"`
+	ModulationConfiguration   string          `tfschema:"modulation_configuration"`
+	DemodulationConfiguration string          `tfschema:"demodulation_configuration"`
+	EncodingConfiguration     string          `tfschema:"encoding_configuration"`
+	DecodingConfiguration     string          `tfschema:"decoding_configuration"`
+}
+
+type EndPointModel struct {
+	EndPointName string `tfschema:"end_point_name"`
+	IpAddress    string `tfschema:"ip_address"`
+	Port         string `tfschema:"port"`
+	Protocol     string `tfschema:"protocol"`
+}
+
+func (r ContactProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"minimum_viable_contact_duration": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ISO8601Duration,
+		},
+
+		"auto_tracking": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(contactprofile.PossibleValuesForAutoTrackingConfiguration(), false),
+		},
+
+		"network_configuration_subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"links": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"direction": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(contactprofile.PossibleValuesForDirection(), false),
+					},
+
+					"polarization": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(contactprofile.PossibleValuesForPolarization(), false),
+					},
+
+					"channels": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"bandwidth_mhz": {
+									Type:         pluginsdk.TypeFloat,
+									Required:     true,
+									ValidateFunc: validation.FloatAtLeast(0),
+								},
+
+								"center_frequency_mhz": {
+									Type:         pluginsdk.TypeFloat,
+									Required:     true,
+									ValidateFunc: validation.FloatAtLeast(0),
+								},
+
+								"end_point": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MaxItems: 1,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"end_point_name": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"ip_address": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.IsIPv4Address,
+											},
+
+											"port": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.IsPortNumber,
+											},
+
+											"protocol": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringInSlice(contactprofile.PossibleValuesForProtocol(), false),
+											},
+										},
+									},
+								},
+
+								"modulation_configuration": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsJSON,
+								},
+
+								"demodulation_configuration": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsJSON,
+								},
+
+								"encoding_configuration": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsJSON,
+								},
+
+								"decoding_configuration": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsJSON,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"minimum_elevation_degrees": {
+			Type:         pluginsdk.TypeFloat,
+			Optional:     true,
+			ValidateFunc: validation.FloatBetween(0, 90),
+		},
+
+		"event_hub_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: eventhubs.ValidateEventhubID,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ContactProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContactProfileResource) ModelObject() interface{} {
+	return &ContactProfileResourceModel{}
+}
+
+func (r ContactProfileResource) ResourceType() string {
+	return "azurerm_orbital_contact_profile"
+}
+
+func (r ContactProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contactprofile.ValidateContactProfileID
+}
+
+func (r ContactProfileResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ContactProfileResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			linkNames := make(map[string]struct{})
+			for _, link := range model.Links {
+				if link.Name == "" {
+					// not known until apply
+					continue
+				}
+				if _, exists := linkNames[strings.ToLower(link.Name)]; exists {
+					return fmt.Errorf("link names must be unique but %q was specified more than once", link.Name)
+				}
+				linkNames[strings.ToLower(link.Name)] = struct{}{}
+
+				channelNames := make(map[string]struct{})
+				for _, channel := range link.Channels {
+					if channel.Name != "" {
+						if _, exists := channelNames[strings.ToLower(channel.Name)]; exists {
+							return fmt.Errorf("channel names must be unique within a link but %q was specified more than once in the link %q", channel.Name, link.Name)
+						}
+						channelNames[strings.ToLower(channel.Name)] = struct{}{}
+					}
+
+					// uplink channels send data to the spacecraft, downlink channels receive data from it
+					if link.Direction == string(contactprofile.DirectionUplink) && (channel.DemodulationConfiguration != "" || channel.DecodingConfiguration != "") {
+						return fmt.Errorf("`demodulation_configuration` and `decoding_configuration` cannot be specified for the channel %q since the link %q is an Uplink", channel.Name, link.Name)
+					}
+					if link.Direction == string(contactprofile.DirectionDownlink) && (channel.ModulationConfiguration != "" || channel.EncodingConfiguration != "") {
+						return fmt.Errorf("`modulation_configuration` and `encoding_configuration` cannot be specified for the channel %q since the link %q is a Downlink", channel.Name, link.Name)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContactProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.ContactProfileClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ContactProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := contactprofile.NewContactProfileID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.ContactProfilesGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := contactprofile.ContactProfile{
+				Location:   location.Normalize(model.Location),
+				Properties: expandContactProfileProperties(model),
+				Tags:       &model.Tags,
+			}
+
+			if err := client.ContactProfilesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContactProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.ContactProfileClient
+
+			id, err := contactprofile.ParseContactProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ContactProfilesGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContactProfileResourceModel{
+				Name:              id.ContactProfileName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				if props.AutoTrackingConfiguration != nil {
+					state.AutoTracking = string(*props.AutoTrackingConfiguration)
+				}
+				state.EventHubUri = utils.NormalizeNilableString(props.EventHubUri)
+				state.Links = flattenContactProfileLinks(props.Links)
+				if props.MinimumElevationDegrees != nil {
+					state.MinimumElevationDegrees = *props.MinimumElevationDegrees
+				}
+				state.MinimumViableContactDuration = utils.NormalizeNilableString(props.MinimumViableContactDuration)
+				state.NetworkConfigurationSubnetId = props.NetworkConfiguration.SubnetId
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContactProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.ContactProfileClient
+
+			id, err := contactprofile.ParseContactProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContactProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// only the tags can be updated using PATCH, so everything else is sent using PUT
+			if metadata.ResourceData.HasChanges("minimum_viable_contact_duration", "auto_tracking", "links", "minimum_elevation_degrees", "event_hub_uri") {
+				payload := contactprofile.ContactProfile{
+					Location:   location.Normalize(model.Location),
+					Properties: expandContactProfileProperties(model),
+					Tags:       &model.Tags,
+				}
+
+				if err := client.ContactProfilesCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				return nil
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := contactprofile.TagsObject{
+					Tags: &model.Tags,
+				}
+				if err := client.ContactProfilesUpdateTagsThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContactProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.ContactProfileClient
+
+			id, err := contactprofile.ParseContactProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.ContactProfilesDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContactProfileProperties(input ContactProfileResourceModel) contactprofile.ContactProfilesProperties {
+	autoTracking := contactprofile.AutoTrackingConfiguration(input.AutoTracking)
+	output := contactprofile.ContactProfilesProperties{
+		AutoTrackingConfiguration:    &autoTracking,
+		Links:                        expandContactProfileLinks(input.Links),
+		MinimumElevationDegrees:      utils.Float(input.MinimumElevationDegrees),
+		MinimumViableContactDuration: utils.String(input.MinimumViableContactDuration),
+		NetworkConfiguration: contactprofile.ContactProfilesPropertiesNetworkConfiguration{
+			SubnetId: input.NetworkConfigurationSubnetId,
+		},
+	}
+	if input.EventHubUri != "" {
+		output.EventHubUri = utils.String(input.EventHubUri)
+	}
+	return output
+}
+
+func expandContactProfileLinks(input []ContactProfileLinkModel) []contactprofile.ContactProfileLink {
+	output := make([]contactprofile.ContactProfileLink, 0)
+	for _, link := range input {
+		channels := make([]contactprofile.ContactProfileLinkChannel, 0)
+		for _, channel := range link.Channels {
+			item := contactprofile.ContactProfileLinkChannel{
+				BandwidthMHz:       channel.BandwidthMhz,
+				CenterFrequencyMHz: channel.CenterFrequencyMhz,
+				Name:               channel.Name,
+			}
+			if len(channel.EndPoint) > 0 {
+				endPoint := channel.EndPoint[0]
+				item.EndPoint = contactprofile.EndPoint{
+					EndPointName: endPoint.EndPointName,
+					IPAddress:    endPoint.IpAddress,
+					Port:         endPoint.Port,
+					Protocol:     contactprofile.Protocol(endPoint.Protocol),
+				}
+			}
+			if channel.ModulationConfiguration != "" {
+				item.ModulationConfiguration = utils.String(channel.ModulationConfiguration)
+			}
+			if channel.DemodulationConfiguration != "" {
+				item.DemodulationConfiguration = utils.String(channel.DemodulationConfiguration)
+			}
+			if channel.EncodingConfiguration != "" {
+				item.EncodingConfiguration = utils.String(channel.EncodingConfiguration)
+			}
+			if channel.DecodingConfiguration != "" {
+				item.DecodingConfiguration = utils.String(channel.DecodingConfiguration)
+			}
+			channels = append(channels, item)
+		}
+
+		output = append(output, contactprofile.ContactProfileLink{
+			Channels:     channels,
+			Direction:    contactprofile.Direction(link.Direction),
+			Name:         link.Name,
+			Polarization: contactprofile.Polarization(link.Polarization),
+		})
+	}
+	return output
+}
+
+func flattenContactProfileLinks(input []contactprofile.ContactProfileLink) []ContactProfileLinkModel {
+	output := make([]ContactProfileLinkModel, 0)
+	for _, link := range input {
+		channels := make([]ContactProfileLinkChannelModel, 0)
+		for _, channel := range link.Channels {
+			channels = append(channels, ContactProfileLinkChannelModel{
+				Name:               channel.Name,
+				BandwidthMhz:       channel.BandwidthMHz,
+				CenterFrequencyMhz: channel.CenterFrequencyMHz,
+				EndPoint: []EndPointModel{
+					{
+						EndPointName: channel.EndPoint.EndPointName,
+						IpAddress:    channel.EndPoint.IPAddress,
+						Port:         channel.EndPoint.Port,
+						Protocol:     string(channel.EndPoint.Protocol),
+					},
+				},
+				ModulationConfiguration:   utils.NormalizeNilableString(channel.ModulationConfiguration),
+				DemodulationConfiguration: utils.NormalizeNilableString(channel.DemodulationConfiguration),
+				EncodingConfiguration:     utils.NormalizeNilableString(channel.EncodingConfiguration),
+				DecodingConfiguration:     utils.NormalizeNilableString(channel.DecodingConfiguration),
+			})
+		}
+
+		output = append(output, ContactProfileLinkModel{
+			Name:         link.Name,
+			Direction:    string(link.Direction),
+			Polarization: string(link.Polarization),
+			Channels:     channels,
+		})
+	}
+	return output
+}
@@ -0,0 +1,328 @@
+package orbital_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contactprofile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContactProfileResource struct{}
+
+func TestAccContactProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_contact_profile", "test")
+	r := ContactProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContactProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_contact_profile", "test")
+	r := ContactProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContactProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_contact_profile", "test")
+	r := ContactProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContactProfile_uplinkWithDemodulation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_contact_profile", "test")
+	r := ContactProfileResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.uplinkWithDemodulation(data),
+			ExpectError: regexp.MustCompile("cannot be specified for the channel"),
+		},
+	})
+}
+
+func (ContactProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := contactprofile.ParseContactProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Orbital.ContactProfileClient.ContactProfilesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ContactProfileResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-orbital-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.1.0/24"]
+
+  delegation {
+    name = "orbitalgateway"
+
+    service_delegation {
+      name = "Microsoft.Orbital/orbitalGateways"
+      actions = [
+        "Microsoft.Network/publicIPAddresses/join/action",
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+        "Microsoft.Network/virtualNetworks/read",
+        "Microsoft.Network/publicIPAddresses/read",
+      ]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ContactProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_orbital_contact_profile" "test" {
+  name                            = "acctestcontactprofile-%[2]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  minimum_viable_contact_duration = "PT1M"
+  auto_tracking                   = "disabled"
+
+  links {
+    channels {
+      name                 = "channelname"
+      bandwidth_mhz        = 100
+      center_frequency_mhz = 101
+
+      end_point {
+        end_point_name = "AQUA_command"
+        ip_address     = "10.0.1.0"
+        port           = "49153"
+        protocol       = "TCP"
+      }
+    }
+
+    direction    = "Uplink"
+    name         = "RHCP_UL"
+    polarization = "RHCP"
+  }
+
+  network_configuration_subnet_id = azurerm_subnet.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContactProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orbital_contact_profile" "import" {
+  name                            = azurerm_orbital_contact_profile.test.name
+  resource_group_name             = azurerm_orbital_contact_profile.test.resource_group_name
+  location                        = azurerm_orbital_contact_profile.test.location
+  minimum_viable_contact_duration = azurerm_orbital_contact_profile.test.minimum_viable_contact_duration
+  auto_tracking                   = azurerm_orbital_contact_profile.test.auto_tracking
+
+  links {
+    channels {
+      name                 = "channelname"
+      bandwidth_mhz        = 100
+      center_frequency_mhz = 101
+
+      end_point {
+        end_point_name = "AQUA_command"
+        ip_address     = "10.0.1.0"
+        port           = "49153"
+        protocol       = "TCP"
+      }
+    }
+
+    direction    = "Uplink"
+    name         = "RHCP_UL"
+    polarization = "RHCP"
+  }
+
+  network_configuration_subnet_id = azurerm_orbital_contact_profile.test.network_configuration_subnet_id
+}
+`, r.basic(data))
+}
+
+func (r ContactProfileResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%[2]d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_orbital_contact_profile" "test" {
+  name                            = "acctestcontactprofile-%[2]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  minimum_viable_contact_duration = "PT2M"
+  auto_tracking                   = "xBand"
+  minimum_elevation_degrees       = 5
+  event_hub_uri                   = azurerm_eventhub.test.id
+
+  links {
+    channels {
+      name                 = "channelname"
+      bandwidth_mhz        = 100
+      center_frequency_mhz = 101
+
+      end_point {
+        end_point_name = "AQUA_command"
+        ip_address     = "10.0.1.0"
+        port           = "49153"
+        protocol       = "TCP"
+      }
+    }
+
+    direction    = "Uplink"
+    name         = "RHCP_UL"
+    polarization = "RHCP"
+  }
+
+  links {
+    channels {
+      name                 = "downlinkchannel"
+      bandwidth_mhz        = 15
+      center_frequency_mhz = 8160
+
+      end_point {
+        end_point_name = "AQUA_telemetry"
+        ip_address     = "10.0.1.0"
+        port           = "49154"
+        protocol       = "UDP"
+      }
+    }
+
+    direction    = "Downlink"
+    name         = "RHCP_DL"
+    polarization = "RHCP"
+  }
+
+  network_configuration_subnet_id = azurerm_subnet.test.id
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ContactProfileResource) uplinkWithDemodulation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_orbital_contact_profile" "test" {
+  name                            = "acctestcontactprofile-%[2]d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  minimum_viable_contact_duration = "PT1M"
+  auto_tracking                   = "disabled"
+
+  links {
+    channels {
+      name                       = "channelname"
+      bandwidth_mhz              = 100
+      center_frequency_mhz       = 101
+      demodulation_configuration = jsonencode({ type = "qpsk" })
+
+      end_point {
+        end_point_name = "AQUA_command"
+        ip_address     = "10.0.1.0"
+        port           = "49153"
+        protocol       = "TCP"
+      }
+    }
+
+    direction    = "Uplink"
+    name         = "RHCP_UL"
+    polarization = "RHCP"
+  }
+
+  network_configuration_subnet_id = azurerm_subnet.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
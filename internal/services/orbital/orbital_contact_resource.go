@@ -0,0 +1,201 @@
+package orbital
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contact"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contactprofile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/spacecraft"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContactResource struct{}
+
+var _ sdk.Resource = ContactResource{}
+
+type ContactResourceModel struct {
+	Name                 string `tfschema:"name"`
+	SpacecraftId         string `tfschema:"spacecraft_id"`
+	ReservationStartTime string `tfschema:"reservation_start_time"`
+	ReservationEndTime   string `tfschema:"reservation_end_time"`
+	GroundStationName    string `tfschema:"ground_station_name"`
+	ContactProfileId     string `tfschema:"contact_profile_id"`
+}
+
+func (r ContactResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"spacecraft_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: spacecraft.ValidateSpacecraftID,
+		},
+
+		"reservation_start_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"reservation_end_time": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"ground_station_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"contact_profile_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: contactprofile.ValidateContactProfileID,
+		},
+	}
+}
+
+func (r ContactResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContactResource) ModelObject() interface{} {
+	return &ContactResourceModel{}
+}
+
+func (r ContactResource) ResourceType() string {
+	return "azurerm_orbital_contact"
+}
+
+func (r ContactResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contact.ValidateContactID
+}
+
+func (r ContactResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.ContactClient
+
+			var model ContactResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			spacecraftId, err := spacecraft.ParseSpacecraftID(model.SpacecraftId)
+			if err != nil {
+				return err
+			}
+
+			id := contact.NewContactID(spacecraftId.SubscriptionId, spacecraftId.ResourceGroupName, spacecraftId.SpacecraftName, model.Name)
+			existing, err := client.ContactsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := contact.Contact{
+				Properties: contact.ContactsProperties{
+					ContactProfile: contact.ContactsPropertiesContactProfile{
+						Id: model.ContactProfileId,
+					},
+					GroundStationName:    model.GroundStationName,
+					ReservationStartTime: model.ReservationStartTime,
+					ReservationEndTime:   model.ReservationEndTime,
+				},
+			}
+
+			if err := client.ContactsCreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContactResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.ContactClient
+
+			id, err := contact.ParseContactID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ContactsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContactResourceModel{
+				Name:         id.ContactName,
+				SpacecraftId: spacecraft.NewSpacecraftID(id.SubscriptionId, id.ResourceGroupName, id.SpacecraftName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				contactProfileId := ""
+				if props.ContactProfile.Id != "" {
+					parsed, err := contactprofile.ParseContactProfileIDInsensitively(props.ContactProfile.Id)
+					if err != nil {
+						return err
+					}
+					contactProfileId = parsed.ID()
+				}
+				state.ContactProfileId = contactProfileId
+				state.GroundStationName = props.GroundStationName
+				state.ReservationStartTime = props.ReservationStartTime
+				state.ReservationEndTime = props.ReservationEndTime
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContactResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.ContactClient
+
+			id, err := contact.ParseContactID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.ContactsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
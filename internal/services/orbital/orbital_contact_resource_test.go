@@ -0,0 +1,123 @@
+package orbital_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/contact"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContactResource struct{}
+
+func TestAccContact_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_contact", "test")
+	r := ContactResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContact_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_contact", "test")
+	r := ContactResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ContactResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := contact.ParseContactID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Orbital.ContactClient.ContactsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContactResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_orbital_spacecraft" "test" {
+  name                = "acctestspacecraft-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  norad_id            = "27424"
+
+  links {
+    bandwidth_mhz        = 2
+    center_frequency_mhz = 101
+    direction            = "Uplink"
+    polarization         = "RHCP"
+    name                 = "RHCP_UL"
+  }
+
+  two_line_elements = ["1 27424U 02022A   23016.38434117  .00000943  00000+0  21045-3 0  9993", "2 27424  98.2757 318.0316 0001350 108.6225 339.7346 14.57815738 55373"]
+  title_line        = "AQUA"
+}
+`, ContactProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ContactResource) basic(data acceptance.TestData) string {
+	// contacts can only be reserved in the future, the window is rounded to the hour so that it's stable across test steps
+	startTime := time.Now().UTC().Truncate(time.Hour).Add(2 * time.Hour)
+	endTime := startTime.Add(15 * time.Minute)
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_orbital_contact" "test" {
+  name                   = "acctestcontact-%[2]d"
+  spacecraft_id          = azurerm_orbital_spacecraft.test.id
+  reservation_start_time = "%[3]s"
+  reservation_end_time   = "%[4]s"
+  ground_station_name    = "WESTUS2_0"
+  contact_profile_id     = azurerm_orbital_contact_profile.test.id
+}
+`, r.template(data), data.RandomInteger, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
+
+func (r ContactResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orbital_contact" "import" {
+  name                   = azurerm_orbital_contact.test.name
+  spacecraft_id          = azurerm_orbital_contact.test.spacecraft_id
+  reservation_start_time = azurerm_orbital_contact.test.reservation_start_time
+  reservation_end_time   = azurerm_orbital_contact.test.reservation_end_time
+  ground_station_name    = azurerm_orbital_contact.test.ground_station_name
+  contact_profile_id     = azurerm_orbital_contact.test.contact_profile_id
+}
+`, r.basic(data))
+}
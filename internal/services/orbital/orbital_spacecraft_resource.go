@@ -0,0 +1,329 @@
+package orbital
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/spacecraft"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SpacecraftResource struct{}
+
+var _ sdk.ResourceWithUpdate = SpacecraftResource{}
+
+type SpacecraftResourceModel struct {
+	Name              string                `tfschema:"name"`
+	ResourceGroupName string                `tfschema:"resource_group_name"`
+	Location          string                `tfschema:"location"`
+	NoradId           string                `tfschema:"norad_id"`
+	Links             []SpacecraftLinkModel `tfschema:"links"`
+	TwoLineElements   []string              `tfschema:"two_line_elements"`
+	TitleLine         string                `tfschema:"title_line"`
+	Tags              map[string]string     `tfschema:"tags"`
+}
+
+type SpacecraftLinkModel struct {
+	BandwidthMhz       float64 `tfschema:"bandwidth_mhz"`
+	CenterFrequencyMhz float64 `tfschema:"center_frequency_mhz"`
+	Direction          string  `tfschema:"direction"`
+	Polarization       string  `tfschema:"polarization"`
+	Name               string  `tfschema:"name"`
+}
+
+func (r SpacecraftResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"norad_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[0-9]{5}$`),
+				"`norad_id` must be a 5 digit number",
+			),
+		},
+
+		"links": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"bandwidth_mhz": {
+						Type:         pluginsdk.TypeFloat,
+						Required:     true,
+						ValidateFunc: validation.FloatAtLeast(0),
+					},
+
+					"center_frequency_mhz": {
+						Type:         pluginsdk.TypeFloat,
+						Required:     true,
+						ValidateFunc: validation.FloatAtLeast(0),
+					},
+
+					"direction": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(spacecraft.PossibleValuesForDirection(), false),
+					},
+
+					"polarization": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(spacecraft.PossibleValuesForPolarization(), false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"two_line_elements": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 2,
+			MaxItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringLenBetween(69, 69),
+			},
+		},
+
+		"title_line": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SpacecraftResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SpacecraftResource) ModelObject() interface{} {
+	return &SpacecraftResourceModel{}
+}
+
+func (r SpacecraftResource) ResourceType() string {
+	return "azurerm_orbital_spacecraft"
+}
+
+func (r SpacecraftResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return spacecraft.ValidateSpacecraftID
+}
+
+func (r SpacecraftResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.SpacecraftClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model SpacecraftResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := spacecraft.NewSpacecraftID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.SpacecraftsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := spacecraft.Spacecraft{
+				Location: location.Normalize(model.Location),
+				Properties: spacecraft.SpacecraftsProperties{
+					Links:     expandSpacecraftLinks(model.Links),
+					NoradId:   utils.String(model.NoradId),
+					TitleLine: model.TitleLine,
+					TleLine1:  model.TwoLineElements[0],
+					TleLine2:  model.TwoLineElements[1],
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.SpacecraftsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SpacecraftResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.SpacecraftClient
+
+			id, err := spacecraft.ParseSpacecraftID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SpacecraftsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SpacecraftResourceModel{
+				Name:              id.SpacecraftName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				props := model.Properties
+				state.Links = flattenSpacecraftLinks(props.Links)
+				state.NoradId = utils.NormalizeNilableString(props.NoradId)
+				state.TitleLine = props.TitleLine
+				state.TwoLineElements = []string{props.TleLine1, props.TleLine2}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SpacecraftResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.SpacecraftClient
+
+			id, err := spacecraft.ParseSpacecraftID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SpacecraftResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// only the tags can be updated using PATCH, so everything else is sent using PUT
+			if metadata.ResourceData.HasChanges("links", "two_line_elements", "title_line") {
+				existing, err := client.SpacecraftsGet(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+
+				payload := *existing.Model
+				payload.Properties.Links = expandSpacecraftLinks(model.Links)
+				payload.Properties.TitleLine = model.TitleLine
+				payload.Properties.TleLine1 = model.TwoLineElements[0]
+				payload.Properties.TleLine2 = model.TwoLineElements[1]
+				payload.Tags = &model.Tags
+
+				if err := client.SpacecraftsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				return nil
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := spacecraft.TagsObject{
+					Tags: &model.Tags,
+				}
+				if err := client.SpacecraftsUpdateTagsThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating tags for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SpacecraftResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Orbital.SpacecraftClient
+
+			id, err := spacecraft.ParseSpacecraftID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.SpacecraftsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSpacecraftLinks(input []SpacecraftLinkModel) *[]spacecraft.SpacecraftLink {
+	output := make([]spacecraft.SpacecraftLink, 0)
+	for _, v := range input {
+		output = append(output, spacecraft.SpacecraftLink{
+			BandwidthMHz:       v.BandwidthMhz,
+			CenterFrequencyMHz: v.CenterFrequencyMhz,
+			Direction:          spacecraft.Direction(v.Direction),
+			Name:               v.Name,
+			Polarization:       spacecraft.Polarization(v.Polarization),
+		})
+	}
+	return &output
+}
+
+func flattenSpacecraftLinks(input *[]spacecraft.SpacecraftLink) []SpacecraftLinkModel {
+	output := make([]SpacecraftLinkModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, SpacecraftLinkModel{
+			BandwidthMhz:       v.BandwidthMHz,
+			CenterFrequencyMhz: v.CenterFrequencyMHz,
+			Direction:          string(v.Direction),
+			Name:               v.Name,
+			Polarization:       string(v.Polarization),
+		})
+	}
+	return output
+}
@@ -0,0 +1,191 @@
+package orbital_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/orbital/sdk/2022-11-01/spacecraft"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SpacecraftResource struct{}
+
+func TestAccSpacecraft_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_spacecraft", "test")
+	r := SpacecraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSpacecraft_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_spacecraft", "test")
+	r := SpacecraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSpacecraft_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_spacecraft", "test")
+	r := SpacecraftResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (SpacecraftResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := spacecraft.ParseSpacecraftID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Orbital.SpacecraftClient.SpacecraftsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SpacecraftResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-orbital-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_orbital_spacecraft" "test" {
+  name                = "acctestspacecraft-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  norad_id            = "27424"
+
+  links {
+    bandwidth_mhz        = 15
+    center_frequency_mhz = 8160
+    direction            = "Downlink"
+    polarization         = "RHCP"
+    name                 = "examplename"
+  }
+
+  two_line_elements = ["1 27424U 02022A   23016.38434117  .00000943  00000+0  21045-3 0  9993", "2 27424  98.2757 318.0316 0001350 108.6225 339.7346 14.57815738 55373"]
+  title_line        = "AQUA"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r SpacecraftResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_orbital_spacecraft" "import" {
+  name                = azurerm_orbital_spacecraft.test.name
+  resource_group_name = azurerm_orbital_spacecraft.test.resource_group_name
+  location            = azurerm_orbital_spacecraft.test.location
+  norad_id            = azurerm_orbital_spacecraft.test.norad_id
+
+  links {
+    bandwidth_mhz        = 15
+    center_frequency_mhz = 8160
+    direction            = "Downlink"
+    polarization         = "RHCP"
+    name                 = "examplename"
+  }
+
+  two_line_elements = azurerm_orbital_spacecraft.test.two_line_elements
+  title_line        = azurerm_orbital_spacecraft.test.title_line
+}
+`, r.basic(data))
+}
+
+func (r SpacecraftResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-orbital-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_orbital_spacecraft" "test" {
+  name                = "acctestspacecraft-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  norad_id            = "27424"
+
+  links {
+    bandwidth_mhz        = 15
+    center_frequency_mhz = 8160
+    direction            = "Downlink"
+    polarization         = "RHCP"
+    name                 = "examplename"
+  }
+
+  links {
+    bandwidth_mhz        = 2
+    center_frequency_mhz = 2065
+    direction            = "Uplink"
+    polarization         = "RHCP"
+    name                 = "uplinkname"
+  }
+
+  two_line_elements = ["1 27424U 02022A   23016.38434117  .00000943  00000+0  21045-3 0  9993", "2 27424  98.2757 318.0316 0001350 108.6225 339.7346 14.57815738 55373"]
+  title_line        = "AQUA"
+
+  tags = {
+    environment = "test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,15 @@
+package contact
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ContactClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContactClientWithBaseURI(endpoint string) ContactClient {
+	return ContactClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,80 @@
+package contact
+
+import "strings"
+
+type ContactsStatus string
+
+const (
+	ContactsStatusCancelled         ContactsStatus = "cancelled"
+	ContactsStatusFailed            ContactsStatus = "failed"
+	ContactsStatusProviderCancelled ContactsStatus = "providerCancelled"
+	ContactsStatusScheduled         ContactsStatus = "scheduled"
+	ContactsStatusSucceeded         ContactsStatus = "succeeded"
+)
+
+func PossibleValuesForContactsStatus() []string {
+	return []string{
+		string(ContactsStatusCancelled),
+		string(ContactsStatusFailed),
+		string(ContactsStatusProviderCancelled),
+		string(ContactsStatusScheduled),
+		string(ContactsStatusSucceeded),
+	}
+}
+
+func parseContactsStatus(input string) (*ContactsStatus, error) {
+	vals := map[string]ContactsStatus{
+		"cancelled":         ContactsStatusCancelled,
+		"failed":            ContactsStatusFailed,
+		"providercancelled": ContactsStatusProviderCancelled,
+		"scheduled":         ContactsStatusScheduled,
+		"succeeded":         ContactsStatusSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ContactsStatus(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "canceled"
+	ProvisioningStateCreating  ProvisioningState = "creating"
+	ProvisioningStateDeleting  ProvisioningState = "deleting"
+	ProvisioningStateFailed    ProvisioningState = "failed"
+	ProvisioningStateSucceeded ProvisioningState = "succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
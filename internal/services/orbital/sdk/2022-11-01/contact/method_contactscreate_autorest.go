@@ -0,0 +1,75 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ContactsCreateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ContactsCreate ...
+func (c ContactClient) ContactsCreate(ctx context.Context, id ContactId, input Contact) (result ContactsCreateResponse, err error) {
+	req, err := c.preparerForContactsCreate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contact.ContactClient", "ContactsCreate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForContactsCreate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contact.ContactClient", "ContactsCreate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ContactsCreateThenPoll performs ContactsCreate then polls until it's completed
+func (c ContactClient) ContactsCreateThenPoll(ctx context.Context, id ContactId, input Contact) error {
+	result, err := c.ContactsCreate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ContactsCreate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ContactsCreate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForContactsCreate prepares the ContactsCreate request.
+func (c ContactClient) preparerForContactsCreate(ctx context.Context, id ContactId, input Contact) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForContactsCreate sends the ContactsCreate request. The method will close the
+// http.Response Body if it receives an error.
+func (c ContactClient) senderForContactsCreate(ctx context.Context, req *http.Request) (future ContactsCreateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
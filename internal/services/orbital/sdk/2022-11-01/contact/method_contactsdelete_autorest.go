@@ -0,0 +1,73 @@
+package contact
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ContactsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ContactsDelete ...
+func (c ContactClient) ContactsDelete(ctx context.Context, id ContactId) (result ContactsDeleteResponse, err error) {
+	req, err := c.preparerForContactsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contact.ContactClient", "ContactsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForContactsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contact.ContactClient", "ContactsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ContactsDeleteThenPoll performs ContactsDelete then polls until it's completed
+func (c ContactClient) ContactsDeleteThenPoll(ctx context.Context, id ContactId) error {
+	result, err := c.ContactsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing ContactsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ContactsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForContactsDelete prepares the ContactsDelete request.
+func (c ContactClient) preparerForContactsDelete(ctx context.Context, id ContactId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForContactsDelete sends the ContactsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c ContactClient) senderForContactsDelete(ctx context.Context, req *http.Request) (future ContactsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
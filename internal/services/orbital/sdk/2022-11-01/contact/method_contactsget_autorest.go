@@ -0,0 +1,64 @@
+package contact
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ContactsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Contact
+}
+
+// ContactsGet ...
+func (c ContactClient) ContactsGet(ctx context.Context, id ContactId) (result ContactsGetResponse, err error) {
+	req, err := c.preparerForContactsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contact.ContactClient", "ContactsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contact.ContactClient", "ContactsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForContactsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "contact.ContactClient", "ContactsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForContactsGet prepares the ContactsGet request.
+func (c ContactClient) preparerForContactsGet(ctx context.Context, id ContactId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForContactsGet handles the response to the ContactsGet request. The method always
+// closes the http.Response Body.
+func (c ContactClient) responderForContactsGet(resp *http.Response) (result ContactsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,8 @@
+package contact
+
+type Contact struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties ContactsProperties `json:"properties"`
+	Type       *string            `json:"type,omitempty"`
+}
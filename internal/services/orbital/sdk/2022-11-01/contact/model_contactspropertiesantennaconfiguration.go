@@ -0,0 +1,6 @@
+package contact
+
+type ContactsPropertiesAntennaConfiguration struct {
+	DestinationIP *string   `json:"destinationIp,omitempty"`
+	SourceIPs     *[]string `json:"sourceIps,omitempty"`
+}
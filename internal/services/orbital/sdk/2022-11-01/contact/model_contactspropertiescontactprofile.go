@@ -0,0 +1,5 @@
+package contact
+
+type ContactsPropertiesContactProfile struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,15 @@
+package contactprofile
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ContactProfileClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewContactProfileClientWithBaseURI(endpoint string) ContactProfileClient {
+	return ContactProfileClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,10 @@
+package contactprofile
+
+type ContactProfileLink struct {
+	Channels            []ContactProfileLinkChannel `json:"channels"`
+	Direction           Direction                   `json:"direction"`
+	EirpdBW             *float64                    `json:"eirpdBW,omitempty"`
+	GainOverTemperature *float64                    `json:"gainOverTemperature,omitempty"`
+	Name                string                      `json:"name"`
+	Polarization        Polarization                `json:"polarization"`
+}
@@ -0,0 +1,12 @@
+package contactprofile
+
+type ContactProfilesProperties struct {
+	AutoTrackingConfiguration    *AutoTrackingConfiguration                    `json:"autoTrackingConfiguration,omitempty"`
+	EventHubUri                  *string                                       `json:"eventHubUri,omitempty"`
+	Links                        []ContactProfileLink                          `json:"links"`
+	MinimumElevationDegrees      *float64                                      `json:"minimumElevationDegrees,omitempty"`
+	MinimumViableContactDuration *string                                       `json:"minimumViableContactDuration,omitempty"`
+	NetworkConfiguration         ContactProfilesPropertiesNetworkConfiguration `json:"networkConfiguration"`
+	ProvisioningState            *ProvisioningState                            `json:"provisioningState,omitempty"`
+	ThirdPartyConfigurations     *[]ContactProfileThirdPartyConfiguration      `json:"thirdPartyConfigurations,omitempty"`
+}
@@ -0,0 +1,5 @@
+package contactprofile
+
+type ContactProfilesPropertiesNetworkConfiguration struct {
+	SubnetId string `json:"subnetId"`
+}
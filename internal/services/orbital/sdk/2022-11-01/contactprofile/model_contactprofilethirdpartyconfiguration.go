@@ -0,0 +1,6 @@
+package contactprofile
+
+type ContactProfileThirdPartyConfiguration struct {
+	MissionConfiguration string `json:"missionConfiguration"`
+	ProviderName         string `json:"providerName"`
+}
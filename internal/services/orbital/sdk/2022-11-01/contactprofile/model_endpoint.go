@@ -0,0 +1,8 @@
+package contactprofile
+
+type EndPoint struct {
+	EndPointName string   `json:"endPointName"`
+	IPAddress    string   `json:"ipAddress"`
+	Port         string   `json:"port"`
+	Protocol     Protocol `json:"protocol"`
+}
@@ -0,0 +1,9 @@
+package contactprofile
+
+import "fmt"
+
+const defaultApiVersion = "2022-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/contactprofile/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package spacecraft
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SpacecraftClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSpacecraftClientWithBaseURI(endpoint string) SpacecraftClient {
+	return SpacecraftClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
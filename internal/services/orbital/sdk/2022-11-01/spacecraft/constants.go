@@ -0,0 +1,105 @@
+package spacecraft
+
+import "strings"
+
+type Direction string
+
+const (
+	DirectionDownlink Direction = "Downlink"
+	DirectionUplink   Direction = "Uplink"
+)
+
+func PossibleValuesForDirection() []string {
+	return []string{
+		string(DirectionDownlink),
+		string(DirectionUplink),
+	}
+}
+
+func parseDirection(input string) (*Direction, error) {
+	vals := map[string]Direction{
+		"downlink": DirectionDownlink,
+		"uplink":   DirectionUplink,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Direction(input)
+	return &out, nil
+}
+
+type Polarization string
+
+const (
+	PolarizationLHCP             Polarization = "LHCP"
+	PolarizationRHCP             Polarization = "RHCP"
+	PolarizationLinearHorizontal Polarization = "linearHorizontal"
+	PolarizationLinearVertical   Polarization = "linearVertical"
+)
+
+func PossibleValuesForPolarization() []string {
+	return []string{
+		string(PolarizationLHCP),
+		string(PolarizationRHCP),
+		string(PolarizationLinearHorizontal),
+		string(PolarizationLinearVertical),
+	}
+}
+
+func parsePolarization(input string) (*Polarization, error) {
+	vals := map[string]Polarization{
+		"lhcp":             PolarizationLHCP,
+		"rhcp":             PolarizationRHCP,
+		"linearhorizontal": PolarizationLinearHorizontal,
+		"linearvertical":   PolarizationLinearVertical,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Polarization(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "canceled"
+	ProvisioningStateCreating  ProvisioningState = "creating"
+	ProvisioningStateDeleting  ProvisioningState = "deleting"
+	ProvisioningStateFailed    ProvisioningState = "failed"
+	ProvisioningStateSucceeded ProvisioningState = "succeeded"
+	ProvisioningStateUpdating  ProvisioningState = "updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
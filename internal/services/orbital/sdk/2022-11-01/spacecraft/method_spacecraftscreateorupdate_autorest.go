@@ -0,0 +1,75 @@
+package spacecraft
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SpacecraftsCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SpacecraftsCreateOrUpdate ...
+func (c SpacecraftClient) SpacecraftsCreateOrUpdate(ctx context.Context, id SpacecraftId, input Spacecraft) (result SpacecraftsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForSpacecraftsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSpacecraftsCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SpacecraftsCreateOrUpdateThenPoll performs SpacecraftsCreateOrUpdate then polls until it's completed
+func (c SpacecraftClient) SpacecraftsCreateOrUpdateThenPoll(ctx context.Context, id SpacecraftId, input Spacecraft) error {
+	result, err := c.SpacecraftsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SpacecraftsCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SpacecraftsCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSpacecraftsCreateOrUpdate prepares the SpacecraftsCreateOrUpdate request.
+func (c SpacecraftClient) preparerForSpacecraftsCreateOrUpdate(ctx context.Context, id SpacecraftId, input Spacecraft) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSpacecraftsCreateOrUpdate sends the SpacecraftsCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SpacecraftClient) senderForSpacecraftsCreateOrUpdate(ctx context.Context, req *http.Request) (future SpacecraftsCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,73 @@
+package spacecraft
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SpacecraftsDeleteResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SpacecraftsDelete ...
+func (c SpacecraftClient) SpacecraftsDelete(ctx context.Context, id SpacecraftId) (result SpacecraftsDeleteResponse, err error) {
+	req, err := c.preparerForSpacecraftsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSpacecraftsDelete(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SpacecraftsDeleteThenPoll performs SpacecraftsDelete then polls until it's completed
+func (c SpacecraftClient) SpacecraftsDeleteThenPoll(ctx context.Context, id SpacecraftId) error {
+	result, err := c.SpacecraftsDelete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing SpacecraftsDelete: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SpacecraftsDelete: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSpacecraftsDelete prepares the SpacecraftsDelete request.
+func (c SpacecraftClient) preparerForSpacecraftsDelete(ctx context.Context, id SpacecraftId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSpacecraftsDelete sends the SpacecraftsDelete request. The method will close the
+// http.Response Body if it receives an error.
+func (c SpacecraftClient) senderForSpacecraftsDelete(ctx context.Context, req *http.Request) (future SpacecraftsDeleteResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
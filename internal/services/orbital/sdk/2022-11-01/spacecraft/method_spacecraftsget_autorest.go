@@ -0,0 +1,64 @@
+package spacecraft
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SpacecraftsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Spacecraft
+}
+
+// SpacecraftsGet ...
+func (c SpacecraftClient) SpacecraftsGet(ctx context.Context, id SpacecraftId) (result SpacecraftsGetResponse, err error) {
+	req, err := c.preparerForSpacecraftsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSpacecraftsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSpacecraftsGet prepares the SpacecraftsGet request.
+func (c SpacecraftClient) preparerForSpacecraftsGet(ctx context.Context, id SpacecraftId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSpacecraftsGet handles the response to the SpacecraftsGet request. The method always
+// closes the http.Response Body.
+func (c SpacecraftClient) responderForSpacecraftsGet(resp *http.Response) (result SpacecraftsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
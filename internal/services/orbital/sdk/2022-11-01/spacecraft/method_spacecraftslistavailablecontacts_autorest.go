@@ -0,0 +1,66 @@
+package spacecraft
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SpacecraftsListAvailableContactsResponse struct {
+	HttpResponse *http.Response
+	Model        *AvailableContactsListResult
+}
+
+// SpacecraftsListAvailableContacts ...
+func (c SpacecraftClient) SpacecraftsListAvailableContacts(ctx context.Context, id SpacecraftId, input ContactParameters) (result SpacecraftsListAvailableContactsResponse, err error) {
+	req, err := c.preparerForSpacecraftsListAvailableContacts(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsListAvailableContacts", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsListAvailableContacts", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSpacecraftsListAvailableContacts(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsListAvailableContacts", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSpacecraftsListAvailableContacts prepares the SpacecraftsListAvailableContacts request.
+func (c SpacecraftClient) preparerForSpacecraftsListAvailableContacts(ctx context.Context, id SpacecraftId, input ContactParameters) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/listAvailableContacts", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSpacecraftsListAvailableContacts handles the response to the SpacecraftsListAvailableContacts request. The method always
+// closes the http.Response Body.
+func (c SpacecraftClient) responderForSpacecraftsListAvailableContacts(resp *http.Response) (result SpacecraftsListAvailableContactsResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
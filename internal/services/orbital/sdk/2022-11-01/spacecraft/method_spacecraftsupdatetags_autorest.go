@@ -0,0 +1,75 @@
+package spacecraft
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SpacecraftsUpdateTagsResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SpacecraftsUpdateTags ...
+func (c SpacecraftClient) SpacecraftsUpdateTags(ctx context.Context, id SpacecraftId, input TagsObject) (result SpacecraftsUpdateTagsResponse, err error) {
+	req, err := c.preparerForSpacecraftsUpdateTags(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsUpdateTags", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSpacecraftsUpdateTags(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "spacecraft.SpacecraftClient", "SpacecraftsUpdateTags", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SpacecraftsUpdateTagsThenPoll performs SpacecraftsUpdateTags then polls until it's completed
+func (c SpacecraftClient) SpacecraftsUpdateTagsThenPoll(ctx context.Context, id SpacecraftId, input TagsObject) error {
+	result, err := c.SpacecraftsUpdateTags(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SpacecraftsUpdateTags: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SpacecraftsUpdateTags: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSpacecraftsUpdateTags prepares the SpacecraftsUpdateTags request.
+func (c SpacecraftClient) preparerForSpacecraftsUpdateTags(ctx context.Context, id SpacecraftId, input TagsObject) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSpacecraftsUpdateTags sends the SpacecraftsUpdateTags request. The method will close the
+// http.Response Body if it receives an error.
+func (c SpacecraftClient) senderForSpacecraftsUpdateTags(ctx context.Context, req *http.Request) (future SpacecraftsUpdateTagsResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
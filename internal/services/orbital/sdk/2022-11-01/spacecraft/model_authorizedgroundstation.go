@@ -0,0 +1,6 @@
+package spacecraft
+
+type AuthorizedGroundstation struct {
+	ExpirationDate string `json:"expirationDate"`
+	GroundStation  string `json:"groundStation"`
+}
@@ -0,0 +1,7 @@
+package spacecraft
+
+type AvailableContacts struct {
+	GroundStationName *string                      `json:"groundStationName,omitempty"`
+	Properties        *AvailableContactsProperties `json:"properties,omitempty"`
+	Spacecraft        *AvailableContactsSpacecraft `json:"spacecraft,omitempty"`
+}
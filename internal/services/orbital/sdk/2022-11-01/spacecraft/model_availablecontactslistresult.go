@@ -0,0 +1,6 @@
+package spacecraft
+
+type AvailableContactsListResult struct {
+	NextLink *string              `json:"nextLink,omitempty"`
+	Value    *[]AvailableContacts `json:"value,omitempty"`
+}
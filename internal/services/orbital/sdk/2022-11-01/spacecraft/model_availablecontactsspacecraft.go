@@ -0,0 +1,5 @@
+package spacecraft
+
+type AvailableContactsSpacecraft struct {
+	Id string `json:"id"`
+}
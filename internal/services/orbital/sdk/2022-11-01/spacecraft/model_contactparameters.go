@@ -0,0 +1,8 @@
+package spacecraft
+
+type ContactParameters struct {
+	ContactProfile    ContactParametersContactProfile `json:"contactProfile"`
+	EndTime           string                          `json:"endTime"`
+	GroundStationName string                          `json:"groundStationName"`
+	StartTime         string                          `json:"startTime"`
+}
@@ -0,0 +1,5 @@
+package spacecraft
+
+type ContactParametersContactProfile struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,10 @@
+package spacecraft
+
+type SpacecraftLink struct {
+	Authorizations     *[]AuthorizedGroundstation `json:"authorizations,omitempty"`
+	BandwidthMHz       float64                    `json:"bandwidthMHz"`
+	CenterFrequencyMHz float64                    `json:"centerFrequencyMHz"`
+	Direction          Direction                  `json:"direction"`
+	Name               string                     `json:"name"`
+	Polarization       Polarization               `json:"polarization"`
+}
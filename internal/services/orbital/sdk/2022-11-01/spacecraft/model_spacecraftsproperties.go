@@ -0,0 +1,10 @@
+package spacecraft
+
+type SpacecraftsProperties struct {
+	Links             *[]SpacecraftLink  `json:"links,omitempty"`
+	NoradId           *string            `json:"noradId,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+	TitleLine         string             `json:"titleLine"`
+	TleLine1          string             `json:"tleLine1"`
+	TleLine2          string             `json:"tleLine2"`
+}
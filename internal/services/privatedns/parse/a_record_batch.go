@@ -0,0 +1,56 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// aRecordBatchSuffix is appended to the ID of the Private DNS Zone, since a Private DNS A Record Batch isn't a
+// resource within Azure - this ensures that the ID doesn't collide with the ID of the Private DNS Zone
+const aRecordBatchSuffix = "/aRecordBatch"
+
+type ARecordBatchId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	PrivateDnsZoneName string
+}
+
+func NewARecordBatchID(subscriptionId, resourceGroup, privateDnsZoneName string) ARecordBatchId {
+	return ARecordBatchId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		PrivateDnsZoneName: privateDnsZoneName,
+	}
+}
+
+func (id ARecordBatchId) String() string {
+	segments := []string{
+		fmt.Sprintf("Private Dns Zone Name %q", id.PrivateDnsZoneName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "A Record Batch", segmentsStr)
+}
+
+func (id ARecordBatchId) ID() string {
+	return id.PrivateDnsZoneId().ID() + aRecordBatchSuffix
+}
+
+func (id ARecordBatchId) PrivateDnsZoneId() PrivateDnsZoneId {
+	return NewPrivateDnsZoneID(id.SubscriptionId, id.ResourceGroup, id.PrivateDnsZoneName)
+}
+
+// ARecordBatchID parses a ARecordBatch ID into an ARecordBatchId struct
+func ARecordBatchID(input string) (*ARecordBatchId, error) {
+	if !strings.HasSuffix(input, aRecordBatchSuffix) {
+		return nil, fmt.Errorf("ID was missing the %q suffix", aRecordBatchSuffix)
+	}
+
+	zoneId, err := PrivateDnsZoneID(strings.TrimSuffix(input, aRecordBatchSuffix))
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NewARecordBatchID(zoneId.SubscriptionId, zoneId.ResourceGroup, zoneId.Name)
+	return &resourceId, nil
+}
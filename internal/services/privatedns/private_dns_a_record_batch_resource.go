@@ -0,0 +1,520 @@
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourcePrivateDnsARecordBatch manages many A Records within a single Private DNS Zone. The existing
+// A Records are retrieved using a single (paged) List call, and only the A Records which differ from the
+// configuration are written - with up to `parallelism` A Records being written at once.
+func resourcePrivateDnsARecordBatch() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePrivateDnsARecordBatchCreateUpdate,
+		Read:   resourcePrivateDnsARecordBatchRead,
+		Update: resourcePrivateDnsARecordBatchCreateUpdate,
+		Delete: resourcePrivateDnsARecordBatchDelete,
+		// since only the named A Records within the Private DNS Zone are managed by this resource, this is imported
+		// using an ID in the format `{id}|{name},{name}`
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, _, err := parsePrivateDnsARecordBatchImportId(id)
+			return err
+		}, importPrivateDnsARecordBatch),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			// TODO: make this case sensitive once the API's fixed https://github.com/Azure/azure-rest-api-specs/issues/6641
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"zone_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"record": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							// lower-cased due to the broken API https://github.com/Azure/azure-rest-api-specs/issues/6641
+							ValidateFunc: validate.LowerCasedString,
+						},
+
+						"records": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MaxItems: 20,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+							Set: pluginsdk.HashString,
+						},
+
+						"ttl": {
+							Type:     pluginsdk.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+		},
+	}
+}
+
+type privateDnsARecordBatchItem struct {
+	name     string
+	ttl      int64
+	records  []string
+	metadata map[string]*string
+}
+
+func resourcePrivateDnsARecordBatchCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewARecordBatchID(subscriptionId, d.Get("resource_group_name").(string), d.Get("zone_name").(string))
+
+	desired, err := expandPrivateDnsARecordBatchItems(d.Get("record").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	existing, err := listPrivateDnsARecordBatchItems(ctx, client, id)
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		conflicting := make([]string, 0)
+		for _, name := range sortedPrivateDnsARecordBatchNames(desired) {
+			if _, ok := existing[name]; ok {
+				conflicting = append(conflicting, name)
+			}
+		}
+		if len(conflicting) > 0 {
+			return tf.ImportAsExistsError("azurerm_private_dns_a_record_batch", fmt.Sprintf("%s|%s", id.ID(), strings.Join(conflicting, ",")))
+		}
+	}
+
+	operations := make([]privateDnsARecordBatchOperation, 0)
+	if d.HasChange("record") {
+		oldRaw, _ := d.GetChange("record")
+		previous, err := expandPrivateDnsARecordBatchItems(oldRaw.(*pluginsdk.Set).List())
+		if err != nil {
+			return err
+		}
+
+		for _, name := range sortedPrivateDnsARecordBatchNames(previous) {
+			if _, ok := desired[name]; ok {
+				continue
+			}
+			if _, ok := existing[name]; !ok {
+				continue
+			}
+
+			operations = append(operations, privateDnsARecordBatchDeleteOperation(ctx, client, id, name))
+		}
+	}
+
+	for _, name := range sortedPrivateDnsARecordBatchNames(desired) {
+		item := desired[name]
+		current, exists := existing[name]
+		if exists && privateDnsARecordBatchItemsMatch(current, item) {
+			continue
+		}
+
+		parameters := privatedns.RecordSet{
+			Name: utils.String(name),
+			RecordSetProperties: &privatedns.RecordSetProperties{
+				TTL:      utils.Int64(item.ttl),
+				ARecords: expandPrivateDnsARecordBatchRecords(item.records),
+			},
+		}
+		if exists {
+			// retain any metadata which has been assigned outside of this resource
+			parameters.RecordSetProperties.Metadata = current.metadata
+		}
+
+		operations = append(operations, privateDnsARecordBatchCreateUpdateOperation(ctx, client, id, name, parameters))
+	}
+
+	if failed, errs := runPrivateDnsARecordBatchOperations(operations, d.Get("parallelism").(int)); len(errs) > 0 {
+		// rather than tainting this resource, only the A Records which are in sync are kept in the state - such that
+		// only the A Records which failed are written again during the next apply
+		d.SetId(id.ID())
+		if err := resourcePrivateDnsARecordBatchRead(d, meta); err != nil {
+			return err
+		}
+
+		// the A Records which couldn't be deleted are kept, so that they're deleted during the next apply
+		records := d.Get("record").(*pluginsdk.Set)
+		for _, name := range failed {
+			if _, ok := desired[name]; ok {
+				continue
+			}
+			if current, ok := existing[name]; ok {
+				records.Add(flattenPrivateDnsARecordBatchItem(current))
+			}
+		}
+		if err := d.Set("record", records.List()); err != nil {
+			return fmt.Errorf("setting `record`: %+v", err)
+		}
+
+		return fmt.Errorf("creating/updating/deleting %d of %d A Records in %s:\n%s", len(failed), len(operations), id, strings.Join(errs, "\n"))
+	}
+
+	d.SetId(id.ID())
+	return resourcePrivateDnsARecordBatchRead(d, meta)
+}
+
+func resourcePrivateDnsARecordBatchRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	zonesClient := meta.(*clients.Client).PrivateDns.PrivateZonesClient
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ARecordBatchID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	zone, err := zonesClient.Get(ctx, id.ResourceGroup, id.PrivateDnsZoneName)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	existing, err := listPrivateDnsARecordBatchItems(ctx, client, *id)
+	if err != nil {
+		return err
+	}
+
+	managed, err := expandPrivateDnsARecordBatchItems(d.Get("record").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	// only the A Records managed by this resource are tracked, any differences are reported per A Record
+	records := make([]interface{}, 0)
+	for _, name := range sortedPrivateDnsARecordBatchNames(managed) {
+		current, ok := existing[name]
+		if !ok {
+			log.Printf("[DEBUG] A Record %q was not found in %s - removing from state", name, *id)
+			continue
+		}
+
+		if !privateDnsARecordBatchItemsMatch(current, managed[name]) {
+			log.Printf("[DEBUG] A Record %q in %s has drifted from the state", name, *id)
+		}
+
+		records = append(records, flattenPrivateDnsARecordBatchItem(current))
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("zone_name", id.PrivateDnsZoneName)
+
+	if err := d.Set("record", records); err != nil {
+		return fmt.Errorf("setting `record`: %+v", err)
+	}
+
+	return nil
+}
+
+func importPrivateDnsARecordBatch(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+
+	id, names, err := parsePrivateDnsARecordBatchImportId(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	// only the named A Records become managed by this resource, any other A Records within the Private DNS Zone
+	// are left as-is
+	existing, err := listPrivateDnsARecordBatchItems(ctx, client, *id)
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	records := make([]interface{}, 0)
+	for _, name := range names {
+		item, ok := existing[name]
+		if !ok {
+			return []*pluginsdk.ResourceData{}, fmt.Errorf("the A Record %q was not found in %s", name, *id)
+		}
+		records = append(records, flattenPrivateDnsARecordBatchItem(item))
+	}
+
+	if err := d.Set("record", records); err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("setting `record`: %+v", err)
+	}
+
+	d.Set("parallelism", 10)
+
+	d.SetId(id.ID())
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// parsePrivateDnsARecordBatchImportId parses an Import ID in the format `{id}|{name},{name}`
+func parsePrivateDnsARecordBatchImportId(input string) (*parse.ARecordBatchId, []string, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 || segments[1] == "" {
+		return nil, nil, fmt.Errorf("expected the Import ID to be in the format `{id}|{name},{name}` but got %q", input)
+	}
+
+	id, err := parse.ARecordBatchID(segments[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, name := range strings.Split(segments[1], ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			return nil, nil, fmt.Errorf("expected the Import ID to be in the format `{id}|{name},{name}` but got %q", input)
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+
+	return id, names, nil
+}
+
+func resourcePrivateDnsARecordBatchDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ARecordBatchID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	managed, err := expandPrivateDnsARecordBatchItems(d.Get("record").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	operations := make([]privateDnsARecordBatchOperation, 0)
+	for _, name := range sortedPrivateDnsARecordBatchNames(managed) {
+		operations = append(operations, privateDnsARecordBatchDeleteOperation(ctx, client, *id, name))
+	}
+
+	if failed, errs := runPrivateDnsARecordBatchOperations(operations, d.Get("parallelism").(int)); len(errs) > 0 {
+		return fmt.Errorf("deleting %d of %d A Records from %s:\n%s", len(failed), len(operations), *id, strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+// privateDnsARecordBatchOperation is an operation against a single A Record within the Private DNS Zone
+type privateDnsARecordBatchOperation struct {
+	name string
+	run  func() error
+}
+
+func privateDnsARecordBatchCreateUpdateOperation(ctx context.Context, client *privatedns.RecordSetsClient, id parse.ARecordBatchId, name string, parameters privatedns.RecordSet) privateDnsARecordBatchOperation {
+	return privateDnsARecordBatchOperation{
+		name: name,
+		run: func() error {
+			log.Printf("[DEBUG] Creating/Updating A Record %q in %s..", name, id)
+			eTag := ""
+			ifNoneMatch := "" // set to empty to allow updates to records after creation
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.A, name, parameters, eTag, ifNoneMatch); err != nil {
+				return fmt.Errorf("creating/updating A Record %q in %s: %+v", name, id, err)
+			}
+			return nil
+		},
+	}
+}
+
+func privateDnsARecordBatchDeleteOperation(ctx context.Context, client *privatedns.RecordSetsClient, id parse.ARecordBatchId, name string) privateDnsARecordBatchOperation {
+	return privateDnsARecordBatchOperation{
+		name: name,
+		run: func() error {
+			log.Printf("[DEBUG] Deleting A Record %q from %s..", name, id)
+			if resp, err := client.Delete(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.A, name, ""); err != nil {
+				if utils.ResponseWasNotFound(resp) {
+					return nil
+				}
+				return fmt.Errorf("deleting A Record %q from %s: %+v", name, id, err)
+			}
+			return nil
+		},
+	}
+}
+
+// runPrivateDnsARecordBatchOperations runs the operations with the specified parallelism, returning the names of the
+// A Records and (sorted) errors of every operation which failed rather than stopping at the first failure
+func runPrivateDnsARecordBatchOperations(operations []privateDnsARecordBatchOperation, parallelism int) ([]string, []string) {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failed := make([]string, 0)
+	errs := make([]string, 0)
+	semaphore := make(chan struct{}, parallelism)
+	for _, operation := range operations {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(operation privateDnsARecordBatchOperation) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			if err := operation.run(); err != nil {
+				mu.Lock()
+				failed = append(failed, operation.name)
+				errs = append(errs, err.Error())
+				mu.Unlock()
+			}
+		}(operation)
+	}
+	wg.Wait()
+
+	sort.Strings(failed)
+	sort.Strings(errs)
+	return failed, errs
+}
+
+func listPrivateDnsARecordBatchItems(ctx context.Context, client *privatedns.RecordSetsClient, id parse.ARecordBatchId) (map[string]privateDnsARecordBatchItem, error) {
+	results := make(map[string]privateDnsARecordBatchItem)
+
+	iterator, err := client.ListByTypeComplete(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.A, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing A Records within %s: %+v", id, err)
+	}
+
+	for iterator.NotDone() {
+		recordSet := iterator.Value()
+		if recordSet.Name != nil && recordSet.RecordSetProperties != nil {
+			item := privateDnsARecordBatchItem{
+				name:     strings.ToLower(*recordSet.Name),
+				records:  flattenAzureRmPrivateDnsARecords(recordSet.ARecords),
+				metadata: recordSet.Metadata,
+			}
+			if recordSet.TTL != nil {
+				item.ttl = *recordSet.TTL
+			}
+			results[item.name] = item
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing A Records within %s: %+v", id, err)
+		}
+	}
+
+	return results, nil
+}
+
+func expandPrivateDnsARecordBatchItems(input []interface{}) (map[string]privateDnsARecordBatchItem, error) {
+	results := make(map[string]privateDnsARecordBatchItem)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		name := v["name"].(string)
+		if _, ok := results[name]; ok {
+			return nil, fmt.Errorf("the A Record %q is specified more than once in the `record` block", name)
+		}
+
+		records := make([]string, 0)
+		for _, record := range v["records"].(*pluginsdk.Set).List() {
+			records = append(records, record.(string))
+		}
+
+		results[name] = privateDnsARecordBatchItem{
+			name:    name,
+			ttl:     int64(v["ttl"].(int)),
+			records: records,
+		}
+	}
+
+	return results, nil
+}
+
+func flattenPrivateDnsARecordBatchItem(input privateDnsARecordBatchItem) map[string]interface{} {
+	return map[string]interface{}{
+		"name":    input.name,
+		"records": input.records,
+		"ttl":     int(input.ttl),
+	}
+}
+
+func expandPrivateDnsARecordBatchRecords(input []string) *[]privatedns.ARecord {
+	records := make([]privatedns.ARecord, 0)
+	for _, v := range input {
+		records = append(records, privatedns.ARecord{
+			Ipv4Address: utils.String(v),
+		})
+	}
+	return &records
+}
+
+func privateDnsARecordBatchItemsMatch(current, desired privateDnsARecordBatchItem) bool {
+	if current.ttl != desired.ttl || len(current.records) != len(desired.records) {
+		return false
+	}
+
+	currentRecords := make(map[string]struct{})
+	for _, v := range current.records {
+		currentRecords[v] = struct{}{}
+	}
+	for _, v := range desired.records {
+		if _, ok := currentRecords[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedPrivateDnsARecordBatchNames(input map[string]privateDnsARecordBatchItem) []string {
+	names := make([]string, 0)
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
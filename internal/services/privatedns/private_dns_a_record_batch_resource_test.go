@@ -0,0 +1,286 @@
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/privatedns/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PrivateDnsARecordBatchResource struct{}
+
+func TestAccPrivateDnsARecordBatch_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_a_record_batch", "test")
+	r := PrivateDnsARecordBatchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccPrivateDnsARecordBatch_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_a_record_batch", "test")
+	r := PrivateDnsARecordBatchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsARecordBatch_many(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_a_record_batch", "test")
+	r := PrivateDnsARecordBatchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.many(data, 100),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("100"),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.many(data, 250),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("250"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccPrivateDnsARecordBatch_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_a_record_batch", "test")
+	r := PrivateDnsARecordBatchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("3"),
+			),
+		},
+		r.importStep(data),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+func TestAccPrivateDnsARecordBatch_importOnlyNamedRecords(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_a_record_batch", "test")
+	r := PrivateDnsARecordBatchResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withUnmanagedRecord(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+			),
+		},
+		r.importStep(data),
+	})
+}
+
+// importStep imports the A Record Batch using the names of the A Records within the state, since only the named A
+// Records within the Private DNS Zone are managed by this resource
+func (PrivateDnsARecordBatchResource) importStep(data acceptance.TestData) acceptance.TestStep {
+	return acceptance.TestStep{
+		ResourceName:      data.ResourceName,
+		ImportState:       true,
+		ImportStateVerify: true,
+		// the parallelism isn't stored in Azure
+		ImportStateVerifyIgnore: []string{"parallelism"},
+		ImportStateIdFunc: func(state *acceptance.State) (string, error) {
+			rs, ok := state.RootModule().Resources[data.ResourceName]
+			if !ok {
+				return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+			}
+
+			names := make([]string, 0)
+			for k, v := range rs.Primary.Attributes {
+				if strings.HasPrefix(k, "record.") && strings.HasSuffix(k, ".name") {
+					names = append(names, v)
+				}
+			}
+
+			return fmt.Sprintf("%s|%s", rs.Primary.ID, strings.Join(names, ",")), nil
+		},
+	}
+}
+
+func (PrivateDnsARecordBatchResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ARecordBatchID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.PrivateDns.RecordSetsClient.ListByType(ctx, id.ResourceGroup, id.PrivateDnsZoneName, privatedns.A, nil, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response().Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("listing A Records within %s: %+v", *id, err)
+	}
+
+	return utils.Bool(len(resp.Values()) > 0), nil
+}
+
+func (PrivateDnsARecordBatchResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r PrivateDnsARecordBatchResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record_batch" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+
+  record {
+    name    = "first"
+    ttl     = 300
+    records = ["10.0.0.1", "10.0.0.2"]
+  }
+
+  record {
+    name    = "second"
+    ttl     = 300
+    records = ["10.0.0.3"]
+  }
+}
+`, r.template(data))
+}
+
+func (r PrivateDnsARecordBatchResource) withUnmanagedRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record" "unmanaged" {
+  name                = "unmanaged"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+  records             = ["10.0.0.9"]
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsARecordBatchResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record_batch" "import" {
+  resource_group_name = azurerm_private_dns_a_record_batch.test.resource_group_name
+  zone_name           = azurerm_private_dns_a_record_batch.test.zone_name
+
+  record {
+    name    = "first"
+    ttl     = 300
+    records = ["10.0.0.1", "10.0.0.2"]
+  }
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsARecordBatchResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record_batch" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+
+  record {
+    name    = "first"
+    ttl     = 600
+    records = ["10.0.0.1"]
+  }
+
+  record {
+    name    = "third"
+    ttl     = 300
+    records = ["10.0.0.4", "10.0.0.5"]
+  }
+
+  record {
+    name    = "fourth"
+    ttl     = 3600
+    records = ["10.0.0.6"]
+  }
+}
+`, r.template(data))
+}
+
+func (r PrivateDnsARecordBatchResource) many(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record_batch" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  parallelism         = 25
+
+  dynamic "record" {
+    for_each = range(%d)
+    content {
+      name    = "host${record.value}"
+      ttl     = 300
+      records = ["10.1.${floor(record.value / 250)}.${record.value %% 250 + 1}"]
+    }
+  }
+}
+`, r.template(data), count)
+}
@@ -0,0 +1,138 @@
+package privatedns
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+)
+
+// privateDnsZoneNameRegionPlaceholder is replaced with the (normalized) name of the Azure Region
+const privateDnsZoneNameRegionPlaceholder = "{regionName}"
+
+type privateDnsZoneNamesKey struct {
+	resourceType    string
+	subresourceName string
+}
+
+// privateDnsZoneNames maps a Resource Type and Private Link Subresource Name to the Private DNS Zones
+// required for a Private Endpoint in the Azure Public Cloud.
+// Source: https://learn.microsoft.com/azure/private-link/private-endpoint-dns
+var privateDnsZoneNames = map[privateDnsZoneNamesKey][]string{
+	{"Microsoft.AppConfiguration/configurationStores", "configurationStores"}: {"privatelink.azconfig.io"},
+	{"Microsoft.Automation/automationAccounts", "DSCAndHybridWorker"}:         {"privatelink.azure-automation.net"},
+	{"Microsoft.Automation/automationAccounts", "Webhook"}:                    {"privatelink.azure-automation.net"},
+	{"Microsoft.Batch/batchAccounts", "batchAccount"}:                         {"privatelink.batch.azure.com"},
+	{"Microsoft.Cache/Redis", "redisCache"}:                                   {"privatelink.redis.cache.windows.net"},
+	{"Microsoft.Cache/redisEnterprise", "redisEnterprise"}:                    {"privatelink.redisenterprise.cache.azure.net"},
+	{"Microsoft.CognitiveServices/accounts", "account"}:                       {"privatelink.cognitiveservices.azure.com", "privatelink.openai.azure.com"},
+	{"Microsoft.ContainerRegistry/registries", "registry"}:                    {"privatelink.azurecr.io", "{regionName}.data.privatelink.azurecr.io"},
+	{"Microsoft.ContainerService/managedClusters", "management"}:              {"privatelink.{regionName}.azmk8s.io"},
+	{"Microsoft.Databricks/workspaces", "browser_authentication"}:             {"privatelink.azuredatabricks.net"},
+	{"Microsoft.Databricks/workspaces", "databricks_ui_api"}:                  {"privatelink.azuredatabricks.net"},
+	{"Microsoft.DataFactory/factories", "dataFactory"}:                        {"privatelink.datafactory.azure.net"},
+	{"Microsoft.DataFactory/factories", "portal"}:                             {"privatelink.adf.azure.com"},
+	{"Microsoft.DBforMariaDB/servers", "mariadbServer"}:                       {"privatelink.mariadb.database.azure.com"},
+	{"Microsoft.DBforMySQL/servers", "mysqlServer"}:                           {"privatelink.mysql.database.azure.com"},
+	{"Microsoft.DBforPostgreSQL/servers", "postgresqlServer"}:                 {"privatelink.postgres.database.azure.com"},
+	{"Microsoft.Devices/IotHubs", "iotHub"}:                                   {"privatelink.azure-devices.net", "privatelink.servicebus.windows.net"},
+	{"Microsoft.DigitalTwins/digitalTwinsInstances", "API"}:                   {"privatelink.digitaltwins.azure.net"},
+	{"Microsoft.DocumentDB/databaseAccounts", "Cassandra"}:                    {"privatelink.cassandra.cosmos.azure.com"},
+	{"Microsoft.DocumentDB/databaseAccounts", "Gremlin"}:                      {"privatelink.gremlin.cosmos.azure.com"},
+	{"Microsoft.DocumentDB/databaseAccounts", "MongoDB"}:                      {"privatelink.mongo.cosmos.azure.com"},
+	{"Microsoft.DocumentDB/databaseAccounts", "Sql"}:                          {"privatelink.documents.azure.com"},
+	{"Microsoft.DocumentDB/databaseAccounts", "Table"}:                        {"privatelink.table.cosmos.azure.com"},
+	{"Microsoft.EventGrid/domains", "domain"}:                                 {"privatelink.eventgrid.azure.net"},
+	{"Microsoft.EventGrid/topics", "topic"}:                                   {"privatelink.eventgrid.azure.net"},
+	{"Microsoft.EventHub/namespaces", "namespace"}:                            {"privatelink.servicebus.windows.net"},
+	{"Microsoft.HealthcareApis/services", "fhir"}:                             {"privatelink.azurehealthcareapis.com"},
+	{"Microsoft.Insights/privateLinkScopes", "azuremonitor"}: {
+		"privatelink.agentsvc.azure-automation.net",
+		"privatelink.blob.core.windows.net",
+		"privatelink.monitor.azure.com",
+		"privatelink.ods.opinsights.azure.com",
+		"privatelink.oms.opinsights.azure.com",
+	},
+	{"Microsoft.KeyVault/managedHSMs", "managedhsm"}: {"privatelink.managedhsm.azure.net"},
+	{"Microsoft.KeyVault/vaults", "vault"}:           {"privatelink.vaultcore.azure.net"},
+	{"Microsoft.Kusto/clusters", "cluster"}: {
+		"privatelink.{regionName}.kusto.windows.net",
+		"privatelink.blob.core.windows.net",
+		"privatelink.queue.core.windows.net",
+		"privatelink.table.core.windows.net",
+	},
+	{"Microsoft.MachineLearningServices/workspaces", "amlworkspace"}: {"privatelink.api.azureml.ms", "privatelink.notebooks.azure.net"},
+	{"Microsoft.Media/mediaservices", "keydelivery"}:                 {"privatelink.media.azure.net"},
+	{"Microsoft.Media/mediaservices", "liveevent"}:                   {"privatelink.media.azure.net"},
+	{"Microsoft.Media/mediaservices", "streamingendpoint"}:           {"privatelink.media.azure.net"},
+	{"Microsoft.Purview/accounts", "account"}:                        {"privatelink.purview.azure.com"},
+	{"Microsoft.Purview/accounts", "portal"}:                         {"privatelink.purviewstudio.azure.com"},
+	{"Microsoft.Relay/namespaces", "namespace"}:                      {"privatelink.servicebus.windows.net"},
+	{"Microsoft.Search/searchServices", "searchService"}:             {"privatelink.search.windows.net"},
+	{"Microsoft.ServiceBus/namespaces", "namespace"}:                 {"privatelink.servicebus.windows.net"},
+	{"Microsoft.SignalRService/SignalR", "signalr"}:                  {"privatelink.service.signalr.net"},
+	{"Microsoft.SignalRService/WebPubSub", "webpubsub"}:              {"privatelink.webpubsub.azure.com"},
+	{"Microsoft.Sql/servers", "sqlServer"}:                           {"privatelink.database.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "blob"}:                    {"privatelink.blob.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "blob_secondary"}:          {"privatelink.blob.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "dfs"}:                     {"privatelink.dfs.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "dfs_secondary"}:           {"privatelink.dfs.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "file"}:                    {"privatelink.file.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "queue"}:                   {"privatelink.queue.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "queue_secondary"}:         {"privatelink.queue.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "table"}:                   {"privatelink.table.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "table_secondary"}:         {"privatelink.table.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "web"}:                     {"privatelink.web.core.windows.net"},
+	{"Microsoft.Storage/storageAccounts", "web_secondary"}:           {"privatelink.web.core.windows.net"},
+	{"Microsoft.Synapse/privateLinkHubs", "web"}:                     {"privatelink.azuresynapse.net"},
+	{"Microsoft.Synapse/workspaces", "Dev"}:                          {"privatelink.dev.azuresynapse.net"},
+	{"Microsoft.Synapse/workspaces", "Sql"}:                          {"privatelink.sql.azuresynapse.net"},
+	{"Microsoft.Synapse/workspaces", "SqlOnDemand"}:                  {"privatelink.sql.azuresynapse.net"},
+	{"Microsoft.Web/sites", "sites"}:                                 {"privatelink.azurewebsites.net"},
+}
+
+// privateDnsZoneNamesResourceTypes returns the Resource Types for which the Private DNS Zones are known
+func privateDnsZoneNamesResourceTypes() []string {
+	unique := make(map[string]struct{})
+	for k := range privateDnsZoneNames {
+		unique[k.resourceType] = struct{}{}
+	}
+
+	results := make([]string, 0)
+	for k := range unique {
+		results = append(results, k)
+	}
+	sort.Strings(results)
+	return results
+}
+
+// privateDnsZoneNamesFor returns the sorted list of Private DNS Zones required for a Private Endpoint connecting
+// to the specified Subresource of the Resource Type. The Resource Type and Subresource Name are matched
+// case-insensitively and the location is only required when one of the Private DNS Zones is regional.
+func privateDnsZoneNamesFor(resourceType, subresourceName, loc string) ([]string, error) {
+	var templates []string
+	for k, v := range privateDnsZoneNames {
+		if strings.EqualFold(k.resourceType, resourceType) && strings.EqualFold(k.subresourceName, subresourceName) {
+			templates = v
+			break
+		}
+	}
+	if templates == nil {
+		return nil, fmt.Errorf("the Private DNS Zones for the Subresource %q of the Resource Type %q are not known", subresourceName, resourceType)
+	}
+
+	results := make([]string, 0)
+	for _, v := range templates {
+		if strings.Contains(v, privateDnsZoneNameRegionPlaceholder) {
+			if loc == "" {
+				return nil, fmt.Errorf("a `location` must be specified since the Subresource %q of the Resource Type %q uses a regional Private DNS Zone", subresourceName, resourceType)
+			}
+			v = strings.ReplaceAll(v, privateDnsZoneNameRegionPlaceholder, location.Normalize(loc))
+		}
+		results = append(results, v)
+	}
+	sort.Strings(results)
+
+	return results, nil
+}
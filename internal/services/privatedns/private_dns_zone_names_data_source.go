@@ -0,0 +1,74 @@
+package privatedns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func dataSourcePrivateDnsZoneNames() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourcePrivateDnsZoneNamesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(privateDnsZoneNamesResourceTypes(), true),
+			},
+
+			"subresource_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"names": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourcePrivateDnsZoneNamesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	environment := meta.(*clients.Client).Account.Environment
+	if !strings.EqualFold(environment.Name, azure.PublicCloud.Name) {
+		return fmt.Errorf("the Private DNS Zone names are only available for the Azure Public Cloud, but the %q environment is in use", environment.Name)
+	}
+
+	resourceType := d.Get("resource_type").(string)
+	subresourceName := d.Get("subresource_name").(string)
+	loc := d.Get("location").(string)
+
+	names, err := privateDnsZoneNamesFor(resourceType, subresourceName, loc)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("privateDnsZoneNames/%s/%s/%s", resourceType, subresourceName, loc))
+
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("setting `names`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package privatedns_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PrivateDnsZoneNamesDataSource struct{}
+
+func TestAccPrivateDnsZoneNamesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_dns_zone_names", "test")
+	r := PrivateDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("1"),
+				check.That(data.ResourceName).Key("names.0").HasValue("privatelink.blob.core.windows.net"),
+			),
+		},
+	})
+}
+
+func TestAccPrivateDnsZoneNamesDataSource_regional(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_private_dns_zone_names", "test")
+	r := PrivateDnsZoneNamesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.regional(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("names.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (PrivateDnsZoneNamesDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_dns_zone_names" "test" {
+  resource_type    = "Microsoft.Storage/storageAccounts"
+  subresource_name = "blob"
+}
+`
+}
+
+func (PrivateDnsZoneNamesDataSource) regional(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_private_dns_zone_names" "test" {
+  resource_type    = "Microsoft.ContainerRegistry/registries"
+  subresource_name = "registry"
+  location         = "%s"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,83 @@
+package privatedns
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrivateDnsZoneNamesFor(t *testing.T) {
+	testData := []struct {
+		resourceType    string
+		subresourceName string
+		location        string
+		expected        []string
+		error           bool
+	}{
+		{
+			resourceType:    "Microsoft.Storage/storageAccounts",
+			subresourceName: "blob",
+			expected:        []string{"privatelink.blob.core.windows.net"},
+		},
+		{
+			// matched case-insensitively
+			resourceType:    "microsoft.keyvault/VAULTS",
+			subresourceName: "Vault",
+			expected:        []string{"privatelink.vaultcore.azure.net"},
+		},
+		{
+			// regional zones require a location
+			resourceType:    "Microsoft.ContainerService/managedClusters",
+			subresourceName: "management",
+			error:           true,
+		},
+		{
+			resourceType:    "Microsoft.ContainerService/managedClusters",
+			subresourceName: "management",
+			location:        "West Europe",
+			expected:        []string{"privatelink.westeurope.azmk8s.io"},
+		},
+		{
+			// the location is ignored for global zones
+			resourceType:    "Microsoft.Sql/servers",
+			subresourceName: "sqlServer",
+			location:        "westeurope",
+			expected:        []string{"privatelink.database.windows.net"},
+		},
+		{
+			resourceType:    "Microsoft.ContainerRegistry/registries",
+			subresourceName: "registry",
+			location:        "eastus2",
+			expected:        []string{"eastus2.data.privatelink.azurecr.io", "privatelink.azurecr.io"},
+		},
+		{
+			resourceType:    "Microsoft.Storage/storageAccounts",
+			subresourceName: "unknown",
+			error:           true,
+		},
+		{
+			resourceType:    "Microsoft.Unknown/things",
+			subresourceName: "thing",
+			error:           true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q / %q", v.resourceType, v.subresourceName, v.location)
+
+		actual, err := privateDnsZoneNamesFor(v.resourceType, v.subresourceName, v.location)
+		if err != nil {
+			if v.error {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
@@ -21,7 +21,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_private_dns_zone": dataSourcePrivateDnsZone(),
+		"azurerm_private_dns_zone":       dataSourcePrivateDnsZone(),
+		"azurerm_private_dns_zone_names": dataSourcePrivateDnsZoneNames(),
 	}
 }
 
@@ -30,6 +31,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_private_dns_zone":                      resourcePrivateDnsZone(),
 		"azurerm_private_dns_a_record":                  resourcePrivateDnsARecord(),
+		"azurerm_private_dns_a_record_batch":            resourcePrivateDnsARecordBatch(),
 		"azurerm_private_dns_aaaa_record":               resourcePrivateDnsAaaaRecord(),
 		"azurerm_private_dns_cname_record":              resourcePrivateDnsCNameRecord(),
 		"azurerm_private_dns_mx_record":                 resourcePrivateDnsMxRecord(),
@@ -1,19 +1,95 @@
 package client
 
 import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/services/purview/mgmt/2021-07-01/purview"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2019-11-01-preview/collections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/account"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2023-09-01/scanning"
 )
 
+// purviewDataPlaneResource is the audience used for tokens issued to the Purview data plane
+const purviewDataPlaneResource = "https://purview.azure.net"
+
 type Client struct {
-	AccountsClient *purview.AccountsClient
+	AccountsClient           *purview.AccountsClient
+	AccountClient            *account.AccountClient
+	KafkaConfigurationClient *kafkaconfiguration.KafkaConfigurationClient
+	tokenFunc                func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc      func(c *autorest.Client, authorizer autorest.Authorizer)
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	accountsClient := purview.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
 
+	accountClient := account.NewAccountClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&accountClient.Client, o.ResourceManagerAuthorizer)
+
+	kafkaConfigurationClient := kafkaconfiguration.NewKafkaConfigurationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&kafkaConfigurationClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AccountsClient: &accountsClient,
+		AccountsClient:           &accountsClient,
+		AccountClient:            &accountClient,
+		KafkaConfigurationClient: &kafkaConfigurationClient,
+		tokenFunc:                o.TokenFunc,
+		configureClientFunc:      o.ConfigureClient,
+	}
+}
+
+func (c Client) CollectionsClient(ctx context.Context, accountId parse.AccountId) (*collections.CollectionsClient, error) {
+	endpoint, authorizer, err := c.dataPlaneEndpointAndAuthorizer(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	client := collections.NewCollectionsClientWithBaseURI(*endpoint)
+	c.configureClientFunc(&client.Client, authorizer)
+	return &client, nil
+}
+
+func (c Client) ScanningClient(ctx context.Context, accountId parse.AccountId) (*scanning.ScanningClient, error) {
+	endpoint, authorizer, err := c.dataPlaneEndpointAndAuthorizer(ctx, accountId)
+	if err != nil {
+		return nil, err
 	}
+
+	client := scanning.NewScanningClientWithBaseURI(*endpoint)
+	c.configureClientFunc(&client.Client, authorizer)
+	return &client, nil
+}
+
+func (c Client) dataPlaneEndpointAndAuthorizer(ctx context.Context, accountId parse.AccountId) (*string, autorest.Authorizer, error) {
+	resp, err := c.AccountsClient.Get(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	if resp.AccountProperties == nil || resp.AccountProperties.Endpoints == nil || resp.AccountProperties.Endpoints.Scan == nil {
+		return nil, nil, fmt.Errorf("retrieving %s: `properties.endpoints.scan` was nil", accountId)
+	}
+
+	// the scan endpoint is either `https://{name}.scan.purview.azure.com` or `https://{name}.purview.azure.com/scan`
+	// depending on when the account was created, both of which map to the same account endpoint
+	scanEndpoint, err := url.Parse(*resp.AccountProperties.Endpoints.Scan)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing scan endpoint %q for %s: %+v", *resp.AccountProperties.Endpoints.Scan, accountId, err)
+	}
+	endpoint := fmt.Sprintf("https://%s", strings.Replace(scanEndpoint.Host, ".scan.", ".", 1))
+
+	authorizer, err := c.tokenFunc(purviewDataPlaneResource)
+	if err != nil {
+		return nil, nil, fmt.Errorf("obtaining auth token for %q: %+v", purviewDataPlaneResource, err)
+	}
+
+	return &endpoint, authorizer, nil
 }
@@ -1,6 +1,7 @@
 package purview
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/account"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -73,6 +75,33 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 				ValidateFunc: azure.ValidateResourceGroupName,
 			},
 
+			"managed_event_hub_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"managed_resources": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"event_hub_namespace_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"resource_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"storage_account_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"identity": {
 				Type:     pluginsdk.TypeList,
 				Computed: true,
@@ -177,6 +206,13 @@ func resourcePurviewAccountCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("waiting for create/update of %s: %+v", id, err)
 	}
 
+	// the managed event hub can only be toggled using a newer API version than the one used above
+	if (d.IsNewResource() && !d.Get("managed_event_hub_enabled").(bool)) || d.HasChange("managed_event_hub_enabled") {
+		if err := updatePurviewAccountManagedEventHub(ctx, meta, id, d.Get("managed_event_hub_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourcePurviewAccountRead(d, meta)
 }
@@ -225,6 +261,24 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	accountClient := meta.(*clients.Client).Purview.AccountClient
+	accountId := account.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	accountResp, err := accountClient.Get(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	managedEventHubEnabled := false
+	var managedResources *account.ManagedResources
+	if model := accountResp.Model; model != nil && model.Properties != nil {
+		managedEventHubEnabled = model.Properties.ManagedEventHubState != nil && *model.Properties.ManagedEventHubState == account.ManagedEventHubStateEnabled
+		managedResources = model.Properties.ManagedResources
+	}
+	d.Set("managed_event_hub_enabled", managedEventHubEnabled)
+	if err := d.Set("managed_resources", flattenPurviewAccountManagedResources(managedResources)); err != nil {
+		return fmt.Errorf("setting `managed_resources`: %+v", err)
+	}
+
 	keys, err := client.ListKeys(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("retrieving Keys for %s: %+v", *id, err)
@@ -257,6 +311,54 @@ func resourcePurviewAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	return nil
 }
 
+func updatePurviewAccountManagedEventHub(ctx context.Context, meta interface{}, id parse.AccountId, enabled bool) error {
+	client := meta.(*clients.Client).Purview.AccountClient
+	accountId := account.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	managedEventHubState := account.ManagedEventHubStateDisabled
+	if enabled {
+		managedEventHubState = account.ManagedEventHubStateEnabled
+	}
+
+	payload := account.AccountUpdateParameters{
+		Properties: &account.AccountProperties{
+			ManagedEventHubState: &managedEventHubState,
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, accountId, payload); err != nil {
+		return fmt.Errorf("updating managed event hub for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func flattenPurviewAccountManagedResources(input *account.ManagedResources) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	eventHubNamespaceId := ""
+	if input.EventHubNamespace != nil {
+		eventHubNamespaceId = *input.EventHubNamespace
+	}
+	resourceGroupId := ""
+	if input.ResourceGroup != nil {
+		resourceGroupId = *input.ResourceGroup
+	}
+	storageAccountId := ""
+	if input.StorageAccount != nil {
+		storageAccountId = *input.StorageAccount
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"event_hub_namespace_id": eventHubNamespaceId,
+			"resource_group_id":      resourceGroupId,
+			"storage_account_id":     storageAccountId,
+		},
+	}
+}
+
 func flattenPurviewAccountIdentity(identity *purview.Identity) interface{} {
 	if identity == nil || identity.Type == "None" {
 		return make([]interface{}, 0)
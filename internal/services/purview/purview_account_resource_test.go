@@ -62,6 +62,31 @@ func TestAccPurviewAccount_withManagedResourceGroupName(t *testing.T) {
 	})
 }
 
+func TestAccPurviewAccount_managedEventHub(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_account", "test")
+	r := PurviewAccountResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedEventHub(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_event_hub_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managedEventHub(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_event_hub_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("managed_resources.0.event_hub_namespace_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r PurviewAccountResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.AccountID(state.ID)
 	if err != nil {
@@ -131,3 +156,17 @@ resource "azurerm_purview_account" "test" {
 }
 `, template, data.RandomInteger, managedResourceGroupName)
 }
+
+func (r PurviewAccountResource) managedEventHub(data acceptance.TestData, enabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_account" "test" {
+  name                      = "acctestsw%d"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  managed_event_hub_enabled = %t
+}
+`, template, data.RandomInteger, enabled)
+}
@@ -0,0 +1,202 @@
+package purview
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2019-11-01-preview/collections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePurviewCollection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePurviewCollectionCreateUpdate,
+		Read:   resourcePurviewCollectionRead,
+		Update: resourcePurviewCollectionCreateUpdate,
+		Delete: resourcePurviewCollectionDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.CollectionID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9][-a-zA-Z0-9]{1,35}$`),
+					"The Collection name must be between 2 and 36 characters long, start with a letter or number and can contain only letters, numbers and hyphens."),
+			},
+
+			"purview_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"friendly_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// the root collection of an account shares the name of the account
+			"parent_collection_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourcePurviewCollectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AccountID(d.Get("purview_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*clients.Client).Purview.CollectionsClient(ctx, *accountId)
+	if err != nil {
+		return fmt.Errorf("building Collections client for %s: %+v", *accountId, err)
+	}
+
+	id := parse.NewCollectionID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	collectionId := collections.NewCollectionID(id.Name)
+	if d.IsNewResource() {
+		existing, err := client.GetCollection(ctx, collectionId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_purview_collection", id.ID())
+		}
+	}
+
+	parentCollectionName := accountId.Name
+	if v, ok := d.GetOk("parent_collection_name"); ok {
+		parentCollectionName = v.(string)
+	}
+
+	payload := collections.Collection{
+		Name: utils.String(id.Name),
+		ParentCollection: &collections.CollectionReference{
+			ReferenceName: utils.String(parentCollectionName),
+			Type:          utils.String("CollectionReference"),
+		},
+	}
+
+	if v, ok := d.GetOk("friendly_name"); ok {
+		payload.FriendlyName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		payload.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdateCollection(ctx, collectionId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePurviewCollectionRead(d, meta)
+}
+
+func resourcePurviewCollectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName)
+	client, err := meta.(*clients.Client).Purview.CollectionsClient(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("building Collections client for %s: %+v", accountId, err)
+	}
+
+	resp, err := client.GetCollection(ctx, collections.NewCollectionID(id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("purview_account_id", accountId.ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("friendly_name", model.FriendlyName)
+		d.Set("description", model.Description)
+
+		parentCollectionName := ""
+		if model.ParentCollection != nil && model.ParentCollection.ReferenceName != nil {
+			parentCollectionName = *model.ParentCollection.ReferenceName
+		}
+		d.Set("parent_collection_name", parentCollectionName)
+	}
+
+	return nil
+}
+
+func resourcePurviewCollectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName)
+	client, err := meta.(*clients.Client).Purview.CollectionsClient(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("building Collections client for %s: %+v", accountId, err)
+	}
+
+	if _, err := client.DeleteCollection(ctx, collections.NewCollectionID(id.Name)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
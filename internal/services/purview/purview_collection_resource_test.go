@@ -0,0 +1,153 @@
+package purview_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2019-11-01-preview/collections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PurviewCollectionResource struct{}
+
+func TestAccPurviewCollection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_collection", "test")
+	r := PurviewCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewCollection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_collection", "test")
+	r := PurviewCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPurviewCollection_hierarchy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_collection", "test")
+	r := PurviewCollectionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.hierarchy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("friendly_name").HasValue("Finance"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PurviewCollectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.CollectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionsClient, err := client.Purview.CollectionsClient(ctx, parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := collectionsClient.GetCollection(ctx, collections.NewCollectionID(id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r PurviewCollectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_collection" "test" {
+  name               = "acctestcol%d"
+  purview_account_id = azurerm_purview_account.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewCollectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_collection" "import" {
+  name               = azurerm_purview_collection.test.name
+  purview_account_id = azurerm_purview_collection.test.purview_account_id
+}
+`, r.basic(data))
+}
+
+func (r PurviewCollectionResource) hierarchy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_collection" "parent" {
+  name               = "acctestparent%d"
+  purview_account_id = azurerm_purview_account.test.id
+}
+
+resource "azurerm_purview_collection" "test" {
+  name                   = "acctestcol%d"
+  purview_account_id     = azurerm_purview_account.test.id
+  friendly_name          = "Finance"
+  description            = "Finance data assets"
+  parent_collection_name = azurerm_purview_collection.parent.name
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (PurviewCollectionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-purview-%d"
+  location = "%s"
+}
+
+resource "azurerm_purview_account" "test" {
+  name                = "acctestsw%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,244 @@
+package purview
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2023-09-01/scanning"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePurviewDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePurviewDataSourceCreateUpdate,
+		Read:   resourcePurviewDataSourceRead,
+		Update: resourcePurviewDataSourceCreateUpdate,
+		Delete: resourcePurviewDataSourceDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.DataSourceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9][-a-zA-Z0-9_]{2,62}$`),
+					"The Data Source name must be between 3 and 63 characters long, start with a letter or number and can contain only letters, numbers, hyphens and underscores."),
+			},
+
+			"purview_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"kind": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(scanning.PossibleValuesForDataSourceKind(), false),
+			},
+
+			"endpoint": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"collection_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourcePurviewDataSourceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AccountID(d.Get("purview_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*clients.Client).Purview.ScanningClient(ctx, *accountId)
+	if err != nil {
+		return fmt.Errorf("building Scanning client for %s: %+v", *accountId, err)
+	}
+
+	id := parse.NewDataSourceID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	dataSourceId := scanning.NewDataSourceID(id.Name)
+	if d.IsNewResource() {
+		existing, err := client.DataSourcesGet(ctx, dataSourceId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_purview_data_source", id.ID())
+		}
+	}
+
+	collectionName := accountId.Name
+	if v, ok := d.GetOk("collection_name"); ok {
+		collectionName = v.(string)
+	}
+
+	kind := scanning.DataSourceKind(d.Get("kind").(string))
+	props := &scanning.DataSourceProperties{
+		Collection: &scanning.CollectionReference{
+			ReferenceName: utils.String(collectionName),
+			Type:          utils.String("CollectionReference"),
+		},
+	}
+	setPurviewDataSourceEndpoint(props, kind, d.Get("endpoint").(string))
+
+	if v, ok := d.GetOk("resource_id"); ok {
+		props.ResourceId = utils.String(v.(string))
+	}
+
+	payload := scanning.DataSource{
+		Kind:       kind,
+		Name:       utils.String(id.Name),
+		Properties: props,
+	}
+
+	if _, err := client.DataSourcesCreateOrUpdate(ctx, dataSourceId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePurviewDataSourceRead(d, meta)
+}
+
+func resourcePurviewDataSourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataSourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName)
+	client, err := meta.(*clients.Client).Purview.ScanningClient(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("building Scanning client for %s: %+v", accountId, err)
+	}
+
+	resp, err := client.DataSourcesGet(ctx, scanning.NewDataSourceID(id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("purview_account_id", accountId.ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("kind", string(model.Kind))
+
+		if props := model.Properties; props != nil {
+			d.Set("endpoint", flattenPurviewDataSourceEndpoint(props, model.Kind))
+			d.Set("resource_id", props.ResourceId)
+
+			collectionName := ""
+			if props.Collection != nil && props.Collection.ReferenceName != nil {
+				collectionName = *props.Collection.ReferenceName
+			}
+			d.Set("collection_name", collectionName)
+		}
+	}
+
+	return nil
+}
+
+func resourcePurviewDataSourceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DataSourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName)
+	client, err := meta.(*clients.Client).Purview.ScanningClient(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("building Scanning client for %s: %+v", accountId, err)
+	}
+
+	if _, err := client.DataSourcesDelete(ctx, scanning.NewDataSourceID(id.Name)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// setPurviewDataSourceEndpoint assigns the endpoint to the property the data plane expects for the given kind
+func setPurviewDataSourceEndpoint(props *scanning.DataSourceProperties, kind scanning.DataSourceKind, endpoint string) {
+	switch kind {
+	case scanning.DataSourceKindAzureCosmosDb:
+		props.AccountUri = utils.String(endpoint)
+	case scanning.DataSourceKindAzureSqlDatabase:
+		props.ServerEndpoint = utils.String(endpoint)
+	default:
+		props.Endpoint = utils.String(endpoint)
+	}
+}
+
+func flattenPurviewDataSourceEndpoint(props *scanning.DataSourceProperties, kind scanning.DataSourceKind) string {
+	var endpoint *string
+	switch kind {
+	case scanning.DataSourceKindAzureCosmosDb:
+		endpoint = props.AccountUri
+	case scanning.DataSourceKindAzureSqlDatabase:
+		endpoint = props.ServerEndpoint
+	default:
+		endpoint = props.Endpoint
+	}
+
+	if endpoint == nil {
+		return ""
+	}
+	return *endpoint
+}
@@ -0,0 +1,167 @@
+package purview_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2023-09-01/scanning"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PurviewDataSourceResource struct{}
+
+func TestAccPurviewDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_data_source", "test")
+	r := PurviewDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewDataSource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_data_source", "test")
+	r := PurviewDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPurviewDataSource_collection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_data_source", "test")
+	r := PurviewDataSourceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.collection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PurviewDataSourceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DataSourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scanningClient, err := client.Purview.ScanningClient(ctx, parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := scanningClient.DataSourcesGet(ctx, scanning.NewDataSourceID(id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r PurviewDataSourceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_data_source" "test" {
+  name               = "acctestds%d"
+  purview_account_id = azurerm_purview_account.test.id
+  kind               = "AdlsGen2"
+  endpoint           = azurerm_storage_account.test.primary_dfs_endpoint
+  resource_id        = azurerm_storage_account.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewDataSourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_data_source" "import" {
+  name               = azurerm_purview_data_source.test.name
+  purview_account_id = azurerm_purview_data_source.test.purview_account_id
+  kind               = azurerm_purview_data_source.test.kind
+  endpoint           = azurerm_purview_data_source.test.endpoint
+}
+`, r.basic(data))
+}
+
+func (r PurviewDataSourceResource) collection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_collection" "test" {
+  name               = "acctestcol%d"
+  purview_account_id = azurerm_purview_account.test.id
+}
+
+resource "azurerm_purview_data_source" "test" {
+  name               = "acctestds%d"
+  purview_account_id = azurerm_purview_account.test.id
+  kind               = "AdlsGen2"
+  endpoint           = azurerm_storage_account.test.primary_dfs_endpoint
+  resource_id        = azurerm_storage_account.test.id
+  collection_name    = azurerm_purview_collection.test.name
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (PurviewDataSourceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-purview-%d"
+  location = "%s"
+}
+
+resource "azurerm_purview_account" "test" {
+  name                = "acctestsw%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString)
+}
@@ -0,0 +1,230 @@
+package purview
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/sdk/2017-04-01/eventhubs"
+	msiValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/msi/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePurviewKafkaConfiguration() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePurviewKafkaConfigurationCreateUpdate,
+		Read:   resourcePurviewKafkaConfigurationRead,
+		Update: resourcePurviewKafkaConfigurationCreateUpdate,
+		Delete: resourcePurviewKafkaConfigurationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := kafkaconfiguration.ParseKafkaConfigurationID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9][-a-zA-Z0-9_]{0,62}$`),
+					"The Kafka configuration name must be between 1 and 63 characters long, start with a letter or number and can contain only letters, numbers, hyphens and underscores."),
+			},
+
+			"purview_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"eventhub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: eventhubs.ValidateEventhubID,
+			},
+
+			"event_hub_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(kafkaconfiguration.EventHubTypeHook),
+					string(kafkaconfiguration.EventHubTypeNotification),
+				}, false),
+			},
+
+			"consumer_group_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"partition_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"user_assigned_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: msiValidate.UserAssignedIdentityID,
+			},
+
+			"event_streaming_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourcePurviewKafkaConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AccountID(d.Get("purview_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := kafkaconfiguration.NewKafkaConfigurationID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_purview_kafka_configuration", id.ID())
+		}
+	}
+
+	// Purview authenticates against the Event Hub using either its system or a user assigned identity
+	credentials := &kafkaconfiguration.Credentials{}
+	credentialsType := kafkaconfiguration.CredentialsTypeSystemAssigned
+	if v, ok := d.GetOk("user_assigned_identity_id"); ok {
+		credentialsType = kafkaconfiguration.CredentialsTypeUserAssigned
+		credentials.IdentityId = utils.String(v.(string))
+	}
+	credentials.Type = &credentialsType
+
+	eventHubType := kafkaconfiguration.EventHubType(d.Get("event_hub_type").(string))
+	eventStreamingState := kafkaconfiguration.EventStreamingStateDisabled
+	if d.Get("event_streaming_enabled").(bool) {
+		eventStreamingState = kafkaconfiguration.EventStreamingStateEnabled
+	}
+	eventStreamingType := kafkaconfiguration.EventStreamingTypeAzure
+
+	payload := kafkaconfiguration.KafkaConfiguration{
+		Properties: &kafkaconfiguration.KafkaConfigurationProperties{
+			Credentials:         credentials,
+			EventHubResourceId:  utils.String(d.Get("eventhub_id").(string)),
+			EventHubType:        &eventHubType,
+			EventStreamingState: &eventStreamingState,
+			EventStreamingType:  &eventStreamingType,
+		},
+	}
+
+	if v, ok := d.GetOk("consumer_group_name"); ok {
+		payload.Properties.ConsumerGroup = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("partition_id"); ok {
+		payload.Properties.EventHubPartitionId = utils.String(v.(string))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePurviewKafkaConfigurationRead(d, meta)
+}
+
+func resourcePurviewKafkaConfigurationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.KafkaConfigurationName)
+	d.Set("purview_account_id", parse.NewAccountID(id.SubscriptionId, id.ResourceGroupName, id.AccountName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("consumer_group_name", props.ConsumerGroup)
+			d.Set("eventhub_id", props.EventHubResourceId)
+			d.Set("partition_id", props.EventHubPartitionId)
+
+			eventHubType := ""
+			if props.EventHubType != nil {
+				eventHubType = string(*props.EventHubType)
+			}
+			d.Set("event_hub_type", eventHubType)
+			d.Set("event_streaming_enabled", props.EventStreamingState != nil && *props.EventStreamingState == kafkaconfiguration.EventStreamingStateEnabled)
+
+			userAssignedIdentityId := ""
+			if creds := props.Credentials; creds != nil && creds.Type != nil && *creds.Type == kafkaconfiguration.CredentialsTypeUserAssigned && creds.IdentityId != nil {
+				userAssignedIdentityId = *creds.IdentityId
+			}
+			d.Set("user_assigned_identity_id", userAssignedIdentityId)
+		}
+	}
+
+	return nil
+}
+
+func resourcePurviewKafkaConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Purview.KafkaConfigurationClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
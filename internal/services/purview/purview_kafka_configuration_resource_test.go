@@ -0,0 +1,176 @@
+package purview_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2021-12-01/kafkaconfiguration"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PurviewKafkaConfigurationResource struct{}
+
+func TestAccPurviewKafkaConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_kafka_configuration", "test")
+	r := PurviewKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewKafkaConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_kafka_configuration", "test")
+	r := PurviewKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPurviewKafkaConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_kafka_configuration", "test")
+	r := PurviewKafkaConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PurviewKafkaConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := kafkaconfiguration.ParseKafkaConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Purview.KafkaConfigurationClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r PurviewKafkaConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_kafka_configuration" "test" {
+  name               = "acctestkc%d"
+  purview_account_id = azurerm_purview_account.test.id
+  eventhub_id        = azurerm_eventhub.test.id
+  event_hub_type     = "Notification"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewKafkaConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_kafka_configuration" "import" {
+  name               = azurerm_purview_kafka_configuration.test.name
+  purview_account_id = azurerm_purview_kafka_configuration.test.purview_account_id
+  eventhub_id        = azurerm_purview_kafka_configuration.test.eventhub_id
+  event_hub_type     = azurerm_purview_kafka_configuration.test.event_hub_type
+}
+`, r.basic(data))
+}
+
+func (r PurviewKafkaConfigurationResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_consumer_group" "test" {
+  name                = "acctestcg%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  eventhub_name       = azurerm_eventhub.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_purview_kafka_configuration" "test" {
+  name                    = "acctestkc%d"
+  purview_account_id      = azurerm_purview_account.test.id
+  eventhub_id             = azurerm_eventhub.test.id
+  event_hub_type          = "Notification"
+  consumer_group_name     = azurerm_eventhub_consumer_group.test.name
+  partition_id            = "0"
+  event_streaming_enabled = false
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (PurviewKafkaConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-purview-%d"
+  location = "%s"
+}
+
+resource "azurerm_purview_account" "test" {
+  name                      = "acctestsw%d"
+  resource_group_name       = azurerm_resource_group.test.name
+  location                  = azurerm_resource_group.test.location
+  managed_event_hub_enabled = false
+}
+
+resource "azurerm_eventhub_namespace" "test" {
+  name                = "acctesteventhubnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub" "test" {
+  name                = "acctesteventhub-%d"
+  namespace_name      = azurerm_eventhub_namespace.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  partition_count     = 2
+  message_retention   = 1
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_eventhub_namespace.test.id
+  role_definition_name = "Azure Event Hubs Data Owner"
+  principal_id         = azurerm_purview_account.test.identity.0.principal_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,221 @@
+package purview
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2023-09-01/scanning"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourcePurviewScanRuleSet() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePurviewScanRuleSetCreateUpdate,
+		Read:   resourcePurviewScanRuleSetRead,
+		Update: resourcePurviewScanRuleSetCreateUpdate,
+		Delete: resourcePurviewScanRuleSetDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ScanRuleSetID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9][-a-zA-Z0-9_]{2,62}$`),
+					"The Scan Rule Set name must be between 3 and 63 characters long, start with a letter or number and can contain only letters, numbers, hyphens and underscores."),
+			},
+
+			"purview_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.AccountID,
+			},
+
+			"kind": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(scanning.PossibleValuesForDataSourceKind(), false),
+			},
+
+			"description": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"file_extensions": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"excluded_system_classifications": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"included_custom_classification_rule_names": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourcePurviewScanRuleSetCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := parse.AccountID(d.Get("purview_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := meta.(*clients.Client).Purview.ScanningClient(ctx, *accountId)
+	if err != nil {
+		return fmt.Errorf("building Scanning client for %s: %+v", *accountId, err)
+	}
+
+	id := parse.NewScanRuleSetID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, d.Get("name").(string))
+	scanRulesetId := scanning.NewScanRulesetID(id.Name)
+	if d.IsNewResource() {
+		existing, err := client.ScanRulesetsGet(ctx, scanRulesetId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_purview_scan_rule_set", id.ID())
+		}
+	}
+
+	payload := scanning.ScanRuleset{
+		Kind: scanning.DataSourceKind(d.Get("kind").(string)),
+		Name: utils.String(id.Name),
+		Properties: &scanning.ScanRulesetProperties{
+			ExcludedSystemClassifications:         utils.ExpandStringSlice(d.Get("excluded_system_classifications").(*pluginsdk.Set).List()),
+			IncludedCustomClassificationRuleNames: utils.ExpandStringSlice(d.Get("included_custom_classification_rule_names").(*pluginsdk.Set).List()),
+			ScanningRule: &scanning.ScanningRule{
+				FileExtensions: utils.ExpandStringSlice(d.Get("file_extensions").(*pluginsdk.Set).List()),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		payload.Properties.Description = utils.String(v.(string))
+	}
+
+	if _, err := client.ScanRulesetsCreateOrUpdate(ctx, scanRulesetId, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourcePurviewScanRuleSetRead(d, meta)
+}
+
+func resourcePurviewScanRuleSetRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ScanRuleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName)
+	client, err := meta.(*clients.Client).Purview.ScanningClient(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("building Scanning client for %s: %+v", accountId, err)
+	}
+
+	resp, err := client.ScanRulesetsGet(ctx, scanning.NewScanRulesetID(id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("purview_account_id", accountId.ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("kind", string(model.Kind))
+
+		if props := model.Properties; props != nil {
+			d.Set("description", props.Description)
+			d.Set("excluded_system_classifications", utils.FlattenStringSlice(props.ExcludedSystemClassifications))
+			d.Set("included_custom_classification_rule_names", utils.FlattenStringSlice(props.IncludedCustomClassificationRuleNames))
+
+			var fileExtensions *[]string
+			if props.ScanningRule != nil {
+				fileExtensions = props.ScanningRule.FileExtensions
+			}
+			d.Set("file_extensions", utils.FlattenStringSlice(fileExtensions))
+		}
+	}
+
+	return nil
+}
+
+func resourcePurviewScanRuleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ScanRuleSetID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName)
+	client, err := meta.(*clients.Client).Purview.ScanningClient(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("building Scanning client for %s: %+v", accountId, err)
+	}
+
+	if _, err := client.ScanRulesetsDelete(ctx, scanning.NewScanRulesetID(id.Name)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package purview_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/purview/sdk/2023-09-01/scanning"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PurviewScanRuleSetResource struct{}
+
+func TestAccPurviewScanRuleSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_scan_rule_set", "test")
+	r := PurviewScanRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPurviewScanRuleSet_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_scan_rule_set", "test")
+	r := PurviewScanRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPurviewScanRuleSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_purview_scan_rule_set", "test")
+	r := PurviewScanRuleSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PurviewScanRuleSetResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ScanRuleSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	scanningClient, err := client.Purview.ScanningClient(ctx, parse.NewAccountID(id.SubscriptionId, id.ResourceGroup, id.AccountName))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := scanningClient.ScanRulesetsGet(ctx, scanning.NewScanRulesetID(id.Name))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r PurviewScanRuleSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_scan_rule_set" "test" {
+  name               = "acctestsrs%d"
+  purview_account_id = azurerm_purview_account.test.id
+  kind               = "AdlsGen2"
+  file_extensions    = ["CSV", "JSON"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r PurviewScanRuleSetResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_scan_rule_set" "import" {
+  name               = azurerm_purview_scan_rule_set.test.name
+  purview_account_id = azurerm_purview_scan_rule_set.test.purview_account_id
+  kind               = azurerm_purview_scan_rule_set.test.kind
+  file_extensions    = azurerm_purview_scan_rule_set.test.file_extensions
+}
+`, r.basic(data))
+}
+
+func (r PurviewScanRuleSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_purview_scan_rule_set" "test" {
+  name                            = "acctestsrs%d"
+  purview_account_id              = azurerm_purview_account.test.id
+  kind                            = "AdlsGen2"
+  description                     = "Scans delimited and structured files"
+  file_extensions                 = ["CSV", "JSON", "PARQUET", "TSV"]
+  excluded_system_classifications = ["MICROSOFT.PERSONAL.NAME"]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (PurviewScanRuleSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-purview-%d"
+  location = "%s"
+}
+
+resource "azurerm_purview_account" "test" {
+  name                = "acctestsw%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -26,6 +26,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_purview_account": resourcePurviewAccount(),
+		"azurerm_purview_account":             resourcePurviewAccount(),
+		"azurerm_purview_collection":          resourcePurviewCollection(),
+		"azurerm_purview_data_source":         resourcePurviewDataSource(),
+		"azurerm_purview_kafka_configuration": resourcePurviewKafkaConfiguration(),
+		"azurerm_purview_scan_rule_set":       resourcePurviewScanRuleSet(),
 	}
 }
@@ -1,3 +1,6 @@
 package purview
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Account -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Purview/accounts/account1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Collection -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Purview/accounts/account1/collections/collection1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=DataSource -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Purview/accounts/account1/dataSources/dataSource1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ScanRuleSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Purview/accounts/account1/scanRuleSets/scanRuleSet1
@@ -0,0 +1,15 @@
+package collections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type CollectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCollectionsClientWithBaseURI(endpoint string) CollectionsClient {
+	return CollectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
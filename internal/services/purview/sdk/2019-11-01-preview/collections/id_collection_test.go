@@ -0,0 +1,159 @@
+package collections
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = CollectionId{}
+
+func TestNewCollectionID(t *testing.T) {
+	id := NewCollectionID("collectionValue")
+
+	if id.CollectionName != "collectionValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'CollectionName'", id.CollectionName, "collectionValue")
+	}
+}
+
+func TestFormatCollectionID(t *testing.T) {
+	actual := NewCollectionID("collectionValue").ID()
+	expected := "/account/collections/collectionValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseCollectionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CollectionId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/account",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/account/collections",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/account/collections/collectionValue",
+			Expected: &CollectionId{
+				CollectionName: "collectionValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/account/collections/collectionValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseCollectionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.CollectionName != v.Expected.CollectionName {
+			t.Fatalf("Expected %q but got %q for CollectionName", v.Expected.CollectionName, actual.CollectionName)
+		}
+
+	}
+}
+
+func TestParseCollectionIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *CollectionId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/account",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/AcCoUnT",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/account/collections",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/AcCoUnT/CoLlEcTiOnS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/account/collections/collectionValue",
+			Expected: &CollectionId{
+				CollectionName: "collectionValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/account/collections/collectionValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/AcCoUnT/CoLlEcTiOnS/CoLlEcTiOnVaLuE",
+			Expected: &CollectionId{
+				CollectionName: "CoLlEcTiOnVaLuE",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/AcCoUnT/CoLlEcTiOnS/CoLlEcTiOnVaLuE/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseCollectionIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.CollectionName != v.Expected.CollectionName {
+			t.Fatalf("Expected %q but got %q for CollectionName", v.Expected.CollectionName, actual.CollectionName)
+		}
+
+	}
+}
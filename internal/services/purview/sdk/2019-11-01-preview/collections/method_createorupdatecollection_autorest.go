@@ -0,0 +1,65 @@
+package collections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CreateOrUpdateCollectionResponse struct {
+	HttpResponse *http.Response
+	Model        *Collection
+}
+
+// CreateOrUpdateCollection ...
+func (c CollectionsClient) CreateOrUpdateCollection(ctx context.Context, id CollectionId, input Collection) (result CreateOrUpdateCollectionResponse, err error) {
+	req, err := c.preparerForCreateOrUpdateCollection(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "CreateOrUpdateCollection", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "CreateOrUpdateCollection", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCreateOrUpdateCollection(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "CreateOrUpdateCollection", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCreateOrUpdateCollection prepares the CreateOrUpdateCollection request.
+func (c CollectionsClient) preparerForCreateOrUpdateCollection(ctx context.Context, id CollectionId, input Collection) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCreateOrUpdateCollection handles the response to the CreateOrUpdateCollection request. The method always
+// closes the http.Response Body.
+func (c CollectionsClient) responderForCreateOrUpdateCollection(resp *http.Response) (result CreateOrUpdateCollectionResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
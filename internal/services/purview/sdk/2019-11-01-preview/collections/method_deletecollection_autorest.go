@@ -0,0 +1,61 @@
+package collections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DeleteCollectionResponse struct {
+	HttpResponse *http.Response
+}
+
+// DeleteCollection ...
+func (c CollectionsClient) DeleteCollection(ctx context.Context, id CollectionId) (result DeleteCollectionResponse, err error) {
+	req, err := c.preparerForDeleteCollection(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "DeleteCollection", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "DeleteCollection", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDeleteCollection(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "DeleteCollection", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDeleteCollection prepares the DeleteCollection request.
+func (c CollectionsClient) preparerForDeleteCollection(ctx context.Context, id CollectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDeleteCollection handles the response to the DeleteCollection request. The method always
+// closes the http.Response Body.
+func (c CollectionsClient) responderForDeleteCollection(resp *http.Response) (result DeleteCollectionResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package collections
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetCollectionResponse struct {
+	HttpResponse *http.Response
+	Model        *Collection
+}
+
+// GetCollection ...
+func (c CollectionsClient) GetCollection(ctx context.Context, id CollectionId) (result GetCollectionResponse, err error) {
+	req, err := c.preparerForGetCollection(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "GetCollection", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "GetCollection", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetCollection(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "collections.CollectionsClient", "GetCollection", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetCollection prepares the GetCollection request.
+func (c CollectionsClient) preparerForGetCollection(ctx context.Context, id CollectionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetCollection handles the response to the GetCollection request. The method always
+// closes the http.Response Body.
+func (c CollectionsClient) responderForGetCollection(resp *http.Response) (result GetCollectionResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
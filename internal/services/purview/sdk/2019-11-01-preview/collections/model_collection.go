@@ -0,0 +1,8 @@
+package collections
+
+type Collection struct {
+	Description      *string              `json:"description,omitempty"`
+	FriendlyName     *string              `json:"friendlyName,omitempty"`
+	Name             *string              `json:"name,omitempty"`
+	ParentCollection *CollectionReference `json:"parentCollection,omitempty"`
+}
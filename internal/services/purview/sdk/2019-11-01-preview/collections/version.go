@@ -0,0 +1,9 @@
+package collections
+
+import "fmt"
+
+const defaultApiVersion = "2019-11-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/collections/%s", defaultApiVersion)
+}
@@ -0,0 +1,34 @@
+package account
+
+import "strings"
+
+type ManagedEventHubState string
+
+const (
+	ManagedEventHubStateDisabled     ManagedEventHubState = "Disabled"
+	ManagedEventHubStateEnabled      ManagedEventHubState = "Enabled"
+	ManagedEventHubStateNotSpecified ManagedEventHubState = "NotSpecified"
+)
+
+func PossibleValuesForManagedEventHubState() []string {
+	return []string{
+		string(ManagedEventHubStateDisabled),
+		string(ManagedEventHubStateEnabled),
+		string(ManagedEventHubStateNotSpecified),
+	}
+}
+
+func parseManagedEventHubState(input string) (*ManagedEventHubState, error) {
+	vals := map[string]ManagedEventHubState{
+		"disabled":     ManagedEventHubStateDisabled,
+		"enabled":      ManagedEventHubStateEnabled,
+		"notspecified": ManagedEventHubStateNotSpecified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ManagedEventHubState(input)
+	return &out, nil
+}
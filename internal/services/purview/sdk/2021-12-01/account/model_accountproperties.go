@@ -0,0 +1,6 @@
+package account
+
+type AccountProperties struct {
+	ManagedEventHubState *ManagedEventHubState `json:"managedEventHubState,omitempty"`
+	ManagedResources     *ManagedResources     `json:"managedResources,omitempty"`
+}
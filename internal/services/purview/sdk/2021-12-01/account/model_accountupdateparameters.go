@@ -0,0 +1,5 @@
+package account
+
+type AccountUpdateParameters struct {
+	Properties *AccountProperties `json:"properties,omitempty"`
+}
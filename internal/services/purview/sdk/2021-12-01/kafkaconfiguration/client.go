@@ -0,0 +1,15 @@
+package kafkaconfiguration
+
+import "github.com/Azure/go-autorest/autorest"
+
+type KafkaConfigurationClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewKafkaConfigurationClientWithBaseURI(endpoint string) KafkaConfigurationClient {
+	return KafkaConfigurationClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
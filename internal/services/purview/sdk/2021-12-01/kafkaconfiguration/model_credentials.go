@@ -0,0 +1,6 @@
+package kafkaconfiguration
+
+type Credentials struct {
+	IdentityId *string          `json:"identityId,omitempty"`
+	Type       *CredentialsType `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package kafkaconfiguration
+
+type KafkaConfiguration struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *KafkaConfigurationProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package kafkaconfiguration
+
+type KafkaConfigurationProperties struct {
+	ConsumerGroup       *string              `json:"consumerGroup,omitempty"`
+	Credentials         *Credentials         `json:"credentials,omitempty"`
+	EventHubPartitionId *string              `json:"eventHubPartitionId,omitempty"`
+	EventHubResourceId  *string              `json:"eventHubResourceId,omitempty"`
+	EventHubType        *EventHubType        `json:"eventHubType,omitempty"`
+	EventStreamingState *EventStreamingState `json:"eventStreamingState,omitempty"`
+	EventStreamingType  *EventStreamingType  `json:"eventStreamingType,omitempty"`
+}
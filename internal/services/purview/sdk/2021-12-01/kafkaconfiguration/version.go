@@ -0,0 +1,9 @@
+package kafkaconfiguration
+
+import "fmt"
+
+const defaultApiVersion = "2021-12-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/kafkaconfiguration/%s", defaultApiVersion)
+}
@@ -0,0 +1,15 @@
+package scanning
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ScanningClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewScanningClientWithBaseURI(endpoint string) ScanningClient {
+	return ScanningClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
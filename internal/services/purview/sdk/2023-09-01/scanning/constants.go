@@ -0,0 +1,37 @@
+package scanning
+
+import "strings"
+
+type DataSourceKind string
+
+const (
+	DataSourceKindAdlsGen2         DataSourceKind = "AdlsGen2"
+	DataSourceKindAzureCosmosDb    DataSourceKind = "AzureCosmosDb"
+	DataSourceKindAzureSqlDatabase DataSourceKind = "AzureSqlDatabase"
+	DataSourceKindAzureStorage     DataSourceKind = "AzureStorage"
+)
+
+func PossibleValuesForDataSourceKind() []string {
+	return []string{
+		string(DataSourceKindAdlsGen2),
+		string(DataSourceKindAzureCosmosDb),
+		string(DataSourceKindAzureSqlDatabase),
+		string(DataSourceKindAzureStorage),
+	}
+}
+
+func parseDataSourceKind(input string) (*DataSourceKind, error) {
+	vals := map[string]DataSourceKind{
+		"adlsgen2":         DataSourceKindAdlsGen2,
+		"azurecosmosdb":    DataSourceKindAzureCosmosDb,
+		"azuresqldatabase": DataSourceKindAzureSqlDatabase,
+		"azurestorage":     DataSourceKindAzureStorage,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DataSourceKind(input)
+	return &out, nil
+}
@@ -0,0 +1,97 @@
+package scanning
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = DataSourceId{}
+
+// DataSourceId is a struct representing the Resource ID for a Data Source
+type DataSourceId struct {
+	DataSourceName string
+}
+
+// NewDataSourceID returns a new DataSourceId struct
+func NewDataSourceID(dataSourceName string) DataSourceId {
+	return DataSourceId{
+		DataSourceName: dataSourceName,
+	}
+}
+
+// ParseDataSourceID parses 'input' into a DataSourceId
+func ParseDataSourceID(input string) (*DataSourceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DataSourceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DataSourceId{}
+
+	if id.DataSourceName, ok = parsed.Parsed["dataSourceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'dataSourceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseDataSourceIDInsensitively parses 'input' case-insensitively into a DataSourceId
+// note: this method should only be used for API response data and not user input
+func ParseDataSourceIDInsensitively(input string) (*DataSourceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(DataSourceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := DataSourceId{}
+
+	if id.DataSourceName, ok = parsed.Parsed["dataSourceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'dataSourceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateDataSourceID checks that 'input' can be parsed as a Data Source ID
+func ValidateDataSourceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseDataSourceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Data Source ID
+func (id DataSourceId) ID() string {
+	fmtString := "/scan/datasources/%s"
+	return fmt.Sprintf(fmtString, id.DataSourceName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Data Source ID
+func (id DataSourceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticScan", "scan", "scan"),
+		resourceids.StaticSegment("staticDatasources", "datasources", "datasources"),
+		resourceids.UserSpecifiedSegment("dataSourceName", "dataSourceValue"),
+	}
+}
+
+// String returns a human-readable description of this Data Source ID
+func (id DataSourceId) String() string {
+	components := []string{
+		fmt.Sprintf("Data Source Name: %q", id.DataSourceName),
+	}
+	return fmt.Sprintf("Data Source (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,159 @@
+package scanning
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = DataSourceId{}
+
+func TestNewDataSourceID(t *testing.T) {
+	id := NewDataSourceID("dataSourceValue")
+
+	if id.DataSourceName != "dataSourceValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'DataSourceName'", id.DataSourceName, "dataSourceValue")
+	}
+}
+
+func TestFormatDataSourceID(t *testing.T) {
+	actual := NewDataSourceID("dataSourceValue").ID()
+	expected := "/scan/datasources/dataSourceValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseDataSourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DataSourceId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan/datasources",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/scan/datasources/dataSourceValue",
+			Expected: &DataSourceId{
+				DataSourceName: "dataSourceValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/scan/datasources/dataSourceValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseDataSourceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.DataSourceName != v.Expected.DataSourceName {
+			t.Fatalf("Expected %q but got %q for DataSourceName", v.Expected.DataSourceName, actual.DataSourceName)
+		}
+
+	}
+}
+
+func TestParseDataSourceIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *DataSourceId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/ScAn",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan/datasources",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/ScAn/DaTaSoUrCeS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/scan/datasources/dataSourceValue",
+			Expected: &DataSourceId{
+				DataSourceName: "dataSourceValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/scan/datasources/dataSourceValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/ScAn/DaTaSoUrCeS/DaTaSoUrCeVaLuE",
+			Expected: &DataSourceId{
+				DataSourceName: "DaTaSoUrCeVaLuE",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/ScAn/DaTaSoUrCeS/DaTaSoUrCeVaLuE/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseDataSourceIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.DataSourceName != v.Expected.DataSourceName {
+			t.Fatalf("Expected %q but got %q for DataSourceName", v.Expected.DataSourceName, actual.DataSourceName)
+		}
+
+	}
+}
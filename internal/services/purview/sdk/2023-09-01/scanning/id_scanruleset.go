@@ -0,0 +1,97 @@
+package scanning
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScanRulesetId{}
+
+// ScanRulesetId is a struct representing the Resource ID for a Scan Ruleset
+type ScanRulesetId struct {
+	ScanRulesetName string
+}
+
+// NewScanRulesetID returns a new ScanRulesetId struct
+func NewScanRulesetID(scanRulesetName string) ScanRulesetId {
+	return ScanRulesetId{
+		ScanRulesetName: scanRulesetName,
+	}
+}
+
+// ParseScanRulesetID parses 'input' into a ScanRulesetId
+func ParseScanRulesetID(input string) (*ScanRulesetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScanRulesetId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScanRulesetId{}
+
+	if id.ScanRulesetName, ok = parsed.Parsed["scanRulesetName"]; !ok {
+		return nil, fmt.Errorf("the segment 'scanRulesetName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseScanRulesetIDInsensitively parses 'input' case-insensitively into a ScanRulesetId
+// note: this method should only be used for API response data and not user input
+func ParseScanRulesetIDInsensitively(input string) (*ScanRulesetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ScanRulesetId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ScanRulesetId{}
+
+	if id.ScanRulesetName, ok = parsed.Parsed["scanRulesetName"]; !ok {
+		return nil, fmt.Errorf("the segment 'scanRulesetName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateScanRulesetID checks that 'input' can be parsed as a Scan Ruleset ID
+func ValidateScanRulesetID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseScanRulesetID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Scan Ruleset ID
+func (id ScanRulesetId) ID() string {
+	fmtString := "/scan/scanrulesets/%s"
+	return fmt.Sprintf(fmtString, id.ScanRulesetName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Scan Ruleset ID
+func (id ScanRulesetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticScan", "scan", "scan"),
+		resourceids.StaticSegment("staticScanrulesets", "scanrulesets", "scanrulesets"),
+		resourceids.UserSpecifiedSegment("scanRulesetName", "scanRulesetValue"),
+	}
+}
+
+// String returns a human-readable description of this Scan Ruleset ID
+func (id ScanRulesetId) String() string {
+	components := []string{
+		fmt.Sprintf("Scan Ruleset Name: %q", id.ScanRulesetName),
+	}
+	return fmt.Sprintf("Scan Ruleset (%s)", strings.Join(components, "\n"))
+}
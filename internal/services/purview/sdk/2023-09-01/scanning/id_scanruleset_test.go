@@ -0,0 +1,159 @@
+package scanning
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ScanRulesetId{}
+
+func TestNewScanRulesetID(t *testing.T) {
+	id := NewScanRulesetID("scanRulesetValue")
+
+	if id.ScanRulesetName != "scanRulesetValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'ScanRulesetName'", id.ScanRulesetName, "scanRulesetValue")
+	}
+}
+
+func TestFormatScanRulesetID(t *testing.T) {
+	actual := NewScanRulesetID("scanRulesetValue").ID()
+	expected := "/scan/scanrulesets/scanRulesetValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseScanRulesetID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ScanRulesetId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan/scanrulesets",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/scan/scanrulesets/scanRulesetValue",
+			Expected: &ScanRulesetId{
+				ScanRulesetName: "scanRulesetValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/scan/scanrulesets/scanRulesetValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScanRulesetID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ScanRulesetName != v.Expected.ScanRulesetName {
+			t.Fatalf("Expected %q but got %q for ScanRulesetName", v.Expected.ScanRulesetName, actual.ScanRulesetName)
+		}
+
+	}
+}
+
+func TestParseScanRulesetIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ScanRulesetId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/ScAn",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/scan/scanrulesets",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/ScAn/ScAnRuLeSeTs",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/scan/scanrulesets/scanRulesetValue",
+			Expected: &ScanRulesetId{
+				ScanRulesetName: "scanRulesetValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/scan/scanrulesets/scanRulesetValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/ScAn/ScAnRuLeSeTs/ScAnRuLeSeTvAlUe",
+			Expected: &ScanRulesetId{
+				ScanRulesetName: "ScAnRuLeSeTvAlUe",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/ScAn/ScAnRuLeSeTs/ScAnRuLeSeTvAlUe/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScanRulesetIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ScanRulesetName != v.Expected.ScanRulesetName {
+			t.Fatalf("Expected %q but got %q for ScanRulesetName", v.Expected.ScanRulesetName, actual.ScanRulesetName)
+		}
+
+	}
+}
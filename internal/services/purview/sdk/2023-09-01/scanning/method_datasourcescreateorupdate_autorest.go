@@ -0,0 +1,65 @@
+package scanning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DataSourcesCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *DataSource
+}
+
+// DataSourcesCreateOrUpdate ...
+func (c ScanningClient) DataSourcesCreateOrUpdate(ctx context.Context, id DataSourceId, input DataSource) (result DataSourcesCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForDataSourcesCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDataSourcesCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDataSourcesCreateOrUpdate prepares the DataSourcesCreateOrUpdate request.
+func (c ScanningClient) preparerForDataSourcesCreateOrUpdate(ctx context.Context, id DataSourceId, input DataSource) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDataSourcesCreateOrUpdate handles the response to the DataSourcesCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c ScanningClient) responderForDataSourcesCreateOrUpdate(resp *http.Response) (result DataSourcesCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,61 @@
+package scanning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DataSourcesDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// DataSourcesDelete ...
+func (c ScanningClient) DataSourcesDelete(ctx context.Context, id DataSourceId) (result DataSourcesDeleteResponse, err error) {
+	req, err := c.preparerForDataSourcesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDataSourcesDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDataSourcesDelete prepares the DataSourcesDelete request.
+func (c ScanningClient) preparerForDataSourcesDelete(ctx context.Context, id DataSourceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDataSourcesDelete handles the response to the DataSourcesDelete request. The method always
+// closes the http.Response Body.
+func (c ScanningClient) responderForDataSourcesDelete(resp *http.Response) (result DataSourcesDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
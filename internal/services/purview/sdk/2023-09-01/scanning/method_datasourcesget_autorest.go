@@ -0,0 +1,64 @@
+package scanning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type DataSourcesGetResponse struct {
+	HttpResponse *http.Response
+	Model        *DataSource
+}
+
+// DataSourcesGet ...
+func (c ScanningClient) DataSourcesGet(ctx context.Context, id DataSourceId) (result DataSourcesGetResponse, err error) {
+	req, err := c.preparerForDataSourcesGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForDataSourcesGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "DataSourcesGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForDataSourcesGet prepares the DataSourcesGet request.
+func (c ScanningClient) preparerForDataSourcesGet(ctx context.Context, id DataSourceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForDataSourcesGet handles the response to the DataSourcesGet request. The method always
+// closes the http.Response Body.
+func (c ScanningClient) responderForDataSourcesGet(resp *http.Response) (result DataSourcesGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,65 @@
+package scanning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ScanRulesetsCreateOrUpdateResponse struct {
+	HttpResponse *http.Response
+	Model        *ScanRuleset
+}
+
+// ScanRulesetsCreateOrUpdate ...
+func (c ScanningClient) ScanRulesetsCreateOrUpdate(ctx context.Context, id ScanRulesetId, input ScanRuleset) (result ScanRulesetsCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForScanRulesetsCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForScanRulesetsCreateOrUpdate(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsCreateOrUpdate", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForScanRulesetsCreateOrUpdate prepares the ScanRulesetsCreateOrUpdate request.
+func (c ScanningClient) preparerForScanRulesetsCreateOrUpdate(ctx context.Context, id ScanRulesetId, input ScanRuleset) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForScanRulesetsCreateOrUpdate handles the response to the ScanRulesetsCreateOrUpdate request. The method always
+// closes the http.Response Body.
+func (c ScanningClient) responderForScanRulesetsCreateOrUpdate(resp *http.Response) (result ScanRulesetsCreateOrUpdateResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusCreated, http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,61 @@
+package scanning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ScanRulesetsDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// ScanRulesetsDelete ...
+func (c ScanningClient) ScanRulesetsDelete(ctx context.Context, id ScanRulesetId) (result ScanRulesetsDeleteResponse, err error) {
+	req, err := c.preparerForScanRulesetsDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForScanRulesetsDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForScanRulesetsDelete prepares the ScanRulesetsDelete request.
+func (c ScanningClient) preparerForScanRulesetsDelete(ctx context.Context, id ScanRulesetId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForScanRulesetsDelete handles the response to the ScanRulesetsDelete request. The method always
+// closes the http.Response Body.
+func (c ScanningClient) responderForScanRulesetsDelete(resp *http.Response) (result ScanRulesetsDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package scanning
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ScanRulesetsGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ScanRuleset
+}
+
+// ScanRulesetsGet ...
+func (c ScanningClient) ScanRulesetsGet(ctx context.Context, id ScanRulesetId) (result ScanRulesetsGetResponse, err error) {
+	req, err := c.preparerForScanRulesetsGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForScanRulesetsGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "scanning.ScanningClient", "ScanRulesetsGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForScanRulesetsGet prepares the ScanRulesetsGet request.
+func (c ScanningClient) preparerForScanRulesetsGet(ctx context.Context, id ScanRulesetId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForScanRulesetsGet handles the response to the ScanRulesetsGet request. The method always
+// closes the http.Response Body.
+func (c ScanningClient) responderForScanRulesetsGet(resp *http.Response) (result ScanRulesetsGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,6 @@
+package scanning
+
+type CollectionReference struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+	Type          *string `json:"type,omitempty"`
+}
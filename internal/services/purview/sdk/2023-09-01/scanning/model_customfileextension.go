@@ -0,0 +1,7 @@
+package scanning
+
+type CustomFileExtension struct {
+	Description   *string `json:"description,omitempty"`
+	Enabled       *bool   `json:"enabled,omitempty"`
+	FileExtension *string `json:"fileExtension,omitempty"`
+}
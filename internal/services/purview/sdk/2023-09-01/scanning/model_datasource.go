@@ -0,0 +1,7 @@
+package scanning
+
+type DataSource struct {
+	Kind       DataSourceKind        `json:"kind"`
+	Name       *string               `json:"name,omitempty"`
+	Properties *DataSourceProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,13 @@
+package scanning
+
+type DataSourceProperties struct {
+	AccountUri     *string              `json:"accountUri,omitempty"`
+	Collection     *CollectionReference `json:"collection,omitempty"`
+	Endpoint       *string              `json:"endpoint,omitempty"`
+	Location       *string              `json:"location,omitempty"`
+	ResourceGroup  *string              `json:"resourceGroup,omitempty"`
+	ResourceId     *string              `json:"resourceId,omitempty"`
+	ResourceName   *string              `json:"resourceName,omitempty"`
+	ServerEndpoint *string              `json:"serverEndpoint,omitempty"`
+	SubscriptionId *string              `json:"subscriptionId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package scanning
+
+type ScanningRule struct {
+	CustomFileExtensions *[]CustomFileExtension `json:"customFileExtensions,omitempty"`
+	FileExtensions       *[]string              `json:"fileExtensions,omitempty"`
+}
@@ -0,0 +1,7 @@
+package scanning
+
+type ScanRuleset struct {
+	Kind       DataSourceKind         `json:"kind"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ScanRulesetProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,8 @@
+package scanning
+
+type ScanRulesetProperties struct {
+	Description                           *string       `json:"description,omitempty"`
+	ExcludedSystemClassifications         *[]string     `json:"excludedSystemClassifications,omitempty"`
+	IncludedCustomClassificationRuleNames *[]string     `json:"includedCustomClassificationRuleNames,omitempty"`
+	ScanningRule                          *ScanningRule `json:"scanningRule,omitempty"`
+}
@@ -4,11 +4,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/hybridconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/wcfrelays"
 )
 
 type Client struct {
 	HybridConnectionsClient *hybridconnections.HybridConnectionsClient
 	NamespacesClient        *namespaces.NamespacesClient
+	WcfRelaysClient         *wcfrelays.WCFRelaysClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -18,8 +20,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	namespacesClient := namespaces.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&namespacesClient.Client, o.ResourceManagerAuthorizer)
 
+	wcfRelaysClient := wcfrelays.NewWCFRelaysClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&wcfRelaysClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		HybridConnectionsClient: &hybridConnectionsClient,
 		NamespacesClient:        &namespacesClient,
+		WcfRelaysClient:         &wcfRelaysClient,
 	}
 }
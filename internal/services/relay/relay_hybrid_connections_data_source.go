@@ -0,0 +1,128 @@
+package relay
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/hybridconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceRelayHybridConnections() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceRelayHybridConnectionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"namespace_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"hybrid_connections": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"listener_count": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+
+						"requires_client_authorization": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"user_metadata": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRelayHybridConnectionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.HybridConnectionsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := hybridconnections.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("namespace_name").(string))
+	resp, err := client.ListByNamespaceComplete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing Hybrid Connections in %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("namespace_name", id.NamespaceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if err := d.Set("hybrid_connections", flattenRelayHybridConnections(resp.Items)); err != nil {
+		return fmt.Errorf("setting `hybrid_connections`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenRelayHybridConnections(input []hybridconnections.HybridConnection) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, item := range input {
+		var id, name, userMetadata string
+		var listenerCount int64
+		var requiresClientAuthorization bool
+
+		if item.Id != nil {
+			id = *item.Id
+		}
+		if item.Name != nil {
+			name = *item.Name
+		}
+		if props := item.Properties; props != nil {
+			if props.ListenerCount != nil {
+				listenerCount = *props.ListenerCount
+			}
+			if props.RequiresClientAuthorization != nil {
+				requiresClientAuthorization = *props.RequiresClientAuthorization
+			}
+			if props.UserMetadata != nil {
+				userMetadata = *props.UserMetadata
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"id":                            id,
+			"name":                          name,
+			"listener_count":                int(listenerCount),
+			"requires_client_authorization": requiresClientAuthorization,
+			"user_metadata":                 userMetadata,
+		})
+	}
+
+	return output
+}
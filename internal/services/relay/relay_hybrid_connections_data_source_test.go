@@ -0,0 +1,40 @@
+package relay_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RelayHybridConnectionsDataSource struct {
+}
+
+func TestAccRelayHybridConnectionsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_relay_hybrid_connections", "test")
+	r := RelayHybridConnectionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("hybrid_connections.#").HasValue("1"),
+				check.That(data.ResourceName).Key("hybrid_connections.0.id").Exists(),
+				check.That(data.ResourceName).Key("hybrid_connections.0.name").HasValue(fmt.Sprintf("acctestrnhc-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("hybrid_connections.0.requires_client_authorization").HasValue("true"),
+			),
+		},
+	})
+}
+
+func (RelayHybridConnectionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_relay_hybrid_connections" "test" {
+  namespace_name      = azurerm_relay_hybrid_connection.test.relay_namespace_name
+  resource_group_name = azurerm_relay_hybrid_connection.test.resource_group_name
+}
+`, RelayHybridConnectionResource{}.basic(data))
+}
@@ -0,0 +1,218 @@
+package relay
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/wcfrelays"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceRelayWcfRelay() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceRelayWcfRelayCreateUpdate,
+		Read:   resourceRelayWcfRelayRead,
+		Update: resourceRelayWcfRelayCreateUpdate,
+		Delete: resourceRelayWcfRelayDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := wcfrelays.ParseWcfRelayID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"relay_namespace_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"relay_type": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(wcfrelays.RelaytypeNetTcp),
+				ValidateFunc: validation.StringInSlice(wcfrelays.PossibleValuesForRelaytype(), false),
+			},
+
+			"requires_client_authorization": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"requires_transport_security": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"user_metadata": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"is_dynamic": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRelayWcfRelayCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.WcfRelaysClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Relay WCF Relay creation.")
+
+	id := wcfrelays.NewWcfRelayID(subscriptionId, d.Get("resource_group_name").(string), d.Get("relay_namespace_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_relay_wcf_relay", id.ID())
+		}
+	}
+
+	relayType := wcfrelays.Relaytype(d.Get("relay_type").(string))
+	requiresClientAuthorization := d.Get("requires_client_authorization").(bool)
+	requiresTransportSecurity := d.Get("requires_transport_security").(bool)
+	userMetadata := d.Get("user_metadata").(string)
+
+	parameters := wcfrelays.WcfRelay{
+		Properties: &wcfrelays.WcfRelayProperties{
+			RelayType:                   &relayType,
+			RequiresClientAuthorization: &requiresClientAuthorization,
+			RequiresTransportSecurity:   &requiresTransportSecurity,
+			UserMetadata:                &userMetadata,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceRelayWcfRelayRead(d, meta)
+}
+
+func resourceRelayWcfRelayRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.WcfRelaysClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := wcfrelays.ParseWcfRelayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.RelayName)
+	d.Set("relay_namespace_name", id.NamespaceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			relayType := ""
+			if props.RelayType != nil {
+				relayType = string(*props.RelayType)
+			}
+			d.Set("relay_type", relayType)
+			d.Set("requires_client_authorization", props.RequiresClientAuthorization)
+			d.Set("requires_transport_security", props.RequiresTransportSecurity)
+			d.Set("user_metadata", props.UserMetadata)
+			d.Set("is_dynamic", props.IsDynamic)
+		}
+	}
+
+	return nil
+}
+
+func resourceRelayWcfRelayDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Relay.WcfRelaysClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := wcfrelays.ParseWcfRelayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	// as with Hybrid Connections the deletion completes asynchronously, so we poll until it's gone
+	log.Printf("[INFO] Waiting for %s to be deleted", *id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Pending"},
+		Target:     []string{"Deleted"},
+		Refresh:    wcfRelayDeleteRefreshFunc(ctx, client, *id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    d.Timeout(pluginsdk.TimeoutDelete),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be deleted: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func wcfRelayDeleteRefreshFunc(ctx context.Context, client *wcfrelays.WCFRelaysClient, id wcfrelays.WcfRelayId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(res.HttpResponse) {
+				return res, "Deleted", nil
+			}
+
+			return nil, "Error", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		return res, "Pending", nil
+	}
+}
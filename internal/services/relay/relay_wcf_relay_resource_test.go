@@ -0,0 +1,171 @@
+package relay_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/relay/sdk/2017-04-01/wcfrelays"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RelayWcfRelayResource struct {
+}
+
+func TestAccRelayWcfRelay_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_wcf_relay", "test")
+	r := RelayWcfRelayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("relay_type").HasValue("NetTcp"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRelayWcfRelay_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_wcf_relay", "test")
+	r := RelayWcfRelayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, false, "metadatatest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRelayWcfRelay_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_wcf_relay", "test")
+	r := RelayWcfRelayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, false, "metadatatest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requires_transport_security").HasValue("false"),
+				check.That(data.ResourceName).Key("user_metadata").HasValue("metadatatest"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, true, "metadataupdated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("requires_transport_security").HasValue("true"),
+				check.That(data.ResourceName).Key("user_metadata").HasValue("metadataupdated"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRelayWcfRelay_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_relay_wcf_relay", "test")
+	r := RelayWcfRelayResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (t RelayWcfRelayResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := wcfrelays.ParseWcfRelayID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Relay.WcfRelaysClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r RelayWcfRelayResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_wcf_relay" "test" {
+  name                 = "acctestrnwr-%d"
+  resource_group_name  = azurerm_resource_group.test.name
+  relay_namespace_name = azurerm_relay_namespace.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r RelayWcfRelayResource) complete(data acceptance.TestData, requiresTransportSecurity bool, userMetadata string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_wcf_relay" "test" {
+  name                          = "acctestrnwr-%d"
+  resource_group_name           = azurerm_resource_group.test.name
+  relay_namespace_name          = azurerm_relay_namespace.test.name
+  relay_type                    = "Http"
+  requires_client_authorization = false
+  requires_transport_security   = %t
+  user_metadata                 = "%s"
+}
+`, r.template(data), data.RandomInteger, requiresTransportSecurity, userMetadata)
+}
+
+func (r RelayWcfRelayResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_wcf_relay" "import" {
+  name                 = azurerm_relay_wcf_relay.test.name
+  resource_group_name  = azurerm_relay_wcf_relay.test.resource_group_name
+  relay_namespace_name = azurerm_relay_wcf_relay.test.relay_namespace_name
+}
+`, r.basic(data))
+}
+
+func (RelayWcfRelayResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  sku_name = "Standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,25 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/savingsplans"
+)
+
+type Client struct {
+	ReservationOrdersClient *reservationorders.ReservationOrdersClient
+	SavingsPlansClient      *savingsplans.SavingsPlansClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	reservationOrdersClient := reservationorders.NewReservationOrdersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&reservationOrdersClient.Client, o.ResourceManagerAuthorizer)
+
+	savingsPlansClient := savingsplans.NewSavingsPlansClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&savingsPlansClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		ReservationOrdersClient: &reservationOrdersClient,
+		SavingsPlansClient:      &savingsPlansClient,
+	}
+}
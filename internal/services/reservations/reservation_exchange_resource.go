@@ -0,0 +1,344 @@
+package reservations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// ReservationExchangeResource exchanges (returns) one or more Reservations for new Reservations and/or Savings Plans.
+// An exchange can't be undone, so deleting this resource only removes it from the state - the Reservation Orders and
+// Savings Plan Orders which were purchased can be imported into `azurerm_reservation_order` and
+// `azurerm_savings_plan_order` resources to manage them.
+type ReservationExchangeResource struct{}
+
+var _ sdk.Resource = ReservationExchangeResource{}
+
+type ReservationExchangeResourceModel struct {
+	ReservationToReturn    []ReservationToReturnModel `tfschema:"reservation_to_return"`
+	ReservationToPurchase  []ReservationPurchaseModel `tfschema:"reservation_to_purchase"`
+	SavingsPlanToPurchase  []SavingsPlanPurchaseModel `tfschema:"savings_plan_to_purchase"`
+	SessionId              string                     `tfschema:"session_id"`
+	Status                 string                     `tfschema:"status"`
+	ReservationOrderIds    []string                   `tfschema:"reservation_order_ids"`
+	SavingsPlanOrderIds    []string                   `tfschema:"savings_plan_order_ids"`
+	NetPayableAmount       float64                    `tfschema:"net_payable_amount"`
+	NetPayableCurrencyCode string                     `tfschema:"net_payable_currency_code"`
+}
+
+type ReservationToReturnModel struct {
+	ReservationId string `tfschema:"reservation_id"`
+	Quantity      int64  `tfschema:"quantity"`
+}
+
+func (r ReservationExchangeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reservation_to_return": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"reservation_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: reservationorders.ValidateReservationID,
+					},
+
+					"quantity": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+			},
+		},
+
+		"reservation_to_purchase": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: []string{"reservation_to_purchase", "savings_plan_to_purchase"},
+			Elem: &pluginsdk.Resource{
+				Schema: reservationPurchaseSchema(false),
+			},
+		},
+
+		"savings_plan_to_purchase": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			AtLeastOneOf: []string{"reservation_to_purchase", "savings_plan_to_purchase"},
+			Elem: &pluginsdk.Resource{
+				Schema: savingsPlanPurchaseSchema(false),
+			},
+		},
+	}
+}
+
+func (r ReservationExchangeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"session_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"reservation_order_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"savings_plan_order_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"net_payable_amount": {
+			Type:     pluginsdk.TypeFloat,
+			Computed: true,
+		},
+
+		"net_payable_currency_code": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ReservationExchangeResource) ModelObject() interface{} {
+	return &ReservationExchangeResourceModel{}
+}
+
+func (r ReservationExchangeResource) ResourceType() string {
+	return "azurerm_reservation_exchange"
+}
+
+func (r ReservationExchangeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return reservationorders.ValidateExchangeOperationResultID
+}
+
+func (r ReservationExchangeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			var model ReservationExchangeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			toReturn := make([]reservationorders.ReservationToReturn, 0)
+			for _, v := range model.ReservationToReturn {
+				reservationId, err := reservationorders.ParseReservationID(v.ReservationId)
+				if err != nil {
+					return err
+				}
+
+				toReturn = append(toReturn, reservationorders.ReservationToReturn{
+					Quantity:      utils.Int64(v.Quantity),
+					ReservationId: utils.String(reservationId.ID()),
+				})
+			}
+
+			reservationsToPurchase := make([]reservationorders.PurchaseRequest, 0)
+			for i, v := range model.ReservationToPurchase {
+				purchase, err := expandReservationPurchaseRequest(v)
+				if err != nil {
+					return fmt.Errorf("expanding `reservation_to_purchase.%d`: %+v", i, err)
+				}
+				reservationsToPurchase = append(reservationsToPurchase, *purchase)
+			}
+
+			savingsPlansToPurchase := make([]reservationorders.SavingsPlanPurchaseRequest, 0)
+			for i, v := range model.SavingsPlanToPurchase {
+				purchase, err := expandSavingsPlanPurchaseRequest(v)
+				if err != nil {
+					return fmt.Errorf("expanding `savings_plan_to_purchase.%d`: %+v", i, err)
+				}
+				savingsPlansToPurchase = append(savingsPlansToPurchase, *purchase)
+			}
+
+			calculated, err := client.CalculateExchangeThenPoll(ctx, reservationorders.CalculateExchangeRequest{
+				Properties: &reservationorders.CalculateExchangeRequestProperties{
+					ReservationsToExchange: &toReturn,
+					ReservationsToPurchase: &reservationsToPurchase,
+					SavingsPlansToPurchase: &savingsPlansToPurchase,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("calculating the exchange: %+v", err)
+			}
+			if calculated.Status == nil || *calculated.Status != reservationorders.CalculateExchangeOperationResultStatusSucceeded {
+				return fmt.Errorf("calculating the exchange: %s", exchangeOperationError(calculated.Error))
+			}
+			if calculated.Properties == nil || calculated.Properties.SessionId == nil {
+				return fmt.Errorf("calculating the exchange: `properties.sessionId` was nil")
+			}
+			if err := exchangePolicyError(calculated.Properties.PolicyResult); err != nil {
+				return fmt.Errorf("the exchange isn't allowed: %+v", err)
+			}
+
+			result, err := client.ExchangeThenPoll(ctx, reservationorders.ExchangeRequest{
+				Properties: &reservationorders.ExchangeRequestProperties{
+					SessionId: calculated.Properties.SessionId,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("performing the exchange for session %q: %+v", *calculated.Properties.SessionId, err)
+			}
+			if result.Id == nil {
+				return fmt.Errorf("performing the exchange for session %q: `id` was nil", *calculated.Properties.SessionId)
+			}
+
+			id, err := reservationorders.ParseExchangeOperationResultIDInsensitively(*result.Id)
+			if err != nil {
+				return err
+			}
+
+			// the exchange has (at least partially) happened at this point, so the ID is recorded even if it failed
+			metadata.SetID(id)
+
+			if result.Status == nil || *result.Status != reservationorders.ExchangeOperationResultStatusSucceeded {
+				status := ""
+				if result.Status != nil {
+					status = string(*result.Status)
+				}
+				return fmt.Errorf("performing %s: the exchange finished with the status %q: %s", *id, status, exchangeOperationError(result.Error))
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ReservationExchangeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			id, err := reservationorders.ParseExchangeOperationResultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ExchangeOperationResultGet(ctx, *id)
+			if err != nil {
+				// the result of an exchange is only retained for a limited time - since recreating this resource would
+				// perform another exchange, the existing state is retained once the result is no longer available
+				if response.WasNotFound(resp.HttpResponse) {
+					log.Printf("[DEBUG] the result of %s is no longer available - retaining the existing state", *id)
+					return nil
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var state ReservationExchangeResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.ReservationOrderIds = make([]string, 0)
+			state.SavingsPlanOrderIds = make([]string, 0)
+
+			if model := resp.Model; model != nil {
+				if model.Status != nil {
+					state.Status = string(*model.Status)
+				}
+
+				if props := model.Properties; props != nil {
+					state.SessionId = utils.NormalizeNilableString(props.SessionId)
+
+					if props.NetPayable != nil {
+						if props.NetPayable.Amount != nil {
+							state.NetPayableAmount = *props.NetPayable.Amount
+						}
+						state.NetPayableCurrencyCode = utils.NormalizeNilableString(props.NetPayable.CurrencyCode)
+					}
+
+					if props.ReservationsToPurchase != nil {
+						for _, v := range *props.ReservationsToPurchase {
+							if v.ReservationOrderId == nil {
+								continue
+							}
+							orderId, err := reservationorders.ParseReservationOrderIDInsensitively(*v.ReservationOrderId)
+							if err != nil {
+								return err
+							}
+							state.ReservationOrderIds = append(state.ReservationOrderIds, orderId.ID())
+						}
+					}
+
+					if props.SavingsPlansToPurchase != nil {
+						for _, v := range *props.SavingsPlansToPurchase {
+							if v.SavingsPlanOrderId == nil {
+								continue
+							}
+							state.SavingsPlanOrderIds = append(state.SavingsPlanOrderIds, *v.SavingsPlanOrderId)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ReservationExchangeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := reservationorders.ParseExchangeOperationResultID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] %s can't be undone - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+func exchangeOperationError(input *reservationorders.OperationResultError) string {
+	if input == nil {
+		return "no error was returned"
+	}
+	return fmt.Sprintf("%s: %s", utils.NormalizeNilableString(input.Code), utils.NormalizeNilableString(input.Message))
+}
+
+func exchangePolicyError(input *reservationorders.ExchangePolicyErrors) error {
+	if input == nil || input.PolicyErrors == nil || len(*input.PolicyErrors) == 0 {
+		return nil
+	}
+
+	errors := make([]string, 0)
+	for _, v := range *input.PolicyErrors {
+		errors = append(errors, fmt.Sprintf("%s: %s", utils.NormalizeNilableString(v.Code), utils.NormalizeNilableString(v.Message)))
+	}
+	return fmt.Errorf("%s", strings.Join(errors, "\n"))
+}
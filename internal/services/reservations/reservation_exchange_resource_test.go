@@ -0,0 +1,78 @@
+package reservations_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ReservationExchangeResource struct{}
+
+func TestAccReservationExchange_savingsPlan(t *testing.T) {
+	if os.Getenv("ARM_TEST_RESERVATIONS") == "" {
+		t.Skip(reservationsTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_reservation_exchange", "test")
+	r := ReservationExchangeResource{}
+
+	// the arguments of an exchange can't be retrieved from the API, so there's no import step
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.savingsPlan(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("savings_plan_order_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (ReservationExchangeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := reservationorders.ParseExchangeOperationResultID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Reservations.ReservationOrdersClient.ExchangeOperationResultGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ReservationExchangeResource) savingsPlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_reservation_exchange" "test" {
+  reservation_to_return {
+    reservation_id = azurerm_reservation_order.test.reservation_id
+    quantity       = 2
+  }
+
+  savings_plan_to_purchase {
+    billing_scope_id         = data.azurerm_subscription.current.id
+    term                     = "P3Y"
+    commitment_amount        = 0.1
+    commitment_currency_code = "USD"
+    display_name             = "acctest-savings-plan-%d"
+    applied_scope_type       = "Shared"
+  }
+}
+`, ReservationOrderResource{}.basic(data), data.RandomInteger)
+}
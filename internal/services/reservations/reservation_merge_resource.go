@@ -0,0 +1,185 @@
+package reservations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ReservationMergeResource merges two Reservations within the same Reservation Order into a single Reservation - a
+// merge can't be undone, so deleting this resource only removes it from the state.
+type ReservationMergeResource struct{}
+
+var _ sdk.Resource = ReservationMergeResource{}
+
+type ReservationMergeResourceModel struct {
+	ReservationIds []string `tfschema:"reservation_ids"`
+	ReservationId  string   `tfschema:"reservation_id"`
+}
+
+func (r ReservationMergeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reservation_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 2,
+			MaxItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: reservationorders.ValidateReservationID,
+			},
+		},
+	}
+}
+
+func (r ReservationMergeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reservation_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ReservationMergeResource) ModelObject() interface{} {
+	return &ReservationMergeResourceModel{}
+}
+
+func (r ReservationMergeResource) ResourceType() string {
+	return "azurerm_reservation_merge"
+}
+
+func (r ReservationMergeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return reservationorders.ValidateReservationID
+}
+
+func (r ReservationMergeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			var model ReservationMergeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			sources := make([]string, 0)
+			var orderId *reservationorders.ReservationOrderId
+			for _, v := range model.ReservationIds {
+				sourceId, err := reservationorders.ParseReservationID(v)
+				if err != nil {
+					return err
+				}
+
+				if orderId == nil {
+					id := reservationorders.NewReservationOrderID(sourceId.ReservationOrderId)
+					orderId = &id
+				} else if !strings.EqualFold(orderId.ReservationOrderId, sourceId.ReservationOrderId) {
+					return fmt.Errorf("only Reservations within the same Reservation Order can be merged, but %s isn't within %s", *sourceId, *orderId)
+				}
+
+				sources = append(sources, sourceId.ID())
+			}
+
+			payload := reservationorders.MergeRequest{
+				Properties: &reservationorders.MergeProperties{
+					Sources: &sources,
+				},
+			}
+			if err := client.MergeThenPoll(ctx, *orderId, payload); err != nil {
+				return fmt.Errorf("merging the Reservations %q within %s: %+v", strings.Join(sources, ", "), *orderId, err)
+			}
+
+			// the merged Reservation is only exposed on the source Reservations
+			sourceId, err := reservationorders.ParseReservationID(sources[0])
+			if err != nil {
+				return err
+			}
+			source, err := client.ReservationGet(ctx, *sourceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *sourceId, err)
+			}
+			if source.Model == nil || source.Model.Properties == nil || source.Model.Properties.MergeProperties == nil || source.Model.Properties.MergeProperties.MergeDestination == nil {
+				return fmt.Errorf("retrieving %s: `properties.mergeProperties.mergeDestination` was nil", *sourceId)
+			}
+
+			id, err := reservationorders.ParseReservationIDInsensitively(*source.Model.Properties.MergeProperties.MergeDestination)
+			if err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ReservationMergeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			id, err := reservationorders.ParseReservationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReservationGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var sources []string
+			if model := resp.Model; model != nil && model.Properties != nil && model.Properties.MergeProperties != nil && model.Properties.MergeProperties.MergeSources != nil {
+				sources = *model.Properties.MergeProperties.MergeSources
+			}
+			if len(sources) == 0 {
+				log.Printf("[DEBUG] %s isn't the result of a merge - removing from state", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ReservationMergeResourceModel{
+				ReservationId:  id.ID(),
+				ReservationIds: make([]string, 0),
+			}
+
+			for _, source := range sources {
+				sourceId, err := reservationorders.ParseReservationIDInsensitively(source)
+				if err != nil {
+					return err
+				}
+				state.ReservationIds = append(state.ReservationIds, sourceId.ID())
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ReservationMergeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := reservationorders.ParseReservationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] the merge into %s can't be undone - removing from state", *id)
+			return nil
+		},
+	}
+}
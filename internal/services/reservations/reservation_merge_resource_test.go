@@ -0,0 +1,66 @@
+package reservations_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ReservationMergeResource struct{}
+
+func TestAccReservationMerge_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_RESERVATIONS") == "" {
+		t.Skip(reservationsTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_reservation_merge", "test")
+	r := ReservationMergeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("reservation_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ReservationMergeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := reservationorders.ParseReservationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Reservations.ReservationOrdersClient.ReservationGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// basic merges the Reservations which were created by splitting a Reservation
+func (ReservationMergeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_reservation_merge" "test" {
+  reservation_ids = azurerm_reservation_split.test.reservation_ids
+}
+`, ReservationSplitResource{}.basic(data))
+}
@@ -0,0 +1,457 @@
+package reservations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// ReservationOrderResource purchases a Reservation Order - since a Reservation can't be deleted, deleting this
+// resource returns (refunds) the Reservations within the Reservation Order.
+type ReservationOrderResource struct{}
+
+var _ sdk.ResourceWithUpdate = ReservationOrderResource{}
+
+type ReservationOrderResourceModel struct {
+	SkuName                    string              `tfschema:"sku_name"`
+	Location                   string              `tfschema:"location"`
+	ReservedResourceType       string              `tfschema:"reserved_resource_type"`
+	BillingScopeId             string              `tfschema:"billing_scope_id"`
+	Term                       string              `tfschema:"term"`
+	BillingPlan                string              `tfschema:"billing_plan"`
+	Quantity                   int64               `tfschema:"quantity"`
+	DisplayName                string              `tfschema:"display_name"`
+	AppliedScopeType           string              `tfschema:"applied_scope_type"`
+	AppliedScope               []AppliedScopeModel `tfschema:"applied_scope"`
+	RenewEnabled               bool                `tfschema:"renew_enabled"`
+	InstanceFlexibilityEnabled bool                `tfschema:"instance_flexibility_enabled"`
+	ReservationId              string              `tfschema:"reservation_id"`
+	ReservationIds             []string            `tfschema:"reservation_ids"`
+	BenefitStartTime           string              `tfschema:"benefit_start_time"`
+	ExpiryTime                 string              `tfschema:"expiry_time"`
+}
+
+func (m ReservationOrderResourceModel) purchase() ReservationPurchaseModel {
+	return ReservationPurchaseModel{
+		SkuName:                    m.SkuName,
+		Location:                   m.Location,
+		ReservedResourceType:       m.ReservedResourceType,
+		BillingScopeId:             m.BillingScopeId,
+		Term:                       m.Term,
+		BillingPlan:                m.BillingPlan,
+		Quantity:                   m.Quantity,
+		DisplayName:                m.DisplayName,
+		AppliedScopeType:           m.AppliedScopeType,
+		AppliedScope:               m.AppliedScope,
+		RenewEnabled:               m.RenewEnabled,
+		InstanceFlexibilityEnabled: m.InstanceFlexibilityEnabled,
+	}
+}
+
+// returnReason is the reason provided to the API when returning a Reservation
+const returnReason = "Returned by Terraform"
+
+func (r ReservationOrderResource) Arguments() map[string]*pluginsdk.Schema {
+	return reservationPurchaseSchema(true)
+}
+
+func (r ReservationOrderResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reservation_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"reservation_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"benefit_start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expiry_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ReservationOrderResource) ModelObject() interface{} {
+	return &ReservationOrderResourceModel{}
+}
+
+func (r ReservationOrderResource) ResourceType() string {
+	return "azurerm_reservation_order"
+}
+
+func (r ReservationOrderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return reservationorders.ValidateReservationOrderID
+}
+
+func (r ReservationOrderResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			var model ReservationOrderResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload, err := expandReservationPurchaseRequest(model.purchase())
+			if err != nil {
+				return err
+			}
+
+			// the ID of the Reservation Order is allocated by the API when calculating the price of the purchase
+			price, err := client.CalculatePrice(ctx, *payload)
+			if err != nil {
+				return fmt.Errorf("calculating the price of the Reservation Order: %+v", err)
+			}
+			if price.Model == nil || price.Model.Properties == nil || price.Model.Properties.ReservationOrderId == nil {
+				return fmt.Errorf("calculating the price of the Reservation Order: `properties.reservationOrderId` was nil")
+			}
+
+			id := reservationorders.NewReservationOrderID(*price.Model.Properties.ReservationOrderId)
+			if err := client.PurchaseThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("purchasing %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ReservationOrderResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			id, err := reservationorders.ParseReservationOrderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			reservations, err := reservationsForOrder(ctx, client, *id, resp.Model)
+			if err != nil {
+				return err
+			}
+
+			state := ReservationOrderResourceModel{
+				// the Quantity can be changed by splitting, merging or exchanging the Reservations, so this is
+				// tracked as the quantity which was originally purchased
+				Quantity:       int64(metadata.ResourceData.Get("quantity").(int)),
+				ReservationIds: make([]string, 0),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.BillingPlan != nil {
+						state.BillingPlan = string(*props.BillingPlan)
+					}
+					if props.Term != nil {
+						state.Term = string(*props.Term)
+					}
+					if props.OriginalQuantity != nil {
+						state.Quantity = *props.OriginalQuantity
+					}
+					state.BenefitStartTime = utils.NormalizeNilableString(props.BenefitStartTime)
+					state.ExpiryTime = utils.NormalizeNilableString(props.ExpiryDateTime)
+				}
+			}
+
+			active := activeReservations(reservations)
+			for _, reservation := range active {
+				state.ReservationIds = append(state.ReservationIds, utils.NormalizeNilableString(reservation.Id))
+			}
+
+			// the purchased Reservation remains within the Reservation Order once it's been split, merged or
+			// exchanged, however the arguments which can be updated are only applied to the active Reservations
+			var purchased, current *reservationorders.ReservationResponse
+			for i := range reservations {
+				if reservationWasPurchased(reservations[i].Properties) {
+					purchased = &reservations[i]
+					break
+				}
+			}
+			if len(active) > 0 {
+				current = &active[0]
+			}
+			if purchased == nil {
+				purchased = current
+			}
+			if current == nil {
+				current = purchased
+			}
+
+			if purchased != nil {
+				state.ReservationId = utils.NormalizeNilableString(purchased.Id)
+				if purchased.Location != nil {
+					state.Location = location.Normalize(*purchased.Location)
+				}
+				if purchased.Sku != nil {
+					state.SkuName = utils.NormalizeNilableString(purchased.Sku.Name)
+				}
+				if props := purchased.Properties; props != nil {
+					state.BillingScopeId = utils.NormalizeNilableString(props.BillingScopeId)
+					if props.ReservedResourceType != nil {
+						state.ReservedResourceType = string(*props.ReservedResourceType)
+					}
+				}
+			}
+
+			if current != nil {
+				if props := current.Properties; props != nil {
+					if props.AppliedScopeType != nil {
+						state.AppliedScopeType = string(*props.AppliedScopeType)
+					}
+					state.AppliedScope = flattenReservationAppliedScope(props.AppliedScopeProperties)
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					state.RenewEnabled = props.Renew != nil && *props.Renew
+
+					state.InstanceFlexibilityEnabled = true
+					if props.InstanceFlexibility != nil {
+						state.InstanceFlexibilityEnabled = *props.InstanceFlexibility == reservationorders.InstanceFlexibilityOn
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ReservationOrderResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			id, err := reservationorders.ParseReservationOrderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ReservationOrderResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			appliedScope, err := expandReservationAppliedScope(model.AppliedScopeType, model.AppliedScope)
+			if err != nil {
+				return err
+			}
+			appliedScopeType := reservationorders.AppliedScopeType(model.AppliedScopeType)
+
+			payload := reservationorders.Patch{
+				Properties: &reservationorders.PatchProperties{
+					AppliedScopeProperties: appliedScope,
+					AppliedScopeType:       &appliedScopeType,
+					Renew:                  utils.Bool(model.RenewEnabled),
+				},
+			}
+
+			if model.DisplayName != "" {
+				payload.Properties.DisplayName = utils.String(model.DisplayName)
+			}
+
+			if model.ReservedResourceType == string(reservationorders.ReservedResourceTypeVirtualMachines) {
+				payload.Properties.InstanceFlexibility = expandReservationInstanceFlexibility(model.InstanceFlexibilityEnabled)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			reservations, err := reservationsForOrder(ctx, client, *id, existing.Model)
+			if err != nil {
+				return err
+			}
+
+			for _, reservation := range activeReservations(reservations) {
+				reservationId, err := reservationorders.ParseReservationIDInsensitively(utils.NormalizeNilableString(reservation.Id))
+				if err != nil {
+					return err
+				}
+
+				if err := client.ReservationUpdateThenPoll(ctx, *reservationId, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *reservationId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ReservationOrderResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			id, err := reservationorders.ParseReservationOrderID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			reservations, err := reservationsForOrder(ctx, client, *id, existing.Model)
+			if err != nil {
+				return err
+			}
+
+			for _, reservation := range activeReservations(reservations) {
+				if err := returnReservation(ctx, client, *id, reservation); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// reservationsForOrder returns all of the Reservations within the Reservation Order
+func reservationsForOrder(ctx context.Context, client *reservationorders.ReservationOrdersClient, id reservationorders.ReservationOrderId, order *reservationorders.ReservationOrderResponse) ([]reservationorders.ReservationResponse, error) {
+	output := make([]reservationorders.ReservationResponse, 0)
+	if order == nil || order.Properties == nil || order.Properties.Reservations == nil {
+		return output, nil
+	}
+
+	for _, item := range *order.Properties.Reservations {
+		if item.Id == nil {
+			continue
+		}
+
+		reservationId, err := reservationorders.ParseReservationIDInsensitively(*item.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := client.ReservationGet(ctx, *reservationId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s within %s: %+v", *reservationId, id, err)
+		}
+		if resp.Model == nil {
+			continue
+		}
+
+		// normalize the ID so that it can be compared with the IDs in the configuration
+		resp.Model.Id = utils.String(reservationId.ID())
+		output = append(output, *resp.Model)
+	}
+
+	return output, nil
+}
+
+// activeReservations returns the Reservations which haven't been cancelled, expired, split, merged or exchanged
+func activeReservations(input []reservationorders.ReservationResponse) []reservationorders.ReservationResponse {
+	output := make([]reservationorders.ReservationResponse, 0)
+	for _, v := range input {
+		if v.Properties == nil || v.Properties.ProvisioningState == nil {
+			output = append(output, v)
+			continue
+		}
+
+		active := true
+		for _, state := range []string{"BillingFailed", "Cancelled", "Expired", "Failed", "Merged", "Split"} {
+			if strings.EqualFold(*v.Properties.ProvisioningState, state) {
+				active = false
+				break
+			}
+		}
+		if active {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+// reservationWasPurchased returns whether the Reservation was purchased, rather than being the result of a split or merge
+func reservationWasPurchased(input *reservationorders.ReservationsProperties) bool {
+	if input == nil {
+		return false
+	}
+
+	if input.SplitProperties != nil && input.SplitProperties.SplitSource != nil && *input.SplitProperties.SplitSource != "" {
+		return false
+	}
+	if input.MergeProperties != nil && input.MergeProperties.MergeSources != nil && len(*input.MergeProperties.MergeSources) > 0 {
+		return false
+	}
+	return true
+}
+
+// returnReservation returns (refunds) the full quantity of the specified Reservation
+func returnReservation(ctx context.Context, client *reservationorders.ReservationOrdersClient, id reservationorders.ReservationOrderId, reservation reservationorders.ReservationResponse) error {
+	reservationId := utils.NormalizeNilableString(reservation.Id)
+
+	var quantity *int64
+	if props := reservation.Properties; props != nil {
+		quantity = props.Quantity
+	}
+
+	toReturn := reservationorders.ReservationToReturn{
+		Quantity:      quantity,
+		ReservationId: utils.String(reservationId),
+	}
+
+	refund, err := client.CalculateRefund(ctx, id, reservationorders.CalculateRefundRequest{
+		Id: utils.String(id.ID()),
+		Properties: &reservationorders.CalculateRefundRequestProperties{
+			ReservationToReturn: &toReturn,
+			Scope:               utils.String("Reservation"),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("calculating the refund for Reservation %q within %s: %+v", reservationId, id, err)
+	}
+	if refund.Model == nil || refund.Model.Properties == nil || refund.Model.Properties.SessionId == nil {
+		return fmt.Errorf("calculating the refund for Reservation %q within %s: `properties.sessionId` was nil", reservationId, id)
+	}
+
+	payload := reservationorders.RefundRequest{
+		Properties: &reservationorders.RefundRequestProperties{
+			ReservationToReturn: &toReturn,
+			ReturnReason:        utils.String(returnReason),
+			Scope:               utils.String("Reservation"),
+			SessionId:           refund.Model.Properties.SessionId,
+		},
+	}
+	if err := client.ReturnThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("returning Reservation %q within %s: %+v", reservationId, id, err)
+	}
+
+	return nil
+}
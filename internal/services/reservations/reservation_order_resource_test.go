@@ -0,0 +1,146 @@
+package reservations_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ReservationOrderResource struct{}
+
+// reservationsTestSkipMessage is used to skip the Reservation tests unless explicitly enabled, since these purchase
+// (and subsequently return) Reservations and Savings Plans using the billing account of the test subscription
+const reservationsTestSkipMessage = "Skipping as ARM_TEST_RESERVATIONS is not specified"
+
+func TestAccReservationOrder_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_RESERVATIONS") == "" {
+		t.Skip(reservationsTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_reservation_order", "test")
+	r := ReservationOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("reservation_id").Exists(),
+				check.That(data.ResourceName).Key("reservation_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccReservationOrder_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_RESERVATIONS") == "" {
+		t.Skip(reservationsTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_reservation_order", "test")
+	r := ReservationOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.singleScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ReservationOrderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := reservationorders.ParseReservationOrderID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Reservations.ReservationOrdersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (ReservationOrderResource) template() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+`
+}
+
+func (r ReservationOrderResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_reservation_order" "test" {
+  sku_name               = "Standard_B1ls"
+  location               = "%s"
+  reserved_resource_type = "VirtualMachines"
+  billing_scope_id       = data.azurerm_subscription.current.id
+  term                   = "P1Y"
+  billing_plan           = "Monthly"
+  quantity               = 2
+  display_name           = "acctest-reservation-%d"
+  applied_scope_type     = "Shared"
+}
+`, r.template(), data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ReservationOrderResource) singleScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_reservation_order" "test" {
+  sku_name               = "Standard_B1ls"
+  location               = "%s"
+  reserved_resource_type = "VirtualMachines"
+  billing_scope_id       = data.azurerm_subscription.current.id
+  term                   = "P1Y"
+  billing_plan           = "Monthly"
+  quantity               = 2
+  display_name           = "acctest-reservation-updated-%d"
+  applied_scope_type     = "Single"
+
+  applied_scope {
+    subscription_id = data.azurerm_subscription.current.id
+  }
+
+  instance_flexibility_enabled = false
+  renew_enabled                = true
+}
+`, r.template(), data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,426 @@
+package reservations
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/savingsplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// defaultSavingsPlanSkuName is the only SKU which Savings Plans are currently available for
+const defaultSavingsPlanSkuName = "Compute_Savings_Plan"
+
+type AppliedScopeModel struct {
+	SubscriptionId    string `tfschema:"subscription_id"`
+	ResourceGroupId   string `tfschema:"resource_group_id"`
+	ManagementGroupId string `tfschema:"management_group_id"`
+	TenantId          string `tfschema:"tenant_id"`
+}
+
+type ReservationPurchaseModel struct {
+	SkuName                    string              `tfschema:"sku_name"`
+	Location                   string              `tfschema:"location"`
+	ReservedResourceType       string              `tfschema:"reserved_resource_type"`
+	BillingScopeId             string              `tfschema:"billing_scope_id"`
+	Term                       string              `tfschema:"term"`
+	BillingPlan                string              `tfschema:"billing_plan"`
+	Quantity                   int64               `tfschema:"quantity"`
+	DisplayName                string              `tfschema:"display_name"`
+	AppliedScopeType           string              `tfschema:"applied_scope_type"`
+	AppliedScope               []AppliedScopeModel `tfschema:"applied_scope"`
+	RenewEnabled               bool                `tfschema:"renew_enabled"`
+	InstanceFlexibilityEnabled bool                `tfschema:"instance_flexibility_enabled"`
+}
+
+type SavingsPlanPurchaseModel struct {
+	SkuName                string              `tfschema:"sku_name"`
+	BillingScopeId         string              `tfschema:"billing_scope_id"`
+	Term                   string              `tfschema:"term"`
+	BillingPlan            string              `tfschema:"billing_plan"`
+	CommitmentAmount       float64             `tfschema:"commitment_amount"`
+	CommitmentCurrencyCode string              `tfschema:"commitment_currency_code"`
+	DisplayName            string              `tfschema:"display_name"`
+	AppliedScopeType       string              `tfschema:"applied_scope_type"`
+	AppliedScope           []AppliedScopeModel `tfschema:"applied_scope"`
+}
+
+// reservationPurchaseSchema returns the schema used to purchase a Reservation - when `updatable` is true the
+// arguments which can be changed on an existing Reservation aren't ForceNew.
+func reservationPurchaseSchema(updatable bool) map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.LocationOptional(),
+
+		"reserved_resource_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(reservationorders.PossibleValuesForReservedResourceType(), false),
+		},
+
+		"billing_scope_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSubscriptionID,
+		},
+
+		"term": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(reservationorders.PossibleValuesForReservationTerm(), false),
+		},
+
+		"quantity": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"applied_scope_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     !updatable,
+			ValidateFunc: validation.StringInSlice(reservationorders.PossibleValuesForAppliedScopeType(), false),
+		},
+
+		"applied_scope": appliedScopeSchema(updatable),
+
+		"billing_plan": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(reservationorders.ReservationBillingPlanUpfront),
+			ValidateFunc: validation.StringInSlice(reservationorders.PossibleValuesForReservationBillingPlan(), false),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     !updatable,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"instance_flexibility_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: !updatable,
+			Default:  true,
+		},
+
+		"renew_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: !updatable,
+			Default:  false,
+		},
+	}
+}
+
+// savingsPlanPurchaseSchema returns the schema used to purchase a Savings Plan - when `updatable` is true the
+// arguments which can be changed on an existing Savings Plan aren't ForceNew.
+func savingsPlanPurchaseSchema(updatable bool) map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"billing_scope_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"term": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(reservationorders.PossibleValuesForSavingsPlanTerm(), false),
+		},
+
+		"commitment_amount": {
+			Type:         pluginsdk.TypeFloat,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.FloatAtLeast(0.001),
+		},
+
+		"commitment_currency_code": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(3, 3),
+		},
+
+		"applied_scope_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     !updatable,
+			ValidateFunc: validation.StringInSlice(reservationorders.PossibleValuesForAppliedScopeType(), false),
+		},
+
+		"applied_scope": appliedScopeSchema(updatable),
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      defaultSavingsPlanSkuName,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"billing_plan": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(reservationorders.SavingsPlanBillingPlanPOneM),
+			ValidateFunc: validation.StringInSlice(reservationorders.PossibleValuesForSavingsPlanBillingPlan(), false),
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     !updatable,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func appliedScopeSchema(updatable bool) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: !updatable,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"subscription_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     !updatable,
+					ValidateFunc: commonids.ValidateSubscriptionID,
+				},
+
+				"resource_group_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     !updatable,
+					ValidateFunc: commonids.ValidateResourceGroupID,
+				},
+
+				"management_group_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     !updatable,
+					ValidateFunc: commonids.ValidateManagementGroupID,
+				},
+
+				"tenant_id": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     !updatable,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+		},
+	}
+}
+
+// validateAppliedScope checks that the `applied_scope` block contains the fields required by the `applied_scope_type`
+func validateAppliedScope(appliedScopeType string, input []AppliedScopeModel) error {
+	switch appliedScopeType {
+	case string(reservationorders.AppliedScopeTypeShared):
+		if len(input) > 0 {
+			return fmt.Errorf("an `applied_scope` block can't be specified when `applied_scope_type` is `%s`", appliedScopeType)
+		}
+
+	case string(reservationorders.AppliedScopeTypeSingle):
+		if len(input) == 0 || (input[0].SubscriptionId == "") == (input[0].ResourceGroupId == "") {
+			return fmt.Errorf("exactly one of `subscription_id` or `resource_group_id` must be specified within the `applied_scope` block when `applied_scope_type` is `%s`", appliedScopeType)
+		}
+		if input[0].ManagementGroupId != "" || input[0].TenantId != "" {
+			return fmt.Errorf("`management_group_id` and `tenant_id` can only be specified within the `applied_scope` block when `applied_scope_type` is `%s`", reservationorders.AppliedScopeTypeManagementGroup)
+		}
+
+	case string(reservationorders.AppliedScopeTypeManagementGroup):
+		if len(input) == 0 || input[0].ManagementGroupId == "" || input[0].TenantId == "" {
+			return fmt.Errorf("`management_group_id` and `tenant_id` must be specified within the `applied_scope` block when `applied_scope_type` is `%s`", appliedScopeType)
+		}
+		if input[0].SubscriptionId != "" || input[0].ResourceGroupId != "" {
+			return fmt.Errorf("`subscription_id` and `resource_group_id` can only be specified within the `applied_scope` block when `applied_scope_type` is `%s`", reservationorders.AppliedScopeTypeSingle)
+		}
+	}
+
+	return nil
+}
+
+func expandReservationAppliedScope(appliedScopeType string, input []AppliedScopeModel) (*reservationorders.AppliedScopeProperties, error) {
+	if err := validateAppliedScope(appliedScopeType, input); err != nil {
+		return nil, err
+	}
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	scope := input[0]
+	output := reservationorders.AppliedScopeProperties{}
+	if scope.SubscriptionId != "" {
+		output.SubscriptionId = utils.String(scope.SubscriptionId)
+	}
+	if scope.ResourceGroupId != "" {
+		output.ResourceGroupId = utils.String(scope.ResourceGroupId)
+	}
+	if scope.ManagementGroupId != "" {
+		output.ManagementGroupId = utils.String(scope.ManagementGroupId)
+	}
+	if scope.TenantId != "" {
+		output.TenantId = utils.String(scope.TenantId)
+	}
+	return &output, nil
+}
+
+func flattenReservationAppliedScope(input *reservationorders.AppliedScopeProperties) []AppliedScopeModel {
+	if input == nil {
+		return []AppliedScopeModel{}
+	}
+
+	return []AppliedScopeModel{
+		{
+			SubscriptionId:    utils.NormalizeNilableString(input.SubscriptionId),
+			ResourceGroupId:   utils.NormalizeNilableString(input.ResourceGroupId),
+			ManagementGroupId: utils.NormalizeNilableString(input.ManagementGroupId),
+			TenantId:          utils.NormalizeNilableString(input.TenantId),
+		},
+	}
+}
+
+func expandSavingsPlanAppliedScope(appliedScopeType string, input []AppliedScopeModel) (*savingsplans.AppliedScopeProperties, error) {
+	scope, err := expandReservationAppliedScope(appliedScopeType, input)
+	if err != nil || scope == nil {
+		return nil, err
+	}
+
+	return &savingsplans.AppliedScopeProperties{
+		SubscriptionId:    scope.SubscriptionId,
+		ResourceGroupId:   scope.ResourceGroupId,
+		ManagementGroupId: scope.ManagementGroupId,
+		TenantId:          scope.TenantId,
+	}, nil
+}
+
+func flattenSavingsPlanAppliedScope(input *savingsplans.AppliedScopeProperties) []AppliedScopeModel {
+	if input == nil {
+		return []AppliedScopeModel{}
+	}
+
+	return []AppliedScopeModel{
+		{
+			SubscriptionId:    utils.NormalizeNilableString(input.SubscriptionId),
+			ResourceGroupId:   utils.NormalizeNilableString(input.ResourceGroupId),
+			ManagementGroupId: utils.NormalizeNilableString(input.ManagementGroupId),
+			TenantId:          utils.NormalizeNilableString(input.TenantId),
+		},
+	}
+}
+
+func expandReservationPurchaseRequest(input ReservationPurchaseModel) (*reservationorders.PurchaseRequest, error) {
+	appliedScope, err := expandReservationAppliedScope(input.AppliedScopeType, input.AppliedScope)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedScopeType := reservationorders.AppliedScopeType(input.AppliedScopeType)
+	billingPlan := reservationorders.ReservationBillingPlan(input.BillingPlan)
+	reservedResourceType := reservationorders.ReservedResourceType(input.ReservedResourceType)
+	term := reservationorders.ReservationTerm(input.Term)
+
+	output := reservationorders.PurchaseRequest{
+		Sku: &reservationorders.SkuName{
+			Name: utils.String(input.SkuName),
+		},
+		Properties: &reservationorders.PurchaseRequestProperties{
+			AppliedScopeProperties: appliedScope,
+			AppliedScopeType:       &appliedScopeType,
+			BillingPlan:            &billingPlan,
+			BillingScopeId:         utils.String(input.BillingScopeId),
+			Quantity:               utils.Int64(input.Quantity),
+			Renew:                  utils.Bool(input.RenewEnabled),
+			ReservedResourceType:   &reservedResourceType,
+			Term:                   &term,
+		},
+	}
+
+	if input.Location != "" {
+		output.Location = utils.String(location.Normalize(input.Location))
+	}
+
+	if input.DisplayName != "" {
+		output.Properties.DisplayName = utils.String(input.DisplayName)
+	}
+
+	// instance flexibility is only applicable to Virtual Machine reservations
+	if reservedResourceType == reservationorders.ReservedResourceTypeVirtualMachines {
+		output.Properties.ReservedResourceProperties = &reservationorders.PurchaseRequestPropertiesReservedResourceProperties{
+			InstanceFlexibility: expandReservationInstanceFlexibility(input.InstanceFlexibilityEnabled),
+		}
+	}
+
+	return &output, nil
+}
+
+func expandSavingsPlanPurchaseRequest(input SavingsPlanPurchaseModel) (*reservationorders.SavingsPlanPurchaseRequest, error) {
+	appliedScope, err := expandReservationAppliedScope(input.AppliedScopeType, input.AppliedScope)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedScopeType := reservationorders.AppliedScopeType(input.AppliedScopeType)
+	billingPlan := reservationorders.SavingsPlanBillingPlan(input.BillingPlan)
+	grain := reservationorders.CommitmentGrainHourly
+	term := reservationorders.SavingsPlanTerm(input.Term)
+
+	output := reservationorders.SavingsPlanPurchaseRequest{
+		Sku: &reservationorders.SkuName{
+			Name: utils.String(input.SkuName),
+		},
+		Properties: &reservationorders.SavingsPlanPurchaseRequestProperties{
+			AppliedScopeProperties: appliedScope,
+			AppliedScopeType:       &appliedScopeType,
+			BillingPlan:            &billingPlan,
+			BillingScopeId:         utils.String(input.BillingScopeId),
+			Commitment: &reservationorders.Commitment{
+				Amount:       utils.Float(input.CommitmentAmount),
+				CurrencyCode: utils.String(input.CommitmentCurrencyCode),
+				Grain:        &grain,
+			},
+			Term: &term,
+		},
+	}
+
+	if input.DisplayName != "" {
+		output.Properties.DisplayName = utils.String(input.DisplayName)
+	}
+
+	return &output, nil
+}
+
+func expandReservationInstanceFlexibility(input bool) *reservationorders.InstanceFlexibility {
+	output := reservationorders.InstanceFlexibilityOff
+	if input {
+		output = reservationorders.InstanceFlexibilityOn
+	}
+	return &output
+}
@@ -0,0 +1,209 @@
+package reservations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// ReservationSplitResource splits a Reservation into two Reservations - a split can't be undone, so deleting this
+// resource only removes it from the state.
+type ReservationSplitResource struct{}
+
+var _ sdk.Resource = ReservationSplitResource{}
+
+type ReservationSplitResourceModel struct {
+	ReservationId  string   `tfschema:"reservation_id"`
+	Quantities     []int    `tfschema:"quantities"`
+	ReservationIds []string `tfschema:"reservation_ids"`
+}
+
+func (r ReservationSplitResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reservation_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: reservationorders.ValidateReservationID,
+		},
+
+		"quantities": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 2,
+			MaxItems: 2,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func (r ReservationSplitResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"reservation_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ReservationSplitResource) ModelObject() interface{} {
+	return &ReservationSplitResourceModel{}
+}
+
+func (r ReservationSplitResource) ResourceType() string {
+	return "azurerm_reservation_split"
+}
+
+func (r ReservationSplitResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return reservationorders.ValidateReservationID
+}
+
+func (r ReservationSplitResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			var model ReservationSplitResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := reservationorders.ParseReservationID(model.ReservationId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.ReservationGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			if len(reservationSplitDestinations(existing.Model.Properties)) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			quantities := make([]int64, 0)
+			total := int64(0)
+			for _, v := range model.Quantities {
+				quantities = append(quantities, int64(v))
+				total += int64(v)
+			}
+			if quantity := existing.Model.Properties.Quantity; quantity != nil && *quantity != total {
+				return fmt.Errorf("the sum of `quantities` (%d) must equal the quantity of %s (%d)", total, *id, *quantity)
+			}
+
+			orderId := reservationorders.NewReservationOrderID(id.ReservationOrderId)
+			payload := reservationorders.SplitRequest{
+				Properties: &reservationorders.SplitProperties{
+					Quantities:    &quantities,
+					ReservationId: utils.String(id.ID()),
+				},
+			}
+			if err := client.SplitThenPoll(ctx, orderId, payload); err != nil {
+				return fmt.Errorf("splitting %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ReservationSplitResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.ReservationOrdersClient
+
+			id, err := reservationorders.ParseReservationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReservationGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			var destinations []string
+			if model := resp.Model; model != nil && model.Properties != nil {
+				destinations = reservationSplitDestinations(model.Properties)
+			}
+			if len(destinations) == 0 {
+				log.Printf("[DEBUG] %s hasn't been split - removing from state", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state := ReservationSplitResourceModel{
+				ReservationId:  id.ID(),
+				Quantities:     make([]int, 0),
+				ReservationIds: make([]string, 0),
+			}
+
+			for _, destination := range destinations {
+				destinationId, err := reservationorders.ParseReservationIDInsensitively(destination)
+				if err != nil {
+					return err
+				}
+
+				destinationResp, err := client.ReservationGet(ctx, *destinationId)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *destinationId, err)
+				}
+
+				quantity := 0
+				if model := destinationResp.Model; model != nil && model.Properties != nil && model.Properties.Quantity != nil {
+					quantity = int(*model.Properties.Quantity)
+				}
+
+				state.Quantities = append(state.Quantities, quantity)
+				state.ReservationIds = append(state.ReservationIds, destinationId.ID())
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ReservationSplitResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := reservationorders.ParseReservationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] the split of %s can't be undone - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+func reservationSplitDestinations(input *reservationorders.ReservationsProperties) []string {
+	if input == nil || input.SplitProperties == nil || input.SplitProperties.SplitDestinations == nil {
+		return nil
+	}
+	return *input.SplitProperties.SplitDestinations
+}
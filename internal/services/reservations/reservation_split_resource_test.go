@@ -0,0 +1,70 @@
+package reservations_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/reservationorders"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ReservationSplitResource struct{}
+
+func TestAccReservationSplit_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_RESERVATIONS") == "" {
+		t.Skip(reservationsTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_reservation_split", "test")
+	r := ReservationSplitResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("reservation_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ReservationSplitResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := reservationorders.ParseReservationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Reservations.ReservationOrdersClient.ReservationGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.SplitProperties != nil && model.Properties.SplitProperties.SplitDestinations != nil {
+		return utils.Bool(len(*model.Properties.SplitProperties.SplitDestinations) > 0), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (ReservationSplitResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_reservation_split" "test" {
+  reservation_id = azurerm_reservation_order.test.reservation_id
+  quantities     = [1, 1]
+}
+`, ReservationOrderResource{}.basic(data))
+}
@@ -0,0 +1,321 @@
+package reservations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/savingsplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// SavingsPlanOrderResource purchases a Savings Plan Order using a Savings Plan Order Alias - a Savings Plan can't be
+// cancelled, so deleting this resource only removes it from the state.
+type SavingsPlanOrderResource struct{}
+
+var _ sdk.ResourceWithUpdate = SavingsPlanOrderResource{}
+
+type SavingsPlanOrderResourceModel struct {
+	Name                   string              `tfschema:"name"`
+	SkuName                string              `tfschema:"sku_name"`
+	BillingScopeId         string              `tfschema:"billing_scope_id"`
+	Term                   string              `tfschema:"term"`
+	BillingPlan            string              `tfschema:"billing_plan"`
+	CommitmentAmount       float64             `tfschema:"commitment_amount"`
+	CommitmentCurrencyCode string              `tfschema:"commitment_currency_code"`
+	DisplayName            string              `tfschema:"display_name"`
+	AppliedScopeType       string              `tfschema:"applied_scope_type"`
+	AppliedScope           []AppliedScopeModel `tfschema:"applied_scope"`
+	SavingsPlanOrderId     string              `tfschema:"savings_plan_order_id"`
+	SavingsPlanId          string              `tfschema:"savings_plan_id"`
+	BenefitStartTime       string              `tfschema:"benefit_start_time"`
+	ExpiryTime             string              `tfschema:"expiry_time"`
+}
+
+func (r SavingsPlanOrderResource) Arguments() map[string]*pluginsdk.Schema {
+	output := savingsPlanPurchaseSchema(true)
+	output["name"] = &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringMatch(
+			regexp.MustCompile(`^[a-zA-Z0-9_\-.]{1,64}$`),
+			"`name` must be between 1 and 64 characters and can only contain letters, numbers, underscores, hyphens and periods",
+		),
+	}
+	return output
+}
+
+func (r SavingsPlanOrderResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"savings_plan_order_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"savings_plan_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"benefit_start_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"expiry_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SavingsPlanOrderResource) ModelObject() interface{} {
+	return &SavingsPlanOrderResourceModel{}
+}
+
+func (r SavingsPlanOrderResource) ResourceType() string {
+	return "azurerm_savings_plan_order"
+}
+
+func (r SavingsPlanOrderResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return savingsplans.ValidateSavingsPlanOrderAliasID
+}
+
+func (r SavingsPlanOrderResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.SavingsPlansClient
+
+			var model SavingsPlanOrderResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := savingsplans.NewSavingsPlanOrderAliasID(model.Name)
+			existing, err := client.SavingsPlanOrderAliasGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			appliedScope, err := expandSavingsPlanAppliedScope(model.AppliedScopeType, model.AppliedScope)
+			if err != nil {
+				return err
+			}
+
+			appliedScopeType := savingsplans.AppliedScopeType(model.AppliedScopeType)
+			billingPlan := savingsplans.BillingPlan(model.BillingPlan)
+			grain := savingsplans.CommitmentGrainHourly
+			term := savingsplans.Term(model.Term)
+
+			payload := savingsplans.SavingsPlanOrderAliasModel{
+				Sku: savingsplans.ResourceSku{
+					Name: utils.String(model.SkuName),
+				},
+				Properties: &savingsplans.SavingsPlanOrderAliasProperties{
+					AppliedScopeProperties: appliedScope,
+					AppliedScopeType:       &appliedScopeType,
+					BillingPlan:            &billingPlan,
+					BillingScopeId:         utils.String(model.BillingScopeId),
+					Commitment: &savingsplans.Commitment{
+						Amount:       utils.Float(model.CommitmentAmount),
+						CurrencyCode: utils.String(model.CommitmentCurrencyCode),
+						Grain:        &grain,
+					},
+					Term: &term,
+				},
+			}
+
+			if model.DisplayName != "" {
+				payload.Properties.DisplayName = utils.String(model.DisplayName)
+			}
+
+			if err := client.SavingsPlanOrderAliasCreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SavingsPlanOrderResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.SavingsPlansClient
+
+			id, err := savingsplans.ParseSavingsPlanOrderAliasID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.SavingsPlanOrderAliasGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SavingsPlanOrderResourceModel{
+				Name: id.SavingsPlanOrderAliasName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.SkuName = utils.NormalizeNilableString(model.Sku.Name)
+
+				if props := model.Properties; props != nil {
+					if props.AppliedScopeType != nil {
+						state.AppliedScopeType = string(*props.AppliedScopeType)
+					}
+					state.AppliedScope = flattenSavingsPlanAppliedScope(props.AppliedScopeProperties)
+					if props.BillingPlan != nil {
+						state.BillingPlan = string(*props.BillingPlan)
+					}
+					state.BillingScopeId = utils.NormalizeNilableString(props.BillingScopeId)
+					if commitment := props.Commitment; commitment != nil {
+						if commitment.Amount != nil {
+							state.CommitmentAmount = *commitment.Amount
+						}
+						state.CommitmentCurrencyCode = utils.NormalizeNilableString(commitment.CurrencyCode)
+					}
+					state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+					if props.Term != nil {
+						state.Term = string(*props.Term)
+					}
+
+					if props.SavingsPlanOrderId != nil {
+						orderId, err := savingsplans.ParseSavingsPlanOrderIDInsensitively(*props.SavingsPlanOrderId)
+						if err != nil {
+							return err
+						}
+						state.SavingsPlanOrderId = orderId.ID()
+
+						// the Savings Plan Order Alias reflects the purchase, whereas the Savings Plan reflects any
+						// changes made since
+						savingsPlan, err := savingsPlanForOrder(ctx, client, *orderId)
+						if err != nil {
+							return err
+						}
+						if savingsPlan != nil {
+							state.SavingsPlanId = utils.NormalizeNilableString(savingsPlan.Id)
+							if props := savingsPlan.Properties; props != nil {
+								if props.AppliedScopeType != nil {
+									state.AppliedScopeType = string(*props.AppliedScopeType)
+								}
+								state.AppliedScope = flattenSavingsPlanAppliedScope(props.AppliedScopeProperties)
+								state.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+								state.BenefitStartTime = utils.NormalizeNilableString(props.BenefitStartTime)
+								state.ExpiryTime = utils.NormalizeNilableString(props.ExpiryDateTime)
+							}
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SavingsPlanOrderResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Reservations.SavingsPlansClient
+
+			id, err := savingsplans.ParseSavingsPlanOrderAliasID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model SavingsPlanOrderResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			savingsPlanId, err := savingsplans.ParseSavingsPlanIDInsensitively(model.SavingsPlanId)
+			if err != nil {
+				return fmt.Errorf("parsing the Savings Plan ID for %s: %+v", *id, err)
+			}
+
+			payload := savingsplans.SavingsPlanUpdateRequest{
+				Properties: &savingsplans.SavingsPlanUpdateRequestProperties{},
+			}
+
+			if metadata.ResourceData.HasChanges("applied_scope_type", "applied_scope") {
+				appliedScope, err := expandSavingsPlanAppliedScope(model.AppliedScopeType, model.AppliedScope)
+				if err != nil {
+					return err
+				}
+				appliedScopeType := savingsplans.AppliedScopeType(model.AppliedScopeType)
+				payload.Properties.AppliedScopeType = &appliedScopeType
+				payload.Properties.AppliedScopeProperties = appliedScope
+			}
+
+			if metadata.ResourceData.HasChange("display_name") {
+				payload.Properties.DisplayName = utils.String(model.DisplayName)
+			}
+
+			if err := client.SavingsPlanUpdateThenPoll(ctx, *savingsPlanId, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *savingsPlanId, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SavingsPlanOrderResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := savingsplans.ParseSavingsPlanOrderAliasID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] the Savings Plan purchased by %s can't be cancelled - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+// savingsPlanForOrder returns the Savings Plan within the specified Savings Plan Order, if any
+func savingsPlanForOrder(ctx context.Context, client *savingsplans.SavingsPlansClient, id savingsplans.SavingsPlanOrderId) (*savingsplans.SavingsPlanModel, error) {
+	order, err := client.SavingsPlanOrderGet(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if order.Model == nil || order.Model.Properties == nil || order.Model.Properties.SavingsPlans == nil || len(*order.Model.Properties.SavingsPlans) == 0 {
+		return nil, nil
+	}
+
+	savingsPlanId, err := savingsplans.ParseSavingsPlanIDInsensitively((*order.Model.Properties.SavingsPlans)[0])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SavingsPlanGet(ctx, *savingsPlanId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *savingsPlanId, err)
+	}
+	if resp.Model == nil {
+		return nil, nil
+	}
+
+	resp.Model.Id = utils.String(savingsPlanId.ID())
+	return resp.Model, nil
+}
@@ -0,0 +1,147 @@
+package reservations_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/reservations/sdk/2022-11-01/savingsplans"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SavingsPlanOrderResource struct{}
+
+func TestAccSavingsPlanOrder_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_RESERVATIONS") == "" {
+		t.Skip(reservationsTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_savings_plan_order", "test")
+	r := SavingsPlanOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("savings_plan_order_id").Exists(),
+				check.That(data.ResourceName).Key("savings_plan_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.managementGroupScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSavingsPlanOrder_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_RESERVATIONS") == "" {
+		t.Skip(reservationsTestSkipMessage)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_savings_plan_order", "test")
+	r := SavingsPlanOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (SavingsPlanOrderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := savingsplans.ParseSavingsPlanOrderAliasID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Reservations.SavingsPlansClient.SavingsPlanOrderAliasGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (SavingsPlanOrderResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_savings_plan_order" "test" {
+  name                     = "acctest-savings-plan-%d"
+  billing_scope_id         = data.azurerm_subscription.current.id
+  term                     = "P1Y"
+  commitment_amount        = 0.01
+  commitment_currency_code = "USD"
+  applied_scope_type       = "Shared"
+}
+`, data.RandomInteger)
+}
+
+func (SavingsPlanOrderResource) managementGroupScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+data "azurerm_subscription" "current" {}
+
+data "azurerm_management_group" "tenant_root" {
+  name = data.azurerm_client_config.current.tenant_id
+}
+
+resource "azurerm_savings_plan_order" "test" {
+  name                     = "acctest-savings-plan-%d"
+  billing_scope_id         = data.azurerm_subscription.current.id
+  term                     = "P1Y"
+  commitment_amount        = 0.01
+  commitment_currency_code = "USD"
+  display_name             = "acctest-savings-plan-updated-%d"
+  applied_scope_type       = "ManagementGroup"
+
+  applied_scope {
+    management_group_id = data.azurerm_management_group.tenant_root.id
+    tenant_id           = data.azurerm_client_config.current.tenant_id
+  }
+}
+`, data.RandomInteger, data.RandomInteger)
+}
+
+func (r SavingsPlanOrderResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_savings_plan_order" "import" {
+  name                     = azurerm_savings_plan_order.test.name
+  billing_scope_id         = azurerm_savings_plan_order.test.billing_scope_id
+  term                     = azurerm_savings_plan_order.test.term
+  commitment_amount        = azurerm_savings_plan_order.test.commitment_amount
+  commitment_currency_code = azurerm_savings_plan_order.test.commitment_currency_code
+  applied_scope_type       = azurerm_savings_plan_order.test.applied_scope_type
+}
+`, r.basic(data))
+}
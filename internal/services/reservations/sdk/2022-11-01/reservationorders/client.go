@@ -0,0 +1,15 @@
+package reservationorders
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ReservationOrdersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewReservationOrdersClientWithBaseURI(endpoint string) ReservationOrdersClient {
+	return ReservationOrdersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
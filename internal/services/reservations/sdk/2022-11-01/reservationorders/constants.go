@@ -0,0 +1,404 @@
+package reservationorders
+
+import "strings"
+
+type AppliedScopeType string
+
+const (
+	AppliedScopeTypeManagementGroup AppliedScopeType = "ManagementGroup"
+	AppliedScopeTypeShared          AppliedScopeType = "Shared"
+	AppliedScopeTypeSingle          AppliedScopeType = "Single"
+)
+
+func PossibleValuesForAppliedScopeType() []string {
+	return []string{
+		string(AppliedScopeTypeManagementGroup),
+		string(AppliedScopeTypeShared),
+		string(AppliedScopeTypeSingle),
+	}
+}
+
+func parseAppliedScopeType(input string) (*AppliedScopeType, error) {
+	vals := map[string]AppliedScopeType{
+		"managementgroup": AppliedScopeTypeManagementGroup,
+		"shared":          AppliedScopeTypeShared,
+		"single":          AppliedScopeTypeSingle,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AppliedScopeType(input)
+	return &out, nil
+}
+
+type CalculateExchangeOperationResultStatus string
+
+const (
+	CalculateExchangeOperationResultStatusCancelled CalculateExchangeOperationResultStatus = "Cancelled"
+	CalculateExchangeOperationResultStatusFailed    CalculateExchangeOperationResultStatus = "Failed"
+	CalculateExchangeOperationResultStatusPending   CalculateExchangeOperationResultStatus = "Pending"
+	CalculateExchangeOperationResultStatusSucceeded CalculateExchangeOperationResultStatus = "Succeeded"
+)
+
+func PossibleValuesForCalculateExchangeOperationResultStatus() []string {
+	return []string{
+		string(CalculateExchangeOperationResultStatusCancelled),
+		string(CalculateExchangeOperationResultStatusFailed),
+		string(CalculateExchangeOperationResultStatusPending),
+		string(CalculateExchangeOperationResultStatusSucceeded),
+	}
+}
+
+func parseCalculateExchangeOperationResultStatus(input string) (*CalculateExchangeOperationResultStatus, error) {
+	vals := map[string]CalculateExchangeOperationResultStatus{
+		"cancelled": CalculateExchangeOperationResultStatusCancelled,
+		"failed":    CalculateExchangeOperationResultStatusFailed,
+		"pending":   CalculateExchangeOperationResultStatusPending,
+		"succeeded": CalculateExchangeOperationResultStatusSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CalculateExchangeOperationResultStatus(input)
+	return &out, nil
+}
+
+type ExchangeOperationResultStatus string
+
+const (
+	ExchangeOperationResultStatusCancelled        ExchangeOperationResultStatus = "Cancelled"
+	ExchangeOperationResultStatusFailed           ExchangeOperationResultStatus = "Failed"
+	ExchangeOperationResultStatusPendingPurchases ExchangeOperationResultStatus = "PendingPurchases"
+	ExchangeOperationResultStatusPendingRefunds   ExchangeOperationResultStatus = "PendingRefunds"
+	ExchangeOperationResultStatusSucceeded        ExchangeOperationResultStatus = "Succeeded"
+)
+
+func PossibleValuesForExchangeOperationResultStatus() []string {
+	return []string{
+		string(ExchangeOperationResultStatusCancelled),
+		string(ExchangeOperationResultStatusFailed),
+		string(ExchangeOperationResultStatusPendingPurchases),
+		string(ExchangeOperationResultStatusPendingRefunds),
+		string(ExchangeOperationResultStatusSucceeded),
+	}
+}
+
+func parseExchangeOperationResultStatus(input string) (*ExchangeOperationResultStatus, error) {
+	vals := map[string]ExchangeOperationResultStatus{
+		"cancelled":        ExchangeOperationResultStatusCancelled,
+		"failed":           ExchangeOperationResultStatusFailed,
+		"pendingpurchases": ExchangeOperationResultStatusPendingPurchases,
+		"pendingrefunds":   ExchangeOperationResultStatusPendingRefunds,
+		"succeeded":        ExchangeOperationResultStatusSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ExchangeOperationResultStatus(input)
+	return &out, nil
+}
+
+type InstanceFlexibility string
+
+const (
+	InstanceFlexibilityOff InstanceFlexibility = "Off"
+	InstanceFlexibilityOn  InstanceFlexibility = "On"
+)
+
+func PossibleValuesForInstanceFlexibility() []string {
+	return []string{
+		string(InstanceFlexibilityOff),
+		string(InstanceFlexibilityOn),
+	}
+}
+
+func parseInstanceFlexibility(input string) (*InstanceFlexibility, error) {
+	vals := map[string]InstanceFlexibility{
+		"off": InstanceFlexibilityOff,
+		"on":  InstanceFlexibilityOn,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := InstanceFlexibility(input)
+	return &out, nil
+}
+
+type OperationStatus string
+
+const (
+	OperationStatusCancelled OperationStatus = "Cancelled"
+	OperationStatusFailed    OperationStatus = "Failed"
+	OperationStatusPending   OperationStatus = "Pending"
+	OperationStatusSucceeded OperationStatus = "Succeeded"
+)
+
+func PossibleValuesForOperationStatus() []string {
+	return []string{
+		string(OperationStatusCancelled),
+		string(OperationStatusFailed),
+		string(OperationStatusPending),
+		string(OperationStatusSucceeded),
+	}
+}
+
+func parseOperationStatus(input string) (*OperationStatus, error) {
+	vals := map[string]OperationStatus{
+		"cancelled": OperationStatusCancelled,
+		"failed":    OperationStatusFailed,
+		"pending":   OperationStatusPending,
+		"succeeded": OperationStatusSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := OperationStatus(input)
+	return &out, nil
+}
+
+type ReservationBillingPlan string
+
+const (
+	ReservationBillingPlanMonthly ReservationBillingPlan = "Monthly"
+	ReservationBillingPlanUpfront ReservationBillingPlan = "Upfront"
+)
+
+func PossibleValuesForReservationBillingPlan() []string {
+	return []string{
+		string(ReservationBillingPlanMonthly),
+		string(ReservationBillingPlanUpfront),
+	}
+}
+
+func parseReservationBillingPlan(input string) (*ReservationBillingPlan, error) {
+	vals := map[string]ReservationBillingPlan{
+		"monthly": ReservationBillingPlanMonthly,
+		"upfront": ReservationBillingPlanUpfront,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReservationBillingPlan(input)
+	return &out, nil
+}
+
+type ReservationTerm string
+
+const (
+	ReservationTermPFiveY  ReservationTerm = "P5Y"
+	ReservationTermPOneY   ReservationTerm = "P1Y"
+	ReservationTermPThreeY ReservationTerm = "P3Y"
+)
+
+func PossibleValuesForReservationTerm() []string {
+	return []string{
+		string(ReservationTermPFiveY),
+		string(ReservationTermPOneY),
+		string(ReservationTermPThreeY),
+	}
+}
+
+func parseReservationTerm(input string) (*ReservationTerm, error) {
+	vals := map[string]ReservationTerm{
+		"p5y": ReservationTermPFiveY,
+		"p1y": ReservationTermPOneY,
+		"p3y": ReservationTermPThreeY,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReservationTerm(input)
+	return &out, nil
+}
+
+type ReservedResourceType string
+
+const (
+	ReservedResourceTypeAVS                    ReservedResourceType = "AVS"
+	ReservedResourceTypeAppService             ReservedResourceType = "AppService"
+	ReservedResourceTypeAzureDataExplorer      ReservedResourceType = "AzureDataExplorer"
+	ReservedResourceTypeAzureFiles             ReservedResourceType = "AzureFiles"
+	ReservedResourceTypeBlockBlob              ReservedResourceType = "BlockBlob"
+	ReservedResourceTypeCosmosDb               ReservedResourceType = "CosmosDb"
+	ReservedResourceTypeDataFactory            ReservedResourceType = "DataFactory"
+	ReservedResourceTypeDatabricks             ReservedResourceType = "Databricks"
+	ReservedResourceTypeDedicatedHost          ReservedResourceType = "DedicatedHost"
+	ReservedResourceTypeManagedDisk            ReservedResourceType = "ManagedDisk"
+	ReservedResourceTypeMariaDb                ReservedResourceType = "MariaDb"
+	ReservedResourceTypeMySql                  ReservedResourceType = "MySql"
+	ReservedResourceTypeNetAppStorage          ReservedResourceType = "NetAppStorage"
+	ReservedResourceTypePostgreSql             ReservedResourceType = "PostgreSql"
+	ReservedResourceTypeRedHat                 ReservedResourceType = "RedHat"
+	ReservedResourceTypeRedHatOsa              ReservedResourceType = "RedHatOsa"
+	ReservedResourceTypeRedisCache             ReservedResourceType = "RedisCache"
+	ReservedResourceTypeSapHana                ReservedResourceType = "SapHana"
+	ReservedResourceTypeSqlAzureHybridBenefit  ReservedResourceType = "SqlAzureHybridBenefit"
+	ReservedResourceTypeSqlDataWarehouse       ReservedResourceType = "SqlDataWarehouse"
+	ReservedResourceTypeSqlDatabases           ReservedResourceType = "SqlDatabases"
+	ReservedResourceTypeSqlEdge                ReservedResourceType = "SqlEdge"
+	ReservedResourceTypeSuseLinux              ReservedResourceType = "SuseLinux"
+	ReservedResourceTypeVMwareCloudSimple      ReservedResourceType = "VMwareCloudSimple"
+	ReservedResourceTypeVirtualMachineSoftware ReservedResourceType = "VirtualMachineSoftware"
+	ReservedResourceTypeVirtualMachines        ReservedResourceType = "VirtualMachines"
+)
+
+func PossibleValuesForReservedResourceType() []string {
+	return []string{
+		string(ReservedResourceTypeAVS),
+		string(ReservedResourceTypeAppService),
+		string(ReservedResourceTypeAzureDataExplorer),
+		string(ReservedResourceTypeAzureFiles),
+		string(ReservedResourceTypeBlockBlob),
+		string(ReservedResourceTypeCosmosDb),
+		string(ReservedResourceTypeDataFactory),
+		string(ReservedResourceTypeDatabricks),
+		string(ReservedResourceTypeDedicatedHost),
+		string(ReservedResourceTypeManagedDisk),
+		string(ReservedResourceTypeMariaDb),
+		string(ReservedResourceTypeMySql),
+		string(ReservedResourceTypeNetAppStorage),
+		string(ReservedResourceTypePostgreSql),
+		string(ReservedResourceTypeRedHat),
+		string(ReservedResourceTypeRedHatOsa),
+		string(ReservedResourceTypeRedisCache),
+		string(ReservedResourceTypeSapHana),
+		string(ReservedResourceTypeSqlAzureHybridBenefit),
+		string(ReservedResourceTypeSqlDataWarehouse),
+		string(ReservedResourceTypeSqlDatabases),
+		string(ReservedResourceTypeSqlEdge),
+		string(ReservedResourceTypeSuseLinux),
+		string(ReservedResourceTypeVMwareCloudSimple),
+		string(ReservedResourceTypeVirtualMachineSoftware),
+		string(ReservedResourceTypeVirtualMachines),
+	}
+}
+
+func parseReservedResourceType(input string) (*ReservedResourceType, error) {
+	vals := map[string]ReservedResourceType{
+		"avs":                    ReservedResourceTypeAVS,
+		"appservice":             ReservedResourceTypeAppService,
+		"azuredataexplorer":      ReservedResourceTypeAzureDataExplorer,
+		"azurefiles":             ReservedResourceTypeAzureFiles,
+		"blockblob":              ReservedResourceTypeBlockBlob,
+		"cosmosdb":               ReservedResourceTypeCosmosDb,
+		"datafactory":            ReservedResourceTypeDataFactory,
+		"databricks":             ReservedResourceTypeDatabricks,
+		"dedicatedhost":          ReservedResourceTypeDedicatedHost,
+		"manageddisk":            ReservedResourceTypeManagedDisk,
+		"mariadb":                ReservedResourceTypeMariaDb,
+		"mysql":                  ReservedResourceTypeMySql,
+		"netappstorage":          ReservedResourceTypeNetAppStorage,
+		"postgresql":             ReservedResourceTypePostgreSql,
+		"redhat":                 ReservedResourceTypeRedHat,
+		"redhatosa":              ReservedResourceTypeRedHatOsa,
+		"rediscache":             ReservedResourceTypeRedisCache,
+		"saphana":                ReservedResourceTypeSapHana,
+		"sqlazurehybridbenefit":  ReservedResourceTypeSqlAzureHybridBenefit,
+		"sqldatawarehouse":       ReservedResourceTypeSqlDataWarehouse,
+		"sqldatabases":           ReservedResourceTypeSqlDatabases,
+		"sqledge":                ReservedResourceTypeSqlEdge,
+		"suselinux":              ReservedResourceTypeSuseLinux,
+		"vmwarecloudsimple":      ReservedResourceTypeVMwareCloudSimple,
+		"virtualmachinesoftware": ReservedResourceTypeVirtualMachineSoftware,
+		"virtualmachines":        ReservedResourceTypeVirtualMachines,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReservedResourceType(input)
+	return &out, nil
+}
+
+type SavingsPlanBillingPlan string
+
+const (
+	SavingsPlanBillingPlanPOneM SavingsPlanBillingPlan = "P1M"
+)
+
+func PossibleValuesForSavingsPlanBillingPlan() []string {
+	return []string{
+		string(SavingsPlanBillingPlanPOneM),
+	}
+}
+
+func parseSavingsPlanBillingPlan(input string) (*SavingsPlanBillingPlan, error) {
+	vals := map[string]SavingsPlanBillingPlan{
+		"p1m": SavingsPlanBillingPlanPOneM,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SavingsPlanBillingPlan(input)
+	return &out, nil
+}
+
+type SavingsPlanTerm string
+
+const (
+	SavingsPlanTermPOneY   SavingsPlanTerm = "P1Y"
+	SavingsPlanTermPThreeY SavingsPlanTerm = "P3Y"
+)
+
+func PossibleValuesForSavingsPlanTerm() []string {
+	return []string{
+		string(SavingsPlanTermPOneY),
+		string(SavingsPlanTermPThreeY),
+	}
+}
+
+func parseSavingsPlanTerm(input string) (*SavingsPlanTerm, error) {
+	vals := map[string]SavingsPlanTerm{
+		"p1y": SavingsPlanTermPOneY,
+		"p3y": SavingsPlanTermPThreeY,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SavingsPlanTerm(input)
+	return &out, nil
+}
+
+type CommitmentGrain string
+
+const (
+	CommitmentGrainHourly CommitmentGrain = "Hourly"
+)
+
+func PossibleValuesForCommitmentGrain() []string {
+	return []string{
+		string(CommitmentGrainHourly),
+	}
+}
+
+func parseCommitmentGrain(input string) (*CommitmentGrain, error) {
+	vals := map[string]CommitmentGrain{
+		"hourly": CommitmentGrainHourly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CommitmentGrain(input)
+	return &out, nil
+}
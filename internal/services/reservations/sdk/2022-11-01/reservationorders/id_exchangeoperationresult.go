@@ -0,0 +1,98 @@
+package reservationorders
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ExchangeOperationResultId{}
+
+// ExchangeOperationResultId is a struct representing the Resource ID for a Exchange Operation Result
+type ExchangeOperationResultId struct {
+	OperationId string
+}
+
+// NewExchangeOperationResultID returns a new ExchangeOperationResultId struct
+func NewExchangeOperationResultID(operationId string) ExchangeOperationResultId {
+	return ExchangeOperationResultId{
+		OperationId: operationId,
+	}
+}
+
+// ParseExchangeOperationResultID parses 'input' into a ExchangeOperationResultId
+func ParseExchangeOperationResultID(input string) (*ExchangeOperationResultId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ExchangeOperationResultId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ExchangeOperationResultId{}
+
+	if id.OperationId, ok = parsed.Parsed["operationId"]; !ok {
+		return nil, fmt.Errorf("the segment 'operationId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseExchangeOperationResultIDInsensitively parses 'input' case-insensitively into a ExchangeOperationResultId
+// note: this method should only be used for API response data and not user input
+func ParseExchangeOperationResultIDInsensitively(input string) (*ExchangeOperationResultId, error) {
+	parser := resourceids.NewParserFromResourceIdType(ExchangeOperationResultId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := ExchangeOperationResultId{}
+
+	if id.OperationId, ok = parsed.Parsed["operationId"]; !ok {
+		return nil, fmt.Errorf("the segment 'operationId' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateExchangeOperationResultID checks that 'input' can be parsed as a Exchange Operation Result ID
+func ValidateExchangeOperationResultID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseExchangeOperationResultID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Exchange Operation Result ID
+func (id ExchangeOperationResultId) ID() string {
+	fmtString := "/providers/Microsoft.Capacity/exchangeOperationResults/%s"
+	return fmt.Sprintf(fmtString, id.OperationId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Exchange Operation Result ID
+func (id ExchangeOperationResultId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftCapacity", "Microsoft.Capacity", "Microsoft.Capacity"),
+		resourceids.StaticSegment("staticExchangeOperationResults", "exchangeOperationResults", "exchangeOperationResults"),
+		resourceids.UserSpecifiedSegment("operationId", "operationIdValue"),
+	}
+}
+
+// String returns a human-readable description of this Exchange Operation Result ID
+func (id ExchangeOperationResultId) String() string {
+	components := []string{
+		fmt.Sprintf("Operation Id: %q", id.OperationId),
+	}
+	return fmt.Sprintf("Exchange Operation Result (%s)", strings.Join(components, "\n"))
+}
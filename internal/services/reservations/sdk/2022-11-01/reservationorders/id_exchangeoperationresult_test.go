@@ -0,0 +1,174 @@
+package reservationorders
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ExchangeOperationResultId{}
+
+func TestNewExchangeOperationResultID(t *testing.T) {
+	id := NewExchangeOperationResultID("operationIdValue")
+
+	if id.OperationId != "operationIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'OperationId'", id.OperationId, "operationIdValue")
+	}
+}
+
+func TestFormatExchangeOperationResultID(t *testing.T) {
+	actual := NewExchangeOperationResultID("operationIdValue").ID()
+	expected := "/providers/Microsoft.Capacity/exchangeOperationResults/operationIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseExchangeOperationResultID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ExchangeOperationResultId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity/exchangeOperationResults",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Capacity/exchangeOperationResults/operationIdValue",
+			Expected: &ExchangeOperationResultId{
+				OperationId: "operationIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Capacity/exchangeOperationResults/operationIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseExchangeOperationResultID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.OperationId != v.Expected.OperationId {
+			t.Fatalf("Expected %q but got %q for OperationId", v.Expected.OperationId, actual.OperationId)
+		}
+
+	}
+}
+
+func TestParseExchangeOperationResultIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ExchangeOperationResultId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity/exchangeOperationResults",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy/ExChAnGeOpErAtIoNrEsUlTs",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Capacity/exchangeOperationResults/operationIdValue",
+			Expected: &ExchangeOperationResultId{
+				OperationId: "operationIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Capacity/exchangeOperationResults/operationIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy/ExChAnGeOpErAtIoNrEsUlTs/OpErAtIoNiDvAlUe",
+			Expected: &ExchangeOperationResultId{
+				OperationId: "OpErAtIoNiDvAlUe",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy/ExChAnGeOpErAtIoNrEsUlTs/OpErAtIoNiDvAlUe/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseExchangeOperationResultIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.OperationId != v.Expected.OperationId {
+			t.Fatalf("Expected %q but got %q for OperationId", v.Expected.OperationId, actual.OperationId)
+		}
+
+	}
+}
@@ -0,0 +1,174 @@
+package reservationorders
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = ReservationOrderId{}
+
+func TestNewReservationOrderID(t *testing.T) {
+	id := NewReservationOrderID("reservationOrderIdValue")
+
+	if id.ReservationOrderId != "reservationOrderIdValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'ReservationOrderId'", id.ReservationOrderId, "reservationOrderIdValue")
+	}
+}
+
+func TestFormatReservationOrderID(t *testing.T) {
+	actual := NewReservationOrderID("reservationOrderIdValue").ID()
+	expected := "/providers/Microsoft.Capacity/reservationOrders/reservationOrderIdValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseReservationOrderID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ReservationOrderId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity/reservationOrders",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Capacity/reservationOrders/reservationOrderIdValue",
+			Expected: &ReservationOrderId{
+				ReservationOrderId: "reservationOrderIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Capacity/reservationOrders/reservationOrderIdValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseReservationOrderID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ReservationOrderId != v.Expected.ReservationOrderId {
+			t.Fatalf("Expected %q but got %q for ReservationOrderId", v.Expected.ReservationOrderId, actual.ReservationOrderId)
+		}
+
+	}
+}
+
+func TestParseReservationOrderIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ReservationOrderId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.Capacity/reservationOrders",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy/ReSeRvAtIoNoRdErS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.Capacity/reservationOrders/reservationOrderIdValue",
+			Expected: &ReservationOrderId{
+				ReservationOrderId: "reservationOrderIdValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.Capacity/reservationOrders/reservationOrderIdValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy/ReSeRvAtIoNoRdErS/ReSeRvAtIoNoRdErIdVaLuE",
+			Expected: &ReservationOrderId{
+				ReservationOrderId: "ReSeRvAtIoNoRdErIdVaLuE",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.CaPaCiTy/ReSeRvAtIoNoRdErS/ReSeRvAtIoNoRdErIdVaLuE/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseReservationOrderIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ReservationOrderId != v.Expected.ReservationOrderId {
+			t.Fatalf("Expected %q but got %q for ReservationOrderId", v.Expected.ReservationOrderId, actual.ReservationOrderId)
+		}
+
+	}
+}
@@ -0,0 +1,106 @@
+package reservationorders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// CalculateExchangeThenPoll performs CalculateExchange, polls until it's completed and then returns the result
+func (c ReservationOrdersClient) CalculateExchangeThenPoll(ctx context.Context, input CalculateExchangeRequest) (*CalculateExchangeOperationResultResponse, error) {
+	var result CalculateExchangeOperationResultResponse
+	if err := c.tenantActionThenPoll(ctx, "CalculateExchange", "/providers/Microsoft.Capacity/calculateExchange", input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ExchangeThenPoll performs Exchange, polls until it's completed and then returns the result
+func (c ReservationOrdersClient) ExchangeThenPoll(ctx context.Context, input ExchangeRequest) (*ExchangeOperationResultResponse, error) {
+	var result ExchangeOperationResultResponse
+	if err := c.tenantActionThenPoll(ctx, "Exchange", "/providers/Microsoft.Capacity/exchange", input, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// tenantActionThenPoll performs a long running tenant-level action and unmarshals the operation result into 'output',
+// since the result of these operations is only available from the operation status endpoint
+func (c ReservationOrdersClient) tenantActionThenPoll(ctx context.Context, name string, path string, input interface{}, output interface{}) error {
+	req, err := c.preparerForTenantAction(ctx, path, input)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", name, nil, "Failure preparing request")
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", name, resp, "Failure sending request")
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		if err := autorest.Respond(resp, autorest.ByUnmarshallingJSON(output), autorest.ByClosing()); err != nil {
+			return autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", name, resp, "Failure responding to request")
+		}
+		return nil
+	}
+
+	operationUri := resp.Header.Get("Azure-AsyncOperation")
+	if operationUri == "" {
+		operationUri = resp.Header.Get("Location")
+	}
+	if operationUri == "" {
+		return fmt.Errorf("performing %s: the operation status URI was not returned", name)
+	}
+
+	poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	if err != nil {
+		return fmt.Errorf("performing %s: %+v", name, err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after %s: %+v", name, err)
+	}
+
+	uri, err := url.Parse(operationUri)
+	if err != nil {
+		return fmt.Errorf("parsing the operation status URI %q: %+v", operationUri, err)
+	}
+
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		queryParameters[k] = autorest.Encode("query", v[0])
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	statusReq, err := preparer.Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", name, nil, "Failure preparing operation status request")
+	}
+
+	statusResp, err := c.Client.Send(statusReq, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", name, statusResp, "Failure sending operation status request")
+	}
+
+	err = autorest.Respond(
+		statusResp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(output),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", name, statusResp, "Failure responding to operation status request")
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package reservationorders
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CalculatePriceOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *CalculatePriceResponse
+}
+
+// CalculatePrice ...
+func (c ReservationOrdersClient) CalculatePrice(ctx context.Context, input PurchaseRequest) (result CalculatePriceOperationResponse, err error) {
+	req, err := c.preparerForTenantAction(ctx, "/providers/Microsoft.Capacity/calculatePrice", input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "CalculatePrice", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "CalculatePrice", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		result.HttpResponse,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "CalculatePrice", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForTenantAction prepares a POST request against a tenant-level action within Microsoft.Capacity
+func (c ReservationOrdersClient) preparerForTenantAction(ctx context.Context, path string, input interface{}) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(path),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
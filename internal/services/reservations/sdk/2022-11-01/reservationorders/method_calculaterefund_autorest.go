@@ -0,0 +1,66 @@
+package reservationorders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CalculateRefundResponse struct {
+	HttpResponse *http.Response
+	Model        *RefundResponse
+}
+
+// CalculateRefund ...
+func (c ReservationOrdersClient) CalculateRefund(ctx context.Context, id ReservationOrderId, input CalculateRefundRequest) (result CalculateRefundResponse, err error) {
+	req, err := c.preparerForCalculateRefund(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "CalculateRefund", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "CalculateRefund", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCalculateRefund(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "CalculateRefund", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCalculateRefund prepares the CalculateRefund request.
+func (c ReservationOrdersClient) preparerForCalculateRefund(ctx context.Context, id ReservationOrderId, input CalculateRefundRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/calculateRefund", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCalculateRefund handles the response to the CalculateRefund request. The method always
+// closes the http.Response Body.
+func (c ReservationOrdersClient) responderForCalculateRefund(resp *http.Response) (result CalculateRefundResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
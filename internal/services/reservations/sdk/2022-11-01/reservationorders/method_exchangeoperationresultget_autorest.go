@@ -0,0 +1,64 @@
+package reservationorders
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ExchangeOperationResultGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ExchangeOperationResultResponse
+}
+
+// ExchangeOperationResultGet ...
+func (c ReservationOrdersClient) ExchangeOperationResultGet(ctx context.Context, id ExchangeOperationResultId) (result ExchangeOperationResultGetResponse, err error) {
+	req, err := c.preparerForExchangeOperationResultGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ExchangeOperationResultGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ExchangeOperationResultGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForExchangeOperationResultGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ExchangeOperationResultGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForExchangeOperationResultGet prepares the ExchangeOperationResultGet request.
+func (c ReservationOrdersClient) preparerForExchangeOperationResultGet(ctx context.Context, id ExchangeOperationResultId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForExchangeOperationResultGet handles the response to the ExchangeOperationResultGet request. The method always
+// closes the http.Response Body.
+func (c ReservationOrdersClient) responderForExchangeOperationResultGet(resp *http.Response) (result ExchangeOperationResultGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
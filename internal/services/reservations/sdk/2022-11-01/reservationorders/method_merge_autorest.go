@@ -0,0 +1,75 @@
+package reservationorders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type MergeResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Merge ...
+func (c ReservationOrdersClient) Merge(ctx context.Context, id ReservationOrderId, input MergeRequest) (result MergeResponse, err error) {
+	req, err := c.preparerForMerge(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Merge", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForMerge(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Merge", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// MergeThenPoll performs Merge then polls until it's completed
+func (c ReservationOrdersClient) MergeThenPoll(ctx context.Context, id ReservationOrderId, input MergeRequest) error {
+	result, err := c.Merge(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Merge: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Merge: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForMerge prepares the Merge request.
+func (c ReservationOrdersClient) preparerForMerge(ctx context.Context, id ReservationOrderId, input MergeRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/merge", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForMerge sends the Merge request. The method will close the
+// http.Response Body if it receives an error.
+func (c ReservationOrdersClient) senderForMerge(ctx context.Context, req *http.Request) (future MergeResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,75 @@
+package reservationorders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type PurchaseResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Purchase ...
+func (c ReservationOrdersClient) Purchase(ctx context.Context, id ReservationOrderId, input PurchaseRequest) (result PurchaseResponse, err error) {
+	req, err := c.preparerForPurchase(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Purchase", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForPurchase(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Purchase", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// PurchaseThenPoll performs Purchase then polls until it's completed
+func (c ReservationOrdersClient) PurchaseThenPoll(ctx context.Context, id ReservationOrderId, input PurchaseRequest) error {
+	result, err := c.Purchase(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Purchase: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Purchase: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForPurchase prepares the Purchase request.
+func (c ReservationOrdersClient) preparerForPurchase(ctx context.Context, id ReservationOrderId, input PurchaseRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForPurchase sends the Purchase request. The method will close the
+// http.Response Body if it receives an error.
+func (c ReservationOrdersClient) senderForPurchase(ctx context.Context, req *http.Request) (future PurchaseResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package reservationorders
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ReservationGetResponse struct {
+	HttpResponse *http.Response
+	Model        *ReservationResponse
+}
+
+// ReservationGet ...
+func (c ReservationOrdersClient) ReservationGet(ctx context.Context, id ReservationId) (result ReservationGetResponse, err error) {
+	req, err := c.preparerForReservationGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ReservationGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ReservationGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForReservationGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ReservationGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForReservationGet prepares the ReservationGet request.
+func (c ReservationOrdersClient) preparerForReservationGet(ctx context.Context, id ReservationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForReservationGet handles the response to the ReservationGet request. The method always
+// closes the http.Response Body.
+func (c ReservationOrdersClient) responderForReservationGet(resp *http.Response) (result ReservationGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
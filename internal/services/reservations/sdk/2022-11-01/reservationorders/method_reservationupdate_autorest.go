@@ -0,0 +1,75 @@
+package reservationorders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ReservationUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ReservationUpdate ...
+func (c ReservationOrdersClient) ReservationUpdate(ctx context.Context, id ReservationId, input Patch) (result ReservationUpdateResponse, err error) {
+	req, err := c.preparerForReservationUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ReservationUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForReservationUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "ReservationUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ReservationUpdateThenPoll performs ReservationUpdate then polls until it's completed
+func (c ReservationOrdersClient) ReservationUpdateThenPoll(ctx context.Context, id ReservationId, input Patch) error {
+	result, err := c.ReservationUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ReservationUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ReservationUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForReservationUpdate prepares the ReservationUpdate request.
+func (c ReservationOrdersClient) preparerForReservationUpdate(ctx context.Context, id ReservationId, input Patch) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForReservationUpdate sends the ReservationUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c ReservationOrdersClient) senderForReservationUpdate(ctx context.Context, req *http.Request) (future ReservationUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
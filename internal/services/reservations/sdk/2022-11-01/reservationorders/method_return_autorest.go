@@ -0,0 +1,75 @@
+package reservationorders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ReturnResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Return ...
+func (c ReservationOrdersClient) Return(ctx context.Context, id ReservationOrderId, input RefundRequest) (result ReturnResponse, err error) {
+	req, err := c.preparerForReturn(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Return", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForReturn(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Return", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ReturnThenPoll performs Return then polls until it's completed
+func (c ReservationOrdersClient) ReturnThenPoll(ctx context.Context, id ReservationOrderId, input RefundRequest) error {
+	result, err := c.Return(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Return: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Return: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForReturn prepares the Return request.
+func (c ReservationOrdersClient) preparerForReturn(ctx context.Context, id ReservationOrderId, input RefundRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/return", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForReturn sends the Return request. The method will close the
+// http.Response Body if it receives an error.
+func (c ReservationOrdersClient) senderForReturn(ctx context.Context, req *http.Request) (future ReturnResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
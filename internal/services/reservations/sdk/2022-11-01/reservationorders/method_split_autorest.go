@@ -0,0 +1,75 @@
+package reservationorders
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SplitResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Split ...
+func (c ReservationOrdersClient) Split(ctx context.Context, id ReservationOrderId, input SplitRequest) (result SplitResponse, err error) {
+	req, err := c.preparerForSplit(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Split", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSplit(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "reservationorders.ReservationOrdersClient", "Split", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SplitThenPoll performs Split then polls until it's completed
+func (c ReservationOrdersClient) SplitThenPoll(ctx context.Context, id ReservationOrderId, input SplitRequest) error {
+	result, err := c.Split(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Split: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Split: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSplit prepares the Split request.
+func (c ReservationOrdersClient) preparerForSplit(ctx context.Context, id ReservationOrderId, input SplitRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/split", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSplit sends the Split request. The method will close the
+// http.Response Body if it receives an error.
+func (c ReservationOrdersClient) senderForSplit(ctx context.Context, req *http.Request) (future SplitResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,9 @@
+package reservationorders
+
+type CalculateExchangeOperationResultResponse struct {
+	Error      *OperationResultError                   `json:"error,omitempty"`
+	Id         *string                                 `json:"id,omitempty"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties *CalculateExchangeResponseProperties    `json:"properties,omitempty"`
+	Status     *CalculateExchangeOperationResultStatus `json:"status,omitempty"`
+}
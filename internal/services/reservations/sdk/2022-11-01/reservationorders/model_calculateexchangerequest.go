@@ -0,0 +1,5 @@
+package reservationorders
+
+type CalculateExchangeRequest struct {
+	Properties *CalculateExchangeRequestProperties `json:"properties,omitempty"`
+}
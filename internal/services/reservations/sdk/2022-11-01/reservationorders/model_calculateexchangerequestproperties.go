@@ -0,0 +1,7 @@
+package reservationorders
+
+type CalculateExchangeRequestProperties struct {
+	ReservationsToExchange *[]ReservationToReturn        `json:"reservationsToExchange,omitempty"`
+	ReservationsToPurchase *[]PurchaseRequest            `json:"reservationsToPurchase,omitempty"`
+	SavingsPlansToPurchase *[]SavingsPlanPurchaseRequest `json:"savingsPlansToPurchase,omitempty"`
+}
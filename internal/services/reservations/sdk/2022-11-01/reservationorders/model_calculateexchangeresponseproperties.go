@@ -0,0 +1,9 @@
+package reservationorders
+
+type CalculateExchangeResponseProperties struct {
+	NetPayable     *Price                `json:"netPayable,omitempty"`
+	PolicyResult   *ExchangePolicyErrors `json:"policyResult,omitempty"`
+	PurchasesTotal *Price                `json:"purchasesTotal,omitempty"`
+	RefundsTotal   *Price                `json:"refundsTotal,omitempty"`
+	SessionId      *string               `json:"sessionId,omitempty"`
+}
@@ -0,0 +1,5 @@
+package reservationorders
+
+type CalculatePriceResponse struct {
+	Properties *CalculatePriceResponseProperties `json:"properties,omitempty"`
+}
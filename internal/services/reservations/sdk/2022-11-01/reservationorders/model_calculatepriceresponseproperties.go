@@ -0,0 +1,8 @@
+package reservationorders
+
+type CalculatePriceResponseProperties struct {
+	BillingCurrencyTotal *Price  `json:"billingCurrencyTotal,omitempty"`
+	ReservationOrderId   *string `json:"reservationOrderId,omitempty"`
+	SkuDescription       *string `json:"skuDescription,omitempty"`
+	SkuTitle             *string `json:"skuTitle,omitempty"`
+}
@@ -0,0 +1,6 @@
+package reservationorders
+
+type CalculateRefundRequest struct {
+	Id         *string                           `json:"id,omitempty"`
+	Properties *CalculateRefundRequestProperties `json:"properties,omitempty"`
+}
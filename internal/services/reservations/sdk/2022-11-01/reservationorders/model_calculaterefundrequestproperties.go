@@ -0,0 +1,6 @@
+package reservationorders
+
+type CalculateRefundRequestProperties struct {
+	ReservationToReturn *ReservationToReturn `json:"reservationToReturn,omitempty"`
+	Scope               *string              `json:"scope,omitempty"`
+}
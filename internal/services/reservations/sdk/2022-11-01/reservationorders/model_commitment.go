@@ -0,0 +1,7 @@
+package reservationorders
+
+type Commitment struct {
+	Amount       *float64         `json:"amount,omitempty"`
+	CurrencyCode *string          `json:"currencyCode,omitempty"`
+	Grain        *CommitmentGrain `json:"grain,omitempty"`
+}
@@ -0,0 +1,9 @@
+package reservationorders
+
+type ExchangeOperationResultResponse struct {
+	Error      *OperationResultError          `json:"error,omitempty"`
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *ExchangeResponseProperties    `json:"properties,omitempty"`
+	Status     *ExchangeOperationResultStatus `json:"status,omitempty"`
+}
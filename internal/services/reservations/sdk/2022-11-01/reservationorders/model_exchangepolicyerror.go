@@ -0,0 +1,6 @@
+package reservationorders
+
+type ExchangePolicyError struct {
+	Code    *string `json:"code,omitempty"`
+	Message *string `json:"message,omitempty"`
+}
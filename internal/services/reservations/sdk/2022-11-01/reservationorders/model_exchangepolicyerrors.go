@@ -0,0 +1,5 @@
+package reservationorders
+
+type ExchangePolicyErrors struct {
+	PolicyErrors *[]ExchangePolicyError `json:"policyErrors,omitempty"`
+}
@@ -0,0 +1,5 @@
+package reservationorders
+
+type ExchangeRequest struct {
+	Properties *ExchangeRequestProperties `json:"properties,omitempty"`
+}
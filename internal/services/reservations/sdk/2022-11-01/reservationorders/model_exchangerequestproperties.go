@@ -0,0 +1,5 @@
+package reservationorders
+
+type ExchangeRequestProperties struct {
+	SessionId *string `json:"sessionId,omitempty"`
+}
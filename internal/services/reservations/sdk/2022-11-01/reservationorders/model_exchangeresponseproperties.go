@@ -0,0 +1,12 @@
+package reservationorders
+
+type ExchangeResponseProperties struct {
+	NetPayable             *Price                            `json:"netPayable,omitempty"`
+	PolicyResult           *ExchangePolicyErrors             `json:"policyResult,omitempty"`
+	PurchasesTotal         *Price                            `json:"purchasesTotal,omitempty"`
+	RefundsTotal           *Price                            `json:"refundsTotal,omitempty"`
+	ReservationsToExchange *[]ReservationToReturnForExchange `json:"reservationsToExchange,omitempty"`
+	ReservationsToPurchase *[]ReservationToPurchaseExchange  `json:"reservationsToPurchase,omitempty"`
+	SavingsPlansToPurchase *[]SavingsPlanToPurchaseExchange  `json:"savingsPlansToPurchase,omitempty"`
+	SessionId              *string                           `json:"sessionId,omitempty"`
+}
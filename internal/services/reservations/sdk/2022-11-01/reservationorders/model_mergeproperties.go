@@ -0,0 +1,5 @@
+package reservationorders
+
+type MergeProperties struct {
+	Sources *[]string `json:"sources,omitempty"`
+}
@@ -0,0 +1,5 @@
+package reservationorders
+
+type MergeRequest struct {
+	Properties *MergeProperties `json:"properties,omitempty"`
+}
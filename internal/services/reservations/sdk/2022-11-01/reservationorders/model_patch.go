@@ -0,0 +1,5 @@
+package reservationorders
+
+type Patch struct {
+	Properties *PatchProperties `json:"properties,omitempty"`
+}
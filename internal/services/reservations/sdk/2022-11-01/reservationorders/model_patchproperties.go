@@ -0,0 +1,9 @@
+package reservationorders
+
+type PatchProperties struct {
+	AppliedScopeProperties *AppliedScopeProperties `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType       *AppliedScopeType       `json:"appliedScopeType,omitempty"`
+	DisplayName            *string                 `json:"displayName,omitempty"`
+	InstanceFlexibility    *InstanceFlexibility    `json:"instanceFlexibility,omitempty"`
+	Renew                  *bool                   `json:"renew,omitempty"`
+}
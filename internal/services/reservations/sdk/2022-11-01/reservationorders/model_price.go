@@ -0,0 +1,6 @@
+package reservationorders
+
+type Price struct {
+	Amount       *float64 `json:"amount,omitempty"`
+	CurrencyCode *string  `json:"currencyCode,omitempty"`
+}
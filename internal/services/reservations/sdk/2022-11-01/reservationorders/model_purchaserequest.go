@@ -0,0 +1,7 @@
+package reservationorders
+
+type PurchaseRequest struct {
+	Location   *string                    `json:"location,omitempty"`
+	Properties *PurchaseRequestProperties `json:"properties,omitempty"`
+	Sku        *SkuName                   `json:"sku,omitempty"`
+}
@@ -0,0 +1,14 @@
+package reservationorders
+
+type PurchaseRequestProperties struct {
+	AppliedScopeProperties     *AppliedScopeProperties                              `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType           *AppliedScopeType                                    `json:"appliedScopeType,omitempty"`
+	BillingPlan                *ReservationBillingPlan                              `json:"billingPlan,omitempty"`
+	BillingScopeId             *string                                              `json:"billingScopeId,omitempty"`
+	DisplayName                *string                                              `json:"displayName,omitempty"`
+	Quantity                   *int64                                               `json:"quantity,omitempty"`
+	Renew                      *bool                                                `json:"renew,omitempty"`
+	ReservedResourceProperties *PurchaseRequestPropertiesReservedResourceProperties `json:"reservedResourceProperties,omitempty"`
+	ReservedResourceType       *ReservedResourceType                                `json:"reservedResourceType,omitempty"`
+	Term                       *ReservationTerm                                     `json:"term,omitempty"`
+}
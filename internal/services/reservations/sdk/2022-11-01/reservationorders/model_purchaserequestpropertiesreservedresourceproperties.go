@@ -0,0 +1,5 @@
+package reservationorders
+
+type PurchaseRequestPropertiesReservedResourceProperties struct {
+	InstanceFlexibility *InstanceFlexibility `json:"instanceFlexibility,omitempty"`
+}
@@ -0,0 +1,5 @@
+package reservationorders
+
+type RefundRequest struct {
+	Properties *RefundRequestProperties `json:"properties,omitempty"`
+}
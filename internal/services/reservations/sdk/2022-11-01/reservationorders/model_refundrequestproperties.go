@@ -0,0 +1,8 @@
+package reservationorders
+
+type RefundRequestProperties struct {
+	ReservationToReturn *ReservationToReturn `json:"reservationToReturn,omitempty"`
+	ReturnReason        *string              `json:"returnReason,omitempty"`
+	Scope               *string              `json:"scope,omitempty"`
+	SessionId           *string              `json:"sessionId,omitempty"`
+}
@@ -0,0 +1,6 @@
+package reservationorders
+
+type RefundResponse struct {
+	Id         *string                   `json:"id,omitempty"`
+	Properties *RefundResponseProperties `json:"properties,omitempty"`
+}
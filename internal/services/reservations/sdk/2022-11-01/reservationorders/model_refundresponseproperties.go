@@ -0,0 +1,6 @@
+package reservationorders
+
+type RefundResponseProperties struct {
+	Quantity  *int64  `json:"quantity,omitempty"`
+	SessionId *string `json:"sessionId,omitempty"`
+}
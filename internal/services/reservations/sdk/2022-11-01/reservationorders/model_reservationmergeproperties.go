@@ -0,0 +1,6 @@
+package reservationorders
+
+type ReservationMergeProperties struct {
+	MergeDestination *string   `json:"mergeDestination,omitempty"`
+	MergeSources     *[]string `json:"mergeSources,omitempty"`
+}
@@ -0,0 +1,13 @@
+package reservationorders
+
+type ReservationOrderProperties struct {
+	BenefitStartTime  *string                 `json:"benefitStartTime,omitempty"`
+	BillingPlan       *ReservationBillingPlan `json:"billingPlan,omitempty"`
+	CreatedDateTime   *string                 `json:"createdDateTime,omitempty"`
+	DisplayName       *string                 `json:"displayName,omitempty"`
+	ExpiryDateTime    *string                 `json:"expiryDateTime,omitempty"`
+	OriginalQuantity  *int64                  `json:"originalQuantity,omitempty"`
+	ProvisioningState *string                 `json:"provisioningState,omitempty"`
+	Reservations      *[]ReservationResponse  `json:"reservations,omitempty"`
+	Term              *ReservationTerm        `json:"term,omitempty"`
+}
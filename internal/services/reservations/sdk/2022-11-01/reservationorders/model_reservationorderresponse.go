@@ -0,0 +1,9 @@
+package reservationorders
+
+type ReservationOrderResponse struct {
+	Etag       *int64                      `json:"etag,omitempty"`
+	Id         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *ReservationOrderProperties `json:"properties,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
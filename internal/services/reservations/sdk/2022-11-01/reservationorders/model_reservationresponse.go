@@ -0,0 +1,11 @@
+package reservationorders
+
+type ReservationResponse struct {
+	Etag       *int64                  `json:"etag,omitempty"`
+	Id         *string                 `json:"id,omitempty"`
+	Location   *string                 `json:"location,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *ReservationsProperties `json:"properties,omitempty"`
+	Sku        *SkuName                `json:"sku,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package reservationorders
+
+type ReservationSplitProperties struct {
+	SplitDestinations *[]string `json:"splitDestinations,omitempty"`
+	SplitSource       *string   `json:"splitSource,omitempty"`
+}
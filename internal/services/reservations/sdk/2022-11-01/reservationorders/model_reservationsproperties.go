@@ -0,0 +1,20 @@
+package reservationorders
+
+type ReservationsProperties struct {
+	AppliedScopeProperties   *AppliedScopeProperties     `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType         *AppliedScopeType           `json:"appliedScopeType,omitempty"`
+	BenefitStartTime         *string                     `json:"benefitStartTime,omitempty"`
+	BillingPlan              *ReservationBillingPlan     `json:"billingPlan,omitempty"`
+	BillingScopeId           *string                     `json:"billingScopeId,omitempty"`
+	DisplayName              *string                     `json:"displayName,omitempty"`
+	DisplayProvisioningState *string                     `json:"displayProvisioningState,omitempty"`
+	ExpiryDateTime           *string                     `json:"expiryDateTime,omitempty"`
+	InstanceFlexibility      *InstanceFlexibility        `json:"instanceFlexibility,omitempty"`
+	MergeProperties          *ReservationMergeProperties `json:"mergeProperties,omitempty"`
+	ProvisioningState        *string                     `json:"provisioningState,omitempty"`
+	Quantity                 *int64                      `json:"quantity,omitempty"`
+	Renew                    *bool                       `json:"renew,omitempty"`
+	ReservedResourceType     *ReservedResourceType       `json:"reservedResourceType,omitempty"`
+	SplitProperties          *ReservationSplitProperties `json:"splitProperties,omitempty"`
+	Term                     *ReservationTerm            `json:"term,omitempty"`
+}
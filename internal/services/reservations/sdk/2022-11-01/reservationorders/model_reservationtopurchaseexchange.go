@@ -0,0 +1,7 @@
+package reservationorders
+
+type ReservationToPurchaseExchange struct {
+	ReservationId      *string          `json:"reservationId,omitempty"`
+	ReservationOrderId *string          `json:"reservationOrderId,omitempty"`
+	Status             *OperationStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,6 @@
+package reservationorders
+
+type ReservationToReturn struct {
+	Quantity      *int64  `json:"quantity,omitempty"`
+	ReservationId *string `json:"reservationId,omitempty"`
+}
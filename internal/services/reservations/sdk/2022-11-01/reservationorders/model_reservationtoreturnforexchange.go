@@ -0,0 +1,7 @@
+package reservationorders
+
+type ReservationToReturnForExchange struct {
+	Quantity      *int64           `json:"quantity,omitempty"`
+	ReservationId *string          `json:"reservationId,omitempty"`
+	Status        *OperationStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,6 @@
+package reservationorders
+
+type SavingsPlanPurchaseRequest struct {
+	Properties *SavingsPlanPurchaseRequestProperties `json:"properties,omitempty"`
+	Sku        *SkuName                              `json:"sku,omitempty"`
+}
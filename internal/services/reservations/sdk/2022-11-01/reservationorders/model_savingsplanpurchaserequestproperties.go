@@ -0,0 +1,11 @@
+package reservationorders
+
+type SavingsPlanPurchaseRequestProperties struct {
+	AppliedScopeProperties *AppliedScopeProperties `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType       *AppliedScopeType       `json:"appliedScopeType,omitempty"`
+	BillingPlan            *SavingsPlanBillingPlan `json:"billingPlan,omitempty"`
+	BillingScopeId         *string                 `json:"billingScopeId,omitempty"`
+	Commitment             *Commitment             `json:"commitment,omitempty"`
+	DisplayName            *string                 `json:"displayName,omitempty"`
+	Term                   *SavingsPlanTerm        `json:"term,omitempty"`
+}
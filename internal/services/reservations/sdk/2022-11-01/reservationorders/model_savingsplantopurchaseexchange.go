@@ -0,0 +1,7 @@
+package reservationorders
+
+type SavingsPlanToPurchaseExchange struct {
+	SavingsPlanId      *string          `json:"savingsPlanId,omitempty"`
+	SavingsPlanOrderId *string          `json:"savingsPlanOrderId,omitempty"`
+	Status             *OperationStatus `json:"status,omitempty"`
+}
@@ -0,0 +1,5 @@
+package reservationorders
+
+type SkuName struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,6 @@
+package reservationorders
+
+type SplitProperties struct {
+	Quantities    *[]int64 `json:"quantities,omitempty"`
+	ReservationId *string  `json:"reservationId,omitempty"`
+}
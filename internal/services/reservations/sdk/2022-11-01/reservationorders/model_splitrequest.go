@@ -0,0 +1,5 @@
+package reservationorders
+
+type SplitRequest struct {
+	Properties *SplitProperties `json:"properties,omitempty"`
+}
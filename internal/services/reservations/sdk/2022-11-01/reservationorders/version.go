@@ -0,0 +1,9 @@
+package reservationorders
+
+import "fmt"
+
+const defaultApiVersion = "2022-11-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/reservationorders/%s", defaultApiVersion)
+}
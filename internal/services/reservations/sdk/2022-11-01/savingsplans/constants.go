@@ -0,0 +1,115 @@
+package savingsplans
+
+import "strings"
+
+type AppliedScopeType string
+
+const (
+	AppliedScopeTypeManagementGroup AppliedScopeType = "ManagementGroup"
+	AppliedScopeTypeShared          AppliedScopeType = "Shared"
+	AppliedScopeTypeSingle          AppliedScopeType = "Single"
+)
+
+func PossibleValuesForAppliedScopeType() []string {
+	return []string{
+		string(AppliedScopeTypeManagementGroup),
+		string(AppliedScopeTypeShared),
+		string(AppliedScopeTypeSingle),
+	}
+}
+
+func parseAppliedScopeType(input string) (*AppliedScopeType, error) {
+	vals := map[string]AppliedScopeType{
+		"managementgroup": AppliedScopeTypeManagementGroup,
+		"shared":          AppliedScopeTypeShared,
+		"single":          AppliedScopeTypeSingle,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := AppliedScopeType(input)
+	return &out, nil
+}
+
+type BillingPlan string
+
+const (
+	BillingPlanPOneM BillingPlan = "P1M"
+)
+
+func PossibleValuesForBillingPlan() []string {
+	return []string{
+		string(BillingPlanPOneM),
+	}
+}
+
+func parseBillingPlan(input string) (*BillingPlan, error) {
+	vals := map[string]BillingPlan{
+		"p1m": BillingPlanPOneM,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := BillingPlan(input)
+	return &out, nil
+}
+
+type CommitmentGrain string
+
+const (
+	CommitmentGrainHourly CommitmentGrain = "Hourly"
+)
+
+func PossibleValuesForCommitmentGrain() []string {
+	return []string{
+		string(CommitmentGrainHourly),
+	}
+}
+
+func parseCommitmentGrain(input string) (*CommitmentGrain, error) {
+	vals := map[string]CommitmentGrain{
+		"hourly": CommitmentGrainHourly,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CommitmentGrain(input)
+	return &out, nil
+}
+
+type Term string
+
+const (
+	TermPFiveY  Term = "P5Y"
+	TermPOneY   Term = "P1Y"
+	TermPThreeY Term = "P3Y"
+)
+
+func PossibleValuesForTerm() []string {
+	return []string{
+		string(TermPFiveY),
+		string(TermPOneY),
+		string(TermPThreeY),
+	}
+}
+
+func parseTerm(input string) (*Term, error) {
+	vals := map[string]Term{
+		"p5y": TermPFiveY,
+		"p1y": TermPOneY,
+		"p3y": TermPThreeY,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := Term(input)
+	return &out, nil
+}
@@ -0,0 +1,98 @@
+package savingsplans
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SavingsPlanOrderAliasId{}
+
+// SavingsPlanOrderAliasId is a struct representing the Resource ID for a Savings Plan Order Alias
+type SavingsPlanOrderAliasId struct {
+	SavingsPlanOrderAliasName string
+}
+
+// NewSavingsPlanOrderAliasID returns a new SavingsPlanOrderAliasId struct
+func NewSavingsPlanOrderAliasID(savingsPlanOrderAliasName string) SavingsPlanOrderAliasId {
+	return SavingsPlanOrderAliasId{
+		SavingsPlanOrderAliasName: savingsPlanOrderAliasName,
+	}
+}
+
+// ParseSavingsPlanOrderAliasID parses 'input' into a SavingsPlanOrderAliasId
+func ParseSavingsPlanOrderAliasID(input string) (*SavingsPlanOrderAliasId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SavingsPlanOrderAliasId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SavingsPlanOrderAliasId{}
+
+	if id.SavingsPlanOrderAliasName, ok = parsed.Parsed["savingsPlanOrderAliasName"]; !ok {
+		return nil, fmt.Errorf("the segment 'savingsPlanOrderAliasName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseSavingsPlanOrderAliasIDInsensitively parses 'input' case-insensitively into a SavingsPlanOrderAliasId
+// note: this method should only be used for API response data and not user input
+func ParseSavingsPlanOrderAliasIDInsensitively(input string) (*SavingsPlanOrderAliasId, error) {
+	parser := resourceids.NewParserFromResourceIdType(SavingsPlanOrderAliasId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := SavingsPlanOrderAliasId{}
+
+	if id.SavingsPlanOrderAliasName, ok = parsed.Parsed["savingsPlanOrderAliasName"]; !ok {
+		return nil, fmt.Errorf("the segment 'savingsPlanOrderAliasName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateSavingsPlanOrderAliasID checks that 'input' can be parsed as a Savings Plan Order Alias ID
+func ValidateSavingsPlanOrderAliasID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSavingsPlanOrderAliasID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Savings Plan Order Alias ID
+func (id SavingsPlanOrderAliasId) ID() string {
+	fmtString := "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases/%s"
+	return fmt.Sprintf(fmtString, id.SavingsPlanOrderAliasName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Savings Plan Order Alias ID
+func (id SavingsPlanOrderAliasId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftBillingBenefits", "Microsoft.BillingBenefits", "Microsoft.BillingBenefits"),
+		resourceids.StaticSegment("staticSavingsPlanOrderAliases", "savingsPlanOrderAliases", "savingsPlanOrderAliases"),
+		resourceids.UserSpecifiedSegment("savingsPlanOrderAliasName", "savingsPlanOrderAliasValue"),
+	}
+}
+
+// String returns a human-readable description of this Savings Plan Order Alias ID
+func (id SavingsPlanOrderAliasId) String() string {
+	components := []string{
+		fmt.Sprintf("Savings Plan Order Alias Name: %q", id.SavingsPlanOrderAliasName),
+	}
+	return fmt.Sprintf("Savings Plan Order Alias (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,174 @@
+package savingsplans
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = SavingsPlanOrderAliasId{}
+
+func TestNewSavingsPlanOrderAliasID(t *testing.T) {
+	id := NewSavingsPlanOrderAliasID("savingsPlanOrderAliasValue")
+
+	if id.SavingsPlanOrderAliasName != "savingsPlanOrderAliasValue" {
+		t.Fatalf("Expected %q but got %q for Segment 'SavingsPlanOrderAliasName'", id.SavingsPlanOrderAliasName, "savingsPlanOrderAliasValue")
+	}
+}
+
+func TestFormatSavingsPlanOrderAliasID(t *testing.T) {
+	actual := NewSavingsPlanOrderAliasID("savingsPlanOrderAliasValue").ID()
+	expected := "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases/savingsPlanOrderAliasValue"
+	if actual != expected {
+		t.Fatalf("Expected the Formatted ID to be %q but got %q", actual, expected)
+	}
+}
+
+func TestParseSavingsPlanOrderAliasID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *SavingsPlanOrderAliasId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.BillingBenefits",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases/savingsPlanOrderAliasValue",
+			Expected: &SavingsPlanOrderAliasId{
+				SavingsPlanOrderAliasName: "savingsPlanOrderAliasValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases/savingsPlanOrderAliasValue/extra",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseSavingsPlanOrderAliasID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SavingsPlanOrderAliasName != v.Expected.SavingsPlanOrderAliasName {
+			t.Fatalf("Expected %q but got %q for SavingsPlanOrderAliasName", v.Expected.SavingsPlanOrderAliasName, actual.SavingsPlanOrderAliasName)
+		}
+
+	}
+}
+
+func TestParseSavingsPlanOrderAliasIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *SavingsPlanOrderAliasId
+	}{
+		{
+			// Incomplete URI
+			Input: "",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.BillingBenefits",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.BiLlInGbEnEfItS",
+			Error: true,
+		},
+		{
+			// Incomplete URI
+			Input: "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases",
+			Error: true,
+		},
+		{
+			// Incomplete URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.BiLlInGbEnEfItS/SaViNgSpLaNoRdErAlIaSeS",
+			Error: true,
+		},
+		{
+			// Valid URI
+			Input: "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases/savingsPlanOrderAliasValue",
+			Expected: &SavingsPlanOrderAliasId{
+				SavingsPlanOrderAliasName: "savingsPlanOrderAliasValue",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment)
+			Input: "/providers/Microsoft.BillingBenefits/savingsPlanOrderAliases/savingsPlanOrderAliasValue/extra",
+			Error: true,
+		},
+		{
+			// Valid URI (mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.BiLlInGbEnEfItS/SaViNgSpLaNoRdErAlIaSeS/SaViNgSpLaNoRdErAlIaSvAlUe",
+			Expected: &SavingsPlanOrderAliasId{
+				SavingsPlanOrderAliasName: "SaViNgSpLaNoRdErAlIaSvAlUe",
+			},
+		},
+		{
+			// Invalid (Valid Uri with Extra segment - mIxEd CaSe since this is insensitive)
+			Input: "/PrOvIdErS/MiCrOsOfT.BiLlInGbEnEfItS/SaViNgSpLaNoRdErAlIaSeS/SaViNgSpLaNoRdErAlIaSvAlUe/eXtRa",
+			Error: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseSavingsPlanOrderAliasIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SavingsPlanOrderAliasName != v.Expected.SavingsPlanOrderAliasName {
+			t.Fatalf("Expected %q but got %q for SavingsPlanOrderAliasName", v.Expected.SavingsPlanOrderAliasName, actual.SavingsPlanOrderAliasName)
+		}
+
+	}
+}
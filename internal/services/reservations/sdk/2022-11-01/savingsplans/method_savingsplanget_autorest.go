@@ -0,0 +1,64 @@
+package savingsplans
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SavingsPlanGetResponse struct {
+	HttpResponse *http.Response
+	Model        *SavingsPlanModel
+}
+
+// SavingsPlanGet ...
+func (c SavingsPlansClient) SavingsPlanGet(ctx context.Context, id SavingsPlanId) (result SavingsPlanGetResponse, err error) {
+	req, err := c.preparerForSavingsPlanGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSavingsPlanGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSavingsPlanGet prepares the SavingsPlanGet request.
+func (c SavingsPlansClient) preparerForSavingsPlanGet(ctx context.Context, id SavingsPlanId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSavingsPlanGet handles the response to the SavingsPlanGet request. The method always
+// closes the http.Response Body.
+func (c SavingsPlansClient) responderForSavingsPlanGet(resp *http.Response) (result SavingsPlanGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
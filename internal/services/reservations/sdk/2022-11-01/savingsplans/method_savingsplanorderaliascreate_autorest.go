@@ -0,0 +1,75 @@
+package savingsplans
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SavingsPlanOrderAliasCreateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SavingsPlanOrderAliasCreate ...
+func (c SavingsPlansClient) SavingsPlanOrderAliasCreate(ctx context.Context, id SavingsPlanOrderAliasId, input SavingsPlanOrderAliasModel) (result SavingsPlanOrderAliasCreateResponse, err error) {
+	req, err := c.preparerForSavingsPlanOrderAliasCreate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderAliasCreate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSavingsPlanOrderAliasCreate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderAliasCreate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SavingsPlanOrderAliasCreateThenPoll performs SavingsPlanOrderAliasCreate then polls until it's completed
+func (c SavingsPlansClient) SavingsPlanOrderAliasCreateThenPoll(ctx context.Context, id SavingsPlanOrderAliasId, input SavingsPlanOrderAliasModel) error {
+	result, err := c.SavingsPlanOrderAliasCreate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SavingsPlanOrderAliasCreate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SavingsPlanOrderAliasCreate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSavingsPlanOrderAliasCreate prepares the SavingsPlanOrderAliasCreate request.
+func (c SavingsPlansClient) preparerForSavingsPlanOrderAliasCreate(ctx context.Context, id SavingsPlanOrderAliasId, input SavingsPlanOrderAliasModel) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSavingsPlanOrderAliasCreate sends the SavingsPlanOrderAliasCreate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SavingsPlansClient) senderForSavingsPlanOrderAliasCreate(ctx context.Context, req *http.Request) (future SavingsPlanOrderAliasCreateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package savingsplans
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SavingsPlanOrderAliasGetResponse struct {
+	HttpResponse *http.Response
+	Model        *SavingsPlanOrderAliasModel
+}
+
+// SavingsPlanOrderAliasGet ...
+func (c SavingsPlansClient) SavingsPlanOrderAliasGet(ctx context.Context, id SavingsPlanOrderAliasId) (result SavingsPlanOrderAliasGetResponse, err error) {
+	req, err := c.preparerForSavingsPlanOrderAliasGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderAliasGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderAliasGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSavingsPlanOrderAliasGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderAliasGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSavingsPlanOrderAliasGet prepares the SavingsPlanOrderAliasGet request.
+func (c SavingsPlansClient) preparerForSavingsPlanOrderAliasGet(ctx context.Context, id SavingsPlanOrderAliasId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSavingsPlanOrderAliasGet handles the response to the SavingsPlanOrderAliasGet request. The method always
+// closes the http.Response Body.
+func (c SavingsPlansClient) responderForSavingsPlanOrderAliasGet(resp *http.Response) (result SavingsPlanOrderAliasGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,64 @@
+package savingsplans
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type SavingsPlanOrderGetResponse struct {
+	HttpResponse *http.Response
+	Model        *SavingsPlanOrderModel
+}
+
+// SavingsPlanOrderGet ...
+func (c SavingsPlansClient) SavingsPlanOrderGet(ctx context.Context, id SavingsPlanOrderId) (result SavingsPlanOrderGetResponse, err error) {
+	req, err := c.preparerForSavingsPlanOrderGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSavingsPlanOrderGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanOrderGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSavingsPlanOrderGet prepares the SavingsPlanOrderGet request.
+func (c SavingsPlansClient) preparerForSavingsPlanOrderGet(ctx context.Context, id SavingsPlanOrderId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSavingsPlanOrderGet handles the response to the SavingsPlanOrderGet request. The method always
+// closes the http.Response Body.
+func (c SavingsPlansClient) responderForSavingsPlanOrderGet(resp *http.Response) (result SavingsPlanOrderGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
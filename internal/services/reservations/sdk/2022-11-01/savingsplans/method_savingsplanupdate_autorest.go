@@ -0,0 +1,75 @@
+package savingsplans
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type SavingsPlanUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// SavingsPlanUpdate ...
+func (c SavingsPlansClient) SavingsPlanUpdate(ctx context.Context, id SavingsPlanId, input SavingsPlanUpdateRequest) (result SavingsPlanUpdateResponse, err error) {
+	req, err := c.preparerForSavingsPlanUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForSavingsPlanUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "savingsplans.SavingsPlansClient", "SavingsPlanUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// SavingsPlanUpdateThenPoll performs SavingsPlanUpdate then polls until it's completed
+func (c SavingsPlansClient) SavingsPlanUpdateThenPoll(ctx context.Context, id SavingsPlanId, input SavingsPlanUpdateRequest) error {
+	result, err := c.SavingsPlanUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing SavingsPlanUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after SavingsPlanUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForSavingsPlanUpdate prepares the SavingsPlanUpdate request.
+func (c SavingsPlansClient) preparerForSavingsPlanUpdate(ctx context.Context, id SavingsPlanId, input SavingsPlanUpdateRequest) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForSavingsPlanUpdate sends the SavingsPlanUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SavingsPlansClient) senderForSavingsPlanUpdate(ctx context.Context, req *http.Request) (future SavingsPlanUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
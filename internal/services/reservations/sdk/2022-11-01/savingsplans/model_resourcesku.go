@@ -0,0 +1,5 @@
+package savingsplans
+
+type ResourceSku struct {
+	Name *string `json:"name,omitempty"`
+}
@@ -0,0 +1,9 @@
+package savingsplans
+
+type SavingsPlanModel struct {
+	Id         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties *SavingsPlanModelProperties `json:"properties,omitempty"`
+	Sku        ResourceSku                 `json:"sku"`
+	Type       *string                     `json:"type,omitempty"`
+}
@@ -0,0 +1,16 @@
+package savingsplans
+
+type SavingsPlanModelProperties struct {
+	AppliedScopeProperties   *AppliedScopeProperties `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType         *AppliedScopeType       `json:"appliedScopeType,omitempty"`
+	BenefitStartTime         *string                 `json:"benefitStartTime,omitempty"`
+	BillingPlan              *BillingPlan            `json:"billingPlan,omitempty"`
+	BillingScopeId           *string                 `json:"billingScopeId,omitempty"`
+	Commitment               *Commitment             `json:"commitment,omitempty"`
+	DisplayName              *string                 `json:"displayName,omitempty"`
+	DisplayProvisioningState *string                 `json:"displayProvisioningState,omitempty"`
+	ExpiryDateTime           *string                 `json:"expiryDateTime,omitempty"`
+	ProvisioningState        *string                 `json:"provisioningState,omitempty"`
+	Renew                    *bool                   `json:"renew,omitempty"`
+	Term                     *Term                   `json:"term,omitempty"`
+}
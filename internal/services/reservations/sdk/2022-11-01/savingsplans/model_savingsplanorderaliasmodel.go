@@ -0,0 +1,10 @@
+package savingsplans
+
+type SavingsPlanOrderAliasModel struct {
+	Id         *string                          `json:"id,omitempty"`
+	Kind       *string                          `json:"kind,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *SavingsPlanOrderAliasProperties `json:"properties,omitempty"`
+	Sku        ResourceSku                      `json:"sku"`
+	Type       *string                          `json:"type,omitempty"`
+}
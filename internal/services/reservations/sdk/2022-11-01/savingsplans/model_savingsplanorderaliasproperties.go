@@ -0,0 +1,14 @@
+package savingsplans
+
+type SavingsPlanOrderAliasProperties struct {
+	AppliedScopeProperties   *AppliedScopeProperties `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType         *AppliedScopeType       `json:"appliedScopeType,omitempty"`
+	BillingPlan              *BillingPlan            `json:"billingPlan,omitempty"`
+	BillingScopeId           *string                 `json:"billingScopeId,omitempty"`
+	Commitment               *Commitment             `json:"commitment,omitempty"`
+	DisplayName              *string                 `json:"displayName,omitempty"`
+	DisplayProvisioningState *string                 `json:"displayProvisioningState,omitempty"`
+	ProvisioningState        *string                 `json:"provisioningState,omitempty"`
+	SavingsPlanOrderId       *string                 `json:"savingsPlanOrderId,omitempty"`
+	Term                     *Term                   `json:"term,omitempty"`
+}
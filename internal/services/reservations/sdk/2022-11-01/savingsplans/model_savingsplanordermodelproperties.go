@@ -0,0 +1,12 @@
+package savingsplans
+
+type SavingsPlanOrderModelProperties struct {
+	BenefitStartTime  *string      `json:"benefitStartTime,omitempty"`
+	BillingPlan       *BillingPlan `json:"billingPlan,omitempty"`
+	BillingScopeId    *string      `json:"billingScopeId,omitempty"`
+	DisplayName       *string      `json:"displayName,omitempty"`
+	ExpiryDateTime    *string      `json:"expiryDateTime,omitempty"`
+	ProvisioningState *string      `json:"provisioningState,omitempty"`
+	SavingsPlans      *[]string    `json:"savingsPlans,omitempty"`
+	Term              *Term        `json:"term,omitempty"`
+}
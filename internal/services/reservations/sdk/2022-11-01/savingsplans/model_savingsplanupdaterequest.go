@@ -0,0 +1,5 @@
+package savingsplans
+
+type SavingsPlanUpdateRequest struct {
+	Properties *SavingsPlanUpdateRequestProperties `json:"properties,omitempty"`
+}
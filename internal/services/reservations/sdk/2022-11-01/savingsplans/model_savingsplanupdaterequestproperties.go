@@ -0,0 +1,8 @@
+package savingsplans
+
+type SavingsPlanUpdateRequestProperties struct {
+	AppliedScopeProperties *AppliedScopeProperties `json:"appliedScopeProperties,omitempty"`
+	AppliedScopeType       *AppliedScopeType       `json:"appliedScopeType,omitempty"`
+	DisplayName            *string                 `json:"displayName,omitempty"`
+	Renew                  *bool                   `json:"renew,omitempty"`
+}
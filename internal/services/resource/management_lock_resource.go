@@ -3,11 +3,13 @@ package resource
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -64,6 +66,13 @@ func resourceManagementLock() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+
+			"release_during_dependent_updates": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -95,6 +104,16 @@ func resourceManagementLockCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		},
 	}
 
+	// when enabled in the `features` block, Management Locks with this Owner are temporarily released when they
+	// prevent the Resources within their Scope from being created or updated (but never deleted)
+	if d.Get("release_during_dependent_updates").(bool) {
+		lock.ManagementLockProperties.Owners = &[]locks.ManagementLockOwner{
+			{
+				ApplicationID: utils.String(common.TerraformPartnerId),
+			},
+		}
+	}
+
 	if _, err := client.CreateOrUpdateByScope(ctx, id.Scope, id.Name, lock); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -129,6 +148,16 @@ func resourceManagementLockRead(d *pluginsdk.ResourceData, meta interface{}) err
 	if props := resp.ManagementLockProperties; props != nil {
 		d.Set("lock_level", string(props.Level))
 		d.Set("notes", props.Notes)
+
+		releasable := false
+		if props.Owners != nil {
+			for _, owner := range *props.Owners {
+				if owner.ApplicationID != nil && strings.EqualFold(*owner.ApplicationID, common.TerraformPartnerId) {
+					releasable = true
+				}
+			}
+		}
+		d.Set("release_during_dependent_updates", releasable)
 	}
 
 	return nil
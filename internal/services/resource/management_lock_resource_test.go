@@ -163,6 +163,31 @@ func TestAccManagementLock_subscriptionCanNotDeleteBasic(t *testing.T) {
 	})
 }
 
+func TestAccManagementLock_releaseDuringDependentUpdates(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_lock", "test")
+	r := ManagementLockResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.releaseDuringDependentUpdates(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("release_during_dependent_updates").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			// removing the Public IP requires the releasable Management Lock to be temporarily released
+			Config: r.releaseDuringDependentUpdates(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("release_during_dependent_updates").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ManagementLockResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ParseManagementLockID(state.ID)
 	if err != nil {
@@ -321,6 +346,44 @@ resource "azurerm_management_lock" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (ManagementLockResource) releaseDuringDependentUpdates(data acceptance.TestData, withPublicIP bool) string {
+	publicIP := ""
+	if withPublicIP {
+		publicIP = fmt.Sprintf(`
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+}
+`, data.RandomInteger)
+	}
+
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    management_lock {
+      release_during_dependent_updates = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_management_lock" "test" {
+  name                             = "acctestlock-%d"
+  scope                            = azurerm_resource_group.test.id
+  lock_level                       = "CanNotDelete"
+  release_during_dependent_updates = true
+}
+
+%s
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, publicIP)
+}
+
 func (ManagementLockResource) subscriptionReadOnlyBasic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -25,10 +26,10 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 		Read:   resourceGroupTemplateDeploymentResourceRead,
 		Update: resourceGroupTemplateDeploymentResourceUpdate,
 		Delete: resourceGroupTemplateDeploymentResourceDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.ResourceGroupTemplateDeploymentID(id)
 			return err
-		}),
+		}, importResourceGroupTemplateDeployment),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
@@ -37,6 +38,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(180 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceGroupTemplateDeploymentWhatIfCustomizeDiff),
+
 		// (@jackofallops - lintignore needed as we need to make sure the JSON is usable in `output_content`)
 
 		//lintignore:S033
@@ -96,6 +99,12 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -103,6 +112,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 	}
 }
@@ -400,3 +411,124 @@ func validateResourceGroupTemplateDeployment(ctx context.Context, id parse.Resou
 
 	return nil
 }
+
+// importResourceGroupTemplateDeployment defaults `what_if_enabled` since What-If is opt-in and isn't returned from the API
+func importResourceGroupTemplateDeployment(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) ([]*pluginsdk.ResourceData, error) {
+	d.Set("what_if_enabled", false)
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// resourceGroupTemplateDeploymentWhatIfCustomizeDiff runs an ARM What-If operation when the Template, Parameters or
+// Deployment Mode change, such that the changes predicted by ARM are surfaced in the plan via `what_if_changes`
+func resourceGroupTemplateDeploymentWhatIfCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !d.Get("what_if_enabled").(bool) {
+		if len(d.Get("what_if_changes").([]interface{})) > 0 {
+			return d.SetNew("what_if_changes", []interface{}{})
+		}
+		return nil
+	}
+
+	keys := []string{
+		"deployment_mode",
+		"parameters_content",
+		"template_content",
+		"template_spec_version_id",
+	}
+	changed := d.Id() == ""
+	for _, key := range keys {
+		if d.HasChange(key) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	for _, key := range append(keys, "name", "resource_group_name") {
+		// `template_content` and `parameters_content` are Computed, as such when they're not specified in the config
+		// their value can be unknown - which is fine since the value from the config is what's being deployed
+		if templateDeploymentValueConfigured(d, key) && !d.NewValueKnown(key) {
+			log.Printf("[DEBUG] Skipping What-If for Template Deployment since %q is not known until apply", key)
+			return d.SetNewComputed("what_if_changes")
+		}
+	}
+
+	client := meta.(*clients.Client).Resource.DeploymentsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	id := parse.NewResourceGroupTemplateDeploymentID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	deployment := resources.DeploymentWhatIf{
+		Properties: &resources.DeploymentWhatIfProperties{
+			Mode: resources.DeploymentMode(d.Get("deployment_mode").(string)),
+			WhatIfSettings: &resources.DeploymentWhatIfSettings{
+				ResultFormat: resources.WhatIfResultFormatFullResourcePayloads,
+			},
+		},
+	}
+
+	if templateSpecVersionID := d.Get("template_spec_version_id").(string); templateSpecVersionID != "" {
+		deployment.Properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(templateSpecVersionID),
+		}
+	} else {
+		template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+		if err != nil {
+			return fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		deployment.Properties.Template = template
+	}
+
+	if v := d.Get("parameters_content").(string); v != "" {
+		parameters, err := expandTemplateDeploymentBody(v)
+		if err != nil {
+			return fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		deployment.Properties.Parameters = parameters
+	}
+
+	// the What-If operation runs during the plan, so this is capped to avoid blocking the plan for a long time
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	log.Printf("[DEBUG] Running What-If for Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
+	future, err := client.WhatIf(ctx, id.ResourceGroup, id.DeploymentName, deployment)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			// the Resource Group is likely being created in this apply, as such the changes can't be predicted yet
+			log.Printf("[DEBUG] Skipping What-If for Template Deployment %q since Resource Group %q was not found", id.DeploymentName, id.ResourceGroup)
+			return d.SetNewComputed("what_if_changes")
+		}
+		return fmt.Errorf("running What-If for Template Deployment %q (Resource Group %q) - this can be skipped by setting `what_if_enabled` to `false`: %+v", id.DeploymentName, id.ResourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for What-If for Template Deployment %q (Resource Group %q) - this can be skipped by setting `what_if_enabled` to `false`: %+v", id.DeploymentName, id.ResourceGroup, err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving What-If result for Template Deployment %q (Resource Group %q): %+v", id.DeploymentName, id.ResourceGroup, err)
+	}
+	if result.Error != nil {
+		if result.Error.Message != nil {
+			return fmt.Errorf("running What-If for Template Deployment %q (Resource Group %q): %s", id.DeploymentName, id.ResourceGroup, *result.Error.Message)
+		}
+		return fmt.Errorf("running What-If for Template Deployment %q (Resource Group %q): %+v", id.DeploymentName, id.ResourceGroup, *result.Error)
+	}
+
+	var changes *[]resources.WhatIfChange
+	if result.WhatIfOperationProperties != nil {
+		changes = result.WhatIfOperationProperties.Changes
+	}
+	log.Printf("[DEBUG] Ran What-If for Template Deployment %q (Resource Group %q).", id.DeploymentName, id.ResourceGroup)
+
+	return d.SetNew("what_if_changes", flattenTemplateDeploymentWhatIfChanges(changes))
+}
+
+// templateDeploymentValueConfigured returns whether the field is specified in the configuration
+func templateDeploymentValueConfigured(d *pluginsdk.ResourceDiff, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return true
+	}
+
+	return !config.GetAttr(key).IsNull()
+}
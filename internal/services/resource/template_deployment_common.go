@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"sort"
 	"strings"
 
 	providers "github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -83,6 +85,131 @@ func flattenTemplateDeploymentBody(input interface{}) (*string, error) {
 	return &output, nil
 }
 
+func templateDeploymentWhatIfChangesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"change_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"property_change": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"path": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"change_type": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"before": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+
+							"after": {
+								Type:     pluginsdk.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenTemplateDeploymentWhatIfChanges flattens the changes predicted by What-If, omitting the Resources which are
+// unchanged (or ignored) such that only the Resources which would be changed by the deployment are surfaced
+func flattenTemplateDeploymentWhatIfChanges(input *[]resources.WhatIfChange) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	changes := make([]resources.WhatIfChange, 0)
+	for _, v := range *input {
+		if v.ChangeType == resources.ChangeTypeNoChange || v.ChangeType == resources.ChangeTypeIgnore {
+			continue
+		}
+		changes = append(changes, v)
+	}
+
+	// the ordering returned from the API isn't guaranteed, so sort these to avoid a spurious diff
+	sort.SliceStable(changes, func(i, j int) bool {
+		return strings.ToLower(utils.NormalizeNilableString(changes[i].ResourceID)) < strings.ToLower(utils.NormalizeNilableString(changes[j].ResourceID))
+	})
+
+	for _, v := range changes {
+		propertyChanges := make([]interface{}, 0)
+		if v.Delta != nil {
+			propertyChanges = flattenTemplateDeploymentWhatIfPropertyChanges("", *v.Delta)
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id":     utils.NormalizeNilableString(v.ResourceID),
+			"change_type":     string(v.ChangeType),
+			"property_change": propertyChanges,
+		})
+	}
+
+	return output
+}
+
+// flattenTemplateDeploymentWhatIfPropertyChanges flattens the nested property changes into a single list, where the
+// path of each nested property is prefixed with the path of it's parent (e.g. `properties.siteConfig.0.alwaysOn`)
+func flattenTemplateDeploymentWhatIfPropertyChanges(prefix string, input []resources.WhatIfPropertyChange) []interface{} {
+	output := make([]interface{}, 0)
+
+	for _, v := range input {
+		path := utils.NormalizeNilableString(v.Path)
+		if prefix != "" {
+			path = fmt.Sprintf("%s.%s", prefix, path)
+		}
+
+		if v.Children != nil && len(*v.Children) > 0 {
+			output = append(output, flattenTemplateDeploymentWhatIfPropertyChanges(path, *v.Children)...)
+			continue
+		}
+
+		output = append(output, map[string]interface{}{
+			"path":        path,
+			"change_type": string(v.PropertyChangeType),
+			"before":      flattenTemplateDeploymentWhatIfValue(v.Before),
+			"after":       flattenTemplateDeploymentWhatIfValue(v.After),
+		})
+	}
+
+	return output
+}
+
+func flattenTemplateDeploymentWhatIfValue(input interface{}) string {
+	if input == nil {
+		return ""
+	}
+
+	bytes, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Sprintf("%v", input)
+	}
+
+	return string(bytes)
+}
+
 func filterOutTemplateDeploymentParameters(input interface{}) interface{} {
 	if input == nil {
 		return nil
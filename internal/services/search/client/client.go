@@ -3,12 +3,16 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/search/mgmt/2020-03-13/search"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/services"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/sharedprivatelinkresources"
 )
 
 type Client struct {
-	AdminKeysClient *search.AdminKeysClient
-	QueryKeysClient *search.QueryKeysClient
-	ServicesClient  *search.ServicesClient
+	AdminKeysClient                  *search.AdminKeysClient
+	QueryKeysClient                  *search.QueryKeysClient
+	ServicesClient                   *search.ServicesClient
+	SearchServicesClient             *services.ServicesClient
+	SharedPrivateLinkResourcesClient *sharedprivatelinkresources.SharedPrivateLinkResourcesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,9 +25,17 @@ func NewClient(o *common.ClientOptions) *Client {
 	servicesClient := search.NewServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&servicesClient.Client, o.ResourceManagerAuthorizer)
 
+	searchServicesClient := services.NewServicesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&searchServicesClient.Client, o.ResourceManagerAuthorizer)
+
+	sharedPrivateLinkResourcesClient := sharedprivatelinkresources.NewSharedPrivateLinkResourcesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sharedPrivateLinkResourcesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AdminKeysClient: &adminKeysClient,
-		QueryKeysClient: &queryKeysClient,
-		ServicesClient:  &servicesClient,
+		AdminKeysClient:                  &adminKeysClient,
+		QueryKeysClient:                  &queryKeysClient,
+		ServicesClient:                   &servicesClient,
+		SearchServicesClient:             &searchServicesClient,
+		SharedPrivateLinkResourcesClient: &sharedPrivateLinkResourcesClient,
 	}
 }
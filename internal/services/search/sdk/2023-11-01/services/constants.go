@@ -0,0 +1,93 @@
+package services
+
+import "strings"
+
+type SearchEncryptionComplianceStatus string
+
+const (
+	SearchEncryptionComplianceStatusCompliant    SearchEncryptionComplianceStatus = "Compliant"
+	SearchEncryptionComplianceStatusNonCompliant SearchEncryptionComplianceStatus = "NonCompliant"
+)
+
+func PossibleValuesForSearchEncryptionComplianceStatus() []string {
+	return []string{
+		string(SearchEncryptionComplianceStatusCompliant),
+		string(SearchEncryptionComplianceStatusNonCompliant),
+	}
+}
+
+func parseSearchEncryptionComplianceStatus(input string) (*SearchEncryptionComplianceStatus, error) {
+	vals := map[string]SearchEncryptionComplianceStatus{
+		"compliant":    SearchEncryptionComplianceStatusCompliant,
+		"noncompliant": SearchEncryptionComplianceStatusNonCompliant,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchEncryptionComplianceStatus(input)
+	return &out, nil
+}
+
+type SearchEncryptionWithCmk string
+
+const (
+	SearchEncryptionWithCmkDisabled    SearchEncryptionWithCmk = "Disabled"
+	SearchEncryptionWithCmkEnabled     SearchEncryptionWithCmk = "Enabled"
+	SearchEncryptionWithCmkUnspecified SearchEncryptionWithCmk = "Unspecified"
+)
+
+func PossibleValuesForSearchEncryptionWithCmk() []string {
+	return []string{
+		string(SearchEncryptionWithCmkDisabled),
+		string(SearchEncryptionWithCmkEnabled),
+		string(SearchEncryptionWithCmkUnspecified),
+	}
+}
+
+func parseSearchEncryptionWithCmk(input string) (*SearchEncryptionWithCmk, error) {
+	vals := map[string]SearchEncryptionWithCmk{
+		"disabled":    SearchEncryptionWithCmkDisabled,
+		"enabled":     SearchEncryptionWithCmkEnabled,
+		"unspecified": SearchEncryptionWithCmkUnspecified,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchEncryptionWithCmk(input)
+	return &out, nil
+}
+
+type SearchSemanticSearch string
+
+const (
+	SearchSemanticSearchDisabled SearchSemanticSearch = "disabled"
+	SearchSemanticSearchFree     SearchSemanticSearch = "free"
+	SearchSemanticSearchStandard SearchSemanticSearch = "standard"
+)
+
+func PossibleValuesForSearchSemanticSearch() []string {
+	return []string{
+		string(SearchSemanticSearchDisabled),
+		string(SearchSemanticSearchFree),
+		string(SearchSemanticSearchStandard),
+	}
+}
+
+func parseSearchSemanticSearch(input string) (*SearchSemanticSearch, error) {
+	vals := map[string]SearchSemanticSearch{
+		"disabled": SearchSemanticSearchDisabled,
+		"free":     SearchSemanticSearchFree,
+		"standard": SearchSemanticSearchStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SearchSemanticSearch(input)
+	return &out, nil
+}
@@ -0,0 +1,6 @@
+package services
+
+type EncryptionWithCmk struct {
+	EncryptionComplianceStatus *SearchEncryptionComplianceStatus `json:"encryptionComplianceStatus,omitempty"`
+	Enforcement                *SearchEncryptionWithCmk          `json:"enforcement,omitempty"`
+}
@@ -0,0 +1,9 @@
+package services
+
+type SearchService struct {
+	Id         *string                  `json:"id,omitempty"`
+	Location   string                   `json:"location"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties *SearchServiceProperties `json:"properties,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
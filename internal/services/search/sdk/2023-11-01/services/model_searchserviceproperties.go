@@ -0,0 +1,6 @@
+package services
+
+type SearchServiceProperties struct {
+	EncryptionWithCmk *EncryptionWithCmk    `json:"encryptionWithCmk,omitempty"`
+	SemanticSearch    *SearchSemanticSearch `json:"semanticSearch,omitempty"`
+}
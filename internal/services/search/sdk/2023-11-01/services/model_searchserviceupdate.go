@@ -0,0 +1,5 @@
+package services
+
+type SearchServiceUpdate struct {
+	Properties *SearchServiceProperties `json:"properties,omitempty"`
+}
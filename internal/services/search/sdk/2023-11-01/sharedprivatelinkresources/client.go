@@ -0,0 +1,15 @@
+package sharedprivatelinkresources
+
+import "github.com/Azure/go-autorest/autorest"
+
+type SharedPrivateLinkResourcesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewSharedPrivateLinkResourcesClientWithBaseURI(endpoint string) SharedPrivateLinkResourcesClient {
+	return SharedPrivateLinkResourcesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
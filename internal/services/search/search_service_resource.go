@@ -1,6 +1,7 @@
 package search
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/services"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -124,6 +126,26 @@ func resourceSearchService() *pluginsdk.Resource {
 				},
 			},
 
+			"semantic_search_sku": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(services.SearchSemanticSearchFree),
+					string(services.SearchSemanticSearchStandard),
+				}, false),
+			},
+
+			"customer_managed_key_enforcement_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"customer_managed_key_encryption_compliance_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"identity": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -220,6 +242,13 @@ func resourceSearchServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("waiting for the creation/update of %s: %+v", id, err)
 	}
 
+	// semantic search and customer managed key enforcement aren't available in the API version used above
+	if d.IsNewResource() || d.HasChanges("semantic_search_sku", "customer_managed_key_enforcement_enabled") {
+		if err := updateSearchServiceProperties(ctx, meta, id, d); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id.ID())
 	return resourceSearchServiceRead(d, meta)
 }
@@ -284,6 +313,31 @@ func resourceSearchServiceRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("setting `identity`: %s", err)
 	}
 
+	searchServicesClient := meta.(*clients.Client).Search.SearchServicesClient
+	searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+	searchServiceResp, err := searchServicesClient.Get(ctx, searchServiceId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", searchServiceId, err)
+	}
+
+	semanticSearchSku := ""
+	cmkEnforcementEnabled := false
+	cmkComplianceStatus := ""
+	if model := searchServiceResp.Model; model != nil && model.Properties != nil {
+		if v := model.Properties.SemanticSearch; v != nil && *v != services.SearchSemanticSearchDisabled {
+			semanticSearchSku = string(*v)
+		}
+		if encryption := model.Properties.EncryptionWithCmk; encryption != nil {
+			cmkEnforcementEnabled = encryption.Enforcement != nil && *encryption.Enforcement == services.SearchEncryptionWithCmkEnabled
+			if encryption.EncryptionComplianceStatus != nil {
+				cmkComplianceStatus = string(*encryption.EncryptionComplianceStatus)
+			}
+		}
+	}
+	d.Set("semantic_search_sku", semanticSearchSku)
+	d.Set("customer_managed_key_enforcement_enabled", cmkEnforcementEnabled)
+	d.Set("customer_managed_key_encryption_compliance_status", cmkComplianceStatus)
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -309,6 +363,40 @@ func resourceSearchServiceDelete(d *pluginsdk.ResourceData, meta interface{}) er
 	return nil
 }
 
+func updateSearchServiceProperties(ctx context.Context, meta interface{}, id parse.SearchServiceId, d *pluginsdk.ResourceData) error {
+	client := meta.(*clients.Client).Search.SearchServicesClient
+	searchServiceId := services.NewSearchServiceID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	semanticSearch := services.SearchSemanticSearchDisabled
+	if v := d.Get("semantic_search_sku").(string); v != "" {
+		semanticSearch = services.SearchSemanticSearch(v)
+	}
+
+	enforcement := services.SearchEncryptionWithCmkUnspecified
+	if d.Get("customer_managed_key_enforcement_enabled").(bool) {
+		enforcement = services.SearchEncryptionWithCmkEnabled
+	}
+
+	payload := services.SearchServiceUpdate{
+		Properties: &services.SearchServiceProperties{
+			EncryptionWithCmk: &services.EncryptionWithCmk{
+				Enforcement: &enforcement,
+			},
+		},
+	}
+
+	// the free sku doesn't support semantic search, so it's only sent when it's been configured
+	if semanticSearch != services.SearchSemanticSearchDisabled || d.HasChange("semantic_search_sku") {
+		payload.Properties.SemanticSearch = &semanticSearch
+	}
+
+	if _, err := client.Update(ctx, searchServiceId, payload); err != nil {
+		return fmt.Errorf("updating semantic search and customer managed key enforcement for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
 func flattenSearchQueryKeys(input []search.QueryKey) []interface{} {
 	results := make([]interface{}, 0)
 
@@ -158,6 +158,40 @@ func TestAccSearchService_identity(t *testing.T) {
 	})
 }
 
+func TestAccSearchService_semanticSearchAndCustomerManagedKeyEnforcement(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_service", "test")
+	r := SearchServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.semanticSearchAndCustomerManagedKeyEnforcement(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("semantic_search_sku").HasValue("standard"),
+				check.That(data.ResourceName).Key("customer_managed_key_enforcement_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("customer_managed_key_encryption_compliance_status").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("semantic_search_sku").HasValue(""),
+				check.That(data.ResourceName).Key("customer_managed_key_enforcement_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t SearchServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SearchServiceID(state.ID)
 	if err != nil {
@@ -295,3 +329,30 @@ resource "azurerm_search_service" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (SearchServiceResource) semanticSearchAndCustomerManagedKeyEnforcement(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+
+  semantic_search_sku                      = "standard"
+  customer_managed_key_enforcement_enabled = true
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
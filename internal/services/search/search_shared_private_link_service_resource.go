@@ -0,0 +1,197 @@
+package search
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/sharedprivatelinkresources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSearchSharedPrivateLinkService() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSearchSharedPrivateLinkServiceCreateUpdate,
+		Read:   resourceSearchSharedPrivateLinkServiceRead,
+		Update: resourceSearchSharedPrivateLinkServiceCreateUpdate,
+		Delete: resourceSearchSharedPrivateLinkServiceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"search_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.SearchServiceID,
+			},
+
+			// the group ID of the target resource, see https://learn.microsoft.com/azure/search/search-indexer-howto-access-private#supported-resource-types
+			"subresource_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"amlworkspace",
+					"blob",
+					"cognitiveservices_account",
+					"dfs",
+					"file",
+					"managedInstance",
+					"mysqlServer",
+					"openai_account",
+					"sites",
+					"Sql",
+					"sqlServer",
+					"table",
+					"vault",
+				}, false),
+			},
+
+			"target_resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"request_message": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSearchSharedPrivateLinkServiceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Search.SharedPrivateLinkResourcesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	searchServiceId, err := parse.SearchServiceID(d.Get("search_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := sharedprivatelinkresources.NewSharedPrivateLinkResourceID(searchServiceId.SubscriptionId, searchServiceId.ResourceGroup, searchServiceId.Name, d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_search_shared_private_link_service", id.ID())
+		}
+	}
+
+	payload := sharedprivatelinkresources.SharedPrivateLinkResource{
+		Properties: &sharedprivatelinkresources.SharedPrivateLinkResourceProperties{
+			GroupId:               utils.String(d.Get("subresource_name").(string)),
+			PrivateLinkResourceId: utils.String(d.Get("target_resource_id").(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("request_message"); ok {
+		payload.Properties.RequestMessage = utils.String(v.(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSearchSharedPrivateLinkServiceRead(d, meta)
+}
+
+func resourceSearchSharedPrivateLinkServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Search.SharedPrivateLinkResourcesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SharedPrivateLinkResourceName)
+	d.Set("search_service_id", parse.NewSearchServiceID(id.SubscriptionId, id.ResourceGroupName, id.SearchServiceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("subresource_name", props.GroupId)
+			d.Set("target_resource_id", props.PrivateLinkResourceId)
+			d.Set("request_message", props.RequestMessage)
+
+			status := ""
+			if props.Status != nil {
+				status = string(*props.Status)
+			}
+			d.Set("status", status)
+		}
+	}
+
+	return nil
+}
+
+func resourceSearchSharedPrivateLinkServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Search.SharedPrivateLinkResourcesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package search_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/search/sdk/2023-11-01/sharedprivatelinkresources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SearchSharedPrivateLinkServiceResource struct{}
+
+func TestAccSearchSharedPrivateLinkService_blob(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blob(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Pending"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSharedPrivateLinkService_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blob(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSearchSharedPrivateLinkService_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blob(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.blobWithRequestMessage(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSearchSharedPrivateLinkService_openAI(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.openAI(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SearchSharedPrivateLinkServiceResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Search.SharedPrivateLinkResourcesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SearchSharedPrivateLinkServiceResource) blob(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_shared_private_link_service" "test" {
+  name               = "acctestsplblob%d"
+  search_service_id  = azurerm_search_service.test.id
+  subresource_name   = "blob"
+  target_resource_id = azurerm_storage_account.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchSharedPrivateLinkServiceResource) blobWithRequestMessage(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_shared_private_link_service" "test" {
+  name               = "acctestsplblob%d"
+  search_service_id  = azurerm_search_service.test.id
+  subresource_name   = "blob"
+  target_resource_id = azurerm_storage_account.test.id
+  request_message    = "please approve"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SearchSharedPrivateLinkServiceResource) openAI(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                  = "acctestcogacc-%d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  kind                  = "OpenAI"
+  sku_name              = "S0"
+  custom_subdomain_name = "acctestcogacc-%d"
+}
+
+resource "azurerm_search_shared_private_link_service" "test" {
+  name               = "acctestsplaoai%d"
+  search_service_id  = azurerm_search_service.test.id
+  subresource_name   = "openai_account"
+  target_resource_id = azurerm_cognitive_account.test.id
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r SearchSharedPrivateLinkServiceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_shared_private_link_service" "import" {
+  name               = azurerm_search_shared_private_link_service.test.name
+  search_service_id  = azurerm_search_shared_private_link_service.test.search_service_id
+  subresource_name   = azurerm_search_shared_private_link_service.test.subresource_name
+  target_resource_id = azurerm_search_shared_private_link_service.test.target_resource_id
+}
+`, r.blob(data))
+}
+
+func (SearchSharedPrivateLinkServiceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-search-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_search_service" "test" {
+  name                = "acctestsearchservice%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "standard"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
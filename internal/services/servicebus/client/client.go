@@ -3,15 +3,17 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	namespacesV20230101Preview "github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/sdk/2023-01-01-preview/namespaces"
 )
 
 type Client struct {
-	QueuesClient                  *servicebus.QueuesClient
-	DisasterRecoveryConfigsClient *servicebus.DisasterRecoveryConfigsClient
-	NamespacesClient              *servicebus.NamespacesClient
-	TopicsClient                  *servicebus.TopicsClient
-	SubscriptionsClient           *servicebus.SubscriptionsClient
-	SubscriptionRulesClient       *servicebus.RulesClient
+	QueuesClient                     *servicebus.QueuesClient
+	DisasterRecoveryConfigsClient    *servicebus.DisasterRecoveryConfigsClient
+	NamespacesClient                 *servicebus.NamespacesClient
+	NamespacesV20230101PreviewClient *namespacesV20230101Preview.NamespacesClient
+	TopicsClient                     *servicebus.TopicsClient
+	SubscriptionsClient              *servicebus.SubscriptionsClient
+	SubscriptionRulesClient          *servicebus.RulesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -24,6 +26,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	NamespacesClient := servicebus.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
 
+	NamespacesV20230101PreviewClient := namespacesV20230101Preview.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&NamespacesV20230101PreviewClient.Client, o.ResourceManagerAuthorizer)
+
 	TopicsClient := servicebus.NewTopicsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&TopicsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -34,11 +39,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&SubscriptionRulesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		QueuesClient:                  &QueuesClient,
-		DisasterRecoveryConfigsClient: &DisasterRecoveryConfigsClient,
-		NamespacesClient:              &NamespacesClient,
-		TopicsClient:                  &TopicsClient,
-		SubscriptionsClient:           &SubscriptionsClient,
-		SubscriptionRulesClient:       &SubscriptionRulesClient,
+		QueuesClient:                     &QueuesClient,
+		DisasterRecoveryConfigsClient:    &DisasterRecoveryConfigsClient,
+		NamespacesClient:                 &NamespacesClient,
+		NamespacesV20230101PreviewClient: &NamespacesV20230101PreviewClient,
+		TopicsClient:                     &TopicsClient,
+		SubscriptionsClient:              &SubscriptionsClient,
+		SubscriptionRulesClient:          &SubscriptionRulesClient,
 	}
 }
@@ -0,0 +1,93 @@
+package namespaces
+
+import "strings"
+
+type GeoDRRoleType string
+
+const (
+	GeoDRRoleTypePrimary   GeoDRRoleType = "Primary"
+	GeoDRRoleTypeSecondary GeoDRRoleType = "Secondary"
+)
+
+func PossibleValuesForGeoDRRoleType() []string {
+	return []string{
+		string(GeoDRRoleTypePrimary),
+		string(GeoDRRoleTypeSecondary),
+	}
+}
+
+func parseGeoDRRoleType(input string) (*GeoDRRoleType, error) {
+	vals := map[string]GeoDRRoleType{
+		"primary":   GeoDRRoleTypePrimary,
+		"secondary": GeoDRRoleTypeSecondary,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GeoDRRoleType(input)
+	return &out, nil
+}
+
+type SkuName string
+
+const (
+	SkuNameBasic    SkuName = "Basic"
+	SkuNamePremium  SkuName = "Premium"
+	SkuNameStandard SkuName = "Standard"
+)
+
+func PossibleValuesForSkuName() []string {
+	return []string{
+		string(SkuNameBasic),
+		string(SkuNamePremium),
+		string(SkuNameStandard),
+	}
+}
+
+func parseSkuName(input string) (*SkuName, error) {
+	vals := map[string]SkuName{
+		"basic":    SkuNameBasic,
+		"premium":  SkuNamePremium,
+		"standard": SkuNameStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuName(input)
+	return &out, nil
+}
+
+type SkuTier string
+
+const (
+	SkuTierBasic    SkuTier = "Basic"
+	SkuTierPremium  SkuTier = "Premium"
+	SkuTierStandard SkuTier = "Standard"
+)
+
+func PossibleValuesForSkuTier() []string {
+	return []string{
+		string(SkuTierBasic),
+		string(SkuTierPremium),
+		string(SkuTierStandard),
+	}
+}
+
+func parseSkuTier(input string) (*SkuTier, error) {
+	vals := map[string]SkuTier{
+		"basic":    SkuTierBasic,
+		"premium":  SkuTierPremium,
+		"standard": SkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SkuTier(input)
+	return &out, nil
+}
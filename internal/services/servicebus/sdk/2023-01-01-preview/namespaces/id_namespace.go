@@ -0,0 +1,124 @@
+package namespaces
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = NamespaceId{}
+
+// NamespaceId is a struct representing the Resource ID for a Namespace
+type NamespaceId struct {
+	SubscriptionId    string
+	ResourceGroupName string
+	NamespaceName     string
+}
+
+// NewNamespaceID returns a new NamespaceId struct
+func NewNamespaceID(subscriptionId string, resourceGroupName string, namespaceName string) NamespaceId {
+	return NamespaceId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroupName: resourceGroupName,
+		NamespaceName:     namespaceName,
+	}
+}
+
+// ParseNamespaceID parses 'input' into a NamespaceId
+func ParseNamespaceID(input string) (*NamespaceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(NamespaceId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := NamespaceId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NamespaceName, ok = parsed.Parsed["namespaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'namespaceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseNamespaceIDInsensitively parses 'input' case-insensitively into a NamespaceId
+// note: this method should only be used for API response data and not user input
+func ParseNamespaceIDInsensitively(input string) (*NamespaceId, error) {
+	parser := resourceids.NewParserFromResourceIdType(NamespaceId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := NamespaceId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.NamespaceName, ok = parsed.Parsed["namespaceName"]; !ok {
+		return nil, fmt.Errorf("the segment 'namespaceName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateNamespaceID checks that 'input' can be parsed as a Namespace ID
+func ValidateNamespaceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseNamespaceID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Namespace ID
+func (id NamespaceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ServiceBus/namespaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Namespace ID
+func (id NamespaceId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftServiceBus", "Microsoft.ServiceBus", "Microsoft.ServiceBus"),
+		resourceids.StaticSegment("staticNamespaces", "namespaces", "namespaces"),
+		resourceids.UserSpecifiedSegment("namespaceName", "namespaceValue"),
+	}
+}
+
+// String returns a human-readable description of this Namespace ID
+func (id NamespaceId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Namespace Name: %q", id.NamespaceName),
+	}
+	return fmt.Sprintf("Namespace (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,75 @@
+package namespaces
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type FailoverResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// Failover ...
+func (c NamespacesClient) Failover(ctx context.Context, id NamespaceId, input FailOver) (result FailoverResponse, err error) {
+	req, err := c.preparerForFailover(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "namespaces.NamespacesClient", "Failover", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForFailover(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "namespaces.NamespacesClient", "Failover", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// FailoverThenPoll performs Failover then polls until it's completed
+func (c NamespacesClient) FailoverThenPoll(ctx context.Context, id NamespaceId, input FailOver) error {
+	result, err := c.Failover(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing Failover: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after Failover: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForFailover prepares the Failover request.
+func (c NamespacesClient) preparerForFailover(ctx context.Context, id NamespaceId, input FailOver) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/failover", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForFailover sends the Failover request. The method will close the
+// http.Response Body if it receives an error.
+func (c NamespacesClient) senderForFailover(ctx context.Context, req *http.Request) (future FailoverResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,5 @@
+package namespaces
+
+type FailOver struct {
+	Properties *FailOverProperties `json:"properties,omitempty"`
+}
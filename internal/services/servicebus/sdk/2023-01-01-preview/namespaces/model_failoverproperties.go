@@ -0,0 +1,7 @@
+package namespaces
+
+type FailOverProperties struct {
+	Force                    *bool   `json:"force,omitempty"`
+	MaximumGracePeriodInMins *int64  `json:"maximumGracePeriodInMins,omitempty"`
+	PrimaryLocation          *string `json:"primaryLocation,omitempty"`
+}
@@ -0,0 +1,6 @@
+package namespaces
+
+type GeoDataReplicationProperties struct {
+	Locations                          *[]NamespaceReplicaLocation `json:"locations,omitempty"`
+	MaxReplicationLagDurationInSeconds *int64                      `json:"maxReplicationLagDurationInSeconds,omitempty"`
+}
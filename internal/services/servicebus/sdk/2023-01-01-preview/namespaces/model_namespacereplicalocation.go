@@ -0,0 +1,7 @@
+package namespaces
+
+type NamespaceReplicaLocation struct {
+	ClusterArmId *string        `json:"clusterArmId,omitempty"`
+	LocationName *string        `json:"locationName,omitempty"`
+	RoleType     *GeoDRRoleType `json:"roleType,omitempty"`
+}
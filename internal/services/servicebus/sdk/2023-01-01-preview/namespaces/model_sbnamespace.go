@@ -0,0 +1,11 @@
+package namespaces
+
+type SBNamespace struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *SBNamespaceProperties `json:"properties,omitempty"`
+	Sku        *SBSku                 `json:"sku,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
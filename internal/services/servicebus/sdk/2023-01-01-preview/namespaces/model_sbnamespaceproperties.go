@@ -0,0 +1,9 @@
+package namespaces
+
+type SBNamespaceProperties struct {
+	GeoDataReplication *GeoDataReplicationProperties `json:"geoDataReplication,omitempty"`
+	ProvisioningState  *string                       `json:"provisioningState,omitempty"`
+	ServiceBusEndpoint *string                       `json:"serviceBusEndpoint,omitempty"`
+	Status             *string                       `json:"status,omitempty"`
+	ZoneRedundant      *bool                         `json:"zoneRedundant,omitempty"`
+}
@@ -0,0 +1,7 @@
+package namespaces
+
+type SBSku struct {
+	Capacity *int64   `json:"capacity,omitempty"`
+	Name     SkuName  `json:"name"`
+	Tier     *SkuTier `json:"tier,omitempty"`
+}
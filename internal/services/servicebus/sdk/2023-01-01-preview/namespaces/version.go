@@ -0,0 +1,9 @@
+package namespaces
+
+import "fmt"
+
+const defaultApiVersion = "2023-01-01-preview"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/namespaces/%s", defaultApiVersion)
+}
@@ -1,19 +1,21 @@
 package servicebus
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/servicebus/mgmt/2021-06-01-preview/servicebus"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/sdk/2023-01-01-preview/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicebus/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -71,9 +73,9 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(servicebus.SkuNameBasic),
-					string(servicebus.SkuNameStandard),
-					string(servicebus.SkuNamePremium),
+					string(namespaces.SkuNameBasic),
+					string(namespaces.SkuNameStandard),
+					string(namespaces.SkuNamePremium),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
@@ -115,13 +117,59 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"geo_replication": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"secondary_locations": {
+							Type:     pluginsdk.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							Set: location.HashCode,
+						},
+
+						// changing the primary location to one of the secondary locations promotes that replica
+						"primary_location": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.StringIsNotEmpty,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+
+						"max_replication_lag_duration_in_seconds": {
+							Type:     pluginsdk.TypeInt,
+							Optional: true,
+							Default:  0,
+							ValidateFunc: validation.Any(
+								validation.IntInSlice([]int{0}),
+								validation.IntBetween(300, 86400),
+							),
+						},
+
+						"forced_promotion_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
 }
 
 func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus.NamespacesV20230101PreviewClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -132,49 +180,80 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 	sku := d.Get("sku").(string)
 	t := d.Get("tags").(map[string]interface{})
 
-	resourceId := parse.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	resourceId := namespaces.NewNamespaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceId.ResourceGroup, resourceId.Name)
+		existing, err := client.Get(ctx, resourceId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing %s: %+v", resourceId, err)
 			}
 		}
 
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_servicebus_namespace", resourceId.ID())
 		}
 	}
 
-	parameters := servicebus.SBNamespace{
-		Location: &location,
-		Sku: &servicebus.SBSku{
-			Name: servicebus.SkuName(sku),
-			Tier: servicebus.SkuTier(sku),
+	skuTier := namespaces.SkuTier(sku)
+	parameters := namespaces.SBNamespace{
+		Location: location,
+		Sku: &namespaces.SBSku{
+			Name: namespaces.SkuName(sku),
+			Tier: &skuTier,
 		},
-		SBNamespaceProperties: &servicebus.SBNamespaceProperties{
+		Properties: &namespaces.SBNamespaceProperties{
 			ZoneRedundant: utils.Bool(d.Get("zone_redundant").(bool)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tagsHelper.Expand(t),
 	}
 
 	if capacity := d.Get("capacity"); capacity != nil {
-		if !strings.EqualFold(sku, string(servicebus.SkuNamePremium)) && capacity.(int) > 0 {
+		if !strings.EqualFold(sku, string(namespaces.SkuNamePremium)) && capacity.(int) > 0 {
 			return fmt.Errorf("Service Bus SKU %q only supports `capacity` of 0", sku)
 		}
-		if strings.EqualFold(sku, string(servicebus.SkuNamePremium)) && capacity.(int) == 0 {
+		if strings.EqualFold(sku, string(namespaces.SkuNamePremium)) && capacity.(int) == 0 {
 			return fmt.Errorf("Service Bus SKU %q only supports `capacity` of 1, 2, 4, 8 or 16", sku)
 		}
-		parameters.Sku.Capacity = utils.Int32(int32(capacity.(int)))
+		parameters.Sku.Capacity = utils.Int64(int64(capacity.(int)))
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceId.ResourceGroup, resourceId.Name, parameters)
-	if err != nil {
-		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
+	geoReplicationRaw := d.Get("geo_replication").([]interface{})
+	if len(geoReplicationRaw) > 0 {
+		if !strings.EqualFold(sku, string(namespaces.SkuNamePremium)) {
+			return fmt.Errorf("`geo_replication` is only supported for the %q SKU", string(namespaces.SkuNamePremium))
+		}
+
+		geoReplication := geoReplicationRaw[0].(map[string]interface{})
+		primaryLocation := azure.NormalizeLocation(geoReplication["primary_location"].(string))
+		if d.IsNewResource() && primaryLocation != "" && primaryLocation != location {
+			return fmt.Errorf("`geo_replication.0.primary_location` must be the same as `location` when creating the Namespace - a secondary location can only be promoted once the Namespace exists")
+		}
+
+		for _, secondaryLocation := range geoReplication["secondary_locations"].(*pluginsdk.Set).List() {
+			if azure.NormalizeLocation(secondaryLocation.(string)) == primaryLocation {
+				return fmt.Errorf("`geo_replication.0.secondary_locations` can't contain the primary location %q", primaryLocation)
+			}
+		}
+	}
+
+	if !d.IsNewResource() && d.HasChange("geo_replication.0.primary_location") {
+		oldPrimary, newPrimary := d.GetChange("geo_replication.0.primary_location")
+		if oldPrimary.(string) != "" && newPrimary.(string) != "" {
+			if err := promoteServiceBusNamespaceReplica(ctx, client, resourceId, azure.NormalizeLocation(newPrimary.(string)), d.Get("geo_replication.0.forced_promotion_enabled").(bool)); err != nil {
+				return err
+			}
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting for create/update of %s: %+v", resourceId, err)
+	parameters.Properties.GeoDataReplication = expandServiceBusNamespaceGeoReplication(geoReplicationRaw, location)
+	if parameters.Properties.GeoDataReplication == nil && !d.IsNewResource() && d.HasChange("geo_replication") {
+		// removing the block removes all of the secondary locations, which leaves only the current primary location
+		oldGeoReplication, _ := d.GetChange("geo_replication")
+		parameters.Properties.GeoDataReplication = expandServiceBusNamespaceGeoReplicationRemoval(oldGeoReplication.([]interface{}), location)
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, resourceId, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", resourceId, err)
 	}
 
 	d.SetId(resourceId.ID())
@@ -182,39 +261,61 @@ func resourceServiceBusNamespaceCreateUpdate(d *pluginsdk.ResourceData, meta int
 }
 
 func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus.NamespacesV20230101PreviewClient
 	clientStable := meta.(*clients.Client).ServiceBus.NamespacesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.NamespaceID(d.Id())
+	id, err := namespaces.ParseNamespaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	resp, err := client.Get(ctx, *id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
-	d.Set("name", id.Name)
-	d.Set("resource_group_name", id.ResourceGroup)
-	d.Set("location", location.NormalizeNilable(resp.Location))
+	d.Set("name", id.NamespaceName)
+	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if sku := resp.Sku; sku != nil {
-		d.Set("sku", strings.ToLower(string(sku.Name)))
-		d.Set("capacity", sku.Capacity)
-	}
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
 
-	if properties := resp.SBNamespaceProperties; properties != nil {
-		d.Set("zone_redundant", properties.ZoneRedundant)
+		if sku := model.Sku; sku != nil {
+			d.Set("sku", strings.ToLower(string(sku.Name)))
+
+			capacity := 0
+			if sku.Capacity != nil {
+				capacity = int(*sku.Capacity)
+			}
+			d.Set("capacity", capacity)
+		}
+
+		zoneRedundant := false
+		var geoReplication *namespaces.GeoDataReplicationProperties
+		if props := model.Properties; props != nil {
+			if props.ZoneRedundant != nil {
+				zoneRedundant = *props.ZoneRedundant
+			}
+			geoReplication = props.GeoDataReplication
+		}
+		d.Set("zone_redundant", zoneRedundant)
+
+		if err := d.Set("geo_replication", flattenServiceBusNamespaceGeoReplication(geoReplication, d.Get("geo_replication").([]interface{}))); err != nil {
+			return fmt.Errorf("setting `geo_replication`: %+v", err)
+		}
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
 	}
 
-	keys, err := clientStable.ListKeys(ctx, id.ResourceGroup, id.Name, serviceBusNamespaceDefaultAuthorizationRule)
+	keys, err := clientStable.ListKeys(ctx, id.ResourceGroupName, id.NamespaceName, serviceBusNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		log.Printf("[WARN] listing default keys for %s: %+v", id, err)
 	} else {
@@ -224,29 +325,138 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("default_secondary_key", keys.SecondaryKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return nil
 }
 
 func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).ServiceBus.NamespacesClient
+	client := meta.(*clients.Client).ServiceBus.NamespacesV20230101PreviewClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parse.NamespaceID(d.Id())
+	id, err := namespaces.ParseNamespaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
-	if err != nil {
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
-		}
+	return nil
+}
+
+// promoteServiceBusNamespaceReplica fails the Namespace over to one of its secondary locations, which then becomes
+// the primary location - this has to happen before the list of locations can be updated.
+func promoteServiceBusNamespaceReplica(ctx context.Context, client *namespaces.NamespacesClient, id namespaces.NamespaceId, primaryLocation string, forced bool) error {
+	payload := namespaces.FailOver{
+		Properties: &namespaces.FailOverProperties{
+			Force:           utils.Bool(forced),
+			PrimaryLocation: utils.String(primaryLocation),
+		},
+	}
+
+	log.Printf("[DEBUG] promoting the replica in %q to be the primary location of %s..", primaryLocation, id)
+	if err := client.FailoverThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("promoting the replica in %q to be the primary location of %s: %+v", primaryLocation, id, err)
 	}
 
 	return nil
 }
+
+func expandServiceBusNamespaceGeoReplication(input []interface{}, namespaceLocation string) *namespaces.GeoDataReplicationProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	primaryLocation := azure.NormalizeLocation(raw["primary_location"].(string))
+	if primaryLocation == "" {
+		primaryLocation = namespaceLocation
+	}
+
+	primaryRole := namespaces.GeoDRRoleTypePrimary
+	secondaryRole := namespaces.GeoDRRoleTypeSecondary
+	locations := []namespaces.NamespaceReplicaLocation{
+		{
+			LocationName: utils.String(primaryLocation),
+			RoleType:     &primaryRole,
+		},
+	}
+	for _, secondaryLocation := range raw["secondary_locations"].(*pluginsdk.Set).List() {
+		locations = append(locations, namespaces.NamespaceReplicaLocation{
+			LocationName: utils.String(azure.NormalizeLocation(secondaryLocation.(string))),
+			RoleType:     &secondaryRole,
+		})
+	}
+
+	return &namespaces.GeoDataReplicationProperties{
+		Locations:                          &locations,
+		MaxReplicationLagDurationInSeconds: utils.Int64(int64(raw["max_replication_lag_duration_in_seconds"].(int))),
+	}
+}
+
+func expandServiceBusNamespaceGeoReplicationRemoval(old []interface{}, namespaceLocation string) *namespaces.GeoDataReplicationProperties {
+	primaryLocation := namespaceLocation
+	if len(old) > 0 && old[0] != nil {
+		if v := azure.NormalizeLocation(old[0].(map[string]interface{})["primary_location"].(string)); v != "" {
+			primaryLocation = v
+		}
+	}
+
+	primaryRole := namespaces.GeoDRRoleTypePrimary
+	return &namespaces.GeoDataReplicationProperties{
+		Locations: &[]namespaces.NamespaceReplicaLocation{
+			{
+				LocationName: utils.String(primaryLocation),
+				RoleType:     &primaryRole,
+			},
+		},
+		MaxReplicationLagDurationInSeconds: utils.Int64(0),
+	}
+}
+
+func flattenServiceBusNamespaceGeoReplication(input *namespaces.GeoDataReplicationProperties, existing []interface{}) []interface{} {
+	if input == nil || input.Locations == nil {
+		return []interface{}{}
+	}
+
+	primaryLocation := ""
+	secondaryLocations := make([]interface{}, 0)
+	for _, item := range *input.Locations {
+		if item.LocationName == nil {
+			continue
+		}
+
+		if item.RoleType != nil && *item.RoleType == namespaces.GeoDRRoleTypePrimary {
+			primaryLocation = location.Normalize(*item.LocationName)
+			continue
+		}
+		secondaryLocations = append(secondaryLocations, location.Normalize(*item.LocationName))
+	}
+
+	// a Namespace without any secondary locations isn't geo-replicated
+	if len(secondaryLocations) == 0 {
+		return []interface{}{}
+	}
+
+	maxReplicationLag := 0
+	if input.MaxReplicationLagDurationInSeconds != nil {
+		maxReplicationLag = int(*input.MaxReplicationLagDurationInSeconds)
+	}
+
+	// `forced_promotion_enabled` only controls how a promotion is performed, so it isn't returned by the API
+	forcedPromotion := false
+	if len(existing) > 0 && existing[0] != nil {
+		forcedPromotion = existing[0].(map[string]interface{})["forced_promotion_enabled"].(bool)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"forced_promotion_enabled":                forcedPromotion,
+			"max_replication_lag_duration_in_seconds": maxReplicationLag,
+			"primary_location":                        primaryLocation,
+			"secondary_locations":                     pluginsdk.NewSet(location.HashCode, secondaryLocations),
+		},
+	}
+}
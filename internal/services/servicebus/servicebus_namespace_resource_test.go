@@ -138,6 +138,62 @@ func TestAccAzureRMServiceBusNamespace_zoneRedundant(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMServiceBusNamespace_geoReplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.premium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.geoReplication(data, data.Locations.Primary, data.Locations.Secondary),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("geo_replication.0.secondary_locations.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("geo_replication.0.forced_promotion_enabled"),
+		{
+			// promotes the secondary location to be the primary location
+			Config: r.geoReplication(data, data.Locations.Secondary, data.Locations.Primary),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("geo_replication.0.forced_promotion_enabled"),
+		{
+			Config: r.geoReplication(data, data.Locations.Primary, data.Locations.Secondary),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("geo_replication.0.forced_promotion_enabled"),
+		{
+			Config: r.premium(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("geo_replication.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMServiceBusNamespace_geoReplicationStandard(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_servicebus_namespace", "test")
+	r := ServiceBusNamespaceResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.geoReplicationStandard(data),
+			ExpectError: regexp.MustCompile("`geo_replication` is only supported for the \"Premium\" SKU"),
+		},
+	})
+}
+
 func (t ServiceBusNamespaceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.NamespaceID(state.ID)
 	if err != nil {
@@ -289,3 +345,54 @@ resource "azurerm_servicebus_namespace" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (ServiceBusNamespaceResource) geoReplication(data acceptance.TestData, primaryLocation, secondaryLocation string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Premium"
+  capacity            = 1
+
+  geo_replication {
+    primary_location                        = "%s"
+    secondary_locations                     = ["%s"]
+    max_replication_lag_duration_in_seconds = 300
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, primaryLocation, secondaryLocation)
+}
+
+func (ServiceBusNamespaceResource) geoReplicationStandard(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "test" {
+  name                = "acctestservicebusnamespace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  geo_replication {
+    secondary_locations = ["%s"]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Secondary)
+}
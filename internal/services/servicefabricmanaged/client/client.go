@@ -3,28 +3,52 @@ package client
 import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/application"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/applicationtype"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/applicationtypeversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/managedcluster"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/nodetype"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/service"
 )
 
 type Client struct {
-	ManagedClusterClient *managedcluster.ManagedClusterClient
-	NodeTypeClient       *nodetype.NodeTypeClient
-	tokenFunc            func(endpoint string) (autorest.Authorizer, error)
-	configureClientFunc  func(c *autorest.Client, authorizer autorest.Authorizer)
+	ApplicationClient            *application.ApplicationClient
+	ApplicationTypeClient        *applicationtype.ApplicationTypeClient
+	ApplicationTypeVersionClient *applicationtypeversion.ApplicationTypeVersionClient
+	ManagedClusterClient         *managedcluster.ManagedClusterClient
+	NodeTypeClient               *nodetype.NodeTypeClient
+	ServiceClient                *service.ServiceClient
+	tokenFunc                    func(endpoint string) (autorest.Authorizer, error)
+	configureClientFunc          func(c *autorest.Client, authorizer autorest.Authorizer)
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	applicationClient := application.NewApplicationClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&applicationClient.Client, o.ResourceManagerAuthorizer)
+
+	applicationTypeClient := applicationtype.NewApplicationTypeClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&applicationTypeClient.Client, o.ResourceManagerAuthorizer)
+
+	applicationTypeVersionClient := applicationtypeversion.NewApplicationTypeVersionClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&applicationTypeVersionClient.Client, o.ResourceManagerAuthorizer)
+
 	managedCluster := managedcluster.NewManagedClusterClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&managedCluster.Client, o.ResourceManagerAuthorizer)
 
 	nodeType := nodetype.NewNodeTypeClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&nodeType.Client, o.ResourceManagerAuthorizer)
 
+	serviceClient := service.NewServiceClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&serviceClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		ManagedClusterClient: &managedCluster,
-		NodeTypeClient:       &nodeType,
-		tokenFunc:            o.TokenFunc,
-		configureClientFunc:  o.ConfigureClient,
+		ApplicationClient:            &applicationClient,
+		ApplicationTypeClient:        &applicationTypeClient,
+		ApplicationTypeVersionClient: &applicationTypeVersionClient,
+		ManagedClusterClient:         &managedCluster,
+		NodeTypeClient:               &nodeType,
+		ServiceClient:                &serviceClient,
+		tokenFunc:                    o.TokenFunc,
+		configureClientFunc:          o.ConfigureClient,
 	}
 }
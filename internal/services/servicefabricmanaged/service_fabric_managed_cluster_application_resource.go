@@ -0,0 +1,284 @@
+package servicefabricmanaged
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/application"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/applicationtypeversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationResource{}
+
+type ApplicationResourceModel struct {
+	Name                     string                    `tfschema:"name"`
+	ManagedClusterId         string                    `tfschema:"managed_cluster_id"`
+	ApplicationTypeVersionId string                    `tfschema:"application_type_version_id"`
+	Parameters               map[string]string         `tfschema:"parameters"`
+	UpgradePolicy            []ApplicationUpgradeModel `tfschema:"upgrade_policy"`
+	Tags                     map[string]string         `tfschema:"tags"`
+}
+
+type ApplicationUpgradeModel struct {
+	ForceRestartEnabled        bool   `tfschema:"force_restart_enabled"`
+	RecreateApplicationEnabled bool   `tfschema:"recreate_application_enabled"`
+	UpgradeMode                string `tfschema:"upgrade_mode"`
+}
+
+func (r ApplicationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"managed_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: application.ValidateManagedClusterID,
+		},
+
+		// changing the version upgrades the application in-place
+		"application_type_version_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: applicationtypeversion.ValidateVersionID,
+		},
+
+		"parameters": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"upgrade_policy": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"force_restart_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"recreate_application_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+
+					"upgrade_mode": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      string(application.RollingUpgradeModeMonitored),
+						ValidateFunc: validation.StringInSlice(application.PossibleValuesForRollingUpgradeMode(), false),
+					},
+				},
+			},
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ApplicationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationResource) ModelObject() interface{} {
+	return &ApplicationResourceModel{}
+}
+
+func (r ApplicationResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_cluster_application"
+}
+
+func (r ApplicationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return application.ValidateApplicationID
+}
+
+func (r ApplicationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			var model ApplicationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := application.ParseManagedClusterID(model.ManagedClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := application.NewApplicationID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ClusterName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandServiceFabricManagedApplication(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			id, err := application.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationResourceModel{
+				Name:             id.ApplicationName,
+				ManagedClusterId: application.NewManagedClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID(),
+			}
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if props.Version != nil {
+						versionId, err := applicationtypeversion.ParseVersionIDInsensitively(*props.Version)
+						if err != nil {
+							return err
+						}
+						state.ApplicationTypeVersionId = versionId.ID()
+					}
+					if props.Parameters != nil {
+						state.Parameters = *props.Parameters
+					}
+					state.UpgradePolicy = flattenServiceFabricManagedApplicationUpgradePolicy(props.UpgradePolicy)
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			id, err := application.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a change to the version or parameters triggers a rolling upgrade of the application
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandServiceFabricManagedApplication(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationClient
+
+			id, err := application.ParseApplicationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandServiceFabricManagedApplication(input ApplicationResourceModel) application.ApplicationResource {
+	props := &application.ApplicationResourceProperties{
+		Parameters: &input.Parameters,
+		Version:    utils.String(input.ApplicationTypeVersionId),
+	}
+
+	if len(input.UpgradePolicy) > 0 {
+		v := input.UpgradePolicy[0]
+		upgradeMode := application.RollingUpgradeMode(v.UpgradeMode)
+		props.UpgradePolicy = &application.ApplicationUpgradePolicy{
+			ForceRestart:        utils.Bool(v.ForceRestartEnabled),
+			RecreateApplication: utils.Bool(v.RecreateApplicationEnabled),
+			UpgradeMode:         &upgradeMode,
+		}
+	}
+
+	return application.ApplicationResource{
+		Properties: props,
+		Tags:       &input.Tags,
+	}
+}
+
+func flattenServiceFabricManagedApplicationUpgradePolicy(input *application.ApplicationUpgradePolicy) []ApplicationUpgradeModel {
+	if input == nil {
+		return []ApplicationUpgradeModel{}
+	}
+
+	upgradeMode := string(application.RollingUpgradeModeMonitored)
+	if input.UpgradeMode != nil {
+		upgradeMode = string(*input.UpgradeMode)
+	}
+
+	return []ApplicationUpgradeModel{
+		{
+			ForceRestartEnabled:        input.ForceRestart != nil && *input.ForceRestart,
+			RecreateApplicationEnabled: input.RecreateApplication != nil && *input.RecreateApplication,
+			UpgradeMode:                upgradeMode,
+		},
+	}
+}
@@ -0,0 +1,138 @@
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/application"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationResource struct{}
+
+func TestAccServiceFabricManagedClusterApplication_basic(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, packageUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedClusterApplication_requiresImport(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, packageUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, packageUrl),
+			ExpectError: acceptance.RequiresImportError(data.ResourceType),
+		},
+	})
+}
+
+func TestAccServiceFabricManagedClusterApplication_complete(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, packageUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, packageUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("upgrade_policy.0.force_restart_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := application.ParseApplicationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ApplicationClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApplicationResource) basic(data acceptance.TestData, packageUrl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application" "test" {
+  name                        = "acctestApp"
+  managed_cluster_id          = azurerm_service_fabric_managed_cluster.test.id
+  application_type_version_id = azurerm_service_fabric_managed_cluster_application_type_version.test.id
+}
+`, ApplicationTypeVersionResource{}.basic(data, packageUrl))
+}
+
+func (r ApplicationResource) requiresImport(data acceptance.TestData, packageUrl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application" "import" {
+  name                        = azurerm_service_fabric_managed_cluster_application.test.name
+  managed_cluster_id          = azurerm_service_fabric_managed_cluster_application.test.managed_cluster_id
+  application_type_version_id = azurerm_service_fabric_managed_cluster_application.test.application_type_version_id
+}
+`, r.basic(data, packageUrl))
+}
+
+func (r ApplicationResource) complete(data acceptance.TestData, packageUrl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application" "test" {
+  name                        = "acctestApp"
+  managed_cluster_id          = azurerm_service_fabric_managed_cluster.test.id
+  application_type_version_id = azurerm_service_fabric_managed_cluster_application_type_version.test.id
+
+  upgrade_policy {
+    force_restart_enabled = true
+    upgrade_mode          = "Monitored"
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, ApplicationTypeVersionResource{}.basic(data, packageUrl))
+}
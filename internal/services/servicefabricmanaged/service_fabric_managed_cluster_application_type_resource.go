@@ -0,0 +1,180 @@
+package servicefabricmanaged
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/applicationtype"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationTypeResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationTypeResource{}
+
+type ApplicationTypeResourceModel struct {
+	Name             string            `tfschema:"name"`
+	ManagedClusterId string            `tfschema:"managed_cluster_id"`
+	Tags             map[string]string `tfschema:"tags"`
+}
+
+func (r ApplicationTypeResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"managed_cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationtype.ValidateManagedClusterID,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ApplicationTypeResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationTypeResource) ModelObject() interface{} {
+	return &ApplicationTypeResourceModel{}
+}
+
+func (r ApplicationTypeResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_cluster_application_type"
+}
+
+func (r ApplicationTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationtype.ValidateApplicationTypeID
+}
+
+func (r ApplicationTypeResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			var model ApplicationTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			clusterId, err := applicationtype.ParseManagedClusterID(model.ManagedClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationtype.NewApplicationTypeID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ClusterName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := applicationtype.ApplicationTypeResource{
+				Tags: &model.Tags,
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			id, err := applicationtype.ParseApplicationTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationTypeResourceModel{
+				Name:             id.ApplicationTypeName,
+				ManagedClusterId: applicationtype.NewManagedClusterID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName).ID(),
+			}
+			if model := resp.Model; model != nil && model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationTypeResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			id, err := applicationtype.ParseApplicationTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationTypeResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := applicationtype.ApplicationTypeUpdateParameters{
+					Tags: &model.Tags,
+				}
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeClient
+
+			id, err := applicationtype.ParseApplicationTypeID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
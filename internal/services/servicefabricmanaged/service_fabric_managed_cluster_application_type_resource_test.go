@@ -0,0 +1,129 @@
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/applicationtype"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationTypeResource struct{}
+
+func TestAccServiceFabricManagedClusterApplicationType_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application_type", "test")
+	r := ApplicationTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedClusterApplicationType_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application_type", "test")
+	r := ApplicationTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccServiceFabricManagedClusterApplicationType_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application_type", "test")
+	r := ApplicationTypeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationTypeResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := applicationtype.ParseApplicationTypeID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ApplicationTypeClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApplicationTypeResource) template(data acceptance.TestData) string {
+	c := ClusterResource{}
+	return c.basic(data, c.nodeType("test1", true, 130))
+}
+
+func (r ApplicationTypeResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application_type" "test" {
+  name               = "acctestAppType"
+  managed_cluster_id = azurerm_service_fabric_managed_cluster.test.id
+}
+`, r.template(data))
+}
+
+func (r ApplicationTypeResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application_type" "import" {
+  name               = azurerm_service_fabric_managed_cluster_application_type.test.name
+  managed_cluster_id = azurerm_service_fabric_managed_cluster_application_type.test.managed_cluster_id
+}
+`, r.basic(data))
+}
+
+func (r ApplicationTypeResource) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application_type" "test" {
+  name               = "acctestAppType"
+  managed_cluster_id = azurerm_service_fabric_managed_cluster.test.id
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data))
+}
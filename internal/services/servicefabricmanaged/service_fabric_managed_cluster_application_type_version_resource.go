@@ -0,0 +1,197 @@
+package servicefabricmanaged
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/applicationtypeversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApplicationTypeVersionResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApplicationTypeVersionResource{}
+
+type ApplicationTypeVersionResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ApplicationTypeId string            `tfschema:"application_type_id"`
+	PackageUrl        string            `tfschema:"package_url"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r ApplicationTypeVersionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_type_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: applicationtypeversion.ValidateApplicationTypeID,
+		},
+
+		// the package for a given version is immutable, a new version needs to be provisioned to change it
+		"package_url": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ApplicationTypeVersionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApplicationTypeVersionResource) ModelObject() interface{} {
+	return &ApplicationTypeVersionResourceModel{}
+}
+
+func (r ApplicationTypeVersionResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_cluster_application_type_version"
+}
+
+func (r ApplicationTypeVersionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return applicationtypeversion.ValidateVersionID
+}
+
+func (r ApplicationTypeVersionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			var model ApplicationTypeVersionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationTypeId, err := applicationtypeversion.ParseApplicationTypeID(model.ApplicationTypeId)
+			if err != nil {
+				return err
+			}
+
+			id := applicationtypeversion.NewVersionID(applicationTypeId.SubscriptionId, applicationTypeId.ResourceGroupName, applicationTypeId.ClusterName, applicationTypeId.ApplicationTypeName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := applicationtypeversion.ApplicationTypeVersionResource{
+				Properties: &applicationtypeversion.ApplicationTypeVersionResourceProperties{
+					AppPackageUrl: model.PackageUrl,
+				},
+				Tags: &model.Tags,
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeVersionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			id, err := applicationtypeversion.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ApplicationTypeVersionResourceModel{
+				Name:              id.Version,
+				ApplicationTypeId: applicationtypeversion.NewApplicationTypeID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, id.ApplicationTypeName).ID(),
+			}
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.PackageUrl = props.AppPackageUrl
+				}
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ApplicationTypeVersionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			id, err := applicationtypeversion.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApplicationTypeVersionResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload := applicationtypeversion.ApplicationTypeVersionUpdateParameters{
+					Tags: &model.Tags,
+				}
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApplicationTypeVersionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ApplicationTypeVersionClient
+
+			id, err := applicationtypeversion.ParseVersionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/applicationtypeversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApplicationTypeVersionResource struct{}
+
+// the application package (an .sfpkg) needs to be published somewhere the cluster can download it from
+func applicationPackageUrl(t *testing.T) string {
+	packageUrl := os.Getenv("ARM_TEST_SERVICE_FABRIC_APPLICATION_PACKAGE_URL")
+	if packageUrl == "" {
+		t.Skip("Skipping as ARM_TEST_SERVICE_FABRIC_APPLICATION_PACKAGE_URL is not specified")
+	}
+	return packageUrl
+}
+
+func TestAccServiceFabricManagedClusterApplicationTypeVersion_basic(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application_type_version", "test")
+	r := ApplicationTypeVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, packageUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedClusterApplicationTypeVersion_requiresImport(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_application_type_version", "test")
+	r := ApplicationTypeVersionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, packageUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, packageUrl),
+			ExpectError: acceptance.RequiresImportError(data.ResourceType),
+		},
+	})
+}
+
+func (r ApplicationTypeVersionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := applicationtypeversion.ParseVersionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ApplicationTypeVersionClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApplicationTypeVersionResource) basic(data acceptance.TestData, packageUrl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application_type_version" "test" {
+  name                = "1.0.0"
+  application_type_id = azurerm_service_fabric_managed_cluster_application_type.test.id
+  package_url         = %q
+}
+`, ApplicationTypeResource{}.basic(data), packageUrl)
+}
+
+func (r ApplicationTypeVersionResource) requiresImport(data acceptance.TestData, packageUrl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_application_type_version" "import" {
+  name                = azurerm_service_fabric_managed_cluster_application_type_version.test.name
+  application_type_id = azurerm_service_fabric_managed_cluster_application_type_version.test.application_type_id
+  package_url         = azurerm_service_fabric_managed_cluster_application_type_version.test.package_url
+}
+`, r.basic(data, packageUrl))
+}
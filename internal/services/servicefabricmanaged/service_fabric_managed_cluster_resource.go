@@ -69,6 +69,7 @@ type VmSecrets struct {
 }
 
 type NodeType struct {
+	AutoscaleEnabled               bool   `tfschema:"autoscale_enabled"`
 	DataDiskSize                   int64  `tfschema:"data_disk_size_gb"`
 	Id                             string `tfschema:"id"`
 	MultiplePlacementGroupsEnabled bool   `tfschema:"multiple_placement_groups_enabled"`
@@ -277,12 +278,25 @@ func (k ClusterResource) Read() sdk.ResourceFunc {
 			model.Password = metadata.ResourceData.Get("password").(string)
 			model.ResourceGroup = resourceId.ResourceGroupName
 			model.NodeTypes = make([]NodeType, 0)
+
+			// autoscaled node types report an instance count of -1, so the configured count is retained
+			configuredInstanceCounts := make(map[string]int64)
+			for _, v := range metadata.ResourceData.Get("node_type").([]interface{}) {
+				if nt, ok := v.(map[string]interface{}); ok {
+					configuredInstanceCounts[nt["name"].(string)] = int64(nt["vm_instance_count"].(int))
+				}
+			}
+
 			for _, nt := range nts.Items {
 				provState := nt.Properties.ProvisioningState
 				if provState == nil || *provState == nodetype.ManagedResourceProvisioningStateDeleted || *provState == nodetype.ManagedResourceProvisioningStateDeleting {
 					continue
 				}
-				model.NodeTypes = append(model.NodeTypes, flattenNodetypeProperties(nt))
+				flattened := flattenNodetypeProperties(nt)
+				if flattened.AutoscaleEnabled {
+					flattened.VmInstanceCount = configuredInstanceCounts[flattened.Name]
+				}
+				model.NodeTypes = append(model.NodeTypes, flattened)
 			}
 			return metadata.Encode(model)
 		},
@@ -326,6 +340,14 @@ func (k ClusterResource) CustomizeDiff() sdk.ResourceFunc {
 			for _, nti := range rd.Get("node_type").([]interface{}) {
 				nt := nti.(map[string]interface{})
 				vmCount := nt["vm_instance_count"].(int)
+				if nt["autoscale_enabled"].(bool) {
+					if nt["primary"].(bool) {
+						return fmt.Errorf("autoscaling can only be enabled on secondary node types")
+					}
+					if sku != string(managedcluster.SkuNameStandard) {
+						return fmt.Errorf("autoscaling node types requires the %q SKU", string(managedcluster.SkuNameStandard))
+					}
+				}
 				if sku == string(managedcluster.SkuNameBasic) && vmCount < 3 {
 					return fmt.Errorf("basic SKU requires at least 3 instances in a node type")
 				} else if sku == string(managedcluster.SkuNameStandard) && vmCount < 5 {
@@ -652,6 +674,8 @@ func flattenNodetypeProperties(nt nodetype.NodeType) NodeType {
 		out.Stateless = *stateless
 	}
 
+	out.AutoscaleEnabled = props.VmInstanceCount == -1
+
 	if capacities := props.Capacities; capacities != nil {
 		caps := make(map[string]string)
 		for k, v := range *capacities {
@@ -852,6 +876,11 @@ func expandNodeTypeProperties(nt *NodeType) (*nodetype.NodeTypeProperties, error
 		VmSize:                  &nt.VmSize,
 	}
 
+	// the instance count of an autoscaled node type is controlled by the autoscale settings targeting it
+	if nt.AutoscaleEnabled {
+		nodeTypeProperties.VmInstanceCount = -1
+	}
+
 	return nodeTypeProperties, nil
 }
 
@@ -885,6 +914,10 @@ func nodeTypeSchema() *pluginsdk.Schema {
 					Type:     pluginsdk.TypeString,
 					Computed: true,
 				},
+				"autoscale_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+				},
 				"data_disk_size_gb": {
 					Type:     pluginsdk.TypeInt,
 					Required: true,
@@ -94,6 +94,33 @@ func TestAccServiceFabricManagedCluster_full(t *testing.T) {
 	})
 }
 
+func TestAccServiceFabricManagedCluster_autoscaleNodeType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster", "test")
+	r := ClusterResource{}
+	nodeTypeData1 := r.nodeType("test1", true, 130)
+	nodeTypeData2 := r.nodeType("test2", false, 130)
+	nodeTypeDataBoth := fmt.Sprintf("%s\n%s", nodeTypeData1, nodeTypeData2)
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, nodeTypeDataBoth),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("node_type.1.autoscale_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.autoscale(data, nodeTypeData1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("node_type.1.autoscale_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("node_type.1.vm_instance_count").HasValue("5"),
+			),
+		},
+		data.ImportStep("password", "node_type.1.vm_instance_count"),
+	})
+}
+
 func (r ClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	resourceID, err := managedcluster.ParseManagedClusterID(state.ID)
 	if err != nil {
@@ -202,3 +229,65 @@ node_type {
 }
 `, diskSize, name, primary)
 }
+
+func (r ClusterResource) autoscale(data acceptance.TestData, primaryNodeType string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_autoscale_setting" "test" {
+  name                = "acctestautoscale-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  target_resource_id  = azurerm_service_fabric_managed_cluster.test.node_type.1.id
+
+  profile {
+    name = "default"
+
+    capacity {
+      default = 5
+      minimum = 5
+      maximum = 10
+    }
+
+    rule {
+      metric_trigger {
+        metric_name        = "Percentage CPU"
+        metric_resource_id = azurerm_service_fabric_managed_cluster.test.node_type.1.id
+        time_grain         = "PT1M"
+        statistic          = "Average"
+        time_window        = "PT5M"
+        time_aggregation   = "Average"
+        operator           = "GreaterThan"
+        threshold          = 75
+      }
+
+      scale_action {
+        direction = "Increase"
+        type      = "ChangeCount"
+        value     = "1"
+        cooldown  = "PT5M"
+      }
+    }
+  }
+}
+`, r.basic(data, fmt.Sprintf("%s\n%s", primaryNodeType, r.autoscaleNodeType("test2"))), data.RandomInteger)
+}
+
+func (r ClusterResource) autoscaleNodeType(name string) string {
+	return fmt.Sprintf(`
+node_type {
+  data_disk_size_gb      = 130
+  name                   = "%[1]s"
+  autoscale_enabled      = true
+  application_port_range = "7000-9000"
+  ephemeral_port_range   = "10000-20000"
+
+  vm_size            = "Standard_DS2_v2"
+  vm_image_publisher = "MicrosoftWindowsServer"
+  vm_image_sku       = "2016-Datacenter"
+  vm_image_offer     = "WindowsServer"
+  vm_image_version   = "latest"
+  vm_instance_count  = 5
+}
+`, name)
+}
@@ -0,0 +1,473 @@
+package servicefabricmanaged
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ServiceResource{}
+	_ sdk.ResourceWithCustomizeDiff = ServiceResource{}
+)
+
+type ServiceResourceModel struct {
+	Name                 string                  `tfschema:"name"`
+	ApplicationId        string                  `tfschema:"application_id"`
+	ServiceTypeName      string                  `tfschema:"service_type_name"`
+	Kind                 string                  `tfschema:"kind"`
+	Partition            []ServicePartitionModel `tfschema:"partition"`
+	InstanceCount        int                     `tfschema:"instance_count"`
+	TargetReplicaSetSize int                     `tfschema:"target_replica_set_size"`
+	MinReplicaSetSize    int                     `tfschema:"min_replica_set_size"`
+	HasPersistedState    bool                    `tfschema:"has_persisted_state"`
+	PlacementConstraints string                  `tfschema:"placement_constraints"`
+	DefaultMoveCost      string                  `tfschema:"default_move_cost"`
+	Tags                 map[string]string       `tfschema:"tags"`
+}
+
+type ServicePartitionModel struct {
+	Scheme  string   `tfschema:"scheme"`
+	Count   int      `tfschema:"count"`
+	LowKey  int      `tfschema:"low_key"`
+	HighKey int      `tfschema:"high_key"`
+	Names   []string `tfschema:"names"`
+}
+
+func (r ServiceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"application_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: service.ValidateApplicationID,
+		},
+
+		"service_type_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kind": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(service.PossibleValuesForServiceKind(), false),
+		},
+
+		"partition": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(service.PossibleValuesForPartitionScheme(), false),
+					},
+
+					"count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"low_key": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					"high_key": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+						ForceNew: true,
+					},
+
+					"names": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"instance_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(-1),
+		},
+
+		"target_replica_set_size": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"min_replica_set_size": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"has_persisted_state": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+		},
+
+		"placement_constraints": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"default_move_cost": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(service.PossibleValuesForMoveCost(), false),
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ServiceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ServiceResource) ModelObject() interface{} {
+	return &ServiceResourceModel{}
+}
+
+func (r ServiceResource) ResourceType() string {
+	return "azurerm_service_fabric_managed_cluster_service"
+}
+
+func (r ServiceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return service.ValidateServiceID
+}
+
+func (r ServiceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			switch rd.Get("kind").(string) {
+			case string(service.ServiceKindStateless):
+				if rd.Get("instance_count").(int) == 0 {
+					return fmt.Errorf("`instance_count` must be specified for a %q service", string(service.ServiceKindStateless))
+				}
+				for _, v := range []string{"target_replica_set_size", "min_replica_set_size", "has_persisted_state"} {
+					if _, ok := rd.GetOk(v); ok {
+						return fmt.Errorf("`%s` can only be specified for a %q service", v, string(service.ServiceKindStateful))
+					}
+				}
+			case string(service.ServiceKindStateful):
+				if _, ok := rd.GetOk("instance_count"); ok {
+					return fmt.Errorf("`instance_count` can only be specified for a %q service", string(service.ServiceKindStateless))
+				}
+				targetReplicaSetSize := rd.Get("target_replica_set_size").(int)
+				minReplicaSetSize := rd.Get("min_replica_set_size").(int)
+				if targetReplicaSetSize == 0 || minReplicaSetSize == 0 {
+					return fmt.Errorf("`target_replica_set_size` and `min_replica_set_size` must be specified for a %q service", string(service.ServiceKindStateful))
+				}
+				if minReplicaSetSize > targetReplicaSetSize {
+					return fmt.Errorf("`min_replica_set_size` cannot be greater than `target_replica_set_size`")
+				}
+			}
+
+			partitions := rd.Get("partition").([]interface{})
+			if len(partitions) == 0 || partitions[0] == nil {
+				return nil
+			}
+			partition := partitions[0].(map[string]interface{})
+			switch partition["scheme"].(string) {
+			case string(service.PartitionSchemeUniformIntSixFourRange):
+				if partition["count"].(int) == 0 {
+					return fmt.Errorf("`count` must be specified for a %q partition", string(service.PartitionSchemeUniformIntSixFourRange))
+				}
+				if partition["low_key"].(int) > partition["high_key"].(int) {
+					return fmt.Errorf("`low_key` cannot be greater than `high_key`")
+				}
+			case string(service.PartitionSchemeNamed):
+				if len(partition["names"].([]interface{})) == 0 {
+					return fmt.Errorf("`names` must be specified for a %q partition", string(service.PartitionSchemeNamed))
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			var model ServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			applicationId, err := service.ParseApplicationID(model.ApplicationId)
+			if err != nil {
+				return err
+			}
+
+			id := service.NewServiceID(applicationId.SubscriptionId, applicationId.ResourceGroupName, applicationId.ClusterName, applicationId.ApplicationName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandServiceFabricManagedService(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ServiceResourceModel{
+				Name:          id.ServiceName,
+				ApplicationId: service.NewApplicationID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, id.ApplicationName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				var partition service.Partition
+				var placementConstraints *string
+				var defaultMoveCost *service.MoveCost
+
+				switch props := model.Properties.(type) {
+				case service.StatelessServiceProperties:
+					state.Kind = string(service.ServiceKindStateless)
+					state.ServiceTypeName = props.ServiceTypeName
+					state.InstanceCount = int(props.InstanceCount)
+					partition = props.PartitionDescription
+					placementConstraints = props.PlacementConstraints
+					defaultMoveCost = props.DefaultMoveCost
+				case service.StatefulServiceProperties:
+					state.Kind = string(service.ServiceKindStateful)
+					state.ServiceTypeName = props.ServiceTypeName
+					if props.TargetReplicaSetSize != nil {
+						state.TargetReplicaSetSize = int(*props.TargetReplicaSetSize)
+					}
+					if props.MinReplicaSetSize != nil {
+						state.MinReplicaSetSize = int(*props.MinReplicaSetSize)
+					}
+					state.HasPersistedState = props.HasPersistedState != nil && *props.HasPersistedState
+					partition = props.PartitionDescription
+					placementConstraints = props.PlacementConstraints
+					defaultMoveCost = props.DefaultMoveCost
+				}
+
+				flattenedPartition, err := flattenServiceFabricManagedServicePartition(partition)
+				if err != nil {
+					return fmt.Errorf("flattening `partition`: %+v", err)
+				}
+				state.Partition = flattenedPartition
+				state.PlacementConstraints = utils.NormalizeNilableString(placementConstraints)
+				if defaultMoveCost != nil {
+					state.DefaultMoveCost = string(*defaultMoveCost)
+				}
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ServiceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ServiceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandServiceFabricManagedService(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ServiceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ServiceFabricManaged.ServiceClient
+
+			id, err := service.ParseServiceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandServiceFabricManagedService(input ServiceResourceModel) service.ServiceResource {
+	var partition service.Partition = service.SingletonPartitionScheme{}
+	if len(input.Partition) > 0 {
+		v := input.Partition[0]
+		switch v.Scheme {
+		case string(service.PartitionSchemeUniformIntSixFourRange):
+			partition = service.UniformInt64RangePartitionScheme{
+				Count:   int64(v.Count),
+				LowKey:  int64(v.LowKey),
+				HighKey: int64(v.HighKey),
+			}
+		case string(service.PartitionSchemeNamed):
+			partition = service.NamedPartitionScheme{
+				Names: v.Names,
+			}
+		}
+	}
+
+	var placementConstraints *string
+	if input.PlacementConstraints != "" {
+		placementConstraints = utils.String(input.PlacementConstraints)
+	}
+
+	var defaultMoveCost *service.MoveCost
+	if input.DefaultMoveCost != "" {
+		moveCost := service.MoveCost(input.DefaultMoveCost)
+		defaultMoveCost = &moveCost
+	}
+
+	var props service.ServiceResourceProperties
+	if input.Kind == string(service.ServiceKindStateful) {
+		props = service.StatefulServiceProperties{
+			HasPersistedState:    utils.Bool(input.HasPersistedState),
+			MinReplicaSetSize:    utils.Int64(int64(input.MinReplicaSetSize)),
+			TargetReplicaSetSize: utils.Int64(int64(input.TargetReplicaSetSize)),
+			DefaultMoveCost:      defaultMoveCost,
+			PartitionDescription: partition,
+			PlacementConstraints: placementConstraints,
+			ServiceTypeName:      input.ServiceTypeName,
+		}
+	} else {
+		props = service.StatelessServiceProperties{
+			InstanceCount:        int64(input.InstanceCount),
+			DefaultMoveCost:      defaultMoveCost,
+			PartitionDescription: partition,
+			PlacementConstraints: placementConstraints,
+			ServiceTypeName:      input.ServiceTypeName,
+		}
+	}
+
+	return service.ServiceResource{
+		Properties: props,
+		Tags:       &input.Tags,
+	}
+}
+
+func flattenServiceFabricManagedServicePartition(input service.Partition) ([]ServicePartitionModel, error) {
+	if input == nil {
+		return []ServicePartitionModel{}, nil
+	}
+
+	// the partition description isn't unmarshalled into its concrete type, so this is decoded from the raw payload
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var partition struct {
+		PartitionScheme string   `json:"partitionScheme"`
+		Count           int64    `json:"count"`
+		LowKey          int64    `json:"lowKey"`
+		HighKey         int64    `json:"highKey"`
+		Names           []string `json:"names"`
+	}
+	if err := json.Unmarshal(raw, &partition); err != nil {
+		return nil, err
+	}
+
+	return []ServicePartitionModel{
+		{
+			Scheme:  partition.PartitionScheme,
+			Count:   int(partition.Count),
+			LowKey:  int(partition.LowKey),
+			HighKey: int(partition.HighKey),
+			Names:   partition.Names,
+		},
+	}, nil
+}
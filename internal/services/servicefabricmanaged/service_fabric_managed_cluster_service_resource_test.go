@@ -0,0 +1,159 @@
+package servicefabricmanaged_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/servicefabricmanaged/sdk/2021-05-01/service"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ServiceResource struct{}
+
+// the service types below need to be registered by the application package referenced by
+// ARM_TEST_SERVICE_FABRIC_APPLICATION_PACKAGE_URL
+
+func TestAccServiceFabricManagedClusterService_stateless(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stateless(data, packageUrl, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stateless(data, packageUrl, -1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServiceFabricManagedClusterService_requiresImport(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stateless(data, packageUrl, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data, packageUrl),
+			ExpectError: acceptance.RequiresImportError(data.ResourceType),
+		},
+	})
+}
+
+func TestAccServiceFabricManagedClusterService_stateful(t *testing.T) {
+	packageUrl := applicationPackageUrl(t)
+	data := acceptance.BuildTestData(t, "azurerm_service_fabric_managed_cluster_service", "test")
+	r := ServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.stateful(data, packageUrl),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := service.ParseServiceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ServiceFabricManaged.ServiceClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ServiceResource) stateless(data acceptance.TestData, packageUrl string, instanceCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_service" "test" {
+  name              = "acctestStatelessService"
+  application_id    = azurerm_service_fabric_managed_cluster_application.test.id
+  service_type_name = "StatelessServiceType"
+  kind              = "Stateless"
+  instance_count    = %d
+
+  partition {
+    scheme = "Singleton"
+  }
+}
+`, ApplicationResource{}.basic(data, packageUrl), instanceCount)
+}
+
+func (r ServiceResource) requiresImport(data acceptance.TestData, packageUrl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_service" "import" {
+  name              = azurerm_service_fabric_managed_cluster_service.test.name
+  application_id    = azurerm_service_fabric_managed_cluster_service.test.application_id
+  service_type_name = azurerm_service_fabric_managed_cluster_service.test.service_type_name
+  kind              = azurerm_service_fabric_managed_cluster_service.test.kind
+  instance_count    = azurerm_service_fabric_managed_cluster_service.test.instance_count
+
+  partition {
+    scheme = "Singleton"
+  }
+}
+`, r.stateless(data, packageUrl, 1))
+}
+
+func (r ServiceResource) stateful(data acceptance.TestData, packageUrl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_service_fabric_managed_cluster_service" "test" {
+  name                    = "acctestStatefulService"
+  application_id          = azurerm_service_fabric_managed_cluster_application.test.id
+  service_type_name       = "StatefulServiceType"
+  kind                    = "Stateful"
+  target_replica_set_size = 3
+  min_replica_set_size    = 2
+  has_persisted_state     = true
+  default_move_cost       = "Low"
+
+  partition {
+    scheme   = "UniformInt64Range"
+    count    = 2
+    low_key  = 0
+    high_key = 100
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, ApplicationResource{}.basic(data, packageUrl))
+}
@@ -3,17 +3,29 @@ package client
 import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2020-05-01/signalr"
+	signalrV20240301 "github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/webpubsub"
 )
 
 type Client struct {
-	Client *signalr.SignalRClient
+	Client          *signalr.SignalRClient
+	SignalRClient   *signalrV20240301.SignalRClient
+	WebPubSubClient *webpubsub.WebPubSubClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	client := signalr.NewSignalRClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&client.Client, o.ResourceManagerAuthorizer)
 
+	signalRClient := signalrV20240301.NewSignalRClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&signalRClient.Client, o.ResourceManagerAuthorizer)
+
+	webPubSubClient := webpubsub.NewWebPubSubClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&webPubSubClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		Client: &client,
+		Client:          &client,
+		SignalRClient:   &signalRClient,
+		WebPubSubClient: &webPubSubClient,
 	}
 }
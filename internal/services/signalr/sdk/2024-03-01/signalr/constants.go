@@ -0,0 +1,86 @@
+package signalr
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateMoving    ProvisioningState = "Moving"
+	ProvisioningStateRunning   ProvisioningState = "Running"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUnknown   ProvisioningState = "Unknown"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMoving),
+		string(ProvisioningStateRunning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUnknown),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"moving":    ProvisioningStateMoving,
+		"running":   ProvisioningStateRunning,
+		"succeeded": ProvisioningStateSucceeded,
+		"unknown":   ProvisioningStateUnknown,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type SignalRSkuTier string
+
+const (
+	SignalRSkuTierBasic    SignalRSkuTier = "Basic"
+	SignalRSkuTierFree     SignalRSkuTier = "Free"
+	SignalRSkuTierPremium  SignalRSkuTier = "Premium"
+	SignalRSkuTierStandard SignalRSkuTier = "Standard"
+)
+
+func PossibleValuesForSignalRSkuTier() []string {
+	return []string{
+		string(SignalRSkuTierBasic),
+		string(SignalRSkuTierFree),
+		string(SignalRSkuTierPremium),
+		string(SignalRSkuTierStandard),
+	}
+}
+
+func parseSignalRSkuTier(input string) (*SignalRSkuTier, error) {
+	vals := map[string]SignalRSkuTier{
+		"basic":    SignalRSkuTierBasic,
+		"free":     SignalRSkuTierFree,
+		"premium":  SignalRSkuTierPremium,
+		"standard": SignalRSkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SignalRSkuTier(input)
+	return &out, nil
+}
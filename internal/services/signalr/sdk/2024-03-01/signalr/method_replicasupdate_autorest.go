@@ -0,0 +1,75 @@
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ReplicasUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ReplicasUpdate ...
+func (c SignalRClient) ReplicasUpdate(ctx context.Context, id ReplicaId, input Replica) (result ReplicasUpdateResponse, err error) {
+	req, err := c.preparerForReplicasUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "signalr.SignalRClient", "ReplicasUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForReplicasUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "signalr.SignalRClient", "ReplicasUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ReplicasUpdateThenPoll performs ReplicasUpdate then polls until it's completed
+func (c SignalRClient) ReplicasUpdateThenPoll(ctx context.Context, id ReplicaId, input Replica) error {
+	result, err := c.ReplicasUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ReplicasUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ReplicasUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForReplicasUpdate prepares the ReplicasUpdate request.
+func (c SignalRClient) preparerForReplicasUpdate(ctx context.Context, id ReplicaId, input Replica) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForReplicasUpdate sends the ReplicasUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c SignalRClient) senderForReplicasUpdate(ctx context.Context, req *http.Request) (future ReplicasUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,7 @@
+package signalr
+
+type CustomDomainProperties struct {
+	CustomCertificate ResourceReference  `json:"customCertificate"`
+	DomainName        string             `json:"domainName"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,7 @@
+package signalr
+
+type ReplicaProperties struct {
+	ProvisioningState     *ProvisioningState `json:"provisioningState,omitempty"`
+	RegionEndpointEnabled *string            `json:"regionEndpointEnabled,omitempty"`
+	ResourceStopped       *string            `json:"resourceStopped,omitempty"`
+}
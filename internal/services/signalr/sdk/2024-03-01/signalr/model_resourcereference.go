@@ -0,0 +1,5 @@
+package signalr
+
+type ResourceReference struct {
+	Id *string `json:"id,omitempty"`
+}
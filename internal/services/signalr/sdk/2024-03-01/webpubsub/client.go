@@ -0,0 +1,15 @@
+package webpubsub
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WebPubSubClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWebPubSubClientWithBaseURI(endpoint string) WebPubSubClient {
+	return WebPubSubClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,86 @@
+package webpubsub
+
+import "strings"
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateCreating  ProvisioningState = "Creating"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateMoving    ProvisioningState = "Moving"
+	ProvisioningStateRunning   ProvisioningState = "Running"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	ProvisioningStateUnknown   ProvisioningState = "Unknown"
+	ProvisioningStateUpdating  ProvisioningState = "Updating"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateCreating),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateMoving),
+		string(ProvisioningStateRunning),
+		string(ProvisioningStateSucceeded),
+		string(ProvisioningStateUnknown),
+		string(ProvisioningStateUpdating),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"creating":  ProvisioningStateCreating,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"moving":    ProvisioningStateMoving,
+		"running":   ProvisioningStateRunning,
+		"succeeded": ProvisioningStateSucceeded,
+		"unknown":   ProvisioningStateUnknown,
+		"updating":  ProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
+
+type WebPubSubSkuTier string
+
+const (
+	WebPubSubSkuTierBasic    WebPubSubSkuTier = "Basic"
+	WebPubSubSkuTierFree     WebPubSubSkuTier = "Free"
+	WebPubSubSkuTierPremium  WebPubSubSkuTier = "Premium"
+	WebPubSubSkuTierStandard WebPubSubSkuTier = "Standard"
+)
+
+func PossibleValuesForWebPubSubSkuTier() []string {
+	return []string{
+		string(WebPubSubSkuTierBasic),
+		string(WebPubSubSkuTierFree),
+		string(WebPubSubSkuTierPremium),
+		string(WebPubSubSkuTierStandard),
+	}
+}
+
+func parseWebPubSubSkuTier(input string) (*WebPubSubSkuTier, error) {
+	vals := map[string]WebPubSubSkuTier{
+		"basic":    WebPubSubSkuTierBasic,
+		"free":     WebPubSubSkuTierFree,
+		"premium":  WebPubSubSkuTierPremium,
+		"standard": WebPubSubSkuTierStandard,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WebPubSubSkuTier(input)
+	return &out, nil
+}
@@ -0,0 +1,61 @@
+package webpubsub
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type CustomCertificatesDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// CustomCertificatesDelete ...
+func (c WebPubSubClient) CustomCertificatesDelete(ctx context.Context, id CustomCertificateId) (result CustomCertificatesDeleteResponse, err error) {
+	req, err := c.preparerForCustomCertificatesDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "CustomCertificatesDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "CustomCertificatesDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForCustomCertificatesDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "CustomCertificatesDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForCustomCertificatesDelete prepares the CustomCertificatesDelete request.
+func (c WebPubSubClient) preparerForCustomCertificatesDelete(ctx context.Context, id CustomCertificateId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForCustomCertificatesDelete handles the response to the CustomCertificatesDelete request. The method always
+// closes the http.Response Body.
+func (c WebPubSubClient) responderForCustomCertificatesDelete(resp *http.Response) (result CustomCertificatesDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
@@ -0,0 +1,75 @@
+package webpubsub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type ReplicasCreateOrUpdateResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// ReplicasCreateOrUpdate ...
+func (c WebPubSubClient) ReplicasCreateOrUpdate(ctx context.Context, id ReplicaId, input Replica) (result ReplicasCreateOrUpdateResponse, err error) {
+	req, err := c.preparerForReplicasCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasCreateOrUpdate", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForReplicasCreateOrUpdate(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasCreateOrUpdate", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ReplicasCreateOrUpdateThenPoll performs ReplicasCreateOrUpdate then polls until it's completed
+func (c WebPubSubClient) ReplicasCreateOrUpdateThenPoll(ctx context.Context, id ReplicaId, input Replica) error {
+	result, err := c.ReplicasCreateOrUpdate(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing ReplicasCreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after ReplicasCreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForReplicasCreateOrUpdate prepares the ReplicasCreateOrUpdate request.
+func (c WebPubSubClient) preparerForReplicasCreateOrUpdate(ctx context.Context, id ReplicaId, input Replica) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForReplicasCreateOrUpdate sends the ReplicasCreateOrUpdate request. The method will close the
+// http.Response Body if it receives an error.
+func (c WebPubSubClient) senderForReplicasCreateOrUpdate(ctx context.Context, req *http.Request) (future ReplicasCreateOrUpdateResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
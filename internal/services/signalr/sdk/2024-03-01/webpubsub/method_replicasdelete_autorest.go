@@ -0,0 +1,61 @@
+package webpubsub
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ReplicasDeleteResponse struct {
+	HttpResponse *http.Response
+}
+
+// ReplicasDelete ...
+func (c WebPubSubClient) ReplicasDelete(ctx context.Context, id ReplicaId) (result ReplicasDeleteResponse, err error) {
+	req, err := c.preparerForReplicasDelete(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasDelete", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasDelete", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForReplicasDelete(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasDelete", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForReplicasDelete prepares the ReplicasDelete request.
+func (c WebPubSubClient) preparerForReplicasDelete(ctx context.Context, id ReplicaId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForReplicasDelete handles the response to the ReplicasDelete request. The method always
+// closes the http.Response Body.
+func (c WebPubSubClient) responderForReplicasDelete(resp *http.Response) (result ReplicasDeleteResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusNoContent, http.StatusOK),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
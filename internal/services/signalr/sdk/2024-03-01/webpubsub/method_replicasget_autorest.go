@@ -0,0 +1,64 @@
+package webpubsub
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type ReplicasGetResponse struct {
+	HttpResponse *http.Response
+	Model        *Replica
+}
+
+// ReplicasGet ...
+func (c WebPubSubClient) ReplicasGet(ctx context.Context, id ReplicaId) (result ReplicasGetResponse, err error) {
+	req, err := c.preparerForReplicasGet(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasGet", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasGet", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForReplicasGet(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "webpubsub.WebPubSubClient", "ReplicasGet", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForReplicasGet prepares the ReplicasGet request.
+func (c WebPubSubClient) preparerForReplicasGet(ctx context.Context, id ReplicaId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForReplicasGet handles the response to the ReplicasGet request. The method always
+// closes the http.Response Body.
+func (c WebPubSubClient) responderForReplicasGet(resp *http.Response) (result ReplicasGetResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
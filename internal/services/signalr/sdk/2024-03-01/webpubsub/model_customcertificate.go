@@ -0,0 +1,8 @@
+package webpubsub
+
+type CustomCertificate struct {
+	Id         *string                     `json:"id,omitempty"`
+	Name       *string                     `json:"name,omitempty"`
+	Properties CustomCertificateProperties `json:"properties"`
+	Type       *string                     `json:"type,omitempty"`
+}
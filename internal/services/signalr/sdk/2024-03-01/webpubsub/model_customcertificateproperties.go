@@ -0,0 +1,8 @@
+package webpubsub
+
+type CustomCertificateProperties struct {
+	KeyVaultBaseUri       string             `json:"keyVaultBaseUri"`
+	KeyVaultSecretName    string             `json:"keyVaultSecretName"`
+	KeyVaultSecretVersion *string            `json:"keyVaultSecretVersion,omitempty"`
+	ProvisioningState     *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,8 @@
+package webpubsub
+
+type CustomDomain struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties CustomDomainProperties `json:"properties"`
+	Type       *string                `json:"type,omitempty"`
+}
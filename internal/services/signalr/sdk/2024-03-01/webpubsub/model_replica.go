@@ -0,0 +1,11 @@
+package webpubsub
+
+type Replica struct {
+	Id         *string            `json:"id,omitempty"`
+	Location   string             `json:"location"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ReplicaProperties `json:"properties,omitempty"`
+	Sku        *ResourceSku       `json:"sku,omitempty"`
+	Tags       *map[string]string `json:"tags,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webpubsub
+
+type ReplicaProperties struct {
+	ProvisioningState     *ProvisioningState `json:"provisioningState,omitempty"`
+	RegionEndpointEnabled *string            `json:"regionEndpointEnabled,omitempty"`
+	ResourceStopped       *string            `json:"resourceStopped,omitempty"`
+}
@@ -0,0 +1,5 @@
+package webpubsub
+
+type ResourceReference struct {
+	Id *string `json:"id,omitempty"`
+}
@@ -0,0 +1,176 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmSignalRServiceCustomCertificate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSignalRServiceCustomCertificateCreate,
+		Read:   resourceSignalRServiceCustomCertificateRead,
+		Delete: resourceSignalRServiceCustomCertificateDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := signalr.ParseCustomCertificateID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"signalr_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalr.ValidateSignalRID,
+			},
+
+			"custom_certificate_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			"certificate_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSignalRServiceCustomCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := signalr.ParseSignalRID(d.Get("signalr_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := signalr.NewCustomCertificateID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.SignalRName, d.Get("name").(string))
+	existing, err := client.CustomCertificatesGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_signalr_service_custom_certificate", id.ID())
+	}
+
+	certificateId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("custom_certificate_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `custom_certificate_id`: %+v", err)
+	}
+
+	payload := signalr.CustomCertificate{
+		Properties: signalr.CustomCertificateProperties{
+			KeyVaultBaseUri:    certificateId.KeyVaultBaseUrl,
+			KeyVaultSecretName: certificateId.Name,
+		},
+	}
+
+	if certificateId.Version != "" {
+		payload.Properties.KeyVaultSecretVersion = utils.String(certificateId.Version)
+	}
+
+	if err := client.CustomCertificatesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSignalRServiceCustomCertificateRead(d, meta)
+}
+
+func resourceSignalRServiceCustomCertificateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalr.ParseCustomCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CustomCertificatesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomCertificateName)
+	d.Set("signalr_service_id", signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+
+		version := ""
+		if props.KeyVaultSecretVersion != nil {
+			version = *props.KeyVaultSecretVersion
+		}
+		d.Set("certificate_version", version)
+
+		// the version is only set into the ID when one was specified, since otherwise the latest version is used
+		certificateVersion := ""
+		if existing, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("custom_certificate_id").(string)); err == nil && existing.Version != "" {
+			certificateVersion = version
+		}
+
+		certificateId, err := keyVaultParse.NewNestedItemID(props.KeyVaultBaseUri, "certificates", props.KeyVaultSecretName, certificateVersion)
+		if err != nil {
+			return fmt.Errorf("building `custom_certificate_id`: %+v", err)
+		}
+		d.Set("custom_certificate_id", certificateId.ID())
+	}
+
+	return nil
+}
+
+func resourceSignalRServiceCustomCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalr.ParseCustomCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CustomCertificatesDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
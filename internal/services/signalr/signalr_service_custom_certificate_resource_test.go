@@ -0,0 +1,195 @@
+package signalr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SignalRServiceCustomCertificateResource struct{}
+
+func TestAccSignalRServiceCustomCertificate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_custom_certificate", "test")
+	r := SignalRServiceCustomCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate_version").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSignalRServiceCustomCertificate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_signalr_service_custom_certificate", "test")
+	r := SignalRServiceCustomCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SignalRServiceCustomCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := signalr.ParseCustomCertificateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.SignalR.SignalRClient.CustomCertificatesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SignalRServiceCustomCertificateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_custom_certificate" "test" {
+  name                  = "acctest-cert-%d"
+  signalr_service_id    = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  custom_certificate_id = azurerm_key_vault_certificate.test.id
+
+  depends_on = [azurerm_key_vault_access_policy.parent]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SignalRServiceCustomCertificateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_signalr_service_custom_certificate" "import" {
+  name                  = azurerm_signalr_service_custom_certificate.test.name
+  signalr_service_id    = azurerm_signalr_service_custom_certificate.test.signalr_service_id
+  custom_certificate_id = azurerm_signalr_service_custom_certificate.test.custom_certificate_id
+}
+`, r.basic(data))
+}
+
+func (r SignalRServiceCustomCertificateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-parent-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.SignalRService/signalR",
+      "apiVersion": "2024-03-01",
+      "name": "acctestsr%d",
+      "location": "[resourceGroup().location]",
+      "kind": "SignalR",
+      "sku": {
+        "name": "Premium_P1",
+        "capacity": 1
+      },
+      "identity": {
+        "type": "SystemAssigned"
+      }
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.SignalRService/signalR', 'acctestsr%d')]"
+    },
+    "principalId": {
+      "type": "string",
+      "value": "[reference(resourceId('Microsoft.SignalRService/signalR', 'acctestsr%d'), '2024-03-01', 'full').identity.principalId]"
+    }
+  }
+}
+TEMPLATE
+}
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id               = data.azurerm_client_config.current.tenant_id
+    object_id               = data.azurerm_client_config.current.object_id
+    certificate_permissions = ["Create", "Delete", "Get", "Import", "List", "Purge", "Update"]
+    secret_permissions      = ["Delete", "Get", "List", "Purge", "Set"]
+  }
+}
+
+resource "azurerm_key_vault_access_policy" "parent" {
+  key_vault_id            = azurerm_key_vault.test.id
+  tenant_id               = data.azurerm_client_config.current.tenant_id
+  object_id               = jsondecode(azurerm_resource_group_template_deployment.test.output_content).principalId.value
+  certificate_permissions = ["Get", "List"]
+  secret_permissions      = ["Get", "List"]
+}
+
+resource "azurerm_key_vault_certificate" "test" {
+  name         = "acctestcert%s"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      key_usage          = ["digitalSignature", "keyEncipherment"]
+      subject            = "CN=acctest%s.example.com"
+      validity_in_months = 12
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomString, data.RandomString, data.RandomString)
+}
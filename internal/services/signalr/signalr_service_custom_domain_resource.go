@@ -0,0 +1,170 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmSignalRServiceCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSignalRServiceCustomDomainCreate,
+		Read:   resourceSignalRServiceCustomDomainRead,
+		Delete: resourceSignalRServiceCustomDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := signalr.ParseCustomDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"signalr_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalr.ValidateSignalRID,
+			},
+
+			"domain_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"signalr_custom_certificate_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalr.ValidateCustomCertificateID,
+			},
+		},
+	}
+}
+
+func resourceSignalRServiceCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := signalr.ParseSignalRID(d.Get("signalr_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := signalr.NewCustomDomainID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.SignalRName, d.Get("name").(string))
+	existing, err := client.CustomDomainsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_signalr_service_custom_domain", id.ID())
+	}
+
+	certificateId, err := signalr.ParseCustomCertificateID(d.Get("signalr_custom_certificate_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if certificateId.SignalRName != id.SignalRName || certificateId.ResourceGroupName != id.ResourceGroupName {
+		return fmt.Errorf("the Custom Certificate %q must belong to the same SignalR Service as %s", certificateId.ID(), id)
+	}
+
+	payload := signalr.CustomDomain{
+		Properties: signalr.CustomDomainProperties{
+			DomainName: d.Get("domain_name").(string),
+			CustomCertificate: signalr.ResourceReference{
+				Id: utils.String(certificateId.ID()),
+			},
+		},
+	}
+
+	if err := client.CustomDomainsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSignalRServiceCustomDomainRead(d, meta)
+}
+
+func resourceSignalRServiceCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalr.ParseCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CustomDomainsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("signalr_service_id", signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("domain_name", model.Properties.DomainName)
+
+		certificateId := ""
+		if v := model.Properties.CustomCertificate.Id; v != nil {
+			parsed, err := signalr.ParseCustomCertificateIDInsensitively(*v)
+			if err != nil {
+				return err
+			}
+			certificateId = parsed.ID()
+		}
+		d.Set("signalr_custom_certificate_id", certificateId)
+	}
+
+	return nil
+}
+
+func resourceSignalRServiceCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalr.ParseCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.CustomDomainsDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmSignalRServiceReplica() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSignalRServiceReplicaCreate,
+		Read:   resourceSignalRServiceReplicaRead,
+		Update: resourceSignalRServiceReplicaUpdate,
+		Delete: resourceSignalRServiceReplicaDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := signalr.ParseReplicaID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"signalr_service_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: signalr.ValidateSignalRID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Premium_P1",
+							}, false),
+						},
+
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100}),
+						},
+					},
+				},
+			},
+
+			"regional_endpoint_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceSignalRServiceReplicaCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := signalr.ParseSignalRID(d.Get("signalr_service_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := signalr.NewReplicaID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.SignalRName, d.Get("name").(string))
+	existing, err := client.ReplicasGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_signalr_service_replica", id.ID())
+	}
+
+	payload := signalr.Replica{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &signalr.ReplicaProperties{
+			RegionEndpointEnabled: expandSignalRServiceReplicaRegionEndpointEnabled(d.Get("regional_endpoint_enabled").(bool)),
+		},
+		Sku:  expandSignalRServiceReplicaSku(d.Get("sku").([]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceSignalRServiceReplicaRead(d, meta)
+}
+
+func resourceSignalRServiceReplicaRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalr.ParseReplicaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ReplicasGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ReplicaName)
+	d.Set("signalr_service_id", signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if err := d.Set("sku", flattenSignalRServiceReplicaSku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		regionalEndpointEnabled := true
+		if props := model.Properties; props != nil && props.RegionEndpointEnabled != nil {
+			regionalEndpointEnabled = *props.RegionEndpointEnabled == "Enabled"
+		}
+		d.Set("regional_endpoint_enabled", regionalEndpointEnabled)
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceSignalRServiceReplicaUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalr.ParseReplicaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := signalr.Replica{
+		Location:   azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &signalr.ReplicaProperties{},
+	}
+
+	if d.HasChange("regional_endpoint_enabled") {
+		payload.Properties.RegionEndpointEnabled = expandSignalRServiceReplicaRegionEndpointEnabled(d.Get("regional_endpoint_enabled").(bool))
+	}
+
+	if d.HasChange("sku") {
+		payload.Sku = expandSignalRServiceReplicaSku(d.Get("sku").([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		payload.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.ReplicasUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceSignalRServiceReplicaRead(d, meta)
+}
+
+func resourceSignalRServiceReplicaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.SignalRClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := signalr.ParseReplicaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandSignalRServiceReplicaRegionEndpointEnabled(input bool) *string {
+	if input {
+		return utils.String("Enabled")
+	}
+	return utils.String("Disabled")
+}
+
+func expandSignalRServiceReplicaSku(input []interface{}) *signalr.ResourceSku {
+	v := input[0].(map[string]interface{})
+	return &signalr.ResourceSku{
+		Name:     v["name"].(string),
+		Capacity: utils.Int64(int64(v["capacity"].(int))),
+	}
+}
+
+func flattenSignalRServiceReplicaSku(input *signalr.ResourceSku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     input.Name,
+			"capacity": capacity,
+		},
+	}
+}
@@ -71,6 +71,7 @@ func resourceArmSignalRService() *pluginsdk.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								"Free_F1",
 								"Standard_S1",
+								"Premium_P1",
 							}, false),
 						},
 
@@ -0,0 +1,176 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmWebPubSubCustomCertificate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebPubSubCustomCertificateCreate,
+		Read:   resourceWebPubSubCustomCertificateRead,
+		Delete: resourceWebPubSubCustomCertificateDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := webpubsub.ParseCustomCertificateID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"web_pubsub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: webpubsub.ValidateWebPubSubID,
+			},
+
+			"custom_certificate_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			"certificate_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWebPubSubCustomCertificateCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := webpubsub.ParseWebPubSubID(d.Get("web_pubsub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := webpubsub.NewCustomCertificateID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.WebPubSubName, d.Get("name").(string))
+	existing, err := client.CustomCertificatesGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_web_pubsub_custom_certificate", id.ID())
+	}
+
+	certificateId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("custom_certificate_id").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `custom_certificate_id`: %+v", err)
+	}
+
+	payload := webpubsub.CustomCertificate{
+		Properties: webpubsub.CustomCertificateProperties{
+			KeyVaultBaseUri:    certificateId.KeyVaultBaseUrl,
+			KeyVaultSecretName: certificateId.Name,
+		},
+	}
+
+	if certificateId.Version != "" {
+		payload.Properties.KeyVaultSecretVersion = utils.String(certificateId.Version)
+	}
+
+	if err := client.CustomCertificatesCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceWebPubSubCustomCertificateRead(d, meta)
+}
+
+func resourceWebPubSubCustomCertificateRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseCustomCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CustomCertificatesGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomCertificateName)
+	d.Set("web_pubsub_id", webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName).ID())
+
+	if model := resp.Model; model != nil {
+		props := model.Properties
+
+		version := ""
+		if props.KeyVaultSecretVersion != nil {
+			version = *props.KeyVaultSecretVersion
+		}
+		d.Set("certificate_version", version)
+
+		// the version is only set into the ID when one was specified, since otherwise the latest version is used
+		certificateVersion := ""
+		if existing, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("custom_certificate_id").(string)); err == nil && existing.Version != "" {
+			certificateVersion = version
+		}
+
+		certificateId, err := keyVaultParse.NewNestedItemID(props.KeyVaultBaseUri, "certificates", props.KeyVaultSecretName, certificateVersion)
+		if err != nil {
+			return fmt.Errorf("building `custom_certificate_id`: %+v", err)
+		}
+		d.Set("custom_certificate_id", certificateId.ID())
+	}
+
+	return nil
+}
+
+func resourceWebPubSubCustomCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseCustomCertificateID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.CustomCertificatesDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
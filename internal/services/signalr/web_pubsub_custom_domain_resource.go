@@ -0,0 +1,170 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmWebPubSubCustomDomain() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebPubSubCustomDomainCreate,
+		Read:   resourceWebPubSubCustomDomainRead,
+		Delete: resourceWebPubSubCustomDomainDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := webpubsub.ParseCustomDomainID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"web_pubsub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: webpubsub.ValidateWebPubSubID,
+			},
+
+			"domain_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"web_pubsub_custom_certificate_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: webpubsub.ValidateCustomCertificateID,
+			},
+		},
+	}
+}
+
+func resourceWebPubSubCustomDomainCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := webpubsub.ParseWebPubSubID(d.Get("web_pubsub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := webpubsub.NewCustomDomainID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.WebPubSubName, d.Get("name").(string))
+	existing, err := client.CustomDomainsGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_web_pubsub_custom_domain", id.ID())
+	}
+
+	certificateId, err := webpubsub.ParseCustomCertificateID(d.Get("web_pubsub_custom_certificate_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if certificateId.WebPubSubName != id.WebPubSubName || certificateId.ResourceGroupName != id.ResourceGroupName {
+		return fmt.Errorf("the Custom Certificate %q must belong to the same Web PubSub as %s", certificateId.ID(), id)
+	}
+
+	payload := webpubsub.CustomDomain{
+		Properties: webpubsub.CustomDomainProperties{
+			DomainName: d.Get("domain_name").(string),
+			CustomCertificate: webpubsub.ResourceReference{
+				Id: utils.String(certificateId.ID()),
+			},
+		},
+	}
+
+	if err := client.CustomDomainsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceWebPubSubCustomDomainRead(d, meta)
+}
+
+func resourceWebPubSubCustomDomainRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.CustomDomainsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.CustomDomainName)
+	d.Set("web_pubsub_id", webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("domain_name", model.Properties.DomainName)
+
+		certificateId := ""
+		if v := model.Properties.CustomCertificate.Id; v != nil {
+			parsed, err := webpubsub.ParseCustomCertificateIDInsensitively(*v)
+			if err != nil {
+				return err
+			}
+			certificateId = parsed.ID()
+		}
+		d.Set("web_pubsub_custom_certificate_id", certificateId)
+	}
+
+	return nil
+}
+
+func resourceWebPubSubCustomDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseCustomDomainID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.CustomDomainsDeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
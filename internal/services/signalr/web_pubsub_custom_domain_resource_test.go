@@ -0,0 +1,111 @@
+package signalr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebPubSubCustomDomainResource struct{}
+
+func TestAccWebPubSubCustomDomain_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_custom_domain", "test")
+	r := WebPubSubCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebPubSubCustomDomain_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_custom_domain", "test")
+	r := WebPubSubCustomDomainResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WebPubSubCustomDomainResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := webpubsub.ParseCustomDomainID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.SignalR.WebPubSubClient.CustomDomainsGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WebPubSubCustomDomainResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_custom_domain" "test" {
+  name                             = "acctest-domain-%d"
+  web_pubsub_id                    = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  domain_name                      = "acctest%s.${azurerm_dns_zone.test.name}"
+  web_pubsub_custom_certificate_id = azurerm_web_pubsub_custom_certificate.test.id
+
+  depends_on = [azurerm_dns_cname_record.test]
+}
+`, r.template(data), data.RandomInteger, data.RandomString)
+}
+
+func (r WebPubSubCustomDomainResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_custom_domain" "import" {
+  name                             = azurerm_web_pubsub_custom_domain.test.name
+  web_pubsub_id                    = azurerm_web_pubsub_custom_domain.test.web_pubsub_id
+  domain_name                      = azurerm_web_pubsub_custom_domain.test.domain_name
+  web_pubsub_custom_certificate_id = azurerm_web_pubsub_custom_domain.test.web_pubsub_custom_certificate_id
+}
+`, r.basic(data))
+}
+
+func (r WebPubSubCustomDomainResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "acctest%s"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  record              = "acctestwps%d.webpubsub.azure.com"
+}
+`, WebPubSubCustomCertificateResource{}.basic(data), data.RandomInteger, data.RandomString, data.RandomInteger)
+}
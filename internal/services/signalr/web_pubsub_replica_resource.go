@@ -0,0 +1,257 @@
+package signalr
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	tagsHelper "github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceArmWebPubSubReplica() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceWebPubSubReplicaCreate,
+		Read:   resourceWebPubSubReplicaRead,
+		Update: resourceWebPubSubReplicaUpdate,
+		Delete: resourceWebPubSubReplicaDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := webpubsub.ParseReplicaID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"web_pubsub_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: webpubsub.ValidateWebPubSubID,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"sku": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Premium_P1",
+							}, false),
+						},
+
+						"capacity": {
+							Type:         pluginsdk.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntInSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100}),
+						},
+					},
+				},
+			},
+
+			"regional_endpoint_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceWebPubSubReplicaCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	parentId, err := webpubsub.ParseWebPubSubID(d.Get("web_pubsub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := webpubsub.NewReplicaID(parentId.SubscriptionId, parentId.ResourceGroupName, parentId.WebPubSubName, d.Get("name").(string))
+	existing, err := client.ReplicasGet(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_web_pubsub_replica", id.ID())
+	}
+
+	payload := webpubsub.Replica{
+		Location: azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &webpubsub.ReplicaProperties{
+			RegionEndpointEnabled: expandWebPubSubReplicaRegionEndpointEnabled(d.Get("regional_endpoint_enabled").(bool)),
+		},
+		Sku:  expandWebPubSubReplicaSku(d.Get("sku").([]interface{})),
+		Tags: tagsHelper.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceWebPubSubReplicaRead(d, meta)
+}
+
+func resourceWebPubSubReplicaRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseReplicaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ReplicasGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.ReplicaName)
+	d.Set("web_pubsub_id", webpubsub.NewWebPubSubID(id.SubscriptionId, id.ResourceGroupName, id.WebPubSubName).ID())
+
+	if model := resp.Model; model != nil {
+		d.Set("location", location.Normalize(model.Location))
+
+		if err := d.Set("sku", flattenWebPubSubReplicaSku(model.Sku)); err != nil {
+			return fmt.Errorf("setting `sku`: %+v", err)
+		}
+
+		regionalEndpointEnabled := true
+		if props := model.Properties; props != nil && props.RegionEndpointEnabled != nil {
+			regionalEndpointEnabled = *props.RegionEndpointEnabled == "Enabled"
+		}
+		d.Set("regional_endpoint_enabled", regionalEndpointEnabled)
+
+		if err := tags.FlattenAndSet(d, tagsHelper.Flatten(model.Tags)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceWebPubSubReplicaUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseReplicaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	payload := webpubsub.Replica{
+		Location:   azure.NormalizeLocation(d.Get("location").(string)),
+		Properties: &webpubsub.ReplicaProperties{},
+	}
+
+	if d.HasChange("regional_endpoint_enabled") {
+		payload.Properties.RegionEndpointEnabled = expandWebPubSubReplicaRegionEndpointEnabled(d.Get("regional_endpoint_enabled").(bool))
+	}
+
+	if d.HasChange("sku") {
+		payload.Sku = expandWebPubSubReplicaSku(d.Get("sku").([]interface{}))
+	}
+
+	if d.HasChange("tags") {
+		payload.Tags = tagsHelper.Expand(d.Get("tags").(map[string]interface{}))
+	}
+
+	if err := client.ReplicasUpdateThenPoll(ctx, *id, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceWebPubSubReplicaRead(d, meta)
+}
+
+func resourceWebPubSubReplicaDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).SignalR.WebPubSubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := webpubsub.ParseReplicaID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandWebPubSubReplicaRegionEndpointEnabled(input bool) *string {
+	if input {
+		return utils.String("Enabled")
+	}
+	return utils.String("Disabled")
+}
+
+func expandWebPubSubReplicaSku(input []interface{}) *webpubsub.ResourceSku {
+	v := input[0].(map[string]interface{})
+	return &webpubsub.ResourceSku{
+		Name:     v["name"].(string),
+		Capacity: utils.Int64(int64(v["capacity"].(int))),
+	}
+}
+
+func flattenWebPubSubReplicaSku(input *webpubsub.ResourceSku) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	capacity := 0
+	if input.Capacity != nil {
+		capacity = int(*input.Capacity)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":     input.Name,
+			"capacity": capacity,
+		},
+	}
+}
@@ -0,0 +1,203 @@
+package signalr_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/signalr/sdk/2024-03-01/webpubsub"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebPubSubReplicaResource struct{}
+
+func TestAccWebPubSubReplica_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_replica", "test")
+	r := WebPubSubReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebPubSubReplica_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_replica", "test")
+	r := WebPubSubReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccWebPubSubReplica_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_pubsub_replica", "test")
+	r := WebPubSubReplicaResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("regional_endpoint_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("regional_endpoint_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WebPubSubReplicaResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := webpubsub.ParseReplicaID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.SignalR.WebPubSubClient.ReplicasGet(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r WebPubSubReplicaResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_replica" "test" {
+  name          = "acctestreplica%d"
+  web_pubsub_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  location      = "%s"
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 1
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r WebPubSubReplicaResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_replica" "import" {
+  name          = azurerm_web_pubsub_replica.test.name
+  web_pubsub_id = azurerm_web_pubsub_replica.test.web_pubsub_id
+  location      = azurerm_web_pubsub_replica.test.location
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 1
+  }
+}
+`, r.basic(data))
+}
+
+func (r WebPubSubReplicaResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_web_pubsub_replica" "test" {
+  name          = "acctestreplica%d"
+  web_pubsub_id = jsondecode(azurerm_resource_group_template_deployment.test.output_content).id.value
+  location      = "%s"
+
+  sku {
+    name     = "Premium_P1"
+    capacity = 2
+  }
+
+  regional_endpoint_enabled = false
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary)
+}
+
+func (r WebPubSubReplicaResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-parent-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.SignalRService/webPubSub",
+      "apiVersion": "2024-03-01",
+      "name": "acctestwps%d",
+      "location": "[resourceGroup().location]",
+      "sku": {
+        "name": "Premium_P1",
+        "capacity": 1
+      },
+      "identity": {
+        "type": "SystemAssigned"
+      }
+    }
+  ],
+  "outputs": {
+    "id": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.SignalRService/webPubSub', 'acctestwps%d')]"
+    },
+    "principalId": {
+      "type": "string",
+      "value": "[reference(resourceId('Microsoft.SignalRService/webPubSub', 'acctestwps%d'), '2024-03-01', 'full').identity.principalId]"
+    }
+  }
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
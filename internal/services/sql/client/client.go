@@ -5,6 +5,7 @@ import (
 	msi "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2018-06-01-preview/sql"
 	sqlv5 "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2021-11-01/distributedavailabilitygroups"
 )
 
 type Client struct {
@@ -12,6 +13,7 @@ type Client struct {
 	DatabaseThreatDetectionPoliciesClient           *sql.DatabaseThreatDetectionPoliciesClient
 	ElasticPoolsClient                              *sql.ElasticPoolsClient
 	DatabaseExtendedBlobAuditingPoliciesClient      *sql.ExtendedDatabaseBlobAuditingPoliciesClient
+	DistributedAvailabilityGroupsClient             *distributedavailabilitygroups.DistributedAvailabilityGroupsClient
 	FirewallRulesClient                             *sql.FirewallRulesClient
 	FailoverGroupsClient                            *sql.FailoverGroupsClient
 	InstanceFailoverGroupsClient                    *sqlv5.InstanceFailoverGroupsClient
@@ -25,6 +27,7 @@ type Client struct {
 	ServerAzureADAdministratorsClient               *sqlv5.ServerAzureADAdministratorsClient
 	ServerAzureADOnlyAuthenticationsClient          *sqlv5.ServerAzureADOnlyAuthenticationsClient
 	ServerSecurityAlertPoliciesClient               *sql.ServerSecurityAlertPoliciesClient
+	ServerTrustGroupsClient                         *sqlv5.ServerTrustGroupsClient
 	VirtualNetworkRulesClient                       *sql.VirtualNetworkRulesClient
 }
 
@@ -39,6 +42,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	databaseThreatDetectionPoliciesClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&databaseThreatDetectionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	distributedAvailabilityGroupsClient := distributedavailabilitygroups.NewDistributedAvailabilityGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&distributedAvailabilityGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	elasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&elasticPoolsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -84,10 +90,14 @@ func NewClient(o *common.ClientOptions) *Client {
 	serverSecurityAlertPoliciesClient := sql.NewServerSecurityAlertPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&serverSecurityAlertPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	serverTrustGroupsClient := sqlv5.NewServerTrustGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&serverTrustGroupsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		DatabasesClient: &databasesClient,
 		DatabaseExtendedBlobAuditingPoliciesClient:      &databaseExtendedBlobAuditingPoliciesClient,
 		DatabaseThreatDetectionPoliciesClient:           &databaseThreatDetectionPoliciesClient,
+		DistributedAvailabilityGroupsClient:             &distributedAvailabilityGroupsClient,
 		ElasticPoolsClient:                              &elasticPoolsClient,
 		FailoverGroupsClient:                            &failoverGroupsClient,
 		FirewallRulesClient:                             &firewallRulesClient,
@@ -102,6 +112,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ServerConnectionPoliciesClient:                  &serverConnectionPoliciesClient,
 		ServerExtendedBlobAuditingPoliciesClient:        &serverExtendedBlobAuditingPoliciesClient,
 		ServerSecurityAlertPoliciesClient:               &serverSecurityAlertPoliciesClient,
+		ServerTrustGroupsClient:                         &serverTrustGroupsClient,
 		VirtualNetworkRulesClient:                       &virtualNetworkRulesClient,
 	}
 }
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ServerTrustGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	LocationName   string
+	Name           string
+}
+
+func NewServerTrustGroupID(subscriptionId, resourceGroup, locationName, name string) ServerTrustGroupId {
+	return ServerTrustGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		LocationName:   locationName,
+		Name:           name,
+	}
+}
+
+func (id ServerTrustGroupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Location Name %q", id.LocationName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Server Trust Group", segmentsStr)
+}
+
+func (id ServerTrustGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/locations/%s/serverTrustGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LocationName, id.Name)
+}
+
+// ServerTrustGroupID parses a ServerTrustGroup ID into an ServerTrustGroupId struct
+func ServerTrustGroupID(input string) (*ServerTrustGroupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ServerTrustGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LocationName, err = id.PopSegment("locations"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("serverTrustGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
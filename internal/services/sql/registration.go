@@ -37,8 +37,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_sql_managed_database":                                resourceArmSqlManagedDatabase(),
 		"azurerm_sql_managed_instance":                                resourceArmSqlMiServer(),
 		"azurerm_sql_managed_instance_failover_group":                 resourceSqlInstanceFailoverGroup(),
+		"azurerm_sql_managed_instance_link":                           resourceSqlManagedInstanceLink(),
 		"azurerm_sql_managed_instance_active_directory_administrator": resourceSqlManagedInstanceAdministrator(),
 		"azurerm_sql_server":                                          resourceSqlServer(),
+		"azurerm_sql_server_trust_group":                              resourceSqlServerTrustGroup(),
 		"azurerm_sql_virtual_network_rule":                            resourceSqlVirtualNetworkRule(),
 	}
 }
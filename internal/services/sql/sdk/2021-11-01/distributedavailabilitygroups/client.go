@@ -0,0 +1,15 @@
+package distributedavailabilitygroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+type DistributedAvailabilityGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewDistributedAvailabilityGroupsClientWithBaseURI(endpoint string) DistributedAvailabilityGroupsClient {
+	return DistributedAvailabilityGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,31 @@
+package distributedavailabilitygroups
+
+import "strings"
+
+type ReplicationMode string
+
+const (
+	ReplicationModeAsync ReplicationMode = "Async"
+	ReplicationModeSync  ReplicationMode = "Sync"
+)
+
+func PossibleValuesForReplicationMode() []string {
+	return []string{
+		string(ReplicationModeAsync),
+		string(ReplicationModeSync),
+	}
+}
+
+func parseReplicationMode(input string) (*ReplicationMode, error) {
+	vals := map[string]ReplicationMode{
+		"async": ReplicationModeAsync,
+		"sync":  ReplicationModeSync,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ReplicationMode(input)
+	return &out, nil
+}
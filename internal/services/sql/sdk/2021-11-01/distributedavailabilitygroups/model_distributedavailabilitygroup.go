@@ -0,0 +1,8 @@
+package distributedavailabilitygroups
+
+type DistributedAvailabilityGroup struct {
+	Id         *string                                 `json:"id,omitempty"`
+	Name       *string                                 `json:"name,omitempty"`
+	Properties *DistributedAvailabilityGroupProperties `json:"properties,omitempty"`
+	Type       *string                                 `json:"type,omitempty"`
+}
@@ -0,0 +1,14 @@
+package distributedavailabilitygroups
+
+type DistributedAvailabilityGroupProperties struct {
+	DistributedAvailabilityGroupId *string          `json:"distributedAvailabilityGroupId,omitempty"`
+	LastHardenedLsn                *string          `json:"lastHardenedLsn,omitempty"`
+	LinkState                      *string          `json:"linkState,omitempty"`
+	PrimaryAvailabilityGroupName   *string          `json:"primaryAvailabilityGroupName,omitempty"`
+	ReplicationMode                *ReplicationMode `json:"replicationMode,omitempty"`
+	SecondaryAvailabilityGroupName *string          `json:"secondaryAvailabilityGroupName,omitempty"`
+	SourceEndpoint                 *string          `json:"sourceEndpoint,omitempty"`
+	SourceReplicaId                *string          `json:"sourceReplicaId,omitempty"`
+	TargetDatabase                 *string          `json:"targetDatabase,omitempty"`
+	TargetReplicaId                *string          `json:"targetReplicaId,omitempty"`
+}
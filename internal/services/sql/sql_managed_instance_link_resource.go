@@ -0,0 +1,250 @@
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2021-11-01/distributedavailabilitygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceSqlManagedInstanceLink manages a Managed Instance Link, which is a Distributed Availability Group
+// replicating a database from an Availability Group on SQL Server to a SQL Managed Instance.
+func resourceSqlManagedInstanceLink() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSqlManagedInstanceLinkCreate,
+		Read:   resourceSqlManagedInstanceLinkRead,
+		Update: resourceSqlManagedInstanceLinkUpdate,
+		Delete: resourceSqlManagedInstanceLinkDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"managed_instance_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"target_database_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_endpoint": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"primary_availability_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"secondary_availability_group_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"replication_mode": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(distributedavailabilitygroups.ReplicationModeAsync),
+				ValidateFunc: validation.StringInSlice(
+					distributedavailabilitygroups.PossibleValuesForReplicationMode(),
+					false),
+			},
+
+			"distributed_availability_group_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"source_replica_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"target_replica_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"link_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_hardened_lsn": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSqlManagedInstanceLinkCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	managedInstanceId, err := parse.ManagedInstanceID(d.Get("managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := distributedavailabilitygroups.NewDistributedAvailabilityGroupID(managedInstanceId.SubscriptionId, managedInstanceId.ResourceGroup, managedInstanceId.Name, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_sql_managed_instance_link", id.ID())
+	}
+
+	replicationMode := distributedavailabilitygroups.ReplicationMode(d.Get("replication_mode").(string))
+	payload := distributedavailabilitygroups.DistributedAvailabilityGroup{
+		Properties: &distributedavailabilitygroups.DistributedAvailabilityGroupProperties{
+			TargetDatabase:                 utils.String(d.Get("target_database_name").(string)),
+			SourceEndpoint:                 utils.String(d.Get("source_endpoint").(string)),
+			PrimaryAvailabilityGroupName:   utils.String(d.Get("primary_availability_group_name").(string)),
+			SecondaryAvailabilityGroupName: utils.String(d.Get("secondary_availability_group_name").(string)),
+			ReplicationMode:                &replicationMode,
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSqlManagedInstanceLinkRead(d, meta)
+}
+
+func resourceSqlManagedInstanceLinkRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DistributedAvailabilityGroupName)
+	d.Set("managed_instance_id", parse.NewManagedInstanceID(id.SubscriptionId, id.ResourceGroupName, id.ManagedInstanceName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("target_database_name", props.TargetDatabase)
+			d.Set("source_endpoint", props.SourceEndpoint)
+			d.Set("primary_availability_group_name", props.PrimaryAvailabilityGroupName)
+			d.Set("secondary_availability_group_name", props.SecondaryAvailabilityGroupName)
+
+			replicationMode := ""
+			if props.ReplicationMode != nil {
+				replicationMode = string(*props.ReplicationMode)
+			}
+			d.Set("replication_mode", replicationMode)
+
+			d.Set("distributed_availability_group_id", props.DistributedAvailabilityGroupId)
+			d.Set("source_replica_id", props.SourceReplicaId)
+			d.Set("target_replica_id", props.TargetReplicaId)
+			d.Set("link_state", props.LinkState)
+			d.Set("last_hardened_lsn", props.LastHardenedLsn)
+		}
+	}
+
+	return nil
+}
+
+func resourceSqlManagedInstanceLinkUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("replication_mode") {
+		replicationMode := distributedavailabilitygroups.ReplicationMode(d.Get("replication_mode").(string))
+		payload := distributedavailabilitygroups.DistributedAvailabilityGroup{
+			Properties: &distributedavailabilitygroups.DistributedAvailabilityGroupProperties{
+				ReplicationMode: &replicationMode,
+			},
+		}
+
+		if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+			return fmt.Errorf("updating %s: %+v", *id, err)
+		}
+	}
+
+	return resourceSqlManagedInstanceLinkRead(d, meta)
+}
+
+func resourceSqlManagedInstanceLinkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.DistributedAvailabilityGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/sdk/2021-11-01/distributedavailabilitygroups"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// a Managed Instance Link replicates from an Availability Group on a SQL Server, which has to be configured
+// (and reachable from the Managed Instance) ahead of time
+type SqlManagedInstanceLinkResource struct {
+	sourceEndpoint        string
+	availabilityGroupName string
+}
+
+func newSqlManagedInstanceLinkResource(t *testing.T) SqlManagedInstanceLinkResource {
+	r := SqlManagedInstanceLinkResource{
+		sourceEndpoint:        os.Getenv("ARM_TEST_SQL_MANAGED_INSTANCE_LINK_SOURCE_ENDPOINT"),
+		availabilityGroupName: os.Getenv("ARM_TEST_SQL_MANAGED_INSTANCE_LINK_AVAILABILITY_GROUP_NAME"),
+	}
+	if r.sourceEndpoint == "" || r.availabilityGroupName == "" {
+		t.Skip("Skipping as ARM_TEST_SQL_MANAGED_INSTANCE_LINK_SOURCE_ENDPOINT and/or ARM_TEST_SQL_MANAGED_INSTANCE_LINK_AVAILABILITY_GROUP_NAME are not specified")
+	}
+
+	return r
+}
+
+func TestAccSqlManagedInstanceLink_basic(t *testing.T) {
+	r := newSqlManagedInstanceLinkResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_sql_managed_instance_link", "test")
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data, "Async"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("distributed_availability_group_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "Sync"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("replication_mode").HasValue("Sync"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SqlManagedInstanceLinkResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := distributedavailabilitygroups.ParseDistributedAvailabilityGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Sql.DistributedAvailabilityGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r SqlManagedInstanceLinkResource) basic(data acceptance.TestData, replicationMode string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_managed_instance_link" "test" {
+  name                              = "acctest-link-%d"
+  managed_instance_id               = azurerm_sql_managed_instance.test.id
+  target_database_name              = "acctestdb"
+  source_endpoint                   = %q
+  primary_availability_group_name   = %q
+  secondary_availability_group_name = "acctest-mi-ag-%d"
+  replication_mode                  = %q
+}
+`, SqlManagedInstanceResource{}.basic(data), data.RandomInteger, r.sourceEndpoint, r.availabilityGroupName, data.RandomInteger, replicationMode)
+}
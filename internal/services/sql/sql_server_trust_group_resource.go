@@ -0,0 +1,192 @@
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceSqlServerTrustGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSqlServerTrustGroupCreate,
+		Read:   resourceSqlServerTrustGroupRead,
+		Delete: resourceSqlServerTrustGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ServerTrustGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			// the members of a Server Trust Group can't be changed once it's been created
+			"managed_instance_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 2,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"trust_scopes": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"GlobalTransactions",
+						"ServiceBroker",
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceSqlServerTrustGroupCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.ServerTrustGroupsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewServerTrustGroupID(subscriptionId, d.Get("resource_group_name").(string), azure.NormalizeLocation(d.Get("location").(string)), d.Get("name").(string))
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_sql_server_trust_group", id.ID())
+	}
+
+	members := make([]sql.ServerInfo, 0)
+	for _, v := range d.Get("managed_instance_ids").(*pluginsdk.Set).List() {
+		members = append(members, sql.ServerInfo{
+			ServerID: utils.String(v.(string)),
+		})
+	}
+
+	parameters := sql.ServerTrustGroup{
+		ServerTrustGroupProperties: &sql.ServerTrustGroupProperties{
+			GroupMembers: &members,
+			TrustScopes:  utils.ExpandStringSlice(d.Get("trust_scopes").(*pluginsdk.Set).List()),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LocationName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceSqlServerTrustGroupRead(d, meta)
+}
+
+func resourceSqlServerTrustGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.ServerTrustGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ServerTrustGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("location", azure.NormalizeLocation(id.LocationName))
+	d.Set("resource_group_name", id.ResourceGroup)
+
+	if props := resp.ServerTrustGroupProperties; props != nil {
+		managedInstanceIds := make([]interface{}, 0)
+		if props.GroupMembers != nil {
+			for _, member := range *props.GroupMembers {
+				if member.ServerID == nil {
+					continue
+				}
+
+				managedInstanceId := *member.ServerID
+				if v, err := parse.ManagedInstanceID(managedInstanceId); err == nil {
+					managedInstanceId = v.ID()
+				}
+				managedInstanceIds = append(managedInstanceIds, managedInstanceId)
+			}
+		}
+		if err := d.Set("managed_instance_ids", managedInstanceIds); err != nil {
+			return fmt.Errorf("setting `managed_instance_ids`: %+v", err)
+		}
+
+		if err := d.Set("trust_scopes", utils.FlattenStringSlice(props.TrustScopes)); err != nil {
+			return fmt.Errorf("setting `trust_scopes`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceSqlServerTrustGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Sql.ServerTrustGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ServerTrustGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
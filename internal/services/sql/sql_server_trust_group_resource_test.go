@@ -0,0 +1,96 @@
+package sql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SqlServerTrustGroupResource struct{}
+
+func TestAccSqlServerTrustGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_server_trust_group", "test")
+	r := SqlServerTrustGroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_instance_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSqlServerTrustGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sql_server_trust_group", "test")
+	r := SqlServerTrustGroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r SqlServerTrustGroupResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.ServerTrustGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Sql.ServerTrustGroupsClient.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(true), nil
+}
+
+func (r SqlServerTrustGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_trust_group" "test" {
+  name                = "acctest-stg-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  trust_scopes        = ["GlobalTransactions"]
+
+  managed_instance_ids = [
+    azurerm_sql_managed_instance.test.id,
+    azurerm_sql_managed_instance.secondary.id,
+  ]
+}
+`, SqlManagedInstanceResource{}.multiple(data), data.RandomInteger)
+}
+
+func (r SqlServerTrustGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sql_server_trust_group" "import" {
+  name                 = azurerm_sql_server_trust_group.test.name
+  resource_group_name  = azurerm_sql_server_trust_group.test.resource_group_name
+  location             = azurerm_sql_server_trust_group.test.location
+  trust_scopes         = azurerm_sql_server_trust_group.test.trust_scopes
+  managed_instance_ids = azurerm_sql_server_trust_group.test.managed_instance_ids
+}
+`, r.basic(data))
+}
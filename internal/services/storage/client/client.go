@@ -4,13 +4,14 @@ import (
 	"context"
 	"fmt"
 
-	legacystorage "github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
 	"github.com/Azure/azure-sdk-for-go/services/storagepool/mgmt/2021-08-01/storagepool"
 	"github.com/Azure/azure-sdk-for-go/services/storagesync/mgmt/2020-03-01/storagesync"
 	"github.com/Azure/go-autorest/autorest"
 	az "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-08-01/blobinventorypolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-08-01/storageaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
 	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
@@ -31,13 +32,14 @@ type Client struct {
 	ADLSGen2PathsClient         *paths.Client
 	ManagementPoliciesClient    *storage.ManagementPoliciesClient
 	BlobServicesClient          *storage.BlobServicesClient
-	BlobInventoryPoliciesClient *legacystorage.BlobInventoryPoliciesClient
+	BlobInventoryPoliciesClient *blobinventorypolicies.BlobInventoryPoliciesClient
 	CloudEndpointsClient        *storagesync.CloudEndpointsClient
 	DisksPoolsClient            *storagepool.DiskPoolsClient
 	EncryptionScopesClient      *storage.EncryptionScopesClient
 	Environment                 az.Environment
 	FileServicesClient          *storage.FileServicesClient
 	ObjectReplicationClient     *storage.ObjectReplicationPoliciesClient
+	StorageAccountsClient       *storageaccounts.StorageAccountsClient
 	SyncServiceClient           *storagesync.ServicesClient
 	SyncGroupsClient            *storagesync.SyncGroupsClient
 	SubscriptionId              string
@@ -62,7 +64,7 @@ func NewClient(options *common.ClientOptions) *Client {
 	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
 
-	blobInventoryPoliciesClient := legacystorage.NewBlobInventoryPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	blobInventoryPoliciesClient := blobinventorypolicies.NewBlobInventoryPoliciesClientWithBaseURI(options.ResourceManagerEndpoint)
 	options.ConfigureClient(&blobInventoryPoliciesClient.Client, options.ResourceManagerAuthorizer)
 
 	cloudEndpointsClient := storagesync.NewCloudEndpointsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
@@ -80,6 +82,9 @@ func NewClient(options *common.ClientOptions) *Client {
 	objectReplicationPolicyClient := storage.NewObjectReplicationPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&objectReplicationPolicyClient.Client, options.ResourceManagerAuthorizer)
 
+	storageAccountsClient := storageaccounts.NewStorageAccountsClientWithBaseURI(options.ResourceManagerEndpoint)
+	options.ConfigureClient(&storageAccountsClient.Client, options.ResourceManagerAuthorizer)
+
 	syncServiceClient := storagesync.NewServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&syncServiceClient.Client, options.ResourceManagerAuthorizer)
 
@@ -101,6 +106,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		Environment:                 options.Environment,
 		FileServicesClient:          &fileServicesClient,
 		ObjectReplicationClient:     &objectReplicationPolicyClient,
+		StorageAccountsClient:       &storageAccountsClient,
 		SubscriptionId:              options.SubscriptionId,
 		SyncServiceClient:           &syncServiceClient,
 		SyncGroupsClient:            &syncGroupsClient,
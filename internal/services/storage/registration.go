@@ -69,5 +69,6 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DisksPoolResource{},
+		StorageAccountStaticWebsiteResource{},
 	}
 }
@@ -0,0 +1,15 @@
+package blobinventorypolicies
+
+import "github.com/Azure/go-autorest/autorest"
+
+type BlobInventoryPoliciesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewBlobInventoryPoliciesClientWithBaseURI(endpoint string) BlobInventoryPoliciesClient {
+	return BlobInventoryPoliciesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
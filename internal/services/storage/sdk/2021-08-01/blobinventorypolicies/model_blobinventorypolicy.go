@@ -0,0 +1,8 @@
+package blobinventorypolicies
+
+type BlobInventoryPolicy struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *BlobInventoryPolicyProperties `json:"properties,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
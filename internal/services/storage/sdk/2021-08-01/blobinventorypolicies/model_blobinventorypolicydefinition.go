@@ -0,0 +1,9 @@
+package blobinventorypolicies
+
+type BlobInventoryPolicyDefinition struct {
+	Filters      *BlobInventoryPolicyFilter `json:"filters,omitempty"`
+	Format       Format                     `json:"format"`
+	ObjectType   ObjectType                 `json:"objectType"`
+	Schedule     Schedule                   `json:"schedule"`
+	SchemaFields []string                   `json:"schemaFields,omitempty"`
+}
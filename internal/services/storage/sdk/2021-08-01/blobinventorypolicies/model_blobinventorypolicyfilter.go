@@ -0,0 +1,10 @@
+package blobinventorypolicies
+
+type BlobInventoryPolicyFilter struct {
+	BlobTypes           *[]string `json:"blobTypes,omitempty"`
+	ExcludePrefix       *[]string `json:"excludePrefix,omitempty"`
+	IncludeBlobVersions *bool     `json:"includeBlobVersions,omitempty"`
+	IncludeDeleted      *bool     `json:"includeDeleted,omitempty"`
+	IncludeSnapshots    *bool     `json:"includeSnapshots,omitempty"`
+	PrefixMatch         *[]string `json:"prefixMatch,omitempty"`
+}
@@ -0,0 +1,6 @@
+package blobinventorypolicies
+
+type BlobInventoryPolicyProperties struct {
+	LastModifiedTime *string                   `json:"lastModifiedTime,omitempty"`
+	Policy           BlobInventoryPolicySchema `json:"policy"`
+}
@@ -0,0 +1,8 @@
+package blobinventorypolicies
+
+type BlobInventoryPolicyRule struct {
+	Definition  BlobInventoryPolicyDefinition `json:"definition"`
+	Destination string                        `json:"destination"`
+	Enabled     bool                          `json:"enabled"`
+	Name        string                        `json:"name"`
+}
@@ -0,0 +1,8 @@
+package blobinventorypolicies
+
+type BlobInventoryPolicySchema struct {
+	Destination *string                   `json:"destination,omitempty"`
+	Enabled     bool                      `json:"enabled"`
+	Rules       []BlobInventoryPolicyRule `json:"rules,omitempty"`
+	Type        string                    `json:"type"`
+}
@@ -0,0 +1,9 @@
+package blobinventorypolicies
+
+import "fmt"
+
+const defaultApiVersion = "2021-08-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/blobinventorypolicies/%s", defaultApiVersion)
+}
@@ -0,0 +1,31 @@
+package storageaccounts
+
+import "strings"
+
+type HierarchicalNamespaceMigrationRequestType string
+
+const (
+	HierarchicalNamespaceMigrationRequestTypeHnsOnHydrationRequest  HierarchicalNamespaceMigrationRequestType = "HnsOnHydrationRequest"
+	HierarchicalNamespaceMigrationRequestTypeHnsOnValidationRequest HierarchicalNamespaceMigrationRequestType = "HnsOnValidationRequest"
+)
+
+func PossibleValuesForHierarchicalNamespaceMigrationRequestType() []string {
+	return []string{
+		string(HierarchicalNamespaceMigrationRequestTypeHnsOnHydrationRequest),
+		string(HierarchicalNamespaceMigrationRequestTypeHnsOnValidationRequest),
+	}
+}
+
+func parseHierarchicalNamespaceMigrationRequestType(input string) (*HierarchicalNamespaceMigrationRequestType, error) {
+	vals := map[string]HierarchicalNamespaceMigrationRequestType{
+		"hnsonhydrationrequest":  HierarchicalNamespaceMigrationRequestTypeHnsOnHydrationRequest,
+		"hnsonvalidationrequest": HierarchicalNamespaceMigrationRequestTypeHnsOnValidationRequest,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := HierarchicalNamespaceMigrationRequestType(input)
+	return &out, nil
+}
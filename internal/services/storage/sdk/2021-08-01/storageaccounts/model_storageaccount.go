@@ -0,0 +1,10 @@
+package storageaccounts
+
+type StorageAccount struct {
+	Id         *string                   `json:"id,omitempty"`
+	Kind       *string                   `json:"kind,omitempty"`
+	Location   *string                   `json:"location,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *StorageAccountProperties `json:"properties,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
@@ -0,0 +1,8 @@
+package storageaccounts
+
+type StorageAccountProperties struct {
+	IsHnsEnabled       *bool `json:"isHnsEnabled,omitempty"`
+	IsLocalUserEnabled *bool `json:"isLocalUserEnabled,omitempty"`
+	IsNfsV3Enabled     *bool `json:"isNfsV3Enabled,omitempty"`
+	IsSftpEnabled      *bool `json:"isSftpEnabled,omitempty"`
+}
@@ -0,0 +1,9 @@
+package storageaccounts
+
+import "fmt"
+
+const defaultApiVersion = "2021-08-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/storageaccounts/%s", defaultApiVersion)
+}
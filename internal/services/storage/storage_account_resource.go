@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/helpers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-08-01/storageaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -57,7 +58,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 		schemaVersion = 3
 	}
 
-	return &pluginsdk.Resource{
+	resource := &pluginsdk.Resource{
 		Create: resourceStorageAccountCreate,
 		Read:   resourceStorageAccountRead,
 		Update: resourceStorageAccountUpdate,
@@ -66,10 +67,10 @@ func resourceStorageAccount() *pluginsdk.Resource {
 		SchemaVersion:  schemaVersion,
 		StateUpgraders: pluginsdk.StateUpgrades(upgraders),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.StorageAccountID(id)
 			return err
-		}),
+		}, importStorageAccount),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -245,11 +246,11 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				}, false),
 			},
 
+			// the hierarchical namespace can be enabled on an existing Storage Account by migrating it, but it can't be disabled
 			"is_hns_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				Default:  false,
-				ForceNew: true,
 			},
 
 			"nfsv3_enabled": {
@@ -259,6 +260,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				ForceNew: true,
 			},
 
+			"sftp_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// TODO: document this new field in 3.0
 			allowPublicNestedItemsName: {
 				Type:     pluginsdk.TypeBool,
@@ -669,27 +676,6 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 
-			//lintignore:XS003
-			"static_website": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"index_document": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-						"error_404_document": {
-							Type:         pluginsdk.TypeString,
-							Optional:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
-						},
-					},
-				},
-			},
-
 			"queue_encryption_key_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -912,7 +898,12 @@ func resourceStorageAccount() *pluginsdk.Resource {
 						return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
 					}
 				}
-				return nil
+
+				return validateStorageAccountProtocols(d)
+			}),
+			pluginsdk.ForceNewIfChange("is_hns_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
+				// the hierarchical namespace can't be disabled once it's been enabled
+				return old.(bool) && !new.(bool)
 			}),
 			pluginsdk.ForceNewIfChange("account_replication_type", func(ctx context.Context, old, new, meta interface{}) bool {
 				newAccRep := strings.ToUpper(new.(string))
@@ -931,6 +922,34 @@ func resourceStorageAccount() *pluginsdk.Resource {
 			}),
 		),
 	}
+
+	// TODO: (v3.0) remove `static_website` from the Storage Account, it's managed using the
+	// `azurerm_storage_account_static_website` resource instead.
+	if !features.ThreePointOh() {
+		//lintignore:XS003
+		resource.Schema["static_website"] = &pluginsdk.Schema{
+			Type:       pluginsdk.TypeList,
+			Optional:   true,
+			MaxItems:   1,
+			Deprecated: "`static_website` has been deprecated in favour of the `azurerm_storage_account_static_website` resource and will be removed in version 3.0 of the AzureRM Provider",
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"index_document": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					"error_404_document": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		}
+	}
+
+	return resource
 }
 
 func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -1040,17 +1059,6 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("`is_hns_enabled` can only be used with account kinds `StorageV2`, `BlobStorage` and `BlockBlobStorage`")
 	}
 
-	// NFSv3 is supported for standard general-purpose v2 storage accounts and for premium block blob storage accounts.
-	// (https://docs.microsoft.com/en-us/azure/storage/blobs/network-file-system-protocol-support-how-to#step-5-create-and-configure-a-storage-account)
-	if nfsV3Enabled &&
-		!((accountTier == string(storage.SkuTierPremium) && accountKind == string(storage.KindBlockBlobStorage)) ||
-			(accountTier == string(storage.SkuTierStandard) && accountKind == string(storage.KindStorageV2))) {
-		return fmt.Errorf("`nfsv3_enabled` can only be used with account tier `Standard` and account kind `StorageV2`, or account tier `Premium` and account kind `BlockBlobStorage`")
-	}
-	if nfsV3Enabled && !isHnsEnabled {
-		return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
-	}
-
 	// AccountTier must be Premium for FileStorage
 	if accountKind == string(storage.KindFileStorage) {
 		if string(parameters.Sku.Tier) == string(storage.SkuNameStandardLRS) {
@@ -1117,6 +1125,13 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("populating cache for %s: %+v", id, err)
 	}
 
+	// SFTP can't be enabled when creating the Storage Account in this API version, so it's enabled afterwards
+	if d.Get("sftp_enabled").(bool) {
+		if err := updateStorageAccountSftp(ctx, storageClient.StorageAccountsClient, id, true); err != nil {
+			return err
+		}
+	}
+
 	if val, ok := d.GetOk("blob_properties"); ok {
 		// FileStorage does not support blob settings
 		if accountKind != string(storage.KindFileStorage) {
@@ -1183,7 +1198,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if val, ok := d.GetOk("static_website"); ok {
+	if val, ok := d.GetOk("static_website"); ok && !features.ThreePointOh() {
 		// static website only supported on StorageV2 and BlockBlobStorage
 		if accountKind != string(storage.KindStorageV2) && accountKind != string(storage.KindBlockBlobStorage) {
 			return fmt.Errorf("`static_website` is only supported for StorageV2 and BlockBlobStorage.")
@@ -1312,6 +1327,19 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("is_hns_enabled") && d.Get("is_hns_enabled").(bool) {
+		if err := migrateStorageAccountToHierarchicalNamespace(ctx, meta.(*clients.Client).Storage.StorageAccountsClient, *id); err != nil {
+			return err
+		}
+	}
+
+	// SFTP requires the hierarchical namespace, so this has to happen after any migration
+	if d.HasChange("sftp_enabled") {
+		if err := updateStorageAccountSftp(ctx, meta.(*clients.Client).Storage.StorageAccountsClient, *id, d.Get("sftp_enabled").(bool)); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("tags") {
 		t := d.Get("tags").(map[string]interface{})
 
@@ -1554,7 +1582,7 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	if d.HasChange("static_website") {
+	if !features.ThreePointOh() && d.HasChange("static_website") {
 		// static website only supported on StorageV2 and BlockBlobStorage
 		if accountKind != string(storage.KindStorageV2) && accountKind != string(storage.KindBlockBlobStorage) {
 			return fmt.Errorf("`static_website` is only supported for StorageV2 and BlockBlobStorage.")
@@ -1629,6 +1657,17 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	// `isSftpEnabled` isn't available in the API version used by the AccountsClient
+	sftpEnabled := false
+	accountResp, err := meta.(*clients.Client).Storage.StorageAccountsClient.GetProperties(ctx, storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name))
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if model := accountResp.Model; model != nil && model.Properties != nil && model.Properties.IsSftpEnabled != nil {
+		sftpEnabled = *model.Properties.IsSftpEnabled
+	}
+	d.Set("sftp_enabled", sftpEnabled)
+
 	d.Set("name", id.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
 	if location := resp.Location; location != nil {
@@ -1845,37 +1884,85 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	var staticWebsite []interface{}
-
-	// static website only supported on StorageV2 and BlockBlobStorage
-	if resp.Kind == storage.KindStorageV2 || resp.Kind == storage.KindBlockBlobStorage {
-		storageClient := meta.(*clients.Client).Storage
-
-		account, err := storageClient.FindAccount(ctx, id.Name)
+	// the static website can only be retrieved using the Data Plane, which isn't available when access to the Storage
+	// Account is restricted (e.g. by the Firewall or when only using a Private Endpoint) - as such this is only
+	// retrieved when `static_website` is set (or when importing the Storage Account)
+	if !features.ThreePointOh() && len(d.Get("static_website").([]interface{})) > 0 {
+		staticWebsite, err := retrieveStorageAccountStaticWebsite(ctx, meta, id.Name, resp.Kind)
 		if err != nil {
-			return fmt.Errorf("retrieving Account %q: %s", id.Name, err)
+			return err
 		}
 
-		accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account)
-		if err != nil {
-			return fmt.Errorf("building Accounts Data Plane Client: %s", err)
+		if err := d.Set("static_website", staticWebsite); err != nil {
+			return fmt.Errorf("setting `static_website `for AzureRM Storage Account %q: %+v", id.Name, err)
 		}
+	}
 
-		staticWebsiteProps, err := accountsClient.GetServiceProperties(ctx, id.Name)
-		if err != nil {
-			if staticWebsiteProps.Response.Response != nil && !utils.ResponseWasNotFound(staticWebsiteProps.Response) {
-				return fmt.Errorf("reading static website for AzureRM Storage Account %q: %+v", id.Name, err)
-			}
-		}
+	return tags.FlattenAndSet(d, resp.Tags)
+}
 
-		staticWebsite = flattenStaticWebsiteProperties(staticWebsiteProps)
+func importStorageAccount(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	if features.ThreePointOh() {
+		return []*pluginsdk.ResourceData{d}, nil
+	}
+
+	client := meta.(*clients.Client).Storage.AccountsClient
+
+	id, err := parse.StorageAccountID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, err
+	}
+
+	resp, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	// the Data Plane isn't available when access to the Storage Account is restricted, in which case the static
+	// website isn't imported rather than the import failing
+	staticWebsite, err := retrieveStorageAccountStaticWebsite(ctx, meta, id.Name, resp.Kind)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve the static website for %s, so this won't be imported: %+v", *id, err)
+		return []*pluginsdk.ResourceData{d}, nil
 	}
 
 	if err := d.Set("static_website", staticWebsite); err != nil {
-		return fmt.Errorf("setting `static_website `for AzureRM Storage Account %q: %+v", id.Name, err)
+		return []*pluginsdk.ResourceData{}, fmt.Errorf("setting `static_website `for AzureRM Storage Account %q: %+v", id.Name, err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// retrieveStorageAccountStaticWebsite retrieves the static website of the Storage Account using the Data Plane
+func retrieveStorageAccountStaticWebsite(ctx context.Context, meta interface{}, accountName string, kind storage.Kind) ([]interface{}, error) {
+	// static website only supported on StorageV2 and BlockBlobStorage
+	if kind != storage.KindStorageV2 && kind != storage.KindBlockBlobStorage {
+		return []interface{}{}, nil
+	}
+
+	storageClient := meta.(*clients.Client).Storage
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q: %s", accountName, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client: %s", err)
+	}
+
+	staticWebsiteProps, err := accountsClient.GetServiceProperties(ctx, accountName)
+	if err != nil {
+		if staticWebsiteProps.Response.Response != nil && !utils.ResponseWasNotFound(staticWebsiteProps.Response) {
+			return nil, fmt.Errorf("reading static website for AzureRM Storage Account %q: %+v", accountName, err)
+		}
+	}
+
+	return flattenStaticWebsiteProperties(staticWebsiteProps), nil
 }
 
 func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -3036,3 +3123,86 @@ func getDefaultAllowBlobPublicAccessName() string {
 	}
 	return "allow_blob_public_access"
 }
+
+// validateStorageAccountProtocols validates the prerequisites of the hierarchical namespace, NFSv3 and SFTP at plan time,
+// since the API only returns an opaque error when these aren't met
+func validateStorageAccountProtocols(d *pluginsdk.ResourceDiff) error {
+	for _, key := range []string{"account_kind", "account_tier", "is_hns_enabled", "nfsv3_enabled", "sftp_enabled"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	accountKind := d.Get("account_kind").(string)
+	accountTier := d.Get("account_tier").(string)
+	isHnsEnabled := d.Get("is_hns_enabled").(bool)
+
+	// enabling the hierarchical namespace on an existing Storage Account requires it to be migrated
+	if d.Id() != "" && d.HasChange("is_hns_enabled") && isHnsEnabled {
+		if accountKind != string(storage.KindStorageV2) {
+			return fmt.Errorf("the hierarchical namespace can only be enabled on an existing Storage Account when `account_kind` is `StorageV2` - either set `account_kind` to `StorageV2` or recreate the Storage Account")
+		}
+		if v, ok := d.GetOk("blob_properties.0.versioning_enabled"); ok && v.(bool) {
+			return fmt.Errorf("the hierarchical namespace can't be enabled on an existing Storage Account with blob versioning - `blob_properties.0.versioning_enabled` must be set to `false` first")
+		}
+		if v, ok := d.GetOk("blob_properties.0.change_feed_enabled"); ok && v.(bool) {
+			return fmt.Errorf("the hierarchical namespace can't be enabled on an existing Storage Account with the change feed - `blob_properties.0.change_feed_enabled` must be set to `false` first")
+		}
+	}
+
+	if d.Get("nfsv3_enabled").(bool) {
+		// NFSv3 is supported for standard general-purpose v2 storage accounts and for premium block blob storage accounts.
+		// (https://docs.microsoft.com/en-us/azure/storage/blobs/network-file-system-protocol-support-how-to#step-5-create-and-configure-a-storage-account)
+		if !((accountTier == string(storage.SkuTierPremium) && accountKind == string(storage.KindBlockBlobStorage)) ||
+			(accountTier == string(storage.SkuTierStandard) && accountKind == string(storage.KindStorageV2))) {
+			return fmt.Errorf("`nfsv3_enabled` can only be used with account tier `Standard` and account kind `StorageV2`, or account tier `Premium` and account kind `BlockBlobStorage`")
+		}
+		if !isHnsEnabled {
+			return fmt.Errorf("`nfsv3_enabled` can only be used when `is_hns_enabled` is `true`")
+		}
+	}
+
+	if d.Get("sftp_enabled").(bool) {
+		if accountKind != string(storage.KindStorageV2) && accountKind != string(storage.KindBlockBlobStorage) {
+			return fmt.Errorf("`sftp_enabled` can only be used with account kinds `StorageV2` and `BlockBlobStorage`")
+		}
+		if !isHnsEnabled {
+			return fmt.Errorf("`sftp_enabled` can only be used when `is_hns_enabled` is `true` - the hierarchical namespace can be enabled on an existing `StorageV2` Storage Account without recreating it")
+		}
+	}
+
+	return nil
+}
+
+// migrateStorageAccountToHierarchicalNamespace enables the hierarchical namespace on an existing Storage Account, which
+// is validated by the API before the account is migrated
+func migrateStorageAccountToHierarchicalNamespace(ctx context.Context, client *storageaccounts.StorageAccountsClient, id parse.StorageAccountId) error {
+	accountId := storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name)
+
+	validationRequest := storageaccounts.HierarchicalNamespaceMigrationRequestTypeHnsOnValidationRequest
+	log.Printf("[DEBUG] validating that %s can be migrated to the hierarchical namespace", id)
+	if err := client.HierarchicalNamespaceMigrationThenPoll(ctx, accountId, storageaccounts.HierarchicalNamespaceMigrationOperationOptions{RequestType: &validationRequest}); err != nil {
+		return fmt.Errorf("validating that %s can be migrated to the hierarchical namespace: %+v", id, err)
+	}
+
+	hydrationRequest := storageaccounts.HierarchicalNamespaceMigrationRequestTypeHnsOnHydrationRequest
+	log.Printf("[DEBUG] migrating %s to the hierarchical namespace", id)
+	if err := client.HierarchicalNamespaceMigrationThenPoll(ctx, accountId, storageaccounts.HierarchicalNamespaceMigrationOperationOptions{RequestType: &hydrationRequest}); err != nil {
+		return fmt.Errorf("migrating %s to the hierarchical namespace: %+v", id, err)
+	}
+
+	return nil
+}
+
+func updateStorageAccountSftp(ctx context.Context, client *storageaccounts.StorageAccountsClient, id parse.StorageAccountId, enabled bool) error {
+	payload := storageaccounts.StorageAccountUpdateParameters{
+		Properties: &storageaccounts.StorageAccountPropertiesUpdateParameters{
+			IsSftpEnabled: utils.Bool(enabled),
+		},
+	}
+	if _, err := client.Update(ctx, storageaccounts.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.Name), payload); err != nil {
+		return fmt.Errorf("updating `sftp_enabled` for %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-04-01/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/accounts"
+)
+
+// StorageAccountStaticWebsiteResource manages the Static Website of a Storage Account separately from the Storage
+// Account itself. There's no Resource Manager API for the Static Website, so this uses the Blob Data Plane API.
+type StorageAccountStaticWebsiteResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageAccountStaticWebsiteResource{}
+
+type StorageAccountStaticWebsiteResourceModel struct {
+	StorageAccountId string `tfschema:"storage_account_id"`
+	IndexDocument    string `tfschema:"index_document"`
+	Error404Document string `tfschema:"error_404_document"`
+}
+
+func (r StorageAccountStaticWebsiteResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageAccountID,
+		},
+
+		"index_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"error_404_document": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageAccountStaticWebsiteResource) ModelObject() interface{} {
+	return &StorageAccountStaticWebsiteResourceModel{}
+}
+
+func (r StorageAccountStaticWebsiteResource) ResourceType() string {
+	return "azurerm_storage_account_static_website"
+}
+
+func (r StorageAccountStaticWebsiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageAccountID
+}
+
+func (r StorageAccountStaticWebsiteResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageAccountStaticWebsiteResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// since the Static Website is a property of the Storage Account, we can use that as the ID
+			id, err := parse.StorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.Name, storageAccountResourceName)
+			defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+			account, err := metadata.Client.Storage.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// static website only supported on StorageV2 and BlockBlobStorage
+			if account.Kind != storage.KindStorageV2 && account.Kind != storage.KindBlockBlobStorage {
+				return fmt.Errorf("a Static Website is only supported for %q and %q Storage Accounts but %s is of kind %q", string(storage.KindStorageV2), string(storage.KindBlockBlobStorage), *id, string(account.Kind))
+			}
+
+			accountsClient, err := r.dataPlaneClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			existing, err := accountsClient.GetServiceProperties(ctx, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving the Static Website for %s: %+v", *id, err)
+			}
+			if props := existing.StorageServiceProperties; props != nil && props.StaticWebsite != nil && props.StaticWebsite.Enabled {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := accountsClient.SetServiceProperties(ctx, id.Name, expandStorageAccountStaticWebsite(model, true)); err != nil {
+				return fmt.Errorf("enabling the Static Website for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			account, err := metadata.Client.Storage.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(account.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			accountsClient, err := r.dataPlaneClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			resp, err := accountsClient.GetServiceProperties(ctx, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving the Static Website for %s: %+v", *id, err)
+			}
+
+			props := resp.StorageServiceProperties
+			if props == nil || props.StaticWebsite == nil || !props.StaticWebsite.Enabled {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageAccountStaticWebsiteResourceModel{
+				StorageAccountId: id.ID(),
+				IndexDocument:    props.StaticWebsite.IndexDocument,
+				Error404Document: props.StaticWebsite.ErrorDocument404Path,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageAccountStaticWebsiteResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.Name, storageAccountResourceName)
+			defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+			accountsClient, err := r.dataPlaneClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			if _, err := accountsClient.SetServiceProperties(ctx, id.Name, expandStorageAccountStaticWebsite(model, true)); err != nil {
+				return fmt.Errorf("updating the Static Website for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.Name, storageAccountResourceName)
+			defer locks.UnlockByName(id.Name, storageAccountResourceName)
+
+			accountsClient, err := r.dataPlaneClient(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			if _, err := accountsClient.SetServiceProperties(ctx, id.Name, expandStorageAccountStaticWebsite(StorageAccountStaticWebsiteResourceModel{}, false)); err != nil {
+				return fmt.Errorf("disabling the Static Website for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageAccountStaticWebsiteResource) dataPlaneClient(ctx context.Context, metadata sdk.ResourceMetaData, id parse.StorageAccountId) (*accounts.Client, error) {
+	storageClient := metadata.Client.Storage
+
+	account, err := storageClient.FindAccount(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate %s", id)
+	}
+
+	accountsClient, err := storageClient.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client for %s: %+v", id, err)
+	}
+
+	return accountsClient, nil
+}
+
+func expandStorageAccountStaticWebsite(input StorageAccountStaticWebsiteResourceModel, enabled bool) accounts.StorageServiceProperties {
+	return accounts.StorageServiceProperties{
+		StaticWebsite: &accounts.StaticWebsite{
+			Enabled:              enabled,
+			IndexDocument:        input.IndexDocument,
+			ErrorDocument404Path: input.Error404Document,
+		},
+	}
+}
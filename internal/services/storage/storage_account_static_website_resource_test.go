@@ -0,0 +1,196 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageAccountStaticWebsiteResource struct{}
+
+func TestAccStorageAccountStaticWebsite_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountStaticWebsite_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageAccountStaticWebsite_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "index.html", "404.html"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, "default.html", "not-found.html"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageAccountStaticWebsite_blockBlobStorage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_static_website", "test")
+	r := StorageAccountStaticWebsiteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockBlobStorage(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageAccountStaticWebsiteResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageAccountID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate %s", *id)
+	}
+
+	accountsClient, err := client.Storage.AccountsDataPlaneClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building Accounts Data Plane Client: %+v", err)
+	}
+
+	resp, err := accountsClient.GetServiceProperties(ctx, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Static Website for %s: %+v", *id, err)
+	}
+
+	props := resp.StorageServiceProperties
+	return utils.Bool(props != nil && props.StaticWebsite != nil && props.StaticWebsite.Enabled), nil
+}
+
+func (r StorageAccountStaticWebsiteResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, r.template(data, "StorageV2", "Standard"))
+}
+
+func (r StorageAccountStaticWebsiteResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website" "import" {
+  storage_account_id = azurerm_storage_account_static_website.test.storage_account_id
+}
+`, r.basic(data))
+}
+
+func (r StorageAccountStaticWebsiteResource) complete(data acceptance.TestData, indexDocument, error404Document string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  index_document     = "%s"
+  error_404_document = "%s"
+}
+`, r.template(data, "StorageV2", "Standard"), indexDocument, error404Document)
+}
+
+func (r StorageAccountStaticWebsiteResource) blockBlobStorage(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_static_website" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  index_document     = "index.html"
+  error_404_document = "404.html"
+}
+`, r.template(data, "BlockBlobStorage", "Premium"))
+}
+
+func (r StorageAccountStaticWebsiteResource) template(data acceptance.TestData, accountKind, accountTier string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "%s"
+  account_tier             = "%s"
+  account_replication_type = "LRS"
+
+  lifecycle {
+    ignore_changes = [static_website]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, accountKind, accountTier)
+}
@@ -5,10 +5,11 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-01-01/storage"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-08-01/blobinventorypolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -61,15 +62,55 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
+						"format": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(blobinventorypolicies.FormatCsv),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(blobinventorypolicies.FormatCsv),
+								string(blobinventorypolicies.FormatParquet),
+							}, false),
+						},
+
+						"schedule": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(blobinventorypolicies.ScheduleDaily),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(blobinventorypolicies.ScheduleDaily),
+								string(blobinventorypolicies.ScheduleWeekly),
+							}, false),
+						},
+
+						"scope": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(blobinventorypolicies.ObjectTypeBlob),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(blobinventorypolicies.ObjectTypeBlob),
+								string(blobinventorypolicies.ObjectTypeContainer),
+							}, false),
+						},
+
+						// when omitted the fields required by the `scope` and the `filter` are included in the inventory
+						"schema_fields": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
 						"filter": {
 							Type:     pluginsdk.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"blob_types": {
 										Type:     pluginsdk.TypeSet,
-										Required: true,
+										Optional: true,
 										Elem: &pluginsdk.Schema{
 											Type: pluginsdk.TypeString,
 											ValidateFunc: validation.StringInSlice([]string{
@@ -100,6 +141,15 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 											ValidateFunc: validation.StringIsNotEmpty,
 										},
 									},
+
+									"exclude_prefixes": {
+										Type:     pluginsdk.TypeSet,
+										Optional: true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
 								},
 							},
 						},
@@ -109,6 +159,7 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 		},
 	}
 }
+
 func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Storage.BlobInventoryPoliciesClient
@@ -121,30 +172,35 @@ func resourceStorageBlobInventoryPolicyCreateUpdate(d *pluginsdk.ResourceData, m
 	}
 
 	id := parse.NewBlobInventoryPolicyID(subscriptionId, storageAccount.ResourceGroup, storageAccount.Name, "Default")
+	policyId := blobinventorypolicies.NewBlobInventoryPolicyID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.InventoryPolicyName)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+		existing, err := client.Get(ctx, policyId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for existing %q: %+v", id, err)
 			}
 		}
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return tf.ImportAsExistsError("azurerm_storage_blob_inventory_policy", id.ID())
 		}
 	}
 
-	props := storage.BlobInventoryPolicy{
-		BlobInventoryPolicyProperties: &storage.BlobInventoryPolicyProperties{
-			Policy: &storage.BlobInventoryPolicySchema{
-				Enabled:     utils.Bool(true),
-				Destination: utils.String(d.Get("storage_container_name").(string)),
-				Type:        utils.String("Inventory"),
-				Rules:       expandBlobInventoryPolicyRules(d.Get("rules").(*pluginsdk.Set).List()),
+	rules, err := expandBlobInventoryPolicyRules(d.Get("storage_container_name").(string), d.Get("rules").(*pluginsdk.Set).List())
+	if err != nil {
+		return err
+	}
+
+	props := blobinventorypolicies.BlobInventoryPolicy{
+		Properties: &blobinventorypolicies.BlobInventoryPolicyProperties{
+			Policy: blobinventorypolicies.BlobInventoryPolicySchema{
+				Enabled: true,
+				Type:    "Inventory",
+				Rules:   rules,
 			},
 		},
 	}
-	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.StorageAccountName, props); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, policyId, props); err != nil {
 		return fmt.Errorf("creating/updating %q: %+v", id, err)
 	}
 
@@ -163,9 +219,9 @@ func resourceStorageBlobInventoryPolicyRead(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+	resp, err := client.Get(ctx, blobinventorypolicies.NewBlobInventoryPolicyID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.InventoryPolicyName))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] storage %q does not exist - removing from state", d.Id())
 			d.SetId("")
 			return nil
@@ -173,15 +229,29 @@ func resourceStorageBlobInventoryPolicyRead(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("retrieving %q: %+v", id, err)
 	}
 	d.Set("storage_account_id", parse.NewStorageAccountID(subscriptionId, id.ResourceGroup, id.StorageAccountName).ID())
-	if props := resp.BlobInventoryPolicyProperties; props != nil {
-		if policy := props.Policy; policy != nil {
-			if policy.Enabled == nil || !*policy.Enabled {
-				log.Printf("[INFO] storage %q is not enabled - removing from state", d.Id())
-				d.SetId("")
-				return nil
+	if model := resp.Model; model != nil && model.Properties != nil {
+		policy := model.Properties.Policy
+		if !policy.Enabled {
+			log.Printf("[INFO] storage %q is not enabled - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		// the destination is set on each of the rules, but since these are all the same we can use the first one
+		storageContainerName := ""
+		if policy.Destination != nil {
+			storageContainerName = *policy.Destination
+		}
+		for _, rule := range policy.Rules {
+			if rule.Destination != "" {
+				storageContainerName = rule.Destination
+				break
 			}
-			d.Set("storage_container_name", policy.Destination)
-			d.Set("rules", flattenBlobInventoryPolicyRules(policy.Rules))
+		}
+		d.Set("storage_container_name", storageContainerName)
+
+		if err := d.Set("rules", flattenBlobInventoryPolicyRules(policy.Rules)); err != nil {
+			return fmt.Errorf("setting `rules`: %+v", err)
 		}
 	}
 	return nil
@@ -197,63 +267,96 @@ func resourceStorageBlobInventoryPolicyDelete(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	if _, err := client.Delete(ctx, id.ResourceGroup, id.StorageAccountName); err != nil {
+	if _, err := client.Delete(ctx, blobinventorypolicies.NewBlobInventoryPolicyID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.InventoryPolicyName)); err != nil {
 		return fmt.Errorf("deleting %q: %+v", id, err)
 	}
 	return nil
 }
 
-func expandBlobInventoryPolicyRules(input []interface{}) *[]storage.BlobInventoryPolicyRule {
-	results := make([]storage.BlobInventoryPolicyRule, 0)
+func expandBlobInventoryPolicyRules(storageContainerName string, input []interface{}) ([]blobinventorypolicies.BlobInventoryPolicyRule, error) {
+	results := make([]blobinventorypolicies.BlobInventoryPolicyRule, 0)
 	for _, item := range input {
 		v := item.(map[string]interface{})
-		results = append(results, storage.BlobInventoryPolicyRule{
-			Enabled: utils.Bool(true),
-			Name:    utils.String(v["name"].(string)),
-			Definition: &storage.BlobInventoryPolicyDefinition{
-				Filters: expandBlobInventoryPolicyFilter(v["filter"].([]interface{})),
+		name := v["name"].(string)
+		objectType := blobinventorypolicies.ObjectType(v["scope"].(string))
+
+		filter := expandBlobInventoryPolicyFilter(v["filter"].([]interface{}))
+		if objectType == blobinventorypolicies.ObjectTypeBlob {
+			if filter == nil || filter.BlobTypes == nil || len(*filter.BlobTypes) == 0 {
+				return nil, fmt.Errorf("`blob_types` must be specified within the `filter` block of the rule %q when the `scope` is %q", name, string(blobinventorypolicies.ObjectTypeBlob))
+			}
+		} else if filter != nil {
+			if (filter.BlobTypes != nil && len(*filter.BlobTypes) > 0) || *filter.IncludeBlobVersions || *filter.IncludeSnapshots {
+				return nil, fmt.Errorf("only `prefix_match` and `exclude_prefixes` can be specified within the `filter` block of the rule %q when the `scope` is %q", name, string(blobinventorypolicies.ObjectTypeContainer))
+			}
+			filter.BlobTypes = nil
+			filter.IncludeBlobVersions = nil
+			filter.IncludeSnapshots = nil
+		}
+
+		schemaFields := *utils.ExpandStringSlice(v["schema_fields"].([]interface{}))
+		if len(schemaFields) == 0 {
+			schemaFields = defaultBlobInventoryPolicySchemaFields(objectType, filter)
+		}
+
+		results = append(results, blobinventorypolicies.BlobInventoryPolicyRule{
+			Enabled:     true,
+			Name:        name,
+			Destination: storageContainerName,
+			Definition: blobinventorypolicies.BlobInventoryPolicyDefinition{
+				Filters:      filter,
+				Format:       blobinventorypolicies.Format(v["format"].(string)),
+				ObjectType:   objectType,
+				Schedule:     blobinventorypolicies.Schedule(v["schedule"].(string)),
+				SchemaFields: schemaFields,
 			},
 		})
 	}
-	return &results
+	return results, nil
 }
 
-func expandBlobInventoryPolicyFilter(input []interface{}) *storage.BlobInventoryPolicyFilter {
-	if len(input) == 0 {
+func expandBlobInventoryPolicyFilter(input []interface{}) *blobinventorypolicies.BlobInventoryPolicyFilter {
+	if len(input) == 0 || input[0] == nil {
 		return nil
 	}
 	v := input[0].(map[string]interface{})
-	return &storage.BlobInventoryPolicyFilter{
+	return &blobinventorypolicies.BlobInventoryPolicyFilter{
 		PrefixMatch:         utils.ExpandStringSlice(v["prefix_match"].(*pluginsdk.Set).List()),
+		ExcludePrefix:       utils.ExpandStringSlice(v["exclude_prefixes"].(*pluginsdk.Set).List()),
 		BlobTypes:           utils.ExpandStringSlice(v["blob_types"].(*pluginsdk.Set).List()),
 		IncludeBlobVersions: utils.Bool(v["include_blob_versions"].(bool)),
 		IncludeSnapshots:    utils.Bool(v["include_snapshots"].(bool)),
 	}
 }
 
-func flattenBlobInventoryPolicyRules(input *[]storage.BlobInventoryPolicyRule) []interface{} {
+func flattenBlobInventoryPolicyRules(input []blobinventorypolicies.BlobInventoryPolicyRule) []interface{} {
 	results := make([]interface{}, 0)
-	if input == nil {
-		return results
-	}
 
-	for _, item := range *input {
-		var name string
-		if item.Name != nil {
-			name = *item.Name
-		}
-		if item.Enabled == nil || !*item.Enabled || item.Definition == nil {
+	for _, item := range input {
+		if !item.Enabled {
 			continue
 		}
+
+		definition := item.Definition
+		schemaFields := definition.SchemaFields
+		// the default schema fields are omitted since these are sent when `schema_fields` isn't specified
+		if blobInventoryPolicySchemaFieldsMatch(schemaFields, defaultBlobInventoryPolicySchemaFields(definition.ObjectType, definition.Filters)) {
+			schemaFields = make([]string, 0)
+		}
+
 		results = append(results, map[string]interface{}{
-			"name":   name,
-			"filter": flattenBlobInventoryPolicyFilter(item.Definition.Filters),
+			"name":          item.Name,
+			"format":        string(definition.Format),
+			"schedule":      string(definition.Schedule),
+			"scope":         string(definition.ObjectType),
+			"schema_fields": utils.FlattenStringSlice(&schemaFields),
+			"filter":        flattenBlobInventoryPolicyFilter(definition.Filters),
 		})
 	}
 	return results
 }
 
-func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter) []interface{} {
+func flattenBlobInventoryPolicyFilter(input *blobinventorypolicies.BlobInventoryPolicyFilter) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
@@ -272,6 +375,39 @@ func flattenBlobInventoryPolicyFilter(input *storage.BlobInventoryPolicyFilter)
 			"include_blob_versions": includeBlobVersions,
 			"include_snapshots":     includeSnapshots,
 			"prefix_match":          utils.FlattenStringSlice(input.PrefixMatch),
+			"exclude_prefixes":      utils.FlattenStringSlice(input.ExcludePrefix),
 		},
 	}
 }
+
+// defaultBlobInventoryPolicySchemaFields returns the fields which are included in the inventory when `schema_fields`
+// isn't specified - the API requires the fields matching the versions and snapshots filters to be (or not be) included
+func defaultBlobInventoryPolicySchemaFields(objectType blobinventorypolicies.ObjectType, filter *blobinventorypolicies.BlobInventoryPolicyFilter) []string {
+	if objectType == blobinventorypolicies.ObjectTypeContainer {
+		return []string{"Name", "Last-Modified"}
+	}
+
+	fields := []string{"Name", "Creation-Time", "Last-Modified", "Content-Length", "BlobType", "AccessTier"}
+	if filter != nil {
+		if filter.IncludeBlobVersions != nil && *filter.IncludeBlobVersions {
+			fields = append(fields, "VersionId", "IsCurrentVersion")
+		}
+		if filter.IncludeSnapshots != nil && *filter.IncludeSnapshots {
+			fields = append(fields, "Snapshot")
+		}
+	}
+	return fields
+}
+
+func blobInventoryPolicySchemaFieldsMatch(first []string, second []string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for _, v := range first {
+		if !utils.SliceContainsValue(second, v) {
+			return false
+		}
+	}
+	return true
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sdk/2021-08-01/blobinventorypolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -92,23 +94,56 @@ func TestAccStorageBlobInventoryPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlobInventoryPolicy_schemaFieldsAndExcludePrefixes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
+	r := StorageBlobInventoryPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.schemaFieldsAndExcludePrefixes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageBlobInventoryPolicy_containerScope(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_inventory_policy", "test")
+	r := StorageBlobInventoryPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.containerScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r StorageBlobInventoryPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.BlobInventoryPolicyID(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := client.Storage.BlobInventoryPoliciesClient.Get(ctx, id.ResourceGroup, id.StorageAccountName)
+	resp, err := client.Storage.BlobInventoryPoliciesClient.Get(ctx, blobinventorypolicies.NewBlobInventoryPolicyID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.InventoryPolicyName))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving %q: %+v", id, err)
 	}
-	if props := resp.BlobInventoryPolicyProperties; props != nil {
-		if policy := props.Policy; policy != nil {
-			if policy.Enabled == nil || !*policy.Enabled {
-				return utils.Bool(false), nil
-			}
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if !model.Properties.Policy.Enabled {
+			return utils.Bool(false), nil
 		}
 	}
 	return utils.Bool(true), nil
@@ -231,3 +266,57 @@ resource "azurerm_storage_blob_inventory_policy" "test" {
 }
 `, template)
 }
+
+func (r StorageBlobInventoryPolicyResource) schemaFieldsAndExcludePrefixes(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_inventory_policy" "test" {
+  storage_account_id     = azurerm_storage_account.test.id
+  storage_container_name = azurerm_storage_container.test.name
+  rules {
+    name     = "rule1"
+    format   = "Parquet"
+    schedule = "Weekly"
+    scope    = "Blob"
+    schema_fields = [
+      "Name",
+      "Last-Modified",
+      "Content-Length",
+      "AccessTier",
+      "VersionId",
+      "IsCurrentVersion",
+      "Tags",
+    ]
+    filter {
+      blob_types            = ["blockBlob"]
+      include_blob_versions = true
+      prefix_match          = ["logs/"]
+      exclude_prefixes      = ["logs/archive/", "logs/tmp/"]
+    }
+  }
+}
+`, template)
+}
+
+func (r StorageBlobInventoryPolicyResource) containerScope(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_inventory_policy" "test" {
+  storage_account_id     = azurerm_storage_account.test.id
+  storage_container_name = azurerm_storage_container.test.name
+  rules {
+    name          = "rule1"
+    scope         = "Container"
+    schema_fields = ["Name", "Last-Modified", "Metadata", "PublicAccess"]
+    filter {
+      prefix_match     = ["vhds"]
+      exclude_prefixes = ["vhds-old"]
+    }
+  }
+}
+`, template)
+}
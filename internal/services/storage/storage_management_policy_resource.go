@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"regexp"
 	"time"
 
@@ -57,7 +59,8 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 						},
 						"enabled": {
 							Type:     pluginsdk.TypeBool,
-							Required: true,
+							Optional: true,
+							Default:  true,
 						},
 						//lintignore:XS003
 						"filters": {
@@ -154,6 +157,29 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 													// for issue https://github.com/hashicorp/terraform-provider-azurerm/issues/6158
 													ValidateFunc: validation.IntBetween(0, 99999),
 												},
+												"tier_to_cool_after_days_since_last_access_time_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"tier_to_archive_after_days_since_last_access_time_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"delete_after_days_since_last_access_time_greater_than": {
+													Type:         pluginsdk.TypeInt,
+													Optional:     true,
+													Default:      -1,
+													ValidateFunc: validation.IntBetween(0, 99999),
+												},
+												"auto_tier_to_hot_from_cool_enabled": {
+													Type:     pluginsdk.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
 											},
 										},
 									},
@@ -251,6 +277,13 @@ func resourceStorageManagementPolicyCreateOrUpdate(d *pluginsdk.ResourceData, me
 		},
 	}
 
+	// rules based on the last access time of a blob are rejected unless last access time tracking is enabled
+	if storageManagementPolicyRulesUseLastAccessTime(armRules) {
+		if err := enableStorageAccountLastAccessTimeTracking(ctx, meta.(*clients.Client).Storage.BlobServicesClient, *rid); err != nil {
+			return err
+		}
+	}
+
 	if _, err := client.CreateOrUpdate(ctx, rid.ResourceGroup, rid.Name, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", mgmtPolicyId, err)
 	}
@@ -306,7 +339,6 @@ func resourceStorageManagementPolicyDelete(d *pluginsdk.ResourceData, meta inter
 	return nil
 }
 
-// nolint unparam
 func expandStorageManagementPolicyRules(d *pluginsdk.ResourceData) (*[]storage.ManagementPolicyRule, error) {
 	var result []storage.ManagementPolicyRule
 
@@ -314,7 +346,10 @@ func expandStorageManagementPolicyRules(d *pluginsdk.ResourceData) (*[]storage.M
 
 	for k, v := range rules {
 		if v != nil {
-			rule := expandStorageManagementPolicyRule(d, k)
+			rule, err := expandStorageManagementPolicyRule(d, k)
+			if err != nil {
+				return nil, err
+			}
 			_, blobIndexExist := d.GetOk(fmt.Sprintf("rule.%d.filters.0.match_blob_index_tag", k))
 			_, snapshotExist := d.GetOk(fmt.Sprintf("rule.%d.actions.0.snapshot", k))
 			_, versionExist := d.GetOk(fmt.Sprintf("rule.%d.actions.0.version", k))
@@ -327,7 +362,7 @@ func expandStorageManagementPolicyRules(d *pluginsdk.ResourceData) (*[]storage.M
 	return &result, nil
 }
 
-func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int) storage.ManagementPolicyRule {
+func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int) (storage.ManagementPolicyRule, error) {
 	name := d.Get(fmt.Sprintf("rule.%d.name", ruleIndex)).(string)
 	enabled := d.Get(fmt.Sprintf("rule.%d.enabled", ruleIndex)).(bool)
 	typeVal := "Lifecycle"
@@ -365,26 +400,22 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 	if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions", ruleIndex)); ok {
 		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob", ruleIndex)); ok {
 			baseBlob := &storage.ManagementPolicyBaseBlob{}
-			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than", ruleIndex)); ok {
-				if v != nil {
-					baseBlob.TierToCool = &storage.DateAfterModification{
-						DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
-					}
-				}
+			var err error
+			if baseBlob.TierToCool, err = expandStorageManagementPolicyBaseBlobAction(d, ruleIndex, "tier_to_cool"); err != nil {
+				return storage.ManagementPolicyRule{}, err
 			}
-			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", ruleIndex)); ok {
-				if v != nil {
-					baseBlob.TierToArchive = &storage.DateAfterModification{
-						DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
-					}
-				}
+			if baseBlob.TierToArchive, err = expandStorageManagementPolicyBaseBlobAction(d, ruleIndex, "tier_to_archive"); err != nil {
+				return storage.ManagementPolicyRule{}, err
 			}
-			if v, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob.0.delete_after_days_since_modification_greater_than", ruleIndex)); ok {
-				if v != nil {
-					baseBlob.Delete = &storage.DateAfterModification{
-						DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
-					}
+			if baseBlob.Delete, err = expandStorageManagementPolicyBaseBlobAction(d, ruleIndex, "delete"); err != nil {
+				return storage.ManagementPolicyRule{}, err
+			}
+
+			if d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.auto_tier_to_hot_from_cool_enabled", ruleIndex)).(bool) {
+				if baseBlob.TierToCool == nil || baseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan == nil {
+					return storage.ManagementPolicyRule{}, fmt.Errorf("`rule.%d.actions.0.base_blob.0.auto_tier_to_hot_from_cool_enabled` can only be `true` when `tier_to_cool_after_days_since_last_access_time_greater_than` is specified", ruleIndex)
 				}
+				baseBlob.EnableAutoTierToHotFromCool = utils.Bool(true)
 			}
 			definition.Actions.BaseBlob = baseBlob
 		}
@@ -435,7 +466,79 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 		Type:       &typeVal,
 		Definition: &definition,
 	}
-	return rule
+	return rule, nil
+}
+
+// expandStorageManagementPolicyBaseBlobAction expands a base blob action, which can be based on either the time since
+// the blob was last modified or the time since it was last accessed - but not both.
+func expandStorageManagementPolicyBaseBlobAction(d *pluginsdk.ResourceData, ruleIndex int, action string) (*storage.DateAfterModification, error) {
+	modificationKey := fmt.Sprintf("%s_after_days_since_modification_greater_than", action)
+	lastAccessTimeKey := fmt.Sprintf("%s_after_days_since_last_access_time_greater_than", action)
+	prefix := fmt.Sprintf("rule.%d.actions.0.base_blob.0.", ruleIndex)
+
+	var result *storage.DateAfterModification
+	if v, ok := d.GetOk(prefix + modificationKey); ok && v != nil {
+		result = &storage.DateAfterModification{
+			DaysAfterModificationGreaterThan: utils.Float(float64(v.(int))),
+		}
+	}
+
+	if v := d.Get(prefix + lastAccessTimeKey).(int); v != -1 {
+		if result != nil {
+			return nil, fmt.Errorf("only one of `%s%s` and `%s%s` can be specified", prefix, modificationKey, prefix, lastAccessTimeKey)
+		}
+		result = &storage.DateAfterModification{
+			DaysAfterLastAccessTimeGreaterThan: utils.Float(float64(v)),
+		}
+	}
+
+	return result, nil
+}
+
+func storageManagementPolicyRulesUseLastAccessTime(rules *[]storage.ManagementPolicyRule) bool {
+	if rules == nil {
+		return false
+	}
+
+	for _, rule := range *rules {
+		if rule.Definition == nil || rule.Definition.Actions == nil || rule.Definition.Actions.BaseBlob == nil {
+			continue
+		}
+
+		baseBlob := rule.Definition.Actions.BaseBlob
+		for _, action := range []*storage.DateAfterModification{baseBlob.TierToCool, baseBlob.TierToArchive, baseBlob.Delete} {
+			if action != nil && action.DaysAfterLastAccessTimeGreaterThan != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func enableStorageAccountLastAccessTimeTracking(ctx context.Context, client *storage.BlobServicesClient, id parse.StorageAccountId) error {
+	props, err := client.GetServiceProperties(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving the Blob Service Properties for %s: %+v", id, err)
+	}
+
+	if v := props.BlobServicePropertiesProperties; v != nil && v.LastAccessTimeTrackingPolicy != nil && v.LastAccessTimeTrackingPolicy.Enable != nil && *v.LastAccessTimeTrackingPolicy.Enable {
+		return nil
+	}
+
+	log.Printf("[DEBUG] enabling last access time tracking for %s", id)
+	if props.BlobServicePropertiesProperties == nil {
+		props.BlobServicePropertiesProperties = &storage.BlobServicePropertiesProperties{}
+	}
+	props.BlobServicePropertiesProperties.LastAccessTimeTrackingPolicy = &storage.LastAccessTimeTrackingPolicy{
+		Enable: utils.Bool(true),
+	}
+
+	if _, err := client.SetServiceProperties(ctx, id.ResourceGroup, id.Name, props); err != nil {
+		return fmt.Errorf("enabling last access time tracking for %s: %+v", id, err)
+	}
+
+	return nil
 }
 
 func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRule) []interface{} {
@@ -483,7 +586,12 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 				action := make(map[string]interface{})
 				armActionBaseBlob := armAction.BaseBlob
 				if armActionBaseBlob != nil {
-					baseBlob := make(map[string]interface{})
+					baseBlob := map[string]interface{}{
+						"tier_to_cool_after_days_since_last_access_time_greater_than":    -1,
+						"tier_to_archive_after_days_since_last_access_time_greater_than": -1,
+						"delete_after_days_since_last_access_time_greater_than":          -1,
+						"auto_tier_to_hot_from_cool_enabled":                             armActionBaseBlob.EnableAutoTierToHotFromCool != nil && *armActionBaseBlob.EnableAutoTierToHotFromCool,
+					}
 					if armActionBaseBlob.TierToCool != nil && armActionBaseBlob.TierToCool.DaysAfterModificationGreaterThan != nil {
 						intTemp := int(*armActionBaseBlob.TierToCool.DaysAfterModificationGreaterThan)
 						baseBlob["tier_to_cool_after_days_since_modification_greater_than"] = intTemp
@@ -496,6 +604,15 @@ func flattenStorageManagementPolicyRules(armRules *[]storage.ManagementPolicyRul
 						intTemp := int(*armActionBaseBlob.Delete.DaysAfterModificationGreaterThan)
 						baseBlob["delete_after_days_since_modification_greater_than"] = intTemp
 					}
+					if armActionBaseBlob.TierToCool != nil && armActionBaseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan != nil {
+						baseBlob["tier_to_cool_after_days_since_last_access_time_greater_than"] = int(*armActionBaseBlob.TierToCool.DaysAfterLastAccessTimeGreaterThan)
+					}
+					if armActionBaseBlob.TierToArchive != nil && armActionBaseBlob.TierToArchive.DaysAfterLastAccessTimeGreaterThan != nil {
+						baseBlob["tier_to_archive_after_days_since_last_access_time_greater_than"] = int(*armActionBaseBlob.TierToArchive.DaysAfterLastAccessTimeGreaterThan)
+					}
+					if armActionBaseBlob.Delete != nil && armActionBaseBlob.Delete.DaysAfterLastAccessTimeGreaterThan != nil {
+						baseBlob["delete_after_days_since_last_access_time_greater_than"] = int(*armActionBaseBlob.Delete.DaysAfterLastAccessTimeGreaterThan)
+					}
 					action["base_blob"] = []interface{}{baseBlob}
 				}
 
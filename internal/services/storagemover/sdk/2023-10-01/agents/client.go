@@ -0,0 +1,15 @@
+package agents
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AgentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAgentsClientWithBaseURI(endpoint string) AgentsClient {
+	return AgentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
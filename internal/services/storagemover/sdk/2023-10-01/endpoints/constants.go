@@ -0,0 +1,124 @@
+package endpoints
+
+import "strings"
+
+type CredentialType string
+
+const (
+	CredentialTypeAzureKeyVaultSmb CredentialType = "AzureKeyVaultSmb"
+)
+
+func PossibleValuesForCredentialType() []string {
+	return []string{
+		string(CredentialTypeAzureKeyVaultSmb),
+	}
+}
+
+func parseCredentialType(input string) (*CredentialType, error) {
+	vals := map[string]CredentialType{
+		"azurekeyvaultsmb": CredentialTypeAzureKeyVaultSmb,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CredentialType(input)
+	return &out, nil
+}
+
+type EndpointType string
+
+const (
+	EndpointTypeAzureStorageBlobContainer EndpointType = "AzureStorageBlobContainer"
+	EndpointTypeNfsMount                  EndpointType = "NfsMount"
+	EndpointTypeSmbMount                  EndpointType = "SmbMount"
+)
+
+func PossibleValuesForEndpointType() []string {
+	return []string{
+		string(EndpointTypeAzureStorageBlobContainer),
+		string(EndpointTypeNfsMount),
+		string(EndpointTypeSmbMount),
+	}
+}
+
+func parseEndpointType(input string) (*EndpointType, error) {
+	vals := map[string]EndpointType{
+		"azurestorageblobcontainer": EndpointTypeAzureStorageBlobContainer,
+		"nfsmount":                  EndpointTypeNfsMount,
+		"smbmount":                  EndpointTypeSmbMount,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := EndpointType(input)
+	return &out, nil
+}
+
+type NfsVersion string
+
+const (
+	NfsVersionNFSauto NfsVersion = "NFSauto"
+	NfsVersionNFSv3   NfsVersion = "NFSv3"
+	NfsVersionNFSv4   NfsVersion = "NFSv4"
+)
+
+func PossibleValuesForNfsVersion() []string {
+	return []string{
+		string(NfsVersionNFSauto),
+		string(NfsVersionNFSv3),
+		string(NfsVersionNFSv4),
+	}
+}
+
+func parseNfsVersion(input string) (*NfsVersion, error) {
+	vals := map[string]NfsVersion{
+		"nfsauto": NfsVersionNFSauto,
+		"nfsv3":   NfsVersionNFSv3,
+		"nfsv4":   NfsVersionNFSv4,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := NfsVersion(input)
+	return &out, nil
+}
+
+type ProvisioningState string
+
+const (
+	ProvisioningStateCanceled  ProvisioningState = "Canceled"
+	ProvisioningStateDeleting  ProvisioningState = "Deleting"
+	ProvisioningStateFailed    ProvisioningState = "Failed"
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+)
+
+func PossibleValuesForProvisioningState() []string {
+	return []string{
+		string(ProvisioningStateCanceled),
+		string(ProvisioningStateDeleting),
+		string(ProvisioningStateFailed),
+		string(ProvisioningStateSucceeded),
+	}
+}
+
+func parseProvisioningState(input string) (*ProvisioningState, error) {
+	vals := map[string]ProvisioningState{
+		"canceled":  ProvisioningStateCanceled,
+		"deleting":  ProvisioningStateDeleting,
+		"failed":    ProvisioningStateFailed,
+		"succeeded": ProvisioningStateSucceeded,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ProvisioningState(input)
+	return &out, nil
+}
@@ -0,0 +1,7 @@
+package endpoints
+
+type AzureKeyVaultSmbCredentials struct {
+	PasswordUri *string        `json:"passwordUri,omitempty"`
+	Type        CredentialType `json:"type"`
+	UsernameUri *string        `json:"usernameUri,omitempty"`
+}
@@ -0,0 +1,41 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Endpoint struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties EndpointBaseProperties `json:"properties"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &Endpoint{}
+
+func (s *Endpoint) UnmarshalJSON(bytes []byte) error {
+	type alias Endpoint
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into Endpoint: %+v", err)
+	}
+
+	s.Id = decoded.Id
+	s.Name = decoded.Name
+	s.Type = decoded.Type
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling Endpoint into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalEndpointBasePropertiesImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'Endpoint': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
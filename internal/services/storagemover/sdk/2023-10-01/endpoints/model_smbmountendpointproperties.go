@@ -0,0 +1,42 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ EndpointBaseProperties = SmbMountEndpointProperties{}
+
+type SmbMountEndpointProperties struct {
+	Credentials       *AzureKeyVaultSmbCredentials `json:"credentials,omitempty"`
+	Description       *string                      `json:"description,omitempty"`
+	Host              string                       `json:"host"`
+	ProvisioningState *ProvisioningState           `json:"provisioningState,omitempty"`
+	ShareName         string                       `json:"shareName"`
+
+	// Fields inherited from EndpointBaseProperties
+}
+
+var _ json.Marshaler = SmbMountEndpointProperties{}
+
+func (s SmbMountEndpointProperties) MarshalJSON() ([]byte, error) {
+	type wrapper SmbMountEndpointProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling SmbMountEndpointProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling SmbMountEndpointProperties: %+v", err)
+	}
+	decoded["endpointType"] = "SmbMount"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling SmbMountEndpointProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
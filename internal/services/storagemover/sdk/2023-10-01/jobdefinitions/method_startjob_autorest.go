@@ -0,0 +1,65 @@
+package jobdefinitions
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type StartJobResponse struct {
+	HttpResponse *http.Response
+	Model        *JobRunResourceId
+}
+
+// StartJob ...
+func (c JobDefinitionsClient) StartJob(ctx context.Context, id JobDefinitionId) (result StartJobResponse, err error) {
+	req, err := c.preparerForStartJob(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "jobdefinitions.JobDefinitionsClient", "StartJob", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "jobdefinitions.JobDefinitionsClient", "StartJob", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForStartJob(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "jobdefinitions.JobDefinitionsClient", "StartJob", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForStartJob prepares the StartJob request.
+func (c JobDefinitionsClient) preparerForStartJob(ctx context.Context, id JobDefinitionId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/startJob", id.ID())),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForStartJob handles the response to the StartJob request. The method always
+// closes the http.Response Body.
+func (c JobDefinitionsClient) responderForStartJob(resp *http.Response) (result StartJobResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
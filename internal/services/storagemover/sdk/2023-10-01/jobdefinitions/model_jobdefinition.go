@@ -0,0 +1,8 @@
+package jobdefinitions
+
+type JobDefinition struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties JobDefinitionProperties `json:"properties"`
+	Type       *string                 `json:"type,omitempty"`
+}
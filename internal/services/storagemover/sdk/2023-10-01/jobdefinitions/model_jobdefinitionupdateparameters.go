@@ -0,0 +1,5 @@
+package jobdefinitions
+
+type JobDefinitionUpdateParameters struct {
+	Properties *JobDefinitionUpdateProperties `json:"properties,omitempty"`
+}
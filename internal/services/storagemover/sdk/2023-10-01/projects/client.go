@@ -0,0 +1,15 @@
+package projects
+
+import "github.com/Azure/go-autorest/autorest"
+
+type ProjectsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewProjectsClientWithBaseURI(endpoint string) ProjectsClient {
+	return ProjectsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package projects
+
+type Project struct {
+	Id         *string            `json:"id,omitempty"`
+	Name       *string            `json:"name,omitempty"`
+	Properties *ProjectProperties `json:"properties,omitempty"`
+	Type       *string            `json:"type,omitempty"`
+}
@@ -0,0 +1,6 @@
+package storagemovers
+
+type StorageMoverProperties struct {
+	Description       *string            `json:"description,omitempty"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,229 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/sdk/2023-10-03-preview/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/agents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverAgentResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageMoverAgentResource{}
+
+type StorageMoverAgentModel struct {
+	Name                  string `tfschema:"name"`
+	StorageMoverId        string `tfschema:"storage_mover_id"`
+	ArcVirtualMachineId   string `tfschema:"arc_virtual_machine_id"`
+	ArcVirtualMachineUuid string `tfschema:"arc_virtual_machine_uuid"`
+	Description           string `tfschema:"description"`
+	AgentVersion          string `tfschema:"agent_version"`
+}
+
+func (r StorageMoverAgentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"storage_mover_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagemovers.ValidateStorageMoverID,
+		},
+
+		// the Agent VM is registered with Azure Arc as part of its setup, which gives it both a Resource ID and a UUID
+		"arc_virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"arc_virtual_machine_uuid": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverAgentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"agent_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageMoverAgentResource) ModelObject() interface{} {
+	return &StorageMoverAgentModel{}
+}
+
+func (r StorageMoverAgentResource) ResourceType() string {
+	return "azurerm_storage_mover_agent"
+}
+
+func (r StorageMoverAgentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return agents.ValidateAgentID
+}
+
+func (r StorageMoverAgentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.AgentsClient
+
+			var model StorageMoverAgentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storageMoverId, err := storagemovers.ParseStorageMoverID(model.StorageMoverId)
+			if err != nil {
+				return err
+			}
+
+			id := agents.NewAgentID(storageMoverId.SubscriptionId, storageMoverId.ResourceGroupName, storageMoverId.StorageMoverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := agents.Agent{
+				Properties: agents.AgentProperties{
+					ArcResourceId: model.ArcVirtualMachineId,
+					ArcVMUuid:     model.ArcVirtualMachineUuid,
+				},
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverAgentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.AgentsClient
+
+			id, err := agents.ParseAgentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverAgentModel{
+				Name:           id.AgentName,
+				StorageMoverId: storagemovers.NewStorageMoverID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.ArcVirtualMachineId = props.ArcResourceId
+				if v, err := machines.ParseMachineIDInsensitively(props.ArcResourceId); err == nil {
+					state.ArcVirtualMachineId = v.ID()
+				}
+				state.ArcVirtualMachineUuid = props.ArcVMUuid
+				state.Description = utils.NormalizeNilableString(props.Description)
+				state.AgentVersion = utils.NormalizeNilableString(props.AgentVersion)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverAgentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.AgentsClient
+
+			id, err := agents.ParseAgentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverAgentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				payload := agents.AgentUpdateParameters{
+					Properties: &agents.AgentUpdateProperties{
+						Description: utils.String(model.Description),
+					},
+				}
+
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverAgentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.AgentsClient
+
+			id, err := agents.ParseAgentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,145 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/agents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// an Agent can only be registered from an Agent VM which has been deployed and connected to Azure Arc ahead of time
+type StorageMoverAgentResource struct {
+	arcMachineId   string
+	arcMachineUuid string
+}
+
+func newStorageMoverAgentResource(t *testing.T) StorageMoverAgentResource {
+	r := StorageMoverAgentResource{
+		arcMachineId:   os.Getenv("ARM_TEST_STORAGE_MOVER_ARC_MACHINE_ID"),
+		arcMachineUuid: os.Getenv("ARM_TEST_STORAGE_MOVER_ARC_MACHINE_UUID"),
+	}
+	if r.arcMachineId == "" || r.arcMachineUuid == "" {
+		t.Skip("Skipping as ARM_TEST_STORAGE_MOVER_ARC_MACHINE_ID and/or ARM_TEST_STORAGE_MOVER_ARC_MACHINE_UUID are not specified")
+	}
+
+	return r
+}
+
+func TestAccStorageMoverAgent_basic(t *testing.T) {
+	r := newStorageMoverAgentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("agent_version").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverAgent_requiresImport(t *testing.T) {
+	r := newStorageMoverAgentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverAgent_update(t *testing.T) {
+	r := newStorageMoverAgentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_agent", "test")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Example Agent"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverAgentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := agents.ParseAgentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StorageMover.AgentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverAgentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sma-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = %q
+  arc_virtual_machine_uuid = %q
+}
+`, StorageMoverResource{}.basic(data), data.RandomInteger, r.arcMachineId, r.arcMachineUuid)
+}
+
+func (r StorageMoverAgentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "import" {
+  name                     = azurerm_storage_mover_agent.test.name
+  storage_mover_id         = azurerm_storage_mover_agent.test.storage_mover_id
+  arc_virtual_machine_id   = azurerm_storage_mover_agent.test.arc_virtual_machine_id
+  arc_virtual_machine_uuid = azurerm_storage_mover_agent.test.arc_virtual_machine_uuid
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverAgentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sma-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = %q
+  arc_virtual_machine_uuid = %q
+  description              = "Example Agent"
+}
+`, StorageMoverResource{}.basic(data), data.RandomInteger, r.arcMachineId, r.arcMachineUuid)
+}
@@ -0,0 +1,223 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverBlobContainerEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageMoverBlobContainerEndpointResource{}
+
+type StorageMoverBlobContainerEndpointModel struct {
+	Name                 string `tfschema:"name"`
+	StorageMoverId       string `tfschema:"storage_mover_id"`
+	StorageAccountId     string `tfschema:"storage_account_id"`
+	StorageContainerName string `tfschema:"storage_container_name"`
+	Description          string `tfschema:"description"`
+}
+
+func (r StorageMoverBlobContainerEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"storage_mover_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagemovers.ValidateStorageMoverID,
+		},
+
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageAccountID,
+		},
+
+		"storage_container_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageContainerName,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverBlobContainerEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageMoverBlobContainerEndpointResource) ModelObject() interface{} {
+	return &StorageMoverBlobContainerEndpointModel{}
+}
+
+func (r StorageMoverBlobContainerEndpointResource) ResourceType() string {
+	return "azurerm_storage_mover_blob_container_endpoint"
+}
+
+func (r StorageMoverBlobContainerEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return endpoints.ValidateEndpointID
+}
+
+func (r StorageMoverBlobContainerEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			var model StorageMoverBlobContainerEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storageMoverId, err := storagemovers.ParseStorageMoverID(model.StorageMoverId)
+			if err != nil {
+				return err
+			}
+
+			id := endpoints.NewEndpointID(storageMoverId.SubscriptionId, storageMoverId.ResourceGroupName, storageMoverId.StorageMoverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, r.expand(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverBlobContainerEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverBlobContainerEndpointModel{
+				Name:           id.EndpointName,
+				StorageMoverId: storagemovers.NewStorageMoverID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props, ok := model.Properties.(endpoints.AzureStorageBlobContainerEndpointProperties)
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected a Blob Container Endpoint but got %T", *id, model.Properties)
+				}
+
+				storageAccountId := props.StorageAccountResourceId
+				if v, err := storageParse.StorageAccountID(storageAccountId); err == nil {
+					storageAccountId = v.ID()
+				}
+				state.StorageAccountId = storageAccountId
+				state.StorageContainerName = props.BlobContainerName
+				state.Description = utils.NormalizeNilableString(props.Description)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverBlobContainerEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverBlobContainerEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, r.expand(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverBlobContainerEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverBlobContainerEndpointResource) expand(model StorageMoverBlobContainerEndpointModel) endpoints.Endpoint {
+	props := endpoints.AzureStorageBlobContainerEndpointProperties{
+		StorageAccountResourceId: model.StorageAccountId,
+		BlobContainerName:        model.StorageContainerName,
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+
+	return endpoints.Endpoint{
+		Properties: props,
+	}
+}
@@ -0,0 +1,155 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverBlobContainerEndpointResource struct{}
+
+func TestAccStorageMoverBlobContainerEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_blob_container_endpoint", "test")
+	r := StorageMoverBlobContainerEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverBlobContainerEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_blob_container_endpoint", "test")
+	r := StorageMoverBlobContainerEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverBlobContainerEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_blob_container_endpoint", "test")
+	r := StorageMoverBlobContainerEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Example Blob Container Endpoint"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverBlobContainerEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := endpoints.ParseEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StorageMover.EndpointsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverBlobContainerEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_blob_container_endpoint" "test" {
+  name                   = "acctest-smbc-%d"
+  storage_mover_id       = azurerm_storage_mover.test.id
+  storage_account_id     = azurerm_storage_account.test.id
+  storage_container_name = azurerm_storage_container.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverBlobContainerEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_blob_container_endpoint" "import" {
+  name                   = azurerm_storage_mover_blob_container_endpoint.test.name
+  storage_mover_id       = azurerm_storage_mover_blob_container_endpoint.test.storage_mover_id
+  storage_account_id     = azurerm_storage_mover_blob_container_endpoint.test.storage_account_id
+  storage_container_name = azurerm_storage_mover_blob_container_endpoint.test.storage_container_name
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverBlobContainerEndpointResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_blob_container_endpoint" "test" {
+  name                   = "acctest-smbc-%d"
+  storage_mover_id       = azurerm_storage_mover.test.id
+  storage_account_id     = azurerm_storage_account.test.id
+  storage_container_name = azurerm_storage_container.test.name
+  description            = "Example Blob Container Endpoint"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (StorageMoverBlobContainerEndpointResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                            = "acctestsa%s"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  account_tier                    = "Standard"
+  account_replication_type        = "LRS"
+  allow_nested_items_to_be_public = false
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctest-cont-%d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, StorageMoverResource{}.basic(data), data.RandomString, data.RandomInteger)
+}
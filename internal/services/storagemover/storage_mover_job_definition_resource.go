@@ -0,0 +1,327 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverJobDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageMoverJobDefinitionResource{}
+
+type StorageMoverJobDefinitionModel struct {
+	Name                  string            `tfschema:"name"`
+	StorageMoverProjectId string            `tfschema:"storage_mover_project_id"`
+	SourceName            string            `tfschema:"source_name"`
+	SourceSubPath         string            `tfschema:"source_sub_path"`
+	TargetName            string            `tfschema:"target_name"`
+	TargetSubPath         string            `tfschema:"target_sub_path"`
+	CopyMode              string            `tfschema:"copy_mode"`
+	AgentName             string            `tfschema:"agent_name"`
+	Description           string            `tfschema:"description"`
+	RunTriggers           map[string]string `tfschema:"run_triggers"`
+	LatestJobRunName      string            `tfschema:"latest_job_run_name"`
+	LatestJobRunStatus    string            `tfschema:"latest_job_run_status"`
+}
+
+func (r StorageMoverJobDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"storage_mover_project_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: projects.ValidateProjectID,
+		},
+
+		"source_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"source_sub_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"target_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"target_sub_path": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"copy_mode": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice(
+				jobdefinitions.PossibleValuesForCopyMode(),
+				false,
+			),
+		},
+
+		"agent_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// a Job Run is started whenever these change, in the same manner as the `triggers` of a `null_resource`
+		"run_triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"latest_job_run_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_job_run_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) ModelObject() interface{} {
+	return &StorageMoverJobDefinitionModel{}
+}
+
+func (r StorageMoverJobDefinitionResource) ResourceType() string {
+	return "azurerm_storage_mover_job_definition"
+}
+
+func (r StorageMoverJobDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobdefinitions.ValidateJobDefinitionID
+}
+
+func (r StorageMoverJobDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			var model StorageMoverJobDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			projectId, err := projects.ParseProjectID(model.StorageMoverProjectId)
+			if err != nil {
+				return err
+			}
+
+			id := jobdefinitions.NewJobDefinitionID(projectId.SubscriptionId, projectId.ResourceGroupName, projectId.StorageMoverName, projectId.ProjectName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := jobdefinitions.JobDefinition{
+				Properties: jobdefinitions.JobDefinitionProperties{
+					CopyMode:   jobdefinitions.CopyMode(model.CopyMode),
+					SourceName: model.SourceName,
+					TargetName: model.TargetName,
+				},
+			}
+
+			if model.SourceSubPath != "" {
+				payload.Properties.SourceSubpath = utils.String(model.SourceSubPath)
+			}
+
+			if model.TargetSubPath != "" {
+				payload.Properties.TargetSubpath = utils.String(model.TargetSubPath)
+			}
+
+			if model.AgentName != "" {
+				payload.Properties.AgentName = utils.String(model.AgentName)
+			}
+
+			if model.Description != "" {
+				payload.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if len(model.RunTriggers) > 0 {
+				if _, err := client.StartJob(ctx, id); err != nil {
+					return fmt.Errorf("starting a Job Run for %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the run triggers only exist within Terraform, so use the value from the config
+			var config StorageMoverJobDefinitionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := StorageMoverJobDefinitionModel{
+				Name:                  id.JobDefinitionName,
+				StorageMoverProjectId: projects.NewProjectID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName, id.ProjectName).ID(),
+				RunTriggers:           config.RunTriggers,
+			}
+
+			if model := resp.Model; model != nil {
+				props := model.Properties
+				state.SourceName = props.SourceName
+				state.SourceSubPath = utils.NormalizeNilableString(props.SourceSubpath)
+				state.TargetName = props.TargetName
+				state.TargetSubPath = utils.NormalizeNilableString(props.TargetSubpath)
+				state.CopyMode = string(props.CopyMode)
+				state.AgentName = utils.NormalizeNilableString(props.AgentName)
+				state.Description = utils.NormalizeNilableString(props.Description)
+				state.LatestJobRunName = utils.NormalizeNilableString(props.LatestJobRunName)
+
+				if props.LatestJobRunStatus != nil {
+					state.LatestJobRunStatus = string(*props.LatestJobRunStatus)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverJobDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("copy_mode", "agent_name", "description") {
+				props := jobdefinitions.JobDefinitionUpdateProperties{}
+
+				if metadata.ResourceData.HasChange("copy_mode") {
+					copyMode := jobdefinitions.CopyMode(model.CopyMode)
+					props.CopyMode = &copyMode
+				}
+
+				if metadata.ResourceData.HasChange("agent_name") {
+					props.AgentName = utils.String(model.AgentName)
+				}
+
+				if metadata.ResourceData.HasChange("description") {
+					props.Description = utils.String(model.Description)
+				}
+
+				payload := jobdefinitions.JobDefinitionUpdateParameters{
+					Properties: &props,
+				}
+
+				if _, err := client.Update(ctx, *id, payload); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("run_triggers") && len(model.RunTriggers) > 0 {
+				if _, err := client.StartJob(ctx, *id); err != nil {
+					return fmt.Errorf("starting a Job Run for %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverJobDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.JobDefinitionsClient
+
+			id, err := jobdefinitions.ParseJobDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
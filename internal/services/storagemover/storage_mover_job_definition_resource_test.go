@@ -0,0 +1,215 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverJobDefinitionResource struct{}
+
+func TestAccStorageMoverJobDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverJobDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverJobDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("copy_mode").HasValue("Mirror"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverJobDefinition_runTriggers(t *testing.T) {
+	agent := newStorageMoverAgentResource(t)
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.runTriggers(data, agent, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("latest_job_run_name").Exists(),
+			),
+		},
+		data.ImportStep("run_triggers"),
+		{
+			Config: r.runTriggers(data, agent, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("latest_job_run_name").Exists(),
+			),
+		},
+		data.ImportStep("run_triggers"),
+	})
+}
+
+func (r StorageMoverJobDefinitionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobdefinitions.ParseJobDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StorageMover.JobDefinitionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverJobDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_definition" "test" {
+  name                     = "acctest-smjd-%d"
+  storage_mover_project_id = azurerm_storage_mover_project.test.id
+  source_name              = azurerm_storage_mover_nfs_mount_endpoint.test.name
+  target_name              = azurerm_storage_mover_blob_container_endpoint.test.name
+  copy_mode                = "Additive"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverJobDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_definition" "import" {
+  name                     = azurerm_storage_mover_job_definition.test.name
+  storage_mover_project_id = azurerm_storage_mover_job_definition.test.storage_mover_project_id
+  source_name              = azurerm_storage_mover_job_definition.test.source_name
+  target_name              = azurerm_storage_mover_job_definition.test.target_name
+  copy_mode                = azurerm_storage_mover_job_definition.test.copy_mode
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverJobDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_definition" "test" {
+  name                     = "acctest-smjd-%d"
+  storage_mover_project_id = azurerm_storage_mover_project.test.id
+  source_name              = azurerm_storage_mover_nfs_mount_endpoint.test.name
+  target_name              = azurerm_storage_mover_blob_container_endpoint.test.name
+  copy_mode                = "Mirror"
+  description              = "Example Job Definition"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverJobDefinitionResource) runTriggers(data acceptance.TestData, agent StorageMoverAgentResource, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_agent" "test" {
+  name                     = "acctest-sma-%d"
+  storage_mover_id         = azurerm_storage_mover.test.id
+  arc_virtual_machine_id   = %q
+  arc_virtual_machine_uuid = %q
+}
+
+resource "azurerm_storage_mover_job_definition" "test" {
+  name                     = "acctest-smjd-%d"
+  storage_mover_project_id = azurerm_storage_mover_project.test.id
+  source_name              = azurerm_storage_mover_nfs_mount_endpoint.test.name
+  source_sub_path          = "data"
+  target_name              = azurerm_storage_mover_blob_container_endpoint.test.name
+  target_sub_path          = "data"
+  copy_mode                = "Additive"
+  agent_name               = azurerm_storage_mover_agent.test.name
+
+  run_triggers = {
+    run = %q
+  }
+}
+`, r.template(data), data.RandomInteger, agent.arcMachineId, agent.arcMachineUuid, data.RandomInteger, trigger)
+}
+
+func (r StorageMoverJobDefinitionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_project" "test" {
+  name             = "acctest-smp-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+}
+
+resource "azurerm_storage_mover_nfs_mount_endpoint" "test" {
+  name             = "acctest-smnfs-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  host             = "192.168.0.1"
+  export           = "/"
+}
+
+resource "azurerm_storage_mover_blob_container_endpoint" "test" {
+  name                   = "acctest-smbc-%d"
+  storage_mover_id       = azurerm_storage_mover.test.id
+  storage_account_id     = azurerm_storage_account.test.id
+  storage_container_name = azurerm_storage_container.test.name
+}
+`, StorageMoverBlobContainerEndpointResource{}.template(data), data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,237 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverNfsMountEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageMoverNfsMountEndpointResource{}
+
+type StorageMoverNfsMountEndpointModel struct {
+	Name           string `tfschema:"name"`
+	StorageMoverId string `tfschema:"storage_mover_id"`
+	Host           string `tfschema:"host"`
+	Export         string `tfschema:"export"`
+	NfsVersion     string `tfschema:"nfs_version"`
+	Description    string `tfschema:"description"`
+}
+
+func (r StorageMoverNfsMountEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"storage_mover_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagemovers.ValidateStorageMoverID,
+		},
+
+		"host": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"export": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"nfs_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(endpoints.NfsVersionNFSauto),
+			ValidateFunc: validation.StringInSlice(
+				endpoints.PossibleValuesForNfsVersion(),
+				false,
+			),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverNfsMountEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageMoverNfsMountEndpointResource) ModelObject() interface{} {
+	return &StorageMoverNfsMountEndpointModel{}
+}
+
+func (r StorageMoverNfsMountEndpointResource) ResourceType() string {
+	return "azurerm_storage_mover_nfs_mount_endpoint"
+}
+
+func (r StorageMoverNfsMountEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return endpoints.ValidateEndpointID
+}
+
+func (r StorageMoverNfsMountEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			var model StorageMoverNfsMountEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storageMoverId, err := storagemovers.ParseStorageMoverID(model.StorageMoverId)
+			if err != nil {
+				return err
+			}
+
+			id := endpoints.NewEndpointID(storageMoverId.SubscriptionId, storageMoverId.ResourceGroupName, storageMoverId.StorageMoverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, r.expand(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverNfsMountEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverNfsMountEndpointModel{
+				Name:           id.EndpointName,
+				StorageMoverId: storagemovers.NewStorageMoverID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props, ok := model.Properties.(endpoints.NfsMountEndpointProperties)
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected an NFS Mount Endpoint but got %T", *id, model.Properties)
+				}
+
+				state.Host = props.Host
+				state.Export = props.Export
+				state.Description = utils.NormalizeNilableString(props.Description)
+
+				nfsVersion := string(endpoints.NfsVersionNFSauto)
+				if props.NfsVersion != nil {
+					nfsVersion = string(*props.NfsVersion)
+				}
+				state.NfsVersion = nfsVersion
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverNfsMountEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverNfsMountEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, r.expand(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverNfsMountEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverNfsMountEndpointResource) expand(model StorageMoverNfsMountEndpointModel) endpoints.Endpoint {
+	nfsVersion := endpoints.NfsVersion(model.NfsVersion)
+	props := endpoints.NfsMountEndpointProperties{
+		Host:       model.Host,
+		Export:     model.Export,
+		NfsVersion: &nfsVersion,
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+
+	return endpoints.Endpoint{
+		Properties: props,
+	}
+}
@@ -0,0 +1,134 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverNfsMountEndpointResource struct{}
+
+func TestAccStorageMoverNfsMountEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_nfs_mount_endpoint", "test")
+	r := StorageMoverNfsMountEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverNfsMountEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_nfs_mount_endpoint", "test")
+	r := StorageMoverNfsMountEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverNfsMountEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_nfs_mount_endpoint", "test")
+	r := StorageMoverNfsMountEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Example NFS Mount Endpoint"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverNfsMountEndpointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := endpoints.ParseEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.StorageMover.EndpointsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverNfsMountEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_nfs_mount_endpoint" "test" {
+  name             = "acctest-smnfs-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  host             = "192.168.0.1"
+  export           = "/"
+}
+`, StorageMoverResource{}.basic(data), data.RandomInteger)
+}
+
+func (r StorageMoverNfsMountEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_nfs_mount_endpoint" "import" {
+  name             = azurerm_storage_mover_nfs_mount_endpoint.test.name
+  storage_mover_id = azurerm_storage_mover_nfs_mount_endpoint.test.storage_mover_id
+  host             = azurerm_storage_mover_nfs_mount_endpoint.test.host
+  export           = azurerm_storage_mover_nfs_mount_endpoint.test.export
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverNfsMountEndpointResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_nfs_mount_endpoint" "test" {
+  name             = "acctest-smnfs-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  host             = "192.168.0.1"
+  export           = "/"
+  description      = "Example NFS Mount Endpoint"
+}
+`, StorageMoverResource{}.basic(data), data.RandomInteger)
+}
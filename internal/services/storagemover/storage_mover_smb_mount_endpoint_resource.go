@@ -0,0 +1,248 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverSmbMountEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageMoverSmbMountEndpointResource{}
+
+type StorageMoverSmbMountEndpointModel struct {
+	Name                     string `tfschema:"name"`
+	StorageMoverId           string `tfschema:"storage_mover_id"`
+	Host                     string `tfschema:"host"`
+	ShareName                string `tfschema:"share_name"`
+	UsernameKeyVaultSecretId string `tfschema:"username_key_vault_secret_id"`
+	PasswordKeyVaultSecretId string `tfschema:"password_key_vault_secret_id"`
+	Description              string `tfschema:"description"`
+}
+
+func (r StorageMoverSmbMountEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageMoverName,
+		},
+
+		"storage_mover_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagemovers.ValidateStorageMoverID,
+		},
+
+		"host": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"share_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// the credentials used to mount the share are read by the Agent from Key Vault Secrets, rather than being sent to the API
+		"username_key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			RequiredWith: []string{"password_key_vault_secret_id"},
+		},
+
+		"password_key_vault_secret_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			RequiredWith: []string{"username_key_vault_secret_id"},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverSmbMountEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageMoverSmbMountEndpointResource) ModelObject() interface{} {
+	return &StorageMoverSmbMountEndpointModel{}
+}
+
+func (r StorageMoverSmbMountEndpointResource) ResourceType() string {
+	return "azurerm_storage_mover_smb_mount_endpoint"
+}
+
+func (r StorageMoverSmbMountEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return endpoints.ValidateEndpointID
+}
+
+func (r StorageMoverSmbMountEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			var model StorageMoverSmbMountEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			storageMoverId, err := storagemovers.ParseStorageMoverID(model.StorageMoverId)
+			if err != nil {
+				return err
+			}
+
+			id := endpoints.NewEndpointID(storageMoverId.SubscriptionId, storageMoverId.ResourceGroupName, storageMoverId.StorageMoverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, r.expand(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverSmbMountEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverSmbMountEndpointModel{
+				Name:           id.EndpointName,
+				StorageMoverId: storagemovers.NewStorageMoverID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				props, ok := model.Properties.(endpoints.SmbMountEndpointProperties)
+				if !ok {
+					return fmt.Errorf("retrieving %s: expected an SMB Mount Endpoint but got %T", *id, model.Properties)
+				}
+
+				state.Host = props.Host
+				state.ShareName = props.ShareName
+				state.Description = utils.NormalizeNilableString(props.Description)
+
+				if creds := props.Credentials; creds != nil {
+					state.UsernameKeyVaultSecretId = utils.NormalizeNilableString(creds.UsernameUri)
+					state.PasswordKeyVaultSecretId = utils.NormalizeNilableString(creds.PasswordUri)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverSmbMountEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverSmbMountEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, r.expand(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverSmbMountEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverSmbMountEndpointResource) expand(model StorageMoverSmbMountEndpointModel) endpoints.Endpoint {
+	props := endpoints.SmbMountEndpointProperties{
+		Host:      model.Host,
+		ShareName: model.ShareName,
+	}
+
+	if model.UsernameKeyVaultSecretId != "" {
+		props.Credentials = &endpoints.AzureKeyVaultSmbCredentials{
+			Type:        endpoints.CredentialTypeAzureKeyVaultSmb,
+			UsernameUri: utils.String(model.UsernameKeyVaultSecretId),
+			PasswordUri: utils.String(model.PasswordKeyVaultSecretId),
+		}
+	}
+
+	if model.Description != "" {
+		props.Description = utils.String(model.Description)
+	}
+
+	return endpoints.Endpoint{
+		Properties: props,
+	}
+}
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// StorageMoverName validates the name of a Storage Mover and of the Agents, Endpoints, Projects and
+// Job Definitions within it, which all share the same rules
+func StorageMoverName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[0-9a-zA-Z][-_0-9a-zA-Z]{0,63}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 64 characters, start with a letter or number and can only contain letters, numbers, hyphens and underscores, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStorageMoverName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "-mover",
+			Valid: false,
+		},
+		{
+			Input: "_mover",
+			Valid: false,
+		},
+		{
+			Input: "storage-mover_1",
+			Valid: true,
+		},
+		{
+			Input: "storage.mover",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 64),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 65),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := StorageMoverName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
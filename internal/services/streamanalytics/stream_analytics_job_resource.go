@@ -146,6 +146,48 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 				},
 			},
 
+			"content_storage_policy": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(streamanalytics.ContentStoragePolicySystemAccount),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamanalytics.ContentStoragePolicySystemAccount),
+					string(streamanalytics.ContentStoragePolicyJobStorageAccount),
+				}, false),
+			},
+
+			"job_storage_account": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"authentication_mode": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(streamanalytics.ConnectionString),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(streamanalytics.Msi),
+								string(streamanalytics.ConnectionString),
+							}, false),
+						},
+
+						"account_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"account_key": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"job_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -233,6 +275,17 @@ func resourceStreamAnalyticsJobCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		props.Identity = expandStreamAnalyticsJobIdentity(identity.([]interface{}))
 	}
 
+	contentStoragePolicy := d.Get("content_storage_policy").(string)
+	jobStorageAccount := expandStreamAnalyticsJobStorageAccount(d.Get("job_storage_account").([]interface{}))
+	if contentStoragePolicy == string(streamanalytics.ContentStoragePolicyJobStorageAccount) && jobStorageAccount == nil {
+		return fmt.Errorf("`job_storage_account` must be specified when `content_storage_policy` is `JobStorageAccount`")
+	}
+	if jobStorageAccount != nil && jobStorageAccount.AuthenticationMode == streamanalytics.ConnectionString && jobStorageAccount.AccountKey == nil {
+		return fmt.Errorf("`job_storage_account.0.account_key` must be specified when `job_storage_account.0.authentication_mode` is `ConnectionString`")
+	}
+	props.StreamingJobProperties.ContentStoragePolicy = streamanalytics.ContentStoragePolicy(contentStoragePolicy)
+	props.StreamingJobProperties.JobStorageAccount = jobStorageAccount
+
 	if d.IsNewResource() {
 		props.StreamingJobProperties.Transformation = &transformation
 
@@ -313,6 +366,16 @@ func resourceStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("events_out_of_order_policy", string(props.EventsOutOfOrderPolicy))
 		d.Set("output_error_policy", string(props.OutputErrorPolicy))
 
+		contentStoragePolicy := string(streamanalytics.ContentStoragePolicySystemAccount)
+		if props.ContentStoragePolicy != "" {
+			contentStoragePolicy = string(props.ContentStoragePolicy)
+		}
+		d.Set("content_storage_policy", contentStoragePolicy)
+
+		if err := d.Set("job_storage_account", flattenStreamAnalyticsJobStorageAccount(d, props.JobStorageAccount)); err != nil {
+			return fmt.Errorf("setting `job_storage_account`: %v", err)
+		}
+
 		// Computed
 		d.Set("job_id", props.JobID)
 
@@ -384,3 +447,46 @@ func flattenStreamAnalyticsJobIdentity(identity *streamanalytics.Identity) []int
 		},
 	}
 }
+
+func expandStreamAnalyticsJobStorageAccount(input []interface{}) *streamanalytics.JobStorageAccount {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := streamanalytics.JobStorageAccount{
+		AuthenticationMode: streamanalytics.AuthenticationMode(v["authentication_mode"].(string)),
+		AccountName:        utils.String(v["account_name"].(string)),
+	}
+
+	if accountKey := v["account_key"].(string); accountKey != "" {
+		result.AccountKey = utils.String(accountKey)
+	}
+
+	return &result
+}
+
+func flattenStreamAnalyticsJobStorageAccount(d *pluginsdk.ResourceData, input *streamanalytics.JobStorageAccount) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	authenticationMode := string(streamanalytics.ConnectionString)
+	if input.AuthenticationMode != "" {
+		authenticationMode = string(input.AuthenticationMode)
+	}
+
+	var accountName string
+	if input.AccountName != nil {
+		accountName = *input.AccountName
+	}
+
+	// the account key isn't returned by the API so we look it up from the config
+	return []interface{}{
+		map[string]interface{}{
+			"authentication_mode": authenticationMode,
+			"account_name":        accountName,
+			"account_key":         d.Get("job_storage_account.0.account_key").(string),
+		},
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func importStreamAnalyticsOutput(expectType streamanalytics.TypeBasicOutputDataSource) pluginsdk.ImporterFunc {
@@ -63,3 +64,24 @@ func importStreamAnalyticsOutput(expectType streamanalytics.TypeBasicOutputDataS
 		return []*pluginsdk.ResourceData{d}, nil
 	}
 }
+
+func schemaStreamAnalyticsOutputAuthenticationMode() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Default:  string(streamanalytics.ConnectionString),
+		ValidateFunc: validation.StringInSlice([]string{
+			string(streamanalytics.Msi),
+			string(streamanalytics.ConnectionString),
+		}, false),
+	}
+}
+
+func flattenStreamAnalyticsOutputAuthenticationMode(input streamanalytics.AuthenticationMode) string {
+	// outputs created prior to the introduction of Managed Identity support don't return this value
+	if input == "" {
+		return string(streamanalytics.ConnectionString)
+	}
+
+	return string(input)
+}
@@ -66,7 +66,7 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 
 			"storage_account_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
@@ -101,6 +101,8 @@ func resourceStreamAnalyticsOutputBlob() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.FloatBetween(0, 10000),
 			},
+
+			"authentication_mode": schemaStreamAnalyticsOutputAuthenticationMode(),
 		},
 	}
 }
@@ -131,6 +133,11 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 	storageAccountKey := d.Get("storage_account_key").(string)
 	storageAccountName := d.Get("storage_account_name").(string)
 	timeFormat := d.Get("time_format").(string)
+	authenticationMode := streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string))
+
+	if authenticationMode == streamanalytics.ConnectionString && storageAccountKey == "" {
+		return fmt.Errorf("`storage_account_key` must be specified when `authentication_mode` is `ConnectionString`")
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -144,6 +151,7 @@ func resourceStreamAnalyticsOutputBlobCreateUpdate(d *pluginsdk.ResourceData, me
 			Datasource: &streamanalytics.BlobOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftStorageBlob,
 				BlobOutputDataSourceProperties: &streamanalytics.BlobOutputDataSourceProperties{
+					AuthenticationMode: authenticationMode,
 					StorageAccounts: &[]streamanalytics.StorageAccount{
 						{
 							AccountKey:  utils.String(storageAccountKey),
@@ -222,6 +230,7 @@ func resourceStreamAnalyticsOutputBlobRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("path_pattern", v.PathPattern)
 		d.Set("storage_container_name", v.Container)
 		d.Set("time_format", v.TimeFormat)
+		d.Set("authentication_mode", flattenStreamAnalyticsOutputAuthenticationMode(v.AuthenticationMode))
 
 		if accounts := v.StorageAccounts; accounts != nil && len(*accounts) > 0 {
 			account := (*accounts)[0]
@@ -74,6 +74,22 @@ func TestAccStreamAnalyticsOutputBlob_parquet(t *testing.T) {
 	})
 }
 
+func TestAccStreamAnalyticsOutputBlob_authenticationModeMsi(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_blob", "test")
+	r := StreamAnalyticsOutputBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.authenticationModeMsi(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authentication_mode").HasValue("Msi"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStreamAnalyticsOutputBlob_update(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_output_blob", "test")
 	r := StreamAnalyticsOutputBlobResource{}
@@ -223,6 +239,74 @@ resource "azurerm_stream_analytics_output_blob" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r StreamAnalyticsOutputBlobResource) authenticationModeMsi(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "example"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                = "acctestjob-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  streaming_units     = 3
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [YourOutputAlias]
+    FROM [YourInputAlias]
+QUERY
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_stream_analytics_job.test.identity.0.principal_id
+}
+
+resource "azurerm_stream_analytics_output_blob" "test" {
+  name                      = "acctestoutput-%[1]d"
+  stream_analytics_job_name = azurerm_stream_analytics_job.test.name
+  resource_group_name       = azurerm_stream_analytics_job.test.resource_group_name
+  storage_account_name      = azurerm_storage_account.test.name
+  storage_container_name    = azurerm_storage_container.test.name
+  path_pattern              = "some-pattern"
+  date_format               = "yyyy-MM-dd"
+  time_format               = "HH"
+  authentication_mode       = "Msi"
+
+  serialization {
+    type = "Avro"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StreamAnalyticsOutputBlobResource) updated(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -66,14 +66,14 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -92,6 +92,8 @@ func resourceStreamAnalyticsOutputEventHub() *pluginsdk.Resource {
 			},
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsOutputAuthenticationMode(),
 		},
 	}
 }
@@ -120,6 +122,11 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 	serviceBusNamespace := d.Get("servicebus_namespace").(string)
 	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
 	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
+	authenticationMode := streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string))
+
+	if authenticationMode == streamanalytics.ConnectionString && (sharedAccessPolicyKey == "" || sharedAccessPolicyName == "") {
+		return fmt.Errorf("`shared_access_policy_key` and `shared_access_policy_name` must be specified when `authentication_mode` is `ConnectionString`")
+	}
 	propertyColumns := d.Get("property_columns").([]interface{})
 	partitionKey := d.Get("partition_key").(string)
 
@@ -135,6 +142,7 @@ func resourceStreamAnalyticsOutputEventHubCreateUpdate(d *pluginsdk.ResourceData
 			Datasource: &streamanalytics.EventHubOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftServiceBusEventHub,
 				EventHubOutputDataSourceProperties: &streamanalytics.EventHubOutputDataSourceProperties{
+					AuthenticationMode:     authenticationMode,
 					EventHubName:           utils.String(eventHubName),
 					ServiceBusNamespace:    utils.String(serviceBusNamespace),
 					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
@@ -194,6 +202,7 @@ func resourceStreamAnalyticsOutputEventHubRead(d *pluginsdk.ResourceData, meta i
 		d.Set("eventhub_name", v.EventHubName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsOutputAuthenticationMode(v.AuthenticationMode))
 		d.Set("property_columns", v.PropertyColumns)
 		d.Set("partition_key", v.PartitionKey)
 
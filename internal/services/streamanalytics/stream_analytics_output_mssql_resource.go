@@ -79,17 +79,19 @@ func resourceStreamAnalyticsOutputSql() *pluginsdk.Resource {
 
 			"user": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"password": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"authentication_mode": schemaStreamAnalyticsOutputAuthenticationMode(),
 		},
 	}
 }
@@ -117,6 +119,11 @@ func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, met
 	tableName := d.Get("table").(string)
 	sqlUser := d.Get("user").(string)
 	sqlUserPassword := d.Get("password").(string)
+	authenticationMode := streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string))
+
+	if authenticationMode == streamanalytics.ConnectionString && (sqlUser == "" || sqlUserPassword == "") {
+		return fmt.Errorf("`user` and `password` must be specified when `authentication_mode` is `ConnectionString`")
+	}
 
 	props := streamanalytics.Output{
 		Name: utils.String(id.Name),
@@ -124,11 +131,12 @@ func resourceStreamAnalyticsOutputSqlCreateUpdate(d *pluginsdk.ResourceData, met
 			Datasource: &streamanalytics.AzureSQLDatabaseOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftSQLServerDatabase,
 				AzureSQLDatabaseOutputDataSourceProperties: &streamanalytics.AzureSQLDatabaseOutputDataSourceProperties{
-					Server:   utils.String(server),
-					Database: utils.String(databaseName),
-					User:     utils.String(sqlUser),
-					Password: utils.String(sqlUserPassword),
-					Table:    utils.String(tableName),
+					AuthenticationMode: authenticationMode,
+					Server:             utils.String(server),
+					Database:           utils.String(databaseName),
+					User:               utils.String(sqlUser),
+					Password:           utils.String(sqlUserPassword),
+					Table:              utils.String(tableName),
 				},
 			},
 		},
@@ -182,6 +190,7 @@ func resourceStreamAnalyticsOutputSqlRead(d *pluginsdk.ResourceData, meta interf
 		d.Set("database", v.Database)
 		d.Set("table", v.Table)
 		d.Set("user", v.User)
+		d.Set("authentication_mode", flattenStreamAnalyticsOutputAuthenticationMode(v.AuthenticationMode))
 	}
 
 	return nil
@@ -66,18 +66,20 @@ func resourceStreamAnalyticsOutputServiceBusQueue() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsOutputAuthenticationMode(),
 		},
 	}
 }
@@ -106,6 +108,11 @@ func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.Resou
 	serviceBusNamespace := d.Get("servicebus_namespace").(string)
 	sharedAccessPolicyKey := d.Get("shared_access_policy_key").(string)
 	sharedAccessPolicyName := d.Get("shared_access_policy_name").(string)
+	authenticationMode := streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string))
+
+	if authenticationMode == streamanalytics.ConnectionString && (sharedAccessPolicyKey == "" || sharedAccessPolicyName == "") {
+		return fmt.Errorf("`shared_access_policy_key` and `shared_access_policy_name` must be specified when `authentication_mode` is `ConnectionString`")
+	}
 
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
@@ -119,6 +126,7 @@ func resourceStreamAnalyticsOutputServiceBusQueueCreateUpdate(d *pluginsdk.Resou
 			Datasource: &streamanalytics.ServiceBusQueueOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftServiceBusQueue,
 				ServiceBusQueueOutputDataSourceProperties: &streamanalytics.ServiceBusQueueOutputDataSourceProperties{
+					AuthenticationMode:     authenticationMode,
 					QueueName:              utils.String(queueName),
 					ServiceBusNamespace:    utils.String(serviceBusNamespace),
 					SharedAccessPolicyKey:  utils.String(sharedAccessPolicyKey),
@@ -177,6 +185,7 @@ func resourceStreamAnalyticsOutputServiceBusQueueRead(d *pluginsdk.ResourceData,
 		d.Set("queue_name", v.QueueName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsOutputAuthenticationMode(v.AuthenticationMode))
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
 			return fmt.Errorf("setting `serialization`: %+v", err)
@@ -66,14 +66,14 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 
 			"shared_access_policy_key": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"shared_access_policy_name": {
 				Type:         pluginsdk.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -87,6 +87,8 @@ func resourceStreamAnalyticsOutputServiceBusTopic() *pluginsdk.Resource {
 			},
 
 			"serialization": schemaStreamAnalyticsOutputSerialization(),
+
+			"authentication_mode": schemaStreamAnalyticsOutputAuthenticationMode(),
 		},
 	}
 }
@@ -113,6 +115,10 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.Resou
 		}
 	}
 
+	if d.Get("authentication_mode").(string) == string(streamanalytics.ConnectionString) && (d.Get("shared_access_policy_key").(string) == "" || d.Get("shared_access_policy_name").(string) == "") {
+		return fmt.Errorf("`shared_access_policy_key` and `shared_access_policy_name` must be specified when `authentication_mode` is `ConnectionString`")
+	}
+
 	serializationRaw := d.Get("serialization").([]interface{})
 	serialization, err := expandStreamAnalyticsOutputSerialization(serializationRaw)
 	if err != nil {
@@ -125,6 +131,7 @@ func resourceStreamAnalyticsOutputServiceBusTopicCreateUpdate(d *pluginsdk.Resou
 			Datasource: &streamanalytics.ServiceBusTopicOutputDataSource{
 				Type: streamanalytics.TypeMicrosoftServiceBusTopic,
 				ServiceBusTopicOutputDataSourceProperties: &streamanalytics.ServiceBusTopicOutputDataSourceProperties{
+					AuthenticationMode:     streamanalytics.AuthenticationMode(d.Get("authentication_mode").(string)),
 					TopicName:              utils.String(d.Get("topic_name").(string)),
 					ServiceBusNamespace:    utils.String(d.Get("servicebus_namespace").(string)),
 					SharedAccessPolicyKey:  utils.String(d.Get("shared_access_policy_key").(string)),
@@ -183,6 +190,7 @@ func resourceStreamAnalyticsOutputServiceBusTopicRead(d *pluginsdk.ResourceData,
 		d.Set("topic_name", v.TopicName)
 		d.Set("servicebus_namespace", v.ServiceBusNamespace)
 		d.Set("shared_access_policy_name", v.SharedAccessPolicyName)
+		d.Set("authentication_mode", flattenStreamAnalyticsOutputAuthenticationMode(v.AuthenticationMode))
 		d.Set("property_columns", v.PropertyColumns)
 
 		if err := d.Set("serialization", flattenStreamAnalyticsOutputSerialization(props.Serialization)); err != nil {
@@ -1,8 +1,11 @@
 package synapse
 
 import (
+	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
+	"os"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/synapse/mgmt/2021-03-01/synapse"
@@ -36,6 +39,8 @@ func resourceSynapseSparkPool() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(synapseSparkPoolCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -182,13 +187,52 @@ func resourceSynapseSparkPool() *pluginsdk.Resource {
 					Schema: map[string]*pluginsdk.Schema{
 						"content": {
 							Type:     pluginsdk.TypeString,
-							Required: true,
+							Optional: true,
+							Computed: true,
 						},
 
 						"filename": {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 						},
+
+						"source": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"custom_library": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.WorkspacePackageName,
+						},
+
+						"path": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"container_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
 					},
 				},
 			},
@@ -275,7 +319,7 @@ func resourceSynapseSparkPoolCreate(d *pluginsdk.ResourceData, meta interface{})
 
 	d.SetId(id.ID())
 
-	// Library Requirements can't be specified on Create so we'll call update after we've confirmed the Spark Pool has been created.
+	// Library Requirements and Custom Libraries can't be specified on Create so we'll call update after we've confirmed the Spark Pool has been created.
 	return resourceSynapseSparkPoolUpdate(d, meta)
 }
 
@@ -309,9 +353,12 @@ func resourceSynapseSparkPoolRead(d *pluginsdk.ResourceData, meta interface{}) e
 		if err := d.Set("auto_scale", flattenArmSparkPoolAutoScaleProperties(props.AutoScale)); err != nil {
 			return fmt.Errorf("setting `auto_scale`: %+v", err)
 		}
-		if err := d.Set("library_requirement", flattenArmSparkPoolLibraryRequirements(props.LibraryRequirements)); err != nil {
+		if err := d.Set("library_requirement", flattenArmSparkPoolLibraryRequirements(props.LibraryRequirements, d.Get("library_requirement").([]interface{}))); err != nil {
 			return fmt.Errorf("setting `library_requirement`: %+v", err)
 		}
+		if err := d.Set("custom_library", flattenArmSparkPoolCustomLibraries(props.CustomLibraries)); err != nil {
+			return fmt.Errorf("setting `custom_library`: %+v", err)
+		}
 		d.Set("cache_size", props.CacheSize)
 		d.Set("compute_isolation_enabled", props.IsComputeIsolationEnabled)
 
@@ -347,6 +394,11 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("reading Synapse workspace %q (Workspace %q / Resource Group %q): %+v", id.WorkspaceName, id.WorkspaceName, id.ResourceGroup, err)
 	}
 
+	libraryRequirements, err := expandArmSparkPoolLibraryRequirements(d.Get("library_requirement").([]interface{}))
+	if err != nil {
+		return err
+	}
+
 	autoScale := expandArmSparkPoolAutoScaleProperties(d.Get("auto_scale").([]interface{}))
 	bigDataPoolInfo := synapse.BigDataPoolResourceInfo{
 		Location: workspace.Location,
@@ -354,12 +406,13 @@ func resourceSynapseSparkPoolUpdate(d *pluginsdk.ResourceData, meta interface{})
 			AutoPause:                 expandArmSparkPoolAutoPauseProperties(d.Get("auto_pause").([]interface{})),
 			AutoScale:                 autoScale,
 			CacheSize:                 utils.Int32(int32(d.Get("cache_size").(int))),
+			CustomLibraries:           expandArmSparkPoolCustomLibraries(d.Get("custom_library").(*pluginsdk.Set).List()),
 			IsComputeIsolationEnabled: utils.Bool(d.Get("compute_isolation_enabled").(bool)),
 			DynamicExecutorAllocation: &synapse.DynamicExecutorAllocation{
 				Enabled: utils.Bool(d.Get("dynamic_executor_allocation_enabled").(bool)),
 			},
 			DefaultSparkLogFolder:       utils.String(d.Get("spark_log_folder").(string)),
-			LibraryRequirements:         expandArmSparkPoolLibraryRequirements(d.Get("library_requirement").([]interface{})),
+			LibraryRequirements:         libraryRequirements,
 			NodeSize:                    synapse.NodeSize(d.Get("node_size").(string)),
 			NodeSizeFamily:              synapse.NodeSizeFamily(d.Get("node_size_family").(string)),
 			SessionLevelPackagesEnabled: utils.Bool(d.Get("session_level_packages_enabled").(bool)),
@@ -435,15 +488,42 @@ func expandArmSparkPoolAutoScaleProperties(input []interface{}) *synapse.AutoSca
 	}
 }
 
-func expandArmSparkPoolLibraryRequirements(input []interface{}) *synapse.LibraryRequirements {
+func expandArmSparkPoolLibraryRequirements(input []interface{}) (*synapse.LibraryRequirements, error) {
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return nil, nil
 	}
 	v := input[0].(map[string]interface{})
+
+	content := v["content"].(string)
+	if source := v["source"].(string); source != "" {
+		contents, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("reading `library_requirement.0.source` %q: %+v", source, err)
+		}
+		content = string(contents)
+	}
+	if content == "" {
+		return nil, fmt.Errorf("one of `library_requirement.0.content` or `library_requirement.0.source` must be specified")
+	}
+
 	return &synapse.LibraryRequirements{
-		Content:  utils.String(v["content"].(string)),
+		Content:  utils.String(content),
 		Filename: utils.String(v["filename"].(string)),
-	}
+	}, nil
+}
+
+func expandArmSparkPoolCustomLibraries(input []interface{}) *[]synapse.LibraryInfo {
+	results := make([]synapse.LibraryInfo, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, synapse.LibraryInfo{
+			Name:          utils.String(v["name"].(string)),
+			Path:          utils.String(v["path"].(string)),
+			ContainerName: utils.String(v["container_name"].(string)),
+			Type:          utils.String(v["type"].(string)),
+		})
+	}
+	return &results
 }
 
 func expandSparkPoolSparkConfig(input []interface{}) *synapse.LibraryRequirements {
@@ -512,7 +592,7 @@ func flattenArmSparkPoolAutoScaleProperties(input *synapse.AutoScaleProperties)
 	}
 }
 
-func flattenArmSparkPoolLibraryRequirements(input *synapse.LibraryRequirements) []interface{} {
+func flattenArmSparkPoolLibraryRequirements(input *synapse.LibraryRequirements, existing []interface{}) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
 	}
@@ -525,14 +605,55 @@ func flattenArmSparkPoolLibraryRequirements(input *synapse.LibraryRequirements)
 	if input.Filename != nil {
 		filename = *input.Filename
 	}
+
+	// `source` isn't returned by the API, so we look this up from the existing config
+	var source string
+	if len(existing) > 0 && existing[0] != nil {
+		source = existing[0].(map[string]interface{})["source"].(string)
+	}
+
 	return []interface{}{
 		map[string]interface{}{
 			"content":  content,
 			"filename": filename,
+			"source":   source,
 		},
 	}
 }
 
+func flattenArmSparkPoolCustomLibraries(input *[]synapse.LibraryInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var name string
+		if item.Name != nil {
+			name = *item.Name
+		}
+		var path string
+		if item.Path != nil {
+			path = *item.Path
+		}
+		var containerName string
+		if item.ContainerName != nil {
+			containerName = *item.ContainerName
+		}
+		var libraryType string
+		if item.Type != nil {
+			libraryType = *item.Type
+		}
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"path":           path,
+			"container_name": containerName,
+			"type":           libraryType,
+		})
+	}
+	return results
+}
+
 func flattenSparkPoolSparkConfig(input *synapse.LibraryRequirements) []interface{} {
 	if input == nil {
 		return make([]interface{}, 0)
@@ -553,3 +674,36 @@ func flattenSparkPoolSparkConfig(input *synapse.LibraryRequirements) []interface
 		},
 	}
 }
+
+// synapseSparkPoolCustomizeDiff compares the hash of the file referenced by `library_requirement.0.source`
+// with the hash of the content currently applied to the Spark Pool, so that changes to the local file
+// (or to the requirements on the Spark Pool) show up as a diff.
+func synapseSparkPoolCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	requirements := d.Get("library_requirement").([]interface{})
+	if len(requirements) == 0 || requirements[0] == nil {
+		return nil
+	}
+	requirement := requirements[0].(map[string]interface{})
+
+	source := requirement["source"].(string)
+	if source == "" {
+		return nil
+	}
+
+	contents, err := os.ReadFile(source)
+	if err != nil {
+		// the file may be generated during apply, in which case it's read when the Spark Pool is updated
+		log.Printf("[DEBUG] unable to read `library_requirement.0.source` %q: %+v", source, err)
+		return nil
+	}
+
+	fileHash := sha256.Sum256(contents)
+	existingContent, _ := d.GetChange("library_requirement.0.content")
+	existingHash := sha256.Sum256([]byte(existingContent.(string)))
+	if fileHash == existingHash {
+		return nil
+	}
+
+	requirement["content"] = string(contents)
+	return d.SetNew("library_requirement", []interface{}{requirement})
+}
@@ -3,6 +3,7 @@ package synapse_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -152,6 +153,68 @@ func TestAccSynapseSpark3Pool_update(t *testing.T) {
 	})
 }
 
+func TestAccSynapseSparkPool_libraryRequirementFromFile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_spark_pool", "test")
+	r := SynapseSparkPoolResource{}
+
+	source, err := os.CreateTemp("", "requirements*.txt")
+	if err != nil {
+		t.Fatalf("creating requirements file: %+v", err)
+	}
+	if _, err := source.WriteString("appnope==0.1.0\n"); err != nil {
+		t.Fatalf("populating requirements file: %+v", err)
+	}
+	source.Close()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.libraryRequirementFromFile(data, source.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("library_requirement.0.content").HasValue("appnope==0.1.0\n"),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder", "library_requirement.0.source"),
+		{
+			PreConfig: func() {
+				if err := os.WriteFile(source.Name(), []byte("beautifulsoup4==4.6.3\n"), 0644); err != nil {
+					t.Fatalf("updating requirements file: %+v", err)
+				}
+			},
+			Config: r.libraryRequirementFromFile(data, source.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("library_requirement.0.content").HasValue("beautifulsoup4==4.6.3\n"),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder", "library_requirement.0.source"),
+	})
+}
+
+func TestAccSynapseSparkPool_customLibrary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_spark_pool", "test")
+	r := SynapseSparkPoolResource{}
+	source := WorkspacePackageResource{}.sourceFile(t, "print('hello')")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customLibrary(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("custom_library.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("spark_events_folder", "spark_log_folder"),
+	})
+}
+
 func (r SynapseSparkPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.SparkPoolID(state.ID)
 	if err != nil {
@@ -263,6 +326,65 @@ resource "azurerm_synapse_spark_pool" "test" {
 `, template, data.RandomString)
 }
 
+func (r SynapseSparkPoolResource) libraryRequirementFromFile(data acceptance.TestData, source string) string {
+	template := r.template(data, data.Locations.Primary)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_spark_pool" "test" {
+  name                 = "acctestSSP%s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  node_size_family     = "MemoryOptimized"
+  node_size            = "Small"
+  node_count           = 3
+
+  library_requirement {
+    source   = %q
+    filename = "requirements.txt"
+  }
+}
+`, template, data.RandomString, source)
+}
+
+func (r SynapseSparkPoolResource) customLibrary(data acceptance.TestData, source string) string {
+	template := r.template(data, data.Locations.Primary)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_firewall_rule" "test" {
+  name                 = "allowAll"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  start_ip_address     = "0.0.0.0"
+  end_ip_address       = "255.255.255.255"
+}
+
+resource "azurerm_synapse_workspace_package" "test" {
+  name                 = "acctest_package_%d-0.1.0-py3-none-any.whl"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  source               = %q
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+
+resource "azurerm_synapse_spark_pool" "test" {
+  name                 = "acctestSSP%s"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  node_size_family     = "MemoryOptimized"
+  node_size            = "Small"
+  node_count           = 3
+
+  custom_library {
+    name           = azurerm_synapse_workspace_package.test.name
+    path           = azurerm_synapse_workspace_package.test.path
+    container_name = azurerm_synapse_workspace_package.test.container_name
+    type           = azurerm_synapse_workspace_package.test.type
+  }
+}
+`, template, data.RandomInteger, source, data.RandomString)
+}
+
 func (r SynapseSparkPoolResource) template(data acceptance.TestData, location string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
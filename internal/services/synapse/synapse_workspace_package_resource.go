@@ -0,0 +1,278 @@
+package synapse
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Library API only accepts content in chunks of up to 4MiB
+const workspacePackageChunkSize = 4 * 1024 * 1024
+
+func resourceSynapseWorkspacePackage() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceSynapseWorkspacePackageCreate,
+		Read:   resourceSynapseWorkspacePackageRead,
+		Delete: resourceSynapseWorkspacePackageDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.WorkspacePackageID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(synapseWorkspacePackageCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspacePackageName,
+			},
+
+			"synapse_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WorkspaceID,
+			},
+
+			"source": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"content_sha256": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"container_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"path": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSynapseWorkspacePackageCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+
+	workspaceId, err := parse.WorkspaceID(d.Get("synapse_workspace_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LibraryClient(workspaceId.Name, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewWorkspacePackageID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.Name, d.Get("name").(string))
+	existing, err := client.Get(ctx, id.LibraryName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return tf.ImportAsExistsError("azurerm_synapse_workspace_package", id.ID())
+	}
+
+	source := d.Get("source").(string)
+	file, err := os.Open(source)
+	if err != nil {
+		return fmt.Errorf("opening `source` %q: %+v", source, err)
+	}
+	defer file.Close()
+
+	future, err := client.Create(ctx, id.LibraryName)
+	if err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	hash := sha256.New()
+	buffer := make([]byte, workspacePackageChunkSize)
+	var position int64
+	for {
+		n, err := io.ReadFull(file, buffer)
+		if n > 0 {
+			chunk := buffer[:n]
+			if _, err := client.Append(ctx, id.LibraryName, string(chunk), utils.Int64(position)); err != nil {
+				return fmt.Errorf("uploading content at offset %d for %s: %+v", position, id, err)
+			}
+			hash.Write(chunk)
+			position += int64(n)
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading `source` %q: %+v", source, err)
+		}
+	}
+
+	flushFuture, err := client.Flush(ctx, id.LibraryName)
+	if err != nil {
+		return fmt.Errorf("flushing %s: %+v", id, err)
+	}
+	if err = flushFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for flush of %s: %+v", id, err)
+	}
+
+	d.Set("content_sha256", hex.EncodeToString(hash.Sum(nil)))
+
+	return resourceSynapseWorkspacePackageRead(d, meta)
+}
+
+func resourceSynapseWorkspacePackageRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+
+	id, err := parse.WorkspacePackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LibraryClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.LibraryName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.LibraryName)
+	d.Set("synapse_workspace_id", parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID())
+
+	if props := resp.Properties; props != nil {
+		d.Set("container_name", props.ContainerName)
+		d.Set("path", props.Path)
+		d.Set("type", props.Type)
+	}
+
+	return nil
+}
+
+func resourceSynapseWorkspacePackageDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	synapseClient := meta.(*clients.Client).Synapse
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	environment := meta.(*clients.Client).Account.Environment
+
+	id, err := parse.WorkspacePackageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client, err := synapseClient.LibraryClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.LibraryName)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func synapseWorkspacePackageCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	source := d.Get("source").(string)
+	if source == "" {
+		// the path isn't known until apply time
+		return nil
+	}
+
+	hash, err := synapseFileSha256(source)
+	if err != nil {
+		// the file may be generated during apply, in which case the hash is computed on upload
+		log.Printf("[DEBUG] unable to compute the hash of `source` %q: %+v", source, err)
+		return nil
+	}
+
+	if d.Get("content_sha256").(string) == hash {
+		return nil
+	}
+
+	if err := d.SetNew("content_sha256", hash); err != nil {
+		return err
+	}
+
+	if d.Id() != "" {
+		return d.ForceNew("content_sha256")
+	}
+
+	return nil
+}
+
+func synapseFileSha256(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,190 @@
+package synapse_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspacePackageResource struct{}
+
+func TestAccSynapseWorkspacePackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_package", "test")
+	r := WorkspacePackageResource{}
+	source := r.sourceFile(t, "print('hello')")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_sha256").Exists(),
+				check.That(data.ResourceName).Key("path").Exists(),
+			),
+		},
+		data.ImportStep("source", "content_sha256"),
+	})
+}
+
+func TestAccSynapseWorkspacePackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_package", "test")
+	r := WorkspacePackageResource{}
+	source := r.sourceFile(t, "print('hello')")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, source)
+		}),
+	})
+}
+
+func TestAccSynapseWorkspacePackage_sourceChanged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace_package", "test")
+	r := WorkspacePackageResource{}
+	source := r.sourceFile(t, "print('hello')")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source", "content_sha256"),
+		{
+			PreConfig: func() {
+				if err := os.WriteFile(source, []byte("print('world')"), 0644); err != nil {
+					t.Fatalf("updating source file: %+v", err)
+				}
+			},
+			Config: r.basic(data, source),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source", "content_sha256"),
+	})
+}
+
+func (r WorkspacePackageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkspacePackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	environment := clients.Account.Environment
+	client, err := clients.Synapse.LibraryClient(id.WorkspaceName, environment.SynapseEndpointSuffix)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.LibraryName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r WorkspacePackageResource) sourceFile(t *testing.T, content string) string {
+	file, err := os.CreateTemp("", "*.whl")
+	if err != nil {
+		t.Fatalf("creating source file: %+v", err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(content); err != nil {
+		t.Fatalf("populating source file: %+v", err)
+	}
+
+	return file.Name()
+}
+
+func (r WorkspacePackageResource) basic(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace_package" "test" {
+  name                 = "acctest_package_%d-0.1.0-py3-none-any.whl"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  source               = %q
+
+  depends_on = [
+    azurerm_synapse_firewall_rule.test,
+  ]
+}
+`, r.template(data), data.RandomInteger, source)
+}
+
+func (r WorkspacePackageResource) requiresImport(data acceptance.TestData, source string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_synapse_workspace_package" "import" {
+  name                 = azurerm_synapse_workspace_package.test.name
+  synapse_workspace_id = azurerm_synapse_workspace_package.test.synapse_workspace_id
+  source               = azurerm_synapse_workspace_package.test.source
+}
+`, r.basic(data, source))
+}
+
+func (WorkspacePackageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-synapse-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "acctest-%d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+}
+
+resource "azurerm_synapse_firewall_rule" "test" {
+  name                 = "allowAll"
+  synapse_workspace_id = azurerm_synapse_workspace.test.id
+  start_ip_address     = "0.0.0.0"
+  end_ip_address       = "255.255.255.255"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,31 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func WorkspacePackageName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	// The name attribute rules are :
+	// 1. must not contain a path separator.
+	// 2. must end with one of the supported package extensions (`.jar`, `.whl` or `.tar.gz`).
+	// 3. must be at most 100 characters long, with at least 1 character excluding the extension
+
+	if len(v) > 100 {
+		errors = append(errors, fmt.Errorf("%s must be at most 100 characters long", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[^/\\]+\.(jar|whl|tar\.gz)$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%s must not contain a path separator and must end with `.jar`, `.whl` or `.tar.gz`", k))
+		return
+	}
+
+	return warnings, errors
+}
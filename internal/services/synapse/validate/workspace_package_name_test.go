@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWorkspacePackageName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			// empty
+			input:    "",
+			expected: false,
+		},
+		{
+			// wheel
+			input:    "example-0.1.0-py3-none-any.whl",
+			expected: true,
+		},
+		{
+			// jar
+			input:    "example_1.0.jar",
+			expected: true,
+		},
+		{
+			// tarball
+			input:    "example-1.0.tar.gz",
+			expected: true,
+		},
+		{
+			// extension only
+			input:    ".whl",
+			expected: false,
+		},
+		{
+			// unsupported extension
+			input:    "example.zip",
+			expected: false,
+		},
+		{
+			// can't contain a path separator
+			input:    "packages/example.whl",
+			expected: false,
+		},
+		{
+			// 100 chars
+			input:    strings.Repeat("a", 96) + ".whl",
+			expected: true,
+		},
+		{
+			// 101 chars
+			input:    strings.Repeat("a", 97) + ".whl",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := WorkspacePackageName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package endpoints
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.ResourceId = EndpointId{}
+
+// EndpointId is a struct representing the Resource ID for a Endpoint
+type EndpointId struct {
+	SubscriptionId            string
+	ResourceGroupName         string
+	TrafficManagerProfileName string
+	EndpointType              string
+	EndpointName              string
+}
+
+// NewEndpointID returns a new EndpointId struct
+func NewEndpointID(subscriptionId string, resourceGroupName string, trafficManagerProfileName string, endpointType string, endpointName string) EndpointId {
+	return EndpointId{
+		SubscriptionId:            subscriptionId,
+		ResourceGroupName:         resourceGroupName,
+		TrafficManagerProfileName: trafficManagerProfileName,
+		EndpointType:              endpointType,
+		EndpointName:              endpointName,
+	}
+}
+
+// ParseEndpointID parses 'input' into a EndpointId
+func ParseEndpointID(input string) (*EndpointId, error) {
+	parser := resourceids.NewParserFromResourceIdType(EndpointId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := EndpointId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.TrafficManagerProfileName, ok = parsed.Parsed["trafficManagerProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'trafficManagerProfileName' was not found in the resource id %q", input)
+	}
+
+	if id.EndpointType, ok = parsed.Parsed["endpointType"]; !ok {
+		return nil, fmt.Errorf("the segment 'endpointType' was not found in the resource id %q", input)
+	}
+
+	if id.EndpointName, ok = parsed.Parsed["endpointName"]; !ok {
+		return nil, fmt.Errorf("the segment 'endpointName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ParseEndpointIDInsensitively parses 'input' case-insensitively into a EndpointId
+// note: this method should only be used for API response data and not user input
+func ParseEndpointIDInsensitively(input string) (*EndpointId, error) {
+	parser := resourceids.NewParserFromResourceIdType(EndpointId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	var ok bool
+	id := EndpointId{}
+
+	if id.SubscriptionId, ok = parsed.Parsed["subscriptionId"]; !ok {
+		return nil, fmt.Errorf("the segment 'subscriptionId' was not found in the resource id %q", input)
+	}
+
+	if id.ResourceGroupName, ok = parsed.Parsed["resourceGroupName"]; !ok {
+		return nil, fmt.Errorf("the segment 'resourceGroupName' was not found in the resource id %q", input)
+	}
+
+	if id.TrafficManagerProfileName, ok = parsed.Parsed["trafficManagerProfileName"]; !ok {
+		return nil, fmt.Errorf("the segment 'trafficManagerProfileName' was not found in the resource id %q", input)
+	}
+
+	if id.EndpointType, ok = parsed.Parsed["endpointType"]; !ok {
+		return nil, fmt.Errorf("the segment 'endpointType' was not found in the resource id %q", input)
+	}
+
+	if id.EndpointName, ok = parsed.Parsed["endpointName"]; !ok {
+		return nil, fmt.Errorf("the segment 'endpointName' was not found in the resource id %q", input)
+	}
+
+	return &id, nil
+}
+
+// ValidateEndpointID checks that 'input' can be parsed as a Endpoint ID
+func ValidateEndpointID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseEndpointID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Endpoint ID
+func (id EndpointId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/trafficManagerProfiles/%s/%s/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.TrafficManagerProfileName, id.EndpointType, id.EndpointName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Endpoint ID
+func (id EndpointId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticTrafficManagerProfiles", "trafficManagerProfiles", "trafficManagerProfiles"),
+		resourceids.UserSpecifiedSegment("trafficManagerProfileName", "trafficManagerProfileValue"),
+		resourceids.UserSpecifiedSegment("endpointType", "endpointTypeValue"),
+		resourceids.UserSpecifiedSegment("endpointName", "endpointValue"),
+	}
+}
+
+// String returns a human-readable description of this Endpoint ID
+func (id EndpointId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Traffic Manager Profile Name: %q", id.TrafficManagerProfileName),
+		fmt.Sprintf("Endpoint Type: %q", id.EndpointType),
+		fmt.Sprintf("Endpoint Name: %q", id.EndpointName),
+	}
+	return fmt.Sprintf("Endpoint (%s)", strings.Join(components, "\n"))
+}
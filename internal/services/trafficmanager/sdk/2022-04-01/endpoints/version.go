@@ -0,0 +1,9 @@
+package endpoints
+
+import "fmt"
+
+const defaultApiVersion = "2022-04-01"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/endpoints/%s", defaultApiVersion)
+}
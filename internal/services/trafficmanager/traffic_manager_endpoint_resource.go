@@ -5,13 +5,13 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-08-01/trafficmanager"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -82,12 +82,18 @@ func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 				Optional: true,
 				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					string(trafficmanager.EndpointStatusDisabled),
-					string(trafficmanager.EndpointStatusEnabled),
+					string(endpoints.EndpointStatusDisabled),
+					string(endpoints.EndpointStatusEnabled),
 				}, true),
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
+			"always_serve_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"weight": {
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
@@ -161,24 +167,23 @@ func resourceArmTrafficManagerEndpoint() *pluginsdk.Resource {
 
 			"subnet": {
 				Type:     pluginsdk.TypeList,
-				ForceNew: true,
 				Optional: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"first": {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validate.IPv4Address,
+							ValidateFunc: validation.IsIPAddress,
 						},
 						"last": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
-							ValidateFunc: validate.IPv4Address,
+							ValidateFunc: validation.IsIPAddress,
 						},
 						"scope": {
 							Type:         pluginsdk.TypeInt,
 							Optional:     true,
-							ValidateFunc: validation.IntBetween(0, 32),
+							ValidateFunc: validation.IntBetween(0, 128),
 						},
 					},
 				},
@@ -205,27 +210,45 @@ func resourceArmTrafficManagerEndpointCreateUpdate(d *pluginsdk.ResourceData, me
 	if err != nil {
 		return err
 	}
+	endpointId := endpoints.NewEndpointID(resourceId.SubscriptionId, resourceId.ResourceGroup, resourceId.TrafficManagerProfileName, resourceId.EndpointType(), resourceId.Name)
 
 	if d.IsNewResource() {
-		existing, err := client.Get(ctx, resourceGroup, profileName, endpointType, name)
+		existing, err := client.Get(ctx, endpointId)
 		if err != nil {
-			if !utils.ResponseWasNotFound(existing.Response) {
+			if !response.WasNotFound(existing.HttpResponse) {
 				return fmt.Errorf("checking for presence of existing Traffic Manager Endpoint %q (Resource Group %q): %v", name, resourceGroup, err)
 			}
 		}
 
-		if existing.ID != nil && *existing.ID != "" {
+		if existing.Model != nil && existing.Model.Id != nil && *existing.Model.Id != "" {
 			return tf.ImportAsExistsError("azurerm_traffic_manager_endpoint", resourceId.ID())
 		}
 	}
 
-	params := trafficmanager.Endpoint{
-		Name:               &name,
-		Type:               &fullEndpointType,
-		EndpointProperties: getArmTrafficManagerEndpointProperties(d),
+	// toggling the status of an endpoint is done via a PATCH containing only the status, since a PUT would
+	// re-send the values Azure computes for the endpoint (e.g. the target/location of an Azure resource)
+	if !d.IsNewResource() && d.HasChange("endpoint_status") && !d.HasChangesExcept("endpoint_status") {
+		status := endpoints.EndpointStatus(d.Get("endpoint_status").(string))
+		params := endpoints.Endpoint{
+			Type: &fullEndpointType,
+			Properties: &endpoints.EndpointProperties{
+				EndpointStatus: &status,
+			},
+		}
+		if _, err := client.Update(ctx, endpointId, params); err != nil {
+			return fmt.Errorf("updating the status of %s Endpoint %q (Traffic Manager Profile %q / Resource Group %q): %+v", resourceId.EndpointType(), resourceId.Name, resourceId.TrafficManagerProfileName, resourceId.ResourceGroup, err)
+		}
+
+		return resourceArmTrafficManagerEndpointRead(d, meta)
+	}
+
+	params := endpoints.Endpoint{
+		Name:       &name,
+		Type:       &fullEndpointType,
+		Properties: getArmTrafficManagerEndpointProperties(d),
 	}
 
-	if _, err := client.CreateOrUpdate(ctx, resourceGroup, profileName, endpointType, name, params); err != nil {
+	if _, err := client.CreateOrUpdate(ctx, endpointId, params); err != nil {
 		return fmt.Errorf("creating/updating %s Endpoint %q (Traffic Manager Profile %q / Resource Group %q): %+v", resourceId.EndpointType(), resourceId.Name, resourceId.TrafficManagerProfileName, resourceId.ResourceGroup, err)
 	}
 
@@ -243,9 +266,10 @@ func resourceArmTrafficManagerEndpointRead(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	resp, err := client.Get(ctx, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name)
+	resp, err := client.Get(ctx, endpoints.NewEndpointID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s Endpoint %q (Traffic Manager Profile %q / Resource Group %q) was not found - removing from state!", id.EndpointType(), id.Name, id.TrafficManagerProfileName, id.ResourceGroup)
 			d.SetId("")
 			return nil
 		}
@@ -257,19 +281,31 @@ func resourceArmTrafficManagerEndpointRead(d *pluginsdk.ResourceData, meta inter
 	d.Set("type", id.EndpointType())
 	d.Set("profile_name", id.TrafficManagerProfileName)
 
-	if props := resp.EndpointProperties; props != nil {
-		d.Set("endpoint_status", string(props.EndpointStatus))
-		d.Set("target_resource_id", props.TargetResourceID)
+	if model := resp.Model; model != nil && model.Properties != nil {
+		props := *model.Properties
+
+		endpointStatus := ""
+		if props.EndpointStatus != nil {
+			endpointStatus = string(*props.EndpointStatus)
+		}
+		d.Set("endpoint_status", endpointStatus)
+		d.Set("always_serve_enabled", props.AlwaysServe != nil && *props.AlwaysServe == endpoints.AlwaysServeEnabled)
+		d.Set("target_resource_id", props.TargetResourceId)
 		d.Set("target", props.Target)
 		d.Set("weight", props.Weight)
 		d.Set("priority", props.Priority)
 		d.Set("endpoint_location", props.EndpointLocation)
-		d.Set("endpoint_monitor_status", props.EndpointMonitorStatus)
+
+		endpointMonitorStatus := ""
+		if props.EndpointMonitorStatus != nil {
+			endpointMonitorStatus = string(*props.EndpointMonitorStatus)
+		}
+		d.Set("endpoint_monitor_status", endpointMonitorStatus)
 
 		d.Set("min_child_endpoints", props.MinChildEndpoints)
 		d.Set("minimum_required_child_endpoints_ipv4", props.MinChildEndpointsIPv4)
 		d.Set("minimum_required_child_endpoints_ipv6", props.MinChildEndpointsIPv6)
-		d.Set("geo_mappings", props.GeoMapping)
+		d.Set("geo_mappings", utils.FlattenStringSlice(props.GeoMapping))
 		if err := d.Set("subnet", flattenAzureRMTrafficManagerEndpointSubnetConfig(props.Subnets)); err != nil {
 			return fmt.Errorf("setting `subnet`: %s", err)
 		}
@@ -290,9 +326,9 @@ func resourceArmTrafficManagerEndpointDelete(d *pluginsdk.ResourceData, meta int
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name)
+	resp, err := client.Delete(ctx, endpoints.NewEndpointID(id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.EndpointType(), id.Name))
 	if err != nil {
-		if !utils.ResponseWasNotFound(resp.Response) {
+		if !response.WasNotFound(resp.HttpResponse) {
 			return fmt.Errorf("deleting Endpoint %q (Traffic Manager Profile %q / Resource Group %q): %+v", id.Name, id.TrafficManagerProfileName, id.ResourceGroup, err)
 		}
 	}
@@ -300,17 +336,27 @@ func resourceArmTrafficManagerEndpointDelete(d *pluginsdk.ResourceData, meta int
 	return nil
 }
 
-func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficmanager.EndpointProperties {
+func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *endpoints.EndpointProperties {
 	target := d.Get("target").(string)
-	status := d.Get("endpoint_status").(string)
+	status := endpoints.EndpointStatus(d.Get("endpoint_status").(string))
+
+	alwaysServe := endpoints.AlwaysServeDisabled
+	if d.Get("always_serve_enabled").(bool) {
+		alwaysServe = endpoints.AlwaysServeEnabled
+	}
+
+	endpointProps := endpoints.EndpointProperties{
+		Target:      &target,
+		AlwaysServe: &alwaysServe,
+	}
 
-	endpointProps := trafficmanager.EndpointProperties{
-		Target:         &target,
-		EndpointStatus: trafficmanager.EndpointStatus(status),
+	// omitting the status leaves it up to Azure, which enables new endpoints
+	if status != "" {
+		endpointProps.EndpointStatus = &status
 	}
 
 	if resourceId := d.Get("target_resource_id").(string); resourceId != "" {
-		endpointProps.TargetResourceID = utils.String(resourceId)
+		endpointProps.TargetResourceId = utils.String(resourceId)
 		// NOTE: Workaround for upstream behaviour: if the target is blank instead of nil, the REST API will throw a 500 error
 		if target == "" {
 			endpointProps.Target = nil
@@ -353,29 +399,28 @@ func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficm
 		endpointProps.MinChildEndpointsIPv6 = utils.Int64(int64(minChildEndpointsIPv6))
 	}
 
-	subnetSlice := make([]trafficmanager.EndpointPropertiesSubnetsItem, 0)
+	subnetSlice := make([]endpoints.EndpointPropertiesSubnetsInlined, 0)
 	for _, subnet := range d.Get("subnet").([]interface{}) {
 		subnetBlock := subnet.(map[string]interface{})
-		if subnetBlock["scope"].(int) == 0 && subnetBlock["first"].(string) != "0.0.0.0" {
-			subnetSlice = append(subnetSlice, trafficmanager.EndpointPropertiesSubnetsItem{
+		if subnetBlock["scope"].(int) == 0 && subnetBlock["first"].(string) != "0.0.0.0" && subnetBlock["first"].(string) != "::" {
+			subnetSlice = append(subnetSlice, endpoints.EndpointPropertiesSubnetsInlined{
 				First: utils.String(subnetBlock["first"].(string)),
 				Last:  utils.String(subnetBlock["last"].(string)),
 			})
 		} else {
-			subnetSlice = append(subnetSlice, trafficmanager.EndpointPropertiesSubnetsItem{
+			subnetSlice = append(subnetSlice, endpoints.EndpointPropertiesSubnetsInlined{
 				First: utils.String(subnetBlock["first"].(string)),
-				Scope: utils.Int32(int32(subnetBlock["scope"].(int))),
+				Scope: utils.Int64(int64(subnetBlock["scope"].(int))),
 			})
 		}
 	}
-	if len(subnetSlice) > 0 {
-		endpointProps.Subnets = &subnetSlice
-	}
+	// an empty list is sent to remove any previously configured subnets
+	endpointProps.Subnets = &subnetSlice
 
-	headerSlice := make([]trafficmanager.EndpointPropertiesCustomHeadersItem, 0)
+	headerSlice := make([]endpoints.EndpointPropertiesCustomHeadersInlined, 0)
 	for _, header := range d.Get("custom_header").([]interface{}) {
 		headerBlock := header.(map[string]interface{})
-		headerSlice = append(headerSlice, trafficmanager.EndpointPropertiesCustomHeadersItem{
+		headerSlice = append(headerSlice, endpoints.EndpointPropertiesCustomHeadersInlined{
 			Name:  utils.String(headerBlock["name"].(string)),
 			Value: utils.String(headerBlock["value"].(string)),
 		})
@@ -387,28 +432,34 @@ func getArmTrafficManagerEndpointProperties(d *pluginsdk.ResourceData) *trafficm
 	return &endpointProps
 }
 
-func flattenAzureRMTrafficManagerEndpointSubnetConfig(input *[]trafficmanager.EndpointPropertiesSubnetsItem) []interface{} {
+func flattenAzureRMTrafficManagerEndpointSubnetConfig(input *[]endpoints.EndpointPropertiesSubnetsInlined) []interface{} {
 	result := make([]interface{}, 0)
 	if input == nil {
 		return result
 	}
 	for _, subnet := range *input {
-		flatSubnet := make(map[string]interface{}, 3)
+		first := ""
 		if subnet.First != nil {
-			flatSubnet["first"] = *subnet.First
+			first = *subnet.First
 		}
+		last := ""
 		if subnet.Last != nil {
-			flatSubnet["last"] = *subnet.Last
+			last = *subnet.Last
 		}
+		scope := 0
 		if subnet.Scope != nil {
-			flatSubnet["scope"] = int(*subnet.Scope)
+			scope = int(*subnet.Scope)
 		}
-		result = append(result, flatSubnet)
+		result = append(result, map[string]interface{}{
+			"first": first,
+			"last":  last,
+			"scope": scope,
+		})
 	}
 	return result
 }
 
-func flattenAzureRMTrafficManagerEndpointCustomHeaderConfig(input *[]trafficmanager.EndpointPropertiesCustomHeadersItem) []interface{} {
+func flattenAzureRMTrafficManagerEndpointCustomHeaderConfig(input *[]endpoints.EndpointPropertiesCustomHeadersInlined) []interface{} {
 	result := make([]interface{}, 0)
 	if input == nil {
 		return result
@@ -6,9 +6,11 @@ import (
 	"path"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/trafficmanager/sdk/2022-04-01/endpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -89,6 +91,40 @@ func TestAccAzureRMTrafficManagerEndpoint_basicDisableExternal(t *testing.T) {
 				check.That(externalResourceName).Key("endpoint_status").HasValue("Disabled"),
 			),
 		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(externalResourceName).ExistsInAzure(r),
+				check.That(externalResourceName).Key("endpoint_status").HasValue("Enabled"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAzureRMTrafficManagerEndpoint_alwaysServe(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_traffic_manager_endpoint", "testExternal")
+	r := TrafficManagerEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.alwaysServe(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("always_serve_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.alwaysServe(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("always_serve_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
 	})
 }
 
@@ -152,6 +188,16 @@ func TestAccAzureRMTrafficManagerEndpoint_updateSubnets(t *testing.T) {
 				check.That(secondResourceName).Key("subnet.0.last").HasValue("12.34.56.78"),
 			),
 		},
+		data.ImportStep(),
+		{
+			Config: r.subnets(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subnet.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
 	})
 }
 
@@ -295,9 +341,10 @@ func (r TrafficManagerEndpointResource) Exists(ctx context.Context, client *clie
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, resourceGroup, profileName, path.Base(endpointType), name)
+	id := endpoints.NewEndpointID(client.Account.SubscriptionId, resourceGroup, profileName, path.Base(endpointType), name)
+	resp, err := client.TrafficManager.EndpointsClient.Get(ctx, id)
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			return utils.Bool(false), nil
 		}
 		return nil, fmt.Errorf("retrieving Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -311,7 +358,8 @@ func (r TrafficManagerEndpointResource) Destroy(ctx context.Context, client *cli
 	profileName := state.Attributes["profile_name"]
 	resourceGroup := state.Attributes["resource_group_name"]
 
-	if _, err := client.TrafficManager.EndpointsClient.Delete(ctx, resourceGroup, profileName, path.Base(endpointType), name); err != nil {
+	id := endpoints.NewEndpointID(client.Account.SubscriptionId, resourceGroup, profileName, path.Base(endpointType), name)
+	if _, err := client.TrafficManager.EndpointsClient.Delete(ctx, id); err != nil {
 		return nil, fmt.Errorf("deleting Traffic Manager Endpoint %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 	return utils.Bool(true), nil
@@ -446,6 +494,46 @@ resource "azurerm_traffic_manager_endpoint" "testExternal" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (r TrafficManagerEndpointResource) alwaysServe(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-traffic-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctest-TMP-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  traffic_routing_method = "MultiValue"
+  max_return             = 2
+
+  dns_config {
+    relative_name = "acctest-tmp-%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol = "https"
+    port     = 443
+    path     = "/"
+  }
+}
+
+resource "azurerm_traffic_manager_endpoint" "testExternal" {
+  name                 = "acctestend-external%d"
+  type                 = "externalEndpoints"
+  target               = "1.2.3.4"
+  always_serve_enabled = %t
+  profile_name         = azurerm_traffic_manager_profile.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, enabled)
+}
+
 func (r TrafficManagerEndpointResource) weight(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
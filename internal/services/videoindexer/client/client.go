@@ -0,0 +1,19 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/videoindexer/sdk/2024-01-01/accounts"
+)
+
+type Client struct {
+	AccountsClient *accounts.AccountsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	accountsClient := accounts.NewAccountsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&accountsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AccountsClient: &accountsClient,
+	}
+}
@@ -0,0 +1,11 @@
+package accounts
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+)
+
+type AccountPatch struct {
+	Identity   *identity.SystemAndUserAssignedMap `json:"identity,omitempty"`
+	Properties *AccountPropertiesForPatchRequest  `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
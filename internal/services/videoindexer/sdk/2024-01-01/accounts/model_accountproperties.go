@@ -0,0 +1,11 @@
+package accounts
+
+type AccountProperties struct {
+	AccountId           *string                       `json:"accountId,omitempty"`
+	AccountName         *string                       `json:"accountName,omitempty"`
+	ProvisioningState   *ProvisioningState            `json:"provisioningState,omitempty"`
+	StorageServices     *StorageServicesForPutRequest `json:"storageServices,omitempty"`
+	TenantId            *string                       `json:"tenantId,omitempty"`
+	TotalMinutesIndexed *int64                        `json:"totalMinutesIndexed,omitempty"`
+	TotalSecondsIndexed *int64                        `json:"totalSecondsIndexed,omitempty"`
+}
@@ -0,0 +1,5 @@
+package accounts
+
+type AccountPropertiesForPatchRequest struct {
+	StorageServices *StorageServicesForPatchRequest `json:"storageServices,omitempty"`
+}
@@ -0,0 +1,5 @@
+package accounts
+
+type StorageServicesForPatchRequest struct {
+	UserAssignedIdentity *string `json:"userAssignedIdentity,omitempty"`
+}
@@ -0,0 +1,6 @@
+package accounts
+
+type StorageServicesForPutRequest struct {
+	ResourceId           *string `json:"resourceId,omitempty"`
+	UserAssignedIdentity *string `json:"userAssignedIdentity,omitempty"`
+}
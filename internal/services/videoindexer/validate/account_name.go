@@ -0,0 +1,20 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func AccountName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[0-9a-zA-Z][-0-9a-zA-Z]{0,49}$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 50 characters, start with a letter or number and can only contain letters, numbers and hyphens, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAccountName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "-indexer",
+			Valid: false,
+		},
+		{
+			Input: "video-indexer1",
+			Valid: true,
+		},
+		{
+			Input: "video_indexer",
+			Valid: false,
+		},
+		{
+			Input: strings.Repeat("a", 50),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("a", 51),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := AccountName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
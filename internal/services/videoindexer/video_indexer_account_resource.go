@@ -0,0 +1,323 @@
+package videoindexer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/videoindexer/sdk/2024-01-01/accounts"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/videoindexer/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VideoIndexerAccountResource struct{}
+
+var _ sdk.ResourceWithUpdate = VideoIndexerAccountResource{}
+
+type VideoIndexerAccountResourceModel struct {
+	Name              string                       `tfschema:"name"`
+	ResourceGroupName string                       `tfschema:"resource_group_name"`
+	Location          string                       `tfschema:"location"`
+	Storage           []VideoIndexerAccountStorage `tfschema:"storage"`
+	Tags              map[string]string            `tfschema:"tags"`
+	AccountId         string                       `tfschema:"account_id"`
+	TenantId          string                       `tfschema:"tenant_id"`
+}
+
+type VideoIndexerAccountStorage struct {
+	StorageAccountId       string `tfschema:"storage_account_id"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
+}
+
+func (r VideoIndexerAccountResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.AccountName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		// the Storage Account which holds the media replaces the Media Services Account used by earlier API versions
+		"storage": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"storage_account_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: storageValidate.StorageAccountID,
+					},
+
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentity(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VideoIndexerAccountResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"account_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"tenant_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r VideoIndexerAccountResource) ModelObject() interface{} {
+	return &VideoIndexerAccountResourceModel{}
+}
+
+func (r VideoIndexerAccountResource) ResourceType() string {
+	return "azurerm_video_indexer_account"
+}
+
+func (r VideoIndexerAccountResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return accounts.ValidateAccountID
+}
+
+func (r VideoIndexerAccountResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.VideoIndexer.AccountsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model VideoIndexerAccountResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := accounts.NewAccountID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			payload := accounts.Account{
+				Identity: identityValue,
+				Location: location.Normalize(model.Location),
+				Properties: &accounts.AccountProperties{
+					StorageServices: expandVideoIndexerAccountStorage(model.Storage),
+				},
+				Tags: &model.Tags,
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VideoIndexerAccountResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.VideoIndexer.AccountsClient
+
+			id, err := accounts.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VideoIndexerAccountResourceModel{
+				Name:              id.AccountName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+
+				flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+				if err := metadata.ResourceData.Set("identity", flattenedIdentity); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if props := model.Properties; props != nil {
+					state.AccountId = utils.NormalizeNilableString(props.AccountId)
+					state.TenantId = utils.NormalizeNilableString(props.TenantId)
+
+					storage, err := flattenVideoIndexerAccountStorage(props.StorageServices)
+					if err != nil {
+						return err
+					}
+					state.Storage = storage
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VideoIndexerAccountResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.VideoIndexer.AccountsClient
+
+			id, err := accounts.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VideoIndexerAccountResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := accounts.AccountPatch{}
+
+			if metadata.ResourceData.HasChange("identity") {
+				identityValue, err := identity.ExpandSystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = identityValue
+			}
+
+			// only the identity used to access the Storage Account can be changed once the Account has been created
+			if metadata.ResourceData.HasChange("storage.0.user_assigned_identity_id") {
+				payload.Properties = &accounts.AccountPropertiesForPatchRequest{
+					StorageServices: &accounts.StorageServicesForPatchRequest{
+						UserAssignedIdentity: utils.String(model.Storage[0].UserAssignedIdentityId),
+					},
+				}
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.Update(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VideoIndexerAccountResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.VideoIndexer.AccountsClient
+
+			id, err := accounts.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandVideoIndexerAccountStorage(input []VideoIndexerAccountStorage) *accounts.StorageServicesForPutRequest {
+	if len(input) == 0 {
+		return nil
+	}
+
+	storage := input[0]
+	output := accounts.StorageServicesForPutRequest{
+		ResourceId: utils.String(storage.StorageAccountId),
+	}
+
+	if storage.UserAssignedIdentityId != "" {
+		output.UserAssignedIdentity = utils.String(storage.UserAssignedIdentityId)
+	}
+
+	return &output
+}
+
+func flattenVideoIndexerAccountStorage(input *accounts.StorageServicesForPutRequest) ([]VideoIndexerAccountStorage, error) {
+	if input == nil {
+		return []VideoIndexerAccountStorage{}, nil
+	}
+
+	storageAccountId := ""
+	if input.ResourceId != nil {
+		id, err := storageParse.StorageAccountID(*input.ResourceId)
+		if err != nil {
+			return nil, err
+		}
+		storageAccountId = id.ID()
+	}
+
+	userAssignedIdentityId := ""
+	if input.UserAssignedIdentity != nil && *input.UserAssignedIdentity != "" {
+		id, err := commonids.ParseUserAssignedIdentityIDInsensitively(*input.UserAssignedIdentity)
+		if err != nil {
+			return nil, err
+		}
+		userAssignedIdentityId = id.ID()
+	}
+
+	return []VideoIndexerAccountStorage{
+		{
+			StorageAccountId:       storageAccountId,
+			UserAssignedIdentityId: userAssignedIdentityId,
+		},
+	}, nil
+}
@@ -4,28 +4,58 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/authorizations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/hcxenterprisesites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/privateclouds"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/addons"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/globalreachconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
 )
 
 type Client struct {
-	AuthorizationClient *authorizations.AuthorizationsClient
-	ClusterClient       *clusters.ClustersClient
-	PrivateCloudClient  *privateclouds.PrivateCloudsClient
+	AddonClient                 *addons.AddonsClient
+	AuthorizationClient         *authorizations.AuthorizationsClient
+	ClusterClient               *clusters.ClustersClient
+	GlobalReachConnectionClient *globalreachconnections.GlobalReachConnectionsClient
+	HcxEnterpriseSiteClient     *hcxenterprisesites.HcxEnterpriseSitesClient
+	PlacementPolicyClient       *placementpolicies.PlacementPoliciesClient
+	PrivateCloudClient          *privateclouds.PrivateCloudsClient
+	WorkloadNetworkClient       *workloadnetworks.WorkloadNetworksClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	addonClient := addons.NewAddonsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&addonClient.Client, o.ResourceManagerAuthorizer)
+
 	authorizationClient := authorizations.NewAuthorizationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&authorizationClient.Client, o.ResourceManagerAuthorizer)
 
 	clusterClient := clusters.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&clusterClient.Client, o.ResourceManagerAuthorizer)
 
+	globalReachConnectionClient := globalreachconnections.NewGlobalReachConnectionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&globalReachConnectionClient.Client, o.ResourceManagerAuthorizer)
+
+	hcxEnterpriseSiteClient := hcxenterprisesites.NewHcxEnterpriseSitesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&hcxEnterpriseSiteClient.Client, o.ResourceManagerAuthorizer)
+
+	placementPolicyClient := placementpolicies.NewPlacementPoliciesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&placementPolicyClient.Client, o.ResourceManagerAuthorizer)
+
 	privateCloudClient := privateclouds.NewPrivateCloudsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&privateCloudClient.Client, o.ResourceManagerAuthorizer)
 
+	workloadNetworkClient := workloadnetworks.NewWorkloadNetworksClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&workloadNetworkClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AuthorizationClient: &authorizationClient,
-		ClusterClient:       &clusterClient,
-		PrivateCloudClient:  &privateCloudClient,
+		AddonClient:                 &addonClient,
+		AuthorizationClient:         &authorizationClient,
+		ClusterClient:               &clusterClient,
+		GlobalReachConnectionClient: &globalReachConnectionClient,
+		HcxEnterpriseSiteClient:     &hcxEnterpriseSiteClient,
+		PlacementPolicyClient:       &placementPolicyClient,
+		PrivateCloudClient:          &privateCloudClient,
+		WorkloadNetworkClient:       &workloadNetworkClient,
 	}
 }
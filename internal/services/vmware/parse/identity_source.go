@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type IdentitySourceId struct {
+	SubscriptionId   string
+	ResourceGroup    string
+	PrivateCloudName string
+	Name             string
+}
+
+func NewIdentitySourceID(subscriptionId, resourceGroup, privateCloudName, name string) IdentitySourceId {
+	return IdentitySourceId{
+		SubscriptionId:   subscriptionId,
+		ResourceGroup:    resourceGroup,
+		PrivateCloudName: privateCloudName,
+		Name:             name,
+	}
+}
+
+func (id IdentitySourceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Private Cloud Name %q", id.PrivateCloudName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Identity Source", segmentsStr)
+}
+
+func (id IdentitySourceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AVS/privateClouds/%s/identitySources/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateCloudName, id.Name)
+}
+
+// IdentitySourceID parses a IdentitySource ID into an IdentitySourceId struct
+func IdentitySourceID(input string) (*IdentitySourceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := IdentitySourceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.PrivateCloudName, err = id.PopSegment("privateClouds"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("identitySources"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
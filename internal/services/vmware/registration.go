@@ -26,8 +26,17 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_vmware_private_cloud":               resourceVmwarePrivateCloud(),
-		"azurerm_vmware_cluster":                     resourceVmwareCluster(),
-		"azurerm_vmware_express_route_authorization": resourceVmwareExpressRouteAuthorization(),
+		"azurerm_vmware_private_cloud":                 resourceVmwarePrivateCloud(),
+		"azurerm_vmware_private_cloud_identity_source": resourceVmwarePrivateCloudIdentitySource(),
+		"azurerm_vmware_addon":                         resourceVmwareAddon(),
+		"azurerm_vmware_cluster":                       resourceVmwareCluster(),
+		"azurerm_vmware_express_route_authorization":   resourceVmwareExpressRouteAuthorization(),
+		"azurerm_vmware_global_reach_connection":       resourceVmwareGlobalReachConnection(),
+		"azurerm_vmware_hcx_enterprise_site":           resourceVmwareHcxEnterpriseSite(),
+		"azurerm_vmware_placement_policy":              resourceVmwarePlacementPolicy(),
+		"azurerm_vmware_workload_network_dhcp":         resourceVmwareWorkloadNetworkDhcp(),
+		"azurerm_vmware_workload_network_dns_service":  resourceVmwareWorkloadNetworkDnsService(),
+		"azurerm_vmware_workload_network_dns_zone":     resourceVmwareWorkloadNetworkDnsZone(),
+		"azurerm_vmware_workload_network_segment":      resourceVmwareWorkloadNetworkSegment(),
 	}
 }
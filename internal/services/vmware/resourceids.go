@@ -0,0 +1,3 @@
+package vmware
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=IdentitySource -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AVS/privateClouds/privateCloud1/identitySources/identitySource1
@@ -0,0 +1,15 @@
+package addons
+
+import "github.com/Azure/go-autorest/autorest"
+
+type AddonsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAddonsClientWithBaseURI(endpoint string) AddonsClient {
+	return AddonsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
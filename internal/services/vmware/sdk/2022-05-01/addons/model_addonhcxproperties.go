@@ -0,0 +1,39 @@
+package addons
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ AddonProperties = AddonHcxProperties{}
+
+type AddonHcxProperties struct {
+	Offer             string                  `json:"offer"`
+	ProvisioningState *AddonProvisioningState `json:"provisioningState,omitempty"`
+
+	// Fields inherited from AddonProperties
+}
+
+var _ json.Marshaler = AddonHcxProperties{}
+
+func (s AddonHcxProperties) MarshalJSON() ([]byte, error) {
+	type wrapper AddonHcxProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling AddonHcxProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling AddonHcxProperties: %+v", err)
+	}
+	decoded["addonType"] = "HCX"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling AddonHcxProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
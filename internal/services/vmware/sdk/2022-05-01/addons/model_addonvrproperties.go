@@ -0,0 +1,39 @@
+package addons
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ AddonProperties = AddonVrProperties{}
+
+type AddonVrProperties struct {
+	ProvisioningState *AddonProvisioningState `json:"provisioningState,omitempty"`
+	VrsCount          int64                   `json:"vrsCount"`
+
+	// Fields inherited from AddonProperties
+}
+
+var _ json.Marshaler = AddonVrProperties{}
+
+func (s AddonVrProperties) MarshalJSON() ([]byte, error) {
+	type wrapper AddonVrProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling AddonVrProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling AddonVrProperties: %+v", err)
+	}
+	decoded["addonType"] = "VR"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling AddonVrProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,15 @@
+package globalreachconnections
+
+import "github.com/Azure/go-autorest/autorest"
+
+type GlobalReachConnectionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewGlobalReachConnectionsClientWithBaseURI(endpoint string) GlobalReachConnectionsClient {
+	return GlobalReachConnectionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,65 @@
+package globalreachconnections
+
+import "strings"
+
+type GlobalReachConnectionProvisioningState string
+
+const (
+	GlobalReachConnectionProvisioningStateFailed    GlobalReachConnectionProvisioningState = "Failed"
+	GlobalReachConnectionProvisioningStateSucceeded GlobalReachConnectionProvisioningState = "Succeeded"
+	GlobalReachConnectionProvisioningStateUpdating  GlobalReachConnectionProvisioningState = "Updating"
+)
+
+func PossibleValuesForGlobalReachConnectionProvisioningState() []string {
+	return []string{
+		string(GlobalReachConnectionProvisioningStateFailed),
+		string(GlobalReachConnectionProvisioningStateSucceeded),
+		string(GlobalReachConnectionProvisioningStateUpdating),
+	}
+}
+
+func parseGlobalReachConnectionProvisioningState(input string) (*GlobalReachConnectionProvisioningState, error) {
+	vals := map[string]GlobalReachConnectionProvisioningState{
+		"failed":    GlobalReachConnectionProvisioningStateFailed,
+		"succeeded": GlobalReachConnectionProvisioningStateSucceeded,
+		"updating":  GlobalReachConnectionProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GlobalReachConnectionProvisioningState(input)
+	return &out, nil
+}
+
+type GlobalReachConnectionStatus string
+
+const (
+	GlobalReachConnectionStatusConnected    GlobalReachConnectionStatus = "Connected"
+	GlobalReachConnectionStatusConnecting   GlobalReachConnectionStatus = "Connecting"
+	GlobalReachConnectionStatusDisconnected GlobalReachConnectionStatus = "Disconnected"
+)
+
+func PossibleValuesForGlobalReachConnectionStatus() []string {
+	return []string{
+		string(GlobalReachConnectionStatusConnected),
+		string(GlobalReachConnectionStatusConnecting),
+		string(GlobalReachConnectionStatusDisconnected),
+	}
+}
+
+func parseGlobalReachConnectionStatus(input string) (*GlobalReachConnectionStatus, error) {
+	vals := map[string]GlobalReachConnectionStatus{
+		"connected":    GlobalReachConnectionStatusConnected,
+		"connecting":   GlobalReachConnectionStatusConnecting,
+		"disconnected": GlobalReachConnectionStatusDisconnected,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := GlobalReachConnectionStatus(input)
+	return &out, nil
+}
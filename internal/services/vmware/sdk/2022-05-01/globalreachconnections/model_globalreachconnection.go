@@ -0,0 +1,8 @@
+package globalreachconnections
+
+type GlobalReachConnection struct {
+	Id         *string                          `json:"id,omitempty"`
+	Name       *string                          `json:"name,omitempty"`
+	Properties *GlobalReachConnectionProperties `json:"properties,omitempty"`
+	Type       *string                          `json:"type,omitempty"`
+}
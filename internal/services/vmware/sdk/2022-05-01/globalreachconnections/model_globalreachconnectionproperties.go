@@ -0,0 +1,10 @@
+package globalreachconnections
+
+type GlobalReachConnectionProperties struct {
+	AddressPrefix           *string                                 `json:"addressPrefix,omitempty"`
+	AuthorizationKey        *string                                 `json:"authorizationKey,omitempty"`
+	CircuitConnectionStatus *GlobalReachConnectionStatus            `json:"circuitConnectionStatus,omitempty"`
+	ExpressRouteId          *string                                 `json:"expressRouteId,omitempty"`
+	PeerExpressRouteCircuit *string                                 `json:"peerExpressRouteCircuit,omitempty"`
+	ProvisioningState       *GlobalReachConnectionProvisioningState `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,42 @@
+package placementpolicies
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ PlacementPolicyProperties = VMPlacementPolicyProperties{}
+
+type VMPlacementPolicyProperties struct {
+	AffinityType      AffinityType                      `json:"affinityType"`
+	VMMembers         []string                          `json:"vmMembers"`
+	DisplayName       *string                           `json:"displayName,omitempty"`
+	ProvisioningState *PlacementPolicyProvisioningState `json:"provisioningState,omitempty"`
+	State             *PlacementPolicyState             `json:"state,omitempty"`
+
+	// Fields inherited from PlacementPolicyProperties
+}
+
+var _ json.Marshaler = VMPlacementPolicyProperties{}
+
+func (s VMPlacementPolicyProperties) MarshalJSON() ([]byte, error) {
+	type wrapper VMPlacementPolicyProperties
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling VMPlacementPolicyProperties: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling VMPlacementPolicyProperties: %+v", err)
+	}
+	decoded["type"] = "VmVm"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling VMPlacementPolicyProperties: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,15 @@
+package workloadnetworks
+
+import "github.com/Azure/go-autorest/autorest"
+
+type WorkloadNetworksClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewWorkloadNetworksClientWithBaseURI(endpoint string) WorkloadNetworksClient {
+	return WorkloadNetworksClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
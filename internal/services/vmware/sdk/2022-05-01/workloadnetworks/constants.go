@@ -0,0 +1,272 @@
+package workloadnetworks
+
+import "strings"
+
+type DhcpTypeEnum string
+
+const (
+	DhcpTypeEnumRELAY  DhcpTypeEnum = "RELAY"
+	DhcpTypeEnumSERVER DhcpTypeEnum = "SERVER"
+)
+
+func PossibleValuesForDhcpTypeEnum() []string {
+	return []string{
+		string(DhcpTypeEnumRELAY),
+		string(DhcpTypeEnumSERVER),
+	}
+}
+
+func parseDhcpTypeEnum(input string) (*DhcpTypeEnum, error) {
+	vals := map[string]DhcpTypeEnum{
+		"relay":  DhcpTypeEnumRELAY,
+		"server": DhcpTypeEnumSERVER,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DhcpTypeEnum(input)
+	return &out, nil
+}
+
+type DnsServiceLogLevelEnum string
+
+const (
+	DnsServiceLogLevelEnumDEBUG   DnsServiceLogLevelEnum = "DEBUG"
+	DnsServiceLogLevelEnumERROR   DnsServiceLogLevelEnum = "ERROR"
+	DnsServiceLogLevelEnumFATAL   DnsServiceLogLevelEnum = "FATAL"
+	DnsServiceLogLevelEnumINFO    DnsServiceLogLevelEnum = "INFO"
+	DnsServiceLogLevelEnumWARNING DnsServiceLogLevelEnum = "WARNING"
+)
+
+func PossibleValuesForDnsServiceLogLevelEnum() []string {
+	return []string{
+		string(DnsServiceLogLevelEnumDEBUG),
+		string(DnsServiceLogLevelEnumERROR),
+		string(DnsServiceLogLevelEnumFATAL),
+		string(DnsServiceLogLevelEnumINFO),
+		string(DnsServiceLogLevelEnumWARNING),
+	}
+}
+
+func parseDnsServiceLogLevelEnum(input string) (*DnsServiceLogLevelEnum, error) {
+	vals := map[string]DnsServiceLogLevelEnum{
+		"debug":   DnsServiceLogLevelEnumDEBUG,
+		"error":   DnsServiceLogLevelEnumERROR,
+		"fatal":   DnsServiceLogLevelEnumFATAL,
+		"info":    DnsServiceLogLevelEnumINFO,
+		"warning": DnsServiceLogLevelEnumWARNING,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DnsServiceLogLevelEnum(input)
+	return &out, nil
+}
+
+type DnsServiceStatusEnum string
+
+const (
+	DnsServiceStatusEnumFAILURE DnsServiceStatusEnum = "FAILURE"
+	DnsServiceStatusEnumSUCCESS DnsServiceStatusEnum = "SUCCESS"
+)
+
+func PossibleValuesForDnsServiceStatusEnum() []string {
+	return []string{
+		string(DnsServiceStatusEnumFAILURE),
+		string(DnsServiceStatusEnumSUCCESS),
+	}
+}
+
+func parseDnsServiceStatusEnum(input string) (*DnsServiceStatusEnum, error) {
+	vals := map[string]DnsServiceStatusEnum{
+		"failure": DnsServiceStatusEnumFAILURE,
+		"success": DnsServiceStatusEnumSUCCESS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DnsServiceStatusEnum(input)
+	return &out, nil
+}
+
+type SegmentStatusEnum string
+
+const (
+	SegmentStatusEnumFAILURE SegmentStatusEnum = "FAILURE"
+	SegmentStatusEnumSUCCESS SegmentStatusEnum = "SUCCESS"
+)
+
+func PossibleValuesForSegmentStatusEnum() []string {
+	return []string{
+		string(SegmentStatusEnumFAILURE),
+		string(SegmentStatusEnumSUCCESS),
+	}
+}
+
+func parseSegmentStatusEnum(input string) (*SegmentStatusEnum, error) {
+	vals := map[string]SegmentStatusEnum{
+		"failure": SegmentStatusEnumFAILURE,
+		"success": SegmentStatusEnumSUCCESS,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SegmentStatusEnum(input)
+	return &out, nil
+}
+
+type WorkloadNetworkDhcpProvisioningState string
+
+const (
+	WorkloadNetworkDhcpProvisioningStateBuilding  WorkloadNetworkDhcpProvisioningState = "Building"
+	WorkloadNetworkDhcpProvisioningStateDeleting  WorkloadNetworkDhcpProvisioningState = "Deleting"
+	WorkloadNetworkDhcpProvisioningStateFailed    WorkloadNetworkDhcpProvisioningState = "Failed"
+	WorkloadNetworkDhcpProvisioningStateSucceeded WorkloadNetworkDhcpProvisioningState = "Succeeded"
+	WorkloadNetworkDhcpProvisioningStateUpdating  WorkloadNetworkDhcpProvisioningState = "Updating"
+)
+
+func PossibleValuesForWorkloadNetworkDhcpProvisioningState() []string {
+	return []string{
+		string(WorkloadNetworkDhcpProvisioningStateBuilding),
+		string(WorkloadNetworkDhcpProvisioningStateDeleting),
+		string(WorkloadNetworkDhcpProvisioningStateFailed),
+		string(WorkloadNetworkDhcpProvisioningStateSucceeded),
+		string(WorkloadNetworkDhcpProvisioningStateUpdating),
+	}
+}
+
+func parseWorkloadNetworkDhcpProvisioningState(input string) (*WorkloadNetworkDhcpProvisioningState, error) {
+	vals := map[string]WorkloadNetworkDhcpProvisioningState{
+		"building":  WorkloadNetworkDhcpProvisioningStateBuilding,
+		"deleting":  WorkloadNetworkDhcpProvisioningStateDeleting,
+		"failed":    WorkloadNetworkDhcpProvisioningStateFailed,
+		"succeeded": WorkloadNetworkDhcpProvisioningStateSucceeded,
+		"updating":  WorkloadNetworkDhcpProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WorkloadNetworkDhcpProvisioningState(input)
+	return &out, nil
+}
+
+type WorkloadNetworkDnsServiceProvisioningState string
+
+const (
+	WorkloadNetworkDnsServiceProvisioningStateBuilding  WorkloadNetworkDnsServiceProvisioningState = "Building"
+	WorkloadNetworkDnsServiceProvisioningStateDeleting  WorkloadNetworkDnsServiceProvisioningState = "Deleting"
+	WorkloadNetworkDnsServiceProvisioningStateFailed    WorkloadNetworkDnsServiceProvisioningState = "Failed"
+	WorkloadNetworkDnsServiceProvisioningStateSucceeded WorkloadNetworkDnsServiceProvisioningState = "Succeeded"
+	WorkloadNetworkDnsServiceProvisioningStateUpdating  WorkloadNetworkDnsServiceProvisioningState = "Updating"
+)
+
+func PossibleValuesForWorkloadNetworkDnsServiceProvisioningState() []string {
+	return []string{
+		string(WorkloadNetworkDnsServiceProvisioningStateBuilding),
+		string(WorkloadNetworkDnsServiceProvisioningStateDeleting),
+		string(WorkloadNetworkDnsServiceProvisioningStateFailed),
+		string(WorkloadNetworkDnsServiceProvisioningStateSucceeded),
+		string(WorkloadNetworkDnsServiceProvisioningStateUpdating),
+	}
+}
+
+func parseWorkloadNetworkDnsServiceProvisioningState(input string) (*WorkloadNetworkDnsServiceProvisioningState, error) {
+	vals := map[string]WorkloadNetworkDnsServiceProvisioningState{
+		"building":  WorkloadNetworkDnsServiceProvisioningStateBuilding,
+		"deleting":  WorkloadNetworkDnsServiceProvisioningStateDeleting,
+		"failed":    WorkloadNetworkDnsServiceProvisioningStateFailed,
+		"succeeded": WorkloadNetworkDnsServiceProvisioningStateSucceeded,
+		"updating":  WorkloadNetworkDnsServiceProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WorkloadNetworkDnsServiceProvisioningState(input)
+	return &out, nil
+}
+
+type WorkloadNetworkDnsZoneProvisioningState string
+
+const (
+	WorkloadNetworkDnsZoneProvisioningStateBuilding  WorkloadNetworkDnsZoneProvisioningState = "Building"
+	WorkloadNetworkDnsZoneProvisioningStateDeleting  WorkloadNetworkDnsZoneProvisioningState = "Deleting"
+	WorkloadNetworkDnsZoneProvisioningStateFailed    WorkloadNetworkDnsZoneProvisioningState = "Failed"
+	WorkloadNetworkDnsZoneProvisioningStateSucceeded WorkloadNetworkDnsZoneProvisioningState = "Succeeded"
+	WorkloadNetworkDnsZoneProvisioningStateUpdating  WorkloadNetworkDnsZoneProvisioningState = "Updating"
+)
+
+func PossibleValuesForWorkloadNetworkDnsZoneProvisioningState() []string {
+	return []string{
+		string(WorkloadNetworkDnsZoneProvisioningStateBuilding),
+		string(WorkloadNetworkDnsZoneProvisioningStateDeleting),
+		string(WorkloadNetworkDnsZoneProvisioningStateFailed),
+		string(WorkloadNetworkDnsZoneProvisioningStateSucceeded),
+		string(WorkloadNetworkDnsZoneProvisioningStateUpdating),
+	}
+}
+
+func parseWorkloadNetworkDnsZoneProvisioningState(input string) (*WorkloadNetworkDnsZoneProvisioningState, error) {
+	vals := map[string]WorkloadNetworkDnsZoneProvisioningState{
+		"building":  WorkloadNetworkDnsZoneProvisioningStateBuilding,
+		"deleting":  WorkloadNetworkDnsZoneProvisioningStateDeleting,
+		"failed":    WorkloadNetworkDnsZoneProvisioningStateFailed,
+		"succeeded": WorkloadNetworkDnsZoneProvisioningStateSucceeded,
+		"updating":  WorkloadNetworkDnsZoneProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WorkloadNetworkDnsZoneProvisioningState(input)
+	return &out, nil
+}
+
+type WorkloadNetworkSegmentProvisioningState string
+
+const (
+	WorkloadNetworkSegmentProvisioningStateBuilding  WorkloadNetworkSegmentProvisioningState = "Building"
+	WorkloadNetworkSegmentProvisioningStateDeleting  WorkloadNetworkSegmentProvisioningState = "Deleting"
+	WorkloadNetworkSegmentProvisioningStateFailed    WorkloadNetworkSegmentProvisioningState = "Failed"
+	WorkloadNetworkSegmentProvisioningStateSucceeded WorkloadNetworkSegmentProvisioningState = "Succeeded"
+	WorkloadNetworkSegmentProvisioningStateUpdating  WorkloadNetworkSegmentProvisioningState = "Updating"
+)
+
+func PossibleValuesForWorkloadNetworkSegmentProvisioningState() []string {
+	return []string{
+		string(WorkloadNetworkSegmentProvisioningStateBuilding),
+		string(WorkloadNetworkSegmentProvisioningStateDeleting),
+		string(WorkloadNetworkSegmentProvisioningStateFailed),
+		string(WorkloadNetworkSegmentProvisioningStateSucceeded),
+		string(WorkloadNetworkSegmentProvisioningStateUpdating),
+	}
+}
+
+func parseWorkloadNetworkSegmentProvisioningState(input string) (*WorkloadNetworkSegmentProvisioningState, error) {
+	vals := map[string]WorkloadNetworkSegmentProvisioningState{
+		"building":  WorkloadNetworkSegmentProvisioningStateBuilding,
+		"deleting":  WorkloadNetworkSegmentProvisioningStateDeleting,
+		"failed":    WorkloadNetworkSegmentProvisioningStateFailed,
+		"succeeded": WorkloadNetworkSegmentProvisioningStateSucceeded,
+		"updating":  WorkloadNetworkSegmentProvisioningStateUpdating,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := WorkloadNetworkSegmentProvisioningState(input)
+	return &out, nil
+}
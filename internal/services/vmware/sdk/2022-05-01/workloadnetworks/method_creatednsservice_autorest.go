@@ -0,0 +1,75 @@
+package workloadnetworks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CreateDnsServiceResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateDnsService ...
+func (c WorkloadNetworksClient) CreateDnsService(ctx context.Context, id DnsServiceId, input WorkloadNetworkDnsService) (result CreateDnsServiceResponse, err error) {
+	req, err := c.preparerForCreateDnsService(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "CreateDnsService", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateDnsService(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "CreateDnsService", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateDnsServiceThenPoll performs CreateDnsService then polls until it's completed
+func (c WorkloadNetworksClient) CreateDnsServiceThenPoll(ctx context.Context, id DnsServiceId, input WorkloadNetworkDnsService) error {
+	result, err := c.CreateDnsService(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateDnsService: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateDnsService: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateDnsService prepares the CreateDnsService request.
+func (c WorkloadNetworksClient) preparerForCreateDnsService(ctx context.Context, id DnsServiceId, input WorkloadNetworkDnsService) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateDnsService sends the CreateDnsService request. The method will close the
+// http.Response Body if it receives an error.
+func (c WorkloadNetworksClient) senderForCreateDnsService(ctx context.Context, req *http.Request) (future CreateDnsServiceResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,75 @@
+package workloadnetworks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type CreateSegmentResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// CreateSegment ...
+func (c WorkloadNetworksClient) CreateSegment(ctx context.Context, id SegmentId, input WorkloadNetworkSegment) (result CreateSegmentResponse, err error) {
+	req, err := c.preparerForCreateSegment(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "CreateSegment", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForCreateSegment(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "CreateSegment", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// CreateSegmentThenPoll performs CreateSegment then polls until it's completed
+func (c WorkloadNetworksClient) CreateSegmentThenPoll(ctx context.Context, id SegmentId, input WorkloadNetworkSegment) error {
+	result, err := c.CreateSegment(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing CreateSegment: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after CreateSegment: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForCreateSegment prepares the CreateSegment request.
+func (c WorkloadNetworksClient) preparerForCreateSegment(ctx context.Context, id SegmentId, input WorkloadNetworkSegment) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForCreateSegment sends the CreateSegment request. The method will close the
+// http.Response Body if it receives an error.
+func (c WorkloadNetworksClient) senderForCreateSegment(ctx context.Context, req *http.Request) (future CreateSegmentResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
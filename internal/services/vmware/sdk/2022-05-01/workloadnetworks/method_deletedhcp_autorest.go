@@ -0,0 +1,73 @@
+package workloadnetworks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type DeleteDhcpResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// DeleteDhcp ...
+func (c WorkloadNetworksClient) DeleteDhcp(ctx context.Context, id DhcpConfigurationId) (result DeleteDhcpResponse, err error) {
+	req, err := c.preparerForDeleteDhcp(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "DeleteDhcp", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDeleteDhcp(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "DeleteDhcp", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DeleteDhcpThenPoll performs DeleteDhcp then polls until it's completed
+func (c WorkloadNetworksClient) DeleteDhcpThenPoll(ctx context.Context, id DhcpConfigurationId) error {
+	result, err := c.DeleteDhcp(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing DeleteDhcp: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DeleteDhcp: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDeleteDhcp prepares the DeleteDhcp request.
+func (c WorkloadNetworksClient) preparerForDeleteDhcp(ctx context.Context, id DhcpConfigurationId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDeleteDhcp sends the DeleteDhcp request. The method will close the
+// http.Response Body if it receives an error.
+func (c WorkloadNetworksClient) senderForDeleteDhcp(ctx context.Context, req *http.Request) (future DeleteDhcpResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,73 @@
+package workloadnetworks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type DeleteDnsZoneResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// DeleteDnsZone ...
+func (c WorkloadNetworksClient) DeleteDnsZone(ctx context.Context, id DnsZoneId) (result DeleteDnsZoneResponse, err error) {
+	req, err := c.preparerForDeleteDnsZone(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "DeleteDnsZone", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForDeleteDnsZone(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "DeleteDnsZone", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// DeleteDnsZoneThenPoll performs DeleteDnsZone then polls until it's completed
+func (c WorkloadNetworksClient) DeleteDnsZoneThenPoll(ctx context.Context, id DnsZoneId) error {
+	result, err := c.DeleteDnsZone(ctx, id)
+	if err != nil {
+		return fmt.Errorf("performing DeleteDnsZone: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after DeleteDnsZone: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForDeleteDnsZone prepares the DeleteDnsZone request.
+func (c WorkloadNetworksClient) preparerForDeleteDnsZone(ctx context.Context, id DnsZoneId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForDeleteDnsZone sends the DeleteDnsZone request. The method will close the
+// http.Response Body if it receives an error.
+func (c WorkloadNetworksClient) senderForDeleteDnsZone(ctx context.Context, req *http.Request) (future DeleteDnsZoneResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,64 @@
+package workloadnetworks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+type GetDnsServiceResponse struct {
+	HttpResponse *http.Response
+	Model        *WorkloadNetworkDnsService
+}
+
+// GetDnsService ...
+func (c WorkloadNetworksClient) GetDnsService(ctx context.Context, id DnsServiceId) (result GetDnsServiceResponse, err error) {
+	req, err := c.preparerForGetDnsService(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "GetDnsService", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "GetDnsService", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForGetDnsService(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "GetDnsService", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForGetDnsService prepares the GetDnsService request.
+func (c WorkloadNetworksClient) preparerForGetDnsService(ctx context.Context, id DnsServiceId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForGetDnsService handles the response to the GetDnsService request. The method always
+// closes the http.Response Body.
+func (c WorkloadNetworksClient) responderForGetDnsService(resp *http.Response) (result GetDnsServiceResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
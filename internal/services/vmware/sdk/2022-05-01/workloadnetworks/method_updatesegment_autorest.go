@@ -0,0 +1,75 @@
+package workloadnetworks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+type UpdateSegmentResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// UpdateSegment ...
+func (c WorkloadNetworksClient) UpdateSegment(ctx context.Context, id SegmentId, input WorkloadNetworkSegment) (result UpdateSegmentResponse, err error) {
+	req, err := c.preparerForUpdateSegment(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "UpdateSegment", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForUpdateSegment(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "workloadnetworks.WorkloadNetworksClient", "UpdateSegment", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// UpdateSegmentThenPoll performs UpdateSegment then polls until it's completed
+func (c WorkloadNetworksClient) UpdateSegmentThenPoll(ctx context.Context, id SegmentId, input WorkloadNetworkSegment) error {
+	result, err := c.UpdateSegment(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing UpdateSegment: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after UpdateSegment: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForUpdateSegment prepares the UpdateSegment request.
+func (c WorkloadNetworksClient) preparerForUpdateSegment(ctx context.Context, id SegmentId, input WorkloadNetworkSegment) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForUpdateSegment sends the UpdateSegment request. The method will close the
+// http.Response Body if it receives an error.
+func (c WorkloadNetworksClient) senderForUpdateSegment(ctx context.Context, req *http.Request) (future UpdateSegmentResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
@@ -0,0 +1,41 @@
+package workloadnetworks
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type WorkloadNetworkDhcp struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties WorkloadNetworkDhcpEntity `json:"properties"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+var _ json.Unmarshaler = &WorkloadNetworkDhcp{}
+
+func (s *WorkloadNetworkDhcp) UnmarshalJSON(bytes []byte) error {
+	type alias WorkloadNetworkDhcp
+	var decoded alias
+	if err := json.Unmarshal(bytes, &decoded); err != nil {
+		return fmt.Errorf("unmarshaling into WorkloadNetworkDhcp: %+v", err)
+	}
+
+	s.Id = decoded.Id
+	s.Name = decoded.Name
+	s.Type = decoded.Type
+
+	var temp map[string]json.RawMessage
+	if err := json.Unmarshal(bytes, &temp); err != nil {
+		return fmt.Errorf("unmarshaling WorkloadNetworkDhcp into map[string]json.RawMessage: %+v", err)
+	}
+
+	if v, ok := temp["properties"]; ok {
+		impl, err := unmarshalWorkloadNetworkDhcpEntityImplementation(v)
+		if err != nil {
+			return fmt.Errorf("unmarshaling field 'Properties' for 'WorkloadNetworkDhcp': %+v", err)
+		}
+		s.Properties = impl
+	}
+	return nil
+}
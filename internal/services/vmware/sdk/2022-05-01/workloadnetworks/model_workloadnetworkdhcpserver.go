@@ -0,0 +1,43 @@
+package workloadnetworks
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+var _ WorkloadNetworkDhcpEntity = WorkloadNetworkDhcpServer{}
+
+type WorkloadNetworkDhcpServer struct {
+	LeaseTime         *int64                                `json:"leaseTime,omitempty"`
+	ServerAddress     *string                               `json:"serverAddress,omitempty"`
+	DisplayName       *string                               `json:"displayName,omitempty"`
+	ProvisioningState *WorkloadNetworkDhcpProvisioningState `json:"provisioningState,omitempty"`
+	Revision          *int64                                `json:"revision,omitempty"`
+	Segments          *[]string                             `json:"segments,omitempty"`
+
+	// Fields inherited from WorkloadNetworkDhcpEntity
+}
+
+var _ json.Marshaler = WorkloadNetworkDhcpServer{}
+
+func (s WorkloadNetworkDhcpServer) MarshalJSON() ([]byte, error) {
+	type wrapper WorkloadNetworkDhcpServer
+	wrapped := wrapper(s)
+	encoded, err := json.Marshal(wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling WorkloadNetworkDhcpServer: %+v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("unmarshaling WorkloadNetworkDhcpServer: %+v", err)
+	}
+	decoded["dhcpType"] = "SERVER"
+
+	encoded, err = json.Marshal(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("re-marshaling WorkloadNetworkDhcpServer: %+v", err)
+	}
+
+	return encoded, nil
+}
@@ -0,0 +1,12 @@
+package workloadnetworks
+
+type WorkloadNetworkDnsServiceProperties struct {
+	DefaultDnsZone    *string                                     `json:"defaultDnsZone,omitempty"`
+	DisplayName       *string                                     `json:"displayName,omitempty"`
+	DnsServiceIP      *string                                     `json:"dnsServiceIp,omitempty"`
+	FqdnZones         *[]string                                   `json:"fqdnZones,omitempty"`
+	LogLevel          *DnsServiceLogLevelEnum                     `json:"logLevel,omitempty"`
+	ProvisioningState *WorkloadNetworkDnsServiceProvisioningState `json:"provisioningState,omitempty"`
+	Revision          *int64                                      `json:"revision,omitempty"`
+	Status            *DnsServiceStatusEnum                       `json:"status,omitempty"`
+}
@@ -0,0 +1,8 @@
+package workloadnetworks
+
+type WorkloadNetworkDnsZone struct {
+	Id         *string                           `json:"id,omitempty"`
+	Name       *string                           `json:"name,omitempty"`
+	Properties *WorkloadNetworkDnsZoneProperties `json:"properties,omitempty"`
+	Type       *string                           `json:"type,omitempty"`
+}
@@ -0,0 +1,11 @@
+package workloadnetworks
+
+type WorkloadNetworkDnsZoneProperties struct {
+	DisplayName       *string                                  `json:"displayName,omitempty"`
+	DnsServerIPs      *[]string                                `json:"dnsServerIps,omitempty"`
+	DnsServices       *int64                                   `json:"dnsServices,omitempty"`
+	Domain            *[]string                                `json:"domain,omitempty"`
+	ProvisioningState *WorkloadNetworkDnsZoneProvisioningState `json:"provisioningState,omitempty"`
+	Revision          *int64                                   `json:"revision,omitempty"`
+	SourceIP          *string                                  `json:"sourceIp,omitempty"`
+}
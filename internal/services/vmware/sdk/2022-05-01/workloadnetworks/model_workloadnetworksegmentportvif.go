@@ -0,0 +1,5 @@
+package workloadnetworks
+
+type WorkloadNetworkSegmentPortVif struct {
+	PortName *string `json:"portName,omitempty"`
+}
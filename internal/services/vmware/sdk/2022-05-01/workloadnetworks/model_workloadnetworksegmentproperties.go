@@ -0,0 +1,11 @@
+package workloadnetworks
+
+type WorkloadNetworkSegmentProperties struct {
+	ConnectedGateway  *string                                  `json:"connectedGateway,omitempty"`
+	DisplayName       *string                                  `json:"displayName,omitempty"`
+	PortVif           *[]WorkloadNetworkSegmentPortVif         `json:"portVif,omitempty"`
+	ProvisioningState *WorkloadNetworkSegmentProvisioningState `json:"provisioningState,omitempty"`
+	Revision          *int64                                   `json:"revision,omitempty"`
+	Status            *SegmentStatusEnum                       `json:"status,omitempty"`
+	Subnet            *WorkloadNetworkSegmentSubnet            `json:"subnet,omitempty"`
+}
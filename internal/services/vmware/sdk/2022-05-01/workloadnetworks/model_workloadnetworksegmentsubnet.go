@@ -0,0 +1,6 @@
+package workloadnetworks
+
+type WorkloadNetworkSegmentSubnet struct {
+	DhcpRanges     *[]string `json:"dhcpRanges,omitempty"`
+	GatewayAddress *string   `json:"gatewayAddress,omitempty"`
+}
@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+func LdapServerUrl(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if !strings.HasPrefix(v, "ldap://") && !strings.HasPrefix(v, "ldaps://") {
+		errors = append(errors, fmt.Errorf("%q must start with `ldap://` or `ldaps://`, got %q", key, v))
+		return
+	}
+
+	if host := strings.TrimPrefix(strings.TrimPrefix(v, "ldaps://"), "ldap://"); host == "" {
+		errors = append(errors, fmt.Errorf("%q must include a host, got %q", key, v))
+	}
+
+	return
+}
@@ -0,0 +1,49 @@
+package validate
+
+import "testing"
+
+func TestLdapServerUrl(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "example.com:389",
+			Valid: false,
+		},
+		{
+			Input: "https://example.com",
+			Valid: false,
+		},
+		{
+			Input: "ldap://",
+			Valid: false,
+		},
+		{
+			Input: "ldaps://",
+			Valid: false,
+		},
+		{
+			Input: "ldap://example.com:389",
+			Valid: true,
+		},
+		{
+			Input: "ldaps://example.com:636",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := LdapServerUrl(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
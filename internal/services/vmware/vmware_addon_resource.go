@@ -0,0 +1,212 @@
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/addons"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwareAddon() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwareAddonCreateUpdate,
+		Read:   resourceVmwareAddonRead,
+		Update: resourceVmwareAddonCreateUpdate,
+		Delete: resourceVmwareAddonDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := addons.ParseAddonID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVmwareAddonCustomizeDiff),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(addons.AddonTypeHCX),
+					string(addons.AddonTypeSRM),
+					string(addons.AddonTypeVR),
+				}, false),
+			},
+
+			"hcx_offer": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"VMware MaaS Cloud Provider",
+					"VMware MaaS Cloud Provider (Enterprise)",
+				}, false),
+			},
+
+			"srm_license_key": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"vr_server_count": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+		},
+	}
+}
+
+func resourceVmwareAddonCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.AddonClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := addons.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// each type of addon can only be enabled once per Private Cloud, where it's named after the type
+	addonType := d.Get("type").(string)
+	id := addons.NewAddonID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, strings.ToLower(addonType))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_vmware_addon", id.ID())
+		}
+	}
+
+	var props addons.AddonProperties
+	switch addons.AddonType(addonType) {
+	case addons.AddonTypeHCX:
+		props = addons.AddonHcxProperties{
+			Offer: d.Get("hcx_offer").(string),
+		}
+	case addons.AddonTypeSRM:
+		props = addons.AddonSrmProperties{
+			LicenseKey: utils.String(d.Get("srm_license_key").(string)),
+		}
+	case addons.AddonTypeVR:
+		props = addons.AddonVrProperties{
+			VrsCount: int64(d.Get("vr_server_count").(int)),
+		}
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, addons.Addon{Properties: props}); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwareAddonRead(d, meta)
+}
+
+func resourceVmwareAddonRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.AddonClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := addons.ParseAddonID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("private_cloud_id", addons.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID())
+
+	if model := resp.Model; model != nil {
+		switch props := model.Properties.(type) {
+		case addons.AddonHcxProperties:
+			d.Set("type", string(addons.AddonTypeHCX))
+			d.Set("hcx_offer", props.Offer)
+		case addons.AddonSrmProperties:
+			d.Set("type", string(addons.AddonTypeSRM))
+			// the license key isn't returned by the API
+		case addons.AddonVrProperties:
+			d.Set("type", string(addons.AddonTypeVR))
+			d.Set("vr_server_count", int(props.VrsCount))
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwareAddonDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.AddonClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := addons.ParseAddonID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func resourceVmwareAddonCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	required := map[string]string{
+		string(addons.AddonTypeHCX): "hcx_offer",
+		string(addons.AddonTypeSRM): "srm_license_key",
+		string(addons.AddonTypeVR):  "vr_server_count",
+	}
+
+	addonType := diff.Get("type").(string)
+	for t, field := range required {
+		_, ok := diff.GetOk(field)
+		if t == addonType && !ok {
+			return fmt.Errorf("`%s` must be specified when `type` is `%s`", field, t)
+		}
+		if t != addonType && ok {
+			return fmt.Errorf("`%s` can only be specified when `type` is `%s`", field, t)
+		}
+	}
+
+	return nil
+}
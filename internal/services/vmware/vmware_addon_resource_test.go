@@ -0,0 +1,124 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/addons"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareAddonResource struct {
+}
+
+func TestAccVmwareAddon_hcx(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_addon", "test")
+	r := VmwareAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hcx(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareAddon_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_addon", "test")
+	r := VmwareAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hcx(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareAddon_vr(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_addon", "test")
+	r := VmwareAddonResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vr(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.vr(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("vr_server_count").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwareAddonResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := addons.ParseAddonID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.AddonClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwareAddonResource) hcx(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_addon" "test" {
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  type             = "HCX"
+  hcx_offer        = "VMware MaaS Cloud Provider"
+}
+`, VmwarePrivateCloudResource{}.basic(data))
+}
+
+func (r VmwareAddonResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_addon" "import" {
+  private_cloud_id = azurerm_vmware_addon.test.private_cloud_id
+  type             = azurerm_vmware_addon.test.type
+  hcx_offer        = azurerm_vmware_addon.test.hcx_offer
+}
+`, r.hcx(data))
+}
+
+func (r VmwareAddonResource) vr(data acceptance.TestData, serverCount int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_addon" "test" {
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  type             = "VR"
+  vr_server_count  = %d
+}
+`, VmwarePrivateCloudResource{}.basic(data), serverCount)
+}
@@ -0,0 +1,172 @@
+package vmware
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/globalreachconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwareGlobalReachConnection() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwareGlobalReachConnectionCreate,
+		Read:   resourceVmwareGlobalReachConnectionRead,
+		Delete: resourceVmwareGlobalReachConnectionDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := globalreachconnections.ParseGlobalReachConnectionID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"peer_express_route_circuit_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"authorization_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"address_prefix": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"circuit_connection_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVmwareGlobalReachConnectionCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.GlobalReachConnectionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := globalreachconnections.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := globalreachconnections.NewGlobalReachConnectionID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_global_reach_connection", id.ID())
+	}
+
+	payload := globalreachconnections.GlobalReachConnection{
+		Properties: &globalreachconnections.GlobalReachConnectionProperties{
+			AuthorizationKey:        utils.String(d.Get("authorization_key").(string)),
+			PeerExpressRouteCircuit: utils.String(d.Get("peer_express_route_circuit_id").(string)),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwareGlobalReachConnectionRead(d, meta)
+}
+
+func resourceVmwareGlobalReachConnectionRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.GlobalReachConnectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := globalreachconnections.ParseGlobalReachConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.GlobalReachConnectionName)
+	d.Set("private_cloud_id", globalreachconnections.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("peer_express_route_circuit_id", props.PeerExpressRouteCircuit)
+			d.Set("address_prefix", props.AddressPrefix)
+
+			status := ""
+			if props.CircuitConnectionStatus != nil {
+				status = string(*props.CircuitConnectionStatus)
+			}
+			d.Set("circuit_connection_status", status)
+			// the authorization key isn't returned by the API, so is intentionally not set
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwareGlobalReachConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.GlobalReachConnectionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := globalreachconnections.ParseGlobalReachConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/globalreachconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareGlobalReachConnectionResource struct {
+	peerCircuitId    string
+	authorizationKey string
+}
+
+// the peer ExpressRoute Circuit needs to be provisioned by a connectivity provider, so an existing Circuit is used
+func newVmwareGlobalReachConnectionResource(t *testing.T) VmwareGlobalReachConnectionResource {
+	r := VmwareGlobalReachConnectionResource{
+		peerCircuitId:    os.Getenv("ARM_TEST_VMWARE_PEER_EXPRESS_ROUTE_CIRCUIT_ID"),
+		authorizationKey: os.Getenv("ARM_TEST_VMWARE_PEER_EXPRESS_ROUTE_AUTHORIZATION_KEY"),
+	}
+	if r.peerCircuitId == "" || r.authorizationKey == "" {
+		t.Skip("Skipping as ARM_TEST_VMWARE_PEER_EXPRESS_ROUTE_CIRCUIT_ID and ARM_TEST_VMWARE_PEER_EXPRESS_ROUTE_AUTHORIZATION_KEY are not specified")
+	}
+	return r
+}
+
+func TestAccVmwareGlobalReachConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_global_reach_connection", "test")
+	r := newVmwareGlobalReachConnectionResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("circuit_connection_status").Exists(),
+			),
+		},
+		data.ImportStep("authorization_key"),
+	})
+}
+
+func TestAccVmwareGlobalReachConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_global_reach_connection", "test")
+	r := newVmwareGlobalReachConnectionResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (VmwareGlobalReachConnectionResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := globalreachconnections.ParseGlobalReachConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.GlobalReachConnectionClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwareGlobalReachConnectionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_global_reach_connection" "test" {
+  name                          = "acctest-GRC-%d"
+  private_cloud_id              = azurerm_vmware_private_cloud.test.id
+  peer_express_route_circuit_id = %q
+  authorization_key             = %q
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger, r.peerCircuitId, r.authorizationKey)
+}
+
+func (r VmwareGlobalReachConnectionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_global_reach_connection" "import" {
+  name                          = azurerm_vmware_global_reach_connection.test.name
+  private_cloud_id              = azurerm_vmware_global_reach_connection.test.private_cloud_id
+  peer_express_route_circuit_id = azurerm_vmware_global_reach_connection.test.peer_express_route_circuit_id
+  authorization_key             = %q
+}
+`, r.basic(data), r.authorizationKey)
+}
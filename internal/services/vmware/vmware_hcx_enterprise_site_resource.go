@@ -0,0 +1,148 @@
+package vmware
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/hcxenterprisesites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func resourceVmwareHcxEnterpriseSite() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwareHcxEnterpriseSiteCreate,
+		Read:   resourceVmwareHcxEnterpriseSiteRead,
+		Delete: resourceVmwareHcxEnterpriseSiteDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := hcxenterprisesites.ParseHcxEnterpriseSiteID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"activation_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVmwareHcxEnterpriseSiteCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.HcxEnterpriseSiteClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := hcxenterprisesites.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := hcxenterprisesites.NewHcxEnterpriseSiteID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_hcx_enterprise_site", id.ID())
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id, hcxenterprisesites.HcxEnterpriseSite{}); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwareHcxEnterpriseSiteRead(d, meta)
+}
+
+func resourceVmwareHcxEnterpriseSiteRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.HcxEnterpriseSiteClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := hcxenterprisesites.ParseHcxEnterpriseSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.HcxEnterpriseSiteName)
+	d.Set("private_cloud_id", hcxenterprisesites.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("activation_key", props.ActivationKey)
+
+			status := ""
+			if props.Status != nil {
+				status = string(*props.Status)
+			}
+			d.Set("status", status)
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwareHcxEnterpriseSiteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.HcxEnterpriseSiteClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := hcxenterprisesites.ParseHcxEnterpriseSiteID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// deleting the site deactivates the activation key
+	if _, err := client.Delete(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,278 @@
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwarePlacementPolicy() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwarePlacementPolicyCreate,
+		Read:   resourceVmwarePlacementPolicyRead,
+		Update: resourceVmwarePlacementPolicyUpdate,
+		Delete: resourceVmwarePlacementPolicyDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := placementpolicies.ParsePlacementPolicyID(id)
+			return err
+		}),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			hostMembers := diff.Get("host_members").(*pluginsdk.Set).Len()
+			switch diff.Get("type").(string) {
+			case string(placementpolicies.PlacementPolicyTypeVmHost):
+				if hostMembers == 0 {
+					return fmt.Errorf("`host_members` must be specified when `type` is `%s`", string(placementpolicies.PlacementPolicyTypeVmHost))
+				}
+			case string(placementpolicies.PlacementPolicyTypeVmVm):
+				if hostMembers > 0 {
+					return fmt.Errorf("`host_members` can only be specified when `type` is `%s`", string(placementpolicies.PlacementPolicyTypeVmHost))
+				}
+				if diff.Get("virtual_machine_ids").(*pluginsdk.Set).Len() < 2 {
+					return fmt.Errorf("at least two `virtual_machine_ids` must be specified when `type` is `%s`", string(placementpolicies.PlacementPolicyTypeVmVm))
+				}
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"cluster_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ClusterID,
+			},
+
+			"type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(placementpolicies.PossibleValuesForPlacementPolicyType(), false),
+			},
+
+			"affinity_type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(placementpolicies.PossibleValuesForAffinityType(), false),
+			},
+
+			"virtual_machine_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"host_members": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceVmwarePlacementPolicyCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	clusterId, err := placementpolicies.ParseClusterID(d.Get("cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := placementpolicies.NewPlacementPolicyID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.PrivateCloudName, clusterId.ClusterName, d.Get("name").(string))
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_placement_policy", id.ID())
+	}
+
+	state := expandVmwarePlacementPolicyState(d.Get("enabled").(bool))
+	affinityType := placementpolicies.AffinityType(d.Get("affinity_type").(string))
+	vmMembers := *utils.ExpandStringSlice(d.Get("virtual_machine_ids").(*pluginsdk.Set).List())
+
+	var displayName *string
+	if v := d.Get("display_name").(string); v != "" {
+		displayName = utils.String(v)
+	}
+
+	var props placementpolicies.PlacementPolicyProperties
+	if d.Get("type").(string) == string(placementpolicies.PlacementPolicyTypeVmHost) {
+		props = placementpolicies.VMHostPlacementPolicyProperties{
+			AffinityType: affinityType,
+			DisplayName:  displayName,
+			HostMembers:  *utils.ExpandStringSlice(d.Get("host_members").(*pluginsdk.Set).List()),
+			State:        &state,
+			VMMembers:    vmMembers,
+		}
+	} else {
+		props = placementpolicies.VMPlacementPolicyProperties{
+			AffinityType: affinityType,
+			DisplayName:  displayName,
+			State:        &state,
+			VMMembers:    vmMembers,
+		}
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, id, placementpolicies.PlacementPolicy{Properties: props}); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwarePlacementPolicyRead(d, meta)
+}
+
+func resourceVmwarePlacementPolicyRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := placementpolicies.ParsePlacementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.PlacementPolicyName)
+	d.Set("cluster_id", placementpolicies.NewClusterID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName, id.ClusterName).ID())
+
+	if model := resp.Model; model != nil {
+		switch props := model.Properties.(type) {
+		case placementpolicies.VMHostPlacementPolicyProperties:
+			d.Set("type", string(placementpolicies.PlacementPolicyTypeVmHost))
+			d.Set("affinity_type", string(props.AffinityType))
+			d.Set("display_name", props.DisplayName)
+			d.Set("enabled", props.State == nil || *props.State == placementpolicies.PlacementPolicyStateEnabled)
+			if err := d.Set("host_members", props.HostMembers); err != nil {
+				return fmt.Errorf("setting `host_members`: %+v", err)
+			}
+			if err := d.Set("virtual_machine_ids", props.VMMembers); err != nil {
+				return fmt.Errorf("setting `virtual_machine_ids`: %+v", err)
+			}
+		case placementpolicies.VMPlacementPolicyProperties:
+			d.Set("type", string(placementpolicies.PlacementPolicyTypeVmVm))
+			d.Set("affinity_type", string(props.AffinityType))
+			d.Set("display_name", props.DisplayName)
+			d.Set("enabled", props.State == nil || *props.State == placementpolicies.PlacementPolicyStateEnabled)
+			if err := d.Set("virtual_machine_ids", props.VMMembers); err != nil {
+				return fmt.Errorf("setting `virtual_machine_ids`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwarePlacementPolicyUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := placementpolicies.ParsePlacementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	props := placementpolicies.PlacementPolicyUpdateProperties{}
+	if d.HasChange("enabled") {
+		state := expandVmwarePlacementPolicyState(d.Get("enabled").(bool))
+		props.State = &state
+	}
+	if d.HasChange("virtual_machine_ids") {
+		props.VMMembers = utils.ExpandStringSlice(d.Get("virtual_machine_ids").(*pluginsdk.Set).List())
+	}
+	if d.HasChange("host_members") {
+		props.HostMembers = utils.ExpandStringSlice(d.Get("host_members").(*pluginsdk.Set).List())
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, placementpolicies.PlacementPolicyUpdate{Properties: &props}); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVmwarePlacementPolicyRead(d, meta)
+}
+
+func resourceVmwarePlacementPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PlacementPolicyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := placementpolicies.ParsePlacementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVmwarePlacementPolicyState(enabled bool) placementpolicies.PlacementPolicyState {
+	if enabled {
+		return placementpolicies.PlacementPolicyStateEnabled
+	}
+	return placementpolicies.PlacementPolicyStateDisabled
+}
@@ -0,0 +1,160 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/placementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwarePlacementPolicyResource struct {
+	clusterId         string
+	virtualMachineIds []string
+	hostMember        string
+}
+
+// Placement Policies reference Virtual Machines and Hosts within vCenter, which can't be provisioned
+// through Terraform - so these tests run against an existing Cluster
+func newVmwarePlacementPolicyResource(t *testing.T) VmwarePlacementPolicyResource {
+	r := VmwarePlacementPolicyResource{
+		clusterId:  os.Getenv("ARM_TEST_VMWARE_CLUSTER_ID"),
+		hostMember: os.Getenv("ARM_TEST_VMWARE_HOST_MEMBER"),
+	}
+	if v := os.Getenv("ARM_TEST_VMWARE_VIRTUAL_MACHINE_IDS"); v != "" {
+		r.virtualMachineIds = strings.Split(v, ",")
+	}
+	if r.clusterId == "" || r.hostMember == "" || len(r.virtualMachineIds) < 2 {
+		t.Skip("Skipping as ARM_TEST_VMWARE_CLUSTER_ID, ARM_TEST_VMWARE_HOST_MEMBER and ARM_TEST_VMWARE_VIRTUAL_MACHINE_IDS (a comma separated list of at least two IDs) are not specified")
+	}
+	return r
+}
+
+func TestAccVmwarePlacementPolicy_vmVm(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_placement_policy", "test")
+	r := newVmwarePlacementPolicyResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vmVm(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.vmVm(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwarePlacementPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_placement_policy", "test")
+	r := newVmwarePlacementPolicyResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vmVm(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwarePlacementPolicy_vmHost(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_placement_policy", "test")
+	r := newVmwarePlacementPolicyResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.vmHost(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwarePlacementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := placementpolicies.ParsePlacementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.PlacementPolicyClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r VmwarePlacementPolicyResource) vmVm(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+  disable_correlation_request_id = true
+}
+
+resource "azurerm_vmware_placement_policy" "test" {
+  name                = "acctest-PP-%d"
+  cluster_id          = %q
+  type                = "VmVm"
+  affinity_type       = "AntiAffinity"
+  virtual_machine_ids = [%q, %q]
+  enabled             = %t
+}
+`, data.RandomInteger, r.clusterId, r.virtualMachineIds[0], r.virtualMachineIds[1], enabled)
+}
+
+func (r VmwarePlacementPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_placement_policy" "import" {
+  name                = azurerm_vmware_placement_policy.test.name
+  cluster_id          = azurerm_vmware_placement_policy.test.cluster_id
+  type                = azurerm_vmware_placement_policy.test.type
+  affinity_type       = azurerm_vmware_placement_policy.test.affinity_type
+  virtual_machine_ids = azurerm_vmware_placement_policy.test.virtual_machine_ids
+}
+`, r.vmVm(data, true))
+}
+
+func (r VmwarePlacementPolicyResource) vmHost(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+  disable_correlation_request_id = true
+}
+
+resource "azurerm_vmware_placement_policy" "test" {
+  name                = "acctest-PP-%d"
+  cluster_id          = %q
+  type                = "VmHost"
+  affinity_type       = "Affinity"
+  virtual_machine_ids = [%q]
+  host_members        = [%q]
+  display_name        = "acctest"
+}
+`, data.RandomInteger, r.clusterId, r.virtualMachineIds[0], r.hostMember)
+}
@@ -0,0 +1,308 @@
+package vmware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/privateclouds"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// Identity Sources aren't a sub-resource of the Private Cloud within the API, instead they're a list
+// on the Private Cloud - as such this resource manages a single entry within that list
+func resourceVmwarePrivateCloudIdentitySource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwarePrivateCloudIdentitySourceCreate,
+		Read:   resourceVmwarePrivateCloudIdentitySourceRead,
+		Update: resourceVmwarePrivateCloudIdentitySourceUpdate,
+		Delete: resourceVmwarePrivateCloudIdentitySourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(1 * time.Hour),
+			Delete: pluginsdk.DefaultTimeout(1 * time.Hour),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IdentitySourceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"alias": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"domain": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"base_user_dn": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"base_group_dn": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"primary_server_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.LdapServerUrl,
+			},
+
+			"secondary_server_url": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.LdapServerUrl,
+			},
+
+			"ssl_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"username": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"password": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceVmwarePrivateCloudIdentitySourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := privateclouds.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewIdentitySourceID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, d.Get("name").(string))
+
+	locks.ByID(privateCloudId.ID())
+	defer locks.UnlockByID(privateCloudId.ID())
+
+	identitySources, err := getVmwarePrivateCloudIdentitySources(ctx, client, *privateCloudId)
+	if err != nil {
+		return err
+	}
+
+	if findVmwarePrivateCloudIdentitySource(identitySources, id.Name) != -1 {
+		return tf.ImportAsExistsError("azurerm_vmware_private_cloud_identity_source", id.ID())
+	}
+
+	identitySources = append(identitySources, expandVmwarePrivateCloudIdentitySource(d))
+	if err := setVmwarePrivateCloudIdentitySources(ctx, client, *privateCloudId, identitySources); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwarePrivateCloudIdentitySourceRead(d, meta)
+}
+
+func resourceVmwarePrivateCloudIdentitySourceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IdentitySourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	privateCloudId := privateclouds.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroup, id.PrivateCloudName)
+	identitySources, err := getVmwarePrivateCloudIdentitySources(ctx, client, privateCloudId)
+	if err != nil {
+		return err
+	}
+
+	index := findVmwarePrivateCloudIdentitySource(identitySources, id.Name)
+	if index == -1 {
+		log.Printf("[INFO] %s was not found - removing from state", *id)
+		d.SetId("")
+		return nil
+	}
+	identitySource := identitySources[index]
+
+	d.Set("name", id.Name)
+	d.Set("private_cloud_id", privateCloudId.ID())
+	d.Set("alias", identitySource.Alias)
+	d.Set("domain", identitySource.Domain)
+	d.Set("base_user_dn", identitySource.BaseUserDN)
+	d.Set("base_group_dn", identitySource.BaseGroupDN)
+	d.Set("primary_server_url", identitySource.PrimaryServer)
+	d.Set("secondary_server_url", identitySource.SecondaryServer)
+	d.Set("ssl_enabled", identitySource.Ssl != nil && *identitySource.Ssl == privateclouds.SslEnumEnabled)
+	d.Set("username", identitySource.Username)
+	// the password isn't returned by the API, so is intentionally not set
+
+	return nil
+}
+
+func resourceVmwarePrivateCloudIdentitySourceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IdentitySourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	privateCloudId := privateclouds.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroup, id.PrivateCloudName)
+
+	locks.ByID(privateCloudId.ID())
+	defer locks.UnlockByID(privateCloudId.ID())
+
+	identitySources, err := getVmwarePrivateCloudIdentitySources(ctx, client, privateCloudId)
+	if err != nil {
+		return err
+	}
+
+	index := findVmwarePrivateCloudIdentitySource(identitySources, id.Name)
+	if index == -1 {
+		return fmt.Errorf("%s was not found", *id)
+	}
+
+	identitySources[index] = expandVmwarePrivateCloudIdentitySource(d)
+	if err := setVmwarePrivateCloudIdentitySources(ctx, client, privateCloudId, identitySources); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVmwarePrivateCloudIdentitySourceRead(d, meta)
+}
+
+func resourceVmwarePrivateCloudIdentitySourceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.PrivateCloudClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.IdentitySourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	privateCloudId := privateclouds.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroup, id.PrivateCloudName)
+
+	locks.ByID(privateCloudId.ID())
+	defer locks.UnlockByID(privateCloudId.ID())
+
+	identitySources, err := getVmwarePrivateCloudIdentitySources(ctx, client, privateCloudId)
+	if err != nil {
+		return err
+	}
+
+	index := findVmwarePrivateCloudIdentitySource(identitySources, id.Name)
+	if index == -1 {
+		return nil
+	}
+
+	identitySources = append(identitySources[:index], identitySources[index+1:]...)
+	if err := setVmwarePrivateCloudIdentitySources(ctx, client, privateCloudId, identitySources); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func getVmwarePrivateCloudIdentitySources(ctx context.Context, client *privateclouds.PrivateCloudsClient, id privateclouds.PrivateCloudId) ([]privateclouds.IdentitySource, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	identitySources := make([]privateclouds.IdentitySource, 0)
+	if model := resp.Model; model != nil && model.Properties.IdentitySources != nil {
+		identitySources = append(identitySources, *model.Properties.IdentitySources...)
+	}
+	return identitySources, nil
+}
+
+func setVmwarePrivateCloudIdentitySources(ctx context.Context, client *privateclouds.PrivateCloudsClient, id privateclouds.PrivateCloudId, identitySources []privateclouds.IdentitySource) error {
+	payload := privateclouds.PrivateCloudUpdate{
+		Properties: &privateclouds.PrivateCloudUpdateProperties{
+			IdentitySources: &identitySources,
+		},
+	}
+	return client.UpdateThenPoll(ctx, id, payload)
+}
+
+func findVmwarePrivateCloudIdentitySource(input []privateclouds.IdentitySource, name string) int {
+	for i, v := range input {
+		if v.Name != nil && strings.EqualFold(*v.Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func expandVmwarePrivateCloudIdentitySource(d *pluginsdk.ResourceData) privateclouds.IdentitySource {
+	ssl := privateclouds.SslEnumDisabled
+	if d.Get("ssl_enabled").(bool) {
+		ssl = privateclouds.SslEnumEnabled
+	}
+
+	identitySource := privateclouds.IdentitySource{
+		Name:          utils.String(d.Get("name").(string)),
+		Alias:         utils.String(d.Get("alias").(string)),
+		Domain:        utils.String(d.Get("domain").(string)),
+		BaseUserDN:    utils.String(d.Get("base_user_dn").(string)),
+		BaseGroupDN:   utils.String(d.Get("base_group_dn").(string)),
+		PrimaryServer: utils.String(d.Get("primary_server_url").(string)),
+		Ssl:           &ssl,
+		Username:      utils.String(d.Get("username").(string)),
+		Password:      utils.String(d.Get("password").(string)),
+	}
+
+	if v := d.Get("secondary_server_url").(string); v != "" {
+		identitySource.SecondaryServer = utils.String(v)
+	}
+
+	return identitySource
+}
@@ -0,0 +1,158 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2020-03-20/privateclouds"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwarePrivateCloudIdentitySourceResource struct {
+	domain     string
+	serverUrl  string
+	username   string
+	password   string
+	baseUserDN string
+}
+
+// the Identity Source needs to point at an LDAP server which is reachable from the Private Cloud
+func newVmwarePrivateCloudIdentitySourceResource(t *testing.T) VmwarePrivateCloudIdentitySourceResource {
+	r := VmwarePrivateCloudIdentitySourceResource{
+		domain:    os.Getenv("ARM_TEST_VMWARE_LDAP_DOMAIN"),
+		serverUrl: os.Getenv("ARM_TEST_VMWARE_LDAP_SERVER_URL"),
+		username:  os.Getenv("ARM_TEST_VMWARE_LDAP_USERNAME"),
+		password:  os.Getenv("ARM_TEST_VMWARE_LDAP_PASSWORD"),
+	}
+	if r.domain == "" || r.serverUrl == "" || r.username == "" || r.password == "" {
+		t.Skip("Skipping as ARM_TEST_VMWARE_LDAP_DOMAIN, ARM_TEST_VMWARE_LDAP_SERVER_URL, ARM_TEST_VMWARE_LDAP_USERNAME and ARM_TEST_VMWARE_LDAP_PASSWORD are not specified")
+	}
+
+	components := make([]string, 0)
+	for _, v := range strings.Split(r.domain, ".") {
+		components = append(components, fmt.Sprintf("dc=%s", v))
+	}
+	r.baseUserDN = strings.Join(components, ",")
+
+	return r
+}
+
+func TestAccVmwarePrivateCloudIdentitySource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud_identity_source", "test")
+	r := newVmwarePrivateCloudIdentitySourceResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "acctest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccVmwarePrivateCloudIdentitySource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud_identity_source", "test")
+	r := newVmwarePrivateCloudIdentitySourceResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "acctest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwarePrivateCloudIdentitySource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_private_cloud_identity_source", "test")
+	r := newVmwarePrivateCloudIdentitySourceResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "acctest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.basic(data, "acctest-updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alias").HasValue("acctest-updated"),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func (VmwarePrivateCloudIdentitySourceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.IdentitySourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	privateCloudId := privateclouds.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroup, id.PrivateCloudName)
+	resp, err := clients.Vmware.PrivateCloudClient.Get(ctx, privateCloudId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", privateCloudId, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties.IdentitySources != nil {
+		for _, v := range *model.Properties.IdentitySources {
+			if v.Name != nil && strings.EqualFold(*v.Name, id.Name) {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r VmwarePrivateCloudIdentitySourceResource) basic(data acceptance.TestData, alias string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_private_cloud_identity_source" "test" {
+  name               = "acctest-IS-%d"
+  private_cloud_id   = azurerm_vmware_private_cloud.test.id
+  alias              = %q
+  domain             = %q
+  base_user_dn       = %q
+  base_group_dn      = %q
+  primary_server_url = %q
+  username           = %q
+  password           = %q
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger, alias, r.domain, r.baseUserDN, r.baseUserDN, r.serverUrl, r.username, r.password)
+}
+
+func (r VmwarePrivateCloudIdentitySourceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_private_cloud_identity_source" "import" {
+  name               = azurerm_vmware_private_cloud_identity_source.test.name
+  private_cloud_id   = azurerm_vmware_private_cloud_identity_source.test.private_cloud_id
+  alias              = azurerm_vmware_private_cloud_identity_source.test.alias
+  domain             = azurerm_vmware_private_cloud_identity_source.test.domain
+  base_user_dn       = azurerm_vmware_private_cloud_identity_source.test.base_user_dn
+  base_group_dn      = azurerm_vmware_private_cloud_identity_source.test.base_group_dn
+  primary_server_url = azurerm_vmware_private_cloud_identity_source.test.primary_server_url
+  username           = azurerm_vmware_private_cloud_identity_source.test.username
+  password           = %q
+}
+`, r.basic(data, "acctest"), r.password)
+}
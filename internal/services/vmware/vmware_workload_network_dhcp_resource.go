@@ -0,0 +1,272 @@
+package vmware
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwareWorkloadNetworkDhcp() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwareWorkloadNetworkDhcpCreate,
+		Read:   resourceVmwareWorkloadNetworkDhcpRead,
+		Update: resourceVmwareWorkloadNetworkDhcpUpdate,
+		Delete: resourceVmwareWorkloadNetworkDhcpDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := workloadnetworks.ParseDhcpConfigurationID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// the type of DHCP configuration can't be changed once created
+			"server": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"server", "relay"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"server_address_cidr": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+
+						"lease_time_in_seconds": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      86400,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"relay": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"server", "relay"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"server_addresses": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 3,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.IsIPv4Address,
+							},
+						},
+					},
+				},
+			},
+
+			"segments": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceVmwareWorkloadNetworkDhcpCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := workloadnetworks.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := workloadnetworks.NewDhcpConfigurationID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, d.Get("name").(string))
+	existing, err := client.GetDhcp(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_workload_network_dhcp", id.ID())
+	}
+
+	if err := client.CreateDhcpThenPoll(ctx, id, expandVmwareWorkloadNetworkDhcp(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwareWorkloadNetworkDhcpRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkDhcpRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDhcpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetDhcp(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DhcpConfigurationName)
+	d.Set("private_cloud_id", workloadnetworks.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID())
+
+	if model := resp.Model; model != nil {
+		server := make([]interface{}, 0)
+		relay := make([]interface{}, 0)
+		var displayName *string
+		var segments *[]string
+
+		switch props := model.Properties.(type) {
+		case workloadnetworks.WorkloadNetworkDhcpServer:
+			displayName = props.DisplayName
+			segments = props.Segments
+
+			leaseTime := 0
+			if props.LeaseTime != nil {
+				leaseTime = int(*props.LeaseTime)
+			}
+			server = append(server, map[string]interface{}{
+				"server_address_cidr":   utils.NormalizeNilableString(props.ServerAddress),
+				"lease_time_in_seconds": leaseTime,
+			})
+		case workloadnetworks.WorkloadNetworkDhcpRelay:
+			displayName = props.DisplayName
+			segments = props.Segments
+
+			relay = append(relay, map[string]interface{}{
+				"server_addresses": utils.FlattenStringSlice(props.ServerAddresses),
+			})
+		}
+
+		d.Set("display_name", displayName)
+		if err := d.Set("server", server); err != nil {
+			return fmt.Errorf("setting `server`: %+v", err)
+		}
+		if err := d.Set("relay", relay); err != nil {
+			return fmt.Errorf("setting `relay`: %+v", err)
+		}
+		if err := d.Set("segments", utils.FlattenStringSlice(segments)); err != nil {
+			return fmt.Errorf("setting `segments`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwareWorkloadNetworkDhcpUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDhcpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.UpdateDhcpThenPoll(ctx, *id, expandVmwareWorkloadNetworkDhcp(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVmwareWorkloadNetworkDhcpRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkDhcpDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDhcpConfigurationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteDhcpThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVmwareWorkloadNetworkDhcp(d *pluginsdk.ResourceData) workloadnetworks.WorkloadNetworkDhcp {
+	displayName := utils.String(d.Get("display_name").(string))
+
+	if v := d.Get("relay").([]interface{}); len(v) > 0 && v[0] != nil {
+		relay := v[0].(map[string]interface{})
+		return workloadnetworks.WorkloadNetworkDhcp{
+			Properties: workloadnetworks.WorkloadNetworkDhcpRelay{
+				DisplayName:     displayName,
+				ServerAddresses: utils.ExpandStringSlice(relay["server_addresses"].([]interface{})),
+			},
+		}
+	}
+
+	props := workloadnetworks.WorkloadNetworkDhcpServer{
+		DisplayName: displayName,
+	}
+	if v := d.Get("server").([]interface{}); len(v) > 0 && v[0] != nil {
+		server := v[0].(map[string]interface{})
+		props.ServerAddress = utils.String(server["server_address_cidr"].(string))
+		props.LeaseTime = utils.Int64(int64(server["lease_time_in_seconds"].(int)))
+	}
+
+	return workloadnetworks.WorkloadNetworkDhcp{
+		Properties: props,
+	}
+}
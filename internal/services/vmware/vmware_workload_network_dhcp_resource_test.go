@@ -0,0 +1,135 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareWorkloadNetworkDhcpResource struct {
+}
+
+func TestAccVmwareWorkloadNetworkDhcp_server(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dhcp", "test")
+	r := VmwareWorkloadNetworkDhcpResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.server(data, "acctest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.server(data, "acctest-updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("acctest-updated"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkDhcp_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dhcp", "test")
+	r := VmwareWorkloadNetworkDhcpResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.server(data, "acctest"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkDhcp_relay(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dhcp", "test")
+	r := VmwareWorkloadNetworkDhcpResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.relay(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwareWorkloadNetworkDhcpResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workloadnetworks.ParseDhcpConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.WorkloadNetworkClient.GetDhcp(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (VmwareWorkloadNetworkDhcpResource) server(data acceptance.TestData, displayName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dhcp" "test" {
+  name             = "acctest-DHCP-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  display_name     = %q
+
+  server {
+    server_address_cidr   = "10.200.0.1/24"
+    lease_time_in_seconds = 3600
+  }
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger, displayName)
+}
+
+func (r VmwareWorkloadNetworkDhcpResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dhcp" "import" {
+  name             = azurerm_vmware_workload_network_dhcp.test.name
+  private_cloud_id = azurerm_vmware_workload_network_dhcp.test.private_cloud_id
+
+  server {
+    server_address_cidr = azurerm_vmware_workload_network_dhcp.test.server.0.server_address_cidr
+  }
+}
+`, r.server(data, "acctest"))
+}
+
+func (VmwareWorkloadNetworkDhcpResource) relay(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dhcp" "test" {
+  name             = "acctest-DHCP-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+
+  relay {
+    server_addresses = ["10.200.0.10", "10.200.0.11"]
+  }
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,219 @@
+package vmware
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwareWorkloadNetworkDnsService() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwareWorkloadNetworkDnsServiceCreate,
+		Read:   resourceVmwareWorkloadNetworkDnsServiceRead,
+		Update: resourceVmwareWorkloadNetworkDnsServiceUpdate,
+		Delete: resourceVmwareWorkloadNetworkDnsServiceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := workloadnetworks.ParseDnsServiceID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"dns_service_ip": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+
+			"default_dns_zone_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"fqdn_zone_names": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"log_level": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(workloadnetworks.DnsServiceLogLevelEnumINFO),
+				ValidateFunc: validation.StringInSlice(workloadnetworks.PossibleValuesForDnsServiceLogLevelEnum(), false),
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVmwareWorkloadNetworkDnsServiceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := workloadnetworks.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := workloadnetworks.NewDnsServiceID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, d.Get("name").(string))
+	existing, err := client.GetDnsService(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_workload_network_dns_service", id.ID())
+	}
+
+	if err := client.CreateDnsServiceThenPoll(ctx, id, expandVmwareWorkloadNetworkDnsService(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwareWorkloadNetworkDnsServiceRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkDnsServiceRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDnsServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetDnsService(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DnsServiceName)
+	d.Set("private_cloud_id", workloadnetworks.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("dns_service_ip", props.DnsServiceIP)
+			d.Set("default_dns_zone_name", props.DefaultDnsZone)
+			d.Set("display_name", props.DisplayName)
+			if err := d.Set("fqdn_zone_names", utils.FlattenStringSlice(props.FqdnZones)); err != nil {
+				return fmt.Errorf("setting `fqdn_zone_names`: %+v", err)
+			}
+
+			logLevel := string(workloadnetworks.DnsServiceLogLevelEnumINFO)
+			if props.LogLevel != nil {
+				logLevel = string(*props.LogLevel)
+			}
+			d.Set("log_level", logLevel)
+
+			status := ""
+			if props.Status != nil {
+				status = string(*props.Status)
+			}
+			d.Set("status", status)
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwareWorkloadNetworkDnsServiceUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDnsServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.UpdateDnsServiceThenPoll(ctx, *id, expandVmwareWorkloadNetworkDnsService(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVmwareWorkloadNetworkDnsServiceRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkDnsServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDnsServiceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteDnsServiceThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVmwareWorkloadNetworkDnsService(d *pluginsdk.ResourceData) workloadnetworks.WorkloadNetworkDnsService {
+	logLevel := workloadnetworks.DnsServiceLogLevelEnum(d.Get("log_level").(string))
+	return workloadnetworks.WorkloadNetworkDnsService{
+		Properties: &workloadnetworks.WorkloadNetworkDnsServiceProperties{
+			DefaultDnsZone: utils.String(d.Get("default_dns_zone_name").(string)),
+			DisplayName:    utils.String(d.Get("display_name").(string)),
+			DnsServiceIP:   utils.String(d.Get("dns_service_ip").(string)),
+			FqdnZones:      utils.ExpandStringSlice(d.Get("fqdn_zone_names").([]interface{})),
+			LogLevel:       &logLevel,
+		},
+	}
+}
@@ -0,0 +1,157 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareWorkloadNetworkDnsServiceResource struct {
+}
+
+func TestAccVmwareWorkloadNetworkDnsService_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dns_service", "test")
+	r := VmwareWorkloadNetworkDnsServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkDnsService_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dns_service", "test")
+	r := VmwareWorkloadNetworkDnsServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkDnsService_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dns_service", "test")
+	r := VmwareWorkloadNetworkDnsServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log_level").HasValue("WARNING"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwareWorkloadNetworkDnsServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workloadnetworks.ParseDnsServiceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.WorkloadNetworkClient.GetDnsService(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (VmwareWorkloadNetworkDnsServiceResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dns_zone" "test" {
+  name             = "acctest-DNSZone-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  domains          = ["contoso.com"]
+  dns_server_ips   = ["10.0.0.4"]
+}
+
+resource "azurerm_vmware_workload_network_dns_zone" "fqdn" {
+  name             = "acctest-DNSZone2-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  domains          = ["fabrikam.com"]
+  dns_server_ips   = ["10.0.0.5"]
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r VmwareWorkloadNetworkDnsServiceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dns_service" "test" {
+  name                  = "acctest-DNSService-%d"
+  private_cloud_id      = azurerm_vmware_private_cloud.test.id
+  dns_service_ip        = "10.0.0.53"
+  default_dns_zone_name = azurerm_vmware_workload_network_dns_zone.test.name
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VmwareWorkloadNetworkDnsServiceResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dns_service" "import" {
+  name                  = azurerm_vmware_workload_network_dns_service.test.name
+  private_cloud_id      = azurerm_vmware_workload_network_dns_service.test.private_cloud_id
+  dns_service_ip        = azurerm_vmware_workload_network_dns_service.test.dns_service_ip
+  default_dns_zone_name = azurerm_vmware_workload_network_dns_service.test.default_dns_zone_name
+}
+`, r.basic(data))
+}
+
+func (r VmwareWorkloadNetworkDnsServiceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dns_service" "test" {
+  name                  = "acctest-DNSService-%d"
+  private_cloud_id      = azurerm_vmware_private_cloud.test.id
+  dns_service_ip        = "10.0.0.53"
+  default_dns_zone_name = azurerm_vmware_workload_network_dns_zone.test.name
+  display_name          = "acctest-dns"
+  fqdn_zone_names       = [azurerm_vmware_workload_network_dns_zone.fqdn.name]
+  log_level             = "WARNING"
+}
+`, r.template(data), data.RandomInteger)
+}
@@ -0,0 +1,216 @@
+package vmware
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwareWorkloadNetworkDnsZone() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwareWorkloadNetworkDnsZoneCreate,
+		Read:   resourceVmwareWorkloadNetworkDnsZoneRead,
+		Update: resourceVmwareWorkloadNetworkDnsZoneUpdate,
+		Delete: resourceVmwareWorkloadNetworkDnsZoneDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := workloadnetworks.ParseDnsZoneID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"domains": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"dns_server_ips": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_ip": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+
+			"dns_services_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVmwareWorkloadNetworkDnsZoneCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := workloadnetworks.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := workloadnetworks.NewDnsZoneID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, d.Get("name").(string))
+	existing, err := client.GetDnsZone(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_workload_network_dns_zone", id.ID())
+	}
+
+	if err := client.CreateDnsZoneThenPoll(ctx, id, expandVmwareWorkloadNetworkDnsZone(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwareWorkloadNetworkDnsZoneRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetDnsZone(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.DnsZoneName)
+	d.Set("private_cloud_id", workloadnetworks.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			if err := d.Set("domains", utils.FlattenStringSlice(props.Domain)); err != nil {
+				return fmt.Errorf("setting `domains`: %+v", err)
+			}
+			if err := d.Set("dns_server_ips", utils.FlattenStringSlice(props.DnsServerIPs)); err != nil {
+				return fmt.Errorf("setting `dns_server_ips`: %+v", err)
+			}
+			d.Set("display_name", props.DisplayName)
+			d.Set("source_ip", props.SourceIP)
+
+			dnsServicesCount := 0
+			if props.DnsServices != nil {
+				dnsServicesCount = int(*props.DnsServices)
+			}
+			d.Set("dns_services_count", dnsServicesCount)
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwareWorkloadNetworkDnsZoneUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.UpdateDnsZoneThenPoll(ctx, *id, expandVmwareWorkloadNetworkDnsZone(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVmwareWorkloadNetworkDnsZoneRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkDnsZoneDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseDnsZoneID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteDnsZoneThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVmwareWorkloadNetworkDnsZone(d *pluginsdk.ResourceData) workloadnetworks.WorkloadNetworkDnsZone {
+	props := workloadnetworks.WorkloadNetworkDnsZoneProperties{
+		DisplayName:  utils.String(d.Get("display_name").(string)),
+		DnsServerIPs: utils.ExpandStringSlice(d.Get("dns_server_ips").([]interface{})),
+		Domain:       utils.ExpandStringSlice(d.Get("domains").([]interface{})),
+	}
+
+	if v := d.Get("source_ip").(string); v != "" {
+		props.SourceIP = utils.String(v)
+	}
+
+	return workloadnetworks.WorkloadNetworkDnsZone{
+		Properties: &props,
+	}
+}
@@ -0,0 +1,136 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareWorkloadNetworkDnsZoneResource struct {
+}
+
+func TestAccVmwareWorkloadNetworkDnsZone_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dns_zone", "test")
+	r := VmwareWorkloadNetworkDnsZoneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkDnsZone_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dns_zone", "test")
+	r := VmwareWorkloadNetworkDnsZoneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkDnsZone_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_dns_zone", "test")
+	r := VmwareWorkloadNetworkDnsZoneResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dns_server_ips.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwareWorkloadNetworkDnsZoneResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workloadnetworks.ParseDnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.WorkloadNetworkClient.GetDnsZone(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (VmwareWorkloadNetworkDnsZoneResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dns_zone" "test" {
+  name             = "acctest-DNSZone-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  domains          = ["contoso.com"]
+  dns_server_ips   = ["10.0.0.4"]
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VmwareWorkloadNetworkDnsZoneResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dns_zone" "import" {
+  name             = azurerm_vmware_workload_network_dns_zone.test.name
+  private_cloud_id = azurerm_vmware_workload_network_dns_zone.test.private_cloud_id
+  domains          = azurerm_vmware_workload_network_dns_zone.test.domains
+  dns_server_ips   = azurerm_vmware_workload_network_dns_zone.test.dns_server_ips
+}
+`, r.basic(data))
+}
+
+func (VmwareWorkloadNetworkDnsZoneResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_dns_zone" "test" {
+  name             = "acctest-DNSZone-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+  domains          = ["contoso.com", "fabrikam.com"]
+  dns_server_ips   = ["10.0.0.4", "10.0.0.5"]
+  display_name     = "acctest-zone"
+  source_ip        = "10.0.0.10"
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
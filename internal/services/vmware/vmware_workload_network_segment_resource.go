@@ -0,0 +1,220 @@
+package vmware
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceVmwareWorkloadNetworkSegment() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceVmwareWorkloadNetworkSegmentCreate,
+		Read:   resourceVmwareWorkloadNetworkSegmentRead,
+		Update: resourceVmwareWorkloadNetworkSegmentUpdate,
+		Delete: resourceVmwareWorkloadNetworkSegmentDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := workloadnetworks.ParseSegmentID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"private_cloud_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.PrivateCloudID,
+			},
+
+			"display_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"connected_gateway": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"gateway_address_cidr": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			"dhcp_ranges": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVmwareWorkloadNetworkSegmentCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	privateCloudId, err := workloadnetworks.ParsePrivateCloudID(d.Get("private_cloud_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := workloadnetworks.NewSegmentID(privateCloudId.SubscriptionId, privateCloudId.ResourceGroupName, privateCloudId.PrivateCloudName, d.Get("name").(string))
+	existing, err := client.GetSegment(ctx, id)
+	if err != nil {
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_vmware_workload_network_segment", id.ID())
+	}
+
+	if err := client.CreateSegmentThenPoll(ctx, id, expandVmwareWorkloadNetworkSegment(d)); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceVmwareWorkloadNetworkSegmentRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkSegmentRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseSegmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetSegment(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.SegmentName)
+	d.Set("private_cloud_id", workloadnetworks.NewPrivateCloudID(id.SubscriptionId, id.ResourceGroupName, id.PrivateCloudName).ID())
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			d.Set("display_name", props.DisplayName)
+			d.Set("connected_gateway", props.ConnectedGateway)
+
+			gatewayAddress := ""
+			var dhcpRanges *[]string
+			if subnet := props.Subnet; subnet != nil {
+				gatewayAddress = utils.NormalizeNilableString(subnet.GatewayAddress)
+				dhcpRanges = subnet.DhcpRanges
+			}
+			d.Set("gateway_address_cidr", gatewayAddress)
+			if err := d.Set("dhcp_ranges", utils.FlattenStringSlice(dhcpRanges)); err != nil {
+				return fmt.Errorf("setting `dhcp_ranges`: %+v", err)
+			}
+
+			status := ""
+			if props.Status != nil {
+				status = string(*props.Status)
+			}
+			d.Set("status", status)
+		}
+	}
+
+	return nil
+}
+
+func resourceVmwareWorkloadNetworkSegmentUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseSegmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.UpdateSegmentThenPoll(ctx, *id, expandVmwareWorkloadNetworkSegment(d)); err != nil {
+		return fmt.Errorf("updating %s: %+v", *id, err)
+	}
+
+	return resourceVmwareWorkloadNetworkSegmentRead(d, meta)
+}
+
+func resourceVmwareWorkloadNetworkSegmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Vmware.WorkloadNetworkClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := workloadnetworks.ParseSegmentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteSegmentThenPoll(ctx, *id); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandVmwareWorkloadNetworkSegment(d *pluginsdk.ResourceData) workloadnetworks.WorkloadNetworkSegment {
+	props := workloadnetworks.WorkloadNetworkSegmentProperties{
+		DisplayName: utils.String(d.Get("display_name").(string)),
+		Subnet: &workloadnetworks.WorkloadNetworkSegmentSubnet{
+			DhcpRanges: utils.ExpandStringSlice(d.Get("dhcp_ranges").([]interface{})),
+		},
+	}
+
+	if v := d.Get("connected_gateway").(string); v != "" {
+		props.ConnectedGateway = utils.String(v)
+	}
+	if v := d.Get("gateway_address_cidr").(string); v != "" {
+		props.Subnet.GatewayAddress = utils.String(v)
+	}
+
+	return workloadnetworks.WorkloadNetworkSegment{
+		Properties: &props,
+	}
+}
@@ -0,0 +1,131 @@
+package vmware_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/vmware/sdk/2022-05-01/workloadnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VmwareWorkloadNetworkSegmentResource struct {
+}
+
+func TestAccVmwareWorkloadNetworkSegment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_segment", "test")
+	r := VmwareWorkloadNetworkSegmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkSegment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_segment", "test")
+	r := VmwareWorkloadNetworkSegmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVmwareWorkloadNetworkSegment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vmware_workload_network_segment", "test")
+	r := VmwareWorkloadNetworkSegmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dhcp_ranges.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (VmwareWorkloadNetworkSegmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := workloadnetworks.ParseSegmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Vmware.WorkloadNetworkClient.GetSegment(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (VmwareWorkloadNetworkSegmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_segment" "test" {
+  name             = "acctest-Segment-%d"
+  private_cloud_id = azurerm_vmware_private_cloud.test.id
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
+
+func (r VmwareWorkloadNetworkSegmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_segment" "import" {
+  name             = azurerm_vmware_workload_network_segment.test.name
+  private_cloud_id = azurerm_vmware_workload_network_segment.test.private_cloud_id
+}
+`, r.basic(data))
+}
+
+func (VmwareWorkloadNetworkSegmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_vmware_workload_network_segment" "test" {
+  name                 = "acctest-Segment-%d"
+  private_cloud_id     = azurerm_vmware_private_cloud.test.id
+  display_name         = "acctest-segment"
+  gateway_address_cidr = "10.100.0.1/24"
+  dhcp_ranges          = ["10.100.0.10-10.100.0.100"]
+}
+`, VmwarePrivateCloudResource{}.basic(data), data.RandomInteger)
+}
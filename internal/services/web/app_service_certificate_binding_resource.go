@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -23,6 +24,7 @@ func resourceAppServiceCertificateBinding() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAppServiceCertificateBindingCreate,
 		Read:   resourceAppServiceCertificateBindingRead,
+		Update: resourceAppServiceCertificateBindingUpdate,
 		Delete: resourceAppServiceCertificateBindingDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -33,9 +35,25 @@ func resourceAppServiceCertificateBinding() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		// when the Certificate is rotated (e.g. a Managed Certificate or a Key Vault Certificate being renewed) the
+		// binding is re-pointed at the new thumbprint in-place, rather than being removed and re-added
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if diff.Id() == "" {
+					return nil
+				}
+				certificateThumbprint := diff.Get("certificate_thumbprint").(string)
+				if certificateThumbprint != "" && certificateThumbprint != diff.Get("thumbprint").(string) {
+					return diff.SetNew("thumbprint", certificateThumbprint)
+				}
+				return nil
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 
 			"hostname_binding_id": {
@@ -55,7 +73,6 @@ func resourceAppServiceCertificateBinding() *pluginsdk.Resource {
 			"ssl_state": {
 				Type:     pluginsdk.TypeString,
 				Required: true,
-				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(web.SslStateIPBasedEnabled),
 					string(web.SslStateSniEnabled),
@@ -76,6 +93,11 @@ func resourceAppServiceCertificateBinding() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"certificate_thumbprint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -148,6 +170,7 @@ func resourceAppServiceCertificateBindingCreate(d *pluginsdk.ResourceData, meta
 
 func resourceAppServiceCertificateBindingRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -180,9 +203,61 @@ func resourceAppServiceCertificateBindingRead(d *pluginsdk.ResourceData, meta in
 	d.Set("hostname", id.HostnameBindingId.Name)
 	d.Set("app_service_name", id.HostnameBindingId.SiteName)
 
+	// the Certificate's current thumbprint differs from the bound one once it's been rotated
+	certificateThumbprint := ""
+	certDetails, err := certClient.Get(ctx, id.CertificateId.ResourceGroup, id.CertificateId.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(certDetails.Response) {
+			return fmt.Errorf("retrieving App Service Certificate %q (Resource Group %q): %+v", id.CertificateId.Name, id.CertificateId.ResourceGroup, err)
+		}
+	} else if certDetails.CertificateProperties != nil && certDetails.Thumbprint != nil {
+		certificateThumbprint = *certDetails.Thumbprint
+	}
+	d.Set("certificate_thumbprint", certificateThumbprint)
+
 	return nil
 }
 
+func resourceAppServiceCertificateBindingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Web.AppServicesClient
+	certClient := meta.(*clients.Client).Web.CertificatesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.CertificateBindingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	certDetails, err := certClient.Get(ctx, id.CertificateId.ResourceGroup, id.CertificateId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving App Service Certificate %q (Resource Group %q): %+v", id.CertificateId.Name, id.CertificateId.ResourceGroup, err)
+	}
+	if certDetails.CertificateProperties == nil || certDetails.Thumbprint == nil {
+		return fmt.Errorf("could not read thumbprint from certificate %q (resource group %q)", id.CertificateId.Name, id.CertificateId.ResourceGroup)
+	}
+
+	locks.ByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
+	defer locks.UnlockByName(id.HostnameBindingId.SiteName, appServiceHostnameBindingResourceName)
+
+	binding, err := client.GetHostNameBinding(ctx, id.HostnameBindingId.ResourceGroup, id.HostnameBindingId.SiteName, id.HostnameBindingId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving Custom Hostname Certificate Binding %q (App Service %q / Resource Group %q): %+v", id.HostnameBindingId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup, err)
+	}
+	if binding.HostNameBindingProperties == nil {
+		return fmt.Errorf("retrieving Custom Hostname Certificate Binding %q (App Service %q / Resource Group %q): `properties` was nil", id.HostnameBindingId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup)
+	}
+
+	binding.HostNameBindingProperties.SslState = web.SslState(d.Get("ssl_state").(string))
+	binding.HostNameBindingProperties.Thumbprint = certDetails.Thumbprint
+
+	if _, err := client.CreateOrUpdateHostNameBinding(ctx, id.HostnameBindingId.ResourceGroup, id.HostnameBindingId.SiteName, id.HostnameBindingId.Name, binding); err != nil {
+		return fmt.Errorf("updating Custom Hostname Certificate Binding %q (App Service %q / Resource Group %q): %+v", id.HostnameBindingId.Name, id.HostnameBindingId.SiteName, id.HostnameBindingId.ResourceGroup, err)
+	}
+
+	return resourceAppServiceCertificateBindingRead(d, meta)
+}
+
 func resourceAppServiceCertificateBindingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.AppServicesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -55,6 +55,33 @@ func TestAccAppServiceCertificateBinding_basicSniEnabled(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceCertificateBinding_updateSslState(t *testing.T) {
+	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
+		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_certificate_binding", "test")
+	r := AppServiceCertificateBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ssl_state").HasValue("IpBasedEnabled"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicSniEnabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("ssl_state").HasValue("SniEnabled"),
+				check.That(data.ResourceName).Key("certificate_thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccAppServiceCertificateBinding_requiresImport(t *testing.T) {
 	if os.Getenv("ARM_TEST_DNS_ZONE") == "" || os.Getenv("ARM_TEST_DATA_RESOURCE_GROUP") == "" {
 		t.Skip("Skipping as ARM_TEST_DNS_ZONE or ARM_TEST_DATA_RESOURCE_GROUP is not set")
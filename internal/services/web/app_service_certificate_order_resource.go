@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/web/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -82,6 +84,44 @@ func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 				},
 			},
 
+			"key_vault_certificate": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"key_vault_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.VaultID,
+						},
+
+						"key_vault_secret_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemName,
+						},
+
+						"provisioning_state": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"verify_domain_ownership": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"csr": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
@@ -136,6 +176,16 @@ func resourceAppServiceCertificateOrder() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"next_auto_renewal_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_certificate_issuance_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"is_private_key_external": {
 				Type:     pluginsdk.TypeBool,
 				Computed: true,
@@ -243,6 +293,20 @@ func resourceAppServiceCertificateOrderCreateUpdate(d *pluginsdk.ResourceData, m
 
 	d.SetId(*read.ID)
 
+	if d.IsNewResource() || d.HasChange("key_vault_certificate") {
+		if err := setAppServiceCertificateOrderKeyVaultCertificate(ctx, client, d, resourceGroup, name, location); err != nil {
+			return err
+		}
+	}
+
+	// domain ownership can only be verified once the Domain Verification Token has been added to the domain,
+	// so this is only attempted whilst the order is waiting on it
+	if d.Get("verify_domain_ownership").(bool) && read.AppServiceCertificateOrderProperties != nil && read.AppServiceCertificateOrderProperties.Status == web.CertificateOrderStatusPendingissuance {
+		if _, err := client.VerifyDomainOwnership(ctx, resourceGroup, name); err != nil {
+			return fmt.Errorf("verifying domain ownership for App Service Certificate Order %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	return resourceAppServiceCertificateOrderRead(d, meta)
 }
 
@@ -269,6 +333,7 @@ func resourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta inte
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("verify_domain_ownership", d.Get("verify_domain_ownership").(bool))
 
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
@@ -284,6 +349,7 @@ func resourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("status", string(props.Status))
 		d.Set("is_private_key_external", props.IsPrivateKeyExternal)
 		d.Set("certificates", flattenArmCertificateOrderCertificate(props.Certificates))
+		d.Set("key_vault_certificate", flattenArmCertificateOrderKeyVaultCertificate(d.Get("key_vault_certificate").([]interface{}), props.Certificates))
 		d.Set("app_service_certificate_not_renewable_reasons", utils.FlattenStringSlice(props.AppServiceCertificateNotRenewableReasons))
 
 		if productType := props.ProductType; productType == web.CertificateProductTypeStandardDomainValidatedSsl {
@@ -296,6 +362,18 @@ func resourceAppServiceCertificateOrderRead(d *pluginsdk.ResourceData, meta inte
 			d.Set("expiration_time", expirationTime.Format(time.RFC3339))
 		}
 
+		nextAutoRenewalTime := ""
+		if v := props.NextAutoRenewalTimeStamp; v != nil {
+			nextAutoRenewalTime = v.Format(time.RFC3339)
+		}
+		d.Set("next_auto_renewal_time", nextAutoRenewalTime)
+
+		lastCertificateIssuanceTime := ""
+		if v := props.LastCertificateIssuanceTime; v != nil {
+			lastCertificateIssuanceTime = v.Format(time.RFC3339)
+		}
+		d.Set("last_certificate_issuance_time", lastCertificateIssuanceTime)
+
 		if signedCertificate := props.SignedCertificate; signedCertificate != nil {
 			d.Set("signed_certificate_thumbprint", signedCertificate.Thumbprint)
 		}
@@ -355,3 +433,79 @@ func flattenArmCertificateOrderCertificate(input map[string]*web.AppServiceCerti
 
 	return results
 }
+
+func setAppServiceCertificateOrderKeyVaultCertificate(ctx context.Context, client *web.AppServiceCertificateOrdersClient, d *pluginsdk.ResourceData, resourceGroup, name, location string) error {
+	old, new := d.GetChange("key_vault_certificate")
+	oldList := old.([]interface{})
+	newList := new.([]interface{})
+
+	newName := ""
+	if len(newList) > 0 && newList[0] != nil {
+		newName = newList[0].(map[string]interface{})["name"].(string)
+	}
+
+	// the Key Vault binding is keyed on its name, so a rename means removing the previous binding
+	if !d.IsNewResource() && len(oldList) > 0 && oldList[0] != nil {
+		oldName := oldList[0].(map[string]interface{})["name"].(string)
+		if oldName != newName {
+			resp, err := client.DeleteCertificate(ctx, resourceGroup, name, oldName)
+			if err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("removing Key Vault Certificate %q from App Service Certificate Order %q (Resource Group %q): %+v", oldName, name, resourceGroup, err)
+			}
+		}
+	}
+
+	if newName == "" {
+		return nil
+	}
+
+	v := newList[0].(map[string]interface{})
+	certificate := web.AppServiceCertificateResource{
+		AppServiceCertificate: &web.AppServiceCertificate{
+			KeyVaultID:         utils.String(v["key_vault_id"].(string)),
+			KeyVaultSecretName: utils.String(v["key_vault_secret_name"].(string)),
+		},
+		Location: utils.String(location),
+	}
+
+	future, err := client.CreateOrUpdateCertificate(ctx, resourceGroup, name, newName, certificate)
+	if err != nil {
+		return fmt.Errorf("binding Key Vault Certificate %q to App Service Certificate Order %q (Resource Group %q): %+v", newName, name, resourceGroup, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for Key Vault Certificate %q to be bound to App Service Certificate Order %q (Resource Group %q): %+v", newName, name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func flattenArmCertificateOrderKeyVaultCertificate(configured []interface{}, input map[string]*web.AppServiceCertificate) []interface{} {
+	// only the binding managed through this resource is surfaced, since others can be added outside of Terraform
+	if len(configured) == 0 || configured[0] == nil {
+		return []interface{}{}
+	}
+
+	name := configured[0].(map[string]interface{})["name"].(string)
+	v, ok := input[name]
+	if !ok || v == nil {
+		return []interface{}{}
+	}
+
+	keyVaultId := ""
+	if v.KeyVaultID != nil {
+		keyVaultId = *v.KeyVaultID
+	}
+	keyVaultSecretName := ""
+	if v.KeyVaultSecretName != nil {
+		keyVaultSecretName = *v.KeyVaultSecretName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                  name,
+			"key_vault_id":          keyVaultId,
+			"key_vault_secret_name": keyVaultSecretName,
+			"provisioning_state":    string(v.ProvisioningState),
+		},
+	}
+}
@@ -152,6 +152,41 @@ func TestAccAppServiceCertificateOrder_update(t *testing.T) {
 	})
 }
 
+func TestAccAppServiceCertificateOrder_keyVaultCertificate(t *testing.T) {
+	if os.Getenv("ARM_RUN_TEST_APP_SERVICE_CERTIFICATE") == "" {
+		t.Skip("Skipping as ARM_RUN_TEST_APP_SERVICE_CERTIFICATE is not specified")
+		return
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_app_service_certificate_order", "test")
+	r := AppServiceCertificateOrderResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.keyVaultCertificate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_vault_certificate.0.provisioning_state").Exists(),
+			),
+		},
+		data.ImportStep("key_vault_certificate", "verify_domain_ownership"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r AppServiceCertificateOrderResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.CertificateOrderID(state.ID)
 	if err != nil {
@@ -248,3 +283,65 @@ resource "azurerm_app_service_certificate_order" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, keySize)
 }
+
+func (r AppServiceCertificateOrderResource) keyVaultCertificate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "test" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%[3]s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.test.tenant_id
+  sku_name            = "standard"
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.test.tenant_id
+    object_id = data.azurerm_client_config.test.object_id
+
+    secret_permissions = [
+      "Get",
+      "List",
+      "Set",
+      "Delete",
+    ]
+  }
+
+  # Microsoft.Azure.CertificateRegistration
+  access_policy {
+    tenant_id = data.azurerm_client_config.test.tenant_id
+    object_id = "ed47c2a1-bd23-4341-b39c-f4fd69138dd3"
+
+    secret_permissions = [
+      "Get",
+      "Set",
+      "Delete",
+    ]
+  }
+}
+
+resource "azurerm_app_service_certificate_order" "test" {
+  name                    = "acctestASCO-%[1]d"
+  location                = "global"
+  resource_group_name     = azurerm_resource_group.test.name
+  distinguished_name      = "CN=example.com"
+  product_type            = "Standard"
+  verify_domain_ownership = true
+
+  key_vault_certificate {
+    name                  = "acctestcert"
+    key_vault_id          = azurerm_key_vault.test.id
+    key_vault_secret_name = "acctestsecret"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
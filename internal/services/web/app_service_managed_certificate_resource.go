@@ -86,6 +86,11 @@ func resourceAppServiceManagedCertificate() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"valid": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -229,6 +234,7 @@ func resourceAppServiceManagedCertificateRead(d *pluginsdk.ResourceData, meta in
 		}
 		d.Set("expiration_date", expirationDate)
 		d.Set("thumbprint", props.Thumbprint)
+		d.Set("valid", props.Valid)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
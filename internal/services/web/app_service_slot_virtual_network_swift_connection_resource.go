@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"time"
 
-	azureNetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-02-01/web"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -59,9 +58,7 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnection() *pluginsdk.Resource {
 
 func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.AppServicesClient
-	subnetClient := meta.(*clients.Client).Network.SubnetsClient
-	vnetClient := meta.(*clients.Client).Network.VnetClient
-	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	appID, err := parse.AppServiceID(d.Get("app_service_id").(string))
@@ -75,7 +72,6 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsd
 
 	resourceGroup := appID.ResourceGroup
 	name := appID.SiteName
-	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 	slotName := d.Get("slot_name").(string)
 
@@ -92,11 +88,28 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsd
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
+	// when the integration is moved to another Subnet both the previous and the new Subnet are updated
+	subnetIds, err := appServiceVirtualNetworkSwiftConnectionSubnetIDs(d)
+	if err != nil {
+		return err
+	}
 
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	virtualNetworkNames := make([]string, 0)
+	subnetNames := make([]string, 0)
+	for _, v := range subnetIds {
+		if !utils.SliceContainsValue(virtualNetworkNames, v.VirtualNetworkName) {
+			virtualNetworkNames = append(virtualNetworkNames, v.VirtualNetworkName)
+		}
+		if !utils.SliceContainsValue(subnetNames, v.Name) {
+			subnetNames = append(subnetNames, v.Name)
+		}
+	}
+
+	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+
+	locks.MultipleByName(&subnetNames, network.SubnetResourceName)
+	defer locks.UnlockMultipleByName(&subnetNames, network.SubnetResourceName)
 
 	appServiceExists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -123,29 +136,8 @@ func resourceAppServiceSlotVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsd
 		return fmt.Errorf("creating/updating App Service Slot VNet association between %q (App Service %q / Resource Group %q) and Virtual Network %q: %s", slotName, name, resourceGroup, virtualNetworkName, err)
 	}
 
-	timeout, _ := ctx.Deadline()
-
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:    []string{string(azureNetwork.ProvisioningStateUpdating)},
-		Target:     []string{string(azureNetwork.ProvisioningStateSucceeded)},
-		Refresh:    network.SubnetProvisioningStateRefreshFunc(ctx, subnetClient, *subnetID),
-		MinTimeout: 1 * time.Minute,
-		Timeout:    time.Until(timeout),
-	}
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for provisioning state of subnet for App Service Slot VNet association between %q (App Service %q / Resource Group %q) and Virtual Network %q: %s", slotName, name, resourceGroup, virtualNetworkName, err)
-	}
-
-	vnetId := networkParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, subnetID.VirtualNetworkName)
-	vnetStateConf := &pluginsdk.StateChangeConf{
-		Pending:    []string{string(azureNetwork.ProvisioningStateUpdating)},
-		Target:     []string{string(azureNetwork.ProvisioningStateSucceeded)},
-		Refresh:    network.VirtualNetworkProvisioningStateRefreshFunc(ctx, vnetClient, vnetId),
-		MinTimeout: 1 * time.Minute,
-		Timeout:    time.Until(timeout),
-	}
-	if _, err = vnetStateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for provisioning state of virtual network for App Service Slot VNet association between %q (App Service %q / Resource Group %q) and Virtual Network %q: %s", slotName, name, resourceGroup, virtualNetworkName, err)
+	if err := waitForAppServiceVirtualNetworkSwiftConnectionSubnets(ctx, meta.(*clients.Client), subnetIds); err != nil {
+		return fmt.Errorf("waiting for provisioning state of App Service Slot VNet association between %q (App Service %q / Resource Group %q) and Virtual Network %q: %s", slotName, name, resourceGroup, virtualNetworkName, err)
 	}
 
 	read, err := client.GetSwiftVirtualNetworkConnectionSlot(ctx, resourceGroup, name, slotName)
@@ -1,7 +1,9 @@
 package web
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	azureNetwork "github.com/Azure/azure-sdk-for-go/services/network/mgmt/2021-02-01/network"
@@ -52,9 +54,7 @@ func resourceAppServiceVirtualNetworkSwiftConnection() *pluginsdk.Resource {
 
 func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Web.AppServicesClient
-	subnetClient := meta.(*clients.Client).Network.SubnetsClient
-	vnetClient := meta.(*clients.Client).Network.VnetClient
-	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	appID, err := parse.AppServiceID(d.Get("app_service_id").(string))
@@ -69,7 +69,6 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.Re
 
 	resourceGroup := appID.ResourceGroup
 	name := appID.SiteName
-	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 
 	if d.IsNewResource() {
@@ -85,11 +84,28 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.Re
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
+	// when the integration is moved to another Subnet both the previous and the new Subnet are updated
+	subnetIds, err := appServiceVirtualNetworkSwiftConnectionSubnetIDs(d)
+	if err != nil {
+		return err
+	}
 
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	virtualNetworkNames := make([]string, 0)
+	subnetNames := make([]string, 0)
+	for _, v := range subnetIds {
+		if !utils.SliceContainsValue(virtualNetworkNames, v.VirtualNetworkName) {
+			virtualNetworkNames = append(virtualNetworkNames, v.VirtualNetworkName)
+		}
+		if !utils.SliceContainsValue(subnetNames, v.Name) {
+			subnetNames = append(subnetNames, v.Name)
+		}
+	}
+
+	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+
+	locks.MultipleByName(&subnetNames, network.SubnetResourceName)
+	defer locks.UnlockMultipleByName(&subnetNames, network.SubnetResourceName)
 
 	exists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -108,29 +124,8 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.Re
 		return fmt.Errorf("creating/updating App Service VNet association between %q (Resource Group %q) and Virtual Network %q: %s", name, resourceGroup, virtualNetworkName, err)
 	}
 
-	timeout, _ := ctx.Deadline()
-
-	stateConf := &pluginsdk.StateChangeConf{
-		Pending:    []string{string(azureNetwork.ProvisioningStateUpdating)},
-		Target:     []string{string(azureNetwork.ProvisioningStateSucceeded)},
-		Refresh:    network.SubnetProvisioningStateRefreshFunc(ctx, subnetClient, *subnetID),
-		MinTimeout: 1 * time.Minute,
-		Timeout:    time.Until(timeout),
-	}
-	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for provisioning state of subnet for App Service VNet association between %q (Resource Group %q) and Virtual Network %q: %s", name, resourceGroup, virtualNetworkName, err)
-	}
-
-	vnetId := networkParse.NewVirtualNetworkID(subnetID.SubscriptionId, subnetID.ResourceGroup, subnetID.VirtualNetworkName)
-	vnetStateConf := &pluginsdk.StateChangeConf{
-		Pending:    []string{string(azureNetwork.ProvisioningStateUpdating)},
-		Target:     []string{string(azureNetwork.ProvisioningStateSucceeded)},
-		Refresh:    network.VirtualNetworkProvisioningStateRefreshFunc(ctx, vnetClient, vnetId),
-		MinTimeout: 1 * time.Minute,
-		Timeout:    time.Until(timeout),
-	}
-	if _, err = vnetStateConf.WaitForStateContext(ctx); err != nil {
-		return fmt.Errorf("waiting for provisioning state of virtual network for App Service VNet association between %q (Resource Group %q) and Virtual Network %q: %s", name, resourceGroup, virtualNetworkName, err)
+	if err := waitForAppServiceVirtualNetworkSwiftConnectionSubnets(ctx, meta.(*clients.Client), subnetIds); err != nil {
+		return fmt.Errorf("waiting for provisioning state of App Service VNet association between %q (Resource Group %q) and Virtual Network %q: %s", name, resourceGroup, virtualNetworkName, err)
 	}
 
 	read, err := client.GetSwiftVirtualNetworkConnection(ctx, resourceGroup, name)
@@ -229,3 +224,57 @@ func resourceAppServiceVirtualNetworkSwiftConnectionDelete(d *pluginsdk.Resource
 
 	return nil
 }
+
+// appServiceVirtualNetworkSwiftConnectionSubnetIDs returns the ID of the Subnet the App Service is being integrated
+// with - and when the integration is being moved from another Subnet, the ID of the previous Subnet too
+func appServiceVirtualNetworkSwiftConnectionSubnetIDs(d *pluginsdk.ResourceData) ([]networkParse.SubnetId, error) {
+	subnetIds := make([]networkParse.SubnetId, 0)
+
+	oldRaw, newRaw := d.GetChange("subnet_id")
+	subnetId, err := networkParse.SubnetID(newRaw.(string))
+	if err != nil {
+		return nil, err
+	}
+	subnetIds = append(subnetIds, *subnetId)
+
+	if !d.IsNewResource() && oldRaw.(string) != "" && !strings.EqualFold(oldRaw.(string), newRaw.(string)) {
+		previousSubnetId, err := networkParse.SubnetIDInsensitively(oldRaw.(string))
+		if err != nil {
+			return nil, err
+		}
+		subnetIds = append(subnetIds, *previousSubnetId)
+	}
+
+	return subnetIds, nil
+}
+
+func waitForAppServiceVirtualNetworkSwiftConnectionSubnets(ctx context.Context, client *clients.Client, subnetIds []networkParse.SubnetId) error {
+	timeout, _ := ctx.Deadline()
+
+	for _, subnetId := range subnetIds {
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{string(azureNetwork.ProvisioningStateUpdating)},
+			Target:     []string{string(azureNetwork.ProvisioningStateSucceeded)},
+			Refresh:    network.SubnetProvisioningStateRefreshFunc(ctx, client.Network.SubnetsClient, subnetId),
+			MinTimeout: 1 * time.Minute,
+			Timeout:    time.Until(timeout),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for provisioning state of %s: %+v", subnetId, err)
+		}
+
+		vnetId := networkParse.NewVirtualNetworkID(subnetId.SubscriptionId, subnetId.ResourceGroup, subnetId.VirtualNetworkName)
+		vnetStateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{string(azureNetwork.ProvisioningStateUpdating)},
+			Target:     []string{string(azureNetwork.ProvisioningStateSucceeded)},
+			Refresh:    network.VirtualNetworkProvisioningStateRefreshFunc(ctx, client.Network.VnetClient, vnetId),
+			MinTimeout: 1 * time.Minute,
+			Timeout:    time.Until(timeout),
+		}
+		if _, err := vnetStateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for provisioning state of %s: %+v", vnetId, err)
+		}
+	}
+
+	return nil
+}